@@ -403,6 +403,9 @@ Write-Host ""
 #           └── Action handle "view"       → permission "system:group:view"
 #       └── Resource handle "usertype"      → permission "system:usertype"
 #           └── Action handle "view"       → permission "system:usertype:view"
+#       └── Resource handle "authz"        → permission "system:authz"
+#           └── Action handle "evaluate"   → permission "system:authz:evaluate"
+#           └── Action handle "view"       → permission "system:authz:view"
 # ============================================================================
 
 Log-Info "Creating 'system' resource under the system resource server..."
@@ -776,6 +779,108 @@ else {
 
 Write-Host ""
 
+Log-Info "Creating 'authz' sub-resource under the 'system' resource..."
+
+if (-not $SYSTEM_RESOURCE_ID) {
+    Log-Error "System resource ID is not available. Cannot create authorization resource."
+    exit 1
+}
+
+$authzResourceData = @{
+    name        = "Authorization"
+    description = "Authorization resource"
+    handle      = "authz"
+    parent      = $SYSTEM_RESOURCE_ID
+} | ConvertTo-Json -Depth 10
+
+$response = Invoke-Api -Method POST -Endpoint "/resource-servers/$SYSTEM_RS_ID/resources" -Data $authzResourceData
+
+if ($response.StatusCode -eq 201 -or $response.StatusCode -eq 200) {
+    Log-Success "Authorization resource created successfully (permission: system:authz)"
+    $body = $response.Body | ConvertFrom-Json
+    $AUTHZ_RESOURCE_ID = $body.id
+    if ($AUTHZ_RESOURCE_ID) {
+        Log-Info "Authorization resource ID: $AUTHZ_RESOURCE_ID"
+    }
+    else {
+        Log-Error "Could not extract authorization resource ID from response"
+        exit 1
+    }
+}
+elseif ($response.StatusCode -eq 409) {
+    Log-Warning "Authorization resource already exists, retrieving ID..."
+    $response = Invoke-Api -Method GET -Endpoint "/resource-servers/$SYSTEM_RS_ID/resources?parentId=$SYSTEM_RESOURCE_ID"
+
+    if ($response.StatusCode -eq 200) {
+        $body = $response.Body | ConvertFrom-Json
+        $authzResource = $body.resources | Where-Object { $_.handle -eq "authz" } | Select-Object -First 1
+
+        if ($authzResource) {
+            $AUTHZ_RESOURCE_ID = $authzResource.id
+            Log-Success "Found authorization resource ID: $AUTHZ_RESOURCE_ID"
+        }
+        else {
+            Log-Error "Could not find authorization resource in response"
+            exit 1
+        }
+    }
+    else {
+        Log-Error "Failed to fetch resources (HTTP $($response.StatusCode))"
+        exit 1
+    }
+}
+else {
+    Log-Error "Failed to create authorization resource (HTTP $($response.StatusCode))"
+    Log-Error "Response: $($response.Body)"
+    exit 1
+}
+
+Log-Info "Creating 'evaluate' action under the 'authz' resource..."
+
+$authzEvaluateActionData = @{
+    name        = "Evaluate"
+    description = "Evaluate access decisions on behalf of resource servers"
+    handle      = "evaluate"
+} | ConvertTo-Json -Depth 10
+
+$response = Invoke-Api -Method POST -Endpoint "/resource-servers/$SYSTEM_RS_ID/resources/$AUTHZ_RESOURCE_ID/actions" -Data $authzEvaluateActionData
+
+if ($response.StatusCode -eq 201 -or $response.StatusCode -eq 200) {
+    Log-Success "Authorization evaluate action created successfully (permission: system:authz:evaluate)"
+}
+elseif ($response.StatusCode -eq 409) {
+    Log-Warning "Authorization evaluate action already exists, skipping"
+}
+else {
+    Log-Error "Failed to create authorization evaluate action (HTTP $($response.StatusCode))"
+    Log-Error "Response: $($response.Body)"
+    exit 1
+}
+
+Log-Info "Creating 'view' action under the 'authz' resource..."
+
+$authzViewActionData = @{
+    name        = "View"
+    description = "Read-only access to authorization policies"
+    handle      = "view"
+} | ConvertTo-Json -Depth 10
+
+$response = Invoke-Api -Method POST -Endpoint "/resource-servers/$SYSTEM_RS_ID/resources/$AUTHZ_RESOURCE_ID/actions" -Data $authzViewActionData
+
+if ($response.StatusCode -eq 201 -or $response.StatusCode -eq 200) {
+    Log-Success "Authorization view action created successfully (permission: system:authz:view)"
+}
+elseif ($response.StatusCode -eq 409) {
+    Log-Warning "Authorization view action already exists, skipping"
+}
+else {
+    Log-Error "Failed to create authorization view action (HTTP $($response.StatusCode))"
+    Log-Error "Response: $($response.Body)"
+    exit 1
+}
+
+Write-Host ""
+
 # ============================================================================
 # Create Administrator Group
 # ============================================================================
@@ -915,9 +1020,11 @@ Log-Info "Creating default flows..."
 $AUTH_FLOWS_DIR = Join-Path $PSScriptRoot "flows" "authentication"
 $REG_FLOWS_DIR = Join-Path $PSScriptRoot "flows" "registration"
 $USER_ONBOARDING_FLOWS_DIR = Join-Path $PSScriptRoot "flows" "user_onboarding"
+$BACKCHANNEL_FLOWS_DIR = Join-Path $PSScriptRoot "flows" "backchannel_authentication"
 
 # Check if flows directories exist
-if (-not (Test-Path $AUTH_FLOWS_DIR) -and -not (Test-Path $REG_FLOWS_DIR) -and -not (Test-Path $USER_ONBOARDING_FLOWS_DIR)) {
+if (-not (Test-Path $AUTH_FLOWS_DIR) -and -not (Test-Path $REG_FLOWS_DIR) -and -not (Test-Path $USER_ONBOARDING_FLOWS_DIR) -and
+    -not (Test-Path $BACKCHANNEL_FLOWS_DIR)) {
     Log-Warning "Flow definitions directories not found, skipping flow creation"
 }
 else {
@@ -1099,6 +1206,60 @@ else {
         }
     }
 
+    # Process backchannel authentication flows
+    if (Test-Path $BACKCHANNEL_FLOWS_DIR) {
+        $backchannelFlowFiles = Get-ChildItem -Path $BACKCHANNEL_FLOWS_DIR -Filter "*.json" -File -ErrorAction SilentlyContinue
+
+        if ($backchannelFlowFiles.Count -gt 0) {
+            Log-Info "Processing backchannel authentication flows..."
+
+            # Fetch existing backchannel authentication flows
+            $listResponse = Invoke-Api -Method GET -Endpoint "/flows?flowType=BACKCHANNEL_AUTHENTICATION&limit=200"
+
+            # Store existing backchannel flows by handle in a hashtable
+            $existingBackchannelFlows = @{}
+            if ($listResponse.StatusCode -eq 200) {
+                $listBody = $listResponse.Body | ConvertFrom-Json
+                foreach ($flow in $listBody.flows) {
+                    $existingBackchannelFlows[$flow.handle] = $flow.id
+                }
+            }
+
+            foreach ($flowFile in $backchannelFlowFiles) {
+                $flowCount++
+
+                # Get flow handle and name from file
+                $flowContent = Get-Content -Path $flowFile.FullName -Raw | ConvertFrom-Json
+                $flowHandle = $flowContent.handle
+                $flowName = $flowContent.name
+
+                # Check if flow exists by handle
+                if ($existingBackchannelFlows.ContainsKey($flowHandle)) {
+                    # Update existing flow
+                    $flowId = $existingBackchannelFlows[$flowHandle]
+                    Log-Info "Updating existing backchannel authentication flow: $flowName (handle: $flowHandle)"
+                    $result = Update-Flow -FlowId $flowId -FlowFilePath $flowFile.FullName
+                    if ($result) {
+                        $flowSuccess++
+                    }
+                }
+                else {
+                    # Create new flow
+                    $flowId = Create-Flow -FlowFilePath $flowFile.FullName
+                    if ($flowId) {
+                        $flowSuccess++
+                    }
+                    elseif ($flowId -eq "") {
+                        $flowSkipped++
+                    }
+                }
+            }
+        }
+        else {
+            Log-Info "No backchannel authentication flow files found"
+        }
+    }
+
     if ($flowCount -gt 0) {
         Log-Info "Flow creation summary: $flowSuccess created/updated, $flowSkipped skipped, $($flowCount - $flowSuccess - $flowSkipped) failed"
     }
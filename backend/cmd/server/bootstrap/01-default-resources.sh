@@ -389,6 +389,9 @@ echo ""
 #           └── Action handle "view"       → permission "system:group:view"
 #       └── Resource handle "usertype"      → permission "system:usertype"
 #           └── Action handle "view"       → permission "system:usertype:view"
+#       └── Resource handle "authz"        → permission "system:authz"
+#           └── Action handle "evaluate"   → permission "system:authz:evaluate"
+#           └── Action handle "view"       → permission "system:authz:view"
 # ============================================================================
 
 log_info "Creating 'system' resource under the system resource server..."
@@ -708,6 +711,95 @@ fi
 
 echo ""
 
+log_info "Creating 'authz' sub-resource under the 'system' resource..."
+
+RESPONSE=$(api_call POST "/resource-servers/${SYSTEM_RS_ID}/resources" "{
+  \"name\": \"Authorization\",
+  \"description\": \"Authorization resource\",
+  \"handle\": \"authz\",
+  \"parent\": \"${SYSTEM_RESOURCE_ID}\"
+}")
+
+HTTP_CODE="${RESPONSE: -3}"
+BODY="${RESPONSE%???}"
+
+if [[ "$HTTP_CODE" == "201" ]] || [[ "$HTTP_CODE" == "200" ]]; then
+    log_success "Authorization resource created successfully (permission: system:authz)"
+    AUTHZ_RESOURCE_ID=$(echo "$BODY" | grep -o '"id":"[^"]*"' | head -1 | cut -d'"' -f4)
+    if [[ -n "$AUTHZ_RESOURCE_ID" ]]; then
+        log_info "Authorization resource ID: $AUTHZ_RESOURCE_ID"
+    else
+        log_error "Could not extract authorization resource ID from response"
+        exit 1
+    fi
+elif [[ "$HTTP_CODE" == "409" ]]; then
+    log_warning "Authorization resource already exists, retrieving ID..."
+    RESPONSE=$(api_call GET "/resource-servers/${SYSTEM_RS_ID}/resources?parentId=${SYSTEM_RESOURCE_ID}")
+    HTTP_CODE="${RESPONSE: -3}"
+    BODY="${RESPONSE%???}"
+
+    if [[ "$HTTP_CODE" == "200" ]]; then
+        AUTHZ_RESOURCE_ID=$(echo "$BODY" | sed 's/},{/}\n{/g' | grep '"handle":"authz"' | grep -o '"id":"[^"]*"' | head -1 | cut -d'"' -f4)
+        if [[ -n "$AUTHZ_RESOURCE_ID" ]]; then
+            log_success "Found authorization resource ID: $AUTHZ_RESOURCE_ID"
+        else
+            log_error "Could not find authorization resource in response"
+            exit 1
+        fi
+    else
+        log_error "Failed to fetch resources (HTTP $HTTP_CODE)"
+        exit 1
+    fi
+else
+    log_error "Failed to create authorization resource (HTTP $HTTP_CODE)"
+    echo "Response: $BODY"
+    exit 1
+fi
+
+log_info "Creating 'evaluate' action under the 'authz' resource..."
+
+RESPONSE=$(api_call POST "/resource-servers/${SYSTEM_RS_ID}/resources/${AUTHZ_RESOURCE_ID}/actions" '{
+  "name": "Evaluate",
+  "description": "Evaluate access decisions on behalf of resource servers",
+  "handle": "evaluate"
+}')
+
+HTTP_CODE="${RESPONSE: -3}"
+BODY="${RESPONSE%???}"
+
+if [[ "$HTTP_CODE" == "201" ]] || [[ "$HTTP_CODE" == "200" ]]; then
+    log_success "Authorization evaluate action created successfully (permission: system:authz:evaluate)"
+elif [[ "$HTTP_CODE" == "409" ]]; then
+    log_warning "Authorization evaluate action already exists, skipping"
+else
+    log_error "Failed to create authorization evaluate action (HTTP $HTTP_CODE)"
+    echo "Response: $BODY"
+    exit 1
+fi
+
+log_info "Creating 'view' action under the 'authz' resource..."
+
+RESPONSE=$(api_call POST "/resource-servers/${SYSTEM_RS_ID}/resources/${AUTHZ_RESOURCE_ID}/actions" '{
+  "name": "View",
+  "description": "Read-only access to authorization policies",
+  "handle": "view"
+}')
+
+HTTP_CODE="${RESPONSE: -3}"
+BODY="${RESPONSE%???}"
+
+if [[ "$HTTP_CODE" == "201" ]] || [[ "$HTTP_CODE" == "200" ]]; then
+    log_success "Authorization view action created successfully (permission: system:authz:view)"
+elif [[ "$HTTP_CODE" == "409" ]]; then
+    log_warning "Authorization view action already exists, skipping"
+else
+    log_error "Failed to create authorization view action (HTTP $HTTP_CODE)"
+    echo "Response: $BODY"
+    exit 1
+fi
+
+echo ""
+
 # ============================================================================
 # Create Administrator Group
 # ============================================================================
@@ -842,9 +934,11 @@ log_info "Creating default flows..."
 AUTH_FLOWS_DIR="${SCRIPT_DIR}/flows/authentication"
 REG_FLOWS_DIR="${SCRIPT_DIR}/flows/registration"
 USER_ONBOARDING_FLOWS_DIR="${SCRIPT_DIR}/flows/user_onboarding"
+BACKCHANNEL_FLOWS_DIR="${SCRIPT_DIR}/flows/backchannel_authentication"
 
 # Check if flows directory exists
-if [[ ! -d "$AUTH_FLOWS_DIR" ]] && [[ ! -d "$REG_FLOWS_DIR" ]] && [[ ! -d "$USER_ONBOARDING_FLOWS_DIR" ]]; then
+if [[ ! -d "$AUTH_FLOWS_DIR" ]] && [[ ! -d "$REG_FLOWS_DIR" ]] && [[ ! -d "$USER_ONBOARDING_FLOWS_DIR" ]] \
+    && [[ ! -d "$BACKCHANNEL_FLOWS_DIR" ]]; then
     log_warning "Flow definition directories not found, skipping flow creation"
 else
     FLOW_COUNT=0
@@ -1042,6 +1136,65 @@ else
         fi
     fi
 
+    # Process backchannel authentication flows
+    if [[ -d "$BACKCHANNEL_FLOWS_DIR" ]]; then
+        shopt -s nullglob
+        BACKCHANNEL_FILES=("$BACKCHANNEL_FLOWS_DIR"/*.json)
+        shopt -u nullglob
+
+        if [[ ${#BACKCHANNEL_FILES[@]} -gt 0 ]]; then
+            log_info "Processing backchannel authentication flows..."
+
+            # Fetch existing backchannel authentication flows
+            RESPONSE=$(api_call GET "/flows?flowType=BACKCHANNEL_AUTHENTICATION&limit=200")
+            HTTP_CODE="${RESPONSE: -3}"
+            BODY="${RESPONSE%???}"
+
+            # Store existing backchannel authentication flows as "handle|id" pairs
+            EXISTING_BACKCHANNEL_FLOWS=""
+            if [[ "$HTTP_CODE" == "200" ]]; then
+                while IFS= read -r line; do
+                    FLOW_ID=$(echo "$line" | grep -o '"id":"[^"]*"' | cut -d'"' -f4)
+                    FLOW_HANDLE=$(echo "$line" | grep -o '"handle":"[^"]*"' | cut -d'"' -f4)
+                    if [[ -n "$FLOW_ID" ]] && [[ -n "$FLOW_HANDLE" ]]; then
+                        EXISTING_BACKCHANNEL_FLOWS="${EXISTING_BACKCHANNEL_FLOWS}${FLOW_HANDLE}|${FLOW_ID}"$'\n'
+                    fi
+                done < <(echo "$BODY" | grep -o '{[^}]*"id":"[^"]*"[^}]*"handle":"[^"]*"[^}]*}')
+            fi
+
+            for FLOW_FILE in "$BACKCHANNEL_FLOWS_DIR"/*.json; do
+                [[ ! -f "$FLOW_FILE" ]] && continue
+
+                FLOW_COUNT=$((FLOW_COUNT + 1))
+                FLOW_HANDLE=$(grep -o '"handle"[[:space:]]*:[[:space:]]*"[^"]*"' "$FLOW_FILE" | head -1 | sed 's/"handle"[[:space:]]*:[[:space:]]*"\([^"]*\)"/\1/')
+                FLOW_NAME=$(grep -o '"name"[[:space:]]*:[[:space:]]*"[^"]*"' "$FLOW_FILE" | head -1 | sed 's/"name"[[:space:]]*:[[:space:]]*"\([^"]*\)"/\1/')
+
+                # Check if flow exists by handle
+                if echo "$EXISTING_BACKCHANNEL_FLOWS" | grep -q "^${FLOW_HANDLE}|"; then
+                    # Update existing flow
+                    FLOW_ID=$(echo "$EXISTING_BACKCHANNEL_FLOWS" | grep "^${FLOW_HANDLE}|" | cut -d'|' -f2)
+                    log_info "Updating existing backchannel authentication flow: $FLOW_NAME (handle: $FLOW_HANDLE)"
+                    update_flow "$FLOW_ID" "$FLOW_FILE"
+                    RESULT=$?
+                    if [[ $RESULT -eq 0 ]]; then
+                        FLOW_SUCCESS=$((FLOW_SUCCESS + 1))
+                    fi
+                else
+                    # Create new flow
+                    create_flow "$FLOW_FILE"
+                    RESULT=$?
+                    if [[ $RESULT -eq 0 ]]; then
+                        FLOW_SUCCESS=$((FLOW_SUCCESS + 1))
+                    elif [[ $RESULT -eq 2 ]]; then
+                        FLOW_SKIPPED=$((FLOW_SKIPPED + 1))
+                    fi
+                fi
+            done
+        else
+            log_debug "No backchannel authentication flow files found"
+        fi
+    fi
+
     if [[ $FLOW_COUNT -gt 0 ]]; then
         log_info "Flow creation summary: $FLOW_SUCCESS created/updated, $FLOW_SKIPPED skipped, $((FLOW_COUNT - FLOW_SUCCESS - FLOW_SKIPPED)) failed"
     fi
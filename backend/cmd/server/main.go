@@ -84,7 +84,7 @@ func main() {
 	}
 
 	// Register the services.
-	jwtService := registerServices(mux, cacheManager)
+	jwtService, revocationChecker := registerServices(mux, cacheManager)
 
 	// Register static file handlers for frontend applications.
 	registerStaticFileHandlers(logger, mux, serverHome)
@@ -94,7 +94,7 @@ func main() {
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 
 	// Create the HTTP server.
-	server := createHTTPServer(logger, cfg, mux, jwtService)
+	server := createHTTPServer(logger, cfg, mux, jwtService, revocationChecker)
 	var ln net.Listener
 	if cfg.Server.HTTPOnly {
 		logger.Info("TLS is not enabled, starting server without TLS")
@@ -180,13 +180,14 @@ func loadCertConfig(logger *log.Logger, cfg *config.Config, serverHome string) *
 
 // createHTTPServer creates and configures an HTTP server with common settings.
 func createHTTPServer(logger *log.Logger, cfg *config.Config, mux *http.ServeMux,
-	jwtService jwt.JWTServiceInterface) *http.Server {
-	securityMiddleware := createSecurityMiddleware(logger, mux, jwtService)
+	jwtService jwt.JWTServiceInterface, revocationChecker security.TokenRevocationCheckerInterface) *http.Server {
+	securityMiddleware := createSecurityMiddleware(logger, mux, jwtService, revocationChecker)
 
 	// Build the middleware chain with proper execution order.
-	// Request flow: CorrelationID (outermost) -> AccessLog -> Security -> Route Handler (innermost)
+	// Request flow: CorrelationID (outermost) -> ClientIP -> AccessLog -> Security -> Route Handler (innermost)
 	// Note: Middlewares are wrapped in reverse order - the last added will execute first.
 	handler := log.AccessLogHandler(logger, securityMiddleware)
+	handler = middleware.ClientIPMiddleware(handler)
 	handler = middleware.CorrelationIDMiddleware(handler)
 
 	// Build the server address using hostname and port from the configurations.
@@ -222,8 +223,8 @@ func createTLSListener(logger *log.Logger, server *http.Server, tlsConfig *tls.C
 }
 
 func createSecurityMiddleware(logger *log.Logger, mux *http.ServeMux,
-	jwtService jwt.JWTServiceInterface) http.Handler {
-	middlewareFunc, err := security.Initialize(jwtService)
+	jwtService jwt.JWTServiceInterface, revocationChecker security.TokenRevocationCheckerInterface) http.Handler {
+	middlewareFunc, err := security.Initialize(jwtService, revocationChecker)
 	if err != nil {
 		logger.Fatal("Failed to initialize security middleware", log.Error(err))
 	}
@@ -134,7 +134,7 @@ func (suite *CreateSecurityMiddlewareTestSuite) TestCreateSecurityMiddleware_Wit
 			}
 
 			// Execute
-			handler := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService)
+			handler := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService, nil)
 
 			// Assert - handler is always returned now, regardless of skip security flag
 			assert.NotNil(suite.T(), handler, "Handler should always be non-nil")
@@ -148,9 +148,9 @@ func (suite *CreateSecurityMiddlewareTestSuite) TestCreateSecurityMiddleware_Wit
 // TestCreateSecurityMiddleware_MultipleInvocations tests that multiple calls work correctly
 func (suite *CreateSecurityMiddlewareTestSuite) TestCreateSecurityMiddleware_MultipleInvocations() {
 	// Execute multiple times
-	handler1 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService)
-	handler2 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService)
-	handler3 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService)
+	handler1 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService, nil)
+	handler2 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService, nil)
+	handler3 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService, nil)
 
 	// Assert - each call should return a new handler instance
 	assert.NotNil(suite.T(), handler1)
@@ -161,17 +161,17 @@ func (suite *CreateSecurityMiddlewareTestSuite) TestCreateSecurityMiddleware_Mul
 // TestCreateSecurityMiddleware_RuntimeToggle tests toggling security at runtime by changing environment variable
 func (suite *CreateSecurityMiddlewareTestSuite) TestCreateSecurityMiddleware_RuntimeToggle() {
 	// First call with security enabled
-	handler1 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService)
+	handler1 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService, nil)
 	assert.NotNil(suite.T(), handler1, "First handler should not be nil")
 
 	// Disable security
 	_ = os.Setenv("SKIP_SECURITY", "true")
-	handler2 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService)
+	handler2 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService, nil)
 	assert.NotNil(suite.T(), handler2, "Second handler should not be nil (skipSecurity is handled internally)")
 
 	// Re-enable security
 	_ = os.Unsetenv("SKIP_SECURITY")
-	handler3 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService)
+	handler3 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService, nil)
 	assert.NotNil(suite.T(), handler3, "Third handler should not be nil after re-enabling security")
 }
 
@@ -195,7 +195,7 @@ func TestCreateHTTPServer_WithHTTPOnly(t *testing.T) {
 	}
 
 	mux := http.NewServeMux()
-	server := createHTTPServer(logger, cfg, mux, nil)
+	server := createHTTPServer(logger, cfg, mux, nil, nil)
 
 	assert.Equal(t, "localhost:0", server.Addr)
 	assert.NotNil(t, server.Handler)
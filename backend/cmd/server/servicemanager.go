@@ -33,11 +33,14 @@ import (
 	authnConsent "github.com/asgardeo/thunder/internal/authn/consent"
 	"github.com/asgardeo/thunder/internal/authn/github"
 	"github.com/asgardeo/thunder/internal/authn/google"
+	"github.com/asgardeo/thunder/internal/authn/lockout"
 	"github.com/asgardeo/thunder/internal/authn/magiclink"
 	authnOAuth "github.com/asgardeo/thunder/internal/authn/oauth"
 	authnOIDC "github.com/asgardeo/thunder/internal/authn/oidc"
 	"github.com/asgardeo/thunder/internal/authn/otp"
 	"github.com/asgardeo/thunder/internal/authn/passkey"
+	authnSAML "github.com/asgardeo/thunder/internal/authn/saml"
+	"github.com/asgardeo/thunder/internal/authn/totp"
 	authnprovidermgr "github.com/asgardeo/thunder/internal/authnprovider/manager"
 	"github.com/asgardeo/thunder/internal/authz"
 	"github.com/asgardeo/thunder/internal/cert"
@@ -59,8 +62,11 @@ import (
 	"github.com/asgardeo/thunder/internal/notification"
 	"github.com/asgardeo/thunder/internal/oauth"
 	"github.com/asgardeo/thunder/internal/ou"
+	"github.com/asgardeo/thunder/internal/passwordpolicy"
 	"github.com/asgardeo/thunder/internal/resource"
 	"github.com/asgardeo/thunder/internal/role"
+	"github.com/asgardeo/thunder/internal/saml"
+	"github.com/asgardeo/thunder/internal/session"
 	"github.com/asgardeo/thunder/internal/system/cache"
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
@@ -73,11 +79,14 @@ import (
 	"github.com/asgardeo/thunder/internal/system/importer"
 	"github.com/asgardeo/thunder/internal/system/jose"
 	"github.com/asgardeo/thunder/internal/system/jose/jwt"
-	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm"
-	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/pkiservice"
+	"github.com/asgardeo/thunder/internal/system/kmprovider"
+	_ "github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm" // registers the default key manager
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/keyrotation"
+	_ "github.com/asgardeo/thunder/internal/system/kmprovider/pkcs11km" // registers the PKCS#11 key manager
 	"github.com/asgardeo/thunder/internal/system/log"
 	"github.com/asgardeo/thunder/internal/system/mcp"
 	"github.com/asgardeo/thunder/internal/system/observability"
+	"github.com/asgardeo/thunder/internal/system/security"
 	"github.com/asgardeo/thunder/internal/system/services"
 	"github.com/asgardeo/thunder/internal/system/sysauthz"
 	"github.com/asgardeo/thunder/internal/system/template"
@@ -87,25 +96,34 @@ import (
 // observabilitySvc is the observability service instance. This is used for graceful shutdown.
 var observabilitySvc observability.ObservabilityServiceInterface
 
+// keyRotationSvc is the signing key rotation service instance. This is used for graceful shutdown.
+var keyRotationSvc keyrotation.KeyRotationServiceInterface
+
 // registerServices registers all the services with the provided HTTP multiplexer.
-func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterface) jwt.JWTServiceInterface {
+// Returns the JWT service and the token revocation checker used by the security middleware.
+func registerServices(
+	mux *http.ServeMux, cacheManager cache.CacheManagerInterface,
+) (jwt.JWTServiceInterface, security.TokenRevocationCheckerInterface) {
 	logger := log.GetLogger()
 
-	// Load the server's private key for signing JWTs.
-	pkiService, err := pkiservice.Initialize()
+	// Load the server's signing keys and crypto services from the configured key manager provider.
+	keyManager, err := kmprovider.InitializeKeyManager()
 	if err != nil {
-		logger.Fatal("Failed to initialize certificate service", log.Error(err))
+		logger.Fatal("Failed to initialize key manager", log.Error(err))
 	}
+	pkiService := keyManager.PKIService
+	configCryptoSvc := keyManager.ConfigCrypto
+	runtimeCryptoSvc := keyManager.RuntimeCrypto
 
-	configCryptoSvc, err := defaultkm.InitConfigProvider()
+	jwtService, jweService, err := jose.Initialize(pkiService, runtimeCryptoSvc)
 	if err != nil {
-		logger.Fatal("Failed to initialize config crypto provider", log.Error(err))
+		logger.Fatal("Failed to initialize JOSE services", log.Error(err))
 	}
-	runtimeCryptoSvc := defaultkm.NewRuntimeCryptoService(pkiService, configCryptoSvc)
 
-	jwtService, jweService, err := jose.Initialize(pkiService)
+	// Initialize the signing key rotation lifecycle. Managed keys take over token signing once loaded.
+	keyRotationSvc, err = keyrotation.Initialize(mux, pkiService, configCryptoSvc)
 	if err != nil {
-		logger.Fatal("Failed to initialize JOSE services", log.Error(err))
+		logger.Fatal("Failed to initialize signing key rotation", log.Error(err))
 	}
 
 	observabilitySvc = observability.Initialize()
@@ -157,8 +175,15 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	}
 	exporters = append(exporters, entityTypeExporter)
 
+	// Initialize password policy service
+	passwordPolicyService, err := passwordpolicy.Initialize(hashService, ouService)
+	if err != nil {
+		logger.Fatal("Failed to initialize PasswordPolicyService", log.Error(err))
+	}
+
 	// Initialize entity service
-	entityService, err := entity.Initialize(cacheManager, hashService, entityTypeService, ouService)
+	entityService, err := entity.Initialize(cacheManager, hashService, entityTypeService, ouService,
+		passwordPolicyService)
 	if err != nil {
 		logger.Fatal("Failed to initialize EntityService", log.Error(err))
 	}
@@ -166,8 +191,11 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	// Initialize entity provider
 	entityProvider := entityprovider.InitializeEntityProvider(entityService)
 
+	// Initialize account lockout service
+	lockoutService := lockout.Initialize(observabilitySvc)
+
 	userService, ouUserResolver, userExporter, err := user.Initialize(
-		mux, entityService, ouService, entityTypeService, ouAuthzService,
+		mux, entityService, ouService, entityTypeService, ouAuthzService, lockoutService,
 	)
 	if err != nil {
 		logger.Fatal("Failed to initialize UserService", log.Error(err))
@@ -198,7 +226,10 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 		logger.Fatal("Failed to initialize RoleService", log.Error(err))
 	}
 	exporters = append(exporters, roleExporter)
-	authZService := authz.Initialize(roleService)
+	authZService, err := authz.Initialize(mux, roleService, resourceService, entityProvider, ouService)
+	if err != nil {
+		logger.Fatal("Failed to initialize AuthorizationService", log.Error(err))
+	}
 
 	idpService, idpExporter, err := idp.Initialize(mux)
 	if err != nil {
@@ -211,8 +242,16 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 		logger.Fatal("Failed to initialize template service", log.Error(err))
 	}
 
+	var emailClient email.EmailClientInterface
+	emailClient, err = email.Initialize()
+	if err != nil {
+		logger.Debug("Email client not configured. "+
+			"Email executors will be registered but will not send emails.", log.Error(err))
+		emailClient = nil
+	}
+
 	_, otpService, notifSenderSvc, notificationExporter, err := notification.Initialize(
-		mux, jwtService, templateService)
+		mux, jwtService, templateService, emailClient)
 	if err != nil {
 		logger.Fatal("Failed to initialize NotificationService", log.Error(err))
 	}
@@ -224,6 +263,9 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	// Initialize passkey service
 	passkeyService := passkey.Initialize(entityService)
 
+	// Initialize TOTP service
+	totpService := totp.Initialize(mux, entityService, hashService, configCryptoSvc, lockoutService)
+
 	// Initialize magic link service
 	magicLinkService := magiclink.Initialize(jwtService, entityProvider)
 
@@ -235,17 +277,19 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	oidcAuthnService := authnOIDC.Initialize(oauthAuthnService, jwtService)
 	googleAuthnService := google.Initialize(oidcAuthnService, jwtService)
 	githubAuthnService := github.Initialize(oauthAuthnService)
+	samlAuthnService := authnSAML.Initialize(idpService, entityProvider)
 
 	federatedAuths := map[idp.IDPType]authncm.FederatedAuthenticator{
 		idp.IDPTypeOAuth:  oauthAuthnService,
 		idp.IDPTypeOIDC:   oidcAuthnService,
 		idp.IDPTypeGoogle: googleAuthnService,
 		idp.IDPTypeGitHub: githubAuthnService,
+		idp.IDPTypeSAML:   samlAuthnService,
 	}
 
 	// Initialize authn provider
 	authnProvider := authnprovidermgr.InitializeAuthnProviderManager(entityService, passkeyService, otpCoreService,
-		federatedAuths)
+		federatedAuths, lockoutService)
 
 	// Initialize authentication services.
 	authAssertGen := authnAssert.Initialize()
@@ -258,17 +302,11 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 
 	// Initialize flow and executor services.
 	flowFactory, graphCache := flowcore.Initialize(cacheManager)
-	var emailClient email.EmailClientInterface
-	emailClient, err = email.Initialize()
-	if err != nil {
-		logger.Debug("Email client not configured. "+
-			"EmailExecutor will be registered but will not send emails.", log.Error(err))
-		emailClient = nil
-	}
 	execRegistry := executor.Initialize(flowFactory, ouService, idpService, notifSenderSvc, jwtService, authAssertGen,
 		consentEnforcer, authnProvider, otpCoreService, passkeyService, magicLinkService, authZService,
 		entityTypeService, groupService, roleService, entityProvider, attributeCacheService, emailClient,
-		templateService, oauthAuthnService, oidcAuthnService, githubAuthnService, googleAuthnService)
+		templateService, oauthAuthnService, oidcAuthnService, githubAuthnService, googleAuthnService,
+		samlAuthnService, passwordPolicyService, totpService)
 
 	flowMgtService, flowMgtExporter, err := flowmgt.Initialize(
 		mux, mcpServer, cacheManager, flowFactory, execRegistry, graphCache)
@@ -345,24 +383,36 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 		logger.Fatal("Failed to initialize flow execution service", log.Error(err))
 	}
 
+	// Initialize the SSO session service.
+	sessionService := session.Initialize(mux)
+
 	// Initialize OAuth services.
-	err = oauth.Initialize(mux, applicationService, inboundClientService, authnProvider, jwtService, jweService,
+	revocationService, err := oauth.Initialize(mux, applicationService, inboundClientService, authnProvider, jwtService, jweService,
 		flowExecService, observabilitySvc, pkiService, ouService, attributeCacheService, authZService, entityProvider,
-		resourceService, i18nService)
+		resourceService, i18nService, sessionService)
 	if err != nil {
 		logger.Fatal("Failed to initialize OAuth services", log.Error(err))
 	}
 
+	// Initialize SAML services.
+	if err := saml.Initialize(mux, inboundClientService, flowExecService, jwtService, attributeCacheService,
+		sessionService, pkiService, runtimeCryptoSvc); err != nil {
+		logger.Fatal("Failed to initialize SAML services", log.Error(err))
+	}
+
 	// Register the health service.
 	healthSvc := healthcheckservice.Initialize(dbprovider.GetDBProvider(), dbprovider.GetRedisProvider())
 	services.NewHealthCheckService(mux, healthSvc)
 
-	return jwtService
+	return jwtService, revocationService
 }
 
 // unregisterServices unregisters all services that require cleanup during shutdown.
 func unregisterServices() {
 	observabilitySvc.Shutdown()
+	if keyRotationSvc != nil {
+		keyRotationSvc.Shutdown()
+	}
 }
 
 // buildHashConfig constructs a hash.HashConfig from the server configuration.
@@ -57,6 +57,60 @@ CREATE TABLE "ROLE_ASSIGNMENT" (
     FOREIGN KEY (ROLE_ID) REFERENCES "ROLE" (ID) ON DELETE CASCADE
 );
 
+-- Table to store attribute-based authorization policies.
+CREATE TABLE "AUTHZ_POLICY" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    NAME VARCHAR(255) NOT NULL,
+    DESCRIPTION VARCHAR(512),
+    EFFECT VARCHAR(10) NOT NULL CHECK (EFFECT IN ('permit', 'deny')),
+    DEFINITION JSONB NOT NULL,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    UPDATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (DEPLOYMENT_ID, NAME)
+);
+
+-- Index for deployment isolation on AUTHZ_POLICY
+CREATE INDEX idx_authz_policy_deployment_id ON "AUTHZ_POLICY" (DEPLOYMENT_ID);
+
+-- Table to store relationship tuples of relationship-based authorization.
+CREATE TABLE "AUTHZ_RELATION_TUPLE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    OBJECT_TYPE VARCHAR(64) NOT NULL,
+    OBJECT_ID VARCHAR(255) NOT NULL,
+    RELATION VARCHAR(64) NOT NULL,
+    SUBJECT_TYPE VARCHAR(64) NOT NULL,
+    SUBJECT_ID VARCHAR(255) NOT NULL,
+    SUBJECT_RELATION VARCHAR(64) NOT NULL DEFAULT '',
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (DEPLOYMENT_ID, OBJECT_TYPE, OBJECT_ID, RELATION, SUBJECT_TYPE, SUBJECT_ID, SUBJECT_RELATION)
+);
+
+-- Index for reverse lookups of the objects related to a subject
+CREATE INDEX idx_authz_relation_tuple_subject ON "AUTHZ_RELATION_TUPLE" (DEPLOYMENT_ID, SUBJECT_TYPE, SUBJECT_ID);
+
+-- Table to store managed token signing keys. Private keys are encrypted at rest.
+CREATE TABLE "SIGNING_KEY" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    KEY_ID VARCHAR(36) NOT NULL,
+    ALGORITHM VARCHAR(16) NOT NULL,
+    STATE VARCHAR(16) NOT NULL CHECK (STATE IN ('pending', 'active', 'retiring', 'revoked')),
+    PRIVATE_KEY TEXT NOT NULL,
+    CERTIFICATE TEXT NOT NULL,
+    CREATED_AT TIMESTAMP NOT NULL,
+    ACTIVATED_AT TIMESTAMP,
+    RETIRED_AT TIMESTAMP,
+    REVOKED_AT TIMESTAMP,
+    PRIMARY KEY (DEPLOYMENT_ID, KEY_ID)
+);
+
+-- Index for looking up signing keys by state
+CREATE INDEX idx_signing_key_state ON "SIGNING_KEY" (DEPLOYMENT_ID, STATE);
+
+-- Unique index allowing at most one active and one pending signing key per deployment
+CREATE UNIQUE INDEX idx_signing_key_current_state ON "SIGNING_KEY" (DEPLOYMENT_ID, STATE)
+    WHERE STATE IN ('active', 'pending');
+
 -- Table to store theme configurations.
 CREATE TABLE "THEME" (
     DEPLOYMENT_ID VARCHAR(255) NOT NULL,
@@ -124,6 +178,17 @@ CREATE TABLE "OAUTH_INBOUND_PROFILE" (
     FOREIGN KEY (ENTITY_ID) REFERENCES "INBOUND_CLIENT"(ENTITY_ID) ON DELETE CASCADE
 );
 
+-- Table to store SAML inbound profile for an entity.
+CREATE TABLE "SAML_INBOUND_PROFILE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ENTITY_ID VARCHAR(36) NOT NULL,
+    SP_ENTITY_ID VARCHAR(1024) NOT NULL,
+    SAML_CONFIG JSONB,
+    PRIMARY KEY (ENTITY_ID, DEPLOYMENT_ID),
+    UNIQUE (SP_ENTITY_ID, DEPLOYMENT_ID),
+    FOREIGN KEY (ENTITY_ID) REFERENCES "INBOUND_CLIENT"(ENTITY_ID) ON DELETE CASCADE
+);
+
 -- Table to store identity providers.
 CREATE TABLE "IDP" (
     DEPLOYMENT_ID VARCHAR(255) NOT NULL,
@@ -53,8 +53,20 @@ BEGIN
     DELETE FROM "FLOW_CONTEXT"          WHERE EXPIRY_TIME < v_now;
     DELETE FROM "AUTHORIZATION_CODE"    WHERE EXPIRY_TIME < v_now;
     DELETE FROM "AUTHORIZATION_REQUEST" WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "AUTHORIZATION_RESPONSE" WHERE EXPIRY_TIME < v_now;
     DELETE FROM "WEBAUTHN_SESSION"      WHERE EXPIRY_TIME < v_now;
     DELETE FROM "ATTRIBUTE_CACHE"       WHERE EXPIRY_TIME < v_now;
     DELETE FROM "PAR_REQUEST"           WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "REVOKED_TOKEN"         WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "SSO_SESSION"           WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "DEVICE_AUTHORIZATION"  WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "BACKCHANNEL_AUTH_REQUEST" WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "SAML_AUTH_REQUEST"     WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "LOGIN_ATTEMPT"         WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "OTP_SESSION"           WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "OTP_SEND_RECORD"       WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "CONSUMED_MAGIC_LINK"   WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "CONSUMED_DPOP_PROOF"   WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "REFRESH_TOKEN_GRANT"   WHERE EXPIRY_TIME < v_now;
 END;
 $$;
@@ -29,6 +29,19 @@ CREATE TABLE "AUTHORIZATION_REQUEST" (
 -- Index for expiry time on AUTHORIZATION_REQUEST (supports cleanup and expiry checks)
 CREATE INDEX idx_authorization_request_expiry_time ON "AUTHORIZATION_REQUEST" (EXPIRY_TIME);
 
+-- Table to store OAuth2 authorization responses pending delivery with the form_post response mode
+CREATE TABLE "AUTHORIZATION_RESPONSE" (
+    RESPONSE_ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    RESPONSE_DATA JSONB NOT NULL,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (RESPONSE_ID, DEPLOYMENT_ID)
+);
+
+-- Index for expiry time on AUTHORIZATION_RESPONSE (supports cleanup and expiry checks)
+CREATE INDEX idx_authorization_response_expiry_time ON "AUTHORIZATION_RESPONSE" (EXPIRY_TIME);
+
 -- Table to store flow context
 CREATE TABLE "FLOW_CONTEXT" (
     FLOW_ID VARCHAR(36) NOT NULL,
@@ -78,3 +91,163 @@ CREATE TABLE "PAR_REQUEST" (
 
 -- Index for expiry time on PAR_REQUEST (supports cleanup and expiry checks)
 CREATE INDEX idx_par_request_expiry_time ON "PAR_REQUEST" (EXPIRY_TIME);
+
+-- Table to store revoked OAuth2 token identifiers (RFC 7009)
+CREATE TABLE "REVOKED_TOKEN" (
+    TOKEN_ID VARCHAR(255) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (TOKEN_ID, DEPLOYMENT_ID)
+);
+
+-- Index for expiry time on REVOKED_TOKEN (supports cleanup and expiry checks)
+CREATE INDEX idx_revoked_token_expiry_time ON "REVOKED_TOKEN" (EXPIRY_TIME);
+
+-- Table to store browser SSO sessions
+CREATE TABLE "SSO_SESSION" (
+    SESSION_ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    USER_ID VARCHAR(255) NOT NULL,
+    SESSION_DATA JSONB NOT NULL,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (SESSION_ID, DEPLOYMENT_ID)
+);
+
+-- Index for listing the sessions of a user
+CREATE INDEX idx_sso_session_user_id ON "SSO_SESSION" (USER_ID, DEPLOYMENT_ID);
+
+-- Index for expiry time on SSO_SESSION (supports cleanup and expiry checks)
+CREATE INDEX idx_sso_session_expiry_time ON "SSO_SESSION" (EXPIRY_TIME);
+
+-- Table to store OAuth2 device authorization requests (RFC 8628)
+CREATE TABLE "DEVICE_AUTHORIZATION" (
+    DEVICE_CODE VARCHAR(43) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    USER_CODE VARCHAR(9) NOT NULL,
+    AUTHORIZATION_DATA JSONB NOT NULL,
+    LAST_POLLED_AT TIMESTAMP NULL,
+    POLL_INTERVAL INTEGER NULL,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (DEVICE_CODE, DEPLOYMENT_ID)
+);
+
+-- Index for looking up device authorization requests by user code
+CREATE UNIQUE INDEX idx_device_authorization_user_code ON "DEVICE_AUTHORIZATION" (USER_CODE, DEPLOYMENT_ID);
+
+-- Index for expiry time on DEVICE_AUTHORIZATION (supports cleanup and expiry checks)
+CREATE INDEX idx_device_authorization_expiry_time ON "DEVICE_AUTHORIZATION" (EXPIRY_TIME);
+
+-- Table to store OpenID Connect CIBA backchannel authentication requests
+CREATE TABLE "BACKCHANNEL_AUTH_REQUEST" (
+    AUTH_REQ_ID VARCHAR(43) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    APPROVAL_TOKEN VARCHAR(43) NOT NULL,
+    REQUEST_DATA JSONB NOT NULL,
+    LAST_POLLED_AT TIMESTAMP NULL,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (AUTH_REQ_ID, DEPLOYMENT_ID)
+);
+
+-- Index for looking up backchannel authentication requests by approval token
+CREATE UNIQUE INDEX idx_backchannel_auth_request_approval_token ON "BACKCHANNEL_AUTH_REQUEST" (APPROVAL_TOKEN, DEPLOYMENT_ID);
+
+-- Index for expiry time on BACKCHANNEL_AUTH_REQUEST (supports cleanup and expiry checks)
+CREATE INDEX idx_backchannel_auth_request_expiry_time ON "BACKCHANNEL_AUTH_REQUEST" (EXPIRY_TIME);
+
+-- Table to store in-flight SAML 2.0 authentication requests and pending responses
+CREATE TABLE "SAML_AUTH_REQUEST" (
+    REQUEST_KEY VARCHAR(43) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    REQUEST_DATA JSONB NOT NULL,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (REQUEST_KEY, DEPLOYMENT_ID)
+);
+
+-- Index for expiry time on SAML_AUTH_REQUEST (supports cleanup and expiry checks)
+CREATE INDEX idx_saml_auth_request_expiry_time ON "SAML_AUTH_REQUEST" (EXPIRY_TIME);
+
+-- Table to store failed login attempts for account lockout
+CREATE TABLE "LOGIN_ATTEMPT" (
+    SUBJECT_TYPE VARCHAR(20) NOT NULL,
+    SUBJECT_KEY VARCHAR(255) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    FAILED_ATTEMPTS INTEGER NOT NULL,
+    LOCK_COUNT INTEGER NOT NULL DEFAULT 0,
+    LAST_FAILED_AT TIMESTAMP NOT NULL,
+    LOCKED_UNTIL TIMESTAMP,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (SUBJECT_TYPE, SUBJECT_KEY, DEPLOYMENT_ID)
+);
+
+-- Index for expiry time on LOGIN_ATTEMPT (supports cleanup and expiry checks)
+CREATE INDEX idx_login_attempt_expiry_time ON "LOGIN_ATTEMPT" (EXPIRY_TIME);
+
+-- Table to track the verification attempts and consumption of OTP sessions
+CREATE TABLE "OTP_SESSION" (
+    SESSION_ID VARCHAR(255) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ATTEMPTS INTEGER NOT NULL,
+    CONSUMED_AT TIMESTAMP,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (SESSION_ID, DEPLOYMENT_ID)
+);
+
+-- Index for expiry time on OTP_SESSION (supports cleanup and expiry checks)
+CREATE INDEX idx_otp_session_expiry_time ON "OTP_SESSION" (EXPIRY_TIME);
+
+-- Table to store the OTP sends to a recipient for resend throttling
+CREATE TABLE "OTP_SEND_RECORD" (
+    RECIPIENT_KEY VARCHAR(255) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    SEND_COUNT INTEGER NOT NULL,
+    WINDOW_END TIMESTAMP NOT NULL,
+    LAST_SENT_AT TIMESTAMP NOT NULL,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (RECIPIENT_KEY, DEPLOYMENT_ID)
+);
+
+-- Index for expiry time on OTP_SEND_RECORD (supports cleanup and expiry checks)
+CREATE INDEX idx_otp_send_record_expiry_time ON "OTP_SEND_RECORD" (EXPIRY_TIME);
+
+-- Table to store consumed magic link tokens to prevent their reuse
+CREATE TABLE "CONSUMED_MAGIC_LINK" (
+    TOKEN_ID VARCHAR(255) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (TOKEN_ID, DEPLOYMENT_ID)
+);
+
+-- Index for expiry time on CONSUMED_MAGIC_LINK (supports cleanup and expiry checks)
+CREATE INDEX idx_consumed_magic_link_expiry_time ON "CONSUMED_MAGIC_LINK" (EXPIRY_TIME);
+
+-- Table to store used DPoP proofs to prevent their replay
+CREATE TABLE "CONSUMED_DPOP_PROOF" (
+    PROOF_ID VARCHAR(64) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (PROOF_ID, DEPLOYMENT_ID)
+);
+
+-- Index for expiry time on CONSUMED_DPOP_PROOF (supports cleanup and expiry checks)
+CREATE INDEX idx_consumed_dpop_proof_expiry_time ON "CONSUMED_DPOP_PROOF" (EXPIRY_TIME);
+
+-- Table to store refresh token grants (refresh token families)
+CREATE TABLE "REFRESH_TOKEN_GRANT" (
+    GRANT_ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    CLIENT_ID VARCHAR(255) NOT NULL,
+    USER_ID VARCHAR(255) NOT NULL,
+    CURRENT_TOKEN_ID VARCHAR(36) NOT NULL,
+    GRANT_DATA JSONB NOT NULL,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UPDATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (GRANT_ID, DEPLOYMENT_ID)
+);
+
+-- Index for listing the refresh token grants of a user
+CREATE INDEX idx_refresh_token_grant_user_id ON "REFRESH_TOKEN_GRANT" (USER_ID, DEPLOYMENT_ID);
+
+-- Index for expiry time on REFRESH_TOKEN_GRANT (supports cleanup and expiry checks)
+CREATE INDEX idx_refresh_token_grant_expiry_time ON "REFRESH_TOKEN_GRANT" (EXPIRY_TIME);
@@ -78,3 +78,24 @@ CREATE TABLE "ENTITY_IDENTIFIER" (
 
 -- Index for fast identifier lookups (primary use case for authentication)
 CREATE INDEX idx_entity_identifier_lookup ON "ENTITY_IDENTIFIER" (NAME, VALUE);
+
+-- Table to store the password history of entities for password policy enforcement
+CREATE TABLE "PASSWORD_HISTORY" (
+    DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
+    ENTITY_ID       VARCHAR(36)  NOT NULL,
+    PASSWORD_DATA   TEXT         NOT NULL,
+    UPDATED_AT      TEXT DEFAULT (datetime('now')),
+    PRIMARY KEY (ENTITY_ID, DEPLOYMENT_ID),
+    FOREIGN KEY (ENTITY_ID) REFERENCES "ENTITY" (ID) ON DELETE CASCADE
+);
+
+-- Table to store the TOTP authenticator app credentials of entities
+CREATE TABLE "TOTP_CREDENTIAL" (
+    DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
+    ENTITY_ID       VARCHAR(36)  NOT NULL,
+    TOTP_DATA       TEXT         NOT NULL,
+    VERSION         INTEGER      NOT NULL DEFAULT 0,
+    UPDATED_AT      TEXT DEFAULT (datetime('now')),
+    PRIMARY KEY (ENTITY_ID, DEPLOYMENT_ID),
+    FOREIGN KEY (ENTITY_ID) REFERENCES "ENTITY" (ID) ON DELETE CASCADE
+);
@@ -952,7 +952,9 @@ func requiresClientSecret(cfg *inboundmodel.OAuthConfigWithSecret) bool {
 		oauth2const.TokenEndpointAuthMethodClientSecretPost:
 		return true
 	case oauth2const.TokenEndpointAuthMethodNone,
-		oauth2const.TokenEndpointAuthMethodPrivateKeyJWT:
+		oauth2const.TokenEndpointAuthMethodPrivateKeyJWT,
+		oauth2const.TokenEndpointAuthMethodTLSClientAuth,
+		oauth2const.TokenEndpointAuthMethodSelfSignedTLSClientAuth:
 		return false
 	}
 	// Default to client_secret_basic when unspecified.
@@ -1084,6 +1086,10 @@ func buildOAuthProfile(configs []inboundmodel.InboundAuthConfigWithSecret) *inbo
 		Scopes:                             cfg.Scopes,
 		UserInfo:                           cfg.UserInfo,
 		ScopeClaims:                        cfg.ScopeClaims,
+		TLSClientAuthSubjectDN:             cfg.TLSClientAuthSubjectDN,
+		CertificateBoundAccessTokens:       cfg.CertificateBoundAccessTokens,
+		DPoPBoundAccessTokens:              cfg.DPoPBoundAccessTokens,
+		RequireSignedRequestObject:         cfg.RequireSignedRequestObject,
 	}
 }
 
@@ -1107,6 +1113,10 @@ func oauthProfileToComplete(clientID string, p *inboundmodel.OAuthProfile) *inbo
 		Scopes:                             p.Scopes,
 		UserInfo:                           p.UserInfo,
 		ScopeClaims:                        p.ScopeClaims,
+		TLSClientAuthSubjectDN:             p.TLSClientAuthSubjectDN,
+		CertificateBoundAccessTokens:       p.CertificateBoundAccessTokens,
+		DPoPBoundAccessTokens:              p.DPoPBoundAccessTokens,
+		RequireSignedRequestObject:         p.RequireSignedRequestObject,
 	}
 }
 
@@ -1130,6 +1140,10 @@ func oauthProfileToConfig(clientID string, p *inboundmodel.OAuthProfile) *inboun
 		Scopes:                             p.Scopes,
 		UserInfo:                           p.UserInfo,
 		ScopeClaims:                        p.ScopeClaims,
+		TLSClientAuthSubjectDN:             p.TLSClientAuthSubjectDN,
+		CertificateBoundAccessTokens:       p.CertificateBoundAccessTokens,
+		DPoPBoundAccessTokens:              p.DPoPBoundAccessTokens,
+		RequireSignedRequestObject:         p.RequireSignedRequestObject,
 	}
 }
 
@@ -1325,6 +1339,9 @@ func translateOAuthValidationError(err error) *serviceerror.ServiceError {
 		errors.Is(err, inboundclient.ErrOAuthPrivateKeyJWTRequiresCertificate),
 		errors.Is(err, inboundclient.ErrOAuthPrivateKeyJWTCannotHaveClientSecret),
 		errors.Is(err, inboundclient.ErrOAuthClientSecretCannotHaveCertificate),
+		errors.Is(err, inboundclient.ErrOAuthTLSClientAuthRequiresSubjectDN),
+		errors.Is(err, inboundclient.ErrOAuthSelfSignedTLSClientAuthRequiresCertificate),
+		errors.Is(err, inboundclient.ErrOAuthTLSClientAuthCannotHaveClientSecret),
 		errors.Is(err, inboundclient.ErrOAuthNoneAuthRequiresPublicClient),
 		errors.Is(err, inboundclient.ErrOAuthNoneAuthCannotHaveCertOrSecret),
 		errors.Is(err, inboundclient.ErrOAuthClientCredentialsCannotUseNoneAuth),
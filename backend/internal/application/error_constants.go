@@ -474,4 +474,31 @@ var (
 			DefaultValue: "An application may have at most one inbound auth config per protocol",
 		},
 	}
+	// ErrorInvalidSAMLConfiguration is returned when the SAML inbound auth config is invalid.
+	ErrorInvalidSAMLConfiguration = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APP-1035",
+		Error: core.I18nMessage{
+			Key:          "error.applicationservice.invalid_saml_configuration",
+			DefaultValue: "Invalid SAML configuration",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.applicationservice.invalid_saml_configuration_description",
+			DefaultValue: "The provided SAML inbound authentication configuration is invalid",
+		},
+	}
+	// ErrorSAMLEntityIDAlreadyExists is returned when the SAML service provider entity ID is already
+	// registered by another application.
+	ErrorSAMLEntityIDAlreadyExists = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APP-1036",
+		Error: core.I18nMessage{
+			Key:          "error.applicationservice.saml_entity_id_already_exists",
+			DefaultValue: "SAML entity ID already exists",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.applicationservice.saml_entity_id_already_exists_description",
+			DefaultValue: "Another application is already registered with the provided SAML entity ID",
+		},
+	}
 )
@@ -191,35 +191,28 @@ func (ah *applicationHandler) HandleApplicationGetRequest(w http.ResponseWriter,
 		Metadata:  appDTO.Metadata,
 	}
 
-	// TODO: Need to refactor when supporting other/multiple inbound auth types.
 	if len(appDTO.InboundAuthConfig) > 0 {
-		if appDTO.InboundAuthConfig[0].Type != inboundmodel.OAuthInboundAuthType {
-			logger.Error("Unsupported inbound authentication type returned",
-				log.String("type", string(appDTO.InboundAuthConfig[0].Type)))
-
-			errResp := apierror.ErrorResponse{
-				Code:        serviceerror.InternalServerError.Code,
-				Message:     serviceerror.InternalServerError.Error,
-				Description: serviceerror.InternalServerError.ErrorDescription,
+		returnInboundAuthConfigs := make([]inboundmodel.InboundAuthConfig, 0, len(appDTO.InboundAuthConfig))
+		for _, config := range appDTO.InboundAuthConfig {
+			if config.Type == inboundmodel.SAMLInboundAuthType && config.SAMLConfig != nil {
+				returnInboundAuthConfigs = append(returnInboundAuthConfigs, inboundmodel.InboundAuthConfig{
+					Type:       config.Type,
+					SAMLConfig: config.SAMLConfig,
+				})
+				continue
 			}
-			sysutils.WriteErrorResponse(w, http.StatusInternalServerError, errResp)
-			return
-		}
+			if config.Type != inboundmodel.OAuthInboundAuthType {
+				logger.Error("Unsupported inbound authentication type returned",
+					log.String("type", string(config.Type)))
 
-		if appDTO.InboundAuthConfig[0].OAuthConfig == nil {
-			logger.Error("OAuth application configuration is nil")
-
-			errResp := apierror.ErrorResponse{
-				Code:        serviceerror.InternalServerError.Code,
-				Message:     serviceerror.InternalServerError.Error,
-				Description: serviceerror.InternalServerError.ErrorDescription,
+				errResp := apierror.ErrorResponse{
+					Code:        serviceerror.InternalServerError.Code,
+					Message:     serviceerror.InternalServerError.Error,
+					Description: serviceerror.InternalServerError.ErrorDescription,
+				}
+				sysutils.WriteErrorResponse(w, http.StatusInternalServerError, errResp)
+				return
 			}
-			sysutils.WriteErrorResponse(w, http.StatusInternalServerError, errResp)
-			return
-		}
-
-		returnInboundAuthConfigs := make([]inboundmodel.InboundAuthConfig, 0, len(appDTO.InboundAuthConfig))
-		for _, config := range appDTO.InboundAuthConfig {
 			if config.OAuthConfig == nil {
 				logger.Error("OAuth application configuration is nil")
 				errResp := apierror.ErrorResponse{
@@ -257,14 +250,26 @@ func (ah *applicationHandler) HandleApplicationGetRequest(w http.ResponseWriter,
 				ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 				Certificate:                        config.OAuthConfig.Certificate,
 				AcrValues:                          config.OAuthConfig.AcrValues,
+				PostLogoutRedirectURIs:             config.OAuthConfig.PostLogoutRedirectURIs,
+				BackChannelLogoutURI:               config.OAuthConfig.BackChannelLogoutURI,
+				FrontChannelLogoutURI:              config.OAuthConfig.FrontChannelLogoutURI,
+				BackchannelTokenDeliveryMode:       config.OAuthConfig.BackchannelTokenDeliveryMode,
+				BackchannelNotificationEndpoint:    config.OAuthConfig.BackchannelNotificationEndpoint,
+				JWTBearerAllowedIssuers:            config.OAuthConfig.JWTBearerAllowedIssuers,
+				TLSClientAuthSubjectDN:             config.OAuthConfig.TLSClientAuthSubjectDN,
+				CertificateBoundAccessTokens:       config.OAuthConfig.CertificateBoundAccessTokens,
+				DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
+				RequireSignedRequestObject:         config.OAuthConfig.RequireSignedRequestObject,
 			}
 			returnInboundAuthConfigs = append(returnInboundAuthConfigs, inboundmodel.InboundAuthConfig{
 				Type:        config.Type,
 				OAuthConfig: &oAuthAppConfig,
 			})
+			if returnApp.ClientID == "" {
+				returnApp.ClientID = config.OAuthConfig.ClientID
+			}
 		}
 		returnApp.InboundAuthConfig = returnInboundAuthConfigs
-		returnApp.ClientID = appDTO.InboundAuthConfig[0].OAuthConfig.ClientID
 	}
 
 	sysutils.WriteSuccessResponse(w, http.StatusOK, returnApp)
@@ -395,24 +400,24 @@ func (ah *applicationHandler) HandleApplicationDeleteRequest(w http.ResponseWrit
 	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
 }
 
-// processInboundAuthConfig prepares the response for OAuth app configuration.
+// processInboundAuthConfig prepares the response for the OAuth and SAML app configurations.
 func (ah *applicationHandler) processInboundAuthConfig(logger *log.Logger, appDTO *model.ApplicationDTO,
 	returnApp *model.ApplicationCompleteResponse) bool {
 	if len(appDTO.InboundAuthConfig) > 0 {
-		if appDTO.InboundAuthConfig[0].Type != inboundmodel.OAuthInboundAuthType {
-			logger.Error("Unsupported inbound authentication type returned",
-				log.String("type", string(appDTO.InboundAuthConfig[0].Type)))
-
-			return false
-		}
-
-		if appDTO.InboundAuthConfig[0].OAuthConfig == nil {
-			logger.Error("OAuth application configuration is nil")
-			return false
-		}
-
 		returnInboundAuthConfigs := make([]inboundmodel.InboundAuthConfigWithSecret, 0, len(appDTO.InboundAuthConfig))
 		for _, config := range appDTO.InboundAuthConfig {
+			if config.Type == inboundmodel.SAMLInboundAuthType && config.SAMLConfig != nil {
+				returnInboundAuthConfigs = append(returnInboundAuthConfigs, inboundmodel.InboundAuthConfigWithSecret{
+					Type:       config.Type,
+					SAMLConfig: config.SAMLConfig,
+				})
+				continue
+			}
+			if config.Type != inboundmodel.OAuthInboundAuthType {
+				logger.Error("Unsupported inbound authentication type returned",
+					log.String("type", string(config.Type)))
+				return false
+			}
 			if config.OAuthConfig == nil {
 				logger.Error("OAuth application configuration is nil")
 				return false
@@ -445,14 +450,26 @@ func (ah *applicationHandler) processInboundAuthConfig(logger *log.Logger, appDT
 				ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 				Certificate:                        config.OAuthConfig.Certificate,
 				AcrValues:                          config.OAuthConfig.AcrValues,
+				PostLogoutRedirectURIs:             config.OAuthConfig.PostLogoutRedirectURIs,
+				BackChannelLogoutURI:               config.OAuthConfig.BackChannelLogoutURI,
+				FrontChannelLogoutURI:              config.OAuthConfig.FrontChannelLogoutURI,
+				BackchannelTokenDeliveryMode:       config.OAuthConfig.BackchannelTokenDeliveryMode,
+				BackchannelNotificationEndpoint:    config.OAuthConfig.BackchannelNotificationEndpoint,
+				JWTBearerAllowedIssuers:            config.OAuthConfig.JWTBearerAllowedIssuers,
+				TLSClientAuthSubjectDN:             config.OAuthConfig.TLSClientAuthSubjectDN,
+				CertificateBoundAccessTokens:       config.OAuthConfig.CertificateBoundAccessTokens,
+				DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
+				RequireSignedRequestObject:         config.OAuthConfig.RequireSignedRequestObject,
 			}
 			returnInboundAuthConfigs = append(returnInboundAuthConfigs, inboundmodel.InboundAuthConfigWithSecret{
 				Type:        config.Type,
 				OAuthConfig: &oAuthAppConfig,
 			})
+			if returnApp.ClientID == "" {
+				returnApp.ClientID = config.OAuthConfig.ClientID
+			}
 		}
 		returnApp.InboundAuthConfig = returnInboundAuthConfigs
-		returnApp.ClientID = appDTO.InboundAuthConfig[0].OAuthConfig.ClientID
 	}
 
 	return true
@@ -499,6 +516,13 @@ func (ah *applicationHandler) processInboundAuthConfigFromRequest(
 
 	inboundAuthConfigDTOs := make([]inboundmodel.InboundAuthConfigWithSecret, 0)
 	for _, config := range configs {
+		if config.Type == inboundmodel.SAMLInboundAuthType && config.SAMLConfig != nil {
+			inboundAuthConfigDTOs = append(inboundAuthConfigDTOs, inboundmodel.InboundAuthConfigWithSecret{
+				Type:       config.Type,
+				SAMLConfig: config.SAMLConfig,
+			})
+			continue
+		}
 		if config.Type != inboundmodel.OAuthInboundAuthType || config.OAuthConfig == nil {
 			continue
 		}
@@ -521,6 +545,16 @@ func (ah *applicationHandler) processInboundAuthConfigFromRequest(
 				ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 				Certificate:                        config.OAuthConfig.Certificate,
 				AcrValues:                          config.OAuthConfig.AcrValues,
+				PostLogoutRedirectURIs:             config.OAuthConfig.PostLogoutRedirectURIs,
+				BackChannelLogoutURI:               config.OAuthConfig.BackChannelLogoutURI,
+				FrontChannelLogoutURI:              config.OAuthConfig.FrontChannelLogoutURI,
+				BackchannelTokenDeliveryMode:       config.OAuthConfig.BackchannelTokenDeliveryMode,
+				BackchannelNotificationEndpoint:    config.OAuthConfig.BackchannelNotificationEndpoint,
+				JWTBearerAllowedIssuers:            config.OAuthConfig.JWTBearerAllowedIssuers,
+				TLSClientAuthSubjectDN:             config.OAuthConfig.TLSClientAuthSubjectDN,
+				CertificateBoundAccessTokens:       config.OAuthConfig.CertificateBoundAccessTokens,
+				DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
+				RequireSignedRequestObject:         config.OAuthConfig.RequireSignedRequestObject,
 			},
 		}
 		inboundAuthConfigDTOs = append(inboundAuthConfigDTOs, inboundAuthConfigDTO)
@@ -1327,6 +1327,55 @@ func (suite *HandlerTestSuite) TestProcessInboundAuthConfigFromRequest_Unsupport
 	assert.Len(suite.T(), result, 0) // Should skip unsupported types
 }
 
+func (suite *HandlerTestSuite) TestProcessInboundAuthConfigFromRequest_SAMLConfig() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	samlConfig := &inboundmodel.SAMLProfile{
+		EntityID:                     "https://sp.example.com",
+		AssertionConsumerServiceURLs: []string{"https://sp.example.com/acs"},
+	}
+	configs := []inboundmodel.InboundAuthConfigWithSecret{
+		{Type: inboundmodel.SAMLInboundAuthType, SAMLConfig: samlConfig},
+	}
+
+	result := handler.processInboundAuthConfigFromRequest(configs)
+
+	assert.Len(suite.T(), result, 1)
+	assert.Equal(suite.T(), inboundmodel.SAMLInboundAuthType, result[0].Type)
+	assert.Equal(suite.T(), samlConfig, result[0].SAMLConfig)
+}
+
+func (suite *HandlerTestSuite) TestHandleApplicationGetRequest_SAMLConfig() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	expectedApp := &model.Application{
+		ID:   "test-app-id",
+		Name: "TestApp",
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+			{
+				Type: inboundmodel.SAMLInboundAuthType,
+				SAMLConfig: &inboundmodel.SAMLProfile{
+					EntityID:                     "https://sp.example.com",
+					AssertionConsumerServiceURLs: []string{"https://sp.example.com/acs"},
+				},
+			},
+		},
+	}
+	mockService.On("GetApplication", mock.Anything, "test-app-id").Return(expectedApp, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/applications/test-app-id", nil)
+	req.SetPathValue("id", "test-app-id")
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationGetRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), `"type":"saml2"`)
+	assert.Contains(suite.T(), w.Body.String(), `"entityId":"https://sp.example.com"`)
+}
+
 func (suite *HandlerTestSuite) TestProcessInboundAuthConfigFromRequest_NilOAuthConfig() {
 	mockService := NewApplicationServiceInterfaceMock(suite.T())
 	handler := newApplicationHandler(mockService)
@@ -155,6 +155,23 @@ func (as *applicationService) CreateApplication(ctx context.Context, app *model.
 		return nil, &serviceerror.InternalServerError
 	}
 
+	samlProfile := getSAMLProfile(app)
+	if samlProfile != nil {
+		if err := as.inboundClientService.SyncSAMLProfile(ctx, appID, samlProfile); err != nil {
+			// Compensate: remove the config and entity since the SAML profile could not be stored.
+			if delErr := as.inboundClientService.DeleteInboundClient(ctx, appID); delErr != nil {
+				as.logger.Error("Failed to delete inbound client during compensation",
+					log.String("appID", appID), log.Error(delErr))
+			}
+			as.deleteEntityCompensation(appID)
+			if svcErr := translateInboundClientError(err); svcErr != nil {
+				return nil, svcErr
+			}
+			as.logger.Error("Failed to create SAML profile", log.Error(err), log.String("appID", appID))
+			return nil, &serviceerror.InternalServerError
+		}
+	}
+
 	appForReturn := *app
 	appForReturn.AuthFlowID = inboundClient.AuthFlowID
 	appForReturn.RegistrationFlowID = inboundClient.RegistrationFlowID
@@ -174,8 +191,10 @@ func (as *applicationService) CreateApplication(ctx context.Context, app *model.
 			oauthCfg.Certificate = nil
 		}
 	}
-	return buildReturnApplicationDTO(appID, &appForReturn, inboundClient.Assertion, processedDTO.Metadata,
-		inboundAuthConfig, oauthToken, userInfo, scopeClaims), nil
+	returnApp := buildReturnApplicationDTO(appID, &appForReturn, inboundClient.Assertion, processedDTO.Metadata,
+		inboundAuthConfig, oauthToken, userInfo, scopeClaims)
+	appendSAMLInboundAuthConfig(returnApp, samlProfile)
+	return returnApp, nil
 }
 
 // ValidateApplication validates the application data transfer object.
@@ -391,6 +410,17 @@ func (as *applicationService) UpdateApplication(ctx context.Context, appID strin
 		return nil, &serviceerror.InternalServerError
 	}
 
+	samlProfile := getSAMLProfile(app)
+	if samlProfile != nil || hasSAMLInboundAuthConfig(existingApp.InboundAuthConfig) {
+		if err := as.inboundClientService.SyncSAMLProfile(ctx, appID, samlProfile); err != nil {
+			if svcErr := translateInboundClientError(err); svcErr != nil {
+				return nil, svcErr
+			}
+			as.logger.Error("Failed to update SAML profile", log.Error(err), log.String("appID", appID))
+			return nil, &serviceerror.InternalServerError
+		}
+	}
+
 	if svcErr := as.updateEntityDataForApplicationUpdate(appID, app, inboundAuthConfig); svcErr != nil {
 		return nil, svcErr
 	}
@@ -419,8 +449,10 @@ func (as *applicationService) UpdateApplication(ctx context.Context, appID strin
 			inboundAuthConfig.OAuthConfig.Certificate = nil
 		}
 	}
-	return buildReturnApplicationDTO(appID, &appForReturn, inboundClient.Assertion, processedDTO.Metadata,
-		inboundAuthConfig, oauthToken, userInfo, scopeClaims), nil
+	returnApp := buildReturnApplicationDTO(appID, &appForReturn, inboundClient.Assertion, processedDTO.Metadata,
+		inboundAuthConfig, oauthToken, userInfo, scopeClaims)
+	appendSAMLInboundAuthConfig(returnApp, samlProfile)
+	return returnApp, nil
 }
 
 func (as *applicationService) updateEntityDataForApplicationUpdate(
@@ -497,7 +529,9 @@ func appRequiresClientSecret(cfg *inboundmodel.OAuthConfigWithSecret) bool {
 		oauth2const.TokenEndpointAuthMethodClientSecretPost:
 		return true
 	case oauth2const.TokenEndpointAuthMethodNone,
-		oauth2const.TokenEndpointAuthMethodPrivateKeyJWT:
+		oauth2const.TokenEndpointAuthMethodPrivateKeyJWT,
+		oauth2const.TokenEndpointAuthMethodTLSClientAuth,
+		oauth2const.TokenEndpointAuthMethodSelfSignedTLSClientAuth:
 		return false
 	}
 	// Default to requiring a secret when method is unspecified.
@@ -601,7 +635,19 @@ func (as *applicationService) getApplication(
 		return nil, &serviceerror.InternalServerError
 	}
 
+	samlProfile, err := as.inboundClientService.GetSAMLProfileByEntityID(ctx, appID)
+	if err != nil && !errors.Is(err, inboundclient.ErrInboundClientNotFound) {
+		as.logger.Error("Failed to get SAML profile for application", log.String("appID", appID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
 	dto := toProcessedDTO(entity, inboundClient, oauthProfile)
+	if samlProfile != nil {
+		dto.InboundAuthConfig = append(dto.InboundAuthConfig, inboundmodel.InboundAuthConfigProcessed{
+			Type:       inboundmodel.SAMLInboundAuthType,
+			SAMLConfig: samlProfile,
+		})
+	}
 	return dto, nil
 }
 
@@ -789,6 +835,16 @@ func buildOAuthProfileFromProcessed(inboundAuth inboundmodel.InboundAuthConfigPr
 		UserInfo:                           oa.UserInfo,
 		Certificate:                        oa.Certificate,
 		AcrValues:                          oa.AcrValues,
+		PostLogoutRedirectURIs:             oa.PostLogoutRedirectURIs,
+		BackChannelLogoutURI:               oa.BackChannelLogoutURI,
+		FrontChannelLogoutURI:              oa.FrontChannelLogoutURI,
+		BackchannelTokenDeliveryMode:       oa.BackchannelTokenDeliveryMode,
+		BackchannelNotificationEndpoint:    oa.BackchannelNotificationEndpoint,
+		JWTBearerAllowedIssuers:            oa.JWTBearerAllowedIssuers,
+		TLSClientAuthSubjectDN:             oa.TLSClientAuthSubjectDN,
+		CertificateBoundAccessTokens:       oa.CertificateBoundAccessTokens,
+		DPoPBoundAccessTokens:              oa.DPoPBoundAccessTokens,
+		RequireSignedRequestObject:         oa.RequireSignedRequestObject,
 	}
 }
 
@@ -870,6 +926,47 @@ func getOAuthInboundAuthConfigProcessedDTO(
 	return nil
 }
 
+// getSAMLInboundAuthConfigDTO returns the first SAML InboundAuthConfigDTO, or nil.
+func getSAMLInboundAuthConfigDTO(
+	configs []inboundmodel.InboundAuthConfigWithSecret,
+) *inboundmodel.InboundAuthConfigWithSecret {
+	for i := range configs {
+		if configs[i].Type == inboundmodel.SAMLInboundAuthType {
+			return &configs[i]
+		}
+	}
+	return nil
+}
+
+// getSAMLProfile returns the SAML profile supplied in the application, or nil.
+func getSAMLProfile(app *model.ApplicationDTO) *inboundmodel.SAMLProfile {
+	if samlAuthConfig := getSAMLInboundAuthConfigDTO(app.InboundAuthConfig); samlAuthConfig != nil {
+		return samlAuthConfig.SAMLConfig
+	}
+	return nil
+}
+
+// hasSAMLInboundAuthConfig reports whether the processed inbound auth configs include a SAML profile.
+func hasSAMLInboundAuthConfig(configs []inboundmodel.InboundAuthConfigProcessed) bool {
+	for i := range configs {
+		if configs[i].Type == inboundmodel.SAMLInboundAuthType && configs[i].SAMLConfig != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// appendSAMLInboundAuthConfig adds the SAML profile as an inbound auth entry of the returned application.
+func appendSAMLInboundAuthConfig(returnApp *model.ApplicationDTO, samlProfile *inboundmodel.SAMLProfile) {
+	if samlProfile == nil {
+		return
+	}
+	returnApp.InboundAuthConfig = append(returnApp.InboundAuthConfig, inboundmodel.InboundAuthConfigWithSecret{
+		Type:       inboundmodel.SAMLInboundAuthType,
+		SAMLConfig: samlProfile,
+	})
+}
+
 func (as *applicationService) validateApplicationForUpdate(
 	ctx context.Context, appID string, app *model.ApplicationDTO) (
 	*model.ApplicationProcessedDTO, *inboundmodel.InboundAuthConfigWithSecret, *serviceerror.ServiceError) {
@@ -928,22 +1025,49 @@ func (as *applicationService) validateApplicationFields(
 	if app.LogoURL != "" && !sysutils.IsValidLogoURI(app.LogoURL) {
 		return &ErrorInvalidLogoURL
 	}
-	// Reject requests with more than one OAuth-typed inbound auth entry — at most one
+	// Reject requests with more than one inbound auth entry of the same type — at most one
 	// inbound auth config per protocol per application is allowed.
 	isOAuthConfig := false
+	isSAMLConfig := false
 	for i := range app.InboundAuthConfig {
-		if app.InboundAuthConfig[i].Type != inboundmodel.OAuthInboundAuthType {
-			continue
-		}
-		if isOAuthConfig {
-			return &ErrorMultipleOAuthConfigs
+		switch app.InboundAuthConfig[i].Type {
+		case inboundmodel.OAuthInboundAuthType:
+			if isOAuthConfig {
+				return &ErrorMultipleOAuthConfigs
+			}
+			isOAuthConfig = true
+		case inboundmodel.SAMLInboundAuthType:
+			if isSAMLConfig {
+				return &ErrorInvalidInboundAuthConfig
+			}
+			isSAMLConfig = true
 		}
-		isOAuthConfig = true
+	}
+	if svcErr := validateSAMLInboundAuthConfig(app); svcErr != nil {
+		return svcErr
 	}
 	as.validateConsentConfig(app)
 	return nil
 }
 
+// validateSAMLInboundAuthConfig validates the SAML inbound auth config of the application, if any.
+func validateSAMLInboundAuthConfig(app *model.ApplicationDTO) *serviceerror.ServiceError {
+	samlAuthConfig := getSAMLInboundAuthConfigDTO(app.InboundAuthConfig)
+	if samlAuthConfig == nil {
+		return nil
+	}
+	if samlAuthConfig.SAMLConfig == nil {
+		return &ErrorInvalidInboundAuthConfig
+	}
+	if err := inboundclient.ValidateSAMLProfile(samlAuthConfig.SAMLConfig); err != nil {
+		if svcErr := translateSAMLValidationError(err); svcErr != nil {
+			return svcErr
+		}
+		return &ErrorInvalidSAMLConfiguration
+	}
+	return nil
+}
+
 // validateConsentConfig validates the consent configuration for the application.
 func (as *applicationService) validateConsentConfig(appDTO *model.ApplicationDTO) {
 	if appDTO.LoginConsent == nil {
@@ -971,6 +1095,10 @@ func validateOAuthParamsForCreateAndUpdate(app *model.ApplicationDTO) (*inboundm
 		return nil, svcErr
 	}
 	if inboundAuthConfig == nil {
+		// A SAML-only application carries no OAuth config.
+		if getSAMLInboundAuthConfigDTO(app.InboundAuthConfig) != nil {
+			return nil, nil
+		}
 		return nil, &ErrorInvalidInboundAuthConfig
 	}
 	if inboundAuthConfig.OAuthConfig == nil {
@@ -979,7 +1107,13 @@ func validateOAuthParamsForCreateAndUpdate(app *model.ApplicationDTO) (*inboundm
 
 	oauthAppConfig := inboundAuthConfig.OAuthConfig
 
-	if len(oauthAppConfig.GrantTypes) == 0 {
+	// Clients using only the id_token response type are served entirely by the authorization endpoint,
+	// hence the authorization_code grant is not defaulted for them.
+	issuesCode := len(oauthAppConfig.ResponseTypes) == 0 || slices.ContainsFunc(oauthAppConfig.ResponseTypes,
+		func(responseType oauth2const.ResponseType) bool {
+			return responseType.Includes(oauth2const.ResponseTypeCode)
+		})
+	if len(oauthAppConfig.GrantTypes) == 0 && issuesCode {
 		oauthAppConfig.GrantTypes = []oauth2const.GrantType{oauth2const.GrantTypeAuthorizationCode}
 	}
 	if len(oauthAppConfig.ResponseTypes) == 0 {
@@ -1027,6 +1161,31 @@ func translateOAuthValidationError(err error) *serviceerror.ServiceError {
 			Key:          "error.applicationservice.redirect_uri_fragment_not_allowed_description",
 			DefaultValue: "Redirect URIs must not contain a fragment component",
 		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidPostLogoutRedirectURI):
+		return serviceerror.CustomServiceError(ErrorInvalidRedirectURI, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_post_logout_redirect_uri_description",
+			DefaultValue: "Post logout redirect URIs must be absolute URIs without wildcards or fragments",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidLogoutURI):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_logout_uri_description",
+			DefaultValue: "Logout URIs must be absolute URIs without wildcards or fragments",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidBackchannelConfig):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_backchannel_config_description",
+			DefaultValue: "Backchannel delivery mode must be 'poll' or 'ping'; 'ping' requires a notification URI",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidRefreshTokenConfig):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_refresh_token_config_description",
+			DefaultValue: "Refresh token validity periods must be non-negative, with the idle period within the absolute one",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidJWTBearerIssuer):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_jwt_bearer_issuer_description",
+			DefaultValue: "JWT bearer allowed issuers must be configured trusted issuers",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthAuthCodeRequiresRedirectURIs):
 		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
 			Key:          "error.applicationservice.auth_code_requires_redirect_uris_description",
@@ -1058,8 +1217,9 @@ func translateOAuthValidationError(err error) *serviceerror.ServiceError {
 		})
 	case errors.Is(err, inboundclient.ErrOAuthResponseTypesRequireAuthCode):
 		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
-			Key:          "error.applicationservice.response_types_require_authorization_code_description",
-			DefaultValue: "Response types can only be configured with the authorization_code grant type",
+			Key: "error.applicationservice.response_types_require_authorization_code_description",
+			DefaultValue: "Response types issuing an authorization code can only be configured with the " +
+				"authorization_code grant type",
 		})
 	case errors.Is(err, inboundclient.ErrOAuthInvalidTokenEndpointAuthMethod):
 		return &ErrorInvalidTokenEndpointAuthMethod
@@ -1073,6 +1233,21 @@ func translateOAuthValidationError(err error) *serviceerror.ServiceError {
 			Key:          "error.applicationservice.private_key_jwt_cannot_have_client_secret_description",
 			DefaultValue: "private_key_jwt authentication method cannot have a client secret",
 		})
+	case errors.Is(err, inboundclient.ErrOAuthTLSClientAuthRequiresSubjectDN):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.tls_client_auth_requires_subject_dn_description",
+			DefaultValue: "tls_client_auth authentication method requires a certificate subject DN",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthSelfSignedTLSClientAuthRequiresCertificate):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.self_signed_tls_client_auth_requires_certificate_description",
+			DefaultValue: "self_signed_tls_client_auth authentication method requires a certificate",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthTLSClientAuthCannotHaveClientSecret):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.tls_client_auth_cannot_have_client_secret_description",
+			DefaultValue: "Mutual-TLS authentication methods cannot have a client secret",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthClientSecretCannotHaveCertificate):
 		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
 			Key:          "error.applicationservice.client_secret_cannot_have_certificate_description",
@@ -1246,6 +1421,9 @@ func translateInboundClientError(err error) *serviceerror.ServiceError {
 	if svcErr := translateOAuthValidationError(err); svcErr != nil {
 		return svcErr
 	}
+	if svcErr := translateSAMLValidationError(err); svcErr != nil {
+		return svcErr
+	}
 	var consentErr *inboundclient.ConsentSyncError
 	if errors.As(err, &consentErr) {
 		return translateConsentSyncError(consentErr)
@@ -1253,6 +1431,46 @@ func translateInboundClientError(err error) *serviceerror.ServiceError {
 	return nil
 }
 
+// translateSAMLValidationError maps SAML profile validation errors to application service errors.
+func translateSAMLValidationError(err error) *serviceerror.ServiceError {
+	switch {
+	case errors.Is(err, inboundclient.ErrSAMLEntityIDAlreadyExists):
+		return &ErrorSAMLEntityIDAlreadyExists
+	case errors.Is(err, inboundclient.ErrSAMLMissingEntityID):
+		return serviceerror.CustomServiceError(ErrorInvalidSAMLConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.saml_missing_entity_id_description",
+			DefaultValue: "SAML service provider entity ID is required",
+		})
+	case errors.Is(err, inboundclient.ErrSAMLInvalidACSURL):
+		return serviceerror.CustomServiceError(ErrorInvalidSAMLConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.saml_invalid_acs_url_description",
+			DefaultValue: "At least one assertion consumer service URL is required and each must be an absolute URI",
+		})
+	case errors.Is(err, inboundclient.ErrSAMLInvalidSingleLogoutURL):
+		return serviceerror.CustomServiceError(ErrorInvalidSAMLConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.saml_invalid_single_logout_url_description",
+			DefaultValue: "Single logout URL must be an absolute URI without wildcards",
+		})
+	case errors.Is(err, inboundclient.ErrSAMLUnsupportedNameIDFormat):
+		return serviceerror.CustomServiceError(ErrorInvalidSAMLConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.saml_unsupported_nameid_format_description",
+			DefaultValue: "The provided NameID format is not supported",
+		})
+	case errors.Is(err, inboundclient.ErrSAMLInvalidCertificate):
+		return serviceerror.CustomServiceError(ErrorInvalidSAMLConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.saml_invalid_certificate_description",
+			DefaultValue: "The service provider certificate must be a PEM encoded X.509 certificate",
+		})
+	case errors.Is(err, inboundclient.ErrSAMLSignedRequestsRequireCertificate):
+		return serviceerror.CustomServiceError(ErrorInvalidSAMLConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.saml_signed_requests_require_certificate_description",
+			DefaultValue: "A service provider certificate is required when signed requests are enforced",
+		})
+	default:
+		return nil
+	}
+}
+
 func translateConsentSyncError(err *inboundclient.ConsentSyncError) *serviceerror.ServiceError {
 	if err.IsClientError() {
 		return serviceerror.CustomServiceError(ErrorConsentSyncFailed, core.I18nMessage{
@@ -1501,9 +1719,25 @@ func buildApplicationResponse(dto *model.ApplicationProcessedDTO) *model.Applica
 					UserInfo:                           oauthAppConfig.UserInfo,
 					ScopeClaims:                        oauthAppConfig.ScopeClaims,
 					AcrValues:                          oauthAppConfig.AcrValues,
+					PostLogoutRedirectURIs:             oauthAppConfig.PostLogoutRedirectURIs,
+					BackChannelLogoutURI:               oauthAppConfig.BackChannelLogoutURI,
+					FrontChannelLogoutURI:              oauthAppConfig.FrontChannelLogoutURI,
+					BackchannelTokenDeliveryMode:       oauthAppConfig.BackchannelTokenDeliveryMode,
+					BackchannelNotificationEndpoint:    oauthAppConfig.BackchannelNotificationEndpoint,
+					JWTBearerAllowedIssuers:            oauthAppConfig.JWTBearerAllowedIssuers,
+					TLSClientAuthSubjectDN:             oauthAppConfig.TLSClientAuthSubjectDN,
+					CertificateBoundAccessTokens:       oauthAppConfig.CertificateBoundAccessTokens,
+					DPoPBoundAccessTokens:              oauthAppConfig.DPoPBoundAccessTokens,
+					RequireSignedRequestObject:         oauthAppConfig.RequireSignedRequestObject,
 				},
 			})
 		}
+		if config.Type == inboundmodel.SAMLInboundAuthType && config.SAMLConfig != nil {
+			inboundAuthConfigs = append(inboundAuthConfigs, inboundmodel.InboundAuthConfigWithSecret{
+				Type:       inboundmodel.SAMLInboundAuthType,
+				SAMLConfig: config.SAMLConfig,
+			})
+		}
 	}
 	application.InboundAuthConfig = inboundAuthConfigs
 	return application
@@ -1621,6 +1855,16 @@ func buildOAuthInboundAuthConfigProcessedDTO(
 			ScopeClaims:                        scopeClaims,
 			Certificate:                        certificate,
 			AcrValues:                          inboundAuthConfig.OAuthConfig.AcrValues,
+			PostLogoutRedirectURIs:             inboundAuthConfig.OAuthConfig.PostLogoutRedirectURIs,
+			BackChannelLogoutURI:               inboundAuthConfig.OAuthConfig.BackChannelLogoutURI,
+			FrontChannelLogoutURI:              inboundAuthConfig.OAuthConfig.FrontChannelLogoutURI,
+			BackchannelTokenDeliveryMode:       inboundAuthConfig.OAuthConfig.BackchannelTokenDeliveryMode,
+			BackchannelNotificationEndpoint:    inboundAuthConfig.OAuthConfig.BackchannelNotificationEndpoint,
+			JWTBearerAllowedIssuers:            inboundAuthConfig.OAuthConfig.JWTBearerAllowedIssuers,
+			TLSClientAuthSubjectDN:             inboundAuthConfig.OAuthConfig.TLSClientAuthSubjectDN,
+			CertificateBoundAccessTokens:       inboundAuthConfig.OAuthConfig.CertificateBoundAccessTokens,
+			DPoPBoundAccessTokens:              inboundAuthConfig.OAuthConfig.DPoPBoundAccessTokens,
+			RequireSignedRequestObject:         inboundAuthConfig.OAuthConfig.RequireSignedRequestObject,
 		},
 	}
 }
@@ -1678,6 +1922,16 @@ func buildReturnApplicationDTO(
 				ScopeClaims:                        scopeClaims,
 				Certificate:                        oauthCert,
 				AcrValues:                          inboundAuthConfig.OAuthConfig.AcrValues,
+				PostLogoutRedirectURIs:             inboundAuthConfig.OAuthConfig.PostLogoutRedirectURIs,
+				BackChannelLogoutURI:               inboundAuthConfig.OAuthConfig.BackChannelLogoutURI,
+				FrontChannelLogoutURI:              inboundAuthConfig.OAuthConfig.FrontChannelLogoutURI,
+				BackchannelTokenDeliveryMode:       inboundAuthConfig.OAuthConfig.BackchannelTokenDeliveryMode,
+				BackchannelNotificationEndpoint:    inboundAuthConfig.OAuthConfig.BackchannelNotificationEndpoint,
+				JWTBearerAllowedIssuers:            inboundAuthConfig.OAuthConfig.JWTBearerAllowedIssuers,
+				TLSClientAuthSubjectDN:             inboundAuthConfig.OAuthConfig.TLSClientAuthSubjectDN,
+				CertificateBoundAccessTokens:       inboundAuthConfig.OAuthConfig.CertificateBoundAccessTokens,
+				DPoPBoundAccessTokens:              inboundAuthConfig.OAuthConfig.DPoPBoundAccessTokens,
+				RequireSignedRequestObject:         inboundAuthConfig.OAuthConfig.RequireSignedRequestObject,
 			},
 		}
 		returnApp.InboundAuthConfig = []inboundmodel.InboundAuthConfigWithSecret{returnInboundAuthConfig}
@@ -209,6 +209,19 @@ func mockLoadFullApplication(
 			Return((*inboundmodel.OAuthProfile)(nil), inboundclient.ErrInboundClientNotFound)
 	}
 
+	var samlProfile *inboundmodel.SAMLProfile
+	for i := range dto.InboundAuthConfig {
+		if dto.InboundAuthConfig[i].Type == inboundmodel.SAMLInboundAuthType {
+			samlProfile = dto.InboundAuthConfig[i].SAMLConfig
+		}
+	}
+	if samlProfile != nil {
+		mockStore.On("GetSAMLProfileByEntityID", mock.Anything, dto.ID).Return(samlProfile, nil)
+	} else {
+		mockStore.On("GetSAMLProfileByEntityID", mock.Anything, dto.ID).
+			Return((*inboundmodel.SAMLProfile)(nil), inboundclient.ErrInboundClientNotFound)
+	}
+
 	sysAttrs := map[string]interface{}{}
 	if dto.Name != "" {
 		sysAttrs["name"] = dto.Name
@@ -740,6 +753,8 @@ func (suite *ServiceTestSuite) TestValidateApplicationForUpdate_NameConflict() {
 		Return(&inboundmodel.InboundClient{ID: testServiceAppID}, nil)
 	mockStore.On("GetOAuthProfileByEntityID", mock.Anything, testServiceAppID).
 		Return((*inboundmodel.OAuthProfile)(nil), nil)
+	mockStore.On("GetSAMLProfileByEntityID", mock.Anything, testServiceAppID).
+		Return((*inboundmodel.SAMLProfile)(nil), nil)
 	mockEP := resetIdentifyEntity(service)
 	mockEP.On("GetEntity", testServiceAppID).Unset()
 	mockEP.On("GetEntity", testServiceAppID).Return(
@@ -1190,6 +1205,32 @@ func (suite *ServiceTestSuite) TestValidateOAuthParamsForCreateAndUpdate_WithGra
 	assert.Len(suite.T(), result.OAuthConfig.ResponseTypes, 0)
 }
 
+func (suite *ServiceTestSuite) TestValidateOAuthParamsForCreateAndUpdate_IDTokenResponseTypeOnly() {
+	app := &model.ApplicationDTO{
+		Name: "Test App",
+		OUID: testOUID,
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+			{
+				Type: inboundmodel.OAuthInboundAuthType,
+				OAuthConfig: &inboundmodel.OAuthConfigWithSecret{
+					RedirectURIs:            []string{"https://example.com/callback"},
+					GrantTypes:              []oauth2const.GrantType{},
+					ResponseTypes:           []oauth2const.ResponseType{oauth2const.ResponseTypeIDToken},
+					TokenEndpointAuthMethod: oauth2const.TokenEndpointAuthMethodClientSecretBasic,
+				},
+			},
+		},
+	}
+
+	result, svcErr := validateOAuthParamsForCreateAndUpdate(app)
+
+	assert.NotNil(suite.T(), result)
+	assert.Nil(suite.T(), svcErr)
+	assert.Empty(suite.T(), result.OAuthConfig.GrantTypes)
+	assert.Equal(suite.T(), []oauth2const.ResponseType{oauth2const.ResponseTypeIDToken},
+		result.OAuthConfig.ResponseTypes)
+}
+
 func (suite *ServiceTestSuite) TestEnrichApplicationWithCertificate_Error() {
 	service, mockStore := suite.setupTestService()
 
@@ -3005,3 +3046,176 @@ func (s *AcrValidationTestSuite) TestIsValidACR_EmptyMapping() {
 
 	s.False(isValidACR("urn:thunder:acr:password"))
 }
+
+func newTestSAMLProfile() *inboundmodel.SAMLProfile {
+	return &inboundmodel.SAMLProfile{
+		EntityID:                     "https://sp.example.com/metadata",
+		AssertionConsumerServiceURLs: []string{"https://sp.example.com/acs"},
+	}
+}
+
+func (suite *ServiceTestSuite) TestCreateApplication_WithSAMLConfig_Success() {
+	testConfig := &config.Config{}
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("/tmp/test", testConfig)
+	require.NoError(suite.T(), err)
+	defer config.ResetServerRuntime()
+
+	service, mockStore := suite.setupTestService()
+	samlProfile := newTestSAMLProfile()
+	app := &model.ApplicationDTO{
+		Name: "Test SAML App",
+		OUID: testOUID,
+		InboundAuthProfile: inboundmodel.InboundAuthProfile{
+			AuthFlowID:         "auth-flow-id",
+			RegistrationFlowID: "reg-flow-id",
+		},
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+			{Type: inboundmodel.SAMLInboundAuthType, SAMLConfig: samlProfile},
+		},
+	}
+
+	mockStore.On("CreateInboundClient",
+		mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockStore.On("SyncSAMLProfile", mock.Anything, mock.Anything, samlProfile).Return(nil).Once()
+
+	result, svcErr := service.CreateApplication(context.Background(), app)
+
+	require.Nil(suite.T(), svcErr)
+	require.NotNil(suite.T(), result)
+	require.Len(suite.T(), result.InboundAuthConfig, 1)
+	assert.Equal(suite.T(), inboundmodel.SAMLInboundAuthType, result.InboundAuthConfig[0].Type)
+	assert.Equal(suite.T(), samlProfile, result.InboundAuthConfig[0].SAMLConfig)
+	mockStore.AssertExpectations(suite.T())
+}
+
+func (suite *ServiceTestSuite) TestCreateApplication_WithSAMLConfig_EntityIDTaken() {
+	testConfig := &config.Config{}
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("/tmp/test", testConfig)
+	require.NoError(suite.T(), err)
+	defer config.ResetServerRuntime()
+
+	service, mockStore := suite.setupTestService()
+	app := &model.ApplicationDTO{
+		Name: "Test SAML App",
+		OUID: testOUID,
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+			{Type: inboundmodel.SAMLInboundAuthType, SAMLConfig: newTestSAMLProfile()},
+		},
+	}
+
+	mockStore.On("CreateInboundClient",
+		mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockStore.On("SyncSAMLProfile", mock.Anything, mock.Anything, mock.Anything).
+		Return(inboundclient.ErrSAMLEntityIDAlreadyExists)
+	mockStore.On("DeleteInboundClient", mock.Anything, mock.Anything).Return(nil).Once()
+
+	result, svcErr := service.CreateApplication(context.Background(), app)
+
+	assert.Nil(suite.T(), result)
+	require.NotNil(suite.T(), svcErr)
+	assert.Equal(suite.T(), ErrorSAMLEntityIDAlreadyExists.Code, svcErr.Code)
+	mockStore.AssertExpectations(suite.T())
+}
+
+func (suite *ServiceTestSuite) TestValidateApplicationFields_InvalidSAMLConfig() {
+	testCases := []struct {
+		name    string
+		configs []inboundmodel.InboundAuthConfigWithSecret
+		code    string
+	}{
+		{
+			name:    "NilSAMLConfig",
+			configs: []inboundmodel.InboundAuthConfigWithSecret{{Type: inboundmodel.SAMLInboundAuthType}},
+			code:    ErrorInvalidInboundAuthConfig.Code,
+		},
+		{
+			name: "MissingACSURL",
+			configs: []inboundmodel.InboundAuthConfigWithSecret{{
+				Type:       inboundmodel.SAMLInboundAuthType,
+				SAMLConfig: &inboundmodel.SAMLProfile{EntityID: "https://sp.example.com"},
+			}},
+			code: ErrorInvalidSAMLConfiguration.Code,
+		},
+		{
+			name: "DuplicateSAMLConfig",
+			configs: []inboundmodel.InboundAuthConfigWithSecret{
+				{Type: inboundmodel.SAMLInboundAuthType, SAMLConfig: newTestSAMLProfile()},
+				{Type: inboundmodel.SAMLInboundAuthType, SAMLConfig: newTestSAMLProfile()},
+			},
+			code: ErrorInvalidInboundAuthConfig.Code,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			service, _ := suite.setupTestService()
+			app := &model.ApplicationDTO{Name: "Test App", OUID: testOUID, InboundAuthConfig: tc.configs}
+
+			svcErr := service.validateApplicationFields(context.Background(), app)
+
+			require.NotNil(suite.T(), svcErr)
+			assert.Equal(suite.T(), tc.code, svcErr.Code)
+		})
+	}
+}
+
+func (suite *ServiceTestSuite) TestGetApplication_WithSAMLConfig() {
+	service, mockStore := suite.setupTestService()
+	samlProfile := newTestSAMLProfile()
+	existingApp := &model.ApplicationProcessedDTO{
+		ID:   testServiceAppID,
+		Name: "Test SAML App",
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigProcessed{
+			{Type: inboundmodel.SAMLInboundAuthType, SAMLConfig: samlProfile},
+		},
+	}
+	mockLoadFullApplication(mockStore, service, existingApp)
+
+	dto, svcErr := service.getApplication(context.Background(), testServiceAppID)
+
+	require.Nil(suite.T(), svcErr)
+	require.Len(suite.T(), dto.InboundAuthConfig, 1)
+	assert.Equal(suite.T(), samlProfile, dto.InboundAuthConfig[0].SAMLConfig)
+
+	response := buildApplicationResponse(dto)
+	require.Len(suite.T(), response.InboundAuthConfig, 1)
+	assert.Equal(suite.T(), inboundmodel.SAMLInboundAuthType, response.InboundAuthConfig[0].Type)
+	assert.Equal(suite.T(), samlProfile, response.InboundAuthConfig[0].SAMLConfig)
+}
+
+func (suite *ServiceTestSuite) TestUpdateApplication_RemovesSAMLConfig() {
+	testConfig := &config.Config{}
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("/tmp/test", testConfig)
+	require.NoError(suite.T(), err)
+	defer config.ResetServerRuntime()
+
+	service, mockStore := suite.setupTestService()
+	existingApp := &model.ApplicationProcessedDTO{
+		ID:   testServiceAppID,
+		Name: "Test App",
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigProcessed{
+			{Type: inboundmodel.SAMLInboundAuthType, SAMLConfig: newTestSAMLProfile()},
+		},
+	}
+	updatedApp := &model.ApplicationDTO{Name: "Test App", OUID: testOUID}
+
+	mockStore.On("IsDeclarative", mock.Anything, testServiceAppID).Maybe().Return(false)
+	mockLoadFullApplication(mockStore, service, existingApp)
+	mockStore.On("UpdateInboundClient",
+		mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockStore.On("SyncSAMLProfile", mock.Anything, testServiceAppID, (*inboundmodel.SAMLProfile)(nil)).
+		Return(nil).Once()
+
+	result, svcErr := service.UpdateApplication(context.Background(), testServiceAppID, updatedApp)
+
+	require.Nil(suite.T(), svcErr)
+	require.NotNil(suite.T(), result)
+	assert.Empty(suite.T(), result.InboundAuthConfig)
+	mockStore.AssertExpectations(suite.T())
+}
@@ -23,11 +23,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/asgardeo/thunder/internal/system/config"
 	dbprovider "github.com/asgardeo/thunder/internal/system/database/provider"
+	dbutils "github.com/asgardeo/thunder/internal/system/database/utils"
 )
 
 // attributeCacheStoreInterface defines the interface for the attribute cache store.
@@ -174,7 +174,7 @@ func (s *attributeCacheStore) buildAttributeCacheFromResultRow(row map[string]in
 		return AttributeCache{}, fmt.Errorf("failed to unmarshal attributes: %w", err)
 	}
 
-	expiryTime, err := parseTimeField(row["expiry_time"], "expiry_time")
+	expiryTime, err := dbutils.ParseTimeField(row["expiry_time"], "expiry_time")
 	if err != nil {
 		return AttributeCache{}, err
 	}
@@ -191,36 +191,3 @@ func (s *attributeCacheStore) buildAttributeCacheFromResultRow(row map[string]in
 		TTLSeconds: ttlSeconds,
 	}, nil
 }
-
-// parseTimeField parses a time field from the database result.
-func parseTimeField(field interface{}, fieldName string) (time.Time, error) {
-	const customTimeFormat = "2006-01-02 15:04:05.999999999"
-
-	switch v := field.(type) {
-	case string:
-		// Handle SQLite datetime strings
-		trimmedTime := trimTimeString(v)
-		parsedTime, err := time.Parse(customTimeFormat, trimmedTime)
-		if err != nil {
-			// Try alternative ISO 8601 format as fallback
-			parsedTime, err = time.Parse("2006-01-02T15:04:05Z07:00", v)
-			if err != nil {
-				return time.Time{}, fmt.Errorf("error parsing %s: %w", fieldName, err)
-			}
-		}
-		return parsedTime, nil
-	case time.Time:
-		return v, nil
-	default:
-		return time.Time{}, fmt.Errorf("unexpected type for %s", fieldName)
-	}
-}
-
-// trimTimeString trims extra information from a time string to match the expected format.
-func trimTimeString(timeStr string) string {
-	parts := strings.SplitN(timeStr, " ", 3)
-	if len(parts) >= 2 {
-		return parts[0] + " " + parts[1]
-	}
-	return timeStr
-}
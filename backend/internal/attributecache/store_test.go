@@ -454,39 +454,3 @@ func (suite *AttributeCacheStoreTestSuite) TestBuildAttributeCacheFromResultRow_
 	assert.Contains(suite.T(), err.Error(), "unexpected type for expiry_time")
 	assert.Equal(suite.T(), AttributeCache{}, result)
 }
-
-// Tests for parseTimeField and trimTimeString helpers
-
-const testTimeString = "2023-12-01 10:30:45.123456789"
-
-func (suite *AttributeCacheStoreTestSuite) TestParseTimeField_StringInput() {
-	testTime := testTimeString + " extra content"
-	expectedTime, _ := time.Parse("2006-01-02 15:04:05.999999999", testTimeString)
-
-	result, err := parseTimeField(testTime, "test_field")
-	assert.NoError(suite.T(), err)
-	assert.Equal(suite.T(), expectedTime, result)
-}
-
-func (suite *AttributeCacheStoreTestSuite) TestParseTimeField_TimeInput() {
-	testTime := time.Now()
-
-	result, err := parseTimeField(testTime, "test_field")
-	assert.NoError(suite.T(), err)
-	assert.Equal(suite.T(), testTime, result)
-}
-
-func (suite *AttributeCacheStoreTestSuite) TestTrimTimeString() {
-	input := testTimeString + " extra content here"
-	expected := testTimeString
-
-	result := trimTimeString(input)
-	assert.Equal(suite.T(), expected, result)
-}
-
-func (suite *AttributeCacheStoreTestSuite) TestTrimTimeString_ShortInput() {
-	input := "2023-12-01"
-
-	result := trimTimeString(input)
-	assert.Equal(suite.T(), input, result)
-}
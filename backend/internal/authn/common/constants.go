@@ -29,11 +29,14 @@ const DefaultHTTPTimeout = 5 * time.Second
 const (
 	AuthenticatorCredentials = "CredentialsAuthenticator"
 	AuthenticatorSMSOTP      = "SMSOTPAuthenticator"
+	AuthenticatorEmailOTP    = "EmailOTPAuthenticator"
 	AuthenticatorMagicLink   = "MagicLinkAuthenticator"
+	AuthenticatorTOTP        = "TOTPAuthenticator"
 	AuthenticatorGoogle      = "GoogleOIDCAuthenticator"
 	AuthenticatorGithub      = "GithubOAuthAuthenticator"
 	AuthenticatorOAuth       = "OAuthAuthenticator"
 	AuthenticatorOIDC        = "OIDCAuthenticator"
+	AuthenticatorSAML        = "SAMLAuthenticator"
 	AuthenticatorPasskey     = "Passkey"
 )
 
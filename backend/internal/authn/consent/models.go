@@ -45,6 +45,8 @@ type ConsentPurposePrompt struct {
 type ConsentDecisions struct {
 	// Purposes contains the per-purpose element approval decisions
 	Purposes []PurposeDecision `json:"purposes"`
+	// AuthorizationDetailsApproved indicates whether the user approved the requested authorization details
+	AuthorizationDetailsApproved bool `json:"authorizationDetailsApproved,omitempty"`
 }
 
 // PurposeDecision holds the consent decisions for a single purpose
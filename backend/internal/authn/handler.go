@@ -22,6 +22,7 @@ import (
 	"net/http"
 
 	"github.com/asgardeo/thunder/internal/authn/common"
+	authnprovidermgr "github.com/asgardeo/thunder/internal/authnprovider/manager"
 	"github.com/asgardeo/thunder/internal/idp"
 	notifcommon "github.com/asgardeo/thunder/internal/notification/common"
 	"github.com/asgardeo/thunder/internal/system/error/apierror"
@@ -328,7 +329,7 @@ func (ah *authenticationHandler) handleServiceError(w http.ResponseWriter, svcEr
 	status := http.StatusInternalServerError
 	if svcErr.Type == serviceerror.ClientErrorType {
 		switch svcErr.Code {
-		case ErrorInvalidCredentials.Code, ErrorOTPAuthenticationFailed.Code:
+		case ErrorInvalidCredentials.Code, ErrorOTPAuthenticationFailed.Code, authnprovidermgr.ErrorAccountLocked.Code:
 			status = http.StatusUnauthorized
 		case common.ErrorUserNotFound.Code:
 			status = http.StatusNotFound
@@ -63,6 +63,14 @@ func Initialize(
 		Name:    common.AuthenticatorSMSOTP,
 		Factors: []common.AuthenticationFactor{common.FactorPossession},
 	})
+	common.RegisterAuthenticator(common.AuthenticatorMeta{
+		Name:    common.AuthenticatorEmailOTP,
+		Factors: []common.AuthenticationFactor{common.FactorPossession},
+	})
+	common.RegisterAuthenticator(common.AuthenticatorMeta{
+		Name:    common.AuthenticatorTOTP,
+		Factors: []common.AuthenticationFactor{common.FactorPossession},
+	})
 	common.RegisterAuthenticator(common.AuthenticatorMeta{
 		Name:    common.AuthenticatorPasskey,
 		Factors: []common.AuthenticationFactor{common.FactorPossession, common.FactorInherence},
@@ -87,6 +95,11 @@ func Initialize(
 		Factors:       []common.AuthenticationFactor{common.FactorKnowledge},
 		AssociatedIDP: idp.IDPTypeGoogle,
 	})
+	common.RegisterAuthenticator(common.AuthenticatorMeta{
+		Name:          common.AuthenticatorSAML,
+		Factors:       []common.AuthenticationFactor{common.FactorKnowledge},
+		AssociatedIDP: idp.IDPTypeSAML,
+	})
 	common.RegisterAuthenticator(common.AuthenticatorMeta{
 		Name:    common.AuthenticatorMagicLink,
 		Factors: []common.AuthenticationFactor{common.FactorPossession},
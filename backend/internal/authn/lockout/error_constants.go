@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package lockout
+
+import (
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/i18n/core"
+)
+
+// Client errors for the account lockout service.
+var (
+	// ErrorAccountLocked is the error returned when the account is locked after too many failed
+	// login attempts.
+	ErrorAccountLocked = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-LOCK-1001",
+		Error: core.I18nMessage{
+			Key:          "error.authnlockoutservice.account_locked",
+			DefaultValue: "Account locked",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authnlockoutservice.account_locked_description",
+			DefaultValue: "The account is temporarily locked due to too many failed login attempts",
+		},
+	}
+	// ErrorTooManyAttempts is the error returned when login attempts are made faster than allowed
+	// after previous failures, or from a client that is locked.
+	ErrorTooManyAttempts = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-LOCK-1002",
+		Error: core.I18nMessage{
+			Key:          "error.authnlockoutservice.too_many_attempts",
+			DefaultValue: "Too many attempts",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authnlockoutservice.too_many_attempts_description",
+			DefaultValue: "Too many failed login attempts. Try again later",
+		},
+	}
+)
@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package lockout
+
+import (
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+	"github.com/asgardeo/thunder/internal/system/observability"
+)
+
+// Initialize initializes the account lockout service.
+func Initialize(observabilitySvc observability.ObservabilityServiceInterface) LockoutServiceInterface {
+	lockoutConfig := config.GetServerRuntime().Config.AccountLockout
+	return newLockoutService(lockoutConfig, initializeAttemptStore(), observabilitySvc)
+}
+
+// initializeAttemptStore selects the login attempt store implementation based on the configured
+// runtime DB type.
+func initializeAttemptStore() attemptStoreInterface {
+	deploymentID := config.GetServerRuntime().Config.Server.Identifier
+
+	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		return newRedisAttemptStore(provider.GetRedisProvider(), deploymentID)
+	}
+	return newAttemptStore(deploymentID)
+}
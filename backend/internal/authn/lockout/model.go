@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package lockout
+
+import "time"
+
+// subjectType identifies the kind of subject that failed login attempts are counted against.
+type subjectType string
+
+const (
+	subjectTypeEntity     subjectType = "entity"
+	subjectTypeIdentifier subjectType = "identifier"
+	subjectTypeIP         subjectType = "ip"
+)
+
+// AttemptSubjects holds the subjects that a login attempt is counted against. Subjects that are
+// not known for an attempt are left empty.
+type AttemptSubjects struct {
+	// EntityID is the ID of the entity that the attempt authenticates.
+	EntityID string
+	// Identifiers are the login identifiers of an attempt that could not be resolved to an entity.
+	Identifiers map[string]interface{}
+	// ClientIP is the IP address of the client making the attempt.
+	ClientIP string
+}
+
+// LockStatus represents the lockout state of an entity.
+type LockStatus struct {
+	Locked         bool       `json:"locked"`
+	FailedAttempts int        `json:"failedAttempts"`
+	LockedUntil    *time.Time `json:"lockedUntil,omitempty"`
+}
+
+// attemptRecord holds the failed login attempts counted against a subject.
+type attemptRecord struct {
+	FailedAttempts int
+	LockCount      int
+	LastFailedAt   time.Time
+	// LockedUntil is the end of the last lock of the subject. It is zero if the subject has not been
+	// locked since its failed attempts were last restarted.
+	LockedUntil time.Time
+}
+
+// isLocked reports whether the subject of the record is locked at the given time.
+func (r *attemptRecord) isLocked(now time.Time) bool {
+	return now.Before(r.LockedUntil)
+}
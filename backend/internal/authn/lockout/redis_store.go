@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package lockout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// incrementAttemptsScript atomically counts a failed attempt of a subject and returns the record after
+// the increment. The count restarts when the last lock has expired or the last failed attempt was made
+// before the start of the failure window. The expiry of the record is only ever extended.
+// KEYS[1] is the record key; ARGV holds the failure time, window start and expiry time in milliseconds.
+var incrementAttemptsScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local record = {failedAttempts = 0, lockCount = 0, lastFailedAt = 0, lockedUntil = 0}
+local val = redis.call('GET', KEYS[1])
+if val then record = cjson.decode(val) end
+if record['lockedUntil'] > 0 and record['lockedUntil'] <= now then
+	record['failedAttempts'] = 0
+	record['lockedUntil'] = 0
+elseif record['lockedUntil'] == 0 and record['lastFailedAt'] < tonumber(ARGV[2]) then
+	record['failedAttempts'] = 0
+end
+record['failedAttempts'] = record['failedAttempts'] + 1
+record['lastFailedAt'] = now
+local expiry = tonumber(ARGV[3])
+local ttl = redis.call('PTTL', KEYS[1])
+if ttl > 0 and now + ttl > expiry then expiry = now + ttl end
+local encoded = cjson.encode(record)
+redis.call('SET', KEYS[1], encoded)
+redis.call('PEXPIREAT', KEYS[1], expiry)
+return encoded
+`)
+
+// lockAttemptsScript atomically locks a subject unless it is already locked. Returns 1 if the subject
+// was locked, 0 if it has no record or is already locked.
+// KEYS[1] is the record key; ARGV holds the current time, lock end and expiry time in milliseconds.
+var lockAttemptsScript = redis.NewScript(`
+local val = redis.call('GET', KEYS[1])
+if not val then return 0 end
+local record = cjson.decode(val)
+if record['lockedUntil'] > tonumber(ARGV[1]) then return 0 end
+record['lockedUntil'] = tonumber(ARGV[2])
+record['lockCount'] = record['lockCount'] + 1
+redis.call('SET', KEYS[1], cjson.encode(record))
+redis.call('PEXPIREAT', KEYS[1], ARGV[3])
+return 1
+`)
+
+// lockoutRedisClient abstracts the Redis commands used by the login attempt store.
+type lockoutRedisClient interface {
+	redis.Scripter
+	Get(ctx context.Context, key string) *redis.StringCmd
+	Del(ctx context.Context, keys ...string) *redis.IntCmd
+}
+
+// redisAttemptRecord is the representation of an attemptRecord in Redis. Times are held in Unix
+// milliseconds so that the scripts can compare them, with zero standing for an unset time.
+type redisAttemptRecord struct {
+	FailedAttempts int   `json:"failedAttempts"`
+	LockCount      int   `json:"lockCount"`
+	LastFailedAt   int64 `json:"lastFailedAt"`
+	LockedUntil    int64 `json:"lockedUntil"`
+}
+
+// redisAttemptStore is the Redis-backed implementation of attemptStoreInterface.
+// Records are written with a TTL matching their expiry time so that Redis evicts them
+// automatically once they no longer affect the lockout state.
+type redisAttemptStore struct {
+	client       lockoutRedisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// newRedisAttemptStore creates a new Redis-backed login attempt store.
+func newRedisAttemptStore(p provider.RedisProviderInterface, deploymentID string) attemptStoreInterface {
+	return &redisAttemptStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: deploymentID,
+	}
+}
+
+// attemptKey builds the Redis key for the record of a subject.
+func (s *redisAttemptStore) attemptKey(kind subjectType, key string) string {
+	return fmt.Sprintf("%s:runtime:%s:login_attempt:%s:%s", s.keyPrefix, s.deploymentID, kind, key)
+}
+
+// GetAttempts retrieves the record of the subject from Redis. Returns nil if there is none.
+func (s *redisAttemptStore) GetAttempts(
+	ctx context.Context, kind subjectType, key string,
+) (*attemptRecord, error) {
+	data, err := s.client.Get(ctx, s.attemptKey(kind, key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get login attempts from Redis: %w", err)
+	}
+
+	return decodeRedisAttemptRecord(data)
+}
+
+// IncrementAttempts atomically counts a failed attempt of the subject in Redis and returns the record
+// after the increment.
+func (s *redisAttemptStore) IncrementAttempts(
+	ctx context.Context, kind subjectType, key string, failedAt, windowStart, expiryTime time.Time,
+) (*attemptRecord, error) {
+	data, err := incrementAttemptsScript.Run(ctx, s.client, []string{s.attemptKey(kind, key)},
+		failedAt.UnixMilli(), windowStart.UnixMilli(), expiryTime.UnixMilli()).Text()
+	if err != nil {
+		return nil, fmt.Errorf("failed to record login attempt in Redis: %w", err)
+	}
+
+	return decodeRedisAttemptRecord([]byte(data))
+}
+
+// LockAttempts locks the subject in Redis unless it is already locked.
+func (s *redisAttemptStore) LockAttempts(
+	ctx context.Context, kind subjectType, key string, now, lockedUntil, expiryTime time.Time,
+) (bool, error) {
+	locked, err := lockAttemptsScript.Run(ctx, s.client, []string{s.attemptKey(kind, key)},
+		now.UnixMilli(), lockedUntil.UnixMilli(), expiryTime.UnixMilli()).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to lock login attempts in Redis: %w", err)
+	}
+	return locked == 1, nil
+}
+
+// DeleteAttempts deletes the record of the subject from Redis.
+func (s *redisAttemptStore) DeleteAttempts(ctx context.Context, kind subjectType, key string) error {
+	if err := s.client.Del(ctx, s.attemptKey(kind, key)).Err(); err != nil {
+		return fmt.Errorf("failed to delete login attempts from Redis: %w", err)
+	}
+	return nil
+}
+
+// decodeRedisAttemptRecord reconstructs an attemptRecord from its Redis representation.
+func decodeRedisAttemptRecord(data []byte) (*attemptRecord, error) {
+	var stored redisAttemptRecord
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal login attempts: %w", err)
+	}
+
+	record := &attemptRecord{
+		FailedAttempts: stored.FailedAttempts,
+		LockCount:      stored.LockCount,
+	}
+	if stored.LastFailedAt > 0 {
+		record.LastFailedAt = time.UnixMilli(stored.LastFailedAt)
+	}
+	if stored.LockedUntil > 0 {
+		record.LockedUntil = time.UnixMilli(stored.LockedUntil)
+	}
+	return record, nil
+}
@@ -0,0 +1,245 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package lockout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const redisTestKeyPrefix = "thunderid"
+
+type RedisStoreTestSuite struct {
+	suite.Suite
+	mockClient *lockoutRedisClientMock
+	store      *redisAttemptStore
+	ctx        context.Context
+	testRecord attemptRecord
+}
+
+func TestRedisStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisStoreTestSuite))
+}
+
+func (s *RedisStoreTestSuite) SetupTest() {
+	s.mockClient = newLockoutRedisClientMock(s.T())
+	s.store = &redisAttemptStore{
+		client:       s.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+	failedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.testRecord = attemptRecord{
+		FailedAttempts: 5,
+		LockCount:      1,
+		LastFailedAt:   failedAt,
+		LockedUntil:    failedAt.Add(5 * time.Minute),
+	}
+}
+
+func (s *RedisStoreTestSuite) testRecordJSON() string {
+	data, _ := json.Marshal(redisAttemptRecord{
+		FailedAttempts: s.testRecord.FailedAttempts,
+		LockCount:      s.testRecord.LockCount,
+		LastFailedAt:   s.testRecord.LastFailedAt.UnixMilli(),
+		LockedUntil:    s.testRecord.LockedUntil.UnixMilli(),
+	})
+	return string(data)
+}
+
+func (s *RedisStoreTestSuite) buildRedisKey(kind subjectType, key string) string {
+	return fmt.Sprintf("%s:runtime:%s:login_attempt:%s:%s", redisTestKeyPrefix, testDeploymentID, kind, key)
+}
+
+func (s *RedisStoreTestSuite) TestAttemptKey() {
+	s.Equal(s.buildRedisKey(subjectTypeEntity, testEntityID), s.store.attemptKey(subjectTypeEntity, testEntityID))
+}
+
+func (s *RedisStoreTestSuite) TestGetAttempts_Success() {
+	stringCmd := redis.NewStringCmd(s.ctx)
+	stringCmd.SetVal(s.testRecordJSON())
+	s.mockClient.On("Get", s.ctx, s.buildRedisKey(subjectTypeEntity, testEntityID)).Return(stringCmd)
+
+	record, err := s.store.GetAttempts(s.ctx, subjectTypeEntity, testEntityID)
+
+	s.NoError(err)
+	s.Equal(s.testRecord.FailedAttempts, record.FailedAttempts)
+	s.Equal(s.testRecord.LockCount, record.LockCount)
+	s.True(s.testRecord.LastFailedAt.Equal(record.LastFailedAt))
+	s.True(s.testRecord.LockedUntil.Equal(record.LockedUntil))
+}
+
+func (s *RedisStoreTestSuite) TestGetAttempts_NotLocked() {
+	stringCmd := redis.NewStringCmd(s.ctx)
+	stringCmd.SetVal(`{"failedAttempts":1,"lockCount":0,"lastFailedAt":1767323045000,"lockedUntil":0}`)
+	s.mockClient.On("Get", s.ctx, mock.Anything).Return(stringCmd)
+
+	record, err := s.store.GetAttempts(s.ctx, subjectTypeEntity, testEntityID)
+
+	s.NoError(err)
+	s.Equal(1, record.FailedAttempts)
+	s.True(record.LockedUntil.IsZero())
+}
+
+func (s *RedisStoreTestSuite) TestGetAttempts_NotFound() {
+	stringCmd := redis.NewStringCmd(s.ctx)
+	stringCmd.SetErr(redis.Nil)
+	s.mockClient.On("Get", s.ctx, s.buildRedisKey(subjectTypeEntity, testEntityID)).Return(stringCmd)
+
+	record, err := s.store.GetAttempts(s.ctx, subjectTypeEntity, testEntityID)
+
+	s.NoError(err)
+	s.Nil(record)
+}
+
+func (s *RedisStoreTestSuite) TestGetAttempts_RedisError() {
+	stringCmd := redis.NewStringCmd(s.ctx)
+	stringCmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("Get", s.ctx, mock.Anything).Return(stringCmd)
+
+	record, err := s.store.GetAttempts(s.ctx, subjectTypeEntity, testEntityID)
+
+	s.ErrorContains(err, "failed to get login attempts from Redis")
+	s.Nil(record)
+}
+
+func (s *RedisStoreTestSuite) TestGetAttempts_InvalidJSON() {
+	stringCmd := redis.NewStringCmd(s.ctx)
+	stringCmd.SetVal("{not valid json")
+	s.mockClient.On("Get", s.ctx, mock.Anything).Return(stringCmd)
+
+	record, err := s.store.GetAttempts(s.ctx, subjectTypeEntity, testEntityID)
+
+	s.ErrorContains(err, "failed to unmarshal login attempts")
+	s.Nil(record)
+}
+
+// The scripts are run with EvalSha using their precomputed SHA.
+func (s *RedisStoreTestSuite) TestIncrementAttempts_Success() {
+	failedAt := time.Now()
+	windowStart := failedAt.Add(-time.Hour)
+	expiryTime := failedAt.Add(time.Hour)
+	cmd := redis.NewCmd(s.ctx)
+	cmd.SetVal(s.testRecordJSON())
+	s.mockClient.On("EvalSha", s.ctx, incrementAttemptsScript.Hash(),
+		[]string{s.buildRedisKey(subjectTypeIP, "192.0.2.10")},
+		failedAt.UnixMilli(), windowStart.UnixMilli(), expiryTime.UnixMilli(),
+	).Return(cmd)
+
+	record, err := s.store.IncrementAttempts(s.ctx, subjectTypeIP, "192.0.2.10", failedAt, windowStart, expiryTime)
+
+	s.NoError(err)
+	s.Equal(s.testRecord.FailedAttempts, record.FailedAttempts)
+	s.True(s.testRecord.LockedUntil.Equal(record.LockedUntil))
+}
+
+func (s *RedisStoreTestSuite) TestIncrementAttempts_ScriptError() {
+	cmd := redis.NewCmd(s.ctx)
+	cmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("EvalSha", s.ctx, incrementAttemptsScript.Hash(), mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(cmd)
+
+	record, err := s.store.IncrementAttempts(s.ctx, subjectTypeEntity, testEntityID,
+		time.Now(), time.Now(), time.Now())
+
+	s.ErrorContains(err, "failed to record login attempt in Redis")
+	s.Nil(record)
+}
+
+func (s *RedisStoreTestSuite) TestIncrementAttempts_InvalidJSON() {
+	cmd := redis.NewCmd(s.ctx)
+	cmd.SetVal("{not valid json")
+	s.mockClient.On("EvalSha", s.ctx, incrementAttemptsScript.Hash(), mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(cmd)
+
+	record, err := s.store.IncrementAttempts(s.ctx, subjectTypeEntity, testEntityID,
+		time.Now(), time.Now(), time.Now())
+
+	s.ErrorContains(err, "failed to unmarshal login attempts")
+	s.Nil(record)
+}
+
+func (s *RedisStoreTestSuite) TestLockAttempts_Locked() {
+	now := time.Now()
+	lockedUntil := now.Add(5 * time.Minute)
+	expiryTime := lockedUntil.Add(time.Hour)
+	cmd := redis.NewCmd(s.ctx)
+	cmd.SetVal(int64(1))
+	s.mockClient.On("EvalSha", s.ctx, lockAttemptsScript.Hash(),
+		[]string{s.buildRedisKey(subjectTypeEntity, testEntityID)},
+		now.UnixMilli(), lockedUntil.UnixMilli(), expiryTime.UnixMilli(),
+	).Return(cmd)
+
+	locked, err := s.store.LockAttempts(s.ctx, subjectTypeEntity, testEntityID, now, lockedUntil, expiryTime)
+
+	s.NoError(err)
+	s.True(locked)
+}
+
+func (s *RedisStoreTestSuite) TestLockAttempts_AlreadyLocked() {
+	cmd := redis.NewCmd(s.ctx)
+	cmd.SetVal(int64(0))
+	s.mockClient.On("EvalSha", s.ctx, lockAttemptsScript.Hash(), mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(cmd)
+
+	locked, err := s.store.LockAttempts(s.ctx, subjectTypeEntity, testEntityID, time.Now(), time.Now(), time.Now())
+
+	s.NoError(err)
+	s.False(locked)
+}
+
+func (s *RedisStoreTestSuite) TestLockAttempts_ScriptError() {
+	cmd := redis.NewCmd(s.ctx)
+	cmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("EvalSha", s.ctx, lockAttemptsScript.Hash(), mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(cmd)
+
+	locked, err := s.store.LockAttempts(s.ctx, subjectTypeEntity, testEntityID, time.Now(), time.Now(), time.Now())
+
+	s.ErrorContains(err, "failed to lock login attempts in Redis")
+	s.False(locked)
+}
+
+func (s *RedisStoreTestSuite) TestDeleteAttempts_Success() {
+	s.mockClient.On("Del", s.ctx, s.buildRedisKey(subjectTypeEntity, testEntityID)).
+		Return(redis.NewIntCmd(s.ctx))
+
+	err := s.store.DeleteAttempts(s.ctx, subjectTypeEntity, testEntityID)
+
+	s.NoError(err)
+}
+
+func (s *RedisStoreTestSuite) TestDeleteAttempts_DelError() {
+	intCmd := redis.NewIntCmd(s.ctx)
+	intCmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("Del", s.ctx, mock.Anything).Return(intCmd)
+
+	err := s.store.DeleteAttempts(s.ctx, subjectTypeEntity, testEntityID)
+
+	s.ErrorContains(err, "failed to delete login attempts from Redis")
+}
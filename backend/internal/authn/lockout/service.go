@@ -0,0 +1,290 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package lockout protects login attempts against brute-force attacks by counting failed attempts
+// per entity, login identifier and client IP, delaying and locking out subjects that fail too often.
+package lockout
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	sysContext "github.com/asgardeo/thunder/internal/system/context"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/log"
+	"github.com/asgardeo/thunder/internal/system/observability"
+	"github.com/asgardeo/thunder/internal/system/observability/event"
+)
+
+// defaultFailureWindow is the failure window used when none is configured.
+const defaultFailureWindow = 15 * time.Minute
+
+// LockoutServiceInterface defines the interface of the account lockout service.
+type LockoutServiceInterface interface {
+	// IsEnabled reports whether account lockout is enabled.
+	IsEnabled() bool
+	// CheckAttempt checks whether a login attempt may proceed. Returns ErrorAccountLocked when the
+	// entity or identifier is locked and ErrorTooManyAttempts when the client is locked or the
+	// attempt is made before the progressive delay has elapsed.
+	CheckAttempt(ctx context.Context, subjects AttemptSubjects) *serviceerror.ServiceError
+	// RecordFailure counts a failed login attempt against the subjects, locking those that reach
+	// the threshold of their policy.
+	RecordFailure(ctx context.Context, subjects AttemptSubjects)
+	// RecordSuccess clears the failed login attempts of the entity and identifiers after a
+	// successful login. Failed attempts of the client IP are retained.
+	RecordSuccess(ctx context.Context, subjects AttemptSubjects)
+	// GetLockStatus returns the lockout state of an entity.
+	GetLockStatus(ctx context.Context, entityID string) (*LockStatus, *serviceerror.ServiceError)
+	// Unlock clears the lock and failed login attempts of an entity.
+	Unlock(ctx context.Context, entityID string) *serviceerror.ServiceError
+}
+
+// lockoutService is the default implementation of the LockoutServiceInterface.
+type lockoutService struct {
+	config           config.AccountLockoutConfig
+	store            attemptStoreInterface
+	observabilitySvc observability.ObservabilityServiceInterface
+	logger           *log.Logger
+}
+
+// newLockoutService creates a new instance of lockoutService with injected dependencies.
+func newLockoutService(
+	lockoutConfig config.AccountLockoutConfig,
+	store attemptStoreInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+) LockoutServiceInterface {
+	return &lockoutService{
+		config:           lockoutConfig,
+		store:            store,
+		observabilitySvc: observabilitySvc,
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AccountLockoutService")),
+	}
+}
+
+// IsEnabled reports whether account lockout is enabled.
+func (s *lockoutService) IsEnabled() bool {
+	return s.config.Enabled
+}
+
+// CheckAttempt checks whether a login attempt may proceed against the lockout state of its subjects.
+func (s *lockoutService) CheckAttempt(ctx context.Context, subjects AttemptSubjects) *serviceerror.ServiceError {
+	if !s.IsEnabled() {
+		return nil
+	}
+
+	now := time.Now()
+	for _, sub := range s.getSubjects(subjects) {
+		record, err := s.store.GetAttempts(ctx, sub.subjectType, sub.key)
+		if err != nil {
+			s.logger.Error("Failed to retrieve login attempts", log.String("subjectType", string(sub.subjectType)),
+				log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+		if record == nil {
+			continue
+		}
+		s.refreshRecord(record, now)
+
+		if record.isLocked(now) {
+			s.logger.Debug("Login attempt rejected for a locked subject",
+				log.String("subjectType", string(sub.subjectType)))
+			if sub.subjectType == subjectTypeIP {
+				return &ErrorTooManyAttempts
+			}
+			return &ErrorAccountLocked
+		}
+		// The progressive delay is not applied per client IP, as it would slow down all the users
+		// behind a shared address.
+		if sub.subjectType != subjectTypeIP &&
+			now.Before(record.LastFailedAt.Add(s.getProgressiveDelay(record.FailedAttempts))) {
+			s.logger.Debug("Login attempt rejected before the progressive delay elapsed",
+				log.String("subjectType", string(sub.subjectType)))
+			return &ErrorTooManyAttempts
+		}
+	}
+	return nil
+}
+
+// RecordFailure counts a failed login attempt against the subjects. The count is incremented
+// atomically by the store and the lock is decided from the returned count, so that concurrent
+// attempts cannot exceed the threshold unnoticed. Failures to persist the attempt are logged rather
+// than returned, so that the original authentication error reaches the caller.
+func (s *lockoutService) RecordFailure(ctx context.Context, subjects AttemptSubjects) {
+	if !s.IsEnabled() {
+		return
+	}
+
+	now := time.Now()
+	failureWindow := s.getFailureWindow()
+	for _, sub := range s.getSubjects(subjects) {
+		record, err := s.store.IncrementAttempts(ctx, sub.subjectType, sub.key, now, now.Add(-failureWindow),
+			now.Add(failureWindow))
+		if err != nil {
+			s.logger.Error("Failed to record login attempt", log.String("subjectType", string(sub.subjectType)),
+				log.Error(err))
+			continue
+		}
+		if record.FailedAttempts < sub.policy.MaxFailedAttempts || record.isLocked(now) {
+			continue
+		}
+
+		lockedUntil := now.Add(s.getLockDuration(sub.policy, record.LockCount))
+		locked, err := s.store.LockAttempts(ctx, sub.subjectType, sub.key, now, lockedUntil,
+			lockedUntil.Add(failureWindow))
+		if err != nil {
+			s.logger.Error("Failed to lock login attempts", log.String("subjectType", string(sub.subjectType)),
+				log.Error(err))
+			continue
+		}
+		if !locked {
+			// A concurrent attempt has already locked the subject.
+			continue
+		}
+		record.LockedUntil = lockedUntil
+		record.LockCount++
+
+		s.logger.Info("Subject locked after too many failed login attempts",
+			log.String("subjectType", string(sub.subjectType)),
+			log.Int("failedAttempts", record.FailedAttempts))
+		s.publishAccountLockedEvent(ctx, sub, record)
+	}
+}
+
+// RecordSuccess clears the failed login attempts of the entity and identifiers.
+func (s *lockoutService) RecordSuccess(ctx context.Context, subjects AttemptSubjects) {
+	if !s.IsEnabled() {
+		return
+	}
+
+	for _, sub := range s.getSubjects(AttemptSubjects{EntityID: subjects.EntityID,
+		Identifiers: subjects.Identifiers}) {
+		if err := s.store.DeleteAttempts(ctx, sub.subjectType, sub.key); err != nil {
+			s.logger.Error("Failed to clear login attempts", log.String("subjectType", string(sub.subjectType)),
+				log.Error(err))
+		}
+	}
+}
+
+// GetLockStatus returns the lockout state of an entity.
+func (s *lockoutService) GetLockStatus(
+	ctx context.Context, entityID string,
+) (*LockStatus, *serviceerror.ServiceError) {
+	status := &LockStatus{}
+	if !s.IsEnabled() || s.config.Entity.MaxFailedAttempts <= 0 {
+		return status, nil
+	}
+
+	record, err := s.store.GetAttempts(ctx, subjectTypeEntity, entityID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve login attempts of entity", log.MaskedString(log.LoggerKeyUserID, entityID),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if record == nil {
+		return status, nil
+	}
+
+	now := time.Now()
+	s.refreshRecord(record, now)
+	status.FailedAttempts = record.FailedAttempts
+	if record.isLocked(now) {
+		lockedUntil := record.LockedUntil.UTC()
+		status.Locked = true
+		status.LockedUntil = &lockedUntil
+	}
+	return status, nil
+}
+
+// Unlock clears the lock and failed login attempts of an entity, including the escalation of its
+// lock duration.
+func (s *lockoutService) Unlock(ctx context.Context, entityID string) *serviceerror.ServiceError {
+	if err := s.store.DeleteAttempts(ctx, subjectTypeEntity, entityID); err != nil {
+		s.logger.Error("Failed to clear login attempts of entity", log.MaskedString(log.LoggerKeyUserID, entityID),
+			log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("Entity unlocked", log.MaskedString(log.LoggerKeyUserID, entityID))
+	s.publishAccountUnlockedEvent(ctx, entityID)
+	return nil
+}
+
+// refreshRecord discards the failed attempts of a record that no longer count, which are those
+// outside the failure window and those that led to a lock that has since expired. The lock count
+// is retained so that repeated locks escalate until the record expires.
+func (s *lockoutService) refreshRecord(record *attemptRecord, now time.Time) {
+	if record.isLocked(now) {
+		return
+	}
+	lockExpired := !record.LockedUntil.IsZero()
+	if lockExpired || now.Sub(record.LastFailedAt) > s.getFailureWindow() {
+		record.FailedAttempts = 0
+		record.LockedUntil = time.Time{}
+	}
+}
+
+// getFailureWindow returns the duration for which a failed attempt is counted.
+func (s *lockoutService) getFailureWindow() time.Duration {
+	if s.config.FailureWindow <= 0 {
+		return defaultFailureWindow
+	}
+	return time.Duration(s.config.FailureWindow) * time.Second
+}
+
+// publishAccountLockedEvent publishes an event for a subject that has been locked. Only entity
+// subjects carry their key, as the keys of the other subjects identify the client.
+func (s *lockoutService) publishAccountLockedEvent(ctx context.Context, sub subject, record *attemptRecord) {
+	if s.observabilitySvc == nil || !s.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	evt := event.NewEvent(
+		sysContext.GetTraceID(ctx),
+		string(event.EventTypeAccountLocked),
+		event.ComponentAccountLockout,
+	).
+		WithStatus(event.StatusSuccess).
+		WithData(event.DataKey.LockoutSubject, string(sub.subjectType)).
+		WithData(event.DataKey.FailedAttempts, strconv.Itoa(record.FailedAttempts)).
+		WithData(event.DataKey.LockedUntil, record.LockedUntil.UTC().Format(time.RFC3339))
+	if sub.subjectType == subjectTypeEntity {
+		evt.WithData(event.DataKey.UserID, sub.key)
+	}
+
+	s.observabilitySvc.PublishEvent(evt)
+}
+
+// publishAccountUnlockedEvent publishes an event for an entity that has been unlocked.
+func (s *lockoutService) publishAccountUnlockedEvent(ctx context.Context, entityID string) {
+	if s.observabilitySvc == nil || !s.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	evt := event.NewEvent(
+		sysContext.GetTraceID(ctx),
+		string(event.EventTypeAccountUnlocked),
+		event.ComponentAccountLockout,
+	).
+		WithStatus(event.StatusSuccess).
+		WithData(event.DataKey.LockoutSubject, string(subjectTypeEntity)).
+		WithData(event.DataKey.UserID, entityID)
+
+	s.observabilitySvc.PublishEvent(evt)
+}
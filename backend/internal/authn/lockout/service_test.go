@@ -0,0 +1,429 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package lockout
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/observability/event"
+	"github.com/asgardeo/thunder/tests/mocks/observability/observabilitymock"
+)
+
+const testClientIP = "192.0.2.10"
+
+type ServiceTestSuite struct {
+	suite.Suite
+	mockStore         *attemptStoreInterfaceMock
+	mockObservability *observabilitymock.ObservabilityServiceInterfaceMock
+	lockoutConfig     config.AccountLockoutConfig
+	ctx               context.Context
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	s.mockStore = newAttemptStoreInterfaceMock(s.T())
+	s.mockObservability = observabilitymock.NewObservabilityServiceInterfaceMock(s.T())
+	s.lockoutConfig = config.AccountLockoutConfig{
+		Enabled:                true,
+		FailureWindow:          900,
+		LockDurationMultiplier: 2,
+		MaxLockDuration:        3600,
+		ProgressiveDelay: config.ProgressiveDelayConfig{
+			FreeAttempts: 2,
+			BaseDelay:    1000,
+			MaxDelay:     4000,
+		},
+		Entity:     config.LockoutPolicyConfig{MaxFailedAttempts: 3, LockDuration: 300},
+		Identifier: config.LockoutPolicyConfig{MaxFailedAttempts: 5, LockDuration: 300},
+		IP:         config.LockoutPolicyConfig{MaxFailedAttempts: 10, LockDuration: 600},
+	}
+	s.ctx = context.Background()
+}
+
+func (s *ServiceTestSuite) newService() *lockoutService {
+	return newLockoutService(s.lockoutConfig, s.mockStore, s.mockObservability).(*lockoutService)
+}
+
+func (s *ServiceTestSuite) TestIsEnabled() {
+	s.True(s.newService().IsEnabled())
+
+	s.lockoutConfig.Enabled = false
+	s.False(s.newService().IsEnabled())
+}
+
+func (s *ServiceTestSuite) TestCheckAttempt_Disabled() {
+	s.lockoutConfig.Enabled = false
+
+	svcErr := s.newService().CheckAttempt(s.ctx, AttemptSubjects{EntityID: testEntityID, ClientIP: testClientIP})
+
+	s.Nil(svcErr)
+	s.mockStore.AssertNotCalled(s.T(), "GetAttempts", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestCheckAttempt_NoRecords() {
+	s.mockStore.On("GetAttempts", s.ctx, subjectTypeEntity, testEntityID).Return(nil, nil)
+	s.mockStore.On("GetAttempts", s.ctx, subjectTypeIP, testClientIP).Return(nil, nil)
+
+	svcErr := s.newService().CheckAttempt(s.ctx, AttemptSubjects{EntityID: testEntityID, ClientIP: testClientIP})
+
+	s.Nil(svcErr)
+}
+
+func (s *ServiceTestSuite) TestCheckAttempt_EntityLocked() {
+	now := time.Now()
+	s.mockStore.On("GetAttempts", s.ctx, subjectTypeEntity, testEntityID).Return(&attemptRecord{
+		FailedAttempts: 3,
+		LastFailedAt:   now.Add(-time.Minute),
+		LockedUntil:    now.Add(time.Minute),
+	}, nil)
+
+	svcErr := s.newService().CheckAttempt(s.ctx, AttemptSubjects{EntityID: testEntityID, ClientIP: testClientIP})
+
+	s.Equal(&ErrorAccountLocked, svcErr)
+}
+
+func (s *ServiceTestSuite) TestCheckAttempt_IdentifierLocked() {
+	now := time.Now()
+	identifiers := map[string]interface{}{"username": "unknown"}
+	s.mockStore.On("GetAttempts", s.ctx, subjectTypeIdentifier, hashIdentifiers(identifiers)).
+		Return(&attemptRecord{FailedAttempts: 5, LastFailedAt: now, LockedUntil: now.Add(time.Minute)}, nil)
+
+	svcErr := s.newService().CheckAttempt(s.ctx, AttemptSubjects{Identifiers: identifiers})
+
+	s.Equal(&ErrorAccountLocked, svcErr)
+}
+
+func (s *ServiceTestSuite) TestCheckAttempt_IPLocked() {
+	now := time.Now()
+	s.mockStore.On("GetAttempts", s.ctx, subjectTypeEntity, testEntityID).Return(nil, nil)
+	s.mockStore.On("GetAttempts", s.ctx, subjectTypeIP, testClientIP).
+		Return(&attemptRecord{FailedAttempts: 10, LastFailedAt: now, LockedUntil: now.Add(time.Minute)}, nil)
+
+	svcErr := s.newService().CheckAttempt(s.ctx, AttemptSubjects{EntityID: testEntityID, ClientIP: testClientIP})
+
+	s.Equal(&ErrorTooManyAttempts, svcErr)
+}
+
+func (s *ServiceTestSuite) TestCheckAttempt_ProgressiveDelay() {
+	identifiers := map[string]interface{}{"username": "unknown"}
+	s.mockStore.On("GetAttempts", s.ctx, subjectTypeIdentifier, hashIdentifiers(identifiers)).
+		Return(&attemptRecord{FailedAttempts: 3, LastFailedAt: time.Now()}, nil)
+
+	svcErr := s.newService().CheckAttempt(s.ctx, AttemptSubjects{Identifiers: identifiers})
+
+	s.Equal(&ErrorTooManyAttempts, svcErr)
+}
+
+func (s *ServiceTestSuite) TestCheckAttempt_ProgressiveDelayElapsed() {
+	identifiers := map[string]interface{}{"username": "unknown"}
+	s.mockStore.On("GetAttempts", s.ctx, subjectTypeIdentifier, hashIdentifiers(identifiers)).
+		Return(&attemptRecord{FailedAttempts: 3, LastFailedAt: time.Now().Add(-2 * time.Second)}, nil)
+
+	svcErr := s.newService().CheckAttempt(s.ctx, AttemptSubjects{Identifiers: identifiers})
+
+	s.Nil(svcErr)
+}
+
+func (s *ServiceTestSuite) TestCheckAttempt_WithinFreeAttempts() {
+	s.mockStore.On("GetAttempts", s.ctx, subjectTypeEntity, testEntityID).
+		Return(&attemptRecord{FailedAttempts: 2, LastFailedAt: time.Now()}, nil)
+
+	svcErr := s.newService().CheckAttempt(s.ctx, AttemptSubjects{EntityID: testEntityID})
+
+	s.Nil(svcErr)
+}
+
+func (s *ServiceTestSuite) TestCheckAttempt_NoProgressiveDelayForIP() {
+	s.mockStore.On("GetAttempts", s.ctx, subjectTypeIP, testClientIP).
+		Return(&attemptRecord{FailedAttempts: 8, LastFailedAt: time.Now()}, nil)
+
+	svcErr := s.newService().CheckAttempt(s.ctx, AttemptSubjects{ClientIP: testClientIP})
+
+	s.Nil(svcErr)
+}
+
+func (s *ServiceTestSuite) TestCheckAttempt_StoreError() {
+	s.mockStore.On("GetAttempts", s.ctx, subjectTypeEntity, testEntityID).Return(nil, errors.New("db error"))
+
+	svcErr := s.newService().CheckAttempt(s.ctx, AttemptSubjects{EntityID: testEntityID})
+
+	s.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (s *ServiceTestSuite) TestCheckAttempt_DisabledPolicySkipped() {
+	s.lockoutConfig.IP.MaxFailedAttempts = 0
+
+	svcErr := s.newService().CheckAttempt(s.ctx, AttemptSubjects{ClientIP: testClientIP})
+
+	s.Nil(svcErr)
+	s.mockStore.AssertNotCalled(s.T(), "GetAttempts", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestRecordFailure_FirstFailure() {
+	s.mockStore.On("IncrementAttempts", s.ctx, subjectTypeEntity, testEntityID, mock.Anything,
+		mock.MatchedBy(func(windowStart time.Time) bool {
+			return time.Since(windowStart) >= 15*time.Minute && time.Since(windowStart) < 16*time.Minute
+		}),
+		mock.MatchedBy(func(expiryTime time.Time) bool {
+			return time.Until(expiryTime) > 14*time.Minute && time.Until(expiryTime) <= 15*time.Minute
+		}),
+	).Return(&attemptRecord{FailedAttempts: 1, LastFailedAt: time.Now()}, nil)
+
+	s.newService().RecordFailure(s.ctx, AttemptSubjects{EntityID: testEntityID})
+
+	s.mockStore.AssertNotCalled(s.T(), "LockAttempts", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestRecordFailure_LocksAtThreshold() {
+	s.mockStore.On("IncrementAttempts", s.ctx, subjectTypeEntity, testEntityID,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(&attemptRecord{FailedAttempts: 3, LastFailedAt: time.Now()}, nil)
+	s.mockStore.On("LockAttempts", s.ctx, subjectTypeEntity, testEntityID, mock.Anything,
+		mock.MatchedBy(func(lockedUntil time.Time) bool {
+			return time.Until(lockedUntil) > 4*time.Minute && time.Until(lockedUntil) <= 5*time.Minute
+		}),
+		mock.MatchedBy(func(expiryTime time.Time) bool {
+			return time.Until(expiryTime) > 19*time.Minute && time.Until(expiryTime) <= 20*time.Minute
+		}),
+	).Return(true, nil)
+	s.mockObservability.On("IsEnabled").Return(true)
+	s.mockObservability.On("PublishEvent", mock.MatchedBy(func(evt *event.Event) bool {
+		return evt.Type == string(event.EventTypeAccountLocked) &&
+			evt.Data[event.DataKey.UserID] == testEntityID &&
+			evt.Data[event.DataKey.LockoutSubject] == string(subjectTypeEntity)
+	})).Return()
+
+	s.newService().RecordFailure(s.ctx, AttemptSubjects{EntityID: testEntityID})
+}
+
+func (s *ServiceTestSuite) TestRecordFailure_EscalatesLockDuration() {
+	s.mockStore.On("IncrementAttempts", s.ctx, subjectTypeIdentifier, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(&attemptRecord{FailedAttempts: 5, LockCount: 2, LastFailedAt: time.Now()}, nil)
+	s.mockStore.On("LockAttempts", s.ctx, subjectTypeIdentifier, mock.Anything, mock.Anything,
+		mock.MatchedBy(func(lockedUntil time.Time) bool {
+			return time.Until(lockedUntil) > 19*time.Minute && time.Until(lockedUntil) <= 20*time.Minute
+		}),
+		mock.Anything,
+	).Return(true, nil)
+	s.mockObservability.On("IsEnabled").Return(false)
+
+	s.newService().RecordFailure(s.ctx, AttemptSubjects{
+		Identifiers: map[string]interface{}{"username": "alice"},
+	})
+}
+
+func (s *ServiceTestSuite) TestRecordFailure_AlreadyLocked() {
+	now := time.Now()
+	s.mockStore.On("IncrementAttempts", s.ctx, subjectTypeEntity, testEntityID,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(&attemptRecord{FailedAttempts: 4, LockCount: 1, LastFailedAt: now,
+		LockedUntil: now.Add(time.Minute)}, nil)
+
+	s.newService().RecordFailure(s.ctx, AttemptSubjects{EntityID: testEntityID})
+
+	s.mockStore.AssertNotCalled(s.T(), "LockAttempts", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestRecordFailure_ConcurrentLock() {
+	s.mockStore.On("IncrementAttempts", s.ctx, subjectTypeEntity, testEntityID,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(&attemptRecord{FailedAttempts: 3, LastFailedAt: time.Now()}, nil)
+	s.mockStore.On("LockAttempts", s.ctx, subjectTypeEntity, testEntityID,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(false, nil)
+
+	s.newService().RecordFailure(s.ctx, AttemptSubjects{EntityID: testEntityID})
+
+	s.mockObservability.AssertNotCalled(s.T(), "PublishEvent", mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestRecordFailure_StoreErrorsAreNotFatal() {
+	s.mockStore.On("IncrementAttempts", s.ctx, subjectTypeEntity, testEntityID,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil, errors.New("db error"))
+	s.mockStore.On("IncrementAttempts", s.ctx, subjectTypeIP, testClientIP,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(&attemptRecord{FailedAttempts: 10, LastFailedAt: time.Now()}, nil)
+	s.mockStore.On("LockAttempts", s.ctx, subjectTypeIP, testClientIP,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(false, errors.New("db error"))
+
+	s.newService().RecordFailure(s.ctx, AttemptSubjects{EntityID: testEntityID, ClientIP: testClientIP})
+
+	s.mockObservability.AssertNotCalled(s.T(), "PublishEvent", mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestRecordFailure_Disabled() {
+	s.lockoutConfig.Enabled = false
+
+	s.newService().RecordFailure(s.ctx, AttemptSubjects{EntityID: testEntityID})
+
+	s.mockStore.AssertNotCalled(s.T(), "IncrementAttempts", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestRecordSuccess_RetainsIPAttempts() {
+	identifiers := map[string]interface{}{"username": "alice"}
+	s.mockStore.On("DeleteAttempts", s.ctx, subjectTypeEntity, testEntityID).Return(nil)
+	s.mockStore.On("DeleteAttempts", s.ctx, subjectTypeIdentifier, hashIdentifiers(identifiers)).
+		Return(errors.New("db error"))
+
+	s.newService().RecordSuccess(s.ctx, AttemptSubjects{
+		EntityID:    testEntityID,
+		Identifiers: identifiers,
+		ClientIP:    testClientIP,
+	})
+
+	s.mockStore.AssertNotCalled(s.T(), "DeleteAttempts", mock.Anything, subjectTypeIP, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestGetLockStatus_Locked() {
+	lockedUntil := time.Now().Add(time.Minute)
+	s.mockStore.On("GetAttempts", s.ctx, subjectTypeEntity, testEntityID).Return(&attemptRecord{
+		FailedAttempts: 3,
+		LastFailedAt:   time.Now(),
+		LockedUntil:    lockedUntil,
+	}, nil)
+
+	status, svcErr := s.newService().GetLockStatus(s.ctx, testEntityID)
+
+	s.Nil(svcErr)
+	s.True(status.Locked)
+	s.Equal(3, status.FailedAttempts)
+	s.Require().NotNil(status.LockedUntil)
+	s.True(lockedUntil.Equal(*status.LockedUntil))
+}
+
+func (s *ServiceTestSuite) TestGetLockStatus_LockExpired() {
+	s.mockStore.On("GetAttempts", s.ctx, subjectTypeEntity, testEntityID).Return(&attemptRecord{
+		FailedAttempts: 3,
+		LastFailedAt:   time.Now().Add(-10 * time.Minute),
+		LockedUntil:    time.Now().Add(-time.Minute),
+	}, nil)
+
+	status, svcErr := s.newService().GetLockStatus(s.ctx, testEntityID)
+
+	s.Nil(svcErr)
+	s.Equal(&LockStatus{}, status)
+}
+
+func (s *ServiceTestSuite) TestGetLockStatus_NoRecord() {
+	s.mockStore.On("GetAttempts", s.ctx, subjectTypeEntity, testEntityID).Return(nil, nil)
+
+	status, svcErr := s.newService().GetLockStatus(s.ctx, testEntityID)
+
+	s.Nil(svcErr)
+	s.Equal(&LockStatus{}, status)
+}
+
+func (s *ServiceTestSuite) TestGetLockStatus_Disabled() {
+	s.lockoutConfig.Enabled = false
+
+	status, svcErr := s.newService().GetLockStatus(s.ctx, testEntityID)
+
+	s.Nil(svcErr)
+	s.Equal(&LockStatus{}, status)
+}
+
+func (s *ServiceTestSuite) TestGetLockStatus_StoreError() {
+	s.mockStore.On("GetAttempts", s.ctx, subjectTypeEntity, testEntityID).Return(nil, errors.New("db error"))
+
+	status, svcErr := s.newService().GetLockStatus(s.ctx, testEntityID)
+
+	s.Nil(status)
+	s.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (s *ServiceTestSuite) TestUnlock_Success() {
+	s.mockStore.On("DeleteAttempts", s.ctx, subjectTypeEntity, testEntityID).Return(nil)
+	s.mockObservability.On("IsEnabled").Return(true)
+	s.mockObservability.On("PublishEvent", mock.MatchedBy(func(evt *event.Event) bool {
+		return evt.Type == string(event.EventTypeAccountUnlocked) && evt.Data[event.DataKey.UserID] == testEntityID
+	})).Return()
+
+	svcErr := s.newService().Unlock(s.ctx, testEntityID)
+
+	s.Nil(svcErr)
+}
+
+func (s *ServiceTestSuite) TestUnlock_StoreError() {
+	s.mockStore.On("DeleteAttempts", s.ctx, subjectTypeEntity, testEntityID).Return(errors.New("db error"))
+
+	svcErr := s.newService().Unlock(s.ctx, testEntityID)
+
+	s.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (s *ServiceTestSuite) TestGetProgressiveDelay() {
+	service := s.newService()
+
+	testCases := []struct {
+		failedAttempts int
+		expected       time.Duration
+	}{
+		{failedAttempts: 0, expected: 0},
+		{failedAttempts: 2, expected: 0},
+		{failedAttempts: 3, expected: time.Second},
+		{failedAttempts: 4, expected: 2 * time.Second},
+		{failedAttempts: 5, expected: 4 * time.Second},
+		{failedAttempts: 50, expected: 4 * time.Second},
+	}
+	for _, tc := range testCases {
+		s.Equal(tc.expected, service.getProgressiveDelay(tc.failedAttempts), "failedAttempts=%d", tc.failedAttempts)
+	}
+
+	s.lockoutConfig.ProgressiveDelay.BaseDelay = 0
+	s.Equal(time.Duration(0), s.newService().getProgressiveDelay(10))
+}
+
+func (s *ServiceTestSuite) TestGetLockDuration() {
+	service := s.newService()
+	policy := s.lockoutConfig.Entity
+
+	s.Equal(5*time.Minute, service.getLockDuration(policy, 0))
+	s.Equal(10*time.Minute, service.getLockDuration(policy, 1))
+	s.Equal(40*time.Minute, service.getLockDuration(policy, 3))
+	s.Equal(time.Hour, service.getLockDuration(policy, 4))
+	s.Equal(time.Hour, service.getLockDuration(policy, 1000))
+
+	s.lockoutConfig.MaxLockDuration = 0
+	s.Equal(5*time.Minute, s.newService().getLockDuration(policy, 3))
+}
+
+func (s *ServiceTestSuite) TestHashIdentifiers() {
+	hash := hashIdentifiers(map[string]interface{}{"username": "Alice", "ou": "ou-1"})
+
+	s.Len(hash, 64)
+	s.Equal(hash, hashIdentifiers(map[string]interface{}{"ou": "ou-1", "username": " alice "}))
+	s.NotEqual(hash, hashIdentifiers(map[string]interface{}{"username": "alice"}))
+}
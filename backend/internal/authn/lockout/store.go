@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package lockout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+	dbutils "github.com/asgardeo/thunder/internal/system/database/utils"
+)
+
+// attemptStoreInterface defines the interface for storing the failed login attempts of subjects.
+// Records only need to be retained until the given expiry time, after which they no longer affect
+// the lockout state of the subject.
+type attemptStoreInterface interface {
+	GetAttempts(ctx context.Context, kind subjectType, key string) (*attemptRecord, error)
+	// IncrementAttempts atomically counts a failed attempt made at failedAt and returns the record after
+	// the increment. The count restarts when the previous failed attempts no longer count, which is when
+	// the last of them was made before windowStart or the lock they led to has expired.
+	IncrementAttempts(ctx context.Context, kind subjectType, key string,
+		failedAt, windowStart, expiryTime time.Time) (*attemptRecord, error)
+	// LockAttempts locks the subject until lockedUntil and escalates its lock count. Returns false if the
+	// subject is already locked at the given time.
+	LockAttempts(ctx context.Context, kind subjectType, key string, now, lockedUntil, expiryTime time.Time) (
+		bool, error)
+	DeleteAttempts(ctx context.Context, kind subjectType, key string) error
+}
+
+// attemptStore is the relational-DB-backed implementation of attemptStoreInterface.
+type attemptStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newAttemptStore creates a new DB-backed login attempt store.
+func newAttemptStore(deploymentID string) attemptStoreInterface {
+	return &attemptStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// GetAttempts retrieves the unexpired record of the subject. Returns nil if there is none.
+func (s *attemptStore) GetAttempts(
+	ctx context.Context, kind subjectType, key string,
+) (*attemptRecord, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(
+		ctx, queryGetLoginAttempt, string(kind), key, s.deploymentID, time.Now().UTC(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query login attempts: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	return buildAttemptRecordFromRow(results[0])
+}
+
+// IncrementAttempts atomically counts a failed attempt of the subject and returns the record after
+// the increment.
+func (s *attemptStore) IncrementAttempts(
+	ctx context.Context, kind subjectType, key string, failedAt, windowStart, expiryTime time.Time,
+) (*attemptRecord, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryIncrementLoginAttempt, string(kind), key, s.deploymentID,
+		failedAt.UTC(), windowStart.UTC(), expiryTime.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to record login attempt: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, errors.New("login attempt record not returned after the increment")
+	}
+
+	return buildAttemptRecordFromRow(results[0])
+}
+
+// LockAttempts locks the subject unless it is already locked.
+func (s *attemptStore) LockAttempts(
+	ctx context.Context, kind subjectType, key string, now, lockedUntil, expiryTime time.Time,
+) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryLockLoginAttempt, string(kind), key, s.deploymentID,
+		now.UTC(), lockedUntil.UTC(), expiryTime.UTC())
+	if err != nil {
+		return false, fmt.Errorf("failed to lock login attempts: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// DeleteAttempts deletes the record of the subject. Deleting a missing record is a no-op.
+func (s *attemptStore) DeleteAttempts(ctx context.Context, kind subjectType, key string) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(
+		ctx, queryDeleteLoginAttempt, string(kind), key, s.deploymentID,
+	); err != nil {
+		return fmt.Errorf("failed to delete login attempts: %w", err)
+	}
+	return nil
+}
+
+// buildAttemptRecordFromRow reconstructs an attemptRecord from a database row.
+func buildAttemptRecordFromRow(row map[string]any) (*attemptRecord, error) {
+	failedAttempts, err := parseIntField(row[dbColumnFailedAttempts], dbColumnFailedAttempts)
+	if err != nil {
+		return nil, err
+	}
+	lockCount, err := parseIntField(row[dbColumnLockCount], dbColumnLockCount)
+	if err != nil {
+		return nil, err
+	}
+	lastFailedAt, err := dbutils.ParseTimeField(row[dbColumnLastFailedAt], dbColumnLastFailedAt)
+	if err != nil {
+		return nil, err
+	}
+	lockedUntil, err := dbutils.ParseNullableTimeField(row[dbColumnLockedUntil], dbColumnLockedUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &attemptRecord{
+		FailedAttempts: failedAttempts,
+		LockCount:      lockCount,
+		LastFailedAt:   lastFailedAt,
+		LockedUntil:    lockedUntil,
+	}, nil
+}
+
+// parseIntField parses an integer column from a database result row.
+func parseIntField(field any, fieldName string) (int, error) {
+	switch val := field.(type) {
+	case int64:
+		return int(val), nil
+	case int32:
+		return int(val), nil
+	case int:
+		return val, nil
+	default:
+		return 0, fmt.Errorf("%s is missing or of unexpected type", fieldName)
+	}
+}
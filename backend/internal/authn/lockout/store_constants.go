@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package lockout
+
+import dbmodel "github.com/asgardeo/thunder/internal/system/database/model"
+
+// Database column names for login attempt storage.
+const (
+	dbColumnFailedAttempts = "failed_attempts"
+	dbColumnLockCount      = "lock_count"
+	dbColumnLastFailedAt   = "last_failed_at"
+	dbColumnLockedUntil    = "locked_until"
+)
+
+// queryIncrementLoginAttempt counts a failed attempt in a single statement, so that concurrent
+// attempts cannot overwrite each other's count. The count restarts when the record has expired, the
+// last lock has expired, or the last failed attempt was made before the start of the failure window.
+var queryIncrementLoginAttempt = dbmodel.DBQuery{
+	ID: "LKQ-LAS-01",
+	Query: `INSERT INTO "LOGIN_ATTEMPT" (SUBJECT_TYPE, SUBJECT_KEY, DEPLOYMENT_ID, FAILED_ATTEMPTS, LOCK_COUNT, ` +
+		`LAST_FAILED_AT, EXPIRY_TIME) VALUES ($1, $2, $3, 1, 0, $4, $6) ` +
+		`ON CONFLICT (SUBJECT_TYPE, SUBJECT_KEY, DEPLOYMENT_ID) DO UPDATE SET ` +
+		`FAILED_ATTEMPTS = CASE WHEN "LOGIN_ATTEMPT".EXPIRY_TIME <= $4 OR "LOGIN_ATTEMPT".LOCKED_UNTIL <= $4 ` +
+		`OR ("LOGIN_ATTEMPT".LOCKED_UNTIL IS NULL AND "LOGIN_ATTEMPT".LAST_FAILED_AT < $5) ` +
+		`THEN 1 ELSE "LOGIN_ATTEMPT".FAILED_ATTEMPTS + 1 END, ` +
+		`LOCK_COUNT = CASE WHEN "LOGIN_ATTEMPT".EXPIRY_TIME <= $4 THEN 0 ELSE "LOGIN_ATTEMPT".LOCK_COUNT END, ` +
+		`LOCKED_UNTIL = CASE WHEN "LOGIN_ATTEMPT".EXPIRY_TIME <= $4 OR "LOGIN_ATTEMPT".LOCKED_UNTIL <= $4 ` +
+		`THEN NULL ELSE "LOGIN_ATTEMPT".LOCKED_UNTIL END, ` +
+		`LAST_FAILED_AT = $4, ` +
+		`EXPIRY_TIME = CASE WHEN "LOGIN_ATTEMPT".EXPIRY_TIME > $6 THEN "LOGIN_ATTEMPT".EXPIRY_TIME ELSE $6 END ` +
+		`RETURNING FAILED_ATTEMPTS, LOCK_COUNT, LAST_FAILED_AT, LOCKED_UNTIL`,
+}
+
+var queryGetLoginAttempt = dbmodel.DBQuery{
+	ID: "LKQ-LAS-02",
+	Query: `SELECT FAILED_ATTEMPTS, LOCK_COUNT, LAST_FAILED_AT, LOCKED_UNTIL FROM "LOGIN_ATTEMPT" ` +
+		`WHERE SUBJECT_TYPE = $1 AND SUBJECT_KEY = $2 AND DEPLOYMENT_ID = $3 AND EXPIRY_TIME > $4`,
+}
+
+var queryDeleteLoginAttempt = dbmodel.DBQuery{
+	ID: "LKQ-LAS-03",
+	Query: `DELETE FROM "LOGIN_ATTEMPT" ` +
+		`WHERE SUBJECT_TYPE = $1 AND SUBJECT_KEY = $2 AND DEPLOYMENT_ID = $3`,
+}
+
+// queryLockLoginAttempt locks a subject unless a concurrent attempt has already locked it.
+var queryLockLoginAttempt = dbmodel.DBQuery{
+	ID: "LKQ-LAS-04",
+	Query: `UPDATE "LOGIN_ATTEMPT" SET LOCKED_UNTIL = $5, LOCK_COUNT = LOCK_COUNT + 1, EXPIRY_TIME = $6 ` +
+		`WHERE SUBJECT_TYPE = $1 AND SUBJECT_KEY = $2 AND DEPLOYMENT_ID = $3 ` +
+		`AND (LOCKED_UNTIL IS NULL OR LOCKED_UNTIL <= $4)`,
+}
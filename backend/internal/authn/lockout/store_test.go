@@ -0,0 +1,254 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package lockout
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/tests/mocks/database/providermock"
+)
+
+const (
+	testDeploymentID = "test-deployment-id"
+	testEntityID     = "entity-1"
+)
+
+type StoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *attemptStore
+	ctx            context.Context
+	testRecord     attemptRecord
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (s *StoreTestSuite) SetupTest() {
+	s.mockDBProvider = providermock.NewDBProviderInterfaceMock(s.T())
+	s.mockDBClient = providermock.NewDBClientInterfaceMock(s.T())
+	s.store = &attemptStore{
+		dbProvider:   s.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+	failedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.testRecord = attemptRecord{
+		FailedAttempts: 2,
+		LockCount:      1,
+		LastFailedAt:   failedAt,
+		LockedUntil:    failedAt.Add(5 * time.Minute),
+	}
+}
+
+func (s *StoreTestSuite) testRow() map[string]any {
+	return map[string]any{
+		dbColumnFailedAttempts: int64(s.testRecord.FailedAttempts),
+		dbColumnLockCount:      int64(s.testRecord.LockCount),
+		dbColumnLastFailedAt:   s.testRecord.LastFailedAt,
+		dbColumnLockedUntil:    s.testRecord.LockedUntil,
+	}
+}
+
+func (s *StoreTestSuite) TestGetAttempts_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetLoginAttempt,
+		string(subjectTypeEntity), testEntityID, testDeploymentID, mock.Anything,
+	).Return([]map[string]any{s.testRow()}, nil)
+
+	record, err := s.store.GetAttempts(s.ctx, subjectTypeEntity, testEntityID)
+
+	s.NoError(err)
+	s.Equal(&s.testRecord, record)
+}
+
+func (s *StoreTestSuite) TestGetAttempts_StringTimes() {
+	row := s.testRow()
+	row[dbColumnLastFailedAt] = "2026-01-02 03:04:05"
+	row[dbColumnLockedUntil] = nil
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetLoginAttempt,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return([]map[string]any{row}, nil)
+
+	record, err := s.store.GetAttempts(s.ctx, subjectTypeEntity, testEntityID)
+
+	s.NoError(err)
+	s.Equal(s.testRecord.LastFailedAt, record.LastFailedAt)
+	s.True(record.LockedUntil.IsZero())
+}
+
+func (s *StoreTestSuite) TestGetAttempts_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetLoginAttempt,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return([]map[string]any{}, nil)
+
+	record, err := s.store.GetAttempts(s.ctx, subjectTypeEntity, testEntityID)
+
+	s.NoError(err)
+	s.Nil(record)
+}
+
+func (s *StoreTestSuite) TestGetAttempts_DBClientError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db client error"))
+
+	record, err := s.store.GetAttempts(s.ctx, subjectTypeEntity, testEntityID)
+
+	s.Error(err)
+	s.Nil(record)
+}
+
+func (s *StoreTestSuite) TestGetAttempts_QueryError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetLoginAttempt,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil, errors.New("query failed"))
+
+	record, err := s.store.GetAttempts(s.ctx, subjectTypeEntity, testEntityID)
+
+	s.ErrorContains(err, "failed to query login attempts")
+	s.Nil(record)
+}
+
+func (s *StoreTestSuite) TestGetAttempts_InvalidData() {
+	row := s.testRow()
+	row[dbColumnFailedAttempts] = "two"
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetLoginAttempt,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return([]map[string]any{row}, nil)
+
+	record, err := s.store.GetAttempts(s.ctx, subjectTypeEntity, testEntityID)
+
+	s.ErrorContains(err, "failed_attempts is missing")
+	s.Nil(record)
+}
+
+func (s *StoreTestSuite) TestIncrementAttempts_Success() {
+	failedAt := time.Now()
+	windowStart := failedAt.Add(-time.Hour)
+	expiryTime := failedAt.Add(time.Hour)
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryIncrementLoginAttempt,
+		string(subjectTypeIP), "192.0.2.10", testDeploymentID,
+		failedAt.UTC(), windowStart.UTC(), expiryTime.UTC(),
+	).Return([]map[string]any{s.testRow()}, nil)
+
+	record, err := s.store.IncrementAttempts(s.ctx, subjectTypeIP, "192.0.2.10", failedAt, windowStart, expiryTime)
+
+	s.NoError(err)
+	s.Equal(&s.testRecord, record)
+}
+
+func (s *StoreTestSuite) TestIncrementAttempts_QueryError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryIncrementLoginAttempt,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil, errors.New("upsert failed"))
+
+	record, err := s.store.IncrementAttempts(s.ctx, subjectTypeEntity, testEntityID,
+		time.Now(), time.Now(), time.Now())
+
+	s.ErrorContains(err, "failed to record login attempt")
+	s.Nil(record)
+}
+
+func (s *StoreTestSuite) TestIncrementAttempts_NoRowReturned() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryIncrementLoginAttempt,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return([]map[string]any{}, nil)
+
+	record, err := s.store.IncrementAttempts(s.ctx, subjectTypeEntity, testEntityID,
+		time.Now(), time.Now(), time.Now())
+
+	s.Error(err)
+	s.Nil(record)
+}
+
+func (s *StoreTestSuite) TestLockAttempts_Locked() {
+	now := time.Now()
+	lockedUntil := now.Add(5 * time.Minute)
+	expiryTime := lockedUntil.Add(time.Hour)
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryLockLoginAttempt,
+		string(subjectTypeEntity), testEntityID, testDeploymentID,
+		now.UTC(), lockedUntil.UTC(), expiryTime.UTC(),
+	).Return(int64(1), nil)
+
+	locked, err := s.store.LockAttempts(s.ctx, subjectTypeEntity, testEntityID, now, lockedUntil, expiryTime)
+
+	s.NoError(err)
+	s.True(locked)
+}
+
+func (s *StoreTestSuite) TestLockAttempts_AlreadyLocked() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryLockLoginAttempt,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(int64(0), nil)
+
+	locked, err := s.store.LockAttempts(s.ctx, subjectTypeEntity, testEntityID, time.Now(), time.Now(), time.Now())
+
+	s.NoError(err)
+	s.False(locked)
+}
+
+func (s *StoreTestSuite) TestLockAttempts_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryLockLoginAttempt,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(int64(0), errors.New("update failed"))
+
+	locked, err := s.store.LockAttempts(s.ctx, subjectTypeEntity, testEntityID, time.Now(), time.Now(), time.Now())
+
+	s.ErrorContains(err, "failed to lock login attempts")
+	s.False(locked)
+}
+
+func (s *StoreTestSuite) TestDeleteAttempts_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteLoginAttempt,
+		string(subjectTypeEntity), testEntityID, testDeploymentID,
+	).Return(int64(0), nil)
+
+	err := s.store.DeleteAttempts(s.ctx, subjectTypeEntity, testEntityID)
+
+	s.NoError(err)
+}
+
+func (s *StoreTestSuite) TestDeleteAttempts_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteLoginAttempt,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(int64(0), errors.New("delete failed"))
+
+	err := s.store.DeleteAttempts(s.ctx, subjectTypeEntity, testEntityID)
+
+	s.ErrorContains(err, "failed to delete login attempts")
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package lockout
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+)
+
+// subject is a subject that failed login attempts are counted against, along with its policy.
+type subject struct {
+	subjectType subjectType
+	key         string
+	policy      config.LockoutPolicyConfig
+}
+
+// getSubjects returns the subjects of an attempt whose policy is enabled.
+func (s *lockoutService) getSubjects(subjects AttemptSubjects) []subject {
+	result := make([]subject, 0, 3)
+	if subjects.EntityID != "" && s.config.Entity.MaxFailedAttempts > 0 {
+		result = append(result, subject{
+			subjectType: subjectTypeEntity,
+			key:         subjects.EntityID,
+			policy:      s.config.Entity,
+		})
+	}
+	if len(subjects.Identifiers) > 0 && s.config.Identifier.MaxFailedAttempts > 0 {
+		result = append(result, subject{
+			subjectType: subjectTypeIdentifier,
+			key:         hashIdentifiers(subjects.Identifiers),
+			policy:      s.config.Identifier,
+		})
+	}
+	if subjects.ClientIP != "" && s.config.IP.MaxFailedAttempts > 0 {
+		result = append(result, subject{
+			subjectType: subjectTypeIP,
+			key:         subjects.ClientIP,
+			policy:      s.config.IP,
+		})
+	}
+	return result
+}
+
+// getProgressiveDelay returns the delay enforced after the given number of consecutive failed
+// attempts. The delay starts at the base delay after the free attempts and doubles with every
+// further failure, up to the maximum delay.
+func (s *lockoutService) getProgressiveDelay(failedAttempts int) time.Duration {
+	delayConfig := s.config.ProgressiveDelay
+	excess := failedAttempts - delayConfig.FreeAttempts
+	if delayConfig.BaseDelay <= 0 || excess <= 0 {
+		return 0
+	}
+
+	maxDelay := time.Duration(delayConfig.MaxDelay) * time.Millisecond
+	delay := time.Duration(delayConfig.BaseDelay) * time.Millisecond
+	for i := 1; i < excess; i++ {
+		delay *= 2
+		if maxDelay > 0 && delay >= maxDelay {
+			return maxDelay
+		}
+	}
+	if maxDelay > 0 && delay > maxDelay {
+		return maxDelay
+	}
+	return delay
+}
+
+// getLockDuration returns the duration of a lock given the number of previous locks of the subject.
+// The duration grows by the lock duration multiplier with every previous lock, up to the maximum
+// lock duration. It does not grow when no maximum is configured.
+func (s *lockoutService) getLockDuration(policy config.LockoutPolicyConfig, lockCount int) time.Duration {
+	duration := time.Duration(policy.LockDuration) * time.Second
+	maxDuration := time.Duration(s.config.MaxLockDuration) * time.Second
+	if maxDuration <= 0 || s.config.LockDurationMultiplier <= 1 {
+		return duration
+	}
+	if duration >= maxDuration {
+		return maxDuration
+	}
+
+	grown := float64(duration) * math.Pow(s.config.LockDurationMultiplier, float64(lockCount))
+	if grown >= float64(maxDuration) {
+		return maxDuration
+	}
+	return time.Duration(grown)
+}
+
+// hashIdentifiers derives the key of a set of login identifiers, so that the identifiers are not
+// stored in clear text. Values are normalized so that case variants count as the same identifier.
+func hashIdentifiers(identifiers map[string]interface{}) string {
+	names := make([]string, 0, len(identifiers))
+	for name := range identifiers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var builder strings.Builder
+	for _, name := range names {
+		value := strings.ToLower(strings.TrimSpace(fmt.Sprint(identifiers[name])))
+		builder.WriteString(name)
+		builder.WriteString("=")
+		builder.WriteString(value)
+		builder.WriteString("\n")
+	}
+
+	sum := sha256.Sum256([]byte(builder.String()))
+	return hex.EncodeToString(sum[:])
+}
@@ -77,6 +77,19 @@ var (
 			DefaultValue: "An error occurred while resolving the user for the recipient",
 		},
 	}
+	// ErrorTokenAlreadyUsed is the error returned when the magic link token has already been used.
+	ErrorTokenAlreadyUsed = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-ML-1006",
+		Error: core.I18nMessage{
+			Key:          "error.magiclinkservice.token_already_used",
+			DefaultValue: "Token already used",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.magiclinkservice.token_already_used_description",
+			DefaultValue: "The magic link has already been used",
+		},
+	}
 	// ErrorTokenGenerationFailed is the error returned when JWT token generation fails.
 	ErrorTokenGenerationFailed = serviceerror.ServiceError{
 		Type: serviceerror.ServerErrorType,
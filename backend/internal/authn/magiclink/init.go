@@ -20,6 +20,8 @@ package magiclink
 
 import (
 	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/database/provider"
 	"github.com/asgardeo/thunder/internal/system/jose/jwt"
 )
 
@@ -28,5 +30,16 @@ func Initialize(
 	jwtSvc jwt.JWTServiceInterface,
 	entityProvider entityprovider.EntityProviderInterface,
 ) MagicLinkAuthnServiceInterface {
-	return newMagicLinkAuthnService(jwtSvc, entityProvider)
+	return newMagicLinkAuthnService(jwtSvc, entityProvider, initializeConsumedTokenStore())
+}
+
+// initializeConsumedTokenStore selects the consumed token store implementation based on the configured
+// runtime DB type.
+func initializeConsumedTokenStore() consumedTokenStoreInterface {
+	deploymentID := config.GetServerRuntime().Config.Server.Identifier
+
+	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		return newRedisConsumedTokenStore(provider.GetRedisProvider(), deploymentID)
+	}
+	return newConsumedTokenStore(deploymentID)
 }
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package magiclink
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// magicLinkRedisClient abstracts the Redis commands used by the consumed token store.
+type magicLinkRedisClient interface {
+	SetNX(ctx context.Context, key string, value any, expiration time.Duration) *redis.BoolCmd
+}
+
+// redisConsumedTokenStore is the Redis-backed implementation of consumedTokenStoreInterface.
+// Entries are written with a TTL matching the token expiry so that Redis evicts them automatically.
+type redisConsumedTokenStore struct {
+	client       magicLinkRedisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// newRedisConsumedTokenStore creates a new Redis-backed consumed token store.
+func newRedisConsumedTokenStore(p provider.RedisProviderInterface, deploymentID string) consumedTokenStoreInterface {
+	return &redisConsumedTokenStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: deploymentID,
+	}
+}
+
+// consumedKey builds the Redis key for the consumption marker of a token.
+func (s *redisConsumedTokenStore) consumedKey(tokenID string) string {
+	return fmt.Sprintf("%s:runtime:%s:consumed_magic_link:%s", s.keyPrefix, s.deploymentID, tokenID)
+}
+
+// Consume records the token as consumed. Returns false if the token was already consumed.
+func (s *redisConsumedTokenStore) Consume(ctx context.Context, tokenID string, expiryTime time.Time) (bool, error) {
+	ttl := time.Until(expiryTime)
+	if ttl <= 0 {
+		// The token can no longer be verified; there is nothing to consume.
+		return false, nil
+	}
+
+	consumed, err := s.client.SetNX(ctx, s.consumedKey(tokenID), "1", ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to record consumed magic link in Redis: %w", err)
+	}
+	return consumed, nil
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package magiclink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const redisTestKeyPrefix = "thunderid"
+
+type RedisConsumedTokenStoreTestSuite struct {
+	suite.Suite
+	mockClient *magicLinkRedisClientMock
+	store      *redisConsumedTokenStore
+	ctx        context.Context
+}
+
+func TestRedisConsumedTokenStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisConsumedTokenStoreTestSuite))
+}
+
+func (s *RedisConsumedTokenStoreTestSuite) SetupTest() {
+	s.mockClient = newMagicLinkRedisClientMock(s.T())
+	s.store = &redisConsumedTokenStore{
+		client:       s.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+}
+
+func (s *RedisConsumedTokenStoreTestSuite) buildRedisKey() string {
+	return fmt.Sprintf("%s:runtime:%s:consumed_magic_link:%s", redisTestKeyPrefix, testDeploymentID, testTokenID)
+}
+
+func (s *RedisConsumedTokenStoreTestSuite) TestConsumedKey() {
+	s.Equal(s.buildRedisKey(), s.store.consumedKey(testTokenID))
+}
+
+func (s *RedisConsumedTokenStoreTestSuite) TestConsume_Success() {
+	boolCmd := redis.NewBoolCmd(s.ctx)
+	boolCmd.SetVal(true)
+	s.mockClient.On("SetNX", s.ctx, s.buildRedisKey(), "1",
+		mock.MatchedBy(func(ttl time.Duration) bool { return ttl > 0 && ttl <= time.Minute })).Return(boolCmd)
+
+	consumed, err := s.store.Consume(s.ctx, testTokenID, time.Now().Add(time.Minute))
+
+	s.NoError(err)
+	s.True(consumed)
+}
+
+func (s *RedisConsumedTokenStoreTestSuite) TestConsume_AlreadyConsumed() {
+	boolCmd := redis.NewBoolCmd(s.ctx)
+	boolCmd.SetVal(false)
+	s.mockClient.On("SetNX", s.ctx, s.buildRedisKey(), "1", mock.Anything).Return(boolCmd)
+
+	consumed, err := s.store.Consume(s.ctx, testTokenID, time.Now().Add(time.Minute))
+
+	s.NoError(err)
+	s.False(consumed)
+}
+
+func (s *RedisConsumedTokenStoreTestSuite) TestConsume_RedisError() {
+	boolCmd := redis.NewBoolCmd(s.ctx)
+	boolCmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("SetNX", s.ctx, mock.Anything, mock.Anything, mock.Anything).Return(boolCmd)
+
+	consumed, err := s.store.Consume(s.ctx, testTokenID, time.Now().Add(time.Minute))
+
+	s.ErrorContains(err, "failed to record consumed magic link in Redis")
+	s.False(consumed)
+}
+
+func (s *RedisConsumedTokenStoreTestSuite) TestConsume_Expired() {
+	consumed, err := s.store.Consume(s.ctx, testTokenID, time.Now().Add(-time.Minute))
+
+	s.NoError(err)
+	s.False(consumed)
+	s.mockClient.AssertNotCalled(s.T(), "SetNX", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -23,6 +23,7 @@ import (
 	"context"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/asgardeo/thunder/internal/authn/common"
 	"github.com/asgardeo/thunder/internal/entityprovider"
@@ -51,6 +52,7 @@ type MagicLinkAuthnServiceInterface interface {
 type magicLinkAuthnService struct {
 	jwtService     jwt.JWTServiceInterface
 	entityProvider entityprovider.EntityProviderInterface
+	consumedStore  consumedTokenStoreInterface
 	logger         *log.Logger
 }
 
@@ -58,10 +60,12 @@ type magicLinkAuthnService struct {
 func newMagicLinkAuthnService(
 	jwtSvc jwt.JWTServiceInterface,
 	entityProvider entityprovider.EntityProviderInterface,
+	consumedStore consumedTokenStoreInterface,
 ) MagicLinkAuthnServiceInterface {
 	service := &magicLinkAuthnService{
 		jwtService:     jwtSvc,
 		entityProvider: entityProvider,
+		consumedStore:  consumedStore,
 		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, "MagicLinkAuthnService")),
 	}
 	common.RegisterAuthenticator(service.getMetadata())
@@ -115,8 +119,9 @@ func (s *magicLinkAuthnService) GenerateMagicLink(ctx context.Context,
 }
 
 // VerifyMagicLink verifies the validity of a magic link token and retrieves the associated user information.
+// A token is accepted only once; replaying a consumed token returns ErrorTokenAlreadyUsed.
 // Returns a user object on success or a localized service error if the token is invalid, expired, or malformed.
-func (s *magicLinkAuthnService) VerifyMagicLink(_ context.Context,
+func (s *magicLinkAuthnService) VerifyMagicLink(ctx context.Context,
 	token string, subjectAttribute string) (*entityprovider.Entity, *serviceerror.ServiceError) {
 	s.logger.Debug("Verifying magic link token")
 
@@ -146,6 +151,11 @@ func (s *magicLinkAuthnService) VerifyMagicLink(_ context.Context,
 		s.logger.Debug("Subject claim not found or invalid")
 		return nil, &ErrorMalformedTokenClaims
 	}
+
+	if svcErr := s.consumeToken(ctx, payload); svcErr != nil {
+		return nil, svcErr
+	}
+
 	user, svcErr := s.resolveUserFromSubject(subject, strings.TrimSpace(subjectAttribute))
 	if svcErr != nil {
 		return nil, svcErr
@@ -155,6 +165,28 @@ func (s *magicLinkAuthnService) VerifyMagicLink(_ context.Context,
 	return user, nil
 }
 
+// consumeToken records the token identified by the jti claim as consumed so that it cannot be replayed.
+func (s *magicLinkAuthnService) consumeToken(
+	ctx context.Context, payload map[string]interface{}) *serviceerror.ServiceError {
+	tokenID := utils.ConvertInterfaceValueToString(payload["jti"])
+	exp, ok := payload["exp"].(float64)
+	if tokenID == "" || !ok {
+		s.logger.Debug("Token ID or expiry claim not found or invalid")
+		return &ErrorMalformedTokenClaims
+	}
+
+	consumed, err := s.consumedStore.Consume(ctx, tokenID, time.Unix(int64(exp), 0))
+	if err != nil {
+		s.logger.Error("Failed to record consumed magic link token", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if !consumed {
+		s.logger.Debug("Magic link token has already been used")
+		return &ErrorTokenAlreadyUsed
+	}
+	return nil
+}
+
 // resolveUserFromSubject resolves the token subject either as a user ID or as a configured destination attribute.
 func (s *magicLinkAuthnService) resolveUserFromSubject(
 	subject string, subjectAttribute string) (*entityprovider.Entity, *serviceerror.ServiceError) {
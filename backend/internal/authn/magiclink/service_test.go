@@ -21,10 +21,12 @@ package magiclink
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/url"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -43,21 +45,18 @@ const (
 	testExecutionID = "flow-123"
 	testToken       = "jwt-token-123" // nolint:gosec // G101: test data, not a real secret
 	testIssuedAt    = int64(1609459200)
+	testTokenID     = "token-id-123"
+	testTokenExpiry = int64(4102444800)
 )
 
-// testValidJWT is a valid JWT with recipient and user_id in the standard subclaim.
-// nolint:gosec // G101: test data, not a real secret
-var testValidJWT = "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9." +
-	"eyJyZWNpcGllbnQiOiJ0ZXN0QGV4YW1wbGUuY29tIiwic3ViIjoidXNlci0xMjMifQ." +
-	"test-signature"
+// testValidJWT is a valid JWT with the user ID in the standard subclaim.
+var testValidJWT = createMagicLinkJWTWithSubject("user-123")
 
 var testMissingSubJWT = "eyJhbGciOiAiSFMyNTYiLCAidHlwIjogIkpXVCJ9." +
 	"eyJyZWNpcGllbnQiOiAidGVzdEBleGFtcGxlLmNvbSJ9." +
 	"test-signature"
 
-var testMismatchedUserIDJWT = "eyJhbGciOiAiSFMyNTYiLCAidHlwIjogIkpXVCJ9." +
-	"eyJyZWNpcGllbnQiOiAidGVzdEBleGFtcGxlLmNvbSIsICJzdWIiOiAidXNlci00NTYifQ." +
-	"test-signature"
+var testMismatchedUserIDJWT = createMagicLinkJWTWithSubject("user-456")
 
 var (
 	testUserID   = "user-123"
@@ -66,7 +65,7 @@ var (
 
 func createMagicLinkJWTWithSubject(subject string) string {
 	header := `{"alg":"HS256","typ":"JWT"}`
-	payload := fmt.Sprintf(`{"sub":%q}`, subject)
+	payload := fmt.Sprintf(`{"sub":%q,"jti":%q,"exp":%d}`, subject, testTokenID, testTokenExpiry)
 
 	headerB64 := base64.RawURLEncoding.EncodeToString([]byte(header))
 	payloadB64 := base64.RawURLEncoding.EncodeToString([]byte(payload))
@@ -95,9 +94,10 @@ func initializeTestRuntime(root string) error {
 
 type MagicLinkServiceTestSuite struct {
 	suite.Suite
-	mockJWTService  *jwtmock.JWTServiceInterfaceMock
-	mockUserService *entityprovidermock.EntityProviderInterfaceMock
-	service         MagicLinkAuthnServiceInterface
+	mockJWTService    *jwtmock.JWTServiceInterfaceMock
+	mockUserService   *entityprovidermock.EntityProviderInterfaceMock
+	mockConsumedStore *consumedTokenStoreInterfaceMock
+	service           MagicLinkAuthnServiceInterface
 }
 
 func TestMagicLinkServiceTestSuite(t *testing.T) {
@@ -118,7 +118,8 @@ func (suite *MagicLinkServiceTestSuite) TearDownSuite() {
 func (suite *MagicLinkServiceTestSuite) SetupTest() {
 	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
 	suite.mockUserService = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
-	suite.service = newMagicLinkAuthnService(suite.mockJWTService, suite.mockUserService)
+	suite.mockConsumedStore = newConsumedTokenStoreInterfaceMock(suite.T())
+	suite.service = newMagicLinkAuthnService(suite.mockJWTService, suite.mockUserService, suite.mockConsumedStore)
 }
 
 func (suite *MagicLinkServiceTestSuite) TestGenerateMagicLinkSuccess() {
@@ -208,6 +209,7 @@ func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkInvalidToken() {
 
 func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkSuccess() {
 	suite.mockJWTService.On("VerifyJWT", testValidJWT, tokenAudience, mock.Anything).Return(nil)
+	suite.mockConsumedStore.On("Consume", mock.Anything, testTokenID, time.Unix(testTokenExpiry, 0)).Return(true, nil)
 
 	testUser := &entityprovider.Entity{
 		ID:   testUserID,
@@ -231,6 +233,7 @@ func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkSuccessWithDestinatio
 	workEmailUser := "user-work"
 	testWorkEmailJWT := createMagicLinkJWTWithSubject(workEmailValue)
 	suite.mockJWTService.On("VerifyJWT", testWorkEmailJWT, tokenAudience, mock.Anything).Return(nil)
+	suite.mockConsumedStore.On("Consume", mock.Anything, testTokenID, time.Unix(testTokenExpiry, 0)).Return(true, nil)
 	suite.mockUserService.On("IdentifyEntity", map[string]interface{}{
 		workEmailAttr: workEmailValue,
 	}).Return(&workEmailUser, nil)
@@ -259,6 +262,7 @@ func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkMissingSubjectClaim()
 
 func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkUserIDMismatchClaim() {
 	suite.mockJWTService.On("VerifyJWT", testMismatchedUserIDJWT, tokenAudience, mock.Anything).Return(nil)
+	suite.mockConsumedStore.On("Consume", mock.Anything, testTokenID, time.Unix(testTokenExpiry, 0)).Return(true, nil)
 	suite.mockUserService.On("GetEntity", "user-456").Return(nil, &entityprovider.EntityProviderError{
 		Code:    entityprovider.ErrorCodeEntityNotFound,
 		Message: "Entity not found",
@@ -272,6 +276,7 @@ func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkUserIDMismatchClaim()
 
 func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkUserNotFoundOnVerify() {
 	suite.mockJWTService.On("VerifyJWT", testValidJWT, tokenAudience, mock.Anything).Return(nil)
+	suite.mockConsumedStore.On("Consume", mock.Anything, testTokenID, time.Unix(testTokenExpiry, 0)).Return(true, nil)
 	suite.mockUserService.On("GetEntity", testUserID).Return(nil, &entityprovider.EntityProviderError{
 		Code:    entityprovider.ErrorCodeEntityNotFound,
 		Message: "Entity not found",
@@ -285,6 +290,7 @@ func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkUserNotFoundOnVerify(
 
 func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkGetUserError() {
 	suite.mockJWTService.On("VerifyJWT", testValidJWT, tokenAudience, mock.Anything).Return(nil)
+	suite.mockConsumedStore.On("Consume", mock.Anything, testTokenID, time.Unix(testTokenExpiry, 0)).Return(true, nil)
 	suite.mockUserService.On("GetEntity", testUserID).Return(nil, &entityprovider.EntityProviderError{
 		Code:    entityprovider.ErrorCodeInvalidRequestFormat,
 		Message: "Invalid request",
@@ -298,6 +304,7 @@ func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkGetUserError() {
 
 func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkEntityProviderSystemError() {
 	suite.mockJWTService.On("VerifyJWT", testValidJWT, tokenAudience, mock.Anything).Return(nil)
+	suite.mockConsumedStore.On("Consume", mock.Anything, testTokenID, time.Unix(testTokenExpiry, 0)).Return(true, nil)
 	suite.mockUserService.On("GetEntity", testUserID).Return(nil, &entityprovider.EntityProviderError{
 		Code:        entityprovider.ErrorCodeSystemError,
 		Message:     "System error",
@@ -310,6 +317,40 @@ func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkEntityProviderSystemE
 	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
 }
 
+func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkAlreadyUsed() {
+	suite.mockJWTService.On("VerifyJWT", testValidJWT, tokenAudience, mock.Anything).Return(nil)
+	suite.mockConsumedStore.On("Consume", mock.Anything, testTokenID, mock.Anything).Return(false, nil)
+
+	result, err := suite.service.VerifyMagicLink(context.Background(), testValidJWT, "")
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(ErrorTokenAlreadyUsed.Code, err.Code)
+	suite.mockUserService.AssertNotCalled(suite.T(), "GetEntity", mock.Anything)
+}
+
+func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkConsumeError() {
+	suite.mockJWTService.On("VerifyJWT", testValidJWT, tokenAudience, mock.Anything).Return(nil)
+	suite.mockConsumedStore.On("Consume", mock.Anything, testTokenID, mock.Anything).
+		Return(false, errors.New("db error"))
+
+	result, err := suite.service.VerifyMagicLink(context.Background(), testValidJWT, "")
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkMissingTokenIDClaim() {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"user-123","exp":4102444800}`))
+	token := "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9." + payload + ".test-signature"
+	suite.mockJWTService.On("VerifyJWT", token, tokenAudience, mock.Anything).Return(nil)
+
+	result, err := suite.service.VerifyMagicLink(context.Background(), token, "")
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(ErrorMalformedTokenClaims.Code, err.Code)
+	suite.mockConsumedStore.AssertNotCalled(suite.T(), "Consume", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (suite *MagicLinkServiceTestSuite) TestGetAuthenticatorMetadata() {
 	metadata := suite.service.(*magicLinkAuthnService).getMetadata()
 	suite.Equal(common.AuthenticatorMagicLink, metadata.Name)
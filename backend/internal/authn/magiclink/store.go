@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package magiclink
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// consumedTokenStoreInterface defines the interface for tracking consumed magic link tokens.
+// Entries only need to be retained until the token expires.
+type consumedTokenStoreInterface interface {
+	Consume(ctx context.Context, tokenID string, expiryTime time.Time) (bool, error)
+}
+
+// consumedTokenStore is the relational-DB-backed implementation of consumedTokenStoreInterface.
+type consumedTokenStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newConsumedTokenStore creates a new DB-backed consumed token store.
+func newConsumedTokenStore(deploymentID string) consumedTokenStoreInterface {
+	return &consumedTokenStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// Consume records the token as consumed. Returns false if the token was already consumed.
+func (s *consumedTokenStore) Consume(ctx context.Context, tokenID string, expiryTime time.Time) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(
+		ctx, queryInsertConsumedMagicLink, tokenID, s.deploymentID, expiryTime.UTC(),
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to record consumed magic link: %w", err)
+	}
+	return rows > 0, nil
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package magiclink
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type ConsumedTokenStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *consumedTokenStore
+	ctx            context.Context
+	expiry         time.Time
+}
+
+func TestConsumedTokenStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(ConsumedTokenStoreTestSuite))
+}
+
+func (s *ConsumedTokenStoreTestSuite) SetupTest() {
+	s.mockDBProvider = providermock.NewDBProviderInterfaceMock(s.T())
+	s.mockDBClient = providermock.NewDBClientInterfaceMock(s.T())
+	s.store = &consumedTokenStore{
+		dbProvider:   s.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+	s.expiry = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+}
+
+func (s *ConsumedTokenStoreTestSuite) TestConsume_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertConsumedMagicLink,
+		testTokenID, testDeploymentID, s.expiry).Return(int64(1), nil)
+
+	consumed, err := s.store.Consume(s.ctx, testTokenID, s.expiry)
+
+	s.NoError(err)
+	s.True(consumed)
+}
+
+func (s *ConsumedTokenStoreTestSuite) TestConsume_AlreadyConsumed() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertConsumedMagicLink,
+		mock.Anything, mock.Anything, mock.Anything).Return(int64(0), nil)
+
+	consumed, err := s.store.Consume(s.ctx, testTokenID, s.expiry)
+
+	s.NoError(err)
+	s.False(consumed)
+}
+
+func (s *ConsumedTokenStoreTestSuite) TestConsume_DBClientError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db client error"))
+
+	consumed, err := s.store.Consume(s.ctx, testTokenID, s.expiry)
+
+	s.Error(err)
+	s.False(consumed)
+}
+
+func (s *ConsumedTokenStoreTestSuite) TestConsume_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertConsumedMagicLink,
+		mock.Anything, mock.Anything, mock.Anything).Return(int64(0), errors.New("exec failed"))
+
+	consumed, err := s.store.Consume(s.ctx, testTokenID, s.expiry)
+
+	s.ErrorContains(err, "failed to record consumed magic link")
+	s.False(consumed)
+}
@@ -129,4 +129,30 @@ var (
 			DefaultValue: "An error occurred while resolving the user for the recipient",
 		},
 	}
+	// ErrorOTPAttemptsExceeded is the error returned when the verification attempts for an OTP session are exhausted.
+	ErrorOTPAttemptsExceeded = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-OTP-1009",
+		Error: core.I18nMessage{
+			Key:          "error.authnotpservice.otp_attempts_exceeded",
+			DefaultValue: "OTP attempts exceeded",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authnotpservice.otp_attempts_exceeded_description",
+			DefaultValue: "The maximum number of verification attempts for the OTP has been reached",
+		},
+	}
+	// ErrorOTPSendThrottled is the error returned when OTP sends to the recipient are being throttled.
+	ErrorOTPSendThrottled = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-OTP-1010",
+		Error: core.I18nMessage{
+			Key:          "error.authnotpservice.otp_send_throttled",
+			DefaultValue: "OTP send throttled",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authnotpservice.otp_send_throttled_description",
+			DefaultValue: "Too many OTPs have been requested for the recipient. Try again later",
+		},
+	}
 )
@@ -37,9 +37,10 @@ import (
 const (
 	loggerComponentName       = "OTPAuthnService"
 	userAttributeMobileNumber = "mobileNumber"
+	userAttributeEmail        = "email"
 )
 
-var supportedChannels = []notifcommon.ChannelType{notifcommon.ChannelTypeSMS}
+var supportedChannels = []notifcommon.ChannelType{notifcommon.ChannelTypeSMS, notifcommon.ChannelTypeEmail}
 
 // OTPAuthnServiceInterface defines the interface for OTP authentication operations.
 // This is a wrapper over the notification.OTPServiceInterface to perform user authentication.
@@ -139,21 +140,29 @@ func (s *otpAuthnService) Authenticate(ctx context.Context, sessionToken,
 // validateOTPSendRequest validates the parameters for sending an OTP.
 func (s *otpAuthnService) validateOTPSendRequest(senderID string, channel notifcommon.ChannelType,
 	recipient string) *serviceerror.ServiceError {
-	if strings.TrimSpace(senderID) == "" {
+	if !slices.Contains(supportedChannels, channel) {
+		return &ErrorUnsupportedChannel
+	}
+	// Email OTPs are delivered through the server email client and do not need a sender.
+	if strings.TrimSpace(senderID) == "" && channel != notifcommon.ChannelTypeEmail {
 		return &ErrorInvalidSenderID
 	}
 	if strings.TrimSpace(recipient) == "" {
 		return &ErrorInvalidRecipient
 	}
-	if !slices.Contains(supportedChannels, channel) {
-		return &ErrorUnsupportedChannel
-	}
 	return nil
 }
 
 // handleOTPServiceError handles errors from the OTP service.
 func (s *otpAuthnService) handleOTPServiceError(svcErr *serviceerror.ServiceError, isVerify bool,
 	logger *log.Logger) *serviceerror.ServiceError {
+	switch svcErr.Code {
+	case notification.ErrorOTPAttemptsExceeded.Code:
+		return &ErrorOTPAttemptsExceeded
+	case notification.ErrorOTPSendThrottled.Code:
+		return &ErrorOTPSendThrottled
+	}
+
 	if svcErr.Type == serviceerror.ClientErrorType {
 		if isVerify {
 			return serviceerror.CustomServiceError(ErrorClientErrorFromOTPService, core.I18nMessage{
@@ -199,7 +208,11 @@ func (s *otpAuthnService) handleVerifyOTPResponse(result *notifcommon.VerifyOTPR
 		return nil, &serviceerror.InternalServerError
 	}
 
-	user, svcErr := s.resolveUser(result.Recipient, notifcommon.ChannelTypeSMS, logger)
+	channel := result.Channel
+	if channel == "" {
+		channel = notifcommon.ChannelTypeSMS
+	}
+	user, svcErr := s.resolveUser(result.Recipient, channel, logger)
 	if svcErr != nil {
 		return nil, svcErr
 	}
@@ -207,7 +220,7 @@ func (s *otpAuthnService) handleVerifyOTPResponse(result *notifcommon.VerifyOTPR
 	return user, nil
 }
 
-// resolveUser retrieves a user by their recipient identifier (e.g., mobile number).
+// resolveUser retrieves a user by their recipient identifier (e.g., mobile number or email).
 func (s *otpAuthnService) resolveUser(recipient string, channel notifcommon.ChannelType,
 	logger *log.Logger) (*entityprovider.Entity, *serviceerror.ServiceError) {
 	logger.Debug("Resolving user from recipient", log.MaskedString("recipient", recipient),
@@ -218,6 +231,8 @@ func (s *otpAuthnService) resolveUser(recipient string, channel notifcommon.Chan
 	switch channel {
 	case notifcommon.ChannelTypeSMS:
 		filters[userAttributeMobileNumber] = recipient
+	case notifcommon.ChannelTypeEmail:
+		filters[userAttributeEmail] = recipient
 	default:
 		return nil, &ErrorUnsupportedChannel
 	}
@@ -29,6 +29,7 @@ import (
 
 	"github.com/asgardeo/thunder/internal/authn/common"
 	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/notification"
 	notifcommon "github.com/asgardeo/thunder/internal/notification/common"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	"github.com/asgardeo/thunder/tests/mocks/entityprovidermock"
@@ -74,6 +75,23 @@ func (suite *OTPAuthnServiceTestSuite) TestSendOTPSuccess() {
 	suite.Equal(testSessionToken, token)
 }
 
+func (suite *OTPAuthnServiceTestSuite) TestSendOTPViaEmailWithoutSender() {
+	recipient := "user@example.com"
+
+	result := &notifcommon.SendOTPResultDTO{
+		SessionToken: testSessionToken,
+	}
+
+	suite.mockOTPService.On("SendOTP", mock.Anything, mock.MatchedBy(func(dto notifcommon.SendOTPDTO) bool {
+		return dto.SenderID == "" && dto.Channel == string(notifcommon.ChannelTypeEmail) &&
+			dto.Recipient == recipient
+	})).Return(result, nil)
+
+	token, err := suite.service.SendOTP(context.Background(), "", notifcommon.ChannelTypeEmail, recipient)
+	suite.Nil(err)
+	suite.Equal(testSessionToken, token)
+}
+
 func (suite *OTPAuthnServiceTestSuite) TestSendOTPInvalidInputs() {
 	tests := []struct {
 		name         string
@@ -99,8 +117,8 @@ func (suite *OTPAuthnServiceTestSuite) TestSendOTPInvalidInputs() {
 		{
 			"UnsupportedChannel",
 			testSenderID,
-			notifcommon.ChannelType("email"),
-			"test@example.com",
+			notifcommon.ChannelType("whatsapp"),
+			"+1234567890",
 			ErrorUnsupportedChannel.Code,
 		},
 	}
@@ -145,6 +163,11 @@ func (suite *OTPAuthnServiceTestSuite) TestSendOTPWithServiceError() {
 			expectedErrCode:    ErrorClientErrorFromOTPService.Code,
 			expectedDescSubstr: "Invalid phone number format",
 		},
+		{
+			name:            "SendThrottled",
+			mockReturnErr:   &notification.ErrorOTPSendThrottled,
+			expectedErrCode: ErrorOTPSendThrottled.Code,
+		},
 	}
 
 	for _, tc := range tests {
@@ -197,6 +220,30 @@ func (suite *OTPAuthnServiceTestSuite) TestAuthenticateSuccess() {
 	suite.Equal(orgUnit, result.OUID)
 }
 
+func (suite *OTPAuthnServiceTestSuite) TestAuthenticateResolvesEmailRecipient() {
+	otp := "123456"
+	recipient := "user@example.com"
+	userID := "user123"
+
+	verifyResult := &notifcommon.VerifyOTPResultDTO{
+		Status:    notifcommon.OTPVerifyStatusVerified,
+		Recipient: recipient,
+		Channel:   notifcommon.ChannelTypeEmail,
+	}
+
+	suite.mockOTPService.On("VerifyOTP", mock.Anything, mock.Anything).Return(verifyResult, nil)
+	suite.mockEntityService.On("IdentifyEntity", mock.MatchedBy(func(filters map[string]interface{}) bool {
+		_, hasMobile := filters["mobileNumber"]
+		return filters["email"] == recipient && !hasMobile
+	})).Return(&userID, nil)
+	suite.mockEntityService.On("GetEntity", userID).Return(&entityprovider.Entity{ID: userID}, nil)
+
+	result, err := suite.service.Authenticate(context.Background(), testSessionToken, otp)
+	suite.Nil(err)
+	suite.NotNil(result)
+	suite.Equal(userID, result.ID)
+}
+
 func (suite *OTPAuthnServiceTestSuite) TestAuthenticateWithInvalidInputs() {
 	tests := []struct {
 		name         string
@@ -270,6 +317,11 @@ func (suite *OTPAuthnServiceTestSuite) TestAuthenticateWithOTPServiceError() {
 			expectedErrCode:    ErrorClientErrorFromOTPService.Code,
 			expectedDescSubstr: "OTP has expired",
 		},
+		{
+			name:            "AttemptsExceeded",
+			mockReturnErr:   &notification.ErrorOTPAttemptsExceeded,
+			expectedErrCode: ErrorOTPAttemptsExceeded.Code,
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/i18n/core"
+)
+
+// Client errors for SAML authentication.
+var (
+	// ErrorEmptyIdpID is the error when the IDP identifier is empty.
+	ErrorEmptyIdpID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTH-SAML-1001",
+		Error: core.I18nMessage{
+			Key:          "error.authsamlservice.empty_idp_id",
+			DefaultValue: "IDP id is empty",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authsamlservice.empty_idp_id_description",
+			DefaultValue: "The identity provider id cannot be empty",
+		},
+	}
+	// ErrorInvalidIDP is the error when the retrieved IDP is invalid or not a SAML identity provider.
+	ErrorInvalidIDP = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTH-SAML-1002",
+		Error: core.I18nMessage{
+			Key:          "error.authsamlservice.invalid_idp",
+			DefaultValue: "Invalid identity provider",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authsamlservice.invalid_idp_description",
+			DefaultValue: "The retrieved identity provider is empty or not a SAML identity provider",
+		},
+	}
+	// ErrorClientErrorWhileRetrievingIDP is the error when there is a client error while retrieving the IDP.
+	ErrorClientErrorWhileRetrievingIDP = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTH-SAML-1003",
+		Error: core.I18nMessage{
+			Key:          "error.authsamlservice.failed_to_retrieve_idp",
+			DefaultValue: "Failed to retrieve identity provider",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authsamlservice.failed_to_retrieve_idp_description",
+			DefaultValue: "A client error occurred while retrieving the identity provider configuration",
+		},
+	}
+	// ErrorEmptySAMLResponse is the error when the SAML response is empty.
+	ErrorEmptySAMLResponse = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTH-SAML-1004",
+		Error: core.I18nMessage{
+			Key:          "error.authsamlservice.empty_saml_response",
+			DefaultValue: "Empty SAML response",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authsamlservice.empty_saml_response_description",
+			DefaultValue: "The SAML response cannot be empty",
+		},
+	}
+	// ErrorInvalidSAMLResponse is the error when the SAML response is malformed or fails validation.
+	ErrorInvalidSAMLResponse = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTH-SAML-1005",
+		Error: core.I18nMessage{
+			Key:          "error.authsamlservice.invalid_saml_response",
+			DefaultValue: "Invalid SAML response",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authsamlservice.invalid_saml_response_description",
+			DefaultValue: "The SAML response is malformed or not valid for this service provider",
+		},
+	}
+	// ErrorInvalidSAMLSignature is the error when the SAML response is unsigned or its signature is invalid.
+	ErrorInvalidSAMLSignature = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTH-SAML-1006",
+		Error: core.I18nMessage{
+			Key:          "error.authsamlservice.invalid_saml_signature",
+			DefaultValue: "Invalid SAML signature",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authsamlservice.invalid_saml_signature_description",
+			DefaultValue: "Neither the SAML response nor its assertion carries a valid signature",
+		},
+	}
+	// ErrorSAMLAuthenticationFailed is the error when the identity provider did not authenticate the user.
+	ErrorSAMLAuthenticationFailed = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTH-SAML-1007",
+		Error: core.I18nMessage{
+			Key:          "error.authsamlservice.authentication_failed",
+			DefaultValue: "SAML authentication failed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authsamlservice.authentication_failed_description",
+			DefaultValue: "The identity provider did not authenticate the user",
+		},
+	}
+)
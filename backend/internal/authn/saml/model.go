@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import "crypto/x509"
+
+// Claims added to the assertion attributes in the authentication result.
+const (
+	// ClaimSub is the claim holding the subject of the assertion.
+	ClaimSub = "sub"
+	// ClaimInResponseTo is the claim holding the ID of the request the response was issued for.
+	ClaimInResponseTo = "in_response_to"
+	// ClaimSessionIndex is the claim holding the session index of the identity provider session.
+	ClaimSessionIndex = "session_index"
+)
+
+// SAMLConfig holds the configuration of a SAML 2.0 identity provider.
+type SAMLConfig struct {
+	// SPEntityID is the entity ID the server uses as a service provider of the identity provider.
+	SPEntityID string
+	// ACSURL is the assertion consumer service URL the identity provider delivers responses to.
+	ACSURL      string
+	IDPEntityID string
+	SSOURL      string
+	// Certificate is the certificate that signs the responses or assertions of the identity provider.
+	Certificate  *x509.Certificate
+	NameIDFormat string
+	// SubjectAttribute is the optional assertion attribute identifying the user instead of the NameID.
+	SubjectAttribute string
+	// AttributeMapping maps assertion attributes to local user attributes. When empty, all the
+	// assertion attributes are used with their names.
+	AttributeMapping map[string]string
+}
@@ -0,0 +1,285 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package saml implements authentication with external SAML 2.0 identity providers.
+package saml
+
+import (
+	"context"
+	"crypto/x509"
+	"strings"
+	"time"
+
+	authncm "github.com/asgardeo/thunder/internal/authn/common"
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/idp"
+	"github.com/asgardeo/thunder/internal/saml/saml2/binding"
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/saml/saml2/message"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/i18n/core"
+	"github.com/asgardeo/thunder/internal/system/log"
+	"github.com/asgardeo/thunder/internal/system/xmldsig"
+)
+
+const (
+	loggerComponentName = "SAMLAuthnService"
+)
+
+// SAMLAuthnServiceInterface defines the contract for SAML 2.0 based authenticator services.
+type SAMLAuthnServiceInterface interface {
+	GetSAMLConfig(ctx context.Context, idpID string) (*SAMLConfig, *serviceerror.ServiceError)
+	BuildAuthnRequestURL(ctx context.Context, idpID string) (string, string, *serviceerror.ServiceError)
+	ValidateResponse(ctx context.Context, idpID, samlResponse string) (
+		map[string]interface{}, *serviceerror.ServiceError)
+	GetInternalUser(sub string) (*entityprovider.Entity, *serviceerror.ServiceError)
+	Authenticate(ctx context.Context, idpID, code string) (*authncm.FederatedAuthResult, *serviceerror.ServiceError)
+}
+
+// samlAuthnService is the default implementation of SAMLAuthnServiceInterface.
+type samlAuthnService struct {
+	idpService     idp.IDPServiceInterface
+	entityProvider entityprovider.EntityProviderInterface
+	logger         *log.Logger
+}
+
+// newSAMLAuthnService creates a new instance of SAML authenticator service.
+func newSAMLAuthnService(idpSvc idp.IDPServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface) SAMLAuthnServiceInterface {
+	return &samlAuthnService{
+		idpService:     idpSvc,
+		entityProvider: entityProvider,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// GetSAMLConfig retrieves the SAML configuration for the given identity provider ID.
+func (s *samlAuthnService) GetSAMLConfig(ctx context.Context, idpID string) (
+	*SAMLConfig, *serviceerror.ServiceError) {
+	logger := s.logger.With(log.String("idpId", idpID))
+	if strings.TrimSpace(idpID) == "" {
+		return nil, &ErrorEmptyIdpID
+	}
+
+	identityProvider, svcErr := s.idpService.GetIdentityProvider(ctx, idpID)
+	if svcErr != nil {
+		if svcErr.Type == serviceerror.ClientErrorType {
+			return nil, serviceerror.CustomServiceError(ErrorClientErrorWhileRetrievingIDP, core.I18nMessage{
+				Key:          "error.authsamlservice.error_retrieving_idp_description",
+				DefaultValue: "Error while retrieving identity provider: " + svcErr.ErrorDescription.DefaultValue,
+			})
+		}
+		logger.Error("Error while retrieving identity provider", log.String("errorCode", svcErr.Code),
+			log.String("description", svcErr.ErrorDescription.DefaultValue))
+		return nil, &serviceerror.InternalServerError
+	}
+	if identityProvider == nil || identityProvider.Type != idp.IDPTypeSAML {
+		return nil, &ErrorInvalidIDP
+	}
+
+	config, err := parseIDPConfig(identityProvider)
+	if err != nil {
+		logger.Error("Failed to parse identity provider configurations", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return config, nil
+}
+
+// BuildAuthnRequestURL builds the HTTP-Redirect binding URL that sends an authentication request to
+// the identity provider. The ID of the request is returned along with the URL so that the caller can
+// correlate the response with the request.
+func (s *samlAuthnService) BuildAuthnRequestURL(ctx context.Context, idpID string) (
+	string, string, *serviceerror.ServiceError) {
+	logger := s.logger.With(log.String("idpId", idpID))
+	config, svcErr := s.GetSAMLConfig(ctx, idpID)
+	if svcErr != nil {
+		return "", "", svcErr
+	}
+
+	request, err := message.BuildAuthnRequest(&message.AuthnRequestParams{
+		Issuer:                      config.SPEntityID,
+		Destination:                 config.SSOURL,
+		AssertionConsumerServiceURL: config.ACSURL,
+		NameIDFormat:                config.NameIDFormat,
+		IssueInstant:                time.Now(),
+	})
+	if err != nil {
+		logger.Error("Failed to build SAML authentication request", log.Error(err))
+		return "", "", &serviceerror.InternalServerError
+	}
+	redirectURL, err := binding.BuildRedirectURL(ctx, config.SSOURL, constants.ParamSAMLRequest,
+		request.Bytes(), "", nil)
+	if err != nil {
+		logger.Error("Failed to build SAML authentication request URL", log.Error(err))
+		return "", "", &serviceerror.InternalServerError
+	}
+	return redirectURL, request.AttrValue("ID"), nil
+}
+
+// ValidateResponse validates the base64 encoded HTTP-POST binding response of the identity provider
+// and returns the claims of the authenticated user. Either the response or its assertion must be
+// signed by the identity provider. The ID of the request the response was issued for is returned in
+// the in_response_to claim so that the caller can reject unsolicited responses.
+func (s *samlAuthnService) ValidateResponse(ctx context.Context, idpID, samlResponse string) (
+	map[string]interface{}, *serviceerror.ServiceError) {
+	logger := s.logger.With(log.String("idpId", idpID))
+	if strings.TrimSpace(samlResponse) == "" {
+		return nil, &ErrorEmptySAMLResponse
+	}
+	config, svcErr := s.GetSAMLConfig(ctx, idpID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	data, err := binding.DecodePostMessage(samlResponse)
+	if err != nil {
+		logger.Debug("Failed to decode SAML response", log.Error(err))
+		return nil, &ErrorInvalidSAMLResponse
+	}
+	response, err := message.ParseResponse(data)
+	if err != nil {
+		logger.Debug("Failed to parse SAML response", log.Error(err))
+		return nil, &ErrorInvalidSAMLResponse
+	}
+	if response.StatusCode != constants.StatusSuccess {
+		logger.Debug("Identity provider returned an unsuccessful SAML response",
+			log.String("status", response.StatusCode), log.String("subStatus", response.SubStatusCode))
+		return nil, &ErrorSAMLAuthenticationFailed
+	}
+	if (response.Issuer != "" && response.Issuer != config.IDPEntityID) ||
+		(response.Destination != "" && response.Destination != config.ACSURL) {
+		logger.Debug("SAML response is not issued by the identity provider to this service provider",
+			log.String("issuer", response.Issuer), log.String("destination", response.Destination))
+		return nil, &ErrorInvalidSAMLResponse
+	}
+	if len(response.Assertions) != 1 {
+		logger.Debug("SAML response must carry exactly one assertion", log.Int("count", len(response.Assertions)))
+		return nil, &ErrorInvalidSAMLResponse
+	}
+	assertion := response.Assertions[0]
+
+	if err := verifyResponseSignature(response, assertion, config.Certificate); err != nil {
+		logger.Debug("SAML response signature verification failed", log.Error(err))
+		return nil, &ErrorInvalidSAMLSignature
+	}
+	if assertion.Issuer != config.IDPEntityID {
+		logger.Debug("SAML assertion is not issued by the identity provider", log.String("issuer", assertion.Issuer))
+		return nil, &ErrorInvalidSAMLResponse
+	}
+	if err := validateConditions(assertion, config, time.Now()); err != nil {
+		logger.Debug("SAML assertion validation failed", log.Error(err))
+		return nil, &ErrorInvalidSAMLResponse
+	}
+	inResponseTo, err := getInResponseTo(response, assertion)
+	if err != nil {
+		logger.Debug("SAML response validation failed", log.Error(err))
+		return nil, &ErrorInvalidSAMLResponse
+	}
+
+	claims, err := buildClaims(assertion, config)
+	if err != nil {
+		logger.Debug("Failed to extract claims from SAML assertion", log.Error(err))
+		return nil, &authncm.ErrorSubClaimNotFound
+	}
+	if inResponseTo != "" {
+		claims[ClaimInResponseTo] = inResponseTo
+	}
+	return claims, nil
+}
+
+// GetInternalUser retrieves the internal user based on the subject of the assertion.
+func (s *samlAuthnService) GetInternalUser(sub string) (*entityprovider.Entity, *serviceerror.ServiceError) {
+	logger := s.logger.With(log.MaskedString("sub", sub))
+	logger.Debug("Retrieving internal user for the given subject")
+
+	if strings.TrimSpace(sub) == "" {
+		return nil, &authncm.ErrorSubClaimNotFound
+	}
+
+	userID, upErr := s.entityProvider.IdentifyEntity(map[string]interface{}{"sub": sub})
+	if upErr != nil {
+		if upErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			logger.Debug("No user found for the provided subject")
+			return nil, &authncm.ErrorUserNotFound
+		}
+		if upErr.Code == entityprovider.ErrorCodeAmbiguousEntity {
+			logger.Debug("Multiple users found for the provided subject")
+			return nil, &authncm.ErrorAmbiguousUser
+		}
+		logger.Error("Error while identifying user", log.String("errorCode", string(upErr.Code)),
+			log.String("description", upErr.Description))
+		return nil, &serviceerror.InternalServerError
+	}
+	if userID == nil {
+		return nil, &authncm.ErrorUserNotFound
+	}
+
+	user, upErr := s.entityProvider.GetEntity(*userID)
+	if upErr != nil {
+		if upErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			return nil, &authncm.ErrorUserNotFound
+		}
+		logger.Error("Error while retrieving user", log.String("errorCode", string(upErr.Code)),
+			log.String("description", upErr.Description))
+		return nil, &serviceerror.InternalServerError
+	}
+	return user, nil
+}
+
+// Authenticate validates the SAML response of the identity provider, given as the code, and resolves
+// the internal user of its subject.
+// A missing internal user is NOT an error — the caller decides how to handle it.
+func (s *samlAuthnService) Authenticate(ctx context.Context, idpID, code string) (
+	*authncm.FederatedAuthResult, *serviceerror.ServiceError) {
+	s.logger.Debug("Performing federated SAML authentication", log.String("idpId", idpID))
+
+	claims, svcErr := s.ValidateResponse(ctx, idpID, code)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	sub, _ := claims[ClaimSub].(string)
+
+	result := &authncm.FederatedAuthResult{
+		Sub:    sub,
+		Claims: claims,
+	}
+	user, svcErr := s.GetInternalUser(sub)
+	if svcErr != nil {
+		if svcErr.Code == authncm.ErrorUserNotFound.Code {
+			return result, nil
+		}
+		if svcErr.Code == authncm.ErrorAmbiguousUser.Code {
+			result.IsAmbiguousUser = true
+			return result, nil
+		}
+		return nil, svcErr
+	}
+	result.InternalEntity = user
+	return result, nil
+}
+
+// verifyResponseSignature verifies the signature of the response if it is signed, or the signature
+// of the assertion otherwise.
+func verifyResponseSignature(response *message.Response, assertion *message.Assertion,
+	cert *x509.Certificate) error {
+	certs := []*x509.Certificate{cert}
+	if xmldsig.IsSigned(response.Element) {
+		return xmldsig.VerifyEnveloped(response.Element, certs)
+	}
+	return xmldsig.VerifyEnveloped(assertion.Element, certs)
+}
@@ -0,0 +1,410 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	authncm "github.com/asgardeo/thunder/internal/authn/common"
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/idp"
+	"github.com/asgardeo/thunder/internal/saml/saml2/binding"
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/saml/saml2/message"
+	"github.com/asgardeo/thunder/internal/system/cmodels"
+	"github.com/asgardeo/thunder/internal/system/cryptolab"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/xmldsig"
+	"github.com/asgardeo/thunder/tests/mocks/entityprovidermock"
+	"github.com/asgardeo/thunder/tests/mocks/idp/idpmock"
+)
+
+const (
+	testIDPID       = "idp-1"
+	testIDPEntityID = "https://idp.example.com"
+	testSSOURL      = "https://idp.example.com/sso"
+	testSPEntityID  = "https://thunder.example.com"
+	testACSURL      = "https://thunder.example.com/acs"
+	testRequestID   = "_request1"
+)
+
+type SAMLAuthnServiceTestSuite struct {
+	suite.Suite
+	key                *rsa.PrivateKey
+	cert               *x509.Certificate
+	mockIDPService     *idpmock.IDPServiceInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	service            SAMLAuthnServiceInterface
+}
+
+func TestSAMLAuthnServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SAMLAuthnServiceTestSuite))
+}
+
+func (suite *SAMLAuthnServiceTestSuite) SetupSuite() {
+	suite.key, suite.cert = suite.newKeyPair()
+}
+
+func (suite *SAMLAuthnServiceTestSuite) SetupTest() {
+	suite.mockIDPService = idpmock.NewIDPServiceInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.service = newSAMLAuthnService(suite.mockIDPService, suite.mockEntityProvider)
+}
+
+func (suite *SAMLAuthnServiceTestSuite) newKeyPair() (*rsa.PrivateKey, *x509.Certificate) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	suite.Require().NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	suite.Require().NoError(err)
+	return key, cert
+}
+
+func (suite *SAMLAuthnServiceTestSuite) newIDP(extra map[string]string) *idp.IDPDTO {
+	values := map[string]string{
+		idp.PropSPEntityID:     testSPEntityID,
+		idp.PropACSURL:         testACSURL,
+		idp.PropIDPEntityID:    testIDPEntityID,
+		idp.PropSSOURL:         testSSOURL,
+		idp.PropIDPCertificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: suite.cert.Raw})),
+	}
+	for name, value := range extra {
+		values[name] = value
+	}
+	properties := make([]cmodels.Property, 0, len(values))
+	for name, value := range values {
+		prop, err := cmodels.NewProperty(name, value, false)
+		suite.Require().NoError(err)
+		properties = append(properties, *prop)
+	}
+	return &idp.IDPDTO{ID: testIDPID, Name: "SAML IdP", Type: idp.IDPTypeSAML, Properties: properties}
+}
+
+func (suite *SAMLAuthnServiceTestSuite) expectIDP(extra map[string]string) {
+	suite.mockIDPService.On("GetIdentityProvider", mock.Anything, testIDPID).Return(suite.newIDP(extra), nil)
+}
+
+// responseOptions customizes the SAML response built for a test.
+type responseOptions struct {
+	issuer        string
+	audience      string
+	recipient     string
+	statusCode    string
+	issueInstant  time.Time
+	signResponse  bool
+	unsigned      bool
+	signingKey    *rsa.PrivateKey
+	noAssertion   bool
+	attributes    map[string][]string
+	responseInRTo string
+}
+
+func (suite *SAMLAuthnServiceTestSuite) buildResponse(opts responseOptions) string {
+	if opts.issuer == "" {
+		opts.issuer = testIDPEntityID
+	}
+	if opts.audience == "" {
+		opts.audience = testSPEntityID
+	}
+	if opts.recipient == "" {
+		opts.recipient = testACSURL
+	}
+	if opts.issueInstant.IsZero() {
+		opts.issueInstant = time.Now()
+	}
+	if opts.signingKey == nil {
+		opts.signingKey = suite.key
+	}
+	if opts.responseInRTo == "" {
+		opts.responseInRTo = testRequestID
+	}
+	signFunc := func(content []byte) ([]byte, error) {
+		return cryptolab.Generate(content, cryptolab.RSASHA256, opts.signingKey)
+	}
+
+	var assertion *xmldsig.Element
+	if !opts.noAssertion && opts.statusCode == "" {
+		var err error
+		assertion, err = message.BuildAssertion(&message.AssertionParams{
+			Issuer:          opts.issuer,
+			Recipient:       opts.recipient,
+			InResponseTo:    testRequestID,
+			Audiences:       []string{opts.audience},
+			NameID:          "alice@example.com",
+			NameIDFormat:    constants.NameIDFormatEmailAddress,
+			SessionIndex:    "_session1",
+			AuthnInstant:    opts.issueInstant,
+			Attributes:      opts.attributes,
+			IssueInstant:    opts.issueInstant,
+			ValiditySeconds: 300,
+		})
+		suite.Require().NoError(err)
+		if !opts.signResponse && !opts.unsigned {
+			suite.Require().NoError(xmldsig.SignEnveloped(assertion, message.SignaturePosition,
+				xmldsig.AlgorithmRSASHA256, signFunc, suite.cert))
+		}
+	}
+	response, err := message.BuildResponse(&message.ResponseParams{
+		Issuer:       opts.issuer,
+		Destination:  testACSURL,
+		InResponseTo: opts.responseInRTo,
+		StatusCode:   opts.statusCode,
+		IssueInstant: opts.issueInstant,
+	}, assertion)
+	suite.Require().NoError(err)
+	if opts.signResponse {
+		suite.Require().NoError(xmldsig.SignEnveloped(response, message.SignaturePosition,
+			xmldsig.AlgorithmRSASHA256, signFunc, suite.cert))
+	}
+	return base64.StdEncoding.EncodeToString(response.Bytes())
+}
+
+func (suite *SAMLAuthnServiceTestSuite) TestGetSAMLConfig() {
+	suite.expectIDP(map[string]string{
+		idp.PropNameIDFormat:     constants.NameIDFormatEmailAddress,
+		idp.PropAttributeMapping: "mail=email",
+	})
+
+	config, svcErr := suite.service.GetSAMLConfig(context.Background(), testIDPID)
+
+	suite.Nil(svcErr)
+	suite.Equal(testIDPEntityID, config.IDPEntityID)
+	suite.Equal(testSSOURL, config.SSOURL)
+	suite.Equal(testSPEntityID, config.SPEntityID)
+	suite.Equal(testACSURL, config.ACSURL)
+	suite.Equal(constants.NameIDFormatEmailAddress, config.NameIDFormat)
+	suite.Equal(map[string]string{"mail": "email"}, config.AttributeMapping)
+	suite.True(config.Certificate.Equal(suite.cert))
+}
+
+func (suite *SAMLAuthnServiceTestSuite) TestGetSAMLConfig_Errors() {
+	suite.Run("empty IDP ID", func() {
+		_, svcErr := suite.service.GetSAMLConfig(context.Background(), " ")
+		suite.Equal(ErrorEmptyIdpID.Code, svcErr.Code)
+	})
+	suite.Run("not a SAML IDP", func() {
+		identityProvider := suite.newIDP(nil)
+		identityProvider.Type = idp.IDPTypeOIDC
+		suite.mockIDPService.On("GetIdentityProvider", mock.Anything, "oidc").Return(identityProvider, nil).Once()
+
+		_, svcErr := suite.service.GetSAMLConfig(context.Background(), "oidc")
+
+		suite.Equal(ErrorInvalidIDP.Code, svcErr.Code)
+	})
+	suite.Run("client error", func() {
+		suite.mockIDPService.On("GetIdentityProvider", mock.Anything, "missing").
+			Return(nil, &serviceerror.ServiceError{Type: serviceerror.ClientErrorType, Code: "IDP-1001"}).Once()
+
+		_, svcErr := suite.service.GetSAMLConfig(context.Background(), "missing")
+
+		suite.Equal(ErrorClientErrorWhileRetrievingIDP.Code, svcErr.Code)
+	})
+	suite.Run("server error", func() {
+		suite.mockIDPService.On("GetIdentityProvider", mock.Anything, "broken").
+			Return(nil, &serviceerror.InternalServerError).Once()
+
+		_, svcErr := suite.service.GetSAMLConfig(context.Background(), "broken")
+
+		suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+	})
+}
+
+func (suite *SAMLAuthnServiceTestSuite) TestBuildAuthnRequestURL() {
+	suite.expectIDP(map[string]string{idp.PropNameIDFormat: constants.NameIDFormatEmailAddress})
+
+	redirectURL, requestID, svcErr := suite.service.BuildAuthnRequestURL(context.Background(), testIDPID)
+
+	suite.Nil(svcErr)
+	suite.NotEmpty(requestID)
+	parsed, err := url.Parse(redirectURL)
+	suite.Require().NoError(err)
+	suite.Equal(testSSOURL, parsed.Scheme+"://"+parsed.Host+parsed.Path)
+	data, err := binding.DecodeRedirectMessage(parsed.Query().Get(constants.ParamSAMLRequest))
+	suite.Require().NoError(err)
+	request, err := message.ParseAuthnRequest(data)
+	suite.Require().NoError(err)
+	suite.Equal(requestID, request.ID)
+	suite.Equal(testSPEntityID, request.Issuer)
+	suite.Equal(testSSOURL, request.Destination)
+	suite.Equal(testACSURL, request.AssertionConsumerServiceURL)
+	suite.Equal(constants.NameIDFormatEmailAddress, request.NameIDPolicyFormat)
+}
+
+func (suite *SAMLAuthnServiceTestSuite) TestValidateResponse_SignedAssertion() {
+	suite.expectIDP(nil)
+	samlResponse := suite.buildResponse(responseOptions{
+		attributes: map[string][]string{"mail": {"alice@example.com"}, "groups": {"a", "b"}},
+	})
+
+	claims, svcErr := suite.service.ValidateResponse(context.Background(), testIDPID, samlResponse)
+
+	suite.Nil(svcErr)
+	suite.Equal("alice@example.com", claims[ClaimSub])
+	suite.Equal(testRequestID, claims[ClaimInResponseTo])
+	suite.Equal("_session1", claims[ClaimSessionIndex])
+	suite.Equal("alice@example.com", claims["mail"])
+	suite.Equal([]string{"a", "b"}, claims["groups"])
+}
+
+func (suite *SAMLAuthnServiceTestSuite) TestValidateResponse_SignedResponse() {
+	suite.expectIDP(nil)
+	samlResponse := suite.buildResponse(responseOptions{signResponse: true})
+
+	claims, svcErr := suite.service.ValidateResponse(context.Background(), testIDPID, samlResponse)
+
+	suite.Nil(svcErr)
+	suite.Equal("alice@example.com", claims[ClaimSub])
+}
+
+func (suite *SAMLAuthnServiceTestSuite) TestValidateResponse_AttributeMappingAndSubjectAttribute() {
+	suite.expectIDP(map[string]string{
+		idp.PropSubjectAttribute: "uid",
+		idp.PropAttributeMapping: "mail=email",
+	})
+	samlResponse := suite.buildResponse(responseOptions{
+		attributes: map[string][]string{"uid": {"alice"}, "mail": {"alice@example.com"}, "other": {"x"}},
+	})
+
+	claims, svcErr := suite.service.ValidateResponse(context.Background(), testIDPID, samlResponse)
+
+	suite.Nil(svcErr)
+	suite.Equal("alice", claims[ClaimSub])
+	suite.Equal("alice@example.com", claims["email"])
+	suite.NotContains(claims, "mail")
+	suite.NotContains(claims, "other")
+}
+
+func (suite *SAMLAuthnServiceTestSuite) TestValidateResponse_Errors() {
+	otherKey, _ := suite.newKeyPair()
+	testCases := []struct {
+		name         string
+		samlResponse string
+		expectedCode string
+	}{
+		{"empty response", "", ErrorEmptySAMLResponse.Code},
+		{"malformed encoding", "%%%", ErrorInvalidSAMLResponse.Code},
+		{"not a response", base64.StdEncoding.EncodeToString([]byte("<a/>")), ErrorInvalidSAMLResponse.Code},
+		{"unsuccessful status",
+			suite.buildResponse(responseOptions{statusCode: constants.StatusResponder}),
+			ErrorSAMLAuthenticationFailed.Code},
+		{"no assertion", suite.buildResponse(responseOptions{noAssertion: true}), ErrorInvalidSAMLResponse.Code},
+		{"unsigned", suite.buildResponse(responseOptions{unsigned: true}), ErrorInvalidSAMLSignature.Code},
+		{"wrong signing key", suite.buildResponse(responseOptions{signingKey: otherKey}),
+			ErrorInvalidSAMLSignature.Code},
+		{"wrong issuer", suite.buildResponse(responseOptions{issuer: "https://evil.example.com"}),
+			ErrorInvalidSAMLResponse.Code},
+		{"wrong audience", suite.buildResponse(responseOptions{audience: "https://other.example.com"}),
+			ErrorInvalidSAMLResponse.Code},
+		{"wrong recipient", suite.buildResponse(responseOptions{recipient: "https://other.example.com/acs"}),
+			ErrorInvalidSAMLResponse.Code},
+		{"expired", suite.buildResponse(responseOptions{issueInstant: time.Now().Add(-time.Hour)}),
+			ErrorInvalidSAMLResponse.Code},
+		{"mismatched InResponseTo", suite.buildResponse(responseOptions{responseInRTo: "_other"}),
+			ErrorInvalidSAMLResponse.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mockIDPService.On("GetIdentityProvider", mock.Anything, testIDPID).
+				Return(suite.newIDP(nil), nil).Maybe()
+
+			claims, svcErr := suite.service.ValidateResponse(context.Background(), testIDPID, tc.samlResponse)
+
+			suite.Nil(claims)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tc.expectedCode, svcErr.Code)
+		})
+	}
+}
+
+func (suite *SAMLAuthnServiceTestSuite) TestAuthenticate_ExistingUser() {
+	suite.expectIDP(nil)
+	userID := "user-1"
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{"sub": "alice@example.com"}).
+		Return(&userID, nil)
+	suite.mockEntityProvider.On("GetEntity", userID).Return(&entityprovider.Entity{ID: userID}, nil)
+
+	result, svcErr := suite.service.Authenticate(context.Background(), testIDPID, suite.buildResponse(responseOptions{}))
+
+	suite.Nil(svcErr)
+	suite.Equal("alice@example.com", result.Sub)
+	suite.Equal(userID, result.InternalEntity.ID)
+	suite.False(result.IsAmbiguousUser)
+}
+
+func (suite *SAMLAuthnServiceTestSuite) TestAuthenticate_UserResolution() {
+	testCases := []struct {
+		name        string
+		errorCode   entityprovider.ErrorCode
+		expectErr   bool
+		expectAmbig bool
+	}{
+		{name: "user not found", errorCode: entityprovider.ErrorCodeEntityNotFound},
+		{name: "ambiguous user", errorCode: entityprovider.ErrorCodeAmbiguousEntity, expectAmbig: true},
+		{name: "provider failure", errorCode: entityprovider.ErrorCodeSystemError, expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.expectIDP(nil)
+			suite.mockEntityProvider.On("IdentifyEntity", mock.Anything).
+				Return(nil, entityprovider.NewEntityProviderError(tc.errorCode, "error", "error"))
+
+			result, svcErr := suite.service.Authenticate(context.Background(), testIDPID,
+				suite.buildResponse(responseOptions{}))
+
+			if tc.expectErr {
+				suite.Nil(result)
+				suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+				return
+			}
+			suite.Nil(svcErr)
+			suite.Nil(result.InternalEntity)
+			suite.Equal(tc.expectAmbig, result.IsAmbiguousUser)
+		})
+	}
+}
+
+func (suite *SAMLAuthnServiceTestSuite) TestGetInternalUser_EmptySub() {
+	_, svcErr := suite.service.GetInternalUser("")
+
+	suite.Equal(authncm.ErrorSubClaimNotFound.Code, svcErr.Code)
+}
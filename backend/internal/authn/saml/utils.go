@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	idpPkg "github.com/asgardeo/thunder/internal/idp"
+	"github.com/asgardeo/thunder/internal/saml/saml2/message"
+)
+
+// clockSkew is the tolerated difference between the clocks of the server and the identity provider.
+const clockSkew = 2 * time.Minute
+
+// parseIDPConfig extracts the SAML configuration from the identity provider details.
+func parseIDPConfig(idp *idpPkg.IDPDTO) (*SAMLConfig, error) {
+	config := &SAMLConfig{}
+	for _, prop := range idp.Properties {
+		name := strings.TrimSpace(prop.GetName())
+		value, err := prop.GetValue()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get value for property %s: %w", name, err)
+		}
+		value = strings.TrimSpace(value)
+
+		switch name {
+		case idpPkg.PropSPEntityID:
+			config.SPEntityID = value
+		case idpPkg.PropACSURL:
+			config.ACSURL = value
+		case idpPkg.PropIDPEntityID:
+			config.IDPEntityID = value
+		case idpPkg.PropSSOURL:
+			config.SSOURL = value
+		case idpPkg.PropIDPCertificate:
+			if config.Certificate, err = idpPkg.ParseCertificate(value); err != nil {
+				return nil, err
+			}
+		case idpPkg.PropNameIDFormat:
+			config.NameIDFormat = value
+		case idpPkg.PropSubjectAttribute:
+			config.SubjectAttribute = value
+		case idpPkg.PropAttributeMapping:
+			if config.AttributeMapping, err = idpPkg.ParseAttributeMapping(value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if config.IDPEntityID == "" || config.SSOURL == "" || config.SPEntityID == "" || config.ACSURL == "" ||
+		config.Certificate == nil {
+		return nil, errors.New("incomplete SAML identity provider configuration")
+	}
+	return config, nil
+}
+
+// validateConditions validates the bearer subject confirmation and the conditions of the assertion.
+// The response is only accepted for the ACS URL and audience of the server within the validity
+// period of the assertion.
+func validateConditions(assertion *message.Assertion, config *SAMLConfig, now time.Time) error {
+	var confirmed bool
+	for _, confirmation := range assertion.SubjectConfirmations {
+		if confirmation.Recipient != config.ACSURL {
+			continue
+		}
+		if confirmation.NotOnOrAfter != "" {
+			notOnOrAfter, err := message.ParseTime(confirmation.NotOnOrAfter)
+			if err != nil || !now.Before(notOnOrAfter.Add(clockSkew)) {
+				continue
+			}
+		}
+		confirmed = true
+		break
+	}
+	if !confirmed {
+		return errors.New("no valid bearer subject confirmation")
+	}
+
+	if assertion.NotBefore != "" {
+		notBefore, err := message.ParseTime(assertion.NotBefore)
+		if err != nil {
+			return err
+		}
+		if now.Add(clockSkew).Before(notBefore) {
+			return errors.New("assertion is not yet valid")
+		}
+	}
+	if assertion.NotOnOrAfter != "" {
+		notOnOrAfter, err := message.ParseTime(assertion.NotOnOrAfter)
+		if err != nil {
+			return err
+		}
+		if !now.Before(notOnOrAfter.Add(clockSkew)) {
+			return errors.New("assertion has expired")
+		}
+	}
+
+	if len(assertion.Audiences) == 0 {
+		return errors.New("assertion has no audience restriction")
+	}
+	for _, audiences := range assertion.Audiences {
+		if !slices.Contains(audiences, config.SPEntityID) {
+			return errors.New("assertion is not intended for this service provider")
+		}
+	}
+	return nil
+}
+
+// getInResponseTo returns the ID of the request the assertion was issued for. The bearer subject
+// confirmation and the response must refer to the same request.
+func getInResponseTo(response *message.Response, assertion *message.Assertion) (string, error) {
+	inResponseTo := response.InResponseTo
+	for _, confirmation := range assertion.SubjectConfirmations {
+		if confirmation.InResponseTo == "" {
+			continue
+		}
+		if inResponseTo != "" && inResponseTo != confirmation.InResponseTo {
+			return "", errors.New("mismatched InResponseTo values")
+		}
+		inResponseTo = confirmation.InResponseTo
+	}
+	return inResponseTo, nil
+}
+
+// buildClaims builds the claims of the authenticated user from the assertion. The subject is taken
+// from the configured subject attribute, or the NameID otherwise.
+func buildClaims(assertion *message.Assertion, config *SAMLConfig) (map[string]interface{}, error) {
+	claims := make(map[string]interface{})
+	for name, values := range assertion.Attributes {
+		if len(values) == 0 {
+			continue
+		}
+		claimName := name
+		if len(config.AttributeMapping) > 0 {
+			mapped, ok := config.AttributeMapping[name]
+			if !ok {
+				continue
+			}
+			claimName = mapped
+		}
+		if len(values) == 1 {
+			claims[claimName] = values[0]
+		} else {
+			claims[claimName] = values
+		}
+	}
+
+	sub := assertion.NameID
+	if config.SubjectAttribute != "" {
+		sub = ""
+		if values := assertion.Attributes[config.SubjectAttribute]; len(values) > 0 {
+			sub = values[0]
+		}
+	}
+	if sub == "" {
+		return nil, errors.New("subject not found in the assertion")
+	}
+	claims[ClaimSub] = sub
+	if assertion.SessionIndex != "" {
+		claims[ClaimSessionIndex] = assertion.SessionIndex
+	}
+	return claims, nil
+}
@@ -545,6 +545,8 @@ func (as *authenticationService) mapCredentialsAuthnError(svcErr *serviceerror.S
 		return &common.ErrorUserNotFound
 	case authnprovidermgr.ErrorInvalidRequest.Code:
 		return &ErrorEmptyAttributesOrCredentials
+	case authnprovidermgr.ErrorAccountLocked.Code:
+		return svcErr
 	default:
 		logger.Error("Error occurred while authenticating with credentials",
 			log.String("errorCode", svcErr.Code), log.String("errorDescription", svcErr.ErrorDescription.DefaultValue))
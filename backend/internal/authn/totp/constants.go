@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package totp
+
+const (
+	// defaultIssuer is the issuer shown in authenticator apps when none is configured.
+	defaultIssuer = "Thunder"
+	// defaultDigits is the default number of digits of a TOTP code.
+	defaultDigits = 6
+	// defaultPeriod is the default time step of a TOTP code in seconds.
+	defaultPeriod = 30
+	// defaultDriftWindow is the default number of time steps accepted either side of the current one.
+	defaultDriftWindow = 1
+	// defaultRecoveryCodeCount is the default number of recovery codes issued on enrollment.
+	defaultRecoveryCodeCount = 10
+
+	// secretSize is the size of a generated TOTP secret in bytes, as recommended by RFC 4226.
+	secretSize = 20
+	// algorithm is the HMAC algorithm of the generated codes. SHA1 is the only algorithm supported
+	// by all common authenticator apps.
+	algorithm = "SHA1"
+	// recoveryCodeLength is the number of characters in a recovery code, excluding the separator.
+	recoveryCodeLength = 10
+	// recoveryCodeAlphabet is the alphabet of recovery codes, which omits look-alike characters.
+	recoveryCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	// userAttributeUsername is the user attribute used as the account name in authenticator apps.
+	userAttributeUsername = "username"
+	// userAttributeEmail is the user attribute used as the account name when there is no username.
+	userAttributeEmail = "email"
+)
@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package totp
+
+import (
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/i18n/core"
+)
+
+// Client errors for the TOTP service.
+var (
+	// ErrorEmptyEntityID is returned when the entity ID is empty.
+	ErrorEmptyEntityID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-TOTP-1001",
+		Error: core.I18nMessage{
+			Key:          "error.totpservice.empty_entity_id",
+			DefaultValue: "Empty entity ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.totpservice.empty_entity_id_description",
+			DefaultValue: "The entity ID is required",
+		},
+	}
+	// ErrorEntityNotFound is returned when the entity does not exist.
+	ErrorEntityNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-TOTP-1002",
+		Error: core.I18nMessage{
+			Key:          "error.totpservice.entity_not_found",
+			DefaultValue: "Entity not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.totpservice.entity_not_found_description",
+			DefaultValue: "The specified entity does not exist",
+		},
+	}
+	// ErrorTOTPNotEnrolled is returned when the entity has no confirmed TOTP enrollment.
+	ErrorTOTPNotEnrolled = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-TOTP-1003",
+		Error: core.I18nMessage{
+			Key:          "error.totpservice.totp_not_enrolled",
+			DefaultValue: "TOTP not enrolled",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.totpservice.totp_not_enrolled_description",
+			DefaultValue: "No authenticator app is enrolled for the user",
+		},
+	}
+	// ErrorTOTPAlreadyEnrolled is returned when the entity already has a confirmed TOTP enrollment.
+	ErrorTOTPAlreadyEnrolled = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-TOTP-1004",
+		Error: core.I18nMessage{
+			Key:          "error.totpservice.totp_already_enrolled",
+			DefaultValue: "TOTP already enrolled",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.totpservice.totp_already_enrolled_description",
+			DefaultValue: "An authenticator app is already enrolled for the user",
+		},
+	}
+	// ErrorEnrollmentNotStarted is returned when an enrollment is confirmed before it is started.
+	ErrorEnrollmentNotStarted = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-TOTP-1005",
+		Error: core.I18nMessage{
+			Key:          "error.totpservice.enrollment_not_started",
+			DefaultValue: "Enrollment not started",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.totpservice.enrollment_not_started_description",
+			DefaultValue: "No pending authenticator app enrollment was found for the user",
+		},
+	}
+	// ErrorEmptyCode is returned when the TOTP code is empty.
+	ErrorEmptyCode = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-TOTP-1006",
+		Error: core.I18nMessage{
+			Key:          "error.totpservice.empty_code",
+			DefaultValue: "Empty code",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.totpservice.empty_code_description",
+			DefaultValue: "The TOTP code is required",
+		},
+	}
+	// ErrorInvalidCode is returned when the TOTP or recovery code is incorrect, expired or already used.
+	ErrorInvalidCode = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-TOTP-1007",
+		Error: core.I18nMessage{
+			Key:          "error.totpservice.invalid_code",
+			DefaultValue: "Invalid code",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.totpservice.invalid_code_description",
+			DefaultValue: "The provided code is incorrect, expired or has already been used",
+		},
+	}
+	// ErrorUnauthenticated is returned when a self-service request has no authenticated subject.
+	ErrorUnauthenticated = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-TOTP-1008",
+		Error: core.I18nMessage{
+			Key:          "error.totpservice.unauthenticated",
+			DefaultValue: "Unauthenticated",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.totpservice.unauthenticated_description",
+			DefaultValue: "The request is not authenticated",
+		},
+	}
+	// ErrorInvalidRequestFormat is returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-TOTP-1009",
+		Error: core.I18nMessage{
+			Key:          "error.totpservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.totpservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+)
@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package totp
+
+import (
+	"net/http"
+	"strings"
+
+	serverconst "github.com/asgardeo/thunder/internal/system/constants"
+	"github.com/asgardeo/thunder/internal/system/error/apierror"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/log"
+	"github.com/asgardeo/thunder/internal/system/security"
+	sysutils "github.com/asgardeo/thunder/internal/system/utils"
+)
+
+const handlerLoggerComponentName = "TOTPHandler"
+
+// totpHandler is the handler for the self-service TOTP enrollment operations of the authenticated user.
+type totpHandler struct {
+	totpService TOTPServiceInterface
+}
+
+// newTOTPHandler creates a new instance of totpHandler with dependency injection.
+func newTOTPHandler(totpService TOTPServiceInterface) *totpHandler {
+	return &totpHandler{
+		totpService: totpService,
+	}
+}
+
+// HandleStatusRequest handles the TOTP enrollment status request of the authenticated user.
+func (h *totpHandler) HandleStatusRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	status, svcErr := h.totpService.GetStatus(ctx, userID)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, status)
+}
+
+// HandleEnrollRequest handles the TOTP enrollment start request of the authenticated user.
+func (h *totpHandler) HandleEnrollRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+	userID, ok := getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	enrollment, svcErr := h.totpService.StartEnrollment(ctx, userID)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	setNoStoreHeaders(w)
+	sysutils.WriteSuccessResponse(w, http.StatusOK, enrollment)
+	logger.Debug("TOTP enrollment response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// HandleConfirmRequest handles the TOTP enrollment confirmation request of the authenticated user.
+func (h *totpHandler) HandleConfirmRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+	userID, ok := getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	codeRequest, err := sysutils.DecodeJSONBody[TOTPCodeRequest](r)
+	if err != nil || codeRequest == nil {
+		handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	recoveryCodes, svcErr := h.totpService.ConfirmEnrollment(ctx, userID, codeRequest.Code)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	setNoStoreHeaders(w)
+	sysutils.WriteSuccessResponse(w, http.StatusOK, recoveryCodes)
+	logger.Debug("TOTP enrollment confirmation response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// HandleRecoveryCodesRequest handles the recovery code regeneration request of the authenticated user.
+func (h *totpHandler) HandleRecoveryCodesRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+	userID, ok := getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	codeRequest, err := sysutils.DecodeJSONBody[TOTPCodeRequest](r)
+	if err != nil || codeRequest == nil {
+		handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	recoveryCodes, svcErr := h.totpService.RegenerateRecoveryCodes(ctx, userID, codeRequest.Code)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	setNoStoreHeaders(w)
+	sysutils.WriteSuccessResponse(w, http.StatusOK, recoveryCodes)
+	logger.Debug("TOTP recovery codes response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// HandleDeleteRequest handles the TOTP enrollment removal request of the authenticated user.
+func (h *totpHandler) HandleDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+	userID, ok := getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	if svcErr := h.totpService.RemoveEnrollment(ctx, userID); svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+	logger.Debug("TOTP enrollment removal response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// getAuthenticatedUserID returns the ID of the authenticated user, writing an error response if the
+// request is not authenticated.
+func getAuthenticatedUserID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID := security.GetSubject(r.Context())
+	if strings.TrimSpace(userID) == "" {
+		handleError(w, &ErrorUnauthenticated)
+		return "", false
+	}
+	return userID, true
+}
+
+// setNoStoreHeaders prevents responses carrying secrets or recovery codes from being cached.
+func setNoStoreHeaders(w http.ResponseWriter) {
+	w.Header().Set(serverconst.CacheControlHeaderName, serverconst.CacheControlNoStore)
+	w.Header().Set(serverconst.PragmaHeaderName, serverconst.PragmaNoCache)
+}
+
+// handleError handles service errors and writes the corresponding error response.
+func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	var statusCode int
+	if svcErr.Type == serviceerror.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorEntityNotFound.Code, ErrorTOTPNotEnrolled.Code, ErrorEnrollmentNotStarted.Code:
+			statusCode = http.StatusNotFound
+		case ErrorTOTPAlreadyEnrolled.Code:
+			statusCode = http.StatusConflict
+		case ErrorUnauthenticated.Code:
+			statusCode = http.StatusUnauthorized
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	} else {
+		statusCode = http.StatusInternalServerError
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
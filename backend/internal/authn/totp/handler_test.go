@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package totp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/asgardeo/thunder/internal/system/error/apierror"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/security"
+)
+
+func newAuthenticatedRequest(method, target, body string) *http.Request {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	authCtx := security.NewSecurityContextForTest(testEntityID, "", "", nil, nil)
+	return req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
+}
+
+func TestHandleStatusRequest_Success(t *testing.T) {
+	mockSvc := NewTOTPServiceInterfaceMock(t)
+	mockSvc.On("GetStatus", mock.Anything, testEntityID).
+		Return(&TOTPStatus{Enrolled: true, RecoveryCodesRemaining: 3}, nil)
+
+	rr := httptest.NewRecorder()
+	newTOTPHandler(mockSvc).HandleStatusRequest(rr, newAuthenticatedRequest(http.MethodGet, "/users/me/totp", ""))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var status TOTPStatus
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&status))
+	require.True(t, status.Enrolled)
+	require.Equal(t, 3, status.RecoveryCodesRemaining)
+}
+
+func TestHandleStatusRequest_Unauthenticated(t *testing.T) {
+	mockSvc := NewTOTPServiceInterfaceMock(t)
+
+	rr := httptest.NewRecorder()
+	newTOTPHandler(mockSvc).HandleStatusRequest(rr, httptest.NewRequest(http.MethodGet, "/users/me/totp", nil))
+
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+	var errResp apierror.ErrorResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&errResp))
+	require.Equal(t, ErrorUnauthenticated.Code, errResp.Code)
+}
+
+func TestHandleEnrollRequest_Success(t *testing.T) {
+	mockSvc := NewTOTPServiceInterfaceMock(t)
+	mockSvc.On("StartEnrollment", mock.Anything, testEntityID).
+		Return(&TOTPEnrollment{Secret: "SECRET", QRPayload: "otpauth://totp/x", Digits: 6, Period: 30}, nil)
+
+	rr := httptest.NewRecorder()
+	newTOTPHandler(mockSvc).HandleEnrollRequest(rr,
+		newAuthenticatedRequest(http.MethodPost, "/users/me/totp/enroll", ""))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+	var enrollment TOTPEnrollment
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&enrollment))
+	require.Equal(t, "SECRET", enrollment.Secret)
+}
+
+func TestHandleEnrollRequest_AlreadyEnrolled(t *testing.T) {
+	mockSvc := NewTOTPServiceInterfaceMock(t)
+	mockSvc.On("StartEnrollment", mock.Anything, testEntityID).Return(nil, &ErrorTOTPAlreadyEnrolled)
+
+	rr := httptest.NewRecorder()
+	newTOTPHandler(mockSvc).HandleEnrollRequest(rr,
+		newAuthenticatedRequest(http.MethodPost, "/users/me/totp/enroll", ""))
+
+	require.Equal(t, http.StatusConflict, rr.Code)
+}
+
+func TestHandleConfirmRequest_Success(t *testing.T) {
+	mockSvc := NewTOTPServiceInterfaceMock(t)
+	mockSvc.On("ConfirmEnrollment", mock.Anything, testEntityID, "123456").
+		Return(&RecoveryCodes{Codes: []string{"ABCDE-FGHJK"}}, nil)
+
+	rr := httptest.NewRecorder()
+	newTOTPHandler(mockSvc).HandleConfirmRequest(rr,
+		newAuthenticatedRequest(http.MethodPost, "/users/me/totp/confirm", `{"code":"123456"}`))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var codes RecoveryCodes
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&codes))
+	require.Equal(t, []string{"ABCDE-FGHJK"}, codes.Codes)
+}
+
+func TestHandleConfirmRequest_InvalidBody(t *testing.T) {
+	mockSvc := NewTOTPServiceInterfaceMock(t)
+
+	rr := httptest.NewRecorder()
+	newTOTPHandler(mockSvc).HandleConfirmRequest(rr,
+		newAuthenticatedRequest(http.MethodPost, "/users/me/totp/confirm", `{`))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestHandleConfirmRequest_InvalidCode(t *testing.T) {
+	mockSvc := NewTOTPServiceInterfaceMock(t)
+	mockSvc.On("ConfirmEnrollment", mock.Anything, testEntityID, "000000").Return(nil, &ErrorInvalidCode)
+
+	rr := httptest.NewRecorder()
+	newTOTPHandler(mockSvc).HandleConfirmRequest(rr,
+		newAuthenticatedRequest(http.MethodPost, "/users/me/totp/confirm", `{"code":"000000"}`))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestHandleRecoveryCodesRequest_Success(t *testing.T) {
+	mockSvc := NewTOTPServiceInterfaceMock(t)
+	mockSvc.On("RegenerateRecoveryCodes", mock.Anything, testEntityID, "123456").
+		Return(&RecoveryCodes{Codes: []string{"ABCDE-FGHJK"}}, nil)
+
+	rr := httptest.NewRecorder()
+	newTOTPHandler(mockSvc).HandleRecoveryCodesRequest(rr,
+		newAuthenticatedRequest(http.MethodPost, "/users/me/totp/recovery-codes", `{"code":"123456"}`))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestHandleDeleteRequest_Success(t *testing.T) {
+	mockSvc := NewTOTPServiceInterfaceMock(t)
+	mockSvc.On("RemoveEnrollment", mock.Anything, testEntityID).Return(nil)
+
+	rr := httptest.NewRecorder()
+	newTOTPHandler(mockSvc).HandleDeleteRequest(rr, newAuthenticatedRequest(http.MethodDelete, "/users/me/totp", ""))
+
+	require.Equal(t, http.StatusNoContent, rr.Code)
+}
+
+func TestHandleDeleteRequest_NotEnrolled(t *testing.T) {
+	mockSvc := NewTOTPServiceInterfaceMock(t)
+	mockSvc.On("RemoveEnrollment", mock.Anything, testEntityID).Return(&ErrorTOTPNotEnrolled)
+
+	rr := httptest.NewRecorder()
+	newTOTPHandler(mockSvc).HandleDeleteRequest(rr, newAuthenticatedRequest(http.MethodDelete, "/users/me/totp", ""))
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestHandleDeleteRequest_ServerError(t *testing.T) {
+	mockSvc := NewTOTPServiceInterfaceMock(t)
+	mockSvc.On("RemoveEnrollment", mock.Anything, testEntityID).Return(&serviceerror.InternalServerError)
+
+	rr := httptest.NewRecorder()
+	newTOTPHandler(mockSvc).HandleDeleteRequest(rr, newAuthenticatedRequest(http.MethodDelete, "/users/me/totp", ""))
+
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package totp
+
+import (
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/authn/lockout"
+	"github.com/asgardeo/thunder/internal/entity"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
+	"github.com/asgardeo/thunder/internal/system/kmprovider"
+	"github.com/asgardeo/thunder/internal/system/middleware"
+)
+
+// Initialize initializes the TOTP service and registers the self-service enrollment routes.
+func Initialize(
+	mux *http.ServeMux,
+	entityService entity.EntityServiceInterface,
+	hashService hash.HashServiceInterface,
+	cryptoProvider kmprovider.ConfigCryptoProvider,
+	lockoutService lockout.LockoutServiceInterface,
+) TOTPServiceInterface {
+	runtime := config.GetServerRuntime()
+	store := newTOTPStore(runtime.Config.Server.Identifier)
+	totpService := newTOTPService(runtime.Config.TOTP, store, entityService, hashService, cryptoProvider,
+		lockoutService)
+
+	totpHandler := newTOTPHandler(totpService)
+	registerRoutes(mux, totpHandler)
+
+	return totpService
+}
+
+// registerRoutes registers the self-service TOTP enrollment routes of the authenticated user.
+func registerRoutes(mux *http.ServeMux, totpHandler *totpHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /users/me/totp", totpHandler.HandleStatusRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("DELETE /users/me/totp", totpHandler.HandleDeleteRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/totp", optionsNoContentHandler, opts))
+
+	mux.HandleFunc(middleware.WithCORS("POST /users/me/totp/enroll", totpHandler.HandleEnrollRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/totp/enroll", optionsNoContentHandler, opts))
+
+	mux.HandleFunc(middleware.WithCORS("POST /users/me/totp/confirm", totpHandler.HandleConfirmRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/totp/confirm", optionsNoContentHandler, opts))
+
+	mux.HandleFunc(middleware.WithCORS("POST /users/me/totp/recovery-codes",
+		totpHandler.HandleRecoveryCodesRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/totp/recovery-codes", optionsNoContentHandler, opts))
+}
+
+// optionsNoContentHandler handles the CORS preflight requests.
+func optionsNoContentHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package totp
+
+import (
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
+)
+
+// TOTPStatus represents the TOTP enrollment status of an entity.
+type TOTPStatus struct {
+	Enrolled               bool `json:"enrolled"`
+	RecoveryCodesRemaining int  `json:"recoveryCodesRemaining"`
+}
+
+// TOTPEnrollment holds the details an authenticator app needs to register a new TOTP secret.
+type TOTPEnrollment struct {
+	// Secret is the base32 encoded secret, for manual entry in the authenticator app.
+	Secret string `json:"secret"`
+	// QRPayload is the otpauth:// key URI to be rendered as the enrollment QR code.
+	QRPayload string `json:"qrPayload"`
+	Algorithm string `json:"algorithm"`
+	Digits    int    `json:"digits"`
+	Period    int64  `json:"period"`
+}
+
+// RecoveryCodes holds newly issued recovery codes. The codes are only returned once.
+type RecoveryCodes struct {
+	Codes []string `json:"recoveryCodes"`
+}
+
+// TOTPCodeRequest represents a request carrying a TOTP code.
+type TOTPCodeRequest struct {
+	Code string `json:"code"`
+}
+
+// totpRecord holds the stored TOTP credential of an entity.
+type totpRecord struct {
+	// Secret is the base32 encoded secret, encrypted with the server encryption key.
+	Secret    string `json:"secret"`
+	Confirmed bool   `json:"confirmed"`
+	// LastUsedStep is the time step of the last accepted code. Codes of this or earlier time steps
+	// are rejected to prevent replay.
+	LastUsedStep  int64             `json:"lastUsedStep"`
+	RecoveryCodes []hash.Credential `json:"recoveryCodes,omitempty"`
+	CreatedAt     time.Time         `json:"createdAt"`
+	// Version is incremented on every write of the record. It is stored in a column of its own and
+	// guards updates against concurrent changes to the record.
+	Version int64 `json:"-"`
+}
@@ -0,0 +1,469 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package totp implements time-based one-time passwords (RFC 6238) as an authenticator app second
+// factor, along with the recovery codes that replace a lost authenticator.
+package totp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/authn/lockout"
+	"github.com/asgardeo/thunder/internal/entity"
+	"github.com/asgardeo/thunder/internal/system/config"
+	sysContext "github.com/asgardeo/thunder/internal/system/context"
+	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/kmprovider"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+// TOTPServiceInterface defines the interface of the TOTP service.
+type TOTPServiceInterface interface {
+	// GetStatus returns the TOTP enrollment status of the entity.
+	GetStatus(ctx context.Context, entityID string) (*TOTPStatus, *serviceerror.ServiceError)
+	// StartEnrollment generates a new secret for the entity. The enrollment takes effect once it is
+	// confirmed with a code from the authenticator app.
+	StartEnrollment(ctx context.Context, entityID string) (*TOTPEnrollment, *serviceerror.ServiceError)
+	// ConfirmEnrollment confirms the pending enrollment of the entity with a code from the
+	// authenticator app and issues the recovery codes.
+	ConfirmEnrollment(ctx context.Context, entityID, code string) (*RecoveryCodes, *serviceerror.ServiceError)
+	// VerifyCode verifies a TOTP code or a recovery code of the entity. Accepted codes cannot be used
+	// again, and failed attempts count towards the account lockout of the entity.
+	VerifyCode(ctx context.Context, entityID, code string) *serviceerror.ServiceError
+	// RegenerateRecoveryCodes replaces the recovery codes of the entity after verifying a TOTP code.
+	RegenerateRecoveryCodes(ctx context.Context, entityID, code string) (*RecoveryCodes, *serviceerror.ServiceError)
+	// RemoveEnrollment removes the TOTP credential and recovery codes of the entity.
+	RemoveEnrollment(ctx context.Context, entityID string) *serviceerror.ServiceError
+}
+
+// totpService is the default implementation of TOTPServiceInterface.
+type totpService struct {
+	config         config.TOTPConfig
+	store          totpStoreInterface
+	entityService  entity.EntityServiceInterface
+	hashService    hash.HashServiceInterface
+	cryptoProvider kmprovider.ConfigCryptoProvider
+	lockoutService lockout.LockoutServiceInterface
+	logger         *log.Logger
+}
+
+// newTOTPService creates a new instance of totpService with injected dependencies.
+func newTOTPService(
+	totpConfig config.TOTPConfig,
+	store totpStoreInterface,
+	entityService entity.EntityServiceInterface,
+	hashService hash.HashServiceInterface,
+	cryptoProvider kmprovider.ConfigCryptoProvider,
+	lockoutService lockout.LockoutServiceInterface,
+) TOTPServiceInterface {
+	return &totpService{
+		config:         withDefaults(totpConfig),
+		store:          store,
+		entityService:  entityService,
+		hashService:    hashService,
+		cryptoProvider: cryptoProvider,
+		lockoutService: lockoutService,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, "TOTPService")),
+	}
+}
+
+// GetStatus returns the TOTP enrollment status of the entity.
+func (s *totpService) GetStatus(ctx context.Context, entityID string) (*TOTPStatus, *serviceerror.ServiceError) {
+	if strings.TrimSpace(entityID) == "" {
+		return nil, &ErrorEmptyEntityID
+	}
+
+	record, svcErr := s.getRecord(ctx, entityID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if record == nil || !record.Confirmed {
+		return &TOTPStatus{}, nil
+	}
+	return &TOTPStatus{Enrolled: true, RecoveryCodesRemaining: len(record.RecoveryCodes)}, nil
+}
+
+// StartEnrollment generates a new secret for the entity. A pending enrollment is replaced, while an
+// entity with a confirmed enrollment has to remove it first.
+func (s *totpService) StartEnrollment(
+	ctx context.Context, entityID string,
+) (*TOTPEnrollment, *serviceerror.ServiceError) {
+	if strings.TrimSpace(entityID) == "" {
+		return nil, &ErrorEmptyEntityID
+	}
+
+	accountName, svcErr := s.getAccountName(ctx, entityID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	record, svcErr := s.getRecord(ctx, entityID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if record != nil && record.Confirmed {
+		return nil, &ErrorTOTPAlreadyEnrolled
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		s.logger.Error("Failed to generate TOTP secret", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	encryptedSecret, err := s.cryptoProvider.Encrypt(ctx, []byte(secret))
+	if err != nil {
+		s.logger.Error("Failed to encrypt TOTP secret", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	newRecord := totpRecord{
+		Secret:    string(encryptedSecret),
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.store.SaveTOTPRecord(ctx, entityID, newRecord); err != nil {
+		s.logger.Error("Failed to save TOTP credential", log.MaskedString(log.LoggerKeyUserID, entityID),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("TOTP enrollment started", log.MaskedString(log.LoggerKeyUserID, entityID))
+	return &TOTPEnrollment{
+		Secret:    secret,
+		QRPayload: buildKeyURI(s.config.Issuer, accountName, secret, s.config.Digits, s.config.Period),
+		Algorithm: algorithm,
+		Digits:    s.config.Digits,
+		Period:    s.config.Period,
+	}, nil
+}
+
+// ConfirmEnrollment confirms the pending enrollment of the entity with a code from the
+// authenticator app and issues the recovery codes.
+func (s *totpService) ConfirmEnrollment(
+	ctx context.Context, entityID, code string,
+) (*RecoveryCodes, *serviceerror.ServiceError) {
+	if strings.TrimSpace(entityID) == "" {
+		return nil, &ErrorEmptyEntityID
+	}
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, &ErrorEmptyCode
+	}
+
+	record, svcErr := s.getRecord(ctx, entityID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if record == nil {
+		return nil, &ErrorEnrollmentNotStarted
+	}
+	if record.Confirmed {
+		return nil, &ErrorTOTPAlreadyEnrolled
+	}
+
+	step, svcErr := s.matchTOTPCode(ctx, record, code)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	codes, hashedCodes, svcErr := s.generateRecoveryCodes()
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	record.Confirmed = true
+	record.LastUsedStep = step
+	record.RecoveryCodes = hashedCodes
+	if svcErr := s.updateRecord(ctx, entityID, record); svcErr != nil {
+		return nil, svcErr
+	}
+
+	s.logger.Debug("TOTP enrollment confirmed", log.MaskedString(log.LoggerKeyUserID, entityID))
+	return &RecoveryCodes{Codes: codes}, nil
+}
+
+// VerifyCode verifies a TOTP code or a recovery code of the entity. Attempts are rejected while the
+// entity or client is locked out, and invalid codes are counted as failed login attempts.
+func (s *totpService) VerifyCode(ctx context.Context, entityID, code string) *serviceerror.ServiceError {
+	if strings.TrimSpace(entityID) == "" {
+		return &ErrorEmptyEntityID
+	}
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return &ErrorEmptyCode
+	}
+
+	subjects := lockout.AttemptSubjects{EntityID: entityID, ClientIP: sysContext.GetClientIP(ctx)}
+	if svcErr := s.lockoutService.CheckAttempt(ctx, subjects); svcErr != nil {
+		return svcErr
+	}
+
+	if svcErr := s.verifyCode(ctx, entityID, code); svcErr != nil {
+		if svcErr.Code == ErrorInvalidCode.Code {
+			s.lockoutService.RecordFailure(ctx, subjects)
+		}
+		return svcErr
+	}
+	s.lockoutService.RecordSuccess(ctx, subjects)
+	return nil
+}
+
+// verifyCode verifies a code of the entity. Numeric codes are verified as TOTP codes and anything
+// else as a recovery code, which is consumed on use.
+func (s *totpService) verifyCode(ctx context.Context, entityID, code string) *serviceerror.ServiceError {
+	record, svcErr := s.getConfirmedRecord(ctx, entityID)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	if isNumeric(code) {
+		step, svcErr := s.matchTOTPCode(ctx, record, code)
+		if svcErr != nil {
+			return svcErr
+		}
+		record.LastUsedStep = step
+		return s.updateRecord(ctx, entityID, record)
+	}
+
+	index := s.matchRecoveryCode(record, code)
+	if index < 0 {
+		return &ErrorInvalidCode
+	}
+	record.RecoveryCodes = append(record.RecoveryCodes[:index], record.RecoveryCodes[index+1:]...)
+	if svcErr := s.updateRecord(ctx, entityID, record); svcErr != nil {
+		return svcErr
+	}
+
+	s.logger.Debug("TOTP recovery code used", log.MaskedString(log.LoggerKeyUserID, entityID),
+		log.Int("recoveryCodesRemaining", len(record.RecoveryCodes)))
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the entity after verifying a TOTP code.
+func (s *totpService) RegenerateRecoveryCodes(
+	ctx context.Context, entityID, code string,
+) (*RecoveryCodes, *serviceerror.ServiceError) {
+	if strings.TrimSpace(entityID) == "" {
+		return nil, &ErrorEmptyEntityID
+	}
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, &ErrorEmptyCode
+	}
+
+	record, svcErr := s.getConfirmedRecord(ctx, entityID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	step, svcErr := s.matchTOTPCode(ctx, record, code)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	codes, hashedCodes, svcErr := s.generateRecoveryCodes()
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	record.LastUsedStep = step
+	record.RecoveryCodes = hashedCodes
+	if svcErr := s.updateRecord(ctx, entityID, record); svcErr != nil {
+		return nil, svcErr
+	}
+
+	s.logger.Debug("TOTP recovery codes regenerated", log.MaskedString(log.LoggerKeyUserID, entityID))
+	return &RecoveryCodes{Codes: codes}, nil
+}
+
+// RemoveEnrollment removes the TOTP credential and recovery codes of the entity.
+func (s *totpService) RemoveEnrollment(ctx context.Context, entityID string) *serviceerror.ServiceError {
+	if strings.TrimSpace(entityID) == "" {
+		return &ErrorEmptyEntityID
+	}
+
+	record, svcErr := s.getRecord(ctx, entityID)
+	if svcErr != nil {
+		return svcErr
+	}
+	if record == nil {
+		return &ErrorTOTPNotEnrolled
+	}
+	if err := s.store.DeleteTOTPRecord(ctx, entityID); err != nil {
+		s.logger.Error("Failed to delete TOTP credential", log.MaskedString(log.LoggerKeyUserID, entityID),
+			log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("TOTP enrollment removed", log.MaskedString(log.LoggerKeyUserID, entityID))
+	return nil
+}
+
+// getRecord retrieves the TOTP record of the entity. Returns nil if there is none.
+func (s *totpService) getRecord(ctx context.Context, entityID string) (*totpRecord, *serviceerror.ServiceError) {
+	record, err := s.store.GetTOTPRecord(ctx, entityID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve TOTP credential", log.MaskedString(log.LoggerKeyUserID, entityID),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return record, nil
+}
+
+// getConfirmedRecord retrieves the TOTP record of the entity, which must be confirmed.
+func (s *totpService) getConfirmedRecord(
+	ctx context.Context, entityID string,
+) (*totpRecord, *serviceerror.ServiceError) {
+	record, svcErr := s.getRecord(ctx, entityID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if record == nil || !record.Confirmed {
+		return nil, &ErrorTOTPNotEnrolled
+	}
+	return record, nil
+}
+
+// updateRecord saves the TOTP record of the entity after one of its codes was accepted. The record
+// is only written if it has not been changed since it was read, so that a TOTP code or recovery code
+// accepted by a concurrent request is rejected rather than accepted twice.
+func (s *totpService) updateRecord(
+	ctx context.Context, entityID string, record *totpRecord,
+) *serviceerror.ServiceError {
+	updated, err := s.store.UpdateTOTPRecord(ctx, entityID, *record)
+	if err != nil {
+		s.logger.Error("Failed to save TOTP credential", log.MaskedString(log.LoggerKeyUserID, entityID),
+			log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if !updated {
+		s.logger.Debug("TOTP credential was changed by a concurrent request",
+			log.MaskedString(log.LoggerKeyUserID, entityID))
+		return &ErrorInvalidCode
+	}
+	return nil
+}
+
+// matchTOTPCode decrypts the secret of the record and returns the time step matched by the code.
+func (s *totpService) matchTOTPCode(
+	ctx context.Context, record *totpRecord, code string,
+) (int64, *serviceerror.ServiceError) {
+	if len(code) != s.config.Digits || !isNumeric(code) {
+		return 0, &ErrorInvalidCode
+	}
+
+	decrypted, err := s.cryptoProvider.Decrypt(ctx, []byte(record.Secret))
+	if err != nil {
+		s.logger.Error("Failed to decrypt TOTP secret", log.Error(err))
+		return 0, &serviceerror.InternalServerError
+	}
+	secret, err := secretEncoding.DecodeString(string(decrypted))
+	if err != nil {
+		s.logger.Error("Failed to decode TOTP secret", log.Error(err))
+		return 0, &serviceerror.InternalServerError
+	}
+
+	currentStep := time.Now().Unix() / s.config.Period
+	step, ok := matchCode(secret, code, currentStep, record.LastUsedStep, s.config.Digits, s.config.DriftWindow)
+	if !ok {
+		return 0, &ErrorInvalidCode
+	}
+	return step, nil
+}
+
+// matchRecoveryCode returns the index of the stored recovery code matching the code, or -1.
+func (s *totpService) matchRecoveryCode(record *totpRecord, code string) int {
+	normalized := []byte(normalizeRecoveryCode(code))
+	for i, stored := range record.RecoveryCodes {
+		ok, err := s.hashService.Verify(normalized, stored)
+		if err == nil && ok {
+			return i
+		}
+	}
+	return -1
+}
+
+// generateRecoveryCodes generates a new set of recovery codes along with their hashes.
+func (s *totpService) generateRecoveryCodes() ([]string, []hash.Credential, *serviceerror.ServiceError) {
+	codes := make([]string, 0, s.config.RecoveryCodeCount)
+	hashedCodes := make([]hash.Credential, 0, s.config.RecoveryCodeCount)
+	for range s.config.RecoveryCodeCount {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			s.logger.Error("Failed to generate recovery code", log.Error(err))
+			return nil, nil, &serviceerror.InternalServerError
+		}
+		hashed, err := s.hashService.Generate([]byte(normalizeRecoveryCode(code)))
+		if err != nil {
+			s.logger.Error("Failed to hash recovery code", log.Error(err))
+			return nil, nil, &serviceerror.InternalServerError
+		}
+		codes = append(codes, code)
+		hashedCodes = append(hashedCodes, hashed)
+	}
+	return codes, hashedCodes, nil
+}
+
+// getAccountName returns the account name of the entity shown in authenticator apps, which is its
+// username or email address, falling back to the entity ID.
+func (s *totpService) getAccountName(ctx context.Context, entityID string) (string, *serviceerror.ServiceError) {
+	e, err := s.entityService.GetEntity(ctx, entityID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return "", &ErrorEntityNotFound
+		}
+		s.logger.Error("Failed to retrieve entity", log.MaskedString(log.LoggerKeyUserID, entityID),
+			log.Error(err))
+		return "", &serviceerror.InternalServerError
+	}
+
+	var attrs map[string]interface{}
+	if len(e.Attributes) > 0 {
+		if err := json.Unmarshal(e.Attributes, &attrs); err != nil {
+			s.logger.Error("Failed to unmarshal entity attributes", log.Error(err))
+			return "", &serviceerror.InternalServerError
+		}
+	}
+	for _, attr := range []string{userAttributeUsername, userAttributeEmail} {
+		if value, ok := attrs[attr].(string); ok && value != "" {
+			return value, nil
+		}
+	}
+	return entityID, nil
+}
+
+// withDefaults fills in the unset values of the TOTP configuration with their defaults.
+func withDefaults(totpConfig config.TOTPConfig) config.TOTPConfig {
+	if totpConfig.Issuer == "" {
+		totpConfig.Issuer = defaultIssuer
+	}
+	// Authenticator apps only support six and eight digit codes.
+	if totpConfig.Digits != 6 && totpConfig.Digits != 8 {
+		totpConfig.Digits = defaultDigits
+	}
+	if totpConfig.Period <= 0 {
+		totpConfig.Period = defaultPeriod
+	}
+	if totpConfig.DriftWindow < 0 {
+		totpConfig.DriftWindow = defaultDriftWindow
+	}
+	if totpConfig.RecoveryCodeCount <= 0 {
+		totpConfig.RecoveryCodeCount = defaultRecoveryCodeCount
+	}
+	return totpConfig
+}
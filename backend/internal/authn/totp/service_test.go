@@ -0,0 +1,403 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package totp
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/authn/lockout"
+	"github.com/asgardeo/thunder/internal/entity"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/tests/mocks/authn/lockoutmock"
+	"github.com/asgardeo/thunder/tests/mocks/crypto/cryptomock"
+	"github.com/asgardeo/thunder/tests/mocks/crypto/hashmock"
+	"github.com/asgardeo/thunder/tests/mocks/entitymock"
+)
+
+const (
+	testEntityID     = "entity-1"
+	testSecret       = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	encryptionPrefix = "enc:"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	mockStore          *totpStoreInterfaceMock
+	mockEntityService  *entitymock.EntityServiceInterfaceMock
+	mockHashService    *hashmock.HashServiceInterfaceMock
+	mockCryptoProvider *cryptomock.ConfigCryptoProviderMock
+	mockLockout        *lockoutmock.LockoutServiceInterfaceMock
+	service            *totpService
+	ctx                context.Context
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	s.mockStore = newTotpStoreInterfaceMock(s.T())
+	s.mockEntityService = entitymock.NewEntityServiceInterfaceMock(s.T())
+	s.mockHashService = hashmock.NewHashServiceInterfaceMock(s.T())
+	s.mockCryptoProvider = cryptomock.NewConfigCryptoProviderMock(s.T())
+	s.mockLockout = lockoutmock.NewLockoutServiceInterfaceMock(s.T())
+	s.service = newTOTPService(config.TOTPConfig{RecoveryCodeCount: 2, DriftWindow: 1}, s.mockStore,
+		s.mockEntityService, s.mockHashService, s.mockCryptoProvider, s.mockLockout).(*totpService)
+	s.ctx = context.Background()
+
+	s.mockCryptoProvider.On("Encrypt", mock.Anything, mock.Anything).Maybe().
+		Return(func(_ context.Context, content []byte) ([]byte, error) {
+			return append([]byte(encryptionPrefix), content...), nil
+		})
+	s.mockCryptoProvider.On("Decrypt", mock.Anything, mock.Anything).Maybe().
+		Return(func(_ context.Context, content []byte) ([]byte, error) {
+			return []byte(strings.TrimPrefix(string(content), encryptionPrefix)), nil
+		})
+}
+
+// currentCode returns the TOTP code of the test secret for the current time step.
+func (s *ServiceTestSuite) currentCode() string {
+	secret, err := secretEncoding.DecodeString(testSecret)
+	s.Require().NoError(err)
+	return generateCode(secret, time.Now().Unix()/defaultPeriod, defaultDigits)
+}
+
+func (s *ServiceTestSuite) confirmedRecord() *totpRecord {
+	return &totpRecord{
+		Secret:        encryptionPrefix + testSecret,
+		Confirmed:     true,
+		RecoveryCodes: []hash.Credential{{Hash: "recovery-1"}, {Hash: "recovery-2"}},
+	}
+}
+
+func (s *ServiceTestSuite) TestWithDefaults() {
+	cfg := withDefaults(config.TOTPConfig{Digits: 7, DriftWindow: -1})
+
+	s.Equal(defaultIssuer, cfg.Issuer)
+	s.Equal(defaultDigits, cfg.Digits)
+	s.Equal(int64(defaultPeriod), cfg.Period)
+	s.Equal(defaultDriftWindow, cfg.DriftWindow)
+	s.Equal(defaultRecoveryCodeCount, cfg.RecoveryCodeCount)
+}
+
+func (s *ServiceTestSuite) TestGetStatus_NotEnrolled() {
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(nil, nil)
+
+	status, svcErr := s.service.GetStatus(s.ctx, testEntityID)
+
+	s.Nil(svcErr)
+	s.False(status.Enrolled)
+}
+
+func (s *ServiceTestSuite) TestGetStatus_PendingEnrollment() {
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(&totpRecord{Secret: "secret"}, nil)
+
+	status, svcErr := s.service.GetStatus(s.ctx, testEntityID)
+
+	s.Nil(svcErr)
+	s.False(status.Enrolled)
+}
+
+func (s *ServiceTestSuite) TestGetStatus_Enrolled() {
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(s.confirmedRecord(), nil)
+
+	status, svcErr := s.service.GetStatus(s.ctx, testEntityID)
+
+	s.Nil(svcErr)
+	s.True(status.Enrolled)
+	s.Equal(2, status.RecoveryCodesRemaining)
+}
+
+func (s *ServiceTestSuite) TestGetStatus_EmptyEntityID() {
+	_, svcErr := s.service.GetStatus(s.ctx, " ")
+
+	s.Equal(&ErrorEmptyEntityID, svcErr)
+}
+
+func (s *ServiceTestSuite) TestGetStatus_StoreError() {
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(nil, errors.New("db error"))
+
+	_, svcErr := s.service.GetStatus(s.ctx, testEntityID)
+
+	s.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (s *ServiceTestSuite) TestStartEnrollment_Success() {
+	s.mockEntityService.On("GetEntity", s.ctx, testEntityID).
+		Return(&entity.Entity{ID: testEntityID, Attributes: []byte(`{"username":"alice"}`)}, nil)
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(nil, nil)
+	var saved totpRecord
+	s.mockStore.On("SaveTOTPRecord", s.ctx, testEntityID, mock.AnythingOfType("totp.totpRecord")).
+		Run(func(args mock.Arguments) { saved = args.Get(2).(totpRecord) }).Return(nil)
+
+	enrollment, svcErr := s.service.StartEnrollment(s.ctx, testEntityID)
+
+	s.Nil(svcErr)
+	s.Equal(encryptionPrefix+enrollment.Secret, saved.Secret)
+	s.False(saved.Confirmed)
+	s.Equal(algorithm, enrollment.Algorithm)
+	s.Equal(defaultDigits, enrollment.Digits)
+	s.Equal(int64(defaultPeriod), enrollment.Period)
+
+	uri, err := url.Parse(enrollment.QRPayload)
+	s.NoError(err)
+	s.Equal("/Thunder:alice", uri.Path)
+	s.Equal(enrollment.Secret, uri.Query().Get("secret"))
+}
+
+func (s *ServiceTestSuite) TestStartEnrollment_AccountNameFallsBackToEmail() {
+	s.mockEntityService.On("GetEntity", s.ctx, testEntityID).
+		Return(&entity.Entity{ID: testEntityID, Attributes: []byte(`{"email":"alice@example.com"}`)}, nil)
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(nil, nil)
+	s.mockStore.On("SaveTOTPRecord", s.ctx, testEntityID, mock.Anything).Return(nil)
+
+	enrollment, svcErr := s.service.StartEnrollment(s.ctx, testEntityID)
+
+	s.Nil(svcErr)
+	uri, err := url.Parse(enrollment.QRPayload)
+	s.NoError(err)
+	s.Equal("/Thunder:alice@example.com", uri.Path)
+}
+
+func (s *ServiceTestSuite) TestStartEnrollment_AlreadyEnrolled() {
+	s.mockEntityService.On("GetEntity", s.ctx, testEntityID).Return(&entity.Entity{ID: testEntityID}, nil)
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(s.confirmedRecord(), nil)
+
+	_, svcErr := s.service.StartEnrollment(s.ctx, testEntityID)
+
+	s.Equal(&ErrorTOTPAlreadyEnrolled, svcErr)
+}
+
+func (s *ServiceTestSuite) TestStartEnrollment_EntityNotFound() {
+	s.mockEntityService.On("GetEntity", s.ctx, testEntityID).Return(nil, entity.ErrEntityNotFound)
+
+	_, svcErr := s.service.StartEnrollment(s.ctx, testEntityID)
+
+	s.Equal(&ErrorEntityNotFound, svcErr)
+}
+
+func (s *ServiceTestSuite) TestConfirmEnrollment_Success() {
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).
+		Return(&totpRecord{Secret: encryptionPrefix + testSecret}, nil)
+	s.mockHashService.On("Generate", mock.Anything).Return(hash.Credential{Hash: "hashed"}, nil)
+	var saved totpRecord
+	s.mockStore.On("UpdateTOTPRecord", s.ctx, testEntityID, mock.Anything).
+		Run(func(args mock.Arguments) { saved = args.Get(2).(totpRecord) }).Return(true, nil)
+
+	codes, svcErr := s.service.ConfirmEnrollment(s.ctx, testEntityID, s.currentCode())
+
+	s.Nil(svcErr)
+	s.Len(codes.Codes, 2)
+	s.True(saved.Confirmed)
+	s.NotZero(saved.LastUsedStep)
+	s.Len(saved.RecoveryCodes, 2)
+}
+
+func (s *ServiceTestSuite) TestConfirmEnrollment_NotStarted() {
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(nil, nil)
+
+	_, svcErr := s.service.ConfirmEnrollment(s.ctx, testEntityID, "123456")
+
+	s.Equal(&ErrorEnrollmentNotStarted, svcErr)
+}
+
+func (s *ServiceTestSuite) TestConfirmEnrollment_InvalidCode() {
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).
+		Return(&totpRecord{Secret: encryptionPrefix + testSecret}, nil)
+
+	_, svcErr := s.service.ConfirmEnrollment(s.ctx, testEntityID, "12345")
+
+	s.Equal(&ErrorInvalidCode, svcErr)
+}
+
+func (s *ServiceTestSuite) TestConfirmEnrollment_EmptyCode() {
+	_, svcErr := s.service.ConfirmEnrollment(s.ctx, testEntityID, "")
+
+	s.Equal(&ErrorEmptyCode, svcErr)
+}
+
+func (s *ServiceTestSuite) TestVerifyCode_TOTPCode() {
+	s.mockLockout.On("CheckAttempt", s.ctx, lockout.AttemptSubjects{EntityID: testEntityID}).Return(nil)
+	s.mockLockout.On("RecordSuccess", s.ctx, lockout.AttemptSubjects{EntityID: testEntityID}).Return()
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(s.confirmedRecord(), nil)
+	var saved totpRecord
+	s.mockStore.On("UpdateTOTPRecord", s.ctx, testEntityID, mock.Anything).
+		Run(func(args mock.Arguments) { saved = args.Get(2).(totpRecord) }).Return(true, nil)
+
+	svcErr := s.service.VerifyCode(s.ctx, testEntityID, s.currentCode())
+
+	s.Nil(svcErr)
+	s.NotZero(saved.LastUsedStep)
+}
+
+func (s *ServiceTestSuite) TestVerifyCode_RejectsReplay() {
+	record := s.confirmedRecord()
+	record.LastUsedStep = time.Now().Unix()/defaultPeriod + 1
+	s.mockLockout.On("CheckAttempt", s.ctx, mock.Anything).Return(nil)
+	s.mockLockout.On("RecordFailure", s.ctx, lockout.AttemptSubjects{EntityID: testEntityID}).Return()
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(record, nil)
+
+	svcErr := s.service.VerifyCode(s.ctx, testEntityID, s.currentCode())
+
+	s.Equal(&ErrorInvalidCode, svcErr)
+}
+
+func (s *ServiceTestSuite) TestVerifyCode_RejectsConcurrentUse() {
+	s.mockLockout.On("CheckAttempt", s.ctx, mock.Anything).Return(nil)
+	s.mockLockout.On("RecordFailure", s.ctx, mock.Anything).Return()
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(s.confirmedRecord(), nil)
+	s.mockStore.On("UpdateTOTPRecord", s.ctx, testEntityID, mock.Anything).Return(false, nil)
+
+	svcErr := s.service.VerifyCode(s.ctx, testEntityID, s.currentCode())
+
+	s.Equal(&ErrorInvalidCode, svcErr)
+}
+
+func (s *ServiceTestSuite) TestVerifyCode_UpdateError() {
+	s.mockLockout.On("CheckAttempt", s.ctx, mock.Anything).Return(nil)
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(s.confirmedRecord(), nil)
+	s.mockStore.On("UpdateTOTPRecord", s.ctx, testEntityID, mock.Anything).
+		Return(false, errors.New("db error"))
+
+	svcErr := s.service.VerifyCode(s.ctx, testEntityID, s.currentCode())
+
+	s.Equal(&serviceerror.InternalServerError, svcErr)
+	s.mockLockout.AssertNotCalled(s.T(), "RecordFailure", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestVerifyCode_LockedOut() {
+	s.mockLockout.On("CheckAttempt", s.ctx, lockout.AttemptSubjects{EntityID: testEntityID}).
+		Return(&lockout.ErrorAccountLocked)
+
+	svcErr := s.service.VerifyCode(s.ctx, testEntityID, s.currentCode())
+
+	s.Equal(&lockout.ErrorAccountLocked, svcErr)
+	s.mockStore.AssertNotCalled(s.T(), "GetTOTPRecord", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestVerifyCode_RecoveryCode() {
+	s.mockLockout.On("CheckAttempt", s.ctx, mock.Anything).Return(nil)
+	s.mockLockout.On("RecordSuccess", s.ctx, mock.Anything).Return()
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(s.confirmedRecord(), nil)
+	s.mockHashService.On("Verify", []byte("ABCDEFGHJK"), hash.Credential{Hash: "recovery-1"}).Return(false, nil)
+	s.mockHashService.On("Verify", []byte("ABCDEFGHJK"), hash.Credential{Hash: "recovery-2"}).Return(true, nil)
+	var saved totpRecord
+	s.mockStore.On("UpdateTOTPRecord", s.ctx, testEntityID, mock.Anything).
+		Run(func(args mock.Arguments) { saved = args.Get(2).(totpRecord) }).Return(true, nil)
+
+	svcErr := s.service.VerifyCode(s.ctx, testEntityID, "abcde-fghjk")
+
+	s.Nil(svcErr)
+	s.Equal([]hash.Credential{{Hash: "recovery-1"}}, saved.RecoveryCodes)
+}
+
+func (s *ServiceTestSuite) TestVerifyCode_RecoveryCodeRejectsConcurrentUse() {
+	s.mockLockout.On("CheckAttempt", s.ctx, mock.Anything).Return(nil)
+	s.mockLockout.On("RecordFailure", s.ctx, lockout.AttemptSubjects{EntityID: testEntityID}).Return()
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(s.confirmedRecord(), nil)
+	s.mockHashService.On("Verify", []byte("ABCDEFGHJK"), hash.Credential{Hash: "recovery-1"}).Return(true, nil)
+	s.mockStore.On("UpdateTOTPRecord", s.ctx, testEntityID, mock.Anything).Return(false, nil)
+
+	svcErr := s.service.VerifyCode(s.ctx, testEntityID, "ABCDE-FGHJK")
+
+	s.Equal(&ErrorInvalidCode, svcErr)
+}
+
+func (s *ServiceTestSuite) TestVerifyCode_InvalidRecoveryCode() {
+	s.mockLockout.On("CheckAttempt", s.ctx, mock.Anything).Return(nil)
+	s.mockLockout.On("RecordFailure", s.ctx, lockout.AttemptSubjects{EntityID: testEntityID}).Return()
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(s.confirmedRecord(), nil)
+	s.mockHashService.On("Verify", mock.Anything, mock.Anything).Return(false, nil)
+
+	svcErr := s.service.VerifyCode(s.ctx, testEntityID, "ABCDE-FGHJK")
+
+	s.Equal(&ErrorInvalidCode, svcErr)
+}
+
+func (s *ServiceTestSuite) TestVerifyCode_NotEnrolled() {
+	s.mockLockout.On("CheckAttempt", s.ctx, mock.Anything).Return(nil)
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(&totpRecord{Secret: "secret"}, nil)
+
+	svcErr := s.service.VerifyCode(s.ctx, testEntityID, "123456")
+
+	s.Equal(&ErrorTOTPNotEnrolled, svcErr)
+}
+
+func (s *ServiceTestSuite) TestVerifyCode_DecryptError() {
+	s.mockCryptoProvider.ExpectedCalls = nil
+	s.mockCryptoProvider.On("Decrypt", mock.Anything, mock.Anything).Return(nil, errors.New("decrypt error"))
+	s.mockLockout.On("CheckAttempt", s.ctx, mock.Anything).Return(nil)
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(s.confirmedRecord(), nil)
+
+	svcErr := s.service.VerifyCode(s.ctx, testEntityID, "123456")
+
+	s.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (s *ServiceTestSuite) TestRegenerateRecoveryCodes_Success() {
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(s.confirmedRecord(), nil)
+	s.mockHashService.On("Generate", mock.Anything).Return(hash.Credential{Hash: "new"}, nil)
+	var saved totpRecord
+	s.mockStore.On("UpdateTOTPRecord", s.ctx, testEntityID, mock.Anything).
+		Run(func(args mock.Arguments) { saved = args.Get(2).(totpRecord) }).Return(true, nil)
+
+	codes, svcErr := s.service.RegenerateRecoveryCodes(s.ctx, testEntityID, s.currentCode())
+
+	s.Nil(svcErr)
+	s.Len(codes.Codes, 2)
+	s.Equal([]hash.Credential{{Hash: "new"}, {Hash: "new"}}, saved.RecoveryCodes)
+}
+
+func (s *ServiceTestSuite) TestRegenerateRecoveryCodes_RejectsRecoveryCode() {
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(s.confirmedRecord(), nil)
+
+	_, svcErr := s.service.RegenerateRecoveryCodes(s.ctx, testEntityID, "ABCDE-FGHJK")
+
+	s.Equal(&ErrorInvalidCode, svcErr)
+}
+
+func (s *ServiceTestSuite) TestRemoveEnrollment_Success() {
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(s.confirmedRecord(), nil)
+	s.mockStore.On("DeleteTOTPRecord", s.ctx, testEntityID).Return(nil)
+
+	s.Nil(s.service.RemoveEnrollment(s.ctx, testEntityID))
+}
+
+func (s *ServiceTestSuite) TestRemoveEnrollment_NotEnrolled() {
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(nil, nil)
+
+	s.Equal(&ErrorTOTPNotEnrolled, s.service.RemoveEnrollment(s.ctx, testEntityID))
+}
+
+func (s *ServiceTestSuite) TestRemoveEnrollment_DeleteError() {
+	s.mockStore.On("GetTOTPRecord", s.ctx, testEntityID).Return(s.confirmedRecord(), nil)
+	s.mockStore.On("DeleteTOTPRecord", s.ctx, testEntityID).Return(errors.New("db error"))
+
+	s.Equal(&serviceerror.InternalServerError, s.service.RemoveEnrollment(s.ctx, testEntityID))
+}
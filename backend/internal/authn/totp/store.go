@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package totp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// totpStoreInterface defines the interface for storing the TOTP credentials of entities.
+type totpStoreInterface interface {
+	GetTOTPRecord(ctx context.Context, entityID string) (*totpRecord, error)
+	SaveTOTPRecord(ctx context.Context, entityID string, record totpRecord) error
+	UpdateTOTPRecord(ctx context.Context, entityID string, record totpRecord) (bool, error)
+	DeleteTOTPRecord(ctx context.Context, entityID string) error
+}
+
+// totpStore is the user-DB-backed implementation of totpStoreInterface. The credential lives next
+// to the entity so that it is removed along with it.
+type totpStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newTOTPStore creates a new DB-backed TOTP credential store.
+func newTOTPStore(deploymentID string) totpStoreInterface {
+	return &totpStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// GetTOTPRecord retrieves the TOTP record of the entity. Returns nil if there is none.
+func (s *totpStore) GetTOTPRecord(ctx context.Context, entityID string) (*totpRecord, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetTOTPCredential, entityID, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query TOTP credential: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	return buildTOTPRecordFromRow(results[0])
+}
+
+// SaveTOTPRecord creates or replaces the TOTP record of the entity.
+func (s *totpStore) SaveTOTPRecord(ctx context.Context, entityID string, record totpRecord) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal TOTP credential: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(
+		ctx, queryUpsertTOTPCredential, entityID, s.deploymentID, string(data), time.Now().UTC(),
+	); err != nil {
+		return fmt.Errorf("failed to save TOTP credential: %w", err)
+	}
+	return nil
+}
+
+// UpdateTOTPRecord replaces the TOTP record of the entity if its stored version still matches the
+// version of the given record. Returns false if the record has been changed or removed in the meantime.
+func (s *totpStore) UpdateTOTPRecord(ctx context.Context, entityID string, record totpRecord) (bool, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal TOTP credential: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(
+		ctx, queryUpdateTOTPCredential, entityID, s.deploymentID, string(data), time.Now().UTC(), record.Version,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to update TOTP credential: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// DeleteTOTPRecord deletes the TOTP record of the entity, if any.
+func (s *totpStore) DeleteTOTPRecord(ctx context.Context, entityID string) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteTOTPCredential, entityID, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to delete TOTP credential: %w", err)
+	}
+	return nil
+}
+
+// buildTOTPRecordFromRow reconstructs a totpRecord from a database row.
+func buildTOTPRecordFromRow(row map[string]any) (*totpRecord, error) {
+	var dataJSON []byte
+	if val, ok := row[dbColumnTOTPData].(string); ok && val != "" {
+		dataJSON = []byte(val)
+	} else if val, ok := row[dbColumnTOTPData].([]byte); ok && len(val) > 0 {
+		dataJSON = val
+	} else {
+		return nil, errors.New("totp_data is missing or of unexpected type")
+	}
+
+	version, ok := row[dbColumnVersion].(int64)
+	if !ok {
+		return nil, errors.New("version is missing or of unexpected type")
+	}
+
+	var record totpRecord
+	if err := json.Unmarshal(dataJSON, &record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal TOTP credential: %w", err)
+	}
+	record.Version = version
+	return &record, nil
+}
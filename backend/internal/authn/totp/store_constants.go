@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package totp
+
+import dbmodel "github.com/asgardeo/thunder/internal/system/database/model"
+
+// Database column names for TOTP credential storage.
+const (
+	dbColumnTOTPData = "totp_data"
+	dbColumnVersion  = "version"
+)
+
+var queryUpsertTOTPCredential = dbmodel.DBQuery{
+	ID: "TTQ-TCS-01",
+	Query: `INSERT INTO "TOTP_CREDENTIAL" (ENTITY_ID, DEPLOYMENT_ID, TOTP_DATA, VERSION, UPDATED_AT) ` +
+		`VALUES ($1, $2, $3, 0, $4) ON CONFLICT (ENTITY_ID, DEPLOYMENT_ID) ` +
+		`DO UPDATE SET TOTP_DATA = excluded.TOTP_DATA, VERSION = "TOTP_CREDENTIAL".VERSION + 1, ` +
+		`UPDATED_AT = excluded.UPDATED_AT`,
+}
+
+var queryGetTOTPCredential = dbmodel.DBQuery{
+	ID:    "TTQ-TCS-02",
+	Query: `SELECT TOTP_DATA, VERSION FROM "TOTP_CREDENTIAL" WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2`,
+}
+
+var queryDeleteTOTPCredential = dbmodel.DBQuery{
+	ID:    "TTQ-TCS-03",
+	Query: `DELETE FROM "TOTP_CREDENTIAL" WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2`,
+}
+
+var queryUpdateTOTPCredential = dbmodel.DBQuery{
+	ID: "TTQ-TCS-04",
+	Query: `UPDATE "TOTP_CREDENTIAL" SET TOTP_DATA = $3, VERSION = VERSION + 1, UPDATED_AT = $4 ` +
+		`WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2 AND VERSION = $5`,
+}
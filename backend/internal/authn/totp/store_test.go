@@ -0,0 +1,203 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package totp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
+	"github.com/asgardeo/thunder/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type StoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *totpStore
+	ctx            context.Context
+	testRecord     totpRecord
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (s *StoreTestSuite) SetupTest() {
+	s.mockDBProvider = providermock.NewDBProviderInterfaceMock(s.T())
+	s.mockDBClient = providermock.NewDBClientInterfaceMock(s.T())
+	s.store = &totpStore{
+		dbProvider:   s.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+	s.testRecord = totpRecord{
+		Secret:       "encrypted-secret",
+		Confirmed:    true,
+		LastUsedStep: 1000,
+		RecoveryCodes: []hash.Credential{
+			{
+				Algorithm:  hash.PBKDF2,
+				Hash:       "hash",
+				Parameters: hash.CredParameters{Salt: "salt", Iterations: 600000, KeySize: 32},
+			},
+		},
+		CreatedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Version:   3,
+	}
+}
+
+func (s *StoreTestSuite) TestGetTOTPRecord_Success() {
+	data, _ := json.Marshal(s.testRecord)
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", s.ctx, queryGetTOTPCredential, testEntityID, testDeploymentID).
+		Return([]map[string]any{{dbColumnTOTPData: string(data), dbColumnVersion: int64(3)}}, nil)
+
+	record, err := s.store.GetTOTPRecord(s.ctx, testEntityID)
+
+	s.NoError(err)
+	s.Equal(&s.testRecord, record)
+}
+
+func (s *StoreTestSuite) TestGetTOTPRecord_ByteData() {
+	data, _ := json.Marshal(s.testRecord)
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", s.ctx, queryGetTOTPCredential, testEntityID, testDeploymentID).
+		Return([]map[string]any{{dbColumnTOTPData: data, dbColumnVersion: int64(3)}}, nil)
+
+	record, err := s.store.GetTOTPRecord(s.ctx, testEntityID)
+
+	s.NoError(err)
+	s.Equal(&s.testRecord, record)
+}
+
+func (s *StoreTestSuite) TestGetTOTPRecord_NotFound() {
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", s.ctx, queryGetTOTPCredential, testEntityID, testDeploymentID).
+		Return([]map[string]any{}, nil)
+
+	record, err := s.store.GetTOTPRecord(s.ctx, testEntityID)
+
+	s.NoError(err)
+	s.Nil(record)
+}
+
+func (s *StoreTestSuite) TestGetTOTPRecord_InvalidData() {
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", s.ctx, queryGetTOTPCredential, testEntityID, testDeploymentID).
+		Return([]map[string]any{{dbColumnTOTPData: 42}}, nil)
+
+	_, err := s.store.GetTOTPRecord(s.ctx, testEntityID)
+
+	s.Error(err)
+}
+
+func (s *StoreTestSuite) TestGetTOTPRecord_MissingVersion() {
+	data, _ := json.Marshal(s.testRecord)
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", s.ctx, queryGetTOTPCredential, testEntityID, testDeploymentID).
+		Return([]map[string]any{{dbColumnTOTPData: string(data)}}, nil)
+
+	_, err := s.store.GetTOTPRecord(s.ctx, testEntityID)
+
+	s.ErrorContains(err, "version is missing")
+}
+
+func (s *StoreTestSuite) TestGetTOTPRecord_DBClientError() {
+	s.mockDBProvider.On("GetUserDBClient").Return(nil, errors.New("db error"))
+
+	_, err := s.store.GetTOTPRecord(s.ctx, testEntityID)
+
+	s.Error(err)
+}
+
+func (s *StoreTestSuite) TestSaveTOTPRecord_Success() {
+	data, _ := json.Marshal(s.testRecord)
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", s.ctx, queryUpsertTOTPCredential, testEntityID, testDeploymentID,
+		string(data), mock.AnythingOfType("time.Time")).Return(int64(1), nil)
+
+	s.NoError(s.store.SaveTOTPRecord(s.ctx, testEntityID, s.testRecord))
+}
+
+func (s *StoreTestSuite) TestSaveTOTPRecord_ExecuteError() {
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", s.ctx, queryUpsertTOTPCredential, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).Return(int64(0), errors.New("db error"))
+
+	s.Error(s.store.SaveTOTPRecord(s.ctx, testEntityID, s.testRecord))
+}
+
+func (s *StoreTestSuite) TestUpdateTOTPRecord_Success() {
+	data, _ := json.Marshal(s.testRecord)
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", s.ctx, queryUpdateTOTPCredential, testEntityID, testDeploymentID,
+		string(data), mock.AnythingOfType("time.Time"), int64(3)).Return(int64(1), nil)
+
+	updated, err := s.store.UpdateTOTPRecord(s.ctx, testEntityID, s.testRecord)
+
+	s.NoError(err)
+	s.True(updated)
+}
+
+func (s *StoreTestSuite) TestUpdateTOTPRecord_VersionChanged() {
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", s.ctx, queryUpdateTOTPCredential, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, int64(3)).Return(int64(0), nil)
+
+	updated, err := s.store.UpdateTOTPRecord(s.ctx, testEntityID, s.testRecord)
+
+	s.NoError(err)
+	s.False(updated)
+}
+
+func (s *StoreTestSuite) TestUpdateTOTPRecord_ExecuteError() {
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", s.ctx, queryUpdateTOTPCredential, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(int64(0), errors.New("db error"))
+
+	updated, err := s.store.UpdateTOTPRecord(s.ctx, testEntityID, s.testRecord)
+
+	s.Error(err)
+	s.False(updated)
+}
+
+func (s *StoreTestSuite) TestDeleteTOTPRecord_Success() {
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", s.ctx, queryDeleteTOTPCredential, testEntityID, testDeploymentID).
+		Return(int64(1), nil)
+
+	s.NoError(s.store.DeleteTOTPRecord(s.ctx, testEntityID))
+}
+
+func (s *StoreTestSuite) TestDeleteTOTPRecord_ExecuteError() {
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", s.ctx, queryDeleteTOTPCredential, testEntityID, testDeploymentID).
+		Return(int64(0), errors.New("db error"))
+
+	s.Error(s.store.DeleteTOTPRecord(s.ctx, testEntityID))
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // RFC 6238 codes are HMAC-SHA1 for authenticator app compatibility.
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// secretEncoding is the base32 encoding of TOTP secrets, without padding as expected in key URIs.
+var secretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// generateSecret generates a new random base32 encoded TOTP secret.
+func generateSecret() (string, error) {
+	b := make([]byte, secretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return secretEncoding.EncodeToString(b), nil
+}
+
+// generateCode computes the HOTP value (RFC 4226) of the secret for the counter, which for TOTP is
+// the time step.
+func generateCode(secret []byte, counter int64, digits int) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(counter)) //nolint:gosec // Time steps are never negative.
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range digits {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
+
+// matchCode returns the time step within the drift window of the current step whose code matches
+// the given code. Steps at or before lastUsedStep are never matched, so that an accepted code cannot
+// be replayed.
+func matchCode(secret []byte, code string, currentStep, lastUsedStep int64, digits, driftWindow int) (int64, bool) {
+	for step := currentStep - int64(driftWindow); step <= currentStep+int64(driftWindow); step++ {
+		if step <= lastUsedStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(generateCode(secret, step, digits)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// buildKeyURI builds the otpauth:// key URI of a TOTP secret understood by authenticator apps.
+func buildKeyURI(issuer, accountName, secret string, digits int, period int64) string {
+	label := url.PathEscape(issuer + ":" + accountName)
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", algorithm)
+	query.Set("digits", strconv.Itoa(digits))
+	query.Set("period", strconv.FormatInt(period, 10))
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// generateRecoveryCode generates a random recovery code formatted as two groups separated by a dash.
+func generateRecoveryCode() (string, error) {
+	var sb strings.Builder
+	alphabetSize := big.NewInt(int64(len(recoveryCodeAlphabet)))
+	for i := range recoveryCodeLength {
+		if i == recoveryCodeLength/2 {
+			sb.WriteByte('-')
+		}
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate random number: %w", err)
+		}
+		sb.WriteByte(recoveryCodeAlphabet[n.Int64()])
+	}
+	return sb.String(), nil
+}
+
+// normalizeRecoveryCode normalizes a recovery code entered by a user for comparison.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+// isNumeric reports whether the code consists of digits only.
+func isNumeric(code string) bool {
+	if code == "" {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package totp
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rfc6238Secret is the SHA1 test secret of RFC 6238 Appendix B.
+var rfc6238Secret = []byte("12345678901234567890")
+
+func TestGenerateCode_RFC6238Vectors(t *testing.T) {
+	vectors := []struct {
+		unixTime int64
+		code     string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+	for _, v := range vectors {
+		assert.Equal(t, v.code, generateCode(rfc6238Secret, v.unixTime/30, 8))
+	}
+	assert.Equal(t, "287082", generateCode(rfc6238Secret, 59/30, 6))
+}
+
+func TestMatchCode(t *testing.T) {
+	const currentStep = int64(1000)
+	previous := generateCode(rfc6238Secret, currentStep-1, 6)
+	next := generateCode(rfc6238Secret, currentStep+1, 6)
+	tooOld := generateCode(rfc6238Secret, currentStep-2, 6)
+
+	step, ok := matchCode(rfc6238Secret, previous, currentStep, 0, 6, 1)
+	assert.True(t, ok)
+	assert.Equal(t, currentStep-1, step)
+
+	step, ok = matchCode(rfc6238Secret, next, currentStep, 0, 6, 1)
+	assert.True(t, ok)
+	assert.Equal(t, currentStep+1, step)
+
+	_, ok = matchCode(rfc6238Secret, tooOld, currentStep, 0, 6, 1)
+	assert.False(t, ok)
+
+	_, ok = matchCode(rfc6238Secret, previous, currentStep, 0, 6, 0)
+	assert.False(t, ok)
+}
+
+func TestMatchCode_RejectsReplay(t *testing.T) {
+	const currentStep = int64(1000)
+	code := generateCode(rfc6238Secret, currentStep, 6)
+
+	_, ok := matchCode(rfc6238Secret, code, currentStep, currentStep, 6, 1)
+	assert.False(t, ok)
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := generateSecret()
+	assert.NoError(t, err)
+
+	decoded, err := secretEncoding.DecodeString(secret)
+	assert.NoError(t, err)
+	assert.Len(t, decoded, secretSize)
+	assert.NotContains(t, secret, "=")
+
+	other, err := generateSecret()
+	assert.NoError(t, err)
+	assert.NotEqual(t, secret, other)
+}
+
+func TestBuildKeyURI(t *testing.T) {
+	uri := buildKeyURI("Thunder", "alice@example.com", "JBSWY3DPEHPK3PXP", 6, 30)
+
+	parsed, err := url.Parse(uri)
+	assert.NoError(t, err)
+	assert.Equal(t, "otpauth", parsed.Scheme)
+	assert.Equal(t, "totp", parsed.Host)
+	assert.Equal(t, "/Thunder:alice@example.com", parsed.Path)
+
+	query := parsed.Query()
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", query.Get("secret"))
+	assert.Equal(t, "Thunder", query.Get("issuer"))
+	assert.Equal(t, "SHA1", query.Get("algorithm"))
+	assert.Equal(t, "6", query.Get("digits"))
+	assert.Equal(t, "30", query.Get("period"))
+}
+
+func TestGenerateRecoveryCode(t *testing.T) {
+	code, err := generateRecoveryCode()
+	assert.NoError(t, err)
+	assert.Len(t, code, recoveryCodeLength+1)
+	assert.Equal(t, byte('-'), code[recoveryCodeLength/2])
+
+	for _, r := range strings.ReplaceAll(code, "-", "") {
+		assert.True(t, strings.ContainsRune(recoveryCodeAlphabet, r))
+	}
+}
+
+func TestNormalizeRecoveryCode(t *testing.T) {
+	assert.Equal(t, "ABCDEFGHJK", normalizeRecoveryCode(" abcde-fghjk "))
+	assert.Equal(t, "ABCDEFGHJK", normalizeRecoveryCode("ABCDE FGHJK"))
+}
+
+func TestIsNumeric(t *testing.T) {
+	assert.True(t, isNumeric("123456"))
+	assert.False(t, isNumeric(""))
+	assert.False(t, isNumeric("12a456"))
+	assert.False(t, isNumeric("ABCDE-FGHJK"))
+}
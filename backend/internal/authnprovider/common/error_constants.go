@@ -26,4 +26,5 @@ const (
 	ErrorCodeInvalidToken         = "AUP-0004"
 	ErrorCodeNotImplemented       = "AUP-0005"
 	ErrorCodeInvalidRequest       = "AUP-0006"
+	ErrorCodeAccountLocked        = "AUP-0007"
 )
@@ -83,4 +83,19 @@ var (
 			DefaultValue: "The authentication request is invalid",
 		},
 	}
+
+	// ErrorAccountLocked is returned when the underlying provider rejects the authentication
+	// attempt because the account or client is locked after too many failed attempts.
+	ErrorAccountLocked = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-MGR-1009",
+		Error: core.I18nMessage{
+			Key:          "error.authnmgrservice.account_locked",
+			DefaultValue: "Account locked",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authnmgrservice.account_locked_description",
+			DefaultValue: "The account is temporarily locked due to too many failed login attempts",
+		},
+	}
 )
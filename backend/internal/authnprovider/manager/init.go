@@ -20,6 +20,7 @@ package manager
 
 import (
 	authncommon "github.com/asgardeo/thunder/internal/authn/common"
+	"github.com/asgardeo/thunder/internal/authn/lockout"
 	"github.com/asgardeo/thunder/internal/authn/otp"
 	"github.com/asgardeo/thunder/internal/authn/passkey"
 	"github.com/asgardeo/thunder/internal/authnprovider/provider"
@@ -30,7 +31,8 @@ import (
 // InitializeAuthnProviderManager initializes and returns an AuthnProviderManagerInterface.
 func InitializeAuthnProviderManager(entitySvc entity.EntityServiceInterface,
 	passkeySvc passkey.PasskeyServiceInterface, otpSvc otp.OTPAuthnServiceInterface,
-	federatedAuths map[idp.IDPType]authncommon.FederatedAuthenticator) AuthnProviderManagerInterface {
-	p := provider.InitializeAuthnProvider(entitySvc, passkeySvc, otpSvc, federatedAuths)
+	federatedAuths map[idp.IDPType]authncommon.FederatedAuthenticator,
+	lockoutSvc lockout.LockoutServiceInterface) AuthnProviderManagerInterface {
+	p := provider.InitializeAuthnProvider(entitySvc, passkeySvc, otpSvc, federatedAuths, lockoutSvc)
 	return newAuthnProviderManager(p)
 }
@@ -66,6 +66,11 @@ func (m *authnProviderManager) AuthenticateUser(ctx context.Context, identifiers
 				Key:          "error.authnprovider.invalid_request_description",
 				DefaultValue: svcErr.ErrorDescription.DefaultValue,
 			})
+		case authnprovidercm.ErrorCodeAccountLocked:
+			return AuthUser{}, nil, serviceerror.CustomServiceError(ErrorAccountLocked, core.I18nMessage{
+				Key:          "error.authnprovider.account_locked_description",
+				DefaultValue: svcErr.ErrorDescription.DefaultValue,
+			})
 		default:
 			return AuthUser{}, nil, serviceerror.CustomServiceError(ErrorAuthenticationFailed, core.I18nMessage{
 				Key:          "error.authnprovider.authentication_failed_description",
@@ -151,6 +151,15 @@ func (s *ManagerTestSuite) TestAuthenticateUser_InvalidRequest() {
 	)
 }
 
+func (s *ManagerTestSuite) TestAuthenticateUser_AccountLocked() {
+	s.assertAuthenticateUserClientErrorMapping(
+		authnprovidercm.ErrorCodeAccountLocked,
+		"account locked",
+		"too many failed login attempts",
+		ErrorAccountLocked.Code,
+	)
+}
+
 func (s *ManagerTestSuite) assertAuthenticateUserClientErrorMapping(
 	providerErrorCode, providerError, providerErrorDescription, expectedServiceErrorCode string,
 ) {
@@ -25,11 +25,13 @@ import (
 	"errors"
 
 	authncommon "github.com/asgardeo/thunder/internal/authn/common"
+	"github.com/asgardeo/thunder/internal/authn/lockout"
 	"github.com/asgardeo/thunder/internal/authn/otp"
 	"github.com/asgardeo/thunder/internal/authn/passkey"
 	authnprovidercm "github.com/asgardeo/thunder/internal/authnprovider/common"
 	"github.com/asgardeo/thunder/internal/entity"
 	"github.com/asgardeo/thunder/internal/idp"
+	sysContext "github.com/asgardeo/thunder/internal/system/context"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	"github.com/asgardeo/thunder/internal/system/i18n/core"
 	"github.com/asgardeo/thunder/internal/system/log"
@@ -40,18 +42,21 @@ type defaultAuthnProvider struct {
 	passkeyService passkey.PasskeyServiceInterface
 	otpService     otp.OTPAuthnServiceInterface
 	federatedAuths map[idp.IDPType]authncommon.FederatedAuthenticator
+	lockoutService lockout.LockoutServiceInterface
 	logger         *log.Logger
 }
 
 // newDefaultAuthnProvider creates a new internal user authn provider.
 func newDefaultAuthnProvider(entitySvc entity.EntityServiceInterface,
 	passkeyService passkey.PasskeyServiceInterface, otpService otp.OTPAuthnServiceInterface,
-	federatedAuths map[idp.IDPType]authncommon.FederatedAuthenticator) AuthnProviderInterface {
+	federatedAuths map[idp.IDPType]authncommon.FederatedAuthenticator,
+	lockoutService lockout.LockoutServiceInterface) AuthnProviderInterface {
 	return &defaultAuthnProvider{
 		entitySvc:      entitySvc,
 		passkeyService: passkeyService,
 		otpService:     otpService,
 		federatedAuths: federatedAuths,
+		lockoutService: lockoutService,
 		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DefaultAuthnProvider")),
 	}
 }
@@ -132,10 +137,14 @@ func (p *defaultAuthnProvider) resolveCredentials(
 	identifiers, credentials map[string]interface{},
 ) (*credentialOutcome, *serviceerror.ServiceError) {
 	if passkeyCredential, ok := credentials["passkey"]; ok {
-		return p.authenticateWithPasskey(ctx, passkeyCredential)
+		return p.authenticateWithLockout(ctx, func() (*credentialOutcome, *serviceerror.ServiceError) {
+			return p.authenticateWithPasskey(ctx, passkeyCredential)
+		})
 	}
 	if otpCredential, ok := credentials["otp"]; ok {
-		return p.authenticateWithOTP(ctx, otpCredential)
+		return p.authenticateWithLockout(ctx, func() (*credentialOutcome, *serviceerror.ServiceError) {
+			return p.authenticateWithOTP(ctx, otpCredential)
+		})
 	}
 	if fedCred, ok := credentials["federated"]; ok {
 		return p.authenticateWithFederated(ctx, fedCred)
@@ -183,7 +192,7 @@ func (p *defaultAuthnProvider) authenticateWithOTP(
 	authResponse, authErr := p.otpService.Authenticate(ctx, sessionToken, otpValue)
 	if authErr != nil {
 		if authErr.Type == serviceerror.ClientErrorType {
-			if authErr.Code == otp.ErrorIncorrectOTP.Code {
+			if authErr.Code == otp.ErrorIncorrectOTP.Code || authErr.Code == otp.ErrorOTPAttemptsExceeded.Code {
 				return nil, newClientError(authnprovidercm.ErrorCodeAuthenticationFailed,
 					authErr.Error.DefaultValue, authErr.ErrorDescription.DefaultValue)
 			}
@@ -253,6 +262,9 @@ func (p *defaultAuthnProvider) authenticateByUserID(
 		return nil, newClientError(authnprovidercm.ErrorCodeInvalidRequest,
 			"Invalid user ID", "The provided userID is invalid")
 	}
+	if p.isLockoutEnabled() {
+		return p.authenticateEntityWithLockout(ctx, userIDStr, credentials)
+	}
 	authResult, authErr := p.entitySvc.AuthenticateEntityByID(ctx, userIDStr, credentials)
 	if authErr != nil {
 		return nil, p.handleEntityAuthError(authErr, "Basic authentication by ID failed with server error")
@@ -263,6 +275,9 @@ func (p *defaultAuthnProvider) authenticateByUserID(
 func (p *defaultAuthnProvider) authenticateByIdentifiers(
 	ctx context.Context, identifiers, credentials map[string]interface{},
 ) (*credentialOutcome, *serviceerror.ServiceError) {
+	if p.isLockoutEnabled() && len(identifiers) > 0 && len(credentials) > 0 {
+		return p.authenticateByIdentifiersWithLockout(ctx, identifiers, credentials)
+	}
 	authResult, authErr := p.entitySvc.AuthenticateEntity(ctx, identifiers, credentials)
 	if authErr != nil {
 		return nil, p.handleEntityAuthError(authErr, "Basic authentication failed with server error")
@@ -270,6 +285,100 @@ func (p *defaultAuthnProvider) authenticateByIdentifiers(
 	return &credentialOutcome{entityID: authResult.EntityID}, nil
 }
 
+// authenticateByIdentifiersWithLockout identifies the entity before verifying the credentials so
+// that the attempt is counted against the entity. Attempts with identifiers that do not resolve to
+// an entity are counted against the identifiers instead.
+func (p *defaultAuthnProvider) authenticateByIdentifiersWithLockout(
+	ctx context.Context, identifiers, credentials map[string]interface{},
+) (*credentialOutcome, *serviceerror.ServiceError) {
+	entityID, err := p.entitySvc.IdentifyEntity(ctx, identifiers)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			subjects := lockout.AttemptSubjects{Identifiers: identifiers, ClientIP: sysContext.GetClientIP(ctx)}
+			if svcErr := p.checkLockout(ctx, subjects); svcErr != nil {
+				return nil, svcErr
+			}
+			p.lockoutService.RecordFailure(ctx, subjects)
+		}
+		return nil, p.handleEntityAuthError(err, "Basic authentication failed with server error")
+	}
+	return p.authenticateEntityWithLockout(ctx, *entityID, credentials)
+}
+
+// authenticateEntityWithLockout verifies the credentials of a known entity, rejecting the attempt
+// while the entity or client is locked and counting failed attempts against them.
+func (p *defaultAuthnProvider) authenticateEntityWithLockout(
+	ctx context.Context, entityID string, credentials map[string]interface{},
+) (*credentialOutcome, *serviceerror.ServiceError) {
+	subjects := lockout.AttemptSubjects{EntityID: entityID, ClientIP: sysContext.GetClientIP(ctx)}
+	if svcErr := p.checkLockout(ctx, subjects); svcErr != nil {
+		return nil, svcErr
+	}
+
+	authResult, authErr := p.entitySvc.AuthenticateEntityByID(ctx, entityID, credentials)
+	if authErr != nil {
+		if errors.Is(authErr, entity.ErrAuthenticationFailed) {
+			p.lockoutService.RecordFailure(ctx, subjects)
+		}
+		return nil, p.handleEntityAuthError(authErr, "Basic authentication failed with server error")
+	}
+	p.lockoutService.RecordSuccess(ctx, subjects)
+	return &credentialOutcome{entityID: authResult.EntityID}, nil
+}
+
+// authenticateWithLockout applies account lockout to credentials that identify the entity only once
+// verified. Failed attempts are counted against the client, and the entity lock is enforced after
+// the credential has been verified.
+func (p *defaultAuthnProvider) authenticateWithLockout(
+	ctx context.Context, authenticate func() (*credentialOutcome, *serviceerror.ServiceError),
+) (*credentialOutcome, *serviceerror.ServiceError) {
+	if !p.isLockoutEnabled() {
+		return authenticate()
+	}
+
+	clientIP := sysContext.GetClientIP(ctx)
+	if svcErr := p.checkLockout(ctx, lockout.AttemptSubjects{ClientIP: clientIP}); svcErr != nil {
+		return nil, svcErr
+	}
+
+	outcome, svcErr := authenticate()
+	if svcErr != nil {
+		if svcErr.Code == authnprovidercm.ErrorCodeAuthenticationFailed {
+			p.lockoutService.RecordFailure(ctx, lockout.AttemptSubjects{ClientIP: clientIP})
+		}
+		return nil, svcErr
+	}
+
+	subjects := lockout.AttemptSubjects{EntityID: outcome.entityID}
+	if svcErr := p.checkLockout(ctx, subjects); svcErr != nil {
+		return nil, svcErr
+	}
+	p.lockoutService.RecordSuccess(ctx, subjects)
+	return outcome, nil
+}
+
+// isLockoutEnabled reports whether login attempts are subject to account lockout.
+func (p *defaultAuthnProvider) isLockoutEnabled() bool {
+	return p.lockoutService != nil && p.lockoutService.IsEnabled()
+}
+
+// checkLockout checks the lockout state of the subjects of an attempt and maps a rejection to an
+// account locked error.
+func (p *defaultAuthnProvider) checkLockout(
+	ctx context.Context, subjects lockout.AttemptSubjects,
+) *serviceerror.ServiceError {
+	svcErr := p.lockoutService.CheckAttempt(ctx, subjects)
+	if svcErr == nil {
+		return nil
+	}
+	if svcErr.Type == serviceerror.ClientErrorType {
+		return newClientError(authnprovidercm.ErrorCodeAccountLocked,
+			svcErr.Error.DefaultValue, svcErr.ErrorDescription.DefaultValue)
+	}
+	return p.logAndReturnServerError("Failed to check account lockout",
+		log.String("error", svcErr.ErrorDescription.DefaultValue))
+}
+
 func (p *defaultAuthnProvider) handleEntityAuthError(err error, serverMsg string) *serviceerror.ServiceError {
 	if errors.Is(err, entity.ErrEntityNotFound) {
 		return newClientError(authnprovidercm.ErrorCodeUserNotFound,
@@ -26,8 +26,12 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/asgardeo/thunder/internal/authn/lockout"
 	authnprovidercm "github.com/asgardeo/thunder/internal/authnprovider/common"
 	"github.com/asgardeo/thunder/internal/entity"
+	sysContext "github.com/asgardeo/thunder/internal/system/context"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/tests/mocks/authn/lockoutmock"
 	"github.com/asgardeo/thunder/tests/mocks/entitymock"
 )
 
@@ -39,7 +43,7 @@ type DefaultAuthnProviderTestSuite struct {
 
 func (suite *DefaultAuthnProviderTestSuite) SetupTest() {
 	suite.mockService = entitymock.NewEntityServiceInterfaceMock(suite.T())
-	suite.provider = newDefaultAuthnProvider(suite.mockService, nil, nil, nil)
+	suite.provider = newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil)
 }
 
 func TestDefaultAuthnProviderTestSuite(t *testing.T) {
@@ -293,3 +297,120 @@ func (suite *DefaultAuthnProviderTestSuite) TestGetAttributes_InvalidToken() {
 	suite.NotNil(err)
 	suite.Equal(authnprovidercm.ErrorCodeInvalidToken, err.Code)
 }
+
+func (suite *DefaultAuthnProviderTestSuite) newProviderWithLockout() *lockoutmock.LockoutServiceInterfaceMock {
+	lockoutMock := lockoutmock.NewLockoutServiceInterfaceMock(suite.T())
+	lockoutMock.On("IsEnabled").Return(true).Maybe()
+	suite.provider = newDefaultAuthnProvider(suite.mockService, nil, nil, nil, lockoutMock)
+	return lockoutMock
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Lockout_Success() {
+	lockoutMock := suite.newProviderWithLockout()
+	ctx := sysContext.WithClientIP(context.Background(), "192.0.2.1")
+	identifiers := map[string]interface{}{"username": "testuser"}
+	credentials := map[string]interface{}{"password": "password123"}
+	entityID := "user123"
+	subjects := lockout.AttemptSubjects{EntityID: entityID, ClientIP: "192.0.2.1"}
+
+	suite.mockService.On("IdentifyEntity", mock.Anything, identifiers).Return(&entityID, nil).Once()
+	lockoutMock.On("CheckAttempt", mock.Anything, subjects).Return((*serviceerror.ServiceError)(nil)).Once()
+	suite.mockService.On("AuthenticateEntityByID", mock.Anything, entityID, credentials).
+		Return(&entity.AuthenticateResult{EntityID: entityID}, nil).Once()
+	lockoutMock.On("RecordSuccess", mock.Anything, subjects).Once()
+	suite.mockService.On("GetEntity", mock.Anything, entityID).
+		Return(&entity.Entity{ID: entityID, Category: entity.EntityCategoryUser}, nil).Once()
+
+	result, err := suite.provider.Authenticate(ctx, identifiers, credentials, nil)
+
+	suite.Nil(err)
+	suite.Equal(entityID, result.EntityID)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Lockout_AuthenticationFailedRecordsFailure() {
+	lockoutMock := suite.newProviderWithLockout()
+	identifiers := map[string]interface{}{"username": "testuser"}
+	credentials := map[string]interface{}{"password": "wrongpassword"}
+	entityID := "user123"
+	subjects := lockout.AttemptSubjects{EntityID: entityID}
+
+	suite.mockService.On("IdentifyEntity", mock.Anything, identifiers).Return(&entityID, nil).Once()
+	lockoutMock.On("CheckAttempt", mock.Anything, subjects).Return((*serviceerror.ServiceError)(nil)).Once()
+	suite.mockService.On("AuthenticateEntityByID", mock.Anything, entityID, credentials).
+		Return(nil, entity.ErrAuthenticationFailed).Once()
+	lockoutMock.On("RecordFailure", mock.Anything, subjects).Once()
+
+	result, err := suite.provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeAuthenticationFailed, err.Code)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Lockout_AccountLocked() {
+	lockoutMock := suite.newProviderWithLockout()
+	identifiers := map[string]interface{}{"username": "testuser"}
+	credentials := map[string]interface{}{"password": "password123"}
+	entityID := "user123"
+
+	suite.mockService.On("IdentifyEntity", mock.Anything, identifiers).Return(&entityID, nil).Once()
+	lockoutMock.On("CheckAttempt", mock.Anything, lockout.AttemptSubjects{EntityID: entityID}).
+		Return(&lockout.ErrorAccountLocked).Once()
+
+	result, err := suite.provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeAccountLocked, err.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "AuthenticateEntityByID", mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Lockout_CheckServerError() {
+	lockoutMock := suite.newProviderWithLockout()
+	identifiers := map[string]interface{}{"username": "testuser"}
+	credentials := map[string]interface{}{"password": "password123"}
+	entityID := "user123"
+
+	suite.mockService.On("IdentifyEntity", mock.Anything, identifiers).Return(&entityID, nil).Once()
+	lockoutMock.On("CheckAttempt", mock.Anything, lockout.AttemptSubjects{EntityID: entityID}).
+		Return(&serviceerror.InternalServerError).Once()
+
+	result, err := suite.provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(serviceerror.ServerErrorType, err.Type)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Lockout_UnknownIdentifierRecordsFailure() {
+	lockoutMock := suite.newProviderWithLockout()
+	identifiers := map[string]interface{}{"username": "unknown"}
+	credentials := map[string]interface{}{"password": "password"}
+	subjects := lockout.AttemptSubjects{Identifiers: identifiers}
+
+	suite.mockService.On("IdentifyEntity", mock.Anything, identifiers).Return(nil, entity.ErrEntityNotFound).Once()
+	lockoutMock.On("CheckAttempt", mock.Anything, subjects).Return((*serviceerror.ServiceError)(nil)).Once()
+	lockoutMock.On("RecordFailure", mock.Anything, subjects).Once()
+
+	result, err := suite.provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeUserNotFound, err.Code)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Lockout_ByPreResolvedUserID() {
+	lockoutMock := suite.newProviderWithLockout()
+	identifiers := map[string]interface{}{"userID": "resolved-user-123"}
+	credentials := map[string]interface{}{"password": "password123"}
+	subjects := lockout.AttemptSubjects{EntityID: "resolved-user-123"}
+
+	lockoutMock.On("CheckAttempt", mock.Anything, subjects).Return(&lockout.ErrorAccountLocked).Once()
+
+	result, err := suite.provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeAccountLocked, err.Code)
+}
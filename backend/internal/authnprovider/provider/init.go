@@ -22,6 +22,7 @@ import (
 	"time"
 
 	authncommon "github.com/asgardeo/thunder/internal/authn/common"
+	"github.com/asgardeo/thunder/internal/authn/lockout"
 	"github.com/asgardeo/thunder/internal/authn/otp"
 	"github.com/asgardeo/thunder/internal/authn/passkey"
 	"github.com/asgardeo/thunder/internal/entity"
@@ -37,13 +38,14 @@ func InitializeAuthnProvider(
 	passkeySvc passkey.PasskeyServiceInterface,
 	otpSvc otp.OTPAuthnServiceInterface,
 	federatedAuths map[idp.IDPType]authncommon.FederatedAuthenticator,
+	lockoutSvc lockout.LockoutServiceInterface,
 ) AuthnProviderInterface {
 	authnProviderConfig := config.GetServerRuntime().Config.AuthnProvider
 	switch authnProviderConfig.Type {
 	case "rest":
 		return initializeRestAuthnProvider()
 	default:
-		return initializeDefaultAuthnProvider(entitySvc, passkeySvc, otpSvc, federatedAuths)
+		return initializeDefaultAuthnProvider(entitySvc, passkeySvc, otpSvc, federatedAuths, lockoutSvc)
 	}
 }
 
@@ -53,8 +55,9 @@ func initializeDefaultAuthnProvider(
 	passkeySvc passkey.PasskeyServiceInterface,
 	otpSvc otp.OTPAuthnServiceInterface,
 	federatedAuths map[idp.IDPType]authncommon.FederatedAuthenticator,
+	lockoutSvc lockout.LockoutServiceInterface,
 ) AuthnProviderInterface {
-	return newDefaultAuthnProvider(entitySvc, passkeySvc, otpSvc, federatedAuths)
+	return newDefaultAuthnProvider(entitySvc, passkeySvc, otpSvc, federatedAuths, lockoutSvc)
 }
 
 // initializeRestAuthnProvider initializes the REST authentication provider.
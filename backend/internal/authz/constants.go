@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package authz
+
+// Evaluations semantics supported by the batch access evaluation API.
+const (
+	// EvaluationsSemanticExecuteAll evaluates every request in the batch.
+	EvaluationsSemanticExecuteAll = "execute_all"
+	// EvaluationsSemanticDenyOnFirstDeny stops evaluating the batch at the first denied request.
+	EvaluationsSemanticDenyOnFirstDeny = "deny_on_first_deny"
+	// EvaluationsSemanticPermitOnFirstPermit stops evaluating the batch at the first permitted request.
+	EvaluationsSemanticPermitOnFirstPermit = "permit_on_first_permit"
+)
+
+// defaultMaxBatchSize is the maximum number of evaluations in a batch request when none is configured.
+const defaultMaxBatchSize = 100
+
+// AuthZEN API paths.
+const (
+	accessEvaluationPath  = "/access/v1/evaluation"
+	accessEvaluationsPath = "/access/v1/evaluations"
+	pdpMetadataPath       = "/.well-known/authzen-configuration"
+)
+
+// Keys of the decision context returned with denied evaluations.
+const (
+	contextKeyReasonAdmin = "reason_admin"
+	reasonLanguageEnglish = "en"
+)
+
+// contextKeyACR is the key of the authentication class reference in the evaluation request context.
+const contextKeyACR = "acr"
+
+// requestIDHeaderName is the header used by policy enforcement points to correlate evaluation requests.
+const requestIDHeaderName = "X-Request-ID"
@@ -0,0 +1,540 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/authz/policy"
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/ou"
+	sysContext "github.com/asgardeo/thunder/internal/system/context"
+)
+
+// Combining algorithms of the RBAC and ABAC engines.
+const (
+	// CombiningAlgorithmDenyOverrides grants a permission permitted by a role or a permit policy,
+	// unless a deny policy applies to it.
+	CombiningAlgorithmDenyOverrides = "deny_overrides"
+	// CombiningAlgorithmPermitOverrides grants a permission permitted by a role or a permit policy,
+	// regardless of deny policies.
+	CombiningAlgorithmPermitOverrides = "permit_overrides"
+)
+
+// Attributes resolved by the ABAC engine.
+const (
+	attributeSubjectID         = "subject.id"
+	attributeSubjectType       = "subject.type"
+	attributeSubjectOUID       = "subject.ouId"
+	attributeSubjectGroups     = "subject.groups"
+	attributeSubjectAttributes = "subject.attributes."
+	attributeResourcePerm      = "resource.permission"
+	attributeContextTime       = "context.time"
+	attributeContextIP         = "context.ip"
+	attributeContextACR        = "context.acr"
+	contextAttributeIP         = "ip"
+)
+
+// abacEngine implements Attribute-Based Access Control (ABAC) authorization.
+// Permissions are granted by permit policies whose conditions hold for the subject, the resource,
+// and the request context, unless a deny policy whose conditions hold applies to them as well.
+// Conditions on attributes that cannot be resolved do not hold.
+type abacEngine struct {
+	policyService  policy.PolicyServiceInterface
+	entityProvider entityprovider.EntityProviderInterface
+	ouService      ou.OrganizationUnitServiceInterface
+}
+
+// NewABACEngine creates a new ABAC authorization engine.
+func NewABACEngine(
+	policyService policy.PolicyServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+) AuthorizationEngine {
+	return newABACEngine(policyService, entityProvider, ouService)
+}
+
+// newABACEngine creates a new ABAC engine.
+func newABACEngine(
+	policyService policy.PolicyServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+) *abacEngine {
+	return &abacEngine{
+		policyService:  policyService,
+		entityProvider: entityProvider,
+		ouService:      ouService,
+	}
+}
+
+// GetAuthorizedPermissions returns the subset of requested permissions that are permitted
+// and not denied by the applicable policies.
+func (e *abacEngine) GetAuthorizedPermissions(
+	ctx context.Context,
+	entityID string,
+	groupIDs []string,
+	requestedPermissions []string,
+) ([]string, error) {
+	permitted, denied, err := e.evaluate(ctx, entityID, groupIDs, requestedPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	authorized := make([]string, 0, len(requestedPermissions))
+	for _, permission := range requestedPermissions {
+		if permitted[permission] && !denied[permission] {
+			authorized = append(authorized, permission)
+		}
+	}
+	return authorized, nil
+}
+
+// evaluate returns the requested permissions permitted and denied by the applicable policies.
+func (e *abacEngine) evaluate(
+	ctx context.Context,
+	entityID string,
+	groupIDs []string,
+	requestedPermissions []string,
+) (map[string]bool, map[string]bool, error) {
+	permitted := make(map[string]bool)
+	denied := make(map[string]bool)
+	if len(requestedPermissions) == 0 {
+		return permitted, denied, nil
+	}
+
+	policies, svcErr := e.policyService.GetApplicablePolicies(ctx, requestedPermissions)
+	if svcErr != nil {
+		return nil, nil, fmt.Errorf("policy service error: %s", svcErr.Error.DefaultValue)
+	}
+	if len(policies) == 0 {
+		return permitted, denied, nil
+	}
+
+	evaluator := &conditionEvaluator{
+		engine:        e,
+		ctx:           ctx,
+		entityID:      entityID,
+		groupIDs:      groupIDs,
+		accessContext: getAccessContext(ctx),
+		now:           time.Now().UTC(),
+	}
+
+	for _, permission := range requestedPermissions {
+		for i := range policies {
+			if !policies[i].AppliesTo(permission) {
+				continue
+			}
+			if policies[i].Effect == policy.EffectDeny && denied[permission] ||
+				policies[i].Effect == policy.EffectPermit && permitted[permission] {
+				continue
+			}
+
+			holds, err := evaluator.conditionsHold(policies[i].Conditions, permission)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !holds {
+				continue
+			}
+			if policies[i].Effect == policy.EffectDeny {
+				denied[permission] = true
+			} else {
+				permitted[permission] = true
+			}
+		}
+	}
+
+	return permitted, denied, nil
+}
+
+// combinedEngine combines the decisions of the RBAC and ABAC engines.
+type combinedEngine struct {
+	rbac      AuthorizationEngine
+	abac      *abacEngine
+	algorithm string
+}
+
+// NewRBACABACEngine creates an authorization engine that combines role-based decisions with
+// attribute-based policies using the given combining algorithm.
+func NewRBACABACEngine(
+	rbac AuthorizationEngine,
+	policyService policy.PolicyServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+	algorithm string,
+) (AuthorizationEngine, error) {
+	if algorithm == "" {
+		algorithm = CombiningAlgorithmDenyOverrides
+	}
+	if algorithm != CombiningAlgorithmDenyOverrides && algorithm != CombiningAlgorithmPermitOverrides {
+		return nil, fmt.Errorf("unsupported combining algorithm: %s", algorithm)
+	}
+
+	return &combinedEngine{
+		rbac:      rbac,
+		abac:      newABACEngine(policyService, entityProvider, ouService),
+		algorithm: algorithm,
+	}, nil
+}
+
+// GetAuthorizedPermissions returns the subset of requested permissions granted by combining the
+// role-based and attribute-based decisions.
+func (e *combinedEngine) GetAuthorizedPermissions(
+	ctx context.Context,
+	entityID string,
+	groupIDs []string,
+	requestedPermissions []string,
+) ([]string, error) {
+	rbacPermissions, err := e.rbac.GetAuthorizedPermissions(ctx, entityID, groupIDs, requestedPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	permitted, denied, err := e.abac.evaluate(ctx, entityID, groupIDs, requestedPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	authorized := make([]string, 0, len(requestedPermissions))
+	for _, permission := range requestedPermissions {
+		if !permitted[permission] && !slices.Contains(rbacPermissions, permission) {
+			continue
+		}
+		if e.algorithm == CombiningAlgorithmDenyOverrides && denied[permission] {
+			continue
+		}
+		authorized = append(authorized, permission)
+	}
+	return authorized, nil
+}
+
+// conditionEvaluator evaluates policy conditions for a single authorization request.
+// The subject entity is looked up at most once, when a condition first needs it.
+type conditionEvaluator struct {
+	engine        *abacEngine
+	ctx           context.Context
+	entityID      string
+	groupIDs      []string
+	accessContext *AccessContext
+	now           time.Time
+
+	entityLoaded     bool
+	entity           *entityprovider.Entity
+	entityAttributes map[string]interface{}
+}
+
+// conditionsHold reports whether all the conditions hold for the given permission.
+func (ev *conditionEvaluator) conditionsHold(conditions []policy.Condition, permission string) (bool, error) {
+	for _, condition := range conditions {
+		value, found, err := ev.resolveAttribute(condition.Attribute, permission)
+		if err != nil {
+			return false, err
+		}
+		holds, err := ev.evaluateCondition(condition, value, found)
+		if err != nil {
+			return false, err
+		}
+		if !holds {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// resolveAttribute resolves the value of a condition attribute.
+func (ev *conditionEvaluator) resolveAttribute(attribute, permission string) (interface{}, bool, error) {
+	switch attribute {
+	case attributeSubjectID:
+		return ev.entityID, ev.entityID != "", nil
+	case attributeSubjectGroups:
+		groups := make([]interface{}, 0, len(ev.groupIDs))
+		for _, groupID := range ev.groupIDs {
+			groups = append(groups, groupID)
+		}
+		return groups, true, nil
+	case attributeSubjectType, attributeSubjectOUID:
+		if err := ev.loadEntity(); err != nil {
+			return nil, false, err
+		}
+		if ev.entity == nil {
+			return nil, false, nil
+		}
+		if attribute == attributeSubjectType {
+			return ev.entity.Type, ev.entity.Type != "", nil
+		}
+		return ev.entity.OUID, ev.entity.OUID != "", nil
+	case attributeResourcePerm:
+		return permission, true, nil
+	case attributeContextTime:
+		return ev.now, true, nil
+	case attributeContextIP:
+		if ip, ok := ev.accessContext.Attributes[contextAttributeIP].(string); ok && ip != "" {
+			return ip, true, nil
+		}
+		ip := sysContext.GetClientIP(ev.ctx)
+		return ip, ip != "", nil
+	case attributeContextACR:
+		return ev.accessContext.ACR, ev.accessContext.ACR != "", nil
+	}
+
+	if path, ok := strings.CutPrefix(attribute, attributeSubjectAttributes); ok {
+		if err := ev.loadEntity(); err != nil {
+			return nil, false, err
+		}
+		value, found := lookupPath(ev.entityAttributes, path)
+		return value, found, nil
+	}
+	if path, ok := strings.CutPrefix(attribute, policy.AttributePrefixResource); ok {
+		value, found := lookupPath(ev.accessContext.Resource, path)
+		return value, found, nil
+	}
+	if path, ok := strings.CutPrefix(attribute, policy.AttributePrefixContext); ok {
+		value, found := lookupPath(ev.accessContext.Attributes, path)
+		return value, found, nil
+	}
+	return nil, false, nil
+}
+
+// loadEntity looks up the subject entity and its attributes. A subject that is not an entity has
+// no entity attributes.
+func (ev *conditionEvaluator) loadEntity() error {
+	if ev.entityLoaded {
+		return nil
+	}
+	ev.entityLoaded = true
+	if ev.entityID == "" {
+		return nil
+	}
+
+	entity, epErr := ev.engine.entityProvider.GetEntity(ev.entityID)
+	if epErr != nil {
+		if epErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			return nil
+		}
+		return fmt.Errorf("entity provider error: %s", epErr.Error())
+	}
+	ev.entity = entity
+
+	if len(entity.Attributes) > 0 {
+		if err := json.Unmarshal(entity.Attributes, &ev.entityAttributes); err != nil {
+			return fmt.Errorf("failed to unmarshal entity attributes: %w", err)
+		}
+	}
+	return nil
+}
+
+// evaluateCondition reports whether a condition holds for the resolved attribute value.
+func (ev *conditionEvaluator) evaluateCondition(
+	condition policy.Condition, value interface{}, found bool,
+) (bool, error) {
+	if condition.Operator == policy.OperatorExists {
+		expected := true
+		if b, ok := condition.Value.(bool); ok {
+			expected = b
+		}
+		return found == expected, nil
+	}
+	if !found {
+		return false, nil
+	}
+
+	switch condition.Operator {
+	case policy.OperatorEquals:
+		return valuesEqual(value, condition.Value), nil
+	case policy.OperatorNotEquals:
+		return !valuesEqual(value, condition.Value), nil
+	case policy.OperatorIn:
+		return isIn(value, condition.Value), nil
+	case policy.OperatorNotIn:
+		return !isIn(value, condition.Value), nil
+	case policy.OperatorContains:
+		return contains(value, condition.Value), nil
+	case policy.OperatorStartsWith:
+		s, ok := value.(string)
+		prefix, _ := condition.Value.(string)
+		return ok && strings.HasPrefix(s, prefix), nil
+	case policy.OperatorGreaterThan, policy.OperatorLessThan:
+		actual, ok := policy.ToFloat(value)
+		expected, expectedOK := policy.ToFloat(condition.Value)
+		if !ok || !expectedOK {
+			return false, nil
+		}
+		if condition.Operator == policy.OperatorGreaterThan {
+			return actual > expected, nil
+		}
+		return actual < expected, nil
+	case policy.OperatorIPInRange:
+		return ipInRange(value, condition.Value), nil
+	case policy.OperatorTimeBetween:
+		return timeBetween(value, condition.Value), nil
+	case policy.OperatorInOU:
+		return ev.inOU(value, condition.Value)
+	default:
+		return false, nil
+	}
+}
+
+// inOU reports whether the organization unit is the given organization unit or one of its descendants.
+func (ev *conditionEvaluator) inOU(value, expected interface{}) (bool, error) {
+	ouID, ok := value.(string)
+	parentID, parentOK := expected.(string)
+	if !ok || !parentOK || ouID == "" || parentID == "" {
+		return false, nil
+	}
+	if ouID == parentID {
+		return true, nil
+	}
+
+	isParent, svcErr := ev.engine.ouService.IsParent(ev.ctx, parentID, ouID)
+	if svcErr != nil {
+		if svcErr.Code == ou.ErrorOrganizationUnitNotFound.Code {
+			return false, nil
+		}
+		return false, fmt.Errorf("organization unit service error: %s", svcErr.Error.DefaultValue)
+	}
+	return isParent, nil
+}
+
+// lookupPath resolves a dot separated path within nested attribute maps.
+func lookupPath(attributes map[string]interface{}, path string) (interface{}, bool) {
+	var current interface{} = attributes
+	for _, key := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+	return current, current != nil
+}
+
+// valuesEqual compares two attribute values, treating numbers of different types as equal when
+// their values are equal.
+func valuesEqual(a, b interface{}) bool {
+	if af, ok := policy.ToFloat(a); ok {
+		bf, ok := policy.ToFloat(b)
+		return ok && af == bf
+	}
+	switch av := a.(type) {
+	case string:
+		bv, ok := b.(string)
+		return ok && av == bv
+	case bool:
+		bv, ok := b.(bool)
+		return ok && av == bv
+	default:
+		return false
+	}
+}
+
+// isIn reports whether the value, or any element of a list value, is an element of the list.
+func isIn(value, list interface{}) bool {
+	items, ok := list.([]interface{})
+	if !ok {
+		return false
+	}
+	values, isList := value.([]interface{})
+	if !isList {
+		values = []interface{}{value}
+	}
+	for _, v := range values {
+		for _, item := range items {
+			if valuesEqual(v, item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// contains reports whether a string value contains a substring or a list value contains an element.
+func contains(value, element interface{}) bool {
+	switch v := value.(type) {
+	case string:
+		s, ok := element.(string)
+		return ok && strings.Contains(v, s)
+	case []interface{}:
+		for _, item := range v {
+			if valuesEqual(item, element) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ipInRange reports whether an IP address is within any of the given CIDR blocks.
+func ipInRange(value, ranges interface{}) bool {
+	s, ok := value.(string)
+	if !ok {
+		return false
+	}
+	ip := net.ParseIP(strings.TrimSpace(s))
+	if ip == nil {
+		return false
+	}
+	cidrs, ok := policy.ToStringSlice(ranges)
+	if !ok {
+		return false
+	}
+	for _, cidr := range cidrs {
+		if _, network, err := net.ParseCIDR(cidr); err == nil && network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// timeBetween reports whether a time falls within a daily UTC window. The window includes its start
+// and excludes its end, and wraps around midnight when the start is after the end.
+func timeBetween(value, window interface{}) bool {
+	var t time.Time
+	switch v := value.(type) {
+	case time.Time:
+		t = v
+	case string:
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return false
+		}
+		t = parsed
+	default:
+		return false
+	}
+
+	start, end, ok := policy.ParseTimeWindow(window)
+	if !ok {
+		return false
+	}
+	t = t.UTC()
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+
+	if start <= end {
+		return offset >= start && offset < end
+	}
+	return offset >= start || offset < end
+}
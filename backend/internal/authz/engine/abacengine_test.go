@@ -0,0 +1,330 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/authz/policy"
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/ou"
+	sysContext "github.com/asgardeo/thunder/internal/system/context"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/tests/mocks/authz/policymock"
+	"github.com/asgardeo/thunder/tests/mocks/entityprovidermock"
+	"github.com/asgardeo/thunder/tests/mocks/oumock"
+	"github.com/asgardeo/thunder/tests/mocks/rolemock"
+)
+
+const (
+	testPermRead  = "booking:read"
+	testPermWrite = "booking:write"
+)
+
+// ABACEngineTestSuite is the test suite for the ABAC and combined engines.
+type ABACEngineTestSuite struct {
+	suite.Suite
+	mockPolicyService  *policymock.PolicyServiceInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockOUService      *oumock.OrganizationUnitServiceInterfaceMock
+	engine             AuthorizationEngine
+}
+
+func TestABACEngineTestSuite(t *testing.T) {
+	suite.Run(t, new(ABACEngineTestSuite))
+}
+
+func (suite *ABACEngineTestSuite) SetupTest() {
+	suite.mockPolicyService = policymock.NewPolicyServiceInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	suite.engine = NewABACEngine(suite.mockPolicyService, suite.mockEntityProvider, suite.mockOUService)
+}
+
+func (suite *ABACEngineTestSuite) mockPolicies(policies ...policy.Policy) {
+	suite.mockPolicyService.On("GetApplicablePolicies", mock.Anything, mock.Anything).Return(policies, nil)
+}
+
+func (suite *ABACEngineTestSuite) mockEntity(ouID string, attributes string) {
+	suite.mockEntityProvider.On("GetEntity", testUserID1).Return(&entityprovider.Entity{
+		ID:         testUserID1,
+		Type:       "employee",
+		OUID:       ouID,
+		Attributes: json.RawMessage(attributes),
+	}, nil).Once()
+}
+
+func permitPolicy(permission string, conditions ...policy.Condition) policy.Policy {
+	return policy.Policy{Effect: policy.EffectPermit, Permissions: []string{permission}, Conditions: conditions}
+}
+
+func denyPolicy(permission string, conditions ...policy.Condition) policy.Policy {
+	return policy.Policy{Effect: policy.EffectDeny, Permissions: []string{permission}, Conditions: conditions}
+}
+
+func (suite *ABACEngineTestSuite) TestGetAuthorizedPermissions_NoApplicablePolicies() {
+	suite.mockPolicies()
+
+	result, err := suite.engine.GetAuthorizedPermissions(
+		context.Background(), testUserID1, nil, []string{testPermRead})
+
+	suite.NoError(err)
+	suite.Empty(result)
+}
+
+func (suite *ABACEngineTestSuite) TestGetAuthorizedPermissions_SubjectAttributes() {
+	suite.mockPolicies(
+		permitPolicy("booking:*", policy.Condition{
+			Attribute: "subject.attributes.department", Operator: policy.OperatorIn,
+			Value: []interface{}{"sales", "support"},
+		}, policy.Condition{
+			Attribute: "subject.attributes.profile.level", Operator: policy.OperatorGreaterThan, Value: 2,
+		}),
+	)
+	suite.mockEntity("ou1", `{"department":"sales","profile":{"level":3}}`)
+
+	result, err := suite.engine.GetAuthorizedPermissions(
+		context.Background(), testUserID1, nil, []string{testPermRead, testPermWrite})
+
+	suite.NoError(err)
+	suite.Equal([]string{testPermRead, testPermWrite}, result)
+}
+
+func (suite *ABACEngineTestSuite) TestGetAuthorizedPermissions_DenyBeatsPermit() {
+	suite.mockPolicies(
+		permitPolicy("booking:*"),
+		denyPolicy(testPermWrite, policy.Condition{
+			Attribute: "subject.type", Operator: policy.OperatorEquals, Value: "employee",
+		}),
+	)
+	suite.mockEntity("ou1", `{}`)
+
+	result, err := suite.engine.GetAuthorizedPermissions(
+		context.Background(), testUserID1, nil, []string{testPermRead, testPermWrite})
+
+	suite.NoError(err)
+	suite.Equal([]string{testPermRead}, result)
+}
+
+func (suite *ABACEngineTestSuite) TestGetAuthorizedPermissions_GroupsAndResourcePermission() {
+	suite.mockPolicies(
+		permitPolicy("booking:*", policy.Condition{
+			Attribute: "subject.groups", Operator: policy.OperatorContains, Value: "admins",
+		}, policy.Condition{
+			Attribute: "resource.permission", Operator: policy.OperatorStartsWith, Value: "booking:r",
+		}),
+	)
+
+	result, err := suite.engine.GetAuthorizedPermissions(
+		context.Background(), testUserID1, []string{"users", "admins"}, []string{testPermRead, testPermWrite})
+
+	suite.NoError(err)
+	suite.Equal([]string{testPermRead}, result)
+}
+
+func (suite *ABACEngineTestSuite) TestGetAuthorizedPermissions_RequestContext() {
+	suite.mockPolicies(
+		permitPolicy(testPermRead, policy.Condition{
+			Attribute: "context.ip", Operator: policy.OperatorIPInRange,
+			Value: []interface{}{"10.0.0.0/8", "192.168.0.0/16"},
+		}, policy.Condition{
+			Attribute: "context.acr", Operator: policy.OperatorEquals, Value: "mfa",
+		}, policy.Condition{
+			Attribute: "resource.owner.id", Operator: policy.OperatorEquals, Value: testUserID1,
+		}, policy.Condition{
+			Attribute: "context.channel", Operator: policy.OperatorNotIn, Value: []interface{}{"kiosk"},
+		}),
+	)
+
+	ctx := sysContext.WithClientIP(context.Background(), "192.168.1.20")
+	ctx = WithAccessContext(ctx, &AccessContext{
+		ACR:        "mfa",
+		Resource:   map[string]interface{}{"owner": map[string]interface{}{"id": testUserID1}},
+		Attributes: map[string]interface{}{"channel": "web"},
+	})
+	result, err := suite.engine.GetAuthorizedPermissions(ctx, testUserID1, nil, []string{testPermRead})
+	suite.NoError(err)
+	suite.Equal([]string{testPermRead}, result)
+
+	ctx = WithAccessContext(context.Background(), &AccessContext{
+		ACR:        "mfa",
+		Resource:   map[string]interface{}{"owner": map[string]interface{}{"id": testUserID1}},
+		Attributes: map[string]interface{}{"ip": "172.16.0.1", "channel": "web"},
+	})
+	result, err = suite.engine.GetAuthorizedPermissions(ctx, testUserID1, nil, []string{testPermRead})
+	suite.NoError(err)
+	suite.Empty(result)
+}
+
+func (suite *ABACEngineTestSuite) TestGetAuthorizedPermissions_MissingAttribute() {
+	suite.mockPolicies(
+		permitPolicy(testPermRead, policy.Condition{
+			Attribute: "subject.attributes.clearance", Operator: policy.OperatorNotEquals, Value: "none",
+		}),
+		permitPolicy(testPermWrite, policy.Condition{
+			Attribute: "subject.attributes.clearance", Operator: policy.OperatorExists, Value: false,
+		}),
+	)
+	suite.mockEntity("ou1", `{"department":"sales"}`)
+
+	result, err := suite.engine.GetAuthorizedPermissions(
+		context.Background(), testUserID1, nil, []string{testPermRead, testPermWrite})
+
+	suite.NoError(err)
+	suite.Equal([]string{testPermWrite}, result)
+}
+
+func (suite *ABACEngineTestSuite) TestGetAuthorizedPermissions_InOU() {
+	suite.mockPolicies(
+		permitPolicy(testPermRead, policy.Condition{
+			Attribute: "subject.ouId", Operator: policy.OperatorInOU, Value: "parent-ou",
+		}),
+	)
+	suite.mockEntity("child-ou", `{}`)
+	suite.mockOUService.On("IsParent", mock.Anything, "parent-ou", "child-ou").Return(true, nil)
+
+	result, err := suite.engine.GetAuthorizedPermissions(
+		context.Background(), testUserID1, nil, []string{testPermRead})
+
+	suite.NoError(err)
+	suite.Equal([]string{testPermRead}, result)
+}
+
+func (suite *ABACEngineTestSuite) TestGetAuthorizedPermissions_InOUServiceError() {
+	suite.mockPolicies(
+		permitPolicy(testPermRead, policy.Condition{
+			Attribute: "subject.ouId", Operator: policy.OperatorInOU, Value: "parent-ou",
+		}),
+	)
+	suite.mockEntity("child-ou", `{}`)
+	suite.mockOUService.On("IsParent", mock.Anything, "parent-ou", "child-ou").
+		Return(false, &serviceerror.InternalServerError)
+
+	_, err := suite.engine.GetAuthorizedPermissions(
+		context.Background(), testUserID1, nil, []string{testPermRead})
+
+	suite.Error(err)
+}
+
+func (suite *ABACEngineTestSuite) TestGetAuthorizedPermissions_InOUMissingOU() {
+	suite.mockPolicies(
+		permitPolicy(testPermRead, policy.Condition{
+			Attribute: "subject.ouId", Operator: policy.OperatorInOU, Value: "parent-ou",
+		}),
+	)
+	suite.mockEntity("child-ou", `{}`)
+	suite.mockOUService.On("IsParent", mock.Anything, "parent-ou", "child-ou").
+		Return(false, &ou.ErrorOrganizationUnitNotFound)
+
+	result, err := suite.engine.GetAuthorizedPermissions(
+		context.Background(), testUserID1, nil, []string{testPermRead})
+
+	suite.NoError(err)
+	suite.Empty(result)
+}
+
+func (suite *ABACEngineTestSuite) TestGetAuthorizedPermissions_UnknownEntity() {
+	suite.mockPolicies(
+		permitPolicy(testPermRead, policy.Condition{
+			Attribute: "subject.attributes.department", Operator: policy.OperatorExists,
+		}),
+	)
+	suite.mockEntityProvider.On("GetEntity", testUserID1).Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "Entity not found", ""))
+
+	result, err := suite.engine.GetAuthorizedPermissions(
+		context.Background(), testUserID1, nil, []string{testPermRead})
+
+	suite.NoError(err)
+	suite.Empty(result)
+}
+
+func (suite *ABACEngineTestSuite) TestGetAuthorizedPermissions_PolicyServiceError() {
+	suite.mockPolicyService.On("GetApplicablePolicies", mock.Anything, mock.Anything).
+		Return(nil, &serviceerror.InternalServerError)
+
+	_, err := suite.engine.GetAuthorizedPermissions(
+		context.Background(), testUserID1, nil, []string{testPermRead})
+
+	suite.Error(err)
+}
+
+func (suite *ABACEngineTestSuite) TestCombinedEngine_DenyOverrides() {
+	mockRoleService := rolemock.NewRoleServiceInterfaceMock(suite.T())
+	mockRoleService.On("GetAuthorizedPermissions", mock.Anything, testUserID1, mock.Anything, mock.Anything).
+		Return([]string{testPermRead, testPermWrite}, nil)
+	suite.mockPolicies(denyPolicy(testPermWrite))
+
+	combined, err := NewRBACABACEngine(NewRBACEngine(mockRoleService), suite.mockPolicyService,
+		suite.mockEntityProvider, suite.mockOUService, "")
+	suite.Require().NoError(err)
+
+	result, err := combined.GetAuthorizedPermissions(
+		context.Background(), testUserID1, nil, []string{testPermRead, testPermWrite, "booking:delete"})
+
+	suite.NoError(err)
+	suite.Equal([]string{testPermRead}, result)
+}
+
+func (suite *ABACEngineTestSuite) TestCombinedEngine_PermitOverrides() {
+	mockRoleService := rolemock.NewRoleServiceInterfaceMock(suite.T())
+	mockRoleService.On("GetAuthorizedPermissions", mock.Anything, testUserID1, mock.Anything, mock.Anything).
+		Return([]string{testPermWrite}, nil)
+	suite.mockPolicies(permitPolicy(testPermRead), denyPolicy(testPermWrite))
+
+	combined, err := NewRBACABACEngine(NewRBACEngine(mockRoleService), suite.mockPolicyService,
+		suite.mockEntityProvider, suite.mockOUService, CombiningAlgorithmPermitOverrides)
+	suite.Require().NoError(err)
+
+	result, err := combined.GetAuthorizedPermissions(
+		context.Background(), testUserID1, nil, []string{testPermRead, testPermWrite})
+
+	suite.NoError(err)
+	suite.Equal([]string{testPermRead, testPermWrite}, result)
+}
+
+func (suite *ABACEngineTestSuite) TestNewRBACABACEngine_UnsupportedAlgorithm() {
+	_, err := NewRBACABACEngine(nil, suite.mockPolicyService, suite.mockEntityProvider, suite.mockOUService,
+		"first_applicable")
+
+	suite.Error(err)
+}
+
+func (suite *ABACEngineTestSuite) TestTimeBetween() {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2026, 1, 1, hour, minute, 0, 0, time.UTC)
+	}
+	officeHours := []interface{}{"09:00", "17:00"}
+	overnight := []interface{}{"22:00", "06:00"}
+
+	suite.True(timeBetween(at(9, 0), officeHours))
+	suite.False(timeBetween(at(17, 0), officeHours))
+	suite.False(timeBetween(at(23, 0), officeHours))
+	suite.True(timeBetween(at(23, 0), overnight))
+	suite.True(timeBetween(at(5, 59), overnight))
+	suite.False(timeBetween(at(12, 0), overnight))
+	suite.True(timeBetween("2026-01-01T10:30:00+01:00", officeHours))
+	suite.False(timeBetween("not a time", officeHours))
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package engine
+
+import "context"
+
+// AccessContext holds the request context an authorization decision is made in. It is used by
+// engines that evaluate conditions over the resource and the request, such as the ABAC engine.
+type AccessContext struct {
+	// ACR is the authentication class reference of the authentication the request is made in.
+	ACR string
+	// Resource holds the attributes of the resource being accessed.
+	Resource map[string]interface{}
+	// Attributes holds additional attributes of the request, such as the client IP address.
+	Attributes map[string]interface{}
+}
+
+type accessContextKey struct{}
+
+// WithAccessContext returns a copy of ctx carrying the given access context.
+func WithAccessContext(ctx context.Context, accessContext *AccessContext) context.Context {
+	return context.WithValue(ctx, accessContextKey{}, accessContext)
+}
+
+// getAccessContext returns the access context carried by ctx, or an empty access context.
+func getAccessContext(ctx context.Context) *AccessContext {
+	if accessContext, ok := ctx.Value(accessContextKey{}).(*AccessContext); ok && accessContext != nil {
+		return accessContext
+	}
+	return &AccessContext{}
+}
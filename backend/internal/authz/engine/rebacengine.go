@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/asgardeo/thunder/internal/authz/rebac"
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+)
+
+// Separators of relationship permissions of the form "<namespace>:<object id>#<relation>".
+const (
+	relationshipObjectSeparator   = ":"
+	relationshipRelationSeparator = "#"
+)
+
+// rebacEngine implements Relationship-Based Access Control (ReBAC) authorization.
+// A requested permission of the form "<namespace>:<object id>#<relation>", such as
+// "document:readme#viewer", is granted when the entity has the relation to the object.
+// Permissions of any other form, and relations that are not defined in the schema, are not granted.
+type rebacEngine struct {
+	relationService rebac.RelationServiceInterface
+	entityProvider  entityprovider.EntityProviderInterface
+}
+
+// NewReBACEngine creates a new ReBAC authorization engine.
+func NewReBACEngine(
+	relationService rebac.RelationServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+) AuthorizationEngine {
+	return &rebacEngine{
+		relationService: relationService,
+		entityProvider:  entityProvider,
+	}
+}
+
+// GetAuthorizedPermissions returns the subset of requested relationship permissions that the
+// entity holds.
+func (e *rebacEngine) GetAuthorizedPermissions(
+	ctx context.Context,
+	entityID string,
+	groupIDs []string,
+	requestedPermissions []string,
+) ([]string, error) {
+	authorized := make([]string, 0)
+	var subject *rebac.SubjectRef
+
+	for _, permission := range requestedPermissions {
+		object, relation, ok := parseRelationshipPermission(permission)
+		if !ok {
+			continue
+		}
+
+		if subject == nil {
+			entity, epErr := e.entityProvider.GetEntity(entityID)
+			if epErr != nil {
+				if epErr.Code == entityprovider.ErrorCodeEntityNotFound {
+					return authorized, nil
+				}
+				return nil, fmt.Errorf("entity provider error: %w", epErr)
+			}
+			subject = &rebac.SubjectRef{Type: string(entity.Category), ID: entityID}
+		}
+
+		response, svcErr := e.relationService.Check(ctx, rebac.CheckRequest{
+			Object:   object,
+			Relation: relation,
+			Subject:  *subject,
+		})
+		if svcErr != nil {
+			if svcErr.Type == serviceerror.ClientErrorType {
+				continue
+			}
+			return nil, fmt.Errorf("relation service error: %s", svcErr.Error.DefaultValue)
+		}
+		if response.Allowed {
+			authorized = append(authorized, permission)
+		}
+	}
+	return authorized, nil
+}
+
+// parseRelationshipPermission parses a permission of the form "<namespace>:<object id>#<relation>".
+func parseRelationshipPermission(permission string) (rebac.ObjectRef, string, bool) {
+	objectPart, relation, ok := strings.Cut(permission, relationshipRelationSeparator)
+	if !ok || relation == "" {
+		return rebac.ObjectRef{}, "", false
+	}
+	namespace, objectID, ok := strings.Cut(objectPart, relationshipObjectSeparator)
+	if !ok || namespace == "" || objectID == "" {
+		return rebac.ObjectRef{}, "", false
+	}
+	return rebac.ObjectRef{Type: namespace, ID: objectID}, relation, true
+}
+
+// unionEngine grants the permissions granted by any of its engines.
+type unionEngine struct {
+	engines []AuthorizationEngine
+}
+
+// NewRBACReBACEngine creates an authorization engine that grants the permissions granted through
+// role assignments or relationships.
+func NewRBACReBACEngine(
+	rbac AuthorizationEngine,
+	relationService rebac.RelationServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+) AuthorizationEngine {
+	return &unionEngine{
+		engines: []AuthorizationEngine{rbac, NewReBACEngine(relationService, entityProvider)},
+	}
+}
+
+// GetAuthorizedPermissions returns the subset of requested permissions granted by any engine,
+// in the order they were requested.
+func (e *unionEngine) GetAuthorizedPermissions(
+	ctx context.Context,
+	entityID string,
+	groupIDs []string,
+	requestedPermissions []string,
+) ([]string, error) {
+	granted := make(map[string]bool)
+	for _, engine := range e.engines {
+		permissions, err := engine.GetAuthorizedPermissions(ctx, entityID, groupIDs, requestedPermissions)
+		if err != nil {
+			return nil, err
+		}
+		for _, permission := range permissions {
+			granted[permission] = true
+		}
+	}
+
+	authorized := make([]string, 0, len(granted))
+	for _, permission := range requestedPermissions {
+		if granted[permission] && !slices.Contains(authorized, permission) {
+			authorized = append(authorized, permission)
+		}
+	}
+	return authorized, nil
+}
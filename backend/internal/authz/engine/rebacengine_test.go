@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/authz/rebac"
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/tests/mocks/authz/rebacmock"
+	"github.com/asgardeo/thunder/tests/mocks/entityprovidermock"
+	"github.com/asgardeo/thunder/tests/mocks/rolemock"
+)
+
+const (
+	testPermViewReadme = "document:readme#viewer"
+	testPermEditReadme = "document:readme#editor"
+)
+
+// ReBACEngineTestSuite is the test suite for the ReBAC and union engines.
+type ReBACEngineTestSuite struct {
+	suite.Suite
+	mockRelationService *rebacmock.RelationServiceInterfaceMock
+	mockEntityProvider  *entityprovidermock.EntityProviderInterfaceMock
+	engine              AuthorizationEngine
+}
+
+func TestReBACEngineTestSuite(t *testing.T) {
+	suite.Run(t, new(ReBACEngineTestSuite))
+}
+
+func (suite *ReBACEngineTestSuite) SetupTest() {
+	suite.mockRelationService = rebacmock.NewRelationServiceInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.engine = NewReBACEngine(suite.mockRelationService, suite.mockEntityProvider)
+}
+
+func (suite *ReBACEngineTestSuite) mockEntity() {
+	suite.mockEntityProvider.On("GetEntity", testUserID1).
+		Return(&entityprovider.Entity{ID: testUserID1, Category: entityprovider.EntityCategoryUser}, nil).Once()
+}
+
+func (suite *ReBACEngineTestSuite) mockCheck(relation string, allowed bool) {
+	suite.mockRelationService.On("Check", mock.Anything, rebac.CheckRequest{
+		Object:   rebac.ObjectRef{Type: "document", ID: "readme"},
+		Relation: relation,
+		Subject:  rebac.SubjectRef{Type: "user", ID: testUserID1},
+	}).Return(&rebac.CheckResponse{Allowed: allowed}, nil).Once()
+}
+
+func (suite *ReBACEngineTestSuite) TestGetAuthorizedPermissions() {
+	suite.mockEntity()
+	suite.mockCheck("viewer", true)
+	suite.mockCheck("editor", false)
+
+	result, err := suite.engine.GetAuthorizedPermissions(context.Background(), testUserID1, nil,
+		[]string{"booking:read", testPermViewReadme, testPermEditReadme, "document:#viewer"})
+
+	suite.NoError(err)
+	suite.Equal([]string{testPermViewReadme}, result)
+}
+
+func (suite *ReBACEngineTestSuite) TestGetAuthorizedPermissions_NoRelationshipPermissions() {
+	result, err := suite.engine.GetAuthorizedPermissions(context.Background(), testUserID1, nil,
+		[]string{"booking:read", "booking:write"})
+
+	suite.NoError(err)
+	suite.Empty(result)
+}
+
+func (suite *ReBACEngineTestSuite) TestGetAuthorizedPermissions_UnknownEntity() {
+	suite.mockEntityProvider.On("GetEntity", testUserID1).Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "Entity not found", ""))
+
+	result, err := suite.engine.GetAuthorizedPermissions(context.Background(), testUserID1, nil,
+		[]string{testPermViewReadme})
+
+	suite.NoError(err)
+	suite.Empty(result)
+}
+
+func (suite *ReBACEngineTestSuite) TestGetAuthorizedPermissions_UndefinedRelation() {
+	suite.mockEntity()
+	suite.mockRelationService.On("Check", mock.Anything, mock.Anything).Return(nil, &rebac.ErrorUnknownRelation)
+
+	result, err := suite.engine.GetAuthorizedPermissions(context.Background(), testUserID1, nil,
+		[]string{"document:readme#commenter"})
+
+	suite.NoError(err)
+	suite.Empty(result)
+}
+
+func (suite *ReBACEngineTestSuite) TestGetAuthorizedPermissions_ServiceError() {
+	suite.mockEntity()
+	suite.mockRelationService.On("Check", mock.Anything, mock.Anything).
+		Return(nil, &serviceerror.InternalServerError)
+
+	_, err := suite.engine.GetAuthorizedPermissions(context.Background(), testUserID1, nil,
+		[]string{testPermViewReadme})
+
+	suite.Error(err)
+}
+
+func (suite *ReBACEngineTestSuite) TestRBACReBACEngine() {
+	mockRoleService := rolemock.NewRoleServiceInterfaceMock(suite.T())
+	requested := []string{testPermEditReadme, "booking:read", testPermViewReadme, "booking:write"}
+	mockRoleService.On("GetAuthorizedPermissions", mock.Anything, testUserID1, []string{"group1"}, requested).
+		Return([]string{"booking:read"}, nil)
+	suite.mockEntity()
+	suite.mockCheck("viewer", true)
+	suite.mockCheck("editor", false)
+
+	combined := NewRBACReBACEngine(NewRBACEngine(mockRoleService), suite.mockRelationService,
+		suite.mockEntityProvider)
+	result, err := combined.GetAuthorizedPermissions(context.Background(), testUserID1, []string{"group1"},
+		requested)
+
+	suite.NoError(err)
+	suite.Equal([]string{"booking:read", testPermViewReadme}, result)
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package authz
+
+import (
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/i18n/core"
+)
+
+// Client errors for the authorization service.
+var (
+	// ErrorInvalidRequestFormat is returned when the request body cannot be parsed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZ-1001",
+		Error: core.I18nMessage{
+			Key:          "error.authzservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorInvalidSubject is returned when the subject of an evaluation is missing or incomplete.
+	ErrorInvalidSubject = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZ-1002",
+		Error: core.I18nMessage{
+			Key:          "error.authzservice.invalid_subject",
+			DefaultValue: "Invalid subject",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzservice.invalid_subject_description",
+			DefaultValue: "The subject type and id are required",
+		},
+	}
+	// ErrorInvalidResource is returned when the resource of an evaluation is missing or incomplete.
+	ErrorInvalidResource = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZ-1003",
+		Error: core.I18nMessage{
+			Key:          "error.authzservice.invalid_resource",
+			DefaultValue: "Invalid resource",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzservice.invalid_resource_description",
+			DefaultValue: "The resource type and id are required",
+		},
+	}
+	// ErrorInvalidAction is returned when the action of an evaluation is missing or incomplete.
+	ErrorInvalidAction = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZ-1004",
+		Error: core.I18nMessage{
+			Key:          "error.authzservice.invalid_action",
+			DefaultValue: "Invalid action",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzservice.invalid_action_description",
+			DefaultValue: "The action name is required",
+		},
+	}
+	// ErrorInvalidEvaluationsSemantic is returned when a batch evaluation requests an unsupported semantic.
+	ErrorInvalidEvaluationsSemantic = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZ-1005",
+		Error: core.I18nMessage{
+			Key:          "error.authzservice.invalid_evaluations_semantic",
+			DefaultValue: "Invalid evaluations semantic",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzservice.invalid_evaluations_semantic_description",
+			DefaultValue: "The evaluations semantic must be one of execute_all, deny_on_first_deny, or permit_on_first_permit",
+		},
+	}
+	// ErrorBatchSizeExceeded is returned when a batch evaluation contains more evaluations than allowed.
+	ErrorBatchSizeExceeded = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZ-1006",
+		Error: core.I18nMessage{
+			Key:          "error.authzservice.batch_size_exceeded",
+			DefaultValue: "Batch size exceeded",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzservice.batch_size_exceeded_description",
+			DefaultValue: "The number of evaluations exceeds the maximum batch size",
+		},
+	}
+)
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package authz
+
+import (
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/apierror"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/log"
+	sysutils "github.com/asgardeo/thunder/internal/system/utils"
+)
+
+const handlerLoggerComponentName = "AuthorizationHandler"
+
+// authorizationHandler is the handler for the AuthZEN access evaluation API.
+type authorizationHandler struct {
+	authzService AuthorizationServiceInterface
+}
+
+// newAuthorizationHandler creates a new instance of authorizationHandler with dependency injection.
+func newAuthorizationHandler(authzService AuthorizationServiceInterface) *authorizationHandler {
+	return &authorizationHandler{
+		authzService: authzService,
+	}
+}
+
+// HandleEvaluationRequest handles the access evaluation request.
+func (h *authorizationHandler) HandleEvaluationRequest(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+	echoRequestID(w, r)
+
+	evaluationRequest, err := sysutils.DecodeJSONBody[EvaluationRequest](r)
+	if err != nil || evaluationRequest == nil {
+		handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	evaluationResponse, svcErr := h.authzService.Evaluate(r.Context(), *evaluationRequest)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, evaluationResponse)
+	logger.Debug("Access evaluation response sent", log.Bool("decision", evaluationResponse.Decision))
+}
+
+// HandleEvaluationsRequest handles the batch access evaluation request.
+func (h *authorizationHandler) HandleEvaluationsRequest(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+	echoRequestID(w, r)
+
+	evaluationsRequest, err := sysutils.DecodeJSONBody[EvaluationsRequest](r)
+	if err != nil || evaluationsRequest == nil {
+		handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	evaluationsResponse, svcErr := h.authzService.EvaluateBatch(r.Context(), *evaluationsRequest)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, evaluationsResponse)
+	logger.Debug("Batch access evaluation response sent",
+		log.Int("evaluationCount", len(evaluationsResponse.Evaluations)))
+}
+
+// HandleMetadataRequest handles the policy decision point metadata request.
+func (h *authorizationHandler) HandleMetadataRequest(w http.ResponseWriter, r *http.Request) {
+	baseURL := config.GetServerURL(&config.GetServerRuntime().Config.Server)
+	metadata := PDPMetadata{
+		PolicyDecisionPoint:       baseURL,
+		AccessEvaluationEndpoint:  baseURL + accessEvaluationPath,
+		AccessEvaluationsEndpoint: baseURL + accessEvaluationsPath,
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, metadata)
+}
+
+// echoRequestID returns the request ID sent by the policy enforcement point with the response.
+func echoRequestID(w http.ResponseWriter, r *http.Request) {
+	if requestID := r.Header.Get(requestIDHeaderName); requestID != "" {
+		w.Header().Set(requestIDHeaderName, requestID)
+	}
+}
+
+// handleError handles service errors and writes the corresponding error response.
+func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		statusCode = http.StatusBadRequest
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
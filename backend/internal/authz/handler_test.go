@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package authz
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/apierror"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+)
+
+const testEvaluationBody = `{"subject":{"type":"user","id":"user1"},` +
+	`"resource":{"type":"https://api.example.com","id":"booking:reservations"},"action":{"name":"create"}}`
+
+func TestHandleEvaluationRequest_Success(t *testing.T) {
+	mockSvc := NewAuthorizationServiceInterfaceMock(t)
+	mockSvc.On("Evaluate", mock.Anything, mock.MatchedBy(func(req EvaluationRequest) bool {
+		return req.Subject.ID == "user1" && req.Resource.ID == "booking:reservations" && req.Action.Name == "create"
+	})).Return(&EvaluationResponse{Decision: true}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, accessEvaluationPath, strings.NewReader(testEvaluationBody))
+	req.Header.Set(requestIDHeaderName, "req-1")
+	rr := httptest.NewRecorder()
+	newAuthorizationHandler(mockSvc).HandleEvaluationRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "req-1", rr.Header().Get(requestIDHeaderName))
+	var resp EvaluationResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.True(t, resp.Decision)
+}
+
+func TestHandleEvaluationRequest_InvalidBody(t *testing.T) {
+	mockSvc := NewAuthorizationServiceInterfaceMock(t)
+
+	rr := httptest.NewRecorder()
+	newAuthorizationHandler(mockSvc).HandleEvaluationRequest(rr,
+		httptest.NewRequest(http.MethodPost, accessEvaluationPath, strings.NewReader(`{`)))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&errResp))
+	require.Equal(t, ErrorInvalidRequestFormat.Code, errResp.Code)
+}
+
+func TestHandleEvaluationRequest_InvalidSubject(t *testing.T) {
+	mockSvc := NewAuthorizationServiceInterfaceMock(t)
+	mockSvc.On("Evaluate", mock.Anything, mock.Anything).Return(nil, &ErrorInvalidSubject)
+
+	rr := httptest.NewRecorder()
+	newAuthorizationHandler(mockSvc).HandleEvaluationRequest(rr,
+		httptest.NewRequest(http.MethodPost, accessEvaluationPath, strings.NewReader(`{}`)))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestHandleEvaluationRequest_ServerError(t *testing.T) {
+	mockSvc := NewAuthorizationServiceInterfaceMock(t)
+	mockSvc.On("Evaluate", mock.Anything, mock.Anything).Return(nil, &serviceerror.InternalServerError)
+
+	rr := httptest.NewRecorder()
+	newAuthorizationHandler(mockSvc).HandleEvaluationRequest(rr,
+		httptest.NewRequest(http.MethodPost, accessEvaluationPath, strings.NewReader(testEvaluationBody)))
+
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+}
+
+func TestHandleEvaluationsRequest_Success(t *testing.T) {
+	mockSvc := NewAuthorizationServiceInterfaceMock(t)
+	mockSvc.On("EvaluateBatch", mock.Anything, mock.MatchedBy(func(req EvaluationsRequest) bool {
+		return len(req.Evaluations) == 2 && req.Options.EvaluationsSemantic == EvaluationsSemanticDenyOnFirstDeny
+	})).Return(&EvaluationsResponse{Evaluations: []EvaluationResponse{{Decision: false}}}, nil)
+
+	body := `{"subject":{"type":"user","id":"user1"},"evaluations":[{},{}],` +
+		`"options":{"evaluations_semantic":"deny_on_first_deny"}}`
+	rr := httptest.NewRecorder()
+	newAuthorizationHandler(mockSvc).HandleEvaluationsRequest(rr,
+		httptest.NewRequest(http.MethodPost, accessEvaluationsPath, strings.NewReader(body)))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp EvaluationsResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Len(t, resp.Evaluations, 1)
+	require.False(t, resp.Evaluations[0].Decision)
+}
+
+func TestHandleEvaluationsRequest_InvalidSemantic(t *testing.T) {
+	mockSvc := NewAuthorizationServiceInterfaceMock(t)
+	mockSvc.On("EvaluateBatch", mock.Anything, mock.Anything).Return(nil, &ErrorInvalidEvaluationsSemantic)
+
+	rr := httptest.NewRecorder()
+	newAuthorizationHandler(mockSvc).HandleEvaluationsRequest(rr,
+		httptest.NewRequest(http.MethodPost, accessEvaluationsPath,
+			strings.NewReader(`{"options":{"evaluations_semantic":"unknown"}}`)))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestHandleEvaluationsRequest_BatchSizeExceeded(t *testing.T) {
+	mockSvc := NewAuthorizationServiceInterfaceMock(t)
+	mockSvc.On("EvaluateBatch", mock.Anything, mock.Anything).Return(nil, &ErrorBatchSizeExceeded)
+
+	rr := httptest.NewRecorder()
+	newAuthorizationHandler(mockSvc).HandleEvaluationsRequest(rr,
+		httptest.NewRequest(http.MethodPost, accessEvaluationsPath,
+			strings.NewReader(`{"evaluations":[{},{},{}]}`)))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestHandleMetadataRequest(t *testing.T) {
+	config.ResetServerRuntime()
+	defer config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("", &config.Config{
+		Server: config.ServerConfig{PublicURL: "https://thunder.example.com"},
+	})
+	mockSvc := NewAuthorizationServiceInterfaceMock(t)
+
+	rr := httptest.NewRecorder()
+	newAuthorizationHandler(mockSvc).HandleMetadataRequest(rr,
+		httptest.NewRequest(http.MethodGet, pdpMetadataPath, nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var metadata PDPMetadata
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&metadata))
+	require.Equal(t, "https://thunder.example.com", metadata.PolicyDecisionPoint)
+	require.Equal(t, "https://thunder.example.com/access/v1/evaluation", metadata.AccessEvaluationEndpoint)
+	require.Equal(t, "https://thunder.example.com/access/v1/evaluations", metadata.AccessEvaluationsEndpoint)
+}
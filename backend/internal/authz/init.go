@@ -19,12 +19,84 @@
 package authz
 
 import (
+	"net/http"
+
 	"github.com/asgardeo/thunder/internal/authz/engine"
+	"github.com/asgardeo/thunder/internal/authz/policy"
+	"github.com/asgardeo/thunder/internal/authz/rebac"
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/ou"
+	"github.com/asgardeo/thunder/internal/resource"
 	"github.com/asgardeo/thunder/internal/role"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/middleware"
 )
 
-// Initialize creates and initializes the authorization service with the RBAC engine.
-func Initialize(roleService role.RoleServiceInterface) AuthorizationServiceInterface {
-	rbacEngine := engine.NewRBACEngine(roleService)
-	return newAuthorizationService(rbacEngine)
+// Initialize creates and initializes the authorization service and registers the access evaluation,
+// authorization policy, and relationship routes. The RBAC engine is extended with the ReBAC engine
+// when relationship-based access control is enabled, and the result is combined with the ABAC engine
+// when attribute-based access control is enabled.
+func Initialize(
+	mux *http.ServeMux,
+	roleService role.RoleServiceInterface,
+	resourceService resource.ResourceServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+) (AuthorizationServiceInterface, error) {
+	policyService, err := policy.Initialize(mux)
+	if err != nil {
+		return nil, err
+	}
+
+	relationService, err := rebac.Initialize(mux, entityProvider, ouService)
+	if err != nil {
+		return nil, err
+	}
+
+	authzConfig := config.GetServerRuntime().Config.Authorization
+	authzEngine := engine.NewRBACEngine(roleService)
+	if authzConfig.ReBAC.Enabled {
+		authzEngine = engine.NewRBACReBACEngine(authzEngine, relationService, entityProvider)
+	}
+	if abacConfig := authzConfig.ABAC; abacConfig.Enabled {
+		authzEngine, err = engine.NewRBACABACEngine(authzEngine, policyService, entityProvider, ouService,
+			abacConfig.CombiningAlgorithm)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	maxBatchSize := authzConfig.AccessEvaluation.MaxBatchSize
+	if maxBatchSize <= 0 {
+		maxBatchSize = defaultMaxBatchSize
+	}
+	authzService := newAuthorizationService(authzEngine, resourceService, entityProvider, maxBatchSize)
+
+	authzHandler := newAuthorizationHandler(authzService)
+	registerRoutes(mux, authzHandler)
+
+	return authzService, nil
+}
+
+// registerRoutes registers the AuthZEN access evaluation and metadata routes.
+func registerRoutes(mux *http.ServeMux, authzHandler *authorizationHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   []string{"Content-Type", "Authorization", requestIDHeaderName},
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST "+accessEvaluationPath, authzHandler.HandleEvaluationRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+accessEvaluationPath, optionsNoContentHandler, opts))
+
+	mux.HandleFunc(middleware.WithCORS("POST "+accessEvaluationsPath, authzHandler.HandleEvaluationsRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+accessEvaluationsPath, optionsNoContentHandler, opts))
+
+	mux.HandleFunc(middleware.WithCORS("GET "+pdpMetadataPath, authzHandler.HandleMetadataRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+pdpMetadataPath, optionsNoContentHandler, opts))
+}
+
+// optionsNoContentHandler handles the CORS preflight requests.
+func optionsNoContentHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNoContent)
 }
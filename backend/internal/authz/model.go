@@ -20,12 +20,87 @@ package authz
 
 // GetAuthorizedPermissionsRequest represents the request for getting authorized permissions.
 type GetAuthorizedPermissionsRequest struct {
-	EntityID             string   `json:"entityId,omitempty"`
-	GroupIDs             []string `json:"groupIds,omitempty"`
-	RequestedPermissions []string `json:"requestedPermissions"`
+	EntityID             string         `json:"entityId,omitempty"`
+	GroupIDs             []string       `json:"groupIds,omitempty"`
+	RequestedPermissions []string       `json:"requestedPermissions"`
+	Context              *AccessContext `json:"context,omitempty"`
+}
+
+// AccessContext holds the context of an authorization request, such as the authentication class
+// and attributes of the resource and the request. It is evaluated by attribute-based policies.
+type AccessContext struct {
+	ACR                string                 `json:"acr,omitempty"`
+	ResourceAttributes map[string]interface{} `json:"resourceAttributes,omitempty"`
+	Attributes         map[string]interface{} `json:"attributes,omitempty"`
 }
 
 // GetAuthorizedPermissionsResponse represents the response with authorized permissions.
 type GetAuthorizedPermissionsResponse struct {
 	AuthorizedPermissions []string `json:"authorizedPermissions"`
 }
+
+// EvaluationSubject represents the subject of an access evaluation request.
+// The subject ID is the ID of the entity the decision is evaluated for.
+type EvaluationSubject struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+// EvaluationResource represents the resource of an access evaluation request.
+// The resource type is the identifier of a resource server and the resource ID is the permission
+// string of a resource within it, or the resource server identifier for resource server level actions.
+type EvaluationResource struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+// EvaluationAction represents the action of an access evaluation request.
+// The action name is the handle of an action defined on the resource.
+type EvaluationAction struct {
+	Name       string                 `json:"name"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+// EvaluationRequest represents an access evaluation request as defined by the OpenID AuthZEN specification.
+type EvaluationRequest struct {
+	Subject  *EvaluationSubject     `json:"subject,omitempty"`
+	Resource *EvaluationResource    `json:"resource,omitempty"`
+	Action   *EvaluationAction      `json:"action,omitempty"`
+	Context  map[string]interface{} `json:"context,omitempty"`
+}
+
+// EvaluationResponse represents the decision of an access evaluation request.
+type EvaluationResponse struct {
+	Decision bool                   `json:"decision"`
+	Context  map[string]interface{} `json:"context,omitempty"`
+}
+
+// EvaluationsOptions represents the options of a batch access evaluation request.
+type EvaluationsOptions struct {
+	EvaluationsSemantic string `json:"evaluations_semantic,omitempty"`
+}
+
+// EvaluationsRequest represents a batch access evaluation request. The top-level subject, resource,
+// action, and context are the defaults for each evaluation in the batch.
+type EvaluationsRequest struct {
+	Subject     *EvaluationSubject     `json:"subject,omitempty"`
+	Resource    *EvaluationResource    `json:"resource,omitempty"`
+	Action      *EvaluationAction      `json:"action,omitempty"`
+	Context     map[string]interface{} `json:"context,omitempty"`
+	Evaluations []EvaluationRequest    `json:"evaluations,omitempty"`
+	Options     *EvaluationsOptions    `json:"options,omitempty"`
+}
+
+// EvaluationsResponse represents the decisions of a batch access evaluation request.
+type EvaluationsResponse struct {
+	Evaluations []EvaluationResponse `json:"evaluations"`
+}
+
+// PDPMetadata represents the policy decision point metadata published for AuthZEN discovery.
+type PDPMetadata struct {
+	PolicyDecisionPoint       string `json:"policy_decision_point"`
+	AccessEvaluationEndpoint  string `json:"access_evaluation_endpoint"`
+	AccessEvaluationsEndpoint string `json:"access_evaluations_endpoint"`
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"context"
+
+	serverconst "github.com/asgardeo/thunder/internal/system/constants"
+	declarativeresource "github.com/asgardeo/thunder/internal/system/declarative_resource"
+)
+
+// compositePolicyStore implements a composite store that combines file-based (immutable) and
+// database (mutable) stores.
+// - Read operations query both stores and merge results
+// - Write operations (Create/Update/Delete) only affect the database store
+// - Declarative policies (from YAML files) cannot be modified or deleted
+type compositePolicyStore struct {
+	fileStore policyStoreInterface
+	dbStore   policyStoreInterface
+}
+
+// newCompositePolicyStore creates a new composite store with both file-based and database stores.
+func newCompositePolicyStore(fileStore, dbStore policyStoreInterface) *compositePolicyStore {
+	return &compositePolicyStore{
+		fileStore: fileStore,
+		dbStore:   dbStore,
+	}
+}
+
+// GetPolicyListCount retrieves the total count of policies from both stores.
+func (c *compositePolicyStore) GetPolicyListCount(ctx context.Context) (int, error) {
+	return declarativeresource.CompositeMergeCountHelper(
+		func() (int, error) { return c.dbStore.GetPolicyListCount(ctx) },
+		func() (int, error) { return c.fileStore.GetPolicyListCount(ctx) },
+	)
+}
+
+// GetPolicyList retrieves policies from both stores with pagination.
+// Returns errResultLimitExceededInCompositeMode if the composite record limit is exceeded.
+func (c *compositePolicyStore) GetPolicyList(ctx context.Context, limit, offset int) ([]Policy, error) {
+	items, limitExceeded, err := declarativeresource.CompositeMergeListHelperWithLimit(
+		func() (int, error) { return c.dbStore.GetPolicyListCount(ctx) },
+		func() (int, error) { return c.fileStore.GetPolicyListCount(ctx) },
+		func(count int) ([]Policy, error) { return c.dbStore.GetPolicyList(ctx, count, 0) },
+		func(count int) ([]Policy, error) { return c.fileStore.GetPolicyList(ctx, count, 0) },
+		mergeAndDeduplicatePolicies,
+		limit,
+		offset,
+		serverconst.MaxCompositeStoreRecords,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if limitExceeded {
+		return nil, errResultLimitExceededInCompositeMode
+	}
+	return items, nil
+}
+
+// GetAllPolicies retrieves all policies from both stores.
+func (c *compositePolicyStore) GetAllPolicies(ctx context.Context) ([]Policy, error) {
+	dbPolicies, err := c.dbStore.GetAllPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filePolicies, err := c.fileStore.GetAllPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mergeAndDeduplicatePolicies(dbPolicies, filePolicies), nil
+}
+
+// CreatePolicy creates a new policy in the database store only.
+func (c *compositePolicyStore) CreatePolicy(ctx context.Context, policy Policy) error {
+	return c.dbStore.CreatePolicy(ctx, policy)
+}
+
+// GetPolicy retrieves a policy by ID from either store.
+func (c *compositePolicyStore) GetPolicy(ctx context.Context, id string) (Policy, error) {
+	return declarativeresource.CompositeGetHelper(
+		func() (Policy, error) {
+			policy, err := c.dbStore.GetPolicy(ctx, id)
+			if err != nil {
+				return Policy{}, err
+			}
+			policy.IsReadOnly = false
+			return policy, nil
+		},
+		func() (Policy, error) {
+			policy, err := c.fileStore.GetPolicy(ctx, id)
+			if err != nil {
+				return Policy{}, err
+			}
+			policy.IsReadOnly = true
+			return policy, nil
+		},
+		errPolicyNotFound,
+	)
+}
+
+// IsPolicyExist checks if a policy exists in either store.
+func (c *compositePolicyStore) IsPolicyExist(ctx context.Context, id string) (bool, error) {
+	exists, err := c.dbStore.IsPolicyExist(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return true, nil
+	}
+	return c.fileStore.IsPolicyExist(ctx, id)
+}
+
+// UpdatePolicy updates a policy in the database store only.
+// Returns an error if the policy is declarative (immutable).
+func (c *compositePolicyStore) UpdatePolicy(ctx context.Context, policy Policy) error {
+	return declarativeresource.CompositeUpdateHelper(
+		policy,
+		func(p Policy) string { return p.ID },
+		func(id string) (bool, error) { return c.fileStore.IsPolicyExist(ctx, id) },
+		func(p Policy) error { return c.dbStore.UpdatePolicy(ctx, p) },
+		errCannotUpdateDeclarativePolicy,
+	)
+}
+
+// DeletePolicy deletes a policy from the database store only.
+// Returns an error if the policy is declarative (immutable).
+func (c *compositePolicyStore) DeletePolicy(ctx context.Context, id string) error {
+	return declarativeresource.CompositeDeleteHelper(
+		id,
+		func(id string) (bool, error) { return c.fileStore.IsPolicyExist(ctx, id) },
+		func(id string) error { return c.dbStore.DeletePolicy(ctx, id) },
+		errCannotDeleteDeclarativePolicy,
+	)
+}
+
+// IsPolicyDeclarative checks if a policy is immutable (exists in file store).
+func (c *compositePolicyStore) IsPolicyDeclarative(ctx context.Context, id string) bool {
+	exists, err := c.fileStore.IsPolicyExist(ctx, id)
+	return err == nil && exists
+}
+
+// IsPolicyNameConflict checks if a policy name conflicts in either store.
+func (c *compositePolicyStore) IsPolicyNameConflict(ctx context.Context, name string, excludeID string) (bool, error) {
+	conflict, err := c.fileStore.IsPolicyNameConflict(ctx, name, excludeID)
+	if err != nil {
+		return false, err
+	}
+	if conflict {
+		return true, nil
+	}
+	return c.dbStore.IsPolicyNameConflict(ctx, name, excludeID)
+}
+
+// mergeAndDeduplicatePolicies merges policies from DB and file stores, removing duplicates.
+// File store (declarative) policies take precedence over DB policies with the same ID.
+func mergeAndDeduplicatePolicies(dbPolicies, filePolicies []Policy) []Policy {
+	seen := make(map[string]bool)
+	merged := make([]Policy, 0, len(dbPolicies)+len(filePolicies))
+
+	for i := range filePolicies {
+		if !seen[filePolicies[i].ID] {
+			filePolicies[i].IsReadOnly = true
+			merged = append(merged, filePolicies[i])
+			seen[filePolicies[i].ID] = true
+		}
+	}
+
+	for i := range dbPolicies {
+		if !seen[dbPolicies[i].ID] {
+			dbPolicies[i].IsReadOnly = false
+			merged = append(merged, dbPolicies[i])
+			seen[dbPolicies[i].ID] = true
+		}
+	}
+
+	return merged
+}
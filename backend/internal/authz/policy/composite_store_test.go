@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompositePolicyStore_GetAllPoliciesMergesStores(t *testing.T) {
+	fileStore := newPolicyStoreInterfaceMock(t)
+	dbStore := newPolicyStoreInterfaceMock(t)
+	fileStore.On("GetAllPolicies", mock.Anything).Return([]Policy{{ID: "p1"}, {ID: "p2"}}, nil)
+	dbStore.On("GetAllPolicies", mock.Anything).Return([]Policy{{ID: "p2"}, {ID: "p3"}}, nil)
+
+	policies, err := newCompositePolicyStore(fileStore, dbStore).GetAllPolicies(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, policies, 3)
+	require.True(t, policies[0].IsReadOnly)
+	require.True(t, policies[1].IsReadOnly)
+	require.Equal(t, "p3", policies[2].ID)
+	require.False(t, policies[2].IsReadOnly)
+}
+
+func TestCompositePolicyStore_GetPolicyFallsBackToFileStore(t *testing.T) {
+	fileStore := newPolicyStoreInterfaceMock(t)
+	dbStore := newPolicyStoreInterfaceMock(t)
+	dbStore.On("GetPolicy", mock.Anything, "p1").Return(Policy{}, errPolicyNotFound)
+	fileStore.On("GetPolicy", mock.Anything, "p1").Return(Policy{ID: "p1"}, nil)
+
+	policy, err := newCompositePolicyStore(fileStore, dbStore).GetPolicy(context.Background(), "p1")
+
+	require.NoError(t, err)
+	require.True(t, policy.IsReadOnly)
+}
+
+func TestCompositePolicyStore_DeleteDeclarativePolicy(t *testing.T) {
+	fileStore := newPolicyStoreInterfaceMock(t)
+	dbStore := newPolicyStoreInterfaceMock(t)
+	fileStore.On("IsPolicyExist", mock.Anything, "p1").Return(true, nil)
+
+	err := newCompositePolicyStore(fileStore, dbStore).DeletePolicy(context.Background(), "p1")
+
+	require.ErrorIs(t, err, errCannotDeleteDeclarativePolicy)
+	dbStore.AssertNotCalled(t, "DeletePolicy", mock.Anything, mock.Anything)
+}
+
+func TestCompositePolicyStore_UpdateDatabasePolicy(t *testing.T) {
+	fileStore := newPolicyStoreInterfaceMock(t)
+	dbStore := newPolicyStoreInterfaceMock(t)
+	fileStore.On("IsPolicyExist", mock.Anything, "p1").Return(false, nil)
+	dbStore.On("UpdatePolicy", mock.Anything, Policy{ID: "p1", Name: "Updated"}).Return(nil)
+
+	err := newCompositePolicyStore(fileStore, dbStore).UpdatePolicy(context.Background(),
+		Policy{ID: "p1", Name: "Updated"})
+
+	require.NoError(t, err)
+}
+
+func TestCompositePolicyStore_IsPolicyNameConflict(t *testing.T) {
+	fileStore := newPolicyStoreInterfaceMock(t)
+	dbStore := newPolicyStoreInterfaceMock(t)
+	fileStore.On("IsPolicyNameConflict", mock.Anything, "Office", "").Return(false, nil)
+	dbStore.On("IsPolicyNameConflict", mock.Anything, "Office", "").Return(true, nil)
+
+	conflict, err := newCompositePolicyStore(fileStore, dbStore).IsPolicyNameConflict(
+		context.Background(), "Office", "")
+
+	require.NoError(t, err)
+	require.True(t, conflict)
+}
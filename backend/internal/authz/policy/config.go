@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"strings"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	serverconst "github.com/asgardeo/thunder/internal/system/constants"
+	declarativeresource "github.com/asgardeo/thunder/internal/system/declarative_resource"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+// getPolicyStoreMode determines the store mode for authorization policies.
+//
+// Resolution order:
+//  1. If Authorization.ABAC.Store is explicitly configured, use it
+//  2. Otherwise, fall back to global DeclarativeResources.Enabled:
+//     - If enabled: return "declarative"
+//     - If disabled: return "mutable"
+//
+// Returns normalized store mode: "mutable", "declarative", or "composite"
+func getPolicyStoreMode() serverconst.StoreMode {
+	cfg := config.GetServerRuntime().Config
+	if cfg.Authorization.ABAC.Store != "" {
+		mode := serverconst.StoreMode(strings.ToLower(strings.TrimSpace(cfg.Authorization.ABAC.Store)))
+		switch mode {
+		case serverconst.StoreModeMutable, serverconst.StoreModeDeclarative, serverconst.StoreModeComposite:
+			return mode
+		default:
+			logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthzPolicyConfig"))
+			logger.Warn("Unrecognized authorization policy store configuration value",
+				log.String("raw_value", cfg.Authorization.ABAC.Store),
+				log.String("normalized_value", string(mode)),
+				log.String("fallback", "global declarative_resources setting"))
+		}
+	}
+
+	if declarativeresource.IsDeclarativeModeEnabled() {
+		return serverconst.StoreModeDeclarative
+	}
+
+	return serverconst.StoreModeMutable
+}
+
+// isDeclarativeModeEnabled checks if immutable-only store mode is enabled for authorization policies.
+func isDeclarativeModeEnabled() bool {
+	return getPolicyStoreMode() == serverconst.StoreModeDeclarative
+}
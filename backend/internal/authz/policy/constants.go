@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+// Policy effects.
+const (
+	// EffectPermit grants the targeted permissions when the policy conditions hold.
+	EffectPermit = "permit"
+	// EffectDeny denies the targeted permissions when the policy conditions hold.
+	EffectDeny = "deny"
+)
+
+// Condition operators.
+const (
+	OperatorEquals      = "equals"
+	OperatorNotEquals   = "not_equals"
+	OperatorIn          = "in"
+	OperatorNotIn       = "not_in"
+	OperatorContains    = "contains"
+	OperatorStartsWith  = "starts_with"
+	OperatorExists      = "exists"
+	OperatorGreaterThan = "greater_than"
+	OperatorLessThan    = "less_than"
+	// OperatorIPInRange matches an IP address against a CIDR block or a list of CIDR blocks.
+	OperatorIPInRange = "ip_in_range"
+	// OperatorTimeBetween matches a time against a ["HH:MM", "HH:MM"] window in UTC. The window
+	// wraps around midnight when the start is after the end.
+	OperatorTimeBetween = "time_between"
+	// OperatorInOU matches an organization unit ID against an organization unit or its descendants.
+	OperatorInOU = "in_ou"
+)
+
+// Condition attribute namespaces.
+const (
+	// AttributePrefixSubject prefixes attributes of the entity the decision is made for.
+	AttributePrefixSubject = "subject."
+	// AttributePrefixResource prefixes attributes of the resource being accessed.
+	AttributePrefixResource = "resource."
+	// AttributePrefixContext prefixes attributes of the request context.
+	AttributePrefixContext = "context."
+)
+
+// wildcardSuffix marks a policy permission as a prefix match.
+const wildcardSuffix = "*"
+
+// timeWindowLayout is the layout of the bounds of a time_between window.
+const timeWindowLayout = "15:04"
+
+// policiesPath is the base path of the policy management API.
+const policiesPath = "/authorization-policies"
@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	declarativeresource "github.com/asgardeo/thunder/internal/system/declarative_resource"
+
+	"gopkg.in/yaml.v3"
+)
+
+// loadDeclarativeResources loads declarative authorization policies from files.
+// The dbStore parameter is optional (can be nil) and is used for duplicate checking in composite mode.
+func loadDeclarativeResources(fileStore policyStoreInterface, dbStore policyStoreInterface) error {
+	fileBasedStore, ok := fileStore.(*policyFileBasedStore)
+	if !ok {
+		return fmt.Errorf("failed to assert fileStore to *policyFileBasedStore")
+	}
+
+	resourceConfig := declarativeresource.ResourceConfig{
+		ResourceType:  "AuthorizationPolicy",
+		DirectoryName: "authorization_policies",
+		Parser:        parseToPolicyWrapper,
+		Validator: func(data interface{}) error {
+			return validatePolicyWrapper(data, dbStore)
+		},
+		IDExtractor: func(data interface{}) string {
+			if policy, ok := data.(*Policy); ok {
+				return policy.ID
+			}
+			return ""
+		},
+	}
+
+	loader := declarativeresource.NewResourceLoader(resourceConfig, fileBasedStore)
+	if err := loader.LoadResources(); err != nil {
+		return fmt.Errorf("failed to load authorization policy resources: %w", err)
+	}
+
+	return nil
+}
+
+// parseToPolicyWrapper wraps parseToPolicy to match ResourceConfig.Parser signature.
+func parseToPolicyWrapper(data []byte) (interface{}, error) {
+	return parseToPolicy(data)
+}
+
+// parseToPolicy converts YAML data into a Policy object.
+func parseToPolicy(data []byte) (*Policy, error) {
+	var policyRequest policyRequestWithID
+	if err := yaml.Unmarshal(data, &policyRequest); err != nil {
+		return nil, err
+	}
+
+	return &Policy{
+		ID:          policyRequest.ID,
+		Name:        policyRequest.Name,
+		Description: policyRequest.Description,
+		Effect:      policyRequest.Effect,
+		Permissions: policyRequest.Permissions,
+		Conditions:  policyRequest.Conditions,
+	}, nil
+}
+
+// validatePolicyWrapper validates a declarative policy and, in composite mode, checks for
+// duplicates in the database store.
+func validatePolicyWrapper(dto interface{}, dbStore policyStoreInterface) error {
+	policy, ok := dto.(*Policy)
+	if !ok {
+		return fmt.Errorf("invalid type: expected *Policy")
+	}
+
+	if policy.ID == "" {
+		return fmt.Errorf("authorization policy ID is required")
+	}
+	if svcErr := validatePolicy(policy); svcErr != nil {
+		return fmt.Errorf("invalid authorization policy '%s': %s", policy.ID, svcErr.ErrorDescription.DefaultValue)
+	}
+
+	if dbStore != nil {
+		exists, err := dbStore.IsPolicyExist(context.Background(), policy.ID)
+		if err != nil {
+			return fmt.Errorf("failed to check for duplicate policy ID '%s': %w", policy.ID, err)
+		}
+		if exists {
+			return fmt.Errorf("authorization policy with ID '%s' already exists in database", policy.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testPolicyYAML = `
+id: office-hours
+name: Office hours
+effect: deny
+permissions:
+  - "booking:*"
+conditions:
+  - attribute: context.time
+    operator: time_between
+    value: ["18:00", "08:00"]
+  - attribute: resource.amount
+    operator: greater_than
+    value: 1000
+`
+
+func TestParseToPolicy(t *testing.T) {
+	policy, err := parseToPolicy([]byte(testPolicyYAML))
+
+	require.NoError(t, err)
+	require.Equal(t, "office-hours", policy.ID)
+	require.Equal(t, EffectDeny, policy.Effect)
+	require.Equal(t, []string{"booking:*"}, policy.Permissions)
+	require.Len(t, policy.Conditions, 2)
+	require.Nil(t, validatePolicy(policy))
+}
+
+func TestParseToPolicy_InvalidYAML(t *testing.T) {
+	_, err := parseToPolicy([]byte("name: [unclosed"))
+
+	require.Error(t, err)
+}
+
+func TestValidatePolicyWrapper(t *testing.T) {
+	policy, err := parseToPolicy([]byte(testPolicyYAML))
+	require.NoError(t, err)
+
+	require.NoError(t, validatePolicyWrapper(policy, nil))
+	require.Error(t, validatePolicyWrapper("not a policy", nil))
+
+	missingID := *policy
+	missingID.ID = ""
+	require.Error(t, validatePolicyWrapper(&missingID, nil))
+
+	invalid := *policy
+	invalid.Effect = "allow"
+	require.ErrorContains(t, validatePolicyWrapper(&invalid, nil), "office-hours")
+}
+
+func TestValidatePolicyWrapper_DuplicateInDatabase(t *testing.T) {
+	policy, err := parseToPolicy([]byte(testPolicyYAML))
+	require.NoError(t, err)
+
+	dbStore := newPolicyStoreInterfaceMock(t)
+	dbStore.On("IsPolicyExist", mock.Anything, "office-hours").Return(true, nil).Once()
+	require.ErrorContains(t, validatePolicyWrapper(policy, dbStore), "already exists")
+
+	dbStore.On("IsPolicyExist", mock.Anything, "office-hours").Return(false, errors.New("db error")).Once()
+	require.Error(t, validatePolicyWrapper(policy, dbStore))
+}
@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"errors"
+
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/i18n/core"
+)
+
+var (
+	// ErrorInvalidRequestFormat is returned when the request body cannot be parsed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZP-1001",
+		Error: core.I18nMessage{
+			Key:          "error.authzpolicyservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzpolicyservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+
+	// ErrorInvalidPolicyID is returned when an invalid policy ID is provided.
+	ErrorInvalidPolicyID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZP-1002",
+		Error: core.I18nMessage{
+			Key:          "error.authzpolicyservice.invalid_policy_id",
+			DefaultValue: "Invalid policy ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzpolicyservice.invalid_policy_id_description",
+			DefaultValue: "The provided policy ID is invalid",
+		},
+	}
+
+	// ErrorPolicyNotFound is returned when a policy is not found.
+	ErrorPolicyNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZP-1003",
+		Error: core.I18nMessage{
+			Key:          "error.authzpolicyservice.policy_not_found",
+			DefaultValue: "Policy not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzpolicyservice.policy_not_found_description",
+			DefaultValue: "The requested authorization policy was not found",
+		},
+	}
+
+	// ErrorMissingPolicyName is returned when the policy name is not provided.
+	ErrorMissingPolicyName = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZP-1004",
+		Error: core.I18nMessage{
+			Key:          "error.authzpolicyservice.missing_name",
+			DefaultValue: "Missing policy name",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzpolicyservice.missing_name_description",
+			DefaultValue: "Policy name is required",
+		},
+	}
+
+	// ErrorInvalidEffect is returned when the policy effect is not supported.
+	ErrorInvalidEffect = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZP-1005",
+		Error: core.I18nMessage{
+			Key:          "error.authzpolicyservice.invalid_effect",
+			DefaultValue: "Invalid policy effect",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzpolicyservice.invalid_effect_description",
+			DefaultValue: "Policy effect must be either permit or deny",
+		},
+	}
+
+	// ErrorMissingPermissions is returned when the policy does not target any permission.
+	ErrorMissingPermissions = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZP-1006",
+		Error: core.I18nMessage{
+			Key:          "error.authzpolicyservice.missing_permissions",
+			DefaultValue: "Missing permissions",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzpolicyservice.missing_permissions_description",
+			DefaultValue: "At least one non-empty permission is required",
+		},
+	}
+
+	// ErrorInvalidCondition is returned when a policy condition is invalid.
+	ErrorInvalidCondition = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZP-1007",
+		Error: core.I18nMessage{
+			Key:          "error.authzpolicyservice.invalid_condition",
+			DefaultValue: "Invalid policy condition",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzpolicyservice.invalid_condition_description",
+			DefaultValue: "The policy condition is invalid",
+		},
+	}
+
+	// ErrorPolicyNameConflict is returned when a policy with the same name already exists.
+	ErrorPolicyNameConflict = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZP-1008",
+		Error: core.I18nMessage{
+			Key:          "error.authzpolicyservice.name_conflict",
+			DefaultValue: "Policy name conflict",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzpolicyservice.name_conflict_description",
+			DefaultValue: "A policy with the same name already exists",
+		},
+	}
+
+	// ErrorInvalidLimit is returned when the limit is invalid.
+	ErrorInvalidLimit = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZP-1009",
+		Error: core.I18nMessage{
+			Key:          "error.authzpolicyservice.invalid_limit",
+			DefaultValue: "Invalid limit",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzpolicyservice.invalid_limit_description",
+			DefaultValue: "Limit must be a valid positive integer",
+		},
+	}
+
+	// ErrorInvalidOffset is returned when the offset is invalid.
+	ErrorInvalidOffset = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZP-1010",
+		Error: core.I18nMessage{
+			Key:          "error.authzpolicyservice.invalid_offset",
+			DefaultValue: "Invalid offset",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzpolicyservice.invalid_offset_description",
+			DefaultValue: "Offset must be a valid non-negative integer",
+		},
+	}
+
+	// ErrorCannotModifyDeclarativeResource is returned when attempting to modify a declarative policy.
+	ErrorCannotModifyDeclarativeResource = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZP-1011",
+		Error: core.I18nMessage{
+			Key:          "error.authzpolicyservice.cannot_modify_declarative",
+			DefaultValue: "Cannot modify declarative resource",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzpolicyservice.cannot_modify_declarative_description",
+			DefaultValue: "The policy is declarative and cannot be modified or deleted",
+		},
+	}
+
+	// ErrorResultLimitExceededInCompositeMode is returned when composite store result count exceeds max limit.
+	ErrorResultLimitExceededInCompositeMode = serviceerror.ServiceError{
+		Type: serviceerror.ServerErrorType,
+		Code: "AUTHZP-5001",
+		Error: core.I18nMessage{
+			Key:          "error.authzpolicyservice.result_limit_exceeded",
+			DefaultValue: "Result limit exceeded",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authzpolicyservice.result_limit_exceeded_description",
+			DefaultValue: "Total count of policies exceeds maximum allowed limit in composite mode",
+		},
+	}
+)
+
+// errPolicyNotFound is returned by the stores when a policy does not exist.
+var errPolicyNotFound = errors.New("policy not found")
+
+// errCannotUpdateDeclarativePolicy is an internal error for composite store operations.
+var errCannotUpdateDeclarativePolicy = errors.New("cannot update declarative policy")
+
+// errCannotDeleteDeclarativePolicy is an internal error for composite store operations.
+var errCannotDeleteDeclarativePolicy = errors.New("cannot delete declarative policy")
+
+// errResultLimitExceededInCompositeMode is returned when composite store result count exceeds max limit.
+var errResultLimitExceededInCompositeMode = errors.New("result limit exceeded in composite mode")
@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"errors"
+
+	declarativeresource "github.com/asgardeo/thunder/internal/system/declarative_resource"
+	"github.com/asgardeo/thunder/internal/system/declarative_resource/entity"
+)
+
+type policyFileBasedStore struct {
+	*declarativeresource.GenericFileBasedStore
+}
+
+// newPolicyFileBasedStore creates a new instance of a file-based store.
+func newPolicyFileBasedStore() policyStoreInterface {
+	genericStore := declarativeresource.NewGenericFileBasedStore(entity.KeyTypeAuthzPolicy)
+	return &policyFileBasedStore{
+		GenericFileBasedStore: genericStore,
+	}
+}
+
+// Create implements declarativeresource.Storer interface for resource loader.
+func (f *policyFileBasedStore) Create(id string, data interface{}) error {
+	policy, ok := data.(*Policy)
+	if !ok {
+		declarativeresource.LogTypeAssertionError("authorization policy", id)
+		return errors.New("invalid data type: expected *Policy")
+	}
+	return f.GenericFileBasedStore.Create(id, policy)
+}
+
+// GetPolicyListCount implements policyStoreInterface.
+func (f *policyFileBasedStore) GetPolicyListCount(ctx context.Context) (int, error) {
+	return f.GenericFileBasedStore.Count()
+}
+
+// GetPolicyList implements policyStoreInterface.
+func (f *policyFileBasedStore) GetPolicyList(ctx context.Context, limit, offset int) ([]Policy, error) {
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 {
+		return []Policy{}, nil
+	}
+
+	policies, err := f.GetAllPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if offset >= len(policies) {
+		return []Policy{}, nil
+	}
+	end := offset + limit
+	if end > len(policies) {
+		end = len(policies)
+	}
+	return policies[offset:end], nil
+}
+
+// GetAllPolicies implements policyStoreInterface.
+func (f *policyFileBasedStore) GetAllPolicies(ctx context.Context) ([]Policy, error) {
+	list, err := f.GenericFileBasedStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]Policy, 0, len(list))
+	for _, item := range list {
+		if policy, ok := item.Data.(*Policy); ok {
+			policies = append(policies, *policy)
+		}
+	}
+	return policies, nil
+}
+
+// CreatePolicy implements policyStoreInterface.
+func (f *policyFileBasedStore) CreatePolicy(ctx context.Context, policy Policy) error {
+	return errors.New("createPolicy is not supported in file-based store")
+}
+
+// GetPolicy implements policyStoreInterface.
+func (f *policyFileBasedStore) GetPolicy(ctx context.Context, id string) (Policy, error) {
+	data, err := f.GenericFileBasedStore.Get(id)
+	if err != nil {
+		return Policy{}, errPolicyNotFound
+	}
+	policy, ok := data.(*Policy)
+	if !ok {
+		declarativeresource.LogTypeAssertionError("authorization policy", id)
+		return Policy{}, errors.New("policy data corrupted")
+	}
+	return *policy, nil
+}
+
+// IsPolicyExist implements policyStoreInterface.
+func (f *policyFileBasedStore) IsPolicyExist(ctx context.Context, id string) (bool, error) {
+	if _, err := f.GetPolicy(ctx, id); err != nil {
+		return false, nil
+	}
+	return true, nil
+}
+
+// UpdatePolicy implements policyStoreInterface.
+func (f *policyFileBasedStore) UpdatePolicy(ctx context.Context, policy Policy) error {
+	return errors.New("updatePolicy is not supported in file-based store")
+}
+
+// DeletePolicy implements policyStoreInterface.
+func (f *policyFileBasedStore) DeletePolicy(ctx context.Context, id string) error {
+	return errors.New("deletePolicy is not supported in file-based store")
+}
+
+// IsPolicyDeclarative checks if a policy is immutable (in file-based store, all policies are immutable).
+func (f *policyFileBasedStore) IsPolicyDeclarative(ctx context.Context, id string) bool {
+	return true
+}
+
+// IsPolicyNameConflict checks if a policy name already exists (excluding a specific ID).
+func (f *policyFileBasedStore) IsPolicyNameConflict(ctx context.Context, name string, excludeID string) (bool, error) {
+	policies, err := f.GetAllPolicies(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, policy := range policies {
+		if policy.Name == name && policy.ID != excludeID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
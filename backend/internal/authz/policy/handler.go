@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	serverconst "github.com/asgardeo/thunder/internal/system/constants"
+	"github.com/asgardeo/thunder/internal/system/error/apierror"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/log"
+	sysutils "github.com/asgardeo/thunder/internal/system/utils"
+)
+
+const handlerLoggerComponentName = "AuthzPolicyHandler"
+
+// policyHandler is the handler for authorization policy management operations.
+type policyHandler struct {
+	policyService PolicyServiceInterface
+	logger        *log.Logger
+}
+
+// newPolicyHandler creates a new instance of policyHandler.
+func newPolicyHandler(policyService PolicyServiceInterface) *policyHandler {
+	return &policyHandler{
+		policyService: policyService,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName)),
+	}
+}
+
+// HandlePolicyListRequest handles the list authorization policies request.
+func (ph *policyHandler) HandlePolicyListRequest(w http.ResponseWriter, r *http.Request) {
+	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	policyList, svcErr := ph.policyService.GetPolicyList(r.Context(), limit, offset)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, policyList)
+}
+
+// HandlePolicyPostRequest handles the create authorization policy request.
+func (ph *policyHandler) HandlePolicyPostRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[PolicyRequest](r)
+	if err != nil {
+		handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	policy, svcErr := ph.policyService.CreatePolicy(r.Context(), *request)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, policy)
+	ph.logger.Debug("Successfully created authorization policy", log.String("id", policy.ID))
+}
+
+// HandlePolicyGetRequest handles the get authorization policy request.
+func (ph *policyHandler) HandlePolicyGetRequest(w http.ResponseWriter, r *http.Request) {
+	policy, svcErr := ph.policyService.GetPolicy(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, policy)
+}
+
+// HandlePolicyPutRequest handles the update authorization policy request.
+func (ph *policyHandler) HandlePolicyPutRequest(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	request, err := sysutils.DecodeJSONBody[PolicyRequest](r)
+	if err != nil {
+		handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	policy, svcErr := ph.policyService.UpdatePolicy(r.Context(), id, *request)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, policy)
+	ph.logger.Debug("Successfully updated authorization policy", log.String("id", id))
+}
+
+// HandlePolicyDeleteRequest handles the delete authorization policy request.
+func (ph *policyHandler) HandlePolicyDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if svcErr := ph.policyService.DeletePolicy(r.Context(), id); svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+	ph.logger.Debug("Successfully deleted authorization policy", log.String("id", id))
+}
+
+// parsePaginationParams parses limit and offset query parameters from the request.
+func parsePaginationParams(query url.Values) (int, int, *serviceerror.ServiceError) {
+	limit := serverconst.DefaultPageSize
+	offset := 0
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return 0, 0, &ErrorInvalidLimit
+		}
+		limit = parsedLimit
+	}
+
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsedOffset, err := strconv.Atoi(offsetStr)
+		if err != nil {
+			return 0, 0, &ErrorInvalidOffset
+		}
+		offset = parsedOffset
+	}
+
+	return limit, offset, nil
+}
+
+// handleError handles service errors and returns appropriate HTTP responses.
+func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	switch {
+	case svcErr.Code == ErrorPolicyNotFound.Code:
+		statusCode = http.StatusNotFound
+	case svcErr.Code == ErrorPolicyNameConflict.Code:
+		statusCode = http.StatusConflict
+	case svcErr.Type == serviceerror.ClientErrorType:
+		statusCode = http.StatusBadRequest
+	}
+
+	sysutils.WriteErrorResponse(w, statusCode, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
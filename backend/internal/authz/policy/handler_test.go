@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/asgardeo/thunder/internal/system/error/apierror"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+)
+
+const testPolicyBody = `{"name":"Office network","effect":"permit","permissions":["booking:*"],` +
+	`"conditions":[{"attribute":"context.ip","operator":"ip_in_range","value":"10.0.0.0/8"}]}`
+
+func TestHandlePolicyPostRequest_Success(t *testing.T) {
+	mockSvc := NewPolicyServiceInterfaceMock(t)
+	mockSvc.On("CreatePolicy", mock.Anything, mock.MatchedBy(func(req PolicyRequest) bool {
+		return req.Name == "Office network" && len(req.Conditions) == 1 && req.Conditions[0].Value == "10.0.0.0/8"
+	})).Return(&Policy{ID: "p1", Name: "Office network", Effect: EffectPermit}, nil)
+
+	rr := httptest.NewRecorder()
+	newPolicyHandler(mockSvc).HandlePolicyPostRequest(rr,
+		httptest.NewRequest(http.MethodPost, policiesPath, strings.NewReader(testPolicyBody)))
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var policy Policy
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&policy))
+	require.Equal(t, "p1", policy.ID)
+}
+
+func TestHandlePolicyPostRequest_InvalidBody(t *testing.T) {
+	mockSvc := NewPolicyServiceInterfaceMock(t)
+
+	rr := httptest.NewRecorder()
+	newPolicyHandler(mockSvc).HandlePolicyPostRequest(rr,
+		httptest.NewRequest(http.MethodPost, policiesPath, strings.NewReader(`{`)))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&errResp))
+	require.Equal(t, ErrorInvalidRequestFormat.Code, errResp.Code)
+}
+
+func TestHandlePolicyPostRequest_Conflict(t *testing.T) {
+	mockSvc := NewPolicyServiceInterfaceMock(t)
+	mockSvc.On("CreatePolicy", mock.Anything, mock.Anything).Return(nil, &ErrorPolicyNameConflict)
+
+	rr := httptest.NewRecorder()
+	newPolicyHandler(mockSvc).HandlePolicyPostRequest(rr,
+		httptest.NewRequest(http.MethodPost, policiesPath, strings.NewReader(testPolicyBody)))
+
+	require.Equal(t, http.StatusConflict, rr.Code)
+}
+
+func TestHandlePolicyListRequest(t *testing.T) {
+	mockSvc := NewPolicyServiceInterfaceMock(t)
+	mockSvc.On("GetPolicyList", mock.Anything, 5, 10).Return(&PolicyList{
+		TotalResults: 11, StartIndex: 11, Count: 1, Policies: []Policy{{ID: "p1"}}, Links: []Link{},
+	}, nil)
+
+	rr := httptest.NewRecorder()
+	newPolicyHandler(mockSvc).HandlePolicyListRequest(rr,
+		httptest.NewRequest(http.MethodGet, policiesPath+"?limit=5&offset=10", nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var list PolicyList
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&list))
+	require.Equal(t, 11, list.TotalResults)
+	require.Len(t, list.Policies, 1)
+}
+
+func TestHandlePolicyListRequest_InvalidLimit(t *testing.T) {
+	mockSvc := NewPolicyServiceInterfaceMock(t)
+
+	rr := httptest.NewRecorder()
+	newPolicyHandler(mockSvc).HandlePolicyListRequest(rr,
+		httptest.NewRequest(http.MethodGet, policiesPath+"?limit=abc", nil))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestHandlePolicyGetRequest_NotFound(t *testing.T) {
+	mockSvc := NewPolicyServiceInterfaceMock(t)
+	mockSvc.On("GetPolicy", mock.Anything, "missing").Return(nil, &ErrorPolicyNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, policiesPath+"/missing", nil)
+	req.SetPathValue("id", "missing")
+	rr := httptest.NewRecorder()
+	newPolicyHandler(mockSvc).HandlePolicyGetRequest(rr, req)
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestHandlePolicyPutRequest_Success(t *testing.T) {
+	mockSvc := NewPolicyServiceInterfaceMock(t)
+	mockSvc.On("UpdatePolicy", mock.Anything, "p1", mock.Anything).
+		Return(&Policy{ID: "p1", Name: "Office network"}, nil)
+
+	req := httptest.NewRequest(http.MethodPut, policiesPath+"/p1", strings.NewReader(testPolicyBody))
+	req.SetPathValue("id", "p1")
+	rr := httptest.NewRecorder()
+	newPolicyHandler(mockSvc).HandlePolicyPutRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestHandlePolicyDeleteRequest(t *testing.T) {
+	mockSvc := NewPolicyServiceInterfaceMock(t)
+	mockSvc.On("DeletePolicy", mock.Anything, "p1").Return(nil)
+	mockSvc.On("DeletePolicy", mock.Anything, "p2").Return(&serviceerror.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodDelete, policiesPath+"/p1", nil)
+	req.SetPathValue("id", "p1")
+	rr := httptest.NewRecorder()
+	newPolicyHandler(mockSvc).HandlePolicyDeleteRequest(rr, req)
+	require.Equal(t, http.StatusNoContent, rr.Code)
+
+	req = httptest.NewRequest(http.MethodDelete, policiesPath+"/p2", nil)
+	req.SetPathValue("id", "p2")
+	rr = httptest.NewRecorder()
+	newPolicyHandler(mockSvc).HandlePolicyDeleteRequest(rr, req)
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"net/http"
+
+	serverconst "github.com/asgardeo/thunder/internal/system/constants"
+	"github.com/asgardeo/thunder/internal/system/middleware"
+)
+
+// Initialize initializes the authorization policy service and registers its routes.
+func Initialize(mux *http.ServeMux) (PolicyServiceInterface, error) {
+	policyStore, err := initializeStore()
+	if err != nil {
+		return nil, err
+	}
+
+	policyService := newPolicyService(policyStore)
+	registerRoutes(mux, newPolicyHandler(policyService))
+
+	return policyService, nil
+}
+
+// initializeStore creates the policy store based on the authorization.abac.store configuration.
+// In composite mode, declarative policies are read-only while policies created through the API are
+// stored in the database.
+func initializeStore() (policyStoreInterface, error) {
+	switch getPolicyStoreMode() {
+	case serverconst.StoreModeComposite:
+		fileStore := newPolicyFileBasedStore()
+		dbStore := newPolicyStore()
+		if err := loadDeclarativeResources(fileStore, dbStore); err != nil {
+			return nil, err
+		}
+		return newCompositePolicyStore(fileStore, dbStore), nil
+
+	case serverconst.StoreModeDeclarative:
+		fileStore := newPolicyFileBasedStore()
+		if err := loadDeclarativeResources(fileStore, nil); err != nil {
+			return nil, err
+		}
+		return fileStore, nil
+
+	default:
+		return newPolicyStore(), nil
+	}
+}
+
+// registerRoutes registers the routes for authorization policy management operations.
+func registerRoutes(mux *http.ServeMux, handler *policyHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET "+policiesPath, handler.HandlePolicyListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("POST "+policiesPath, handler.HandlePolicyPostRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+policiesPath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET "+policiesPath+"/{id}", handler.HandlePolicyGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("PUT "+policiesPath+"/{id}", handler.HandlePolicyPutRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE "+policiesPath+"/{id}", handler.HandlePolicyDeleteRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+policiesPath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, opts2))
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import "strings"
+
+// Policy represents an attribute-based authorization policy.
+type Policy struct {
+	ID          string      `json:"id" yaml:"id,omitempty"`
+	Name        string      `json:"name" yaml:"name"`
+	Description string      `json:"description" yaml:"description,omitempty"`
+	Effect      string      `json:"effect" yaml:"effect"`
+	Permissions []string    `json:"permissions" yaml:"permissions"`
+	Conditions  []Condition `json:"conditions" yaml:"conditions,omitempty"`
+	CreatedAt   string      `json:"createdAt,omitempty" yaml:"createdAt,omitempty"`
+	UpdatedAt   string      `json:"updatedAt,omitempty" yaml:"updatedAt,omitempty"`
+	IsReadOnly  bool        `json:"isReadOnly" yaml:"-"`
+}
+
+// AppliesTo reports whether the policy targets the given permission. A policy permission ending
+// with "*" matches every permission with the preceding prefix.
+func (p *Policy) AppliesTo(permission string) bool {
+	for _, target := range p.Permissions {
+		if prefix, ok := strings.CutSuffix(target, wildcardSuffix); ok {
+			if strings.HasPrefix(permission, prefix) {
+				return true
+			}
+			continue
+		}
+		if target == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Condition represents a condition that must hold for a policy to apply. All conditions of a
+// policy must hold for the policy effect to be applied.
+type Condition struct {
+	Attribute string      `json:"attribute" yaml:"attribute"`
+	Operator  string      `json:"operator" yaml:"operator"`
+	Value     interface{} `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+// PolicyRequest represents the request body for creating or updating a policy.
+type PolicyRequest struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Effect      string      `json:"effect"`
+	Permissions []string    `json:"permissions"`
+	Conditions  []Condition `json:"conditions"`
+}
+
+// policyDefinition holds the parts of a policy persisted as a single JSON document.
+type policyDefinition struct {
+	Permissions []string    `json:"permissions"`
+	Conditions  []Condition `json:"conditions"`
+}
+
+// policyRequestWithID represents the structure of a policy in declarative resource files.
+type policyRequestWithID struct {
+	ID          string      `yaml:"id"`
+	Name        string      `yaml:"name"`
+	Description string      `yaml:"description,omitempty"`
+	Effect      string      `yaml:"effect"`
+	Permissions []string    `yaml:"permissions"`
+	Conditions  []Condition `yaml:"conditions,omitempty"`
+}
+
+// PolicyList represents a paginated list of policies.
+type PolicyList struct {
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	Count        int      `json:"count"`
+	Policies     []Policy `json:"policies"`
+	Links        []Link   `json:"links"`
+}
+
+// Link represents a pagination link.
+type Link struct {
+	Href string `json:"href"`
+	Rel  string `json:"rel"`
+}
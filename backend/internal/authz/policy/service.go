@@ -0,0 +1,458 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package policy provides management of attribute-based authorization policies.
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	serverconst "github.com/asgardeo/thunder/internal/system/constants"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/i18n/core"
+	"github.com/asgardeo/thunder/internal/system/log"
+	"github.com/asgardeo/thunder/internal/system/utils"
+)
+
+const loggerComponentName = "AuthzPolicyService"
+
+// PolicyServiceInterface defines the interface for the authorization policy service.
+type PolicyServiceInterface interface {
+	GetPolicyList(ctx context.Context, limit, offset int) (*PolicyList, *serviceerror.ServiceError)
+	CreatePolicy(ctx context.Context, policy PolicyRequest) (*Policy, *serviceerror.ServiceError)
+	GetPolicy(ctx context.Context, id string) (*Policy, *serviceerror.ServiceError)
+	UpdatePolicy(ctx context.Context, id string, policy PolicyRequest) (*Policy, *serviceerror.ServiceError)
+	DeletePolicy(ctx context.Context, id string) *serviceerror.ServiceError
+	GetApplicablePolicies(ctx context.Context, permissions []string) ([]Policy, *serviceerror.ServiceError)
+}
+
+// policyService is the default implementation of the PolicyServiceInterface.
+type policyService struct {
+	policyStore policyStoreInterface
+	logger      *log.Logger
+}
+
+// newPolicyService creates a new instance of policyService with injected dependencies.
+func newPolicyService(policyStore policyStoreInterface) PolicyServiceInterface {
+	return &policyService{
+		policyStore: policyStore,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// GetPolicyList retrieves a list of authorization policies.
+func (ps *policyService) GetPolicyList(ctx context.Context, limit, offset int) (
+	*PolicyList, *serviceerror.ServiceError) {
+	if err := validatePaginationParams(limit, offset); err != nil {
+		return nil, err
+	}
+
+	totalCount, err := ps.policyStore.GetPolicyListCount(ctx)
+	if err != nil {
+		ps.logger.Error("Failed to get policy count", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	policies, err := ps.policyStore.GetPolicyList(ctx, limit, offset)
+	if err != nil {
+		if errors.Is(err, errResultLimitExceededInCompositeMode) {
+			return nil, &ErrorResultLimitExceededInCompositeMode
+		}
+		ps.logger.Error("Failed to list policies", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &PolicyList{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(policies),
+		Policies:     policies,
+		Links:        buildPaginationLinks(limit, offset, totalCount),
+	}, nil
+}
+
+// CreatePolicy creates a new authorization policy.
+func (ps *policyService) CreatePolicy(ctx context.Context, request PolicyRequest) (
+	*Policy, *serviceerror.ServiceError) {
+	if isDeclarativeModeEnabled() {
+		return nil, &ErrorCannotModifyDeclarativeResource
+	}
+
+	policy := buildPolicy("", request)
+	if err := validatePolicy(&policy); err != nil {
+		return nil, err
+	}
+
+	conflict, err := ps.policyStore.IsPolicyNameConflict(ctx, policy.Name, "")
+	if err != nil {
+		ps.logger.Error("Failed to check policy name conflict", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if conflict {
+		return nil, &ErrorPolicyNameConflict
+	}
+
+	policy.ID, err = utils.GenerateUUIDv7()
+	if err != nil {
+		ps.logger.Error("Failed to generate UUID", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	if err := ps.policyStore.CreatePolicy(ctx, policy); err != nil {
+		ps.logger.Error("Failed to create policy", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	ps.logger.Debug("Successfully created authorization policy", log.String("id", policy.ID))
+	return &policy, nil
+}
+
+// GetPolicy retrieves an authorization policy by its id.
+func (ps *policyService) GetPolicy(ctx context.Context, id string) (*Policy, *serviceerror.ServiceError) {
+	if id == "" {
+		return nil, &ErrorInvalidPolicyID
+	}
+
+	policy, err := ps.policyStore.GetPolicy(ctx, id)
+	if err != nil {
+		if errors.Is(err, errPolicyNotFound) {
+			return nil, &ErrorPolicyNotFound
+		}
+		ps.logger.Error("Failed to retrieve policy", log.String("id", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &policy, nil
+}
+
+// UpdatePolicy updates an existing authorization policy.
+func (ps *policyService) UpdatePolicy(ctx context.Context, id string, request PolicyRequest) (
+	*Policy, *serviceerror.ServiceError) {
+	if id == "" {
+		return nil, &ErrorInvalidPolicyID
+	}
+
+	if ps.policyStore.IsPolicyDeclarative(ctx, id) {
+		return nil, &ErrorCannotModifyDeclarativeResource
+	}
+
+	policy := buildPolicy(id, request)
+	if err := validatePolicy(&policy); err != nil {
+		return nil, err
+	}
+
+	exists, err := ps.policyStore.IsPolicyExist(ctx, id)
+	if err != nil {
+		ps.logger.Error("Failed to check policy existence", log.String("id", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if !exists {
+		return nil, &ErrorPolicyNotFound
+	}
+
+	conflict, err := ps.policyStore.IsPolicyNameConflict(ctx, policy.Name, id)
+	if err != nil {
+		ps.logger.Error("Failed to check policy name conflict", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if conflict {
+		return nil, &ErrorPolicyNameConflict
+	}
+
+	if err := ps.policyStore.UpdatePolicy(ctx, policy); err != nil {
+		ps.logger.Error("Failed to update policy", log.String("id", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	ps.logger.Debug("Successfully updated authorization policy", log.String("id", id))
+	return &policy, nil
+}
+
+// DeletePolicy deletes an authorization policy.
+func (ps *policyService) DeletePolicy(ctx context.Context, id string) *serviceerror.ServiceError {
+	if id == "" {
+		return &ErrorInvalidPolicyID
+	}
+
+	if ps.policyStore.IsPolicyDeclarative(ctx, id) {
+		return &ErrorCannotModifyDeclarativeResource
+	}
+
+	// Return success for non-existing policies (idempotent delete).
+	exists, err := ps.policyStore.IsPolicyExist(ctx, id)
+	if err != nil {
+		ps.logger.Error("Failed to check policy existence", log.String("id", id), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if !exists {
+		return nil
+	}
+
+	if err := ps.policyStore.DeletePolicy(ctx, id); err != nil {
+		ps.logger.Error("Failed to delete policy", log.String("id", id), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	ps.logger.Debug("Successfully deleted authorization policy", log.String("id", id))
+	return nil
+}
+
+// GetApplicablePolicies retrieves the policies targeting at least one of the given permissions.
+func (ps *policyService) GetApplicablePolicies(ctx context.Context, permissions []string) (
+	[]Policy, *serviceerror.ServiceError) {
+	if len(permissions) == 0 {
+		return []Policy{}, nil
+	}
+
+	policies, err := ps.policyStore.GetAllPolicies(ctx)
+	if err != nil {
+		ps.logger.Error("Failed to retrieve policies", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	applicable := make([]Policy, 0)
+	for i := range policies {
+		for _, permission := range permissions {
+			if policies[i].AppliesTo(permission) {
+				applicable = append(applicable, policies[i])
+				break
+			}
+		}
+	}
+
+	return applicable, nil
+}
+
+// buildPolicy builds a policy from a create or update request.
+func buildPolicy(id string, request PolicyRequest) Policy {
+	return Policy{
+		ID:          id,
+		Name:        strings.TrimSpace(request.Name),
+		Description: request.Description,
+		Effect:      request.Effect,
+		Permissions: request.Permissions,
+		Conditions:  request.Conditions,
+	}
+}
+
+// validatePolicy validates the name, effect, permissions, and conditions of a policy.
+func validatePolicy(policy *Policy) *serviceerror.ServiceError {
+	if strings.TrimSpace(policy.Name) == "" {
+		return &ErrorMissingPolicyName
+	}
+
+	if policy.Effect != EffectPermit && policy.Effect != EffectDeny {
+		return &ErrorInvalidEffect
+	}
+
+	if len(policy.Permissions) == 0 {
+		return &ErrorMissingPermissions
+	}
+	for _, permission := range policy.Permissions {
+		if strings.TrimSpace(permission) == "" {
+			return &ErrorMissingPermissions
+		}
+	}
+
+	for _, condition := range policy.Conditions {
+		if err := validateCondition(condition); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateCondition validates the attribute, operator, and value shape of a condition.
+func validateCondition(condition Condition) *serviceerror.ServiceError {
+	if !isValidAttribute(condition.Attribute) {
+		return serviceerror.CustomServiceError(ErrorInvalidCondition, core.I18nMessage{
+			Key:          "error.authzpolicyservice.invalid_condition_attribute_description",
+			DefaultValue: "The condition attribute must start with subject., resource. or context.",
+		})
+	}
+
+	valid := false
+	switch condition.Operator {
+	case OperatorEquals, OperatorNotEquals, OperatorContains:
+		valid = condition.Value != nil
+	case OperatorStartsWith:
+		valid = isNonEmptyString(condition.Value)
+	case OperatorIn, OperatorNotIn:
+		_, valid = condition.Value.([]interface{})
+	case OperatorExists:
+		_, isBool := condition.Value.(bool)
+		valid = condition.Value == nil || isBool
+	case OperatorGreaterThan, OperatorLessThan:
+		_, valid = ToFloat(condition.Value)
+	case OperatorIPInRange:
+		valid = isValidCIDRValue(condition.Value)
+	case OperatorTimeBetween:
+		_, _, valid = ParseTimeWindow(condition.Value)
+	case OperatorInOU:
+		valid = isNonEmptyString(condition.Value)
+	default:
+		return serviceerror.CustomServiceError(ErrorInvalidCondition, core.I18nMessage{
+			Key:          "error.authzpolicyservice.invalid_condition_operator_description",
+			DefaultValue: "The condition operator is not supported",
+		})
+	}
+
+	if !valid {
+		return serviceerror.CustomServiceError(ErrorInvalidCondition, core.I18nMessage{
+			Key:          "error.authzpolicyservice.invalid_condition_value_description",
+			DefaultValue: "The condition value is not valid for the operator",
+		})
+	}
+	return nil
+}
+
+// isValidAttribute checks that an attribute names a key within a supported namespace.
+func isValidAttribute(attribute string) bool {
+	for _, prefix := range []string{AttributePrefixSubject, AttributePrefixResource, AttributePrefixContext} {
+		if key, ok := strings.CutPrefix(attribute, prefix); ok {
+			return key != ""
+		}
+	}
+	return false
+}
+
+// isNonEmptyString checks that a value is a non-empty string.
+func isNonEmptyString(value interface{}) bool {
+	s, ok := value.(string)
+	return ok && s != ""
+}
+
+// isValidCIDRValue checks that a value is a CIDR block or a non-empty list of CIDR blocks.
+func isValidCIDRValue(value interface{}) bool {
+	ranges, ok := ToStringSlice(value)
+	if !ok || len(ranges) == 0 {
+		return false
+	}
+	for _, r := range ranges {
+		if _, _, err := net.ParseCIDR(r); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// ToFloat converts a numeric condition value to a float64.
+func ToFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+// ToStringSlice converts a string or a list of strings to a string slice.
+func ToStringSlice(value interface{}) ([]string, bool) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, true
+	case []string:
+		return v, true
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, s)
+		}
+		return values, true
+	default:
+		return nil, false
+	}
+}
+
+// ParseTimeWindow parses a ["HH:MM", "HH:MM"] time_between value into the start and end of the
+// window as offsets from midnight.
+func ParseTimeWindow(value interface{}) (time.Duration, time.Duration, bool) {
+	bounds, ok := ToStringSlice(value)
+	if !ok || len(bounds) != 2 {
+		return 0, 0, false
+	}
+	start, err := time.Parse(timeWindowLayout, bounds[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	end, err := time.Parse(timeWindowLayout, bounds[1])
+	if err != nil {
+		return 0, 0, false
+	}
+	return time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute,
+		time.Duration(end.Hour())*time.Hour + time.Duration(end.Minute())*time.Minute, true
+}
+
+// validatePaginationParams validates limit and offset parameters.
+func validatePaginationParams(limit, offset int) *serviceerror.ServiceError {
+	if limit < 1 || limit > serverconst.MaxPageSize {
+		return serviceerror.CustomServiceError(ErrorInvalidLimit, core.I18nMessage{
+			Key:          "error.authzpolicyservice.invalid_limit_value_description",
+			DefaultValue: fmt.Sprintf("Limit must be between 1 and %d", serverconst.MaxPageSize),
+		})
+	}
+	if offset < 0 {
+		return &ErrorInvalidOffset
+	}
+	return nil
+}
+
+// buildPaginationLinks builds pagination links for the response.
+func buildPaginationLinks(limit, offset, totalCount int) []Link {
+	links := make([]Link, 0)
+
+	if offset > 0 {
+		prevOffset := offset - limit
+		if prevOffset < 0 {
+			prevOffset = 0
+		}
+		links = append(links, Link{
+			Href: fmt.Sprintf("%s?limit=%d&offset=%d", policiesPath, limit, prevOffset),
+			Rel:  "previous",
+		})
+	}
+
+	if offset+limit < totalCount {
+		links = append(links, Link{
+			Href: fmt.Sprintf("%s?limit=%d&offset=%d", policiesPath, limit, offset+limit),
+			Rel:  "next",
+		})
+	}
+
+	return links
+}
@@ -0,0 +1,301 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+)
+
+type PolicyServiceTestSuite struct {
+	suite.Suite
+	mockStore *policyStoreInterfaceMock
+	service   PolicyServiceInterface
+}
+
+func TestPolicyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PolicyServiceTestSuite))
+}
+
+func (suite *PolicyServiceTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("/tmp/test", &config.Config{
+		Authorization: config.AuthorizationConfig{ABAC: config.ABACConfig{Store: "mutable"}},
+	})
+	suite.Require().NoError(err)
+
+	suite.mockStore = newPolicyStoreInterfaceMock(suite.T())
+	suite.service = newPolicyService(suite.mockStore)
+}
+
+func (suite *PolicyServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func validPolicyRequest() PolicyRequest {
+	return PolicyRequest{
+		Name:        "Business hours",
+		Effect:      EffectPermit,
+		Permissions: []string{"booking:*"},
+		Conditions: []Condition{
+			{Attribute: "context.time", Operator: OperatorTimeBetween, Value: []interface{}{"09:00", "17:00"}},
+			{Attribute: "subject.attributes.department", Operator: OperatorIn,
+				Value: []interface{}{"sales", "support"}},
+		},
+	}
+}
+
+func (suite *PolicyServiceTestSuite) TestGetPolicyList_Success() {
+	policies := []Policy{{ID: "p1", Name: "Policy 1"}, {ID: "p2", Name: "Policy 2"}}
+	suite.mockStore.On("GetPolicyListCount", mock.Anything).Return(3, nil)
+	suite.mockStore.On("GetPolicyList", mock.Anything, 2, 0).Return(policies, nil)
+
+	list, err := suite.service.GetPolicyList(context.Background(), 2, 0)
+
+	suite.Nil(err)
+	suite.Equal(3, list.TotalResults)
+	suite.Equal(2, list.Count)
+	suite.Equal(1, list.StartIndex)
+	suite.Len(list.Links, 1)
+	suite.Equal("next", list.Links[0].Rel)
+}
+
+func (suite *PolicyServiceTestSuite) TestGetPolicyList_InvalidPagination() {
+	_, err := suite.service.GetPolicyList(context.Background(), 0, 0)
+	suite.Equal(ErrorInvalidLimit.Code, err.Code)
+
+	_, err = suite.service.GetPolicyList(context.Background(), 10, -1)
+	suite.Equal(&ErrorInvalidOffset, err)
+}
+
+func (suite *PolicyServiceTestSuite) TestGetPolicyList_CompositeLimitExceeded() {
+	suite.mockStore.On("GetPolicyListCount", mock.Anything).Return(2000, nil)
+	suite.mockStore.On("GetPolicyList", mock.Anything, 10, 0).Return(nil, errResultLimitExceededInCompositeMode)
+
+	_, err := suite.service.GetPolicyList(context.Background(), 10, 0)
+
+	suite.Equal(&ErrorResultLimitExceededInCompositeMode, err)
+}
+
+func (suite *PolicyServiceTestSuite) TestCreatePolicy_Success() {
+	suite.mockStore.On("IsPolicyNameConflict", mock.Anything, "Business hours", "").Return(false, nil)
+	suite.mockStore.On("CreatePolicy", mock.Anything, mock.MatchedBy(func(p Policy) bool {
+		return p.ID != "" && p.Name == "Business hours" && len(p.Conditions) == 2
+	})).Return(nil)
+
+	created, err := suite.service.CreatePolicy(context.Background(), validPolicyRequest())
+
+	suite.Nil(err)
+	suite.NotEmpty(created.ID)
+	suite.Equal(EffectPermit, created.Effect)
+}
+
+func (suite *PolicyServiceTestSuite) TestCreatePolicy_ValidationErrors() {
+	testCases := []struct {
+		name   string
+		modify func(*PolicyRequest)
+		code   string
+	}{
+		{"MissingName", func(r *PolicyRequest) { r.Name = " " }, ErrorMissingPolicyName.Code},
+		{"InvalidEffect", func(r *PolicyRequest) { r.Effect = "allow" }, ErrorInvalidEffect.Code},
+		{"MissingPermissions", func(r *PolicyRequest) { r.Permissions = nil }, ErrorMissingPermissions.Code},
+		{"EmptyPermission", func(r *PolicyRequest) { r.Permissions = []string{""} }, ErrorMissingPermissions.Code},
+		{"InvalidAttribute", func(r *PolicyRequest) {
+			r.Conditions = []Condition{{Attribute: "user.department", Operator: OperatorEquals, Value: "x"}}
+		}, ErrorInvalidCondition.Code},
+		{"InvalidOperator", func(r *PolicyRequest) {
+			r.Conditions = []Condition{{Attribute: "subject.id", Operator: "matches", Value: "x"}}
+		}, ErrorInvalidCondition.Code},
+		{"InvalidCIDR", func(r *PolicyRequest) {
+			r.Conditions = []Condition{{Attribute: "context.ip", Operator: OperatorIPInRange, Value: "10.0.0.0"}}
+		}, ErrorInvalidCondition.Code},
+		{"InvalidTimeWindow", func(r *PolicyRequest) {
+			r.Conditions = []Condition{{Attribute: "context.time", Operator: OperatorTimeBetween,
+				Value: []interface{}{"9am", "5pm"}}}
+		}, ErrorInvalidCondition.Code},
+		{"NonNumericComparison", func(r *PolicyRequest) {
+			r.Conditions = []Condition{{Attribute: "resource.amount", Operator: OperatorGreaterThan, Value: "10"}}
+		}, ErrorInvalidCondition.Code},
+		{"NonListIn", func(r *PolicyRequest) {
+			r.Conditions = []Condition{{Attribute: "subject.type", Operator: OperatorIn, Value: "person"}}
+		}, ErrorInvalidCondition.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			request := validPolicyRequest()
+			tc.modify(&request)
+
+			_, err := suite.service.CreatePolicy(context.Background(), request)
+
+			suite.Require().NotNil(err)
+			suite.Equal(tc.code, err.Code)
+		})
+	}
+}
+
+func (suite *PolicyServiceTestSuite) TestCreatePolicy_NameConflict() {
+	suite.mockStore.On("IsPolicyNameConflict", mock.Anything, "Business hours", "").Return(true, nil)
+
+	_, err := suite.service.CreatePolicy(context.Background(), validPolicyRequest())
+
+	suite.Equal(&ErrorPolicyNameConflict, err)
+}
+
+func (suite *PolicyServiceTestSuite) TestCreatePolicy_DeclarativeMode() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("/tmp/test", &config.Config{
+		Authorization: config.AuthorizationConfig{ABAC: config.ABACConfig{Store: "declarative"}},
+	}))
+
+	_, err := suite.service.CreatePolicy(context.Background(), validPolicyRequest())
+
+	suite.Equal(&ErrorCannotModifyDeclarativeResource, err)
+}
+
+func (suite *PolicyServiceTestSuite) TestCreatePolicy_StoreError() {
+	suite.mockStore.On("IsPolicyNameConflict", mock.Anything, mock.Anything, "").Return(false, nil)
+	suite.mockStore.On("CreatePolicy", mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	_, err := suite.service.CreatePolicy(context.Background(), validPolicyRequest())
+
+	suite.Equal(&serviceerror.InternalServerError, err)
+}
+
+func (suite *PolicyServiceTestSuite) TestGetPolicy() {
+	suite.mockStore.On("GetPolicy", mock.Anything, "p1").Return(Policy{ID: "p1", Name: "Policy 1"}, nil)
+	suite.mockStore.On("GetPolicy", mock.Anything, "missing").Return(Policy{}, errPolicyNotFound)
+
+	policy, err := suite.service.GetPolicy(context.Background(), "p1")
+	suite.Nil(err)
+	suite.Equal("Policy 1", policy.Name)
+
+	_, err = suite.service.GetPolicy(context.Background(), "missing")
+	suite.Equal(&ErrorPolicyNotFound, err)
+
+	_, err = suite.service.GetPolicy(context.Background(), "")
+	suite.Equal(&ErrorInvalidPolicyID, err)
+}
+
+func (suite *PolicyServiceTestSuite) TestUpdatePolicy_Success() {
+	suite.mockStore.On("IsPolicyDeclarative", mock.Anything, "p1").Return(false)
+	suite.mockStore.On("IsPolicyExist", mock.Anything, "p1").Return(true, nil)
+	suite.mockStore.On("IsPolicyNameConflict", mock.Anything, "Business hours", "p1").Return(false, nil)
+	suite.mockStore.On("UpdatePolicy", mock.Anything, mock.MatchedBy(func(p Policy) bool {
+		return p.ID == "p1"
+	})).Return(nil)
+
+	updated, err := suite.service.UpdatePolicy(context.Background(), "p1", validPolicyRequest())
+
+	suite.Nil(err)
+	suite.Equal("p1", updated.ID)
+}
+
+func (suite *PolicyServiceTestSuite) TestUpdatePolicy_NotFound() {
+	suite.mockStore.On("IsPolicyDeclarative", mock.Anything, "p1").Return(false)
+	suite.mockStore.On("IsPolicyExist", mock.Anything, "p1").Return(false, nil)
+
+	_, err := suite.service.UpdatePolicy(context.Background(), "p1", validPolicyRequest())
+
+	suite.Equal(&ErrorPolicyNotFound, err)
+}
+
+func (suite *PolicyServiceTestSuite) TestUpdatePolicy_Declarative() {
+	suite.mockStore.On("IsPolicyDeclarative", mock.Anything, "p1").Return(true)
+
+	_, err := suite.service.UpdatePolicy(context.Background(), "p1", validPolicyRequest())
+
+	suite.Equal(&ErrorCannotModifyDeclarativeResource, err)
+}
+
+func (suite *PolicyServiceTestSuite) TestDeletePolicy() {
+	suite.mockStore.On("IsPolicyDeclarative", mock.Anything, mock.Anything).Return(false)
+	suite.mockStore.On("IsPolicyExist", mock.Anything, "p1").Return(true, nil)
+	suite.mockStore.On("IsPolicyExist", mock.Anything, "missing").Return(false, nil)
+	suite.mockStore.On("DeletePolicy", mock.Anything, "p1").Return(nil)
+
+	suite.Nil(suite.service.DeletePolicy(context.Background(), "p1"))
+	suite.Nil(suite.service.DeletePolicy(context.Background(), "missing"))
+	suite.mockStore.AssertNotCalled(suite.T(), "DeletePolicy", mock.Anything, "missing")
+}
+
+func (suite *PolicyServiceTestSuite) TestDeletePolicy_Declarative() {
+	suite.mockStore.On("IsPolicyDeclarative", mock.Anything, "p1").Return(true)
+
+	err := suite.service.DeletePolicy(context.Background(), "p1")
+
+	suite.Equal(&ErrorCannotModifyDeclarativeResource, err)
+}
+
+func (suite *PolicyServiceTestSuite) TestGetApplicablePolicies() {
+	suite.mockStore.On("GetAllPolicies", mock.Anything).Return([]Policy{
+		{ID: "p1", Permissions: []string{"booking:read"}},
+		{ID: "p2", Permissions: []string{"booking:*"}},
+		{ID: "p3", Permissions: []string{"billing:read"}},
+	}, nil)
+
+	policies, err := suite.service.GetApplicablePolicies(context.Background(), []string{"booking:write"})
+
+	suite.Nil(err)
+	suite.Len(policies, 1)
+	suite.Equal("p2", policies[0].ID)
+}
+
+func (suite *PolicyServiceTestSuite) TestGetApplicablePolicies_NoPermissions() {
+	policies, err := suite.service.GetApplicablePolicies(context.Background(), nil)
+
+	suite.Nil(err)
+	suite.Empty(policies)
+	suite.mockStore.AssertNotCalled(suite.T(), "GetAllPolicies", mock.Anything)
+}
+
+func (suite *PolicyServiceTestSuite) TestGetApplicablePolicies_StoreError() {
+	suite.mockStore.On("GetAllPolicies", mock.Anything).Return(nil, errors.New("db error"))
+
+	_, err := suite.service.GetApplicablePolicies(context.Background(), []string{"booking:read"})
+
+	suite.Equal(&serviceerror.InternalServerError, err)
+}
+
+func (suite *PolicyServiceTestSuite) TestAppliesTo() {
+	policy := Policy{Permissions: []string{"booking:reservations:read", "billing:*"}}
+
+	suite.True(policy.AppliesTo("booking:reservations:read"))
+	suite.True(policy.AppliesTo("billing:invoices:read"))
+	suite.False(policy.AppliesTo("booking:reservations"))
+	suite.False(policy.AppliesTo("booking:reservations:write"))
+}
+
+func (suite *PolicyServiceTestSuite) TestParseTimeWindow() {
+	start, end, ok := ParseTimeWindow([]interface{}{"22:30", "06:00"})
+
+	suite.True(ok)
+	suite.Equal(22*60+30, int(start.Minutes()))
+	suite.Equal(6*60, int(end.Minutes()))
+
+	_, _, ok = ParseTimeWindow([]interface{}{"22:30"})
+	suite.False(ok)
+}
@@ -0,0 +1,345 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// policyStoreInterface defines the interface for authorization policy store operations.
+type policyStoreInterface interface {
+	GetPolicyListCount(ctx context.Context) (int, error)
+	GetPolicyList(ctx context.Context, limit, offset int) ([]Policy, error)
+	GetAllPolicies(ctx context.Context) ([]Policy, error)
+	CreatePolicy(ctx context.Context, policy Policy) error
+	GetPolicy(ctx context.Context, id string) (Policy, error)
+	IsPolicyExist(ctx context.Context, id string) (bool, error)
+	UpdatePolicy(ctx context.Context, policy Policy) error
+	DeletePolicy(ctx context.Context, id string) error
+	IsPolicyDeclarative(ctx context.Context, id string) bool
+	IsPolicyNameConflict(ctx context.Context, name string, excludeID string) (bool, error)
+}
+
+// policyStore is the database backed implementation of policyStoreInterface.
+type policyStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newPolicyStore creates a new instance of policyStore.
+func newPolicyStore() policyStoreInterface {
+	return &policyStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// GetPolicyListCount retrieves the total count of policies.
+func (s *policyStore) GetPolicyListCount(ctx context.Context) (int, error) {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return 0, err
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetPolicyListCount, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute count query: %w", err)
+	}
+
+	return parseCountResult(results)
+}
+
+// GetPolicyList retrieves policies with pagination.
+func (s *policyStore) GetPolicyList(ctx context.Context, limit, offset int) ([]Policy, error) {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetPolicyList, limit, offset, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute policy list query: %w", err)
+	}
+
+	return buildPoliciesFromResultRows(results)
+}
+
+// GetAllPolicies retrieves all policies of the deployment.
+func (s *policyStore) GetAllPolicies(ctx context.Context) ([]Policy, error) {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetAllPolicies, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute policy query: %w", err)
+	}
+
+	return buildPoliciesFromResultRows(results)
+}
+
+// CreatePolicy creates a new policy in the database.
+func (s *policyStore) CreatePolicy(ctx context.Context, policy Policy) error {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return err
+	}
+
+	definitionJSON, err := marshalDefinition(policy)
+	if err != nil {
+		return err
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreatePolicy, policy.ID, policy.Name, policy.Description,
+		policy.Effect, definitionJSON, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// GetPolicy retrieves a policy by its ID.
+func (s *policyStore) GetPolicy(ctx context.Context, id string) (Policy, error) {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return Policy{}, err
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetPolicyByID, id, s.deploymentID)
+	if err != nil {
+		return Policy{}, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	if len(results) == 0 {
+		return Policy{}, errPolicyNotFound
+	}
+
+	if len(results) != 1 {
+		return Policy{}, fmt.Errorf("unexpected number of results: %d", len(results))
+	}
+
+	return buildPolicyFromResultRow(results[0])
+}
+
+// IsPolicyExist checks if a policy exists by its ID.
+func (s *policyStore) IsPolicyExist(ctx context.Context, id string) (bool, error) {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return false, err
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryCheckPolicyExists, id, s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check policy existence: %w", err)
+	}
+
+	count, err := parseCountResult(results)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// UpdatePolicy updates a policy.
+func (s *policyStore) UpdatePolicy(ctx context.Context, policy Policy) error {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return err
+	}
+
+	definitionJSON, err := marshalDefinition(policy)
+	if err != nil {
+		return err
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryUpdatePolicy, policy.Name, policy.Description, policy.Effect,
+		definitionJSON, policy.ID, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// DeletePolicy deletes a policy.
+func (s *policyStore) DeletePolicy(ctx context.Context, id string) error {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryDeletePolicy, id, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// IsPolicyDeclarative checks if a policy is immutable (in database store, all policies are mutable).
+func (s *policyStore) IsPolicyDeclarative(ctx context.Context, id string) bool {
+	return false
+}
+
+// IsPolicyNameConflict checks if a policy name already exists for the deployment, excluding a specific ID.
+func (s *policyStore) IsPolicyNameConflict(ctx context.Context, name string, excludeID string) (bool, error) {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return false, err
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryCheckPolicyNameConflict, name, s.deploymentID, excludeID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check policy name conflict: %w", err)
+	}
+
+	count, err := parseCountResult(results)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// getConfigDBClient retrieves the config database client.
+func (s *policyStore) getConfigDBClient() (provider.DBClientInterface, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config database client: %w", err)
+	}
+	return dbClient, nil
+}
+
+// marshalDefinition serializes the permissions and conditions of a policy.
+func marshalDefinition(policy Policy) ([]byte, error) {
+	definitionJSON, err := json.Marshal(policyDefinition{
+		Permissions: policy.Permissions,
+		Conditions:  policy.Conditions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal policy definition: %w", err)
+	}
+	return definitionJSON, nil
+}
+
+// parseCountResult parses count query results.
+func parseCountResult(results []map[string]interface{}) (int, error) {
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no results returned from count query")
+	}
+
+	countVal, exists := results[0]["total"]
+	if !exists {
+		return 0, fmt.Errorf("count field not found in result")
+	}
+
+	switch v := countVal.(type) {
+	case int64:
+		return int(v), nil
+	case int:
+		return v, nil
+	case float64:
+		return int(v), nil
+	default:
+		return 0, fmt.Errorf("unexpected type for count: %T", countVal)
+	}
+}
+
+// getTimestamp safely extracts a timestamp value from a database row and formats it as ISO 8601.
+func getTimestamp(row map[string]interface{}, key string) string {
+	switch v := row[key].(type) {
+	case string:
+		return v
+	case time.Time:
+		return v.Format(time.RFC3339)
+	default:
+		return ""
+	}
+}
+
+// buildPoliciesFromResultRows builds policies from database result rows.
+func buildPoliciesFromResultRows(results []map[string]interface{}) ([]Policy, error) {
+	policies := make([]Policy, 0, len(results))
+	for _, row := range results {
+		policy, err := buildPolicyFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build policy from result row: %w", err)
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+// buildPolicyFromResultRow builds a Policy from a database result row.
+func buildPolicyFromResultRow(row map[string]interface{}) (Policy, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return Policy{}, fmt.Errorf("id not found or invalid type")
+	}
+
+	name, ok := row["name"].(string)
+	if !ok {
+		return Policy{}, fmt.Errorf("name not found or invalid type")
+	}
+
+	effect, ok := row["effect"].(string)
+	if !ok {
+		return Policy{}, fmt.Errorf("effect not found or invalid type")
+	}
+
+	description := ""
+	if desc, ok := row["description"].(string); ok {
+		description = desc
+	}
+
+	var definitionJSON []byte
+	switch v := row["definition"].(type) {
+	case string:
+		definitionJSON = []byte(v)
+	case []byte:
+		definitionJSON = v
+	default:
+		return Policy{}, fmt.Errorf("unexpected type for definition: %T", row["definition"])
+	}
+
+	var definition policyDefinition
+	if err := json.Unmarshal(definitionJSON, &definition); err != nil {
+		return Policy{}, fmt.Errorf("failed to unmarshal policy definition: %w", err)
+	}
+
+	return Policy{
+		ID:          id,
+		Name:        name,
+		Description: description,
+		Effect:      effect,
+		Permissions: definition.Permissions,
+		Conditions:  definition.Conditions,
+		CreatedAt:   getTimestamp(row, "created_at"),
+		UpdatedAt:   getTimestamp(row, "updated_at"),
+	}, nil
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import dbmodel "github.com/asgardeo/thunder/internal/system/database/model"
+
+var (
+	// queryCreatePolicy creates a new authorization policy.
+	queryCreatePolicy = dbmodel.DBQuery{
+		ID: "AZQ-POLICY_MGT-01",
+		Query: `INSERT INTO "AUTHZ_POLICY" (ID, NAME, DESCRIPTION, EFFECT, DEFINITION, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6)`,
+	}
+
+	// queryGetPolicyByID retrieves an authorization policy by ID.
+	queryGetPolicyByID = dbmodel.DBQuery{
+		ID: "AZQ-POLICY_MGT-02",
+		Query: `SELECT ID, NAME, DESCRIPTION, EFFECT, DEFINITION, CREATED_AT, UPDATED_AT FROM "AUTHZ_POLICY" ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetPolicyList retrieves a list of authorization policies with pagination.
+	queryGetPolicyList = dbmodel.DBQuery{
+		ID: "AZQ-POLICY_MGT-03",
+		Query: `SELECT ID, NAME, DESCRIPTION, EFFECT, DEFINITION, CREATED_AT, UPDATED_AT FROM "AUTHZ_POLICY" ` +
+			`WHERE DEPLOYMENT_ID = $3 ORDER BY CREATED_AT DESC LIMIT $1 OFFSET $2`,
+	}
+
+	// queryGetPolicyListCount retrieves the total count of authorization policies.
+	queryGetPolicyListCount = dbmodel.DBQuery{
+		ID:    "AZQ-POLICY_MGT-04",
+		Query: `SELECT COUNT(*) as total FROM "AUTHZ_POLICY" WHERE DEPLOYMENT_ID = $1`,
+	}
+
+	// queryUpdatePolicy updates an authorization policy.
+	queryUpdatePolicy = dbmodel.DBQuery{
+		ID: "AZQ-POLICY_MGT-05",
+		PostgresQuery: `UPDATE "AUTHZ_POLICY" SET NAME = $1, DESCRIPTION = $2, EFFECT = $3, DEFINITION = $4, ` +
+			`UPDATED_AT = NOW() WHERE ID = $5 AND DEPLOYMENT_ID = $6`,
+		SQLiteQuery: `UPDATE "AUTHZ_POLICY" SET NAME = $1, DESCRIPTION = $2, EFFECT = $3, DEFINITION = $4, ` +
+			`UPDATED_AT = datetime('now') WHERE ID = $5 AND DEPLOYMENT_ID = $6`,
+		Query: `UPDATE "AUTHZ_POLICY" SET NAME = $1, DESCRIPTION = $2, EFFECT = $3, DEFINITION = $4, ` +
+			`UPDATED_AT = datetime('now') WHERE ID = $5 AND DEPLOYMENT_ID = $6`,
+	}
+
+	// queryDeletePolicy deletes an authorization policy.
+	queryDeletePolicy = dbmodel.DBQuery{
+		ID:    "AZQ-POLICY_MGT-06",
+		Query: `DELETE FROM "AUTHZ_POLICY" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryCheckPolicyExists checks if an authorization policy exists.
+	queryCheckPolicyExists = dbmodel.DBQuery{
+		ID:    "AZQ-POLICY_MGT-07",
+		Query: `SELECT COUNT(*) as total FROM "AUTHZ_POLICY" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryCheckPolicyNameConflict checks if a policy name already exists for a deployment (excluding a given ID).
+	queryCheckPolicyNameConflict = dbmodel.DBQuery{
+		ID: "AZQ-POLICY_MGT-08",
+		Query: `SELECT COUNT(*) as total FROM "AUTHZ_POLICY" ` +
+			`WHERE NAME = $1 AND DEPLOYMENT_ID = $2 AND ID != $3`,
+	}
+
+	// queryGetAllPolicies retrieves all authorization policies of a deployment for evaluation.
+	queryGetAllPolicies = dbmodel.DBQuery{
+		ID: "AZQ-POLICY_MGT-09",
+		Query: `SELECT ID, NAME, DESCRIPTION, EFFECT, DEFINITION, CREATED_AT, UPDATED_AT FROM "AUTHZ_POLICY" ` +
+			`WHERE DEPLOYMENT_ID = $1`,
+	}
+)
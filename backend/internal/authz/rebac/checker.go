@@ -0,0 +1,228 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package rebac
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/ou"
+)
+
+// checker resolves the relations of a single subject by walking the relation graph. The groups and
+// organization unit of the subject are loaded at most once per checker.
+type checker struct {
+	store          tupleStoreInterface
+	schema         *schema
+	entityProvider entityprovider.EntityProviderInterface
+	ouService      ou.OrganizationUnitServiceInterface
+	subject        SubjectRef
+
+	groupIDs     []string
+	groupsLoaded bool
+	ouID         string
+	entityLoaded bool
+}
+
+// check reports whether the subject has the relation to the object.
+func (c *checker) check(ctx context.Context, object ObjectRef, relation string, depth int) (bool, error) {
+	if depth > maxCheckDepth {
+		return false, nil
+	}
+	// A userset contains itself, e.g. the members of a group are members of the group.
+	if c.subject.Relation != "" && c.subject.Type == object.Type && c.subject.ID == object.ID &&
+		c.subject.Relation == relation {
+		return true, nil
+	}
+
+	switch object.Type {
+	case NamespaceGroup:
+		return c.isGroupMember(object.ID, relation)
+	case NamespaceOU:
+		return c.isOUMember(ctx, object.ID, relation)
+	}
+
+	terms, ok := c.schema.getRewrite(object.Type, relation)
+	if !ok {
+		return false, nil
+	}
+
+	for _, term := range terms {
+		allowed, err := c.checkTerm(ctx, object, relation, term, depth)
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+	return false, nil
+}
+
+// checkTerm reports whether the subject is in the userset of a single rewrite term.
+func (c *checker) checkTerm(
+	ctx context.Context, object ObjectRef, relation string, term usersetTerm, depth int,
+) (bool, error) {
+	switch term.kind {
+	case termThis:
+		subjects, err := c.store.GetSubjects(ctx, object, relation)
+		if err != nil {
+			return false, err
+		}
+		for _, subject := range subjects {
+			if subject == c.subject {
+				return true, nil
+			}
+		}
+		for _, subject := range subjects {
+			if subject.Relation == "" {
+				continue
+			}
+			allowed, err := c.check(ctx, ObjectRef{Type: subject.Type, ID: subject.ID}, subject.Relation, depth+1)
+			if err != nil || allowed {
+				return allowed, err
+			}
+		}
+		return false, nil
+
+	case termComputedUserset:
+		return c.check(ctx, object, term.relation, depth+1)
+
+	case termTupleToUserset:
+		subjects, err := c.store.GetSubjects(ctx, object, term.tupleset)
+		if err != nil {
+			return false, err
+		}
+		for _, subject := range subjects {
+			allowed, err := c.check(ctx, ObjectRef{Type: subject.Type, ID: subject.ID}, term.relation, depth+1)
+			if err != nil || allowed {
+				return allowed, err
+			}
+		}
+		return false, nil
+
+	default:
+		return false, fmt.Errorf("unsupported userset term: %s", term.kind)
+	}
+}
+
+// isGroupMember reports whether the subject is a direct or inherited member of the group.
+func (c *checker) isGroupMember(groupID, relation string) (bool, error) {
+	if relation != RelationMember || c.subject.Relation != "" {
+		return false, nil
+	}
+	if !c.groupsLoaded {
+		groups, err := c.entityProvider.GetTransitiveEntityGroups(c.subject.ID)
+		if err != nil && err.Code != entityprovider.ErrorCodeEntityNotFound {
+			return false, fmt.Errorf("failed to get groups of entity: %w", err)
+		}
+		for _, group := range groups {
+			c.groupIDs = append(c.groupIDs, group.ID)
+		}
+		c.groupsLoaded = true
+	}
+	return slices.Contains(c.groupIDs, groupID), nil
+}
+
+// isOUMember reports whether the subject belongs to the organization unit or one of its descendants.
+func (c *checker) isOUMember(ctx context.Context, ouID, relation string) (bool, error) {
+	if relation != RelationMember || c.subject.Relation != "" {
+		return false, nil
+	}
+	if !c.entityLoaded {
+		entity, err := c.entityProvider.GetEntity(c.subject.ID)
+		if err != nil && err.Code != entityprovider.ErrorCodeEntityNotFound {
+			return false, fmt.Errorf("failed to get entity: %w", err)
+		}
+		if entity != nil {
+			c.ouID = entity.OUID
+		}
+		c.entityLoaded = true
+	}
+	if c.ouID == "" {
+		return false, nil
+	}
+
+	isMember, svcErr := c.ouService.IsParent(ctx, ouID, c.ouID)
+	if svcErr != nil {
+		if svcErr.Code == ou.ErrorOrganizationUnitNotFound.Code {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to resolve organization unit hierarchy: %s", svcErr.Error.DefaultValue)
+	}
+	return isMember, nil
+}
+
+// expand builds the userset tree of the relation on the object.
+func (c *checker) expand(ctx context.Context, object ObjectRef, relation string, depth int) (UsersetNode, error) {
+	node := UsersetNode{Object: object, Relation: relation}
+	if depth > maxCheckDepth {
+		return node, nil
+	}
+
+	terms, ok := c.schema.getRewrite(object.Type, relation)
+	if !ok {
+		return node, nil
+	}
+
+	for _, term := range terms {
+		switch term.kind {
+		case termThis:
+			subjects, err := c.store.GetSubjects(ctx, object, relation)
+			if err != nil {
+				return node, err
+			}
+			node.Subjects = append(node.Subjects, subjects...)
+			for _, subject := range subjects {
+				if subject.Relation == "" || isBuiltInNamespace(subject.Type) {
+					continue
+				}
+				child, err := c.expand(ctx, ObjectRef{Type: subject.Type, ID: subject.ID}, subject.Relation, depth+1)
+				if err != nil {
+					return node, err
+				}
+				node.Children = append(node.Children, child)
+			}
+
+		case termComputedUserset:
+			child, err := c.expand(ctx, object, term.relation, depth+1)
+			if err != nil {
+				return node, err
+			}
+			node.Children = append(node.Children, child)
+
+		case termTupleToUserset:
+			subjects, err := c.store.GetSubjects(ctx, object, term.tupleset)
+			if err != nil {
+				return node, err
+			}
+			for _, subject := range subjects {
+				target := ObjectRef{Type: subject.Type, ID: subject.ID}
+				if !c.schema.hasRelation(target.Type, term.relation) {
+					continue
+				}
+				child, err := c.expand(ctx, target, term.relation, depth+1)
+				if err != nil {
+					return node, err
+				}
+				node.Children = append(node.Children, child)
+			}
+		}
+	}
+	return node, nil
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package rebac
+
+// Built-in namespaces. Their relations are resolved from the group and organization unit services
+// and cannot be declared in namespace definitions or written as relationship tuples.
+const (
+	// NamespaceGroup is the namespace of groups. Its member relation holds for the direct and
+	// inherited members of a group.
+	NamespaceGroup = "group"
+	// NamespaceOU is the namespace of organization units. Its member relation holds for the entities
+	// of an organization unit and of its descendants.
+	NamespaceOU = "ou"
+	// RelationMember is the relation of the built-in namespaces.
+	RelationMember = "member"
+)
+
+// Userset rewrite syntax of relation definitions.
+const (
+	// rewriteThis refers to the subjects directly related through relationship tuples.
+	rewriteThis = "this"
+	// rewriteUnion separates the usersets whose union forms a relation.
+	rewriteUnion = "|"
+	// rewriteTupleToUserset separates the tupleset relation from the relation computed on the
+	// objects it points to.
+	rewriteTupleToUserset = "->"
+)
+
+// Userset term kinds.
+const (
+	termThis            = "this"
+	termComputedUserset = "computed_userset"
+	termTupleToUserset  = "tuple_to_userset"
+)
+
+// maxCheckDepth bounds the depth of the relation graph walked by check and expand requests.
+const maxCheckDepth = 25
+
+// maxListObjectsResults bounds the number of objects returned by a list-objects request.
+const maxListObjectsResults = 1000
+
+// relationshipsPath is the base path of the relationship API.
+const relationshipsPath = "/relationships"
+
+// Query parameters of the relationship tuple list API.
+const (
+	queryParamObjectType  = "objectType"
+	queryParamObjectID    = "objectId"
+	queryParamRelation    = "relation"
+	queryParamSubjectType = "subjectType"
+	queryParamSubjectID   = "subjectId"
+)
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package rebac
+
+import (
+	"fmt"
+	"sort"
+
+	declarativeresource "github.com/asgardeo/thunder/internal/system/declarative_resource"
+	"github.com/asgardeo/thunder/internal/system/declarative_resource/entity"
+
+	"gopkg.in/yaml.v3"
+)
+
+// loadNamespaces loads the namespace definitions of the schema from declarative resource files.
+// Namespaces are always declarative so that the schema is versioned along with the deployment.
+func loadNamespaces() ([]Namespace, error) {
+	store := declarativeresource.NewGenericFileBasedStore(entity.KeyTypeAuthzNamespace)
+	resourceConfig := declarativeresource.ResourceConfig{
+		ResourceType:  "AuthorizationNamespace",
+		DirectoryName: "authorization_namespaces",
+		Parser:        parseToNamespaceWrapper,
+		Validator:     validateNamespaceWrapper,
+		IDExtractor: func(data interface{}) string {
+			if namespace, ok := data.(*Namespace); ok {
+				return namespace.Name
+			}
+			return ""
+		},
+	}
+
+	loader := declarativeresource.NewResourceLoader(resourceConfig, store)
+	if err := loader.LoadResources(); err != nil {
+		return nil, fmt.Errorf("failed to load authorization namespace resources: %w", err)
+	}
+
+	list, err := store.List()
+	if err != nil {
+		return nil, err
+	}
+	namespaces := make([]Namespace, 0, len(list))
+	for _, item := range list {
+		if namespace, ok := item.Data.(*Namespace); ok {
+			namespaces = append(namespaces, *namespace)
+		}
+	}
+	sort.Slice(namespaces, func(i, j int) bool {
+		return namespaces[i].Name < namespaces[j].Name
+	})
+	return namespaces, nil
+}
+
+// parseToNamespaceWrapper wraps parseToNamespace to match ResourceConfig.Parser signature.
+func parseToNamespaceWrapper(data []byte) (interface{}, error) {
+	return parseToNamespace(data)
+}
+
+// parseToNamespace converts YAML data into a Namespace object.
+func parseToNamespace(data []byte) (*Namespace, error) {
+	var namespace Namespace
+	if err := yaml.Unmarshal(data, &namespace); err != nil {
+		return nil, err
+	}
+	return &namespace, nil
+}
+
+// validateNamespaceWrapper validates a declarative namespace definition.
+func validateNamespaceWrapper(dto interface{}) error {
+	namespace, ok := dto.(*Namespace)
+	if !ok {
+		return fmt.Errorf("invalid type: expected *Namespace")
+	}
+	if _, err := parseNamespace(*namespace); err != nil {
+		return fmt.Errorf("invalid authorization namespace: %w", err)
+	}
+	return nil
+}
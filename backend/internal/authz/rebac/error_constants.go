@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package rebac
+
+import (
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/i18n/core"
+)
+
+var (
+	// ErrorInvalidRequestFormat is returned when the request body cannot be parsed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZR-1001",
+		Error: core.I18nMessage{
+			Key:          "error.rebacservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.rebacservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+
+	// ErrorInvalidObject is returned when the object of a request is incomplete.
+	ErrorInvalidObject = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZR-1002",
+		Error: core.I18nMessage{
+			Key:          "error.rebacservice.invalid_object",
+			DefaultValue: "Invalid object",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.rebacservice.invalid_object_description",
+			DefaultValue: "The object type and id are required",
+		},
+	}
+
+	// ErrorInvalidSubject is returned when the subject of a request is incomplete.
+	ErrorInvalidSubject = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZR-1003",
+		Error: core.I18nMessage{
+			Key:          "error.rebacservice.invalid_subject",
+			DefaultValue: "Invalid subject",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.rebacservice.invalid_subject_description",
+			DefaultValue: "The subject type and id are required",
+		},
+	}
+
+	// ErrorUnknownNamespace is returned when a namespace is not defined in the schema.
+	ErrorUnknownNamespace = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZR-1004",
+		Error: core.I18nMessage{
+			Key:          "error.rebacservice.unknown_namespace",
+			DefaultValue: "Unknown namespace",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.rebacservice.unknown_namespace_description",
+			DefaultValue: "The namespace is not defined in the authorization schema",
+		},
+	}
+
+	// ErrorUnknownRelation is returned when a relation is not defined on its namespace.
+	ErrorUnknownRelation = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZR-1005",
+		Error: core.I18nMessage{
+			Key:          "error.rebacservice.unknown_relation",
+			DefaultValue: "Unknown relation",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.rebacservice.unknown_relation_description",
+			DefaultValue: "The relation is not defined on the namespace",
+		},
+	}
+
+	// ErrorBuiltInNamespace is returned when a relationship tuple targets a built-in namespace.
+	ErrorBuiltInNamespace = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZR-1006",
+		Error: core.I18nMessage{
+			Key:          "error.rebacservice.built_in_namespace",
+			DefaultValue: "Built-in namespace",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.rebacservice.built_in_namespace_description",
+			DefaultValue: "Relationships of the built-in group and ou namespaces cannot be written",
+		},
+	}
+
+	// ErrorEmptyWriteRequest is returned when a write request has no tuples to write or delete.
+	ErrorEmptyWriteRequest = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZR-1007",
+		Error: core.I18nMessage{
+			Key:          "error.rebacservice.empty_write_request",
+			DefaultValue: "Empty write request",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.rebacservice.empty_write_request_description",
+			DefaultValue: "At least one relationship tuple to write or delete is required",
+		},
+	}
+
+	// ErrorInvalidLimit is returned when the limit parameter is invalid.
+	ErrorInvalidLimit = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZR-1008",
+		Error: core.I18nMessage{
+			Key:          "error.rebacservice.invalid_limit",
+			DefaultValue: "Invalid limit",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.rebacservice.invalid_limit_description",
+			DefaultValue: "Limit must be a valid positive integer",
+		},
+	}
+
+	// ErrorInvalidOffset is returned when the offset parameter is invalid.
+	ErrorInvalidOffset = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHZR-1009",
+		Error: core.I18nMessage{
+			Key:          "error.rebacservice.invalid_offset",
+			DefaultValue: "Invalid offset",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.rebacservice.invalid_offset_description",
+			DefaultValue: "Offset must be a valid non-negative integer",
+		},
+	}
+)
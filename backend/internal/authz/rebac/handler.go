@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package rebac
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	serverconst "github.com/asgardeo/thunder/internal/system/constants"
+	"github.com/asgardeo/thunder/internal/system/error/apierror"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/log"
+	sysutils "github.com/asgardeo/thunder/internal/system/utils"
+)
+
+const handlerLoggerComponentName = "ReBACHandler"
+
+// relationHandler is the handler for relationship-based authorization operations.
+type relationHandler struct {
+	relationService RelationServiceInterface
+	logger          *log.Logger
+}
+
+// newRelationHandler creates a new instance of relationHandler.
+func newRelationHandler(relationService RelationServiceInterface) *relationHandler {
+	return &relationHandler{
+		relationService: relationService,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName)),
+	}
+}
+
+// HandleNamespaceListRequest handles the list namespaces request.
+func (rh *relationHandler) HandleNamespaceListRequest(w http.ResponseWriter, r *http.Request) {
+	sysutils.WriteSuccessResponse(w, http.StatusOK, rh.relationService.GetNamespaces(r.Context()))
+}
+
+// HandleTupleListRequest handles the list relationship tuples request.
+func (rh *relationHandler) HandleTupleListRequest(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	limit, offset, svcErr := parsePaginationParams(query)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	filter := TupleFilter{
+		ObjectType:  query.Get(queryParamObjectType),
+		ObjectID:    query.Get(queryParamObjectID),
+		Relation:    query.Get(queryParamRelation),
+		SubjectType: query.Get(queryParamSubjectType),
+		SubjectID:   query.Get(queryParamSubjectID),
+	}
+	tupleList, svcErr := rh.relationService.GetTupleList(r.Context(), filter, limit, offset)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, tupleList)
+}
+
+// HandleWriteRequest handles the write relationship tuples request.
+func (rh *relationHandler) HandleWriteRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[WriteRequest](r)
+	if err != nil {
+		handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	if svcErr := rh.relationService.WriteTuples(r.Context(), *request); svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// HandleCheckRequest handles the check relationship request.
+func (rh *relationHandler) HandleCheckRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[CheckRequest](r)
+	if err != nil {
+		handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	response, svcErr := rh.relationService.Check(r.Context(), *request)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// HandleExpandRequest handles the expand relationship request.
+func (rh *relationHandler) HandleExpandRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[ExpandRequest](r)
+	if err != nil {
+		handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	response, svcErr := rh.relationService.Expand(r.Context(), *request)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// HandleListObjectsRequest handles the list objects request.
+func (rh *relationHandler) HandleListObjectsRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[ListObjectsRequest](r)
+	if err != nil {
+		handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	response, svcErr := rh.relationService.ListObjects(r.Context(), *request)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// parsePaginationParams parses limit and offset query parameters from the request.
+func parsePaginationParams(query url.Values) (int, int, *serviceerror.ServiceError) {
+	limit := serverconst.DefaultPageSize
+	offset := 0
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return 0, 0, &ErrorInvalidLimit
+		}
+		limit = parsedLimit
+	}
+
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsedOffset, err := strconv.Atoi(offsetStr)
+		if err != nil {
+			return 0, 0, &ErrorInvalidOffset
+		}
+		offset = parsedOffset
+	}
+
+	return limit, offset, nil
+}
+
+// handleError handles service errors and returns appropriate HTTP responses.
+func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		statusCode = http.StatusBadRequest
+	}
+
+	sysutils.WriteErrorResponse(w, statusCode, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package rebac
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/asgardeo/thunder/internal/system/error/apierror"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+)
+
+func TestHandleCheckRequest(t *testing.T) {
+	mockSvc := NewRelationServiceInterfaceMock(t)
+	mockSvc.On("Check", mock.Anything, CheckRequest{
+		Object: ObjectRef{Type: "document", ID: "readme"}, Relation: "viewer", Subject: SubjectRef{Type: "user", ID: "alice"},
+	}).Return(&CheckResponse{Allowed: true}, nil)
+
+	body := `{"object":{"type":"document","id":"readme"},"relation":"viewer","subject":{"type":"user","id":"alice"}}`
+	rr := httptest.NewRecorder()
+	newRelationHandler(mockSvc).HandleCheckRequest(rr,
+		httptest.NewRequest(http.MethodPost, relationshipsPath+"/check", strings.NewReader(body)))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var response CheckResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+	require.True(t, response.Allowed)
+}
+
+func TestHandleCheckRequest_InvalidBody(t *testing.T) {
+	mockSvc := NewRelationServiceInterfaceMock(t)
+
+	rr := httptest.NewRecorder()
+	newRelationHandler(mockSvc).HandleCheckRequest(rr,
+		httptest.NewRequest(http.MethodPost, relationshipsPath+"/check", strings.NewReader(`{`)))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&errResp))
+	require.Equal(t, ErrorInvalidRequestFormat.Code, errResp.Code)
+}
+
+func TestHandleWriteRequest(t *testing.T) {
+	mockSvc := NewRelationServiceInterfaceMock(t)
+	mockSvc.On("WriteTuples", mock.Anything, mock.MatchedBy(func(req WriteRequest) bool {
+		return len(req.Writes) == 1 && req.Writes[0].Subject.Relation == "member" && len(req.Deletes) == 0
+	})).Return(nil)
+
+	body := `{"writes":[{"object":{"type":"document","id":"readme"},"relation":"viewer",` +
+		`"subject":{"type":"group","id":"eng","relation":"member"}}]}`
+	rr := httptest.NewRecorder()
+	newRelationHandler(mockSvc).HandleWriteRequest(rr,
+		httptest.NewRequest(http.MethodPost, relationshipsPath+"/write", strings.NewReader(body)))
+
+	require.Equal(t, http.StatusNoContent, rr.Code)
+}
+
+func TestHandleWriteRequest_BuiltInNamespace(t *testing.T) {
+	mockSvc := NewRelationServiceInterfaceMock(t)
+	mockSvc.On("WriteTuples", mock.Anything, mock.Anything).Return(&ErrorBuiltInNamespace)
+
+	rr := httptest.NewRecorder()
+	newRelationHandler(mockSvc).HandleWriteRequest(rr,
+		httptest.NewRequest(http.MethodPost, relationshipsPath+"/write", strings.NewReader(`{"writes":[{}]}`)))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestHandleTupleListRequest(t *testing.T) {
+	mockSvc := NewRelationServiceInterfaceMock(t)
+	mockSvc.On("GetTupleList", mock.Anything, TupleFilter{ObjectType: "document", Relation: "viewer"}, 10, 0).
+		Return(&TupleList{Tuples: []RelationTuple{}}, nil)
+
+	rr := httptest.NewRecorder()
+	newRelationHandler(mockSvc).HandleTupleListRequest(rr,
+		httptest.NewRequest(http.MethodGet, relationshipsPath+"?objectType=document&relation=viewer&limit=10", nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestHandleTupleListRequest_InvalidOffset(t *testing.T) {
+	mockSvc := NewRelationServiceInterfaceMock(t)
+
+	rr := httptest.NewRecorder()
+	newRelationHandler(mockSvc).HandleTupleListRequest(rr,
+		httptest.NewRequest(http.MethodGet, relationshipsPath+"?offset=abc", nil))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestHandleListObjectsRequest_ServerError(t *testing.T) {
+	mockSvc := NewRelationServiceInterfaceMock(t)
+	mockSvc.On("ListObjects", mock.Anything, mock.Anything).Return(nil, &serviceerror.InternalServerError)
+
+	rr := httptest.NewRecorder()
+	newRelationHandler(mockSvc).HandleListObjectsRequest(rr,
+		httptest.NewRequest(http.MethodPost, relationshipsPath+"/list-objects", strings.NewReader(`{}`)))
+
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package rebac
+
+import (
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/ou"
+	"github.com/asgardeo/thunder/internal/system/middleware"
+)
+
+// Initialize initializes the relationship-based authorization service and registers its routes.
+// The namespaces of the schema are loaded from the authorization_namespaces declarative resources.
+func Initialize(
+	mux *http.ServeMux,
+	entityProvider entityprovider.EntityProviderInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+) (RelationServiceInterface, error) {
+	namespaces, err := loadNamespaces()
+	if err != nil {
+		return nil, err
+	}
+
+	tupleStore, transactioner, err := newTupleStore()
+	if err != nil {
+		return nil, err
+	}
+
+	relationService, err := newRelationService(namespaces, tupleStore, transactioner, entityProvider, ouService)
+	if err != nil {
+		return nil, err
+	}
+	registerRoutes(mux, newRelationHandler(relationService))
+
+	return relationService, nil
+}
+
+// registerRoutes registers the routes for relationship-based authorization operations.
+func registerRoutes(mux *http.ServeMux, handler *relationHandler) {
+	getOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	postOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	routes := []struct {
+		path    string
+		method  string
+		handler http.HandlerFunc
+		opts    middleware.CORSOptions
+	}{
+		{relationshipsPath, http.MethodGet, handler.HandleTupleListRequest, getOpts},
+		{relationshipsPath + "/namespaces", http.MethodGet, handler.HandleNamespaceListRequest, getOpts},
+		{relationshipsPath + "/write", http.MethodPost, handler.HandleWriteRequest, postOpts},
+		{relationshipsPath + "/check", http.MethodPost, handler.HandleCheckRequest, postOpts},
+		{relationshipsPath + "/expand", http.MethodPost, handler.HandleExpandRequest, postOpts},
+		{relationshipsPath + "/list-objects", http.MethodPost, handler.HandleListObjectsRequest, postOpts},
+	}
+	for _, route := range routes {
+		mux.HandleFunc(middleware.WithCORS(route.method+" "+route.path, route.handler, route.opts))
+		mux.HandleFunc(middleware.WithCORS("OPTIONS "+route.path, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, route.opts))
+	}
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package rebac
+
+// ObjectRef identifies an object of a namespace.
+type ObjectRef struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// SubjectRef identifies the subject of a relationship. A subject with a relation refers to the
+// userset of that relation on the object, such as the members of a group.
+type SubjectRef struct {
+	Type     string `json:"type"`
+	ID       string `json:"id"`
+	Relation string `json:"relation,omitempty"`
+}
+
+// RelationTuple represents a relationship between an object and a subject.
+type RelationTuple struct {
+	Object   ObjectRef  `json:"object"`
+	Relation string     `json:"relation"`
+	Subject  SubjectRef `json:"subject"`
+}
+
+// TupleFilter filters relationship tuples. Empty fields match every tuple.
+type TupleFilter struct {
+	ObjectType  string
+	ObjectID    string
+	Relation    string
+	SubjectType string
+	SubjectID   string
+}
+
+// TupleList represents a paginated list of relationship tuples.
+type TupleList struct {
+	TotalResults int             `json:"totalResults"`
+	StartIndex   int             `json:"startIndex"`
+	Count        int             `json:"count"`
+	Tuples       []RelationTuple `json:"tuples"`
+	Links        []Link          `json:"links"`
+}
+
+// Link represents a pagination link.
+type Link struct {
+	Href string `json:"href"`
+	Rel  string `json:"rel"`
+}
+
+// WriteRequest represents a request to write and delete relationship tuples atomically.
+type WriteRequest struct {
+	Writes  []RelationTuple `json:"writes"`
+	Deletes []RelationTuple `json:"deletes"`
+}
+
+// CheckRequest represents a request to check whether a subject has a relation to an object.
+type CheckRequest struct {
+	Object   ObjectRef  `json:"object"`
+	Relation string     `json:"relation"`
+	Subject  SubjectRef `json:"subject"`
+}
+
+// CheckResponse represents the result of a check request.
+type CheckResponse struct {
+	Allowed bool `json:"allowed"`
+}
+
+// ExpandRequest represents a request to expand the userset of a relation on an object.
+type ExpandRequest struct {
+	Object   ObjectRef `json:"object"`
+	Relation string    `json:"relation"`
+}
+
+// UsersetNode represents a node of an expanded userset tree. The userset of a node is the union of
+// its direct subjects and the usersets of its children.
+type UsersetNode struct {
+	Object   ObjectRef     `json:"object"`
+	Relation string        `json:"relation"`
+	Subjects []SubjectRef  `json:"subjects,omitempty"`
+	Children []UsersetNode `json:"children,omitempty"`
+}
+
+// ListObjectsRequest represents a request to list the objects a subject has a relation to.
+type ListObjectsRequest struct {
+	ObjectType string     `json:"objectType"`
+	Relation   string     `json:"relation"`
+	Subject    SubjectRef `json:"subject"`
+}
+
+// ListObjectsResponse represents the result of a list-objects request.
+type ListObjectsResponse struct {
+	Objects []string `json:"objects"`
+}
+
+// Namespace represents the schema of an object type. Each relation is defined by a userset
+// rewrite such as "this | editor | parent->viewer": the union of the directly related subjects,
+// the subjects with the editor relation on the same object, and the subjects with the viewer
+// relation on the objects related through the parent relation. An empty rewrite is "this".
+type Namespace struct {
+	Name      string               `json:"name" yaml:"name"`
+	Relations []RelationDefinition `json:"relations" yaml:"relations"`
+}
+
+// RelationDefinition represents a relation of a namespace.
+type RelationDefinition struct {
+	Name    string `json:"name" yaml:"name"`
+	Rewrite string `json:"rewrite,omitempty" yaml:"rewrite,omitempty"`
+}
+
+// NamespaceList represents the namespaces of the schema.
+type NamespaceList struct {
+	Namespaces []Namespace `json:"namespaces"`
+}
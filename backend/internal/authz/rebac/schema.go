@@ -0,0 +1,173 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package rebac
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// namePattern is the pattern of namespace and relation names.
+var namePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
+
+// usersetTerm is a parsed term of a userset rewrite.
+type usersetTerm struct {
+	kind string
+	// relation is the computed relation of computed_userset and tuple_to_userset terms.
+	relation string
+	// tupleset is the relation whose subjects a tuple_to_userset term computes the relation on.
+	tupleset string
+}
+
+// schema holds the parsed userset rewrites of the relations of each namespace.
+type schema struct {
+	namespaces map[string]map[string][]usersetTerm
+}
+
+// newSchema parses the given namespaces into a schema.
+func newSchema(namespaces []Namespace) (*schema, error) {
+	s := &schema{namespaces: make(map[string]map[string][]usersetTerm, len(namespaces))}
+	for _, namespace := range namespaces {
+		if _, exists := s.namespaces[namespace.Name]; exists {
+			return nil, fmt.Errorf("duplicate namespace '%s'", namespace.Name)
+		}
+		relations, err := parseNamespace(namespace)
+		if err != nil {
+			return nil, err
+		}
+		s.namespaces[namespace.Name] = relations
+	}
+	return s, nil
+}
+
+// hasNamespace reports whether the namespace is declared in the schema or is built in.
+func (s *schema) hasNamespace(name string) bool {
+	if isBuiltInNamespace(name) {
+		return true
+	}
+	_, ok := s.namespaces[name]
+	return ok
+}
+
+// hasRelation reports whether the relation is defined on the namespace.
+func (s *schema) hasRelation(namespace, relation string) bool {
+	if isBuiltInNamespace(namespace) {
+		return relation == RelationMember
+	}
+	_, ok := s.getRewrite(namespace, relation)
+	return ok
+}
+
+// getRewrite returns the userset rewrite of a relation of a declared namespace.
+func (s *schema) getRewrite(namespace, relation string) ([]usersetTerm, bool) {
+	relations, ok := s.namespaces[namespace]
+	if !ok {
+		return nil, false
+	}
+	terms, ok := relations[relation]
+	return terms, ok
+}
+
+// parseNamespace validates a namespace and parses the userset rewrites of its relations.
+func parseNamespace(namespace Namespace) (map[string][]usersetTerm, error) {
+	if !namePattern.MatchString(namespace.Name) {
+		return nil, fmt.Errorf("invalid namespace name '%s'", namespace.Name)
+	}
+	if isBuiltInNamespace(namespace.Name) {
+		return nil, fmt.Errorf("namespace '%s' is built in and cannot be redefined", namespace.Name)
+	}
+	if len(namespace.Relations) == 0 {
+		return nil, fmt.Errorf("namespace '%s' has no relations", namespace.Name)
+	}
+
+	relations := make(map[string][]usersetTerm, len(namespace.Relations))
+	for _, relation := range namespace.Relations {
+		if !namePattern.MatchString(relation.Name) || relation.Name == rewriteThis {
+			return nil, fmt.Errorf("invalid relation name '%s' in namespace '%s'", relation.Name, namespace.Name)
+		}
+		if _, exists := relations[relation.Name]; exists {
+			return nil, fmt.Errorf("duplicate relation '%s' in namespace '%s'", relation.Name, namespace.Name)
+		}
+		terms, err := parseRewrite(relation.Rewrite)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rewrite of relation '%s' in namespace '%s': %w",
+				relation.Name, namespace.Name, err)
+		}
+		relations[relation.Name] = terms
+	}
+
+	// Relations computed on the same object must be defined on the namespace. Relations computed
+	// through a tupleset are resolved on the namespace of the related objects at evaluation time.
+	for name, terms := range relations {
+		for _, term := range terms {
+			var reference string
+			switch term.kind {
+			case termComputedUserset:
+				reference = term.relation
+			case termTupleToUserset:
+				reference = term.tupleset
+			default:
+				continue
+			}
+			if _, ok := relations[reference]; !ok {
+				return nil, fmt.Errorf("relation '%s' in namespace '%s' refers to undefined relation '%s'",
+					name, namespace.Name, reference)
+			}
+		}
+	}
+
+	return relations, nil
+}
+
+// parseRewrite parses a userset rewrite such as "this | editor | parent->viewer".
+func parseRewrite(rewrite string) ([]usersetTerm, error) {
+	if strings.TrimSpace(rewrite) == "" {
+		return []usersetTerm{{kind: termThis}}, nil
+	}
+
+	parts := strings.Split(rewrite, rewriteUnion)
+	terms := make([]usersetTerm, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		switch {
+		case part == rewriteThis:
+			terms = append(terms, usersetTerm{kind: termThis})
+		case strings.Contains(part, rewriteTupleToUserset):
+			tupleset, relation, _ := strings.Cut(part, rewriteTupleToUserset)
+			tupleset = strings.TrimSpace(tupleset)
+			relation = strings.TrimSpace(relation)
+			if !namePattern.MatchString(tupleset) || !namePattern.MatchString(relation) {
+				return nil, fmt.Errorf("invalid term '%s'", part)
+			}
+			terms = append(terms, usersetTerm{kind: termTupleToUserset, tupleset: tupleset, relation: relation})
+		case namePattern.MatchString(part):
+			terms = append(terms, usersetTerm{kind: termComputedUserset, relation: part})
+		default:
+			return nil, fmt.Errorf("invalid term '%s'", part)
+		}
+	}
+	return terms, nil
+}
+
+// isBuiltInNamespace reports whether the namespace is resolved from the group or organization
+// unit services.
+func isBuiltInNamespace(name string) bool {
+	return name == NamespaceGroup || name == NamespaceOU
+}
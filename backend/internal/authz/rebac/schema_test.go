@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package rebac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRewrite(t *testing.T) {
+	terms, err := parseRewrite("this | editor | parent -> viewer")
+	require.NoError(t, err)
+	require.Equal(t, []usersetTerm{
+		{kind: termThis},
+		{kind: termComputedUserset, relation: "editor"},
+		{kind: termTupleToUserset, tupleset: "parent", relation: "viewer"},
+	}, terms)
+
+	terms, err = parseRewrite("")
+	require.NoError(t, err)
+	require.Equal(t, []usersetTerm{{kind: termThis}}, terms)
+
+	for _, rewrite := range []string{"this |", "parent->", "->viewer", "owner & editor"} {
+		_, err := parseRewrite(rewrite)
+		require.Error(t, err, rewrite)
+	}
+}
+
+func TestParseNamespace_Invalid(t *testing.T) {
+	testCases := []struct {
+		name      string
+		namespace Namespace
+	}{
+		{"invalid name", Namespace{Name: "doc:s", Relations: []RelationDefinition{{Name: "viewer"}}}},
+		{"built-in group", Namespace{Name: NamespaceGroup, Relations: []RelationDefinition{{Name: "member"}}}},
+		{"built-in ou", Namespace{Name: NamespaceOU, Relations: []RelationDefinition{{Name: "member"}}}},
+		{"no relations", Namespace{Name: "document"}},
+		{"reserved relation name", Namespace{Name: "document", Relations: []RelationDefinition{{Name: "this"}}}},
+		{"duplicate relation", Namespace{Name: "document", Relations: []RelationDefinition{
+			{Name: "viewer"}, {Name: "viewer"},
+		}}},
+		{"undefined computed relation", Namespace{Name: "document", Relations: []RelationDefinition{
+			{Name: "viewer", Rewrite: "this | editor"},
+		}}},
+		{"undefined tupleset", Namespace{Name: "document", Relations: []RelationDefinition{
+			{Name: "viewer", Rewrite: "parent->viewer"},
+		}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseNamespace(tc.namespace)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestNewSchema(t *testing.T) {
+	s, err := newSchema(testNamespaces)
+	require.NoError(t, err)
+
+	require.True(t, s.hasNamespace("document"))
+	require.True(t, s.hasNamespace(NamespaceGroup))
+	require.False(t, s.hasNamespace("sheet"))
+	require.True(t, s.hasRelation("document", "viewer"))
+	require.True(t, s.hasRelation(NamespaceOU, RelationMember))
+	require.False(t, s.hasRelation(NamespaceOU, "owner"))
+	require.False(t, s.hasRelation("folder", "owner"))
+
+	_, err = newSchema([]Namespace{testNamespaces[1], testNamespaces[1]})
+	require.Error(t, err)
+}
+
+func TestParseToNamespace(t *testing.T) {
+	namespace, err := parseToNamespace([]byte(`
+name: document
+relations:
+  - name: owner
+  - name: viewer
+    rewrite: this | owner
+`))
+	require.NoError(t, err)
+	require.Equal(t, &Namespace{Name: "document", Relations: []RelationDefinition{
+		{Name: "owner"}, {Name: "viewer", Rewrite: "this | owner"},
+	}}, namespace)
+	require.NoError(t, validateNamespaceWrapper(namespace))
+	require.Error(t, validateNamespaceWrapper(&Namespace{Name: NamespaceGroup}))
+}
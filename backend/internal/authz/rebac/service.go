@@ -0,0 +1,336 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package rebac provides relationship-based authorization over relationship tuples and a namespace
+// schema of computed usersets.
+package rebac
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/ou"
+	serverconst "github.com/asgardeo/thunder/internal/system/constants"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/i18n/core"
+	"github.com/asgardeo/thunder/internal/system/log"
+	"github.com/asgardeo/thunder/internal/system/transaction"
+)
+
+const loggerComponentName = "ReBACService"
+
+// RelationServiceInterface defines the interface for the relationship-based authorization service.
+type RelationServiceInterface interface {
+	GetNamespaces(ctx context.Context) *NamespaceList
+	GetTupleList(ctx context.Context, filter TupleFilter, limit, offset int) (*TupleList, *serviceerror.ServiceError)
+	WriteTuples(ctx context.Context, request WriteRequest) *serviceerror.ServiceError
+	Check(ctx context.Context, request CheckRequest) (*CheckResponse, *serviceerror.ServiceError)
+	Expand(ctx context.Context, request ExpandRequest) (*UsersetNode, *serviceerror.ServiceError)
+	ListObjects(ctx context.Context, request ListObjectsRequest) (*ListObjectsResponse, *serviceerror.ServiceError)
+}
+
+// relationService is the default implementation of the RelationServiceInterface.
+type relationService struct {
+	namespaces     []Namespace
+	schema         *schema
+	tupleStore     tupleStoreInterface
+	transactioner  transaction.Transactioner
+	entityProvider entityprovider.EntityProviderInterface
+	ouService      ou.OrganizationUnitServiceInterface
+	logger         *log.Logger
+}
+
+// newRelationService creates a new instance of relationService with injected dependencies.
+func newRelationService(
+	namespaces []Namespace,
+	tupleStore tupleStoreInterface,
+	transactioner transaction.Transactioner,
+	entityProvider entityprovider.EntityProviderInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+) (RelationServiceInterface, error) {
+	s, err := newSchema(namespaces)
+	if err != nil {
+		return nil, err
+	}
+	return &relationService{
+		namespaces:     namespaces,
+		schema:         s,
+		tupleStore:     tupleStore,
+		transactioner:  transactioner,
+		entityProvider: entityProvider,
+		ouService:      ouService,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}, nil
+}
+
+// GetNamespaces returns the namespaces of the schema.
+func (rs *relationService) GetNamespaces(ctx context.Context) *NamespaceList {
+	return &NamespaceList{Namespaces: rs.namespaces}
+}
+
+// GetTupleList retrieves the relationship tuples matching the filter.
+func (rs *relationService) GetTupleList(ctx context.Context, filter TupleFilter, limit, offset int) (
+	*TupleList, *serviceerror.ServiceError) {
+	if err := validatePaginationParams(limit, offset); err != nil {
+		return nil, err
+	}
+
+	totalCount, err := rs.tupleStore.GetTupleListCount(ctx, filter)
+	if err != nil {
+		rs.logger.Error("Failed to get relationship tuple count", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	tuples, err := rs.tupleStore.GetTupleList(ctx, filter, limit, offset)
+	if err != nil {
+		rs.logger.Error("Failed to list relationship tuples", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &TupleList{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(tuples),
+		Tuples:       tuples,
+		Links:        buildPaginationLinks(filter, limit, offset, totalCount),
+	}, nil
+}
+
+// WriteTuples deletes and writes relationship tuples in a single transaction. Deletes are applied
+// before writes, writing an existing tuple and deleting a missing tuple are no-ops.
+func (rs *relationService) WriteTuples(ctx context.Context, request WriteRequest) *serviceerror.ServiceError {
+	if len(request.Writes) == 0 && len(request.Deletes) == 0 {
+		return &ErrorEmptyWriteRequest
+	}
+	for _, tuple := range slices.Concat(request.Deletes, request.Writes) {
+		if err := rs.validateTuple(tuple); err != nil {
+			return err
+		}
+	}
+
+	err := rs.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		for _, tuple := range request.Deletes {
+			if err := rs.tupleStore.DeleteTuple(txCtx, tuple); err != nil {
+				return err
+			}
+		}
+		for _, tuple := range request.Writes {
+			if err := rs.tupleStore.CreateTuple(txCtx, tuple); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		rs.logger.Error("Failed to write relationship tuples", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	rs.logger.Debug("Successfully wrote relationship tuples", log.Int("writes", len(request.Writes)),
+		log.Int("deletes", len(request.Deletes)))
+	return nil
+}
+
+// Check reports whether the subject has the relation to the object, directly or through the
+// computed usersets of the schema.
+func (rs *relationService) Check(ctx context.Context, request CheckRequest) (
+	*CheckResponse, *serviceerror.ServiceError) {
+	if err := rs.validateObjectRelation(request.Object, request.Relation); err != nil {
+		return nil, err
+	}
+	if err := rs.validateSubject(request.Subject); err != nil {
+		return nil, err
+	}
+
+	allowed, err := rs.newChecker(request.Subject).check(ctx, request.Object, request.Relation, 0)
+	if err != nil {
+		rs.logger.Error("Failed to check relationship", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return &CheckResponse{Allowed: allowed}, nil
+}
+
+// Expand returns the userset tree of the relation on the object.
+func (rs *relationService) Expand(ctx context.Context, request ExpandRequest) (
+	*UsersetNode, *serviceerror.ServiceError) {
+	if err := rs.validateObjectRelation(request.Object, request.Relation); err != nil {
+		return nil, err
+	}
+
+	node, err := rs.newChecker(SubjectRef{}).expand(ctx, request.Object, request.Relation, 0)
+	if err != nil {
+		rs.logger.Error("Failed to expand relationship", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return &node, nil
+}
+
+// ListObjects returns the IDs of the objects of a namespace the subject has the relation to.
+func (rs *relationService) ListObjects(ctx context.Context, request ListObjectsRequest) (
+	*ListObjectsResponse, *serviceerror.ServiceError) {
+	if request.ObjectType == "" {
+		return nil, &ErrorInvalidObject
+	}
+	if isBuiltInNamespace(request.ObjectType) {
+		return nil, &ErrorBuiltInNamespace
+	}
+	if !rs.schema.hasNamespace(request.ObjectType) {
+		return nil, &ErrorUnknownNamespace
+	}
+	if !rs.schema.hasRelation(request.ObjectType, request.Relation) {
+		return nil, &ErrorUnknownRelation
+	}
+	if err := rs.validateSubject(request.Subject); err != nil {
+		return nil, err
+	}
+
+	objectIDs, err := rs.tupleStore.GetObjectIDs(ctx, request.ObjectType)
+	if err != nil {
+		rs.logger.Error("Failed to get object IDs", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	c := rs.newChecker(request.Subject)
+	objects := make([]string, 0)
+	for _, objectID := range objectIDs {
+		allowed, err := c.check(ctx, ObjectRef{Type: request.ObjectType, ID: objectID}, request.Relation, 0)
+		if err != nil {
+			rs.logger.Error("Failed to check relationship", log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+		if !allowed {
+			continue
+		}
+		objects = append(objects, objectID)
+		if len(objects) == maxListObjectsResults {
+			break
+		}
+	}
+	return &ListObjectsResponse{Objects: objects}, nil
+}
+
+// newChecker creates a checker for the subject.
+func (rs *relationService) newChecker(subject SubjectRef) *checker {
+	return &checker{
+		store:          rs.tupleStore,
+		schema:         rs.schema,
+		entityProvider: rs.entityProvider,
+		ouService:      rs.ouService,
+		subject:        subject,
+	}
+}
+
+// validateTuple validates a relationship tuple against the schema.
+func (rs *relationService) validateTuple(tuple RelationTuple) *serviceerror.ServiceError {
+	if isBuiltInNamespace(tuple.Object.Type) {
+		return &ErrorBuiltInNamespace
+	}
+	if err := rs.validateObjectRelation(tuple.Object, tuple.Relation); err != nil {
+		return err
+	}
+	return rs.validateSubject(tuple.Subject)
+}
+
+// validateObjectRelation validates that the object is complete and the relation is defined on its
+// namespace.
+func (rs *relationService) validateObjectRelation(object ObjectRef, relation string) *serviceerror.ServiceError {
+	if object.Type == "" || object.ID == "" {
+		return &ErrorInvalidObject
+	}
+	if !rs.schema.hasNamespace(object.Type) {
+		return &ErrorUnknownNamespace
+	}
+	if !rs.schema.hasRelation(object.Type, relation) {
+		return &ErrorUnknownRelation
+	}
+	return nil
+}
+
+// validateSubject validates that the subject is complete and, for usersets, that the relation is
+// defined on the namespace of the subject.
+func (rs *relationService) validateSubject(subject SubjectRef) *serviceerror.ServiceError {
+	if subject.Type == "" || subject.ID == "" {
+		return &ErrorInvalidSubject
+	}
+	if subject.Relation == "" {
+		return nil
+	}
+	if !rs.schema.hasNamespace(subject.Type) {
+		return &ErrorUnknownNamespace
+	}
+	if !rs.schema.hasRelation(subject.Type, subject.Relation) {
+		return &ErrorUnknownRelation
+	}
+	return nil
+}
+
+// validatePaginationParams validates pagination parameters.
+func validatePaginationParams(limit, offset int) *serviceerror.ServiceError {
+	if limit < 1 || limit > serverconst.MaxPageSize {
+		return serviceerror.CustomServiceError(ErrorInvalidLimit, core.I18nMessage{
+			Key:          "error.rebacservice.invalid_limit_value_description",
+			DefaultValue: fmt.Sprintf("Limit must be between 1 and %d", serverconst.MaxPageSize),
+		})
+	}
+	if offset < 0 {
+		return &ErrorInvalidOffset
+	}
+	return nil
+}
+
+// buildPaginationLinks builds pagination links for the response, retaining the filter parameters.
+func buildPaginationLinks(filter TupleFilter, limit, offset, totalCount int) []Link {
+	links := make([]Link, 0)
+
+	buildHref := func(linkOffset int) string {
+		query := filterToQuery(filter)
+		query.Set("limit", strconv.Itoa(limit))
+		query.Set("offset", strconv.Itoa(linkOffset))
+		return relationshipsPath + "?" + query.Encode()
+	}
+
+	if offset > 0 {
+		links = append(links, Link{Href: buildHref(max(offset-limit, 0)), Rel: "previous"})
+	}
+	if offset+limit < totalCount {
+		links = append(links, Link{Href: buildHref(offset + limit), Rel: "next"})
+	}
+
+	return links
+}
+
+// filterToQuery converts the non-empty fields of a tuple filter to query parameters.
+func filterToQuery(filter TupleFilter) url.Values {
+	query := url.Values{}
+	for key, value := range map[string]string{
+		queryParamObjectType:  filter.ObjectType,
+		queryParamObjectID:    filter.ObjectID,
+		queryParamRelation:    filter.Relation,
+		queryParamSubjectType: filter.SubjectType,
+		queryParamSubjectID:   filter.SubjectID,
+	} {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	return query
+}
@@ -0,0 +1,336 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package rebac
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/ou"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/tests/mocks/entityprovidermock"
+	"github.com/asgardeo/thunder/tests/mocks/oumock"
+)
+
+var testNamespaces = []Namespace{
+	{
+		Name: "document",
+		Relations: []RelationDefinition{
+			{Name: "owner"},
+			{Name: "parent"},
+			{Name: "editor", Rewrite: "this | owner"},
+			{Name: "viewer", Rewrite: "this | editor | parent->viewer"},
+		},
+	},
+	{Name: "folder", Relations: []RelationDefinition{{Name: "viewer"}}},
+	{Name: "team", Relations: []RelationDefinition{{Name: "member"}}},
+}
+
+var (
+	testAlice    = SubjectRef{Type: "user", ID: "alice"}
+	testDocument = ObjectRef{Type: "document", ID: "readme"}
+)
+
+// fakeTransactioner is a light-weight test double to capture transaction usage.
+type fakeTransactioner struct {
+	transactCalls int
+	err           error
+}
+
+func (f *fakeTransactioner) Transact(ctx context.Context, txFunc func(context.Context) error) error {
+	f.transactCalls++
+	if f.err != nil {
+		return f.err
+	}
+	return txFunc(ctx)
+}
+
+type RelationServiceTestSuite struct {
+	suite.Suite
+	mockStore          *tupleStoreInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockOUService      *oumock.OrganizationUnitServiceInterfaceMock
+	transactioner      *fakeTransactioner
+	service            RelationServiceInterface
+}
+
+func TestRelationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RelationServiceTestSuite))
+}
+
+func (suite *RelationServiceTestSuite) SetupTest() {
+	suite.mockStore = newTupleStoreInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	suite.transactioner = &fakeTransactioner{}
+
+	service, err := newRelationService(testNamespaces, suite.mockStore, suite.transactioner,
+		suite.mockEntityProvider, suite.mockOUService)
+	suite.Require().NoError(err)
+	suite.service = service
+}
+
+// mockTuples sets up the store to return the given subjects for each object and relation, and no
+// subjects for any other object and relation.
+func (suite *RelationServiceTestSuite) mockTuples(tuples ...RelationTuple) {
+	subjects := make(map[RelationTuple][]SubjectRef)
+	for _, tuple := range tuples {
+		key := RelationTuple{Object: tuple.Object, Relation: tuple.Relation}
+		subjects[key] = append(subjects[key], tuple.Subject)
+	}
+	for key, value := range subjects {
+		suite.mockStore.On("GetSubjects", mock.Anything, key.Object, key.Relation).Return(value, nil).Maybe()
+	}
+	suite.mockStore.On("GetSubjects", mock.Anything, mock.Anything, mock.Anything).
+		Return([]SubjectRef{}, nil).Maybe()
+}
+
+func (suite *RelationServiceTestSuite) check(object ObjectRef, relation string, subject SubjectRef) bool {
+	response, err := suite.service.Check(context.Background(), CheckRequest{
+		Object: object, Relation: relation, Subject: subject,
+	})
+	suite.Require().Nil(err)
+	return response.Allowed
+}
+
+func (suite *RelationServiceTestSuite) TestCheck_ComputedUserset() {
+	suite.mockTuples(RelationTuple{Object: testDocument, Relation: "owner", Subject: testAlice})
+
+	suite.True(suite.check(testDocument, "viewer", testAlice))
+	suite.True(suite.check(testDocument, "editor", testAlice))
+	suite.False(suite.check(testDocument, "parent", testAlice))
+	suite.False(suite.check(testDocument, "viewer", SubjectRef{Type: "user", ID: "bob"}))
+}
+
+func (suite *RelationServiceTestSuite) TestCheck_TupleToUsersetThroughGroup() {
+	folder := ObjectRef{Type: "folder", ID: "specs"}
+	suite.mockTuples(
+		RelationTuple{Object: testDocument, Relation: "parent", Subject: SubjectRef{Type: "folder", ID: "specs"}},
+		RelationTuple{Object: folder, Relation: "viewer",
+			Subject: SubjectRef{Type: NamespaceGroup, ID: "engineering", Relation: RelationMember}},
+	)
+	suite.mockEntityProvider.On("GetTransitiveEntityGroups", "alice").
+		Return([]entityprovider.EntityGroup{{ID: "engineering"}}, nil).Once()
+
+	suite.True(suite.check(testDocument, "viewer", testAlice))
+}
+
+func (suite *RelationServiceTestSuite) TestCheck_UsersetSubject() {
+	team := SubjectRef{Type: "team", ID: "docs", Relation: "member"}
+	suite.mockTuples(
+		RelationTuple{Object: testDocument, Relation: "editor", Subject: team},
+		RelationTuple{Object: ObjectRef{Type: "team", ID: "docs"}, Relation: "member", Subject: testAlice},
+	)
+
+	suite.True(suite.check(testDocument, "viewer", testAlice))
+	suite.True(suite.check(testDocument, "viewer", team))
+}
+
+func (suite *RelationServiceTestSuite) TestCheck_OrganizationUnitMember() {
+	suite.mockTuples(RelationTuple{Object: testDocument, Relation: "viewer",
+		Subject: SubjectRef{Type: NamespaceOU, ID: "sales", Relation: RelationMember}})
+	suite.mockEntityProvider.On("GetEntity", "alice").
+		Return(&entityprovider.Entity{ID: "alice", OUID: "sales-emea"}, nil).Once()
+	suite.mockOUService.On("IsParent", mock.Anything, "sales", "sales-emea").Return(true, nil).Once()
+
+	suite.True(suite.check(testDocument, "viewer", testAlice))
+}
+
+func (suite *RelationServiceTestSuite) TestCheck_OrganizationUnitNotFound() {
+	suite.mockTuples(RelationTuple{Object: testDocument, Relation: "viewer",
+		Subject: SubjectRef{Type: NamespaceOU, ID: "sales", Relation: RelationMember}})
+	suite.mockEntityProvider.On("GetEntity", "alice").
+		Return(&entityprovider.Entity{ID: "alice", OUID: "sales-emea"}, nil).Once()
+	suite.mockOUService.On("IsParent", mock.Anything, "sales", "sales-emea").
+		Return(false, &ou.ErrorOrganizationUnitNotFound).Once()
+
+	suite.False(suite.check(testDocument, "viewer", testAlice))
+}
+
+func (suite *RelationServiceTestSuite) TestCheck_CyclicRelations() {
+	other := ObjectRef{Type: "document", ID: "other"}
+	suite.mockTuples(
+		RelationTuple{Object: testDocument, Relation: "parent", Subject: SubjectRef{Type: "document", ID: "other"}},
+		RelationTuple{Object: other, Relation: "parent", Subject: SubjectRef{Type: "document", ID: "readme"}},
+	)
+
+	suite.False(suite.check(testDocument, "viewer", testAlice))
+}
+
+func (suite *RelationServiceTestSuite) TestCheck_InvalidRequest() {
+	testCases := []struct {
+		name    string
+		request CheckRequest
+		want    string
+	}{
+		{"missing object", CheckRequest{Relation: "viewer", Subject: testAlice}, ErrorInvalidObject.Code},
+		{"unknown namespace", CheckRequest{Object: ObjectRef{Type: "sheet", ID: "s1"}, Relation: "viewer",
+			Subject: testAlice}, ErrorUnknownNamespace.Code},
+		{"unknown relation", CheckRequest{Object: testDocument, Relation: "commenter", Subject: testAlice},
+			ErrorUnknownRelation.Code},
+		{"missing subject", CheckRequest{Object: testDocument, Relation: "viewer"}, ErrorInvalidSubject.Code},
+		{"unknown subject relation", CheckRequest{Object: testDocument, Relation: "viewer",
+			Subject: SubjectRef{Type: NamespaceGroup, ID: "g1", Relation: "owner"}}, ErrorUnknownRelation.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			response, err := suite.service.Check(context.Background(), tc.request)
+			suite.Nil(response)
+			suite.Equal(tc.want, err.Code)
+		})
+	}
+}
+
+func (suite *RelationServiceTestSuite) TestCheck_StoreError() {
+	suite.mockStore.On("GetSubjects", mock.Anything, testDocument, "owner").Return(nil, errors.New("db error"))
+
+	response, err := suite.service.Check(context.Background(), CheckRequest{
+		Object: testDocument, Relation: "owner", Subject: testAlice,
+	})
+
+	suite.Nil(response)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *RelationServiceTestSuite) TestExpand() {
+	team := SubjectRef{Type: "team", ID: "docs", Relation: "member"}
+	suite.mockTuples(
+		RelationTuple{Object: testDocument, Relation: "viewer", Subject: team},
+		RelationTuple{Object: ObjectRef{Type: "team", ID: "docs"}, Relation: "member", Subject: testAlice},
+		RelationTuple{Object: testDocument, Relation: "owner", Subject: SubjectRef{Type: "user", ID: "bob"}},
+	)
+
+	node, err := suite.service.Expand(context.Background(), ExpandRequest{Object: testDocument, Relation: "viewer"})
+
+	suite.Nil(err)
+	suite.Equal([]SubjectRef{team}, node.Subjects)
+	suite.Len(node.Children, 2)
+	suite.Equal("member", node.Children[0].Relation)
+	suite.Equal([]SubjectRef{testAlice}, node.Children[0].Subjects)
+	suite.Equal("editor", node.Children[1].Relation)
+	suite.Equal("owner", node.Children[1].Children[0].Relation)
+	suite.Equal([]SubjectRef{{Type: "user", ID: "bob"}}, node.Children[1].Children[0].Subjects)
+}
+
+func (suite *RelationServiceTestSuite) TestListObjects() {
+	suite.mockStore.On("GetObjectIDs", mock.Anything, "document").Return([]string{"readme", "roadmap"}, nil)
+	suite.mockTuples(RelationTuple{Object: testDocument, Relation: "editor", Subject: testAlice})
+
+	response, err := suite.service.ListObjects(context.Background(), ListObjectsRequest{
+		ObjectType: "document", Relation: "viewer", Subject: testAlice,
+	})
+
+	suite.Nil(err)
+	suite.Equal([]string{"readme"}, response.Objects)
+}
+
+func (suite *RelationServiceTestSuite) TestListObjects_BuiltInNamespace() {
+	response, err := suite.service.ListObjects(context.Background(), ListObjectsRequest{
+		ObjectType: NamespaceGroup, Relation: RelationMember, Subject: testAlice,
+	})
+
+	suite.Nil(response)
+	suite.Equal(ErrorBuiltInNamespace.Code, err.Code)
+}
+
+func (suite *RelationServiceTestSuite) TestWriteTuples() {
+	write := RelationTuple{Object: testDocument, Relation: "owner", Subject: testAlice}
+	remove := RelationTuple{Object: testDocument, Relation: "parent", Subject: SubjectRef{Type: "folder", ID: "f1"}}
+	deleteCall := suite.mockStore.On("DeleteTuple", mock.Anything, remove).Return(nil).Once()
+	suite.mockStore.On("CreateTuple", mock.Anything, write).Return(nil).Once().NotBefore(deleteCall)
+
+	err := suite.service.WriteTuples(context.Background(), WriteRequest{
+		Writes: []RelationTuple{write}, Deletes: []RelationTuple{remove},
+	})
+
+	suite.Nil(err)
+	suite.Equal(1, suite.transactioner.transactCalls)
+}
+
+func (suite *RelationServiceTestSuite) TestWriteTuples_InvalidRequest() {
+	testCases := []struct {
+		name    string
+		request WriteRequest
+		want    string
+	}{
+		{"empty request", WriteRequest{}, ErrorEmptyWriteRequest.Code},
+		{"built-in namespace", WriteRequest{Writes: []RelationTuple{{
+			Object: ObjectRef{Type: NamespaceGroup, ID: "g1"}, Relation: RelationMember, Subject: testAlice,
+		}}}, ErrorBuiltInNamespace.Code},
+		{"unknown relation", WriteRequest{Deletes: []RelationTuple{{
+			Object: testDocument, Relation: "commenter", Subject: testAlice,
+		}}}, ErrorUnknownRelation.Code},
+		{"missing subject", WriteRequest{Writes: []RelationTuple{{
+			Object: testDocument, Relation: "viewer",
+		}}}, ErrorInvalidSubject.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := suite.service.WriteTuples(context.Background(), tc.request)
+			suite.Equal(tc.want, err.Code)
+		})
+	}
+	suite.Equal(0, suite.transactioner.transactCalls)
+}
+
+func (suite *RelationServiceTestSuite) TestWriteTuples_TransactionError() {
+	suite.transactioner.err = errors.New("tx error")
+
+	err := suite.service.WriteTuples(context.Background(), WriteRequest{
+		Writes: []RelationTuple{{Object: testDocument, Relation: "owner", Subject: testAlice}},
+	})
+
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *RelationServiceTestSuite) TestGetTupleList() {
+	filter := TupleFilter{ObjectType: "document", SubjectID: "alice"}
+	tuples := []RelationTuple{{Object: testDocument, Relation: "owner", Subject: testAlice}}
+	suite.mockStore.On("GetTupleListCount", mock.Anything, filter).Return(3, nil)
+	suite.mockStore.On("GetTupleList", mock.Anything, filter, 1, 1).Return(tuples, nil)
+
+	list, err := suite.service.GetTupleList(context.Background(), filter, 1, 1)
+
+	suite.Nil(err)
+	suite.Equal(3, list.TotalResults)
+	suite.Equal(2, list.StartIndex)
+	suite.Equal(tuples, list.Tuples)
+	suite.Equal([]Link{
+		{Href: "/relationships?limit=1&objectType=document&offset=0&subjectId=alice", Rel: "previous"},
+		{Href: "/relationships?limit=1&objectType=document&offset=2&subjectId=alice", Rel: "next"},
+	}, list.Links)
+}
+
+func (suite *RelationServiceTestSuite) TestGetTupleList_InvalidLimit() {
+	list, err := suite.service.GetTupleList(context.Background(), TupleFilter{}, 0, 0)
+
+	suite.Nil(list)
+	suite.Equal(ErrorInvalidLimit.Code, err.Code)
+}
+
+func (suite *RelationServiceTestSuite) TestGetNamespaces() {
+	suite.Equal(testNamespaces, suite.service.GetNamespaces(context.Background()).Namespaces)
+}
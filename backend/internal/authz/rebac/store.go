@@ -0,0 +1,250 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package rebac
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+	"github.com/asgardeo/thunder/internal/system/transaction"
+)
+
+// tupleStoreInterface defines the interface for relationship tuple store operations.
+type tupleStoreInterface interface {
+	CreateTuple(ctx context.Context, tuple RelationTuple) error
+	DeleteTuple(ctx context.Context, tuple RelationTuple) error
+	GetSubjects(ctx context.Context, object ObjectRef, relation string) ([]SubjectRef, error)
+	GetObjectIDs(ctx context.Context, objectType string) ([]string, error)
+	GetTupleList(ctx context.Context, filter TupleFilter, limit, offset int) ([]RelationTuple, error)
+	GetTupleListCount(ctx context.Context, filter TupleFilter) (int, error)
+}
+
+// tupleStore is the database backed implementation of tupleStoreInterface.
+type tupleStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newTupleStore creates a new instance of tupleStore along with the transactioner of the config
+// database.
+func newTupleStore() (tupleStoreInterface, transaction.Transactioner, error) {
+	dbProvider := provider.GetDBProvider()
+	client, err := dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	transactioner, err := client.GetTransactioner()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &tupleStore{
+		dbProvider:   dbProvider,
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}, transactioner, nil
+}
+
+// CreateTuple creates a relationship tuple.
+func (s *tupleStore) CreateTuple(ctx context.Context, tuple RelationTuple) error {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateTuple, tuple.Object.Type, tuple.Object.ID, tuple.Relation,
+		tuple.Subject.Type, tuple.Subject.ID, tuple.Subject.Relation, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteTuple deletes a relationship tuple.
+func (s *tupleStore) DeleteTuple(ctx context.Context, tuple RelationTuple) error {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryDeleteTuple, tuple.Object.Type, tuple.Object.ID, tuple.Relation,
+		tuple.Subject.Type, tuple.Subject.ID, tuple.Subject.Relation, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// GetSubjects retrieves the subjects related to an object through a relation.
+func (s *tupleStore) GetSubjects(ctx context.Context, object ObjectRef, relation string) ([]SubjectRef, error) {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetSubjects, object.Type, object.ID, relation, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	subjects := make([]SubjectRef, 0, len(results))
+	for _, row := range results {
+		subject, err := buildSubjectFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		subjects = append(subjects, subject)
+	}
+	return subjects, nil
+}
+
+// GetObjectIDs retrieves the IDs of the objects of a namespace that have relationship tuples.
+func (s *tupleStore) GetObjectIDs(ctx context.Context, objectType string) ([]string, error) {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetObjectIDs, objectType, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	objectIDs := make([]string, 0, len(results))
+	for _, row := range results {
+		objectID, ok := row["object_id"].(string)
+		if !ok {
+			return nil, fmt.Errorf("object_id not found or invalid type")
+		}
+		objectIDs = append(objectIDs, objectID)
+	}
+	return objectIDs, nil
+}
+
+// GetTupleList retrieves relationship tuples matching the filter with pagination.
+func (s *tupleStore) GetTupleList(
+	ctx context.Context, filter TupleFilter, limit, offset int,
+) ([]RelationTuple, error) {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetTupleList, filter.ObjectType, filter.ObjectID,
+		filter.Relation, filter.SubjectType, filter.SubjectID, s.deploymentID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute tuple list query: %w", err)
+	}
+
+	tuples := make([]RelationTuple, 0, len(results))
+	for _, row := range results {
+		tuple, err := buildTupleFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		tuples = append(tuples, tuple)
+	}
+	return tuples, nil
+}
+
+// GetTupleListCount retrieves the count of relationship tuples matching the filter.
+func (s *tupleStore) GetTupleListCount(ctx context.Context, filter TupleFilter) (int, error) {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return 0, err
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetTupleListCount, filter.ObjectType, filter.ObjectID,
+		filter.Relation, filter.SubjectType, filter.SubjectID, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute count query: %w", err)
+	}
+
+	return parseCountResult(results)
+}
+
+// getConfigDBClient retrieves the config database client.
+func (s *tupleStore) getConfigDBClient() (provider.DBClientInterface, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config database client: %w", err)
+	}
+	return dbClient, nil
+}
+
+// parseCountResult parses count query results.
+func parseCountResult(results []map[string]interface{}) (int, error) {
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no results returned from count query")
+	}
+
+	switch v := results[0]["total"].(type) {
+	case int64:
+		return int(v), nil
+	case int:
+		return v, nil
+	case float64:
+		return int(v), nil
+	default:
+		return 0, fmt.Errorf("unexpected type for count: %T", results[0]["total"])
+	}
+}
+
+// buildSubjectFromResultRow builds a SubjectRef from a database result row.
+func buildSubjectFromResultRow(row map[string]interface{}) (SubjectRef, error) {
+	subjectType, ok := row["subject_type"].(string)
+	if !ok {
+		return SubjectRef{}, fmt.Errorf("subject_type not found or invalid type")
+	}
+	subjectID, ok := row["subject_id"].(string)
+	if !ok {
+		return SubjectRef{}, fmt.Errorf("subject_id not found or invalid type")
+	}
+	subjectRelation, _ := row["subject_relation"].(string)
+
+	return SubjectRef{Type: subjectType, ID: subjectID, Relation: subjectRelation}, nil
+}
+
+// buildTupleFromResultRow builds a RelationTuple from a database result row.
+func buildTupleFromResultRow(row map[string]interface{}) (RelationTuple, error) {
+	objectType, ok := row["object_type"].(string)
+	if !ok {
+		return RelationTuple{}, fmt.Errorf("object_type not found or invalid type")
+	}
+	objectID, ok := row["object_id"].(string)
+	if !ok {
+		return RelationTuple{}, fmt.Errorf("object_id not found or invalid type")
+	}
+	relation, ok := row["relation"].(string)
+	if !ok {
+		return RelationTuple{}, fmt.Errorf("relation not found or invalid type")
+	}
+	subject, err := buildSubjectFromResultRow(row)
+	if err != nil {
+		return RelationTuple{}, err
+	}
+
+	return RelationTuple{
+		Object:   ObjectRef{Type: objectType, ID: objectID},
+		Relation: relation,
+		Subject:  subject,
+	}, nil
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package rebac
+
+import dbmodel "github.com/asgardeo/thunder/internal/system/database/model"
+
+// tupleColumns are the columns of a relationship tuple.
+const tupleColumns = `OBJECT_TYPE, OBJECT_ID, RELATION, SUBJECT_TYPE, SUBJECT_ID, SUBJECT_RELATION`
+
+// tupleFilterCondition matches the tuples of a TupleFilter. Empty filter fields match every tuple.
+const tupleFilterCondition = `($1 = '' OR OBJECT_TYPE = $1) AND ($2 = '' OR OBJECT_ID = $2) AND ` +
+	`($3 = '' OR RELATION = $3) AND ($4 = '' OR SUBJECT_TYPE = $4) AND ($5 = '' OR SUBJECT_ID = $5) AND ` +
+	`DEPLOYMENT_ID = $6`
+
+var (
+	// queryCreateTuple creates a relationship tuple. Writing an existing tuple is a no-op.
+	queryCreateTuple = dbmodel.DBQuery{
+		ID: "AZQ-REBAC-01",
+		Query: `INSERT INTO "AUTHZ_RELATION_TUPLE" (` + tupleColumns + `, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (DEPLOYMENT_ID, ` + tupleColumns + `) DO NOTHING`,
+	}
+
+	// queryDeleteTuple deletes a relationship tuple.
+	queryDeleteTuple = dbmodel.DBQuery{
+		ID: "AZQ-REBAC-02",
+		Query: `DELETE FROM "AUTHZ_RELATION_TUPLE" WHERE OBJECT_TYPE = $1 AND OBJECT_ID = $2 AND ` +
+			`RELATION = $3 AND SUBJECT_TYPE = $4 AND SUBJECT_ID = $5 AND SUBJECT_RELATION = $6 AND ` +
+			`DEPLOYMENT_ID = $7`,
+	}
+
+	// queryGetSubjects retrieves the subjects related to an object through a relation.
+	queryGetSubjects = dbmodel.DBQuery{
+		ID: "AZQ-REBAC-03",
+		Query: `SELECT SUBJECT_TYPE, SUBJECT_ID, SUBJECT_RELATION FROM "AUTHZ_RELATION_TUPLE" ` +
+			`WHERE OBJECT_TYPE = $1 AND OBJECT_ID = $2 AND RELATION = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryGetObjectIDs retrieves the IDs of the objects of a namespace that have relationship tuples.
+	queryGetObjectIDs = dbmodel.DBQuery{
+		ID: "AZQ-REBAC-04",
+		Query: `SELECT DISTINCT OBJECT_ID FROM "AUTHZ_RELATION_TUPLE" ` +
+			`WHERE OBJECT_TYPE = $1 AND DEPLOYMENT_ID = $2 ORDER BY OBJECT_ID`,
+	}
+
+	// queryGetTupleList retrieves relationship tuples matching a filter with pagination.
+	queryGetTupleList = dbmodel.DBQuery{
+		ID: "AZQ-REBAC-05",
+		Query: `SELECT ` + tupleColumns + ` FROM "AUTHZ_RELATION_TUPLE" WHERE ` + tupleFilterCondition +
+			` ORDER BY ` + tupleColumns + ` LIMIT $7 OFFSET $8`,
+	}
+
+	// queryGetTupleListCount retrieves the count of relationship tuples matching a filter.
+	queryGetTupleListCount = dbmodel.DBQuery{
+		ID:    "AZQ-REBAC-06",
+		Query: `SELECT COUNT(*) as total FROM "AUTHZ_RELATION_TUPLE" WHERE ` + tupleFilterCondition,
+	}
+)
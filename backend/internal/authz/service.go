@@ -21,8 +21,11 @@ package authz
 
 import (
 	"context"
+	"slices"
 
 	"github.com/asgardeo/thunder/internal/authz/engine"
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/resource"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	"github.com/asgardeo/thunder/internal/system/log"
 )
@@ -38,17 +41,37 @@ type AuthorizationServiceInterface interface {
 		ctx context.Context,
 		request GetAuthorizedPermissionsRequest,
 	) (*GetAuthorizedPermissionsResponse, *serviceerror.ServiceError)
+
+	// Evaluate decides whether the subject can perform the action on the resource.
+	Evaluate(ctx context.Context, request EvaluationRequest) (*EvaluationResponse, *serviceerror.ServiceError)
+
+	// EvaluateBatch decides a batch of evaluations according to the requested evaluations semantic.
+	EvaluateBatch(
+		ctx context.Context,
+		request EvaluationsRequest,
+	) (*EvaluationsResponse, *serviceerror.ServiceError)
 }
 
 // authorizationService is the default implementation of AuthorizationServiceInterface.
 type authorizationService struct {
-	engine engine.AuthorizationEngine
+	engine          engine.AuthorizationEngine
+	resourceService resource.ResourceServiceInterface
+	entityProvider  entityprovider.EntityProviderInterface
+	maxBatchSize    int
 }
 
 // newAuthorizationService creates a new instance of authorizationService.
-func newAuthorizationService(engine engine.AuthorizationEngine) AuthorizationServiceInterface {
+func newAuthorizationService(
+	engine engine.AuthorizationEngine,
+	resourceService resource.ResourceServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	maxBatchSize int,
+) AuthorizationServiceInterface {
 	return &authorizationService{
-		engine: engine,
+		engine:          engine,
+		resourceService: resourceService,
+		entityProvider:  entityProvider,
+		maxBatchSize:    maxBatchSize,
 	}
 }
 
@@ -75,6 +98,14 @@ func (s *authorizationService) GetAuthorizedPermissions(
 		}, nil
 	}
 
+	if request.Context != nil {
+		ctx = engine.WithAccessContext(ctx, &engine.AccessContext{
+			ACR:        request.Context.ACR,
+			Resource:   request.Context.ResourceAttributes,
+			Attributes: request.Context.Attributes,
+		})
+	}
+
 	// Delegate to engine (engine/underlying service handles validation)
 	authorizedPerms, err := s.engine.GetAuthorizedPermissions(
 		ctx,
@@ -100,3 +131,210 @@ func (s *authorizationService) GetAuthorizedPermissions(
 		AuthorizedPermissions: authorizedPerms,
 	}, nil
 }
+
+// Evaluate decides whether the subject can perform the action on the resource. The resource type is
+// resolved to a resource server by its identifier and the resource ID and action name are combined into
+// the permission string evaluated for the subject. Unknown subjects, resource servers, and permissions
+// result in a deny decision rather than an error.
+func (s *authorizationService) Evaluate(
+	ctx context.Context,
+	request EvaluationRequest,
+) (*EvaluationResponse, *serviceerror.ServiceError) {
+	if svcErr := validateEvaluationRequest(request); svcErr != nil {
+		return nil, svcErr
+	}
+
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	resourceServer, svcErr := s.resourceService.GetResourceServerByIdentifier(ctx, request.Resource.Type)
+	if svcErr != nil {
+		if svcErr.Code == resource.ErrorResourceServerNotFound.Code {
+			return denyEvaluation("Unknown resource type"), nil
+		}
+		logger.Error("Failed to resolve the resource server of the evaluation",
+			log.String("resourceType", request.Resource.Type), log.String("error", svcErr.Error.DefaultValue))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	permission := buildEvaluationPermission(resourceServer, request.Resource.ID, request.Action.Name)
+	invalidPermissions, svcErr := s.resourceService.ValidatePermissions(
+		ctx, resourceServer.ID, []string{permission})
+	if svcErr != nil {
+		logger.Error("Failed to validate the permission of the evaluation",
+			log.String("permission", permission), log.String("error", svcErr.Error.DefaultValue))
+		return nil, &serviceerror.InternalServerError
+	}
+	if len(invalidPermissions) > 0 {
+		return denyEvaluation("Action is not defined for the resource"), nil
+	}
+
+	groupIDs, found, svcErr := s.resolveSubjectGroups(request.Subject.ID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if !found {
+		return denyEvaluation("Unknown subject"), nil
+	}
+
+	authzResponse, svcErr := s.GetAuthorizedPermissions(ctx, GetAuthorizedPermissionsRequest{
+		EntityID:             request.Subject.ID,
+		GroupIDs:             groupIDs,
+		RequestedPermissions: []string{permission},
+		Context:              buildEvaluationAccessContext(request),
+	})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	return &EvaluationResponse{
+		Decision: slices.Contains(authzResponse.AuthorizedPermissions, permission),
+	}, nil
+}
+
+// EvaluateBatch decides a batch of evaluations. Each evaluation inherits the top-level subject, resource,
+// action, and context it does not override. A batch without evaluations is decided as a single evaluation
+// of the top-level request, and a batch with more evaluations than the configured maximum is rejected.
+// Evaluation stops early for the short-circuiting semantics, in which case the response only contains the
+// decisions made up to and including the deciding one.
+func (s *authorizationService) EvaluateBatch(
+	ctx context.Context,
+	request EvaluationsRequest,
+) (*EvaluationsResponse, *serviceerror.ServiceError) {
+	semantic := EvaluationsSemanticExecuteAll
+	if request.Options != nil && request.Options.EvaluationsSemantic != "" {
+		semantic = request.Options.EvaluationsSemantic
+	}
+	if semantic != EvaluationsSemanticExecuteAll && semantic != EvaluationsSemanticDenyOnFirstDeny &&
+		semantic != EvaluationsSemanticPermitOnFirstPermit {
+		return nil, &ErrorInvalidEvaluationsSemantic
+	}
+	if len(request.Evaluations) > s.maxBatchSize {
+		return nil, &ErrorBatchSizeExceeded
+	}
+
+	evaluations := request.Evaluations
+	if len(evaluations) == 0 {
+		evaluations = []EvaluationRequest{{}}
+	}
+
+	// Validate all evaluations upfront so that a malformed batch is rejected as a whole.
+	requests := make([]EvaluationRequest, 0, len(evaluations))
+	for _, evaluation := range evaluations {
+		merged := mergeEvaluationRequest(request, evaluation)
+		if svcErr := validateEvaluationRequest(merged); svcErr != nil {
+			return nil, svcErr
+		}
+		requests = append(requests, merged)
+	}
+
+	responses := make([]EvaluationResponse, 0, len(requests))
+	for _, evaluation := range requests {
+		response, svcErr := s.Evaluate(ctx, evaluation)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		responses = append(responses, *response)
+
+		if (semantic == EvaluationsSemanticDenyOnFirstDeny && !response.Decision) ||
+			(semantic == EvaluationsSemanticPermitOnFirstPermit && response.Decision) {
+			break
+		}
+	}
+
+	return &EvaluationsResponse{Evaluations: responses}, nil
+}
+
+// resolveSubjectGroups resolves the transitive groups of the subject entity. Returns false if the entity
+// does not exist.
+func (s *authorizationService) resolveSubjectGroups(entityID string) ([]string, bool, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if _, epErr := s.entityProvider.GetEntity(entityID); epErr != nil {
+		if epErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			return nil, false, nil
+		}
+		logger.Error("Failed to retrieve the subject of the evaluation",
+			log.MaskedString(log.LoggerKeyUserID, entityID), log.String("error", epErr.Error()))
+		return nil, false, &serviceerror.InternalServerError
+	}
+
+	groups, epErr := s.entityProvider.GetTransitiveEntityGroups(entityID)
+	if epErr != nil {
+		if epErr.Code == entityprovider.ErrorCodeNotImplemented {
+			return []string{}, true, nil
+		}
+		logger.Error("Failed to retrieve the groups of the evaluation subject",
+			log.MaskedString(log.LoggerKeyUserID, entityID), log.String("error", epErr.Error()))
+		return nil, false, &serviceerror.InternalServerError
+	}
+
+	groupIDs := make([]string, 0, len(groups))
+	for _, group := range groups {
+		groupIDs = append(groupIDs, group.ID)
+	}
+	return groupIDs, true, nil
+}
+
+// validateEvaluationRequest validates that the subject, resource, and action of an evaluation are present.
+func validateEvaluationRequest(request EvaluationRequest) *serviceerror.ServiceError {
+	if request.Subject == nil || request.Subject.Type == "" || request.Subject.ID == "" {
+		return &ErrorInvalidSubject
+	}
+	if request.Resource == nil || request.Resource.Type == "" || request.Resource.ID == "" {
+		return &ErrorInvalidResource
+	}
+	if request.Action == nil || request.Action.Name == "" {
+		return &ErrorInvalidAction
+	}
+	return nil
+}
+
+// mergeEvaluationRequest applies the top-level defaults of a batch to the fields an evaluation omits.
+func mergeEvaluationRequest(defaults EvaluationsRequest, evaluation EvaluationRequest) EvaluationRequest {
+	if evaluation.Subject == nil {
+		evaluation.Subject = defaults.Subject
+	}
+	if evaluation.Resource == nil {
+		evaluation.Resource = defaults.Resource
+	}
+	if evaluation.Action == nil {
+		evaluation.Action = defaults.Action
+	}
+	if evaluation.Context == nil {
+		evaluation.Context = defaults.Context
+	}
+	return evaluation
+}
+
+// buildEvaluationPermission builds the permission string evaluated for an action on a resource. A resource
+// ID equal to the resource server identifier refers to an action defined on the resource server itself.
+func buildEvaluationPermission(resourceServer *resource.ResourceServer, resourceID, action string) string {
+	if resourceID == resourceServer.Identifier {
+		if resourceServer.Handle != "" {
+			return resourceServer.Handle + resourceServer.Delimiter + action
+		}
+		return action
+	}
+	return resourceID + resourceServer.Delimiter + action
+}
+
+// denyEvaluation builds a deny decision carrying the reason for the administrator.
+func denyEvaluation(reason string) *EvaluationResponse {
+	return &EvaluationResponse{
+		Decision: false,
+		Context: map[string]interface{}{
+			contextKeyReasonAdmin: map[string]string{reasonLanguageEnglish: reason},
+		},
+	}
+}
+
+// buildEvaluationAccessContext builds the access context of an evaluation from the resource
+// properties and the request context. The authentication class is read from the "acr" context key.
+func buildEvaluationAccessContext(request EvaluationRequest) *AccessContext {
+	acr, _ := request.Context[contextKeyACR].(string)
+	return &AccessContext{
+		ACR:                acr,
+		ResourceAttributes: request.Resource.Properties,
+		Attributes:         request.Context,
+	}
+}
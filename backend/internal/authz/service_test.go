@@ -25,8 +25,12 @@ import (
 
 	"github.com/stretchr/testify/mock"
 
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/resource"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	enginemock "github.com/asgardeo/thunder/tests/mocks/authz/engine"
+	"github.com/asgardeo/thunder/tests/mocks/entityprovidermock"
+	"github.com/asgardeo/thunder/tests/mocks/resourcemock"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -34,8 +38,10 @@ import (
 // AuthorizationServiceTestSuite is the test suite for authorization service.
 type AuthorizationServiceTestSuite struct {
 	suite.Suite
-	mockEngine *enginemock.AuthorizationEngineMock
-	service    AuthorizationServiceInterface
+	mockEngine          *enginemock.AuthorizationEngineMock
+	mockResourceService *resourcemock.ResourceServiceInterfaceMock
+	mockEntityProvider  *entityprovidermock.EntityProviderInterfaceMock
+	service             AuthorizationServiceInterface
 }
 
 func TestAuthorizationServiceTestSuite(t *testing.T) {
@@ -44,7 +50,10 @@ func TestAuthorizationServiceTestSuite(t *testing.T) {
 
 func (suite *AuthorizationServiceTestSuite) SetupTest() {
 	suite.mockEngine = enginemock.NewAuthorizationEngineMock(suite.T())
-	suite.service = newAuthorizationService(suite.mockEngine)
+	suite.mockResourceService = resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.service = newAuthorizationService(suite.mockEngine, suite.mockResourceService, suite.mockEntityProvider,
+		defaultMaxBatchSize)
 }
 
 func (suite *AuthorizationServiceTestSuite) TestGetAuthorizedPermissions_Success() {
@@ -242,3 +251,292 @@ func (suite *AuthorizationServiceTestSuite) TestGetAuthorizedPermissions_AllPerm
 	suite.NotNil(response)
 	suite.Equal(request.RequestedPermissions, response.AuthorizedPermissions)
 }
+
+var testResourceServer = &resource.ResourceServer{
+	ID:         "rs-1",
+	Handle:     "booking",
+	Identifier: "https://api.example.com",
+	Delimiter:  ":",
+}
+
+func newTestEvaluationRequest(resourceID, action string) EvaluationRequest {
+	return EvaluationRequest{
+		Subject:  &EvaluationSubject{Type: "user", ID: "user1"},
+		Resource: &EvaluationResource{Type: testResourceServer.Identifier, ID: resourceID},
+		Action:   &EvaluationAction{Name: action},
+	}
+}
+
+// mockEvaluation sets up the mocks for a resolvable evaluation of the permission for user1.
+func (suite *AuthorizationServiceTestSuite) mockEvaluation(permission string, authorized bool) {
+	suite.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, testResourceServer.Identifier).
+		Return(testResourceServer, nil)
+	suite.mockResourceService.On("ValidatePermissions", mock.Anything, testResourceServer.ID,
+		[]string{permission}).Return([]string{}, nil)
+	suite.mockEntityProvider.On("GetEntity", "user1").Return(&entityprovider.Entity{ID: "user1"}, nil)
+	suite.mockEntityProvider.On("GetTransitiveEntityGroups", "user1").
+		Return([]entityprovider.EntityGroup{{ID: "group1"}}, nil)
+
+	authorizedPerms := []string{}
+	if authorized {
+		authorizedPerms = []string{permission}
+	}
+	suite.mockEngine.On("GetAuthorizedPermissions", mock.Anything, "user1", []string{"group1"},
+		[]string{permission}).Return(authorizedPerms, nil)
+}
+
+func (suite *AuthorizationServiceTestSuite) TestEvaluate_Permit() {
+	suite.mockEvaluation("booking:reservations:create", true)
+
+	response, err := suite.service.Evaluate(context.Background(),
+		newTestEvaluationRequest("booking:reservations", "create"))
+
+	suite.Nil(err)
+	suite.True(response.Decision)
+	suite.Nil(response.Context)
+}
+
+func (suite *AuthorizationServiceTestSuite) TestEvaluate_Deny() {
+	suite.mockEvaluation("booking:reservations:create", false)
+
+	response, err := suite.service.Evaluate(context.Background(),
+		newTestEvaluationRequest("booking:reservations", "create"))
+
+	suite.Nil(err)
+	suite.False(response.Decision)
+}
+
+func (suite *AuthorizationServiceTestSuite) TestEvaluate_ResourceServerLevelAction() {
+	suite.mockEvaluation("booking:audit", true)
+
+	response, err := suite.service.Evaluate(context.Background(),
+		newTestEvaluationRequest(testResourceServer.Identifier, "audit"))
+
+	suite.Nil(err)
+	suite.True(response.Decision)
+}
+
+func (suite *AuthorizationServiceTestSuite) TestEvaluate_InvalidRequest() {
+	testCases := []struct {
+		name        string
+		request     EvaluationRequest
+		expectedErr string
+	}{
+		{"MissingSubject", EvaluationRequest{}, ErrorInvalidSubject.Code},
+		{
+			"MissingSubjectID",
+			EvaluationRequest{Subject: &EvaluationSubject{Type: "user"}},
+			ErrorInvalidSubject.Code,
+		},
+		{
+			"MissingResource",
+			EvaluationRequest{Subject: &EvaluationSubject{Type: "user", ID: "user1"}},
+			ErrorInvalidResource.Code,
+		},
+		{
+			"MissingAction",
+			EvaluationRequest{
+				Subject:  &EvaluationSubject{Type: "user", ID: "user1"},
+				Resource: &EvaluationResource{Type: "api", ID: "res"},
+			},
+			ErrorInvalidAction.Code,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			response, err := suite.service.Evaluate(context.Background(), tc.request)
+
+			suite.Nil(response)
+			suite.NotNil(err)
+			suite.Equal(tc.expectedErr, err.Code)
+		})
+	}
+}
+
+func (suite *AuthorizationServiceTestSuite) TestEvaluate_UnknownResourceServer() {
+	suite.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, testResourceServer.Identifier).
+		Return(nil, &resource.ErrorResourceServerNotFound)
+
+	response, err := suite.service.Evaluate(context.Background(),
+		newTestEvaluationRequest("booking:reservations", "create"))
+
+	suite.Nil(err)
+	suite.False(response.Decision)
+	suite.Contains(response.Context, contextKeyReasonAdmin)
+}
+
+func (suite *AuthorizationServiceTestSuite) TestEvaluate_ResourceServiceError() {
+	suite.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, testResourceServer.Identifier).
+		Return(nil, &serviceerror.InternalServerError)
+
+	response, err := suite.service.Evaluate(context.Background(),
+		newTestEvaluationRequest("booking:reservations", "create"))
+
+	suite.Nil(response)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *AuthorizationServiceTestSuite) TestEvaluate_UndefinedPermission() {
+	suite.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, testResourceServer.Identifier).
+		Return(testResourceServer, nil)
+	suite.mockResourceService.On("ValidatePermissions", mock.Anything, testResourceServer.ID,
+		[]string{"booking:reservations:fly"}).Return([]string{"booking:reservations:fly"}, nil)
+
+	response, err := suite.service.Evaluate(context.Background(),
+		newTestEvaluationRequest("booking:reservations", "fly"))
+
+	suite.Nil(err)
+	suite.False(response.Decision)
+	suite.Contains(response.Context, contextKeyReasonAdmin)
+}
+
+func (suite *AuthorizationServiceTestSuite) TestEvaluate_UnknownSubject() {
+	suite.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, testResourceServer.Identifier).
+		Return(testResourceServer, nil)
+	suite.mockResourceService.On("ValidatePermissions", mock.Anything, testResourceServer.ID,
+		[]string{"booking:reservations:create"}).Return([]string{}, nil)
+	suite.mockEntityProvider.On("GetEntity", "user1").Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "Entity not found", ""))
+
+	response, err := suite.service.Evaluate(context.Background(),
+		newTestEvaluationRequest("booking:reservations", "create"))
+
+	suite.Nil(err)
+	suite.False(response.Decision)
+	suite.Contains(response.Context, contextKeyReasonAdmin)
+}
+
+func (suite *AuthorizationServiceTestSuite) TestEvaluate_GroupsNotImplemented() {
+	suite.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, testResourceServer.Identifier).
+		Return(testResourceServer, nil)
+	suite.mockResourceService.On("ValidatePermissions", mock.Anything, testResourceServer.ID,
+		[]string{"booking:reservations:create"}).Return([]string{}, nil)
+	suite.mockEntityProvider.On("GetEntity", "user1").Return(&entityprovider.Entity{ID: "user1"}, nil)
+	suite.mockEntityProvider.On("GetTransitiveEntityGroups", "user1").Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeNotImplemented, "Not implemented", ""))
+	suite.mockEngine.On("GetAuthorizedPermissions", mock.Anything, "user1", []string{},
+		[]string{"booking:reservations:create"}).Return([]string{"booking:reservations:create"}, nil)
+
+	response, err := suite.service.Evaluate(context.Background(),
+		newTestEvaluationRequest("booking:reservations", "create"))
+
+	suite.Nil(err)
+	suite.True(response.Decision)
+}
+
+func (suite *AuthorizationServiceTestSuite) TestEvaluateBatch_ExecuteAllWithDefaults() {
+	suite.mockEvaluation("booking:reservations:create", true)
+	suite.mockResourceService.On("ValidatePermissions", mock.Anything, testResourceServer.ID,
+		[]string{"booking:reservations:delete"}).Return([]string{}, nil)
+	suite.mockEngine.On("GetAuthorizedPermissions", mock.Anything, "user1", []string{"group1"},
+		[]string{"booking:reservations:delete"}).Return([]string{}, nil)
+
+	defaults := newTestEvaluationRequest("booking:reservations", "create")
+	response, err := suite.service.EvaluateBatch(context.Background(), EvaluationsRequest{
+		Subject:  defaults.Subject,
+		Resource: defaults.Resource,
+		Evaluations: []EvaluationRequest{
+			{Action: &EvaluationAction{Name: "create"}},
+			{Action: &EvaluationAction{Name: "delete"}},
+		},
+	})
+
+	suite.Nil(err)
+	suite.Len(response.Evaluations, 2)
+	suite.True(response.Evaluations[0].Decision)
+	suite.False(response.Evaluations[1].Decision)
+}
+
+func (suite *AuthorizationServiceTestSuite) TestEvaluateBatch_ShortCircuits() {
+	testCases := []struct {
+		name       string
+		semantic   string
+		authorized bool
+	}{
+		{"DenyOnFirstDeny", EvaluationsSemanticDenyOnFirstDeny, false},
+		{"PermitOnFirstPermit", EvaluationsSemanticPermitOnFirstPermit, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mockEvaluation("booking:reservations:create", tc.authorized)
+
+			request := newTestEvaluationRequest("booking:reservations", "create")
+			response, err := suite.service.EvaluateBatch(context.Background(), EvaluationsRequest{
+				Subject:     request.Subject,
+				Resource:    request.Resource,
+				Evaluations: []EvaluationRequest{{Action: request.Action}, {Action: &EvaluationAction{Name: "delete"}}},
+				Options:     &EvaluationsOptions{EvaluationsSemantic: tc.semantic},
+			})
+
+			suite.Nil(err)
+			suite.Len(response.Evaluations, 1)
+			suite.Equal(tc.authorized, response.Evaluations[0].Decision)
+		})
+	}
+}
+
+func (suite *AuthorizationServiceTestSuite) TestEvaluateBatch_NoEvaluations() {
+	suite.mockEvaluation("booking:reservations:create", true)
+
+	request := newTestEvaluationRequest("booking:reservations", "create")
+	response, err := suite.service.EvaluateBatch(context.Background(), EvaluationsRequest{
+		Subject:  request.Subject,
+		Resource: request.Resource,
+		Action:   request.Action,
+	})
+
+	suite.Nil(err)
+	suite.Len(response.Evaluations, 1)
+	suite.True(response.Evaluations[0].Decision)
+}
+
+func (suite *AuthorizationServiceTestSuite) TestEvaluateBatch_InvalidSemantic() {
+	response, err := suite.service.EvaluateBatch(context.Background(), EvaluationsRequest{
+		Options: &EvaluationsOptions{EvaluationsSemantic: "first_match"},
+	})
+
+	suite.Nil(response)
+	suite.Equal(ErrorInvalidEvaluationsSemantic.Code, err.Code)
+}
+
+func (suite *AuthorizationServiceTestSuite) TestEvaluateBatch_BatchSizeExceeded() {
+	service := newAuthorizationService(suite.mockEngine, suite.mockResourceService, suite.mockEntityProvider, 2)
+	request := newTestEvaluationRequest("booking:reservations", "create")
+
+	response, err := service.EvaluateBatch(context.Background(), EvaluationsRequest{
+		Subject:     request.Subject,
+		Resource:    request.Resource,
+		Action:      request.Action,
+		Evaluations: []EvaluationRequest{{}, {}, {}},
+	})
+
+	suite.Nil(response)
+	suite.Equal(ErrorBatchSizeExceeded.Code, err.Code)
+}
+
+func (suite *AuthorizationServiceTestSuite) TestEvaluateBatch_InvalidEvaluation() {
+	request := newTestEvaluationRequest("booking:reservations", "create")
+	response, err := suite.service.EvaluateBatch(context.Background(), EvaluationsRequest{
+		Subject:     request.Subject,
+		Resource:    request.Resource,
+		Evaluations: []EvaluationRequest{{Action: request.Action}, {}},
+	})
+
+	suite.Nil(response)
+	suite.Equal(ErrorInvalidAction.Code, err.Code)
+}
+
+func (suite *AuthorizationServiceTestSuite) TestBuildEvaluationAccessContext() {
+	request := newTestEvaluationRequest("booking:reservations", "create")
+	request.Resource.Properties = map[string]interface{}{"owner": "user1"}
+	request.Context = map[string]interface{}{"acr": "mfa", "ip": "10.0.0.1"}
+
+	accessContext := buildEvaluationAccessContext(request)
+
+	suite.Equal("mfa", accessContext.ACR)
+	suite.Equal(request.Resource.Properties, accessContext.ResourceAttributes)
+	suite.Equal(request.Context, accessContext.Attributes)
+}
@@ -18,7 +18,11 @@
 
 package entity
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+)
 
 // Error variables for entity operations.
 var (
@@ -37,6 +41,10 @@ var (
 	// ErrInvalidCredential is returned when a credential value is invalid.
 	ErrInvalidCredential = errors.New("invalid credential")
 
+	// ErrPasswordPolicyViolation is returned when a password does not satisfy the password policy.
+	// The violation is available through PasswordPolicyError.
+	ErrPasswordPolicyViolation = errors.New("password policy violation")
+
 	// ErrAmbiguousEntity is returned when multiple entities match the provided filters.
 	ErrAmbiguousEntity = errors.New("ambiguous entity")
 
@@ -46,3 +54,19 @@ var (
 	// errResultLimitExceededInCompositeMode is returned when the result limit is exceeded in composite mode.
 	errResultLimitExceededInCompositeMode = errors.New("result limit exceeded in composite mode")
 )
+
+// PasswordPolicyError is returned when a password does not satisfy the password policy of the entity.
+// It carries the localized violation reported by the password policy service.
+type PasswordPolicyError struct {
+	Violation *serviceerror.ServiceError
+}
+
+// Error returns the description of the violation.
+func (e *PasswordPolicyError) Error() string {
+	return ErrPasswordPolicyViolation.Error() + ": " + e.Violation.ErrorDescription.DefaultValue
+}
+
+// Unwrap returns ErrPasswordPolicyViolation so that callers can match the error with errors.Is.
+func (e *PasswordPolicyError) Unwrap() error {
+	return ErrPasswordPolicyViolation
+}
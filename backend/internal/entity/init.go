@@ -21,6 +21,7 @@ package entity
 import (
 	"github.com/asgardeo/thunder/internal/entitytype"
 	"github.com/asgardeo/thunder/internal/ou"
+	"github.com/asgardeo/thunder/internal/passwordpolicy"
 	"github.com/asgardeo/thunder/internal/system/cache"
 	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
 	"github.com/asgardeo/thunder/internal/system/transaction"
@@ -35,13 +36,14 @@ func Initialize(
 	hashService hash.HashServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
 	ouService ou.OrganizationUnitServiceInterface,
+	passwordPolicy passwordpolicy.PasswordPolicyServiceInterface,
 ) (EntityServiceInterface, error) {
 	store, transactioner, err := initializeStore(cacheManager)
 	if err != nil {
 		return nil, err
 	}
 
-	svc := newEntityService(store, hashService, entityTypeService, ouService, transactioner)
+	svc := newEntityService(store, hashService, entityTypeService, ouService, passwordPolicy, transactioner)
 	return svc, nil
 }
 
@@ -27,7 +27,9 @@ import (
 
 	"github.com/asgardeo/thunder/internal/entitytype"
 	"github.com/asgardeo/thunder/internal/ou"
+	"github.com/asgardeo/thunder/internal/passwordpolicy"
 	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	"github.com/asgardeo/thunder/internal/system/log"
 	"github.com/asgardeo/thunder/internal/system/transaction"
 	sysutils "github.com/asgardeo/thunder/internal/system/utils"
@@ -96,6 +98,7 @@ type entityService struct {
 	hashService       hash.HashServiceInterface
 	entityTypeService entitytype.EntityTypeServiceInterface
 	ouService         ou.OrganizationUnitServiceInterface
+	passwordPolicy    passwordpolicy.PasswordPolicyServiceInterface
 	transactioner     transaction.Transactioner
 	logger            *log.Logger
 }
@@ -112,6 +115,7 @@ func newEntityService(
 	hashService hash.HashServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
 	ouService ou.OrganizationUnitServiceInterface,
+	passwordPolicy passwordpolicy.PasswordPolicyServiceInterface,
 	transactioner transaction.Transactioner,
 ) EntityServiceInterface {
 	return &entityService{
@@ -119,6 +123,7 @@ func newEntityService(
 		hashService:       hashService,
 		entityTypeService: entityTypeService,
 		ouService:         ouService,
+		passwordPolicy:    passwordPolicy,
 		transactioner:     transactioner,
 		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, "EntityService")),
 	}
@@ -163,6 +168,9 @@ func (s *entityService) CreateEntity(ctx context.Context, entity *Entity,
 		if err := s.store.CreateEntity(txCtx, *entity, schemaCredsJSON, hashedSysCreds); err != nil {
 			return err
 		}
+		if err := s.recordPasswordChange(txCtx, entity, schemaCredsJSON); err != nil {
+			return err
+		}
 
 		result, err := s.store.GetEntity(txCtx, entity.ID)
 		if err != nil {
@@ -233,6 +241,7 @@ func (s *entityService) UpdateEntity(ctx context.Context, entityID string, entit
 
 	// Extract schema credentials from attributes.
 	// These will be merged with existing credentials atomically.
+	entity.ID = entityID
 	schemaCredsJSON, err := s.extractAndHashSchemaCredentials(ctx, entity)
 	if err != nil {
 		return nil, fmt.Errorf("failed to extract schema credentials: %w", err)
@@ -240,7 +249,6 @@ func (s *entityService) UpdateEntity(ctx context.Context, entityID string, entit
 
 	var updated Entity
 	err = s.transactioner.Transact(ctx, func(txCtx context.Context) error {
-		entity.ID = entityID
 		if err := s.store.UpdateEntity(txCtx, entity); err != nil {
 			return err
 		}
@@ -256,6 +264,9 @@ func (s *entityService) UpdateEntity(ctx context.Context, entityID string, entit
 			if err := s.store.UpdateCredentials(txCtx, entityID, mergedCreds); err != nil {
 				return err
 			}
+			if err := s.recordPasswordChange(txCtx, entity, schemaCredsJSON); err != nil {
+				return err
+			}
 		}
 
 		result, err := s.store.GetEntity(txCtx, entityID)
@@ -301,8 +312,10 @@ func (s *entityService) UpdateAttributes(ctx context.Context, entityID string, a
 
 	// Extract and hash any schema-defined credential fields from the attributes.
 	entityForExtraction := &Entity{
+		ID:         entityID,
 		Category:   existing.Category,
 		Type:       existing.Type,
+		OUID:       existing.OUID,
 		Attributes: attributes,
 	}
 	schemaCredsJSON, err := s.extractAndHashSchemaCredentials(ctx, entityForExtraction)
@@ -324,7 +337,10 @@ func (s *entityService) UpdateAttributes(ctx context.Context, entityID string, a
 				return getErr
 			}
 			mergedCreds := mergeCredentialJSON(existingWithCreds.SchemaCredentials, schemaCredsJSON)
-			return s.store.UpdateCredentials(txCtx, entityID, mergedCreds)
+			if err := s.store.UpdateCredentials(txCtx, entityID, mergedCreds); err != nil {
+				return err
+			}
+			return s.recordPasswordChange(txCtx, entityForExtraction, schemaCredsJSON)
 		}
 
 		return nil
@@ -585,6 +601,11 @@ func (s *entityService) UpdateCredentials(ctx context.Context, entityID string,
 	if err := s.validateCredentialKeys(ctx, existing.Category, existing.Type, updates); err != nil {
 		return err
 	}
+	if password, ok := updates[s.passwordAttribute()].(string); ok {
+		if err := s.validatePassword(ctx, &existing, password); err != nil {
+			return err
+		}
+	}
 
 	// Hash new plaintext values.
 	hashedUpdates, err := s.hashPlaintextCredentials(plaintextUpdates)
@@ -621,7 +642,10 @@ func (s *entityService) UpdateCredentials(ctx context.Context, entityID string,
 			return fmt.Errorf("failed to marshal merged credentials: %w", err)
 		}
 
-		return s.store.UpdateCredentials(txCtx, entityID, mergedJSON)
+		if err := s.store.UpdateCredentials(txCtx, entityID, mergedJSON); err != nil {
+			return err
+		}
+		return s.recordPasswordChange(txCtx, &existing, hashedUpdates)
 	})
 }
 
@@ -875,6 +899,11 @@ func (s *entityService) extractAndHashSchemaCredentials(ctx context.Context, ent
 	if len(plaintextCreds) == 0 {
 		return nil, nil
 	}
+	if password, ok := plaintextCreds[s.passwordAttribute()]; ok {
+		if err := s.validatePassword(ctx, entity, password); err != nil {
+			return nil, err
+		}
+	}
 
 	// Update entity.Attributes with credentials removed.
 	cleanAttrs, err := json.Marshal(attrsMap)
@@ -941,6 +970,72 @@ func (s *entityService) hashPlaintextCredentials(creds json.RawMessage) (json.Ra
 	return json.Marshal(result)
 }
 
+// isPasswordPolicyEnforced reports whether the password policy applies to entities of the category.
+func (s *entityService) isPasswordPolicyEnforced(category EntityCategory) bool {
+	return category == EntityCategoryUser && s.passwordPolicy != nil && s.passwordPolicy.IsEnabled()
+}
+
+// passwordAttribute returns the credential attribute that holds the password of users.
+func (s *entityService) passwordAttribute() string {
+	if s.passwordPolicy == nil {
+		return ""
+	}
+	return s.passwordPolicy.GetCredentialAttribute()
+}
+
+// validatePassword validates a new plaintext password of the entity against its password policy.
+func (s *entityService) validatePassword(ctx context.Context, entity *Entity, password string) error {
+	if !s.isPasswordPolicyEnforced(entity.Category) {
+		return nil
+	}
+	svcErr := s.passwordPolicy.ValidatePassword(ctx, passwordPolicySubject(entity), password)
+	if svcErr == nil {
+		return nil
+	}
+	if svcErr.Type == serviceerror.ClientErrorType {
+		return &PasswordPolicyError{Violation: svcErr}
+	}
+	return fmt.Errorf("failed to validate password: %s", svcErr.ErrorDescription.DefaultValue)
+}
+
+// recordPasswordChange records the hashed password in the given hashed credentials, if any, in the
+// password history of the entity.
+func (s *entityService) recordPasswordChange(
+	ctx context.Context, entity *Entity, hashedCreds json.RawMessage,
+) error {
+	if !s.isPasswordPolicyEnforced(entity.Category) || len(hashedCreds) == 0 {
+		return nil
+	}
+
+	var credsMap map[string]json.RawMessage
+	if err := json.Unmarshal(hashedCreds, &credsMap); err != nil {
+		return fmt.Errorf("failed to unmarshal hashed credentials: %w", err)
+	}
+	raw, ok := credsMap[s.passwordAttribute()]
+	if !ok {
+		return nil
+	}
+	var stored []StoredCredential
+	if err := json.Unmarshal(raw, &stored); err != nil || len(stored) == 0 {
+		return nil
+	}
+
+	return s.passwordPolicy.RecordPasswordChange(ctx, passwordPolicySubject(entity), hash.Credential{
+		Algorithm:  stored[0].StorageAlgo,
+		Hash:       stored[0].Value,
+		Parameters: stored[0].StorageAlgoParams,
+	})
+}
+
+// passwordPolicySubject builds the password policy subject of the entity.
+func passwordPolicySubject(entity *Entity) passwordpolicy.Subject {
+	return passwordpolicy.Subject{
+		EntityID:   entity.ID,
+		EntityType: entity.Type,
+		OUID:       entity.OUID,
+	}
+}
+
 // IsEntityDeclarative checks if an entity is declarative (immutable).
 func (s *entityService) IsEntityDeclarative(ctx context.Context, entityID string) (bool, error) {
 	return s.store.IsEntityDeclarative(ctx, entityID)
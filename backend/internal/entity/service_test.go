@@ -27,9 +27,12 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/asgardeo/thunder/internal/passwordpolicy"
 	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	"github.com/asgardeo/thunder/internal/system/transaction"
 	"github.com/asgardeo/thunder/tests/mocks/crypto/hashmock"
+	"github.com/asgardeo/thunder/tests/mocks/passwordpolicymock"
 )
 
 type ServiceTestSuite struct {
@@ -56,7 +59,7 @@ func (s *ServiceTestSuite) SetupTest() {
 			Salt: "testsalt", Iterations: 1, KeySize: 32,
 		},
 	}, nil).Maybe()
-	s.svc = newEntityService(s.store, s.hashService, nil, nil, transaction.NewNoOpTransactioner())
+	s.svc = newEntityService(s.store, s.hashService, nil, nil, nil, transaction.NewNoOpTransactioner())
 	s.ctx = context.Background()
 	s.testErr = errors.New("store error")
 }
@@ -486,3 +489,75 @@ func (s *ServiceTestSuite) TestAuthenticateEntity_DelegatesToByID() {
 	s.NoError(err)
 	s.Equal(id, result.EntityID)
 }
+
+func (s *ServiceTestSuite) newServiceWithPasswordPolicy() *passwordpolicymock.PasswordPolicyServiceInterfaceMock {
+	mockPasswordPolicy := passwordpolicymock.NewPasswordPolicyServiceInterfaceMock(s.T())
+	mockPasswordPolicy.On("IsEnabled").Return(true).Maybe()
+	mockPasswordPolicy.On("GetCredentialAttribute").Return("password").Maybe()
+	s.svc = newEntityService(s.store, s.hashService, nil, nil, mockPasswordPolicy,
+		transaction.NewNoOpTransactioner())
+	return mockPasswordPolicy
+}
+
+func (s *ServiceTestSuite) TestUpdateCredentials_PasswordPolicyViolation() {
+	mockPasswordPolicy := s.newServiceWithPasswordPolicy()
+	e := testEntity("policy-1")
+	s.store.On("GetEntity", mock.Anything, e.ID).Return(*e, nil)
+	mockPasswordPolicy.On("ValidatePassword", mock.Anything, passwordpolicy.Subject{
+		EntityID: e.ID, EntityType: e.Type, OUID: e.OUID,
+	}, "weak").Return(&passwordpolicy.ErrorPasswordTooShort)
+
+	err := s.svc.UpdateCredentials(s.ctx, e.ID, json.RawMessage(`{"password":"weak"}`))
+
+	s.ErrorIs(err, ErrPasswordPolicyViolation)
+	var policyErr *PasswordPolicyError
+	s.Require().ErrorAs(err, &policyErr)
+	s.Equal(passwordpolicy.ErrorPasswordTooShort.Code, policyErr.Violation.Code)
+	s.store.AssertNotCalled(s.T(), "UpdateCredentials", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestUpdateCredentials_PasswordPolicyServerError() {
+	mockPasswordPolicy := s.newServiceWithPasswordPolicy()
+	e := testEntity("policy-2")
+	s.store.On("GetEntity", mock.Anything, e.ID).Return(*e, nil)
+	mockPasswordPolicy.On("ValidatePassword", mock.Anything, mock.Anything, "Str0ngPassword").
+		Return(&serviceerror.InternalServerError)
+
+	err := s.svc.UpdateCredentials(s.ctx, e.ID, json.RawMessage(`{"password":"Str0ngPassword"}`))
+
+	s.Error(err)
+	s.NotErrorIs(err, ErrPasswordPolicyViolation)
+}
+
+func (s *ServiceTestSuite) TestUpdateCredentials_RecordsPasswordChange() {
+	mockPasswordPolicy := s.newServiceWithPasswordPolicy()
+	e := testEntity("policy-3")
+	s.store.On("GetEntity", mock.Anything, e.ID).Return(*e, nil)
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e}, nil)
+	s.store.On("UpdateCredentials", mock.Anything, e.ID, mock.Anything).Return(nil)
+	subject := passwordpolicy.Subject{EntityID: e.ID, EntityType: e.Type, OUID: e.OUID}
+	mockPasswordPolicy.On("ValidatePassword", mock.Anything, subject, "Str0ngPassword").Return(nil)
+	mockPasswordPolicy.On("RecordPasswordChange", mock.Anything, subject, hash.Credential{
+		Algorithm:  "PBKDF2",
+		Hash:       "testhash",
+		Parameters: hash.CredParameters{Salt: "testsalt", Iterations: 1, KeySize: 32},
+	}).Return(nil)
+
+	err := s.svc.UpdateCredentials(s.ctx, e.ID, json.RawMessage(`{"password":"Str0ngPassword"}`))
+
+	s.NoError(err)
+	mockPasswordPolicy.AssertExpectations(s.T())
+}
+
+func (s *ServiceTestSuite) TestCreateEntity_PasswordPolicyNotAppliedToApplications() {
+	s.newServiceWithPasswordPolicy()
+	e := testEntity("app-1")
+	e.Category = EntityCategoryApp
+	s.store.On("CreateEntity", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	s.store.On("GetEntity", mock.Anything, e.ID).Return(*e, nil)
+
+	_, err := s.svc.CreateEntity(s.ctx, e, json.RawMessage(`{"clientSecret":"secret"}`))
+
+	s.NoError(err)
+}
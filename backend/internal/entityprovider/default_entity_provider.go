@@ -294,6 +294,13 @@ func toServiceEntity(e *Entity) *entity.Entity {
 // mapEntityError converts an entity service error into an EntityProviderError,
 // preserving the underlying error code semantics where possible.
 func mapEntityError(err error) *EntityProviderError {
+	var policyErr *entity.PasswordPolicyError
+	if errors.As(err, &policyErr) {
+		epErr := NewEntityProviderError(ErrorCodePasswordPolicyFailed, "Password policy violation", err.Error())
+		epErr.Violation = policyErr.Violation
+		return epErr
+	}
+
 	switch {
 	case errors.Is(err, entity.ErrEntityNotFound):
 		return NewEntityProviderError(ErrorCodeEntityNotFound, "Entity not found", err.Error())
@@ -305,6 +312,8 @@ func mapEntityError(err error) *EntityProviderError {
 		return NewEntityProviderError(ErrorCodeSchemaValidationFailed, "Schema validation failed", err.Error())
 	case errors.Is(err, entity.ErrInvalidCredential):
 		return NewEntityProviderError(ErrorCodeInvalidRequestFormat, "Invalid credential", err.Error())
+	case errors.Is(err, entity.ErrPasswordPolicyViolation):
+		return NewEntityProviderError(ErrorCodePasswordPolicyFailed, "Password policy violation", err.Error())
 	case errors.Is(err, entity.ErrBadAttributesInRequest):
 		return NewEntityProviderError(ErrorCodeInvalidRequestFormat, "Invalid request", err.Error())
 	default:
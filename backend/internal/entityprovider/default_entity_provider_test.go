@@ -21,12 +21,14 @@ package entityprovider
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/asgardeo/thunder/internal/entity"
+	"github.com/asgardeo/thunder/internal/passwordpolicy"
 	"github.com/asgardeo/thunder/tests/mocks/entitymock"
 )
 
@@ -358,6 +360,8 @@ func (suite *DefaultEntityProviderTestSuite) TestMapEntityError() {
 		{"AttributeConflict", entity.ErrAttributeConflict, ErrorCodeAttributeConflict},
 		{"SchemaValidationFailed", entity.ErrSchemaValidationFailed, ErrorCodeSchemaValidationFailed},
 		{"InvalidCredential", entity.ErrInvalidCredential, ErrorCodeInvalidRequestFormat},
+		{"PasswordPolicyViolation", &entity.PasswordPolicyError{Violation: &passwordpolicy.ErrorPasswordReused},
+			ErrorCodePasswordPolicyFailed},
 		{"BadAttributesInRequest", entity.ErrBadAttributesInRequest, ErrorCodeInvalidRequestFormat},
 		{"Unknown", errors.New("unexpected"), ErrorCodeSystemError},
 	}
@@ -370,6 +374,14 @@ func (suite *DefaultEntityProviderTestSuite) TestMapEntityError() {
 	}
 }
 
+func (suite *DefaultEntityProviderTestSuite) TestMapEntityError_PasswordPolicyViolation() {
+	err := mapEntityError(fmt.Errorf("update failed: %w",
+		&entity.PasswordPolicyError{Violation: &passwordpolicy.ErrorPasswordReused}))
+
+	suite.Equal(ErrorCodePasswordPolicyFailed, err.Code)
+	suite.Equal(&passwordpolicy.ErrorPasswordReused, err.Violation)
+}
+
 func (suite *DefaultEntityProviderTestSuite) TestGetTransitiveEntityGroups() {
 	groups := []entity.EntityGroup{
 		{ID: "g1", Name: "Group 1", OUID: "ou1"},
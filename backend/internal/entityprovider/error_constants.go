@@ -18,6 +18,8 @@
 
 package entityprovider
 
+import "github.com/asgardeo/thunder/internal/system/error/serviceerror"
+
 // ErrorCode represents an entity provider error code.
 type ErrorCode string
 
@@ -32,6 +34,7 @@ const (
 	ErrorCodeNotImplemented         ErrorCode = "EP-0007"
 	ErrorCodeAmbiguousEntity        ErrorCode = "EP-0008"
 	ErrorCodeSchemaValidationFailed ErrorCode = "EP-0009"
+	ErrorCodePasswordPolicyFailed   ErrorCode = "EP-0010"
 )
 
 // EntityProviderError represents an error returned by the entity provider.
@@ -39,6 +42,8 @@ type EntityProviderError struct {
 	Code        ErrorCode `json:"code"`
 	Message     string    `json:"message"`
 	Description string    `json:"description"`
+	// Violation holds the localized password policy violation of an ErrorCodePasswordPolicyFailed error.
+	Violation *serviceerror.ServiceError `json:"-"`
 }
 
 func (e *EntityProviderError) Error() string {
@@ -31,6 +31,9 @@ const (
 	FlowTypeRegistration FlowType = "REGISTRATION"
 	// FlowTypeUserOnboarding represents an admin-initiated user onboarding flow.
 	FlowTypeUserOnboarding FlowType = "USER_ONBOARDING"
+	// FlowTypeBackchannelAuthentication represents a decoupled (CIBA) authentication flow in which the user
+	// approves a request initiated by a client on another device.
+	FlowTypeBackchannelAuthentication FlowType = "BACKCHANNEL_AUTHENTICATION"
 )
 
 // FlowStatus defines the status of a flow execution.
@@ -129,6 +132,10 @@ const (
 	DataIDPName = "idpName"
 	// DataConsentPrompt is the key used for the consent prompt data in the flow response.
 	DataConsentPrompt = "consentPrompt"
+	// DataAuthorizationDetailsPrompt is the key used for the requested authorization details in the flow response.
+	DataAuthorizationDetailsPrompt = "authorizationDetailsPrompt"
+	// DataLoginHint is the key used to pass the login hint to the frontend to pre-fill the user identifier.
+	DataLoginHint = "loginHint"
 	// DataStepTimeout is the key used for the step expiry timestamp in the flow response.
 	DataStepTimeout = "stepTimeout"
 	// DataInviteLink is the key used for the invite link in the flow response additional data.
@@ -141,6 +148,8 @@ const (
 	DataRootOUID = "rootOuId"
 	// DataPromptMessage is the key used to pass a message to be displayed in the prompt node.
 	DataPromptMessage = "message"
+	// DataFailureReasonKey is the key used to pass the i18n key of the failure reason in the flow response.
+	DataFailureReasonKey = "failureReasonKey"
 )
 
 // DefaultHTTPTimeout defines the default timeout duration for HTTP requests.
@@ -183,6 +192,11 @@ const (
 	RuntimeKeyClientID = "clientId"
 	// RuntimeKeyRequestedPermissions holds the space-separated permission scopes requested by the OAuth client.
 	RuntimeKeyRequestedPermissions = "requested_permissions"
+	// RuntimeKeyRequestedAuthorizationDetails holds the JSON-encoded authorization details requested by the
+	// OAuth client (RFC 9396).
+	RuntimeKeyRequestedAuthorizationDetails = "requested_authorization_details"
+	// RuntimeKeyAuthorizedAuthorizationDetails holds the JSON-encoded authorization details approved by the user.
+	RuntimeKeyAuthorizedAuthorizationDetails = "authorized_authorization_details"
 	// RuntimeKeyRequiredEssentialAttributes holds the space-separated essential user attributes required for the flow.
 	RuntimeKeyRequiredEssentialAttributes = "required_essential_attributes"
 	// RuntimeKeyRequiredOptionalAttributes holds the space-separated optional user attributes required for the flow.
@@ -209,6 +223,14 @@ const (
 	RuntimeKeyMagicLinkExpiryMinutes = "magicLinkExpiryMinutes"
 	// RuntimeKeyMagicLinkDestinationAttribute holds the destination attribute used to generate the magic link.
 	RuntimeKeyMagicLinkDestinationAttribute = "magicLinkDestinationAttribute"
+	// RuntimeKeyUserID holds the ID of the user a flow is initiated for, when known up front.
+	RuntimeKeyUserID = "userID"
+	// RuntimeKeyStoredBackchannelToken holds the approval token bound to a backchannel authentication request.
+	RuntimeKeyStoredBackchannelToken = "storedBackchannelToken"
+	// RuntimeKeyBackchannelLink holds the generated backchannel approval link for downstream executors.
+	RuntimeKeyBackchannelLink = "backchannelLink"
+	// RuntimeKeyBindingMessage holds the binding message shown to the user on both devices.
+	RuntimeKeyBindingMessage = "bindingMessage"
 	// RuntimeKeySkipDelivery indicates that delivery should be skipped for the current flow.
 	RuntimeKeySkipDelivery = "skipDelivery"
 	// RuntimeKeyCandidateUsers holds serialized candidate users during disambiguation in resolve mode.
@@ -225,10 +247,18 @@ const (
 	// RuntimeKeySMSOTPPhoneAttr holds the schema attribute name used to look up the mobile number.
 	// TODO: Revisit when the generic OTP executor is implemented.
 	RuntimeKeySMSOTPPhoneAttr = "smsOTPPhoneAttr"
+	// RuntimeKeyEmailOTPEmail holds the resolved email address for email OTP verification.
+	RuntimeKeyEmailOTPEmail = "emailOTPEmail"
+	// RuntimeKeyEmailOTPEmailAttr holds the schema attribute name used to look up the email address.
+	RuntimeKeyEmailOTPEmailAttr = "emailOTPEmailAttr"
 	// RuntimeKeyMagicLinkUsedJti is the JWT ID claim value of a magic link token that has already been used.
 	RuntimeKeyMagicLinkUsedJti = "magicLinkUsedJti"
 	// RuntimeKeyOAuthState holds the generated OAuth state parameter for CSRF validation.
 	RuntimeKeyOAuthState = "oauthState"
+	// RuntimeKeySAMLRequestID holds the ID of the authentication request sent to a SAML identity provider.
+	RuntimeKeySAMLRequestID = "samlRequestId"
+	// RuntimeKeyLoginHint holds the login_hint sent by the OAuth client, used to pre-fill the user identifier.
+	RuntimeKeyLoginHint = "login_hint"
 	// RuntimeKeyRequestedAuthClasses holds the space-separated ACR values from acr_values.
 	RuntimeKeyRequestedAuthClasses = "requested_auth_classes"
 	// RuntimeKeySelectedAuthClass holds the ACR value of the chosen authentication method.
@@ -309,6 +339,9 @@ const (
 	ForwardedDataKeyInputs = "inputs"
 	// ForwardedDataKeyConsentPrompt is the key used to forward consent prompt data to the prompt node
 	ForwardedDataKeyConsentPrompt = "consent_prompt"
+	// ForwardedDataKeyAuthorizationDetailsPrompt is the key used to forward the requested authorization details
+	// to the prompt node
+	ForwardedDataKeyAuthorizationDetailsPrompt = "authorization_details_prompt"
 	// ForwardedDataKeyActionType holds the action type selected by the user for the immediate next node
 	ForwardedDataKeyActionType = "actionType"
 	// ForwardedDataKeyTemplateData holds template parameters for notification executors
@@ -162,6 +162,12 @@ func (n *promptNode) Execute(ctx *NodeContext) (*common.NodeResponse, *serviceer
 		nodeResp.Meta = n.appendSyntheticMetaComponents(trimmed, nodeResp.Inputs)
 	}
 
+	// Pass the login hint along so that the user identifier can be pre-filled.
+	if loginHint := ctx.RuntimeData[common.RuntimeKeyLoginHint]; loginHint != "" &&
+		slices.ContainsFunc(nodeResp.Inputs, func(input common.Input) bool { return !input.IsSensitive() }) {
+		nodeResp.AdditionalData[common.DataLoginHint] = loginHint
+	}
+
 	nodeResp.Status = common.NodeStatusIncomplete
 	nodeResp.Type = common.NodeResponseTypeView
 	return nodeResp, nil
@@ -107,6 +107,40 @@ func (s *PromptOnlyNodeTestSuite) TestExecuteWithRequiredData() {
 	}
 }
 
+func (s *PromptOnlyNodeTestSuite) TestExecuteWithLoginHint() {
+	tests := []struct {
+		name         string
+		inputs       []common.Input
+		expectedHint string
+	}{
+		{"Identifier input", []common.Input{{Identifier: "username", Type: "TEXT_INPUT", Required: true}},
+			"alice@example.com"},
+		{"Sensitive input only", []common.Input{{Identifier: "password", Type: common.InputTypePassword,
+			Required: true}}, ""},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			node := newPromptNode("prompt-1", map[string]interface{}{}, false, false)
+			promptNode := node.(PromptNodeInterface)
+			promptNode.SetPrompts([]common.Prompt{
+				{Inputs: tt.inputs, Action: &common.Action{Ref: "submit", NextNode: "next"}},
+			})
+
+			ctx := &NodeContext{
+				ExecutionID: "test-flow",
+				UserInputs:  map[string]string{},
+				RuntimeData: map[string]string{common.RuntimeKeyLoginHint: "alice@example.com"},
+			}
+			resp, err := node.Execute(ctx)
+
+			s.Nil(err)
+			s.Equal(common.NodeStatusIncomplete, resp.Status)
+			s.Equal(tt.expectedHint, resp.AdditionalData[common.DataLoginHint])
+		})
+	}
+}
+
 func (s *PromptOnlyNodeTestSuite) TestExecuteWithOptionalData() {
 	node := newPromptNode("prompt-1", map[string]interface{}{}, false, false)
 	promptNode := node.(PromptNodeInterface)
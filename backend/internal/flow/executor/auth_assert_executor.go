@@ -168,6 +168,16 @@ func (a *authAssertExecutor) generateAuthAssertion(ctx *core.NodeContext, logger
 		jwtClaims["authorized_permissions"] = permissions
 	}
 
+	// Include the authorization details approved by the user in the consent step, if any.
+	if details := ctx.RuntimeData[common.RuntimeKeyAuthorizedAuthorizationDetails]; details != "" {
+		var authorizedDetails []interface{}
+		if err := json.Unmarshal([]byte(details), &authorizedDetails); err != nil {
+			logger.Error("Failed to parse authorized authorization details", log.Error(err))
+			return "", errors.New("something went wrong while generating auth assertion")
+		}
+		jwtClaims[common.RuntimeKeyAuthorizedAuthorizationDetails] = authorizedDetails
+	}
+
 	if completedACR, exists := ctx.RuntimeData[common.RuntimeKeySelectedAuthClass]; exists && completedACR != "" {
 		jwtClaims[oauth2const.ClaimCompletedAuthClass] = completedACR
 	}
@@ -226,6 +226,35 @@ func (suite *AuthAssertExecutorTestSuite) TestExecute_WithAuthorizedPermissions(
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *AuthAssertExecutorTestSuite) TestExecute_WithAuthorizedAuthorizationDetails() {
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		EntityID:    "app-123",
+		FlowType:    common.FlowTypeAuthentication,
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			IsAuthenticated: true,
+			UserID:          "user-123",
+		},
+		RuntimeData: map[string]string{
+			common.RuntimeKeyAuthorizedAuthorizationDetails: `[{"type":"payment_initiation","amount":"50.00"}]`,
+		},
+		ExecutionHistory: map[string]*common.NodeExecutionRecord{},
+		Application:      appmodel.Application{},
+	}
+
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "user-123", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			details, ok := claims[common.RuntimeKeyAuthorizedAuthorizationDetails].([]interface{})
+			return ok && len(details) == 1
+		}), mock.Anything, mock.Anything).Return("jwt-token", int64(3600), nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *AuthAssertExecutorTestSuite) TestExecute_WithUserAttributes() {
 	attrs := map[string]interface{}{"email": testEmail, "phone": "1234567890"}
 	attrsJSON, _ := json.Marshal(attrs)
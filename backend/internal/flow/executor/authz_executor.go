@@ -34,6 +34,9 @@ const (
 	authzLoggerComponentName = "AuthorizationExecutor"
 	authorizedPermissionsKey = "authorized_permissions"
 	requestedPermissionsKey  = "requested_permissions"
+	// authzContextKeyApplicationID is the access context attribute holding the ID of the application
+	// the flow is executed for.
+	authzContextKeyApplicationID = "applicationId"
 )
 
 // authorizationExecutor implements the ExecutorInterface for performing authorization checks
@@ -117,6 +120,12 @@ func (a *authorizationExecutor) Execute(ctx *core.NodeContext) (*common.Executor
 		EntityID:             userID,
 		GroupIDs:             groupIDs,
 		RequestedPermissions: requestedPerms,
+		Context: &authzsvc.AccessContext{
+			ACR: ctx.RuntimeData[common.RuntimeKeySelectedAuthClass],
+			Attributes: map[string]interface{}{
+				authzContextKeyApplicationID: ctx.Application.ID,
+			},
+		},
 	}
 
 	authzResp, svcErr := a.authzService.GetAuthorizedPermissions(ctx.Context, authzReq)
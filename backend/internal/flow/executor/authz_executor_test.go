@@ -92,7 +92,8 @@ func TestAuthorizationExecutor_Execute_Success(t *testing.T) {
 			},
 		},
 		RuntimeData: map[string]string{
-			requestedPermissionsKey: "read:documents write:documents delete:documents",
+			requestedPermissionsKey:            "read:documents write:documents delete:documents",
+			common.RuntimeKeySelectedAuthClass: "mfa",
 		},
 	}
 
@@ -100,7 +101,8 @@ func TestAuthorizationExecutor_Execute_Success(t *testing.T) {
 	mockAuthzService.On("GetAuthorizedPermissions",
 		mock.Anything,
 		mock.MatchedBy(func(req authzsvc.GetAuthorizedPermissionsRequest) bool {
-			return req.EntityID == "user123" &&
+			return req.Context != nil && req.Context.ACR == "mfa" &&
+				req.EntityID == "user123" &&
 				len(req.GroupIDs) == 2 &&
 				req.GroupIDs[0] == "group1" &&
 				req.GroupIDs[1] == "group2" &&
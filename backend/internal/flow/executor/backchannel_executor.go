@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/url"
+
+	"github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/core"
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+// backchannelExecutor generates and verifies the approval link of a backchannel authentication request.
+// The approval token is issued by the backchannel authentication endpoint and seeded into the runtime
+// data when the flow is initiated, so this executor never generates one itself.
+type backchannelExecutor struct {
+	core.ExecutorInterface
+	logger *log.Logger
+}
+
+// newBackchannelExecutor creates a new instance of the backchannel executor.
+func newBackchannelExecutor(flowFactory core.FlowFactoryInterface) *backchannelExecutor {
+	defaultInputs := []common.Input{
+		{
+			Identifier: userInputBackchannelToken,
+			Type:       "HIDDEN",
+			Required:   true,
+		},
+	}
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "BackchannelExecutor"))
+	base := flowFactory.CreateExecutor(
+		ExecutorNameBackchannelExecutor,
+		common.ExecutorTypeUtility,
+		defaultInputs,
+		[]common.Input{},
+	)
+	return &backchannelExecutor{
+		ExecutorInterface: base,
+		logger:            logger,
+	}
+}
+
+// GetExecutionPolicy returns the execution policy for the given mode.
+// The verify mode skips challenge token validation because the approval token itself serves as the challenge.
+func (e *backchannelExecutor) GetExecutionPolicy(mode string) *core.ExecutionPolicy {
+	if mode == ExecutorModeVerify {
+		return &core.ExecutionPolicy{
+			SkipChallengeValidation: true,
+			AllowSegmentRestart:     true,
+		}
+	}
+	return nil
+}
+
+// Execute delegates to the appropriate mode handler based on the executor mode.
+func (e *backchannelExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	switch ctx.ExecutorMode {
+	case ExecutorModeGenerate:
+		return e.executeGenerate(ctx)
+	case ExecutorModeVerify:
+		return e.executeVerify(ctx)
+	default:
+		return nil, fmt.Errorf("invalid executor mode for BackchannelExecutor: %s", ctx.ExecutorMode)
+	}
+}
+
+// executeGenerate builds the approval link for the stored approval token and forwards it, along with
+// the binding message, as template data for the notification executors.
+func (e *backchannelExecutor) executeGenerate(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Executing backchannel executor in generate mode")
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+		ForwardedData:  make(map[string]interface{}),
+	}
+
+	approvalToken := ctx.RuntimeData[common.RuntimeKeyStoredBackchannelToken]
+	if approvalToken == "" {
+		logger.Debug("No backchannel token found in runtime data")
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = "Backchannel authentication request not found"
+		return execResp, nil
+	}
+
+	approvalLink := e.generateApprovalLink(ctx, approvalToken)
+	execResp.RuntimeData[common.RuntimeKeyBackchannelLink] = approvalLink
+
+	execResp.ForwardedData[common.ForwardedDataKeyTemplateData] = map[string]interface{}{
+		"appName":         ctx.Application.Name,
+		"backchannelLink": approvalLink,
+		"bindingMessage":  ctx.RuntimeData[common.RuntimeKeyBindingMessage],
+	}
+
+	execResp.Status = common.ExecComplete
+	return execResp, nil
+}
+
+// executeVerify validates the approval token submitted from the approval link against the stored token.
+func (e *backchannelExecutor) executeVerify(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Executing backchannel executor in verify mode")
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	if !e.HasRequiredInputs(ctx, execResp) {
+		execResp.Status = common.ExecUserInputRequired
+		return execResp, nil
+	}
+
+	tokenInput := ctx.UserInputs[userInputBackchannelToken]
+	storedToken := ctx.RuntimeData[common.RuntimeKeyStoredBackchannelToken]
+	if storedToken == "" || subtle.ConstantTimeCompare([]byte(tokenInput), []byte(storedToken)) != 1 {
+		logger.Debug("Backchannel token mismatch")
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = "Invalid backchannel token"
+		return execResp, nil
+	}
+
+	logger.Debug("Backchannel token validated successfully")
+	execResp.Status = common.ExecComplete
+	return execResp, nil
+}
+
+// generateApprovalLink constructs the approval link using the GateClient configuration.
+func (e *backchannelExecutor) generateApprovalLink(ctx *core.NodeContext, approvalToken string) string {
+	gateConfig := config.GetServerRuntime().Config.GateClient
+	gateAppURL := fmt.Sprintf("%s://%s:%d%s",
+		gateConfig.Scheme,
+		gateConfig.Hostname,
+		gateConfig.Port,
+		gateConfig.Path)
+	queryParams := url.Values{
+		"executionId":      []string{ctx.ExecutionID},
+		"backchannelToken": []string{approvalToken},
+	}
+
+	if ctx.EntityID != "" {
+		queryParams.Set(oauth2const.AppID, ctx.EntityID)
+	}
+
+	return fmt.Sprintf("%s/backchannel?%s", gateAppURL, queryParams.Encode())
+}
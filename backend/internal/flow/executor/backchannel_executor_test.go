@@ -0,0 +1,216 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	appmodel "github.com/asgardeo/thunder/internal/application/model"
+	"github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/core"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/tests/mocks/flow/coremock"
+)
+
+type BackchannelExecutorTestSuite struct {
+	suite.Suite
+	mockFlowFactory *coremock.FlowFactoryInterfaceMock
+	executor        *backchannelExecutor
+}
+
+func (suite *BackchannelExecutorTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime(".", &config.Config{
+		GateClient: config.GateClientConfig{
+			Scheme:   "https",
+			Hostname: "localhost",
+			Port:     5190,
+			Path:     "/gate",
+		},
+	})
+	suite.Require().NoError(err)
+
+	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
+	mockBaseExecutor := coremock.NewExecutorInterfaceMock(suite.T())
+
+	suite.mockFlowFactory.On("CreateExecutor",
+		ExecutorNameBackchannelExecutor,
+		common.ExecutorTypeUtility,
+		[]common.Input{
+			{
+				Identifier: userInputBackchannelToken,
+				Type:       "HIDDEN",
+				Required:   true,
+			},
+		},
+		[]common.Input{}).Return(mockBaseExecutor)
+
+	suite.executor = newBackchannelExecutor(suite.mockFlowFactory)
+}
+
+func (suite *BackchannelExecutorTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *BackchannelExecutorTestSuite) TestExecute_GenerateMode() {
+	ctx := &core.NodeContext{
+		ExecutionID:  "test-flow-id",
+		EntityID:     "test-app-id",
+		FlowType:     common.FlowTypeBackchannelAuthentication,
+		ExecutorMode: ExecutorModeGenerate,
+		Application:  appmodel.Application{Name: "Kiosk"},
+		UserInputs:   make(map[string]string),
+		RuntimeData: map[string]string{
+			common.RuntimeKeyStoredBackchannelToken: "approval-token",
+			common.RuntimeKeyBindingMessage:         "W4SCT",
+		},
+	}
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	link := resp.RuntimeData[common.RuntimeKeyBackchannelLink]
+	assert.Contains(suite.T(), link, "https://localhost:5190/gate/backchannel?")
+	assert.Contains(suite.T(), link, "backchannelToken=approval-token")
+	assert.Contains(suite.T(), link, "executionId=test-flow-id")
+	assert.Contains(suite.T(), link, "applicationId=test-app-id")
+
+	templateData, ok := resp.ForwardedData[common.ForwardedDataKeyTemplateData].(map[string]interface{})
+	suite.Require().True(ok)
+	assert.Equal(suite.T(), link, templateData["backchannelLink"])
+	assert.Equal(suite.T(), "W4SCT", templateData["bindingMessage"])
+	assert.Equal(suite.T(), "Kiosk", templateData["appName"])
+}
+
+func (suite *BackchannelExecutorTestSuite) TestExecute_GenerateMode_NoStoredToken() {
+	ctx := &core.NodeContext{
+		ExecutionID:  "test-flow-id",
+		ExecutorMode: ExecutorModeGenerate,
+		RuntimeData:  make(map[string]string),
+	}
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, resp.Status)
+	assert.Empty(suite.T(), resp.RuntimeData[common.RuntimeKeyBackchannelLink])
+}
+
+func (suite *BackchannelExecutorTestSuite) TestExecute_VerifyMode_NoTokenProvided() {
+	ctx := &core.NodeContext{
+		ExecutionID:  "test-flow-id",
+		ExecutorMode: ExecutorModeVerify,
+		UserInputs:   make(map[string]string),
+		RuntimeData: map[string]string{
+			common.RuntimeKeyStoredBackchannelToken: "stored-token",
+		},
+	}
+
+	mockExecutor := suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock)
+	mockExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(false)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, resp.Status)
+}
+
+func (suite *BackchannelExecutorTestSuite) TestExecute_VerifyMode_ValidationSuccess() {
+	ctx := &core.NodeContext{
+		ExecutionID:  "test-flow-id",
+		ExecutorMode: ExecutorModeVerify,
+		UserInputs: map[string]string{
+			userInputBackchannelToken: "valid-token",
+		},
+		RuntimeData: map[string]string{
+			common.RuntimeKeyStoredBackchannelToken: "valid-token",
+		},
+	}
+
+	mockExecutor := suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock)
+	mockExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(true)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+}
+
+func (suite *BackchannelExecutorTestSuite) TestExecute_VerifyMode_ValidationFailure() {
+	testCases := []struct {
+		name        string
+		runtimeData map[string]string
+	}{
+		{"Mismatch", map[string]string{common.RuntimeKeyStoredBackchannelToken: "correct-token"}},
+		{"NoStoredToken", map[string]string{}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := &core.NodeContext{
+				ExecutionID:  "test-flow-id",
+				ExecutorMode: ExecutorModeVerify,
+				UserInputs: map[string]string{
+					userInputBackchannelToken: "wrong-token",
+				},
+				RuntimeData: tc.runtimeData,
+			}
+
+			mockExecutor := suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock)
+			mockExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(true)
+
+			resp, err := suite.executor.Execute(ctx)
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), common.ExecFailure, resp.Status)
+			assert.Equal(suite.T(), "Invalid backchannel token", resp.FailureReason)
+		})
+	}
+}
+
+func (suite *BackchannelExecutorTestSuite) TestExecute_InvalidMode() {
+	ctx := &core.NodeContext{
+		ExecutionID:  "test-flow-id",
+		ExecutorMode: "invalid",
+	}
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), resp)
+	assert.Contains(suite.T(), err.Error(), "invalid executor mode for BackchannelExecutor")
+}
+
+func (suite *BackchannelExecutorTestSuite) TestGetExecutionPolicy() {
+	assert.Nil(suite.T(), suite.executor.GetExecutionPolicy(ExecutorModeGenerate))
+
+	policy := suite.executor.GetExecutionPolicy(ExecutorModeVerify)
+	suite.Require().NotNil(policy)
+	assert.True(suite.T(), policy.SkipChallengeValidation)
+	assert.True(suite.T(), policy.AllowSegmentRestart)
+}
+
+func TestBackchannelExecutorSuite(t *testing.T) {
+	suite.Run(t, new(BackchannelExecutorTestSuite))
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/asgardeo/thunder/internal/entityprovider"
 	"github.com/asgardeo/thunder/internal/flow/common"
 	"github.com/asgardeo/thunder/internal/flow/core"
+	"github.com/asgardeo/thunder/internal/passwordpolicy"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	"github.com/asgardeo/thunder/internal/system/log"
 )
@@ -40,6 +41,7 @@ type basicAuthExecutor struct {
 	identifyingExecutorInterface
 	entityProvider entityprovider.EntityProviderInterface
 	authnProvider  authnprovidermgr.AuthnProviderManagerInterface
+	passwordPolicy passwordpolicy.PasswordPolicyServiceInterface
 	logger         *log.Logger
 }
 
@@ -51,6 +53,7 @@ func newBasicAuthExecutor(
 	flowFactory core.FlowFactoryInterface,
 	entityProvider entityprovider.EntityProviderInterface,
 	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+	passwordPolicy passwordpolicy.PasswordPolicyServiceInterface,
 ) *basicAuthExecutor {
 	defaultInputs := []common.Input{
 		{
@@ -78,6 +81,7 @@ func newBasicAuthExecutor(
 		identifyingExecutorInterface: identifyExec,
 		entityProvider:               entityProvider,
 		authnProvider:                authnProvider,
+		passwordPolicy:               passwordPolicy,
 		logger:                       logger,
 	}
 }
@@ -143,6 +147,10 @@ func (b *basicAuthExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResp
 		return execResp, nil
 	}
 
+	if ctx.FlowType == common.FlowTypeAuthentication && !b.enforcePasswordExpiry(ctx, execResp, authenticatedUser) {
+		return execResp, nil
+	}
+
 	execResp.AuthenticatedUser = *authenticatedUser
 	execResp.Status = common.ExecComplete
 
@@ -164,6 +172,83 @@ func (b *basicAuthExecutor) getCredentialInputs(ctx *core.NodeContext) []common.
 	return credentials
 }
 
+// enforcePasswordExpiry forces the authenticated user to change an expired password before the
+// authentication completes. The user is prompted for a new password along with the current credentials,
+// and the password is changed once the new password satisfies the password policy. Returns false if
+// the authentication cannot complete yet, in which case the executor response is populated.
+func (b *basicAuthExecutor) enforcePasswordExpiry(ctx *core.NodeContext, execResp *common.ExecutorResponse,
+	authenticatedUser *authncm.AuthenticatedUser) bool {
+	if b.passwordPolicy == nil || !b.passwordPolicy.IsEnabled() {
+		return true
+	}
+	logger := b.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	subject := passwordpolicy.Subject{
+		EntityID:   authenticatedUser.UserID,
+		EntityType: authenticatedUser.UserType,
+		OUID:       authenticatedUser.OUID,
+	}
+	expired, svcErr := b.passwordPolicy.IsPasswordExpired(ctx.Context, subject)
+	if svcErr != nil {
+		logger.Error("Failed to check password expiry", log.String("errorCode", svcErr.Code))
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = "Failed to authenticate user: failed to check password expiry"
+		return false
+	}
+	if !expired {
+		return true
+	}
+
+	newPassword := ctx.UserInputs[userInputNewPassword]
+	delete(ctx.UserInputs, userInputNewPassword)
+	if newPassword == "" {
+		logger.Debug("Password has expired, requesting a new password",
+			log.MaskedString(log.LoggerKeyUserID, authenticatedUser.UserID))
+		b.requirePasswordChange(ctx, execResp, &passwordpolicy.ErrorPasswordExpired)
+		return false
+	}
+
+	credentialAttribute := b.passwordPolicy.GetCredentialAttribute()
+	if newPassword == ctx.UserInputs[credentialAttribute] {
+		b.requirePasswordChange(ctx, execResp, &passwordpolicy.ErrorPasswordReused)
+		return false
+	}
+	credentials, err := json.Marshal(map[string]string{credentialAttribute: newPassword})
+	if err != nil {
+		logger.Error("Failed to marshal the new password", log.Error(err))
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = "Failed to update the expired password"
+		return false
+	}
+	if epErr := b.entityProvider.UpdateCredentials(authenticatedUser.UserID, credentials); epErr != nil {
+		if epErr.Violation != nil {
+			b.requirePasswordChange(ctx, execResp, epErr.Violation)
+			return false
+		}
+		logger.Error("Failed to update the expired password", log.Error(epErr))
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = "Failed to update the expired password"
+		return false
+	}
+
+	logger.Debug("Expired password changed", log.MaskedString(log.LoggerKeyUserID, authenticatedUser.UserID))
+	return true
+}
+
+// requirePasswordChange prompts for the current credentials and a new password with the given reason.
+func (b *basicAuthExecutor) requirePasswordChange(ctx *core.NodeContext, execResp *common.ExecutorResponse,
+	reason *serviceerror.ServiceError) {
+	execResp.Status = common.ExecUserInputRequired
+	execResp.AuthUser = authnprovidermgr.AuthUser{}
+	execResp.Inputs = append(b.getCredentialInputs(ctx), common.Input{
+		Identifier: userInputNewPassword,
+		Type:       common.InputTypePassword,
+		Required:   true,
+	})
+	execResp.FailureReason = reason.ErrorDescription.DefaultValue
+	execResp.AdditionalData[common.DataFailureReasonKey] = reason.ErrorDescription.Key
+}
+
 // getAuthenticatedUser perform authentication based on the provided identifying and
 // credential attributes and returns the authenticated user details.
 func (b *basicAuthExecutor) getAuthenticatedUser(ctx *core.NodeContext,
@@ -225,6 +310,8 @@ func (b *basicAuthExecutor) getAuthenticatedUser(ctx *core.NodeContext,
 				execResp.FailureReason = failureReasonUserNotFound
 			case authnprovidermgr.ErrorAuthenticationFailed.Code:
 				execResp.FailureReason = failureReasonInvalidCredentials
+			case authnprovidermgr.ErrorAccountLocked.Code:
+				execResp.FailureReason = failureReasonAccountLocked
 			default:
 				execResp.FailureReason = "Failed to authenticate user: " + svcErr.ErrorDescription.DefaultValue
 			}
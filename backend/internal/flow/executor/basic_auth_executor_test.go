@@ -34,10 +34,12 @@ import (
 	"github.com/asgardeo/thunder/internal/entityprovider"
 	"github.com/asgardeo/thunder/internal/flow/common"
 	"github.com/asgardeo/thunder/internal/flow/core"
+	"github.com/asgardeo/thunder/internal/passwordpolicy"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	"github.com/asgardeo/thunder/tests/mocks/authnprovider/managermock"
 	"github.com/asgardeo/thunder/tests/mocks/entityprovidermock"
 	"github.com/asgardeo/thunder/tests/mocks/flow/coremock"
+	"github.com/asgardeo/thunder/tests/mocks/passwordpolicymock"
 )
 
 type BasicAuthExecutorTestSuite struct {
@@ -71,7 +73,8 @@ func (suite *BasicAuthExecutorTestSuite) SetupTest() {
 	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameBasicAuth, common.ExecutorTypeAuthentication,
 		defaultInputs, []common.Input{}).Return(mockExec)
 
-	suite.executor = newBasicAuthExecutor(suite.mockFlowFactory, suite.mockEntityProvider, suite.mockAuthnProvider)
+	suite.executor = newBasicAuthExecutor(suite.mockFlowFactory, suite.mockEntityProvider, suite.mockAuthnProvider,
+		nil)
 }
 
 func createMockIdentifyingExecutor(t *testing.T) core.ExecutorInterface {
@@ -644,6 +647,14 @@ func (suite *BasicAuthExecutorTestSuite) TestExecute_RetryableAuthenticationErro
 			expectedReason: failureReasonUserNotFound,
 			message:        "Should return specific failure reason for user not found",
 		},
+		{
+			name:           "Account locked",
+			username:       "testuser",
+			password:       "password123",
+			errorCode:      authnprovidermgr.ErrorAccountLocked.Code,
+			expectedReason: failureReasonAccountLocked,
+			message:        "Should return specific failure reason for a locked account",
+		},
 	}
 
 	for _, tt := range tests {
@@ -943,3 +954,104 @@ func (suite *BasicAuthExecutorTestSuite) TestExecute_PreResolvedUser_WithPasswor
 	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
 	assert.True(suite.T(), resp.AuthenticatedUser.IsAuthenticated)
 }
+
+func (suite *BasicAuthExecutorTestSuite) setupExpiredPasswordAuthentication(
+	userInputs map[string]string,
+) (*core.NodeContext, *passwordpolicymock.PasswordPolicyServiceInterfaceMock) {
+	mockPasswordPolicy := passwordpolicymock.NewPasswordPolicyServiceInterfaceMock(suite.T())
+	suite.executor.passwordPolicy = mockPasswordPolicy
+
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeAuthentication,
+		UserInputs:  userInputs,
+		RuntimeData: make(map[string]string),
+	}
+
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(authnprovidermgr.AuthUser{},
+		&authnprovidermgr.AuthnBasicResult{UserID: testUserID, UserType: "person", OUID: "ou-123"}, nil)
+	suite.mockEntityProvider.On("GetEntity", testUserID).Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeNotImplemented, "", ""))
+
+	subject := passwordpolicy.Subject{EntityID: testUserID, EntityType: "person", OUID: "ou-123"}
+	mockPasswordPolicy.On("IsEnabled").Return(true)
+	mockPasswordPolicy.On("IsPasswordExpired", mock.Anything, subject).Return(true, nil)
+	return ctx, mockPasswordPolicy
+}
+
+func (suite *BasicAuthExecutorTestSuite) TestExecute_PasswordExpired_RequestsNewPassword() {
+	ctx, _ := suite.setupExpiredPasswordAuthentication(map[string]string{
+		userAttributeUsername: "testuser",
+		userAttributePassword: "OldPassword1",
+	})
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecUserInputRequired, resp.Status)
+	suite.False(resp.AuthenticatedUser.IsAuthenticated)
+	suite.Equal(passwordpolicy.ErrorPasswordExpired.ErrorDescription.DefaultValue, resp.FailureReason)
+	suite.Equal(passwordpolicy.ErrorPasswordExpired.ErrorDescription.Key,
+		resp.AdditionalData[common.DataFailureReasonKey])
+	suite.Require().Len(resp.Inputs, 2)
+	suite.Equal(userAttributePassword, resp.Inputs[0].Identifier)
+	suite.Equal(userInputNewPassword, resp.Inputs[1].Identifier)
+}
+
+func (suite *BasicAuthExecutorTestSuite) TestExecute_PasswordExpired_ChangesPassword() {
+	ctx, mockPasswordPolicy := suite.setupExpiredPasswordAuthentication(map[string]string{
+		userAttributeUsername: "testuser",
+		userAttributePassword: "OldPassword1",
+		userInputNewPassword:  "NewPassword1",
+	})
+	mockPasswordPolicy.On("GetCredentialAttribute").Return(userAttributePassword)
+	suite.mockEntityProvider.On("UpdateCredentials", testUserID,
+		json.RawMessage(`{"password":"NewPassword1"}`)).Return(nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.True(resp.AuthenticatedUser.IsAuthenticated)
+	suite.NotContains(ctx.UserInputs, userInputNewPassword)
+	suite.mockEntityProvider.AssertExpectations(suite.T())
+}
+
+func (suite *BasicAuthExecutorTestSuite) TestExecute_PasswordExpired_PolicyViolation() {
+	ctx, mockPasswordPolicy := suite.setupExpiredPasswordAuthentication(map[string]string{
+		userAttributeUsername: "testuser",
+		userAttributePassword: "OldPassword1",
+		userInputNewPassword:  "short",
+	})
+	mockPasswordPolicy.On("GetCredentialAttribute").Return(userAttributePassword)
+	policyErr := entityprovider.NewEntityProviderError(entityprovider.ErrorCodePasswordPolicyFailed,
+		"Password policy violation", "password policy violation")
+	policyErr.Violation = &passwordpolicy.ErrorPasswordTooShort
+	suite.mockEntityProvider.On("UpdateCredentials", testUserID,
+		json.RawMessage(`{"password":"short"}`)).Return(policyErr)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecUserInputRequired, resp.Status)
+	suite.Equal(passwordpolicy.ErrorPasswordTooShort.ErrorDescription.DefaultValue, resp.FailureReason)
+	suite.Equal(passwordpolicy.ErrorPasswordTooShort.ErrorDescription.Key,
+		resp.AdditionalData[common.DataFailureReasonKey])
+	suite.False(resp.AuthenticatedUser.IsAuthenticated)
+}
+
+func (suite *BasicAuthExecutorTestSuite) TestExecute_PasswordExpired_SameAsCurrentPassword() {
+	ctx, mockPasswordPolicy := suite.setupExpiredPasswordAuthentication(map[string]string{
+		userAttributeUsername: "testuser",
+		userAttributePassword: "OldPassword1",
+		userInputNewPassword:  "OldPassword1",
+	})
+	mockPasswordPolicy.On("GetCredentialAttribute").Return(userAttributePassword)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecUserInputRequired, resp.Status)
+	suite.Equal(passwordpolicy.ErrorPasswordReused.ErrorDescription.DefaultValue, resp.FailureReason)
+}
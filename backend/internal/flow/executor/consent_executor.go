@@ -143,12 +143,19 @@ func (e *consentExecutor) checkConsent(ctx *core.NodeContext, execResp *common.E
 		return nil, errors.New("failed to resolve consent")
 	}
 
+	// Authorization details of a rich authorization request are approved on every request,
+	// since they describe a specific transaction rather than a standing consent.
+	requestedDetails := ctx.RuntimeData[common.RuntimeKeyRequestedAuthorizationDetails]
+
 	// All consents are active — nothing to prompt
-	if promptData == nil {
+	if promptData == nil && requestedDetails == "" {
 		logger.Debug("All required consents are active; completing consent executor")
 		execResp.Status = common.ExecComplete
 		return execResp, nil
 	}
+	if promptData == nil {
+		promptData = &consentauthn.ConsentPromptData{Purposes: []consentauthn.ConsentPurposePrompt{}}
+	}
 
 	// Consent is needed — forward prompt data to the prompt node via ForwardedData
 	promptJSON, err := json.Marshal(promptData.Purposes)
@@ -160,6 +167,16 @@ func (e *consentExecutor) checkConsent(ctx *core.NodeContext, execResp *common.E
 	execResp.ForwardedData[common.ForwardedDataKeyConsentPrompt] = promptData.Purposes
 	execResp.AdditionalData[common.DataConsentPrompt] = string(promptJSON)
 
+	if requestedDetails != "" {
+		var details []interface{}
+		if err := json.Unmarshal([]byte(requestedDetails), &details); err != nil {
+			logger.Error("Failed to parse requested authorization details", log.Error(err))
+			return nil, errors.New("failed to prepare consent prompt data")
+		}
+		execResp.ForwardedData[common.ForwardedDataKeyAuthorizationDetailsPrompt] = details
+		execResp.AdditionalData[common.DataAuthorizationDetailsPrompt] = requestedDetails
+	}
+
 	// Store the session token in RuntimeData for validation during consent recording
 	if promptData.SessionToken != "" {
 		execResp.RuntimeData[common.RuntimeKeyConsentSessionToken] = promptData.SessionToken
@@ -209,6 +226,10 @@ func (e *consentExecutor) handleConsentDecisions(ctx *core.NodeContext, execResp
 		return execResp, nil
 	}
 
+	if ctx.UserInputs[userInputAuthorizationDetailsApproved] == dataValueTrue {
+		decisions.AuthorizationDetailsApproved = true
+	}
+
 	// Check if the consent prompt has timed out
 	if expiresAtStr, ok := ctx.RuntimeData[common.RuntimeKeyStepTimeout]; ok && expiresAtStr != "" {
 		if expiresAt, err := strconv.ParseInt(expiresAtStr, 10, 64); err == nil {
@@ -229,6 +250,13 @@ func (e *consentExecutor) handleConsentDecisions(ctx *core.NodeContext, execResp
 
 	// Retrieve the consent session token from RuntimeData for server-side validation
 	sessionToken := ctx.RuntimeData[common.RuntimeKeyConsentSessionToken]
+	requestedDetails := ctx.RuntimeData[common.RuntimeKeyRequestedAuthorizationDetails]
+
+	// The prompt carried only authorization details when no consent session was started,
+	// hence there are no attribute consent decisions to record.
+	if sessionToken == "" && requestedDetails != "" {
+		return e.handleAuthorizationDetailsDecision(ctx, execResp, &decisions, requestedDetails)
+	}
 
 	// Always record consent decisions (including denials) for audit/compliance purposes.
 	// The session token is used to verify completeness and enforce essential attribute rules
@@ -266,6 +294,30 @@ func (e *consentExecutor) handleConsentDecisions(ctx *core.NodeContext, execResp
 	execResp.RuntimeData[common.RuntimeKeyConsentedAttributes] = strings.Join(consentedAttrs, " ")
 
 	logger.Debug("Consent recorded successfully", log.String("consentID", consentRecord.ID))
+	if requestedDetails != "" {
+		return e.handleAuthorizationDetailsDecision(ctx, execResp, &decisions, requestedDetails)
+	}
+
+	execResp.Status = common.ExecComplete
+	return execResp, nil
+}
+
+// handleAuthorizationDetailsDecision processes the user's decision on the requested authorization details.
+// The details are authorized as a whole; denying them fails the flow.
+func (e *consentExecutor) handleAuthorizationDetailsDecision(ctx *core.NodeContext,
+	execResp *common.ExecutorResponse, decisions *consentauthn.ConsentDecisions,
+	requestedDetails string) (*common.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	if !decisions.AuthorizationDetailsApproved {
+		logger.Debug("User denied the requested authorization details")
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = failureReasonConsentDenied
+		return execResp, nil
+	}
+
+	logger.Debug("User approved the requested authorization details")
+	execResp.RuntimeData[common.RuntimeKeyAuthorizedAuthorizationDetails] = requestedDetails
 	execResp.Status = common.ExecComplete
 	return execResp, nil
 }
@@ -931,6 +931,97 @@ func (suite *ConsentExecutorTestSuite) TestExecute_HasInputs_NoConsentedElements
 		"Consented attributes should be empty when no elements are approved")
 }
 
+// ----- Execute: authorization details tests -----
+
+const testAuthorizationDetails = `[{"type":"payment_initiation","amount":"50.00"}]`
+
+func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_AuthorizationDetailsPrompted() {
+	ctx := buildConsentNodeContext()
+	ctx.RuntimeData[common.RuntimeKeyRequestedAuthorizationDetails] = testAuthorizationDetails
+
+	suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock).
+		On("ValidatePrerequisites", ctx, mock.AnythingOfType("*common.ExecutorResponse")).Return(true)
+	suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock).
+		On("HasRequiredInputs", ctx, mock.AnythingOfType("*common.ExecutorResponse")).Return(false)
+
+	// All attribute consents are active, but the authorization details still need approval
+	suite.mockConsentEnforcer.On("ResolveConsent", mock.Anything, "default", "app-123", "user-123",
+		[]string{}, []string{"email", "phone"}, mock.Anything).
+		Return(nil, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, resp.Status)
+	assert.Equal(suite.T(), "[]", resp.AdditionalData[common.DataConsentPrompt])
+	assert.Equal(suite.T(), testAuthorizationDetails, resp.AdditionalData[common.DataAuthorizationDetailsPrompt])
+	assert.Len(suite.T(), resp.ForwardedData[common.ForwardedDataKeyAuthorizationDetailsPrompt], 1)
+	assert.NotContains(suite.T(), resp.RuntimeData, common.RuntimeKeyConsentSessionToken)
+}
+
+func (suite *ConsentExecutorTestSuite) TestExecute_HasInputs_AuthorizationDetailsApproved() {
+	ctx := buildConsentNodeContext()
+	ctx.RuntimeData[common.RuntimeKeyRequestedAuthorizationDetails] = testAuthorizationDetails
+	ctx.UserInputs[userInputConsentDecisions] = `{"purposes":[],"authorizationDetailsApproved":true}`
+
+	suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock).
+		On("ValidatePrerequisites", ctx, mock.AnythingOfType("*common.ExecutorResponse")).Return(true)
+	suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock).
+		On("HasRequiredInputs", ctx, mock.AnythingOfType("*common.ExecutorResponse")).Return(true)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), testAuthorizationDetails,
+		resp.RuntimeData[common.RuntimeKeyAuthorizedAuthorizationDetails])
+	suite.mockConsentEnforcer.AssertNotCalled(suite.T(), "RecordConsent",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ConsentExecutorTestSuite) TestExecute_HasInputs_AuthorizationDetailsApprovedInput() {
+	ctx := buildConsentNodeContext()
+	ctx.RuntimeData[common.RuntimeKeyRequestedAuthorizationDetails] = testAuthorizationDetails
+	ctx.UserInputs[userInputConsentDecisions] = `{"purposes":[]}`
+	ctx.UserInputs[userInputAuthorizationDetailsApproved] = dataValueTrue
+
+	suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock).
+		On("ValidatePrerequisites", ctx, mock.AnythingOfType("*common.ExecutorResponse")).Return(true)
+	suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock).
+		On("HasRequiredInputs", ctx, mock.AnythingOfType("*common.ExecutorResponse")).Return(true)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), testAuthorizationDetails,
+		resp.RuntimeData[common.RuntimeKeyAuthorizedAuthorizationDetails])
+}
+
+func (suite *ConsentExecutorTestSuite) TestExecute_HasInputs_AuthorizationDetailsDenied() {
+	ctx := buildConsentNodeContext()
+	ctx.RuntimeData[common.RuntimeKeyRequestedAuthorizationDetails] = testAuthorizationDetails
+	ctx.RuntimeData[common.RuntimeKeyConsentSessionToken] = "session-token"
+	ctx.UserInputs[userInputConsentDecisions] = `{"purposes":[],"authorizationDetailsApproved":false}`
+
+	suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock).
+		On("ValidatePrerequisites", ctx, mock.AnythingOfType("*common.ExecutorResponse")).Return(true)
+	suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock).
+		On("HasRequiredInputs", ctx, mock.AnythingOfType("*common.ExecutorResponse")).Return(true)
+
+	// Attribute consent decisions are still recorded before the authorization details are evaluated
+	suite.mockConsentEnforcer.On("RecordConsent", mock.Anything, "default", "app-123", "user-123",
+		mock.AnythingOfType("*consent.ConsentDecisions"), "session-token", int64(0)).
+		Return(&consent.Consent{ID: "consent-001"}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), failureReasonConsentDenied, resp.FailureReason)
+	assert.NotContains(suite.T(), resp.RuntimeData, common.RuntimeKeyAuthorizedAuthorizationDetails)
+}
+
 // ----- Execute: with augmented attributes tests -----
 
 func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_AugmentedAttributes_GroupsInjected() {
@@ -22,13 +22,16 @@ package executor
 const (
 	ExecutorNameBasicAuth     = "BasicAuthExecutor"
 	ExecutorNameSMSAuth       = "SMSOTPAuthExecutor"
+	ExecutorNameEmailOTPAuth  = "EmailOTPAuthExecutor"
 	ExecutorNameMagicLinkAuth = "MagicLinkAuthExecutor"
+	ExecutorNameTOTPAuth      = "TOTPAuthExecutor"
 	// nolint:gosec // G101: This is an executor name, not a credential
 	ExecutorNamePasskeyAuth                  = "PasskeyAuthExecutor"
 	ExecutorNameOAuth                        = "OAuthExecutor"
 	ExecutorNameOIDCAuth                     = "OIDCAuthExecutor"
 	ExecutorNameGitHubAuth                   = "GithubOAuthExecutor"
 	ExecutorNameGoogleAuth                   = "GoogleOIDCAuthExecutor"
+	ExecutorNameSAMLAuth                     = "SAMLAuthExecutor"
 	ExecutorNameIdentifying                  = "IdentifyingExecutor"
 	ExecutorNameAuthAssert                   = "AuthAssertExecutor"
 	ExecutorNameProvisioning                 = "ProvisioningExecutor"
@@ -41,11 +44,13 @@ const (
 	ExecutorNameInviteExecutor               = "InviteExecutor"
 	ExecutorNameEmailExecutor                = "EmailExecutor"
 	ExecutorNameCredentialSetter             = "CredentialSetter"
+	ExecutorNameTOTPEnrollment               = "TOTPEnrollmentExecutor"
 	ExecutorNameConsent                      = "ConsentExecutor"
 	ExecutorNameOUResolver                   = "OUResolverExecutor"
 	ExecutorNameAttributeUniquenessValidator = "AttributeUniquenessValidator"
 	ExecutorNameSMSExecutor                  = "SMSExecutor"
 	ExecutorNameFederatedAuthResolver        = "FederatedAuthResolverExecutor"
+	ExecutorNameBackchannelExecutor          = "BackchannelExecutor"
 )
 
 // Executor mode constants
@@ -66,17 +71,24 @@ const (
 	userAttributeGroups   = "groups"
 	userAttributeSub      = "sub"
 
-	userInputCode  = "code"
-	userInputNonce = "nonce"
-	userInputState = "state"
+	userInputCode         = "code"
+	userInputNonce        = "nonce"
+	userInputState        = "state"
+	userInputSAMLResponse = "samlResponse"
+	userInputNewPassword  = "newPassword"
 
 	userInputOuName           = "ouName"
 	userInputOuHandle         = "ouHandle"
 	userInputOuDesc           = "ouDescription"
 	userInputInviteToken      = "inviteToken"
+	userInputBackchannelToken = "backchannelToken"
 	userInputOTP              = "otp"
+	userInputTOTP             = "totp"
 	userInputMagicLinkToken   = "token"
 	userInputConsentDecisions = "consent_decisions"
+	// userInputAuthorizationDetailsApproved carries the decision on the requested authorization details when
+	// the consent UI submits it alongside the consent decisions rather than within them.
+	userInputAuthorizationDetailsApproved = "authorizationDetailsApproved"
 
 	ouIDKey        = "ouId"
 	defaultOUIDKey = "defaultOUID"
@@ -103,7 +115,7 @@ const (
 )
 
 // nonSearchableInputs contains the list of user inputs/ attributes that are non-searchable.
-var nonSearchableInputs = []string{"password", "code", "nonce", "otp", "token", "userInputMagicLinkToken"}
+var nonSearchableInputs = []string{"password", "code", "nonce", "otp", "totp", "token", "userInputMagicLinkToken"}
 
 // Failure reason constants
 const (
@@ -112,5 +124,7 @@ const (
 	failureReasonInvalidCredentials   = "Invalid credentials provided" // #nosec G101
 	failureReasonFailedToIdentifyUser = "Failed to identify user"
 	failureReasonInvalidOTP           = "invalid OTP provided"
+	failureReasonInvalidTOTP          = "Invalid authenticator code provided"
 	failureReasonInvalidMagicLink     = "Invalid magic link token"
+	failureReasonAccountLocked        = "Account is temporarily locked due to too many failed login attempts"
 )
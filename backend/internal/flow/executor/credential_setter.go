@@ -136,6 +136,15 @@ func (e *credentialSetter) Execute(ctx *core.NodeContext) (*common.ExecutorRespo
 
 	// Update user credentials
 	svcErr := e.entityProvider.UpdateCredentials(userID, credentials)
+	if svcErr != nil && svcErr.Violation != nil {
+		logger.Debug("Password does not satisfy the password policy", log.String("code", svcErr.Violation.Code))
+		delete(ctx.UserInputs, credentialKey)
+		execResp.Status = common.ExecUserInputRequired
+		execResp.Inputs = requiredInputs
+		execResp.FailureReason = svcErr.Violation.ErrorDescription.DefaultValue
+		execResp.AdditionalData[common.DataFailureReasonKey] = svcErr.Violation.ErrorDescription.Key
+		return execResp, nil
+	}
 	if svcErr != nil {
 		logger.Debug("Failed to update user credentials", log.MaskedString(log.LoggerKeyUserID, userID))
 		execResp.Status = common.ExecFailure
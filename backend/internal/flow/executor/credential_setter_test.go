@@ -28,6 +28,7 @@ import (
 	"github.com/asgardeo/thunder/internal/entityprovider"
 	"github.com/asgardeo/thunder/internal/flow/common"
 	"github.com/asgardeo/thunder/internal/flow/core"
+	"github.com/asgardeo/thunder/internal/passwordpolicy"
 	"github.com/asgardeo/thunder/tests/mocks/entityprovidermock"
 	"github.com/asgardeo/thunder/tests/mocks/flow/coremock"
 )
@@ -280,3 +281,38 @@ func (suite *CredentialSetterTestSuite) TestExecute_EmptyInputIdentifier() {
 func TestCredentialSetterSuite(t *testing.T) {
 	suite.Run(t, new(CredentialSetterTestSuite))
 }
+
+func (suite *CredentialSetterTestSuite) TestExecute_PasswordPolicyViolation() {
+	ctx := &core.NodeContext{
+		ExecutionID: "test-flow",
+		UserInputs: map[string]string{
+			userAttributePassword: "password",
+		},
+		RuntimeData: map[string]string{
+			"userID": testUserID,
+		},
+	}
+	suite.mockBaseExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockBaseExecutor.On("GetRequiredInputs", ctx).Return([]common.Input{
+		{
+			Identifier: userAttributePassword,
+			Type:       common.InputTypePassword,
+			Required:   true,
+		},
+	})
+	policyErr := entityprovider.NewEntityProviderError(entityprovider.ErrorCodePasswordPolicyFailed,
+		"Password policy violation", "password policy violation")
+	policyErr.Violation = &passwordpolicy.ErrorPasswordBreached
+	suite.mockEntityProvider.On("UpdateCredentials", testUserID, mock.Anything).Return(policyErr)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, resp.Status)
+	assert.Equal(suite.T(), passwordpolicy.ErrorPasswordBreached.ErrorDescription.DefaultValue, resp.FailureReason)
+	assert.Equal(suite.T(), passwordpolicy.ErrorPasswordBreached.ErrorDescription.Key,
+		resp.AdditionalData[common.DataFailureReasonKey])
+	assert.NotContains(suite.T(), ctx.UserInputs, userAttributePassword)
+}
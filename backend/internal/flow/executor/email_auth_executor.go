@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"github.com/asgardeo/thunder/internal/authn/otp"
+	authnprovidermgr "github.com/asgardeo/thunder/internal/authnprovider/manager"
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/core"
+	notifcommon "github.com/asgardeo/thunder/internal/notification/common"
+)
+
+// Runtime data keys used by the email OTP executor. They are kept apart from the SMS OTP keys so that
+// both executors can be used in the same flow.
+const (
+	runtimeKeyEmailOTPSessionToken = "emailOTPSessionToken"
+	runtimeKeyEmailOTPAttemptCount = "emailOTPAttemptCount"
+)
+
+// emailAddressInput is the default input definition for email address collection.
+var emailAddressInput = common.Input{
+	Ref:        "email_input",
+	Identifier: common.AttributeEmail,
+	Type:       common.InputTypeEmail,
+	Required:   true,
+}
+
+// emailOTPChannel holds the email specific settings of the OTP authentication executor.
+var emailOTPChannel = otpChannel{
+	executorName:           ExecutorNameEmailOTPAuth,
+	name:                   "email",
+	channelType:            notifcommon.ChannelTypeEmail,
+	addressName:            "email address",
+	addressLabel:           "Email address",
+	defaultInput:           emailAddressInput,
+	runtimeKeyAddress:      common.RuntimeKeyEmailOTPEmail,
+	runtimeKeyAddressAttr:  common.RuntimeKeyEmailOTPEmailAttr,
+	runtimeKeySessionToken: runtimeKeyEmailOTPSessionToken,
+	runtimeKeyAttemptCount: runtimeKeyEmailOTPAttemptCount,
+}
+
+// emailOTPAuthExecutor implements the ExecutorInterface for email OTP authentication.
+type emailOTPAuthExecutor struct {
+	*otpAuthExecutor
+}
+
+var _ core.ExecutorInterface = (*emailOTPAuthExecutor)(nil)
+var _ identifyingExecutorInterface = (*emailOTPAuthExecutor)(nil)
+
+// newEmailOTPAuthExecutor creates a new instance of EmailOTPAuthExecutor.
+func newEmailOTPAuthExecutor(
+	flowFactory core.FlowFactoryInterface,
+	otpService otp.OTPAuthnServiceInterface,
+	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+) *emailOTPAuthExecutor {
+	return &emailOTPAuthExecutor{
+		otpAuthExecutor: newOTPAuthExecutor(emailOTPChannel, flowFactory, otpService, authnProvider, entityProvider),
+	}
+}
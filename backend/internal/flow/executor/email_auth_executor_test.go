@@ -0,0 +1,314 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	authncm "github.com/asgardeo/thunder/internal/authn/common"
+	"github.com/asgardeo/thunder/internal/authn/otp"
+	authnprovidermgr "github.com/asgardeo/thunder/internal/authnprovider/manager"
+	"github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/core"
+	notifcommon "github.com/asgardeo/thunder/internal/notification/common"
+	"github.com/asgardeo/thunder/tests/mocks/authn/otpmock"
+	"github.com/asgardeo/thunder/tests/mocks/authnprovider/managermock"
+	"github.com/asgardeo/thunder/tests/mocks/entityprovidermock"
+	"github.com/asgardeo/thunder/tests/mocks/flow/coremock"
+)
+
+type EmailOTPAuthExecutorTestSuite struct {
+	suite.Suite
+	mockOTPService     *otpmock.OTPAuthnServiceInterfaceMock
+	mockAuthnProvider  *managermock.AuthnProviderManagerInterfaceMock
+	mockFlowFactory    *coremock.FlowFactoryInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	executor           *emailOTPAuthExecutor
+}
+
+func TestEmailOTPAuthExecutorSuite(t *testing.T) {
+	suite.Run(t, new(EmailOTPAuthExecutorTestSuite))
+}
+
+func (suite *EmailOTPAuthExecutorTestSuite) SetupTest() {
+	suite.mockOTPService = otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
+	suite.mockAuthnProvider = managermock.NewAuthnProviderManagerInterfaceMock(suite.T())
+	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+
+	defaultInputs := []common.Input{
+		{
+			Ref:        "otp_input",
+			Identifier: userInputOTP,
+			Type:       common.InputTypeOTP,
+			Required:   true,
+		},
+	}
+	identifyingMock := createMockIdentifyingExecutor(suite.T())
+	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameIdentifying, common.ExecutorTypeUtility,
+		mock.Anything, mock.Anything).Return(identifyingMock).Maybe()
+
+	mockExec := coremock.NewExecutorInterfaceMock(suite.T())
+	mockExec.On("GetName").Return(ExecutorNameEmailOTPAuth).Maybe()
+	mockExec.On("GetType").Return(common.ExecutorTypeAuthentication).Maybe()
+	mockExec.On("GetDefaultInputs").Return(defaultInputs).Maybe()
+	mockExec.On("GetRequiredInputs", mock.Anything).Return(defaultInputs).Maybe()
+	mockExec.On("GetPrerequisites").Return([]common.Input{}).Maybe()
+
+	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameEmailOTPAuth, common.ExecutorTypeAuthentication,
+		defaultInputs, []common.Input(nil)).Return(mockExec)
+
+	suite.executor = newEmailOTPAuthExecutor(suite.mockFlowFactory,
+		suite.mockOTPService, suite.mockAuthnProvider, suite.mockEntityProvider)
+	suite.executor.ExecutorInterface = mockExec
+}
+
+func (suite *EmailOTPAuthExecutorTestSuite) TestExecute_InvalidMode() {
+	ctx := &core.NodeContext{
+		ExecutionID:  "flow-123",
+		ExecutorMode: "invalid",
+	}
+
+	_, err := suite.executor.Execute(ctx)
+
+	assert.Error(suite.T(), err)
+}
+
+func (suite *EmailOTPAuthExecutorTestSuite) TestValidatePrerequisites_RegistrationFlow_PromptsEmail() {
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeRegistration,
+		UserInputs:  make(map[string]string),
+		RuntimeData: make(map[string]string),
+	}
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	result := suite.executor.ValidatePrerequisites(ctx, execResp)
+
+	assert.False(suite.T(), result)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, execResp.Status)
+	assert.Len(suite.T(), execResp.Inputs, 1)
+	assert.Equal(suite.T(), common.AttributeEmail, execResp.Inputs[0].Identifier)
+	assert.Equal(suite.T(), common.InputTypeEmail, execResp.Inputs[0].Type)
+}
+
+func (suite *EmailOTPAuthExecutorTestSuite) TestValidatePrerequisites_CustomEmailAttr() {
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeRegistration,
+		NodeInputs: []common.Input{
+			{Ref: "work_email_input", Identifier: "workEmail", Type: common.InputTypeEmail, Required: true},
+		},
+		UserInputs:  map[string]string{"workEmail": "user@example.com"},
+		RuntimeData: make(map[string]string),
+	}
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	assert.True(suite.T(), suite.executor.ValidatePrerequisites(ctx, execResp))
+}
+
+func (suite *EmailOTPAuthExecutorTestSuite) TestInitiateOTP_AuthenticationFlow_SendsEmailOTP() {
+	userID := "user-123"
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{
+		common.AttributeEmail: "user@example.com",
+	}).Return(&userID, nil)
+	suite.mockOTPService.On("SendOTP", mock.Anything, "", notifcommon.ChannelTypeEmail, "user@example.com").
+		Return("session-token", nil).Once()
+
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeAuthentication,
+		UserInputs: map[string]string{
+			common.AttributeEmail: "user@example.com",
+		},
+		RuntimeData:       make(map[string]string),
+		AuthenticatedUser: authncm.AuthenticatedUser{IsAuthenticated: false},
+	}
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	err := suite.executor.InitiateOTP(ctx, execResp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, execResp.Status)
+	assert.Equal(suite.T(), userID, execResp.RuntimeData[userAttributeUserID])
+	assert.Equal(suite.T(), "session-token", execResp.RuntimeData[runtimeKeyEmailOTPSessionToken])
+	assert.Equal(suite.T(), "1", execResp.RuntimeData[runtimeKeyEmailOTPAttemptCount])
+	assert.Equal(suite.T(), "user@example.com", execResp.RuntimeData[common.RuntimeKeyEmailOTPEmail])
+	assert.Equal(suite.T(), common.AttributeEmail, execResp.RuntimeData[common.RuntimeKeyEmailOTPEmailAttr])
+}
+
+func (suite *EmailOTPAuthExecutorTestSuite) TestInitiateOTP_MaxAttemptsReached() {
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeRegistration,
+		UserInputs: map[string]string{
+			common.AttributeEmail: "user@example.com",
+		},
+		RuntimeData: map[string]string{
+			runtimeKeyEmailOTPAttemptCount: "3",
+		},
+		AuthenticatedUser: authncm.AuthenticatedUser{IsAuthenticated: false},
+	}
+	suite.mockEntityProvider.On("IdentifyEntity", mock.Anything).Return(nil, nil)
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	err := suite.executor.InitiateOTP(ctx, execResp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, execResp.Status)
+	suite.mockOTPService.AssertNotCalled(suite.T(), "SendOTP", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything)
+}
+
+func (suite *EmailOTPAuthExecutorTestSuite) TestInitiateOTP_RegistrationFlow_UserAlreadyExists() {
+	existingUserID := testExistingUser123ID
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{
+		common.AttributeEmail: "user@example.com",
+	}).Return(&existingUserID, nil)
+
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeRegistration,
+		UserInputs: map[string]string{
+			common.AttributeEmail: "user@example.com",
+		},
+		RuntimeData:       make(map[string]string),
+		AuthenticatedUser: authncm.AuthenticatedUser{IsAuthenticated: false},
+	}
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	err := suite.executor.InitiateOTP(ctx, execResp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, execResp.Status)
+	assert.Equal(suite.T(), "User already exists with the provided email address.", execResp.FailureReason)
+	assert.Len(suite.T(), execResp.Inputs, 1)
+	assert.Equal(suite.T(), common.InputTypeEmail, execResp.Inputs[0].Type)
+}
+
+func (suite *EmailOTPAuthExecutorTestSuite) TestGetAuthenticatedUser_MFA_AddsEmailToAttributes() {
+	suite.mockAuthnProvider.On("AuthenticateUser",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(authnprovidermgr.AuthUser{}, &authnprovidermgr.AuthnBasicResult{
+			UserID: "user-123", UserType: "INTERNAL", OUID: "ou-123",
+		}, nil)
+
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeAuthentication,
+		UserInputs: map[string]string{
+			userInputOTP: "123456",
+		},
+		RuntimeData: map[string]string{
+			common.RuntimeKeyEmailOTPEmail: "user@example.com",
+			runtimeKeyEmailOTPSessionToken: "test-session-token",
+		},
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			IsAuthenticated: true,
+			UserID:          "user-123",
+		},
+	}
+	execResp := &common.ExecutorResponse{
+		RuntimeData: make(map[string]string),
+	}
+
+	result, err := suite.executor.getAuthenticatedUser(ctx, execResp)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), "user@example.com", result.Attributes[common.AttributeEmail])
+	assert.Empty(suite.T(), execResp.RuntimeData[runtimeKeyEmailOTPSessionToken])
+}
+
+func (suite *EmailOTPAuthExecutorTestSuite) TestGetAuthenticatedUser_IncorrectOTP_PromptsAgain() {
+	suite.mockAuthnProvider.On("AuthenticateUser",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(authnprovidermgr.AuthUser{}, nil, &authnprovidermgr.ErrorAuthenticationFailed)
+
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeAuthentication,
+		UserInputs: map[string]string{
+			userInputOTP: "000000",
+		},
+		RuntimeData: map[string]string{
+			common.RuntimeKeyEmailOTPEmail: "user@example.com",
+			runtimeKeyEmailOTPSessionToken: "test-session-token",
+		},
+	}
+	execResp := &common.ExecutorResponse{
+		RuntimeData: make(map[string]string),
+	}
+
+	result, err := suite.executor.getAuthenticatedUser(ctx, execResp)
+
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, execResp.Status)
+	assert.Equal(suite.T(), failureReasonInvalidOTP, execResp.FailureReason)
+}
+
+func (suite *EmailOTPAuthExecutorTestSuite) TestInitiateOTP_SendThrottled() {
+	userID := "user-123"
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{
+		common.AttributeEmail: "user@example.com",
+	}).Return(&userID, nil)
+	suite.mockOTPService.On("SendOTP", mock.Anything, "", notifcommon.ChannelTypeEmail, "user@example.com").
+		Return("", &otp.ErrorOTPSendThrottled).Once()
+
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeAuthentication,
+		UserInputs: map[string]string{
+			common.AttributeEmail: "user@example.com",
+		},
+		RuntimeData:       make(map[string]string),
+		AuthenticatedUser: authncm.AuthenticatedUser{IsAuthenticated: false},
+	}
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	err := suite.executor.InitiateOTP(ctx, execResp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, execResp.Status)
+	assert.Equal(suite.T(), otp.ErrorOTPSendThrottled.ErrorDescription.DefaultValue, execResp.FailureReason)
+	assert.Empty(suite.T(), execResp.RuntimeData[runtimeKeyEmailOTPSessionToken])
+}
@@ -0,0 +1,737 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	authncm "github.com/asgardeo/thunder/internal/authn/common"
+	"github.com/asgardeo/thunder/internal/authn/otp"
+	authnprovidermgr "github.com/asgardeo/thunder/internal/authnprovider/manager"
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/core"
+	notifcommon "github.com/asgardeo/thunder/internal/notification/common"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+// otpChannel holds the channel specific settings of an OTP authentication executor.
+type otpChannel struct {
+	// executorName is the name the executor is registered with.
+	executorName string
+	// name is the channel name used in log messages.
+	name string
+	// channelType is the notification channel the OTP is sent through.
+	channelType notifcommon.ChannelType
+	// addressName and addressLabel describe the address in error messages and failure reasons.
+	addressName  string
+	addressLabel string
+	// defaultInput is the input used to collect the address when the node does not define one.
+	defaultInput common.Input
+	// runtimeKeyAddress and runtimeKeyAddressAttr hold the address and its attribute name after sending.
+	runtimeKeyAddress     string
+	runtimeKeyAddressAttr string
+	// runtimeKeySessionToken and runtimeKeyAttemptCount hold the OTP session state.
+	runtimeKeySessionToken string
+	runtimeKeyAttemptCount string
+	// fallbackAttributes are tried after the address and username when resolving the user.
+	fallbackAttributes []string
+	// senderRequired indicates whether the senderId node property must be configured.
+	senderRequired bool
+}
+
+// otpAuthExecutor implements OTP authentication over a notification channel.
+type otpAuthExecutor struct {
+	core.ExecutorInterface
+	identifyingExecutorInterface
+	channel        otpChannel
+	entityProvider entityprovider.EntityProviderInterface
+	otpService     otp.OTPAuthnServiceInterface
+	authnProvider  authnprovidermgr.AuthnProviderManagerInterface
+	logger         *log.Logger
+}
+
+var _ core.ExecutorInterface = (*otpAuthExecutor)(nil)
+var _ identifyingExecutorInterface = (*otpAuthExecutor)(nil)
+
+// newOTPAuthExecutor creates a new instance of otpAuthExecutor for the given channel.
+func newOTPAuthExecutor(
+	channel otpChannel,
+	flowFactory core.FlowFactoryInterface,
+	otpService otp.OTPAuthnServiceInterface,
+	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+) *otpAuthExecutor {
+	defaultInputs := []common.Input{
+		{
+			Ref:        "otp_input",
+			Identifier: userInputOTP,
+			Type:       common.InputTypeOTP,
+			Required:   true,
+		},
+	}
+	var prerequisites []common.Input
+
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, channel.executorName),
+		log.String(log.LoggerKeyExecutorName, channel.executorName))
+
+	identifyExec := newIdentifyingExecutor(channel.executorName, defaultInputs, prerequisites,
+		flowFactory, entityProvider)
+	base := flowFactory.CreateExecutor(channel.executorName, common.ExecutorTypeAuthentication,
+		defaultInputs, prerequisites)
+
+	return &otpAuthExecutor{
+		ExecutorInterface:            base,
+		identifyingExecutorInterface: identifyExec,
+		channel:                      channel,
+		entityProvider:               entityProvider,
+		otpService:                   otpService,
+		authnProvider:                authnProvider,
+		logger:                       logger,
+	}
+}
+
+// Execute executes the OTP authentication logic.
+func (s *otpAuthExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Executing OTP authentication executor", log.String("channel", s.channel.name))
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	// Determine the executor mode
+	switch ctx.ExecutorMode {
+	case ExecutorModeSend:
+		if !s.ValidatePrerequisites(ctx, execResp) {
+			logger.Debug("Prerequisites not met for OTP authentication executor")
+			return execResp, nil
+		}
+		return s.executeSend(ctx, execResp)
+	case ExecutorModeVerify:
+		return s.executeVerify(ctx, execResp)
+	default:
+		return execResp, fmt.Errorf("invalid executor mode: %s", ctx.ExecutorMode)
+	}
+}
+
+// executeSend executes the OTP sending step.
+func (s *otpAuthExecutor) executeSend(ctx *core.NodeContext,
+	execResp *common.ExecutorResponse) (*common.ExecutorResponse, error) {
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	err := s.InitiateOTP(ctx, execResp)
+	if err != nil {
+		return execResp, err
+	}
+
+	logger.Debug("OTP send completed", log.String("status", string(execResp.Status)))
+
+	return execResp, nil
+}
+
+// executeVerify executes the OTP verification step.
+func (s *otpAuthExecutor) executeVerify(ctx *core.NodeContext,
+	execResp *common.ExecutorResponse) (*common.ExecutorResponse, error) {
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	if !s.HasRequiredInputs(ctx, execResp) {
+		logger.Debug("Required inputs for OTP verification are not provided")
+		execResp.Status = common.ExecUserInputRequired
+		return execResp, nil
+	}
+
+	err := s.ProcessAuthFlowResponse(ctx, execResp)
+	if err != nil {
+		return execResp, err
+	}
+
+	logger.Debug("OTP verify completed",
+		log.String("status", string(execResp.Status)),
+		log.Bool("isAuthenticated", execResp.AuthenticatedUser.IsAuthenticated))
+
+	return execResp, nil
+}
+
+// InitiateOTP initiates the OTP sending process to the user's address on the executor's channel.
+func (s *otpAuthExecutor) InitiateOTP(ctx *core.NodeContext,
+	execResp *common.ExecutorResponse) error {
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Sending OTP to user", log.String("channel", s.channel.name))
+
+	addressAttr := s.resolveAddressInput(ctx).Identifier
+	address, err := s.getUserAddressFromContext(ctx, addressAttr)
+	if err != nil {
+		return err
+	}
+
+	var userID *string
+	if ctx.AuthenticatedUser.IsAuthenticated {
+		userIDVal := s.GetUserIDFromContext(ctx)
+		if userIDVal == "" {
+			return errors.New("user ID is empty in the context")
+		}
+		userID = &userIDVal
+	} else {
+		// Identify user by the address if not authenticated
+		if address == "" {
+			logger.Error("Address is empty in the context", log.String("addressAttr", addressAttr))
+		}
+
+		filter := map[string]interface{}{addressAttr: address}
+		userID, err = s.IdentifyUser(filter, execResp)
+		if err != nil {
+			logger.Error("Failed to identify user", log.Error(err))
+			return fmt.Errorf("failed to identify user: %w", err)
+		}
+	}
+
+	// Handle registration flows.
+	if ctx.FlowType == common.FlowTypeRegistration {
+		if execResp.Status == common.ExecFailure && execResp.FailureReason != failureReasonUserNotFound {
+			logger.Error("Failed to identify user during registration flow", log.Error(err))
+			return fmt.Errorf("failed to identify user during registration flow: %w", err)
+		}
+
+		if userID != nil && *userID != "" {
+			// At this point, a unique user is found in the system.
+			// Prompt the user to provide a different address.
+			execResp.Status = common.ExecUserInputRequired
+			execResp.Inputs = []common.Input{s.resolveAddressInput(ctx)}
+			execResp.FailureReason = "User already exists with the provided " + s.channel.addressName + "."
+			return nil
+		}
+
+		execResp.Status = ""
+		execResp.FailureReason = ""
+	} else {
+		if execResp.Status == common.ExecFailure {
+			return nil
+		}
+		execResp.RuntimeData[userAttributeUserID] = *userID
+	}
+
+	// Send the OTP to the user's address.
+	if err := s.generateAndSendOTP(address, ctx, execResp, logger); err != nil {
+		logger.Error("Failed to send OTP", log.Error(err))
+		return fmt.Errorf("failed to send OTP: %w", err)
+	}
+	if execResp.Status == common.ExecFailure {
+		return nil
+	}
+
+	logger.Debug("OTP sent successfully", log.String("channel", s.channel.name))
+	execResp.RuntimeData[s.channel.runtimeKeyAddress] = address
+	execResp.RuntimeData[s.channel.runtimeKeyAddressAttr] = addressAttr
+	execResp.Status = common.ExecComplete
+
+	return nil
+}
+
+// ProcessAuthFlowResponse processes the authentication flow response for OTP.
+func (s *otpAuthExecutor) ProcessAuthFlowResponse(ctx *core.NodeContext,
+	execResp *common.ExecutorResponse) error {
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Processing authentication flow response for OTP")
+
+	authenticatedUser, err := s.getAuthenticatedUser(ctx, execResp)
+	if err != nil {
+		logger.Error("Failed to get authenticated user details", log.Error(err))
+		return fmt.Errorf("failed to get authenticated user details: %w", err)
+	}
+	if execResp.Status == common.ExecFailure || execResp.Status == common.ExecUserInputRequired {
+		return nil
+	}
+
+	execResp.AuthenticatedUser = *authenticatedUser
+	execResp.Status = common.ExecComplete
+
+	return nil
+}
+
+// ValidatePrerequisites validates whether the prerequisites for the OTP executor are met.
+func (s *otpAuthExecutor) ValidatePrerequisites(ctx *core.NodeContext,
+	execResp *common.ExecutorResponse) bool {
+	if s.isAddressPrerequisiteMet(ctx) {
+		return true
+	}
+
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	if ctx.FlowType == common.FlowTypeRegistration {
+		logger.Debug("Prerequisites not met for registration flow, prompting for address")
+		execResp.Status = common.ExecUserInputRequired
+		execResp.Inputs = []common.Input{s.resolveAddressInput(ctx)}
+		return false
+	}
+
+	logger.Debug("Trying to satisfy prerequisites for OTP authentication executor")
+
+	s.satisfyPrerequisites(ctx, execResp)
+	if execResp.Status == common.ExecFailure {
+		return false
+	}
+
+	return s.isAddressPrerequisiteMet(ctx)
+}
+
+// isAddressPrerequisiteMet checks whether the resolved address attribute is present in the context.
+func (s *otpAuthExecutor) isAddressPrerequisiteMet(ctx *core.NodeContext) bool {
+	addressAttr := s.resolveAddressInput(ctx).Identifier
+	if val, ok := ctx.UserInputs[addressAttr]; ok && val != "" {
+		return true
+	}
+	if val, ok := ctx.RuntimeData[addressAttr]; ok && val != "" {
+		return true
+	}
+	if val, ok := ctx.ForwardedData[addressAttr]; ok {
+		if strVal, isString := val.(string); isString && strVal != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveAddressInput returns the node input matching the channel's default input type,
+// falling back to the channel's default input if none is found.
+func (s *otpAuthExecutor) resolveAddressInput(ctx *core.NodeContext) common.Input {
+	for _, input := range ctx.NodeInputs {
+		if input.Type == s.channel.defaultInput.Type {
+			return input
+		}
+	}
+	return s.channel.defaultInput
+}
+
+// getUserAddressFromContext retrieves the user's address from the context.
+func (s *otpAuthExecutor) getUserAddressFromContext(ctx *core.NodeContext, addressAttr string) (string, error) {
+	address := ctx.RuntimeData[addressAttr]
+
+	if address == "" {
+		address = ctx.UserInputs[addressAttr]
+	}
+
+	if address == "" && ctx.AuthenticatedUser.Attributes != nil {
+		if attrVal, ok := ctx.AuthenticatedUser.Attributes[addressAttr]; ok {
+			if attrStr, valid := attrVal.(string); valid && attrStr != "" {
+				address = attrStr
+			}
+		}
+	}
+
+	if address == "" {
+		return "", errors.New(s.channel.addressName + " not found in context")
+	}
+	return address, nil
+}
+
+// satisfyPrerequisites tries to satisfy the prerequisites for the OTP executor.
+func (s *otpAuthExecutor) satisfyPrerequisites(ctx *core.NodeContext,
+	execResp *common.ExecutorResponse) {
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	execResp.Status = ""
+	execResp.FailureReason = ""
+
+	logger.Debug("Trying to resolve user ID from context data")
+	userIDResolved, err := s.resolveUserID(ctx)
+	if err != nil {
+		logger.Error("Failed to resolve user ID from context data", log.Error(err))
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = "Failed to resolve user ID from context data"
+		return
+	}
+	if !userIDResolved {
+		logger.Debug("User ID could not be resolved from context data")
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = "User ID could not be resolved from context data"
+		return
+	}
+	userID := ctx.RuntimeData[userAttributeUserID]
+
+	// TODO: If the address is not found, but the user is authenticated, this method will
+	//  prompt the user to enter their address.
+	//  We should verify whether this is the expected behavior.
+
+	logger.Debug("Retrieving address from user ID", log.MaskedString(log.LoggerKeyUserID, userID))
+	address, err := s.getUserAddress(userID, ctx, execResp)
+	if err != nil {
+		logger.Error("Failed to retrieve address", log.MaskedString(log.LoggerKeyUserID, userID), log.Error(err))
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = "Failed to retrieve " + s.channel.addressName
+		return
+	}
+	if execResp.Status == common.ExecFailure {
+		return
+	}
+
+	logger.Debug("Address retrieved successfully", log.MaskedString(log.LoggerKeyUserID, userID))
+	ctx.RuntimeData[s.resolveAddressInput(ctx).Identifier] = address
+
+	// Reset the executor response status and failure reason.
+	execResp.Status = ""
+	execResp.FailureReason = ""
+}
+
+// resolveUserID resolves the user ID from the context based on various attributes.
+// TODO: Move to a separate resolver when the support is added.
+func (s *otpAuthExecutor) resolveUserID(ctx *core.NodeContext) (bool, error) {
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	// First, check if the user ID is already available in the context.
+	userID := s.GetUserIDFromContext(ctx)
+	if userID != "" {
+		logger.Debug("User ID found in context data", log.MaskedString(log.LoggerKeyUserID, userID))
+		if ctx.RuntimeData == nil {
+			ctx.RuntimeData = make(map[string]string)
+		}
+		ctx.RuntimeData[userAttributeUserID] = userID
+
+		return true, nil
+	}
+
+	userIDResolved := false
+
+	// Try to resolve user ID from the address first.
+	addressAttr := s.resolveAddressInput(ctx).Identifier
+	userIDResolved, err := s.resolveUserIDFromAttribute(ctx, addressAttr, logger)
+	if err != nil {
+		return false, err
+	}
+	if userIDResolved {
+		return true, nil
+	}
+
+	// Try to resolve user ID from username next.
+	userIDResolved, err = s.resolveUserIDFromAttribute(ctx, userAttributeUsername, logger)
+	if err != nil {
+		return false, err
+	}
+	if userIDResolved {
+		return true, nil
+	}
+
+	// Try to resolve user ID from the channel specific fallback attributes.
+	for _, attr := range s.channel.fallbackAttributes {
+		userIDResolved, err = s.resolveUserIDFromAttribute(ctx, attr, logger)
+		if err != nil {
+			return false, err
+		}
+		if userIDResolved {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// resolveUserIDFromAttribute attempts to resolve the user ID from a specific attribute in the context.
+func (s *otpAuthExecutor) resolveUserIDFromAttribute(ctx *core.NodeContext,
+	attributeName string, logger *log.Logger) (bool, error) {
+	logger.Debug("Resolving user ID from attribute", log.String("attributeName", attributeName))
+
+	attributeValue := ctx.UserInputs[attributeName]
+	if attributeValue == "" {
+		attributeValue = ctx.RuntimeData[attributeName]
+	}
+	if attributeValue != "" {
+		filters := map[string]interface{}{attributeName: attributeValue}
+		userID, providerErr := s.entityProvider.IdentifyEntity(filters)
+		if providerErr != nil {
+			return false, fmt.Errorf("failed to identify user by %s: %s", attributeName, providerErr.Error())
+		}
+		if userID != nil && *userID != "" {
+			logger.Debug("User ID resolved from attribute", log.String("attributeName", attributeName),
+				log.MaskedString(log.LoggerKeyUserID, *userID))
+			if ctx.RuntimeData == nil {
+				ctx.RuntimeData = make(map[string]string)
+			}
+			ctx.RuntimeData[userAttributeUserID] = *userID
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// getUserAddress retrieves the address for the given user ID.
+func (s *otpAuthExecutor) getUserAddress(userID string, ctx *core.NodeContext,
+	execResp *common.ExecutorResponse) (string, error) {
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID),
+		log.MaskedString(log.LoggerKeyUserID, userID))
+	logger.Debug("Retrieving user address")
+
+	// Try to get the address from context
+	addressAttr := s.resolveAddressInput(ctx).Identifier
+	address, err := s.getUserAddressFromContext(ctx, addressAttr)
+	if err == nil && address != "" {
+		logger.Debug("Address found in context, skipping user store call")
+		return address, nil
+	}
+
+	// Address not in context, fetch from user store
+	logger.Debug("Address not in context, fetching from user store")
+	user, providerErr := s.entityProvider.GetEntity(userID)
+	if providerErr != nil {
+		return "", fmt.Errorf("failed to retrieve user details: %s", providerErr.Error())
+	}
+
+	// Extract the address from user attributes
+	attrs := make(map[string]interface{})
+	if len(user.Attributes) > 0 {
+		if err := json.Unmarshal(user.Attributes, &attrs); err != nil {
+			return "", fmt.Errorf("failed to unmarshal user attributes: %w", err)
+		}
+	}
+
+	address = ""
+	if attrStr, ok := attrs[addressAttr].(string); ok && attrStr != "" {
+		address = attrStr
+	}
+
+	if address == "" {
+		logger.Debug("Address not found in user attributes or context")
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = s.channel.addressLabel + " not found in user attributes or context"
+		return "", nil
+	}
+
+	return address, nil
+}
+
+// generateAndSendOTP generates an OTP and sends it to the user's address.
+func (s *otpAuthExecutor) generateAndSendOTP(address string, ctx *core.NodeContext,
+	execResp *common.ExecutorResponse, logger *log.Logger) error {
+	attemptCount, err := s.validateAttempts(ctx, execResp, logger)
+	if err != nil {
+		return fmt.Errorf("failed to validate OTP attempts: %w", err)
+	}
+	if execResp.Status == common.ExecFailure {
+		return nil
+	}
+
+	// Get the message sender id from node properties.
+	if s.channel.senderRequired && len(ctx.NodeProperties) == 0 {
+		return errors.New("message sender id is not configured in node properties")
+	}
+
+	senderID := ""
+	if senderIDVal, ok := ctx.NodeProperties[propertyKeyNotificationSenderID]; ok {
+		if sid, valid := senderIDVal.(string); valid && sid != "" {
+			senderID = sid
+		}
+	}
+	if s.channel.senderRequired && senderID == "" {
+		return errors.New("senderId is not configured in node properties")
+	}
+
+	// Send the OTP
+	sessionToken, svcErr := s.otpService.SendOTP(ctx.Context, senderID, s.channel.channelType, address)
+	if svcErr != nil {
+		if svcErr.Code == otp.ErrorOTPSendThrottled.Code {
+			logger.Debug("OTP send throttled for the recipient")
+			execResp.Status = common.ExecFailure
+			execResp.FailureReason = svcErr.ErrorDescription.DefaultValue
+			return nil
+		}
+		return fmt.Errorf("failed to send OTP: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+
+	// Store runtime data
+	if execResp.RuntimeData == nil {
+		execResp.RuntimeData = make(map[string]string)
+	}
+	execResp.RuntimeData[s.channel.runtimeKeySessionToken] = sessionToken
+	execResp.RuntimeData[s.channel.runtimeKeyAttemptCount] = strconv.Itoa(attemptCount + 1)
+
+	return nil
+}
+
+// validateAttempts checks if the maximum number of OTP attempts has been reached.
+func (s *otpAuthExecutor) validateAttempts(ctx *core.NodeContext, execResp *common.ExecutorResponse,
+	logger *log.Logger) (int, error) {
+	userID := ctx.RuntimeData[userAttributeUserID]
+	attemptCount := 0
+
+	attemptCountStr := ctx.RuntimeData[s.channel.runtimeKeyAttemptCount]
+	if attemptCountStr != "" {
+		count, err := strconv.Atoi(attemptCountStr)
+		if err != nil {
+			logger.Error("Failed to parse attempt count", log.Error(err))
+			return 0, fmt.Errorf("failed to parse attempt count: %w", err)
+		}
+		attemptCount = count
+	}
+
+	if attemptCount >= s.getOTPMaxAttempts() {
+		logger.Debug("Maximum OTP attempts reached", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Int("attemptCount", attemptCount))
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = fmt.Sprintf("maximum OTP attempts reached: %d", attemptCount)
+		return 0, nil
+	}
+
+	return attemptCount, nil
+}
+
+// getOTPMaxAttempts returns the maximum number of attempts allowed for OTP validation.
+func (s *otpAuthExecutor) getOTPMaxAttempts() int {
+	// TODO: This needs to be configured as a IDP property.
+	return 3
+}
+
+// getAuthenticatedUser returns the authenticated user details for the given user ID.
+func (s *otpAuthExecutor) getAuthenticatedUser(ctx *core.NodeContext,
+	execResp *common.ExecutorResponse) (*authncm.AuthenticatedUser, error) {
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	addressAttr := ctx.RuntimeData[s.channel.runtimeKeyAddressAttr]
+	if addressAttr == "" {
+		addressAttr = s.resolveAddressInput(ctx).Identifier
+	}
+	address := ctx.RuntimeData[s.channel.runtimeKeyAddress]
+	if address == "" {
+		return nil, errors.New(s.channel.addressName + " not found in context")
+	}
+
+	userID := ctx.RuntimeData[userAttributeUserID]
+
+	logger.Debug("Validating OTP", log.MaskedString(log.LoggerKeyUserID, userID))
+
+	providedOTP := ctx.UserInputs[userInputOTP]
+	if providedOTP == "" {
+		logger.Debug("Provided OTP is empty", log.MaskedString(log.LoggerKeyUserID, userID))
+		execResp.Status = common.ExecUserInputRequired
+		execResp.Inputs = s.GetRequiredInputs(ctx)
+		execResp.FailureReason = failureReasonInvalidOTP
+		return nil, nil
+	}
+
+	sessionToken := ctx.RuntimeData[s.channel.runtimeKeySessionToken]
+	if sessionToken == "" {
+		logger.Error("No session token found for OTP validation", log.MaskedString(log.LoggerKeyUserID, userID))
+		return nil, fmt.Errorf("no session token found for OTP validation")
+	}
+
+	// Handle registration flows.
+	if ctx.FlowType == common.FlowTypeRegistration {
+		// For registration flows, we don't have a user in the system yet.
+		// So we just validate the OTP and return an authenticated user with the address as an attribute.
+		svcErr := s.otpService.VerifyOTP(ctx.Context, sessionToken, providedOTP)
+		if svcErr != nil {
+			if svcErr.Code == otp.ErrorIncorrectOTP.Code || svcErr.Code == otp.ErrorOTPAttemptsExceeded.Code {
+				logger.Debug("OTP verification failed", log.MaskedString(log.LoggerKeyUserID, userID))
+				execResp.Status = common.ExecUserInputRequired
+				execResp.Inputs = s.GetRequiredInputs(ctx)
+				execResp.FailureReason = failureReasonInvalidOTP
+				return nil, nil
+			}
+			logger.Error("Failed to verify OTP",
+				log.MaskedString(log.LoggerKeyUserID, userID), log.Any("serviceError", svcErr))
+			return nil, fmt.Errorf("failed to verify OTP: %s", svcErr.ErrorDescription.DefaultValue)
+		}
+
+		execResp.Status = common.ExecComplete
+		execResp.FailureReason = ""
+		return &authncm.AuthenticatedUser{
+			IsAuthenticated: false,
+			Attributes: map[string]interface{}{
+				addressAttr: address,
+			},
+		}, nil
+	}
+
+	creds := map[string]interface{}{
+		"otp": map[string]interface{}{
+			"sessionToken": sessionToken,
+			"otp":          providedOTP,
+		},
+	}
+	newAuthUser, authnResult, svcErr := s.authnProvider.AuthenticateUser(
+		ctx.Context, nil, creds, nil, nil, ctx.AuthUser)
+	if svcErr != nil {
+		if svcErr.Code == authnprovidermgr.ErrorAuthenticationFailed.Code {
+			logger.Debug("OTP verification failed", log.MaskedString(log.LoggerKeyUserID, userID))
+			execResp.Status = common.ExecUserInputRequired
+			execResp.Inputs = s.GetRequiredInputs(ctx)
+			execResp.FailureReason = failureReasonInvalidOTP
+			return nil, nil
+		}
+		if svcErr.Code == authnprovidermgr.ErrorAccountLocked.Code {
+			logger.Debug("OTP verification rejected as the account is locked",
+				log.MaskedString(log.LoggerKeyUserID, userID))
+			execResp.Status = common.ExecUserInputRequired
+			execResp.Inputs = s.GetRequiredInputs(ctx)
+			execResp.FailureReason = failureReasonAccountLocked
+			return nil, nil
+		}
+		logger.Error("Failed to verify OTP",
+			log.MaskedString(log.LoggerKeyUserID, userID), log.Any("serviceError", svcErr))
+		return nil, fmt.Errorf("failed to verify OTP: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+	execResp.AuthUser = newAuthUser
+
+	execResp.RuntimeData[s.channel.runtimeKeySessionToken] = ""
+	logger.Debug("OTP validated successfully", log.MaskedString(log.LoggerKeyUserID, userID))
+
+	// Check if user is already authenticated
+	if ctx.AuthenticatedUser.IsAuthenticated && ctx.AuthenticatedUser.UserID != "" {
+		if ctx.AuthenticatedUser.Attributes == nil {
+			ctx.AuthenticatedUser.Attributes = make(map[string]interface{})
+		}
+		ctx.AuthenticatedUser.Attributes[addressAttr] = address
+		return &ctx.AuthenticatedUser, nil
+	}
+
+	// User not available in context, try to retrieve the user and get the attributes
+	userID = authnResult.UserID
+
+	logger.Debug("Fetching user details from user store", log.MaskedString(log.LoggerKeyUserID, userID))
+
+	attrs := map[string]interface{}{}
+	user, err := s.entityProvider.GetEntity(userID)
+	if err != nil {
+		if err.Code != entityprovider.ErrorCodeNotImplemented {
+			logger.Error("Failed to get user attributes", log.Error(err))
+			return nil, errors.New("failed to get user attributes")
+		}
+		logger.Debug("User provider is not implemented. User attributes will be empty.")
+	}
+
+	if err == nil && user != nil {
+		if err := json.Unmarshal(user.Attributes, &attrs); err != nil {
+			logger.Error("Failed to unmarshal user attributes", log.Error(err))
+			return nil, errors.New("failed to unmarshal user attributes")
+		}
+	}
+
+	authenticatedUser := &authncm.AuthenticatedUser{
+		IsAuthenticated: true,
+		UserID:          user.ID,
+		OUID:            user.OUID,
+		UserType:        user.Type,
+		Attributes:      attrs,
+	}
+
+	return authenticatedUser, nil
+}
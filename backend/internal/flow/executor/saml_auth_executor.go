@@ -0,0 +1,288 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	authncm "github.com/asgardeo/thunder/internal/authn/common"
+	authnsaml "github.com/asgardeo/thunder/internal/authn/saml"
+	authnprovidermgr "github.com/asgardeo/thunder/internal/authnprovider/manager"
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/entitytype"
+	"github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/core"
+	"github.com/asgardeo/thunder/internal/idp"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/log"
+	systemutils "github.com/asgardeo/thunder/internal/system/utils"
+)
+
+const (
+	samlAuthLoggerComponentName = "SAMLAuthExecutor"
+)
+
+// samlNonUserAttributes contains the list of non-user claims derived from the SAML assertion.
+var samlNonUserAttributes = []string{
+	authnsaml.ClaimSub, authnsaml.ClaimInResponseTo, authnsaml.ClaimSessionIndex,
+}
+
+// samlAuthExecutor implements the executor for authenticating users with a SAML 2.0 identity provider.
+// The user is redirected to the identity provider with an AuthnRequest and the SAML response it posts
+// back is submitted to the flow as the samlResponse input.
+type samlAuthExecutor struct {
+	oAuthExecutorInterface
+	authService   authnsaml.SAMLAuthnServiceInterface
+	authnProvider authnprovidermgr.AuthnProviderManagerInterface
+	idpService    idp.IDPServiceInterface
+	logger        *log.Logger
+}
+
+var _ core.ExecutorInterface = (*samlAuthExecutor)(nil)
+
+// newSAMLAuthExecutor creates a new instance of SAMLAuthExecutor.
+func newSAMLAuthExecutor(
+	flowFactory core.FlowFactoryInterface,
+	idpService idp.IDPServiceInterface,
+	entityTypeService entitytype.EntityTypeServiceInterface,
+	authService authnsaml.SAMLAuthnServiceInterface,
+	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+) oAuthExecutorInterface {
+	defaultInputs := []common.Input{
+		{
+			Identifier: userInputSAMLResponse,
+			Type:       "string",
+			Required:   true,
+		},
+	}
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, samlAuthLoggerComponentName),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameSAMLAuth))
+
+	base := newOAuthExecutor(ExecutorNameSAMLAuth, defaultInputs, []common.Input{},
+		flowFactory, idpService, entityTypeService, nil, authnProvider, idp.IDPTypeSAML)
+
+	return &samlAuthExecutor{
+		oAuthExecutorInterface: base,
+		authService:            authService,
+		authnProvider:          authnProvider,
+		idpService:             idpService,
+		logger:                 logger,
+	}
+}
+
+// Execute executes the SAML authentication logic.
+func (s *samlAuthExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Executing SAML authentication executor")
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	if ctx.FlowType != common.FlowTypeAuthentication && ctx.FlowType != common.FlowTypeRegistration {
+		logger.Warn("Invalid flow type for SAML executor. Skipping execution")
+		execResp.Status = common.ExecComplete
+		return execResp, nil
+	}
+
+	if !s.HasRequiredInputs(ctx, execResp) {
+		logger.Debug("Required inputs for SAML authentication executor is not provided")
+		if err := s.BuildAuthorizeFlow(ctx, execResp); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := s.ProcessAuthFlowResponse(ctx, execResp); err != nil {
+			return nil, err
+		}
+	}
+
+	logger.Debug("SAML authentication executor execution completed",
+		log.String("status", string(execResp.Status)),
+		log.Bool("isAuthenticated", execResp.AuthenticatedUser.IsAuthenticated))
+
+	return execResp, nil
+}
+
+// HasRequiredInputs checks if the SAML response is provided in the context and appends any missing
+// inputs to the executor response. Returns true if the SAML response is found, otherwise false.
+func (s *samlAuthExecutor) HasRequiredInputs(ctx *core.NodeContext, execResp *common.ExecutorResponse) bool {
+	if samlResponse, ok := ctx.UserInputs[userInputSAMLResponse]; ok && samlResponse != "" {
+		return true
+	}
+
+	return s.oAuthExecutorInterface.HasRequiredInputs(ctx, execResp)
+}
+
+// BuildAuthorizeFlow constructs the redirection to the SAML identity provider for user authentication.
+// The ID of the authentication request is kept in the runtime data to correlate the response.
+func (s *samlAuthExecutor) BuildAuthorizeFlow(ctx *core.NodeContext, execResp *common.ExecutorResponse) error {
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Initiating SAML authentication flow")
+
+	idpID, err := s.GetIdpID(ctx)
+	if err != nil {
+		return err
+	}
+
+	redirectURL, requestID, svcErr := s.authService.BuildAuthnRequestURL(ctx.Context, idpID)
+	if svcErr != nil {
+		if svcErr.Type == serviceerror.ClientErrorType {
+			execResp.Status = common.ExecFailure
+			execResp.FailureReason = svcErr.ErrorDescription.DefaultValue
+			return nil
+		}
+
+		logger.Error("Failed to build SAML authentication request", log.String("errorCode", svcErr.Code),
+			log.String("errorDescription", svcErr.ErrorDescription.DefaultValue))
+		return errors.New("failed to build SAML authentication request")
+	}
+
+	identityProvider, svcErr := s.idpService.GetIdentityProvider(ctx.Context, idpID)
+	if svcErr != nil {
+		return fmt.Errorf("failed to get idp name: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+
+	execResp.Status = common.ExecExternalRedirection
+	execResp.RedirectURL = redirectURL
+	execResp.AdditionalData = map[string]string{
+		common.DataIDPName: identityProvider.Name,
+	}
+	if execResp.RuntimeData == nil {
+		execResp.RuntimeData = make(map[string]string)
+	}
+	execResp.RuntimeData[common.RuntimeKeySAMLRequestID] = requestID
+
+	return nil
+}
+
+// ProcessAuthFlowResponse processes the SAML response of the identity provider and authenticates the user.
+// Only responses to the authentication request sent in this flow are accepted.
+func (s *samlAuthExecutor) ProcessAuthFlowResponse(ctx *core.NodeContext,
+	execResp *common.ExecutorResponse) error {
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Processing SAML authentication response")
+
+	samlResponse, ok := ctx.UserInputs[userInputSAMLResponse]
+	if !ok || samlResponse == "" {
+		execResp.AuthenticatedUser = authncm.AuthenticatedUser{
+			IsAuthenticated: false,
+		}
+		return nil
+	}
+
+	idpID, err := s.GetIdpID(ctx)
+	if err != nil {
+		return err
+	}
+
+	credentials := map[string]interface{}{
+		"federated": &authncm.FederatedAuthCredential{
+			IDPID:   idpID,
+			IDPType: idp.IDPTypeSAML,
+			Code:    samlResponse,
+		},
+	}
+	newAuthUser, basicResult, svcErr := s.authnProvider.AuthenticateUser(
+		ctx.Context, nil, credentials, nil, nil, ctx.AuthUser)
+	if svcErr != nil {
+		if svcErr.Type == serviceerror.ClientErrorType {
+			execResp.Status = common.ExecFailure
+			execResp.FailureReason = svcErr.ErrorDescription.DefaultValue
+			return nil
+		}
+
+		logger.Error("SAML authentication failed", log.String("errorCode", svcErr.Code),
+			log.String("errorDescription", svcErr.ErrorDescription.DefaultValue))
+		return errors.New("SAML authentication failed")
+	}
+
+	if basicResult == nil {
+		logger.Error("authnProvider.AuthenticateUser returned nil result")
+		return errors.New("SAML authentication failed")
+	}
+
+	// Reject unsolicited responses and responses to requests of other flows.
+	expectedRequestID := ctx.RuntimeData[common.RuntimeKeySAMLRequestID]
+	inResponseTo, _ := basicResult.ExternalClaims[authnsaml.ClaimInResponseTo].(string)
+	if expectedRequestID == "" || inResponseTo != expectedRequestID {
+		logger.Debug("SAML response is not issued for the authentication request of the flow")
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = "SAML response does not match the authentication request"
+		return nil
+	}
+	delete(ctx.RuntimeData, common.RuntimeKeySAMLRequestID)
+
+	if basicResult.IsAmbiguousUser {
+		if execResp.RuntimeData == nil {
+			execResp.RuntimeData = make(map[string]string)
+		}
+		execResp.RuntimeData[common.RuntimeKeyUserAmbiguous] = dataValueTrue
+	}
+
+	var internalUser *entityprovider.Entity
+	if basicResult.IsExistingUser {
+		internalUser = &entityprovider.Entity{
+			ID:   basicResult.UserID,
+			OUID: basicResult.OUID,
+			Type: basicResult.UserType,
+		}
+	}
+
+	contextUser, err := s.ResolveContextUser(ctx, execResp, basicResult.ExternalSub, internalUser,
+		basicResult.IsAmbiguousUser)
+	if err != nil {
+		return err
+	}
+	if execResp.Status == common.ExecFailure {
+		return nil
+	}
+	if contextUser == nil {
+		logger.Error("Failed to resolve context user after SAML authentication")
+		return errors.New("unexpected error occurred while resolving user")
+	}
+
+	contextUser.Attributes = s.getContextUserAttributes(execResp, basicResult.ExternalClaims)
+	execResp.AuthenticatedUser = *contextUser
+	execResp.AuthUser = newAuthUser
+
+	return nil
+}
+
+// getContextUserAttributes extracts user-facing attributes from the claims of the SAML assertion.
+func (s *samlAuthExecutor) getContextUserAttributes(execResp *common.ExecutorResponse,
+	claims map[string]interface{}) map[string]interface{} {
+	userClaims := make(map[string]interface{})
+	for attr, val := range claims {
+		if !slices.Contains(samlNonUserAttributes, attr) {
+			userClaims[attr] = systemutils.ConvertInterfaceValueToString(val)
+		}
+	}
+
+	if email, ok := userClaims[userAttributeEmail].(string); ok && email != "" {
+		if execResp.RuntimeData == nil {
+			execResp.RuntimeData = make(map[string]string)
+		}
+		execResp.RuntimeData[userAttributeEmail] = email
+	}
+
+	return userClaims
+}
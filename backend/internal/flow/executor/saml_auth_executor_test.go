@@ -0,0 +1,235 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	authncm "github.com/asgardeo/thunder/internal/authn/common"
+	authnsaml "github.com/asgardeo/thunder/internal/authn/saml"
+	authnprovidermgr "github.com/asgardeo/thunder/internal/authnprovider/manager"
+	"github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/core"
+	"github.com/asgardeo/thunder/internal/idp"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/tests/mocks/authn/samlmock"
+	"github.com/asgardeo/thunder/tests/mocks/authnprovider/managermock"
+	"github.com/asgardeo/thunder/tests/mocks/entitytypemock"
+	"github.com/asgardeo/thunder/tests/mocks/flow/coremock"
+	"github.com/asgardeo/thunder/tests/mocks/idp/idpmock"
+)
+
+type SAMLAuthExecutorTestSuite struct {
+	suite.Suite
+	mockSAMLService   *samlmock.SAMLAuthnServiceInterfaceMock
+	mockIDPService    *idpmock.IDPServiceInterfaceMock
+	mockFlowFactory   *coremock.FlowFactoryInterfaceMock
+	mockAuthnProvider *managermock.AuthnProviderManagerInterfaceMock
+	executor          oAuthExecutorInterface
+}
+
+func TestSAMLAuthExecutorSuite(t *testing.T) {
+	suite.Run(t, new(SAMLAuthExecutorTestSuite))
+}
+
+func (suite *SAMLAuthExecutorTestSuite) SetupTest() {
+	suite.mockSAMLService = samlmock.NewSAMLAuthnServiceInterfaceMock(suite.T())
+	suite.mockIDPService = idpmock.NewIDPServiceInterfaceMock(suite.T())
+	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
+	suite.mockAuthnProvider = managermock.NewAuthnProviderManagerInterfaceMock(suite.T())
+
+	defaultInputs := []common.Input{{Identifier: userInputSAMLResponse, Type: "string", Required: true}}
+	mockExec := coremock.NewExecutorInterfaceMock(suite.T())
+	mockExec.On("HasRequiredInputs", mock.Anything, mock.Anything).Return(
+		func(ctx *core.NodeContext, execResp *common.ExecutorResponse) bool {
+			execResp.Inputs = defaultInputs
+			return false
+		}).Maybe()
+	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameSAMLAuth, common.ExecutorTypeAuthentication,
+		defaultInputs, []common.Input{}).Return(mockExec)
+
+	suite.executor = newSAMLAuthExecutor(suite.mockFlowFactory, suite.mockIDPService,
+		entitytypemock.NewEntityTypeServiceInterfaceMock(suite.T()), suite.mockSAMLService, suite.mockAuthnProvider)
+}
+
+func (suite *SAMLAuthExecutorTestSuite) newContext(samlResponse string) *core.NodeContext {
+	ctx := &core.NodeContext{
+		ExecutionID:    "flow-123",
+		FlowType:       common.FlowTypeAuthentication,
+		UserInputs:     map[string]string{},
+		RuntimeData:    map[string]string{common.RuntimeKeySAMLRequestID: "_request1"},
+		NodeProperties: map[string]interface{}{"idpId": "idp-123"},
+	}
+	if samlResponse != "" {
+		ctx.UserInputs[userInputSAMLResponse] = samlResponse
+	}
+	return ctx
+}
+
+func (suite *SAMLAuthExecutorTestSuite) expectAuthentication(result *authnprovidermgr.AuthnBasicResult,
+	svcErr *serviceerror.ServiceError) {
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(credentials map[string]interface{}) bool {
+			credential, ok := credentials["federated"].(*authncm.FederatedAuthCredential)
+			return ok && credential.IDPID == "idp-123" && credential.IDPType == idp.IDPTypeSAML &&
+				credential.Code == "encoded-response"
+		}), mock.Anything, mock.Anything, mock.Anything).
+		Return(authnprovidermgr.AuthUser{}, result, svcErr)
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_ResponseNotProvided_BuildsAuthnRequest() {
+	ctx := suite.newContext("")
+	suite.mockSAMLService.On("BuildAuthnRequestURL", mock.Anything, "idp-123").
+		Return("https://idp.example.com/sso?SAMLRequest=abc", "_request2", nil)
+	suite.mockIDPService.On("GetIdentityProvider", mock.Anything, "idp-123").
+		Return(&idp.IDPDTO{ID: "idp-123", Name: "Corporate IdP"}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecExternalRedirection, resp.Status)
+	suite.Equal("https://idp.example.com/sso?SAMLRequest=abc", resp.RedirectURL)
+	suite.Equal("Corporate IdP", resp.AdditionalData[common.DataIDPName])
+	suite.Equal("_request2", resp.RuntimeData[common.RuntimeKeySAMLRequestID])
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_BuildAuthnRequestClientError() {
+	suite.mockSAMLService.On("BuildAuthnRequestURL", mock.Anything, "idp-123").
+		Return("", "", &authnsaml.ErrorInvalidIDP)
+
+	resp, err := suite.executor.Execute(suite.newContext(""))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal(authnsaml.ErrorInvalidIDP.ErrorDescription.DefaultValue, resp.FailureReason)
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_BuildAuthnRequestServerError() {
+	suite.mockSAMLService.On("BuildAuthnRequestURL", mock.Anything, "idp-123").
+		Return("", "", &serviceerror.InternalServerError)
+
+	resp, err := suite.executor.Execute(suite.newContext(""))
+
+	suite.Error(err)
+	suite.Nil(resp)
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_ResponseProvided_AuthenticatesUser() {
+	ctx := suite.newContext("encoded-response")
+	suite.expectAuthentication(&authnprovidermgr.AuthnBasicResult{
+		ExternalSub: "alice",
+		ExternalClaims: map[string]interface{}{
+			authnsaml.ClaimSub:          "alice",
+			authnsaml.ClaimInResponseTo: "_request1",
+			authnsaml.ClaimSessionIndex: "_session1",
+			"email":                     "alice@example.com",
+			"groups":                    []string{"a", "b"},
+		},
+		IsExistingUser: true,
+		UserID:         "user-123",
+		OUID:           "ou-123",
+		UserType:       "INTERNAL",
+	}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.True(resp.AuthenticatedUser.IsAuthenticated)
+	suite.Equal("user-123", resp.AuthenticatedUser.UserID)
+	suite.Equal("alice@example.com", resp.AuthenticatedUser.Attributes["email"])
+	suite.NotContains(resp.AuthenticatedUser.Attributes, authnsaml.ClaimInResponseTo)
+	suite.NotContains(resp.AuthenticatedUser.Attributes, authnsaml.ClaimSessionIndex)
+	suite.Equal("alice@example.com", resp.RuntimeData[userAttributeEmail])
+	suite.NotContains(ctx.RuntimeData, common.RuntimeKeySAMLRequestID)
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_UnsolicitedResponse() {
+	testCases := []struct {
+		name         string
+		inResponseTo interface{}
+	}{
+		{"missing InResponseTo", nil},
+		{"other request", "_other"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			claims := map[string]interface{}{authnsaml.ClaimSub: "alice"}
+			if tc.inResponseTo != nil {
+				claims[authnsaml.ClaimInResponseTo] = tc.inResponseTo
+			}
+			suite.expectAuthentication(&authnprovidermgr.AuthnBasicResult{
+				ExternalSub: "alice", ExternalClaims: claims, IsExistingUser: true, UserID: "user-123",
+			}, nil)
+
+			resp, err := suite.executor.Execute(suite.newContext("encoded-response"))
+
+			suite.NoError(err)
+			suite.Equal(common.ExecFailure, resp.Status)
+			suite.False(resp.AuthenticatedUser.IsAuthenticated)
+		})
+	}
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_AuthenticationClientError() {
+	suite.expectAuthentication(nil, &authnsaml.ErrorInvalidSAMLSignature)
+
+	resp, err := suite.executor.Execute(suite.newContext("encoded-response"))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal(authnsaml.ErrorInvalidSAMLSignature.ErrorDescription.DefaultValue, resp.FailureReason)
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_AuthenticationServerError() {
+	suite.expectAuthentication(nil, &serviceerror.InternalServerError)
+
+	resp, err := suite.executor.Execute(suite.newContext("encoded-response"))
+
+	suite.Error(err)
+	suite.Nil(resp)
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_UserNotFound() {
+	suite.expectAuthentication(&authnprovidermgr.AuthnBasicResult{
+		ExternalSub:    "unknown",
+		ExternalClaims: map[string]interface{}{authnsaml.ClaimInResponseTo: "_request1"},
+	}, nil)
+
+	resp, err := suite.executor.Execute(suite.newContext("encoded-response"))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal(failureReasonUserNotFound, resp.FailureReason)
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_NonAuthenticationFlowSkipped() {
+	ctx := suite.newContext("")
+	ctx.FlowType = common.FlowTypeUserOnboarding
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+}
@@ -325,7 +325,7 @@ func (suite *SMSAuthExecutorTestSuite) TestGetUserMobileNumber_NotFoundInAttribu
 
 	suite.mockEntityProvider.On("GetEntity", "user-123").Return(userFromStore, nil)
 
-	mobileNumber, err := suite.executor.getUserMobileNumber("user-123", ctx, execResp)
+	mobileNumber, err := suite.executor.getUserAddress("user-123", ctx, execResp)
 
 	assert.NoError(suite.T(), err)
 	assert.Empty(suite.T(), mobileNumber)
@@ -406,7 +406,7 @@ func (suite *SMSAuthExecutorTestSuite) TestGetUserMobileNumber_NonStringAttribut
 
 	suite.mockEntityProvider.On("GetEntity", "user-123").Return(userFromStore, nil)
 
-	mobileNumber, err := suite.executor.getUserMobileNumber("user-123", ctx, execResp)
+	mobileNumber, err := suite.executor.getUserAddress("user-123", ctx, execResp)
 
 	assert.NoError(suite.T(), err)
 	assert.Empty(suite.T(), mobileNumber)
@@ -121,8 +121,10 @@ func (e *smsExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse,
 	scenario := template.ScenarioType(tmplStr)
 
 	templateData := template.TemplateData{
-		"appName":    ctx.Application.Name,
-		"inviteLink": ctx.RuntimeData[common.RuntimeKeyInviteLink],
+		"appName":         ctx.Application.Name,
+		"inviteLink":      ctx.RuntimeData[common.RuntimeKeyInviteLink],
+		"backchannelLink": ctx.RuntimeData[common.RuntimeKeyBackchannelLink],
+		"bindingMessage":  ctx.RuntimeData[common.RuntimeKeyBindingMessage],
 	}
 
 	rendered, svcErr := e.templateService.Render(ctx.Context, scenario, template.TemplateTypeSMS, templateData)
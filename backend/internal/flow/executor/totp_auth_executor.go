@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"errors"
+	"fmt"
+
+	authncm "github.com/asgardeo/thunder/internal/authn/common"
+	"github.com/asgardeo/thunder/internal/authn/totp"
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/core"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+// totpAuthExecutor implements the ExecutorInterface for authenticator app (TOTP) authentication.
+// It verifies a code from the authenticator app, or a recovery code, of an already identified user.
+type totpAuthExecutor struct {
+	core.ExecutorInterface
+	totpService    totp.TOTPServiceInterface
+	entityProvider entityprovider.EntityProviderInterface
+	logger         *log.Logger
+}
+
+var _ core.ExecutorInterface = (*totpAuthExecutor)(nil)
+
+// newTOTPAuthExecutor creates a new instance of TOTPAuthExecutor.
+func newTOTPAuthExecutor(
+	flowFactory core.FlowFactoryInterface,
+	totpService totp.TOTPServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+) *totpAuthExecutor {
+	defaultInputs := []common.Input{
+		{
+			Ref:        "totp_input",
+			Identifier: userInputTOTP,
+			Type:       common.InputTypeOTP,
+			Required:   true,
+		},
+	}
+	prerequisites := []common.Input{
+		{
+			Identifier: userAttributeUserID,
+			Type:       "string",
+			Required:   true,
+		},
+	}
+
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "TOTPAuthExecutor"),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameTOTPAuth))
+
+	base := flowFactory.CreateExecutor(ExecutorNameTOTPAuth, common.ExecutorTypeAuthentication,
+		defaultInputs, prerequisites)
+
+	return &totpAuthExecutor{
+		ExecutorInterface: base,
+		totpService:       totpService,
+		entityProvider:    entityProvider,
+		logger:            logger,
+	}
+}
+
+// Execute executes the TOTP authentication logic.
+func (t *totpAuthExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	logger := t.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Executing TOTP authentication executor")
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	if !t.ValidatePrerequisites(ctx, execResp) {
+		logger.Debug("Prerequisites not met for TOTP authentication executor")
+		return execResp, nil
+	}
+
+	if !t.HasRequiredInputs(ctx, execResp) {
+		logger.Debug("Required inputs for TOTP authentication are not provided")
+		execResp.Status = common.ExecUserInputRequired
+		return execResp, nil
+	}
+
+	userID := t.GetUserIDFromContext(ctx)
+	code := ctx.UserInputs[userInputTOTP]
+	if code == "" {
+		execResp.Status = common.ExecUserInputRequired
+		execResp.Inputs = t.GetRequiredInputs(ctx)
+		execResp.FailureReason = failureReasonInvalidTOTP
+		return execResp, nil
+	}
+
+	if svcErr := t.totpService.VerifyCode(ctx.Context, userID, code); svcErr != nil {
+		if svcErr.Type != serviceerror.ClientErrorType {
+			logger.Error("Failed to verify TOTP code", log.MaskedString(log.LoggerKeyUserID, userID),
+				log.String("error", svcErr.ErrorDescription.DefaultValue))
+			return execResp, fmt.Errorf("failed to verify TOTP code: %s", svcErr.ErrorDescription.DefaultValue)
+		}
+
+		logger.Debug("TOTP verification failed", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.String("error", svcErr.ErrorDescription.DefaultValue))
+		if svcErr.Code == totp.ErrorInvalidCode.Code || svcErr.Code == totp.ErrorEmptyCode.Code {
+			// Return USER_INPUT_REQUIRED to allow retry on an invalid code
+			execResp.Status = common.ExecUserInputRequired
+			execResp.Inputs = t.GetRequiredInputs(ctx)
+			execResp.FailureReason = failureReasonInvalidTOTP
+			return execResp, nil
+		}
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = svcErr.ErrorDescription.DefaultValue
+		return execResp, nil
+	}
+
+	authenticatedUser, err := t.getAuthenticatedUser(userID)
+	if err != nil {
+		logger.Error("Failed to get authenticated user details", log.Error(err))
+		return execResp, fmt.Errorf("failed to get authenticated user details: %w", err)
+	}
+
+	execResp.AuthenticatedUser = *authenticatedUser
+	execResp.Status = common.ExecComplete
+
+	logger.Debug("TOTP authentication completed successfully", log.MaskedString(log.LoggerKeyUserID, userID))
+	return execResp, nil
+}
+
+// getAuthenticatedUser retrieves the authenticated user details from the entity provider.
+func (t *totpAuthExecutor) getAuthenticatedUser(userID string) (*authncm.AuthenticatedUser, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is empty")
+	}
+
+	user, err := t.entityProvider.GetEntity(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return &authncm.AuthenticatedUser{
+		IsAuthenticated: true,
+		UserID:          user.ID,
+		UserType:        user.Type,
+		OUID:            user.OUID,
+	}, nil
+}
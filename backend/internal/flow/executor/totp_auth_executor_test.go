@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/authn/totp"
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/core"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/tests/mocks/authn/totpmock"
+	"github.com/asgardeo/thunder/tests/mocks/entityprovidermock"
+	"github.com/asgardeo/thunder/tests/mocks/flow/coremock"
+)
+
+type TOTPAuthExecutorTestSuite struct {
+	suite.Suite
+	mockFlowFactory    *coremock.FlowFactoryInterfaceMock
+	mockBaseExecutor   *coremock.ExecutorInterfaceMock
+	mockTOTPService    *totpmock.TOTPServiceInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	executor           *totpAuthExecutor
+}
+
+func TestTOTPAuthExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(TOTPAuthExecutorTestSuite))
+}
+
+func (suite *TOTPAuthExecutorTestSuite) SetupTest() {
+	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
+	suite.mockBaseExecutor = coremock.NewExecutorInterfaceMock(suite.T())
+	suite.mockTOTPService = totpmock.NewTOTPServiceInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+
+	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameTOTPAuth, common.ExecutorTypeAuthentication,
+		mock.Anything, mock.Anything).Return(suite.mockBaseExecutor)
+
+	suite.executor = newTOTPAuthExecutor(suite.mockFlowFactory, suite.mockTOTPService, suite.mockEntityProvider)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) newContext(code string) *core.NodeContext {
+	return &core.NodeContext{
+		ExecutionID: "test-flow",
+		FlowType:    common.FlowTypeAuthentication,
+		UserInputs:  map[string]string{userInputTOTP: code},
+		RuntimeData: map[string]string{userAttributeUserID: testUserID},
+	}
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestExecute_Success() {
+	ctx := suite.newContext("123456")
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockTOTPService.On("VerifyCode", mock.Anything, testUserID, "123456").Return(nil)
+	suite.mockEntityProvider.On("GetEntity", testUserID).
+		Return(&entityprovider.Entity{ID: testUserID, Type: "person", OUID: "ou-1"}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	assert.True(suite.T(), resp.AuthenticatedUser.IsAuthenticated)
+	assert.Equal(suite.T(), testUserID, resp.AuthenticatedUser.UserID)
+	assert.Equal(suite.T(), "ou-1", resp.AuthenticatedUser.OUID)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestExecute_PrerequisitesNotMet() {
+	ctx := suite.newContext("123456")
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(false)
+
+	_, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	suite.mockTOTPService.AssertNotCalled(suite.T(), "VerifyCode", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestExecute_MissingInput() {
+	ctx := suite.newContext("")
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(false)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, resp.Status)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestExecute_InvalidCodeAllowsRetry() {
+	ctx := suite.newContext("000000")
+	inputs := []common.Input{{Identifier: userInputTOTP, Type: common.InputTypeOTP, Required: true}}
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockBaseExecutor.On("GetRequiredInputs", ctx).Return(inputs)
+	suite.mockTOTPService.On("VerifyCode", mock.Anything, testUserID, "000000").Return(&totp.ErrorInvalidCode)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, resp.Status)
+	assert.Equal(suite.T(), failureReasonInvalidTOTP, resp.FailureReason)
+	assert.Equal(suite.T(), inputs, resp.Inputs)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestExecute_NotEnrolled() {
+	ctx := suite.newContext("123456")
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockTOTPService.On("VerifyCode", mock.Anything, testUserID, "123456").
+		Return(&totp.ErrorTOTPNotEnrolled)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), totp.ErrorTOTPNotEnrolled.ErrorDescription.DefaultValue, resp.FailureReason)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestExecute_ServerError() {
+	ctx := suite.newContext("123456")
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockTOTPService.On("VerifyCode", mock.Anything, testUserID, "123456").
+		Return(&serviceerror.InternalServerError)
+
+	_, err := suite.executor.Execute(ctx)
+
+	assert.Error(suite.T(), err)
+}
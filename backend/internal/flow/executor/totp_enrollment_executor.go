@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/asgardeo/thunder/internal/authn/totp"
+	"github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/core"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+// TOTP enrollment additional data keys
+const (
+	dataKeyTOTPSecret        = "totpSecret"
+	dataKeyTOTPQRPayload     = "totpQRPayload"
+	dataKeyTOTPDigits        = "totpDigits"
+	dataKeyTOTPPeriod        = "totpPeriod"
+	dataKeyTOTPRecoveryCodes = "totpRecoveryCodes"
+)
+
+// totpEnrollmentExecutor enrolls an authenticator app for an existing user. The generate mode
+// returns the secret and QR payload to register in the app, and the verify mode confirms the
+// enrollment with a code from the app and returns the recovery codes.
+type totpEnrollmentExecutor struct {
+	core.ExecutorInterface
+	totpService totp.TOTPServiceInterface
+	logger      *log.Logger
+}
+
+var _ core.ExecutorInterface = (*totpEnrollmentExecutor)(nil)
+
+// newTOTPEnrollmentExecutor creates a new instance of TOTPEnrollmentExecutor.
+func newTOTPEnrollmentExecutor(
+	flowFactory core.FlowFactoryInterface,
+	totpService totp.TOTPServiceInterface,
+) *totpEnrollmentExecutor {
+	defaultInputs := []common.Input{
+		{
+			Ref:        "totp_input",
+			Identifier: userInputTOTP,
+			Type:       common.InputTypeOTP,
+			Required:   true,
+		},
+	}
+	prerequisites := []common.Input{
+		{
+			Identifier: userAttributeUserID,
+			Type:       common.InputTypeText,
+			Required:   true,
+		},
+	}
+
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "TOTPEnrollmentExecutor"),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameTOTPEnrollment))
+
+	base := flowFactory.CreateExecutor(ExecutorNameTOTPEnrollment, common.ExecutorTypeRegistration,
+		defaultInputs, prerequisites)
+
+	return &totpEnrollmentExecutor{
+		ExecutorInterface: base,
+		totpService:       totpService,
+		logger:            logger,
+	}
+}
+
+// Execute executes the TOTP enrollment logic.
+func (t *totpEnrollmentExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	logger := t.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Executing TOTP enrollment executor")
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	if !t.ValidatePrerequisites(ctx, execResp) {
+		logger.Debug("Prerequisites not met for TOTP enrollment executor")
+		return execResp, nil
+	}
+
+	switch ctx.ExecutorMode {
+	case ExecutorModeGenerate:
+		return t.executeGenerate(ctx, execResp)
+	case ExecutorModeVerify:
+		return t.executeVerify(ctx, execResp)
+	default:
+		return execResp, fmt.Errorf("invalid executor mode: %s", ctx.ExecutorMode)
+	}
+}
+
+// executeGenerate starts the enrollment and returns the details to register in the authenticator app.
+func (t *totpEnrollmentExecutor) executeGenerate(ctx *core.NodeContext,
+	execResp *common.ExecutorResponse) (*common.ExecutorResponse, error) {
+	logger := t.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	userID := t.GetUserIDFromContext(ctx)
+
+	enrollment, svcErr := t.totpService.StartEnrollment(ctx.Context, userID)
+	if svcErr != nil {
+		if svcErr.Type == serviceerror.ClientErrorType {
+			logger.Debug("Failed to start TOTP enrollment", log.MaskedString(log.LoggerKeyUserID, userID),
+				log.String("error", svcErr.ErrorDescription.DefaultValue))
+			execResp.Status = common.ExecFailure
+			execResp.FailureReason = svcErr.ErrorDescription.DefaultValue
+			return execResp, nil
+		}
+		logger.Error("Failed to start TOTP enrollment", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.String("error", svcErr.ErrorDescription.DefaultValue))
+		return execResp, fmt.Errorf("failed to start TOTP enrollment: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+
+	execResp.AdditionalData[dataKeyTOTPSecret] = enrollment.Secret
+	execResp.AdditionalData[dataKeyTOTPQRPayload] = enrollment.QRPayload
+	execResp.AdditionalData[dataKeyTOTPDigits] = strconv.Itoa(enrollment.Digits)
+	execResp.AdditionalData[dataKeyTOTPPeriod] = strconv.FormatInt(enrollment.Period, 10)
+	execResp.Status = common.ExecComplete
+
+	logger.Debug("TOTP enrollment started", log.MaskedString(log.LoggerKeyUserID, userID))
+	return execResp, nil
+}
+
+// executeVerify confirms the enrollment with a code from the authenticator app and returns the
+// recovery codes.
+func (t *totpEnrollmentExecutor) executeVerify(ctx *core.NodeContext,
+	execResp *common.ExecutorResponse) (*common.ExecutorResponse, error) {
+	logger := t.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	if !t.HasRequiredInputs(ctx, execResp) {
+		logger.Debug("Required inputs for TOTP enrollment confirmation are not provided")
+		execResp.Status = common.ExecUserInputRequired
+		return execResp, nil
+	}
+
+	userID := t.GetUserIDFromContext(ctx)
+	recoveryCodes, svcErr := t.totpService.ConfirmEnrollment(ctx.Context, userID, ctx.UserInputs[userInputTOTP])
+	if svcErr != nil {
+		if svcErr.Type != serviceerror.ClientErrorType {
+			logger.Error("Failed to confirm TOTP enrollment", log.MaskedString(log.LoggerKeyUserID, userID),
+				log.String("error", svcErr.ErrorDescription.DefaultValue))
+			return execResp, fmt.Errorf("failed to confirm TOTP enrollment: %s",
+				svcErr.ErrorDescription.DefaultValue)
+		}
+
+		logger.Debug("TOTP enrollment confirmation failed", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.String("error", svcErr.ErrorDescription.DefaultValue))
+		if svcErr.Code == totp.ErrorInvalidCode.Code || svcErr.Code == totp.ErrorEmptyCode.Code {
+			// Return USER_INPUT_REQUIRED to allow retry on an invalid code
+			execResp.Status = common.ExecUserInputRequired
+			execResp.Inputs = t.GetRequiredInputs(ctx)
+			execResp.FailureReason = failureReasonInvalidTOTP
+			return execResp, nil
+		}
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = svcErr.ErrorDescription.DefaultValue
+		return execResp, nil
+	}
+
+	codesJSON, err := json.Marshal(recoveryCodes.Codes)
+	if err != nil {
+		logger.Error("Failed to marshal recovery codes", log.Error(err))
+		return execResp, fmt.Errorf("failed to marshal recovery codes: %w", err)
+	}
+
+	execResp.AdditionalData[dataKeyTOTPRecoveryCodes] = string(codesJSON)
+	execResp.Status = common.ExecComplete
+
+	logger.Debug("TOTP enrollment completed", log.MaskedString(log.LoggerKeyUserID, userID))
+	return execResp, nil
+}
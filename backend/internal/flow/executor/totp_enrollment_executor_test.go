@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/authn/totp"
+	"github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/core"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/tests/mocks/authn/totpmock"
+	"github.com/asgardeo/thunder/tests/mocks/flow/coremock"
+)
+
+type TOTPEnrollmentExecutorTestSuite struct {
+	suite.Suite
+	mockFlowFactory  *coremock.FlowFactoryInterfaceMock
+	mockBaseExecutor *coremock.ExecutorInterfaceMock
+	mockTOTPService  *totpmock.TOTPServiceInterfaceMock
+	executor         *totpEnrollmentExecutor
+}
+
+func TestTOTPEnrollmentExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(TOTPEnrollmentExecutorTestSuite))
+}
+
+func (suite *TOTPEnrollmentExecutorTestSuite) SetupTest() {
+	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
+	suite.mockBaseExecutor = coremock.NewExecutorInterfaceMock(suite.T())
+	suite.mockTOTPService = totpmock.NewTOTPServiceInterfaceMock(suite.T())
+
+	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameTOTPEnrollment, common.ExecutorTypeRegistration,
+		mock.Anything, mock.Anything).Return(suite.mockBaseExecutor)
+
+	suite.executor = newTOTPEnrollmentExecutor(suite.mockFlowFactory, suite.mockTOTPService)
+}
+
+func (suite *TOTPEnrollmentExecutorTestSuite) newContext(mode string, inputs map[string]string) *core.NodeContext {
+	return &core.NodeContext{
+		ExecutionID:  "test-flow",
+		ExecutorMode: mode,
+		UserInputs:   inputs,
+		RuntimeData:  map[string]string{userAttributeUserID: testUserID},
+	}
+}
+
+func (suite *TOTPEnrollmentExecutorTestSuite) TestExecute_Generate() {
+	ctx := suite.newContext(ExecutorModeGenerate, map[string]string{})
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockTOTPService.On("StartEnrollment", mock.Anything, testUserID).Return(&totp.TOTPEnrollment{
+		Secret:    "SECRET",
+		QRPayload: "otpauth://totp/Thunder:alice?secret=SECRET",
+		Algorithm: "SHA1",
+		Digits:    6,
+		Period:    30,
+	}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), "SECRET", resp.AdditionalData[dataKeyTOTPSecret])
+	assert.Equal(suite.T(), "otpauth://totp/Thunder:alice?secret=SECRET", resp.AdditionalData[dataKeyTOTPQRPayload])
+	assert.Equal(suite.T(), "6", resp.AdditionalData[dataKeyTOTPDigits])
+	assert.Equal(suite.T(), "30", resp.AdditionalData[dataKeyTOTPPeriod])
+}
+
+func (suite *TOTPEnrollmentExecutorTestSuite) TestExecute_GenerateAlreadyEnrolled() {
+	ctx := suite.newContext(ExecutorModeGenerate, map[string]string{})
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockTOTPService.On("StartEnrollment", mock.Anything, testUserID).
+		Return(nil, &totp.ErrorTOTPAlreadyEnrolled)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), totp.ErrorTOTPAlreadyEnrolled.ErrorDescription.DefaultValue, resp.FailureReason)
+}
+
+func (suite *TOTPEnrollmentExecutorTestSuite) TestExecute_GenerateServerError() {
+	ctx := suite.newContext(ExecutorModeGenerate, map[string]string{})
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockTOTPService.On("StartEnrollment", mock.Anything, testUserID).
+		Return(nil, &serviceerror.InternalServerError)
+
+	_, err := suite.executor.Execute(ctx)
+
+	assert.Error(suite.T(), err)
+}
+
+func (suite *TOTPEnrollmentExecutorTestSuite) TestExecute_Verify() {
+	ctx := suite.newContext(ExecutorModeVerify, map[string]string{userInputTOTP: "123456"})
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockTOTPService.On("ConfirmEnrollment", mock.Anything, testUserID, "123456").
+		Return(&totp.RecoveryCodes{Codes: []string{"ABCDE-FGHJK", "LMNPQ-RSTUV"}}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	assert.JSONEq(suite.T(), `["ABCDE-FGHJK","LMNPQ-RSTUV"]`, resp.AdditionalData[dataKeyTOTPRecoveryCodes])
+}
+
+func (suite *TOTPEnrollmentExecutorTestSuite) TestExecute_VerifyMissingInput() {
+	ctx := suite.newContext(ExecutorModeVerify, map[string]string{})
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(false)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, resp.Status)
+}
+
+func (suite *TOTPEnrollmentExecutorTestSuite) TestExecute_VerifyInvalidCode() {
+	ctx := suite.newContext(ExecutorModeVerify, map[string]string{userInputTOTP: "000000"})
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockBaseExecutor.On("GetRequiredInputs", ctx).Return([]common.Input{{Identifier: userInputTOTP}})
+	suite.mockTOTPService.On("ConfirmEnrollment", mock.Anything, testUserID, "000000").
+		Return(nil, &totp.ErrorInvalidCode)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, resp.Status)
+	assert.Equal(suite.T(), failureReasonInvalidTOTP, resp.FailureReason)
+}
+
+func (suite *TOTPEnrollmentExecutorTestSuite) TestExecute_InvalidMode() {
+	ctx := suite.newContext("unknown", map[string]string{})
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+
+	_, err := suite.executor.Execute(ctx)
+
+	assert.Error(suite.T(), err)
+}
@@ -32,12 +32,15 @@ import (
 // Returns empty string if executor doesn't map to an authn service.
 func getAuthnServiceName(executorName string) string {
 	executorToAuthnServiceMap := map[string]string{
-		ExecutorNameBasicAuth:  authncm.AuthenticatorCredentials,
-		ExecutorNameSMSAuth:    authncm.AuthenticatorSMSOTP,
-		ExecutorNameOAuth:      authncm.AuthenticatorOAuth,
-		ExecutorNameOIDCAuth:   authncm.AuthenticatorOIDC,
-		ExecutorNameGitHubAuth: authncm.AuthenticatorGithub,
-		ExecutorNameGoogleAuth: authncm.AuthenticatorGoogle,
+		ExecutorNameBasicAuth:    authncm.AuthenticatorCredentials,
+		ExecutorNameSMSAuth:      authncm.AuthenticatorSMSOTP,
+		ExecutorNameEmailOTPAuth: authncm.AuthenticatorEmailOTP,
+		ExecutorNameTOTPAuth:     authncm.AuthenticatorTOTP,
+		ExecutorNameOAuth:        authncm.AuthenticatorOAuth,
+		ExecutorNameOIDCAuth:     authncm.AuthenticatorOIDC,
+		ExecutorNameGitHubAuth:   authncm.AuthenticatorGithub,
+		ExecutorNameGoogleAuth:   authncm.AuthenticatorGoogle,
+		ExecutorNameSAMLAuth:     authncm.AuthenticatorSAML,
 	}
 	return executorToAuthnServiceMap[executorName]
 }
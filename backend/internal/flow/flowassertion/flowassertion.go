@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package flowassertion decodes the assertion issued on completion of an authentication flow.
+package flowassertion
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+)
+
+// Claim names carried by the flow assertion.
+const (
+	claimSub                   = "sub"
+	claimIssuedAt              = "iat"
+	claimAuthorizedPermissions = "authorized_permissions"
+	claimAttributeCacheID      = "aci"
+	claimCompletedAuthClass    = "completed_auth_class"
+)
+
+// Claims holds the claims of a flow assertion used by the protocols that consume it.
+type Claims struct {
+	UserID                string
+	AuthorizedPermissions string
+	AttributeCacheID      string
+	CompletedACR          string
+	// AuthTime is the time the flow completed, taken from the iat claim. It is zero if the claim is absent.
+	AuthTime time.Time
+	// Payload holds the full decoded payload for callers that need additional claims.
+	Payload map[string]interface{}
+}
+
+// Decode decodes the claims of a flow assertion. The assertion signature is not verified, so callers
+// must verify it before trusting the claims.
+func Decode(assertion string) (Claims, error) {
+	claims := Claims{}
+
+	_, payload, err := jwt.DecodeJWT(assertion)
+	if err != nil {
+		return claims, fmt.Errorf("failed to decode the JWT token: %w", err)
+	}
+	claims.Payload = payload
+
+	if iatValue, ok := payload[claimIssuedAt]; ok {
+		switch v := iatValue.(type) {
+		case float64:
+			claims.AuthTime = time.Unix(int64(v), 0)
+		case int64:
+			claims.AuthTime = time.Unix(v, 0)
+		case int:
+			claims.AuthTime = time.Unix(int64(v), 0)
+		default:
+			return claims, errors.New("JWT 'iat' claim has unexpected type")
+		}
+	}
+
+	if claims.UserID, err = getStringClaim(payload, claimSub); err != nil {
+		return claims, err
+	}
+	if claims.AttributeCacheID, err = getStringClaim(payload, claimAttributeCacheID); err != nil {
+		return claims, err
+	}
+	if claims.CompletedACR, err = getStringClaim(payload, claimCompletedAuthClass); err != nil {
+		return claims, err
+	}
+	claims.AuthorizedPermissions, _ = payload[claimAuthorizedPermissions].(string)
+
+	return claims, nil
+}
+
+// getStringClaim returns the value of an optional string claim, or an error if the claim is present
+// with a non-string value.
+func getStringClaim(payload map[string]interface{}, name string) (string, error) {
+	value, ok := payload[name]
+	if !ok {
+		return "", nil
+	}
+	strValue, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("JWT '%s' claim is not a string", name)
+	}
+	return strValue, nil
+}
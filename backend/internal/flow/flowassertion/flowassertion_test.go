@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowassertion
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FlowAssertionTestSuite struct {
+	suite.Suite
+}
+
+func TestFlowAssertionTestSuite(t *testing.T) {
+	suite.Run(t, new(FlowAssertionTestSuite))
+}
+
+func (s *FlowAssertionTestSuite) buildAssertion(payload map[string]interface{}) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	body, err := json.Marshal(payload)
+	s.Require().NoError(err)
+	return header + "." + base64.RawURLEncoding.EncodeToString(body) + "."
+}
+
+func (s *FlowAssertionTestSuite) TestDecode_Success() {
+	assertion := s.buildAssertion(map[string]interface{}{
+		"sub":                    "user-1",
+		"iat":                    1700000000,
+		"authorized_permissions": "read write",
+		"aci":                    "cache-1",
+		"completed_auth_class":   "mfa",
+		"custom":                 "value",
+	})
+
+	claims, err := Decode(assertion)
+
+	s.NoError(err)
+	s.Equal("user-1", claims.UserID)
+	s.Equal("read write", claims.AuthorizedPermissions)
+	s.Equal("cache-1", claims.AttributeCacheID)
+	s.Equal("mfa", claims.CompletedACR)
+	s.Equal(time.Unix(1700000000, 0), claims.AuthTime)
+	s.Equal("value", claims.Payload["custom"])
+}
+
+func (s *FlowAssertionTestSuite) TestDecode_MissingOptionalClaims() {
+	claims, err := Decode(s.buildAssertion(map[string]interface{}{"sub": "user-1"}))
+
+	s.NoError(err)
+	s.Equal("user-1", claims.UserID)
+	s.Empty(claims.AttributeCacheID)
+	s.True(claims.AuthTime.IsZero())
+}
+
+func (s *FlowAssertionTestSuite) TestDecode_NonStringPermissionsIgnored() {
+	claims, err := Decode(s.buildAssertion(map[string]interface{}{"sub": "user-1", "authorized_permissions": 1}))
+
+	s.NoError(err)
+	s.Empty(claims.AuthorizedPermissions)
+}
+
+func (s *FlowAssertionTestSuite) TestDecode_InvalidClaims() {
+	testCases := []struct {
+		name     string
+		payload  map[string]interface{}
+		expected string
+	}{
+		{"NonStringSub", map[string]interface{}{"sub": 1}, "JWT 'sub' claim is not a string"},
+		{"NonStringACI", map[string]interface{}{"sub": "user-1", "aci": 1}, "JWT 'aci' claim is not a string"},
+		{"NonStringACR", map[string]interface{}{"sub": "user-1", "completed_auth_class": true},
+			"JWT 'completed_auth_class' claim is not a string"},
+		{"InvalidIat", map[string]interface{}{"sub": "user-1", "iat": "now"}, "JWT 'iat' claim has unexpected type"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := Decode(s.buildAssertion(tc.payload))
+
+			s.Error(err)
+			s.Contains(err.Error(), tc.expected)
+		})
+	}
+}
+
+func (s *FlowAssertionTestSuite) TestDecode_InvalidJWT() {
+	_, err := Decode("invalid.jwt.token")
+
+	s.Error(err)
+	s.Contains(err.Error(), "failed to decode the JWT token")
+}
@@ -309,9 +309,9 @@ func (fe *flowEngine) getExecutorByName(executorName string) (core.ExecutorInter
 }
 
 // clearSensitiveInputs removes sensitive user inputs from the engine context after a node has executed.
-// This cleanup is only applied for authentication flows.
+// This cleanup is only applied for authentication and backchannel authentication flows.
 func (fe *flowEngine) clearSensitiveInputs(ctx *EngineContext, node core.NodeInterface) {
-	if ctx.FlowType != common.FlowTypeAuthentication {
+	if ctx.FlowType != common.FlowTypeAuthentication && ctx.FlowType != common.FlowTypeBackchannelAuthentication {
 		return
 	}
 
@@ -462,6 +462,11 @@ func (fe *flowEngine) shouldUpdateAuthenticatedUser(engineCtx *EngineContext) bo
 			executorInst.GetName() == executor.ExecutorNameProvisioning
 	}
 
+	// For backchannel authentication flows, only update from authentication executors.
+	if engineCtx.FlowType == common.FlowTypeBackchannelAuthentication {
+		return executorInst.GetType() == common.ExecutorTypeAuthentication
+	}
+
 	return false
 }
 
@@ -511,6 +511,11 @@ func (s *flowExecService) getFlowGraph(ctx context.Context, appID string, flowTy
 		return "", &ErrorInvalidAppID
 	}
 
+	// Backchannel authentication runs a system flow on behalf of the requesting application.
+	if flowType == common.FlowTypeBackchannelAuthentication {
+		return s.getSystemFlowGraph(ctx, flowType, logger)
+	}
+
 	if flowType == common.FlowTypeRegistration {
 		if !client.IsRegistrationFlowEnabled {
 			return "", &ErrorRegistrationFlowDisabled
@@ -535,7 +540,8 @@ func (s *flowExecService) getFlowGraph(ctx context.Context, appID string, flowTy
 // validateFlowType validates the provided flow type string and returns the corresponding FlowType.
 func validateFlowType(flowTypeStr string) (common.FlowType, *serviceerror.ServiceError) {
 	switch common.FlowType(flowTypeStr) {
-	case common.FlowTypeAuthentication, common.FlowTypeRegistration, common.FlowTypeUserOnboarding:
+	case common.FlowTypeAuthentication, common.FlowTypeRegistration, common.FlowTypeUserOnboarding,
+		common.FlowTypeBackchannelAuthentication:
 		return common.FlowType(flowTypeStr), nil
 	default:
 		return "", &ErrorInvalidFlowType
@@ -554,6 +560,8 @@ func (s *flowExecService) getSystemFlowGraph(ctx context.Context, flowType commo
 	switch flowType {
 	case common.FlowTypeUserOnboarding:
 		handle = config.GetServerRuntime().Config.Flow.UserOnboardingFlowHandle
+	case common.FlowTypeBackchannelAuthentication:
+		handle = config.GetServerRuntime().Config.Flow.BackchannelAuthFlowHandle
 	default:
 		return "", &ErrorInvalidFlowType
 	}
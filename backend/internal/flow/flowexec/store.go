@@ -22,11 +22,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/database/provider"
+	dbutils "github.com/asgardeo/thunder/internal/system/database/utils"
 )
 
 // flowStoreInterface defines the methods for flow context storage operations.
@@ -132,7 +132,7 @@ func (s *flowStore) buildFlowContextFromResultRow(row map[string]interface{}) (*
 		return nil, errors.New("failed to parse context as string")
 	}
 
-	expiryTime, err := s.parseTimeField(row["expiry_time"], "expiry_time")
+	expiryTime, err := dbutils.ParseTimeField(row["expiry_time"], "expiry_time")
 	if err != nil {
 		return nil, err
 	}
@@ -159,39 +159,3 @@ func (s *flowStore) parseRequiredString(value interface{}) *string {
 	}
 	return nil
 }
-
-// parseTimeField safely parses a time field from the database row handling multiple formats.
-// This follows the pattern used in other stores for consistency.
-func (s *flowStore) parseTimeField(field interface{}, fieldName string) (time.Time, error) {
-	const customTimeFormat = "2006-01-02 15:04:05.999999999"
-
-	switch v := field.(type) {
-	case string:
-		// Handle SQLite datetime strings
-		trimmedTime := s.trimTimeString(v)
-		parsedTime, err := time.Parse(customTimeFormat, trimmedTime)
-		if err != nil {
-			// Try alternative ISO 8601 format as fallback
-			parsedTime, err = time.Parse(time.RFC3339, v)
-			if err != nil {
-				return time.Time{}, fmt.Errorf("error parsing %s: %w", fieldName, err)
-			}
-		}
-		return parsedTime, nil
-	case time.Time:
-		return v, nil
-	case nil:
-		return time.Time{}, fmt.Errorf("%s is nil", fieldName)
-	default:
-		return time.Time{}, fmt.Errorf("unexpected type for %s: %T", fieldName, field)
-	}
-}
-
-// trimTimeString trims extra information from a time string to match the expected format.
-func (s *flowStore) trimTimeString(timeStr string) string {
-	parts := strings.SplitN(timeStr, " ", 3)
-	if len(parts) >= 2 {
-		return parts[0] + " " + parts[1]
-	}
-	return timeStr
-}
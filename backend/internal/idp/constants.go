@@ -30,6 +30,8 @@ const (
 	IDPTypeGoogle IDPType = "GOOGLE"
 	// IDPTypeGitHub represents a GitHub identity provider.
 	IDPTypeGitHub IDPType = "GITHUB"
+	// IDPTypeSAML represents a SAML 2.0 identity provider.
+	IDPTypeSAML IDPType = "SAML"
 )
 
 // supportedIDPTypes lists all the supported identity provider types.
@@ -38,6 +40,7 @@ var supportedIDPTypes = []IDPType{
 	IDPTypeOIDC,
 	IDPTypeGoogle,
 	IDPTypeGitHub,
+	IDPTypeSAML,
 }
 
 // IDP property names.
@@ -55,6 +58,20 @@ const (
 	PropPrompt                = "prompt"
 )
 
+// SAML IDP property names.
+const (
+	PropSPEntityID       = "sp_entity_id"
+	PropACSURL           = "acs_url"
+	PropIDPEntityID      = "idp_entity_id"
+	PropSSOURL           = "sso_url"
+	PropIDPCertificate   = "idp_certificate"
+	PropNameIDFormat     = "name_id_format"
+	PropMetadataURL      = "metadata_url"
+	PropMetadataXML      = "metadata_xml"
+	PropSubjectAttribute = "subject_attribute"
+	PropAttributeMapping = "attribute_mapping"
+)
+
 // Known endpoints for Google OAuth2/OIDC.
 const (
 	googleAuthorizationEndpoint = "https://accounts.google.com/o/oauth2/v2/auth"
@@ -158,4 +175,21 @@ var idpPropertyConfigs = map[IDPType]idpPropertyConfig{
 			PropUserEmailEndpoint:     gitHubUserEmailEndpoint,
 		},
 	},
+	IDPTypeSAML: {
+		Required: []string{
+			PropSPEntityID,
+			PropACSURL,
+			PropIDPEntityID,
+			PropSSOURL,
+			PropIDPCertificate,
+		},
+		Optional: []string{
+			PropNameIDFormat,
+			PropMetadataURL,
+			PropMetadataXML,
+			PropSubjectAttribute,
+			PropAttributeMapping,
+		},
+		Defaults: map[string]string{},
+	},
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/asgardeo/thunder/internal/system/config"
 	serverconst "github.com/asgardeo/thunder/internal/system/constants"
 	declarativeresource "github.com/asgardeo/thunder/internal/system/declarative_resource"
+	syshttp "github.com/asgardeo/thunder/internal/system/http"
 	"github.com/asgardeo/thunder/internal/system/middleware"
 	"github.com/asgardeo/thunder/internal/system/transaction"
 )
@@ -38,7 +39,10 @@ func Initialize(mux *http.ServeMux) (IDPServiceInterface, declarativeresource.Re
 		return nil, nil, err
 	}
 
-	idpService := newIDPService(idpStore, transactioner)
+	httpClient := syshttp.NewHTTPClientWithCheckRedirect(func(req *http.Request, _ []*http.Request) error {
+		return syshttp.IsSSRFSafeURL(req.URL.String())
+	})
+	idpService := newIDPService(idpStore, transactioner, httpClient)
 
 	idpHandler := newIDPHandler(idpService)
 	registerRoutes(mux, idpHandler)
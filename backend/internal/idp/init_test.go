@@ -132,7 +132,7 @@ func (s *IDPInitTestSuite) TestNewIDPHandler() {
 
 func (s *IDPInitTestSuite) TestNewIDPService() {
 	store := &idpStore{}
-	service := newIDPService(store, &mockTransactioner{})
+	service := newIDPService(store, &mockTransactioner{}, nil)
 
 	s.NotNil(service)
 	s.Implements((*IDPServiceInterface)(nil), service)
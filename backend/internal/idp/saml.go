@@ -0,0 +1,258 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idp
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	samlconst "github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/system/cmodels"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	syshttp "github.com/asgardeo/thunder/internal/system/http"
+	"github.com/asgardeo/thunder/internal/system/i18n/core"
+	"github.com/asgardeo/thunder/internal/system/log"
+	"github.com/asgardeo/thunder/internal/system/xmldsig"
+)
+
+// maxSAMLMetadataSize is the maximum size of a SAML metadata document in bytes.
+const maxSAMLMetadataSize = 1024 * 1024
+
+// samlMetadata holds the values imported from the metadata of a SAML identity provider.
+type samlMetadata struct {
+	EntityID     string
+	SSOURL       string
+	Certificate  string
+	NameIDFormat string
+}
+
+// ParseCertificate parses the PEM encoded signing certificate of a SAML identity provider.
+func ParseCertificate(value string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(value))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("certificate is not a PEM encoded X.509 certificate")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// ParseAttributeMapping parses the attribute mapping of a SAML identity provider, given as a comma
+// separated list of "<assertion attribute>=<local attribute>" pairs, into a map keyed by the
+// assertion attribute.
+func ParseAttributeMapping(value string) (map[string]string, error) {
+	mapping := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		separator := strings.LastIndex(pair, "=")
+		if separator < 0 {
+			return nil, fmt.Errorf("invalid attribute mapping '%s'", pair)
+		}
+		remote := strings.TrimSpace(pair[:separator])
+		local := strings.TrimSpace(pair[separator+1:])
+		if remote == "" || local == "" {
+			return nil, fmt.Errorf("invalid attribute mapping '%s'", pair)
+		}
+		mapping[remote] = local
+	}
+	return mapping, nil
+}
+
+// importSAMLMetadata fills the properties of a SAML identity provider that are not explicitly given
+// from its metadata document.
+func importSAMLMetadata(propertyMap map[string]cmodels.Property, data []byte,
+	logger *log.Logger) *serviceerror.ServiceError {
+	metadata, err := parseSAMLMetadata(data)
+	if err != nil {
+		return serviceerror.CustomServiceError(ErrorInvalidIDPProperty, core.I18nMessage{
+			Key:          "error.idpservice.saml_metadata_invalid_description",
+			DefaultValue: fmt.Sprintf("failed to import SAML metadata: %v", err),
+		})
+	}
+
+	values := map[string]string{
+		PropIDPEntityID:    metadata.EntityID,
+		PropSSOURL:         metadata.SSOURL,
+		PropIDPCertificate: metadata.Certificate,
+		PropNameIDFormat:   metadata.NameIDFormat,
+	}
+	for name, value := range values {
+		if _, exists := propertyMap[name]; exists || value == "" {
+			continue
+		}
+		if svcErr := createAndAppendProperty(propertyMap, name, value, false, logger); svcErr != nil {
+			return svcErr
+		}
+	}
+	return nil
+}
+
+// parseSAMLMetadata parses the identity provider details from a SAML metadata document. The document
+// is either an EntityDescriptor or an EntitiesDescriptor, in which case the first entity with an
+// IDPSSODescriptor is used.
+func parseSAMLMetadata(data []byte) (*samlMetadata, error) {
+	root, err := xmldsig.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	entity := findIDPEntityDescriptor(root)
+	if entity == nil {
+		return nil, errors.New("no identity provider entity descriptor found")
+	}
+	descriptor := entity.FindChild(samlconst.NamespaceMetadata, "IDPSSODescriptor")
+
+	metadata := &samlMetadata{EntityID: entity.AttrValue("entityID")}
+	for _, service := range descriptor.FindChildren(samlconst.NamespaceMetadata, "SingleSignOnService") {
+		if service.AttrValue("Binding") == samlconst.BindingHTTPRedirect {
+			metadata.SSOURL = service.AttrValue("Location")
+			break
+		}
+	}
+	if nameIDFormat := descriptor.FindChild(samlconst.NamespaceMetadata, "NameIDFormat"); nameIDFormat != nil {
+		metadata.NameIDFormat = strings.TrimSpace(nameIDFormat.Text())
+	}
+	for _, keyDescriptor := range descriptor.FindChildren(samlconst.NamespaceMetadata, "KeyDescriptor") {
+		if use := keyDescriptor.AttrValue("use"); use != "" && use != "signing" {
+			continue
+		}
+		certificate, err := getKeyDescriptorCertificate(keyDescriptor)
+		if err != nil {
+			return nil, err
+		}
+		if certificate != "" {
+			metadata.Certificate = certificate
+			break
+		}
+	}
+	return metadata, nil
+}
+
+// findIDPEntityDescriptor returns the entity descriptor of the identity provider in the metadata.
+func findIDPEntityDescriptor(root *xmldsig.Element) *xmldsig.Element {
+	if root.Is(samlconst.NamespaceMetadata, "EntityDescriptor") {
+		if root.FindChild(samlconst.NamespaceMetadata, "IDPSSODescriptor") != nil {
+			return root
+		}
+		return nil
+	}
+	if !root.Is(samlconst.NamespaceMetadata, "EntitiesDescriptor") {
+		return nil
+	}
+	for _, child := range root.ChildElements() {
+		if entity := findIDPEntityDescriptor(child); entity != nil {
+			return entity
+		}
+	}
+	return nil
+}
+
+// getKeyDescriptorCertificate returns the PEM encoded X.509 certificate of the key descriptor, or an
+// empty string if it carries none.
+func getKeyDescriptorCertificate(keyDescriptor *xmldsig.Element) (string, error) {
+	keyInfo := keyDescriptor.FindChild(samlconst.NamespaceXMLDSig, "KeyInfo")
+	if keyInfo == nil {
+		return "", nil
+	}
+	x509Data := keyInfo.FindChild(samlconst.NamespaceXMLDSig, "X509Data")
+	if x509Data == nil {
+		return "", nil
+	}
+	certificate := x509Data.FindChild(samlconst.NamespaceXMLDSig, "X509Certificate")
+	if certificate == nil {
+		return "", nil
+	}
+
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(certificate.Text()), ""))
+	if err != nil {
+		return "", errors.New("malformed signing certificate")
+	}
+	if _, err := x509.ParseCertificate(der); err != nil {
+		return "", fmt.Errorf("invalid signing certificate: %w", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), nil
+}
+
+// validateSAMLProperties validates the format of the SAML identity provider properties.
+func validateSAMLProperties(propertyMap map[string]cmodels.Property) *serviceerror.ServiceError {
+	certificate, err := getPropertyValue(propertyMap, PropIDPCertificate)
+	if err == nil {
+		_, err = ParseCertificate(certificate)
+	}
+	if err != nil {
+		return serviceerror.CustomServiceError(ErrorInvalidIDPProperty, core.I18nMessage{
+			Key:          "error.idpservice.saml_certificate_invalid_description",
+			DefaultValue: fmt.Sprintf("invalid value for property '%s': %v", PropIDPCertificate, err),
+		})
+	}
+
+	if _, exists := propertyMap[PropAttributeMapping]; exists {
+		mapping, err := getPropertyValue(propertyMap, PropAttributeMapping)
+		if err == nil {
+			_, err = ParseAttributeMapping(mapping)
+		}
+		if err != nil {
+			return serviceerror.CustomServiceError(ErrorInvalidIDPProperty, core.I18nMessage{
+				Key:          "error.idpservice.saml_attribute_mapping_invalid_description",
+				DefaultValue: fmt.Sprintf("invalid value for property '%s': %v", PropAttributeMapping, err),
+			})
+		}
+	}
+	return nil
+}
+
+// getPropertyValue returns the value of a property in the property map.
+func getPropertyValue(propertyMap map[string]cmodels.Property, name string) (string, error) {
+	prop, exists := propertyMap[name]
+	if !exists {
+		return "", fmt.Errorf("property '%s' is missing", name)
+	}
+	return prop.GetValue()
+}
+
+// fetchSAMLMetadata retrieves the SAML metadata document from the metadata URL.
+func fetchSAMLMetadata(httpClient syshttp.HTTPClientInterface, metadataURL string) ([]byte, error) {
+	if err := syshttp.IsSSRFSafeURL(metadataURL); err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Get(metadataURL)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSAMLMetadataSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxSAMLMetadataSize {
+		return nil, errors.New("metadata document too large")
+	}
+	return data, nil
+}
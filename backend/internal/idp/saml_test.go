@@ -0,0 +1,295 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idp
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/system/cmodels"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/log"
+	"github.com/asgardeo/thunder/tests/mocks/httpmock"
+)
+
+const testMetadataTemplate = `<md:EntitiesDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata">` +
+	`<md:EntityDescriptor entityID="https://sp.example.com"><md:SPSSODescriptor ` +
+	`protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol"/></md:EntityDescriptor>` +
+	`<md:EntityDescriptor entityID="https://idp.example.com">` +
+	`<md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">` +
+	`<md:KeyDescriptor use="encryption"><ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">` +
+	`<ds:X509Data><ds:X509Certificate>bm90LWEtY2VydA==</ds:X509Certificate></ds:X509Data>` +
+	`</ds:KeyInfo></md:KeyDescriptor>` +
+	`<md:KeyDescriptor use="signing"><ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">` +
+	`<ds:X509Data><ds:X509Certificate>CERTIFICATE</ds:X509Certificate></ds:X509Data>` +
+	`</ds:KeyInfo></md:KeyDescriptor>` +
+	`<md:NameIDFormat>urn:oasis:names:tc:SAML:2.0:nameid-format:persistent</md:NameIDFormat>` +
+	`<md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" ` +
+	`Location="https://idp.example.com/sso/post"/>` +
+	`<md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" ` +
+	`Location="https://idp.example.com/sso/redirect"/>` +
+	`</md:IDPSSODescriptor></md:EntityDescriptor></md:EntitiesDescriptor>`
+
+type SAMLIDPTestSuite struct {
+	suite.Suite
+	logger   *log.Logger
+	certDER  []byte
+	certPEM  string
+	metadata string
+}
+
+func TestSAMLIDPTestSuite(t *testing.T) {
+	suite.Run(t, new(SAMLIDPTestSuite))
+}
+
+func (s *SAMLIDPTestSuite) SetupSuite() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	s.certDER, err = x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	s.Require().NoError(err)
+	s.certPEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.certDER}))
+	s.metadata = strings.Replace(testMetadataTemplate, "CERTIFICATE",
+		base64.StdEncoding.EncodeToString(s.certDER), 1)
+}
+
+func (s *SAMLIDPTestSuite) SetupTest() {
+	s.logger = log.GetLogger()
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("/tmp/test", &config.Config{})
+}
+
+func (s *SAMLIDPTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *SAMLIDPTestSuite) newProperties(values map[string]string) []cmodels.Property {
+	properties := make([]cmodels.Property, 0, len(values))
+	for name, value := range values {
+		prop, err := cmodels.NewProperty(name, value, false)
+		s.Require().NoError(err)
+		properties = append(properties, *prop)
+	}
+	return properties
+}
+
+func (s *SAMLIDPTestSuite) propertyValues(properties []cmodels.Property) map[string]string {
+	values := make(map[string]string, len(properties))
+	for _, prop := range properties {
+		value, err := prop.GetValue()
+		s.Require().NoError(err)
+		values[prop.GetName()] = value
+	}
+	return values
+}
+
+func (s *SAMLIDPTestSuite) TestValidateIDPProperties_ExplicitProperties() {
+	properties := s.newProperties(map[string]string{
+		PropSPEntityID:       "https://thunder.example.com",
+		PropACSURL:           "https://thunder.example.com/acs",
+		PropIDPEntityID:      "https://idp.example.com",
+		PropSSOURL:           "https://idp.example.com/sso",
+		PropIDPCertificate:   s.certPEM,
+		PropAttributeMapping: "http://schemas.example.com/email=email, givenName = firstName",
+	})
+
+	result, err := validateIDPProperties(IDPTypeSAML, properties, s.logger)
+
+	s.Nil(err)
+	s.Len(result, 6)
+}
+
+func (s *SAMLIDPTestSuite) TestValidateIDPProperties_ImportsMetadataXML() {
+	properties := s.newProperties(map[string]string{
+		PropSPEntityID:  "https://thunder.example.com",
+		PropACSURL:      "https://thunder.example.com/acs",
+		PropMetadataXML: s.metadata,
+		PropSSOURL:      "https://idp.example.com/custom-sso",
+	})
+
+	result, err := validateIDPProperties(IDPTypeSAML, properties, s.logger)
+
+	s.Require().Nil(err)
+	values := s.propertyValues(result)
+	s.Equal("https://idp.example.com", values[PropIDPEntityID])
+	s.Equal("https://idp.example.com/custom-sso", values[PropSSOURL])
+	s.Equal(s.certPEM, values[PropIDPCertificate])
+	s.Equal("urn:oasis:names:tc:SAML:2.0:nameid-format:persistent", values[PropNameIDFormat])
+}
+
+func (s *SAMLIDPTestSuite) TestValidateIDPProperties_Errors() {
+	base := map[string]string{
+		PropSPEntityID:  "https://thunder.example.com",
+		PropACSURL:      "https://thunder.example.com/acs",
+		PropIDPEntityID: "https://idp.example.com",
+		PropSSOURL:      "https://idp.example.com/sso",
+	}
+	testCases := []struct {
+		name      string
+		overrides map[string]string
+		remove    string
+		expected  string
+	}{
+		{"MissingSSOURL", nil, PropSSOURL, "required property 'sso_url' is missing"},
+		{"InvalidCertificate", map[string]string{PropIDPCertificate: "not-a-cert"}, "",
+			"invalid value for property 'idp_certificate'"},
+		{"InvalidAttributeMapping", map[string]string{PropAttributeMapping: "email"}, "",
+			"invalid value for property 'attribute_mapping'"},
+		{"InvalidMetadata", map[string]string{PropMetadataXML: "<md:EntityDescriptor"}, "",
+			"failed to import SAML metadata"},
+		{"MetadataWithoutIDP", map[string]string{PropMetadataXML: `<md:EntityDescriptor ` +
+			`xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://sp.example.com"/>`}, "",
+			"no identity provider entity descriptor found"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			values := make(map[string]string)
+			for name, value := range base {
+				values[name] = value
+			}
+			values[PropIDPCertificate] = s.certPEM
+			for name, value := range tc.overrides {
+				values[name] = value
+			}
+			delete(values, tc.remove)
+
+			result, err := validateIDPProperties(IDPTypeSAML, s.newProperties(values), s.logger)
+
+			s.Nil(result)
+			s.Require().NotNil(err)
+			s.Equal(ErrorInvalidIDPProperty.Code, err.Code)
+			s.Contains(err.ErrorDescription.DefaultValue, tc.expected)
+		})
+	}
+}
+
+func (s *SAMLIDPTestSuite) TestParseCertificate() {
+	cert, err := ParseCertificate(s.certPEM)
+	s.Require().NoError(err)
+	s.Equal("idp.example.com", cert.Subject.CommonName)
+
+	_, err = ParseCertificate("invalid")
+	s.Error(err)
+}
+
+func (s *SAMLIDPTestSuite) TestParseAttributeMapping() {
+	mapping, err := ParseAttributeMapping(" urn:oid:0.9.2342.19200300.100.1.3=email,,groups=groups ")
+	s.Require().NoError(err)
+	s.Equal(map[string]string{"urn:oid:0.9.2342.19200300.100.1.3": "email", "groups": "groups"}, mapping)
+
+	for _, value := range []string{"email", "=email", "email="} {
+		_, err := ParseAttributeMapping(value)
+		s.Error(err, value)
+	}
+}
+
+func (s *SAMLIDPTestSuite) TestCreateIdentityProvider_ImportsMetadataURL() {
+	store := newIdpStoreInterfaceMock(s.T())
+	httpClient := httpmock.NewHTTPClientInterfaceMock(s.T())
+	service := newIDPService(store, &mockTransactioner{}, httpClient)
+
+	httpClient.On("Get", "https://idp.example.com/metadata").Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(s.metadata)),
+	}, nil)
+	store.On("GetIdentityProviderByName", mock.Anything, "Corporate").Return((*IDPDTO)(nil), ErrIDPNotFound)
+	store.On("CreateIdentityProvider", mock.Anything, mock.Anything).Return(nil)
+
+	result, err := service.CreateIdentityProvider(context.Background(), &IDPDTO{
+		Name: "Corporate",
+		Type: IDPTypeSAML,
+		Properties: s.newProperties(map[string]string{
+			PropSPEntityID:  "https://thunder.example.com",
+			PropACSURL:      "https://thunder.example.com/acs",
+			PropMetadataURL: "https://idp.example.com/metadata",
+		}),
+	})
+
+	s.Require().Nil(err)
+	values := s.propertyValues(result.Properties)
+	s.Equal("https://idp.example.com", values[PropIDPEntityID])
+	s.Equal("https://idp.example.com/sso/redirect", values[PropSSOURL])
+	s.Equal(s.certPEM, values[PropIDPCertificate])
+	s.Equal("https://idp.example.com/metadata", values[PropMetadataURL])
+}
+
+func (s *SAMLIDPTestSuite) TestCreateIdentityProvider_MetadataURLErrors() {
+	testCases := []struct {
+		name        string
+		metadataURL string
+		setup       func(httpClient *httpmock.HTTPClientInterfaceMock)
+	}{
+		{"UnsafeURL", "http://127.0.0.1/metadata", nil},
+		{"RequestFailed", "https://idp.example.com/metadata", func(httpClient *httpmock.HTTPClientInterfaceMock) {
+			httpClient.On("Get", mock.Anything).Return(nil, errors.New("connection refused"))
+		}},
+		{"UnexpectedStatus", "https://idp.example.com/metadata", func(httpClient *httpmock.HTTPClientInterfaceMock) {
+			httpClient.On("Get", mock.Anything).Return(&http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil)
+		}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			httpClient := httpmock.NewHTTPClientInterfaceMock(s.T())
+			if tc.setup != nil {
+				tc.setup(httpClient)
+			}
+			service := newIDPService(newIdpStoreInterfaceMock(s.T()), &mockTransactioner{}, httpClient)
+
+			result, err := service.CreateIdentityProvider(context.Background(), &IDPDTO{
+				Name: "Corporate",
+				Type: IDPTypeSAML,
+				Properties: s.newProperties(map[string]string{
+					PropSPEntityID:  "https://thunder.example.com",
+					PropACSURL:      "https://thunder.example.com/acs",
+					PropMetadataURL: tc.metadataURL,
+				}),
+			})
+
+			s.Nil(result)
+			s.Require().NotNil(err)
+			s.Equal(ErrorInvalidIDPProperty.Code, err.Code)
+			s.Contains(err.ErrorDescription.DefaultValue, "failed to retrieve SAML metadata")
+		})
+	}
+}
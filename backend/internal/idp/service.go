@@ -22,10 +22,14 @@ package idp
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
+	"github.com/asgardeo/thunder/internal/system/cmodels"
 	declarativeresource "github.com/asgardeo/thunder/internal/system/declarative_resource"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	syshttp "github.com/asgardeo/thunder/internal/system/http"
+	"github.com/asgardeo/thunder/internal/system/i18n/core"
 	"github.com/asgardeo/thunder/internal/system/log"
 	"github.com/asgardeo/thunder/internal/system/transaction"
 	"github.com/asgardeo/thunder/internal/system/utils"
@@ -45,14 +49,17 @@ type IDPServiceInterface interface {
 type idpService struct {
 	idpStore      idpStoreInterface
 	transactioner transaction.Transactioner
+	httpClient    syshttp.HTTPClientInterface
 	logger        *log.Logger
 }
 
 // newIDPService creates a new instance of IdPService.
-func newIDPService(idpStore idpStoreInterface, transactioner transaction.Transactioner) IDPServiceInterface {
+func newIDPService(idpStore idpStoreInterface, transactioner transaction.Transactioner,
+	httpClient syshttp.HTTPClientInterface) IDPServiceInterface {
 	return &idpService{
 		idpStore:      idpStore,
 		transactioner: transactioner,
+		httpClient:    httpClient,
 		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "IdPService")),
 	}
 }
@@ -65,6 +72,9 @@ func (is *idpService) CreateIdentityProvider(
 		return nil, &declarativeresource.ErrorDeclarativeResourceCreateOperation
 	}
 
+	if svcErr := is.importSAMLMetadataFromURL(idp); svcErr != nil {
+		return nil, svcErr
+	}
 	if svcErr := validateIDP(idp, logger); svcErr != nil {
 		return nil, svcErr
 	}
@@ -174,6 +184,9 @@ func (is *idpService) UpdateIdentityProvider(ctx context.Context, idpID string,
 	if strings.TrimSpace(idpID) == "" {
 		return nil, &ErrorInvalidIDPID
 	}
+	if svcErr := is.importSAMLMetadataFromURL(idp); svcErr != nil {
+		return nil, svcErr
+	}
 	if svcErr := validateIDP(idp, logger); svcErr != nil {
 		return nil, svcErr
 	}
@@ -272,3 +285,38 @@ func (is *idpService) DeleteIdentityProvider(ctx context.Context, idpID string)
 
 	return nil
 }
+
+// importSAMLMetadataFromURL fetches the metadata of a SAML identity provider from its metadata URL and
+// fills the properties that are not explicitly given. Metadata given inline takes precedence and is
+// imported during the validation instead.
+func (is *idpService) importSAMLMetadataFromURL(idp *IDPDTO) *serviceerror.ServiceError {
+	if idp == nil || idp.Type != IDPTypeSAML {
+		return nil
+	}
+	propertyMap := make(map[string]cmodels.Property, len(idp.Properties))
+	for _, prop := range idp.Properties {
+		propertyMap[prop.GetName()] = prop
+	}
+	if _, exists := propertyMap[PropMetadataXML]; exists {
+		return nil
+	}
+	metadataURL, err := getPropertyValue(propertyMap, PropMetadataURL)
+	if err != nil || strings.TrimSpace(metadataURL) == "" {
+		// A missing or invalid metadata URL is reported by the property validation.
+		return nil
+	}
+
+	data, err := fetchSAMLMetadata(is.httpClient, metadataURL)
+	if err != nil {
+		is.logger.Debug("Failed to retrieve SAML metadata", log.String("metadataUrl", metadataURL), log.Error(err))
+		return serviceerror.CustomServiceError(ErrorInvalidIDPProperty, core.I18nMessage{
+			Key:          "error.idpservice.saml_metadata_fetch_failed_description",
+			DefaultValue: fmt.Sprintf("failed to retrieve SAML metadata from '%s': %v", metadataURL, err),
+		})
+	}
+	if svcErr := importSAMLMetadata(propertyMap, data, is.logger); svcErr != nil {
+		return svcErr
+	}
+	idp.Properties = propertyMapToSlice(propertyMap)
+	return nil
+}
@@ -66,7 +66,7 @@ func (s *IDPServiceTestSuite) SetupTest() {
 	_ = config.InitializeServerRuntime("/tmp/test", testConfig)
 
 	s.mockStore = newIdpStoreInterfaceMock(s.T())
-	s.idpService = newIDPService(s.mockStore, &mockTransactioner{})
+	s.idpService = newIDPService(s.mockStore, &mockTransactioner{}, nil)
 }
 
 func (s *IDPServiceTestSuite) TearDownTest() {
@@ -768,7 +768,7 @@ func (s *IDPServiceTestSuite) TestUpdateIdentityProvider_FailsForDeclarativeIDP(
 	fileStore.On("GetIdentityProviderByName", context.Background(), "Updated Name").
 		Return((*IDPDTO)(nil), ErrIDPNotFound)
 
-	service := newIDPService(compositeStore, &mockTransactioner{})
+	service := newIDPService(compositeStore, &mockTransactioner{}, nil)
 
 	updatedIDP := &IDPDTO{
 		Name:        "Updated Name",
@@ -820,7 +820,7 @@ func (s *IDPServiceTestSuite) TestUpdateIdentityProvider_SucceedsForMutableIDP()
 		return dto.ID == idpID && dto.Name == "Updated Name"
 	})).Return(nil)
 
-	service := newIDPService(compositeStore, &mockTransactioner{})
+	service := newIDPService(compositeStore, &mockTransactioner{}, nil)
 
 	updatedIDP := &IDPDTO{
 		Name:        "Updated Name",
@@ -863,7 +863,7 @@ func (s *IDPServiceTestSuite) TestDeleteIdentityProvider_FailsForDeclarativeIDP(
 	dbStore.On("GetIdentityProvider", context.Background(), idpID).Return((*IDPDTO)(nil), ErrIDPNotFound)
 	fileStore.On("GetIdentityProvider", context.Background(), idpID).Return(existingIDP, nil)
 
-	service := newIDPService(compositeStore, &mockTransactioner{})
+	service := newIDPService(compositeStore, &mockTransactioner{}, nil)
 
 	err := service.DeleteIdentityProvider(context.Background(), idpID)
 
@@ -901,7 +901,7 @@ func (s *IDPServiceTestSuite) TestDeleteIdentityProvider_SucceedsForMutableIDP()
 	dbStore.On("GetIdentityProvider", context.Background(), idpID).Return(existingIDP, nil)
 	dbStore.On("DeleteIdentityProvider", context.Background(), idpID).Return(nil)
 
-	service := newIDPService(compositeStore, &mockTransactioner{})
+	service := newIDPService(compositeStore, &mockTransactioner{}, nil)
 
 	err := service.DeleteIdentityProvider(context.Background(), idpID)
 
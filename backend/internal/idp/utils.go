@@ -73,7 +73,6 @@ func validateIDPProperties(idpType IDPType, properties []cmodels.Property, logge
 
 	// Filter and validate provided properties
 	filteredPropsMap := make(map[string]cmodels.Property)
-	filteredPropKeys := []string{}
 	for _, prop := range properties {
 		propName := prop.GetName()
 		if strings.TrimSpace(propName) == "" {
@@ -104,12 +103,25 @@ func validateIDPProperties(idpType IDPType, properties []cmodels.Property, logge
 		}
 
 		filteredPropsMap[propName] = prop
-		filteredPropKeys = append(filteredPropKeys, propName)
+	}
+
+	// Import the SAML metadata before checking the required properties as it supplies most of them.
+	if idpType == IDPTypeSAML {
+		if metadataProp, exists := filteredPropsMap[PropMetadataXML]; exists {
+			metadataXML, err := metadataProp.GetValue()
+			if err != nil {
+				logger.Error("Failed to get SAML metadata property value", log.Error(err))
+				return nil, &serviceerror.InternalServerError
+			}
+			if err := importSAMLMetadata(filteredPropsMap, []byte(metadataXML), logger); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// Check for required properties
 	for _, requiredProp := range config.Required {
-		if !slices.Contains(filteredPropKeys, requiredProp) {
+		if _, exists := filteredPropsMap[requiredProp]; !exists {
 			return nil, serviceerror.CustomServiceError(ErrorInvalidIDPProperty, core.I18nMessage{
 				Key:          "error.idpservice.required_property_missing_description",
 				DefaultValue: fmt.Sprintf("required property '%s' is missing for IDP type '%s'", requiredProp, idpType),
@@ -126,6 +138,12 @@ func validateIDPProperties(idpType IDPType, properties []cmodels.Property, logge
 		}
 	}
 
+	if idpType == IDPTypeSAML {
+		if err := validateSAMLProperties(filteredPropsMap); err != nil {
+			return nil, err
+		}
+	}
+
 	// Ensure openid scope for OIDC and Google IDPs
 	if idpType == IDPTypeOIDC || idpType == IDPTypeGoogle {
 		if err := ensureOpenIDScope(filteredPropsMap, logger); err != nil {
@@ -138,6 +138,33 @@ func (c *cachedBackStore) DeleteOAuthProfile(ctx context.Context, entityID strin
 	return nil
 }
 
+// SAML profiles are looked up by service provider entity ID on the SSO path, hence they are read
+// through to the inner store rather than cached by entity ID.
+
+func (c *cachedBackStore) CreateSAMLProfile(ctx context.Context, entityID string,
+	samlProfile *inboundmodel.SAMLProfile) error {
+	return c.inner.CreateSAMLProfile(ctx, entityID, samlProfile)
+}
+
+func (c *cachedBackStore) GetSAMLProfileByEntityID(ctx context.Context, entityID string) (
+	*inboundmodel.SAMLProfile, error) {
+	return c.inner.GetSAMLProfileByEntityID(ctx, entityID)
+}
+
+func (c *cachedBackStore) GetSAMLProfileBySPEntityID(ctx context.Context, spEntityID string) (
+	string, *inboundmodel.SAMLProfile, error) {
+	return c.inner.GetSAMLProfileBySPEntityID(ctx, spEntityID)
+}
+
+func (c *cachedBackStore) UpdateSAMLProfile(ctx context.Context, entityID string,
+	samlProfile *inboundmodel.SAMLProfile) error {
+	return c.inner.UpdateSAMLProfile(ctx, entityID, samlProfile)
+}
+
+func (c *cachedBackStore) DeleteSAMLProfile(ctx context.Context, entityID string) error {
+	return c.inner.DeleteSAMLProfile(ctx, entityID)
+}
+
 func (c *cachedBackStore) InboundClientExists(ctx context.Context, entityID string) (bool, error) {
 	return c.inner.InboundClientExists(ctx, entityID)
 }
@@ -120,6 +120,32 @@ func (c *compositeStore) DeleteOAuthProfile(ctx context.Context, entityID string
 	return c.dbStore.DeleteOAuthProfile(ctx, entityID)
 }
 
+// SAML profiles are not supported by declarative resources, hence they are served by the DB store.
+
+func (c *compositeStore) CreateSAMLProfile(ctx context.Context, entityID string,
+	samlProfile *inboundmodel.SAMLProfile) error {
+	return c.dbStore.CreateSAMLProfile(ctx, entityID, samlProfile)
+}
+
+func (c *compositeStore) GetSAMLProfileByEntityID(ctx context.Context, entityID string) (
+	*inboundmodel.SAMLProfile, error) {
+	return c.dbStore.GetSAMLProfileByEntityID(ctx, entityID)
+}
+
+func (c *compositeStore) GetSAMLProfileBySPEntityID(ctx context.Context, spEntityID string) (
+	string, *inboundmodel.SAMLProfile, error) {
+	return c.dbStore.GetSAMLProfileBySPEntityID(ctx, spEntityID)
+}
+
+func (c *compositeStore) UpdateSAMLProfile(ctx context.Context, entityID string,
+	samlProfile *inboundmodel.SAMLProfile) error {
+	return c.dbStore.UpdateSAMLProfile(ctx, entityID, samlProfile)
+}
+
+func (c *compositeStore) DeleteSAMLProfile(ctx context.Context, entityID string) error {
+	return c.dbStore.DeleteSAMLProfile(ctx, entityID)
+}
+
 func (c *compositeStore) InboundClientExists(ctx context.Context, entityID string) (bool, error) {
 	return declarativeresource.CompositeBooleanCheckHelper(
 		func() (bool, error) { return c.fileStore.InboundClientExists(ctx, entityID) },
@@ -67,6 +67,18 @@ var (
 	ErrOAuthInvalidRedirectURI = errors.New("invalid redirect URI")
 	// ErrOAuthRedirectURIFragmentNotAllowed is returned when a redirect URI contains a fragment.
 	ErrOAuthRedirectURIFragmentNotAllowed = errors.New("redirect URI must not contain a fragment")
+	// ErrOAuthInvalidPostLogoutRedirectURI is returned when a post logout redirect URI is invalid.
+	ErrOAuthInvalidPostLogoutRedirectURI = errors.New("invalid post logout redirect URI")
+	// ErrOAuthInvalidLogoutURI is returned when a back-channel or front-channel logout URI is invalid.
+	ErrOAuthInvalidLogoutURI = errors.New("invalid logout URI")
+	// ErrOAuthInvalidBackchannelConfig is returned when the CIBA token delivery mode or
+	// client notification endpoint is invalid.
+	ErrOAuthInvalidBackchannelConfig = errors.New("invalid backchannel authentication configuration")
+	// ErrOAuthInvalidRefreshTokenConfig is returned when the refresh token lifetimes are invalid.
+	ErrOAuthInvalidRefreshTokenConfig = errors.New("invalid refresh token configuration")
+	// ErrOAuthInvalidJWTBearerIssuer is returned when a JWT bearer allowed issuer is not a configured
+	// trusted issuer.
+	ErrOAuthInvalidJWTBearerIssuer = errors.New("invalid jwt bearer allowed issuer")
 	// ErrOAuthAuthCodeRequiresRedirectURIs is returned when authorization_code grant has no redirect URIs.
 	ErrOAuthAuthCodeRequiresRedirectURIs = errors.New("authorization_code grant requires redirect URIs")
 	// ErrOAuthInvalidGrantType is returned when an unsupported grant type is specified.
@@ -81,14 +93,25 @@ var (
 	ErrOAuthRefreshTokenCannotBeSoleGrant = errors.New("refresh_token cannot be the sole grant type")
 	// ErrOAuthPKCERequiresAuthCode is returned when PKCE is enabled without authorization_code grant.
 	ErrOAuthPKCERequiresAuthCode = errors.New("PKCE requires authorization_code grant type")
-	// ErrOAuthResponseTypesRequireAuthCode is returned when response types are set without authorization_code grant.
-	ErrOAuthResponseTypesRequireAuthCode = errors.New("response types require authorization_code grant type")
+	// ErrOAuthResponseTypesRequireAuthCode is returned when response types issuing a code are set without
+	// authorization_code grant.
+	ErrOAuthResponseTypesRequireAuthCode = errors.New(
+		"response types issuing a code require authorization_code grant type")
 	// ErrOAuthInvalidTokenEndpointAuthMethod is returned when an unsupported auth method is specified.
 	ErrOAuthInvalidTokenEndpointAuthMethod = errors.New("invalid token endpoint auth method")
 	// ErrOAuthPrivateKeyJWTRequiresCertificate is returned when private_key_jwt is used without a certificate.
 	ErrOAuthPrivateKeyJWTRequiresCertificate = errors.New("private_key_jwt requires a certificate")
 	// ErrOAuthPrivateKeyJWTCannotHaveClientSecret is returned when private_key_jwt is used with a client secret.
 	ErrOAuthPrivateKeyJWTCannotHaveClientSecret = errors.New("private_key_jwt cannot have a client secret")
+	// ErrOAuthTLSClientAuthRequiresSubjectDN is returned when tls_client_auth is used without a subject DN.
+	ErrOAuthTLSClientAuthRequiresSubjectDN = errors.New("tls_client_auth requires a certificate subject DN")
+	// ErrOAuthSelfSignedTLSClientAuthRequiresCertificate is returned when self_signed_tls_client_auth is used
+	// without a certificate.
+	ErrOAuthSelfSignedTLSClientAuthRequiresCertificate = errors.New(
+		"self_signed_tls_client_auth requires a certificate")
+	// ErrOAuthTLSClientAuthCannotHaveClientSecret is returned when a mutual-TLS auth method is used with
+	// a client secret.
+	ErrOAuthTLSClientAuthCannotHaveClientSecret = errors.New("mutual-TLS client auth cannot have a client secret")
 	// ErrOAuthClientSecretCannotHaveCertificate is returned when client-secret auth is used with a certificate.
 	ErrOAuthClientSecretCannotHaveCertificate = errors.New("client secret auth cannot have a certificate")
 	// ErrOAuthNoneAuthRequiresPublicClient is returned when none auth method is used without a public client.
@@ -102,6 +125,23 @@ var (
 	// ErrOAuthPublicClientMustHavePKCE is returned when a public client does not have PKCE required.
 	ErrOAuthPublicClientMustHavePKCE = errors.New("public client must have PKCE required")
 
+	// ErrSAMLMissingEntityID is returned when the SAML service provider entity ID is missing.
+	ErrSAMLMissingEntityID = errors.New("SAML service provider entity ID is required")
+	// ErrSAMLInvalidACSURL is returned when the assertion consumer service URLs are missing or invalid.
+	ErrSAMLInvalidACSURL = errors.New("invalid SAML assertion consumer service URL")
+	// ErrSAMLInvalidSingleLogoutURL is returned when the single logout URL is invalid.
+	ErrSAMLInvalidSingleLogoutURL = errors.New("invalid SAML single logout URL")
+	// ErrSAMLUnsupportedNameIDFormat is returned when the NameID format is not supported.
+	ErrSAMLUnsupportedNameIDFormat = errors.New("unsupported SAML NameID format")
+	// ErrSAMLInvalidCertificate is returned when the service provider certificate cannot be parsed.
+	ErrSAMLInvalidCertificate = errors.New("invalid SAML service provider certificate")
+	// ErrSAMLSignedRequestsRequireCertificate is returned when signed requests are required without a
+	// service provider certificate.
+	ErrSAMLSignedRequestsRequireCertificate = errors.New("signed SAML requests require a certificate")
+	// ErrSAMLEntityIDAlreadyExists is returned when the service provider entity ID is registered by
+	// another application.
+	ErrSAMLEntityIDAlreadyExists = errors.New("SAML service provider entity ID already exists")
+
 	// ErrCertValueRequired is returned when a certificate value is missing.
 	ErrCertValueRequired = errors.New("certificate value is required")
 	// ErrCertInvalidJWKSURI is returned when the JWKS URI is invalid.
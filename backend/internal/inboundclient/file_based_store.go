@@ -164,6 +164,35 @@ func (f *fileBasedStore) DeleteOAuthProfile(_ context.Context, _ string) error {
 	return errors.New("DeleteOAuthProfile is not supported in file-based store")
 }
 
+// CreateSAMLProfile is not supported in the file store.
+func (f *fileBasedStore) CreateSAMLProfile(_ context.Context, _ string, _ *inboundmodel.SAMLProfile) error {
+	return errors.New("CreateSAMLProfile is not supported in file-based store")
+}
+
+// GetSAMLProfileByEntityID always reports not found since declarative inbound clients do not
+// carry SAML profiles.
+func (f *fileBasedStore) GetSAMLProfileByEntityID(_ context.Context, _ string) (
+	*inboundmodel.SAMLProfile, error) {
+	return nil, ErrInboundClientNotFound
+}
+
+// GetSAMLProfileBySPEntityID always reports not found since declarative inbound clients do not
+// carry SAML profiles.
+func (f *fileBasedStore) GetSAMLProfileBySPEntityID(_ context.Context, _ string) (
+	string, *inboundmodel.SAMLProfile, error) {
+	return "", nil, ErrInboundClientNotFound
+}
+
+// UpdateSAMLProfile is not supported in the file store.
+func (f *fileBasedStore) UpdateSAMLProfile(_ context.Context, _ string, _ *inboundmodel.SAMLProfile) error {
+	return errors.New("UpdateSAMLProfile is not supported in file-based store")
+}
+
+// DeleteSAMLProfile is not supported in the file store.
+func (f *fileBasedStore) DeleteSAMLProfile(_ context.Context, _ string) error {
+	return errors.New("DeleteSAMLProfile is not supported in file-based store")
+}
+
 // InboundClientExists reports whether an inbound client with the given entity ID is present
 // in the file store.
 func (f *fileBasedStore) InboundClientExists(_ context.Context, entityID string) (bool, error) {
@@ -41,12 +41,15 @@ type InboundAuthType string
 const (
 	// OAuthInboundAuthType is the OAuth 2.0 inbound authentication type.
 	OAuthInboundAuthType InboundAuthType = "oauth2"
+	// SAMLInboundAuthType is the SAML 2.0 inbound authentication type.
+	SAMLInboundAuthType InboundAuthType = "saml2"
 )
 
-// OAuthTokenConfig wraps access and ID token configs.
+// OAuthTokenConfig wraps access, ID and refresh token configs.
 type OAuthTokenConfig struct {
-	AccessToken *AccessTokenConfig `json:"accessToken,omitempty" yaml:"access_token,omitempty" jsonschema:"Access token configuration."`
-	IDToken     *IDTokenConfig     `json:"idToken,omitempty"    yaml:"id_token,omitempty"     jsonschema:"ID token configuration."`
+	AccessToken  *AccessTokenConfig  `json:"accessToken,omitempty" yaml:"access_token,omitempty" jsonschema:"Access token configuration."`
+	IDToken      *IDTokenConfig      `json:"idToken,omitempty"      yaml:"id_token,omitempty"      jsonschema:"ID token configuration."`
+	RefreshToken *RefreshTokenConfig `json:"refreshToken,omitempty" yaml:"refresh_token,omitempty" jsonschema:"Refresh token configuration."`
 }
 
 // AccessTokenConfig is the access token configuration.
@@ -55,6 +58,13 @@ type AccessTokenConfig struct {
 	UserAttributes []string `json:"userAttributes,omitempty" yaml:"user_attributes,omitempty" jsonschema:"User attributes to embed in the access token."`
 }
 
+// RefreshTokenConfig is the refresh token configuration. ValidityPeriod is the idle lifetime of a refresh
+// token, restarted on each rotation, and AbsoluteValidityPeriod caps the lifetime of the whole grant.
+type RefreshTokenConfig struct {
+	ValidityPeriod         int64 `json:"validityPeriod,omitempty"         yaml:"validity_period,omitempty"          jsonschema:"Refresh token idle lifetime in seconds. Each rotation starts a new period."`
+	AbsoluteValidityPeriod int64 `json:"absoluteValidityPeriod,omitempty" yaml:"absolute_validity_period,omitempty" jsonschema:"Maximum lifetime in seconds of a refresh token grant, counted from the initial authorization."`
+}
+
 // IDTokenConfig is the ID token configuration.
 type IDTokenConfig struct {
 	ValidityPeriod int64               `json:"validityPeriod,omitempty" yaml:"validity_period,omitempty" jsonschema:"ID token validity period in seconds."`
@@ -122,6 +132,16 @@ type OAuthProfile struct {
 	ScopeClaims                        map[string][]string `json:"scopeClaims,omitempty"`
 	Certificate                        *Certificate        `json:"certificate,omitempty"`
 	AcrValues                          []string            `json:"acrValues,omitempty"`
+	PostLogoutRedirectURIs             []string            `json:"postLogoutRedirectUris,omitempty"`
+	BackChannelLogoutURI               string              `json:"backchannelLogoutUri,omitempty"`
+	FrontChannelLogoutURI              string              `json:"frontchannelLogoutUri,omitempty"`
+	BackchannelTokenDeliveryMode       string              `json:"backchannelTokenDeliveryMode,omitempty"`
+	BackchannelNotificationEndpoint    string              `json:"backchannelNotificationEndpoint,omitempty"`
+	JWTBearerAllowedIssuers            []string            `json:"jwtBearerAllowedIssuers,omitempty"`
+	TLSClientAuthSubjectDN             string              `json:"tlsClientAuthSubjectDn,omitempty"`
+	CertificateBoundAccessTokens       bool                `json:"tlsClientCertificateBoundAccessTokens"`
+	DPoPBoundAccessTokens              bool                `json:"dpopBoundAccessTokens"`
+	RequireSignedRequestObject         bool                `json:"requireSignedRequestObject"`
 }
 
 // OAuthConfigWithSecret is the wire input shape and the create/update echo response shape.
@@ -142,6 +162,16 @@ type OAuthConfigWithSecret struct {
 	ScopeClaims                        map[string][]string                 `json:"scopeClaims,omitempty"                       yaml:"scope_claims,omitempty"                       jsonschema:"Scope-to-claims mapping. Maps OAuth scopes to user claims for both ID token and userinfo."`
 	Certificate                        *Certificate                        `json:"certificate,omitempty"                       yaml:"certificate,omitempty"                        jsonschema:"Application certificate. Optional. For certificate-based authentication or JWT validation."`
 	AcrValues                          []string                            `json:"acrValues,omitempty"                         yaml:"acr_values,omitempty"                         jsonschema:"Default ACR values applied when the request does not specify acr_values."`
+	PostLogoutRedirectURIs             []string                            `json:"postLogoutRedirectUris,omitempty"            yaml:"post_logout_redirect_uris,omitempty"          jsonschema:"Allowed post logout redirect URIs for OIDC RP-initiated logout."`
+	BackChannelLogoutURI               string                              `json:"backchannelLogoutUri,omitempty"              yaml:"backchannel_logout_uri,omitempty"             jsonschema:"URI to which back-channel logout tokens are sent when the user's session ends."`
+	FrontChannelLogoutURI              string                              `json:"frontchannelLogoutUri,omitempty"             yaml:"frontchannel_logout_uri,omitempty"            jsonschema:"URI rendered in an iframe by the logout page to clear the user's session at the client."`
+	BackchannelTokenDeliveryMode       string                              `json:"backchannelTokenDeliveryMode,omitempty"      yaml:"backchannel_token_delivery_mode,omitempty"    jsonschema:"CIBA token delivery mode: 'poll' (default) or 'ping'."`
+	BackchannelNotificationEndpoint    string                              `json:"backchannelNotificationEndpoint,omitempty"   yaml:"backchannel_notification_endpoint,omitempty"  jsonschema:"Endpoint notified when a CIBA request completes. Required for the 'ping' delivery mode."`
+	JWTBearerAllowedIssuers            []string                            `json:"jwtBearerAllowedIssuers,omitempty"           yaml:"jwt_bearer_allowed_issuers,omitempty"         jsonschema:"Trusted issuers whose assertions this client may exchange using the JWT bearer grant (RFC 7523)."`
+	TLSClientAuthSubjectDN             string                              `json:"tlsClientAuthSubjectDn,omitempty"            yaml:"tls_client_auth_subject_dn,omitempty"         jsonschema:"Expected subject DN of the client certificate for the 'tls_client_auth' authentication method (RFC 8705)."`
+	CertificateBoundAccessTokens       bool                                `json:"tlsClientCertificateBoundAccessTokens"       yaml:"tls_client_certificate_bound_access_tokens"   jsonschema:"Bind issued access tokens to the mutual-TLS client certificate (RFC 8705)."`
+	DPoPBoundAccessTokens              bool                                `json:"dpopBoundAccessTokens"                       yaml:"dpop_bound_access_tokens"                     jsonschema:"Require DPoP proofs at the token endpoint and bind issued tokens to the proof key (RFC 9449)."`
+	RequireSignedRequestObject         bool                                `json:"requireSignedRequestObject"                  yaml:"require_signed_request_object"                jsonschema:"Require authorization requests to be sent as signed request objects (RFC 9101)."`
 }
 
 // OAuthConfig is the wire output shape (GET responses). ClientSecret is structurally absent.
@@ -161,6 +191,16 @@ type OAuthConfig struct {
 	ScopeClaims                        map[string][]string                 `json:"scopeClaims,omitempty"`
 	Certificate                        *Certificate                        `json:"certificate,omitempty"`
 	AcrValues                          []string                            `json:"acrValues,omitempty"`
+	PostLogoutRedirectURIs             []string                            `json:"postLogoutRedirectUris,omitempty"`
+	BackChannelLogoutURI               string                              `json:"backchannelLogoutUri,omitempty"`
+	FrontChannelLogoutURI              string                              `json:"frontchannelLogoutUri,omitempty"`
+	BackchannelTokenDeliveryMode       string                              `json:"backchannelTokenDeliveryMode,omitempty"`
+	BackchannelNotificationEndpoint    string                              `json:"backchannelNotificationEndpoint,omitempty"`
+	JWTBearerAllowedIssuers            []string                            `json:"jwtBearerAllowedIssuers,omitempty"`
+	TLSClientAuthSubjectDN             string                              `json:"tlsClientAuthSubjectDn,omitempty"`
+	CertificateBoundAccessTokens       bool                                `json:"tlsClientCertificateBoundAccessTokens"`
+	DPoPBoundAccessTokens              bool                                `json:"dpopBoundAccessTokens"`
+	RequireSignedRequestObject         bool                                `json:"requireSignedRequestObject"`
 }
 
 // SupportedIDTokenEncryptionAlgs lists JWE key-management algorithms supported for ID token encryption.
@@ -187,6 +227,16 @@ type OAuthClient struct {
 	ScopeClaims                        map[string][]string                 `yaml:"scope_claims,omitempty"`
 	Certificate                        *Certificate                        `yaml:"certificate,omitempty"`
 	AcrValues                          []string                            `yaml:"acr_values,omitempty"`
+	PostLogoutRedirectURIs             []string                            `yaml:"post_logout_redirect_uris,omitempty"`
+	BackChannelLogoutURI               string                              `yaml:"backchannel_logout_uri,omitempty"`
+	FrontChannelLogoutURI              string                              `yaml:"frontchannel_logout_uri,omitempty"`
+	BackchannelTokenDeliveryMode       string                              `yaml:"backchannel_token_delivery_mode,omitempty"`
+	BackchannelNotificationEndpoint    string                              `yaml:"backchannel_notification_endpoint,omitempty"`
+	JWTBearerAllowedIssuers            []string                            `yaml:"jwt_bearer_allowed_issuers,omitempty"`
+	TLSClientAuthSubjectDN             string                              `yaml:"tls_client_auth_subject_dn,omitempty"`
+	CertificateBoundAccessTokens       bool                                `yaml:"tls_client_certificate_bound_access_tokens,omitempty"`
+	DPoPBoundAccessTokens              bool                                `yaml:"dpop_bound_access_tokens,omitempty"`
+	RequireSignedRequestObject         bool                                `yaml:"require_signed_request_object,omitempty"`
 }
 
 // IsAllowedGrantType reports whether the given grant type is allowed for this client.
@@ -194,6 +244,11 @@ func (o *OAuthClient) IsAllowedGrantType(grantType oauth2const.GrantType) bool {
 	return IsAllowedGrantType(o.GrantTypes, grantType)
 }
 
+// IsAllowedJWTBearerIssuer reports whether this client may exchange assertions from the given issuer.
+func (o *OAuthClient) IsAllowedJWTBearerIssuer(issuer string) bool {
+	return issuer != "" && slices.Contains(o.JWTBearerAllowedIssuers, issuer)
+}
+
 // IsAllowedResponseType reports whether the given response type is allowed for this client.
 func (o *OAuthClient) IsAllowedResponseType(responseType string) bool {
 	return IsAllowedResponseType(o.ResponseTypes, responseType)
@@ -219,22 +274,30 @@ func (o *OAuthClient) RequiresPAR() bool {
 	return o.RequirePushedAuthorizationRequests || config.GetServerRuntime().Config.OAuth.PAR.RequirePAR
 }
 
+// RequiresSignedRequestObject reports whether authorization requests must be sent as signed request objects.
+func (o *OAuthClient) RequiresSignedRequestObject() bool {
+	return o.RequireSignedRequestObject || config.GetServerRuntime().Config.OAuth.RequestObject.RequireSignedRequestObject
+}
+
 // InboundAuthConfigWithSecret is the wire input wrapper and create/update echo response wrapper.
 type InboundAuthConfigWithSecret struct {
 	Type        InboundAuthType        `json:"type"             yaml:"type"             jsonschema:"Inbound authentication type. Use 'oauth2' for OAuth/OIDC applications."`
 	OAuthConfig *OAuthConfigWithSecret `json:"config,omitempty" yaml:"config,omitempty" jsonschema:"OAuth/OIDC configuration. Required when type is 'oauth2'. Defines OAuth grant types, redirect URIs, client authentication, and PKCE settings."`
+	SAMLConfig  *SAMLProfile           `json:"-"                yaml:"-"`
 }
 
 // InboundAuthConfig is the wire output wrapper (GET responses).
 type InboundAuthConfig struct {
 	Type        InboundAuthType `json:"type"`
 	OAuthConfig *OAuthConfig    `json:"config,omitempty"`
+	SAMLConfig  *SAMLProfile    `json:"-"`
 }
 
 // InboundAuthConfigProcessed is the runtime wrapper.
 type InboundAuthConfigProcessed struct {
-	Type        InboundAuthType `json:"type"             yaml:"type,omitempty"`
-	OAuthConfig *OAuthClient    `json:"config,omitempty" yaml:"config,omitempty"`
+	Type        InboundAuthType `json:"type"                 yaml:"type,omitempty"`
+	OAuthConfig *OAuthClient    `json:"config,omitempty"     yaml:"config,omitempty"`
+	SAMLConfig  *SAMLProfile    `json:"samlConfig,omitempty" yaml:"saml_config,omitempty"`
 }
 
 // IsAllowedGrantType reports whether the given grant type is in the allowed list.
@@ -245,12 +308,16 @@ func IsAllowedGrantType(grantTypes []oauth2const.GrantType, grantType oauth2cons
 	return slices.Contains(grantTypes, grantType)
 }
 
-// IsAllowedResponseType reports whether the given response type is in the allowed list.
+// IsAllowedResponseType reports whether the given response type is in the allowed list. The order of the
+// values of multi-valued response types is not significant.
 func IsAllowedResponseType(responseTypes []oauth2const.ResponseType, responseType string) bool {
 	if responseType == "" {
 		return false
 	}
-	return slices.Contains(responseTypes, oauth2const.ResponseType(responseType))
+	normalized := oauth2const.NormalizeResponseType(responseType)
+	return slices.ContainsFunc(responseTypes, func(allowed oauth2const.ResponseType) bool {
+		return oauth2const.NormalizeResponseType(string(allowed)) == normalized
+	})
 }
 
 // ValidateRedirectURI validates the provided redirect URI against the registered list.
@@ -162,6 +162,18 @@ func (suite *OAuthClientTestSuite) TestIsAllowedResponseType_NotAllowed() {
 	suite.False(c.IsAllowedResponseType("token"))
 }
 
+func (suite *OAuthClientTestSuite) TestIsAllowedResponseType_HybridInAnyOrder() {
+	c := &model.OAuthClient{
+		ResponseTypes: []oauth2const.ResponseType{
+			oauth2const.ResponseTypeCodeIDTokenToken,
+		},
+	}
+
+	suite.True(c.IsAllowedResponseType("code id_token token"))
+	suite.True(c.IsAllowedResponseType("token id_token code"))
+	suite.False(c.IsAllowedResponseType("code id_token"))
+}
+
 func (suite *OAuthClientTestSuite) TestIsAllowedResponseType_EmptyResponseType() {
 	c := &model.OAuthClient{
 		ResponseTypes: []oauth2const.ResponseType{
@@ -202,6 +214,17 @@ func (suite *OAuthClientTestSuite) TestIsAllowedResponseType_MultipleResponseTyp
 	suite.True(c.IsAllowedResponseType("id_token"))
 }
 
+func (suite *OAuthClientTestSuite) TestIsAllowedJWTBearerIssuer() {
+	c := &model.OAuthClient{
+		JWTBearerAllowedIssuers: []string{"https://idp.example.com"},
+	}
+
+	suite.True(c.IsAllowedJWTBearerIssuer("https://idp.example.com"))
+	suite.False(c.IsAllowedJWTBearerIssuer("https://other.example.com"))
+	suite.False(c.IsAllowedJWTBearerIssuer(""))
+	suite.False((&model.OAuthClient{}).IsAllowedJWTBearerIssuer("https://idp.example.com"))
+}
+
 func (suite *OAuthClientTestSuite) TestIsAllowedTokenEndpointAuthMethod_ClientSecretBasic() {
 	c := &model.OAuthClient{
 		TokenEndpointAuthMethod: oauth2const.TokenEndpointAuthMethodClientSecretBasic,
@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+//nolint:lll
+package model
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"slices"
+)
+
+// SAMLProfile is the SAML 2.0 service provider configuration of an application. It is used as the
+// wire shape of the 'saml2' inbound auth config and as the persistence shape (SAML_CONFIG column).
+type SAMLProfile struct {
+	EntityID                     string            `json:"entityId"                          yaml:"entity_id"                          jsonschema:"Entity ID of the service provider. Must match the Issuer of the requests sent by the service provider."`
+	AssertionConsumerServiceURLs []string          `json:"assertionConsumerServiceUrls"      yaml:"assertion_consumer_service_urls"    jsonschema:"Assertion consumer service URLs of the service provider. The first URL is used when a request does not specify one."`
+	Audiences                    []string          `json:"audiences,omitempty"               yaml:"audiences,omitempty"                jsonschema:"Additional audiences to include in the assertion audience restriction. The entity ID is always included."`
+	NameIDFormat                 string            `json:"nameIdFormat,omitempty"            yaml:"name_id_format,omitempty"           jsonschema:"NameID format of the assertion subject. Defaults to unspecified."`
+	NameIDAttribute              string            `json:"nameIdAttribute,omitempty"         yaml:"name_id_attribute,omitempty"        jsonschema:"User attribute used as the NameID value. Defaults to the user ID."`
+	AttributeMapping             map[string]string `json:"attributeMapping,omitempty"        yaml:"attribute_mapping,omitempty"        jsonschema:"Mapping of SAML attribute names to the user attributes released in the assertion."`
+	SingleLogoutURL              string            `json:"singleLogoutUrl,omitempty"         yaml:"single_logout_url,omitempty"        jsonschema:"Single logout service URL of the service provider (HTTP-Redirect binding)."`
+	Certificate                  string            `json:"certificate,omitempty"             yaml:"certificate,omitempty"              jsonschema:"PEM encoded X.509 signing certificate of the service provider."`
+	RequireSignedRequests        bool              `json:"requireSignedRequests,omitempty"   yaml:"require_signed_requests,omitempty"  jsonschema:"Reject authentication and logout requests that are not signed with the service provider certificate."`
+	AssertionValidityPeriod      int64             `json:"assertionValidityPeriod,omitempty" yaml:"assertion_validity_period,omitempty" jsonschema:"Assertion validity period in seconds."`
+}
+
+// SAMLServiceProvider is the runtime representation of a SAML 2.0 service provider.
+type SAMLServiceProvider struct {
+	SAMLProfile
+	AppID string
+	OUID  string
+}
+
+// IsRegisteredACSURL reports whether the URL is one of the registered assertion consumer service URLs.
+func (p *SAMLProfile) IsRegisteredACSURL(acsURL string) bool {
+	return slices.Contains(p.AssertionConsumerServiceURLs, acsURL)
+}
+
+// GetDefaultACSURL returns the assertion consumer service URL used when a request does not specify one.
+func (p *SAMLProfile) GetDefaultACSURL() string {
+	if len(p.AssertionConsumerServiceURLs) == 0 {
+		return ""
+	}
+	return p.AssertionConsumerServiceURLs[0]
+}
+
+// GetAudiences returns the audiences of the assertions issued to the service provider.
+func (p *SAMLProfile) GetAudiences() []string {
+	audiences := []string{p.EntityID}
+	for _, audience := range p.Audiences {
+		if !slices.Contains(audiences, audience) {
+			audiences = append(audiences, audience)
+		}
+	}
+	return audiences
+}
+
+// GetCertificate parses the PEM encoded signing certificate of the service provider. Returns nil
+// when no certificate is configured.
+func (p *SAMLProfile) GetCertificate() (*x509.Certificate, error) {
+	if p.Certificate == "" {
+		return nil, nil
+	}
+	block, _ := pem.Decode([]byte(p.Certificate))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("certificate is not a PEM encoded X.509 certificate")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// inboundAuthConfigJSON is the wire shape shared by the inbound auth config wrappers, where the
+// structure of config depends on the type.
+type inboundAuthConfigJSON struct {
+	Type   InboundAuthType `json:"type"`
+	Config json.RawMessage `json:"config,omitempty"`
+}
+
+// UnmarshalJSON decodes the config into the OAuth or SAML configuration based on the type.
+func (c *InboundAuthConfigWithSecret) UnmarshalJSON(data []byte) error {
+	var raw inboundAuthConfigJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*c = InboundAuthConfigWithSecret{Type: raw.Type}
+	if isNullJSON(raw.Config) {
+		return nil
+	}
+	if raw.Type == SAMLInboundAuthType {
+		c.SAMLConfig = &SAMLProfile{}
+		return json.Unmarshal(raw.Config, c.SAMLConfig)
+	}
+	c.OAuthConfig = &OAuthConfigWithSecret{}
+	return json.Unmarshal(raw.Config, c.OAuthConfig)
+}
+
+// MarshalJSON encodes the OAuth or SAML configuration as the config based on the type.
+func (c InboundAuthConfigWithSecret) MarshalJSON() ([]byte, error) {
+	if c.Type == SAMLInboundAuthType {
+		return marshalInboundAuthConfig(c.Type, c.SAMLConfig)
+	}
+	return marshalInboundAuthConfig(c.Type, c.OAuthConfig)
+}
+
+// UnmarshalJSON decodes the config into the OAuth or SAML configuration based on the type.
+func (c *InboundAuthConfig) UnmarshalJSON(data []byte) error {
+	var raw inboundAuthConfigJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*c = InboundAuthConfig{Type: raw.Type}
+	if isNullJSON(raw.Config) {
+		return nil
+	}
+	if raw.Type == SAMLInboundAuthType {
+		c.SAMLConfig = &SAMLProfile{}
+		return json.Unmarshal(raw.Config, c.SAMLConfig)
+	}
+	c.OAuthConfig = &OAuthConfig{}
+	return json.Unmarshal(raw.Config, c.OAuthConfig)
+}
+
+// MarshalJSON encodes the OAuth or SAML configuration as the config based on the type.
+func (c InboundAuthConfig) MarshalJSON() ([]byte, error) {
+	if c.Type == SAMLInboundAuthType {
+		return marshalInboundAuthConfig(c.Type, c.SAMLConfig)
+	}
+	return marshalInboundAuthConfig(c.Type, c.OAuthConfig)
+}
+
+// marshalInboundAuthConfig encodes an inbound auth config wrapper, omitting a nil config.
+func marshalInboundAuthConfig[T any](authType InboundAuthType, config *T) ([]byte, error) {
+	out := struct {
+		Type   InboundAuthType `json:"type"`
+		Config *T              `json:"config,omitempty"`
+	}{Type: authType, Config: config}
+	return json.Marshal(out)
+}
+
+// isNullJSON reports whether a raw JSON value is absent or null.
+func isNullJSON(raw json.RawMessage) bool {
+	return len(raw) == 0 || string(raw) == "null"
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package model_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/inboundclient/model"
+)
+
+type SAMLProfileTestSuite struct {
+	suite.Suite
+}
+
+func TestSAMLProfileTestSuite(t *testing.T) {
+	suite.Run(t, new(SAMLProfileTestSuite))
+}
+
+func (suite *SAMLProfileTestSuite) TestUnmarshalInboundAuthConfig_RoutesConfigByType() {
+	var configs []model.InboundAuthConfigWithSecret
+	err := json.Unmarshal([]byte(`[
+		{"type":"oauth2","config":{"clientId":"client-1"}},
+		{"type":"saml2","config":{"entityId":"https://sp.example.com",
+			"assertionConsumerServiceUrls":["https://sp.example.com/acs"]}}
+	]`), &configs)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(configs, 2)
+	suite.Equal("client-1", configs[0].OAuthConfig.ClientID)
+	suite.Nil(configs[0].SAMLConfig)
+	suite.Equal(model.SAMLInboundAuthType, configs[1].Type)
+	suite.Nil(configs[1].OAuthConfig)
+	suite.Equal("https://sp.example.com", configs[1].SAMLConfig.EntityID)
+}
+
+func (suite *SAMLProfileTestSuite) TestMarshalInboundAuthConfig_RoundTrip() {
+	in := model.InboundAuthConfig{
+		Type:       model.SAMLInboundAuthType,
+		SAMLConfig: &model.SAMLProfile{EntityID: "https://sp.example.com"},
+	}
+
+	data, err := json.Marshal(in)
+	suite.Require().NoError(err)
+	suite.JSONEq(`{"type":"saml2","config":{"entityId":"https://sp.example.com",
+		"assertionConsumerServiceUrls":null}}`, string(data))
+
+	var out model.InboundAuthConfig
+	suite.Require().NoError(json.Unmarshal(data, &out))
+	suite.Equal(in, out)
+}
+
+func (suite *SAMLProfileTestSuite) TestMarshalInboundAuthConfig_OmitsNilConfig() {
+	data, err := json.Marshal(model.InboundAuthConfigWithSecret{Type: model.OAuthInboundAuthType})
+
+	suite.Require().NoError(err)
+	suite.JSONEq(`{"type":"oauth2"}`, string(data))
+}
+
+func (suite *SAMLProfileTestSuite) TestGetAudiences_IncludesEntityIDOnce() {
+	p := model.SAMLProfile{
+		EntityID:  "https://sp.example.com",
+		Audiences: []string{"https://sp.example.com", "urn:example:audience"},
+	}
+
+	suite.Equal([]string{"https://sp.example.com", "urn:example:audience"}, p.GetAudiences())
+}
+
+func (suite *SAMLProfileTestSuite) TestACSURLs() {
+	p := model.SAMLProfile{AssertionConsumerServiceURLs: []string{"https://sp/acs", "https://sp/acs2"}}
+
+	suite.Equal("https://sp/acs", p.GetDefaultACSURL())
+	suite.True(p.IsRegisteredACSURL("https://sp/acs2"))
+	suite.False(p.IsRegisteredACSURL("https://sp/other"))
+	suite.Empty((&model.SAMLProfile{}).GetDefaultACSURL())
+}
+
+func (suite *SAMLProfileTestSuite) TestGetCertificate_RejectsNonPEM() {
+	cert, err := (&model.SAMLProfile{Certificate: "invalid"}).GetCertificate()
+
+	suite.Error(err)
+	suite.Nil(cert)
+}
@@ -36,6 +36,7 @@ import (
 	flowmgt "github.com/asgardeo/thunder/internal/flow/mgt"
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
 	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	samlconst "github.com/asgardeo/thunder/internal/saml/saml2/constants"
 	"github.com/asgardeo/thunder/internal/system/config"
 	serverconst "github.com/asgardeo/thunder/internal/system/constants"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
@@ -70,6 +71,14 @@ type InboundClientServiceInterface interface {
 	// GetOAuthClientByClientID resolves a full OAuthClient by its public client_id.
 	GetOAuthClientByClientID(ctx context.Context, clientID string) (*inboundmodel.OAuthClient, error)
 
+	// SyncSAMLProfile validates and creates, updates, or deletes the SAML profile of the given entity
+	// to match the desired state.
+	SyncSAMLProfile(ctx context.Context, entityID string, samlProfile *inboundmodel.SAMLProfile) error
+	// GetSAMLProfileByEntityID returns the stored SAML profile for the given entity.
+	GetSAMLProfileByEntityID(ctx context.Context, entityID string) (*inboundmodel.SAMLProfile, error)
+	// GetSAMLServiceProvider resolves a SAML service provider by its entity ID.
+	GetSAMLServiceProvider(ctx context.Context, spEntityID string) (*inboundmodel.SAMLServiceProvider, error)
+
 	// IsDeclarative reports whether the entity's inbound profile was loaded from a declarative resource file.
 	IsDeclarative(ctx context.Context, entityID string) bool
 	// LoadDeclarativeResources loads inbound client profiles from YAML resource files.
@@ -403,6 +412,85 @@ func (s *inboundClientService) GetOAuthClientByClientID(ctx context.Context, cli
 	return client, nil
 }
 
+// SyncSAMLProfile validates and creates, updates, or deletes the SAML profile of the given entity
+// to match the desired state.
+func (s *inboundClientService) SyncSAMLProfile(ctx context.Context, entityID string,
+	samlProfile *inboundmodel.SAMLProfile) error {
+	if s.store.IsDeclarative(ctx, entityID) {
+		return ErrCannotModifyDeclarative
+	}
+	if samlProfile != nil {
+		if err := ValidateSAMLProfile(samlProfile); err != nil {
+			return err
+		}
+	}
+	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		if samlProfile != nil {
+			ownerID, _, err := s.store.GetSAMLProfileBySPEntityID(txCtx, samlProfile.EntityID)
+			if err != nil && !errors.Is(err, ErrInboundClientNotFound) {
+				return err
+			}
+			if ownerID != "" && ownerID != entityID {
+				return ErrSAMLEntityIDAlreadyExists
+			}
+		}
+
+		existing, err := s.store.GetSAMLProfileByEntityID(txCtx, entityID)
+		if err != nil && !errors.Is(err, ErrInboundClientNotFound) {
+			return err
+		}
+		switch {
+		case samlProfile != nil && existing != nil:
+			return s.store.UpdateSAMLProfile(txCtx, entityID, samlProfile)
+		case samlProfile != nil && existing == nil:
+			return s.store.CreateSAMLProfile(txCtx, entityID, samlProfile)
+		case samlProfile == nil && existing != nil:
+			return s.store.DeleteSAMLProfile(txCtx, entityID)
+		default:
+			return nil
+		}
+	})
+}
+
+// GetSAMLProfileByEntityID returns the stored SAML profile for the given entity.
+func (s *inboundClientService) GetSAMLProfileByEntityID(ctx context.Context, entityID string) (
+	*inboundmodel.SAMLProfile, error) {
+	return s.store.GetSAMLProfileByEntityID(ctx, entityID)
+}
+
+// GetSAMLServiceProvider resolves a SAML service provider by its entity ID. Returns nil when no
+// application has registered the entity ID.
+func (s *inboundClientService) GetSAMLServiceProvider(ctx context.Context, spEntityID string) (
+	*inboundmodel.SAMLServiceProvider, error) {
+	if s.entityProvider == nil {
+		return nil, fmt.Errorf("entity provider not configured")
+	}
+	if spEntityID == "" {
+		return nil, nil
+	}
+
+	entityID, samlProfile, err := s.store.GetSAMLProfileBySPEntityID(ctx, spEntityID)
+	if err != nil {
+		if errors.Is(err, ErrInboundClientNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	entity, epErr := s.entityProvider.GetEntity(entityID)
+	if epErr != nil {
+		if epErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to load entity for SAML service provider: %w", epErr)
+	}
+
+	return &inboundmodel.SAMLServiceProvider{
+		SAMLProfile: *samlProfile,
+		AppID:       entityID,
+		OUID:        entity.OUID,
+	}, nil
+}
+
 // BuildOAuthClient assembles an OAuthClient from a stored OAuthProfile and entity context.
 func BuildOAuthClient(entityID, clientID, ouID string, p *inboundmodel.OAuthProfile) *inboundmodel.OAuthClient {
 	client := &inboundmodel.OAuthClient{
@@ -420,6 +508,16 @@ func BuildOAuthClient(entityID, clientID, ouID string, p *inboundmodel.OAuthProf
 		UserInfo:                           p.UserInfo,
 		Certificate:                        p.Certificate,
 		AcrValues:                          p.AcrValues,
+		PostLogoutRedirectURIs:             p.PostLogoutRedirectURIs,
+		BackChannelLogoutURI:               p.BackChannelLogoutURI,
+		FrontChannelLogoutURI:              p.FrontChannelLogoutURI,
+		BackchannelTokenDeliveryMode:       p.BackchannelTokenDeliveryMode,
+		BackchannelNotificationEndpoint:    p.BackchannelNotificationEndpoint,
+		JWTBearerAllowedIssuers:            p.JWTBearerAllowedIssuers,
+		TLSClientAuthSubjectDN:             p.TLSClientAuthSubjectDN,
+		CertificateBoundAccessTokens:       p.CertificateBoundAccessTokens,
+		DPoPBoundAccessTokens:              p.DPoPBoundAccessTokens,
+		RequireSignedRequestObject:         p.RequireSignedRequestObject,
 	}
 	for _, gt := range p.GrantTypes {
 		client.GrantTypes = append(client.GrantTypes, oauth2const.GrantType(gt))
@@ -612,6 +710,91 @@ func validateOAuthProfile(p *inboundmodel.OAuthProfile, hasClientSecret bool) er
 	if err := validateIDTokenConfig(p); err != nil {
 		return err
 	}
+	if err := validateRefreshTokenConfig(p); err != nil {
+		return err
+	}
+	if err := validateBackchannelConfig(p); err != nil {
+		return err
+	}
+	if err := validateJWTBearerIssuers(p); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateSAMLProfile validates the SAML service provider configuration of an application.
+func ValidateSAMLProfile(p *inboundmodel.SAMLProfile) error {
+	if strings.TrimSpace(p.EntityID) == "" {
+		return ErrSAMLMissingEntityID
+	}
+	if len(p.AssertionConsumerServiceURLs) == 0 {
+		return ErrSAMLInvalidACSURL
+	}
+	for _, acsURL := range p.AssertionConsumerServiceURLs {
+		if !isAbsoluteURIWithoutWildcard(acsURL) {
+			return ErrSAMLInvalidACSURL
+		}
+	}
+	if p.SingleLogoutURL != "" && !isAbsoluteURIWithoutWildcard(p.SingleLogoutURL) {
+		return ErrSAMLInvalidSingleLogoutURL
+	}
+	if p.NameIDFormat != "" && !slices.Contains(samlconst.SupportedNameIDFormats, p.NameIDFormat) {
+		return ErrSAMLUnsupportedNameIDFormat
+	}
+	if _, err := p.GetCertificate(); err != nil {
+		return ErrSAMLInvalidCertificate
+	}
+	if p.RequireSignedRequests && p.Certificate == "" {
+		return ErrSAMLSignedRequestsRequireCertificate
+	}
+	return nil
+}
+
+// validateRefreshTokenConfig validates the refresh token lifetimes. The idle lifetime of a refresh token
+// cannot exceed the absolute lifetime of its grant.
+func validateRefreshTokenConfig(p *inboundmodel.OAuthProfile) error {
+	if p.Token == nil || p.Token.RefreshToken == nil {
+		return nil
+	}
+	cfg := p.Token.RefreshToken
+	if cfg.ValidityPeriod < 0 || cfg.AbsoluteValidityPeriod < 0 {
+		return ErrOAuthInvalidRefreshTokenConfig
+	}
+	if cfg.ValidityPeriod > 0 && cfg.AbsoluteValidityPeriod > 0 && cfg.ValidityPeriod > cfg.AbsoluteValidityPeriod {
+		return ErrOAuthInvalidRefreshTokenConfig
+	}
+	return nil
+}
+
+// validateBackchannelConfig validates the CIBA token delivery mode and client notification endpoint.
+// The ping mode requires a notification endpoint; the poll mode (the default) ignores it.
+func validateBackchannelConfig(p *inboundmodel.OAuthProfile) error {
+	switch p.BackchannelTokenDeliveryMode {
+	case "", oauth2const.BackchannelTokenDeliveryModePoll:
+	case oauth2const.BackchannelTokenDeliveryModePing:
+		if p.BackchannelNotificationEndpoint == "" {
+			return ErrOAuthInvalidBackchannelConfig
+		}
+	default:
+		return ErrOAuthInvalidBackchannelConfig
+	}
+	if p.BackchannelNotificationEndpoint != "" && !isAbsoluteURIWithoutWildcard(p.BackchannelNotificationEndpoint) {
+		return ErrOAuthInvalidBackchannelConfig
+	}
+	return nil
+}
+
+// validateJWTBearerIssuers checks that every JWT bearer allowed issuer is a configured trusted issuer.
+func validateJWTBearerIssuers(p *inboundmodel.OAuthProfile) error {
+	if len(p.JWTBearerAllowedIssuers) == 0 {
+		return nil
+	}
+	jwtBearerConfig := config.GetServerRuntime().Config.OAuth.JWTBearer
+	for _, issuer := range p.JWTBearerAllowedIssuers {
+		if jwtBearerConfig.GetTrustedIssuer(issuer) == nil {
+			return ErrOAuthInvalidJWTBearerIssuer
+		}
+	}
 	return nil
 }
 
@@ -762,9 +945,27 @@ func validateRedirectURIs(p *inboundmodel.OAuthProfile) error {
 		len(p.RedirectURIs) == 0 {
 		return ErrOAuthAuthCodeRequiresRedirectURIs
 	}
+	// Post logout redirect URIs are matched exactly, hence wildcards are not permitted.
+	for _, postLogoutRedirectURI := range p.PostLogoutRedirectURIs {
+		if !isAbsoluteURIWithoutWildcard(postLogoutRedirectURI) {
+			return ErrOAuthInvalidPostLogoutRedirectURI
+		}
+	}
+	for _, logoutURI := range []string{p.BackChannelLogoutURI, p.FrontChannelLogoutURI} {
+		if logoutURI != "" && !isAbsoluteURIWithoutWildcard(logoutURI) {
+			return ErrOAuthInvalidLogoutURI
+		}
+	}
 	return nil
 }
 
+// isAbsoluteURIWithoutWildcard reports whether the URI is an absolute URI without a fragment or wildcards.
+func isAbsoluteURIWithoutWildcard(uri string) bool {
+	parsedURI, err := sysutils.ParseURL(uri)
+	return err == nil && parsedURI.Scheme != "" && parsedURI.Host != "" && parsedURI.Fragment == "" &&
+		!strings.ContainsRune(uri, '*')
+}
+
 // validateHostWildcardPattern enforces structural rules for wildcards in the host
 // component: no * in the port portion of host:port, and no whole-label *. * matches one
 // or more alphanumeric characters at match time, enforced by the matcher itself.
@@ -814,11 +1015,11 @@ func validateGrantAndResponseTypes(p *inboundmodel.OAuthProfile) error {
 		len(p.ResponseTypes) > 0 {
 		return ErrOAuthClientCredentialsCannotUseResponseTypes
 	}
-	if slices.Contains(p.GrantTypes, string(oauth2const.GrantTypeAuthorizationCode)) {
-		if len(p.ResponseTypes) == 0 ||
-			!slices.Contains(p.ResponseTypes, string(oauth2const.ResponseTypeCode)) {
-			return ErrOAuthAuthCodeRequiresCodeResponseType
-		}
+	issuesCode := slices.ContainsFunc(p.ResponseTypes, func(responseType string) bool {
+		return oauth2const.ResponseType(responseType).Includes(oauth2const.ResponseTypeCode)
+	})
+	if slices.Contains(p.GrantTypes, string(oauth2const.GrantTypeAuthorizationCode)) && !issuesCode {
+		return ErrOAuthAuthCodeRequiresCodeResponseType
 	}
 	if len(p.GrantTypes) == 1 &&
 		slices.Contains(p.GrantTypes, string(oauth2const.GrantTypeRefreshToken)) {
@@ -828,8 +1029,9 @@ func validateGrantAndResponseTypes(p *inboundmodel.OAuthProfile) error {
 		!slices.Contains(p.GrantTypes, string(oauth2const.GrantTypeAuthorizationCode)) {
 		return ErrOAuthPKCERequiresAuthCode
 	}
-	if len(p.ResponseTypes) > 0 &&
-		!slices.Contains(p.GrantTypes, string(oauth2const.GrantTypeAuthorizationCode)) {
+	// The id_token response type is served entirely by the authorization endpoint, hence only response types
+	// issuing a code require the authorization_code grant.
+	if issuesCode && !slices.Contains(p.GrantTypes, string(oauth2const.GrantTypeAuthorizationCode)) {
 		return ErrOAuthResponseTypesRequireAuthCode
 	}
 	return nil
@@ -856,6 +1058,20 @@ func validateTokenEndpointAuthMethod(p *inboundmodel.OAuthProfile, hasClientSecr
 		if hasClientSecret {
 			return ErrOAuthPrivateKeyJWTCannotHaveClientSecret
 		}
+	case oauth2const.TokenEndpointAuthMethodTLSClientAuth:
+		if strings.TrimSpace(p.TLSClientAuthSubjectDN) == "" {
+			return ErrOAuthTLSClientAuthRequiresSubjectDN
+		}
+		if hasClientSecret {
+			return ErrOAuthTLSClientAuthCannotHaveClientSecret
+		}
+	case oauth2const.TokenEndpointAuthMethodSelfSignedTLSClientAuth:
+		if !hasCert {
+			return ErrOAuthSelfSignedTLSClientAuthRequiresCertificate
+		}
+		if hasClientSecret {
+			return ErrOAuthTLSClientAuthCannotHaveClientSecret
+		}
 	case oauth2const.TokenEndpointAuthMethodClientSecretBasic, oauth2const.TokenEndpointAuthMethodClientSecretPost:
 		if hasCert && !needsCert {
 			return ErrOAuthClientSecretCannotHaveCertificate
@@ -879,7 +1095,11 @@ func validatePublicClient(p *inboundmodel.OAuthProfile) error {
 	if oauth2const.TokenEndpointAuthMethod(p.TokenEndpointAuthMethod) != oauth2const.TokenEndpointAuthMethodNone {
 		return ErrOAuthPublicClientMustUseNoneAuth
 	}
-	if !p.PKCERequired {
+	// PKCE protects the authorization code; a public client limited to the device authorization
+	// grant never receives one.
+	deviceOnly := slices.Contains(p.GrantTypes, string(oauth2const.GrantTypeDeviceCode)) &&
+		!slices.Contains(p.GrantTypes, string(oauth2const.GrantTypeAuthorizationCode))
+	if !p.PKCERequired && !deviceOnly {
 		return ErrOAuthPublicClientMustHavePKCE
 	}
 	return nil
@@ -1011,7 +1231,14 @@ func applyInboundDefaults(c *inboundmodel.InboundClient, oauthProfile *inboundmo
 		assertion = c.Assertion
 	}
 	accessToken, idToken := resolveOAuthTokens(oauthProfile.Token, assertion)
-	oauthProfile.Token = &inboundmodel.OAuthTokenConfig{AccessToken: accessToken, IDToken: idToken}
+	var refreshToken *inboundmodel.RefreshTokenConfig
+	if oauthProfile.Token != nil {
+		// Unset refresh token lifetimes fall back to the deployment defaults when tokens are issued.
+		refreshToken = oauthProfile.Token.RefreshToken
+	}
+	oauthProfile.Token = &inboundmodel.OAuthTokenConfig{
+		AccessToken: accessToken, IDToken: idToken, RefreshToken: refreshToken,
+	}
 	oauthProfile.UserInfo = resolveUserInfo(oauthProfile.UserInfo, idToken)
 	oauthProfile.ScopeClaims = resolveScopeClaims(oauthProfile.ScopeClaims)
 }
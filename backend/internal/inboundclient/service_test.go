@@ -617,6 +617,31 @@ func (suite *InboundClientServiceTestSuite) TestValidateTokenEndpointAuthMethod_
 	assert.ErrorIs(suite.T(), err, ErrOAuthPrivateKeyJWTCannotHaveClientSecret)
 }
 
+func (suite *InboundClientServiceTestSuite) TestValidateTokenEndpointAuthMethod_TLSClientAuth() {
+	p := &inboundmodel.OAuthProfile{
+		TokenEndpointAuthMethod: "tls_client_auth",
+		TLSClientAuthSubjectDN:  "CN=client,O=Example",
+	}
+	assert.NoError(suite.T(), validateTokenEndpointAuthMethod(p, false))
+	assert.ErrorIs(suite.T(), validateTokenEndpointAuthMethod(p, true), ErrOAuthTLSClientAuthCannotHaveClientSecret)
+
+	p.TLSClientAuthSubjectDN = " "
+	assert.ErrorIs(suite.T(), validateTokenEndpointAuthMethod(p, false), ErrOAuthTLSClientAuthRequiresSubjectDN)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateTokenEndpointAuthMethod_SelfSignedTLSClientAuth() {
+	p := &inboundmodel.OAuthProfile{
+		TokenEndpointAuthMethod: "self_signed_tls_client_auth",
+		Certificate:             &inboundmodel.Certificate{Type: cert.CertificateTypeJWKS, Value: "{}"},
+	}
+	assert.NoError(suite.T(), validateTokenEndpointAuthMethod(p, false))
+	assert.ErrorIs(suite.T(), validateTokenEndpointAuthMethod(p, true), ErrOAuthTLSClientAuthCannotHaveClientSecret)
+
+	p.Certificate = nil
+	assert.ErrorIs(suite.T(), validateTokenEndpointAuthMethod(p, false),
+		ErrOAuthSelfSignedTLSClientAuthRequiresCertificate)
+}
+
 func (suite *InboundClientServiceTestSuite) TestValidateTokenEndpointAuthMethod_NoneRequiresPublicClient() {
 	p := &inboundmodel.OAuthProfile{TokenEndpointAuthMethod: "none"}
 	err := validateTokenEndpointAuthMethod(p, false)
@@ -1133,6 +1158,128 @@ func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_FragmentRej
 	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthRedirectURIFragmentNotAllowed)
 }
 
+func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_PostLogoutRedirectURIs() {
+	testCases := []struct {
+		name        string
+		uris        []string
+		expectedErr error
+	}{
+		{"Valid", []string{"https://app/logged-out"}, nil},
+		{"Relative", []string{"/logged-out"}, ErrOAuthInvalidPostLogoutRedirectURI},
+		{"Fragment", []string{"https://app/logged-out#frag"}, ErrOAuthInvalidPostLogoutRedirectURI},
+		{"Wildcard", []string{"https://app/*"}, ErrOAuthInvalidPostLogoutRedirectURI},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			p := &inboundmodel.OAuthProfile{
+				RedirectURIs:           []string{"https://app/cb"},
+				GrantTypes:             []string{"authorization_code"},
+				PostLogoutRedirectURIs: tc.uris,
+			}
+			err := validateRedirectURIs(p)
+			if tc.expectedErr == nil {
+				assert.NoError(suite.T(), err)
+			} else {
+				assert.ErrorIs(suite.T(), err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_LogoutURIs() {
+	testCases := []struct {
+		name            string
+		backChannelURI  string
+		frontChannelURI string
+		expectedErr     error
+	}{
+		{"Valid", "https://app/backchannel-logout", "https://app/frontchannel-logout", nil},
+		{"RelativeBackChannel", "/backchannel-logout", "", ErrOAuthInvalidLogoutURI},
+		{"FragmentFrontChannel", "", "https://app/logout#frag", ErrOAuthInvalidLogoutURI},
+		{"WildcardBackChannel", "https://app/*", "", ErrOAuthInvalidLogoutURI},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			p := &inboundmodel.OAuthProfile{
+				RedirectURIs:          []string{"https://app/cb"},
+				GrantTypes:            []string{"authorization_code"},
+				BackChannelLogoutURI:  tc.backChannelURI,
+				FrontChannelLogoutURI: tc.frontChannelURI,
+			}
+			err := validateRedirectURIs(p)
+			if tc.expectedErr == nil {
+				assert.NoError(suite.T(), err)
+			} else {
+				assert.ErrorIs(suite.T(), err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateBackchannelConfig() {
+	testCases := []struct {
+		name        string
+		mode        string
+		endpoint    string
+		expectedErr error
+	}{
+		{"Unset", "", "", nil},
+		{"Poll", "poll", "", nil},
+		{"Ping", "ping", "https://app/ciba-notify", nil},
+		{"PingWithoutEndpoint", "ping", "", ErrOAuthInvalidBackchannelConfig},
+		{"RelativeEndpoint", "ping", "/ciba-notify", ErrOAuthInvalidBackchannelConfig},
+		{"UnsupportedMode", "push", "https://app/ciba-notify", ErrOAuthInvalidBackchannelConfig},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			p := &inboundmodel.OAuthProfile{
+				BackchannelTokenDeliveryMode:    tc.mode,
+				BackchannelNotificationEndpoint: tc.endpoint,
+			}
+			err := validateBackchannelConfig(p)
+			if tc.expectedErr == nil {
+				assert.NoError(suite.T(), err)
+			} else {
+				assert.ErrorIs(suite.T(), err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateJWTBearerIssuers() {
+	sysconfig.ResetServerRuntime()
+	cfg := &sysconfig.Config{}
+	cfg.OAuth.JWTBearer.TrustedIssuers = []sysconfig.JWTBearerIssuerConfig{
+		{Issuer: "https://idp.example.com", JWKSURI: "https://idp.example.com/jwks", SubjectAttribute: "email"},
+	}
+	suite.Require().NoError(sysconfig.InitializeServerRuntime("/tmp/test", cfg))
+
+	testCases := []struct {
+		name        string
+		issuers     []string
+		expectedErr error
+	}{
+		{"Unset", nil, nil},
+		{"TrustedIssuer", []string{"https://idp.example.com"}, nil},
+		{"UntrustedIssuer", []string{"https://idp.example.com", "https://other.example.com"},
+			ErrOAuthInvalidJWTBearerIssuer},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateJWTBearerIssuers(&inboundmodel.OAuthProfile{JWTBearerAllowedIssuers: tc.issuers})
+			if tc.expectedErr == nil {
+				assert.NoError(suite.T(), err)
+			} else {
+				assert.ErrorIs(suite.T(), err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_HostWildcardRejected() {
 	p := &inboundmodel.OAuthProfile{
 		RedirectURIs: []string{"https://*.app.com/cb"},
@@ -1468,6 +1615,37 @@ func (suite *InboundClientServiceTestSuite) TestValidateGrantAndResponseTypes_Ha
 	assert.NoError(suite.T(), validateGrantAndResponseTypes(p))
 }
 
+func (suite *InboundClientServiceTestSuite) TestValidateGrantAndResponseTypes_HappyHybrid() {
+	p := &inboundmodel.OAuthProfile{
+		GrantTypes:    []string{"authorization_code"},
+		ResponseTypes: []string{"code id_token", "code id_token token"},
+	}
+	assert.NoError(suite.T(), validateGrantAndResponseTypes(p))
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateGrantAndResponseTypes_HappyIDTokenOnly() {
+	p := &inboundmodel.OAuthProfile{
+		ResponseTypes: []string{"id_token"},
+	}
+	assert.NoError(suite.T(), validateGrantAndResponseTypes(p))
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateGrantAndResponseTypes_HybridWithoutAuthCode() {
+	p := &inboundmodel.OAuthProfile{
+		GrantTypes:    []string{"refresh_token", "client_credentials"},
+		ResponseTypes: []string{"code id_token"},
+	}
+	assert.ErrorIs(suite.T(), validateGrantAndResponseTypes(p), ErrOAuthResponseTypesRequireAuthCode)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateGrantAndResponseTypes_AuthCodeWithIDTokenOnly() {
+	p := &inboundmodel.OAuthProfile{
+		GrantTypes:    []string{"authorization_code"},
+		ResponseTypes: []string{"id_token"},
+	}
+	assert.ErrorIs(suite.T(), validateGrantAndResponseTypes(p), ErrOAuthAuthCodeRequiresCodeResponseType)
+}
+
 func (suite *InboundClientServiceTestSuite) TestValidateGrantAndResponseTypes_HappyClientCredentials() {
 	p := &inboundmodel.OAuthProfile{
 		GrantTypes: []string{"client_credentials"},
@@ -1493,6 +1671,19 @@ func (suite *InboundClientServiceTestSuite) TestValidatePublicClient_HappyPath()
 	assert.NoError(suite.T(), validatePublicClient(p))
 }
 
+func (suite *InboundClientServiceTestSuite) TestValidatePublicClient_DeviceCodeOnlyWithoutPKCE() {
+	p := &inboundmodel.OAuthProfile{
+		TokenEndpointAuthMethod: "none",
+		GrantTypes: []string{
+			string(oauth2const.GrantTypeDeviceCode), string(oauth2const.GrantTypeRefreshToken),
+		},
+	}
+	assert.NoError(suite.T(), validatePublicClient(p))
+
+	p.GrantTypes = append(p.GrantTypes, string(oauth2const.GrantTypeAuthorizationCode))
+	assert.ErrorIs(suite.T(), validatePublicClient(p), ErrOAuthPublicClientMustHavePKCE)
+}
+
 // ----- validateFKs aggregate paths -----
 
 func (suite *InboundClientServiceTestSuite) TestValidateFKs_AuthFlowErrorPropagated() {
@@ -1704,3 +1895,156 @@ func (suite *InboundClientServiceTestSuite) TestGetOAuthClientByClientID_StoreEr
 	assert.ErrorIs(suite.T(), err, storeErr)
 	assert.Nil(suite.T(), got)
 }
+
+// ----- SAML profiles -----
+
+func validSAMLProfile() *inboundmodel.SAMLProfile {
+	return &inboundmodel.SAMLProfile{
+		EntityID:                     "https://sp.example.com",
+		AssertionConsumerServiceURLs: []string{"https://sp.example.com/acs"},
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateSAMLProfile() {
+	testCases := []struct {
+		name    string
+		mutate  func(p *inboundmodel.SAMLProfile)
+		wantErr error
+	}{
+		{"Valid", func(p *inboundmodel.SAMLProfile) {}, nil},
+		{"MissingEntityID", func(p *inboundmodel.SAMLProfile) { p.EntityID = " " }, ErrSAMLMissingEntityID},
+		{"MissingACSURL", func(p *inboundmodel.SAMLProfile) {
+			p.AssertionConsumerServiceURLs = nil
+		}, ErrSAMLInvalidACSURL},
+		{"RelativeACSURL", func(p *inboundmodel.SAMLProfile) {
+			p.AssertionConsumerServiceURLs = []string{"/acs"}
+		}, ErrSAMLInvalidACSURL},
+		{"InvalidSLOURL", func(p *inboundmodel.SAMLProfile) {
+			p.SingleLogoutURL = "https://*.example.com/slo"
+		}, ErrSAMLInvalidSingleLogoutURL},
+		{"UnsupportedNameIDFormat", func(p *inboundmodel.SAMLProfile) {
+			p.NameIDFormat = "urn:example:format"
+		}, ErrSAMLUnsupportedNameIDFormat},
+		{"InvalidCertificate", func(p *inboundmodel.SAMLProfile) {
+			p.Certificate = "not a certificate"
+		}, ErrSAMLInvalidCertificate},
+		{"SignedRequestsWithoutCertificate", func(p *inboundmodel.SAMLProfile) {
+			p.RequireSignedRequests = true
+		}, ErrSAMLSignedRequestsRequireCertificate},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			p := validSAMLProfile()
+			tc.mutate(p)
+			err := ValidateSAMLProfile(p)
+			if tc.wantErr == nil {
+				assert.NoError(suite.T(), err)
+				return
+			}
+			assert.ErrorIs(suite.T(), err, tc.wantErr)
+		})
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestSyncSAMLProfile_Creates() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(false)
+	store.EXPECT().GetSAMLProfileBySPEntityID(mock.Anything, "https://sp.example.com").
+		Return("", nil, ErrInboundClientNotFound)
+	store.EXPECT().GetSAMLProfileByEntityID(mock.Anything, "p1").Return(nil, ErrInboundClientNotFound)
+	store.EXPECT().CreateSAMLProfile(mock.Anything, "p1", mock.Anything).Return(nil)
+
+	err := newServiceForTest(store).SyncSAMLProfile(context.Background(), "p1", validSAMLProfile())
+
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *InboundClientServiceTestSuite) TestSyncSAMLProfile_Updates() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(false)
+	store.EXPECT().GetSAMLProfileBySPEntityID(mock.Anything, "https://sp.example.com").
+		Return("p1", validSAMLProfile(), nil)
+	store.EXPECT().GetSAMLProfileByEntityID(mock.Anything, "p1").Return(validSAMLProfile(), nil)
+	store.EXPECT().UpdateSAMLProfile(mock.Anything, "p1", mock.Anything).Return(nil)
+
+	err := newServiceForTest(store).SyncSAMLProfile(context.Background(), "p1", validSAMLProfile())
+
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *InboundClientServiceTestSuite) TestSyncSAMLProfile_DeletesWhenRemoved() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(false)
+	store.EXPECT().GetSAMLProfileByEntityID(mock.Anything, "p1").Return(validSAMLProfile(), nil)
+	store.EXPECT().DeleteSAMLProfile(mock.Anything, "p1").Return(nil)
+
+	err := newServiceForTest(store).SyncSAMLProfile(context.Background(), "p1", nil)
+
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *InboundClientServiceTestSuite) TestSyncSAMLProfile_RejectsEntityIDOfAnotherApplication() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(false)
+	store.EXPECT().GetSAMLProfileBySPEntityID(mock.Anything, "https://sp.example.com").
+		Return("other-app", validSAMLProfile(), nil)
+
+	err := newServiceForTest(store).SyncSAMLProfile(context.Background(), "p1", validSAMLProfile())
+
+	assert.ErrorIs(suite.T(), err, ErrSAMLEntityIDAlreadyExists)
+}
+
+func (suite *InboundClientServiceTestSuite) TestSyncSAMLProfile_RefusesDeclarative() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(true)
+
+	err := newServiceForTest(store).SyncSAMLProfile(context.Background(), "p1", validSAMLProfile())
+
+	assert.ErrorIs(suite.T(), err, ErrCannotModifyDeclarative)
+}
+
+func (suite *InboundClientServiceTestSuite) TestGetSAMLServiceProvider() {
+	suite.Run("resolves the service provider", func() {
+		store := newInboundClientStoreInterfaceMock(suite.T())
+		store.EXPECT().GetSAMLProfileBySPEntityID(mock.Anything, "https://sp.example.com").
+			Return(testServiceEntityID, validSAMLProfile(), nil)
+		ep := entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+		ep.EXPECT().GetEntity(testServiceEntityID).
+			Return(&entityprovider.Entity{ID: testServiceEntityID, OUID: "ou-1"}, nil)
+		svc := &inboundClientService{entityProvider: ep, store: store}
+
+		sp, err := svc.GetSAMLServiceProvider(context.Background(), "https://sp.example.com")
+
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), testServiceEntityID, sp.AppID)
+		assert.Equal(suite.T(), "ou-1", sp.OUID)
+		assert.Equal(suite.T(), "https://sp.example.com/acs", sp.GetDefaultACSURL())
+	})
+
+	suite.Run("returns nil when the entity ID is not registered", func() {
+		store := newInboundClientStoreInterfaceMock(suite.T())
+		store.EXPECT().GetSAMLProfileBySPEntityID(mock.Anything, "https://unknown.example.com").
+			Return("", nil, ErrInboundClientNotFound)
+		ep := entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+		svc := &inboundClientService{entityProvider: ep, store: store}
+
+		sp, err := svc.GetSAMLServiceProvider(context.Background(), "https://unknown.example.com")
+
+		assert.NoError(suite.T(), err)
+		assert.Nil(suite.T(), sp)
+	})
+
+	suite.Run("propagates store errors", func() {
+		store := newInboundClientStoreInterfaceMock(suite.T())
+		store.EXPECT().GetSAMLProfileBySPEntityID(mock.Anything, "https://sp.example.com").
+			Return("", nil, errors.New("db down"))
+		ep := entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+		svc := &inboundClientService{entityProvider: ep, store: store}
+
+		sp, err := svc.GetSAMLServiceProvider(context.Background(), "https://sp.example.com")
+
+		assert.Error(suite.T(), err)
+		assert.Nil(suite.T(), sp)
+	})
+}
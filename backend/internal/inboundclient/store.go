@@ -55,6 +55,13 @@ type inboundClientStoreInterface interface {
 	UpdateOAuthProfile(ctx context.Context, entityID string, oauthProfile *inboundmodel.OAuthProfile) error
 	DeleteInboundClient(ctx context.Context, entityID string) error
 	DeleteOAuthProfile(ctx context.Context, entityID string) error
+	CreateSAMLProfile(ctx context.Context, entityID string, samlProfile *inboundmodel.SAMLProfile) error
+	GetSAMLProfileByEntityID(ctx context.Context, entityID string) (*inboundmodel.SAMLProfile, error)
+	// GetSAMLProfileBySPEntityID returns the ID of the entity that registered the service provider
+	// entity ID along with its SAML profile.
+	GetSAMLProfileBySPEntityID(ctx context.Context, spEntityID string) (string, *inboundmodel.SAMLProfile, error)
+	UpdateSAMLProfile(ctx context.Context, entityID string, samlProfile *inboundmodel.SAMLProfile) error
+	DeleteSAMLProfile(ctx context.Context, entityID string) error
 	InboundClientExists(ctx context.Context, entityID string) (bool, error)
 	// IsDeclarative reports whether the inbound client with the given entity ID is sourced
 	// from a declarative (YAML) resource and therefore immutable. DB-backed stores always
@@ -337,6 +344,108 @@ func (st *store) DeleteOAuthProfile(ctx context.Context, entityID string) error
 	return nil
 }
 
+// CreateSAMLProfile creates a new SAML inbound profile entry. The typed profile is marshaled
+// to JSON internally.
+func (st *store) CreateSAMLProfile(ctx context.Context, entityID string,
+	samlProfile *inboundmodel.SAMLProfile) error {
+	dbClient, err := st.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	profileJSON, err := json.Marshal(samlProfile)
+	if err != nil {
+		return fmt.Errorf("failed to marshal SAML profile JSON: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateSAMLProfile,
+		entityID, samlProfile.EntityID, profileJSON, st.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to insert SAML profile: %w", err)
+	}
+	return nil
+}
+
+// GetSAMLProfileByEntityID retrieves a SAML profile by entity ID.
+func (st *store) GetSAMLProfileByEntityID(ctx context.Context, entityID string) (*inboundmodel.SAMLProfile, error) {
+	dbClient, err := st.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetSAMLProfileByEntityID, entityID, st.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrInboundClientNotFound
+	}
+	return buildSAMLProfileFromRow(results[0])
+}
+
+// GetSAMLProfileBySPEntityID retrieves a SAML profile by the service provider entity ID and returns
+// it along with the ID of the entity it belongs to.
+func (st *store) GetSAMLProfileBySPEntityID(ctx context.Context, spEntityID string) (
+	string, *inboundmodel.SAMLProfile, error) {
+	dbClient, err := st.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetSAMLProfileBySPEntityID, spEntityID, st.deploymentID)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return "", nil, ErrInboundClientNotFound
+	}
+	entityID, _ := results[0]["entity_id"].(string)
+	profile, err := buildSAMLProfileFromRow(results[0])
+	if err != nil {
+		return "", nil, err
+	}
+	return entityID, profile, nil
+}
+
+// UpdateSAMLProfile updates a SAML profile for an entity. The typed profile is marshaled to JSON
+// internally.
+func (st *store) UpdateSAMLProfile(ctx context.Context, entityID string,
+	samlProfile *inboundmodel.SAMLProfile) error {
+	dbClient, err := st.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	profileJSON, err := json.Marshal(samlProfile)
+	if err != nil {
+		return fmt.Errorf("failed to marshal SAML profile JSON: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryUpdateSAMLProfileByEntityID,
+		entityID, samlProfile.EntityID, profileJSON, st.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to update SAML profile: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrInboundClientNotFound
+	}
+	return nil
+}
+
+// DeleteSAMLProfile deletes a SAML profile by entity ID.
+func (st *store) DeleteSAMLProfile(ctx context.Context, entityID string) error {
+	dbClient, err := st.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryDeleteSAMLProfileByEntityID, entityID, st.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete SAML profile: %w", err)
+	}
+	return nil
+}
+
 // InboundClientExists checks if an inbound client exists by entity ID.
 func (st *store) InboundClientExists(ctx context.Context, entityID string) (bool, error) {
 	dbClient, err := st.dbProvider.GetConfigDBClient()
@@ -414,6 +523,19 @@ func buildOAuthProfileFromRow(row map[string]interface{}) (*inboundmodel.OAuthPr
 	return &p, nil
 }
 
+// buildSAMLProfileFromRow constructs a SAMLProfile from a database result row.
+func buildSAMLProfileFromRow(row map[string]interface{}) (*inboundmodel.SAMLProfile, error) {
+	profileStr := parseJSONColumnString(row, "saml_config")
+	if profileStr == "" {
+		return nil, fmt.Errorf("SAML profile has no configuration")
+	}
+	var p inboundmodel.SAMLProfile
+	if err := json.Unmarshal([]byte(profileStr), &p); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal SAML profile JSON: %w", err)
+	}
+	return &p, nil
+}
+
 // marshalNullableJSON marshals a value to JSON, returning nil for nil/empty input.
 func marshalNullableJSON(v interface{}) (interface{}, error) {
 	if v == nil {
@@ -65,7 +65,7 @@ var (
 		ID:    "ASQ-APP_MGT-08",
 		Query: `UPDATE "OAUTH_INBOUND_PROFILE" SET OAUTH_CONFIG=$2 WHERE ENTITY_ID=$1 AND DEPLOYMENT_ID=$3`,
 	}
-	// queryDeleteInboundClientByEntityID deletes an inbound client by entity ID. Cascades to OAuth profile.
+	// queryDeleteInboundClientByEntityID deletes an inbound client by entity ID. Cascades to the profiles.
 	queryDeleteInboundClientByEntityID = dbmodel.DBQuery{
 		ID:    "ASQ-APP_MGT-09",
 		Query: `DELETE FROM "INBOUND_CLIENT" WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2`,
@@ -85,4 +85,33 @@ var (
 		ID:    "ASQ-APP_MGT-12",
 		Query: `SELECT COUNT(*) as count FROM "INBOUND_CLIENT" WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2`,
 	}
+	// queryCreateSAMLProfile creates a new SAML inbound profile entry keyed by entity ID.
+	queryCreateSAMLProfile = dbmodel.DBQuery{
+		ID: "ASQ-APP_MGT-13",
+		Query: `INSERT INTO "SAML_INBOUND_PROFILE" (ENTITY_ID, SP_ENTITY_ID, SAML_CONFIG, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4)`,
+	}
+	// queryGetSAMLProfileByEntityID retrieves a SAML inbound profile by entity ID.
+	queryGetSAMLProfileByEntityID = dbmodel.DBQuery{
+		ID: "ASQ-APP_MGT-14",
+		Query: `SELECT ENTITY_ID, SAML_CONFIG FROM "SAML_INBOUND_PROFILE" ` +
+			`WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	// queryGetSAMLProfileBySPEntityID retrieves a SAML inbound profile by the service provider entity ID.
+	queryGetSAMLProfileBySPEntityID = dbmodel.DBQuery{
+		ID: "ASQ-APP_MGT-15",
+		Query: `SELECT ENTITY_ID, SAML_CONFIG FROM "SAML_INBOUND_PROFILE" ` +
+			`WHERE SP_ENTITY_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	// queryUpdateSAMLProfileByEntityID updates a SAML inbound profile by entity ID.
+	queryUpdateSAMLProfileByEntityID = dbmodel.DBQuery{
+		ID: "ASQ-APP_MGT-16",
+		Query: `UPDATE "SAML_INBOUND_PROFILE" SET SP_ENTITY_ID=$2, SAML_CONFIG=$3 ` +
+			`WHERE ENTITY_ID=$1 AND DEPLOYMENT_ID=$4`,
+	}
+	// queryDeleteSAMLProfileByEntityID deletes a SAML inbound profile by entity ID.
+	queryDeleteSAMLProfileByEntityID = dbmodel.DBQuery{
+		ID:    "ASQ-APP_MGT-17",
+		Query: `DELETE FROM "SAML_INBOUND_PROFILE" WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
 )
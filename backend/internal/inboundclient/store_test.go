@@ -694,6 +694,102 @@ func (suite *InboundClientStoreTestSuite) TestGetOAuthProfileByEntityID() {
 	})
 }
 
+func (suite *InboundClientStoreTestSuite) TestCreateSAMLProfile() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateSAMLProfile,
+		testEntityID, "https://sp.example.com", mock.Anything, testServerID).Return(int64(1), nil).Once()
+
+	err := suite.store.CreateSAMLProfile(context.Background(), testEntityID,
+		&inboundmodel.SAMLProfile{EntityID: "https://sp.example.com"})
+	suite.NoError(err)
+}
+
+func (suite *InboundClientStoreTestSuite) TestUpdateSAMLProfile() {
+	suite.Run("successfully executes", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateSAMLProfileByEntityID,
+			testEntityID, "https://sp.example.com", mock.Anything, testServerID).Return(int64(1), nil).Once()
+
+		err := suite.store.UpdateSAMLProfile(context.Background(), testEntityID,
+			&inboundmodel.SAMLProfile{EntityID: "https://sp.example.com"})
+		suite.NoError(err)
+	})
+
+	suite.Run("returns ErrInboundClientNotFound when no rows are updated", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateSAMLProfileByEntityID,
+			testEntityID, "https://sp.example.com", mock.Anything, testServerID).Return(int64(0), nil).Once()
+
+		err := suite.store.UpdateSAMLProfile(context.Background(), testEntityID,
+			&inboundmodel.SAMLProfile{EntityID: "https://sp.example.com"})
+		suite.ErrorIs(err, ErrInboundClientNotFound)
+	})
+}
+
+func (suite *InboundClientStoreTestSuite) TestDeleteSAMLProfile() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteSAMLProfileByEntityID,
+		testEntityID, testServerID).Return(int64(1), nil).Once()
+
+	err := suite.store.DeleteSAMLProfile(context.Background(), testEntityID)
+	suite.NoError(err)
+}
+
+func (suite *InboundClientStoreTestSuite) TestGetSAMLProfileByEntityID() {
+	suite.Run("returns SAML profile when found", func() {
+		mockRow := map[string]interface{}{
+			"entity_id":   testEntityID,
+			"saml_config": `{"entityId":"https://sp.example.com","assertionConsumerServiceUrls":["https://sp/acs"]}`,
+		}
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryGetSAMLProfileByEntityID,
+			testEntityID, testServerID).Return([]map[string]interface{}{mockRow}, nil).Once()
+
+		result, err := suite.store.GetSAMLProfileByEntityID(context.Background(), testEntityID)
+		suite.NoError(err)
+		suite.Equal("https://sp.example.com", result.EntityID)
+		suite.Equal([]string{"https://sp/acs"}, result.AssertionConsumerServiceURLs)
+	})
+
+	suite.Run("returns ErrInboundClientNotFound when not found", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryGetSAMLProfileByEntityID,
+			testEntityID, testServerID).Return([]map[string]interface{}{}, nil).Once()
+
+		result, err := suite.store.GetSAMLProfileByEntityID(context.Background(), testEntityID)
+		suite.ErrorIs(err, ErrInboundClientNotFound)
+		suite.Nil(result)
+	})
+}
+
+func (suite *InboundClientStoreTestSuite) TestGetSAMLProfileBySPEntityID() {
+	suite.Run("returns owning entity and profile when found", func() {
+		mockRow := map[string]interface{}{
+			"entity_id":   testEntityID,
+			"saml_config": []byte(`{"entityId":"https://sp.example.com"}`),
+		}
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryGetSAMLProfileBySPEntityID,
+			"https://sp.example.com", testServerID).Return([]map[string]interface{}{mockRow}, nil).Once()
+
+		entityID, result, err := suite.store.GetSAMLProfileBySPEntityID(context.Background(), "https://sp.example.com")
+		suite.NoError(err)
+		suite.Equal(testEntityID, entityID)
+		suite.Equal("https://sp.example.com", result.EntityID)
+	})
+
+	suite.Run("returns error when the profile is malformed", func() {
+		mockRow := map[string]interface{}{"entity_id": testEntityID, "saml_config": "{"}
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryGetSAMLProfileBySPEntityID,
+			"https://sp.example.com", testServerID).Return([]map[string]interface{}{mockRow}, nil).Once()
+
+		_, result, err := suite.store.GetSAMLProfileBySPEntityID(context.Background(), "https://sp.example.com")
+		suite.Error(err)
+		suite.Nil(result)
+	})
+}
+
 func (suite *InboundClientStoreTestSuite) TestIsDeclarative_AlwaysFalse() {
 	suite.False(suite.store.IsDeclarative(context.Background(), "any-id"))
 	suite.False(suite.store.IsDeclarative(context.Background(), ""))
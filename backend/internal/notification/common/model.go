@@ -115,6 +115,7 @@ type VerifyOTPDTO struct {
 type VerifyOTPResultDTO struct {
 	Status    OTPVerifyStatus
 	Recipient string
+	Channel   ChannelType
 }
 
 // OTPSessionData represents the data stored in the OTP session token.
@@ -221,4 +221,46 @@ var (
 			DefaultValue: "An error occurred while retrieving the message client",
 		},
 	}
+	// ErrorEmailChannelNotConfigured is the error returned when an email OTP is requested but the
+	// server email client is not configured.
+	ErrorEmailChannelNotConfigured = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1016",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.email_channel_not_configured",
+			DefaultValue: "Email channel not configured",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.email_channel_not_configured_description",
+			DefaultValue: "The email channel cannot be used as an email client is not configured",
+		},
+	}
+	// ErrorOTPAttemptsExceeded is the error returned when the maximum number of verification attempts
+	// of an OTP session has been reached.
+	ErrorOTPAttemptsExceeded = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1017",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.otp_attempts_exceeded",
+			DefaultValue: "OTP attempts exceeded",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.otp_attempts_exceeded_description",
+			DefaultValue: "The maximum number of verification attempts for the OTP has been reached",
+		},
+	}
+	// ErrorOTPSendThrottled is the error returned when OTPs are requested for a recipient too
+	// frequently.
+	ErrorOTPSendThrottled = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1018",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.otp_send_throttled",
+			DefaultValue: "OTP send throttled",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.otp_send_throttled_description",
+			DefaultValue: "Too many OTPs have been requested for the recipient. Try again later",
+		},
+	}
 )
@@ -22,7 +22,9 @@ import (
 	"net/http"
 
 	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/database/provider"
 	declarativeresource "github.com/asgardeo/thunder/internal/system/declarative_resource"
+	"github.com/asgardeo/thunder/internal/system/email"
 	"github.com/asgardeo/thunder/internal/system/jose/jwt"
 	"github.com/asgardeo/thunder/internal/system/log"
 	"github.com/asgardeo/thunder/internal/system/middleware"
@@ -32,7 +34,7 @@ import (
 
 // Initialize creates and configures the notification service components.
 func Initialize(mux *http.ServeMux, jwtService jwt.JWTServiceInterface,
-	templateService template.TemplateServiceInterface) (
+	templateService template.TemplateServiceInterface, emailClient email.EmailClientInterface) (
 	NotificationSenderMgtSvcInterface, OTPServiceInterface, NotificationSenderServiceInterface,
 	declarativeresource.ResourceExporter, error) {
 	var notificationStore notificationStoreInterface
@@ -57,7 +59,7 @@ func Initialize(mux *http.ServeMux, jwtService jwt.JWTServiceInterface,
 		}
 	}
 
-	otpService := newOTPService(mgtService, jwtService, templateService)
+	otpService := newOTPService(mgtService, jwtService, templateService, emailClient, initializeOTPSessionStore())
 	notificationSenderService := newNotificationSenderService(mgtService)
 	handler := newMessageNotificationSenderHandler(mgtService, otpService)
 	registerRoutes(mux, handler)
@@ -67,6 +69,17 @@ func Initialize(mux *http.ServeMux, jwtService jwt.JWTServiceInterface,
 	return mgtService, otpService, notificationSenderService, exporter, nil
 }
 
+// initializeOTPSessionStore selects the OTP session store implementation based on the configured
+// runtime DB type.
+func initializeOTPSessionStore() otpSessionStoreInterface {
+	deploymentID := config.GetServerRuntime().Config.Server.Identifier
+
+	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		return newRedisOTPSessionStore(provider.GetRedisProvider(), deploymentID)
+	}
+	return newOTPSessionStore(deploymentID)
+}
+
 // registerRoutes registers the HTTP routes for notification services.
 func registerRoutes(mux *http.ServeMux, handler *messageNotificationSenderHandler) {
 	opts1 := middleware.CORSOptions{
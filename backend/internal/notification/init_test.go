@@ -84,7 +84,7 @@ func (suite *InitTestSuite) TearDownSuite() {
 }
 
 func (suite *InitTestSuite) TestInitialize() {
-	mgtService, otpService, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	mgtService, otpService, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	suite.NotNil(mgtService)
@@ -218,7 +218,7 @@ properties:
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_ListEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodGet, "/notification-senders/message", nil)
@@ -230,7 +230,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_ListEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_CreateEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodPost, "/notification-senders/message", nil)
@@ -242,7 +242,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_CreateEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_GetByIDEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodGet, "/notification-senders/message/test-id", nil)
@@ -254,7 +254,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_GetByIDEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_UpdateEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodPut, "/notification-senders/message/test-id", nil)
@@ -266,7 +266,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_UpdateEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_DeleteEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodDelete, "/notification-senders/message/test-id", nil)
@@ -278,7 +278,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_DeleteEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_SendOTPEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodPost, "/notification-senders/otp/send", nil)
@@ -290,7 +290,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_SendOTPEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_VerifyOTPEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodPost, "/notification-senders/otp/verify", nil)
@@ -302,7 +302,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_VerifyOTPEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_CORSPreflight() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	paths := []string{
@@ -515,7 +515,7 @@ func (suite *InitTestSuite) TestInitialize_WithDeclarativeResourcesEnabled_Inval
 	mux := http.NewServeMux()
 
 	// Initialize should return an error due to invalid YAML
-	_, _, _, _, err = Initialize(mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err = Initialize(mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.Error(err)
 	suite.Contains(err.Error(), "failed to load notification sender resources")
 
@@ -593,7 +593,7 @@ properties:
 	mux := http.NewServeMux()
 
 	// Initialize should return an error due to validation failure
-	_, _, _, _, err = Initialize(mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err = Initialize(mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.Error(err)
 	suite.Contains(err.Error(), "failed to load notification sender resources")
 
@@ -275,6 +275,8 @@ func (h *messageNotificationSenderHandler) handleError(w http.ResponseWriter,
 			statusCode = http.StatusNotFound
 		case ErrorDuplicateSenderName.Code:
 			statusCode = http.StatusConflict
+		case ErrorOTPSendThrottled.Code:
+			statusCode = http.StatusTooManyRequests
 		default:
 			statusCode = http.StatusBadRequest
 		}
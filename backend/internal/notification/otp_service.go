@@ -24,12 +24,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"slices"
 	"strconv"
 	"time"
 
 	"github.com/asgardeo/thunder/internal/notification/common"
+	"github.com/asgardeo/thunder/internal/notification/message"
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
+	"github.com/asgardeo/thunder/internal/system/email"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	"github.com/asgardeo/thunder/internal/system/jose/jwt"
 	"github.com/asgardeo/thunder/internal/system/log"
@@ -39,6 +42,9 @@ import (
 // otpUseOnlyNumericChars indicates whether to use only numeric characters for OTP generation.
 var otpUseOnlyNumericChars = true
 
+// supportedOTPChannels contains the channels through which an OTP can be delivered.
+var supportedOTPChannels = []common.ChannelType{common.ChannelTypeSMS, common.ChannelTypeEmail}
+
 // OTPServiceInterface defines the interface for OTP operations.
 type OTPServiceInterface interface {
 	SendOTP(ctx context.Context, request common.SendOTPDTO) (*common.SendOTPResultDTO, *serviceerror.ServiceError)
@@ -52,16 +58,23 @@ type otpService struct {
 	senderMgtService NotificationSenderMgtSvcInterface
 	clientProvider   notificationClientProviderInterface
 	templateService  template.TemplateServiceInterface
+	emailClient      email.EmailClientInterface
+	sessionStore     otpSessionStoreInterface
+	otpConfig        config.OTPConfig
 }
 
 // newOTPService returns a new instance of OTPServiceInterface.
 func newOTPService(notifSenderSvc NotificationSenderMgtSvcInterface,
-	jwtSvc jwt.JWTServiceInterface, templateSvc template.TemplateServiceInterface) OTPServiceInterface {
+	jwtSvc jwt.JWTServiceInterface, templateSvc template.TemplateServiceInterface,
+	emailClient email.EmailClientInterface, sessionStore otpSessionStoreInterface) OTPServiceInterface {
 	return &otpService{
 		jwtService:       jwtSvc,
 		senderMgtService: notifSenderSvc,
 		clientProvider:   newNotificationClientProvider(),
 		templateService:  templateSvc,
+		emailClient:      emailClient,
+		sessionStore:     sessionStore,
+		otpConfig:        config.GetServerRuntime().Config.OTP,
 	}
 }
 
@@ -76,19 +89,27 @@ func (s *otpService) SendOTP(
 		return nil, err
 	}
 
-	sender, svcErr := s.senderMgtService.GetSender(ctx, otpDTO.SenderID)
-	if svcErr != nil {
-		if svcErr.Code == ErrorSenderNotFound.Code {
-			return nil, &ErrorSenderNotFound
+	channel := common.ChannelType(otpDTO.Channel)
+	var messageClient message.NotificationClientInterface
+	switch channel {
+	case common.ChannelTypeSMS:
+		_client, svcErr := s.getMessageClient(ctx, otpDTO.SenderID, channel)
+		if svcErr != nil {
+			return nil, svcErr
 		}
-		return nil, &serviceerror.InternalServerError
-	}
-	if sender == nil {
-		return nil, &ErrorSenderNotFound
+		messageClient = _client
+	case common.ChannelTypeEmail:
+		if s.emailClient == nil {
+			logger.Debug("Email client is not configured to send email OTPs")
+			return nil, &ErrorEmailChannelNotConfigured
+		}
+	default:
+		return nil, &ErrorUnsupportedChannel
 	}
 
-	// TODO: Validate whether the sender supports the requested channel when necessary
-	//  improvements are implemented.
+	if svcErr := s.enforceSendThrottle(ctx, channel, otpDTO.Recipient, logger); svcErr != nil {
+		return nil, svcErr
+	}
 
 	otp, err := s.generateOTP()
 	if err != nil {
@@ -97,13 +118,15 @@ func (s *otpService) SendOTP(
 	}
 
 	// Send OTP based on channel
-	switch common.ChannelType(otpDTO.Channel) {
+	var sendErr *serviceerror.ServiceError
+	switch channel {
 	case common.ChannelTypeSMS:
-		if svcErr := s.sendSMSOTP(ctx, otpDTO.Recipient, otp.Value, *sender, logger); svcErr != nil {
-			return nil, svcErr
-		}
-	default:
-		return nil, &ErrorUnsupportedChannel
+		sendErr = s.sendSMSOTP(ctx, otpDTO.Recipient, otp.Value, messageClient, logger)
+	case common.ChannelTypeEmail:
+		sendErr = s.sendEmailOTP(ctx, otpDTO.Recipient, otp.Value, logger)
+	}
+	if sendErr != nil {
+		return nil, sendErr
 	}
 
 	// Create session token
@@ -138,7 +161,7 @@ func (s *otpService) VerifyOTP(
 		return nil, err
 	}
 
-	sessionData, svcErr := s.verifyAndDecodeSessionToken(otpDTO.SessionToken, logger)
+	sessionData, sessionID, svcErr := s.verifyAndDecodeSessionToken(otpDTO.SessionToken, logger)
 	if svcErr != nil {
 		return nil, svcErr
 	}
@@ -153,6 +176,25 @@ func (s *otpService) VerifyOTP(
 		}, nil
 	}
 
+	// Count the attempt before comparing so that the session cannot be guessed against without limit.
+	expiryTime := time.UnixMilli(sessionData.ExpiryTime)
+	state, err := s.sessionStore.RecordVerifyAttempt(ctx, sessionID, expiryTime)
+	if err != nil {
+		logger.Error("Failed to record OTP verification attempt", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if state.Consumed {
+		logger.Debug("OTP session has already been used")
+		return &common.VerifyOTPResultDTO{
+			Status:    common.OTPVerifyStatusInvalid,
+			Recipient: sessionData.Recipient,
+		}, nil
+	}
+	if s.otpConfig.MaxVerifyAttempts > 0 && state.Attempts > s.otpConfig.MaxVerifyAttempts {
+		logger.Debug("Maximum OTP verification attempts reached", log.Int("attempts", state.Attempts))
+		return nil, &ErrorOTPAttemptsExceeded
+	}
+
 	// Verify OTP value by comparing hashes
 	providedOTPHash := hash.GenerateThumbprintFromString(otpDTO.OTPCode)
 	if providedOTPHash != sessionData.OTPValue {
@@ -163,9 +205,24 @@ func (s *otpService) VerifyOTP(
 		}, nil
 	}
 
+	// Consume the session so that a verified OTP cannot be replayed.
+	consumed, err := s.sessionStore.ConsumeSession(ctx, sessionID, expiryTime)
+	if err != nil {
+		logger.Error("Failed to consume OTP session", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if !consumed {
+		logger.Debug("OTP session was consumed by a concurrent verification")
+		return &common.VerifyOTPResultDTO{
+			Status:    common.OTPVerifyStatusInvalid,
+			Recipient: sessionData.Recipient,
+		}, nil
+	}
+
 	return &common.VerifyOTPResultDTO{
 		Status:    common.OTPVerifyStatusVerified,
 		Recipient: sessionData.Recipient,
+		Channel:   common.ChannelType(sessionData.Channel),
 	}, nil
 }
 
@@ -174,15 +231,16 @@ func (s *otpService) validateOTPSendRequest(request common.SendOTPDTO) *servicee
 	if request.Recipient == "" {
 		return &ErrorInvalidRecipient
 	}
-	if request.SenderID == "" {
-		return &ErrorInvalidSenderID
-	}
 	if request.Channel == "" {
 		return &ErrorInvalidChannel
 	}
-	if request.Channel != string(common.ChannelTypeSMS) {
+	if !slices.Contains(supportedOTPChannels, common.ChannelType(request.Channel)) {
 		return &ErrorUnsupportedChannel
 	}
+	// Email OTPs are delivered through the server email client and do not need a sender.
+	if request.SenderID == "" && request.Channel != string(common.ChannelTypeEmail) {
+		return &ErrorInvalidSenderID
+	}
 	return nil
 }
 
@@ -252,35 +310,112 @@ func (s *otpService) getOTPValidityPeriodInMillis() int64 {
 	return 120000 // 2 minutes
 }
 
+// getMessageClient retrieves the sender and returns its message client after validating that the
+// sender supports the requested channel.
+func (s *otpService) getMessageClient(ctx context.Context, senderID string, channel common.ChannelType) (
+	message.NotificationClientInterface, *serviceerror.ServiceError) {
+	sender, svcErr := s.senderMgtService.GetSender(ctx, senderID)
+	if svcErr != nil {
+		if svcErr.Code == ErrorSenderNotFound.Code {
+			return nil, &ErrorSenderNotFound
+		}
+		return nil, &serviceerror.InternalServerError
+	}
+	if sender == nil {
+		return nil, &ErrorSenderNotFound
+	}
+
+	_client, svcErr := s.clientProvider.GetClient(*sender)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if !_client.IsChannelSupported(channel) {
+		return nil, &ErrorUnsupportedChannel
+	}
+	return _client, nil
+}
+
 // sendSMSOTP sends an SMS OTP to the recipient.
 func (s *otpService) sendSMSOTP(ctx context.Context, recipient, otp string,
-	sender common.NotificationSenderDTO, logger *log.Logger) *serviceerror.ServiceError {
-	expiryMinutes := strconv.FormatInt(s.getOTPValidityPeriodInMillis()/60000, 10)
-	templateData := template.TemplateData{"otp": otp, "expiryMinutes": expiryMinutes}
-	rendered, svcErr := s.templateService.Render(ctx, template.ScenarioOTP, template.TemplateTypeSMS, templateData)
+	_client message.NotificationClientInterface, logger *log.Logger) *serviceerror.ServiceError {
+	rendered, svcErr := s.templateService.Render(ctx, template.ScenarioOTP, template.TemplateTypeSMS,
+		s.getOTPTemplateData(otp))
 	if svcErr != nil {
 		logger.Error("Failed to render SMS OTP template", log.String("error", svcErr.Code))
 		return &serviceerror.InternalServerError
 	}
 
-	_client, clientSvcErr := s.clientProvider.GetClient(sender)
-	if clientSvcErr != nil {
-		return clientSvcErr
+	notifData := common.NotificationData{Recipient: recipient, Body: rendered.Body}
+	if err := _client.Send(common.ChannelTypeSMS, notifData); err != nil {
+		logger.Error("Failed to send SMS OTP", log.Error(err))
+		return &serviceerror.InternalServerError
 	}
 
-	if !_client.IsChannelSupported(common.ChannelTypeSMS) {
-		return &ErrorUnsupportedChannel
+	return nil
+}
+
+// sendEmailOTP sends an email OTP to the recipient using the server email client.
+func (s *otpService) sendEmailOTP(ctx context.Context, recipient, otp string,
+	logger *log.Logger) *serviceerror.ServiceError {
+	rendered, svcErr := s.templateService.Render(ctx, template.ScenarioOTP, template.TemplateTypeEmail,
+		s.getOTPTemplateData(otp))
+	if svcErr != nil {
+		logger.Error("Failed to render email OTP template", log.String("error", svcErr.Code))
+		return &serviceerror.InternalServerError
 	}
 
-	notifData := common.NotificationData{Recipient: recipient, Body: rendered.Body}
-	if err := _client.Send(common.ChannelTypeSMS, notifData); err != nil {
-		logger.Error("Failed to send SMS OTP", log.Error(err))
+	emailData := email.EmailData{
+		To:      []string{recipient},
+		Subject: rendered.Subject,
+		Body:    rendered.Body,
+		IsHTML:  rendered.IsHTML,
+	}
+	if err := s.emailClient.Send(emailData); err != nil {
+		logger.Error("Failed to send email OTP", log.Error(err))
 		return &serviceerror.InternalServerError
 	}
 
 	return nil
 }
 
+// getOTPTemplateData returns the data used to render the OTP templates.
+func (s *otpService) getOTPTemplateData(otp string) template.TemplateData {
+	expiryMinutes := strconv.FormatInt(s.getOTPValidityPeriodInMillis()/60000, 10)
+	return template.TemplateData{"otp": otp, "expiryMinutes": expiryMinutes}
+}
+
+// enforceSendThrottle rejects the send if an OTP was sent to the recipient within the resend
+// interval or the maximum number of sends of the send window has been reached. Otherwise the send
+// is recorded against the recipient in the same atomic store operation, so that concurrent sends
+// cannot both pass the throttle.
+func (s *otpService) enforceSendThrottle(ctx context.Context, channel common.ChannelType, recipient string,
+	logger *log.Logger) *serviceerror.ServiceError {
+	resendInterval := time.Duration(s.otpConfig.ResendInterval) * time.Second
+	sendWindow := time.Duration(s.otpConfig.SendWindow) * time.Second
+	limitSends := s.otpConfig.MaxSends > 0 && sendWindow > 0
+	if resendInterval <= 0 && !limitSends {
+		return nil
+	}
+	if !limitSends {
+		// A zero send window disables the send limit of the store.
+		sendWindow = 0
+	}
+
+	// Recipients are tracked by thumbprint to avoid storing them in plain text.
+	recipientKey := hash.GenerateThumbprintFromString(string(channel) + ":" + recipient)
+	recorded, err := s.sessionStore.RecordSend(ctx, recipientKey, time.Now(), resendInterval, sendWindow,
+		s.otpConfig.MaxSends)
+	if err != nil {
+		logger.Error("Failed to record OTP send", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if !recorded {
+		logger.Debug("OTP send throttled for the recipient", log.MaskedString("recipient", recipient))
+		return &ErrorOTPSendThrottled
+	}
+	return nil
+}
+
 // createSessionToken creates a JWT session token with OTP session data.
 func (s *otpService) createSessionToken(ctx context.Context, sessionData common.OTPSessionData) (string, error) {
 	claims := map[string]interface{}{
@@ -301,38 +436,44 @@ func (s *otpService) createSessionToken(ctx context.Context, sessionData common.
 	return token, nil
 }
 
-// verifyAndDecodeSessionToken verifies the JWT signature and decodes the session data.
+// verifyAndDecodeSessionToken verifies the JWT signature and decodes the session data. The jti of
+// the token is returned as the ID of the session.
 func (s *otpService) verifyAndDecodeSessionToken(token string, logger *log.Logger) (
-	*common.OTPSessionData, *serviceerror.ServiceError) {
+	*common.OTPSessionData, string, *serviceerror.ServiceError) {
 	// Verify JWT signature
 	jwtConfig := config.GetServerRuntime().Config.JWT
 	svcErr := s.jwtService.VerifyJWT(token, "otp-svc", jwtConfig.Issuer)
 	if svcErr != nil {
 		logger.Debug("Invalid session token", log.String("error", svcErr.Error.DefaultValue))
-		return nil, &ErrorInvalidSessionToken
+		return nil, "", &ErrorInvalidSessionToken
 	}
 
 	// Parse and extract OTP session data
 	payload, err := jwt.DecodeJWTPayload(token)
 	if err != nil {
-		return nil, &ErrorInvalidSessionToken
+		return nil, "", &ErrorInvalidSessionToken
 	}
 
 	otpDataClaim, ok := payload["otp_data"]
 	if !ok {
-		return nil, &ErrorInvalidSessionToken
+		return nil, "", &ErrorInvalidSessionToken
 	}
 
 	otpDataBytes, err := json.Marshal(otpDataClaim)
 	if err != nil {
-		return nil, &ErrorInvalidSessionToken
+		return nil, "", &ErrorInvalidSessionToken
 	}
 
 	var sessionData common.OTPSessionData
 	err = json.Unmarshal(otpDataBytes, &sessionData)
 	if err != nil {
-		return nil, &ErrorInvalidSessionToken
+		return nil, "", &ErrorInvalidSessionToken
+	}
+
+	sessionID, ok := payload["jti"].(string)
+	if !ok || sessionID == "" {
+		return nil, "", &ErrorInvalidSessionToken
 	}
 
-	return &sessionData, nil
+	return &sessionData, sessionID, nil
 }
@@ -35,19 +35,24 @@ import (
 	"github.com/asgardeo/thunder/internal/system/cmodels"
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
+	"github.com/asgardeo/thunder/internal/system/email"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	"github.com/asgardeo/thunder/internal/system/log"
 	"github.com/asgardeo/thunder/internal/system/template"
+	"github.com/asgardeo/thunder/tests/mocks/emailmock"
 	"github.com/asgardeo/thunder/tests/mocks/jose/jwtmock"
 	"github.com/asgardeo/thunder/tests/mocks/notification/messagemock"
 	"github.com/asgardeo/thunder/tests/mocks/templatemock"
 )
 
+const testOTPSessionID = "otp-session-123"
+
 type OTPServiceTestSuite struct {
 	suite.Suite
 	mockJWTService      *jwtmock.JWTServiceInterfaceMock
 	mockSenderService   *NotificationSenderMgtSvcInterfaceMock
 	mockTemplateService *templatemock.TemplateServiceInterfaceMock
+	mockSessionStore    *otpSessionStoreInterfaceMock
 	service             *otpService
 }
 
@@ -77,11 +82,13 @@ func (suite *OTPServiceTestSuite) SetupTest() {
 	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
 	suite.mockSenderService = NewNotificationSenderMgtSvcInterfaceMock(suite.T())
 	suite.mockTemplateService = templatemock.NewTemplateServiceInterfaceMock(suite.T())
+	suite.mockSessionStore = newOtpSessionStoreInterfaceMock(suite.T())
 	suite.service = &otpService{
 		jwtService:       suite.mockJWTService,
 		senderMgtService: suite.mockSenderService,
 		clientProvider:   newNotificationClientProvider(),
 		templateService:  suite.mockTemplateService,
+		sessionStore:     suite.mockSessionStore,
 	}
 }
 
@@ -145,7 +152,7 @@ func (suite *OTPServiceTestSuite) TestSendOTP_UnsupportedChannel() {
 	request := common.SendOTPDTO{
 		Recipient: "+15559876543",
 		SenderID:  "sender-123",
-		Channel:   "email",
+		Channel:   "whatsapp",
 	}
 
 	result, err := suite.service.SendOTP(context.Background(), request)
@@ -298,9 +305,12 @@ func (suite *OTPServiceTestSuite) TestSendOTP_GenerateOTPError() {
 	cryptorand.Reader = &badReader{}
 	defer func() { cryptorand.Reader = orig }()
 
-	// ensure clientProvider is a no-op (won't be reached if generateOTP fails early)
-	// mm := messagemock.NewMessageClientInterfaceMock(suite.T())
-	suite.service.clientProvider = newNotificationClientProviderInterfaceMock(suite.T())
+	// the sender capability is validated before the OTP is generated
+	mm := messagemock.NewNotificationClientInterfaceMock(suite.T())
+	mm.EXPECT().IsChannelSupported(common.ChannelTypeSMS).Return(true).Once()
+	cp := newNotificationClientProviderInterfaceMock(suite.T())
+	cp.EXPECT().GetClient(mock.Anything).Return(mm, nil).Once()
+	suite.service.clientProvider = cp
 
 	res, err := suite.service.SendOTP(context.Background(), req)
 
@@ -401,6 +411,7 @@ func (suite *OTPServiceTestSuite) TestVerifyOTP_Success() {
 	expiry := time.Now().Add(1 * time.Minute).UnixMilli()
 
 	payloadMap := map[string]interface{}{
+		"jti": testOTPSessionID,
 		"otp_data": map[string]interface{}{
 			"recipient":   "+15559876543",
 			"channel":     "sms",
@@ -419,6 +430,11 @@ func (suite *OTPServiceTestSuite) TestVerifyOTP_Success() {
 
 	suite.mockJWTService.EXPECT().VerifyJWT(token, mock.Anything, mock.Anything).Return(nil).Once()
 
+	suite.mockSessionStore.On("RecordVerifyAttempt", mock.Anything, testOTPSessionID, mock.Anything).
+		Return(&otpSessionState{Attempts: 1}, nil).Once()
+	suite.mockSessionStore.On("ConsumeSession", mock.Anything, testOTPSessionID, mock.Anything).
+		Return(true, nil).Once()
+
 	req := common.VerifyOTPDTO{SessionToken: token, OTPCode: otpValue}
 	res, err := suite.service.VerifyOTP(context.Background(), req)
 	suite.Nil(err)
@@ -433,6 +449,7 @@ func (suite *OTPServiceTestSuite) TestVerifyOTP_Expired() {
 	expiry := time.Now().Add(-1 * time.Minute).UnixMilli() // already expired
 
 	payloadMap := map[string]interface{}{
+		"jti": testOTPSessionID,
 		"otp_data": map[string]interface{}{
 			"recipient":   "+15559876543",
 			"channel":     "sms",
@@ -468,10 +485,6 @@ func (suite *OTPServiceTestSuite) TestSendOTP_ClientProviderError() {
 	sender := suite.getValidSender()
 	suite.mockSenderService.On("GetSender", mock.Anything, "sender-123").Return(sender, nil).Once()
 
-	suite.mockTemplateService.On("Render", mock.Anything, template.ScenarioOTP,
-		template.TemplateTypeSMS, mock.Anything).
-		Return(&template.RenderedTemplate{Body: "Your code is: 123456. Expires in 2 minutes."}, nil).Once()
-
 	// client provider returns a service error
 	cp := newNotificationClientProviderInterfaceMock(suite.T())
 	cp.EXPECT().GetClient(mock.Anything).Return(nil, &serviceerror.InternalServerError).Once()
@@ -493,10 +506,6 @@ func (suite *OTPServiceTestSuite) TestSendOTP_ClientChannelNotSupported() {
 	sender := suite.getValidSender()
 	suite.mockSenderService.On("GetSender", mock.Anything, "sender-123").Return(sender, nil).Once()
 
-	suite.mockTemplateService.On("Render", mock.Anything, template.ScenarioOTP,
-		template.TemplateTypeSMS, mock.Anything).
-		Return(&template.RenderedTemplate{Body: "Your code is: 123456. Expires in 2 minutes."}, nil).Once()
-
 	mm := messagemock.NewNotificationClientInterfaceMock(suite.T())
 	mm.EXPECT().IsChannelSupported(common.ChannelTypeSMS).Return(false).Once()
 	cp := newNotificationClientProviderInterfaceMock(suite.T())
@@ -547,6 +556,7 @@ func (suite *OTPServiceTestSuite) TestVerifyOTP_Mismatch() {
 	expiry := time.Now().Add(1 * time.Minute).UnixMilli()
 
 	payloadMap := map[string]interface{}{
+		"jti": testOTPSessionID,
 		"otp_data": map[string]interface{}{
 			"recipient":   "+15559876543",
 			"channel":     "sms",
@@ -565,6 +575,9 @@ func (suite *OTPServiceTestSuite) TestVerifyOTP_Mismatch() {
 
 	suite.mockJWTService.EXPECT().VerifyJWT(token, mock.Anything, mock.Anything).Return(nil).Once()
 
+	suite.mockSessionStore.On("RecordVerifyAttempt", mock.Anything, testOTPSessionID, mock.Anything).
+		Return(&otpSessionState{Attempts: 1}, nil).Once()
+
 	req := common.VerifyOTPDTO{SessionToken: token, OTPCode: otpValue}
 	res, err := suite.service.VerifyOTP(context.Background(), req)
 	suite.Nil(err)
@@ -590,6 +603,7 @@ func (suite *OTPServiceTestSuite) TestSendOTP_SenderServiceError_NotFound() {
 func (suite *OTPServiceTestSuite) TestVerifyOTP_UnmarshalError() {
 	// create payload where otp_value is an array (will cause unmarshal into struct to fail)
 	payloadMap := map[string]interface{}{
+		"jti": testOTPSessionID,
 		"otp_data": map[string]interface{}{
 			"recipient":   "+15559876543",
 			"channel":     "sms",
@@ -616,7 +630,8 @@ func (suite *OTPServiceTestSuite) TestVerifyOTP_UnmarshalError() {
 }
 
 func (suite *OTPServiceTestSuite) TestNewOTPService_Constructors() {
-	svc := newOTPService(suite.mockSenderService, suite.mockJWTService, suite.mockTemplateService)
+	svc := newOTPService(suite.mockSenderService, suite.mockJWTService, suite.mockTemplateService, nil,
+		suite.mockSessionStore)
 	suite.NotNil(svc)
 }
 
@@ -678,6 +693,7 @@ func (suite *OTPServiceTestSuite) TestVerifyAndDecode_Success() {
 	expiry := time.Now().Add(1 * time.Minute).UnixMilli()
 
 	payloadMap := map[string]interface{}{
+		"jti": testOTPSessionID,
 		"otp_data": map[string]interface{}{
 			"recipient":   "+15559876543",
 			"channel":     "sms",
@@ -696,8 +712,278 @@ func (suite *OTPServiceTestSuite) TestVerifyAndDecode_Success() {
 
 	suite.mockJWTService.EXPECT().VerifyJWT(token, mock.Anything, mock.Anything).Return(nil).Once()
 
-	sessionData, svcErr := suite.service.verifyAndDecodeSessionToken(token, log.GetLogger())
+	sessionData, sessionID, svcErr := suite.service.verifyAndDecodeSessionToken(token, log.GetLogger())
 	suite.Nil(svcErr)
 	suite.NotNil(sessionData)
 	suite.Equal("+15559876543", sessionData.Recipient)
+	suite.Equal(testOTPSessionID, sessionID)
+}
+
+func (suite *OTPServiceTestSuite) TestSendOTP_EmailSuccess() {
+	req := common.SendOTPDTO{
+		Recipient: "user@example.com",
+		Channel:   "email",
+	}
+
+	suite.mockTemplateService.On("Render", mock.Anything, template.ScenarioOTP,
+		template.TemplateTypeEmail, mock.Anything).
+		Return(&template.RenderedTemplate{Subject: "Your code", Body: "<p>123456</p>", IsHTML: true}, nil).Once()
+
+	mockEmailClient := emailmock.NewEmailClientInterfaceMock(suite.T())
+	mockEmailClient.EXPECT().Send(email.EmailData{
+		To:      []string{"user@example.com"},
+		Subject: "Your code",
+		Body:    "<p>123456</p>",
+		IsHTML:  true,
+	}).Return(nil).Once()
+	suite.service.emailClient = mockEmailClient
+
+	suite.mockJWTService.EXPECT().GenerateJWT(mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("session-token-123", int64(0), nil).Once()
+
+	res, err := suite.service.SendOTP(context.Background(), req)
+	suite.Nil(err)
+	suite.NotNil(res)
+	suite.Equal("session-token-123", res.SessionToken)
+	suite.mockSenderService.AssertNotCalled(suite.T(), "GetSender", mock.Anything, mock.Anything)
+}
+
+func (suite *OTPServiceTestSuite) TestSendOTP_EmailClientNotConfigured() {
+	req := common.SendOTPDTO{
+		Recipient: "user@example.com",
+		Channel:   "email",
+	}
+
+	res, err := suite.service.SendOTP(context.Background(), req)
+	suite.Nil(res)
+	suite.NotNil(err)
+	suite.Equal(ErrorEmailChannelNotConfigured.Code, err.Code)
+}
+
+func (suite *OTPServiceTestSuite) TestSendOTP_EmailSendError() {
+	req := common.SendOTPDTO{
+		Recipient: "user@example.com",
+		Channel:   "email",
+	}
+
+	suite.mockTemplateService.On("Render", mock.Anything, template.ScenarioOTP,
+		template.TemplateTypeEmail, mock.Anything).
+		Return(&template.RenderedTemplate{Subject: "Your code", Body: "123456"}, nil).Once()
+
+	mockEmailClient := emailmock.NewEmailClientInterfaceMock(suite.T())
+	mockEmailClient.EXPECT().Send(mock.Anything).Return(errors.New("smtp failure")).Once()
+	suite.service.emailClient = mockEmailClient
+
+	res, err := suite.service.SendOTP(context.Background(), req)
+	suite.Nil(res)
+	suite.NotNil(err)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *OTPServiceTestSuite) TestVerifyOTP_ReturnsChannel() {
+	otpValue := "123456"
+	payloadMap := map[string]interface{}{
+		"jti": testOTPSessionID,
+		"otp_data": map[string]interface{}{
+			"recipient":   "user@example.com",
+			"channel":     "email",
+			"otp_value":   hash.GenerateThumbprintFromString(otpValue),
+			"expiry_time": time.Now().Add(1 * time.Minute).UnixMilli(),
+		},
+	}
+
+	payloadBytes, _ := json.Marshal(payloadMap)
+	headerBytes, _ := json.Marshal(map[string]interface{}{"alg": "none"})
+	token := fmt.Sprintf("%s.%s.", base64.RawURLEncoding.EncodeToString(headerBytes),
+		base64.RawURLEncoding.EncodeToString(payloadBytes))
+
+	suite.mockJWTService.EXPECT().VerifyJWT(token, mock.Anything, mock.Anything).Return(nil).Once()
+
+	suite.mockSessionStore.On("RecordVerifyAttempt", mock.Anything, testOTPSessionID, mock.Anything).
+		Return(&otpSessionState{Attempts: 1}, nil).Once()
+	suite.mockSessionStore.On("ConsumeSession", mock.Anything, testOTPSessionID, mock.Anything).
+		Return(true, nil).Once()
+
+	req := common.VerifyOTPDTO{SessionToken: token, OTPCode: otpValue}
+	res, err := suite.service.VerifyOTP(context.Background(), req)
+	suite.Nil(err)
+	suite.NotNil(res)
+	suite.Equal(common.OTPVerifyStatusVerified, res.Status)
+	suite.Equal(common.ChannelTypeEmail, res.Channel)
+}
+
+// buildTestSessionToken builds an unsigned OTP session token for the given OTP and claims.
+func buildTestSessionToken(otpValue string, jti string) string {
+	payloadMap := map[string]interface{}{
+		"otp_data": map[string]interface{}{
+			"recipient":   "user@example.com",
+			"channel":     "email",
+			"otp_value":   hash.GenerateThumbprintFromString(otpValue),
+			"expiry_time": time.Now().Add(1 * time.Minute).UnixMilli(),
+		},
+	}
+	if jti != "" {
+		payloadMap["jti"] = jti
+	}
+
+	payloadBytes, _ := json.Marshal(payloadMap)
+	headerBytes, _ := json.Marshal(map[string]interface{}{"alg": "none"})
+	return fmt.Sprintf("%s.%s.", base64.RawURLEncoding.EncodeToString(headerBytes),
+		base64.RawURLEncoding.EncodeToString(payloadBytes))
+}
+
+func (suite *OTPServiceTestSuite) TestVerifyOTP_MissingSessionID() {
+	token := buildTestSessionToken("123456", "")
+	suite.mockJWTService.EXPECT().VerifyJWT(token, mock.Anything, mock.Anything).Return(nil).Once()
+
+	res, err := suite.service.VerifyOTP(context.Background(),
+		common.VerifyOTPDTO{SessionToken: token, OTPCode: "123456"})
+	suite.Nil(res)
+	suite.NotNil(err)
+	suite.Equal(ErrorInvalidSessionToken.Code, err.Code)
+}
+
+func (suite *OTPServiceTestSuite) TestVerifyOTP_AttemptsExceeded() {
+	suite.service.otpConfig = config.OTPConfig{MaxVerifyAttempts: 3}
+	token := buildTestSessionToken("123456", testOTPSessionID)
+	suite.mockJWTService.EXPECT().VerifyJWT(token, mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockSessionStore.On("RecordVerifyAttempt", mock.Anything, testOTPSessionID, mock.Anything).
+		Return(&otpSessionState{Attempts: 4}, nil).Once()
+
+	res, err := suite.service.VerifyOTP(context.Background(),
+		common.VerifyOTPDTO{SessionToken: token, OTPCode: "123456"})
+	suite.Nil(res)
+	suite.NotNil(err)
+	suite.Equal(ErrorOTPAttemptsExceeded.Code, err.Code)
+	suite.mockSessionStore.AssertNotCalled(suite.T(), "ConsumeSession", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OTPServiceTestSuite) TestVerifyOTP_LastAllowedAttempt() {
+	suite.service.otpConfig = config.OTPConfig{MaxVerifyAttempts: 3}
+	token := buildTestSessionToken("123456", testOTPSessionID)
+	suite.mockJWTService.EXPECT().VerifyJWT(token, mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockSessionStore.On("RecordVerifyAttempt", mock.Anything, testOTPSessionID, mock.Anything).
+		Return(&otpSessionState{Attempts: 3}, nil).Once()
+	suite.mockSessionStore.On("ConsumeSession", mock.Anything, testOTPSessionID, mock.Anything).
+		Return(true, nil).Once()
+
+	res, err := suite.service.VerifyOTP(context.Background(),
+		common.VerifyOTPDTO{SessionToken: token, OTPCode: "123456"})
+	suite.Nil(err)
+	suite.Equal(common.OTPVerifyStatusVerified, res.Status)
+}
+
+func (suite *OTPServiceTestSuite) TestVerifyOTP_SessionAlreadyConsumed() {
+	token := buildTestSessionToken("123456", testOTPSessionID)
+	suite.mockJWTService.EXPECT().VerifyJWT(token, mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockSessionStore.On("RecordVerifyAttempt", mock.Anything, testOTPSessionID, mock.Anything).
+		Return(&otpSessionState{Attempts: 2, Consumed: true}, nil).Once()
+
+	res, err := suite.service.VerifyOTP(context.Background(),
+		common.VerifyOTPDTO{SessionToken: token, OTPCode: "123456"})
+	suite.Nil(err)
+	suite.Equal(common.OTPVerifyStatusInvalid, res.Status)
+	suite.mockSessionStore.AssertNotCalled(suite.T(), "ConsumeSession", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OTPServiceTestSuite) TestVerifyOTP_ConsumedConcurrently() {
+	token := buildTestSessionToken("123456", testOTPSessionID)
+	suite.mockJWTService.EXPECT().VerifyJWT(token, mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockSessionStore.On("RecordVerifyAttempt", mock.Anything, testOTPSessionID, mock.Anything).
+		Return(&otpSessionState{Attempts: 1}, nil).Once()
+	suite.mockSessionStore.On("ConsumeSession", mock.Anything, testOTPSessionID, mock.Anything).
+		Return(false, nil).Once()
+
+	res, err := suite.service.VerifyOTP(context.Background(),
+		common.VerifyOTPDTO{SessionToken: token, OTPCode: "123456"})
+	suite.Nil(err)
+	suite.Equal(common.OTPVerifyStatusInvalid, res.Status)
+}
+
+func (suite *OTPServiceTestSuite) TestVerifyOTP_RecordAttemptError() {
+	token := buildTestSessionToken("123456", testOTPSessionID)
+	suite.mockJWTService.EXPECT().VerifyJWT(token, mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockSessionStore.On("RecordVerifyAttempt", mock.Anything, testOTPSessionID, mock.Anything).
+		Return(nil, errors.New("db error")).Once()
+
+	res, err := suite.service.VerifyOTP(context.Background(),
+		common.VerifyOTPDTO{SessionToken: token, OTPCode: "123456"})
+	suite.Nil(res)
+	suite.NotNil(err)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *OTPServiceTestSuite) TestVerifyOTP_ConsumeError() {
+	token := buildTestSessionToken("123456", testOTPSessionID)
+	suite.mockJWTService.EXPECT().VerifyJWT(token, mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockSessionStore.On("RecordVerifyAttempt", mock.Anything, testOTPSessionID, mock.Anything).
+		Return(&otpSessionState{Attempts: 1}, nil).Once()
+	suite.mockSessionStore.On("ConsumeSession", mock.Anything, testOTPSessionID, mock.Anything).
+		Return(false, errors.New("db error")).Once()
+
+	res, err := suite.service.VerifyOTP(context.Background(),
+		common.VerifyOTPDTO{SessionToken: token, OTPCode: "123456"})
+	suite.Nil(res)
+	suite.NotNil(err)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *OTPServiceTestSuite) TestSendOTP_RecordsSend() {
+	suite.service.otpConfig = config.OTPConfig{ResendInterval: 30, MaxSends: 5, SendWindow: 900}
+	recipientKey := hash.GenerateThumbprintFromString("email:user@example.com")
+	req := common.SendOTPDTO{Recipient: "user@example.com", Channel: "email"}
+
+	suite.mockSessionStore.On("RecordSend", mock.Anything, recipientKey, mock.Anything,
+		30*time.Second, 15*time.Minute, 5).Return(true, nil).Once()
+	suite.mockTemplateService.On("Render", mock.Anything, template.ScenarioOTP,
+		template.TemplateTypeEmail, mock.Anything).
+		Return(&template.RenderedTemplate{Subject: "Your code", Body: "123456"}, nil).Once()
+	mockEmailClient := emailmock.NewEmailClientInterfaceMock(suite.T())
+	mockEmailClient.EXPECT().Send(mock.Anything).Return(nil).Once()
+	suite.service.emailClient = mockEmailClient
+	suite.mockJWTService.EXPECT().GenerateJWT(mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("session-token-123", int64(0), nil).Once()
+
+	res, err := suite.service.SendOTP(context.Background(), req)
+	suite.Nil(err)
+	suite.Equal("session-token-123", res.SessionToken)
+}
+
+func (suite *OTPServiceTestSuite) TestSendOTP_SendLimitDisabled() {
+	suite.service.otpConfig = config.OTPConfig{ResendInterval: 30, SendWindow: 900}
+	suite.service.emailClient = emailmock.NewEmailClientInterfaceMock(suite.T())
+	suite.mockSessionStore.On("RecordSend", mock.Anything, mock.Anything, mock.Anything,
+		30*time.Second, time.Duration(0), 0).Return(false, nil).Once()
+
+	res, err := suite.service.SendOTP(context.Background(),
+		common.SendOTPDTO{Recipient: "user@example.com", Channel: "email"})
+	suite.Nil(res)
+	suite.NotNil(err)
+	suite.Equal(ErrorOTPSendThrottled.Code, err.Code)
+}
+
+func (suite *OTPServiceTestSuite) TestSendOTP_SendThrottled() {
+	suite.service.otpConfig = config.OTPConfig{ResendInterval: 30, MaxSends: 5, SendWindow: 900}
+	suite.service.emailClient = emailmock.NewEmailClientInterfaceMock(suite.T())
+	suite.mockSessionStore.On("RecordSend", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Once()
+
+	res, err := suite.service.SendOTP(context.Background(),
+		common.SendOTPDTO{Recipient: "user@example.com", Channel: "email"})
+	suite.Nil(res)
+	suite.NotNil(err)
+	suite.Equal(ErrorOTPSendThrottled.Code, err.Code)
+}
+
+func (suite *OTPServiceTestSuite) TestSendOTP_SendRecordStoreError() {
+	suite.service.otpConfig = config.OTPConfig{ResendInterval: 30}
+	suite.service.emailClient = emailmock.NewEmailClientInterfaceMock(suite.T())
+	suite.mockSessionStore.On("RecordSend", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(false, errors.New("db error")).Once()
+
+	res, err := suite.service.SendOTP(context.Background(),
+		common.SendOTPDTO{Recipient: "user@example.com", Channel: "email"})
+	suite.Nil(res)
+	suite.NotNil(err)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
 }
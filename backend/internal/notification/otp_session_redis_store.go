@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// recordSendScript atomically records an OTP send to a recipient unless it is throttled. A new send window
+// is started once the previous one has ended. Returns 1 if the send was recorded, 0 if it is throttled.
+// KEYS[1] is the send record key; ARGV holds the send time in milliseconds, the resend interval and send
+// window in milliseconds, and the maximum number of sends of the window.
+var recordSendScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local resendInterval = tonumber(ARGV[2])
+local record = {sendCount = 0, windowEnd = 0, lastSentAt = 0}
+local val = redis.call('GET', KEYS[1])
+if val then record = cjson.decode(val) end
+if now < record['lastSentAt'] + resendInterval then return 0 end
+if record['windowEnd'] <= now then
+	record['sendCount'] = 0
+	record['windowEnd'] = now + tonumber(ARGV[3])
+elseif record['sendCount'] >= tonumber(ARGV[4]) then
+	return 0
+end
+record['sendCount'] = record['sendCount'] + 1
+record['lastSentAt'] = now
+redis.call('SET', KEYS[1], cjson.encode(record))
+redis.call('PEXPIREAT', KEYS[1], math.max(record['windowEnd'], now + resendInterval))
+return 1
+`)
+
+// otpSessionRedisClient abstracts the Redis commands used by the OTP session store.
+type otpSessionRedisClient interface {
+	redis.Scripter
+	Incr(ctx context.Context, key string) *redis.IntCmd
+	ExpireAt(ctx context.Context, key string, tm time.Time) *redis.BoolCmd
+	Exists(ctx context.Context, keys ...string) *redis.IntCmd
+	SetNX(ctx context.Context, key string, value any, expiration time.Duration) *redis.BoolCmd
+}
+
+// redisOTPSessionStore is the Redis-backed implementation of otpSessionStoreInterface.
+// Entries are written with a TTL matching their expiry time so that Redis evicts them
+// automatically once the OTP session or send window is over.
+type redisOTPSessionStore struct {
+	client       otpSessionRedisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// newRedisOTPSessionStore creates a new Redis-backed OTP session store.
+func newRedisOTPSessionStore(p provider.RedisProviderInterface, deploymentID string) otpSessionStoreInterface {
+	return &redisOTPSessionStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: deploymentID,
+	}
+}
+
+// attemptsKey builds the Redis key for the verification attempt counter of a session.
+func (s *redisOTPSessionStore) attemptsKey(sessionID string) string {
+	return fmt.Sprintf("%s:runtime:%s:otp_session:%s:attempts", s.keyPrefix, s.deploymentID, sessionID)
+}
+
+// consumedKey builds the Redis key for the consumption marker of a session.
+func (s *redisOTPSessionStore) consumedKey(sessionID string) string {
+	return fmt.Sprintf("%s:runtime:%s:otp_session:%s:consumed", s.keyPrefix, s.deploymentID, sessionID)
+}
+
+// sendRecordKey builds the Redis key for the send record of a recipient.
+func (s *redisOTPSessionStore) sendRecordKey(recipientKey string) string {
+	return fmt.Sprintf("%s:runtime:%s:otp_send:%s", s.keyPrefix, s.deploymentID, recipientKey)
+}
+
+// RecordVerifyAttempt atomically increments the verification attempts of the session and returns
+// its state after the increment.
+func (s *redisOTPSessionStore) RecordVerifyAttempt(
+	ctx context.Context, sessionID string, expiryTime time.Time,
+) (*otpSessionState, error) {
+	key := s.attemptsKey(sessionID)
+	attempts, err := s.client.Incr(ctx, key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to record OTP verification attempt in Redis: %w", err)
+	}
+	if attempts == 1 {
+		if err := s.client.ExpireAt(ctx, key, expiryTime).Err(); err != nil {
+			return nil, fmt.Errorf("failed to set expiry of OTP session in Redis: %w", err)
+		}
+	}
+
+	consumed, err := s.client.Exists(ctx, s.consumedKey(sessionID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OTP session from Redis: %w", err)
+	}
+
+	return &otpSessionState{
+		Attempts: int(attempts),
+		Consumed: consumed > 0,
+	}, nil
+}
+
+// ConsumeSession marks the session as consumed. Returns false if the session was already consumed.
+func (s *redisOTPSessionStore) ConsumeSession(
+	ctx context.Context, sessionID string, expiryTime time.Time,
+) (bool, error) {
+	ttl := time.Until(expiryTime)
+	if ttl <= 0 {
+		// The session can no longer be verified; there is nothing to consume.
+		return false, nil
+	}
+
+	consumed, err := s.client.SetNX(ctx, s.consumedKey(sessionID), "1", ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to consume OTP session in Redis: %w", err)
+	}
+	return consumed, nil
+}
+
+// RecordSend atomically records a send to the recipient in Redis unless it is throttled. Returns false
+// if the send is throttled.
+func (s *redisOTPSessionStore) RecordSend(
+	ctx context.Context, recipientKey string, now time.Time, resendInterval, sendWindow time.Duration, maxSends int,
+) (bool, error) {
+	recorded, err := recordSendScript.Run(ctx, s.client, []string{s.sendRecordKey(recipientKey)},
+		now.UnixMilli(), resendInterval.Milliseconds(), sendWindow.Milliseconds(), maxSends).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to record OTP send in Redis: %w", err)
+	}
+	return recorded == 1, nil
+}
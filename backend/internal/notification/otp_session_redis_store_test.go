@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const redisTestKeyPrefix = "thunderid"
+
+type OTPSessionRedisStoreTestSuite struct {
+	suite.Suite
+	mockClient *otpSessionRedisClientMock
+	store      *redisOTPSessionStore
+	ctx        context.Context
+}
+
+func TestOTPSessionRedisStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(OTPSessionRedisStoreTestSuite))
+}
+
+func (s *OTPSessionRedisStoreTestSuite) SetupTest() {
+	s.mockClient = newOtpSessionRedisClientMock(s.T())
+	s.store = &redisOTPSessionStore{
+		client:       s.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+}
+
+func (s *OTPSessionRedisStoreTestSuite) sessionKey(suffix string) string {
+	return fmt.Sprintf("%s:runtime:%s:otp_session:%s:%s", redisTestKeyPrefix, testDeploymentID,
+		testOTPSessionID, suffix)
+}
+
+func (s *OTPSessionRedisStoreTestSuite) TestKeys() {
+	s.Equal(s.sessionKey("attempts"), s.store.attemptsKey(testOTPSessionID))
+	s.Equal(s.sessionKey("consumed"), s.store.consumedKey(testOTPSessionID))
+	s.Equal(fmt.Sprintf("%s:runtime:%s:otp_send:recipient-key", redisTestKeyPrefix, testDeploymentID),
+		s.store.sendRecordKey("recipient-key"))
+}
+
+func (s *OTPSessionRedisStoreTestSuite) TestRecordVerifyAttempt_FirstAttempt() {
+	expiry := time.Now().Add(time.Minute)
+	incrCmd := redis.NewIntCmd(s.ctx)
+	incrCmd.SetVal(1)
+	expireCmd := redis.NewBoolCmd(s.ctx)
+	expireCmd.SetVal(true)
+	existsCmd := redis.NewIntCmd(s.ctx)
+	existsCmd.SetVal(0)
+	s.mockClient.On("Incr", s.ctx, s.sessionKey("attempts")).Return(incrCmd)
+	s.mockClient.On("ExpireAt", s.ctx, s.sessionKey("attempts"), expiry).Return(expireCmd)
+	s.mockClient.On("Exists", s.ctx, s.sessionKey("consumed")).Return(existsCmd)
+
+	state, err := s.store.RecordVerifyAttempt(s.ctx, testOTPSessionID, expiry)
+
+	s.NoError(err)
+	s.Equal(&otpSessionState{Attempts: 1, Consumed: false}, state)
+}
+
+func (s *OTPSessionRedisStoreTestSuite) TestRecordVerifyAttempt_Consumed() {
+	incrCmd := redis.NewIntCmd(s.ctx)
+	incrCmd.SetVal(3)
+	existsCmd := redis.NewIntCmd(s.ctx)
+	existsCmd.SetVal(1)
+	s.mockClient.On("Incr", s.ctx, s.sessionKey("attempts")).Return(incrCmd)
+	s.mockClient.On("Exists", s.ctx, s.sessionKey("consumed")).Return(existsCmd)
+
+	state, err := s.store.RecordVerifyAttempt(s.ctx, testOTPSessionID, time.Now().Add(time.Minute))
+
+	s.NoError(err)
+	s.Equal(&otpSessionState{Attempts: 3, Consumed: true}, state)
+	s.mockClient.AssertNotCalled(s.T(), "ExpireAt", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *OTPSessionRedisStoreTestSuite) TestRecordVerifyAttempt_IncrError() {
+	incrCmd := redis.NewIntCmd(s.ctx)
+	incrCmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("Incr", s.ctx, mock.Anything).Return(incrCmd)
+
+	state, err := s.store.RecordVerifyAttempt(s.ctx, testOTPSessionID, time.Now().Add(time.Minute))
+
+	s.ErrorContains(err, "failed to record OTP verification attempt in Redis")
+	s.Nil(state)
+}
+
+func (s *OTPSessionRedisStoreTestSuite) TestConsumeSession_Success() {
+	boolCmd := redis.NewBoolCmd(s.ctx)
+	boolCmd.SetVal(true)
+	s.mockClient.On("SetNX", s.ctx, s.sessionKey("consumed"), "1",
+		mock.MatchedBy(func(ttl time.Duration) bool { return ttl > 0 && ttl <= time.Minute })).Return(boolCmd)
+
+	consumed, err := s.store.ConsumeSession(s.ctx, testOTPSessionID, time.Now().Add(time.Minute))
+
+	s.NoError(err)
+	s.True(consumed)
+}
+
+func (s *OTPSessionRedisStoreTestSuite) TestConsumeSession_AlreadyConsumed() {
+	boolCmd := redis.NewBoolCmd(s.ctx)
+	boolCmd.SetVal(false)
+	s.mockClient.On("SetNX", s.ctx, s.sessionKey("consumed"), "1", mock.Anything).Return(boolCmd)
+
+	consumed, err := s.store.ConsumeSession(s.ctx, testOTPSessionID, time.Now().Add(time.Minute))
+
+	s.NoError(err)
+	s.False(consumed)
+}
+
+func (s *OTPSessionRedisStoreTestSuite) TestConsumeSession_Expired() {
+	consumed, err := s.store.ConsumeSession(s.ctx, testOTPSessionID, time.Now().Add(-time.Minute))
+
+	s.NoError(err)
+	s.False(consumed)
+	s.mockClient.AssertNotCalled(s.T(), "SetNX", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *OTPSessionRedisStoreTestSuite) TestRecordSend_Recorded() {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	cmd := redis.NewCmd(s.ctx)
+	cmd.SetVal(int64(1))
+	s.mockClient.On("EvalSha", s.ctx, recordSendScript.Hash(), []string{s.store.sendRecordKey("recipient-key")},
+		now.UnixMilli(), int64(30000), int64(900000), 5).Return(cmd)
+
+	recorded, err := s.store.RecordSend(s.ctx, "recipient-key", now, 30*time.Second, 15*time.Minute, 5)
+
+	s.NoError(err)
+	s.True(recorded)
+}
+
+func (s *OTPSessionRedisStoreTestSuite) TestRecordSend_Throttled() {
+	cmd := redis.NewCmd(s.ctx)
+	cmd.SetVal(int64(0))
+	s.mockClient.On("EvalSha", s.ctx, recordSendScript.Hash(), mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(cmd)
+
+	recorded, err := s.store.RecordSend(s.ctx, "recipient-key", time.Now(), 30*time.Second, 15*time.Minute, 5)
+
+	s.NoError(err)
+	s.False(recorded)
+}
+
+func (s *OTPSessionRedisStoreTestSuite) TestRecordSend_RedisError() {
+	cmd := redis.NewCmd(s.ctx)
+	cmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("EvalSha", s.ctx, recordSendScript.Hash(), mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(cmd)
+
+	recorded, err := s.store.RecordSend(s.ctx, "recipient-key", time.Now(), 30*time.Second, 15*time.Minute, 5)
+
+	s.ErrorContains(err, "failed to record OTP send in Redis")
+	s.False(recorded)
+}
@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// Database column names for OTP session storage.
+const (
+	dbColumnAttempts   = "attempts"
+	dbColumnConsumedAt = "consumed_at"
+)
+
+// otpSessionState holds the server-side verification state of an OTP session.
+type otpSessionState struct {
+	Attempts int
+	Consumed bool
+}
+
+// otpSessionStoreInterface defines the interface for tracking the server-side state of OTP sessions
+// and the OTP sends made to recipients. Entries only need to be retained until the given expiry time.
+type otpSessionStoreInterface interface {
+	RecordVerifyAttempt(ctx context.Context, sessionID string, expiryTime time.Time) (*otpSessionState, error)
+	ConsumeSession(ctx context.Context, sessionID string, expiryTime time.Time) (bool, error)
+	// RecordSend atomically records a send to the recipient made at the given time unless it is throttled.
+	// A send is throttled when it is made within the resend interval of the last send, or when maxSends
+	// sends have already been made within the send window. A zero send window disables the send limit.
+	// Returns false if the send is throttled.
+	RecordSend(ctx context.Context, recipientKey string, now time.Time, resendInterval, sendWindow time.Duration,
+		maxSends int) (bool, error)
+}
+
+// otpSessionStore is the relational-DB-backed implementation of otpSessionStoreInterface.
+type otpSessionStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newOTPSessionStore creates a new DB-backed OTP session store.
+func newOTPSessionStore(deploymentID string) otpSessionStoreInterface {
+	return &otpSessionStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// RecordVerifyAttempt atomically increments the verification attempts of the session and returns
+// its state after the increment.
+func (s *otpSessionStore) RecordVerifyAttempt(
+	ctx context.Context, sessionID string, expiryTime time.Time,
+) (*otpSessionState, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(
+		ctx, queryIncrementOTPSessionAttempts, sessionID, s.deploymentID, expiryTime.UTC(),
+	); err != nil {
+		return nil, fmt.Errorf("failed to record OTP verification attempt: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetOTPSession, sessionID, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query OTP session: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, errors.New("OTP session not found after recording the attempt")
+	}
+
+	return buildOTPSessionStateFromRow(results[0])
+}
+
+// ConsumeSession marks the session as consumed. Returns false if the session was already consumed.
+func (s *otpSessionStore) ConsumeSession(ctx context.Context, sessionID string, _ time.Time) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(
+		ctx, queryConsumeOTPSession, sessionID, s.deploymentID, time.Now().UTC(),
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to consume OTP session: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// RecordSend atomically records a send to the recipient unless it is throttled. Returns false if the
+// send is throttled.
+func (s *otpSessionStore) RecordSend(
+	ctx context.Context, recipientKey string, now time.Time, resendInterval, sendWindow time.Duration, maxSends int,
+) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	now = now.UTC()
+	windowEnd := now.Add(sendWindow)
+	resendAt := now.Add(resendInterval)
+	expiryTime := resendAt
+	if windowEnd.After(expiryTime) {
+		expiryTime = windowEnd
+	}
+	rows, err := dbClient.ExecuteContext(ctx, queryRecordOTPSend, recipientKey, s.deploymentID, now,
+		now.Add(-resendInterval), windowEnd, expiryTime, resendAt, maxSends)
+	if err != nil {
+		return false, fmt.Errorf("failed to record OTP send: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// buildOTPSessionStateFromRow reconstructs an otpSessionState from a database row.
+func buildOTPSessionStateFromRow(row map[string]any) (*otpSessionState, error) {
+	var attempts int
+	switch val := row[dbColumnAttempts].(type) {
+	case int64:
+		attempts = int(val)
+	case int32:
+		attempts = int(val)
+	case int:
+		attempts = val
+	default:
+		return nil, errors.New("attempts is missing or of unexpected type")
+	}
+
+	return &otpSessionState{
+		Attempts: attempts,
+		Consumed: row[dbColumnConsumedAt] != nil,
+	}, nil
+}
@@ -0,0 +1,219 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/tests/mocks/database/providermock"
+)
+
+type OTPSessionStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *otpSessionStore
+	ctx            context.Context
+	expiry         time.Time
+}
+
+func TestOTPSessionStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(OTPSessionStoreTestSuite))
+}
+
+func (s *OTPSessionStoreTestSuite) SetupTest() {
+	s.mockDBProvider = providermock.NewDBProviderInterfaceMock(s.T())
+	s.mockDBClient = providermock.NewDBClientInterfaceMock(s.T())
+	s.store = &otpSessionStore{
+		dbProvider:   s.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+	s.expiry = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+}
+
+func (s *OTPSessionStoreTestSuite) TestRecordVerifyAttempt_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryIncrementOTPSessionAttempts,
+		testOTPSessionID, testDeploymentID, s.expiry).Return(int64(1), nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetOTPSession, testOTPSessionID, testDeploymentID).
+		Return([]map[string]any{{dbColumnAttempts: int64(2), dbColumnConsumedAt: nil}}, nil)
+
+	state, err := s.store.RecordVerifyAttempt(s.ctx, testOTPSessionID, s.expiry)
+
+	s.NoError(err)
+	s.Equal(&otpSessionState{Attempts: 2, Consumed: false}, state)
+}
+
+func (s *OTPSessionStoreTestSuite) TestRecordVerifyAttempt_Consumed() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryIncrementOTPSessionAttempts,
+		mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetOTPSession, mock.Anything, mock.Anything).
+		Return([]map[string]any{{dbColumnAttempts: int32(3), dbColumnConsumedAt: time.Now()}}, nil)
+
+	state, err := s.store.RecordVerifyAttempt(s.ctx, testOTPSessionID, s.expiry)
+
+	s.NoError(err)
+	s.Equal(&otpSessionState{Attempts: 3, Consumed: true}, state)
+}
+
+func (s *OTPSessionStoreTestSuite) TestRecordVerifyAttempt_DBClientError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db client error"))
+
+	state, err := s.store.RecordVerifyAttempt(s.ctx, testOTPSessionID, s.expiry)
+
+	s.Error(err)
+	s.Nil(state)
+}
+
+func (s *OTPSessionStoreTestSuite) TestRecordVerifyAttempt_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryIncrementOTPSessionAttempts,
+		mock.Anything, mock.Anything, mock.Anything).Return(int64(0), errors.New("exec failed"))
+
+	state, err := s.store.RecordVerifyAttempt(s.ctx, testOTPSessionID, s.expiry)
+
+	s.ErrorContains(err, "failed to record OTP verification attempt")
+	s.Nil(state)
+}
+
+func (s *OTPSessionStoreTestSuite) TestRecordVerifyAttempt_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryIncrementOTPSessionAttempts,
+		mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetOTPSession, mock.Anything, mock.Anything).
+		Return([]map[string]any{}, nil)
+
+	state, err := s.store.RecordVerifyAttempt(s.ctx, testOTPSessionID, s.expiry)
+
+	s.Error(err)
+	s.Nil(state)
+}
+
+func (s *OTPSessionStoreTestSuite) TestRecordVerifyAttempt_InvalidAttempts() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryIncrementOTPSessionAttempts,
+		mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetOTPSession, mock.Anything, mock.Anything).
+		Return([]map[string]any{{dbColumnAttempts: "two"}}, nil)
+
+	state, err := s.store.RecordVerifyAttempt(s.ctx, testOTPSessionID, s.expiry)
+
+	s.ErrorContains(err, "attempts is missing")
+	s.Nil(state)
+}
+
+func (s *OTPSessionStoreTestSuite) TestConsumeSession_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryConsumeOTPSession,
+		testOTPSessionID, testDeploymentID, mock.Anything).Return(int64(1), nil)
+
+	consumed, err := s.store.ConsumeSession(s.ctx, testOTPSessionID, s.expiry)
+
+	s.NoError(err)
+	s.True(consumed)
+}
+
+func (s *OTPSessionStoreTestSuite) TestConsumeSession_AlreadyConsumed() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryConsumeOTPSession,
+		mock.Anything, mock.Anything, mock.Anything).Return(int64(0), nil)
+
+	consumed, err := s.store.ConsumeSession(s.ctx, testOTPSessionID, s.expiry)
+
+	s.NoError(err)
+	s.False(consumed)
+}
+
+func (s *OTPSessionStoreTestSuite) TestConsumeSession_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryConsumeOTPSession,
+		mock.Anything, mock.Anything, mock.Anything).Return(int64(0), errors.New("exec failed"))
+
+	consumed, err := s.store.ConsumeSession(s.ctx, testOTPSessionID, s.expiry)
+
+	s.ErrorContains(err, "failed to consume OTP session")
+	s.False(consumed)
+}
+
+func (s *OTPSessionStoreTestSuite) TestRecordSend_Recorded() {
+	now := s.expiry
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryRecordOTPSend, "recipient-key", testDeploymentID,
+		now, now.Add(-30*time.Second), now.Add(15*time.Minute), now.Add(15*time.Minute),
+		now.Add(30*time.Second), 5).Return(int64(1), nil)
+
+	recorded, err := s.store.RecordSend(s.ctx, "recipient-key", now, 30*time.Second, 15*time.Minute, 5)
+
+	s.NoError(err)
+	s.True(recorded)
+}
+
+func (s *OTPSessionStoreTestSuite) TestRecordSend_ExpiresAfterResendIntervalWithoutSendWindow() {
+	now := s.expiry
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryRecordOTPSend, "recipient-key", testDeploymentID,
+		now, now.Add(-30*time.Second), now, now.Add(30*time.Second), now.Add(30*time.Second), 0).
+		Return(int64(1), nil)
+
+	recorded, err := s.store.RecordSend(s.ctx, "recipient-key", now, 30*time.Second, 0, 0)
+
+	s.NoError(err)
+	s.True(recorded)
+}
+
+func (s *OTPSessionStoreTestSuite) TestRecordSend_Throttled() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryRecordOTPSend, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(int64(0), nil)
+
+	recorded, err := s.store.RecordSend(s.ctx, "recipient-key", s.expiry, 30*time.Second, 15*time.Minute, 5)
+
+	s.NoError(err)
+	s.False(recorded)
+}
+
+func (s *OTPSessionStoreTestSuite) TestRecordSend_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryRecordOTPSend, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(int64(0), errors.New("exec failed"))
+
+	recorded, err := s.store.RecordSend(s.ctx, "recipient-key", s.expiry, 30*time.Second, 15*time.Minute, 5)
+
+	s.ErrorContains(err, "failed to record OTP send")
+	s.False(recorded)
+}
+
+func (s *OTPSessionStoreTestSuite) TestRecordSend_DBClientError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db unavailable"))
+
+	recorded, err := s.store.RecordSend(s.ctx, "recipient-key", s.expiry, 30*time.Second, 15*time.Minute, 5)
+
+	s.ErrorContains(err, "failed to get database client")
+	s.False(recorded)
+}
@@ -67,4 +67,44 @@ var (
 		Query: `SELECT ID, NAME, DESCRIPTION, TYPE, PROVIDER, PROPERTIES ` +
 			`FROM "NOTIFICATION_SENDER" WHERE NAME = $1 AND DEPLOYMENT_ID = $2`,
 	}
+
+	// queryIncrementOTPSessionAttempts is the query to record a verification attempt of an OTP session.
+	queryIncrementOTPSessionAttempts = dbmodel.DBQuery{
+		ID: "NMQ-OS-01",
+		Query: `INSERT INTO "OTP_SESSION" (SESSION_ID, DEPLOYMENT_ID, ATTEMPTS, EXPIRY_TIME) ` +
+			`VALUES ($1, $2, 1, $3) ON CONFLICT (SESSION_ID, DEPLOYMENT_ID) ` +
+			`DO UPDATE SET ATTEMPTS = "OTP_SESSION".ATTEMPTS + 1`,
+	}
+
+	// queryGetOTPSession is the query to get the verification state of an OTP session.
+	queryGetOTPSession = dbmodel.DBQuery{
+		ID: "NMQ-OS-02",
+		Query: `SELECT ATTEMPTS, CONSUMED_AT FROM "OTP_SESSION" ` +
+			`WHERE SESSION_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryConsumeOTPSession is the query to mark an OTP session as consumed if it is not already.
+	queryConsumeOTPSession = dbmodel.DBQuery{
+		ID: "NMQ-OS-03",
+		Query: `UPDATE "OTP_SESSION" SET CONSUMED_AT = $3 ` +
+			`WHERE SESSION_ID = $1 AND DEPLOYMENT_ID = $2 AND CONSUMED_AT IS NULL`,
+	}
+
+	// queryRecordOTPSend is the query to record an OTP send to a recipient unless it is throttled. A new send
+	// window is started once the previous one has ended, and nothing is written when the send is made within
+	// the resend interval of the last send or the maximum number of sends of the window has been reached.
+	queryRecordOTPSend = dbmodel.DBQuery{
+		ID: "NMQ-OS-04",
+		Query: `INSERT INTO "OTP_SEND_RECORD" (RECIPIENT_KEY, DEPLOYMENT_ID, SEND_COUNT, WINDOW_END, LAST_SENT_AT, ` +
+			`EXPIRY_TIME) VALUES ($1, $2, 1, $5, $3, $6) ON CONFLICT (RECIPIENT_KEY, DEPLOYMENT_ID) DO UPDATE SET ` +
+			`SEND_COUNT = CASE WHEN "OTP_SEND_RECORD".EXPIRY_TIME <= $3 OR "OTP_SEND_RECORD".WINDOW_END <= $3 ` +
+			`THEN 1 ELSE "OTP_SEND_RECORD".SEND_COUNT + 1 END, ` +
+			`WINDOW_END = CASE WHEN "OTP_SEND_RECORD".EXPIRY_TIME <= $3 OR "OTP_SEND_RECORD".WINDOW_END <= $3 ` +
+			`THEN $5 ELSE "OTP_SEND_RECORD".WINDOW_END END, ` +
+			`EXPIRY_TIME = CASE WHEN "OTP_SEND_RECORD".EXPIRY_TIME <= $3 OR "OTP_SEND_RECORD".WINDOW_END <= $3 ` +
+			`THEN $6 WHEN "OTP_SEND_RECORD".WINDOW_END > $7 THEN "OTP_SEND_RECORD".WINDOW_END ELSE $7 END, ` +
+			`LAST_SENT_AT = $3 ` +
+			`WHERE "OTP_SEND_RECORD".EXPIRY_TIME <= $3 OR ("OTP_SEND_RECORD".LAST_SENT_AT <= $4 ` +
+			`AND ("OTP_SEND_RECORD".WINDOW_END <= $3 OR "OTP_SEND_RECORD".SEND_COUNT < $8))`,
+	}
 )
@@ -30,18 +30,27 @@ import (
 	"github.com/asgardeo/thunder/internal/flow/flowexec"
 	"github.com/asgardeo/thunder/internal/inboundclient"
 	"github.com/asgardeo/thunder/internal/oauth/jwks"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/ciba"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/clientauth"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/dcr"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/device"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/discovery"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/dpop"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/granthandlers"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/introspect"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/jwksresolver"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/logout"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/par"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/requestobject"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/revocation"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/token"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokengrant"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/userinfo"
 	"github.com/asgardeo/thunder/internal/oauth/scope"
 	"github.com/asgardeo/thunder/internal/ou"
 	"github.com/asgardeo/thunder/internal/resource"
+	"github.com/asgardeo/thunder/internal/session"
 	"github.com/asgardeo/thunder/internal/system/database/provider"
 	syshttp "github.com/asgardeo/thunder/internal/system/http"
 	i18nmgt "github.com/asgardeo/thunder/internal/system/i18n/mgt"
@@ -52,6 +61,7 @@ import (
 )
 
 // Initialize initializes all OAuth-related services and registers their routes.
+// Returns the token revocation service so that resource-side token validation can reject revoked tokens.
 func Initialize(
 	mux *http.ServeMux,
 	applicationService application.ApplicationServiceInterface,
@@ -68,11 +78,16 @@ func Initialize(
 	entityProvider entityprovider.EntityProviderInterface,
 	resourceService resource.ResourceServiceInterface,
 	i18nService i18nmgt.I18nServiceInterface,
-) error {
+	sessionService session.SessionServiceInterface,
+) (revocation.TokenRevocationServiceInterface, error) {
 	// Fetch runtime transactioner for OAuth services.
 	transactioner, err := provider.GetDBProvider().GetRuntimeDBTransactioner()
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if err := clientauth.Initialize(); err != nil {
+		return nil, err
 	}
 
 	jwks.Initialize(mux, pkiService)
@@ -80,22 +95,32 @@ func Initialize(
 		return syshttp.IsSSRFSafeURL(req.URL.String())
 	})
 	resolver := jwksresolver.Initialize(httpClient)
-	tokenBuilder, tokenValidator := tokenservice.Initialize(jwtService, jweService, resolver)
+	tokenBuilder, tokenValidator := tokenservice.Initialize(jwtService, jweService, resolver, inboundClient)
 	scopeValidator := scope.Initialize()
 	discoveryService := discovery.Initialize(mux, pkiService)
+	dpopService := dpop.Initialize(jwtService)
+	requestObjectService := requestobject.Initialize(jwtService, jweService, resolver, httpClient)
 	parService := par.Initialize(mux, inboundClient, authnProvider, jwtService, discoveryService,
-		resourceService)
+		resourceService, requestObjectService)
+	revocationService := revocation.Initialize(mux, jwtService, inboundClient, authnProvider, discoveryService)
+	grantService := tokengrant.Initialize(mux)
+	deviceService := device.Initialize(mux, inboundClient, authnProvider, jwtService, discoveryService,
+		flowExecService, resourceService)
+	cibaService := ciba.Initialize(mux, inboundClient, authnProvider, jwtService, tokenValidator, discoveryService,
+		flowExecService, resourceService, entityProvider)
 	grantHandlerProvider, err := granthandlers.Initialize(
 		mux, jwtService, inboundClient, flowExecService, tokenBuilder, tokenValidator,
-		attributeCacheSvc, ouService, authzService, entityProvider, resourceService, parService)
+		attributeCacheSvc, ouService, authzService, entityProvider, resourceService, parService,
+		requestObjectService, revocationService, grantService, sessionService, deviceService, cibaService, resolver)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	token.Initialize(mux, jwtService, inboundClient, authnProvider, grantHandlerProvider,
-		scopeValidator, observabilitySvc, discoveryService, transactioner)
-	introspect.Initialize(mux, jwtService, inboundClient, authnProvider, discoveryService)
+		scopeValidator, observabilitySvc, discoveryService, dpopService, transactioner)
+	introspect.Initialize(mux, jwtService, inboundClient, authnProvider, discoveryService, revocationService)
 	userinfo.Initialize(mux, jwtService, jweService, resolver,
-		tokenValidator, inboundClient, ouService, attributeCacheSvc, transactioner)
+		tokenValidator, inboundClient, ouService, attributeCacheSvc, discoveryService, dpopService, transactioner)
+	logout.Initialize(mux, jwtService, tokenValidator, inboundClient, sessionService)
 	dcr.Initialize(mux, applicationService, ouService, i18nService, transactioner)
-	return nil
+	return revocationService, nil
 }
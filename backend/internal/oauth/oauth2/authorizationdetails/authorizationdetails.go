@@ -0,0 +1,211 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package authorizationdetails provides shared helpers for RFC 9396 rich authorization request
+// processing across the authorization, pushed authorization and token endpoints.
+package authorizationdetails
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+	"github.com/asgardeo/thunder/internal/resource"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+)
+
+const (
+	fieldType      = "type"
+	fieldLocations = "locations"
+)
+
+// Parse parses the JSON-encoded authorization_details request parameter (RFC 9396 §2). Each entry
+// must be a JSON object with a non-empty string "type", and "locations", when present, must be an
+// array of strings. An empty parameter or an empty array yields no authorization details.
+func Parse(raw string) ([]model.AuthorizationDetail, *model.ErrorResponse) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	var entries []interface{}
+	if err := json.Unmarshal([]byte(raw), &entries); err != nil {
+		return nil, invalidDetails("The authorization_details parameter must be a JSON array")
+	}
+
+	details := make([]model.AuthorizationDetail, 0, len(entries))
+	for _, entry := range entries {
+		obj, ok := entry.(map[string]interface{})
+		if !ok {
+			return nil, invalidDetails("Each authorization details entry must be a JSON object")
+		}
+		if detailType, ok := obj[fieldType].(string); !ok || detailType == "" {
+			return nil, invalidDetails("Each authorization details entry must have a type")
+		}
+		if locations, exists := obj[fieldLocations]; exists {
+			if _, ok := toStringSlice(locations); !ok {
+				return nil, invalidDetails("The locations of an authorization details entry must be an array of strings")
+			}
+		}
+		details = append(details, model.AuthorizationDetail(obj))
+	}
+	if len(details) == 0 {
+		return nil, nil
+	}
+	return details, nil
+}
+
+// Validate validates each authorization details entry against the type definitions registered on
+// resource servers (RFC 9396 §5). Unknown types and schema violations surface as
+// invalid_authorization_details, as do locations that do not identify the resource server that
+// defines the type.
+func Validate(
+	ctx context.Context,
+	resourceService resource.ResourceServiceInterface,
+	details []model.AuthorizationDetail,
+) *model.ErrorResponse {
+	for _, detail := range details {
+		rs, svcErr := resourceService.ValidateAuthorizationDetail(ctx, detail)
+		if svcErr != nil {
+			if svcErr.Type == serviceerror.ServerErrorType {
+				return &model.ErrorResponse{
+					Error:            constants.ErrorServerError,
+					ErrorDescription: "Failed to validate authorization details",
+				}
+			}
+			return invalidDetails(svcErr.ErrorDescription.DefaultValue)
+		}
+		locations, _ := toStringSlice(detail[fieldLocations])
+		for _, location := range locations {
+			if location != rs.Identifier {
+				return invalidDetails("The locations of an authorization details entry must identify " +
+					"the resource server that defines its type")
+			}
+		}
+	}
+	return nil
+}
+
+// Narrow returns the requested authorization details when each of them is present in the granted
+// details, or the granted details when none are requested. It returns invalid_authorization_details
+// when a requested entry exceeds the grant (RFC 9396 §6.1).
+func Narrow(
+	requested, granted []model.AuthorizationDetail,
+) ([]model.AuthorizationDetail, *model.ErrorResponse) {
+	if len(requested) == 0 {
+		return granted, nil
+	}
+	for _, detail := range requested {
+		if !contains(granted, detail) {
+			return nil, invalidDetails("Requested authorization details exceed the authorization granted " +
+				"by the resource owner")
+		}
+	}
+	return requested, nil
+}
+
+// Serialize encodes authorization details as a JSON array. It returns an empty string when there
+// are no details.
+func Serialize(details []model.AuthorizationDetail) (string, error) {
+	if len(details) == 0 {
+		return "", nil
+	}
+	encoded, err := json.Marshal(details)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+// FromClaim converts a decoded authorization_details claim into authorization details. Entries
+// that are not JSON objects are ignored.
+func FromClaim(value interface{}) []model.AuthorizationDetail {
+	entries, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+	details := make([]model.AuthorizationDetail, 0, len(entries))
+	for _, entry := range entries {
+		if obj, ok := entry.(map[string]interface{}); ok {
+			details = append(details, model.AuthorizationDetail(obj))
+		}
+	}
+	if len(details) == 0 {
+		return nil
+	}
+	return details
+}
+
+// contains reports whether the given detail is equal to any of the details, comparing their JSON
+// representations so that numeric and nested values compare consistently.
+func contains(details []model.AuthorizationDetail, detail model.AuthorizationDetail) bool {
+	target, ok := normalize(detail)
+	if !ok {
+		return false
+	}
+	for _, candidate := range details {
+		if normalized, ok := normalize(candidate); ok && reflect.DeepEqual(normalized, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize round-trips a detail through JSON to obtain a canonical in-memory representation.
+func normalize(detail model.AuthorizationDetail) (interface{}, bool) {
+	encoded, err := json.Marshal(detail)
+	if err != nil {
+		return nil, false
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(encoded, &normalized); err != nil {
+		return nil, false
+	}
+	return normalized, true
+}
+
+// toStringSlice converts a decoded JSON array of strings into a string slice.
+func toStringSlice(value interface{}) ([]string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return nil, true
+	case []string:
+		return v, true
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			result = append(result, s)
+		}
+		return result, true
+	default:
+		return nil, false
+	}
+}
+
+// invalidDetails builds an invalid_authorization_details error response.
+func invalidDetails(description string) *model.ErrorResponse {
+	return &model.ErrorResponse{
+		Error:            constants.ErrorInvalidAuthorizationDetails,
+		ErrorDescription: description,
+	}
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package authorizationdetails
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+	"github.com/asgardeo/thunder/internal/resource"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/tests/mocks/resourcemock"
+)
+
+type AuthorizationDetailsTestSuite struct {
+	suite.Suite
+	mockResourceService *resourcemock.ResourceServiceInterfaceMock
+}
+
+func TestAuthorizationDetailsTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthorizationDetailsTestSuite))
+}
+
+func (suite *AuthorizationDetailsTestSuite) SetupTest() {
+	suite.mockResourceService = resourcemock.NewResourceServiceInterfaceMock(suite.T())
+}
+
+func paymentDetail() model.AuthorizationDetail {
+	return model.AuthorizationDetail{
+		"type":               "payment_initiation",
+		"locations":          []interface{}{"https://payments.example.com"},
+		"instructedAmount":   map[string]interface{}{"currency": "EUR", "amount": "50.00"},
+		"creditorAccountIds": []interface{}{"DE02100100109307118603"},
+	}
+}
+
+// Parse tests
+
+func (suite *AuthorizationDetailsTestSuite) TestParse_Empty() {
+	details, errResp := Parse("")
+	suite.Nil(errResp)
+	suite.Nil(details)
+
+	details, errResp = Parse("[]")
+	suite.Nil(errResp)
+	suite.Nil(details)
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestParse_Valid() {
+	details, errResp := Parse(`[{"type":"payment_initiation","locations":["https://payments.example.com"],` +
+		`"instructedAmount":{"currency":"EUR","amount":"50.00"}}]`)
+	suite.Nil(errResp)
+	suite.Len(details, 1)
+	suite.Equal("payment_initiation", details[0]["type"])
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestParse_Invalid() {
+	testCases := []struct {
+		name string
+		raw  string
+	}{
+		{"NotJSON", "not-json"},
+		{"NotArray", `{"type":"payment_initiation"}`},
+		{"EntryNotObject", `["payment_initiation"]`},
+		{"MissingType", `[{"actions":["read"]}]`},
+		{"EmptyType", `[{"type":""}]`},
+		{"NonStringType", `[{"type":42}]`},
+		{"LocationsNotArray", `[{"type":"payment_initiation","locations":"https://payments.example.com"}]`},
+		{"LocationsNotStrings", `[{"type":"payment_initiation","locations":[1]}]`},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			details, errResp := Parse(tc.raw)
+			suite.Nil(details)
+			suite.NotNil(errResp)
+			suite.Equal(constants.ErrorInvalidAuthorizationDetails, errResp.Error)
+		})
+	}
+}
+
+// Validate tests
+
+func (suite *AuthorizationDetailsTestSuite) TestValidate_Success() {
+	detail := paymentDetail()
+	suite.mockResourceService.On("ValidateAuthorizationDetail", mock.Anything, map[string]interface{}(detail)).
+		Return(&resource.ResourceServer{Identifier: "https://payments.example.com"}, nil)
+
+	errResp := Validate(context.Background(), suite.mockResourceService, []model.AuthorizationDetail{detail})
+	suite.Nil(errResp)
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestValidate_LocationMismatch() {
+	detail := paymentDetail()
+	suite.mockResourceService.On("ValidateAuthorizationDetail", mock.Anything, mock.Anything).
+		Return(&resource.ResourceServer{Identifier: "https://accounts.example.com"}, nil)
+
+	errResp := Validate(context.Background(), suite.mockResourceService, []model.AuthorizationDetail{detail})
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorInvalidAuthorizationDetails, errResp.Error)
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestValidate_ClientError() {
+	suite.mockResourceService.On("ValidateAuthorizationDetail", mock.Anything, mock.Anything).
+		Return(nil, &resource.ErrorAuthorizationDetailTypeNotFound)
+
+	errResp := Validate(context.Background(), suite.mockResourceService,
+		[]model.AuthorizationDetail{paymentDetail()})
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorInvalidAuthorizationDetails, errResp.Error)
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestValidate_ServerError() {
+	suite.mockResourceService.On("ValidateAuthorizationDetail", mock.Anything, mock.Anything).
+		Return(nil, &serviceerror.InternalServerError)
+
+	errResp := Validate(context.Background(), suite.mockResourceService,
+		[]model.AuthorizationDetail{paymentDetail()})
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorServerError, errResp.Error)
+}
+
+// Narrow tests
+
+func (suite *AuthorizationDetailsTestSuite) TestNarrow_NoneRequested() {
+	granted := []model.AuthorizationDetail{paymentDetail()}
+	narrowed, errResp := Narrow(nil, granted)
+	suite.Nil(errResp)
+	suite.Equal(granted, narrowed)
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestNarrow_Subset() {
+	accountDetail := model.AuthorizationDetail{"type": "account_information", "actions": []interface{}{"read"}}
+	granted := []model.AuthorizationDetail{paymentDetail(), accountDetail}
+
+	// The requested entry is decoded from JSON, so its values differ in Go type from the granted ones.
+	requested, errResp := Parse(`[{"type":"account_information","actions":["read"]}]`)
+	suite.Nil(errResp)
+
+	narrowed, errResp := Narrow(requested, granted)
+	suite.Nil(errResp)
+	suite.Equal(requested, narrowed)
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestNarrow_ExceedsGrant() {
+	requested := paymentDetail()
+	requested["instructedAmount"] = map[string]interface{}{"currency": "EUR", "amount": "5000.00"}
+
+	narrowed, errResp := Narrow([]model.AuthorizationDetail{requested}, []model.AuthorizationDetail{paymentDetail()})
+	suite.Nil(narrowed)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorInvalidAuthorizationDetails, errResp.Error)
+}
+
+// Serialize and FromClaim tests
+
+func (suite *AuthorizationDetailsTestSuite) TestSerialize_RoundTrip() {
+	serialized, err := Serialize(nil)
+	suite.NoError(err)
+	suite.Empty(serialized)
+
+	serialized, err = Serialize([]model.AuthorizationDetail{paymentDetail()})
+	suite.NoError(err)
+
+	parsed, errResp := Parse(serialized)
+	suite.Nil(errResp)
+	narrowed, errResp := Narrow(parsed, []model.AuthorizationDetail{paymentDetail()})
+	suite.Nil(errResp)
+	suite.Len(narrowed, 1)
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestFromClaim() {
+	suite.Nil(FromClaim(nil))
+	suite.Nil(FromClaim("payment_initiation"))
+	suite.Nil(FromClaim([]interface{}{"payment_initiation"}))
+
+	details := FromClaim([]interface{}{map[string]interface{}{"type": "payment_initiation"}, "ignored"})
+	suite.Len(details, 1)
+	suite.Equal("payment_initiation", details[0]["type"])
+}
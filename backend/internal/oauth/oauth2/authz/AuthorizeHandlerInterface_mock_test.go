@@ -128,3 +128,49 @@ func (_c *AuthorizeHandlerInterfaceMock_HandleAuthorizeGetRequest_Call) RunAndRe
 	_c.Run(run)
 	return _c
 }
+
+// HandleAuthorizeResponseGetRequest provides a mock function for the type AuthorizeHandlerInterfaceMock
+func (_mock *AuthorizeHandlerInterfaceMock) HandleAuthorizeResponseGetRequest(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// AuthorizeHandlerInterfaceMock_HandleAuthorizeResponseGetRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleAuthorizeResponseGetRequest'
+type AuthorizeHandlerInterfaceMock_HandleAuthorizeResponseGetRequest_Call struct {
+	*mock.Call
+}
+
+// HandleAuthorizeResponseGetRequest is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *AuthorizeHandlerInterfaceMock_Expecter) HandleAuthorizeResponseGetRequest(w interface{}, r interface{}) *AuthorizeHandlerInterfaceMock_HandleAuthorizeResponseGetRequest_Call {
+	return &AuthorizeHandlerInterfaceMock_HandleAuthorizeResponseGetRequest_Call{Call: _e.mock.On("HandleAuthorizeResponseGetRequest", w, r)}
+}
+
+func (_c *AuthorizeHandlerInterfaceMock_HandleAuthorizeResponseGetRequest_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *AuthorizeHandlerInterfaceMock_HandleAuthorizeResponseGetRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthorizeHandlerInterfaceMock_HandleAuthorizeResponseGetRequest_Call) Return() *AuthorizeHandlerInterfaceMock_HandleAuthorizeResponseGetRequest_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *AuthorizeHandlerInterfaceMock_HandleAuthorizeResponseGetRequest_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *AuthorizeHandlerInterfaceMock_HandleAuthorizeResponseGetRequest_Call {
+	_c.Run(run)
+	return _c
+}
@@ -111,26 +111,98 @@ func (_c *AuthorizeServiceInterfaceMock_GetAuthorizationCodeDetails_Call) RunAnd
 	return _c
 }
 
+// GetFormPostResponse provides a mock function for the type AuthorizeServiceInterfaceMock
+func (_mock *AuthorizeServiceInterfaceMock) GetFormPostResponse(ctx context.Context, authID string) (*FormPostResponse, *AuthorizationError) {
+	ret := _mock.Called(ctx, authID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFormPostResponse")
+	}
+
+	var r0 *FormPostResponse
+	var r1 *AuthorizationError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*FormPostResponse, *AuthorizationError)); ok {
+		return returnFunc(ctx, authID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *FormPostResponse); ok {
+		r0 = returnFunc(ctx, authID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*FormPostResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *AuthorizationError); ok {
+		r1 = returnFunc(ctx, authID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*AuthorizationError)
+		}
+	}
+	return r0, r1
+}
+
+// AuthorizeServiceInterfaceMock_GetFormPostResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFormPostResponse'
+type AuthorizeServiceInterfaceMock_GetFormPostResponse_Call struct {
+	*mock.Call
+}
+
+// GetFormPostResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authID string
+func (_e *AuthorizeServiceInterfaceMock_Expecter) GetFormPostResponse(ctx interface{}, authID interface{}) *AuthorizeServiceInterfaceMock_GetFormPostResponse_Call {
+	return &AuthorizeServiceInterfaceMock_GetFormPostResponse_Call{Call: _e.mock.On("GetFormPostResponse", ctx, authID)}
+}
+
+func (_c *AuthorizeServiceInterfaceMock_GetFormPostResponse_Call) Run(run func(ctx context.Context, authID string)) *AuthorizeServiceInterfaceMock_GetFormPostResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthorizeServiceInterfaceMock_GetFormPostResponse_Call) Return(formPostResponse *FormPostResponse, authorizationError *AuthorizationError) *AuthorizeServiceInterfaceMock_GetFormPostResponse_Call {
+	_c.Call.Return(formPostResponse, authorizationError)
+	return _c
+}
+
+func (_c *AuthorizeServiceInterfaceMock_GetFormPostResponse_Call) RunAndReturn(run func(ctx context.Context, authID string) (*FormPostResponse, *AuthorizationError)) *AuthorizeServiceInterfaceMock_GetFormPostResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HandleAuthorizationCallback provides a mock function for the type AuthorizeServiceInterfaceMock
-func (_mock *AuthorizeServiceInterfaceMock) HandleAuthorizationCallback(ctx context.Context, authID string, assertion string) (string, *AuthorizationError) {
-	ret := _mock.Called(ctx, authID, assertion)
+func (_mock *AuthorizeServiceInterfaceMock) HandleAuthorizationCallback(ctx context.Context, authID string, assertion string, sessionID string) (*AuthorizationCallbackResult, *AuthorizationError) {
+	ret := _mock.Called(ctx, authID, assertion, sessionID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for HandleAuthorizationCallback")
 	}
 
-	var r0 string
+	var r0 *AuthorizationCallbackResult
 	var r1 *AuthorizationError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, *AuthorizationError)); ok {
-		return returnFunc(ctx, authID, assertion)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*AuthorizationCallbackResult, *AuthorizationError)); ok {
+		return returnFunc(ctx, authID, assertion, sessionID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
-		r0 = returnFunc(ctx, authID, assertion)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *AuthorizationCallbackResult); ok {
+		r0 = returnFunc(ctx, authID, assertion, sessionID)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*AuthorizationCallbackResult)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) *AuthorizationError); ok {
-		r1 = returnFunc(ctx, authID, assertion)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) *AuthorizationError); ok {
+		r1 = returnFunc(ctx, authID, assertion, sessionID)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*AuthorizationError)
@@ -148,11 +220,12 @@ type AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call struct {
 //   - ctx context.Context
 //   - authID string
 //   - assertion string
-func (_e *AuthorizeServiceInterfaceMock_Expecter) HandleAuthorizationCallback(ctx interface{}, authID interface{}, assertion interface{}) *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call {
-	return &AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call{Call: _e.mock.On("HandleAuthorizationCallback", ctx, authID, assertion)}
+//   - sessionID string
+func (_e *AuthorizeServiceInterfaceMock_Expecter) HandleAuthorizationCallback(ctx interface{}, authID interface{}, assertion interface{}, sessionID interface{}) *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call {
+	return &AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call{Call: _e.mock.On("HandleAuthorizationCallback", ctx, authID, assertion, sessionID)}
 }
 
-func (_c *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call) Run(run func(ctx context.Context, authID string, assertion string)) *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call {
+func (_c *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call) Run(run func(ctx context.Context, authID string, assertion string, sessionID string)) *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -166,21 +239,26 @@ func (_c *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call) Run(ru
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call) Return(s string, authorizationError *AuthorizationError) *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call {
-	_c.Call.Return(s, authorizationError)
+func (_c *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call) Return(authorizationCallbackResult *AuthorizationCallbackResult, authorizationError *AuthorizationError) *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call {
+	_c.Call.Return(authorizationCallbackResult, authorizationError)
 	return _c
 }
 
-func (_c *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call) RunAndReturn(run func(ctx context.Context, authID string, assertion string) (string, *AuthorizationError)) *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call {
+func (_c *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call) RunAndReturn(run func(ctx context.Context, authID string, assertion string, sessionID string) (*AuthorizationCallbackResult, *AuthorizationError)) *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call {
 	_c.Call.Return(run)
 	return _c
 }
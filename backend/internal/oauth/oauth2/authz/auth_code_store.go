@@ -24,31 +24,35 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/authorizationdetails"
 	oauth2model "github.com/asgardeo/thunder/internal/oauth/oauth2/model"
 	oauth2utils "github.com/asgardeo/thunder/internal/oauth/oauth2/utils"
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/database/provider"
+	dbutils "github.com/asgardeo/thunder/internal/system/database/utils"
 )
 
 const (
-	columnNameCodeID               = "code_id"
-	columnNameAuthorizationCode    = "authorization_code"
-	columnNameClientID             = "client_id"
-	columnNameState                = "state"
-	columnNameAuthZData            = "authz_data"
-	columnNameTimeCreated          = "time_created"
-	columnNameExpiryTime           = "expiry_time"
-	jsonDataKeyRedirectURI         = "redirect_uri"
-	jsonDataKeyAuthorizedUserID    = "authorized_user_id"
-	jsonDataKeyScopes              = "scopes"
-	jsonDataKeyCodeChallenge       = "code_challenge"
-	jsonDataKeyCodeChallengeMethod = "code_challenge_method"
-	jsonDataKeyResource            = "resource"
-	jsonDataKeyAttributeCacheID    = "attribute_cache_id"
-	jsonDataKeyClaimsRequest       = "claims_request"
-	jsonDataKeyClaimsLocales       = "claims_locales"
-	jsonDataKeyNonce               = "nonce"
-	jsonDataKeyCompletedACR        = "completed_acr"
+	columnNameCodeID                = "code_id"
+	columnNameAuthorizationCode     = "authorization_code"
+	columnNameClientID              = "client_id"
+	columnNameState                 = "state"
+	columnNameAuthZData             = "authz_data"
+	columnNameTimeCreated           = "time_created"
+	columnNameExpiryTime            = "expiry_time"
+	jsonDataKeyRedirectURI          = "redirect_uri"
+	jsonDataKeyAuthorizedUserID     = "authorized_user_id"
+	jsonDataKeyScopes               = "scopes"
+	jsonDataKeyCodeChallenge        = "code_challenge"
+	jsonDataKeyCodeChallengeMethod  = "code_challenge_method"
+	jsonDataKeyResource             = "resource"
+	jsonDataKeyAttributeCacheID     = "attribute_cache_id"
+	jsonDataKeyClaimsRequest        = "claims_request"
+	jsonDataKeyClaimsLocales        = "claims_locales"
+	jsonDataKeyNonce                = "nonce"
+	jsonDataKeyCompletedACR         = "completed_acr"
+	jsonDataKeyAuthorizationDetails = "authorization_details"
+	jsonDataKeySessionSID           = "session_sid"
 )
 
 // AuthorizationCodeStoreInterface defines the interface for managing authorization codes.
@@ -145,6 +149,7 @@ func (acs *authorizationCodeStore) getJSONDataBytes(authzCode AuthorizationCode)
 		jsonDataKeyClaimsLocales:       authzCode.ClaimsLocales,
 		jsonDataKeyNonce:               authzCode.Nonce,
 		jsonDataKeyCompletedACR:        authzCode.CompletedACR,
+		jsonDataKeySessionSID:          authzCode.SessionSID,
 	}
 
 	// Include user attributes if present
@@ -157,6 +162,11 @@ func (acs *authorizationCodeStore) getJSONDataBytes(authzCode AuthorizationCode)
 		jsonData[jsonDataKeyClaimsRequest] = authzCode.ClaimsRequest
 	}
 
+	// Include authorization details if present
+	if len(authzCode.AuthorizationDetails) > 0 {
+		jsonData[jsonDataKeyAuthorizationDetails] = authzCode.AuthorizationDetails
+	}
+
 	jsonDataBytes, err := json.Marshal(jsonData)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling authz data to JSON: %w", err)
@@ -198,11 +208,11 @@ func buildAuthorizationCodeFromResultRow(row map[string]interface{}) (*Authoriza
 		return nil, errors.New("state is empty")
 	}
 
-	timeCreated, err := parseTimeField(row[columnNameTimeCreated], columnNameTimeCreated)
+	timeCreated, err := dbutils.ParseTimeField(row[columnNameTimeCreated], columnNameTimeCreated)
 	if err != nil {
 		return nil, err
 	}
-	expiryTime, err := parseTimeField(row[columnNameExpiryTime], columnNameExpiryTime)
+	expiryTime, err := dbutils.ParseTimeField(row[columnNameExpiryTime], columnNameExpiryTime)
 	if err != nil {
 		return nil, err
 	}
@@ -274,6 +284,9 @@ func appendAuthzDataJSON(row map[string]interface{}, authzCode *AuthorizationCod
 	if completedACR, ok := authzData[jsonDataKeyCompletedACR].(string); ok {
 		authzCode.CompletedACR = completedACR
 	}
+	if sessionSID, ok := authzData[jsonDataKeySessionSID].(string); ok {
+		authzCode.SessionSID = sessionSID
+	}
 
 	if claimsData, ok := authzData[jsonDataKeyClaimsRequest]; ok && claimsData != nil {
 		claimsRequest, err := parseClaimsRequestFromJSON(claimsData)
@@ -283,6 +296,8 @@ func appendAuthzDataJSON(row map[string]interface{}, authzCode *AuthorizationCod
 		authzCode.ClaimsRequest = claimsRequest
 	}
 
+	authzCode.AuthorizationDetails = authorizationdetails.FromClaim(authzData[jsonDataKeyAuthorizationDetails])
+
 	return authzCode, nil
 }
 
@@ -301,40 +301,6 @@ func (suite *AuthorizationCodeStoreTestSuite) TestConsumeAuthorizationCode_Execu
 	suite.mockDBClient.AssertExpectations(suite.T())
 }
 
-const testTimeString = "2023-12-01 10:30:45.123456789"
-
-func (suite *AuthorizationCodeStoreTestSuite) TestParseTimeField_StringInput() {
-	testTime := testTimeString + " extra content"
-	expectedTime, _ := time.Parse("2006-01-02 15:04:05.999999999", testTimeString)
-
-	result, err := parseTimeField(testTime, "test_field")
-	assert.NoError(suite.T(), err)
-	assert.Equal(suite.T(), expectedTime, result)
-}
-
-func (suite *AuthorizationCodeStoreTestSuite) TestParseTimeField_TimeInput() {
-	testTime := time.Now()
-
-	result, err := parseTimeField(testTime, "test_field")
-	assert.NoError(suite.T(), err)
-	assert.Equal(suite.T(), testTime, result)
-}
-
-func (suite *AuthorizationCodeStoreTestSuite) TestTrimTimeString() {
-	input := testTimeString + " extra content here"
-	expected := testTimeString
-
-	result := trimTimeString(input)
-	assert.Equal(suite.T(), expected, result)
-}
-
-func (suite *AuthorizationCodeStoreTestSuite) TestTrimTimeString_ShortInput() {
-	input := "2023-12-01"
-
-	result := trimTimeString(input)
-	assert.Equal(suite.T(), input, result)
-}
-
 func (suite *AuthorizationCodeStoreTestSuite) TestGetAuthorizationCode_InvalidCodeIDType() {
 	queryResults := []map[string]interface{}{
 		{
@@ -687,15 +653,6 @@ func (suite *AuthorizationCodeStoreTestSuite) TestGetAuthorizationCode_AuthzData
 	suite.mockDBClient.AssertExpectations(suite.T())
 }
 
-func (suite *AuthorizationCodeStoreTestSuite) TestParseTimeField_InvalidStringFormat() {
-	testTime := "invalid-time-format"
-
-	result, err := parseTimeField(testTime, "test_field")
-	assert.Error(suite.T(), err)
-	assert.Contains(suite.T(), err.Error(), "error parsing test_field")
-	assert.True(suite.T(), result.IsZero())
-}
-
 func (suite *AuthorizationCodeStoreTestSuite) TestGetAuthorizationCode_WithNonce() {
 	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
 
@@ -733,3 +690,44 @@ func (suite *AuthorizationCodeStoreTestSuite) TestGetAuthorizationCode_WithNonce
 	suite.mockdbProvider.AssertExpectations(suite.T())
 	suite.mockDBClient.AssertExpectations(suite.T())
 }
+
+func (suite *AuthorizationCodeStoreTestSuite) TestGetAuthorizationCode_WithAuthorizationDetails() {
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+
+	authzData := map[string]interface{}{
+		"redirect_uri":       "https://client.example.com/callback",
+		"authorized_user_id": "test-user-id",
+		"scopes":             "read write",
+		"authorization_details": []interface{}{
+			map[string]interface{}{"type": "payment_initiation", "amount": "50.00"},
+		},
+	}
+
+	authzDataJSON, _ := json.Marshal(authzData)
+
+	suite.mockDBClient.On("QueryContext",
+		mock.Anything,
+		queryGetAuthorizationCode,
+		"test-code",
+		testDeploymentID,
+	).Return([]map[string]interface{}{
+		{
+			"code_id":            "test-code-id",
+			"authorization_code": "test-code",
+			"client_id":          "test-client-id",
+			"state":              AuthCodeStateActive,
+			"authz_data":         string(authzDataJSON),
+			"time_created":       "2023-01-01 12:00:00",
+			"expiry_time":        "2023-01-01 12:10:00",
+		},
+	}, nil)
+
+	result, err := suite.store.GetAuthorizationCode(context.Background(), "test-code")
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.AuthorizationDetails, 1)
+	assert.Equal(suite.T(), "payment_initiation", result.AuthorizationDetails[0]["type"])
+
+	suite.mockdbProvider.AssertExpectations(suite.T())
+	suite.mockDBClient.AssertExpectations(suite.T())
+}
@@ -25,6 +25,7 @@ import (
 	"slices"
 	"time"
 
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/authorizationdetails"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
 	"github.com/asgardeo/thunder/internal/system/config"
@@ -147,6 +148,7 @@ func (authzRS *authorizationRequestStore) getJSONDataBytes(authRequestCtx authRe
 		jsonKeyClientID:            authRequestCtx.OAuthParameters.ClientID,
 		jsonKeyRedirectURI:         authRequestCtx.OAuthParameters.RedirectURI,
 		jsonKeyResponseType:        authRequestCtx.OAuthParameters.ResponseType,
+		jsonKeyResponseMode:        authRequestCtx.OAuthParameters.ResponseMode,
 		jsonKeyStandardScopes:      authRequestCtx.OAuthParameters.StandardScopes,
 		jsonKeyPermissionScopes:    authRequestCtx.OAuthParameters.PermissionScopes,
 		jsonKeyCodeChallenge:       authRequestCtx.OAuthParameters.CodeChallenge,
@@ -161,6 +163,16 @@ func (authzRS *authorizationRequestStore) getJSONDataBytes(authRequestCtx authRe
 		jsonData[jsonKeyClaimsRequest] = authRequestCtx.OAuthParameters.ClaimsRequest
 	}
 
+	// Add id_token_hint if present, since the authenticated user is checked against it
+	if authRequestCtx.OAuthParameters.IDTokenHint != "" {
+		jsonData[jsonKeyIDTokenHint] = authRequestCtx.OAuthParameters.IDTokenHint
+	}
+
+	// Add authorization_details if present
+	if len(authRequestCtx.OAuthParameters.AuthorizationDetails) > 0 {
+		jsonData[jsonKeyAuthorizationDetails] = authRequestCtx.OAuthParameters.AuthorizationDetails
+	}
+
 	jsonDataBytes, err := json.Marshal(jsonData)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling request context to JSON: %w", err)
@@ -203,6 +215,9 @@ func (authzRS *authorizationRequestStore) buildAuthRequestContextFromResultRow(
 	if responseType, ok := requestDataMap[jsonKeyResponseType].(string); ok {
 		oauthParams.ResponseType = responseType
 	}
+	if responseMode, ok := requestDataMap[jsonKeyResponseMode].(string); ok {
+		oauthParams.ResponseMode = responseMode
+	}
 	// Handle standard_scopes
 	if standardScopes, ok := requestDataMap[jsonKeyStandardScopes].([]interface{}); ok {
 		oauthParams.StandardScopes = convertToStringArray(standardScopes)
@@ -250,6 +265,12 @@ func (authzRS *authorizationRequestStore) buildAuthRequestContextFromResultRow(
 		oauthParams.ClaimsRequest = claimsRequest
 	}
 
+	if idTokenHint, ok := requestDataMap[jsonKeyIDTokenHint].(string); ok {
+		oauthParams.IDTokenHint = idTokenHint
+	}
+
+	oauthParams.AuthorizationDetails = authorizationdetails.FromClaim(requestDataMap[jsonKeyAuthorizationDetails])
+
 	return authRequestContext{
 		OAuthParameters: oauthParams,
 	}, nil
@@ -355,6 +355,31 @@ func (suite *AuthorizationRequestStoreTestSuite) TestGetRequest_RequestDataAsByt
 	suite.mockDBClient.AssertExpectations(suite.T())
 }
 
+func (suite *AuthorizationRequestStoreTestSuite) TestGetRequest_WithIDTokenHint() {
+	requestData := map[string]interface{}{
+		"client_id":     "test-client-id",
+		"redirect_uri":  "https://client.example.com/callback",
+		"id_token_hint": "header.payload.signature",
+	}
+	requestDataJSON, _ := json.Marshal(requestData)
+
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetAuthRequest,
+		"test-request-id", mock.Anything, testDeploymentID).
+		Return([]map[string]interface{}{
+			{
+				"auth_id":      "test-request-id",
+				"request_data": string(requestDataJSON),
+				"expiry_time":  time.Now().Add(10 * time.Minute).Format("2006-01-02 15:04:05.999999999"),
+			},
+		}, nil)
+
+	ok, result, err := suite.store.GetRequest(context.Background(), "test-request-id")
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "header.payload.signature", result.OAuthParameters.IDTokenHint)
+}
+
 func (suite *AuthorizationRequestStoreTestSuite) TestGetRequest_InvalidRequestDataJSON() {
 	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
 
@@ -519,63 +544,6 @@ func (suite *AuthorizationRequestStoreTestSuite) TestClearRequest_ExecuteError()
 	suite.mockDBClient.AssertExpectations(suite.T())
 }
 
-func (suite *AuthorizationRequestStoreTestSuite) TestParseTimeFieldForAuthRequest_StringInput() {
-	testTime := "2023-12-01 10:30:45.123456789"
-	expectedTime, _ := time.Parse("2006-01-02 15:04:05.999999999", testTime)
-
-	result, err := parseTimeField(testTime, "test_field")
-	assert.NoError(suite.T(), err)
-	assert.Equal(suite.T(), expectedTime, result)
-}
-
-func (suite *AuthorizationRequestStoreTestSuite) TestParseTimeFieldForAuthRequest_StringWithExtraContent() {
-	testTime := "2023-12-01 10:30:45.123456789 extra content"
-	expectedTime, _ := time.Parse("2006-01-02 15:04:05.999999999", "2023-12-01 10:30:45.123456789")
-
-	result, err := parseTimeField(testTime, "test_field")
-	assert.NoError(suite.T(), err)
-	assert.Equal(suite.T(), expectedTime, result)
-}
-
-func (suite *AuthorizationRequestStoreTestSuite) TestParseTimeFieldForAuthRequest_TimeInput() {
-	testTime := time.Now()
-
-	result, err := parseTimeField(testTime, "test_field")
-	assert.NoError(suite.T(), err)
-	assert.Equal(suite.T(), testTime, result)
-}
-
-func (suite *AuthorizationRequestStoreTestSuite) TestParseTimeFieldForAuthRequest_InvalidStringFormat() {
-	testTime := "invalid-time-format"
-
-	result, err := parseTimeField(testTime, "test_field")
-	assert.Error(suite.T(), err)
-	assert.Contains(suite.T(), err.Error(), "error parsing test_field")
-	assert.True(suite.T(), result.IsZero())
-}
-
-func (suite *AuthorizationRequestStoreTestSuite) TestParseTimeFieldForAuthRequest_InvalidType() {
-	result, err := parseTimeField(12345, "test_field")
-	assert.Error(suite.T(), err)
-	assert.Contains(suite.T(), err.Error(), "unexpected type for test_field")
-	assert.True(suite.T(), result.IsZero())
-}
-
-func (suite *AuthorizationRequestStoreTestSuite) TestTrimTimeStringForAuthRequest() {
-	input := "2023-12-01 10:30:45.123456789 extra content here"
-	expected := "2023-12-01 10:30:45.123456789"
-
-	result := trimTimeString(input)
-	assert.Equal(suite.T(), expected, result)
-}
-
-func (suite *AuthorizationRequestStoreTestSuite) TestTrimTimeStringForAuthRequest_ShortInput() {
-	input := "2023-12-01"
-
-	result := trimTimeString(input)
-	assert.Equal(suite.T(), input, result)
-}
-
 func (suite *AuthorizationRequestStoreTestSuite) TestConvertToStringArray() {
 	input := []interface{}{"one", "two", "three"}
 	expected := []string{"one", "two", "three"}
@@ -642,26 +610,6 @@ func (suite *AuthorizationRequestStoreTestSuite) TestGetRequest_RequestDataAsUne
 	suite.mockDBClient.AssertExpectations(suite.T())
 }
 
-func (suite *AuthorizationRequestStoreTestSuite) TestParseTimeFieldForAuthRequest_AlternativeFormat() {
-	// Test ISO 8601 format when custom format fails
-	testTime := "2023-12-01T10:30:45Z"
-	expectedTime, _ := time.Parse("2006-01-02T15:04:05Z07:00", testTime)
-
-	result, err := parseTimeField(testTime, "test_field")
-	assert.NoError(suite.T(), err)
-	assert.Equal(suite.T(), expectedTime, result)
-}
-
-func (suite *AuthorizationRequestStoreTestSuite) TestParseTimeFieldForAuthRequest_AlternativeFormatWithTimezone() {
-	// Test ISO 8601 format with timezone
-	testTime := "2023-12-01T10:30:45+05:30"
-	expectedTime, _ := time.Parse("2006-01-02T15:04:05Z07:00", testTime)
-
-	result, err := parseTimeField(testTime, "test_field")
-	assert.NoError(suite.T(), err)
-	assert.Equal(suite.T(), expectedTime, result)
-}
-
 func (suite *AuthorizationRequestStoreTestSuite) TestGetRequest_AllOptionalFieldsMissing() {
 	// Test when optional fields are missing from JSON
 	requestData := map[string]interface{}{
@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+	"github.com/asgardeo/thunder/internal/system/utils"
+)
+
+// redisAuthorizationResponseStore is the Redis-backed implementation of authorizationResponseStoreInterface.
+type redisAuthorizationResponseStore struct {
+	client         authReqRedisClient
+	keyPrefix      string
+	deploymentID   string
+	validityPeriod time.Duration
+}
+
+// newRedisAuthorizationResponseStore creates a new Redis-backed authorization response store.
+func newRedisAuthorizationResponseStore(p provider.RedisProviderInterface) authorizationResponseStoreInterface {
+	return &redisAuthorizationResponseStore{
+		client:         p.GetRedisClient(),
+		keyPrefix:      p.GetKeyPrefix(),
+		deploymentID:   config.GetServerRuntime().Config.Server.Identifier,
+		validityPeriod: 10 * time.Minute,
+	}
+}
+
+// authRespKey builds the Redis key for an authorization response.
+func (s *redisAuthorizationResponseStore) authRespKey(key string) string {
+	return fmt.Sprintf("%s:runtime:%s:authresp:%s", s.keyPrefix, s.deploymentID, key)
+}
+
+// AddResponse adds an authorization response entry to Redis with a TTL.
+func (s *redisAuthorizationResponseStore) AddResponse(ctx context.Context, value FormPostResponse) (string, error) {
+	key, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate UUID: %w", err)
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal authorization response: %w", err)
+	}
+
+	if err := s.client.Set(ctx, s.authRespKey(key), data, s.validityPeriod).Err(); err != nil {
+		return "", fmt.Errorf("failed to store authorization response in Redis: %w", err)
+	}
+
+	return key, nil
+}
+
+// GetResponse retrieves an authorization response entry from Redis.
+func (s *redisAuthorizationResponseStore) GetResponse(
+	ctx context.Context, key string,
+) (bool, FormPostResponse, error) {
+	if key == "" {
+		return false, FormPostResponse{}, nil
+	}
+
+	data, err := s.client.Get(ctx, s.authRespKey(key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false, FormPostResponse{}, nil
+		}
+		return false, FormPostResponse{}, fmt.Errorf("failed to get authorization response from Redis: %w", err)
+	}
+
+	var result FormPostResponse
+	if err := json.Unmarshal(data, &result); err != nil {
+		return false, FormPostResponse{}, fmt.Errorf("failed to unmarshal authorization response: %w", err)
+	}
+
+	return true, result, nil
+}
+
+// ClearResponse removes a specific authorization response entry from Redis.
+func (s *redisAuthorizationResponseStore) ClearResponse(ctx context.Context, key string) error {
+	if key == "" {
+		return nil
+	}
+
+	if err := s.client.Del(ctx, s.authRespKey(key)).Err(); err != nil {
+		return fmt.Errorf("failed to delete authorization response from Redis: %w", err)
+	}
+
+	return nil
+}
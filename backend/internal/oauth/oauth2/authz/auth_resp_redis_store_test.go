@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const redisTestRespKey = "test-resp-key"
+
+type RedisAuthorizationResponseStoreTestSuite struct {
+	suite.Suite
+	store      *redisAuthorizationResponseStore
+	mockClient *authReqRedisClientMock
+	ctx        context.Context
+	authResp   FormPostResponse
+	redisKey   string
+}
+
+func TestRedisAuthorizationResponseStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisAuthorizationResponseStoreTestSuite))
+}
+
+func (suite *RedisAuthorizationResponseStoreTestSuite) SetupTest() {
+	suite.mockClient = newAuthReqRedisClientMock(suite.T())
+	suite.ctx = context.Background()
+	suite.store = &redisAuthorizationResponseStore{
+		client:         suite.mockClient,
+		keyPrefix:      redisTestKeyPrefix,
+		deploymentID:   redisTestDeploymentID,
+		validityPeriod: 10 * time.Minute,
+	}
+	suite.authResp = FormPostResponse{
+		RedirectURI: "https://client.example.com/callback",
+		Params:      map[string]string{"code": "test-code"},
+	}
+	suite.redisKey = fmt.Sprintf("%s:runtime:%s:authresp:%s",
+		redisTestKeyPrefix, redisTestDeploymentID, redisTestRespKey)
+}
+
+func (suite *RedisAuthorizationResponseStoreTestSuite) TestAuthRespKey() {
+	suite.Equal(suite.redisKey, suite.store.authRespKey(redisTestRespKey))
+}
+
+func (suite *RedisAuthorizationResponseStoreTestSuite) TestAddResponse_Success() {
+	statusCmd := redis.NewStatusCmd(suite.ctx)
+	suite.mockClient.On("Set", suite.ctx,
+		mock.MatchedBy(func(k string) bool { return k != "" }),
+		mock.Anything, suite.store.validityPeriod).Return(statusCmd)
+
+	key, err := suite.store.AddResponse(suite.ctx, suite.authResp)
+	suite.NoError(err)
+	suite.NotEmpty(key)
+}
+
+func (suite *RedisAuthorizationResponseStoreTestSuite) TestAddResponse_SetError() {
+	statusCmd := redis.NewStatusCmd(suite.ctx)
+	statusCmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("Set", suite.ctx,
+		mock.MatchedBy(func(k string) bool { return k != "" }),
+		mock.Anything, suite.store.validityPeriod).Return(statusCmd)
+
+	key, err := suite.store.AddResponse(suite.ctx, suite.authResp)
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to store authorization response in Redis")
+	suite.Empty(key)
+}
+
+func (suite *RedisAuthorizationResponseStoreTestSuite) TestGetResponse_Success() {
+	data, _ := json.Marshal(suite.authResp)
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetVal(string(data))
+	suite.mockClient.On("Get", suite.ctx, suite.redisKey).Return(stringCmd)
+
+	found, result, err := suite.store.GetResponse(suite.ctx, redisTestRespKey)
+	suite.NoError(err)
+	suite.True(found)
+	suite.Equal(suite.authResp, result)
+}
+
+func (suite *RedisAuthorizationResponseStoreTestSuite) TestGetResponse_NotFound() {
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetErr(redis.Nil)
+	suite.mockClient.On("Get", suite.ctx, suite.redisKey).Return(stringCmd)
+
+	found, result, err := suite.store.GetResponse(suite.ctx, redisTestRespKey)
+	suite.NoError(err)
+	suite.False(found)
+	suite.Equal(FormPostResponse{}, result)
+}
+
+func (suite *RedisAuthorizationResponseStoreTestSuite) TestGetResponse_GetError() {
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("Get", suite.ctx, suite.redisKey).Return(stringCmd)
+
+	found, _, err := suite.store.GetResponse(suite.ctx, redisTestRespKey)
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to get authorization response from Redis")
+	suite.False(found)
+}
+
+func (suite *RedisAuthorizationResponseStoreTestSuite) TestClearResponse_Success() {
+	intCmd := redis.NewIntCmd(suite.ctx)
+	intCmd.SetVal(1)
+	suite.mockClient.On("Del", suite.ctx, suite.redisKey).Return(intCmd)
+
+	err := suite.store.ClearResponse(suite.ctx, redisTestRespKey)
+	suite.NoError(err)
+}
+
+func (suite *RedisAuthorizationResponseStoreTestSuite) TestClearResponse_DelError() {
+	intCmd := redis.NewIntCmd(suite.ctx)
+	intCmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("Del", suite.ctx, suite.redisKey).Return(intCmd)
+
+	err := suite.store.ClearResponse(suite.ctx, redisTestRespKey)
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to delete authorization response from Redis")
+}
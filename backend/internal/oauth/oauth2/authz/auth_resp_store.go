@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+	"github.com/asgardeo/thunder/internal/system/utils"
+)
+
+// authorizationResponseStoreInterface defines the interface for storing authorization responses pending
+// delivery to the client with the form_post response mode.
+type authorizationResponseStoreInterface interface {
+	AddResponse(ctx context.Context, value FormPostResponse) (string, error)
+	GetResponse(ctx context.Context, key string) (bool, FormPostResponse, error)
+	ClearResponse(ctx context.Context, key string) error
+}
+
+// formPostResponseData is the serialized form of an authorization response in the store.
+type formPostResponseData struct {
+	RedirectURI string            `json:"redirect_uri"`
+	Params      map[string]string `json:"params"`
+}
+
+// authorizationResponseStore provides the authorization response store functionality using database.
+type authorizationResponseStore struct {
+	dbProvider     provider.DBProviderInterface
+	validityPeriod time.Duration
+	deploymentID   string
+}
+
+// newAuthorizationResponseStore creates a new instance of authorizationResponseStore with injected dependencies.
+func newAuthorizationResponseStore() authorizationResponseStoreInterface {
+	return &authorizationResponseStore{
+		dbProvider:     provider.GetDBProvider(),
+		validityPeriod: 10 * time.Minute,
+		deploymentID:   config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// AddResponse adds an authorization response entry to the store.
+func (authzRS *authorizationResponseStore) AddResponse(ctx context.Context, value FormPostResponse) (string, error) {
+	dbClient, err := authzRS.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	key, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate UUID: %w", err)
+	}
+	expiryTime := time.Now().Add(authzRS.validityPeriod)
+
+	jsonDataBytes, err := json.Marshal(formPostResponseData{
+		RedirectURI: value.RedirectURI,
+		Params:      value.Params,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal authorization response to JSON: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryInsertAuthResponse, key, jsonDataBytes, expiryTime,
+		authzRS.deploymentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to insert authorization response: %w", err)
+	}
+
+	return key, nil
+}
+
+// GetResponse retrieves an authorization response entry from the store.
+func (authzRS *authorizationResponseStore) GetResponse(
+	ctx context.Context, key string) (bool, FormPostResponse, error) {
+	if key == "" {
+		return false, FormPostResponse{}, nil
+	}
+
+	dbClient, err := authzRS.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, FormPostResponse{}, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetAuthResponse, key, time.Now(), authzRS.deploymentID)
+	if err != nil {
+		return false, FormPostResponse{}, fmt.Errorf("failed to query authorization response: %w", err)
+	}
+
+	if len(results) == 0 {
+		return false, FormPostResponse{}, nil
+	}
+
+	response, err := buildFormPostResponseFromResultRow(results[0])
+	if err != nil {
+		return false, FormPostResponse{}, fmt.Errorf("failed to build authorization response: %w", err)
+	}
+
+	return true, response, nil
+}
+
+// ClearResponse removes a specific authorization response entry from the store.
+func (authzRS *authorizationResponseStore) ClearResponse(ctx context.Context, key string) error {
+	if key == "" {
+		return nil
+	}
+
+	dbClient, err := authzRS.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryDeleteAuthResponse, key, authzRS.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete authorization response: %w", err)
+	}
+
+	return nil
+}
+
+// buildFormPostResponseFromResultRow builds a FormPostResponse from a database result row.
+func buildFormPostResponseFromResultRow(row map[string]interface{}) (FormPostResponse, error) {
+	var dataJSON []byte
+	if val, ok := row[dbColumnResponseData].(string); ok && val != "" {
+		dataJSON = []byte(val)
+	} else if val, ok := row[dbColumnResponseData].([]byte); ok && len(val) > 0 {
+		dataJSON = val
+	} else {
+		return FormPostResponse{}, fmt.Errorf("%s is missing or of unexpected type", dbColumnResponseData)
+	}
+
+	var responseData formPostResponseData
+	if err := json.Unmarshal(dataJSON, &responseData); err != nil {
+		return FormPostResponse{}, fmt.Errorf("failed to unmarshal %s JSON: %w", dbColumnResponseData, err)
+	}
+
+	return FormPostResponse{
+		RedirectURI: responseData.RedirectURI,
+		Params:      responseData.Params,
+	}, nil
+}
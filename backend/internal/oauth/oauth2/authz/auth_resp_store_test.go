@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/tests/mocks/database/providermock"
+)
+
+type AuthorizationResponseStoreTestSuite struct {
+	suite.Suite
+	mockdbProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *authorizationResponseStore
+	testResponse   FormPostResponse
+}
+
+func TestAuthorizationResponseStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthorizationResponseStoreTestSuite))
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) SetupTest() {
+	suite.mockdbProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+
+	suite.store = &authorizationResponseStore{
+		dbProvider:     suite.mockdbProvider,
+		validityPeriod: 10 * time.Minute,
+		deploymentID:   testDeploymentID,
+	}
+
+	suite.testResponse = FormPostResponse{
+		RedirectURI: "https://client.example.com/callback",
+		Params:      map[string]string{"code": "test-code", "state": "test-state"},
+	}
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) TestAddResponse_Success() {
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertAuthResponse,
+		mock.MatchedBy(func(key string) bool { return len(key) > 0 }),
+		mock.MatchedBy(func(data []byte) bool {
+			var stored formPostResponseData
+			return json.Unmarshal(data, &stored) == nil &&
+				stored.RedirectURI == "https://client.example.com/callback" &&
+				stored.Params["code"] == "test-code"
+		}),
+		mock.Anything, testDeploymentID).
+		Return(int64(1), nil)
+
+	identifier, err := suite.store.AddResponse(context.Background(), suite.testResponse)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), identifier)
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) TestAddResponse_DBClientError() {
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db client error"))
+
+	identifier, err := suite.store.AddResponse(context.Background(), suite.testResponse)
+	assert.Error(suite.T(), err)
+	assert.Empty(suite.T(), identifier)
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) TestAddResponse_ExecuteError() {
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertAuthResponse,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(int64(0), errors.New("execute error"))
+
+	identifier, err := suite.store.AddResponse(context.Background(), suite.testResponse)
+	assert.Error(suite.T(), err)
+	assert.Empty(suite.T(), identifier)
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) TestGetResponse_Success() {
+	responseDataJSON, _ := json.Marshal(map[string]interface{}{
+		"redirect_uri": "https://client.example.com/callback",
+		"params":       map[string]string{"code": "test-code", "state": "test-state"},
+	})
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetAuthResponse,
+		"test-response-id", mock.Anything, testDeploymentID).
+		Return([]map[string]interface{}{
+			{
+				"response_id":   "test-response-id",
+				"response_data": responseDataJSON,
+			},
+		}, nil)
+
+	found, response, err := suite.store.GetResponse(context.Background(), "test-response-id")
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), suite.testResponse, response)
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) TestGetResponse_EmptyKey() {
+	found, response, err := suite.store.GetResponse(context.Background(), "")
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), found)
+	assert.Equal(suite.T(), FormPostResponse{}, response)
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) TestGetResponse_NotFound() {
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetAuthResponse,
+		"test-response-id", mock.Anything, testDeploymentID).
+		Return([]map[string]interface{}{}, nil)
+
+	found, _, err := suite.store.GetResponse(context.Background(), "test-response-id")
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), found)
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) TestGetResponse_QueryError() {
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetAuthResponse,
+		"test-response-id", mock.Anything, testDeploymentID).
+		Return(nil, errors.New("query error"))
+
+	found, _, err := suite.store.GetResponse(context.Background(), "test-response-id")
+	assert.Error(suite.T(), err)
+	assert.False(suite.T(), found)
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) TestGetResponse_InvalidData() {
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetAuthResponse,
+		"test-response-id", mock.Anything, testDeploymentID).
+		Return([]map[string]interface{}{{"response_data": "not valid json{{{"}}, nil)
+
+	found, _, err := suite.store.GetResponse(context.Background(), "test-response-id")
+	assert.Error(suite.T(), err)
+	assert.False(suite.T(), found)
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) TestClearResponse_Success() {
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteAuthResponse,
+		"test-response-id", testDeploymentID).
+		Return(int64(1), nil)
+
+	err := suite.store.ClearResponse(context.Background(), "test-response-id")
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) TestClearResponse_EmptyKey() {
+	err := suite.store.ClearResponse(context.Background(), "")
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) TestClearResponse_ExecuteError() {
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteAuthResponse,
+		"test-response-id", testDeploymentID).
+		Return(int64(0), errors.New("execute error"))
+
+	err := suite.store.ClearResponse(context.Background(), "test-response-id")
+	assert.Error(suite.T(), err)
+}
@@ -21,20 +21,42 @@ package authz
 import (
 	"errors"
 	"fmt"
+	"html/template"
 	"net/http"
 	"net/url"
 
 	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
 	oauth2utils "github.com/asgardeo/thunder/internal/oauth/oauth2/utils"
+	"github.com/asgardeo/thunder/internal/session"
 	"github.com/asgardeo/thunder/internal/system/config"
+	sysconst "github.com/asgardeo/thunder/internal/system/constants"
 	"github.com/asgardeo/thunder/internal/system/log"
 	"github.com/asgardeo/thunder/internal/system/utils"
 )
 
+// formPostTemplate renders an HTML form that posts the authorization response to the client.
+var formPostTemplate = template.Must(template.New("oauthFormPost").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Submit This Form</title>
+</head>
+<body onload="document.forms[0].submit()">
+<form method="post" action="{{.RedirectURI}}">
+{{- range $name, $value := .Params}}
+<input type="hidden" name="{{$name}}" value="{{$value}}">
+{{- end}}
+<noscript><button type="submit">Continue</button></noscript>
+</form>
+</body>
+</html>
+`))
+
 // AuthorizeHandlerInterface defines the interface for handling OAuth2 authorization requests.
 type AuthorizeHandlerInterface interface {
 	HandleAuthorizeGetRequest(w http.ResponseWriter, r *http.Request)
 	HandleAuthCallbackPostRequest(w http.ResponseWriter, r *http.Request)
+	HandleAuthorizeResponseGetRequest(w http.ResponseWriter, r *http.Request)
 }
 
 // authorizeHandler implements the AuthorizeHandlerInterface for handling OAuth2 authorization requests.
@@ -62,15 +84,14 @@ func (ah *authorizeHandler) HandleAuthorizeGetRequest(w http.ResponseWriter, r *
 	result, authErr := ah.authZService.HandleInitialAuthorizationRequest(ctx, oAuthMessage)
 	if authErr != nil {
 		if authErr.SendErrorToClient {
-			queryParams := map[string]string{
-				oauth2const.RequestParamError:            authErr.Code,
-				oauth2const.RequestParamErrorDescription: authErr.Message,
-				oauth2const.RequestParamIss:              config.GetServerRuntime().Config.JWT.Issuer,
-			}
-			if authErr.State != "" {
-				queryParams[oauth2const.RequestParamState] = authErr.State
+			errorParams := getClientErrorParams(authErr)
+			if authErr.ResponseMode == oauth2const.ResponseModeFormPost {
+				ah.writeFormPostResponse(w, &FormPostResponse{
+					RedirectURI: authErr.ClientRedirectURI, Params: errorParams})
+				return
 			}
-			redirectURI, err := oauth2utils.GetURIWithQueryParams(authErr.ClientRedirectURI, queryParams)
+			redirectURI, err := oauth2utils.GetAuthorizationResponseURI(
+				authErr.ClientRedirectURI, authErr.ResponseMode, errorParams)
 			if err != nil {
 				ah.logger.Error("Failed to construct client redirect URI", log.Error(err))
 				ah.redirectToErrorPage(w, r, oauth2const.ErrorServerError, "Failed to process authorization request")
@@ -83,6 +104,16 @@ func (ah *authorizeHandler) HandleAuthorizeGetRequest(w http.ResponseWriter, r *
 		return
 	}
 
+	// The request was authorized from an existing SSO session; respond directly to the client.
+	if result.FormPost != nil {
+		ah.writeFormPostResponse(w, result.FormPost)
+		return
+	}
+	if result.RedirectURI != "" {
+		http.Redirect(w, r, result.RedirectURI, http.StatusFound)
+		return
+	}
+
 	ah.redirectToLoginPage(w, r, result.QueryParams)
 }
 
@@ -100,7 +131,7 @@ func (ah *authorizeHandler) HandleAuthCallbackPostRequest(w http.ResponseWriter,
 		authID := oAuthMessage.AuthID
 		assertion := oAuthMessage.RequestBodyParams[oauth2const.Assertion]
 
-		redirectURI, authErr := ah.authZService.HandleAuthorizationCallback(ctx, authID, assertion)
+		result, authErr := ah.authZService.HandleAuthorizationCallback(ctx, authID, assertion, oAuthMessage.SessionID)
 		if authErr != nil {
 			if authErr.SendErrorToClient {
 				ah.writeAuthZResponseToClientRedirect(w, authErr)
@@ -109,7 +140,10 @@ func (ah *authorizeHandler) HandleAuthCallbackPostRequest(w http.ResponseWriter,
 			ah.writeAuthZResponseToErrorPage(w, authErr.Code, authErr.Message, authErr.State)
 			return
 		}
-		ah.writeAuthZResponse(w, redirectURI)
+		if result.Session != nil {
+			session.SetSessionCookie(w, result.Session)
+		}
+		ah.writeAuthZResponse(w, result.RedirectURI)
 
 	case oauth2const.TypeConsentResponseFromUser:
 		// TODO: Handle the consent response from the user.
@@ -121,6 +155,23 @@ func (ah *authorizeHandler) HandleAuthCallbackPostRequest(w http.ResponseWriter,
 	}
 }
 
+// HandleAuthorizeResponseGetRequest handles the GET request delivering an authorization response to the
+// client with the form_post response mode, once the user agent is sent back from the authentication flow.
+func (ah *authorizeHandler) HandleAuthorizeResponseGetRequest(w http.ResponseWriter, r *http.Request) {
+	authID := r.URL.Query().Get(oauth2const.AuthID)
+	if authID == "" {
+		ah.redirectToErrorPage(w, r, oauth2const.ErrorInvalidRequest, "Invalid authorization response")
+		return
+	}
+
+	formPost, authErr := ah.authZService.GetFormPostResponse(r.Context(), authID)
+	if authErr != nil {
+		ah.redirectToErrorPage(w, r, authErr.Code, authErr.Message)
+		return
+	}
+	ah.writeFormPostResponse(w, formPost)
+}
+
 // getOAuthMessage extracts the OAuth message from the request and response writer.
 func (ah *authorizeHandler) getOAuthMessage(r *http.Request, w http.ResponseWriter) *OAuthMessage {
 	logger := ah.logger
@@ -178,6 +229,7 @@ func (ah *authorizeHandler) getOAuthMessageForGetRequest(r *http.Request) (*OAut
 
 	return &OAuthMessage{
 		RequestType:        oauth2const.TypeInitialAuthorizationRequest,
+		SessionID:          session.GetSessionIDFromRequest(r),
 		RequestQueryParams: queryParams,
 		Resources:          resources,
 	}, nil
@@ -310,16 +362,8 @@ func (ah *authorizeHandler) writeAuthZResponseToErrorPage(w http.ResponseWriter,
 // writeAuthZResponseToClientRedirect writes the authorization error response redirecting to the
 // client's registered redirect URI.
 func (ah *authorizeHandler) writeAuthZResponseToClientRedirect(w http.ResponseWriter, authErr *AuthorizationError) {
-	queryParams := map[string]string{
-		oauth2const.RequestParamError:            authErr.Code,
-		oauth2const.RequestParamErrorDescription: authErr.Message,
-		oauth2const.RequestParamIss:              config.GetServerRuntime().Config.JWT.Issuer,
-	}
-	if authErr.State != "" {
-		queryParams[oauth2const.RequestParamState] = authErr.State
-	}
-
-	redirectURI, err := oauth2utils.GetURIWithQueryParams(authErr.ClientRedirectURI, queryParams)
+	redirectURI, err := oauth2utils.GetAuthorizationResponseURI(
+		authErr.ClientRedirectURI, authErr.ResponseMode, getClientErrorParams(authErr))
 	if err != nil {
 		ah.logger.Error("Failed to construct client redirect URI", log.Error(err))
 		ah.writeAuthZResponseToErrorPage(w, oauth2const.ErrorServerError,
@@ -329,3 +373,15 @@ func (ah *authorizeHandler) writeAuthZResponseToClientRedirect(w http.ResponseWr
 
 	ah.writeAuthZResponse(w, redirectURI)
 }
+
+// writeFormPostResponse writes the page that auto-submits the authorization response to the client's
+// redirect URI with the form_post response mode.
+func (ah *authorizeHandler) writeFormPostResponse(w http.ResponseWriter, formPost *FormPostResponse) {
+	w.Header().Set(sysconst.ContentTypeHeaderName, "text/html; charset=utf-8")
+	w.Header().Set(sysconst.CacheControlHeaderName, sysconst.CacheControlNoCacheComposite)
+	w.Header().Set(sysconst.PragmaHeaderName, sysconst.PragmaNoCache)
+	w.WriteHeader(http.StatusOK)
+	if err := formPostTemplate.Execute(w, formPost); err != nil {
+		ah.logger.Error("Failed to write the form_post authorization response", log.Error(err))
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -32,6 +33,7 @@ import (
 
 	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
 	oauth2model "github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+	"github.com/asgardeo/thunder/internal/session"
 	"github.com/asgardeo/thunder/internal/system/config"
 )
 
@@ -272,6 +274,134 @@ func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_Success()
 	assert.Contains(suite.T(), location, "/login")
 }
 
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_AuthorizedFromSession() {
+	redirectURI := "https://example.com/callback?code=test-code"
+	suite.mockAuthzService.EXPECT().HandleInitialAuthorizationRequest(mock.Anything,
+		mock.MatchedBy(func(msg *OAuthMessage) bool {
+			return msg.SessionID == "test-session-id"
+		})).Return(&AuthorizationInitResult{RedirectURI: redirectURI}, nil)
+
+	req := httptest.NewRequest("GET",
+		"/oauth2/authorize?client_id=test-client&redirect_uri=https://example.com/callback&response_type=code", nil)
+	req.AddCookie(&http.Cookie{Name: "thunder_session", Value: "test-session-id"})
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthorizeGetRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusFound, rr.Code)
+	assert.Equal(suite.T(), redirectURI, rr.Header().Get("Location"))
+}
+
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_AuthorizedFromSessionWithFormPost() {
+	formPost := &FormPostResponse{
+		RedirectURI: "https://example.com/callback",
+		Params:      map[string]string{"code": "test-code", "state": "<test-state>"},
+	}
+	suite.mockAuthzService.EXPECT().HandleInitialAuthorizationRequest(mock.Anything, mock.Anything).
+		Return(&AuthorizationInitResult{FormPost: formPost}, nil)
+
+	req := httptest.NewRequest("GET",
+		"/oauth2/authorize?client_id=test-client&response_type=code&response_mode=form_post", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthorizeGetRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusOK, rr.Code)
+	assert.Equal(suite.T(), "text/html; charset=utf-8", rr.Header().Get("Content-Type"))
+	assert.Contains(suite.T(), rr.Header().Get("Cache-Control"), "no-store")
+	body := rr.Body.String()
+	assert.Contains(suite.T(), body, `<form method="post" action="https://example.com/callback">`)
+	assert.Contains(suite.T(), body, `<input type="hidden" name="code" value="test-code">`)
+	assert.Contains(suite.T(), body, `<input type="hidden" name="state" value="&lt;test-state&gt;">`)
+}
+
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_ServiceErrorRedirectToClientFragment() {
+	authErr := &AuthorizationError{
+		Code:              oauth2const.ErrorInvalidRequest,
+		Message:           "nonce is required for the response type",
+		SendErrorToClient: true,
+		ClientRedirectURI: "https://client.example.com/callback",
+		State:             "test-state",
+		ResponseMode:      oauth2const.ResponseModeFragment,
+	}
+	suite.mockAuthzService.EXPECT().HandleInitialAuthorizationRequest(mock.Anything, mock.Anything).Return(nil, authErr)
+
+	req := httptest.NewRequest("GET", "/oauth2/authorize?client_id=test-client&response_type=id_token", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthorizeGetRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusFound, rr.Code)
+	location := rr.Header().Get("Location")
+	assert.True(suite.T(), strings.HasPrefix(location, "https://client.example.com/callback#"))
+	assert.Contains(suite.T(), location, "error=invalid_request")
+	assert.Contains(suite.T(), location, "state=test-state")
+}
+
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_ServiceErrorFormPostToClient() {
+	authErr := &AuthorizationError{
+		Code:              oauth2const.ErrorInvalidRequest,
+		Message:           "Invalid request",
+		SendErrorToClient: true,
+		ClientRedirectURI: "https://client.example.com/callback",
+		ResponseMode:      oauth2const.ResponseModeFormPost,
+	}
+	suite.mockAuthzService.EXPECT().HandleInitialAuthorizationRequest(mock.Anything, mock.Anything).Return(nil, authErr)
+
+	req := httptest.NewRequest("GET", "/oauth2/authorize?client_id=test-client&response_mode=form_post", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthorizeGetRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusOK, rr.Code)
+	body := rr.Body.String()
+	assert.Contains(suite.T(), body, `action="https://client.example.com/callback"`)
+	assert.Contains(suite.T(), body, `<input type="hidden" name="error" value="invalid_request">`)
+	assert.Contains(suite.T(), body, `<input type="hidden" name="iss" value="https://localhost:8090">`)
+}
+
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeResponseGetRequest_Success() {
+	suite.mockAuthzService.EXPECT().GetFormPostResponse(mock.Anything, testAuthID).Return(&FormPostResponse{
+		RedirectURI: "https://client.example.com/callback",
+		Params:      map[string]string{"code": "test-code"},
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/oauth2/authorize/response?authId="+testAuthID, nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthorizeResponseGetRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusOK, rr.Code)
+	body := rr.Body.String()
+	assert.Contains(suite.T(), body, `action="https://client.example.com/callback"`)
+	assert.Contains(suite.T(), body, `<input type="hidden" name="code" value="test-code">`)
+}
+
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeResponseGetRequest_MissingAuthID() {
+	req := httptest.NewRequest("GET", "/oauth2/authorize/response", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthorizeResponseGetRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusFound, rr.Code)
+	assert.Contains(suite.T(), rr.Header().Get("Location"), "/error")
+}
+
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeResponseGetRequest_ServiceError() {
+	suite.mockAuthzService.EXPECT().GetFormPostResponse(mock.Anything, testAuthID).Return(nil,
+		&AuthorizationError{Code: oauth2const.ErrorInvalidRequest, Message: "Invalid authorization response"})
+
+	req := httptest.NewRequest("GET", "/oauth2/authorize/response?authId="+testAuthID, nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthorizeResponseGetRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusFound, rr.Code)
+	location := rr.Header().Get("Location")
+	assert.Contains(suite.T(), location, "/error")
+	assert.Contains(suite.T(), location, "errorCode=invalid_request")
+}
+
 func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_ServiceErrorRedirectToErrorPage() {
 	authErr := &AuthorizationError{
 		Code:              oauth2const.ErrorInvalidRequest,
@@ -349,8 +479,8 @@ func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_GetOAuthMe
 func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_Success() {
 	redirectURI := "https://client.example.com/callback?code=test-code&state=test-state"
 	suite.mockAuthzService.EXPECT().
-		HandleAuthorizationCallback(mock.Anything, testAuthID, "test-assertion").
-		Return(redirectURI, nil)
+		HandleAuthorizationCallback(mock.Anything, testAuthID, "test-assertion", "").
+		Return(&AuthorizationCallbackResult{RedirectURI: redirectURI}, nil)
 
 	postData := AuthZPostRequest{
 		AuthID:    testAuthID,
@@ -371,6 +501,37 @@ func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_Succes
 	assert.Equal(suite.T(), redirectURI, resp.RedirectURI)
 }
 
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_SetsSessionCookie() {
+	redirectURI := "https://client.example.com/callback?code=test-code"
+	sess := &session.Session{
+		ID:         "test-session-id",
+		UserID:     "test-user",
+		ExpiryTime: time.Now().Add(time.Hour),
+	}
+	suite.mockAuthzService.EXPECT().
+		HandleAuthorizationCallback(mock.Anything, testAuthID, "test-assertion", "").
+		Return(&AuthorizationCallbackResult{RedirectURI: redirectURI, Session: sess}, nil)
+
+	postData := AuthZPostRequest{
+		AuthID:    testAuthID,
+		Assertion: "test-assertion",
+	}
+	jsonData, _ := json.Marshal(postData)
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/auth/callback", bytes.NewReader(jsonData))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthCallbackPostRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusOK, rr.Code)
+	cookies := rr.Result().Cookies()
+	assert.Len(suite.T(), cookies, 1)
+	assert.Equal(suite.T(), "thunder_session", cookies[0].Name)
+	assert.Equal(suite.T(), "test-session-id", cookies[0].Value)
+	assert.True(suite.T(), cookies[0].HttpOnly)
+}
+
 func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_ServiceError() {
 	authErr := &AuthorizationError{
 		Code:    oauth2const.ErrorInvalidRequest,
@@ -378,8 +539,8 @@ func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_Servic
 		State:   "test-state",
 	}
 	suite.mockAuthzService.EXPECT().
-		HandleAuthorizationCallback(mock.Anything, testAuthID, "test-assertion").
-		Return("", authErr)
+		HandleAuthorizationCallback(mock.Anything, testAuthID, "test-assertion", "").
+		Return(nil, authErr)
 
 	postData := AuthZPostRequest{
 		AuthID:    testAuthID,
@@ -409,8 +570,8 @@ func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_Servic
 		SendErrorToClient: true,
 		ClientRedirectURI: "https://client.example.com/callback",
 	}
-	suite.mockAuthzService.EXPECT().HandleAuthorizationCallback(mock.Anything, testAuthID, "test-assertion").
-		Return("", authErr)
+	suite.mockAuthzService.EXPECT().HandleAuthorizationCallback(mock.Anything, testAuthID, "test-assertion", "").
+		Return(nil, authErr)
 
 	postData := AuthZPostRequest{
 		AuthID:    testAuthID,
@@ -442,8 +603,8 @@ func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_Client
 		SendErrorToClient: true,
 		ClientRedirectURI: "https://client.example.com/callback",
 	}
-	suite.mockAuthzService.EXPECT().HandleAuthorizationCallback(mock.Anything, testAuthID, "test-assertion").
-		Return("", authErr)
+	suite.mockAuthzService.EXPECT().HandleAuthorizationCallback(mock.Anything, testAuthID, "test-assertion", "").
+		Return(nil, authErr)
 
 	postData := AuthZPostRequest{
 		AuthID:    testAuthID,
@@ -22,10 +22,15 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/asgardeo/thunder/internal/attributecache"
 	"github.com/asgardeo/thunder/internal/flow/flowexec"
 	"github.com/asgardeo/thunder/internal/inboundclient"
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/par"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/requestobject"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
 	"github.com/asgardeo/thunder/internal/resource"
+	"github.com/asgardeo/thunder/internal/session"
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/constants"
 	"github.com/asgardeo/thunder/internal/system/database/provider"
@@ -40,39 +45,48 @@ func Initialize(
 	inboundClient inboundclient.InboundClientServiceInterface,
 	resourceService resource.ResourceServiceInterface,
 	jwtService jwt.JWTServiceInterface,
+	tokenBuilder tokenservice.TokenBuilderInterface,
+	tokenValidator tokenservice.TokenValidatorInterface,
+	attributeCache attributecache.AttributeCacheServiceInterface,
 	flowExecService flowexec.FlowExecServiceInterface,
 	parService par.PARServiceInterface,
+	requestObjectService requestobject.RequestObjectServiceInterface,
+	sessionService session.SessionServiceInterface,
 ) (AuthorizeServiceInterface, error) {
-	authzCodeStore, authzReqStore, transactioner, err := initializeAuthorizationStores()
+	authzCodeStore, authzReqStore, authzRespStore, transactioner, err := initializeAuthorizationStores()
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize authorization stores: %w", err)
 	}
 
 	authzService := newAuthorizeService(
-		inboundClient, resourceService, jwtService, flowExecService,
-		authzCodeStore, authzReqStore, parService, transactioner,
+		inboundClient, resourceService, jwtService, tokenBuilder, tokenValidator, attributeCache, flowExecService,
+		authzCodeStore, authzReqStore, authzRespStore, parService, requestObjectService, sessionService, transactioner,
 	)
 	authzHandler := newAuthorizeHandler(authzService)
 	registerRoutes(mux, authzHandler)
 	return authzService, nil
 }
 
-// initializeAuthorizationStores creates the authorization code store, request store, and transactioner.
+// initializeAuthorizationStores creates the authorization code store, request store, response store, and
+// transactioner.
 func initializeAuthorizationStores() (
-	AuthorizationCodeStoreInterface, authorizationRequestStoreInterface, transaction.Transactioner, error) {
+	AuthorizationCodeStoreInterface, authorizationRequestStoreInterface, authorizationResponseStoreInterface,
+	transaction.Transactioner, error) {
 	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
 		redisProvider := provider.GetRedisProvider()
 		return newRedisAuthorizationCodeStore(redisProvider),
 			newRedisAuthorizationRequestStore(redisProvider),
+			newRedisAuthorizationResponseStore(redisProvider),
 			transaction.NewNoOpTransactioner(),
 			nil
 	}
 	dbProvider := provider.GetDBProvider()
 	transactioner, err := dbProvider.GetRuntimeDBTransactioner()
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
 	}
-	return newAuthorizationCodeStore(), newAuthorizationRequestStore(), transactioner, nil
+	return newAuthorizationCodeStore(), newAuthorizationRequestStore(), newAuthorizationResponseStore(),
+		transactioner, nil
 }
 
 // registerRoutes registers the routes for OAuth2 authorization operations.
@@ -81,6 +95,8 @@ func registerRoutes(mux *http.ServeMux, authzHandler AuthorizeHandlerInterface)
 	// The client redirects the user agent to it; it is not accessed directly via XHR/fetch.
 	mux.HandleFunc("GET /oauth2/authorize",
 		withFrameProtection(authzHandler.HandleAuthorizeGetRequest))
+	mux.HandleFunc("GET "+oauth2const.OAuth2AuthorizationResponseEndpoint,
+		withFrameProtection(authzHandler.HandleAuthorizeResponseGetRequest))
 
 	callbackOpts := middleware.CORSOptions{
 		AllowedMethods:   []string{"POST"},
@@ -92,7 +92,7 @@ func (suite *InitTestSuite) TestInitialize() {
 
 	service, err := Initialize(
 		mux, suite.mockInboundClient, suite.mockResourceService,
-		suite.mockJWTService, suite.mockFlowExecService, nil,
+		suite.mockJWTService, nil, nil, nil, suite.mockFlowExecService, nil, nil, nil,
 	)
 
 	assert.NoError(suite.T(), err)
@@ -105,7 +105,7 @@ func (suite *InitTestSuite) TestInitialize_RegistersRoutes() {
 
 	_, err := Initialize(
 		mux, suite.mockInboundClient, suite.mockResourceService,
-		suite.mockJWTService, suite.mockFlowExecService, nil,
+		suite.mockJWTService, nil, nil, nil, suite.mockFlowExecService, nil, nil, nil,
 	)
 	assert.NoError(suite.T(), err)
 
@@ -114,6 +114,9 @@ func (suite *InitTestSuite) TestInitialize_RegistersRoutes() {
 	_, pattern := mux.Handler(&http.Request{Method: "GET", URL: &url.URL{Path: "/oauth2/authorize"}})
 	assert.Contains(suite.T(), pattern, "/oauth2/authorize")
 
+	_, pattern = mux.Handler(&http.Request{Method: "GET", URL: &url.URL{Path: "/oauth2/authorize/response"}})
+	assert.Contains(suite.T(), pattern, "/oauth2/authorize/response")
+
 	_, pattern = mux.Handler(&http.Request{Method: "POST", URL: &url.URL{Path: "/oauth2/auth/callback"}})
 	assert.Contains(suite.T(), pattern, "/oauth2/auth/callback")
 
@@ -126,7 +129,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_CORSConfiguration() {
 
 	_, err := Initialize(
 		mux, suite.mockInboundClient, suite.mockResourceService,
-		suite.mockJWTService, suite.mockFlowExecService, nil,
+		suite.mockJWTService, nil, nil, nil, suite.mockFlowExecService, nil, nil, nil,
 	)
 	assert.NoError(suite.T(), err)
 
@@ -176,7 +179,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_CORSHeaders() {
 
 	_, err := Initialize(
 		mux, suite.mockInboundClient, suite.mockResourceService,
-		suite.mockJWTService, suite.mockFlowExecService, nil,
+		suite.mockJWTService, nil, nil, nil, suite.mockFlowExecService, nil, nil, nil,
 	)
 	assert.NoError(suite.T(), err)
 
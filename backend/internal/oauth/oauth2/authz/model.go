@@ -21,13 +21,16 @@ package authz
 import (
 	"time"
 
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
 	oauth2model "github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+	"github.com/asgardeo/thunder/internal/session"
 )
 
 // OAuthMessage represents the OAuth message.
 type OAuthMessage struct {
 	RequestType        string
 	AuthID             string
+	SessionID          string
 	RequestQueryParams map[string]string
 	Resources          []string
 	RequestBodyParams  map[string]string
@@ -35,23 +38,26 @@ type OAuthMessage struct {
 
 // AuthorizationCode represents the authorization code.
 type AuthorizationCode struct {
-	CodeID              string
-	Code                string
-	ClientID            string
-	RedirectURI         string
-	AuthorizedUserID    string
-	AttributeCacheID    string
-	TimeCreated         time.Time
-	ExpiryTime          time.Time
-	Scopes              string
-	State               string
-	CodeChallenge       string
-	CodeChallengeMethod string
-	Resources           []string
-	ClaimsRequest       *oauth2model.ClaimsRequest
-	ClaimsLocales       string
-	Nonce               string
-	CompletedACR        string
+	CodeID               string
+	Code                 string
+	ClientID             string
+	RedirectURI          string
+	AuthorizedUserID     string
+	AttributeCacheID     string
+	TimeCreated          time.Time
+	ExpiryTime           time.Time
+	Scopes               string
+	State                string
+	CodeChallenge        string
+	CodeChallengeMethod  string
+	Resources            []string
+	ClaimsRequest        *oauth2model.ClaimsRequest
+	ClaimsLocales        string
+	Nonce                string
+	CompletedACR         string
+	AuthorizationDetails []oauth2model.AuthorizationDetail
+	// SessionSID is the sid of the SSO session the code was issued within, carried by the ID token.
+	SessionSID string
 }
 
 // AuthZPostRequest represents the request body for the authorization POST request.
@@ -66,8 +72,24 @@ type AuthZPostResponse struct {
 }
 
 // AuthorizationInitResult holds the result of a successful initial authorization request processing.
+// RedirectURI or FormPost is set when the request was authorized from an existing SSO session; otherwise
+// QueryParams holds the parameters for the login page redirect.
 type AuthorizationInitResult struct {
 	QueryParams map[string]string
+	RedirectURI string
+	FormPost    *FormPostResponse // set instead of RedirectURI when the form_post response mode is used
+}
+
+// FormPostResponse holds an authorization response returned to the client with the form_post response mode.
+type FormPostResponse struct {
+	RedirectURI string
+	Params      map[string]string
+}
+
+// AuthorizationCallbackResult holds the result of a successfully processed authorization callback.
+type AuthorizationCallbackResult struct {
+	RedirectURI string
+	Session     *session.Session // SSO session established for the user; nil if it could not be created
 }
 
 // AuthorizationError holds structured error info for authorization failures.
@@ -77,6 +99,8 @@ type AuthorizationError struct {
 	SendErrorToClient bool   // if true, redirect error to client's redirect_uri rather than the error page
 	ClientRedirectURI string // populated when SendErrorToClient is true
 	State             string // from the original request
+	// ResponseMode is the response mode used to return the error to the client; the query is used if empty.
+	ResponseMode oauth2const.ResponseMode
 }
 
 // assertionClaims represents the claims extracted from the flow assertion JWT.
@@ -85,4 +109,6 @@ type assertionClaims struct {
 	authorizedPermissions string
 	attributeCacheID      string
 	completedACR          string
+	// authorizedAuthorizationDetails holds the authorization details approved by the user, if any.
+	authorizedAuthorizationDetails []oauth2model.AuthorizationDetail
 }
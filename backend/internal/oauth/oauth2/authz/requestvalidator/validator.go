@@ -22,6 +22,7 @@ package requestvalidator
 
 import (
 	"slices"
+	"strconv"
 	"strings"
 
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
@@ -32,7 +33,7 @@ import (
 // ValidateAuthorizationRequestParams validates the common authorization request parameters
 // shared by both the standard authorize endpoint and the PAR endpoint.
 //
-// This validates: prompt, grant_type, response_type, PKCE, and nonce.
+// This validates: prompt, grant_type, response_type, response_mode, PKCE, nonce, and max_age.
 // Callers are responsible for validating client_id and redirect_uri before calling this
 // function, since those validations have endpoint-specific error handling semantics
 // (e.g., the authorize endpoint must not redirect errors when the redirect_uri is invalid).
@@ -53,22 +54,48 @@ func ValidateAuthorizationRequestParams(
 		}
 	}
 
-	// Validate grant type is allowed.
-	if !oauthApp.IsAllowedGrantType(constants.GrantTypeAuthorizationCode) {
-		return constants.ErrorUnauthorizedClient,
-			"Authorization code grant type is not allowed for the client"
-	}
-
 	// Validate response type.
 	if responseType == "" {
 		return constants.ErrorInvalidRequest, "Missing response_type parameter"
 	}
-	if !oauthApp.IsAllowedResponseType(responseType) {
+	normalizedResponseType := constants.NormalizeResponseType(responseType)
+	issuesCode := normalizedResponseType.Includes(constants.ResponseTypeCode)
+
+	// Validate grant type is allowed for response types issuing an authorization code.
+	if issuesCode && !oauthApp.IsAllowedGrantType(constants.GrantTypeAuthorizationCode) {
+		return constants.ErrorUnauthorizedClient,
+			"Authorization code grant type is not allowed for the client"
+	}
+
+	if !normalizedResponseType.IsValid() || !oauthApp.IsAllowedResponseType(responseType) {
 		return constants.ErrorUnsupportedResponseType, "Unsupported response type"
 	}
 
+	// Validate the response mode. Tokens issued by the authorization endpoint must not be returned in the
+	// query (OAuth 2.0 Multiple Response Types §2.1).
+	if responseMode := params[constants.RequestParamResponseMode]; responseMode != "" {
+		if !constants.ResponseMode(responseMode).IsValid() {
+			return constants.ErrorInvalidRequest, "Invalid response_mode parameter"
+		}
+		if constants.ResponseMode(responseMode) == constants.ResponseModeQuery &&
+			constants.GetDefaultResponseMode(normalizedResponseType) != constants.ResponseModeQuery {
+			return constants.ErrorInvalidRequest, "The query response mode is not allowed for the response type"
+		}
+	}
+
+	// Response types issuing an ID token from the authorization endpoint are only defined for OpenID Connect
+	// requests, which must carry a nonce to mitigate replay attacks (OIDC Core §3.2.2.1 and §3.3.2.11).
+	if normalizedResponseType.Includes(constants.ResponseTypeIDToken) {
+		if !slices.Contains(strings.Fields(params[constants.RequestParamScope]), constants.ScopeOpenID) {
+			return constants.ErrorInvalidRequest, "The openid scope is required for the response type"
+		}
+		if params[constants.RequestParamNonce] == "" {
+			return constants.ErrorInvalidRequest, "nonce is required for the response type"
+		}
+	}
+
 	// Validate PKCE parameters.
-	if responseType == string(constants.ResponseTypeCode) {
+	if issuesCode {
 		codeChallenge := params[constants.RequestParamCodeChallenge]
 		codeChallengeMethod := params[constants.RequestParamCodeChallengeMethod]
 
@@ -90,9 +117,52 @@ func ValidateAuthorizationRequestParams(
 		return constants.ErrorInvalidRequest, "nonce exceeds maximum allowed length"
 	}
 
+	// Validate max_age, which must be a non-negative number of seconds.
+	if maxAge := params[constants.RequestParamMaxAge]; maxAge != "" && ParseMaxAge(maxAge) == nil {
+		return constants.ErrorInvalidRequest, "Invalid max_age parameter"
+	}
+
 	return "", ""
 }
 
+// ParseMaxAge parses the max_age parameter (OIDC Core §3.1.2.1). Returns nil when the value is empty or
+// is not a non-negative integer.
+func ParseMaxAge(maxAge string) *int64 {
+	if maxAge == "" {
+		return nil
+	}
+	value, err := strconv.ParseInt(maxAge, 10, 64)
+	if err != nil || value < 0 {
+		return nil
+	}
+	return &value
+}
+
+// ApplyRequestObjectParams resolves the parameters of a JWT-secured authorization request (RFC 9101 §5).
+// The request object is authoritative: parameters sent outside it are ignored, except client_id, which
+// identifies the client and must match the client_id of the request object when both are present.
+//
+// Returns (params, errorCode, errorDescription). Empty errorCode means the parameters are consistent.
+func ApplyRequestObjectParams(
+	queryParams map[string]string, requestObjectParams map[string]string,
+) (map[string]string, string, string) {
+	clientID := queryParams[constants.RequestParamClientID]
+	requestObjectClientID := requestObjectParams[constants.RequestParamClientID]
+	if clientID != "" && requestObjectClientID != "" && clientID != requestObjectClientID {
+		return nil, constants.ErrorInvalidRequestObject,
+			"The client_id of the request object does not match the client_id parameter"
+	}
+
+	params := make(map[string]string, len(requestObjectParams)+1)
+	for key, value := range requestObjectParams {
+		params[key] = value
+	}
+	if clientID != "" {
+		params[constants.RequestParamClientID] = clientID
+	}
+	return params, "", ""
+}
+
 // ValidatePromptParameter validates the OIDC prompt parameter per OIDC Core §3.1.2.1.
 // Returns (errorCode, errorDescription). Empty errorCode means validation passed.
 func ValidatePromptParameter(prompt string) (string, string) {
@@ -114,10 +184,6 @@ func ValidatePromptParameter(prompt string) (string, string) {
 			return constants.ErrorInvalidRequest,
 				"prompt value 'none' must not be combined with other values"
 		}
-
-		// The server does not support server-side sessions as of now.
-		return constants.ErrorLoginRequired,
-			"User authentication is required"
 	}
 
 	// The server does not support consent or account selection prompts as of now.
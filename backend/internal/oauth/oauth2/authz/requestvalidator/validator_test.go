@@ -172,6 +172,148 @@ func (suite *AuthzValidationTestSuite) TestValidateParams_ValidNonce() {
 	assert.Empty(suite.T(), errMsg)
 }
 
+func (suite *AuthzValidationTestSuite) TestValidateParams_ValidMaxAge() {
+	params := suite.validParams()
+	params[constants.RequestParamMaxAge] = "0"
+
+	errCode, errMsg := ValidateAuthorizationRequestParams(params, suite.oauthApp)
+
+	assert.Empty(suite.T(), errCode)
+	assert.Empty(suite.T(), errMsg)
+}
+
+func (suite *AuthzValidationTestSuite) TestValidateParams_InvalidMaxAge() {
+	for _, maxAge := range []string{"-1", "abc", "1.5"} {
+		params := suite.validParams()
+		params[constants.RequestParamMaxAge] = maxAge
+
+		errCode, errMsg := ValidateAuthorizationRequestParams(params, suite.oauthApp)
+
+		assert.Equal(suite.T(), constants.ErrorInvalidRequest, errCode, maxAge)
+		assert.Equal(suite.T(), "Invalid max_age parameter", errMsg)
+	}
+}
+
+func (suite *AuthzValidationTestSuite) TestParseMaxAge() {
+	maxAge := ParseMaxAge("3600")
+	assert.NotNil(suite.T(), maxAge)
+	assert.Equal(suite.T(), int64(3600), *maxAge)
+	assert.Nil(suite.T(), ParseMaxAge(""))
+	assert.Nil(suite.T(), ParseMaxAge("-5"))
+}
+
+func (suite *AuthzValidationTestSuite) hybridApp() *inboundmodel.OAuthClient {
+	return &inboundmodel.OAuthClient{
+		ClientID:     "test-client-id",
+		RedirectURIs: []string{"https://client.example.com/callback"},
+		GrantTypes:   []constants.GrantType{constants.GrantTypeAuthorizationCode},
+		ResponseTypes: []constants.ResponseType{
+			constants.ResponseTypeCode, constants.ResponseTypeIDToken,
+			constants.ResponseTypeCodeIDToken, constants.ResponseTypeCodeIDTokenToken,
+		},
+		TokenEndpointAuthMethod: constants.TokenEndpointAuthMethodClientSecretPost,
+	}
+}
+
+func (suite *AuthzValidationTestSuite) TestValidateParams_ImplicitAndHybridResponseTypes() {
+	for _, responseType := range []string{"id_token", "code id_token", "id_token code", "token code id_token"} {
+		params := map[string]string{
+			constants.RequestParamResponseType: responseType,
+			constants.RequestParamScope:        "openid profile",
+			constants.RequestParamNonce:        "n-0S6_WzA2Mj",
+		}
+
+		errCode, errMsg := ValidateAuthorizationRequestParams(params, suite.hybridApp())
+
+		assert.Empty(suite.T(), errCode, responseType)
+		assert.Empty(suite.T(), errMsg, responseType)
+	}
+}
+
+func (suite *AuthzValidationTestSuite) TestValidateParams_IDTokenResponseTypeNotAllowedForClient() {
+	params := map[string]string{
+		constants.RequestParamResponseType: "code id_token",
+		constants.RequestParamScope:        "openid",
+		constants.RequestParamNonce:        "nonce",
+	}
+
+	errCode, _ := ValidateAuthorizationRequestParams(params, suite.oauthApp)
+
+	assert.Equal(suite.T(), constants.ErrorUnsupportedResponseType, errCode)
+}
+
+func (suite *AuthzValidationTestSuite) TestValidateParams_IDTokenWithoutAuthorizationCodeGrant() {
+	app := suite.hybridApp()
+	app.GrantTypes = nil
+	params := map[string]string{
+		constants.RequestParamResponseType: "id_token",
+		constants.RequestParamScope:        "openid",
+		constants.RequestParamNonce:        "nonce",
+	}
+
+	errCode, _ := ValidateAuthorizationRequestParams(params, app)
+	assert.Empty(suite.T(), errCode)
+
+	params[constants.RequestParamResponseType] = "code id_token"
+	errCode, _ = ValidateAuthorizationRequestParams(params, app)
+	assert.Equal(suite.T(), constants.ErrorUnauthorizedClient, errCode)
+}
+
+func (suite *AuthzValidationTestSuite) TestValidateParams_IDTokenResponseTypeRequiresNonce() {
+	params := map[string]string{
+		constants.RequestParamResponseType: "code id_token",
+		constants.RequestParamScope:        "openid",
+	}
+
+	errCode, errMsg := ValidateAuthorizationRequestParams(params, suite.hybridApp())
+
+	assert.Equal(suite.T(), constants.ErrorInvalidRequest, errCode)
+	assert.Equal(suite.T(), "nonce is required for the response type", errMsg)
+}
+
+func (suite *AuthzValidationTestSuite) TestValidateParams_IDTokenResponseTypeRequiresOpenIDScope() {
+	params := map[string]string{
+		constants.RequestParamResponseType: "id_token",
+		constants.RequestParamScope:        "profile",
+		constants.RequestParamNonce:        "nonce",
+	}
+
+	errCode, errMsg := ValidateAuthorizationRequestParams(params, suite.hybridApp())
+
+	assert.Equal(suite.T(), constants.ErrorInvalidRequest, errCode)
+	assert.Equal(suite.T(), "The openid scope is required for the response type", errMsg)
+}
+
+func (suite *AuthzValidationTestSuite) TestValidateParams_ResponseMode() {
+	testCases := []struct {
+		responseType string
+		responseMode string
+		expectedCode string
+	}{
+		{"code", "query", ""},
+		{"code", "fragment", ""},
+		{"code", "form_post", ""},
+		{"code id_token", "fragment", ""},
+		{"code id_token", "form_post", ""},
+		{"code id_token", "query", constants.ErrorInvalidRequest},
+		{"id_token", "query", constants.ErrorInvalidRequest},
+		{"code", "web_message", constants.ErrorInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		params := map[string]string{
+			constants.RequestParamResponseType: tc.responseType,
+			constants.RequestParamResponseMode: tc.responseMode,
+			constants.RequestParamScope:        "openid",
+			constants.RequestParamNonce:        "nonce",
+		}
+
+		errCode, _ := ValidateAuthorizationRequestParams(params, suite.hybridApp())
+
+		assert.Equal(suite.T(), tc.expectedCode, errCode, tc.responseType+" "+tc.responseMode)
+	}
+}
+
 func (suite *AuthzValidationTestSuite) TestValidateParams_PromptLogin_Success() {
 	params := suite.validParams()
 	params[constants.RequestParamPrompt] = "login"
@@ -182,13 +324,14 @@ func (suite *AuthzValidationTestSuite) TestValidateParams_PromptLogin_Success()
 	assert.Empty(suite.T(), errMsg)
 }
 
-func (suite *AuthzValidationTestSuite) TestValidateParams_PromptNone_LoginRequired() {
+func (suite *AuthzValidationTestSuite) TestValidateParams_PromptNone_Success() {
 	params := suite.validParams()
 	params[constants.RequestParamPrompt] = "none"
 
-	errCode, _ := ValidateAuthorizationRequestParams(params, suite.oauthApp)
+	errCode, errMsg := ValidateAuthorizationRequestParams(params, suite.oauthApp)
 
-	assert.Equal(suite.T(), constants.ErrorLoginRequired, errCode)
+	assert.Empty(suite.T(), errCode)
+	assert.Empty(suite.T(), errMsg)
 }
 
 func (suite *AuthzValidationTestSuite) TestValidateParams_PromptInvalid() {
@@ -255,9 +398,9 @@ func (suite *AuthzValidationTestSuite) TestValidatePromptParameter_Login() {
 	assert.Empty(suite.T(), errCode)
 }
 
-func (suite *AuthzValidationTestSuite) TestValidatePromptParameter_None_LoginRequired() {
+func (suite *AuthzValidationTestSuite) TestValidatePromptParameter_None() {
 	errCode, _ := ValidatePromptParameter("none")
-	assert.Equal(suite.T(), constants.ErrorLoginRequired, errCode)
+	assert.Empty(suite.T(), errCode)
 }
 
 func (suite *AuthzValidationTestSuite) TestValidatePromptParameter_Consent() {
@@ -291,6 +434,48 @@ func (suite *AuthzValidationTestSuite) TestValidatePromptParameter_LoginConsent(
 	assert.Equal(suite.T(), constants.ErrorConsentRequired, errCode)
 }
 
+func (suite *AuthzValidationTestSuite) TestApplyRequestObjectParams_RequestObjectTakesPrecedence() {
+	queryParams := map[string]string{
+		constants.RequestParamClientID:     "test-client",
+		constants.RequestParamResponseType: "code",
+		constants.RequestParamScope:        "openid profile",
+		constants.RequestParamState:        "query-state",
+	}
+	requestObjectParams := map[string]string{
+		constants.RequestParamClientID:     "test-client",
+		constants.RequestParamResponseType: "code",
+		constants.RequestParamScope:        "openid",
+		constants.RequestParamRedirectURI:  "https://client.example.com/callback",
+	}
+
+	params, errCode, _ := ApplyRequestObjectParams(queryParams, requestObjectParams)
+	assert.Empty(suite.T(), errCode)
+	assert.Equal(suite.T(), "openid", params[constants.RequestParamScope])
+	assert.Equal(suite.T(), "https://client.example.com/callback", params[constants.RequestParamRedirectURI])
+	// Parameters sent only outside the request object are ignored.
+	_, hasState := params[constants.RequestParamState]
+	assert.False(suite.T(), hasState)
+}
+
+func (suite *AuthzValidationTestSuite) TestApplyRequestObjectParams_KeepsQueryClientID() {
+	queryParams := map[string]string{constants.RequestParamClientID: "test-client"}
+	requestObjectParams := map[string]string{constants.RequestParamResponseType: "code"}
+
+	params, errCode, _ := ApplyRequestObjectParams(queryParams, requestObjectParams)
+	assert.Empty(suite.T(), errCode)
+	assert.Equal(suite.T(), "test-client", params[constants.RequestParamClientID])
+	assert.Equal(suite.T(), "code", params[constants.RequestParamResponseType])
+}
+
+func (suite *AuthzValidationTestSuite) TestApplyRequestObjectParams_ClientIDMismatch() {
+	queryParams := map[string]string{constants.RequestParamClientID: "test-client"}
+	requestObjectParams := map[string]string{constants.RequestParamClientID: "other-client"}
+
+	params, errCode, _ := ApplyRequestObjectParams(queryParams, requestObjectParams)
+	assert.Nil(suite.T(), params)
+	assert.Equal(suite.T(), constants.ErrorInvalidRequestObject, errCode)
+}
+
 type ACRValuesTestSuite struct {
 	suite.Suite
 }
@@ -25,21 +25,27 @@ import (
 	"fmt"
 	"maps"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/asgardeo/thunder/internal/attributecache"
 	flowcm "github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/flowassertion"
 	"github.com/asgardeo/thunder/internal/flow/flowexec"
 	"github.com/asgardeo/thunder/internal/inboundclient"
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/authorizationdetails"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/authz/requestvalidator"
 	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
 	oauth2model "github.com/asgardeo/thunder/internal/oauth/oauth2/model"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/par"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/requestobject"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/resourceindicators"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
 	oauth2utils "github.com/asgardeo/thunder/internal/oauth/oauth2/utils"
 	"github.com/asgardeo/thunder/internal/resource"
+	"github.com/asgardeo/thunder/internal/session"
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/jose/jwt"
 	"github.com/asgardeo/thunder/internal/system/log"
@@ -53,21 +59,30 @@ type AuthorizeServiceInterface interface {
 	HandleInitialAuthorizationRequest(
 		ctx context.Context, msg *OAuthMessage,
 	) (*AuthorizationInitResult, *AuthorizationError)
-	HandleAuthorizationCallback(ctx context.Context, authID string, assertion string) (string, *AuthorizationError)
+	HandleAuthorizationCallback(
+		ctx context.Context, authID string, assertion string, sessionID string,
+	) (*AuthorizationCallbackResult, *AuthorizationError)
+	GetFormPostResponse(ctx context.Context, authID string) (*FormPostResponse, *AuthorizationError)
 }
 
 // authorizeService implements the AuthorizeService for managing OAuth2 authorization flows.
 type authorizeService struct {
-	inboundClient   inboundclient.InboundClientServiceInterface
-	resourceService resource.ResourceServiceInterface
-	authZValidator  AuthorizationValidatorInterface
-	authCodeStore   AuthorizationCodeStoreInterface
-	authReqStore    authorizationRequestStoreInterface
-	parService      par.PARServiceInterface
-	jwtService      jwt.JWTServiceInterface
-	flowExecService flowexec.FlowExecServiceInterface
-	transactioner   transaction.Transactioner
-	logger          *log.Logger
+	inboundClient        inboundclient.InboundClientServiceInterface
+	resourceService      resource.ResourceServiceInterface
+	authZValidator       AuthorizationValidatorInterface
+	authCodeStore        AuthorizationCodeStoreInterface
+	authReqStore         authorizationRequestStoreInterface
+	authRespStore        authorizationResponseStoreInterface
+	parService           par.PARServiceInterface
+	requestObjectService requestobject.RequestObjectServiceInterface
+	sessionService       session.SessionServiceInterface
+	jwtService           jwt.JWTServiceInterface
+	tokenBuilder         tokenservice.TokenBuilderInterface
+	tokenValidator       tokenservice.TokenValidatorInterface
+	attributeCache       attributecache.AttributeCacheServiceInterface
+	flowExecService      flowexec.FlowExecServiceInterface
+	transactioner        transaction.Transactioner
+	logger               *log.Logger
 }
 
 // newAuthorizeService creates a new instance of authorizeService with injected dependencies.
@@ -75,23 +90,35 @@ func newAuthorizeService(
 	inboundClient inboundclient.InboundClientServiceInterface,
 	resourceService resource.ResourceServiceInterface,
 	jwtService jwt.JWTServiceInterface,
+	tokenBuilder tokenservice.TokenBuilderInterface,
+	tokenValidator tokenservice.TokenValidatorInterface,
+	attributeCache attributecache.AttributeCacheServiceInterface,
 	flowExecService flowexec.FlowExecServiceInterface,
 	authCodeStore AuthorizationCodeStoreInterface,
 	authReqStore authorizationRequestStoreInterface,
+	authRespStore authorizationResponseStoreInterface,
 	parService par.PARServiceInterface,
+	requestObjectService requestobject.RequestObjectServiceInterface,
+	sessionService session.SessionServiceInterface,
 	transactioner transaction.Transactioner,
 ) AuthorizeServiceInterface {
 	return &authorizeService{
-		inboundClient:   inboundClient,
-		resourceService: resourceService,
-		authZValidator:  newAuthorizationValidator(),
-		authCodeStore:   authCodeStore,
-		authReqStore:    authReqStore,
-		parService:      parService,
-		jwtService:      jwtService,
-		flowExecService: flowExecService,
-		transactioner:   transactioner,
-		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeService")),
+		inboundClient:        inboundClient,
+		resourceService:      resourceService,
+		authZValidator:       newAuthorizationValidator(),
+		authCodeStore:        authCodeStore,
+		authReqStore:         authReqStore,
+		authRespStore:        authRespStore,
+		parService:           parService,
+		requestObjectService: requestObjectService,
+		sessionService:       sessionService,
+		jwtService:           jwtService,
+		tokenBuilder:         tokenBuilder,
+		tokenValidator:       tokenValidator,
+		attributeCache:       attributeCache,
+		flowExecService:      flowExecService,
+		transactioner:        transactioner,
+		logger:               log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeService")),
 	}
 }
 
@@ -135,6 +162,7 @@ func (as *authorizeService) HandleInitialAuthorizationRequest(ctx context.Contex
 	*AuthorizationInitResult, *AuthorizationError) {
 	clientID := msg.RequestQueryParams[oauth2const.RequestParamClientID]
 	requestURI := msg.RequestQueryParams[oauth2const.RequestParamRequestURI]
+	request := msg.RequestQueryParams[oauth2const.RequestParamRequest]
 
 	if clientID == "" {
 		return nil, &AuthorizationError{
@@ -159,9 +187,15 @@ func (as *authorizeService) HandleInitialAuthorizationRequest(ctx context.Contex
 		}
 	}
 
-	// If request_uri is present, resolve the pushed authorization request.
-	if requestURI != "" {
-		return as.handlePARAuthorizationRequest(ctx, requestURI, clientID, app)
+	// If request_uri was issued by the PAR endpoint, resolve the pushed authorization request.
+	if requestURI != "" && par.IsPushedAuthorizationRequestURI(requestURI) {
+		if request != "" {
+			return nil, &AuthorizationError{
+				Code:    oauth2const.ErrorInvalidRequest,
+				Message: "The request and request_uri parameters must not be used together",
+			}
+		}
+		return as.handlePARAuthorizationRequest(ctx, requestURI, clientID, msg.SessionID, app)
 	}
 
 	// Enforce PAR requirement: if PAR is required (per-client or global), reject requests without request_uri.
@@ -172,12 +206,50 @@ func (as *authorizeService) HandleInitialAuthorizationRequest(ctx context.Contex
 		}
 	}
 
+	// Replace the query parameters with those of the request object, if one was sent (RFC 9101).
+	if request != "" || requestURI != "" {
+		resolvedMsg, authErr := as.resolveRequestObject(ctx, msg, request, requestURI, app)
+		if authErr != nil {
+			return nil, authErr
+		}
+		msg = resolvedMsg
+	} else if app.RequiresSignedRequestObject() {
+		return nil, &AuthorizationError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "A signed request object is required for this client",
+		}
+	}
+
 	return as.handleStandardAuthorizationRequest(ctx, msg, app)
 }
 
+// resolveRequestObject resolves the request object passed by value or by reference and returns a copy of
+// the message carrying its parameters. Errors are not redirected to the client, since the redirect_uri
+// cannot be trusted until the request object has been verified.
+func (as *authorizeService) resolveRequestObject(
+	ctx context.Context, msg *OAuthMessage, request string, requestURI string, app *inboundmodel.OAuthClient,
+) (*OAuthMessage, *AuthorizationError) {
+	requestObject, errCode, errMsg := as.requestObjectService.ResolveRequestObject(ctx, request, requestURI, app)
+	if errCode != "" {
+		as.logger.Debug("Failed to resolve the request object",
+			log.String("errorCode", errCode), log.String("errorDescription", errMsg))
+		return nil, &AuthorizationError{Code: errCode, Message: errMsg}
+	}
+
+	params, errCode, errMsg := requestvalidator.ApplyRequestObjectParams(msg.RequestQueryParams, requestObject.Params)
+	if errCode != "" {
+		return nil, &AuthorizationError{Code: errCode, Message: errMsg}
+	}
+
+	resolvedMsg := *msg
+	resolvedMsg.RequestQueryParams = params
+	resolvedMsg.Resources = requestObject.Resources
+	return &resolvedMsg, nil
+}
+
 // handlePARAuthorizationRequest resolves a request_uri from a PAR and continues the authorization flow.
 func (as *authorizeService) handlePARAuthorizationRequest(
-	ctx context.Context, requestURI string, clientID string, app *inboundmodel.OAuthClient,
+	ctx context.Context, requestURI string, clientID string, sessionID string, app *inboundmodel.OAuthClient,
 ) (*AuthorizationInitResult, *AuthorizationError) {
 	oauthParams, err := as.parService.ResolvePushedAuthorizationRequest(ctx, requestURI, clientID)
 	if err != nil {
@@ -194,7 +266,7 @@ func (as *authorizeService) handlePARAuthorizationRequest(
 		}
 	}
 
-	return as.initiateFlowAndStoreRequest(ctx, oauthParams, app)
+	return as.processAuthorizationRequest(ctx, sessionID, oauthParams, app)
 }
 
 // handleStandardAuthorizationRequest processes a standard authorization request (without PAR).
@@ -206,6 +278,7 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 	scope := msg.RequestQueryParams[oauth2const.RequestParamScope]
 	state := msg.RequestQueryParams[oauth2const.RequestParamState]
 	responseType := msg.RequestQueryParams[oauth2const.RequestParamResponseType]
+	responseMode := msg.RequestQueryParams[oauth2const.RequestParamResponseMode]
 
 	// Extract PKCE parameters.
 	codeChallenge := msg.RequestQueryParams[oauth2const.RequestParamCodeChallenge]
@@ -247,6 +320,7 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 		if sendErrorToApp && redirectURI != "" {
 			authErr.SendErrorToClient = true
 			authErr.ClientRedirectURI = redirectURI
+			authErr.ResponseMode = resolveResponseMode(responseType, responseMode)
 		}
 		return nil, authErr
 	}
@@ -265,24 +339,49 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 			SendErrorToClient: true,
 			ClientRedirectURI: redirectURI,
 			State:             state,
+			ResponseMode:      resolveResponseMode(responseType, responseMode),
+		}
+	}
+
+	// Parse and validate the rich authorization request details against the type definitions of the
+	// resource servers (RFC 9396 §5).
+	authorizationDetails, errResp := authorizationdetails.Parse(
+		msg.RequestQueryParams[oauth2const.RequestParamAuthorizationDetails])
+	if errResp == nil {
+		errResp = authorizationdetails.Validate(ctx, as.resourceService, authorizationDetails)
+	}
+	if errResp != nil {
+		return nil, &AuthorizationError{
+			Code:              errResp.Error,
+			Message:           errResp.ErrorDescription,
+			SendErrorToClient: true,
+			ClientRedirectURI: redirectURI,
+			State:             state,
+			ResponseMode:      resolveResponseMode(responseType, responseMode),
 		}
 	}
 
 	// Construct authorization request context.
 	oauthParams := &oauth2model.OAuthParameters{
-		State:               state,
-		ClientID:            app.ClientID,
-		RedirectURI:         redirectURI,
-		ResponseType:        responseType,
-		StandardScopes:      oidcScopes,
-		PermissionScopes:    nonOidcScopes,
-		CodeChallenge:       codeChallenge,
-		CodeChallengeMethod: codeChallengeMethod,
-		Resources:           resources,
-		ClaimsRequest:       claimsRequest,
-		ClaimsLocales:       claimsLocales,
-		Nonce:               nonce,
-		AcrValues:           acrValues,
+		State:                state,
+		ClientID:             app.ClientID,
+		RedirectURI:          redirectURI,
+		ResponseType:         responseType,
+		ResponseMode:         responseMode,
+		StandardScopes:       oidcScopes,
+		PermissionScopes:     nonOidcScopes,
+		CodeChallenge:        codeChallenge,
+		CodeChallengeMethod:  codeChallengeMethod,
+		Resources:            resources,
+		ClaimsRequest:        claimsRequest,
+		ClaimsLocales:        claimsLocales,
+		Nonce:                nonce,
+		AcrValues:            acrValues,
+		Prompt:               msg.RequestQueryParams[oauth2const.RequestParamPrompt],
+		MaxAge:               requestvalidator.ParseMaxAge(msg.RequestQueryParams[oauth2const.RequestParamMaxAge]),
+		LoginHint:            msg.RequestQueryParams[oauth2const.RequestParamLoginHint],
+		IDTokenHint:          msg.RequestQueryParams[oauth2const.RequestParamIDTokenHint],
+		AuthorizationDetails: authorizationDetails,
 	}
 
 	// Set the redirect URI if not provided in the request. Invalid cases are already handled at this point.
@@ -299,16 +398,128 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 		oauthParams.RedirectURI = app.RedirectURIs[0]
 	}
 
+	return as.processAuthorizationRequest(ctx, msg.SessionID, oauthParams, app)
+}
+
+// processAuthorizationRequest completes the authorization request from the user's SSO session when
+// possible, and otherwise initiates the authentication flow.
+func (as *authorizeService) processAuthorizationRequest(
+	ctx context.Context, sessionID string, oauthParams *oauth2model.OAuthParameters, app *inboundmodel.OAuthClient,
+) (*AuthorizationInitResult, *AuthorizationError) {
+	if oauthParams.IDTokenHint != "" && as.getIDTokenHintSubject(ctx, oauthParams.IDTokenHint) == "" {
+		return nil, newClientAuthorizationError(oauthParams,
+			oauth2const.ErrorInvalidRequest, "Invalid id_token_hint")
+	}
+
+	result, authErr := as.authorizeWithSession(ctx, sessionID, oauthParams, app)
+	if result != nil || authErr != nil {
+		return result, authErr
+	}
+
 	return as.initiateFlowAndStoreRequest(ctx, oauthParams, app)
 }
 
+// authorizeWithSession issues the authorization code directly from an active SSO session, skipping
+// re-authentication. Returns (nil, nil) when the request must go through the authentication flow,
+// and login_required or interaction_required when prompt=none cannot be satisfied without it.
+func (as *authorizeService) authorizeWithSession(
+	ctx context.Context, sessionID string, oauthParams *oauth2model.OAuthParameters, app *inboundmodel.OAuthClient,
+) (*AuthorizationInitResult, *AuthorizationError) {
+	prompts := strings.Fields(oauthParams.Prompt)
+	if slices.Contains(prompts, oauth2const.PromptLogin) {
+		return nil, nil
+	}
+	promptNone := slices.Contains(prompts, oauth2const.PromptNone)
+
+	ssoSession := as.getActiveSession(ctx, sessionID)
+	if ssoSession == nil || !as.isSessionSatisfyingRequest(ctx, ssoSession, oauthParams, app) {
+		if promptNone {
+			return nil, newClientAuthorizationError(oauthParams,
+				oauth2const.ErrorLoginRequired, "User authentication is required")
+		}
+		return nil, nil
+	}
+
+	if !isAuthorizableFromSession(oauthParams, app) {
+		if promptNone {
+			return nil, newClientAuthorizationError(oauthParams,
+				oauth2const.ErrorInteractionRequired, "User interaction is required to authorize the request")
+		}
+		return nil, nil
+	}
+
+	authRequestCtx := &authRequestContext{OAuthParameters: *oauthParams}
+	claims := &assertionClaims{
+		userID:       ssoSession.UserID,
+		completedACR: ssoSession.ACR,
+	}
+	authzCode, err := createAuthorizationCode(authRequestCtx, claims, ssoSession.AuthTime)
+	if err != nil {
+		as.logger.Error("Failed to create authorization code from session", log.Error(err))
+		return nil, newClientAuthorizationError(oauthParams,
+			oauth2const.ErrorServerError, "Failed to process authorization request")
+	}
+	authzCode.SessionSID = ssoSession.SID
+	responseParams, err := as.issueAuthorizationResponse(ctx, &authzCode, oauthParams, app)
+	if err != nil {
+		as.logger.Error("Failed to issue authorization response", log.Error(err))
+		return nil, newClientAuthorizationError(oauthParams,
+			oauth2const.ErrorServerError, "Failed to process authorization request")
+	}
+
+	as.addClientToSession(ctx, ssoSession.ID, oauthParams.ClientID)
+
+	as.logger.Debug("Authorization request satisfied by an existing session",
+		log.String("client_id", oauthParams.ClientID))
+
+	responseMode := resolveResponseMode(oauthParams.ResponseType, oauthParams.ResponseMode)
+	if responseMode == oauth2const.ResponseModeFormPost {
+		return &AuthorizationInitResult{
+			FormPost: &FormPostResponse{RedirectURI: oauthParams.RedirectURI, Params: responseParams},
+		}, nil
+	}
+	redirectURI, err := oauth2utils.GetAuthorizationResponseURI(oauthParams.RedirectURI, responseMode, responseParams)
+	if err != nil {
+		as.logger.Error("Failed to construct authorization response URI", log.Error(err))
+		return nil, newClientAuthorizationError(oauthParams,
+			oauth2const.ErrorServerError, "Failed to process authorization request")
+	}
+	return &AuthorizationInitResult{RedirectURI: redirectURI}, nil
+}
+
+// getActiveSession returns the active SSO session identified by the session ID, or nil if there is none.
+func (as *authorizeService) getActiveSession(ctx context.Context, sessionID string) *session.Session {
+	if sessionID == "" {
+		return nil
+	}
+
+	ssoSession, svcErr := as.sessionService.GetSession(ctx, sessionID)
+	if svcErr != nil {
+		if svcErr.Code != session.ErrorSessionNotFound.Code {
+			as.logger.Error("Failed to retrieve SSO session",
+				log.String("error", svcErr.ErrorDescription.DefaultValue))
+		}
+		return nil
+	}
+	return ssoSession
+}
+
+// addClientToSession records the client against the SSO session. Failures are logged and otherwise
+// ignored since the authorization itself has already succeeded.
+func (as *authorizeService) addClientToSession(ctx context.Context, sessionID string, clientID string) {
+	if svcErr := as.sessionService.AddClientToSession(ctx, sessionID, clientID); svcErr != nil {
+		as.logger.Error("Failed to add client to SSO session", log.String("client_id", clientID),
+			log.String("error", svcErr.ErrorDescription.DefaultValue))
+	}
+}
+
 // initiateFlowAndStoreRequest initiates the authentication flow and stores the authorization request context.
 // This is the common path shared by both standard and PAR-based authorization requests.
 func (as *authorizeService) initiateFlowAndStoreRequest(
 	ctx context.Context, oauthParams *oauth2model.OAuthParameters, app *inboundmodel.OAuthClient,
 ) (*AuthorizationInitResult, *AuthorizationError) {
-	effectiveAcrValues := requestvalidator.ResolveACRValues(oauthParams.AcrValues, app.AcrValues)
-	essentialAttributes, optionalAttributes := getRequiredAttributes(
+	effectiveAcrValues := requestvalidator.ResolveACRValues(getRequestedACRValues(oauthParams), app.AcrValues)
+	essentialAttributes, optionalAttributes := GetRequiredAttributes(
 		oauthParams.StandardScopes, oauthParams.ClaimsRequest, oauthParams.ResponseType, app)
 
 	// Initiate flow with OAuth context.
@@ -323,6 +534,18 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 	if effectiveAcrValues != "" {
 		runtimeData[flowcm.RuntimeKeyRequestedAuthClasses] = effectiveAcrValues
 	}
+	if oauthParams.LoginHint != "" {
+		runtimeData[flowcm.RuntimeKeyLoginHint] = oauthParams.LoginHint
+	}
+	if len(oauthParams.AuthorizationDetails) > 0 {
+		serializedDetails, err := authorizationdetails.Serialize(oauthParams.AuthorizationDetails)
+		if err != nil {
+			as.logger.Error("Failed to serialize authorization details", log.Error(err))
+			return nil, newClientAuthorizationError(oauthParams,
+				oauth2const.ErrorServerError, "Failed to process authorization request")
+		}
+		runtimeData[flowcm.RuntimeKeyRequestedAuthorizationDetails] = serializedDetails
+	}
 	flowInitCtx := &flowexec.FlowInitContext{
 		ApplicationID: app.ID,
 		FlowType:      string(flowcm.FlowTypeAuthentication),
@@ -333,13 +556,8 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 	if flowErr != nil {
 		as.logger.Error("Failed to initiate authentication flow",
 			log.String("error_code", flowErr.Code))
-		return nil, &AuthorizationError{
-			Code:              oauth2const.ErrorServerError,
-			Message:           "Failed to process authorization request",
-			SendErrorToClient: true,
-			ClientRedirectURI: oauthParams.RedirectURI,
-			State:             oauthParams.State,
-		}
+		return nil, newClientAuthorizationError(oauthParams,
+			oauth2const.ErrorServerError, "Failed to process authorization request")
 	}
 
 	authRequestCtx := authRequestContext{
@@ -350,13 +568,8 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 	identifier, storeErr := as.authReqStore.AddRequest(ctx, authRequestCtx)
 	if storeErr != nil {
 		as.logger.Error("Failed to store authorization request context", log.Error(storeErr))
-		return nil, &AuthorizationError{
-			Code:              oauth2const.ErrorServerError,
-			Message:           "Failed to process authorization request",
-			SendErrorToClient: true,
-			ClientRedirectURI: oauthParams.RedirectURI,
-			State:             oauthParams.State,
-		}
+		return nil, newClientAuthorizationError(oauthParams,
+			oauth2const.ErrorServerError, "Failed to process authorization request")
 	}
 
 	// Build query parameters for login page redirect.
@@ -370,13 +583,8 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 	parsedRedirectURI, err := utils.ParseURL(oauthParams.RedirectURI)
 	if err != nil {
 		as.logger.Error("Failed to parse redirect URI", log.Error(err))
-		return nil, &AuthorizationError{
-			Code:              oauth2const.ErrorServerError,
-			Message:           "Failed to process authorization request",
-			SendErrorToClient: true,
-			ClientRedirectURI: oauthParams.RedirectURI,
-			State:             oauthParams.State,
-		}
+		return nil, newClientAuthorizationError(oauthParams,
+			oauth2const.ErrorServerError, "Failed to process authorization request")
 	}
 	if parsedRedirectURI.Scheme == "http" {
 		queryParams[oauth2const.ShowInsecureWarning] = "true"
@@ -385,11 +593,15 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 	return &AuthorizationInitResult{QueryParams: queryParams}, nil
 }
 
-// HandleAuthorizationCallback processes the callback assertion from the flow engine.
+// HandleAuthorizationCallback processes the callback assertion from the flow engine. The SSO session of
+// the user agent, if any, is reused when it belongs to the authenticated user.
 // Returns the client redirect URI (with authorization code) on success, or a structured error.
-func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, authID string, assertion string) (
-	string, *AuthorizationError) {
+func (as *authorizeService) HandleAuthorizationCallback(
+	ctx context.Context, authID string, assertion string, sessionID string,
+) (*AuthorizationCallbackResult, *AuthorizationError) {
 	var redirectURI string
+	var authzCode AuthorizationCode
+	var ssoSession *session.Session
 	var authErr *AuthorizationError
 
 	err := func() error {
@@ -412,53 +624,41 @@ func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, aut
 		}
 
 		if assertion == "" {
-			authErr = &AuthorizationError{
-				Code:              oauth2const.ErrorInvalidRequest,
-				Message:           "Invalid authorization request",
-				SendErrorToClient: true,
-				ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
-				State:             authRequestCtx.OAuthParameters.State,
-			}
+			authErr = newClientAuthorizationError(&authRequestCtx.OAuthParameters,
+				oauth2const.ErrorInvalidRequest, "Invalid authorization request")
 			return errors.New("assertion is empty")
 		}
 
 		// Verify the assertion.
 		if err := as.verifyAssertion(assertion); err != nil {
 			as.logger.Debug("Assertion verification failed", log.Error(err))
-			authErr = &AuthorizationError{
-				Code:              oauth2const.ErrorInvalidRequest,
-				Message:           "Authorization request failed",
-				SendErrorToClient: true,
-				ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
-				State:             authRequestCtx.OAuthParameters.State,
-			}
+			authErr = newClientAuthorizationError(&authRequestCtx.OAuthParameters,
+				oauth2const.ErrorInvalidRequest, "Authorization request failed")
 			return err
 		}
 
 		// Decode user attributes from the assertion.
 		claims, authTime, err := decodeAttributesFromAssertion(assertion)
 		if err != nil {
-			authErr = &AuthorizationError{
-				Code:              oauth2const.ErrorServerError,
-				Message:           "Failed to process authorization request",
-				SendErrorToClient: true,
-				ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
-				State:             authRequestCtx.OAuthParameters.State,
-			}
+			authErr = newClientAuthorizationError(&authRequestCtx.OAuthParameters,
+				oauth2const.ErrorServerError, "Failed to process authorization request")
 			return err
 		}
 
 		if claims.userID == "" {
-			authErr = &AuthorizationError{
-				Code:              oauth2const.ErrorServerError,
-				Message:           "Authorization request failed",
-				SendErrorToClient: true,
-				ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
-				State:             authRequestCtx.OAuthParameters.State,
-			}
+			authErr = newClientAuthorizationError(&authRequestCtx.OAuthParameters,
+				oauth2const.ErrorServerError, "Authorization request failed")
 			return errors.New("user ID is empty")
 		}
 
+		// The authenticated user must be the user identified by the id_token_hint, if one was sent.
+		if idTokenHint := authRequestCtx.OAuthParameters.IDTokenHint; idTokenHint != "" &&
+			as.getIDTokenHintSubject(ctx, idTokenHint) != claims.userID {
+			authErr = newClientAuthorizationError(&authRequestCtx.OAuthParameters,
+				oauth2const.ErrorLoginRequired, "The authenticated user does not match the id_token_hint")
+			return errors.New("authenticated user does not match the id_token_hint")
+		}
+
 		// Validate sub claim constraint if specified in claims parameter.
 		// If sub claim is requested with a value constraint and doesn't match, authentication must fail.
 		hasOpenIDScope := slices.Contains(authRequestCtx.OAuthParameters.StandardScopes, oauth2const.ScopeOpenID)
@@ -467,13 +667,8 @@ func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, aut
 				authRequestCtx.OAuthParameters.ClaimsRequest, claims.userID,
 			); err != nil {
 				as.logger.Debug("Sub claim validation failed", log.Error(err))
-				authErr = &AuthorizationError{
-					Code:              oauth2const.ErrorAccessDenied,
-					Message:           "Authorization request failed",
-					SendErrorToClient: true,
-					ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
-					State:             authRequestCtx.OAuthParameters.State,
-				}
+				authErr = newClientAuthorizationError(&authRequestCtx.OAuthParameters,
+					oauth2const.ErrorAccessDenied, "Authorization request failed")
 				return err
 			}
 		}
@@ -488,48 +683,34 @@ func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, aut
 			authRequestCtx.OAuthParameters.PermissionScopes = []string{}
 		}
 
+		// Only the authorization details approved by the user are granted.
+		authRequestCtx.OAuthParameters.AuthorizationDetails = claims.authorizedAuthorizationDetails
+
 		// Generate the authorization code.
-		authzCode, err := createAuthorizationCode(authRequestCtx, &claims, authTime)
+		authzCode, err = createAuthorizationCode(authRequestCtx, &claims, authTime)
 		if err != nil {
-			authErr = &AuthorizationError{
-				Code:              oauth2const.ErrorServerError,
-				Message:           "Failed to process authorization request",
-				SendErrorToClient: true,
-				ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
-				State:             authRequestCtx.OAuthParameters.State,
-			}
+			authErr = newClientAuthorizationError(&authRequestCtx.OAuthParameters,
+				oauth2const.ErrorServerError, "Failed to process authorization request")
 			return err
 		}
 
-		// Persist the authorization code.
-		if persistErr := as.authCodeStore.InsertAuthorizationCode(ctx, authzCode); persistErr != nil {
-			authErr = &AuthorizationError{
-				Code:              oauth2const.ErrorServerError,
-				Message:           "Failed to process authorization request",
-				SendErrorToClient: true,
-				ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
-				State:             authRequestCtx.OAuthParameters.State,
-			}
-			return persistErr
+		// Establish the SSO session before issuing the response so the ID token carries its sid.
+		ssoSession = as.establishSession(ctx, sessionID, &authzCode)
+		if ssoSession != nil {
+			authzCode.SessionSID = ssoSession.SID
 		}
 
-		// Construct the redirect URI with the authorization code.
-		queryParams := map[string]string{
-			"code":                      authzCode.Code,
-			oauth2const.RequestParamIss: config.GetServerRuntime().Config.JWT.Issuer,
-		}
-		if authRequestCtx.OAuthParameters.State != "" {
-			queryParams[oauth2const.RequestParamState] = authRequestCtx.OAuthParameters.State
+		// Issue the authorization response and construct the URI delivering it to the client.
+		responseParams, err := as.issueAuthorizationResponse(ctx, &authzCode, &authRequestCtx.OAuthParameters, nil)
+		if err != nil {
+			authErr = newClientAuthorizationError(&authRequestCtx.OAuthParameters,
+				oauth2const.ErrorServerError, "Failed to process authorization request")
+			return err
 		}
-		redirectURI, err = oauth2utils.GetURIWithQueryParams(authzCode.RedirectURI, queryParams)
+		redirectURI, err = as.buildCallbackResponseURI(ctx, &authRequestCtx.OAuthParameters, responseParams)
 		if err != nil {
-			authErr = &AuthorizationError{
-				Code:              oauth2const.ErrorServerError,
-				Message:           "Failed to process authorization request",
-				SendErrorToClient: true,
-				ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
-				State:             authRequestCtx.OAuthParameters.State,
-			}
+			authErr = newClientAuthorizationError(&authRequestCtx.OAuthParameters,
+				oauth2const.ErrorServerError, "Failed to process authorization request")
 			return err
 		}
 
@@ -540,17 +721,367 @@ func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, aut
 		if authErr.Code == oauth2const.ErrorServerError {
 			as.logger.Error("Failed to process authorization callback", log.Error(err))
 		}
-		return "", authErr
+		if authErr.SendErrorToClient && authErr.ResponseMode == oauth2const.ResponseModeFormPost {
+			return as.buildFormPostErrorCallbackResult(ctx, authErr)
+		}
+		return nil, authErr
 	}
 	if err != nil {
 		as.logger.Error("Failed to process authorization callback", log.Error(err))
-		return "", &AuthorizationError{
+		return nil, &AuthorizationError{
 			Code:    oauth2const.ErrorServerError,
 			Message: "Failed to process authorization request",
 		}
 	}
 
-	return redirectURI, nil
+	return &AuthorizationCallbackResult{
+		RedirectURI: redirectURI,
+		Session:     ssoSession,
+	}, nil
+}
+
+// establishSession records the authentication by the flow in the SSO session of the user agent and
+// records the client against it. The current session is reused when it belongs to the authenticated
+// user; otherwise it is terminated and a new session is created. Failures are logged and the
+// authorization proceeds without a session.
+func (as *authorizeService) establishSession(
+	ctx context.Context, sessionID string, authzCode *AuthorizationCode,
+) *session.Session {
+	var ssoSession *session.Session
+	if currentSession := as.getActiveSession(ctx, sessionID); currentSession != nil {
+		if currentSession.UserID == authzCode.AuthorizedUserID {
+			reauthenticated, svcErr := as.sessionService.ReauthenticateSession(
+				ctx, currentSession.ID, authzCode.TimeCreated, authzCode.CompletedACR)
+			if svcErr != nil {
+				as.logger.Error("Failed to update SSO session",
+					log.String("error", svcErr.ErrorDescription.DefaultValue))
+			}
+			ssoSession = reauthenticated
+		} else if svcErr := as.sessionService.TerminateSession(ctx, currentSession.ID); svcErr != nil {
+			as.logger.Error("Failed to terminate the SSO session of another user",
+				log.String("error", svcErr.ErrorDescription.DefaultValue))
+		}
+	}
+
+	if ssoSession == nil {
+		newSession, svcErr := as.sessionService.CreateSession(
+			ctx, authzCode.AuthorizedUserID, authzCode.TimeCreated, authzCode.CompletedACR)
+		if svcErr != nil {
+			as.logger.Error("Failed to create SSO session",
+				log.String("error", svcErr.ErrorDescription.DefaultValue))
+			return nil
+		}
+		ssoSession = newSession
+	}
+
+	as.addClientToSession(ctx, ssoSession.ID, authzCode.ClientID)
+	return ssoSession
+}
+
+// GetFormPostResponse retrieves and consumes an authorization response pending delivery to the client
+// with the form_post response mode.
+func (as *authorizeService) GetFormPostResponse(ctx context.Context, authID string) (
+	*FormPostResponse, *AuthorizationError) {
+	ok, formPost, err := as.authRespStore.GetResponse(ctx, authID)
+	if err != nil {
+		as.logger.Error("Failed to retrieve the authorization response", log.Error(err))
+		return nil, &AuthorizationError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process authorization request",
+		}
+	}
+	if !ok {
+		as.logger.Debug("Authorization response not found", log.String("auth_id", authID))
+		return nil, &AuthorizationError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "Invalid authorization response",
+		}
+	}
+
+	// The response is delivered only once.
+	if clearErr := as.authRespStore.ClearResponse(ctx, authID); clearErr != nil {
+		as.logger.Error("Failed to clear the authorization response", log.Error(clearErr))
+	}
+	return &formPost, nil
+}
+
+// issueAuthorizationResponse issues the code and tokens requested by the response type and returns the
+// parameters of the authorization response. The client is looked up only when tokens are issued and the
+// caller has not already resolved it.
+func (as *authorizeService) issueAuthorizationResponse(
+	ctx context.Context, authzCode *AuthorizationCode, oauthParams *oauth2model.OAuthParameters,
+	app *inboundmodel.OAuthClient,
+) (map[string]string, error) {
+	responseType := oauth2const.NormalizeResponseType(oauthParams.ResponseType)
+	params := map[string]string{
+		oauth2const.RequestParamIss: config.GetServerRuntime().Config.JWT.Issuer,
+	}
+	if oauthParams.State != "" {
+		params[oauth2const.RequestParamState] = oauthParams.State
+	}
+
+	if responseType.Includes(oauth2const.ResponseTypeCode) {
+		if err := as.authCodeStore.InsertAuthorizationCode(ctx, *authzCode); err != nil {
+			return nil, fmt.Errorf("failed to persist authorization code: %w", err)
+		}
+		params[oauth2const.RequestParamCode] = authzCode.Code
+	}
+
+	if responseType.Includes(oauth2const.ResponseTypeIDToken) {
+		if app == nil {
+			var err error
+			if app, err = as.inboundClient.GetOAuthClientByClientID(ctx, authzCode.ClientID); err != nil {
+				return nil, fmt.Errorf("failed to retrieve OAuth client: %w", err)
+			}
+			if app == nil {
+				return nil, errors.New("OAuth client not found")
+			}
+		}
+		if err := as.issueTokens(ctx, authzCode, responseType, app, params); err != nil {
+			return nil, err
+		}
+	}
+
+	return params, nil
+}
+
+// issueTokens issues the ID token, and the access token when requested by the response type, directly from
+// the authorization endpoint and adds them to the authorization response parameters.
+func (as *authorizeService) issueTokens(
+	ctx context.Context, authzCode *AuthorizationCode, responseType oauth2const.ResponseType,
+	app *inboundmodel.OAuthClient, params map[string]string,
+) error {
+	attrs := make(map[string]interface{})
+	if authzCode.AttributeCacheID != "" {
+		userAttributes, svcErr := as.attributeCache.GetAttributeCache(ctx, authzCode.AttributeCacheID)
+		if svcErr != nil {
+			return errors.New("failed to get user attributes from attribute cache: " +
+				svcErr.ErrorDescription.DefaultValue)
+		}
+		attrs = userAttributes.Attributes
+	}
+	scopes := tokenservice.ParseScopes(authzCode.Scopes)
+
+	idTokenCtx := &tokenservice.IDTokenBuildContext{
+		Context:        ctx,
+		Subject:        authzCode.AuthorizedUserID,
+		Audience:       authzCode.ClientID,
+		Scopes:         scopes,
+		UserAttributes: attrs,
+		AuthTime:       authzCode.TimeCreated.Unix(),
+		OAuthApp:       app,
+		ClaimsRequest:  authzCode.ClaimsRequest,
+		Nonce:          authzCode.Nonce,
+		CompletedACR:   authzCode.CompletedACR,
+		SID:            authzCode.SessionSID,
+	}
+	if responseType.Includes(oauth2const.ResponseTypeCode) {
+		idTokenCtx.AuthorizationCode = authzCode.Code
+	}
+
+	if responseType.Includes(oauth2const.ResponseTypeToken) {
+		resourceServers, errResp := resourceindicators.ResolveResourceServers(
+			ctx, as.resourceService, authzCode.Resources)
+		if errResp != nil {
+			return errors.New("failed to resolve resource servers: " + errResp.ErrorDescription)
+		}
+		audiences, errResp := resourceindicators.ComposeAudiences(
+			ctx, as.resourceService, authzCode.ClientID, resourceServers, scopes)
+		if errResp != nil {
+			return errors.New("failed to compose access token audiences: " + errResp.ErrorDescription)
+		}
+
+		accessToken, err := as.tokenBuilder.BuildAccessToken(&tokenservice.AccessTokenBuildContext{
+			Context:              ctx,
+			Subject:              authzCode.AuthorizedUserID,
+			Audiences:            audiences,
+			ClientID:             authzCode.ClientID,
+			Scopes:               scopes,
+			UserAttributes:       attrs,
+			AttributeCacheID:     authzCode.AttributeCacheID,
+			GrantType:            string(oauth2const.GrantTypeAuthorizationCode),
+			OAuthApp:             app,
+			ClaimsRequest:        authzCode.ClaimsRequest,
+			ClaimsLocales:        authzCode.ClaimsLocales,
+			AuthorizationDetails: authzCode.AuthorizationDetails,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to generate access token: %w", err)
+		}
+		params[oauth2const.ResponseParamAccessToken] = accessToken.Token
+		params[oauth2const.ResponseParamTokenType] = accessToken.TokenType
+		params[oauth2const.ResponseParamExpiresIn] = strconv.FormatInt(accessToken.ExpiresIn, 10)
+		idTokenCtx.AccessToken = accessToken.Token
+	}
+
+	idToken, err := as.tokenBuilder.BuildIDToken(idTokenCtx)
+	if err != nil {
+		return fmt.Errorf("failed to generate ID token: %w", err)
+	}
+	params[oauth2const.ResponseParamIDToken] = idToken.Token
+	return nil
+}
+
+// buildCallbackResponseURI returns the URI the user agent is sent to after the authentication flow to
+// deliver the authorization response. Responses using the form_post response mode are stored and
+// delivered by the authorization response endpoint of the server.
+func (as *authorizeService) buildCallbackResponseURI(
+	ctx context.Context, oauthParams *oauth2model.OAuthParameters, params map[string]string,
+) (string, error) {
+	responseMode := resolveResponseMode(oauthParams.ResponseType, oauthParams.ResponseMode)
+	if responseMode == oauth2const.ResponseModeFormPost {
+		return as.storeFormPostResponse(ctx, oauthParams.RedirectURI, params)
+	}
+	return oauth2utils.GetAuthorizationResponseURI(oauthParams.RedirectURI, responseMode, params)
+}
+
+// buildFormPostErrorCallbackResult stores an error response for delivery to the client with the form_post
+// response mode, and returns the callback result sending the user agent to it.
+func (as *authorizeService) buildFormPostErrorCallbackResult(
+	ctx context.Context, authErr *AuthorizationError,
+) (*AuthorizationCallbackResult, *AuthorizationError) {
+	redirectURI, err := as.storeFormPostResponse(ctx, authErr.ClientRedirectURI, getClientErrorParams(authErr))
+	if err != nil {
+		as.logger.Error("Failed to store the authorization error response", log.Error(err))
+		return nil, &AuthorizationError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process authorization request",
+		}
+	}
+	return &AuthorizationCallbackResult{RedirectURI: redirectURI}, nil
+}
+
+// storeFormPostResponse stores an authorization response pending delivery with the form_post response
+// mode and returns the URI of the server page delivering it.
+func (as *authorizeService) storeFormPostResponse(
+	ctx context.Context, redirectURI string, params map[string]string,
+) (string, error) {
+	identifier, err := as.authRespStore.AddResponse(ctx, FormPostResponse{
+		RedirectURI: redirectURI,
+		Params:      params,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to store the authorization response: %w", err)
+	}
+
+	responseEndpoint := config.GetServerURL(&config.GetServerRuntime().Config.Server) +
+		oauth2const.OAuth2AuthorizationResponseEndpoint
+	return oauth2utils.GetURIWithQueryParams(responseEndpoint, map[string]string{oauth2const.AuthID: identifier})
+}
+
+// newClientAuthorizationError builds an authorization error that is redirected to the client.
+func newClientAuthorizationError(
+	oauthParams *oauth2model.OAuthParameters, code string, message string,
+) *AuthorizationError {
+	return &AuthorizationError{
+		Code:              code,
+		Message:           message,
+		SendErrorToClient: true,
+		ClientRedirectURI: oauthParams.RedirectURI,
+		State:             oauthParams.State,
+		ResponseMode:      resolveResponseMode(oauthParams.ResponseType, oauthParams.ResponseMode),
+	}
+}
+
+// resolveResponseMode returns the response mode used to return the authorization response. The default
+// response mode of the response type is used when the requested mode is invalid or not allowed.
+func resolveResponseMode(responseType string, responseMode string) oauth2const.ResponseMode {
+	normalizedType := oauth2const.NormalizeResponseType(responseType)
+	defaultMode := oauth2const.GetDefaultResponseMode(normalizedType)
+	mode := oauth2const.ResponseMode(responseMode)
+	if !mode.IsValid() || (mode == oauth2const.ResponseModeQuery && defaultMode != oauth2const.ResponseModeQuery) {
+		return defaultMode
+	}
+	return mode
+}
+
+// getClientErrorParams returns the parameters of an error response returned to the client.
+func getClientErrorParams(authErr *AuthorizationError) map[string]string {
+	params := map[string]string{
+		oauth2const.RequestParamError:            authErr.Code,
+		oauth2const.RequestParamErrorDescription: authErr.Message,
+		oauth2const.RequestParamIss:              config.GetServerRuntime().Config.JWT.Issuer,
+	}
+	if authErr.State != "" {
+		params[oauth2const.RequestParamState] = authErr.State
+	}
+	return params
+}
+
+// isSessionSatisfyingRequest checks whether the authentication performed in the session satisfies the
+// authentication requirements of the request.
+func (as *authorizeService) isSessionSatisfyingRequest(
+	ctx context.Context, ssoSession *session.Session, oauthParams *oauth2model.OAuthParameters, app *inboundmodel.OAuthClient,
+) bool {
+	effectiveAcrValues := requestvalidator.ResolveACRValues(getRequestedACRValues(oauthParams), app.AcrValues)
+	if effectiveAcrValues != "" && !slices.Contains(strings.Fields(effectiveAcrValues), ssoSession.ACR) {
+		return false
+	}
+
+	// Re-authentication is required once more than max_age seconds have elapsed since the user authenticated.
+	if oauthParams.MaxAge != nil &&
+		time.Since(ssoSession.AuthTime) > time.Duration(*oauthParams.MaxAge)*time.Second {
+		return false
+	}
+
+	if oauthParams.IDTokenHint != "" && as.getIDTokenHintSubject(ctx, oauthParams.IDTokenHint) != ssoSession.UserID {
+		return false
+	}
+
+	if slices.Contains(oauthParams.StandardScopes, oauth2const.ScopeOpenID) {
+		if err := validateSubClaimConstraint(oauthParams.ClaimsRequest, ssoSession.UserID); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getRequestedACRValues returns the ACR values requested through the acr_values parameter, or otherwise
+// through the acr claim of the claims parameter (OIDC Core §5.5.1.1).
+func getRequestedACRValues(oauthParams *oauth2model.OAuthParameters) string {
+	if oauthParams.AcrValues != "" || oauthParams.ClaimsRequest == nil {
+		return oauthParams.AcrValues
+	}
+	acrRequest := oauthParams.ClaimsRequest.IDToken[oauth2const.ClaimACR]
+	if acrRequest == nil {
+		return ""
+	}
+
+	acrValues := make([]string, 0, len(acrRequest.Values)+1)
+	if value, ok := acrRequest.Value.(string); ok {
+		acrValues = append(acrValues, value)
+	}
+	for _, value := range acrRequest.Values {
+		if acr, ok := value.(string); ok {
+			acrValues = append(acrValues, acr)
+		}
+	}
+	return strings.Join(acrValues, " ")
+}
+
+// getIDTokenHintSubject validates the ID token hint and returns its subject, or an empty string when the
+// hint is not an ID token issued by this server. Expired ID tokens are accepted as hints.
+func (as *authorizeService) getIDTokenHintSubject(ctx context.Context, idTokenHint string) string {
+	hintClaims, err := as.tokenValidator.ValidateIDTokenHint(ctx, idTokenHint)
+	if err != nil {
+		as.logger.Debug("Invalid id_token_hint", log.Error(err))
+		return ""
+	}
+	return hintClaims.Sub
+}
+
+// isAuthorizableFromSession checks whether the request can be authorized without running the
+// authentication flow. Permission scopes, authorization details and user attributes are resolved within
+// the flow, hence requests that need any of them are not authorized from the session.
+func isAuthorizableFromSession(oauthParams *oauth2model.OAuthParameters, app *inboundmodel.OAuthClient) bool {
+	if len(oauthParams.PermissionScopes) > 0 || len(oauthParams.AuthorizationDetails) > 0 {
+		return false
+	}
+
+	essentialAttributes, optionalAttributes := GetRequiredAttributes(
+		oauthParams.StandardScopes, oauthParams.ClaimsRequest, oauthParams.ResponseType, app)
+	return essentialAttributes == "" && optionalAttributes == ""
 }
 
 // loadAuthRequestContext loads the authorization request context from the store using the auth ID.
@@ -583,67 +1114,21 @@ func (as *authorizeService) verifyAssertion(assertion string) error {
 
 // decodeAttributesFromAssertion decodes user attributes from the flow assertion JWT.
 func decodeAttributesFromAssertion(assertion string) (assertionClaims, time.Time, error) {
-	claims := assertionClaims{}
-
-	_, jwtPayload, err := jwt.DecodeJWT(assertion)
+	decoded, err := flowassertion.Decode(assertion)
 	if err != nil {
-		return claims, time.Time{}, fmt.Errorf("failed to decode the JWT token: %w", err)
-	}
-
-	// Extract authentication time from iat claim.
-	authTime := time.Time{}
-	if iatValue, ok := jwtPayload["iat"]; ok {
-		switch v := iatValue.(type) {
-		case float64:
-			authTime = time.Unix(int64(v), 0)
-		case int64:
-			authTime = time.Unix(v, 0)
-		case int:
-			authTime = time.Unix(int64(v), 0)
-		default:
-			return claims, time.Time{}, errors.New("JWT 'iat' claim has unexpected type")
-		}
-	}
-
-	for key, value := range jwtPayload {
-		// Extract sub claim.
-		if key == oauth2const.ClaimSub {
-			if strValue, ok := value.(string); ok {
-				claims.userID = strValue
-			} else {
-				return claims, time.Time{}, errors.New("JWT 'sub' claim is not a string")
-			}
-			continue
-		}
-
-		// Extract authorized_permissions claim.
-		if key == "authorized_permissions" {
-			if strValue, ok := value.(string); ok {
-				claims.authorizedPermissions = strValue
-			}
-			continue
-		}
-
-		if key == "aci" {
-			strValue, ok := value.(string)
-			if !ok {
-				return claims, time.Time{}, errors.New("JWT 'aci' claim is not a string")
-			}
-			claims.attributeCacheID = strValue
-			continue
-		}
-
-		if key == oauth2const.ClaimCompletedAuthClass {
-			strValue, ok := value.(string)
-			if !ok {
-				return claims, time.Time{}, errors.New("JWT 'completed_auth_class' claim is not a string")
-			}
-			claims.completedACR = strValue
-			continue
-		}
+		return assertionClaims{}, time.Time{}, err
 	}
 
-	return claims, authTime, nil
+	claims := assertionClaims{
+		userID:                decoded.UserID,
+		authorizedPermissions: decoded.AuthorizedPermissions,
+		attributeCacheID:      decoded.AttributeCacheID,
+		completedACR:          decoded.CompletedACR,
+	}
+	if value, ok := decoded.Payload[flowcm.RuntimeKeyAuthorizedAuthorizationDetails]; ok {
+		claims.authorizedAuthorizationDetails = authorizationdetails.FromClaim(value)
+	}
+	return claims, decoded.AuthTime, nil
 }
 
 // createAuthorizationCode generates an authorization code based on the provided
@@ -676,7 +1161,8 @@ func createAuthorizationCode(
 
 	oauthConfig := config.GetServerRuntime().Config.OAuth
 	validityPeriod := oauthConfig.AuthorizationCode.ValidityPeriod
-	expiryTime := authTime.Add(time.Duration(validityPeriod) * time.Second)
+	// The code lifetime starts at issuance, which is later than authTime when issued from an SSO session.
+	expiryTime := time.Now().Add(time.Duration(validityPeriod) * time.Second)
 
 	codeID, err := utils.GenerateUUIDv7()
 	if err != nil {
@@ -689,29 +1175,30 @@ func createAuthorizationCode(
 	}
 
 	return AuthorizationCode{
-		CodeID:              codeID,
-		Code:                code,
-		ClientID:            clientID,
-		RedirectURI:         redirectURI,
-		AuthorizedUserID:    claims.userID,
-		AttributeCacheID:    claims.attributeCacheID,
-		TimeCreated:         authTime,
-		ExpiryTime:          expiryTime,
-		Scopes:              utils.StringifyStringArray(allScopes, " "),
-		State:               AuthCodeStateActive,
-		CodeChallenge:       authRequestCtx.OAuthParameters.CodeChallenge,
-		CodeChallengeMethod: authRequestCtx.OAuthParameters.CodeChallengeMethod,
-		Resources:           resources,
-		ClaimsRequest:       authRequestCtx.OAuthParameters.ClaimsRequest,
-		ClaimsLocales:       authRequestCtx.OAuthParameters.ClaimsLocales,
-		Nonce:               authRequestCtx.OAuthParameters.Nonce,
-		CompletedACR:        claims.completedACR,
+		CodeID:               codeID,
+		Code:                 code,
+		ClientID:             clientID,
+		RedirectURI:          redirectURI,
+		AuthorizedUserID:     claims.userID,
+		AttributeCacheID:     claims.attributeCacheID,
+		TimeCreated:          authTime,
+		ExpiryTime:           expiryTime,
+		Scopes:               utils.StringifyStringArray(allScopes, " "),
+		State:                AuthCodeStateActive,
+		CodeChallenge:        authRequestCtx.OAuthParameters.CodeChallenge,
+		CodeChallengeMethod:  authRequestCtx.OAuthParameters.CodeChallengeMethod,
+		Resources:            resources,
+		ClaimsRequest:        authRequestCtx.OAuthParameters.ClaimsRequest,
+		ClaimsLocales:        authRequestCtx.OAuthParameters.ClaimsLocales,
+		Nonce:                authRequestCtx.OAuthParameters.Nonce,
+		CompletedACR:         claims.completedACR,
+		AuthorizationDetails: authRequestCtx.OAuthParameters.AuthorizationDetails,
 	}, nil
 }
 
-// getRequiredAttributes determines the essential and optional user attributes required based on OIDC scopes,
+// GetRequiredAttributes determines the essential and optional user attributes required based on OIDC scopes,
 // claims parameter, response type, and app configuration.
-func getRequiredAttributes(oidcScopes []string, claimsRequest *oauth2model.ClaimsRequest, responseType string,
+func GetRequiredAttributes(oidcScopes []string, claimsRequest *oauth2model.ClaimsRequest, responseType string,
 	app *inboundmodel.OAuthClient) (essentialAttributes, optionalAttributes string) {
 	if app == nil {
 		return "", ""
@@ -864,7 +1351,7 @@ func resolveScopeAttributes(scope string, scopeAttributesMapping map[string][]st
 func appendAttributesForScope(scopeAttributes []string, responseType string,
 	idTokenAllowedSet, userInfoAllowedSet, optionalAttributes map[string]bool) {
 	for _, attribute := range scopeAttributes {
-		if responseType == string(oauth2const.ResponseTypeIDToken) {
+		if oauth2const.NormalizeResponseType(responseType) == oauth2const.ResponseTypeIDToken {
 			// If response type does not issue an access token, add claim to id token
 			if idTokenAllowedSet != nil && idTokenAllowedSet[attribute] {
 				optionalAttributes[attribute] = true
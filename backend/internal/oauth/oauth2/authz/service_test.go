@@ -20,9 +20,12 @@ package authz
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -33,13 +36,22 @@ import (
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
 	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
 	oauth2model "github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/requestobject"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
+	"github.com/asgardeo/thunder/internal/resource"
+	"github.com/asgardeo/thunder/internal/session"
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	"github.com/asgardeo/thunder/internal/system/jose/jwt"
 	"github.com/asgardeo/thunder/internal/system/log"
+	"github.com/asgardeo/thunder/tests/mocks/attributecachemock"
 	"github.com/asgardeo/thunder/tests/mocks/flow/flowexecmock"
 	"github.com/asgardeo/thunder/tests/mocks/inboundclientmock"
 	"github.com/asgardeo/thunder/tests/mocks/jose/jwtmock"
+	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/requestobjectmock"
+	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/tokenservicemock"
+	"github.com/asgardeo/thunder/tests/mocks/resourcemock"
+	"github.com/asgardeo/thunder/tests/mocks/sessionmock"
 )
 
 // stubTransactioner is a no-op Transactioner for use in service tests.
@@ -63,8 +75,14 @@ type AuthorizeServiceTestSuite struct {
 	mockJWTService      *jwtmock.JWTServiceInterfaceMock
 	mockAuthzCodeStore  *AuthorizationCodeStoreInterfaceMock
 	mockAuthReqStore    *authorizationRequestStoreInterfaceMock
+	mockAuthRespStore   *authorizationResponseStoreInterfaceMock
 	mockFlowExecService *flowexecmock.FlowExecServiceInterfaceMock
 	mockValidator       *AuthorizationValidatorInterfaceMock
+	mockSessionService  *sessionmock.SessionServiceInterfaceMock
+	mockRequestObject   *requestobjectmock.RequestObjectServiceInterfaceMock
+	mockTokenBuilder    *tokenservicemock.TokenBuilderInterfaceMock
+	mockTokenValidator  *tokenservicemock.TokenValidatorInterfaceMock
+	mockAttributeCache  *attributecachemock.AttributeCacheServiceInterfaceMock
 }
 
 func TestAuthorizeServiceTestSuite(t *testing.T) {
@@ -100,21 +118,33 @@ func (suite *AuthorizeServiceTestSuite) SetupTest() {
 	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
 	suite.mockAuthzCodeStore = NewAuthorizationCodeStoreInterfaceMock(suite.T())
 	suite.mockAuthReqStore = newAuthorizationRequestStoreInterfaceMock(suite.T())
+	suite.mockAuthRespStore = newAuthorizationResponseStoreInterfaceMock(suite.T())
 	suite.mockFlowExecService = flowexecmock.NewFlowExecServiceInterfaceMock(suite.T())
 	suite.mockValidator = NewAuthorizationValidatorInterfaceMock(suite.T())
+	suite.mockSessionService = sessionmock.NewSessionServiceInterfaceMock(suite.T())
+	suite.mockRequestObject = requestobjectmock.NewRequestObjectServiceInterfaceMock(suite.T())
+	suite.mockTokenBuilder = tokenservicemock.NewTokenBuilderInterfaceMock(suite.T())
+	suite.mockTokenValidator = tokenservicemock.NewTokenValidatorInterfaceMock(suite.T())
+	suite.mockAttributeCache = attributecachemock.NewAttributeCacheServiceInterfaceMock(suite.T())
 }
 
 // newService builds an authorizeService with all mocked dependencies.
 func (suite *AuthorizeServiceTestSuite) newService() *authorizeService {
 	return &authorizeService{
-		inboundClient:   suite.mockInboundClient,
-		authZValidator:  suite.mockValidator,
-		authCodeStore:   suite.mockAuthzCodeStore,
-		authReqStore:    suite.mockAuthReqStore,
-		jwtService:      suite.mockJWTService,
-		flowExecService: suite.mockFlowExecService,
-		transactioner:   &stubTransactioner{},
-		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeServiceTest")),
+		inboundClient:        suite.mockInboundClient,
+		authZValidator:       suite.mockValidator,
+		authCodeStore:        suite.mockAuthzCodeStore,
+		authReqStore:         suite.mockAuthReqStore,
+		authRespStore:        suite.mockAuthRespStore,
+		jwtService:           suite.mockJWTService,
+		tokenBuilder:         suite.mockTokenBuilder,
+		tokenValidator:       suite.mockTokenValidator,
+		attributeCache:       suite.mockAttributeCache,
+		flowExecService:      suite.mockFlowExecService,
+		sessionService:       suite.mockSessionService,
+		requestObjectService: suite.mockRequestObject,
+		transactioner:        &stubTransactioner{},
+		logger:               log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeServiceTest")),
 	}
 }
 
@@ -278,6 +308,89 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Su
 	assert.Equal(suite.T(), "test-flow-id", result.QueryParams[oauth2const.ExecutionID])
 }
 
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_RequestObject() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	signedParams := suite.testMsg().RequestQueryParams
+	signedParams["state"] = "signed-state"
+	suite.mockRequestObject.EXPECT().ResolveRequestObject(mock.Anything, "signed-request", "", app).
+		Return(&requestobject.RequestObject{Params: signedParams}, "", "")
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.MatchedBy(func(msg *OAuthMessage) bool {
+		return msg.RequestQueryParams["state"] == "signed-state" && msg.RequestQueryParams["request"] == ""
+	}), app).Return(false, "", "")
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.MatchedBy(func(ctx authRequestContext) bool {
+		return ctx.OAuthParameters.State == "signed-state"
+	})).Return(testAuthID, nil)
+
+	msg := &OAuthMessage{
+		RequestType: oauth2const.TypeInitialAuthorizationRequest,
+		RequestQueryParams: map[string]string{
+			"client_id": "test-client-id",
+			"request":   "signed-request",
+			"state":     "unsigned-state",
+		},
+	}
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), testAuthID, result.QueryParams[oauth2const.AuthID])
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_InvalidRequestObject() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockRequestObject.EXPECT().ResolveRequestObject(mock.Anything, "", "https://client.example.com/ro", app).
+		Return(nil, oauth2const.ErrorInvalidRequestObject, "The request object signature is invalid")
+
+	msg := suite.testMsg()
+	msg.RequestQueryParams["request_uri"] = "https://client.example.com/ro"
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequestObject, authErr.Code)
+	// The redirect_uri is not trusted until the request object is verified.
+	assert.False(suite.T(), authErr.SendErrorToClient)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_SignedRequestObjectRequired() {
+	app := suite.testApp()
+	app.RequireSignedRequestObject = true
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), suite.testMsg())
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
+	assert.Contains(suite.T(), authErr.Message, "signed request object is required")
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_RequestWithPushedRequestURI() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+
+	msg := &OAuthMessage{
+		RequestType: oauth2const.TypeInitialAuthorizationRequest,
+		RequestQueryParams: map[string]string{
+			"client_id":   "test-client-id",
+			"request":     "signed-request",
+			"request_uri": "urn:ietf:params:oauth:request_uri:abc",
+		},
+	}
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
+}
+
 func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_InsecureRedirectURI() {
 	app := suite.testApp()
 	app.RedirectURIs = []string{"http://client.example.com/callback"}
@@ -410,9 +523,9 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_InvalidA
 	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, "invalid-key").Return(false, authRequestContext{}, nil)
 
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), "invalid-key", "test-assertion")
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), "invalid-key", "test-assertion", "")
 
-	assert.Empty(suite.T(), redirectURI)
+	assert.Nil(suite.T(), result)
 	assert.NotNil(suite.T(), authErr)
 	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
 }
@@ -422,9 +535,9 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_StoreErr
 		Return(false, authRequestContext{}, errors.New("db connection error"))
 
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), "db-fail-key", "test-assertion")
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), "db-fail-key", "test-assertion", "")
 
-	assert.Empty(suite.T(), redirectURI)
+	assert.Nil(suite.T(), result)
 	assert.NotNil(suite.T(), authErr)
 	assert.Equal(suite.T(), oauth2const.ErrorServerError, authErr.Code)
 }
@@ -441,9 +554,9 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_MissingA
 	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
 
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, "")
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, "", "")
 
-	assert.Empty(suite.T(), redirectURI)
+	assert.Nil(suite.T(), result)
 	assert.NotNil(suite.T(), authErr)
 	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
 	assert.Equal(suite.T(), "test-state", authErr.State)
@@ -464,9 +577,9 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_InvalidA
 	suite.mockJWTService.EXPECT().VerifyJWT("invalid-assertion", "", "").Return(&jwt.ErrorInvalidTokenSignature)
 
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, "invalid-assertion")
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, "invalid-assertion", "")
 
-	assert.Empty(suite.T(), redirectURI)
+	assert.Nil(suite.T(), result)
 	assert.NotNil(suite.T(), authErr)
 	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
 	assert.Equal(suite.T(), "test-state", authErr.State)
@@ -488,9 +601,9 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_FailedTo
 	suite.mockJWTService.EXPECT().VerifyJWT("not.valid.jwt", "", "").Return(nil)
 
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, "not.valid.jwt")
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, "not.valid.jwt", "")
 
-	assert.Empty(suite.T(), redirectURI)
+	assert.Nil(suite.T(), result)
 	assert.NotNil(suite.T(), authErr)
 	assert.Equal(suite.T(), oauth2const.ErrorServerError, authErr.Code)
 	assert.Equal(suite.T(), "Failed to process authorization request", authErr.Message)
@@ -502,9 +615,10 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_FailedTo
 func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_PersistAuthCodeError() {
 	authCtx := authRequestContext{
 		OAuthParameters: oauth2model.OAuthParameters{
-			ClientID:    "test-client",
-			RedirectURI: "https://client.example.com/callback",
-			State:       "test-state",
+			ResponseType: "code",
+			ClientID:     "test-client",
+			RedirectURI:  "https://client.example.com/callback",
+			State:        "test-state",
 		},
 	}
 	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
@@ -513,11 +627,15 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_PersistA
 	suite.mockAuthzCodeStore.EXPECT().
 		InsertAuthorizationCode(mock.Anything, mock.Anything).
 		Return(errors.New("db error"))
+	suite.mockSessionService.EXPECT().CreateSession(mock.Anything, "test-user", mock.Anything, mock.Anything).
+		Return(&session.Session{ID: "test-session-id", UserID: "test-user"}, nil)
+	suite.mockSessionService.EXPECT().AddClientToSession(mock.Anything, "test-session-id", "test-client").
+		Return(nil)
 
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat, "")
 
-	assert.Empty(suite.T(), redirectURI)
+	assert.Nil(suite.T(), result)
 	assert.NotNil(suite.T(), authErr)
 	assert.Equal(suite.T(), oauth2const.ErrorServerError, authErr.Code)
 	assert.Equal(suite.T(), "test-state", authErr.State)
@@ -528,8 +646,9 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_PersistA
 func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_Success() {
 	authCtx := authRequestContext{
 		OAuthParameters: oauth2model.OAuthParameters{
-			ClientID:    "test-client",
-			RedirectURI: "https://client.example.com/callback",
+			ResponseType: "code",
+			ClientID:     "test-client",
+			RedirectURI:  "https://client.example.com/callback",
 		},
 	}
 	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
@@ -537,22 +656,90 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_Success(
 	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(nil)
 	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
 
+	suite.mockSessionService.EXPECT().CreateSession(mock.Anything, "test-user", mock.Anything, mock.Anything).
+		Return(&session.Session{ID: "test-session-id", UserID: "test-user"}, nil)
+	suite.mockSessionService.EXPECT().AddClientToSession(mock.Anything, "test-session-id", "test-client").
+		Return(nil)
+
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat, "")
 
 	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result.Session)
+	redirectURI := result.RedirectURI
 	assert.Contains(suite.T(), redirectURI, "https://client.example.com/callback")
 	assert.Contains(suite.T(), redirectURI, "code=")
 	assert.Contains(suite.T(), redirectURI, "iss=https%3A%2F%2Flocalhost%3A8090")
 	assert.NotContains(suite.T(), redirectURI, "state=")
 }
 
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_ReusesSessionOfSameUser() {
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ResponseType: "code",
+			ClientID:     "test-client",
+			RedirectURI:  "https://client.example.com/callback",
+		},
+	}
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.MatchedBy(
+		func(code AuthorizationCode) bool { return code.SessionSID == "test-sid" })).Return(nil)
+
+	currentSession := &session.Session{ID: "current-session-id", SID: "test-sid", UserID: "test-user"}
+	suite.mockSessionService.EXPECT().GetSession(mock.Anything, "current-session-id").Return(currentSession, nil)
+	suite.mockSessionService.EXPECT().ReauthenticateSession(
+		mock.Anything, "current-session-id", mock.Anything, mock.Anything).Return(currentSession, nil)
+	suite.mockSessionService.EXPECT().AddClientToSession(mock.Anything, "current-session-id", "test-client").
+		Return(nil)
+
+	svc := suite.newService()
+	result, authErr := svc.HandleAuthorizationCallback(
+		context.Background(), testAuthID, svcJWTWithIat, "current-session-id")
+
+	assert.Nil(suite.T(), authErr)
+	assert.Equal(suite.T(), "current-session-id", result.Session.ID)
+	suite.mockSessionService.AssertNotCalled(suite.T(), "CreateSession",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_RotatesSessionOfAnotherUser() {
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ResponseType: "code",
+			ClientID:     "test-client",
+			RedirectURI:  "https://client.example.com/callback",
+		},
+	}
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
+
+	suite.mockSessionService.EXPECT().GetSession(mock.Anything, "current-session-id").
+		Return(&session.Session{ID: "current-session-id", UserID: "another-user"}, nil)
+	suite.mockSessionService.EXPECT().TerminateSession(mock.Anything, "current-session-id").Return(nil)
+	suite.mockSessionService.EXPECT().CreateSession(mock.Anything, "test-user", mock.Anything, mock.Anything).
+		Return(&session.Session{ID: "test-session-id", UserID: "test-user"}, nil)
+	suite.mockSessionService.EXPECT().AddClientToSession(mock.Anything, "test-session-id", "test-client").
+		Return(nil)
+
+	svc := suite.newService()
+	result, authErr := svc.HandleAuthorizationCallback(
+		context.Background(), testAuthID, svcJWTWithIat, "current-session-id")
+
+	assert.Nil(suite.T(), authErr)
+	assert.Equal(suite.T(), "test-session-id", result.Session.ID)
+}
+
 func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_WithState() {
 	authCtx := authRequestContext{
 		OAuthParameters: oauth2model.OAuthParameters{
-			ClientID:    "test-client",
-			RedirectURI: "https://client.example.com/callback",
-			State:       "test-state-123",
+			ResponseType: "code",
+			ClientID:     "test-client",
+			RedirectURI:  "https://client.example.com/callback",
+			State:        "test-state-123",
 		},
 	}
 	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
@@ -560,10 +747,17 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_WithStat
 	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(nil)
 	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
 
+	suite.mockSessionService.EXPECT().CreateSession(mock.Anything, "test-user", mock.Anything, mock.Anything).
+		Return(&session.Session{ID: "test-session-id", UserID: "test-user"}, nil)
+	suite.mockSessionService.EXPECT().AddClientToSession(mock.Anything, "test-session-id", "test-client").
+		Return(nil)
+
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat, "")
 
 	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result.Session)
+	redirectURI := result.RedirectURI
 	assert.Contains(suite.T(), redirectURI, "code=")
 	assert.Contains(suite.T(), redirectURI, "state=test-state-123")
 	assert.Contains(suite.T(), redirectURI, "iss=https%3A%2F%2Flocalhost%3A8090")
@@ -574,6 +768,7 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_EmptyAut
 	// Permission scopes in the auth context should be cleared.
 	authCtx := authRequestContext{
 		OAuthParameters: oauth2model.OAuthParameters{
+			ResponseType:     "code",
 			ClientID:         "test-client",
 			RedirectURI:      "https://client.example.com/callback",
 			PermissionScopes: []string{"read", "write"},
@@ -584,10 +779,17 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_EmptyAut
 	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(nil)
 	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
 
+	suite.mockSessionService.EXPECT().CreateSession(mock.Anything, "test-user", mock.Anything, mock.Anything).
+		Return(&session.Session{ID: "test-session-id", UserID: "test-user"}, nil)
+	suite.mockSessionService.EXPECT().AddClientToSession(mock.Anything, "test-session-id", "test-client").
+		Return(nil)
+
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat, "")
 
 	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result.Session)
+	redirectURI := result.RedirectURI
 	assert.NotEmpty(suite.T(), redirectURI)
 }
 
@@ -604,9 +806,9 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_CreateAu
 	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTMinimal, "", "").Return(nil)
 
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTMinimal)
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTMinimal, "")
 
-	assert.Empty(suite.T(), redirectURI)
+	assert.Nil(suite.T(), result)
 	assert.NotNil(suite.T(), authErr)
 	assert.Equal(suite.T(), oauth2const.ErrorServerError, authErr.Code)
 }
@@ -1142,7 +1344,7 @@ func (suite *AuthorizeServiceTestSuite) TestDetermineClaimsForTokens_EmptyAllowe
 }
 
 func (suite *AuthorizeServiceTestSuite) TestGetRequiredAttributes_NilApp() {
-	essential, optional := getRequiredAttributes(
+	essential, optional := GetRequiredAttributes(
 		[]string{"openid", "profile"},
 		nil,
 		string(oauth2const.ResponseTypeCode),
@@ -1160,7 +1362,7 @@ func (suite *AuthorizeServiceTestSuite) TestGetRequiredAttributes_NilTokenConfig
 		Token:    nil,
 	}
 
-	essential, optional := getRequiredAttributes(
+	essential, optional := GetRequiredAttributes(
 		[]string{"openid", "profile"},
 		nil,
 		string(oauth2const.ResponseTypeCode),
@@ -1182,7 +1384,7 @@ func (suite *AuthorizeServiceTestSuite) TestGetRequiredAttributes_AccessTokenOnl
 		},
 	}
 
-	essential, optional := getRequiredAttributes(
+	essential, optional := GetRequiredAttributes(
 		[]string{},
 		nil,
 		string(oauth2const.ResponseTypeCode),
@@ -1215,7 +1417,7 @@ func (suite *AuthorizeServiceTestSuite) TestGetRequiredAttributes_CodeFlowWithSc
 		},
 	}
 
-	essential, optional := getRequiredAttributes(
+	essential, optional := GetRequiredAttributes(
 		[]string{"openid", "email"},
 		nil,
 		string(oauth2const.ResponseTypeCode),
@@ -1244,7 +1446,7 @@ func (suite *AuthorizeServiceTestSuite) TestGetRequiredAttributes_ImplicitFlowWi
 		},
 	}
 
-	essential, optional := getRequiredAttributes(
+	essential, optional := GetRequiredAttributes(
 		[]string{"openid", "email"},
 		nil,
 		string(oauth2const.ResponseTypeIDToken),
@@ -1287,7 +1489,7 @@ func (suite *AuthorizeServiceTestSuite) TestGetRequiredAttributes_WithClaimsPara
 		},
 	}
 
-	essential, optional := getRequiredAttributes(
+	essential, optional := GetRequiredAttributes(
 		[]string{"openid"},
 		claimsRequest,
 		string(oauth2const.ResponseTypeCode),
@@ -1332,7 +1534,7 @@ func (suite *AuthorizeServiceTestSuite) TestGetRequiredAttributes_ClaimsParamete
 		},
 	}
 
-	essential, optional := getRequiredAttributes(
+	essential, optional := GetRequiredAttributes(
 		[]string{"openid"},
 		claimsRequest,
 		string(oauth2const.ResponseTypeCode),
@@ -1372,7 +1574,7 @@ func (suite *AuthorizeServiceTestSuite) TestGetRequiredAttributes_DeduplicatesCl
 		},
 	}
 
-	essential, optional := getRequiredAttributes(
+	essential, optional := GetRequiredAttributes(
 		[]string{"openid"},
 		claimsRequest,
 		string(oauth2const.ResponseTypeCode),
@@ -1401,7 +1603,7 @@ func (suite *AuthorizeServiceTestSuite) TestGetRequiredAttributes_CustomScopeMap
 		},
 	}
 
-	essential, optional := getRequiredAttributes(
+	essential, optional := GetRequiredAttributes(
 		[]string{"openid", "organization"},
 		nil,
 		string(oauth2const.ResponseTypeCode),
@@ -1446,7 +1648,7 @@ func (suite *AuthorizeServiceTestSuite) TestGetRequiredAttributes_ComplexScenari
 		},
 	}
 
-	essential, optional := getRequiredAttributes(
+	essential, optional := GetRequiredAttributes(
 		[]string{"openid", "custom"},
 		claimsRequest,
 		string(oauth2const.ResponseTypeCode),
@@ -1484,7 +1686,7 @@ func (suite *AuthorizeServiceTestSuite) TestGetRequiredAttributes_NoOpenIDScope(
 		},
 	}
 
-	essential, optional := getRequiredAttributes(
+	essential, optional := GetRequiredAttributes(
 		[]string{"profile"}, // OIDC scope but no openid
 		nil,
 		string(oauth2const.ResponseTypeCode),
@@ -1902,3 +2104,723 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Ac
 	assert.Nil(suite.T(), authErr)
 	assert.NotNil(suite.T(), result)
 }
+
+// ssoMsg returns an initial authorization request carrying an SSO session ID.
+func (suite *AuthorizeServiceTestSuite) ssoMsg(prompt string) *OAuthMessage {
+	msg := &OAuthMessage{
+		RequestType: oauth2const.TypeInitialAuthorizationRequest,
+		RequestQueryParams: map[string]string{
+			"client_id":     "test-client-id",
+			"redirect_uri":  "https://client.example.com/callback",
+			"response_type": "code",
+			"state":         "test-state",
+		},
+		SessionID: "test-session-id",
+	}
+	if prompt != "" {
+		msg.RequestQueryParams["prompt"] = prompt
+	}
+	return msg
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_AuthorizedFromSession() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	suite.mockSessionService.EXPECT().GetSession(mock.Anything, "test-session-id").
+		Return(&session.Session{ID: "test-session-id", UserID: "test-user"}, nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything,
+		mock.MatchedBy(func(code AuthorizationCode) bool {
+			return code.AuthorizedUserID == "test-user" && code.ClientID == "test-client-id"
+		})).Return(nil)
+	suite.mockSessionService.EXPECT().AddClientToSession(mock.Anything, "test-session-id", "test-client-id").
+		Return(nil)
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), suite.ssoMsg(""))
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+	assert.Contains(suite.T(), result.RedirectURI, "https://client.example.com/callback")
+	assert.Contains(suite.T(), result.RedirectURI, "code=")
+	assert.Contains(suite.T(), result.RedirectURI, "state=test-state")
+	suite.mockFlowExecService.AssertNotCalled(suite.T(), "InitiateFlow", mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_PromptLoginSkipsSession() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), suite.ssoMsg("login"))
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+	assert.Empty(suite.T(), result.RedirectURI)
+	assert.Equal(suite.T(), "test-flow-id", result.QueryParams[oauth2const.ExecutionID])
+	suite.mockSessionService.AssertNotCalled(suite.T(), "GetSession", mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_SessionNotFoundInitiatesFlow() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	suite.mockSessionService.EXPECT().GetSession(mock.Anything, "test-session-id").
+		Return(nil, &session.ErrorSessionNotFound)
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), suite.ssoMsg(""))
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), "test-flow-id", result.QueryParams[oauth2const.ExecutionID])
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_PromptNoneWithoutSession() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	suite.mockSessionService.EXPECT().GetSession(mock.Anything, "test-session-id").
+		Return(nil, &session.ErrorSessionNotFound)
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), suite.ssoMsg("none"))
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorLoginRequired, authErr.Code)
+	assert.True(suite.T(), authErr.SendErrorToClient)
+	assert.Equal(suite.T(), "https://client.example.com/callback", authErr.ClientRedirectURI)
+	assert.Equal(suite.T(), "test-state", authErr.State)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_PromptNoneRequiresInteraction() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	suite.mockSessionService.EXPECT().GetSession(mock.Anything, "test-session-id").
+		Return(&session.Session{ID: "test-session-id", UserID: "test-user"}, nil)
+
+	msg := suite.ssoMsg("none")
+	msg.RequestQueryParams["scope"] = "read write"
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInteractionRequired, authErr.Code)
+	assert.True(suite.T(), authErr.SendErrorToClient)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_SessionACRNotSatisfied() {
+	app := suite.testApp()
+	app.AcrValues = []string{"acr-basic", "acr-strong"}
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	suite.mockSessionService.EXPECT().GetSession(mock.Anything, "test-session-id").
+		Return(&session.Session{ID: "test-session-id", UserID: "test-user", ACR: "acr-basic"}, nil)
+
+	msg := suite.ssoMsg("none")
+	msg.RequestQueryParams["acr_values"] = "acr-strong"
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorLoginRequired, authErr.Code)
+}
+
+// mockIDTokenHint registers the given ID token hint as a valid hint issued to the given subject.
+func (suite *AuthorizeServiceTestSuite) mockIDTokenHint(idTokenHint string, subject string) {
+	suite.mockTokenValidator.EXPECT().ValidateIDTokenHint(mock.Anything, idTokenHint).
+		Return(&tokenservice.IDTokenHintClaims{Sub: subject, ClientID: "test-client-id"}, nil)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_MaxAgeSatisfiedBySession() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	authTime := time.Now().Add(-time.Minute)
+	suite.mockSessionService.EXPECT().GetSession(mock.Anything, "test-session-id").
+		Return(&session.Session{ID: "test-session-id", UserID: "test-user", AuthTime: authTime}, nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything,
+		mock.MatchedBy(func(code AuthorizationCode) bool {
+			return code.TimeCreated.Equal(authTime)
+		})).Return(nil)
+	suite.mockSessionService.EXPECT().AddClientToSession(mock.Anything, "test-session-id", "test-client-id").
+		Return(nil)
+
+	msg := suite.ssoMsg("")
+	msg.RequestQueryParams[oauth2const.RequestParamMaxAge] = "3600"
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.Contains(suite.T(), result.RedirectURI, "code=")
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_MaxAgeExceededInitiatesFlow() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	suite.mockSessionService.EXPECT().GetSession(mock.Anything, "test-session-id").
+		Return(&session.Session{
+			ID: "test-session-id", UserID: "test-user", AuthTime: time.Now().Add(-2 * time.Hour),
+		}, nil)
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	msg := suite.ssoMsg("")
+	msg.RequestQueryParams[oauth2const.RequestParamMaxAge] = "3600"
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.Empty(suite.T(), result.RedirectURI)
+	assert.Equal(suite.T(), "test-flow-id", result.QueryParams[oauth2const.ExecutionID])
+	suite.mockAuthzCodeStore.AssertNotCalled(suite.T(), "InsertAuthorizationCode", mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_MaxAgeExceededWithPromptNone() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	suite.mockSessionService.EXPECT().GetSession(mock.Anything, "test-session-id").
+		Return(&session.Session{ID: "test-session-id", UserID: "test-user", AuthTime: time.Now()}, nil)
+
+	msg := suite.ssoMsg("none")
+	msg.RequestQueryParams[oauth2const.RequestParamMaxAge] = "0"
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorLoginRequired, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_InvalidIDTokenHint() {
+	app := suite.testApp()
+	idTokenHint := "other.issuer.hint"
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	suite.mockTokenValidator.EXPECT().ValidateIDTokenHint(mock.Anything, idTokenHint).
+		Return(nil, errors.New("id_token_hint was not issued by this server"))
+
+	msg := suite.ssoMsg("")
+	msg.RequestQueryParams[oauth2const.RequestParamIDTokenHint] = idTokenHint
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
+	assert.Equal(suite.T(), "Invalid id_token_hint", authErr.Message)
+	assert.True(suite.T(), authErr.SendErrorToClient)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_IDTokenHintSignatureInvalid() {
+	app := suite.testApp()
+	idTokenHint := "invalid.signature.hint"
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	suite.mockTokenValidator.EXPECT().ValidateIDTokenHint(mock.Anything, idTokenHint).
+		Return(nil, errors.New("id_token_hint signature verification failed"))
+
+	msg := suite.ssoMsg("")
+	msg.RequestQueryParams[oauth2const.RequestParamIDTokenHint] = idTokenHint
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
+	suite.mockSessionService.AssertNotCalled(suite.T(), "GetSession", mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_IDTokenHintOfAnotherUser() {
+	app := suite.testApp()
+	idTokenHint := "other.user.hint"
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	suite.mockIDTokenHint(idTokenHint, "other-user")
+	suite.mockSessionService.EXPECT().GetSession(mock.Anything, "test-session-id").
+		Return(&session.Session{ID: "test-session-id", UserID: "test-user"}, nil)
+
+	msg := suite.ssoMsg("none")
+	msg.RequestQueryParams[oauth2const.RequestParamIDTokenHint] = idTokenHint
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorLoginRequired, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_IDTokenHintOfSessionUser() {
+	app := suite.testApp()
+	idTokenHint := "session.user.hint"
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	suite.mockIDTokenHint(idTokenHint, "test-user")
+	suite.mockSessionService.EXPECT().GetSession(mock.Anything, "test-session-id").
+		Return(&session.Session{ID: "test-session-id", UserID: "test-user"}, nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
+	suite.mockSessionService.EXPECT().AddClientToSession(mock.Anything, "test-session-id", "test-client-id").
+		Return(nil)
+
+	msg := suite.ssoMsg("none")
+	msg.RequestQueryParams[oauth2const.RequestParamIDTokenHint] = idTokenHint
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.Contains(suite.T(), result.RedirectURI, "code=")
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_LoginHintPassedToFlow() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything,
+		mock.MatchedBy(func(initContext *flowexec.FlowInitContext) bool {
+			return initContext.RuntimeData[flowcm.RuntimeKeyLoginHint] == "alice@example.com"
+		})).Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	msg := suite.testMsg()
+	msg.RequestQueryParams[oauth2const.RequestParamLoginHint] = "alice@example.com"
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_AcrFromClaimsParameter() {
+	app := suite.testApp()
+	app.AcrValues = []string{"acr-basic", "acr-strong"}
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything,
+		mock.MatchedBy(func(initContext *flowexec.FlowInitContext) bool {
+			return initContext.RuntimeData[flowcm.RuntimeKeyRequestedAuthClasses] == "acr-strong"
+		})).Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	msg := suite.testMsg()
+	msg.RequestQueryParams[oauth2const.RequestParamClaims] =
+		`{"id_token":{"acr":{"essential":true,"values":["acr-strong"]}}}`
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_IDTokenHintUserMismatch() {
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ClientID:    "test-client",
+			RedirectURI: "https://client.example.com/callback",
+			State:       "test-state",
+			IDTokenHint: "other.user.hint",
+		},
+	}
+	suite.mockIDTokenHint("other.user.hint", "other-user")
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(nil)
+
+	svc := suite.newService()
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat, "")
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorLoginRequired, authErr.Code)
+	assert.True(suite.T(), authErr.SendErrorToClient)
+	assert.Equal(suite.T(), "test-state", authErr.State)
+	suite.mockAuthzCodeStore.AssertNotCalled(suite.T(), "InsertAuthorizationCode", mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_AuthorizationDetails() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	mockResourceService := resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	mockResourceService.On("ValidateAuthorizationDetail", mock.Anything, mock.Anything).
+		Return(&resource.ResourceServer{Identifier: "https://payments.example.com"}, nil).Once()
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything,
+		mock.MatchedBy(func(initContext *flowexec.FlowInitContext) bool {
+			return initContext.RuntimeData[flowcm.RuntimeKeyRequestedAuthorizationDetails] ==
+				`[{"amount":"50.00","type":"payment_initiation"}]`
+		})).Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything,
+		mock.MatchedBy(func(authRequestCtx authRequestContext) bool {
+			return len(authRequestCtx.OAuthParameters.AuthorizationDetails) == 1
+		})).Return(testAuthID, nil)
+
+	msg := suite.testMsg()
+	msg.RequestQueryParams[oauth2const.RequestParamAuthorizationDetails] =
+		`[{"type":"payment_initiation","amount":"50.00"}]`
+
+	svc := suite.newService()
+	svc.resourceService = mockResourceService
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_InvalidAuthorizationDetails() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	mockResourceService := resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	mockResourceService.On("ValidateAuthorizationDetail", mock.Anything, mock.Anything).
+		Return(nil, &resource.ErrorAuthorizationDetailTypeNotFound).Once()
+
+	msg := suite.testMsg()
+	msg.RequestQueryParams[oauth2const.RequestParamAuthorizationDetails] = `[{"type":"unknown_type"}]`
+
+	svc := suite.newService()
+	svc.resourceService = mockResourceService
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidAuthorizationDetails, authErr.Code)
+	assert.True(suite.T(), authErr.SendErrorToClient)
+	assert.Equal(suite.T(), "test-state", authErr.State)
+	suite.mockFlowExecService.AssertNotCalled(suite.T(), "InitiateFlow", mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_AuthorizedAuthorizationDetails() {
+	payload, _ := json.Marshal(map[string]interface{}{
+		"sub": "test-user",
+		"iat": 1701421200,
+		flowcm.RuntimeKeyAuthorizedAuthorizationDetails: []interface{}{
+			map[string]interface{}{"type": "payment_initiation", "amount": "50.00"},
+		},
+	})
+	assertion := "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." + base64.RawURLEncoding.EncodeToString(payload) + "."
+
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ResponseType: "code",
+			ClientID:     "test-client",
+			RedirectURI:  "https://client.example.com/callback",
+			AuthorizationDetails: []oauth2model.AuthorizationDetail{
+				{"type": "payment_initiation", "amount": "50.00"},
+				{"type": "account_information"},
+			},
+		},
+	}
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(assertion, "", "").Return(nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything,
+		mock.MatchedBy(func(code AuthorizationCode) bool {
+			return len(code.AuthorizationDetails) == 1 &&
+				code.AuthorizationDetails[0]["type"] == "payment_initiation"
+		})).Return(nil)
+	suite.mockSessionService.EXPECT().CreateSession(mock.Anything, "test-user", mock.Anything, mock.Anything).
+		Return(&session.Session{ID: "test-session-id", UserID: "test-user"}, nil)
+	suite.mockSessionService.EXPECT().AddClientToSession(mock.Anything, "test-session-id", "test-client").
+		Return(nil)
+
+	svc := suite.newService()
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, assertion, "")
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_IDTokenFromSession() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	suite.mockSessionService.EXPECT().GetSession(mock.Anything, "test-session-id").
+		Return(&session.Session{ID: "test-session-id", UserID: "test-user"}, nil)
+	suite.mockTokenBuilder.EXPECT().BuildIDToken(mock.MatchedBy(func(ctx *tokenservice.IDTokenBuildContext) bool {
+		return ctx.Subject == "test-user" && ctx.Audience == "test-client-id" && ctx.Nonce == "test-nonce" &&
+			ctx.AuthorizationCode == "" && ctx.AccessToken == ""
+	})).Return(&oauth2model.TokenDTO{Token: "test-id-token"}, nil)
+	suite.mockSessionService.EXPECT().AddClientToSession(mock.Anything, "test-session-id", "test-client-id").
+		Return(nil)
+
+	msg := suite.ssoMsg("")
+	msg.RequestQueryParams["response_type"] = "id_token"
+	msg.RequestQueryParams["scope"] = "openid"
+	msg.RequestQueryParams["nonce"] = "test-nonce"
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+	assert.True(suite.T(), strings.HasPrefix(result.RedirectURI, "https://client.example.com/callback#"))
+	assert.Contains(suite.T(), result.RedirectURI, "id_token=test-id-token")
+	assert.Contains(suite.T(), result.RedirectURI, "state=test-state")
+	assert.NotContains(suite.T(), result.RedirectURI, "code=")
+	suite.mockAuthzCodeStore.AssertNotCalled(suite.T(), "InsertAuthorizationCode", mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_FormPostFromSession() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).Return(false, "", "")
+	suite.mockSessionService.EXPECT().GetSession(mock.Anything, "test-session-id").
+		Return(&session.Session{ID: "test-session-id", UserID: "test-user"}, nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
+	suite.mockSessionService.EXPECT().AddClientToSession(mock.Anything, "test-session-id", "test-client-id").
+		Return(nil)
+
+	msg := suite.ssoMsg("")
+	msg.RequestQueryParams["response_mode"] = "form_post"
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+	assert.Empty(suite.T(), result.RedirectURI)
+	assert.NotNil(suite.T(), result.FormPost)
+	assert.Equal(suite.T(), "https://client.example.com/callback", result.FormPost.RedirectURI)
+	assert.NotEmpty(suite.T(), result.FormPost.Params["code"])
+	assert.Equal(suite.T(), "test-state", result.FormPost.Params["state"])
+	assert.Equal(suite.T(), "https://localhost:8090", result.FormPost.Params["iss"])
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_ErrorUsesResponseMode() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(true, oauth2const.ErrorInvalidRequest, "nonce is required for the response type")
+
+	msg := suite.testMsg()
+	msg.RequestQueryParams["response_type"] = "code id_token"
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.True(suite.T(), authErr.SendErrorToClient)
+	assert.Equal(suite.T(), oauth2const.ResponseModeFragment, authErr.ResponseMode)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_HybridResponseType() {
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ResponseType:   "code id_token token",
+			ClientID:       "test-client",
+			RedirectURI:    "https://client.example.com/callback",
+			State:          "test-state",
+			StandardScopes: []string{"openid"},
+			Nonce:          "test-nonce",
+		},
+	}
+	app := &inboundmodel.OAuthClient{ClientID: "test-client"}
+	mockResourceService := resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	mockResourceService.EXPECT().FindResourceServersByPermissions(mock.Anything, []string{"openid"}).
+		Return([]resource.ResourceServer{}, nil)
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client").Return(app, nil)
+	suite.mockTokenBuilder.EXPECT().BuildAccessToken(
+		mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return ctx.Subject == "test-user" && ctx.ClientID == "test-client" &&
+				ctx.GrantType == string(oauth2const.GrantTypeAuthorizationCode)
+		})).Return(&oauth2model.TokenDTO{Token: "test-access-token", TokenType: "Bearer", ExpiresIn: 3600}, nil)
+	suite.mockTokenBuilder.EXPECT().BuildIDToken(mock.MatchedBy(func(ctx *tokenservice.IDTokenBuildContext) bool {
+		return ctx.Nonce == "test-nonce" && ctx.AuthorizationCode != "" && ctx.AccessToken == "test-access-token"
+	})).Return(&oauth2model.TokenDTO{Token: "test-id-token"}, nil)
+	suite.mockSessionService.EXPECT().CreateSession(mock.Anything, "test-user", mock.Anything, mock.Anything).
+		Return(&session.Session{ID: "test-session-id", UserID: "test-user"}, nil)
+	suite.mockSessionService.EXPECT().AddClientToSession(mock.Anything, "test-session-id", "test-client").
+		Return(nil)
+
+	svc := suite.newService()
+	svc.resourceService = mockResourceService
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat, "")
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+	assert.True(suite.T(), strings.HasPrefix(result.RedirectURI, "https://client.example.com/callback#"))
+	assert.Contains(suite.T(), result.RedirectURI, "code=")
+	assert.Contains(suite.T(), result.RedirectURI, "id_token=test-id-token")
+	assert.Contains(suite.T(), result.RedirectURI, "access_token=test-access-token")
+	assert.Contains(suite.T(), result.RedirectURI, "token_type=Bearer")
+	assert.Contains(suite.T(), result.RedirectURI, "expires_in=3600")
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_IDTokenError() {
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ResponseType:   "id_token",
+			ClientID:       "test-client",
+			RedirectURI:    "https://client.example.com/callback",
+			StandardScopes: []string{"openid"},
+			Nonce:          "test-nonce",
+		},
+	}
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(nil)
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client").
+		Return(&inboundmodel.OAuthClient{ClientID: "test-client"}, nil)
+	suite.mockSessionService.EXPECT().CreateSession(mock.Anything, "test-user", mock.Anything, mock.Anything).
+		Return(&session.Session{ID: "test-session-id", SID: "test-sid", UserID: "test-user"}, nil)
+	suite.mockSessionService.EXPECT().AddClientToSession(mock.Anything, "test-session-id", "test-client").
+		Return(nil)
+	suite.mockTokenBuilder.EXPECT().BuildIDToken(mock.MatchedBy(func(ctx *tokenservice.IDTokenBuildContext) bool {
+		return ctx.SID == "test-sid"
+	})).Return(nil, errors.New("signing failed"))
+
+	svc := suite.newService()
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat, "")
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorServerError, authErr.Code)
+	assert.True(suite.T(), authErr.SendErrorToClient)
+	assert.Equal(suite.T(), oauth2const.ResponseModeFragment, authErr.ResponseMode)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_FormPost() {
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ResponseType: "code",
+			ResponseMode: "form_post",
+			ClientID:     "test-client",
+			RedirectURI:  "https://client.example.com/callback",
+			State:        "test-state",
+		},
+	}
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
+	suite.mockAuthRespStore.EXPECT().AddResponse(mock.Anything, mock.MatchedBy(func(resp FormPostResponse) bool {
+		return resp.RedirectURI == "https://client.example.com/callback" &&
+			resp.Params["code"] != "" && resp.Params["state"] == "test-state"
+	})).Return("test-response-id", nil)
+	suite.mockSessionService.EXPECT().CreateSession(mock.Anything, "test-user", mock.Anything, mock.Anything).
+		Return(&session.Session{ID: "test-session-id", UserID: "test-user"}, nil)
+	suite.mockSessionService.EXPECT().AddClientToSession(mock.Anything, "test-session-id", "test-client").
+		Return(nil)
+
+	svc := suite.newService()
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat, "")
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+	assert.Contains(suite.T(), result.RedirectURI, "/oauth2/authorize/response?authId=test-response-id")
+	assert.NotContains(suite.T(), result.RedirectURI, "code=")
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_FormPostError() {
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ResponseType: "code",
+			ResponseMode: "form_post",
+			ClientID:     "test-client",
+			RedirectURI:  "https://client.example.com/callback",
+			State:        "test-state",
+		},
+	}
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(&jwt.ErrorInvalidTokenSignature)
+	suite.mockAuthRespStore.EXPECT().AddResponse(mock.Anything, mock.MatchedBy(func(resp FormPostResponse) bool {
+		return resp.Params["error"] == oauth2const.ErrorInvalidRequest && resp.Params["state"] == "test-state"
+	})).Return("test-response-id", nil)
+
+	svc := suite.newService()
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat, "")
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+	assert.Contains(suite.T(), result.RedirectURI, "/oauth2/authorize/response?authId=test-response-id")
+	assert.Nil(suite.T(), result.Session)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestGetFormPostResponse_Success() {
+	formPost := FormPostResponse{
+		RedirectURI: "https://client.example.com/callback",
+		Params:      map[string]string{"code": "test-code", "state": "test-state"},
+	}
+	suite.mockAuthRespStore.EXPECT().GetResponse(mock.Anything, testAuthID).Return(true, formPost, nil)
+	suite.mockAuthRespStore.EXPECT().ClearResponse(mock.Anything, testAuthID).Return(nil)
+
+	svc := suite.newService()
+	result, authErr := svc.GetFormPostResponse(context.Background(), testAuthID)
+
+	assert.Nil(suite.T(), authErr)
+	assert.Equal(suite.T(), &formPost, result)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestGetFormPostResponse_NotFound() {
+	suite.mockAuthRespStore.EXPECT().GetResponse(mock.Anything, testAuthID).
+		Return(false, FormPostResponse{}, nil)
+
+	svc := suite.newService()
+	formPost, authErr := svc.GetFormPostResponse(context.Background(), testAuthID)
+
+	assert.Nil(suite.T(), formPost)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestGetFormPostResponse_StoreError() {
+	suite.mockAuthRespStore.EXPECT().GetResponse(mock.Anything, testAuthID).
+		Return(false, FormPostResponse{}, errors.New("db error"))
+
+	svc := suite.newService()
+	formPost, authErr := svc.GetFormPostResponse(context.Background(), testAuthID)
+
+	assert.Nil(suite.T(), formPost)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorServerError, authErr.Code)
+}
+
+func TestResolveResponseMode(t *testing.T) {
+	testCases := []struct {
+		name         string
+		responseType string
+		responseMode string
+		expected     oauth2const.ResponseMode
+	}{
+		{"CodeDefault", "code", "", oauth2const.ResponseModeQuery},
+		{"CodeFragment", "code", "fragment", oauth2const.ResponseModeFragment},
+		{"CodeFormPost", "code", "form_post", oauth2const.ResponseModeFormPost},
+		{"IDTokenDefault", "id_token", "", oauth2const.ResponseModeFragment},
+		{"HybridDefault", "id_token code", "", oauth2const.ResponseModeFragment},
+		{"HybridQueryNotAllowed", "code id_token", "query", oauth2const.ResponseModeFragment},
+		{"HybridFormPost", "code id_token token", "form_post", oauth2const.ResponseModeFormPost},
+		{"InvalidMode", "code", "web_message", oauth2const.ResponseModeQuery},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resolveResponseMode(tc.responseType, tc.responseMode))
+		})
+	}
+}
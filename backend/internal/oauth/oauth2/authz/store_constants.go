@@ -22,18 +22,21 @@ import dbmodel "github.com/asgardeo/thunder/internal/system/database/model"
 
 // JSON keys for authorization request context serialization.
 const (
-	jsonKeyState               = "state"
-	jsonKeyClientID            = "client_id"
-	jsonKeyRedirectURI         = "redirect_uri"
-	jsonKeyResponseType        = "response_type"
-	jsonKeyStandardScopes      = "standard_scopes"
-	jsonKeyPermissionScopes    = "permission_scopes"
-	jsonKeyCodeChallenge       = "code_challenge"
-	jsonKeyCodeChallengeMethod = "code_challenge_method"
-	jsonKeyResource            = "resource"
-	jsonKeyClaimsRequest       = "claims_request"
-	jsonKeyClaimsLocales       = "claims_locales"
-	jsonKeyNonce               = "nonce"
+	jsonKeyState                = "state"
+	jsonKeyClientID             = "client_id"
+	jsonKeyRedirectURI          = "redirect_uri"
+	jsonKeyResponseType         = "response_type"
+	jsonKeyResponseMode         = "response_mode"
+	jsonKeyStandardScopes       = "standard_scopes"
+	jsonKeyPermissionScopes     = "permission_scopes"
+	jsonKeyCodeChallenge        = "code_challenge"
+	jsonKeyCodeChallengeMethod  = "code_challenge_method"
+	jsonKeyResource             = "resource"
+	jsonKeyClaimsRequest        = "claims_request"
+	jsonKeyClaimsLocales        = "claims_locales"
+	jsonKeyNonce                = "nonce"
+	jsonKeyAuthorizationDetails = "authorization_details"
+	jsonKeyIDTokenHint          = "id_token_hint"
 )
 
 // Database column names for authorization request storage.
@@ -41,6 +44,11 @@ const (
 	dbColumnRequestData = "request_data"
 )
 
+// Database column names for authorization response storage.
+const (
+	dbColumnResponseData = "response_data"
+)
+
 // queryInsertAuthorizationCode is the query to insert a new authorization code into the database.
 var queryInsertAuthorizationCode = dbmodel.DBQuery{
 	ID: "AZQ-ACS-01",
@@ -81,3 +89,23 @@ var queryDeleteAuthRequest = dbmodel.DBQuery{
 	ID:    "AZQ-ARS-03",
 	Query: `DELETE FROM "AUTHORIZATION_REQUEST" WHERE AUTH_ID = $1 AND DEPLOYMENT_ID = $2`,
 }
+
+// queryInsertAuthResponse is the query to insert an authorization response pending form_post delivery.
+var queryInsertAuthResponse = dbmodel.DBQuery{
+	ID: "AZQ-ARP-01",
+	Query: `INSERT INTO "AUTHORIZATION_RESPONSE" (RESPONSE_ID, RESPONSE_DATA, EXPIRY_TIME, DEPLOYMENT_ID) ` +
+		`VALUES ($1, $2, $3, $4)`,
+}
+
+// queryGetAuthResponse is the query to retrieve an authorization response by ID.
+var queryGetAuthResponse = dbmodel.DBQuery{
+	ID: "AZQ-ARP-02",
+	Query: `SELECT RESPONSE_ID, RESPONSE_DATA, EXPIRY_TIME ` +
+		`FROM "AUTHORIZATION_RESPONSE" WHERE RESPONSE_ID = $1 AND EXPIRY_TIME > $2 AND DEPLOYMENT_ID = $3`,
+}
+
+// queryDeleteAuthResponse is the query to delete a specific authorization response.
+var queryDeleteAuthResponse = dbmodel.DBQuery{
+	ID:    "AZQ-ARP-03",
+	Query: `DELETE FROM "AUTHORIZATION_RESPONSE" WHERE RESPONSE_ID = $1 AND DEPLOYMENT_ID = $2`,
+}
@@ -560,7 +560,7 @@ func (suite *AuthorizationValidatorTestSuite) TestValidateAuthzReq_PKCENotRequir
 
 // Prompt Parameter Validation Tests (OIDC Core §3.1.2.1)
 
-func (suite *AuthorizationValidatorTestSuite) TestValidateInitialAuthzRequest_PromptNone_LoginRequired() {
+func (suite *AuthorizationValidatorTestSuite) TestValidateInitialAuthzRequest_PromptNone_Success() {
 	msg := &OAuthMessage{
 		RequestQueryParams: map[string]string{
 			constants.RequestParamClientID:     "test-client-id",
@@ -573,9 +573,9 @@ func (suite *AuthorizationValidatorTestSuite) TestValidateInitialAuthzRequest_Pr
 	sendErrorToApp, errorCode, errorMessage := suite.validator.validateInitialAuthorizationRequest(
 		msg, suite.oauthApp)
 
-	assert.True(suite.T(), sendErrorToApp)
-	assert.Equal(suite.T(), constants.ErrorLoginRequired, errorCode)
-	assert.Equal(suite.T(), "User authentication is required", errorMessage)
+	assert.False(suite.T(), sendErrorToApp)
+	assert.Empty(suite.T(), errorCode)
+	assert.Empty(suite.T(), errorMessage)
 }
 
 func (suite *AuthorizationValidatorTestSuite) TestValidateInitialAuthorizationRequest_PromptLogin_Success() {
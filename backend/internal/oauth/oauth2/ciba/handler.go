@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/clientauth"
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/system/log"
+	"github.com/asgardeo/thunder/internal/system/utils"
+)
+
+// cibaHandlerInterface defines the interface for handling backchannel authentication requests.
+type cibaHandlerInterface interface {
+	HandleBackchannelAuthRequest(w http.ResponseWriter, r *http.Request)
+	HandleApprovalCallbackRequest(w http.ResponseWriter, r *http.Request)
+}
+
+// cibaHandler implements cibaHandlerInterface.
+type cibaHandler struct {
+	cibaService BackchannelAuthServiceInterface
+	logger      *log.Logger
+}
+
+// newCIBAHandler creates a new backchannel authentication handler instance.
+func newCIBAHandler(cibaService BackchannelAuthServiceInterface) cibaHandlerInterface {
+	return &cibaHandler{
+		cibaService: cibaService,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, "BackchannelAuthHandler")),
+	}
+}
+
+// HandleBackchannelAuthRequest handles the POST /oauth2/bc-authorize request.
+func (h *cibaHandler) HandleBackchannelAuthRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Client authentication is handled by the ClientAuthMiddleware.
+	clientInfo := clientauth.GetOAuthClient(ctx)
+	if clientInfo == nil {
+		h.logger.Error("OAuth client not found in context - ClientAuthMiddleware must be applied")
+		utils.WriteJSONError(w, oauth2const.ErrorServerError,
+			"Something went wrong", http.StatusInternalServerError, nil)
+		return
+	}
+
+	// Parse form-encoded body.
+	if err := r.ParseForm(); err != nil {
+		utils.WriteJSONError(w, oauth2const.ErrorInvalidRequest, "Failed to parse request body",
+			http.StatusBadRequest, nil)
+		return
+	}
+
+	params := BackchannelAuthRequestParams{
+		Scope:                   r.PostForm.Get(oauth2const.RequestParamScope),
+		Resources:               r.PostForm[oauth2const.RequestParamResource],
+		LoginHint:               r.PostForm.Get(oauth2const.RequestParamLoginHint),
+		LoginHintToken:          r.PostForm.Get(oauth2const.RequestParamLoginHintToken),
+		IDTokenHint:             r.PostForm.Get(oauth2const.RequestParamIDTokenHint),
+		BindingMessage:          r.PostForm.Get(oauth2const.RequestParamBindingMessage),
+		ClientNotificationToken: r.PostForm.Get(oauth2const.RequestParamClientNotifToken),
+		RequestedExpiry:         r.PostForm.Get(oauth2const.RequestParamRequestedExpiry),
+		AcrValues:               r.PostForm.Get(oauth2const.RequestParamAcrValues),
+	}
+	resp, errCode, errDesc := h.cibaService.HandleBackchannelAuthRequest(ctx, params, clientInfo.OAuthApp)
+	if errCode != "" {
+		h.writeError(w, errCode, errDesc)
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, resp)
+}
+
+// HandleApprovalCallbackRequest handles the POST /oauth2/bc-authorize/callback request sent by the
+// gate client once the user has completed the approval flow or denied the request.
+func (h *cibaHandler) HandleApprovalCallbackRequest(w http.ResponseWriter, r *http.Request) {
+	req, err := utils.DecodeJSONBody[approvalCallbackRequest](r)
+	if err != nil || req.BackchannelToken == "" {
+		utils.WriteJSONError(w, oauth2const.ErrorInvalidRequest, "Invalid approval request",
+			http.StatusBadRequest, nil)
+		return
+	}
+
+	status, errCode, errDesc := h.cibaService.CompleteApproval(
+		r.Context(), req.BackchannelToken, req.Assertion, req.Denied)
+	if errCode != "" {
+		h.writeError(w, errCode, errDesc)
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, approvalCallbackResponse{Status: status})
+}
+
+// writeError writes an OAuth error response, mapping server errors to HTTP 500 and client
+// authorization errors to HTTP 401 (CIBA §13).
+func (h *cibaHandler) writeError(w http.ResponseWriter, errCode, errDesc string) {
+	statusCode := http.StatusBadRequest
+	switch errCode {
+	case oauth2const.ErrorServerError:
+		statusCode = http.StatusInternalServerError
+	case oauth2const.ErrorUnauthorizedClient:
+		statusCode = http.StatusUnauthorized
+	}
+	utils.WriteJSONError(w, errCode, errDesc, statusCode, nil)
+}
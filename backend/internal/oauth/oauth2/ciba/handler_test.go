@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/clientauth"
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/system/config"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	testConfig := &config.Config{}
+	_ = config.InitializeServerRuntime("", testConfig)
+}
+
+func (s *HandlerTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *HandlerTestSuite) newBackchannelAuthRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/bc-authorize", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	clientInfo := &clientauth.OAuthClientInfo{
+		ClientID: testClientID,
+		OAuthApp: &inboundmodel.OAuthClient{ClientID: testClientID},
+	}
+	ctx := context.WithValue(req.Context(), clientauth.OAuthClientKey, clientInfo)
+	return req.WithContext(ctx)
+}
+
+func (s *HandlerTestSuite) newCallbackRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/bc-authorize/callback", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+// Tests for HandleBackchannelAuthRequest
+
+func (s *HandlerTestSuite) TestHandleBackchannelAuthRequest_Success() {
+	svc := NewBackchannelAuthServiceInterfaceMock(s.T())
+	svc.EXPECT().HandleBackchannelAuthRequest(mock.Anything, BackchannelAuthRequestParams{
+		Scope:                   "openid read",
+		Resources:               []string{"https://api.example.com"},
+		LoginHint:               "alice",
+		BindingMessage:          "W4SCT",
+		ClientNotificationToken: "notif-token",
+		RequestedExpiry:         "120",
+		AcrValues:               "urn:thunder:acr:password",
+	}, mock.Anything).Return(&BackchannelAuthResponse{
+		AuthReqID: testAuthReqID,
+		ExpiresIn: 120,
+		Interval:  5,
+	}, "", "")
+	handler := newCIBAHandler(svc)
+
+	req := s.newBackchannelAuthRequest("scope=openid+read&resource=https%3A%2F%2Fapi.example.com" +
+		"&login_hint=alice&binding_message=W4SCT&client_notification_token=notif-token&requested_expiry=120" +
+		"&acr_values=urn%3Athunder%3Aacr%3Apassword")
+	rec := httptest.NewRecorder()
+	handler.HandleBackchannelAuthRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusOK, rec.Code)
+
+	var resp BackchannelAuthResponse
+	err := json.NewDecoder(rec.Body).Decode(&resp)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), testAuthReqID, resp.AuthReqID)
+	assert.Equal(s.T(), int64(120), resp.ExpiresIn)
+	assert.Equal(s.T(), int64(5), resp.Interval)
+}
+
+func (s *HandlerTestSuite) TestHandleBackchannelAuthRequest_NoClientAuth() {
+	handler := newCIBAHandler(NewBackchannelAuthServiceInterfaceMock(s.T()))
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/bc-authorize", strings.NewReader(""))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	handler.HandleBackchannelAuthRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusInternalServerError, rec.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleBackchannelAuthRequest_ServiceErrors() {
+	cases := map[string]int{
+		oauth2const.ErrorInvalidRequest:     http.StatusBadRequest,
+		oauth2const.ErrorUnknownUserID:      http.StatusBadRequest,
+		oauth2const.ErrorUnauthorizedClient: http.StatusUnauthorized,
+		oauth2const.ErrorServerError:        http.StatusInternalServerError,
+	}
+	for errCode, expectedStatus := range cases {
+		svc := NewBackchannelAuthServiceInterfaceMock(s.T())
+		svc.EXPECT().HandleBackchannelAuthRequest(mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errCode, "failed")
+		handler := newCIBAHandler(svc)
+
+		rec := httptest.NewRecorder()
+		handler.HandleBackchannelAuthRequest(rec, s.newBackchannelAuthRequest("scope=openid&login_hint=alice"))
+
+		assert.Equal(s.T(), expectedStatus, rec.Code, errCode)
+		assert.Contains(s.T(), rec.Body.String(), errCode)
+	}
+}
+
+// Tests for HandleApprovalCallbackRequest
+
+func (s *HandlerTestSuite) TestHandleApprovalCallbackRequest_Approved() {
+	svc := NewBackchannelAuthServiceInterfaceMock(s.T())
+	svc.EXPECT().CompleteApproval(mock.Anything, testApprovalToken, "assertion", false).
+		Return(AuthRequestStatusAuthorized, "", "")
+	handler := newCIBAHandler(svc)
+
+	rec := httptest.NewRecorder()
+	handler.HandleApprovalCallbackRequest(rec, s.newCallbackRequest(
+		`{"backchannelToken":"`+testApprovalToken+`","assertion":"assertion"}`))
+
+	assert.Equal(s.T(), http.StatusOK, rec.Code)
+	assert.JSONEq(s.T(), `{"status":"authorized"}`, rec.Body.String())
+}
+
+func (s *HandlerTestSuite) TestHandleApprovalCallbackRequest_Denied() {
+	svc := NewBackchannelAuthServiceInterfaceMock(s.T())
+	svc.EXPECT().CompleteApproval(mock.Anything, testApprovalToken, "", true).
+		Return(AuthRequestStatusDenied, "", "")
+	handler := newCIBAHandler(svc)
+
+	rec := httptest.NewRecorder()
+	handler.HandleApprovalCallbackRequest(rec, s.newCallbackRequest(
+		`{"backchannelToken":"`+testApprovalToken+`","denied":true}`))
+
+	assert.Equal(s.T(), http.StatusOK, rec.Code)
+	assert.JSONEq(s.T(), `{"status":"denied"}`, rec.Body.String())
+}
+
+func (s *HandlerTestSuite) TestHandleApprovalCallbackRequest_InvalidBody() {
+	handler := newCIBAHandler(NewBackchannelAuthServiceInterfaceMock(s.T()))
+
+	for _, body := range []string{`not-json`, `{"assertion":"assertion"}`} {
+		rec := httptest.NewRecorder()
+		handler.HandleApprovalCallbackRequest(rec, s.newCallbackRequest(body))
+
+		assert.Equal(s.T(), http.StatusBadRequest, rec.Code)
+	}
+}
+
+func (s *HandlerTestSuite) TestHandleApprovalCallbackRequest_ServiceError() {
+	svc := NewBackchannelAuthServiceInterfaceMock(s.T())
+	svc.EXPECT().CompleteApproval(mock.Anything, testApprovalToken, "", true).
+		Return("", oauth2const.ErrorInvalidRequest, "Invalid or expired backchannel token")
+	handler := newCIBAHandler(svc)
+
+	rec := httptest.NewRecorder()
+	handler.HandleApprovalCallbackRequest(rec, s.newCallbackRequest(
+		`{"backchannelToken":"`+testApprovalToken+`","denied":true}`))
+
+	assert.Equal(s.T(), http.StatusBadRequest, rec.Code)
+	assert.Contains(s.T(), rec.Body.String(), oauth2const.ErrorInvalidRequest)
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"context"
+	"net/http"
+
+	authnprovidermgr "github.com/asgardeo/thunder/internal/authnprovider/manager"
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	"github.com/asgardeo/thunder/internal/flow/flowexec"
+	"github.com/asgardeo/thunder/internal/inboundclient"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/clientauth"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/discovery"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
+	"github.com/asgardeo/thunder/internal/resource"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+	syshttp "github.com/asgardeo/thunder/internal/system/http"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/internal/system/middleware"
+)
+
+// Initialize initializes the backchannel authentication handler and registers its routes.
+// Returns the BackchannelAuthServiceInterface so the token endpoint can redeem auth_req_id values.
+func Initialize(
+	mux *http.ServeMux,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+	jwtService jwt.JWTServiceInterface,
+	tokenValidator tokenservice.TokenValidatorInterface,
+	discoveryService discovery.DiscoveryServiceInterface,
+	flowExecService flowexec.FlowExecServiceInterface,
+	resourceService resource.ResourceServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+) BackchannelAuthServiceInterface {
+	store := initializeCIBAStore()
+	httpClient := syshttp.NewHTTPClientWithCheckRedirect(func(req *http.Request, _ []*http.Request) error {
+		return syshttp.IsSSRFSafeURL(req.URL.String())
+	})
+	notifier := newPingNotifier(inboundClient, httpClient)
+	cibaSvc := newCIBAService(
+		store, notifier, jwtService, tokenValidator, flowExecService, resourceService, entityProvider)
+	handler := newCIBAHandler(cibaSvc)
+	registerRoutes(mux, handler, inboundClient, authnProvider, jwtService, discoveryService)
+	return cibaSvc
+}
+
+// initializeCIBAStore selects the backchannel authentication request store implementation based on
+// the configured runtime DB type.
+func initializeCIBAStore() cibaStoreInterface {
+	deploymentID := config.GetServerRuntime().Config.Server.Identifier
+
+	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		return newRedisBackchannelAuthRequestStore(provider.GetRedisProvider(), deploymentID)
+	}
+	return newBackchannelAuthRequestStore(deploymentID)
+}
+
+// registerRoutes registers the backchannel authentication endpoint with client authentication
+// middleware, and the approval callback endpoint used by the gate client.
+func registerRoutes(
+	mux *http.ServeMux,
+	handler cibaHandlerInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+	jwtService jwt.JWTServiceInterface,
+	discoveryService discovery.DiscoveryServiceInterface,
+) {
+	corsOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	metadata := discoveryService.GetOIDCMetadata(context.Background())
+	endpointURL := metadata.BackchannelAuthenticationEndpoint
+	clientAuthMiddleware := clientauth.ClientAuthMiddleware(inboundClient, authnProvider, jwtService, endpointURL)
+	wrappedHandler := clientAuthMiddleware(http.HandlerFunc(handler.HandleBackchannelAuthRequest))
+
+	mux.HandleFunc(middleware.WithCORS("POST /oauth2/bc-authorize",
+		wrappedHandler.ServeHTTP, corsOpts))
+
+	mux.HandleFunc(middleware.WithCORS("POST /oauth2/bc-authorize/callback",
+		handler.HandleApprovalCallbackRequest, corsOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /oauth2/bc-authorize/callback",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, corsOpts))
+}
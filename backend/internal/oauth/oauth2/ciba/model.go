@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import "time"
+
+// AuthRequestStatus represents the state of a backchannel authentication request.
+type AuthRequestStatus string
+
+const (
+	// AuthRequestStatusPending indicates that the user has not yet acted on the request.
+	AuthRequestStatusPending AuthRequestStatus = "pending"
+	// AuthRequestStatusAuthorized indicates that the user approved the request.
+	AuthRequestStatusAuthorized AuthRequestStatus = "authorized"
+	// AuthRequestStatusDenied indicates that the user denied the request.
+	AuthRequestStatusDenied AuthRequestStatus = "denied"
+)
+
+// BackchannelAuthRequest holds the state of a backchannel authentication request.
+// LastPolledAt is persisted separately from the rest of the record so that polling does not
+// overwrite a decision recorded concurrently by the approval callback.
+type BackchannelAuthRequest struct {
+	AuthReqID               string            `json:"auth_req_id"`
+	ApprovalToken           string            `json:"approval_token"`
+	ClientID                string            `json:"client_id"`
+	UserID                  string            `json:"user_id"`
+	StandardScopes          []string          `json:"standard_scopes,omitempty"`
+	PermissionScopes        []string          `json:"permission_scopes,omitempty"`
+	Resources               []string          `json:"resources,omitempty"`
+	BindingMessage          string            `json:"binding_message,omitempty"`
+	DeliveryMode            string            `json:"delivery_mode"`
+	ClientNotificationToken string            `json:"client_notification_token,omitempty"`
+	Status                  AuthRequestStatus `json:"status"`
+	AuthorizedUserID        string            `json:"authorized_user_id,omitempty"`
+	AttributeCacheID        string            `json:"attribute_cache_id,omitempty"`
+	AuthTime                time.Time         `json:"auth_time"`
+	CompletedACR            string            `json:"completed_acr,omitempty"`
+	Interval                int64             `json:"interval"`
+	ExpiryTime              time.Time         `json:"expiry_time"`
+	LastPolledAt            time.Time         `json:"-"`
+}
+
+// BackchannelAuthRequestParams holds the parameters of a backchannel authentication request.
+type BackchannelAuthRequestParams struct {
+	Scope                   string
+	Resources               []string
+	LoginHint               string
+	LoginHintToken          string
+	IDTokenHint             string
+	BindingMessage          string
+	ClientNotificationToken string
+	RequestedExpiry         string
+	AcrValues               string
+}
+
+// BackchannelAuthResponse represents the backchannel authentication endpoint success response.
+type BackchannelAuthResponse struct {
+	AuthReqID string `json:"auth_req_id"`
+	ExpiresIn int64  `json:"expires_in"`
+	Interval  int64  `json:"interval,omitempty"`
+}
+
+// approvalCallbackRequest represents the request body sent by the gate client once the user has
+// completed the approval flow or denied the request.
+type approvalCallbackRequest struct {
+	BackchannelToken string `json:"backchannelToken"`
+	Assertion        string `json:"assertion,omitempty"`
+	Denied           bool   `json:"denied,omitempty"`
+}
+
+// approvalCallbackResponse represents the response returned to the gate client after the user's
+// decision has been recorded.
+type approvalCallbackResponse struct {
+	Status AuthRequestStatus `json:"status"`
+}
+
+// pingCallbackBody represents the body of the notification sent to the client in ping mode.
+type pingCallbackBody struct {
+	AuthReqID string `json:"auth_req_id"`
+}
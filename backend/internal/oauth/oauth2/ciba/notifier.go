@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/inboundclient"
+	sysconst "github.com/asgardeo/thunder/internal/system/constants"
+	syshttp "github.com/asgardeo/thunder/internal/system/http"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+const (
+	// pingNotificationTimeout is the timeout of a single ping notification request.
+	pingNotificationTimeout = 5 * time.Second
+	// pingNotificationMaxAttempts is the number of attempts made to deliver a ping notification.
+	pingNotificationMaxAttempts = 3
+	// pingNotificationRetryDelay is the delay between two delivery attempts.
+	pingNotificationRetryDelay = time.Second
+)
+
+// pingNotifierInterface defines the interface for notifying clients registered for the ping delivery mode.
+type pingNotifierInterface interface {
+	NotifyClient(ctx context.Context, authRequest BackchannelAuthRequest)
+}
+
+// pingNotifier notifies a client that the result of a backchannel authentication request is ready
+// by calling its client notification endpoint (CIBA §10.2).
+type pingNotifier struct {
+	inboundClient inboundclient.InboundClientServiceInterface
+	httpClient    syshttp.HTTPClientInterface
+	maxAttempts   int
+	retryDelay    time.Duration
+	logger        *log.Logger
+}
+
+// newPingNotifier creates a new instance of pingNotifier.
+func newPingNotifier(
+	inboundClient inboundclient.InboundClientServiceInterface,
+	httpClient syshttp.HTTPClientInterface,
+) *pingNotifier {
+	return &pingNotifier{
+		inboundClient: inboundClient,
+		httpClient:    httpClient,
+		maxAttempts:   pingNotificationMaxAttempts,
+		retryDelay:    pingNotificationRetryDelay,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CIBAPingNotifier")),
+	}
+}
+
+// NotifyClient notifies the client of the given request. Delivery happens in the background so that
+// recording the user's decision is not delayed by an unresponsive client.
+func (n *pingNotifier) NotifyClient(ctx context.Context, authRequest BackchannelAuthRequest) {
+	go n.notifyClient(context.WithoutCancel(ctx), authRequest)
+}
+
+// notifyClient resolves the client notification endpoint and delivers the ping notification.
+func (n *pingNotifier) notifyClient(ctx context.Context, authRequest BackchannelAuthRequest) {
+	client, err := n.inboundClient.GetOAuthClientByClientID(ctx, authRequest.ClientID)
+	if err != nil {
+		n.logger.Error("Failed to retrieve OAuth client for ping notification",
+			log.String("clientId", authRequest.ClientID), log.Error(err))
+		return
+	}
+	if client == nil || client.BackchannelNotificationEndpoint == "" {
+		n.logger.Debug("No client notification endpoint registered", log.String("clientId", authRequest.ClientID))
+		return
+	}
+
+	if err := n.deliverWithRetry(ctx, client.BackchannelNotificationEndpoint, authRequest); err != nil {
+		n.logger.Error("Failed to deliver ping notification",
+			log.String("clientId", authRequest.ClientID), log.Error(err))
+		return
+	}
+	n.logger.Debug("Delivered ping notification", log.String("clientId", authRequest.ClientID))
+}
+
+// deliverWithRetry posts the ping notification to the client notification endpoint, retrying on failures.
+func (n *pingNotifier) deliverWithRetry(
+	ctx context.Context, endpoint string, authRequest BackchannelAuthRequest,
+) error {
+	var lastErr error
+	for attempt := 0; attempt < n.maxAttempts; attempt++ {
+		if attempt > 0 {
+			n.logger.Debug("Retrying ping notification request", log.Int("attempt", attempt))
+			time.Sleep(n.retryDelay)
+		}
+
+		lastErr = n.deliver(ctx, endpoint, authRequest)
+		if lastErr == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("failed after %d attempts: %w", n.maxAttempts, lastErr)
+}
+
+// deliver makes a single ping notification request. The client notification token supplied in the
+// authentication request is sent as a bearer token so that the client can authenticate the callback.
+func (n *pingNotifier) deliver(ctx context.Context, endpoint string, authRequest BackchannelAuthRequest) error {
+	body, err := json.Marshal(pingCallbackBody{AuthReqID: authRequest.AuthReqID})
+	if err != nil {
+		return fmt.Errorf("failed to marshal ping notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create ping notification request: %w", err)
+	}
+	req.Header.Set(sysconst.ContentTypeHeaderName, sysconst.ContentTypeJSON)
+	req.Header.Set(sysconst.AuthorizationHeaderName, sysconst.AuthSchemeBearer+authRequest.ClientNotificationToken)
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute ping notification request: %w", err)
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			n.logger.Error("Failed to close response body", log.Error(closeErr))
+		}
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("ping notification request returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/tests/mocks/httpmock"
+	"github.com/asgardeo/thunder/tests/mocks/inboundclientmock"
+)
+
+const testNotificationEndpoint = "https://client.example.com/ciba/notify"
+
+type PingNotifierTestSuite struct {
+	suite.Suite
+	mockInboundClient *inboundclientmock.InboundClientServiceInterfaceMock
+	mockHTTPClient    *httpmock.HTTPClientInterfaceMock
+	notifier          *pingNotifier
+	ctx               context.Context
+}
+
+func TestPingNotifierTestSuite(t *testing.T) {
+	suite.Run(t, new(PingNotifierTestSuite))
+}
+
+func (s *PingNotifierTestSuite) SetupTest() {
+	s.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(s.T())
+	s.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(s.T())
+	s.notifier = newPingNotifier(s.mockInboundClient, s.mockHTTPClient)
+	s.notifier.retryDelay = 0
+	s.ctx = context.Background()
+}
+
+func (s *PingNotifierTestSuite) testAuthRequest() BackchannelAuthRequest {
+	return BackchannelAuthRequest{
+		AuthReqID:               testAuthReqID,
+		ClientID:                testClientID,
+		ClientNotificationToken: "notif-token",
+	}
+}
+
+func (s *PingNotifierTestSuite) mockPingClient() {
+	s.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(&inboundmodel.OAuthClient{
+			ClientID:                        testClientID,
+			BackchannelNotificationEndpoint: testNotificationEndpoint,
+		}, nil)
+}
+
+func pingRequestMatcher(req *http.Request) bool {
+	if req.Method != http.MethodPost || req.URL.String() != testNotificationEndpoint {
+		return false
+	}
+	if req.Header.Get("Authorization") != "Bearer notif-token" {
+		return false
+	}
+	var body pingCallbackBody
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return false
+	}
+	return body.AuthReqID == testAuthReqID
+}
+
+func httpResponse(statusCode int) *http.Response {
+	return &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader(""))}
+}
+
+func (s *PingNotifierTestSuite) TestNotifyClient_DeliversNotification() {
+	s.mockPingClient()
+	s.mockHTTPClient.EXPECT().Do(mock.MatchedBy(pingRequestMatcher)).
+		Return(httpResponse(http.StatusNoContent), nil).Once()
+
+	s.notifier.notifyClient(s.ctx, s.testAuthRequest())
+}
+
+func (s *PingNotifierTestSuite) TestNotifyClient_RetriesOnFailure() {
+	s.mockPingClient()
+	s.mockHTTPClient.EXPECT().Do(mock.Anything).Return(nil, errors.New("connection refused")).Once()
+	s.mockHTTPClient.EXPECT().Do(mock.Anything).Return(httpResponse(http.StatusServiceUnavailable), nil).Once()
+	s.mockHTTPClient.EXPECT().Do(mock.Anything).Return(httpResponse(http.StatusNoContent), nil).Once()
+
+	s.notifier.notifyClient(s.ctx, s.testAuthRequest())
+}
+
+func (s *PingNotifierTestSuite) TestNotifyClient_StopsAfterMaxAttempts() {
+	s.mockPingClient()
+	s.mockHTTPClient.EXPECT().Do(mock.Anything).Return(httpResponse(http.StatusBadRequest), nil).
+		Times(pingNotificationMaxAttempts)
+
+	s.notifier.notifyClient(s.ctx, s.testAuthRequest())
+}
+
+func (s *PingNotifierTestSuite) TestNotifyClient_SkipsClientWithoutEndpoint() {
+	s.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(&inboundmodel.OAuthClient{ClientID: testClientID}, nil)
+
+	s.notifier.notifyClient(s.ctx, s.testAuthRequest())
+
+	s.mockHTTPClient.AssertNotCalled(s.T(), "Do", mock.Anything)
+}
+
+func (s *PingNotifierTestSuite) TestNotifyClient_ClientLookupError() {
+	s.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(nil, errors.New("db error"))
+
+	s.notifier.notifyClient(s.ctx, s.testAuthRequest())
+
+	s.mockHTTPClient.AssertNotCalled(s.T(), "Do", mock.Anything)
+}
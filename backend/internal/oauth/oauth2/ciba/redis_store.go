@@ -0,0 +1,188 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// cibaRedisClient abstracts the Redis commands used by the backchannel authentication request store.
+type cibaRedisClient interface {
+	Set(ctx context.Context, key string, value any, expiration time.Duration) *redis.StatusCmd
+	SetArgs(ctx context.Context, key string, value any, a redis.SetArgs) *redis.StatusCmd
+	Get(ctx context.Context, key string) *redis.StringCmd
+	GetDel(ctx context.Context, key string) *redis.StringCmd
+	Del(ctx context.Context, keys ...string) *redis.IntCmd
+}
+
+// redisBackchannelAuthRequestStore is the Redis-backed implementation of cibaStoreInterface.
+// Each request is stored under its auth_req_id with a TTL matching the request expiry. A secondary
+// key maps the approval token to the auth_req_id, and the last poll time is kept under its own key.
+// Expired requests are evicted by Redis, so polling with an expired auth_req_id yields invalid_grant.
+type redisBackchannelAuthRequestStore struct {
+	client       cibaRedisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// newRedisBackchannelAuthRequestStore creates a new Redis-backed backchannel authentication request store.
+func newRedisBackchannelAuthRequestStore(
+	p provider.RedisProviderInterface, deploymentID string,
+) cibaStoreInterface {
+	return &redisBackchannelAuthRequestStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: deploymentID,
+	}
+}
+
+// requestKey builds the Redis key for an auth_req_id.
+func (s *redisBackchannelAuthRequestStore) requestKey(authReqID string) string {
+	return fmt.Sprintf("%s:runtime:%s:ciba:%s", s.keyPrefix, s.deploymentID, authReqID)
+}
+
+// approvalTokenKey builds the Redis key mapping an approval token to its auth_req_id.
+func (s *redisBackchannelAuthRequestStore) approvalTokenKey(approvalToken string) string {
+	return fmt.Sprintf("%s:runtime:%s:cibaapproval:%s", s.keyPrefix, s.deploymentID, approvalToken)
+}
+
+// pollKey builds the Redis key holding the last poll time of an auth_req_id.
+func (s *redisBackchannelAuthRequestStore) pollKey(authReqID string) string {
+	return fmt.Sprintf("%s:runtime:%s:cibapoll:%s", s.keyPrefix, s.deploymentID, authReqID)
+}
+
+// Create persists a new backchannel authentication request and its approval token mapping.
+func (s *redisBackchannelAuthRequestStore) Create(ctx context.Context, authRequest BackchannelAuthRequest) error {
+	data, err := json.Marshal(authRequest)
+	if err != nil {
+		return fmt.Errorf("failed to marshal backchannel authentication request: %w", err)
+	}
+
+	ttl := time.Until(authRequest.ExpiryTime)
+	if err := s.client.Set(ctx, s.requestKey(authRequest.AuthReqID), data, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store backchannel authentication request in Redis: %w", err)
+	}
+	if err := s.client.Set(ctx, s.approvalTokenKey(authRequest.ApprovalToken), authRequest.AuthReqID,
+		ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store approval token mapping in Redis: %w", err)
+	}
+	return nil
+}
+
+// GetByAuthReqID retrieves a backchannel authentication request by its auth_req_id.
+func (s *redisBackchannelAuthRequestStore) GetByAuthReqID(
+	ctx context.Context, authReqID string,
+) (BackchannelAuthRequest, bool, error) {
+	data, err := s.client.Get(ctx, s.requestKey(authReqID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return BackchannelAuthRequest{}, false, nil
+		}
+		return BackchannelAuthRequest{}, false,
+			fmt.Errorf("failed to get backchannel authentication request from Redis: %w", err)
+	}
+
+	var authRequest BackchannelAuthRequest
+	if err := json.Unmarshal(data, &authRequest); err != nil {
+		return BackchannelAuthRequest{}, false, fmt.Errorf("failed to unmarshal backchannel authentication request: %w", err)
+	}
+
+	polledAt, err := s.client.Get(ctx, s.pollKey(authReqID)).Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return BackchannelAuthRequest{}, false, fmt.Errorf("failed to get backchannel poll time from Redis: %w", err)
+	}
+	if err == nil {
+		authRequest.LastPolledAt = time.UnixMilli(polledAt)
+	}
+	return authRequest, true, nil
+}
+
+// GetByApprovalToken retrieves a backchannel authentication request by its approval token.
+func (s *redisBackchannelAuthRequestStore) GetByApprovalToken(
+	ctx context.Context, approvalToken string,
+) (BackchannelAuthRequest, bool, error) {
+	authReqID, err := s.client.Get(ctx, s.approvalTokenKey(approvalToken)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return BackchannelAuthRequest{}, false, nil
+		}
+		return BackchannelAuthRequest{}, false, fmt.Errorf("failed to get approval token mapping from Redis: %w", err)
+	}
+	return s.GetByAuthReqID(ctx, authReqID)
+}
+
+// Update replaces the stored data of a backchannel authentication request, preserving its TTL.
+// The update is skipped if the request no longer exists.
+func (s *redisBackchannelAuthRequestStore) Update(ctx context.Context, authRequest BackchannelAuthRequest) error {
+	data, err := json.Marshal(authRequest)
+	if err != nil {
+		return fmt.Errorf("failed to marshal backchannel authentication request: %w", err)
+	}
+
+	err = s.client.SetArgs(ctx, s.requestKey(authRequest.AuthReqID), data,
+		redis.SetArgs{Mode: "XX", KeepTTL: true}).Err()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return fmt.Errorf("failed to update backchannel authentication request in Redis: %w", err)
+	}
+	return nil
+}
+
+// UpdateLastPolledAt records the time at which the client last polled the token endpoint.
+func (s *redisBackchannelAuthRequestStore) UpdateLastPolledAt(
+	ctx context.Context, authRequest BackchannelAuthRequest,
+) error {
+	ttl := time.Until(authRequest.ExpiryTime)
+	if ttl <= 0 {
+		return nil
+	}
+	if err := s.client.Set(ctx, s.pollKey(authRequest.AuthReqID), authRequest.LastPolledAt.UnixMilli(),
+		ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store backchannel poll time in Redis: %w", err)
+	}
+	return nil
+}
+
+// Delete atomically removes a backchannel authentication request via Redis GETDEL along with its
+// auxiliary keys. Returns false if the request did not exist.
+func (s *redisBackchannelAuthRequestStore) Delete(ctx context.Context, authReqID string) (bool, error) {
+	data, err := s.client.GetDel(ctx, s.requestKey(authReqID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to delete backchannel authentication request from Redis: %w", err)
+	}
+
+	keys := []string{s.pollKey(authReqID)}
+	var authRequest BackchannelAuthRequest
+	if err := json.Unmarshal(data, &authRequest); err == nil && authRequest.ApprovalToken != "" {
+		keys = append(keys, s.approvalTokenKey(authRequest.ApprovalToken))
+	}
+	// The auxiliary keys expire along with the request, so failing to delete them is not fatal.
+	s.client.Del(ctx, keys...)
+	return true, nil
+}
@@ -0,0 +1,263 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	redisTestKeyPrefix    = "thunderid"
+	redisTestDeploymentID = "test-deployment-id"
+)
+
+type RedisStoreTestSuite struct {
+	suite.Suite
+	mockClient      *cibaRedisClientMock
+	store           *redisBackchannelAuthRequestStore
+	ctx             context.Context
+	testAuthRequest BackchannelAuthRequest
+}
+
+func TestRedisStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisStoreTestSuite))
+}
+
+func (s *RedisStoreTestSuite) SetupTest() {
+	s.mockClient = newCibaRedisClientMock(s.T())
+	s.store = &redisBackchannelAuthRequestStore{
+		client:       s.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: redisTestDeploymentID,
+	}
+	s.ctx = context.Background()
+	s.testAuthRequest = BackchannelAuthRequest{
+		AuthReqID:     testAuthReqID,
+		ApprovalToken: testApprovalToken,
+		ClientID:      testClientID,
+		Status:        AuthRequestStatusPending,
+		Interval:      5,
+		ExpiryTime:    time.Now().Add(10 * time.Minute),
+	}
+}
+
+func (s *RedisStoreTestSuite) buildKey(name, value string) string {
+	return fmt.Sprintf("%s:runtime:%s:%s:%s", redisTestKeyPrefix, redisTestDeploymentID, name, value)
+}
+
+func (s *RedisStoreTestSuite) stringCmd(val string, err error) *redis.StringCmd {
+	cmd := redis.NewStringCmd(s.ctx)
+	if err != nil {
+		cmd.SetErr(err)
+	} else {
+		cmd.SetVal(val)
+	}
+	return cmd
+}
+
+// Tests for keys
+
+func (s *RedisStoreTestSuite) TestKeys() {
+	s.Equal(s.buildKey("ciba", testAuthReqID), s.store.requestKey(testAuthReqID))
+	s.Equal(s.buildKey("cibaapproval", testApprovalToken), s.store.approvalTokenKey(testApprovalToken))
+	s.Equal(s.buildKey("cibapoll", testAuthReqID), s.store.pollKey(testAuthReqID))
+}
+
+// Tests for Create
+
+func (s *RedisStoreTestSuite) TestCreate_Success() {
+	s.mockClient.On("Set", s.ctx, s.buildKey("ciba", testAuthReqID), mock.Anything,
+		mock.MatchedBy(func(ttl time.Duration) bool { return ttl > 9*time.Minute && ttl <= 10*time.Minute }),
+	).Return(redis.NewStatusCmd(s.ctx))
+	s.mockClient.On("Set", s.ctx, s.buildKey("cibaapproval", testApprovalToken), testAuthReqID, mock.Anything).
+		Return(redis.NewStatusCmd(s.ctx))
+
+	s.NoError(s.store.Create(s.ctx, s.testAuthRequest))
+}
+
+func (s *RedisStoreTestSuite) TestCreate_SetError() {
+	cmd := redis.NewStatusCmd(s.ctx)
+	cmd.SetErr(errors.New("redis error"))
+	s.mockClient.On("Set", s.ctx, s.buildKey("ciba", testAuthReqID), mock.Anything, mock.Anything).
+		Return(cmd)
+
+	err := s.store.Create(s.ctx, s.testAuthRequest)
+
+	s.Error(err)
+	s.Contains(err.Error(), "failed to store backchannel authentication request in Redis")
+}
+
+// Tests for GetByAuthReqID
+
+func (s *RedisStoreTestSuite) TestGetByAuthReqID_Success() {
+	data, _ := json.Marshal(s.testAuthRequest)
+	polledAt := time.Now().Add(-time.Second).Truncate(time.Millisecond)
+	s.mockClient.On("Get", s.ctx, s.buildKey("ciba", testAuthReqID)).Return(s.stringCmd(string(data), nil))
+	s.mockClient.On("Get", s.ctx, s.buildKey("cibapoll", testAuthReqID)).
+		Return(s.stringCmd(fmt.Sprintf("%d", polledAt.UnixMilli()), nil))
+
+	result, found, err := s.store.GetByAuthReqID(s.ctx, testAuthReqID)
+
+	s.NoError(err)
+	s.True(found)
+	s.Equal(testClientID, result.ClientID)
+	s.True(polledAt.Equal(result.LastPolledAt))
+}
+
+func (s *RedisStoreTestSuite) TestGetByAuthReqID_NeverPolled() {
+	data, _ := json.Marshal(s.testAuthRequest)
+	s.mockClient.On("Get", s.ctx, s.buildKey("ciba", testAuthReqID)).Return(s.stringCmd(string(data), nil))
+	s.mockClient.On("Get", s.ctx, s.buildKey("cibapoll", testAuthReqID)).Return(s.stringCmd("", redis.Nil))
+
+	result, found, err := s.store.GetByAuthReqID(s.ctx, testAuthReqID)
+
+	s.NoError(err)
+	s.True(found)
+	s.True(result.LastPolledAt.IsZero())
+}
+
+func (s *RedisStoreTestSuite) TestGetByAuthReqID_NotFound() {
+	s.mockClient.On("Get", s.ctx, s.buildKey("ciba", testAuthReqID)).Return(s.stringCmd("", redis.Nil))
+
+	_, found, err := s.store.GetByAuthReqID(s.ctx, testAuthReqID)
+
+	s.NoError(err)
+	s.False(found)
+}
+
+func (s *RedisStoreTestSuite) TestGetByAuthReqID_RedisError() {
+	s.mockClient.On("Get", s.ctx, s.buildKey("ciba", testAuthReqID)).
+		Return(s.stringCmd("", errors.New("redis error")))
+
+	_, found, err := s.store.GetByAuthReqID(s.ctx, testAuthReqID)
+
+	s.Error(err)
+	s.False(found)
+}
+
+// Tests for GetByApprovalToken
+
+func (s *RedisStoreTestSuite) TestGetByApprovalToken_Success() {
+	data, _ := json.Marshal(s.testAuthRequest)
+	s.mockClient.On("Get", s.ctx, s.buildKey("cibaapproval", testApprovalToken)).
+		Return(s.stringCmd(testAuthReqID, nil))
+	s.mockClient.On("Get", s.ctx, s.buildKey("ciba", testAuthReqID)).Return(s.stringCmd(string(data), nil))
+	s.mockClient.On("Get", s.ctx, s.buildKey("cibapoll", testAuthReqID)).Return(s.stringCmd("", redis.Nil))
+
+	result, found, err := s.store.GetByApprovalToken(s.ctx, testApprovalToken)
+
+	s.NoError(err)
+	s.True(found)
+	s.Equal(testAuthReqID, result.AuthReqID)
+}
+
+func (s *RedisStoreTestSuite) TestGetByApprovalToken_NotFound() {
+	s.mockClient.On("Get", s.ctx, s.buildKey("cibaapproval", testApprovalToken)).Return(s.stringCmd("", redis.Nil))
+
+	_, found, err := s.store.GetByApprovalToken(s.ctx, testApprovalToken)
+
+	s.NoError(err)
+	s.False(found)
+}
+
+// Tests for Update
+
+func (s *RedisStoreTestSuite) TestUpdate_Success() {
+	s.mockClient.On("SetArgs", s.ctx, s.buildKey("ciba", testAuthReqID), mock.Anything,
+		redis.SetArgs{Mode: "XX", KeepTTL: true}).Return(redis.NewStatusCmd(s.ctx))
+
+	s.NoError(s.store.Update(s.ctx, s.testAuthRequest))
+}
+
+func (s *RedisStoreTestSuite) TestUpdate_KeyMissing() {
+	cmd := redis.NewStatusCmd(s.ctx)
+	cmd.SetErr(redis.Nil)
+	s.mockClient.On("SetArgs", s.ctx, s.buildKey("ciba", testAuthReqID), mock.Anything, mock.Anything).
+		Return(cmd)
+
+	s.NoError(s.store.Update(s.ctx, s.testAuthRequest))
+}
+
+func (s *RedisStoreTestSuite) TestUpdate_RedisError() {
+	cmd := redis.NewStatusCmd(s.ctx)
+	cmd.SetErr(errors.New("redis error"))
+	s.mockClient.On("SetArgs", s.ctx, s.buildKey("ciba", testAuthReqID), mock.Anything, mock.Anything).
+		Return(cmd)
+
+	s.Error(s.store.Update(s.ctx, s.testAuthRequest))
+}
+
+// Tests for UpdateLastPolledAt
+
+func (s *RedisStoreTestSuite) TestUpdateLastPolledAt_Success() {
+	s.testAuthRequest.LastPolledAt = time.Now()
+	s.mockClient.On("Set", s.ctx, s.buildKey("cibapoll", testAuthReqID),
+		s.testAuthRequest.LastPolledAt.UnixMilli(), mock.Anything).Return(redis.NewStatusCmd(s.ctx))
+
+	s.NoError(s.store.UpdateLastPolledAt(s.ctx, s.testAuthRequest))
+}
+
+func (s *RedisStoreTestSuite) TestUpdateLastPolledAt_Expired() {
+	s.testAuthRequest.ExpiryTime = time.Now().Add(-time.Second)
+
+	s.NoError(s.store.UpdateLastPolledAt(s.ctx, s.testAuthRequest))
+	s.mockClient.AssertNotCalled(s.T(), "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Tests for Delete
+
+func (s *RedisStoreTestSuite) TestDelete_Success() {
+	data, _ := json.Marshal(s.testAuthRequest)
+	s.mockClient.On("GetDel", s.ctx, s.buildKey("ciba", testAuthReqID)).Return(s.stringCmd(string(data), nil))
+	s.mockClient.On("Del", s.ctx, s.buildKey("cibapoll", testAuthReqID),
+		s.buildKey("cibaapproval", testApprovalToken)).Return(redis.NewIntCmd(s.ctx))
+
+	deleted, err := s.store.Delete(s.ctx, testAuthReqID)
+
+	s.NoError(err)
+	s.True(deleted)
+}
+
+func (s *RedisStoreTestSuite) TestDelete_NotFound() {
+	s.mockClient.On("GetDel", s.ctx, s.buildKey("ciba", testAuthReqID)).Return(s.stringCmd("", redis.Nil))
+
+	deleted, err := s.store.Delete(s.ctx, testAuthReqID)
+
+	s.NoError(err)
+	s.False(deleted)
+}
+
+func (s *RedisStoreTestSuite) TestDelete_RedisError() {
+	s.mockClient.On("GetDel", s.ctx, s.buildKey("ciba", testAuthReqID)).
+		Return(s.stringCmd("", errors.New("redis error")))
+
+	deleted, err := s.store.Delete(s.ctx, testAuthReqID)
+
+	s.Error(err)
+	s.False(deleted)
+}
@@ -0,0 +1,478 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	flowcm "github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/flowassertion"
+	"github.com/asgardeo/thunder/internal/flow/flowexec"
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/authz"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/authz/requestvalidator"
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	oauth2model "github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/resourceindicators"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
+	oauth2utils "github.com/asgardeo/thunder/internal/oauth/oauth2/utils"
+	"github.com/asgardeo/thunder/internal/resource"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/internal/system/log"
+	"github.com/asgardeo/thunder/internal/system/utils"
+)
+
+const (
+	// randomKeyBytes is the number of random bytes for auth_req_id and approval token values (256 bits).
+	randomKeyBytes = 32
+	// maxBindingMessageLength is the maximum number of characters accepted in a binding message.
+	// The message is displayed on both devices, so it is expected to be short (CIBA §7.1).
+	maxBindingMessageLength = 64
+)
+
+// BackchannelAuthServiceInterface defines the interface for the backchannel authentication service.
+type BackchannelAuthServiceInterface interface {
+	HandleBackchannelAuthRequest(
+		ctx context.Context, params BackchannelAuthRequestParams, oauthApp *inboundmodel.OAuthClient,
+	) (*BackchannelAuthResponse, string, string)
+	CompleteApproval(
+		ctx context.Context, approvalToken string, assertion string, denied bool,
+	) (AuthRequestStatus, string, string)
+	ConsumeBackchannelAuthRequest(
+		ctx context.Context, clientID string, authReqID string,
+	) (*BackchannelAuthRequest, *oauth2model.ErrorResponse)
+}
+
+// cibaService implements BackchannelAuthServiceInterface.
+type cibaService struct {
+	store           cibaStoreInterface
+	notifier        pingNotifierInterface
+	jwtService      jwt.JWTServiceInterface
+	tokenValidator  tokenservice.TokenValidatorInterface
+	flowExecService flowexec.FlowExecServiceInterface
+	resourceService resource.ResourceServiceInterface
+	entityProvider  entityprovider.EntityProviderInterface
+	logger          *log.Logger
+}
+
+// newCIBAService creates a new backchannel authentication service instance.
+func newCIBAService(
+	store cibaStoreInterface,
+	notifier pingNotifierInterface,
+	jwtService jwt.JWTServiceInterface,
+	tokenValidator tokenservice.TokenValidatorInterface,
+	flowExecService flowexec.FlowExecServiceInterface,
+	resourceService resource.ResourceServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+) BackchannelAuthServiceInterface {
+	return &cibaService{
+		store:           store,
+		notifier:        notifier,
+		jwtService:      jwtService,
+		tokenValidator:  tokenValidator,
+		flowExecService: flowExecService,
+		resourceService: resourceService,
+		entityProvider:  entityProvider,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "BackchannelAuthService")),
+	}
+}
+
+// HandleBackchannelAuthRequest validates a backchannel authentication request, identifies the user
+// from the supplied hint and starts the approval flow that notifies the user on their own device.
+// Returns the response on success, or (errorCode, errorDescription) on failure.
+func (s *cibaService) HandleBackchannelAuthRequest(
+	ctx context.Context, params BackchannelAuthRequestParams, oauthApp *inboundmodel.OAuthClient,
+) (*BackchannelAuthResponse, string, string) {
+	if !oauthApp.IsAllowedGrantType(oauth2const.GrantTypeCIBA) {
+		return nil, oauth2const.ErrorUnauthorizedClient,
+			"The client is not authorized to use the CIBA grant"
+	}
+
+	deliveryMode := oauthApp.BackchannelTokenDeliveryMode
+	if deliveryMode == "" {
+		deliveryMode = oauth2const.BackchannelTokenDeliveryModePoll
+	}
+	if deliveryMode == oauth2const.BackchannelTokenDeliveryModePing && params.ClientNotificationToken == "" {
+		return nil, oauth2const.ErrorInvalidRequest,
+			"client_notification_token is required for the ping delivery mode"
+	}
+
+	oidcScopes, nonOidcScopes := oauth2utils.SeparateOIDCAndNonOIDCScopes(params.Scope, oauthApp.ScopeClaims)
+	if !slices.Contains(oidcScopes, oauth2const.ScopeOpenID) {
+		return nil, oauth2const.ErrorInvalidScope, "The openid scope is required"
+	}
+
+	if utf8.RuneCountInString(params.BindingMessage) > maxBindingMessageLength {
+		return nil, oauth2const.ErrorInvalidBindingMessage,
+			fmt.Sprintf("binding_message must not exceed %d characters", maxBindingMessageLength)
+	}
+
+	cibaConfig := config.GetServerRuntime().Config.OAuth.CIBA
+	expiresIn := cibaConfig.ExpiresIn
+	if params.RequestedExpiry != "" {
+		requestedExpiry, err := strconv.ParseInt(params.RequestedExpiry, 10, 64)
+		if err != nil || requestedExpiry <= 0 {
+			return nil, oauth2const.ErrorInvalidRequest, "requested_expiry must be a positive integer"
+		}
+		expiresIn = min(expiresIn, requestedExpiry)
+	}
+
+	userID, errCode, errDesc := s.resolveUser(ctx, params)
+	if errCode != "" {
+		return nil, errCode, errDesc
+	}
+
+	if errResp := resourceindicators.ValidateResourceURIs(params.Resources); errResp != nil {
+		return nil, errResp.Error, errResp.ErrorDescription
+	}
+	_, nonOidcScopes, errResp := resourceindicators.ResolveAndDownscope(
+		ctx, s.resourceService, params.Resources, nonOidcScopes)
+	if errResp != nil {
+		return nil, errResp.Error, errResp.ErrorDescription
+	}
+
+	authReqID, err := generateRandomKey()
+	if err != nil {
+		s.logger.Error("Failed to generate auth_req_id", log.Error(err))
+		return nil, oauth2const.ErrorServerError, "Failed to process backchannel authentication request"
+	}
+	approvalToken, err := generateRandomKey()
+	if err != nil {
+		s.logger.Error("Failed to generate approval token", log.Error(err))
+		return nil, oauth2const.ErrorServerError, "Failed to process backchannel authentication request"
+	}
+
+	authRequest := BackchannelAuthRequest{
+		AuthReqID:               authReqID,
+		ApprovalToken:           approvalToken,
+		ClientID:                oauthApp.ClientID,
+		UserID:                  userID,
+		StandardScopes:          oidcScopes,
+		PermissionScopes:        nonOidcScopes,
+		Resources:               params.Resources,
+		BindingMessage:          params.BindingMessage,
+		DeliveryMode:            deliveryMode,
+		ClientNotificationToken: params.ClientNotificationToken,
+		Status:                  AuthRequestStatusPending,
+		Interval:                cibaConfig.PollingInterval,
+		ExpiryTime:              time.Now().Add(time.Duration(expiresIn) * time.Second),
+	}
+	if err := s.store.Create(ctx, authRequest); err != nil {
+		s.logger.Error("Failed to store backchannel authentication request", log.Error(err))
+		return nil, oauth2const.ErrorServerError, "Failed to process backchannel authentication request"
+	}
+
+	if errCode, errDesc := s.startApprovalFlow(ctx, authRequest, params.AcrValues, oauthApp); errCode != "" {
+		if _, err := s.store.Delete(ctx, authReqID); err != nil {
+			s.logger.Error("Failed to remove backchannel authentication request", log.Error(err))
+		}
+		return nil, errCode, errDesc
+	}
+
+	resp := &BackchannelAuthResponse{
+		AuthReqID: authReqID,
+		ExpiresIn: expiresIn,
+	}
+	if deliveryMode == oauth2const.BackchannelTokenDeliveryModePoll {
+		resp.Interval = cibaConfig.PollingInterval
+	}
+	return resp, "", ""
+}
+
+// CompleteApproval records the user's decision for the backchannel authentication request bound to
+// the approval token. When approved, the flow assertion is verified and must identify the user the
+// request was made for. Clients registered for the ping mode are notified once the decision is stored.
+// Returns the resulting status, or (errorCode, errorDescription) on failure.
+func (s *cibaService) CompleteApproval(
+	ctx context.Context, approvalToken string, assertion string, denied bool,
+) (AuthRequestStatus, string, string) {
+	authRequest, found, err := s.store.GetByApprovalToken(ctx, approvalToken)
+	if err != nil {
+		s.logger.Error("Failed to retrieve backchannel authentication request", log.Error(err))
+		return "", oauth2const.ErrorServerError, "Failed to process approval request"
+	}
+	if !found {
+		s.logger.Debug("Backchannel approval rejected", log.Error(errApprovalTokenNotFound))
+		return "", oauth2const.ErrorInvalidRequest, "Invalid or expired backchannel token"
+	}
+	if authRequest.Status != AuthRequestStatusPending {
+		s.logger.Debug("Backchannel approval rejected", log.Error(errAuthRequestCompleted))
+		return "", oauth2const.ErrorInvalidRequest, "Invalid or expired backchannel token"
+	}
+
+	if denied {
+		authRequest.Status = AuthRequestStatusDenied
+	} else {
+		if assertion == "" {
+			return "", oauth2const.ErrorInvalidRequest, "Assertion is required"
+		}
+		if err := s.jwtService.VerifyJWT(assertion, "", ""); err != nil {
+			s.logger.Debug("Invalid assertion signature", log.String("error", err.Error.DefaultValue))
+			return "", oauth2const.ErrorInvalidRequest, "Invalid assertion"
+		}
+		claims, err := flowassertion.Decode(assertion)
+		if err != nil || claims.UserID == "" {
+			s.logger.Debug("Failed to decode assertion", log.Error(err))
+			return "", oauth2const.ErrorInvalidRequest, "Invalid assertion"
+		}
+		// The request can only be approved by the user it was addressed to.
+		if claims.UserID != authRequest.UserID {
+			s.logger.Debug("Backchannel approval rejected", log.Error(errSubjectMismatch))
+			return "", oauth2const.ErrorInvalidRequest, "Invalid assertion"
+		}
+
+		authRequest.Status = AuthRequestStatusAuthorized
+		authRequest.AuthorizedUserID = claims.UserID
+		authRequest.AttributeCacheID = claims.AttributeCacheID
+		authRequest.CompletedACR = claims.CompletedACR
+		authRequest.AuthTime = claims.AuthTime
+		if authRequest.AuthTime.IsZero() {
+			authRequest.AuthTime = time.Now()
+		}
+		// Overwrite the permission scopes with the permissions authorized during the flow.
+		authRequest.PermissionScopes = utils.ParseStringArray(claims.AuthorizedPermissions, " ")
+	}
+
+	if err := s.store.Update(ctx, authRequest); err != nil {
+		s.logger.Error("Failed to update backchannel authentication request", log.Error(err))
+		return "", oauth2const.ErrorServerError, "Failed to process approval request"
+	}
+
+	if authRequest.DeliveryMode == oauth2const.BackchannelTokenDeliveryModePing {
+		s.notifier.NotifyClient(ctx, authRequest)
+	}
+	return authRequest.Status, "", ""
+}
+
+// ConsumeBackchannelAuthRequest resolves the backchannel authentication request redeemed by the client
+// at the token endpoint. The request is consumed once the user has decided; otherwise the appropriate
+// error (authorization_pending, slow_down, access_denied or expired_token) is returned. slow_down is
+// only reported in the poll mode since ping clients are expected to wait for the notification.
+func (s *cibaService) ConsumeBackchannelAuthRequest(
+	ctx context.Context, clientID string, authReqID string,
+) (*BackchannelAuthRequest, *oauth2model.ErrorResponse) {
+	authRequest, found, err := s.store.GetByAuthReqID(ctx, authReqID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve backchannel authentication request", log.Error(err))
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorServerError,
+			ErrorDescription: "Failed to retrieve backchannel authentication request",
+		}
+	}
+	// The auth_req_id is bound to the client it was issued to.
+	if !found || authRequest.ClientID != clientID {
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorInvalidGrant,
+			ErrorDescription: "Invalid auth_req_id",
+		}
+	}
+
+	now := time.Now()
+	if !now.Before(authRequest.ExpiryTime) {
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorExpiredToken,
+			ErrorDescription: "The auth_req_id has expired",
+		}
+	}
+
+	switch authRequest.Status {
+	case AuthRequestStatusAuthorized, AuthRequestStatusDenied:
+		deleted, err := s.store.Delete(ctx, authReqID)
+		if err != nil {
+			s.logger.Error("Failed to consume backchannel authentication request", log.Error(err))
+			return nil, &oauth2model.ErrorResponse{
+				Error:            oauth2const.ErrorServerError,
+				ErrorDescription: "Failed to consume backchannel authentication request",
+			}
+		}
+		// Another token request raced us to the delete; treat as already consumed.
+		if !deleted {
+			return nil, &oauth2model.ErrorResponse{
+				Error:            oauth2const.ErrorInvalidGrant,
+				ErrorDescription: "Invalid auth_req_id",
+			}
+		}
+		if authRequest.Status == AuthRequestStatusDenied {
+			return nil, &oauth2model.ErrorResponse{
+				Error:            oauth2const.ErrorAccessDenied,
+				ErrorDescription: "The user denied the authentication request",
+			}
+		}
+		return &authRequest, nil
+	default:
+		tooFast := authRequest.DeliveryMode == oauth2const.BackchannelTokenDeliveryModePoll &&
+			!authRequest.LastPolledAt.IsZero() &&
+			now.Sub(authRequest.LastPolledAt) < time.Duration(authRequest.Interval)*time.Second
+		authRequest.LastPolledAt = now
+		if err := s.store.UpdateLastPolledAt(ctx, authRequest); err != nil {
+			s.logger.Error("Failed to record backchannel authentication poll", log.Error(err))
+			return nil, &oauth2model.ErrorResponse{
+				Error:            oauth2const.ErrorServerError,
+				ErrorDescription: "Failed to process backchannel authentication request",
+			}
+		}
+		if tooFast {
+			return nil, &oauth2model.ErrorResponse{
+				Error:            oauth2const.ErrorSlowDown,
+				ErrorDescription: "The client is polling too frequently",
+			}
+		}
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorAuthorizationPending,
+			ErrorDescription: "The authentication request is still pending",
+		}
+	}
+}
+
+// resolveUser identifies the user the request is made for from the single hint supplied by the client.
+func (s *cibaService) resolveUser(ctx context.Context, params BackchannelAuthRequestParams) (string, string, string) {
+	hintCount := 0
+	for _, hint := range []string{params.LoginHint, params.LoginHintToken, params.IDTokenHint} {
+		if hint != "" {
+			hintCount++
+		}
+	}
+	if hintCount != 1 {
+		return "", oauth2const.ErrorInvalidRequest,
+			"Exactly one of login_hint, login_hint_token or id_token_hint is required"
+	}
+
+	switch {
+	case params.LoginHintToken != "":
+		return "", oauth2const.ErrorInvalidRequest, "login_hint_token is not supported"
+	case params.IDTokenHint != "":
+		return s.resolveUserFromIDTokenHint(ctx, params.IDTokenHint)
+	default:
+		return s.resolveUserFromLoginHint(params.LoginHint)
+	}
+}
+
+// resolveUserFromLoginHint looks the login hint up against each configured user attribute in order.
+func (s *cibaService) resolveUserFromLoginHint(loginHint string) (string, string, string) {
+	for _, attribute := range config.GetServerRuntime().Config.OAuth.CIBA.LoginHintAttributes {
+		userID, epErr := s.entityProvider.IdentifyEntity(map[string]interface{}{attribute: loginHint})
+		if epErr != nil {
+			switch epErr.Code {
+			case entityprovider.ErrorCodeEntityNotFound:
+				continue
+			case entityprovider.ErrorCodeAmbiguousEntity:
+				s.logger.Debug("The login_hint matches more than one user", log.String("attribute", attribute))
+				return "", oauth2const.ErrorUnknownUserID, "The user could not be identified"
+			default:
+				s.logger.Error("Failed to identify the user from the login_hint", log.Error(epErr))
+				return "", oauth2const.ErrorServerError, "Failed to process backchannel authentication request"
+			}
+		}
+		if userID != nil && *userID != "" {
+			return *userID, "", ""
+		}
+	}
+	return "", oauth2const.ErrorUnknownUserID, "The user could not be identified"
+}
+
+// resolveUserFromIDTokenHint validates an ID token previously issued by this server and returns its subject.
+func (s *cibaService) resolveUserFromIDTokenHint(
+	ctx context.Context, idTokenHint string,
+) (string, string, string) {
+	hintClaims, err := s.tokenValidator.ValidateIDTokenHint(ctx, idTokenHint)
+	if err != nil {
+		s.logger.Debug("Invalid id_token_hint", log.Error(err))
+		return "", oauth2const.ErrorInvalidRequest, "Invalid id_token_hint"
+	}
+	return hintClaims.Sub, "", ""
+}
+
+// startApprovalFlow initiates the backchannel authentication flow for the identified user and runs it
+// up to the point where the approval link has been sent to the user.
+func (s *cibaService) startApprovalFlow(
+	ctx context.Context, authRequest BackchannelAuthRequest, acrValues string, app *inboundmodel.OAuthClient,
+) (string, string) {
+	essentialAttributes, optionalAttributes := authz.GetRequiredAttributes(
+		authRequest.StandardScopes, nil, string(oauth2const.ResponseTypeCode), app)
+	runtimeData := map[string]string{
+		flowcm.RuntimeKeyUserID:                        authRequest.UserID,
+		flowcm.RuntimeKeyStoredBackchannelToken:        authRequest.ApprovalToken,
+		flowcm.RuntimeKeyBindingMessage:                authRequest.BindingMessage,
+		flowcm.RuntimeKeyClientID:                      authRequest.ClientID,
+		flowcm.RuntimeKeyRequestedPermissions:          utils.StringifyStringArray(authRequest.PermissionScopes, " "),
+		flowcm.RuntimeKeyRequiredEssentialAttributes:   essentialAttributes,
+		flowcm.RuntimeKeyRequiredOptionalAttributes:    optionalAttributes,
+		flowcm.RuntimeKeyUserAttributesCacheTTLSeconds: fmt.Sprintf("%d", resolveUserAttributesCacheTTL(app)),
+	}
+	if resolved := requestvalidator.ResolveACRValues(acrValues, app.AcrValues); resolved != "" {
+		runtimeData[flowcm.RuntimeKeyRequestedAuthClasses] = resolved
+	}
+
+	flowType := string(flowcm.FlowTypeBackchannelAuthentication)
+	executionID, flowErr := s.flowExecService.InitiateFlow(ctx, &flowexec.FlowInitContext{
+		ApplicationID: app.ID,
+		FlowType:      flowType,
+		RuntimeData:   runtimeData,
+	})
+	if flowErr != nil {
+		s.logger.Error("Failed to initiate backchannel authentication flow", log.String("error_code", flowErr.Code))
+		return oauth2const.ErrorServerError, "Failed to process backchannel authentication request"
+	}
+
+	step, flowErr := s.flowExecService.Execute(ctx, app.ID, executionID, flowType, false, "", nil, "")
+	if flowErr != nil {
+		s.logger.Error("Failed to execute backchannel authentication flow", log.String("error_code", flowErr.Code))
+		return oauth2const.ErrorServerError, "Failed to process backchannel authentication request"
+	}
+	if step.Status == flowcm.FlowStatusError {
+		s.logger.Error("Failed to notify the user of the backchannel authentication request",
+			log.String("reason", step.FailureReason))
+		return oauth2const.ErrorServerError, "Failed to notify the user"
+	}
+	return "", ""
+}
+
+// resolveUserAttributesCacheTTL computes the attribute cache TTL for a backchannel authentication
+// request. The largest of the access and refresh token (if allowed) validity periods is taken as the
+// base, then the request lifetime is added to cover the window between approval and the client's
+// token request, along with the fixed buffer applied to authorization code flows.
+func resolveUserAttributesCacheTTL(app *inboundmodel.OAuthClient) int64 {
+	maxTTL := tokenservice.ResolveTokenConfig(app, tokenservice.TokenTypeAccess).ValidityPeriod
+	if app.IsAllowedGrantType(oauth2const.GrantTypeRefreshToken) {
+		refreshTTL := tokenservice.ResolveTokenConfig(app, tokenservice.TokenTypeRefresh).ValidityPeriod
+		if refreshTTL > maxTTL {
+			maxTTL = refreshTTL
+		}
+	}
+	expiresIn := config.GetServerRuntime().Config.OAuth.CIBA.ExpiresIn
+	return maxTTL + expiresIn + oauth2const.AttributeCacheTTLBufferSeconds
+}
+
+// generateRandomKey generates a cryptographically random, URL-safe key.
+func generateRandomKey() (string, error) {
+	b := make([]byte, randomKeyBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
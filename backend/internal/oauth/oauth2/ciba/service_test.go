@@ -0,0 +1,709 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	flowcm "github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/flowexec"
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
+	"github.com/asgardeo/thunder/internal/resource"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/tests/mocks/entityprovidermock"
+	"github.com/asgardeo/thunder/tests/mocks/flow/flowexecmock"
+	"github.com/asgardeo/thunder/tests/mocks/jose/jwtmock"
+	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/tokenservicemock"
+	"github.com/asgardeo/thunder/tests/mocks/resourcemock"
+)
+
+const (
+	testClientID      = "test-client"
+	testAppID         = "test-app-id"
+	testUserID        = "test-user"
+	testAuthReqID     = "test-auth-req-id"
+	testApprovalToken = "test-approval-token"
+	testExecutionID   = "test-execution-id"
+	testIssuer        = "https://localhost:8090"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	ctx                 context.Context
+	mockStore           *cibaStoreInterfaceMock
+	mockNotifier        *pingNotifierInterfaceMock
+	mockJWTService      *jwtmock.JWTServiceInterfaceMock
+	mockTokenValidator  *tokenservicemock.TokenValidatorInterfaceMock
+	mockFlowExecService *flowexecmock.FlowExecServiceInterfaceMock
+	mockResourceService *resourcemock.ResourceServiceInterfaceMock
+	mockEntityProvider  *entityprovidermock.EntityProviderInterfaceMock
+	service             BackchannelAuthServiceInterface
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	testConfig := &config.Config{
+		JWT: config.JWTConfig{Issuer: testIssuer, ValidityPeriod: 3600},
+		OAuth: config.OAuthConfig{
+			CIBA: config.CIBAConfig{
+				ExpiresIn:           300,
+				PollingInterval:     5,
+				LoginHintAttributes: []string{"username", "email"},
+			},
+		},
+	}
+	_ = config.InitializeServerRuntime("", testConfig)
+	s.ctx = context.Background()
+
+	s.mockStore = newCibaStoreInterfaceMock(s.T())
+	s.mockNotifier = newPingNotifierInterfaceMock(s.T())
+	s.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(s.T())
+	s.mockTokenValidator = tokenservicemock.NewTokenValidatorInterfaceMock(s.T())
+	s.mockFlowExecService = flowexecmock.NewFlowExecServiceInterfaceMock(s.T())
+	s.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(s.T())
+	s.mockResourceService = resourcemock.NewResourceServiceInterfaceMock(s.T())
+	s.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, mock.Anything).
+		Return(func(_ context.Context, identifier string) *resource.ResourceServer {
+			return &resource.ResourceServer{ID: identifier, Identifier: identifier}
+		}, func(_ context.Context, _ string) *serviceerror.ServiceError {
+			return nil
+		}).Maybe()
+	s.mockResourceService.On("ValidatePermissions", mock.Anything, mock.Anything, mock.Anything).
+		Return([]string{}, (*serviceerror.ServiceError)(nil)).Maybe()
+
+	s.service = newCIBAService(s.mockStore, s.mockNotifier, s.mockJWTService, s.mockTokenValidator,
+		s.mockFlowExecService, s.mockResourceService, s.mockEntityProvider)
+}
+
+func (s *ServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *ServiceTestSuite) newTestApp() *inboundmodel.OAuthClient {
+	return &inboundmodel.OAuthClient{
+		ID:                      testAppID,
+		ClientID:                testClientID,
+		GrantTypes:              []oauth2const.GrantType{oauth2const.GrantTypeCIBA},
+		TokenEndpointAuthMethod: oauth2const.TokenEndpointAuthMethodClientSecretBasic,
+	}
+}
+
+func (s *ServiceTestSuite) newPendingAuthRequest() BackchannelAuthRequest {
+	return BackchannelAuthRequest{
+		AuthReqID:      testAuthReqID,
+		ApprovalToken:  testApprovalToken,
+		ClientID:       testClientID,
+		UserID:         testUserID,
+		StandardScopes: []string{"openid"},
+		DeliveryMode:   oauth2const.BackchannelTokenDeliveryModePoll,
+		Status:         AuthRequestStatusPending,
+		Interval:       5,
+		ExpiryTime:     time.Now().Add(5 * time.Minute),
+	}
+}
+
+// expectUserLookup makes the login hint resolve to the test user through the username attribute.
+func (s *ServiceTestSuite) expectUserLookup(loginHint string) {
+	userID := testUserID
+	s.mockEntityProvider.EXPECT().IdentifyEntity(map[string]interface{}{"username": loginHint}).
+		Return(&userID, nil)
+}
+
+// expectApprovalFlow makes the approval flow start and pause after notifying the user.
+func (s *ServiceTestSuite) expectApprovalFlow() {
+	s.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return(testExecutionID, nil)
+	s.mockFlowExecService.EXPECT().Execute(mock.Anything, testAppID, testExecutionID,
+		string(flowcm.FlowTypeBackchannelAuthentication), false, "", mock.Anything, "").
+		Return(&flowexec.FlowStep{ExecutionID: testExecutionID, Status: flowcm.FlowStatusIncomplete}, nil)
+}
+
+// buildAssertion builds an unsigned JWT carrying the given claims. Signature verification is mocked.
+func buildAssertion(claims map[string]any) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	payload, _ := json.Marshal(claims)
+	return header + "." + base64.RawURLEncoding.EncodeToString(payload) + "."
+}
+
+// Tests for HandleBackchannelAuthRequest
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_Success() {
+	s.expectUserLookup("alice")
+	var stored BackchannelAuthRequest
+	s.mockStore.EXPECT().Create(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, authRequest BackchannelAuthRequest) {
+			stored = authRequest
+		}).Return(nil)
+	s.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything,
+		mock.MatchedBy(func(initCtx *flowexec.FlowInitContext) bool {
+			return initCtx.ApplicationID == testAppID &&
+				initCtx.FlowType == string(flowcm.FlowTypeBackchannelAuthentication) &&
+				initCtx.RuntimeData[flowcm.RuntimeKeyUserID] == testUserID &&
+				initCtx.RuntimeData[flowcm.RuntimeKeyStoredBackchannelToken] == stored.ApprovalToken &&
+				initCtx.RuntimeData[flowcm.RuntimeKeyBindingMessage] == "W4SCT" &&
+				initCtx.RuntimeData[flowcm.RuntimeKeyClientID] == testClientID &&
+				initCtx.RuntimeData[flowcm.RuntimeKeyRequestedPermissions] == "read"
+		})).Return(testExecutionID, nil)
+	s.mockFlowExecService.EXPECT().Execute(mock.Anything, testAppID, testExecutionID,
+		string(flowcm.FlowTypeBackchannelAuthentication), false, "", mock.Anything, "").
+		Return(&flowexec.FlowStep{ExecutionID: testExecutionID, Status: flowcm.FlowStatusIncomplete}, nil)
+
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+		Scope:          "openid read",
+		LoginHint:      "alice",
+		BindingMessage: "W4SCT",
+	}, s.newTestApp())
+
+	s.Empty(errCode)
+	s.NotNil(resp)
+	s.NotEmpty(resp.AuthReqID)
+	s.Equal(int64(300), resp.ExpiresIn)
+	s.Equal(int64(5), resp.Interval)
+
+	s.Equal(resp.AuthReqID, stored.AuthReqID)
+	s.NotEmpty(stored.ApprovalToken)
+	s.NotEqual(stored.AuthReqID, stored.ApprovalToken)
+	s.Equal(testUserID, stored.UserID)
+	s.Equal([]string{"openid"}, stored.StandardScopes)
+	s.Equal([]string{"read"}, stored.PermissionScopes)
+	s.Equal(oauth2const.BackchannelTokenDeliveryModePoll, stored.DeliveryMode)
+	s.Equal(AuthRequestStatusPending, stored.Status)
+	s.WithinDuration(time.Now().Add(5*time.Minute), stored.ExpiryTime, 5*time.Second)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_PingMode() {
+	app := s.newTestApp()
+	app.BackchannelTokenDeliveryMode = oauth2const.BackchannelTokenDeliveryModePing
+	s.expectUserLookup("alice")
+	s.mockStore.EXPECT().Create(mock.Anything, mock.MatchedBy(func(authRequest BackchannelAuthRequest) bool {
+		return authRequest.DeliveryMode == oauth2const.BackchannelTokenDeliveryModePing &&
+			authRequest.ClientNotificationToken == "notif-token"
+	})).Return(nil)
+	s.expectApprovalFlow()
+
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+		Scope:                   "openid",
+		LoginHint:               "alice",
+		ClientNotificationToken: "notif-token",
+	}, app)
+
+	s.Empty(errCode)
+	// The polling interval is only returned for the poll mode.
+	s.Zero(resp.Interval)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_PingModeWithoutNotificationToken() {
+	app := s.newTestApp()
+	app.BackchannelTokenDeliveryMode = oauth2const.BackchannelTokenDeliveryModePing
+
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+		Scope:     "openid",
+		LoginHint: "alice",
+	}, app)
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_GrantNotAllowed() {
+	app := s.newTestApp()
+	app.GrantTypes = []oauth2const.GrantType{oauth2const.GrantTypeAuthorizationCode}
+
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+		Scope:     "openid",
+		LoginHint: "alice",
+	}, app)
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorUnauthorizedClient, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_MissingOpenIDScope() {
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+		Scope:     "read",
+		LoginHint: "alice",
+	}, s.newTestApp())
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorInvalidScope, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_HintCount() {
+	cases := []BackchannelAuthRequestParams{
+		{Scope: "openid"},
+		{Scope: "openid", LoginHint: "alice", IDTokenHint: "id.token.hint"},
+	}
+	for _, params := range cases {
+		resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, params, s.newTestApp())
+
+		s.Nil(resp)
+		s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+	}
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_LoginHintTokenNotSupported() {
+	resp, errCode, errDesc := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+		Scope:          "openid",
+		LoginHintToken: "login.hint.token",
+	}, s.newTestApp())
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+	s.Equal("login_hint_token is not supported", errDesc)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_LoginHintFallsBackToNextAttribute() {
+	userID := testUserID
+	s.mockEntityProvider.EXPECT().IdentifyEntity(map[string]interface{}{"username": "alice@example.com"}).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "not found", ""))
+	s.mockEntityProvider.EXPECT().IdentifyEntity(map[string]interface{}{"email": "alice@example.com"}).
+		Return(&userID, nil)
+	s.mockStore.EXPECT().Create(mock.Anything, mock.MatchedBy(func(authRequest BackchannelAuthRequest) bool {
+		return authRequest.UserID == testUserID
+	})).Return(nil)
+	s.expectApprovalFlow()
+
+	_, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+		Scope:     "openid",
+		LoginHint: "alice@example.com",
+	}, s.newTestApp())
+
+	s.Empty(errCode)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_UnknownUser() {
+	notFound := entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "not found", "")
+	s.mockEntityProvider.EXPECT().IdentifyEntity(mock.Anything).Return(nil, notFound).Times(2)
+
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+		Scope:     "openid",
+		LoginHint: "nobody",
+	}, s.newTestApp())
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorUnknownUserID, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_AmbiguousUser() {
+	s.mockEntityProvider.EXPECT().IdentifyEntity(map[string]interface{}{"username": "alice"}).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeAmbiguousEntity, "ambiguous", ""))
+
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+		Scope:     "openid",
+		LoginHint: "alice",
+	}, s.newTestApp())
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorUnknownUserID, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_IDTokenHint() {
+	s.mockTokenValidator.EXPECT().ValidateIDTokenHint(mock.Anything, "id.token.hint").
+		Return(&tokenservice.IDTokenHintClaims{Sub: testUserID, ClientID: testClientID}, nil)
+	s.mockStore.EXPECT().Create(mock.Anything, mock.MatchedBy(func(authRequest BackchannelAuthRequest) bool {
+		return authRequest.UserID == testUserID
+	})).Return(nil)
+	s.expectApprovalFlow()
+
+	_, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+		Scope:       "openid",
+		IDTokenHint: "id.token.hint",
+	}, s.newTestApp())
+
+	s.Empty(errCode)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_InvalidIDTokenHint() {
+	s.mockTokenValidator.EXPECT().ValidateIDTokenHint(mock.Anything, "id.token.hint").
+		Return(nil, errors.New("id_token_hint is not an ID token"))
+
+	resp, errCode, errDesc := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+		Scope:       "openid",
+		IDTokenHint: "id.token.hint",
+	}, s.newTestApp())
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+	s.Equal("Invalid id_token_hint", errDesc)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_BindingMessageTooLong() {
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+		Scope:          "openid",
+		LoginHint:      "alice",
+		BindingMessage: string(make([]byte, maxBindingMessageLength+1)),
+	}, s.newTestApp())
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorInvalidBindingMessage, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_RequestedExpiry() {
+	s.expectUserLookup("alice")
+	s.mockStore.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+	s.expectApprovalFlow()
+
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+		Scope:           "openid",
+		LoginHint:       "alice",
+		RequestedExpiry: "120",
+	}, s.newTestApp())
+
+	s.Empty(errCode)
+	s.Equal(int64(120), resp.ExpiresIn)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_RequestedExpiryCappedByConfig() {
+	s.expectUserLookup("alice")
+	s.mockStore.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+	s.expectApprovalFlow()
+
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+		Scope:           "openid",
+		LoginHint:       "alice",
+		RequestedExpiry: "3600",
+	}, s.newTestApp())
+
+	s.Empty(errCode)
+	s.Equal(int64(300), resp.ExpiresIn)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_InvalidRequestedExpiry() {
+	for _, requestedExpiry := range []string{"abc", "0", "-5"} {
+		resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+			Scope:           "openid",
+			LoginHint:       "alice",
+			RequestedExpiry: requestedExpiry,
+		}, s.newTestApp())
+
+		s.Nil(resp)
+		s.Equal(oauth2const.ErrorInvalidRequest, errCode, requestedExpiry)
+	}
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_StoreError() {
+	s.expectUserLookup("alice")
+	s.mockStore.EXPECT().Create(mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+		Scope:     "openid",
+		LoginHint: "alice",
+	}, s.newTestApp())
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorServerError, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_FlowInitiationError() {
+	s.expectUserLookup("alice")
+	s.mockStore.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+	s.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).
+		Return("", &serviceerror.InternalServerError)
+	s.mockStore.EXPECT().Delete(mock.Anything, mock.Anything).Return(true, nil)
+
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+		Scope:     "openid",
+		LoginHint: "alice",
+	}, s.newTestApp())
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorServerError, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_NotificationFailure() {
+	s.expectUserLookup("alice")
+	s.mockStore.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+	s.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return(testExecutionID, nil)
+	s.mockFlowExecService.EXPECT().Execute(mock.Anything, testAppID, testExecutionID, mock.Anything,
+		false, "", mock.Anything, "").
+		Return(&flowexec.FlowStep{Status: flowcm.FlowStatusError, FailureReason: "No email address"}, nil)
+	s.mockStore.EXPECT().Delete(mock.Anything, mock.Anything).Return(true, nil)
+
+	resp, errCode, errDesc := s.service.HandleBackchannelAuthRequest(s.ctx, BackchannelAuthRequestParams{
+		Scope:     "openid",
+		LoginHint: "alice",
+	}, s.newTestApp())
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorServerError, errCode)
+	s.Equal("Failed to notify the user", errDesc)
+}
+
+// Tests for CompleteApproval
+
+func (s *ServiceTestSuite) TestCompleteApproval_Approved() {
+	assertion := buildAssertion(map[string]any{
+		"sub":                    testUserID,
+		"iat":                    1701421200,
+		"authorized_permissions": "read",
+		"aci":                    "test-cache-id",
+	})
+	s.mockStore.EXPECT().GetByApprovalToken(mock.Anything, testApprovalToken).
+		Return(s.newPendingAuthRequest(), true, nil)
+	s.mockJWTService.EXPECT().VerifyJWT(assertion, "", "").Return(nil)
+	s.mockStore.EXPECT().Update(mock.Anything, mock.MatchedBy(func(a BackchannelAuthRequest) bool {
+		return a.Status == AuthRequestStatusAuthorized && a.AuthorizedUserID == testUserID &&
+			a.AttributeCacheID == "test-cache-id" && a.AuthTime.Equal(time.Unix(1701421200, 0)) &&
+			assert.ObjectsAreEqual([]string{"read"}, a.PermissionScopes)
+	})).Return(nil)
+
+	status, errCode, _ := s.service.CompleteApproval(s.ctx, testApprovalToken, assertion, false)
+
+	s.Empty(errCode)
+	s.Equal(AuthRequestStatusAuthorized, status)
+}
+
+func (s *ServiceTestSuite) TestCompleteApproval_Denied() {
+	s.mockStore.EXPECT().GetByApprovalToken(mock.Anything, testApprovalToken).
+		Return(s.newPendingAuthRequest(), true, nil)
+	s.mockStore.EXPECT().Update(mock.Anything, mock.MatchedBy(func(a BackchannelAuthRequest) bool {
+		return a.Status == AuthRequestStatusDenied && a.AuthorizedUserID == ""
+	})).Return(nil)
+
+	status, errCode, _ := s.service.CompleteApproval(s.ctx, testApprovalToken, "", true)
+
+	s.Empty(errCode)
+	s.Equal(AuthRequestStatusDenied, status)
+}
+
+func (s *ServiceTestSuite) TestCompleteApproval_PingModeNotifiesClient() {
+	authRequest := s.newPendingAuthRequest()
+	authRequest.DeliveryMode = oauth2const.BackchannelTokenDeliveryModePing
+	s.mockStore.EXPECT().GetByApprovalToken(mock.Anything, testApprovalToken).Return(authRequest, true, nil)
+	s.mockStore.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+	s.mockNotifier.EXPECT().NotifyClient(mock.Anything, mock.MatchedBy(func(a BackchannelAuthRequest) bool {
+		return a.AuthReqID == testAuthReqID && a.Status == AuthRequestStatusDenied
+	})).Return()
+
+	status, errCode, _ := s.service.CompleteApproval(s.ctx, testApprovalToken, "", true)
+
+	s.Empty(errCode)
+	s.Equal(AuthRequestStatusDenied, status)
+}
+
+func (s *ServiceTestSuite) TestCompleteApproval_SubjectMismatch() {
+	assertion := buildAssertion(map[string]any{"sub": "other-user"})
+	s.mockStore.EXPECT().GetByApprovalToken(mock.Anything, testApprovalToken).
+		Return(s.newPendingAuthRequest(), true, nil)
+	s.mockJWTService.EXPECT().VerifyJWT(assertion, "", "").Return(nil)
+
+	status, errCode, errDesc := s.service.CompleteApproval(s.ctx, testApprovalToken, assertion, false)
+
+	s.Empty(status)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+	s.Equal("Invalid assertion", errDesc)
+}
+
+func (s *ServiceTestSuite) TestCompleteApproval_TokenNotFound() {
+	s.mockStore.EXPECT().GetByApprovalToken(mock.Anything, testApprovalToken).
+		Return(BackchannelAuthRequest{}, false, nil)
+
+	status, errCode, errDesc := s.service.CompleteApproval(s.ctx, testApprovalToken, "", true)
+
+	s.Empty(status)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+	s.Equal("Invalid or expired backchannel token", errDesc)
+}
+
+func (s *ServiceTestSuite) TestCompleteApproval_AlreadyCompleted() {
+	authRequest := s.newPendingAuthRequest()
+	authRequest.Status = AuthRequestStatusAuthorized
+	s.mockStore.EXPECT().GetByApprovalToken(mock.Anything, testApprovalToken).Return(authRequest, true, nil)
+
+	status, errCode, _ := s.service.CompleteApproval(s.ctx, testApprovalToken, "", true)
+
+	s.Empty(status)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+}
+
+func (s *ServiceTestSuite) TestCompleteApproval_MissingAssertion() {
+	s.mockStore.EXPECT().GetByApprovalToken(mock.Anything, testApprovalToken).
+		Return(s.newPendingAuthRequest(), true, nil)
+
+	status, errCode, errDesc := s.service.CompleteApproval(s.ctx, testApprovalToken, "", false)
+
+	s.Empty(status)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+	s.Equal("Assertion is required", errDesc)
+}
+
+func (s *ServiceTestSuite) TestCompleteApproval_InvalidAssertionSignature() {
+	s.mockStore.EXPECT().GetByApprovalToken(mock.Anything, testApprovalToken).
+		Return(s.newPendingAuthRequest(), true, nil)
+	s.mockJWTService.EXPECT().VerifyJWT("invalid-assertion", "", "").Return(&jwt.ErrorInvalidTokenSignature)
+
+	status, errCode, _ := s.service.CompleteApproval(s.ctx, testApprovalToken, "invalid-assertion", false)
+
+	s.Empty(status)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+}
+
+func (s *ServiceTestSuite) TestCompleteApproval_StoreError() {
+	s.mockStore.EXPECT().GetByApprovalToken(mock.Anything, testApprovalToken).
+		Return(BackchannelAuthRequest{}, false, errors.New("db error"))
+
+	status, errCode, _ := s.service.CompleteApproval(s.ctx, testApprovalToken, "", true)
+
+	s.Empty(status)
+	s.Equal(oauth2const.ErrorServerError, errCode)
+}
+
+func (s *ServiceTestSuite) TestCompleteApproval_UpdateError() {
+	s.mockStore.EXPECT().GetByApprovalToken(mock.Anything, testApprovalToken).
+		Return(s.newPendingAuthRequest(), true, nil)
+	s.mockStore.EXPECT().Update(mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	status, errCode, _ := s.service.CompleteApproval(s.ctx, testApprovalToken, "", true)
+
+	s.Empty(status)
+	s.Equal(oauth2const.ErrorServerError, errCode)
+}
+
+// Tests for ConsumeBackchannelAuthRequest
+
+func (s *ServiceTestSuite) TestConsumeBackchannelAuthRequest_Authorized() {
+	authRequest := s.newPendingAuthRequest()
+	authRequest.Status = AuthRequestStatusAuthorized
+	authRequest.AuthorizedUserID = testUserID
+	s.mockStore.EXPECT().GetByAuthReqID(mock.Anything, testAuthReqID).Return(authRequest, true, nil)
+	s.mockStore.EXPECT().Delete(mock.Anything, testAuthReqID).Return(true, nil)
+
+	result, errResp := s.service.ConsumeBackchannelAuthRequest(s.ctx, testClientID, testAuthReqID)
+
+	s.Nil(errResp)
+	s.Equal(testUserID, result.AuthorizedUserID)
+}
+
+func (s *ServiceTestSuite) TestConsumeBackchannelAuthRequest_Denied() {
+	authRequest := s.newPendingAuthRequest()
+	authRequest.Status = AuthRequestStatusDenied
+	s.mockStore.EXPECT().GetByAuthReqID(mock.Anything, testAuthReqID).Return(authRequest, true, nil)
+	s.mockStore.EXPECT().Delete(mock.Anything, testAuthReqID).Return(true, nil)
+
+	result, errResp := s.service.ConsumeBackchannelAuthRequest(s.ctx, testClientID, testAuthReqID)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorAccessDenied, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestConsumeBackchannelAuthRequest_AlreadyConsumed() {
+	authRequest := s.newPendingAuthRequest()
+	authRequest.Status = AuthRequestStatusAuthorized
+	s.mockStore.EXPECT().GetByAuthReqID(mock.Anything, testAuthReqID).Return(authRequest, true, nil)
+	s.mockStore.EXPECT().Delete(mock.Anything, testAuthReqID).Return(false, nil)
+
+	result, errResp := s.service.ConsumeBackchannelAuthRequest(s.ctx, testClientID, testAuthReqID)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidGrant, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestConsumeBackchannelAuthRequest_Pending() {
+	s.mockStore.EXPECT().GetByAuthReqID(mock.Anything, testAuthReqID).
+		Return(s.newPendingAuthRequest(), true, nil)
+	s.mockStore.EXPECT().UpdateLastPolledAt(mock.Anything, mock.MatchedBy(func(a BackchannelAuthRequest) bool {
+		return !a.LastPolledAt.IsZero()
+	})).Return(nil)
+
+	result, errResp := s.service.ConsumeBackchannelAuthRequest(s.ctx, testClientID, testAuthReqID)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorAuthorizationPending, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestConsumeBackchannelAuthRequest_SlowDown() {
+	authRequest := s.newPendingAuthRequest()
+	authRequest.LastPolledAt = time.Now().Add(-time.Second)
+	s.mockStore.EXPECT().GetByAuthReqID(mock.Anything, testAuthReqID).Return(authRequest, true, nil)
+	s.mockStore.EXPECT().UpdateLastPolledAt(mock.Anything, mock.Anything).Return(nil)
+
+	result, errResp := s.service.ConsumeBackchannelAuthRequest(s.ctx, testClientID, testAuthReqID)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorSlowDown, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestConsumeBackchannelAuthRequest_PingModeNoSlowDown() {
+	authRequest := s.newPendingAuthRequest()
+	authRequest.DeliveryMode = oauth2const.BackchannelTokenDeliveryModePing
+	authRequest.LastPolledAt = time.Now().Add(-time.Second)
+	s.mockStore.EXPECT().GetByAuthReqID(mock.Anything, testAuthReqID).Return(authRequest, true, nil)
+	s.mockStore.EXPECT().UpdateLastPolledAt(mock.Anything, mock.Anything).Return(nil)
+
+	_, errResp := s.service.ConsumeBackchannelAuthRequest(s.ctx, testClientID, testAuthReqID)
+
+	s.Equal(oauth2const.ErrorAuthorizationPending, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestConsumeBackchannelAuthRequest_Expired() {
+	authRequest := s.newPendingAuthRequest()
+	authRequest.ExpiryTime = time.Now().Add(-time.Second)
+	s.mockStore.EXPECT().GetByAuthReqID(mock.Anything, testAuthReqID).Return(authRequest, true, nil)
+
+	result, errResp := s.service.ConsumeBackchannelAuthRequest(s.ctx, testClientID, testAuthReqID)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorExpiredToken, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestConsumeBackchannelAuthRequest_ClientMismatch() {
+	s.mockStore.EXPECT().GetByAuthReqID(mock.Anything, testAuthReqID).
+		Return(s.newPendingAuthRequest(), true, nil)
+
+	result, errResp := s.service.ConsumeBackchannelAuthRequest(s.ctx, "other-client", testAuthReqID)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidGrant, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestConsumeBackchannelAuthRequest_NotFound() {
+	s.mockStore.EXPECT().GetByAuthReqID(mock.Anything, testAuthReqID).
+		Return(BackchannelAuthRequest{}, false, nil)
+
+	result, errResp := s.service.ConsumeBackchannelAuthRequest(s.ctx, testClientID, testAuthReqID)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidGrant, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestConsumeBackchannelAuthRequest_StoreError() {
+	s.mockStore.EXPECT().GetByAuthReqID(mock.Anything, testAuthReqID).
+		Return(BackchannelAuthRequest{}, false, errors.New("db error"))
+
+	result, errResp := s.service.ConsumeBackchannelAuthRequest(s.ctx, testClientID, testAuthReqID)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorServerError, errResp.Error)
+}
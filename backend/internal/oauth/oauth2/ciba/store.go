@@ -0,0 +1,197 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+	dbutils "github.com/asgardeo/thunder/internal/system/database/utils"
+)
+
+// cibaStoreInterface defines the interface for backchannel authentication request storage.
+type cibaStoreInterface interface {
+	Create(ctx context.Context, authRequest BackchannelAuthRequest) error
+	GetByAuthReqID(ctx context.Context, authReqID string) (BackchannelAuthRequest, bool, error)
+	GetByApprovalToken(ctx context.Context, approvalToken string) (BackchannelAuthRequest, bool, error)
+	Update(ctx context.Context, authRequest BackchannelAuthRequest) error
+	UpdateLastPolledAt(ctx context.Context, authRequest BackchannelAuthRequest) error
+	Delete(ctx context.Context, authReqID string) (bool, error)
+}
+
+// backchannelAuthRequestStore is the relational-DB-backed implementation of cibaStoreInterface.
+type backchannelAuthRequestStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newBackchannelAuthRequestStore creates a new DB-backed backchannel authentication request store.
+func newBackchannelAuthRequestStore(deploymentID string) cibaStoreInterface {
+	return &backchannelAuthRequestStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// Create persists a new backchannel authentication request.
+func (s *backchannelAuthRequestStore) Create(ctx context.Context, authRequest BackchannelAuthRequest) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	data, err := json.Marshal(authRequest)
+	if err != nil {
+		return fmt.Errorf("failed to marshal backchannel authentication request: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryInsertBackchannelAuthRequest, authRequest.AuthReqID,
+		s.deploymentID, authRequest.ApprovalToken, data, authRequest.ExpiryTime.UTC()); err != nil {
+		return fmt.Errorf("failed to insert backchannel authentication request: %w", err)
+	}
+	return nil
+}
+
+// GetByAuthReqID retrieves a backchannel authentication request by its auth_req_id.
+// Expired requests are returned as well so that the caller can report expired_token.
+func (s *backchannelAuthRequestStore) GetByAuthReqID(
+	ctx context.Context, authReqID string,
+) (BackchannelAuthRequest, bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return BackchannelAuthRequest{}, false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetBackchannelAuthRequestByAuthReqID, authReqID, s.deploymentID)
+	if err != nil {
+		return BackchannelAuthRequest{}, false, fmt.Errorf("failed to query backchannel authentication request: %w", err)
+	}
+	if len(results) == 0 {
+		return BackchannelAuthRequest{}, false, nil
+	}
+
+	authRequest, err := buildAuthRequestFromRow(results[0])
+	if err != nil {
+		return BackchannelAuthRequest{}, false, err
+	}
+	return authRequest, true, nil
+}
+
+// GetByApprovalToken retrieves an unexpired backchannel authentication request by its approval token.
+func (s *backchannelAuthRequestStore) GetByApprovalToken(
+	ctx context.Context, approvalToken string,
+) (BackchannelAuthRequest, bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return BackchannelAuthRequest{}, false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetBackchannelAuthRequestByApprovalToken, approvalToken,
+		time.Now().UTC(), s.deploymentID)
+	if err != nil {
+		return BackchannelAuthRequest{}, false, fmt.Errorf("failed to query backchannel authentication request: %w", err)
+	}
+	if len(results) == 0 {
+		return BackchannelAuthRequest{}, false, nil
+	}
+
+	authRequest, err := buildAuthRequestFromRow(results[0])
+	if err != nil {
+		return BackchannelAuthRequest{}, false, err
+	}
+	return authRequest, true, nil
+}
+
+// Update replaces the stored data of a backchannel authentication request.
+func (s *backchannelAuthRequestStore) Update(ctx context.Context, authRequest BackchannelAuthRequest) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	data, err := json.Marshal(authRequest)
+	if err != nil {
+		return fmt.Errorf("failed to marshal backchannel authentication request: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateBackchannelAuthRequestData, data,
+		authRequest.AuthReqID, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to update backchannel authentication request: %w", err)
+	}
+	return nil
+}
+
+// UpdateLastPolledAt records the time at which the client last polled the token endpoint.
+func (s *backchannelAuthRequestStore) UpdateLastPolledAt(
+	ctx context.Context, authRequest BackchannelAuthRequest,
+) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateBackchannelAuthRequestLastPolledAt,
+		authRequest.LastPolledAt.UTC(), authRequest.AuthReqID, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to update backchannel authentication request poll time: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a backchannel authentication request. Returns false if the request did not exist,
+// which indicates that a concurrent request already consumed it.
+func (s *backchannelAuthRequestStore) Delete(ctx context.Context, authReqID string) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteBackchannelAuthRequest, authReqID, s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete backchannel authentication request: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// buildAuthRequestFromRow reconstructs a BackchannelAuthRequest from a database row.
+func buildAuthRequestFromRow(row map[string]any) (BackchannelAuthRequest, error) {
+	var dataJSON []byte
+	if val, ok := row[dbColumnRequestData].(string); ok && val != "" {
+		dataJSON = []byte(val)
+	} else if val, ok := row[dbColumnRequestData].([]byte); ok && len(val) > 0 {
+		dataJSON = val
+	} else {
+		return BackchannelAuthRequest{}, errors.New("request_data is missing or of unexpected type")
+	}
+
+	var authRequest BackchannelAuthRequest
+	if err := json.Unmarshal(dataJSON, &authRequest); err != nil {
+		return BackchannelAuthRequest{}, fmt.Errorf("failed to unmarshal backchannel authentication request: %w", err)
+	}
+
+	lastPolledAt, err := dbutils.ParseNullableTimeField(row[dbColumnLastPolledAt], dbColumnLastPolledAt)
+	if err != nil {
+		return BackchannelAuthRequest{}, err
+	}
+	authRequest.LastPolledAt = lastPolledAt
+	return authRequest, nil
+}
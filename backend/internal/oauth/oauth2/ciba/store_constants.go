@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import dbmodel "github.com/asgardeo/thunder/internal/system/database/model"
+
+// Database column names for backchannel authentication request storage.
+const (
+	dbColumnRequestData  = "request_data"
+	dbColumnLastPolledAt = "last_polled_at"
+)
+
+var queryInsertBackchannelAuthRequest = dbmodel.DBQuery{
+	ID: "CBQ-CBS-01",
+	Query: `INSERT INTO "BACKCHANNEL_AUTH_REQUEST" (AUTH_REQ_ID, DEPLOYMENT_ID, APPROVAL_TOKEN, REQUEST_DATA, ` +
+		`EXPIRY_TIME) VALUES ($1, $2, $3, $4, $5)`,
+}
+
+var queryGetBackchannelAuthRequestByAuthReqID = dbmodel.DBQuery{
+	ID: "CBQ-CBS-02",
+	Query: `SELECT REQUEST_DATA, LAST_POLLED_AT FROM "BACKCHANNEL_AUTH_REQUEST" ` +
+		`WHERE AUTH_REQ_ID = $1 AND DEPLOYMENT_ID = $2`,
+}
+
+var queryGetBackchannelAuthRequestByApprovalToken = dbmodel.DBQuery{
+	ID: "CBQ-CBS-03",
+	Query: `SELECT REQUEST_DATA, LAST_POLLED_AT FROM "BACKCHANNEL_AUTH_REQUEST" ` +
+		`WHERE APPROVAL_TOKEN = $1 AND EXPIRY_TIME > $2 AND DEPLOYMENT_ID = $3`,
+}
+
+var queryUpdateBackchannelAuthRequestData = dbmodel.DBQuery{
+	ID: "CBQ-CBS-04",
+	Query: `UPDATE "BACKCHANNEL_AUTH_REQUEST" SET REQUEST_DATA = $1 ` +
+		`WHERE AUTH_REQ_ID = $2 AND DEPLOYMENT_ID = $3`,
+}
+
+var queryUpdateBackchannelAuthRequestLastPolledAt = dbmodel.DBQuery{
+	ID: "CBQ-CBS-05",
+	Query: `UPDATE "BACKCHANNEL_AUTH_REQUEST" SET LAST_POLLED_AT = $1 ` +
+		`WHERE AUTH_REQ_ID = $2 AND DEPLOYMENT_ID = $3`,
+}
+
+var queryDeleteBackchannelAuthRequest = dbmodel.DBQuery{
+	ID:    "CBQ-CBS-06",
+	Query: `DELETE FROM "BACKCHANNEL_AUTH_REQUEST" WHERE AUTH_REQ_ID = $1 AND DEPLOYMENT_ID = $2`,
+}
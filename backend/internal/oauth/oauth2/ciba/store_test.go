@@ -0,0 +1,282 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type StoreTestSuite struct {
+	suite.Suite
+	mockDBProvider  *providermock.DBProviderInterfaceMock
+	mockDBClient    *providermock.DBClientInterfaceMock
+	store           *backchannelAuthRequestStore
+	ctx             context.Context
+	testAuthRequest BackchannelAuthRequest
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (s *StoreTestSuite) SetupTest() {
+	s.mockDBProvider = &providermock.DBProviderInterfaceMock{}
+	s.mockDBClient = &providermock.DBClientInterfaceMock{}
+	s.store = &backchannelAuthRequestStore{
+		dbProvider:   s.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+	s.testAuthRequest = BackchannelAuthRequest{
+		AuthReqID:        testAuthReqID,
+		ApprovalToken:    testApprovalToken,
+		ClientID:         testClientID,
+		StandardScopes:   []string{"openid"},
+		PermissionScopes: []string{"read"},
+		Status:           AuthRequestStatusPending,
+		Interval:         5,
+		ExpiryTime:       time.Now().Add(10 * time.Minute).UTC().Truncate(time.Second),
+	}
+}
+
+func (s *StoreTestSuite) authRequestRow(lastPolledAt any) map[string]any {
+	data, _ := json.Marshal(s.testAuthRequest)
+	return map[string]any{
+		dbColumnRequestData:  string(data),
+		dbColumnLastPolledAt: lastPolledAt,
+	}
+}
+
+// Tests for Create
+
+func (s *StoreTestSuite) TestCreate_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertBackchannelAuthRequest,
+		testAuthReqID, testDeploymentID, testApprovalToken, mock.Anything, s.testAuthRequest.ExpiryTime,
+	).Return(int64(1), nil)
+
+	err := s.store.Create(s.ctx, s.testAuthRequest)
+
+	assert.NoError(s.T(), err)
+	s.mockDBClient.AssertExpectations(s.T())
+}
+
+func (s *StoreTestSuite) TestCreate_DBClientError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db error"))
+
+	err := s.store.Create(s.ctx, s.testAuthRequest)
+
+	assert.Error(s.T(), err)
+}
+
+func (s *StoreTestSuite) TestCreate_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertBackchannelAuthRequest,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(int64(0), errors.New("insert error"))
+
+	err := s.store.Create(s.ctx, s.testAuthRequest)
+
+	assert.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "failed to insert backchannel authentication request")
+}
+
+// Tests for GetByAuthReqID
+
+func (s *StoreTestSuite) TestGetByAuthReqID_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetBackchannelAuthRequestByAuthReqID,
+		testAuthReqID, testDeploymentID,
+	).Return([]map[string]any{s.authRequestRow("2026-01-02 03:04:05.123456")}, nil)
+
+	result, found, err := s.store.GetByAuthReqID(s.ctx, testAuthReqID)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), found)
+	assert.Equal(s.T(), testClientID, result.ClientID)
+	assert.Equal(s.T(), testApprovalToken, result.ApprovalToken)
+	assert.Equal(s.T(), []string{"read"}, result.PermissionScopes)
+	assert.True(s.T(), s.testAuthRequest.ExpiryTime.Equal(result.ExpiryTime))
+	assert.Equal(s.T(), time.Date(2026, 1, 2, 3, 4, 5, 123456000, time.UTC), result.LastPolledAt)
+}
+
+func (s *StoreTestSuite) TestGetByAuthReqID_NeverPolled() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetBackchannelAuthRequestByAuthReqID,
+		testAuthReqID, testDeploymentID,
+	).Return([]map[string]any{s.authRequestRow(nil)}, nil)
+
+	result, found, err := s.store.GetByAuthReqID(s.ctx, testAuthReqID)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), found)
+	assert.True(s.T(), result.LastPolledAt.IsZero())
+}
+
+func (s *StoreTestSuite) TestGetByAuthReqID_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetBackchannelAuthRequestByAuthReqID,
+		testAuthReqID, testDeploymentID,
+	).Return([]map[string]any{}, nil)
+
+	_, found, err := s.store.GetByAuthReqID(s.ctx, testAuthReqID)
+
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), found)
+}
+
+func (s *StoreTestSuite) TestGetByAuthReqID_QueryError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetBackchannelAuthRequestByAuthReqID,
+		testAuthReqID, testDeploymentID,
+	).Return(nil, errors.New("query error"))
+
+	_, found, err := s.store.GetByAuthReqID(s.ctx, testAuthReqID)
+
+	assert.Error(s.T(), err)
+	assert.False(s.T(), found)
+}
+
+func (s *StoreTestSuite) TestGetByAuthReqID_InvalidData() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetBackchannelAuthRequestByAuthReqID,
+		testAuthReqID, testDeploymentID,
+	).Return([]map[string]any{{dbColumnRequestData: 42}}, nil)
+
+	_, found, err := s.store.GetByAuthReqID(s.ctx, testAuthReqID)
+
+	assert.Error(s.T(), err)
+	assert.False(s.T(), found)
+}
+
+// Tests for GetByApprovalToken
+
+func (s *StoreTestSuite) TestGetByApprovalToken_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetBackchannelAuthRequestByApprovalToken,
+		testApprovalToken, mock.Anything, testDeploymentID,
+	).Return([]map[string]any{s.authRequestRow(nil)}, nil)
+
+	result, found, err := s.store.GetByApprovalToken(s.ctx, testApprovalToken)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), found)
+	assert.Equal(s.T(), testAuthReqID, result.AuthReqID)
+}
+
+func (s *StoreTestSuite) TestGetByApprovalToken_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetBackchannelAuthRequestByApprovalToken,
+		testApprovalToken, mock.Anything, testDeploymentID,
+	).Return([]map[string]any{}, nil)
+
+	_, found, err := s.store.GetByApprovalToken(s.ctx, testApprovalToken)
+
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), found)
+}
+
+// Tests for Update
+
+func (s *StoreTestSuite) TestUpdate_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateBackchannelAuthRequestData,
+		mock.Anything, testAuthReqID, testDeploymentID,
+	).Return(int64(1), nil)
+
+	err := s.store.Update(s.ctx, s.testAuthRequest)
+
+	assert.NoError(s.T(), err)
+}
+
+func (s *StoreTestSuite) TestUpdate_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateBackchannelAuthRequestData,
+		mock.Anything, testAuthReqID, testDeploymentID,
+	).Return(int64(0), errors.New("update error"))
+
+	err := s.store.Update(s.ctx, s.testAuthRequest)
+
+	assert.Error(s.T(), err)
+}
+
+// Tests for UpdateLastPolledAt
+
+func (s *StoreTestSuite) TestUpdateLastPolledAt_Success() {
+	polledAt := time.Now()
+	s.testAuthRequest.LastPolledAt = polledAt
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateBackchannelAuthRequestLastPolledAt,
+		polledAt.UTC(), testAuthReqID, testDeploymentID,
+	).Return(int64(1), nil)
+
+	err := s.store.UpdateLastPolledAt(s.ctx, s.testAuthRequest)
+
+	assert.NoError(s.T(), err)
+}
+
+// Tests for Delete
+
+func (s *StoreTestSuite) TestDelete_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteBackchannelAuthRequest,
+		testAuthReqID, testDeploymentID,
+	).Return(int64(1), nil)
+
+	deleted, err := s.store.Delete(s.ctx, testAuthReqID)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), deleted)
+}
+
+func (s *StoreTestSuite) TestDelete_AlreadyDeleted() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteBackchannelAuthRequest,
+		testAuthReqID, testDeploymentID,
+	).Return(int64(0), nil)
+
+	deleted, err := s.store.Delete(s.ctx, testAuthReqID)
+
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), deleted)
+}
+
+func (s *StoreTestSuite) TestDelete_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteBackchannelAuthRequest,
+		testAuthReqID, testDeploymentID,
+	).Return(int64(0), errors.New("delete error"))
+
+	deleted, err := s.store.Delete(s.ctx, testAuthReqID)
+
+	assert.Error(s.T(), err)
+	assert.False(s.T(), deleted)
+}
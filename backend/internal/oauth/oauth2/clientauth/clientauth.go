@@ -33,6 +33,7 @@ import (
 	"github.com/asgardeo/thunder/internal/inboundclient"
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/jwksresolver"
 	serverconst "github.com/asgardeo/thunder/internal/system/constants"
 	"github.com/asgardeo/thunder/internal/system/jose/jws"
 	"github.com/asgardeo/thunder/internal/system/jose/jwt"
@@ -42,7 +43,8 @@ import (
 
 // authenticate authenticates the OAuth2 client from the request.
 // It extracts credentials, validates them, and returns OAuthClientInfo on success.
-// The endpointURL is used as the expected audience when validating client assertion JWTs.
+// The endpointURL is used as the expected audience when validating client assertion JWTs, and
+// jwksResolver supplies the resolver for the keys of self_signed_tls_client_auth clients.
 // Returns an authError on failure.
 func authenticate(
 	ctx context.Context,
@@ -50,6 +52,7 @@ func authenticate(
 	inboundClient inboundclient.InboundClientServiceInterface,
 	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
 	jwtService jwt.JWTServiceInterface,
+	jwksResolver func() *jwksresolver.Resolver,
 	endpointURL string,
 ) (*OAuthClientInfo, *authError) {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ClientAuthMiddleware"))
@@ -60,6 +63,7 @@ func authenticate(
 	clientSecretFromBody := r.FormValue(constants.RequestParamClientSecret)
 	clientAssertionType := r.FormValue(constants.RequestParamClientAssertionType)
 	clientAssertion := r.FormValue(constants.RequestParamClientAssertion)
+	clientCert := peerCertificate(r)
 
 	var detectedMethod constants.TokenEndpointAuthMethod
 
@@ -139,6 +143,12 @@ func authenticate(
 		return nil, errInvalidClientCredentials
 	}
 
+	// A client certificate presented with only a client_id authenticates mutual-TLS clients (RFC 8705).
+	if detectedMethod == constants.TokenEndpointAuthMethodNone && clientCert != nil &&
+		oauthApp.TokenEndpointAuthMethod.IsMutualTLS() {
+		detectedMethod = oauthApp.TokenEndpointAuthMethod
+	}
+
 	if !oauthApp.IsAllowedTokenEndpointAuthMethod(detectedMethod) {
 		return nil, errUnauthorizedAuthMethod
 	}
@@ -152,6 +162,16 @@ func authenticate(
 			logger.Debug("Invalid client assertion: " + err.Error())
 			return nil, errInvalidClientAssertion
 		}
+	case constants.TokenEndpointAuthMethodTLSClientAuth:
+		if err := validateTLSClientAuth(r, oauthApp); err != nil {
+			logger.Debug("Invalid client certificate: " + err.Error())
+			return nil, errInvalidClientCertificate
+		}
+	case constants.TokenEndpointAuthMethodSelfSignedTLSClientAuth:
+		if err := validateSelfSignedTLSClientAuth(ctx, r, oauthApp, jwksResolver()); err != nil {
+			logger.Debug("Invalid client certificate: " + err.Error())
+			return nil, errInvalidClientCertificate
+		}
 	case constants.TokenEndpointAuthMethodClientSecretBasic,
 		constants.TokenEndpointAuthMethodClientSecretPost:
 		_, _, authnErr := authnProvider.AuthenticateUser(ctx,
@@ -166,9 +186,10 @@ func authenticate(
 	}
 
 	return &OAuthClientInfo{
-		ClientID:     clientID,
-		ClientSecret: clientSecret,
-		OAuthApp:     oauthApp,
+		ClientID:              clientID,
+		ClientSecret:          clientSecret,
+		OAuthApp:              oauthApp,
+		CertificateThumbprint: jwt.CertificateThumbprint(clientCert),
 	}, nil
 }
 
@@ -96,7 +96,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_Success_ClientSecretPost() {
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.Nil(suite.T(), authErr)
 	assert.NotNil(suite.T(), clientInfo)
@@ -124,7 +124,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_Success_ClientSecretBasic() {
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.Nil(suite.T(), authErr)
 	assert.NotNil(suite.T(), clientInfo)
@@ -155,7 +155,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_Success_ClientSecretBasic_URL
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.Nil(suite.T(), authErr)
 	assert.NotNil(suite.T(), clientInfo)
@@ -173,7 +173,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_InvalidBasicAuth_BadPercentEn
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -188,7 +188,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_InvalidBasicAuth_BadPercentEn
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -215,7 +215,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_Success_PublicClient() {
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.Nil(suite.T(), authErr)
 	assert.NotNil(suite.T(), clientInfo)
@@ -231,7 +231,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_MissingClientID() {
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -247,7 +247,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_EmptyClientIDInBasicAuth() {
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -262,7 +262,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_EmptyClientIDAndSecretInBasic
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -285,7 +285,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_MissingClientSecret() {
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -298,7 +298,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_InvalidBasicAuth() {
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -311,7 +311,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_InvalidAuthorizationHeader()
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -330,7 +330,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_BothHeaderAndBody() {
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -352,7 +352,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_ClientNotFound() {
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -392,7 +392,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_InvalidClientSecret() {
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, failAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, failAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -416,7 +416,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_WrongAuthMethod() {
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -446,7 +446,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_PublicClientWithSecret() {
 	// Try to use client_secret_post with public client
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -474,7 +474,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_PublicClientMissingSecret() {
 	// Public client with authMethod = none should succeed
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.Nil(suite.T(), authErr)
 	assert.NotNil(suite.T(), clientInfo)
@@ -501,7 +501,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_ClientIDMismatch_HeaderVsBody
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -522,7 +522,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_ServiceError() {
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -599,7 +599,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_Success_PrivateKeyJWT() {
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.Nil(suite.T(), authErr)
 	assert.NotNil(suite.T(), clientInfo)
@@ -637,7 +637,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_Success_PrivateKeyJWT_WithCli
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.Nil(suite.T(), authErr)
 	assert.NotNil(suite.T(), clientInfo)
@@ -657,7 +657,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_PrivateKeyJWT_UnsupportedAsse
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -676,7 +676,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_PrivateKeyJWT_OnlyAssertionTy
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -700,7 +700,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_PrivateKeyJWT_OnlyAssertionPr
 	// Then it checks assertion_type != SupportedClientAssertionType, which fails.
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -731,7 +731,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_PrivateKeyJWT_InvalidAssertio
 
 			clientInfo, authErr := authenticate(
 				req.Context(), req,
-				suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+				suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 			assert.NotNil(suite.T(), authErr)
 			assert.Nil(suite.T(), clientInfo)
@@ -758,7 +758,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_PrivateKeyJWT_ClientNotFound(
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -787,7 +787,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_PrivateKeyJWT_AuthMethodNotAl
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -817,7 +817,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_PrivateKeyJWT_AssertionValida
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -840,7 +840,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_PrivateKeyJWT_ClientIDMismatc
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -862,7 +862,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_PrivateKeyJWT_WithBasicAuth_M
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -885,7 +885,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_PrivateKeyJWT_WithClientSecre
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -909,7 +909,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_PrivateKeyJWT_ServiceError()
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -932,7 +932,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_PrivateKeyJWT_InvalidBase64Pa
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
@@ -958,7 +958,7 @@ func (suite *ClientAuthTestSuite) TestAuthenticate_PrivateKeyJWT_InvalidJSONPayl
 
 	clientInfo, authErr := authenticate(
 		req.Context(), req,
-		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, testEndpointURL)
+		suite.mockInboundClient, suite.mockAuthnProvider, suite.mockJwtService, nil, testEndpointURL)
 
 	assert.NotNil(suite.T(), authErr)
 	assert.Nil(suite.T(), clientInfo)
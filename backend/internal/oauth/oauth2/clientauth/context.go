@@ -34,6 +34,9 @@ type OAuthClientInfo struct {
 	ClientID     string
 	ClientSecret string
 	OAuthApp     *inboundmodel.OAuthClient
+	// CertificateThumbprint is the x5t#S256 thumbprint of the TLS client certificate presented with
+	// the request, or empty when no certificate was presented.
+	CertificateThumbprint string
 }
 
 // withOAuthClient adds OAuth client information to the context.
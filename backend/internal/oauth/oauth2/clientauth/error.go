@@ -77,4 +77,9 @@ var (
 		"Invalid client assertion",
 		http.StatusUnauthorized,
 	)
+	errInvalidClientCertificate = newAuthError(
+		constants.ErrorInvalidClient,
+		"Invalid client certificate",
+		http.StatusUnauthorized,
+	)
 )
@@ -20,10 +20,13 @@ package clientauth
 
 import (
 	"net/http"
+	"sync"
 
 	authnprovidermgr "github.com/asgardeo/thunder/internal/authnprovider/manager"
 	"github.com/asgardeo/thunder/internal/inboundclient"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/jwksresolver"
 	serverconst "github.com/asgardeo/thunder/internal/system/constants"
+	syshttp "github.com/asgardeo/thunder/internal/system/http"
 	"github.com/asgardeo/thunder/internal/system/jose/jwt"
 	"github.com/asgardeo/thunder/internal/system/utils"
 )
@@ -35,11 +38,21 @@ func ClientAuthMiddleware(inboundClient inboundclient.InboundClientServiceInterf
 	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
 	jwtService jwt.JWTServiceInterface,
 	endpointURL string) func(http.Handler) http.Handler {
+	// Resolves the JWKS registered by self_signed_tls_client_auth clients. It is created on first use
+	// since the HTTP client reads the server runtime configuration at construction time.
+	jwksResolver := sync.OnceValue(func() *jwksresolver.Resolver {
+		return jwksresolver.Initialize(syshttp.NewHTTPClientWithCheckRedirect(
+			func(req *http.Request, _ []*http.Request) error {
+				return syshttp.IsSSRFSafeURL(req.URL.String())
+			}))
+	})
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ctx := r.Context()
 			// Authenticate client
-			clientInfo, authErr := authenticate(ctx, r, inboundClient, authnProvider, jwtService, endpointURL)
+			clientInfo, authErr := authenticate(ctx, r, inboundClient, authnProvider, jwtService, jwksResolver,
+				endpointURL)
 			if authErr != nil {
 				// If the client attempted to authenticate via the Authorization
 				// header, include WWW-Authenticate in 401 responses.
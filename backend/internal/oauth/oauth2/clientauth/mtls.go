@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package clientauth
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/jwksresolver"
+	"github.com/asgardeo/thunder/internal/system/config"
+)
+
+// dnSeparatorSpace matches optional whitespace around distinguished name separators.
+var dnSeparatorSpace = regexp.MustCompile(`\s*([,=+])\s*`)
+
+// trustedClientCAs holds the CAs that issue certificates for tls_client_auth clients. It is loaded once
+// during server initialization and is nil when no trusted CA file is configured.
+var trustedClientCAs *x509.CertPool
+
+// Initialize loads the trusted CA bundle of tls_client_auth clients from the server configuration. It
+// returns an error if a trusted CA file is configured but cannot be read or holds no valid certificates.
+func Initialize() error {
+	pool, err := loadTrustedClientCAs()
+	if err != nil {
+		return err
+	}
+	trustedClientCAs = pool
+	return nil
+}
+
+// peerCertificate returns the leaf certificate the client presented during the TLS handshake, if any.
+func peerCertificate(r *http.Request) *x509.Certificate {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return nil
+	}
+	return r.TLS.PeerCertificates[0]
+}
+
+// validateTLSClientAuth validates a CA-issued client certificate for the tls_client_auth method.
+// The certificate must chain to a configured trusted CA and carry the subject DN registered for the client.
+func validateTLSClientAuth(r *http.Request, oauthApp *inboundmodel.OAuthClient) error {
+	leaf := peerCertificate(r)
+	if leaf == nil {
+		return errors.New("no client certificate presented")
+	}
+
+	if trustedClientCAs == nil {
+		return errors.New("no trusted CA file configured for tls_client_auth")
+	}
+	intermediates := x509.NewCertPool()
+	for _, c := range r.TLS.PeerCertificates[1:] {
+		intermediates.AddCert(c)
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         trustedClientCAs,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return fmt.Errorf("client certificate chain verification failed: %w", err)
+	}
+
+	if !subjectDNMatches(leaf.Subject, oauthApp.TLSClientAuthSubjectDN) {
+		return errors.New("client certificate subject does not match the registered subject DN")
+	}
+	return nil
+}
+
+// validateSelfSignedTLSClientAuth validates a client certificate for the self_signed_tls_client_auth
+// method by matching it against the JWKS registered for the client.
+func validateSelfSignedTLSClientAuth(ctx context.Context, r *http.Request,
+	oauthApp *inboundmodel.OAuthClient, jwksResolver *jwksresolver.Resolver) error {
+	leaf := peerCertificate(r)
+	if leaf == nil {
+		return errors.New("no client certificate presented")
+	}
+
+	matched, svcErr := jwksResolver.MatchCertificate(ctx, oauthApp.Certificate, leaf)
+	if svcErr != nil {
+		return fmt.Errorf("failed to resolve the registered client keys: %s", svcErr.Code)
+	}
+	if !matched {
+		return errors.New("client certificate does not match the registered keys")
+	}
+	return nil
+}
+
+// loadTrustedClientCAs loads the CA bundle that issues certificates for tls_client_auth clients. It
+// returns a nil pool when no trusted CA file is configured.
+func loadTrustedClientCAs() (*x509.CertPool, error) {
+	runtime := config.GetServerRuntime()
+	caFile := runtime.Config.OAuth.MTLS.TrustedCAFile
+	if caFile == "" {
+		return nil, nil
+	}
+	if !filepath.IsAbs(caFile) {
+		caFile = filepath.Join(runtime.ServerHome, caFile)
+	}
+
+	pemData, err := os.ReadFile(filepath.Clean(caFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trusted CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pemData) {
+		return nil, errors.New("trusted CA file contains no valid certificates")
+	}
+	return pool, nil
+}
+
+// subjectDNMatches compares the certificate subject with the registered distinguished name in its
+// RFC 4514 string form, ignoring case and whitespace around separators.
+func subjectDNMatches(subject pkix.Name, expected string) bool {
+	if strings.TrimSpace(expected) == "" {
+		return false
+	}
+	return normalizeDN(subject.String()) == normalizeDN(expected)
+}
+
+// normalizeDN lower-cases a distinguished name and strips whitespace around its separators.
+func normalizeDN(dn string) string {
+	return strings.ToLower(dnSeparatorSpace.ReplaceAllString(strings.TrimSpace(dn), "$1"))
+}
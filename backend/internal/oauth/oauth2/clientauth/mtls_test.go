@@ -0,0 +1,332 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package clientauth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/cert"
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/jwksresolver"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/tests/mocks/authnprovider/managermock"
+	"github.com/asgardeo/thunder/tests/mocks/inboundclientmock"
+	"github.com/asgardeo/thunder/tests/mocks/jose/jwtmock"
+)
+
+const testClientSubjectDN = "CN=client,O=Example"
+
+type MTLSClientAuthTestSuite struct {
+	suite.Suite
+	mockInboundClient *inboundclientmock.InboundClientServiceInterfaceMock
+	caKey             *rsa.PrivateKey
+	caCert            *x509.Certificate
+	clientKey         *rsa.PrivateKey
+	clientCert        *x509.Certificate
+	caFile            string
+}
+
+func TestMTLSClientAuthTestSuite(t *testing.T) {
+	suite.Run(t, new(MTLSClientAuthTestSuite))
+}
+
+func (suite *MTLSClientAuthTestSuite) SetupSuite() {
+	var err error
+	suite.caKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	suite.clientKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	suite.caCert = suite.createCertificate(caTemplate, caTemplate, &suite.caKey.PublicKey, suite.caKey)
+	suite.clientCert = suite.createCertificate(&x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "client", Organization: []string{"Example"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, suite.caCert, &suite.clientKey.PublicKey, suite.caKey)
+}
+
+func (suite *MTLSClientAuthTestSuite) SetupTest() {
+	suite.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(suite.T())
+
+	suite.caFile = filepath.Join(suite.T().TempDir(), "client-ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: suite.caCert.Raw})
+	suite.Require().NoError(os.WriteFile(suite.caFile, caPEM, 0o600))
+
+	suite.initializeTrustedCAFile(suite.caFile)
+	suite.Require().NoError(Initialize())
+}
+
+func (suite *MTLSClientAuthTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+	trustedClientCAs = nil
+}
+
+// initializeTrustedCAFile initializes the server runtime with the given trusted CA file of tls_client_auth clients.
+func (suite *MTLSClientAuthTestSuite) initializeTrustedCAFile(caFile string) {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{}
+	testConfig.OAuth.MTLS.TrustedCAFile = caFile
+	suite.Require().NoError(config.InitializeServerRuntime("", testConfig))
+}
+
+func (suite *MTLSClientAuthTestSuite) createCertificate(template, parent *x509.Certificate,
+	pub *rsa.PublicKey, signer *rsa.PrivateKey) *x509.Certificate {
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, signer)
+	suite.Require().NoError(err)
+	parsed, err := x509.ParseCertificate(der)
+	suite.Require().NoError(err)
+	return parsed
+}
+
+// newMTLSRequest builds a token request carrying only the client_id and the given TLS client certificates.
+func (suite *MTLSClientAuthTestSuite) newMTLSRequest(peerCerts ...*x509.Certificate) *http.Request {
+	formData := url.Values{}
+	formData.Set("client_id", testClientID)
+	req, _ := http.NewRequest("POST", "/test", strings.NewReader(formData.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_ = req.ParseForm()
+	if len(peerCerts) > 0 {
+		req.TLS = &tls.ConnectionState{PeerCertificates: peerCerts}
+	}
+	return req
+}
+
+func (suite *MTLSClientAuthTestSuite) authenticate(req *http.Request, oauthApp *inboundmodel.OAuthClient) (
+	*OAuthClientInfo, *authError) {
+	suite.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, testClientID).
+		Return(oauthApp, nil).Once()
+	resolver := func() *jwksresolver.Resolver { return jwksresolver.Initialize(nil) }
+	return authenticate(req.Context(), req, suite.mockInboundClient,
+		managermock.NewAuthnProviderManagerInterfaceMock(suite.T()), jwtmock.NewJWTServiceInterfaceMock(suite.T()),
+		resolver, testEndpointURL)
+}
+
+func (suite *MTLSClientAuthTestSuite) jwksFor(pub *rsa.PublicKey) string {
+	b, _ := json.Marshal(map[string]interface{}{"keys": []interface{}{map[string]interface{}{
+		"kty": "RSA",
+		"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+	}}})
+	return string(b)
+}
+
+func (suite *MTLSClientAuthTestSuite) TestAuthenticate_TLSClientAuth_Success() {
+	oauthApp := &inboundmodel.OAuthClient{
+		ClientID:                testClientID,
+		TokenEndpointAuthMethod: constants.TokenEndpointAuthMethodTLSClientAuth,
+		TLSClientAuthSubjectDN:  "cn=client, o=Example",
+	}
+
+	clientInfo, authErr := suite.authenticate(suite.newMTLSRequest(suite.clientCert), oauthApp)
+
+	suite.Nil(authErr)
+	suite.Require().NotNil(clientInfo)
+	suite.Equal(testClientID, clientInfo.ClientID)
+	suite.Equal(jwt.CertificateThumbprint(suite.clientCert), clientInfo.CertificateThumbprint)
+}
+
+func (suite *MTLSClientAuthTestSuite) TestAuthenticate_TLSClientAuth_SubjectMismatch() {
+	oauthApp := &inboundmodel.OAuthClient{
+		ClientID:                testClientID,
+		TokenEndpointAuthMethod: constants.TokenEndpointAuthMethodTLSClientAuth,
+		TLSClientAuthSubjectDN:  "CN=other,O=Example",
+	}
+
+	clientInfo, authErr := suite.authenticate(suite.newMTLSRequest(suite.clientCert), oauthApp)
+
+	suite.Nil(clientInfo)
+	suite.Equal(errInvalidClientCertificate, authErr)
+}
+
+func (suite *MTLSClientAuthTestSuite) TestAuthenticate_TLSClientAuth_UntrustedIssuer() {
+	selfSigned := suite.createCertificate(&x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "client", Organization: []string{"Example"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}, &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "client", Organization: []string{"Example"}},
+	}, &suite.clientKey.PublicKey, suite.clientKey)
+	oauthApp := &inboundmodel.OAuthClient{
+		ClientID:                testClientID,
+		TokenEndpointAuthMethod: constants.TokenEndpointAuthMethodTLSClientAuth,
+		TLSClientAuthSubjectDN:  testClientSubjectDN,
+	}
+
+	clientInfo, authErr := suite.authenticate(suite.newMTLSRequest(selfSigned), oauthApp)
+
+	suite.Nil(clientInfo)
+	suite.Equal(errInvalidClientCertificate, authErr)
+}
+
+func (suite *MTLSClientAuthTestSuite) TestAuthenticate_TLSClientAuth_NoCertificate() {
+	oauthApp := &inboundmodel.OAuthClient{
+		ClientID:                testClientID,
+		TokenEndpointAuthMethod: constants.TokenEndpointAuthMethodTLSClientAuth,
+		TLSClientAuthSubjectDN:  testClientSubjectDN,
+	}
+
+	clientInfo, authErr := suite.authenticate(suite.newMTLSRequest(), oauthApp)
+
+	suite.Nil(clientInfo)
+	suite.Equal(errUnauthorizedAuthMethod, authErr)
+}
+
+func (suite *MTLSClientAuthTestSuite) TestAuthenticate_TLSClientAuth_UsesLoadedCAs() {
+	suite.Require().NoError(os.Remove(suite.caFile))
+	oauthApp := &inboundmodel.OAuthClient{
+		ClientID:                testClientID,
+		TokenEndpointAuthMethod: constants.TokenEndpointAuthMethodTLSClientAuth,
+		TLSClientAuthSubjectDN:  testClientSubjectDN,
+	}
+
+	clientInfo, authErr := suite.authenticate(suite.newMTLSRequest(suite.clientCert), oauthApp)
+
+	suite.Nil(authErr)
+	suite.NotNil(clientInfo)
+}
+
+func (suite *MTLSClientAuthTestSuite) TestAuthenticate_TLSClientAuth_NoTrustedCAs() {
+	suite.initializeTrustedCAFile("")
+	suite.Require().NoError(Initialize())
+	oauthApp := &inboundmodel.OAuthClient{
+		ClientID:                testClientID,
+		TokenEndpointAuthMethod: constants.TokenEndpointAuthMethodTLSClientAuth,
+		TLSClientAuthSubjectDN:  testClientSubjectDN,
+	}
+
+	clientInfo, authErr := suite.authenticate(suite.newMTLSRequest(suite.clientCert), oauthApp)
+
+	suite.Nil(clientInfo)
+	suite.Equal(errInvalidClientCertificate, authErr)
+}
+
+func (suite *MTLSClientAuthTestSuite) TestInitialize_InvalidTrustedCAFile() {
+	invalidFile := filepath.Join(suite.T().TempDir(), "invalid-ca.pem")
+	suite.Require().NoError(os.WriteFile(invalidFile, []byte("not a certificate"), 0o600))
+
+	testCases := []struct {
+		name   string
+		caFile string
+		errMsg string
+	}{
+		{"MissingFile", filepath.Join(suite.T().TempDir(), "missing-ca.pem"), "failed to read trusted CA file"},
+		{"NoCertificates", invalidFile, "trusted CA file contains no valid certificates"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.initializeTrustedCAFile(tc.caFile)
+
+			err := Initialize()
+
+			suite.ErrorContains(err, tc.errMsg)
+		})
+	}
+}
+
+func (suite *MTLSClientAuthTestSuite) TestAuthenticate_SelfSignedTLSClientAuth() {
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name    string
+		jwks    string
+		success bool
+	}{
+		{"MatchingKey", suite.jwksFor(&suite.clientKey.PublicKey), true},
+		{"MismatchedKey", suite.jwksFor(&otherKey.PublicKey), false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			oauthApp := &inboundmodel.OAuthClient{
+				ClientID:                testClientID,
+				TokenEndpointAuthMethod: constants.TokenEndpointAuthMethodSelfSignedTLSClientAuth,
+				Certificate:             &inboundmodel.Certificate{Type: cert.CertificateTypeJWKS, Value: tc.jwks},
+			}
+
+			clientInfo, authErr := suite.authenticate(suite.newMTLSRequest(suite.clientCert), oauthApp)
+
+			if tc.success {
+				suite.Nil(authErr)
+				suite.Require().NotNil(clientInfo)
+				suite.NotEmpty(clientInfo.CertificateThumbprint)
+			} else {
+				suite.Nil(clientInfo)
+				suite.Equal(errInvalidClientCertificate, authErr)
+			}
+		})
+	}
+}
+
+func (suite *MTLSClientAuthTestSuite) TestAuthenticate_PublicClientWithCertificate() {
+	oauthApp := &inboundmodel.OAuthClient{
+		ClientID:                testClientID,
+		TokenEndpointAuthMethod: constants.TokenEndpointAuthMethodNone,
+		PublicClient:            true,
+	}
+
+	clientInfo, authErr := suite.authenticate(suite.newMTLSRequest(suite.clientCert), oauthApp)
+
+	suite.Nil(authErr)
+	suite.Require().NotNil(clientInfo)
+	suite.Equal(jwt.CertificateThumbprint(suite.clientCert), clientInfo.CertificateThumbprint)
+}
+
+func (suite *MTLSClientAuthTestSuite) TestSubjectDNMatches() {
+	subject := pkix.Name{CommonName: "client", Organization: []string{"Example"}}
+
+	suite.True(subjectDNMatches(subject, testClientSubjectDN))
+	suite.True(subjectDNMatches(subject, " cn = client , o = example "))
+	suite.False(subjectDNMatches(subject, "O=Example,CN=client"))
+	suite.False(subjectDNMatches(subject, ""))
+}
@@ -21,46 +21,64 @@ package constants
 
 import (
 	"errors"
+	"slices"
+	"strings"
 
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
 )
 
 // OAuth2 request parameters.
 const (
-	RequestParamGrantType           string = "grant_type"
-	RequestParamClientID            string = "client_id"
-	RequestParamClientSecret        string = "client_secret"
-	RequestParamClientAssertion     string = "client_assertion"
-	RequestParamClientAssertionType string = "client_assertion_type"
-	RequestParamRedirectURI         string = "redirect_uri"
-	RequestParamUsername            string = "username"
-	RequestParamPassword            string = "password"
-	RequestParamScope               string = "scope"
-	RequestParamCode                string = "code"
-	RequestParamCodeVerifier        string = "code_verifier"
-	RequestParamCodeChallenge       string = "code_challenge"
-	RequestParamCodeChallengeMethod string = "code_challenge_method"
-	RequestParamRefreshToken        string = "refresh_token"
-	RequestParamResponseType        string = "response_type"
-	RequestParamState               string = "state"
-	RequestParamIss                 string = "iss"
-	RequestParamResource            string = "resource"
-	RequestParamError               string = "error"
-	RequestParamErrorDescription    string = "error_description"
-	RequestParamToken               string = "token"
-	RequestParamTokenTypeHint       string = "token_type_hint"
-	RequestParamSubjectToken        string = "subject_token"
-	RequestParamSubjectTokenType    string = "subject_token_type"
-	RequestParamActorToken          string = "actor_token"
-	RequestParamActorTokenType      string = "actor_token_type"
-	RequestParamRequestedTokenType  string = "requested_token_type"
-	RequestParamAudience            string = "audience"
-	RequestParamClaims              string = "claims"
-	RequestParamClaimsLocales       string = "claims_locales"
-	RequestParamNonce               string = "nonce"
-	RequestParamPrompt              string = "prompt"
-	RequestParamRequestURI          string = "request_uri"
-	RequestParamAcrValues           string = "acr_values"
+	RequestParamGrantType            string = "grant_type"
+	RequestParamClientID             string = "client_id"
+	RequestParamClientSecret         string = "client_secret"
+	RequestParamClientAssertion      string = "client_assertion"
+	RequestParamClientAssertionType  string = "client_assertion_type"
+	RequestParamRedirectURI          string = "redirect_uri"
+	RequestParamUsername             string = "username"
+	RequestParamPassword             string = "password"
+	RequestParamScope                string = "scope"
+	RequestParamCode                 string = "code"
+	RequestParamCodeVerifier         string = "code_verifier"
+	RequestParamCodeChallenge        string = "code_challenge"
+	RequestParamCodeChallengeMethod  string = "code_challenge_method"
+	RequestParamRefreshToken         string = "refresh_token"
+	RequestParamResponseType         string = "response_type"
+	RequestParamState                string = "state"
+	RequestParamIss                  string = "iss"
+	RequestParamResource             string = "resource"
+	RequestParamError                string = "error"
+	RequestParamErrorDescription     string = "error_description"
+	RequestParamToken                string = "token"
+	RequestParamTokenTypeHint        string = "token_type_hint"
+	RequestParamSubjectToken         string = "subject_token"
+	RequestParamSubjectTokenType     string = "subject_token_type"
+	RequestParamActorToken           string = "actor_token"
+	RequestParamActorTokenType       string = "actor_token_type"
+	RequestParamRequestedTokenType   string = "requested_token_type"
+	RequestParamAudience             string = "audience"
+	RequestParamClaims               string = "claims"
+	RequestParamClaimsLocales        string = "claims_locales"
+	RequestParamNonce                string = "nonce"
+	RequestParamPrompt               string = "prompt"
+	RequestParamRequestURI           string = "request_uri"
+	RequestParamRequest              string = "request"
+	RequestParamAcrValues            string = "acr_values"
+	RequestParamIDTokenHint          string = "id_token_hint"
+	RequestParamPostLogoutRedirect   string = "post_logout_redirect_uri"
+	RequestParamLogoutToken          string = "logout_token"
+	RequestParamSid                  string = "sid"
+	RequestParamDeviceCode           string = "device_code"
+	RequestParamAuthReqID            string = "auth_req_id"
+	RequestParamLoginHint            string = "login_hint"
+	RequestParamLoginHintToken       string = "login_hint_token"
+	RequestParamBindingMessage       string = "binding_message"
+	RequestParamClientNotifToken     string = "client_notification_token"
+	RequestParamRequestedExpiry      string = "requested_expiry"
+	RequestParamAssertion            string = "assertion"
+	RequestParamAuthorizationDetails string = "authorization_details"
+	RequestParamMaxAge               string = "max_age"
+	RequestParamResponseMode         string = "response_mode"
 )
 
 // OIDC prompt parameter values.
@@ -76,6 +94,14 @@ var ValidPromptValues = []string{
 	PromptNone, PromptLogin, PromptConsent, PromptSelectAccount,
 }
 
+// OAuth2 authorization response parameters carrying tokens issued by the authorization endpoint.
+const (
+	ResponseParamAccessToken string = "access_token"
+	ResponseParamTokenType   string = "token_type"
+	ResponseParamExpiresIn   string = "expires_in"
+	ResponseParamIDToken     string = "id_token"
+)
+
 // OAuth2 request parameter validation limits.
 const (
 	// MaxNonceLength defines the maximum allowed length of the nonce parameter.
@@ -102,15 +128,18 @@ const (
 
 // OAuth2 endpoints.
 const (
-	OAuth2TokenEndpoint         string = "/oauth2/token" // #nosec G101
-	OAuth2AuthorizationEndpoint string = "/oauth2/authorize"
-	OAuth2IntrospectionEndpoint string = "/oauth2/introspect"
-	OAuth2RevokeEndpoint        string = "/oauth2/revoke"
-	OAuth2UserInfoEndpoint      string = "/oauth2/userinfo"
-	OAuth2JWKSEndpoint          string = "/oauth2/jwks"
-	OAuth2LogoutEndpoint        string = "/oauth2/logout"
-	OAuth2DCREndpoint           string = "/oauth2/dcr/register"
-	OAuth2PAREndpoint           string = "/oauth2/par"
+	OAuth2TokenEndpoint                 string = "/oauth2/token" // #nosec G101
+	OAuth2AuthorizationEndpoint         string = "/oauth2/authorize"
+	OAuth2AuthorizationResponseEndpoint string = "/oauth2/authorize/response"
+	OAuth2IntrospectionEndpoint         string = "/oauth2/introspect"
+	OAuth2RevokeEndpoint                string = "/oauth2/revoke"
+	OAuth2UserInfoEndpoint              string = "/oauth2/userinfo"
+	OAuth2JWKSEndpoint                  string = "/oauth2/jwks"
+	OAuth2LogoutEndpoint                string = "/oauth2/logout"
+	OAuth2DCREndpoint                   string = "/oauth2/dcr/register"
+	OAuth2PAREndpoint                   string = "/oauth2/par"
+	OAuth2DeviceAuthzEndpoint           string = "/oauth2/device_authorization"
+	OAuth2BackchannelEndpoint           string = "/oauth2/bc-authorize"
 )
 
 // GrantType defines a type for OAuth2 grant types.
@@ -125,6 +154,12 @@ const (
 	GrantTypeRefreshToken GrantType = "refresh_token"
 	// GrantTypeTokenExchange represents the token exchange grant type.
 	GrantTypeTokenExchange GrantType = "urn:ietf:params:oauth:grant-type:token-exchange" //nolint:gosec
+	// GrantTypeDeviceCode represents the device authorization grant type (RFC 8628).
+	GrantTypeDeviceCode GrantType = "urn:ietf:params:oauth:grant-type:device_code"
+	// GrantTypeCIBA represents the OpenID Client Initiated Backchannel Authentication grant type.
+	GrantTypeCIBA GrantType = "urn:openid:params:grant-type:ciba"
+	// GrantTypeJWTBearer represents the JWT bearer authorization grant type (RFC 7523).
+	GrantTypeJWTBearer GrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer"
 )
 
 // supportedGrantTypes is the single source of truth for all supported grant types.
@@ -133,6 +168,9 @@ var supportedGrantTypes = []GrantType{
 	GrantTypeClientCredentials,
 	GrantTypeRefreshToken,
 	GrantTypeTokenExchange,
+	GrantTypeDeviceCode,
+	GrantTypeCIBA,
+	GrantTypeJWTBearer,
 }
 
 // IsValid checks if the GrantType is valid.
@@ -153,21 +191,74 @@ const (
 	ResponseTypeCode ResponseType = "code"
 	// ResponseTypeIDToken represents the id token response type.
 	ResponseTypeIDToken ResponseType = "id_token"
+	// ResponseTypeToken represents the access token response type value. It is only supported as part of the
+	// hybrid code id_token token response type.
+	ResponseTypeToken ResponseType = "token"
+	// ResponseTypeCodeIDToken represents the hybrid response type issuing a code and an id token.
+	ResponseTypeCodeIDToken ResponseType = "code id_token"
+	// ResponseTypeCodeIDTokenToken represents the hybrid response type issuing a code, an id token and an
+	// access token.
+	ResponseTypeCodeIDTokenToken ResponseType = "code id_token token"
 )
 
 // supportedResponseTypes is the single source of truth for all supported response types.
 var supportedResponseTypes = []ResponseType{
 	ResponseTypeCode,
+	ResponseTypeIDToken,
+	ResponseTypeCodeIDToken,
+	ResponseTypeCodeIDTokenToken,
+}
+
+// NormalizeResponseType returns the response type with its values in canonical order. The order of the
+// space-delimited values of a response type is not significant (OAuth 2.0 Multiple Response Types §3).
+func NormalizeResponseType(responseType string) ResponseType {
+	values := strings.Fields(responseType)
+	slices.Sort(values)
+	return ResponseType(strings.Join(values, " "))
 }
 
 // IsValid checks if the ResponseType is valid.
 func (rt ResponseType) IsValid() bool {
-	for _, valid := range supportedResponseTypes {
-		if rt == valid {
-			return true
-		}
+	return slices.Contains(supportedResponseTypes, NormalizeResponseType(string(rt)))
+}
+
+// Includes reports whether the response type contains the given response type value.
+func (rt ResponseType) Includes(value ResponseType) bool {
+	return slices.Contains(strings.Fields(string(rt)), string(value))
+}
+
+// ResponseMode defines a type for OAuth2 response modes.
+type ResponseMode string
+
+const (
+	// ResponseModeQuery returns the authorization response parameters in the redirect URI query.
+	ResponseModeQuery ResponseMode = "query"
+	// ResponseModeFragment returns the authorization response parameters in the redirect URI fragment.
+	ResponseModeFragment ResponseMode = "fragment"
+	// ResponseModeFormPost returns the authorization response parameters in an auto-submitted HTML form.
+	ResponseModeFormPost ResponseMode = "form_post"
+)
+
+// supportedResponseModes is the single source of truth for all supported response modes.
+var supportedResponseModes = []ResponseMode{
+	ResponseModeQuery,
+	ResponseModeFragment,
+	ResponseModeFormPost,
+}
+
+// IsValid checks if the ResponseMode is valid.
+func (rm ResponseMode) IsValid() bool {
+	return slices.Contains(supportedResponseModes, rm)
+}
+
+// GetDefaultResponseMode returns the response mode used when the request does not specify one. Response
+// types issuing tokens from the authorization endpoint default to the fragment, since tokens must not be
+// sent in the query (OAuth 2.0 Multiple Response Types §2.1).
+func GetDefaultResponseMode(responseType ResponseType) ResponseMode {
+	if responseType.Includes(ResponseTypeIDToken) || responseType.Includes(ResponseTypeToken) {
+		return ResponseModeFragment
 	}
-	return false
+	return ResponseModeQuery
 }
 
 // TokenEndpointAuthMethod defines a type for token endpoint authentication methods.
@@ -183,6 +274,11 @@ const (
 	TokenEndpointAuthMethodPrivateKeyJWT TokenEndpointAuthMethod = "private_key_jwt"
 	// TokenEndpointAuthMethodNone represents no authentication method.
 	TokenEndpointAuthMethodNone TokenEndpointAuthMethod = "none"
+	// TokenEndpointAuthMethodTLSClientAuth represents PKI mutual-TLS client authentication (RFC 8705).
+	TokenEndpointAuthMethodTLSClientAuth TokenEndpointAuthMethod = "tls_client_auth"
+	// TokenEndpointAuthMethodSelfSignedTLSClientAuth represents self-signed certificate mutual-TLS
+	// client authentication (RFC 8705).
+	TokenEndpointAuthMethodSelfSignedTLSClientAuth TokenEndpointAuthMethod = "self_signed_tls_client_auth"
 )
 
 // supportedTokenEndpointAuthMethods is the single source of truth for all supported token endpoint
@@ -192,6 +288,13 @@ var supportedTokenEndpointAuthMethods = []TokenEndpointAuthMethod{
 	TokenEndpointAuthMethodClientSecretPost,
 	TokenEndpointAuthMethodPrivateKeyJWT,
 	TokenEndpointAuthMethodNone,
+	TokenEndpointAuthMethodTLSClientAuth,
+	TokenEndpointAuthMethodSelfSignedTLSClientAuth,
+}
+
+// IsMutualTLS reports whether the method authenticates the client with a TLS client certificate.
+func (tam TokenEndpointAuthMethod) IsMutualTLS() bool {
+	return tam == TokenEndpointAuthMethodTLSClientAuth || tam == TokenEndpointAuthMethodSelfSignedTLSClientAuth
 }
 
 // IsValid checks if the TokenEndpointAuthMethod is valid.
@@ -207,6 +310,13 @@ func (tam TokenEndpointAuthMethod) IsValid() bool {
 // OAuth2 token types.
 const (
 	TokenTypeBearer = "Bearer"
+	TokenTypeDPoP   = "DPoP"
+)
+
+// RFC 7009 token type hint values.
+const (
+	TokenTypeHintAccessToken  string = "access_token"
+	TokenTypeHintRefreshToken string = "refresh_token" //nolint:gosec // Token type hint, not a credential
 )
 
 // TokenTypeIdentifier defines a type for RFC 8693 token type identifiers.
@@ -242,21 +352,41 @@ func (tti TokenTypeIdentifier) IsValid() bool {
 	return false
 }
 
+// Backchannel token delivery modes (OpenID CIBA).
+const (
+	BackchannelTokenDeliveryModePoll string = "poll"
+	BackchannelTokenDeliveryModePing string = "ping"
+)
+
 // OAuth2 error codes.
 const (
-	ErrorInvalidRequest           string = "invalid_request"
-	ErrorInvalidClient            string = "invalid_client"
-	ErrorInvalidGrant             string = "invalid_grant"
-	ErrorUnauthorizedClient       string = "unauthorized_client"
-	ErrorUnsupportedGrantType     string = "unsupported_grant_type"
-	ErrorInvalidScope             string = "invalid_scope"
-	ErrorInvalidTarget            string = "invalid_target"
-	ErrorServerError              string = "server_error"
-	ErrorUnsupportedResponseType  string = "unsupported_response_type"
-	ErrorAccessDenied             string = "access_denied"
-	ErrorLoginRequired            string = "login_required"
-	ErrorConsentRequired          string = "consent_required"
-	ErrorAccountSelectionRequired string = "account_selection_required"
+	ErrorInvalidRequest              string = "invalid_request"
+	ErrorInvalidClient               string = "invalid_client"
+	ErrorInvalidGrant                string = "invalid_grant"
+	ErrorUnauthorizedClient          string = "unauthorized_client"
+	ErrorUnsupportedGrantType        string = "unsupported_grant_type"
+	ErrorInvalidScope                string = "invalid_scope"
+	ErrorInvalidTarget               string = "invalid_target"
+	ErrorServerError                 string = "server_error"
+	ErrorUnsupportedResponseType     string = "unsupported_response_type"
+	ErrorAccessDenied                string = "access_denied"
+	ErrorLoginRequired               string = "login_required"
+	ErrorConsentRequired             string = "consent_required"
+	ErrorAccountSelectionRequired    string = "account_selection_required"
+	ErrorInteractionRequired         string = "interaction_required"
+	ErrorUnsupportedTokenType        string = "unsupported_token_type"
+	ErrorAuthorizationPending        string = "authorization_pending"
+	ErrorSlowDown                    string = "slow_down"
+	ErrorExpiredToken                string = "expired_token"
+	ErrorUnknownUserID               string = "unknown_user_id"
+	ErrorInvalidBindingMessage       string = "invalid_binding_message"
+	ErrorExpiredLoginHintToken       string = "expired_login_hint_token"
+	ErrorInvalidDPoPProof            string = "invalid_dpop_proof"
+	ErrorUseDPoPNonce                string = "use_dpop_nonce"
+	ErrorInvalidRequestObject        string = "invalid_request_object"
+	ErrorInvalidRequestURI           string = "invalid_request_uri"
+	ErrorRequestURINotSupported      string = "request_uri_not_supported"
+	ErrorInvalidAuthorizationDetails string = "invalid_authorization_details"
 )
 
 // UnSupportedGrantTypeError is returned when an unsupported grant type is requested.
@@ -308,17 +438,30 @@ const (
 	ClaimExp      string = "exp"
 	ClaimIat      string = "iat"
 	ClaimAuthTime string = "auth_time"
+	ClaimACR      string = "acr"
+	ClaimSID      string = "sid"
+	ClaimCHash    string = "c_hash"
+	ClaimATHash   string = "at_hash"
 )
 
 // Custom JWT claim names.
 const (
-	ClaimUserType           string = "userType"
-	ClaimOUID               string = "ouId"
-	ClaimOUName             string = "ouName"
-	ClaimOUHandle           string = "ouHandle"
-	ClaimClaimsRequest      string = "claims_req"
-	ClaimClaimsLocales      string = "claims_locales"
-	ClaimCompletedAuthClass string = "completed_auth_class"
+	ClaimUserType             string = "userType"
+	ClaimOUID                 string = "ouId"
+	ClaimOUName               string = "ouName"
+	ClaimOUHandle             string = "ouHandle"
+	ClaimClaimsRequest        string = "claims_req"
+	ClaimClaimsLocales        string = "claims_locales"
+	ClaimCompletedAuthClass   string = "completed_auth_class"
+	ClaimAuthorizationDetails string = "authorization_details"
+	ClaimTokenUse             string = "token_use"
+)
+
+// Token use values of the token_use claim.
+const (
+	// TokenUseID marks a JWT as an ID token, so that it can be told apart from the other JWTs signed by
+	// this server when it is presented back as an id_token_hint.
+	TokenUseID string = "id"
 )
 
 // OIDC subject types.
@@ -361,6 +504,15 @@ func GetSupportedResponseTypes() []string {
 	return result
 }
 
+// GetSupportedResponseModes returns all supported OAuth2 response modes.
+func GetSupportedResponseModes() []string {
+	result := make([]string, len(supportedResponseModes))
+	for i, rm := range supportedResponseModes {
+		result[i] = string(rm)
+	}
+	return result
+}
+
 // GetSupportedGrantTypes returns all supported OAuth2 grant types.
 func GetSupportedGrantTypes() []string {
 	result := make([]string, len(supportedGrantTypes))
@@ -242,6 +242,7 @@ func (ds *dcrService) convertDCRToApplication(request *DCRRegistrationRequest) (
 		PublicClient:                       isPublicClient,
 		PKCERequired:                       isPublicClient,
 		RequirePushedAuthorizationRequests: request.RequirePushedAuthorizationRequests,
+		RequireSignedRequestObject:         request.RequireSignedRequestObject,
 		Scopes:                             scopes,
 		UserInfo:                           buildUserInfoConfig(request),
 		Token:                              buildTokenConfig(request),
@@ -382,6 +383,7 @@ func (ds *dcrService) convertApplicationToDCRResponse(appDTO *model.ApplicationD
 		Contacts:                           appDTO.Contacts,
 		AppID:                              appDTO.ID,
 		RequirePushedAuthorizationRequests: oauthConfig.RequirePushedAuthorizationRequests,
+		RequireSignedRequestObject:         oauthConfig.RequireSignedRequestObject,
 		UserInfoSignedResponseAlg:          userInfoSignedAlg,
 		UserInfoEncryptedResponseAlg:       userInfoEncryptedAlg,
 		UserInfoEncryptedResponseEnc:       userInfoEncryptedEnc,
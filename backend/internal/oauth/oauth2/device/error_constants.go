@@ -0,0 +1,28 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package device implements the OAuth 2.0 Device Authorization Grant (RFC 8628).
+package device
+
+import "errors"
+
+var errUserCodeNotFound = errors.New("user_code not found, expired, or already used")
+
+var errAuthIDMismatch = errors.New("authId does not match the device authorization request")
+
+var errAuthorizationCompleted = errors.New("device authorization request has already been completed")
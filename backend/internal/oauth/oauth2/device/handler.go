@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/clientauth"
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/system/log"
+	"github.com/asgardeo/thunder/internal/system/utils"
+)
+
+// deviceHandlerInterface defines the interface for handling device authorization requests.
+type deviceHandlerInterface interface {
+	HandleDeviceAuthorizationRequest(w http.ResponseWriter, r *http.Request)
+	HandleVerificationRequest(w http.ResponseWriter, r *http.Request)
+	HandleVerificationCallbackRequest(w http.ResponseWriter, r *http.Request)
+}
+
+// deviceHandler implements deviceHandlerInterface.
+type deviceHandler struct {
+	deviceService DeviceAuthorizationServiceInterface
+	logger        *log.Logger
+}
+
+// newDeviceHandler creates a new device authorization handler instance.
+func newDeviceHandler(deviceService DeviceAuthorizationServiceInterface) deviceHandlerInterface {
+	return &deviceHandler{
+		deviceService: deviceService,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DeviceAuthorizationHandler")),
+	}
+}
+
+// HandleDeviceAuthorizationRequest handles the POST /oauth2/device_authorization request.
+func (h *deviceHandler) HandleDeviceAuthorizationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Client authentication is handled by the ClientAuthMiddleware.
+	clientInfo := clientauth.GetOAuthClient(ctx)
+	if clientInfo == nil {
+		h.logger.Error("OAuth client not found in context - ClientAuthMiddleware must be applied")
+		utils.WriteJSONError(w, oauth2const.ErrorServerError,
+			"Something went wrong", http.StatusInternalServerError, nil)
+		return
+	}
+
+	// Parse form-encoded body.
+	if err := r.ParseForm(); err != nil {
+		utils.WriteJSONError(w, oauth2const.ErrorInvalidRequest, "Failed to parse request body",
+			http.StatusBadRequest, nil)
+		return
+	}
+
+	resp, errCode, errDesc := h.deviceService.HandleDeviceAuthorizationRequest(ctx,
+		r.PostForm.Get(oauth2const.RequestParamScope), r.PostForm[oauth2const.RequestParamResource],
+		clientInfo.OAuthApp)
+	if errCode != "" {
+		h.writeError(w, errCode, errDesc)
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, resp)
+}
+
+// HandleVerificationRequest handles the POST /oauth2/device/verify request sent by the gate client
+// once the user has entered a user code.
+func (h *deviceHandler) HandleVerificationRequest(w http.ResponseWriter, r *http.Request) {
+	req, err := utils.DecodeJSONBody[verificationRequest](r)
+	if err != nil || req.UserCode == "" {
+		utils.WriteJSONError(w, oauth2const.ErrorInvalidRequest, "Invalid verification request",
+			http.StatusBadRequest, nil)
+		return
+	}
+
+	resp, errCode, errDesc := h.deviceService.InitiateVerification(r.Context(), req.UserCode)
+	if errCode != "" {
+		h.writeError(w, errCode, errDesc)
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, resp)
+}
+
+// HandleVerificationCallbackRequest handles the POST /oauth2/device/callback request sent by the
+// gate client once the user has completed the authentication flow or denied the device.
+func (h *deviceHandler) HandleVerificationCallbackRequest(w http.ResponseWriter, r *http.Request) {
+	req, err := utils.DecodeJSONBody[verificationCallbackRequest](r)
+	if err != nil || req.UserCode == "" || req.AuthID == "" {
+		utils.WriteJSONError(w, oauth2const.ErrorInvalidRequest, "Invalid verification request",
+			http.StatusBadRequest, nil)
+		return
+	}
+
+	status, errCode, errDesc := h.deviceService.CompleteVerification(
+		r.Context(), req.UserCode, req.AuthID, req.Assertion, req.Denied)
+	if errCode != "" {
+		h.writeError(w, errCode, errDesc)
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, verificationCallbackResponse{Status: status})
+}
+
+// writeError writes an OAuth error response, mapping server errors to HTTP 500.
+func (h *deviceHandler) writeError(w http.ResponseWriter, errCode, errDesc string) {
+	statusCode := http.StatusBadRequest
+	if errCode == oauth2const.ErrorServerError {
+		statusCode = http.StatusInternalServerError
+	}
+	utils.WriteJSONError(w, errCode, errDesc, statusCode, nil)
+}
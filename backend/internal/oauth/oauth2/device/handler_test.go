@@ -0,0 +1,223 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/clientauth"
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/system/config"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	testConfig := &config.Config{}
+	_ = config.InitializeServerRuntime("", testConfig)
+}
+
+func (s *HandlerTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *HandlerTestSuite) newDeviceAuthorizationRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/device_authorization", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	clientInfo := &clientauth.OAuthClientInfo{
+		ClientID: testClientID,
+		OAuthApp: &inboundmodel.OAuthClient{ClientID: testClientID},
+	}
+	ctx := context.WithValue(req.Context(), clientauth.OAuthClientKey, clientInfo)
+	return req.WithContext(ctx)
+}
+
+func (s *HandlerTestSuite) TestHandleDeviceAuthorization_Success() {
+	svc := NewDeviceAuthorizationServiceInterfaceMock(s.T())
+	svc.EXPECT().HandleDeviceAuthorizationRequest(mock.Anything, "openid read",
+		[]string{"https://api.example.com"}, mock.Anything).
+		Return(&DeviceAuthorizationResponse{
+			DeviceCode:      testDeviceCode,
+			UserCode:        testUserCode,
+			VerificationURI: "https://localhost:5190/gate/device",
+			ExpiresIn:       600,
+			Interval:        5,
+		}, "", "")
+	handler := newDeviceHandler(svc)
+
+	req := s.newDeviceAuthorizationRequest("scope=openid+read&resource=https%3A%2F%2Fapi.example.com")
+	rec := httptest.NewRecorder()
+	handler.HandleDeviceAuthorizationRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusOK, rec.Code)
+
+	var resp DeviceAuthorizationResponse
+	err := json.NewDecoder(rec.Body).Decode(&resp)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), testDeviceCode, resp.DeviceCode)
+	assert.Equal(s.T(), testUserCode, resp.UserCode)
+	assert.Equal(s.T(), int64(5), resp.Interval)
+}
+
+func (s *HandlerTestSuite) TestHandleDeviceAuthorization_NoClientAuth() {
+	handler := newDeviceHandler(NewDeviceAuthorizationServiceInterfaceMock(s.T()))
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/device_authorization", strings.NewReader(""))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	handler.HandleDeviceAuthorizationRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusInternalServerError, rec.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleDeviceAuthorization_ServiceError() {
+	svc := NewDeviceAuthorizationServiceInterfaceMock(s.T())
+	svc.EXPECT().HandleDeviceAuthorizationRequest(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, oauth2const.ErrorUnauthorizedClient, "not allowed")
+	handler := newDeviceHandler(svc)
+
+	rec := httptest.NewRecorder()
+	handler.HandleDeviceAuthorizationRequest(rec, s.newDeviceAuthorizationRequest("scope=openid"))
+
+	assert.Equal(s.T(), http.StatusBadRequest, rec.Code)
+	assert.Contains(s.T(), rec.Body.String(), oauth2const.ErrorUnauthorizedClient)
+}
+
+func (s *HandlerTestSuite) TestHandleDeviceAuthorization_ServerError() {
+	svc := NewDeviceAuthorizationServiceInterfaceMock(s.T())
+	svc.EXPECT().HandleDeviceAuthorizationRequest(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, oauth2const.ErrorServerError, "failed")
+	handler := newDeviceHandler(svc)
+
+	rec := httptest.NewRecorder()
+	handler.HandleDeviceAuthorizationRequest(rec, s.newDeviceAuthorizationRequest("scope=openid"))
+
+	assert.Equal(s.T(), http.StatusInternalServerError, rec.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleVerification_Success() {
+	svc := NewDeviceAuthorizationServiceInterfaceMock(s.T())
+	svc.EXPECT().InitiateVerification(mock.Anything, testUserCode).
+		Return(&VerificationResponse{AuthID: testAuthID, ApplicationID: testAppID, ExecutionID: "exec"}, "", "")
+	handler := newDeviceHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/device/verify",
+		strings.NewReader(`{"userCode":"`+testUserCode+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.HandleVerificationRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusOK, rec.Code)
+
+	var resp VerificationResponse
+	err := json.NewDecoder(rec.Body).Decode(&resp)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), testAuthID, resp.AuthID)
+	assert.Equal(s.T(), testAppID, resp.ApplicationID)
+	assert.Equal(s.T(), "exec", resp.ExecutionID)
+}
+
+func (s *HandlerTestSuite) TestHandleVerification_MissingUserCode() {
+	handler := newDeviceHandler(NewDeviceAuthorizationServiceInterfaceMock(s.T()))
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/device/verify", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.HandleVerificationRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusBadRequest, rec.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleVerification_InvalidUserCode() {
+	svc := NewDeviceAuthorizationServiceInterfaceMock(s.T())
+	svc.EXPECT().InitiateVerification(mock.Anything, "BAD").
+		Return(nil, oauth2const.ErrorInvalidRequest, "Invalid user code")
+	handler := newDeviceHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/device/verify", strings.NewReader(`{"userCode":"BAD"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.HandleVerificationRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusBadRequest, rec.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleVerificationCallback_Success() {
+	svc := NewDeviceAuthorizationServiceInterfaceMock(s.T())
+	svc.EXPECT().CompleteVerification(mock.Anything, testUserCode, testAuthID, "assertion", false).
+		Return(AuthorizationStatusAuthorized, "", "")
+	handler := newDeviceHandler(svc)
+
+	body := `{"userCode":"` + testUserCode + `","authId":"` + testAuthID + `","assertion":"assertion"}`
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/device/callback", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.HandleVerificationCallbackRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusOK, rec.Code)
+
+	var resp verificationCallbackResponse
+	err := json.NewDecoder(rec.Body).Decode(&resp)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), AuthorizationStatusAuthorized, resp.Status)
+}
+
+func (s *HandlerTestSuite) TestHandleVerificationCallback_MissingAuthID() {
+	handler := newDeviceHandler(NewDeviceAuthorizationServiceInterfaceMock(s.T()))
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/device/callback",
+		strings.NewReader(`{"userCode":"`+testUserCode+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.HandleVerificationCallbackRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusBadRequest, rec.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleVerificationCallback_ServerError() {
+	svc := NewDeviceAuthorizationServiceInterfaceMock(s.T())
+	svc.EXPECT().CompleteVerification(mock.Anything, testUserCode, testAuthID, "", true).
+		Return(AuthorizationStatus(""), oauth2const.ErrorServerError, "failed")
+	handler := newDeviceHandler(svc)
+
+	body := `{"userCode":"` + testUserCode + `","authId":"` + testAuthID + `","denied":true}`
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/device/callback", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.HandleVerificationCallbackRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusInternalServerError, rec.Code)
+}
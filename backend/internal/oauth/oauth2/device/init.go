@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"context"
+	"net/http"
+
+	authnprovidermgr "github.com/asgardeo/thunder/internal/authnprovider/manager"
+	"github.com/asgardeo/thunder/internal/flow/flowexec"
+	"github.com/asgardeo/thunder/internal/inboundclient"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/clientauth"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/discovery"
+	"github.com/asgardeo/thunder/internal/resource"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/internal/system/middleware"
+)
+
+// Initialize initializes the device authorization handler and registers its routes.
+// Returns the DeviceAuthorizationServiceInterface so the token endpoint can resolve device codes.
+func Initialize(
+	mux *http.ServeMux,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+	jwtService jwt.JWTServiceInterface,
+	discoveryService discovery.DiscoveryServiceInterface,
+	flowExecService flowexec.FlowExecServiceInterface,
+	resourceService resource.ResourceServiceInterface,
+) DeviceAuthorizationServiceInterface {
+	store := initializeDeviceStore()
+	deviceSvc := newDeviceService(store, inboundClient, jwtService, flowExecService, resourceService)
+	handler := newDeviceHandler(deviceSvc)
+	registerRoutes(mux, handler, inboundClient, authnProvider, jwtService, discoveryService)
+	return deviceSvc
+}
+
+// initializeDeviceStore selects the device authorization store implementation based on the
+// configured runtime DB type.
+func initializeDeviceStore() deviceStoreInterface {
+	deploymentID := config.GetServerRuntime().Config.Server.Identifier
+
+	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		return newRedisDeviceAuthorizationStore(provider.GetRedisProvider(), deploymentID)
+	}
+	return newDeviceAuthorizationStore(deploymentID)
+}
+
+// registerRoutes registers the device authorization endpoint with client authentication middleware,
+// and the verification endpoints used by the gate client.
+func registerRoutes(
+	mux *http.ServeMux,
+	handler deviceHandlerInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+	jwtService jwt.JWTServiceInterface,
+	discoveryService discovery.DiscoveryServiceInterface,
+) {
+	corsOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	metadata := discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background())
+	endpointURL := metadata.DeviceAuthorizationEndpoint
+	clientAuthMiddleware := clientauth.ClientAuthMiddleware(inboundClient, authnProvider, jwtService, endpointURL)
+	wrappedHandler := clientAuthMiddleware(http.HandlerFunc(handler.HandleDeviceAuthorizationRequest))
+
+	mux.HandleFunc(middleware.WithCORS("POST /oauth2/device_authorization",
+		wrappedHandler.ServeHTTP, corsOpts))
+
+	mux.HandleFunc(middleware.WithCORS("POST /oauth2/device/verify",
+		handler.HandleVerificationRequest, corsOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /oauth2/device/verify",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, corsOpts))
+
+	mux.HandleFunc(middleware.WithCORS("POST /oauth2/device/callback",
+		handler.HandleVerificationCallbackRequest, corsOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /oauth2/device/callback",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, corsOpts))
+}
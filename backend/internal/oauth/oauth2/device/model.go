@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import "time"
+
+// AuthorizationStatus represents the state of a device authorization request.
+type AuthorizationStatus string
+
+const (
+	// AuthorizationStatusPending indicates that the user has not yet completed the verification.
+	AuthorizationStatusPending AuthorizationStatus = "pending"
+	// AuthorizationStatusAuthorized indicates that the user approved the device.
+	AuthorizationStatusAuthorized AuthorizationStatus = "authorized"
+	// AuthorizationStatusDenied indicates that the user denied the device.
+	AuthorizationStatusDenied AuthorizationStatus = "denied"
+)
+
+// DeviceAuthorization holds the state of a device authorization request.
+// LastPolledAt and Interval are persisted separately from the rest of the record so that polling
+// does not overwrite a decision recorded concurrently by the verification callback.
+type DeviceAuthorization struct {
+	DeviceCode       string              `json:"device_code"`
+	UserCode         string              `json:"user_code"`
+	ClientID         string              `json:"client_id"`
+	StandardScopes   []string            `json:"standard_scopes,omitempty"`
+	PermissionScopes []string            `json:"permission_scopes,omitempty"`
+	Resources        []string            `json:"resources,omitempty"`
+	Status           AuthorizationStatus `json:"status"`
+	VerificationID   string              `json:"verification_id,omitempty"`
+	AuthorizedUserID string              `json:"authorized_user_id,omitempty"`
+	AttributeCacheID string              `json:"attribute_cache_id,omitempty"`
+	AuthTime         time.Time           `json:"auth_time"`
+	CompletedACR     string              `json:"completed_acr,omitempty"`
+	Interval         int64               `json:"interval"`
+	ExpiryTime       time.Time           `json:"expiry_time"`
+	LastPolledAt     time.Time           `json:"-"`
+}
+
+// DeviceAuthorizationResponse represents the device authorization endpoint success response.
+type DeviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+}
+
+// verificationRequest represents the request body sent by the gate client to start verifying a user code.
+type verificationRequest struct {
+	UserCode string `json:"userCode"`
+}
+
+// VerificationResponse represents the response returned to the gate client once the
+// authentication flow for a user code has been initiated.
+type VerificationResponse struct {
+	AuthID        string `json:"authId"`
+	ApplicationID string `json:"applicationId"`
+	ExecutionID   string `json:"executionId"`
+}
+
+// verificationCallbackRequest represents the request body sent by the gate client once the
+// user has completed the authentication flow or denied the device.
+type verificationCallbackRequest struct {
+	UserCode  string `json:"userCode"`
+	AuthID    string `json:"authId"`
+	Assertion string `json:"assertion,omitempty"`
+	Denied    bool   `json:"denied,omitempty"`
+}
+
+// verificationCallbackResponse represents the response returned to the gate client after the
+// user's decision has been recorded.
+type verificationCallbackResponse struct {
+	Status AuthorizationStatus `json:"status"`
+}
@@ -0,0 +1,207 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// deviceRedisClient abstracts the Redis commands used by the device authorization store.
+type deviceRedisClient interface {
+	Set(ctx context.Context, key string, value any, expiration time.Duration) *redis.StatusCmd
+	SetArgs(ctx context.Context, key string, value any, a redis.SetArgs) *redis.StatusCmd
+	Get(ctx context.Context, key string) *redis.StringCmd
+	GetDel(ctx context.Context, key string) *redis.StringCmd
+	Del(ctx context.Context, keys ...string) *redis.IntCmd
+}
+
+// redisDeviceAuthorizationStore is the Redis-backed implementation of deviceStoreInterface.
+// Each request is stored under its device code with a TTL matching the request expiry. A secondary
+// key maps the user code to the device code, and the poll state is kept under its own key.
+// Expired requests are evicted by Redis, so polling with an expired device code yields invalid_grant.
+type redisDeviceAuthorizationStore struct {
+	client       deviceRedisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// redisPollState is the value stored under the poll key of a device authorization request.
+type redisPollState struct {
+	LastPolledAt int64 `json:"last_polled_at"`
+	Interval     int64 `json:"interval"`
+}
+
+// newRedisDeviceAuthorizationStore creates a new Redis-backed device authorization store.
+func newRedisDeviceAuthorizationStore(
+	p provider.RedisProviderInterface, deploymentID string,
+) deviceStoreInterface {
+	return &redisDeviceAuthorizationStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: deploymentID,
+	}
+}
+
+// deviceKey builds the Redis key for a device code.
+func (s *redisDeviceAuthorizationStore) deviceKey(deviceCode string) string {
+	return fmt.Sprintf("%s:runtime:%s:device:%s", s.keyPrefix, s.deploymentID, deviceCode)
+}
+
+// userCodeKey builds the Redis key mapping a user code to its device code.
+func (s *redisDeviceAuthorizationStore) userCodeKey(userCode string) string {
+	return fmt.Sprintf("%s:runtime:%s:deviceusercode:%s", s.keyPrefix, s.deploymentID, userCode)
+}
+
+// pollKey builds the Redis key holding the poll state of a device code.
+func (s *redisDeviceAuthorizationStore) pollKey(deviceCode string) string {
+	return fmt.Sprintf("%s:runtime:%s:devicepoll:%s", s.keyPrefix, s.deploymentID, deviceCode)
+}
+
+// Create persists a new device authorization request and its user code mapping.
+func (s *redisDeviceAuthorizationStore) Create(ctx context.Context, authorization DeviceAuthorization) error {
+	data, err := json.Marshal(authorization)
+	if err != nil {
+		return fmt.Errorf("failed to marshal device authorization: %w", err)
+	}
+
+	ttl := time.Until(authorization.ExpiryTime)
+	if err := s.client.Set(ctx, s.deviceKey(authorization.DeviceCode), data, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store device authorization in Redis: %w", err)
+	}
+	if err := s.client.Set(ctx, s.userCodeKey(authorization.UserCode), authorization.DeviceCode,
+		ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store user code mapping in Redis: %w", err)
+	}
+	return nil
+}
+
+// GetByDeviceCode retrieves a device authorization request by its device code.
+func (s *redisDeviceAuthorizationStore) GetByDeviceCode(
+	ctx context.Context, deviceCode string,
+) (DeviceAuthorization, bool, error) {
+	data, err := s.client.Get(ctx, s.deviceKey(deviceCode)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return DeviceAuthorization{}, false, nil
+		}
+		return DeviceAuthorization{}, false, fmt.Errorf("failed to get device authorization from Redis: %w", err)
+	}
+
+	var authorization DeviceAuthorization
+	if err := json.Unmarshal(data, &authorization); err != nil {
+		return DeviceAuthorization{}, false, fmt.Errorf("failed to unmarshal device authorization: %w", err)
+	}
+
+	pollData, err := s.client.Get(ctx, s.pollKey(deviceCode)).Bytes()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return DeviceAuthorization{}, false, fmt.Errorf("failed to get device poll state from Redis: %w", err)
+	}
+	if err == nil {
+		var state redisPollState
+		if err := json.Unmarshal(pollData, &state); err != nil {
+			return DeviceAuthorization{}, false, fmt.Errorf("failed to unmarshal device poll state: %w", err)
+		}
+		authorization.LastPolledAt = time.UnixMilli(state.LastPolledAt)
+		if state.Interval > 0 {
+			authorization.Interval = state.Interval
+		}
+	}
+	return authorization, true, nil
+}
+
+// GetByUserCode retrieves a device authorization request by its user code.
+func (s *redisDeviceAuthorizationStore) GetByUserCode(
+	ctx context.Context, userCode string,
+) (DeviceAuthorization, bool, error) {
+	deviceCode, err := s.client.Get(ctx, s.userCodeKey(userCode)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return DeviceAuthorization{}, false, nil
+		}
+		return DeviceAuthorization{}, false, fmt.Errorf("failed to get user code mapping from Redis: %w", err)
+	}
+	return s.GetByDeviceCode(ctx, deviceCode)
+}
+
+// Update replaces the stored data of a device authorization request, preserving its TTL.
+// The update is skipped if the request no longer exists.
+func (s *redisDeviceAuthorizationStore) Update(ctx context.Context, authorization DeviceAuthorization) error {
+	data, err := json.Marshal(authorization)
+	if err != nil {
+		return fmt.Errorf("failed to marshal device authorization: %w", err)
+	}
+
+	err = s.client.SetArgs(ctx, s.deviceKey(authorization.DeviceCode), data,
+		redis.SetArgs{Mode: "XX", KeepTTL: true}).Err()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return fmt.Errorf("failed to update device authorization in Redis: %w", err)
+	}
+	return nil
+}
+
+// UpdatePollState records the time at which the client last polled the token endpoint along with
+// the polling interval currently in effect for the request.
+func (s *redisDeviceAuthorizationStore) UpdatePollState(
+	ctx context.Context, authorization DeviceAuthorization,
+) error {
+	ttl := time.Until(authorization.ExpiryTime)
+	if ttl <= 0 {
+		return nil
+	}
+	data, err := json.Marshal(redisPollState{
+		LastPolledAt: authorization.LastPolledAt.UnixMilli(),
+		Interval:     authorization.Interval,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal device poll state: %w", err)
+	}
+	if err := s.client.Set(ctx, s.pollKey(authorization.DeviceCode), data, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store device poll state in Redis: %w", err)
+	}
+	return nil
+}
+
+// Delete atomically removes a device authorization request via Redis GETDEL along with its
+// auxiliary keys. Returns false if the request did not exist.
+func (s *redisDeviceAuthorizationStore) Delete(ctx context.Context, deviceCode string) (bool, error) {
+	data, err := s.client.GetDel(ctx, s.deviceKey(deviceCode)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to delete device authorization from Redis: %w", err)
+	}
+
+	keys := []string{s.pollKey(deviceCode)}
+	var authorization DeviceAuthorization
+	if err := json.Unmarshal(data, &authorization); err == nil && authorization.UserCode != "" {
+		keys = append(keys, s.userCodeKey(authorization.UserCode))
+	}
+	// The auxiliary keys expire along with the request, so failing to delete them is not fatal.
+	s.client.Del(ctx, keys...)
+	return true, nil
+}
@@ -0,0 +1,269 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	redisTestKeyPrefix    = "thunderid"
+	redisTestDeploymentID = "test-deployment-id"
+)
+
+type RedisStoreTestSuite struct {
+	suite.Suite
+	mockClient        *deviceRedisClientMock
+	store             *redisDeviceAuthorizationStore
+	ctx               context.Context
+	testAuthorization DeviceAuthorization
+}
+
+func TestRedisStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisStoreTestSuite))
+}
+
+func (s *RedisStoreTestSuite) SetupTest() {
+	s.mockClient = newDeviceRedisClientMock(s.T())
+	s.store = &redisDeviceAuthorizationStore{
+		client:       s.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: redisTestDeploymentID,
+	}
+	s.ctx = context.Background()
+	s.testAuthorization = DeviceAuthorization{
+		DeviceCode: testDeviceCode,
+		UserCode:   testUserCode,
+		ClientID:   testClientID,
+		Status:     AuthorizationStatusPending,
+		Interval:   5,
+		ExpiryTime: time.Now().Add(10 * time.Minute),
+	}
+}
+
+func (s *RedisStoreTestSuite) buildKey(name, value string) string {
+	return fmt.Sprintf("%s:runtime:%s:%s:%s", redisTestKeyPrefix, redisTestDeploymentID, name, value)
+}
+
+func (s *RedisStoreTestSuite) stringCmd(val string, err error) *redis.StringCmd {
+	cmd := redis.NewStringCmd(s.ctx)
+	if err != nil {
+		cmd.SetErr(err)
+	} else {
+		cmd.SetVal(val)
+	}
+	return cmd
+}
+
+// Tests for keys
+
+func (s *RedisStoreTestSuite) TestKeys() {
+	s.Equal(s.buildKey("device", testDeviceCode), s.store.deviceKey(testDeviceCode))
+	s.Equal(s.buildKey("deviceusercode", testUserCode), s.store.userCodeKey(testUserCode))
+	s.Equal(s.buildKey("devicepoll", testDeviceCode), s.store.pollKey(testDeviceCode))
+}
+
+// Tests for Create
+
+func (s *RedisStoreTestSuite) TestCreate_Success() {
+	s.mockClient.On("Set", s.ctx, s.buildKey("device", testDeviceCode), mock.Anything,
+		mock.MatchedBy(func(ttl time.Duration) bool { return ttl > 9*time.Minute && ttl <= 10*time.Minute }),
+	).Return(redis.NewStatusCmd(s.ctx))
+	s.mockClient.On("Set", s.ctx, s.buildKey("deviceusercode", testUserCode), testDeviceCode, mock.Anything).
+		Return(redis.NewStatusCmd(s.ctx))
+
+	s.NoError(s.store.Create(s.ctx, s.testAuthorization))
+}
+
+func (s *RedisStoreTestSuite) TestCreate_SetError() {
+	cmd := redis.NewStatusCmd(s.ctx)
+	cmd.SetErr(errors.New("redis error"))
+	s.mockClient.On("Set", s.ctx, s.buildKey("device", testDeviceCode), mock.Anything, mock.Anything).
+		Return(cmd)
+
+	err := s.store.Create(s.ctx, s.testAuthorization)
+
+	s.Error(err)
+	s.Contains(err.Error(), "failed to store device authorization in Redis")
+}
+
+// Tests for GetByDeviceCode
+
+func (s *RedisStoreTestSuite) TestGetByDeviceCode_Success() {
+	data, _ := json.Marshal(s.testAuthorization)
+	polledAt := time.Now().Add(-time.Second).Truncate(time.Millisecond)
+	s.mockClient.On("Get", s.ctx, s.buildKey("device", testDeviceCode)).Return(s.stringCmd(string(data), nil))
+	s.mockClient.On("Get", s.ctx, s.buildKey("devicepoll", testDeviceCode)).
+		Return(s.stringCmd(fmt.Sprintf(`{"last_polled_at":%d,"interval":10}`, polledAt.UnixMilli()), nil))
+
+	result, found, err := s.store.GetByDeviceCode(s.ctx, testDeviceCode)
+
+	s.NoError(err)
+	s.True(found)
+	s.Equal(testClientID, result.ClientID)
+	s.True(polledAt.Equal(result.LastPolledAt))
+	s.Equal(int64(10), result.Interval)
+}
+
+func (s *RedisStoreTestSuite) TestGetByDeviceCode_NeverPolled() {
+	data, _ := json.Marshal(s.testAuthorization)
+	s.mockClient.On("Get", s.ctx, s.buildKey("device", testDeviceCode)).Return(s.stringCmd(string(data), nil))
+	s.mockClient.On("Get", s.ctx, s.buildKey("devicepoll", testDeviceCode)).Return(s.stringCmd("", redis.Nil))
+
+	result, found, err := s.store.GetByDeviceCode(s.ctx, testDeviceCode)
+
+	s.NoError(err)
+	s.True(found)
+	s.True(result.LastPolledAt.IsZero())
+}
+
+func (s *RedisStoreTestSuite) TestGetByDeviceCode_NotFound() {
+	s.mockClient.On("Get", s.ctx, s.buildKey("device", testDeviceCode)).Return(s.stringCmd("", redis.Nil))
+
+	_, found, err := s.store.GetByDeviceCode(s.ctx, testDeviceCode)
+
+	s.NoError(err)
+	s.False(found)
+}
+
+func (s *RedisStoreTestSuite) TestGetByDeviceCode_RedisError() {
+	s.mockClient.On("Get", s.ctx, s.buildKey("device", testDeviceCode)).
+		Return(s.stringCmd("", errors.New("redis error")))
+
+	_, found, err := s.store.GetByDeviceCode(s.ctx, testDeviceCode)
+
+	s.Error(err)
+	s.False(found)
+}
+
+// Tests for GetByUserCode
+
+func (s *RedisStoreTestSuite) TestGetByUserCode_Success() {
+	data, _ := json.Marshal(s.testAuthorization)
+	s.mockClient.On("Get", s.ctx, s.buildKey("deviceusercode", testUserCode)).
+		Return(s.stringCmd(testDeviceCode, nil))
+	s.mockClient.On("Get", s.ctx, s.buildKey("device", testDeviceCode)).Return(s.stringCmd(string(data), nil))
+	s.mockClient.On("Get", s.ctx, s.buildKey("devicepoll", testDeviceCode)).Return(s.stringCmd("", redis.Nil))
+
+	result, found, err := s.store.GetByUserCode(s.ctx, testUserCode)
+
+	s.NoError(err)
+	s.True(found)
+	s.Equal(testDeviceCode, result.DeviceCode)
+}
+
+func (s *RedisStoreTestSuite) TestGetByUserCode_NotFound() {
+	s.mockClient.On("Get", s.ctx, s.buildKey("deviceusercode", testUserCode)).Return(s.stringCmd("", redis.Nil))
+
+	_, found, err := s.store.GetByUserCode(s.ctx, testUserCode)
+
+	s.NoError(err)
+	s.False(found)
+}
+
+// Tests for Update
+
+func (s *RedisStoreTestSuite) TestUpdate_Success() {
+	s.mockClient.On("SetArgs", s.ctx, s.buildKey("device", testDeviceCode), mock.Anything,
+		redis.SetArgs{Mode: "XX", KeepTTL: true}).Return(redis.NewStatusCmd(s.ctx))
+
+	s.NoError(s.store.Update(s.ctx, s.testAuthorization))
+}
+
+func (s *RedisStoreTestSuite) TestUpdate_KeyMissing() {
+	cmd := redis.NewStatusCmd(s.ctx)
+	cmd.SetErr(redis.Nil)
+	s.mockClient.On("SetArgs", s.ctx, s.buildKey("device", testDeviceCode), mock.Anything, mock.Anything).
+		Return(cmd)
+
+	s.NoError(s.store.Update(s.ctx, s.testAuthorization))
+}
+
+func (s *RedisStoreTestSuite) TestUpdate_RedisError() {
+	cmd := redis.NewStatusCmd(s.ctx)
+	cmd.SetErr(errors.New("redis error"))
+	s.mockClient.On("SetArgs", s.ctx, s.buildKey("device", testDeviceCode), mock.Anything, mock.Anything).
+		Return(cmd)
+
+	s.Error(s.store.Update(s.ctx, s.testAuthorization))
+}
+
+// Tests for UpdatePollState
+
+func (s *RedisStoreTestSuite) TestUpdatePollState_Success() {
+	s.testAuthorization.LastPolledAt = time.Now()
+	s.testAuthorization.Interval = 10
+	expected, _ := json.Marshal(redisPollState{
+		LastPolledAt: s.testAuthorization.LastPolledAt.UnixMilli(),
+		Interval:     10,
+	})
+	s.mockClient.On("Set", s.ctx, s.buildKey("devicepoll", testDeviceCode), expected, mock.Anything).
+		Return(redis.NewStatusCmd(s.ctx))
+
+	s.NoError(s.store.UpdatePollState(s.ctx, s.testAuthorization))
+}
+
+func (s *RedisStoreTestSuite) TestUpdatePollState_Expired() {
+	s.testAuthorization.ExpiryTime = time.Now().Add(-time.Second)
+
+	s.NoError(s.store.UpdatePollState(s.ctx, s.testAuthorization))
+	s.mockClient.AssertNotCalled(s.T(), "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Tests for Delete
+
+func (s *RedisStoreTestSuite) TestDelete_Success() {
+	data, _ := json.Marshal(s.testAuthorization)
+	s.mockClient.On("GetDel", s.ctx, s.buildKey("device", testDeviceCode)).Return(s.stringCmd(string(data), nil))
+	s.mockClient.On("Del", s.ctx, s.buildKey("devicepoll", testDeviceCode),
+		s.buildKey("deviceusercode", testUserCode)).Return(redis.NewIntCmd(s.ctx))
+
+	deleted, err := s.store.Delete(s.ctx, testDeviceCode)
+
+	s.NoError(err)
+	s.True(deleted)
+}
+
+func (s *RedisStoreTestSuite) TestDelete_NotFound() {
+	s.mockClient.On("GetDel", s.ctx, s.buildKey("device", testDeviceCode)).Return(s.stringCmd("", redis.Nil))
+
+	deleted, err := s.store.Delete(s.ctx, testDeviceCode)
+
+	s.NoError(err)
+	s.False(deleted)
+}
+
+func (s *RedisStoreTestSuite) TestDelete_RedisError() {
+	s.mockClient.On("GetDel", s.ctx, s.buildKey("device", testDeviceCode)).
+		Return(s.stringCmd("", errors.New("redis error")))
+
+	deleted, err := s.store.Delete(s.ctx, testDeviceCode)
+
+	s.Error(err)
+	s.False(deleted)
+}
@@ -0,0 +1,464 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	flowcm "github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/flowassertion"
+	"github.com/asgardeo/thunder/internal/flow/flowexec"
+	"github.com/asgardeo/thunder/internal/inboundclient"
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/authz"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/authz/requestvalidator"
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	oauth2model "github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/resourceindicators"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
+	oauth2utils "github.com/asgardeo/thunder/internal/oauth/oauth2/utils"
+	"github.com/asgardeo/thunder/internal/resource"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/internal/system/log"
+	"github.com/asgardeo/thunder/internal/system/utils"
+)
+
+const (
+	// deviceCodeRandomBytes is the number of random bytes for the device code (32 bytes = 256 bits).
+	deviceCodeRandomBytes = 32
+	// userCodeCharset is the character set for user codes. Vowels are excluded to avoid forming words,
+	// and the remaining consonants are unambiguous when read from a screen (RFC 8628 §6.1).
+	userCodeCharset = "BCDFGHJKLMNPQRSTVWXZ"
+	// userCodeLength is the number of characters in a user code, excluding the separator.
+	userCodeLength = 8
+	// defaultDevicePath is the gate client verification path used when none is configured.
+	defaultDevicePath = "/device"
+	// slowDownIntervalIncrement is the number of seconds added to the polling interval each time a
+	// client receives a slow_down error (RFC 8628 §3.5).
+	slowDownIntervalIncrement = 5
+)
+
+// DeviceAuthorizationServiceInterface defines the interface for the device authorization service.
+type DeviceAuthorizationServiceInterface interface {
+	HandleDeviceAuthorizationRequest(
+		ctx context.Context, scope string, resources []string, oauthApp *inboundmodel.OAuthClient,
+	) (*DeviceAuthorizationResponse, string, string)
+	InitiateVerification(ctx context.Context, userCode string) (*VerificationResponse, string, string)
+	CompleteVerification(
+		ctx context.Context, userCode string, authID string, assertion string, denied bool,
+	) (AuthorizationStatus, string, string)
+	ConsumeDeviceAuthorization(
+		ctx context.Context, clientID string, deviceCode string,
+	) (*DeviceAuthorization, *oauth2model.ErrorResponse)
+}
+
+// deviceService implements DeviceAuthorizationServiceInterface.
+type deviceService struct {
+	store           deviceStoreInterface
+	inboundClient   inboundclient.InboundClientServiceInterface
+	jwtService      jwt.JWTServiceInterface
+	flowExecService flowexec.FlowExecServiceInterface
+	resourceService resource.ResourceServiceInterface
+	logger          *log.Logger
+}
+
+// newDeviceService creates a new device authorization service instance.
+func newDeviceService(
+	store deviceStoreInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	jwtService jwt.JWTServiceInterface,
+	flowExecService flowexec.FlowExecServiceInterface,
+	resourceService resource.ResourceServiceInterface,
+) DeviceAuthorizationServiceInterface {
+	return &deviceService{
+		store:           store,
+		inboundClient:   inboundClient,
+		jwtService:      jwtService,
+		flowExecService: flowExecService,
+		resourceService: resourceService,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DeviceAuthorizationService")),
+	}
+}
+
+// HandleDeviceAuthorizationRequest validates a device authorization request and issues the device
+// and user codes. Returns the response on success, or (errorCode, errorDescription) on failure.
+func (s *deviceService) HandleDeviceAuthorizationRequest(
+	ctx context.Context, scope string, resources []string, oauthApp *inboundmodel.OAuthClient,
+) (*DeviceAuthorizationResponse, string, string) {
+	if !oauthApp.IsAllowedGrantType(oauth2const.GrantTypeDeviceCode) {
+		return nil, oauth2const.ErrorUnauthorizedClient,
+			"The client is not authorized to use the device authorization grant"
+	}
+
+	if errResp := resourceindicators.ValidateResourceURIs(resources); errResp != nil {
+		return nil, errResp.Error, errResp.ErrorDescription
+	}
+
+	oidcScopes, nonOidcScopes := oauth2utils.SeparateOIDCAndNonOIDCScopes(scope, oauthApp.ScopeClaims)
+	_, nonOidcScopes, errResp := resourceindicators.ResolveAndDownscope(
+		ctx, s.resourceService, resources, nonOidcScopes)
+	if errResp != nil {
+		return nil, errResp.Error, errResp.ErrorDescription
+	}
+
+	deviceCode, err := generateRandomKey()
+	if err != nil {
+		s.logger.Error("Failed to generate device code", log.Error(err))
+		return nil, oauth2const.ErrorServerError, "Failed to process device authorization request"
+	}
+	userCode, err := generateUserCode()
+	if err != nil {
+		s.logger.Error("Failed to generate user code", log.Error(err))
+		return nil, oauth2const.ErrorServerError, "Failed to process device authorization request"
+	}
+
+	deviceConfig := config.GetServerRuntime().Config.OAuth.DeviceAuthorization
+	authorization := DeviceAuthorization{
+		DeviceCode:       deviceCode,
+		UserCode:         userCode,
+		ClientID:         oauthApp.ClientID,
+		StandardScopes:   oidcScopes,
+		PermissionScopes: nonOidcScopes,
+		Resources:        resources,
+		Status:           AuthorizationStatusPending,
+		Interval:         deviceConfig.PollingInterval,
+		ExpiryTime:       time.Now().Add(time.Duration(deviceConfig.ExpiresIn) * time.Second),
+	}
+	if err := s.store.Create(ctx, authorization); err != nil {
+		s.logger.Error("Failed to store device authorization request", log.Error(err))
+		return nil, oauth2const.ErrorServerError, "Failed to process device authorization request"
+	}
+
+	verificationURI := buildVerificationURI()
+	return &DeviceAuthorizationResponse{
+		DeviceCode:              deviceCode,
+		UserCode:                userCode,
+		VerificationURI:         verificationURI,
+		VerificationURIComplete: verificationURI + "?user_code=" + url.QueryEscape(userCode),
+		ExpiresIn:               deviceConfig.ExpiresIn,
+		Interval:                deviceConfig.PollingInterval,
+	}, "", ""
+}
+
+// InitiateVerification starts the authentication flow for the device authorization request
+// identified by the user code. Returns the parameters the gate client needs to run the flow,
+// or (errorCode, errorDescription) on failure.
+func (s *deviceService) InitiateVerification(
+	ctx context.Context, userCode string,
+) (*VerificationResponse, string, string) {
+	authorization, errCode, errDesc := s.getPendingAuthorization(ctx, userCode)
+	if errCode != "" {
+		return nil, errCode, errDesc
+	}
+
+	app, err := s.inboundClient.GetOAuthClientByClientID(ctx, authorization.ClientID)
+	if err != nil || app == nil {
+		s.logger.Error("Failed to resolve the client of the device authorization request",
+			log.MaskedString("clientID", authorization.ClientID), log.Error(err))
+		return nil, oauth2const.ErrorServerError, "Failed to process verification request"
+	}
+
+	essentialAttributes, optionalAttributes := authz.GetRequiredAttributes(
+		authorization.StandardScopes, nil, string(oauth2const.ResponseTypeCode), app)
+	runtimeData := map[string]string{
+		flowcm.RuntimeKeyClientID:                      authorization.ClientID,
+		flowcm.RuntimeKeyRequestedPermissions:          utils.StringifyStringArray(authorization.PermissionScopes, " "),
+		flowcm.RuntimeKeyRequiredEssentialAttributes:   essentialAttributes,
+		flowcm.RuntimeKeyRequiredOptionalAttributes:    optionalAttributes,
+		flowcm.RuntimeKeyUserAttributesCacheTTLSeconds: fmt.Sprintf("%d", resolveUserAttributesCacheTTL(app)),
+	}
+	if acrValues := requestvalidator.ResolveACRValues("", app.AcrValues); acrValues != "" {
+		runtimeData[flowcm.RuntimeKeyRequestedAuthClasses] = acrValues
+	}
+
+	executionID, flowErr := s.flowExecService.InitiateFlow(ctx, &flowexec.FlowInitContext{
+		ApplicationID: app.ID,
+		FlowType:      string(flowcm.FlowTypeAuthentication),
+		RuntimeData:   runtimeData,
+	})
+	if flowErr != nil {
+		s.logger.Error("Failed to initiate authentication flow", log.String("error_code", flowErr.Code))
+		return nil, oauth2const.ErrorServerError, "Failed to process verification request"
+	}
+
+	verificationID, err := generateRandomKey()
+	if err != nil {
+		s.logger.Error("Failed to generate verification identifier", log.Error(err))
+		return nil, oauth2const.ErrorServerError, "Failed to process verification request"
+	}
+	authorization.VerificationID = verificationID
+	if err := s.store.Update(ctx, authorization); err != nil {
+		s.logger.Error("Failed to update device authorization request", log.Error(err))
+		return nil, oauth2const.ErrorServerError, "Failed to process verification request"
+	}
+
+	return &VerificationResponse{
+		AuthID:        verificationID,
+		ApplicationID: app.ID,
+		ExecutionID:   executionID,
+	}, "", ""
+}
+
+// CompleteVerification records the user's decision for the device authorization request identified
+// by the user code. When approved, the flow assertion is verified and the authenticated user is bound
+// to the request. Returns the resulting status, or (errorCode, errorDescription) on failure.
+func (s *deviceService) CompleteVerification(
+	ctx context.Context, userCode string, authID string, assertion string, denied bool,
+) (AuthorizationStatus, string, string) {
+	authorization, errCode, errDesc := s.getPendingAuthorization(ctx, userCode)
+	if errCode != "" {
+		return "", errCode, errDesc
+	}
+
+	if authorization.VerificationID == "" ||
+		subtle.ConstantTimeCompare([]byte(authorization.VerificationID), []byte(authID)) != 1 {
+		s.logger.Debug("Device verification rejected", log.Error(errAuthIDMismatch))
+		return "", oauth2const.ErrorInvalidRequest, "Invalid verification request"
+	}
+
+	if denied {
+		authorization.Status = AuthorizationStatusDenied
+	} else {
+		if assertion == "" {
+			return "", oauth2const.ErrorInvalidRequest, "Assertion is required"
+		}
+		if err := s.jwtService.VerifyJWT(assertion, "", ""); err != nil {
+			s.logger.Debug("Invalid assertion signature", log.String("error", err.Error.DefaultValue))
+			return "", oauth2const.ErrorInvalidRequest, "Invalid assertion"
+		}
+		claims, err := flowassertion.Decode(assertion)
+		if err != nil || claims.UserID == "" {
+			s.logger.Debug("Failed to decode assertion", log.Error(err))
+			return "", oauth2const.ErrorInvalidRequest, "Invalid assertion"
+		}
+
+		authorization.Status = AuthorizationStatusAuthorized
+		authorization.AuthorizedUserID = claims.UserID
+		authorization.AttributeCacheID = claims.AttributeCacheID
+		authorization.CompletedACR = claims.CompletedACR
+		authorization.AuthTime = claims.AuthTime
+		if authorization.AuthTime.IsZero() {
+			authorization.AuthTime = time.Now()
+		}
+		// Overwrite the permission scopes with the permissions authorized during the flow.
+		authorization.PermissionScopes = utils.ParseStringArray(claims.AuthorizedPermissions, " ")
+	}
+
+	if err := s.store.Update(ctx, authorization); err != nil {
+		s.logger.Error("Failed to update device authorization request", log.Error(err))
+		return "", oauth2const.ErrorServerError, "Failed to process verification request"
+	}
+	return authorization.Status, "", ""
+}
+
+// ConsumeDeviceAuthorization resolves the device authorization request polled by the client at the
+// token endpoint. The request is consumed once approved; otherwise the appropriate polling error
+// (authorization_pending, slow_down, access_denied or expired_token) is returned.
+func (s *deviceService) ConsumeDeviceAuthorization(
+	ctx context.Context, clientID string, deviceCode string,
+) (*DeviceAuthorization, *oauth2model.ErrorResponse) {
+	authorization, found, err := s.store.GetByDeviceCode(ctx, deviceCode)
+	if err != nil {
+		s.logger.Error("Failed to retrieve device authorization request", log.Error(err))
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorServerError,
+			ErrorDescription: "Failed to retrieve device authorization request",
+		}
+	}
+	// The device code is bound to the client it was issued to.
+	if !found || authorization.ClientID != clientID {
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorInvalidGrant,
+			ErrorDescription: "Invalid device code",
+		}
+	}
+
+	now := time.Now()
+	if !now.Before(authorization.ExpiryTime) {
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorExpiredToken,
+			ErrorDescription: "The device code has expired",
+		}
+	}
+
+	switch authorization.Status {
+	case AuthorizationStatusAuthorized, AuthorizationStatusDenied:
+		deleted, err := s.store.Delete(ctx, deviceCode)
+		if err != nil {
+			s.logger.Error("Failed to consume device authorization request", log.Error(err))
+			return nil, &oauth2model.ErrorResponse{
+				Error:            oauth2const.ErrorServerError,
+				ErrorDescription: "Failed to consume device authorization request",
+			}
+		}
+		// Another poll raced us to the delete; treat as already consumed.
+		if !deleted {
+			return nil, &oauth2model.ErrorResponse{
+				Error:            oauth2const.ErrorInvalidGrant,
+				ErrorDescription: "Invalid device code",
+			}
+		}
+		if authorization.Status == AuthorizationStatusDenied {
+			return nil, &oauth2model.ErrorResponse{
+				Error:            oauth2const.ErrorAccessDenied,
+				ErrorDescription: "The user denied the authorization request",
+			}
+		}
+		return &authorization, nil
+	default:
+		tooFast := !authorization.LastPolledAt.IsZero() &&
+			now.Sub(authorization.LastPolledAt) < time.Duration(authorization.Interval)*time.Second
+		authorization.LastPolledAt = now
+		if tooFast {
+			authorization.Interval += slowDownIntervalIncrement
+		}
+		if err := s.store.UpdatePollState(ctx, authorization); err != nil {
+			s.logger.Error("Failed to record device authorization poll", log.Error(err))
+			return nil, &oauth2model.ErrorResponse{
+				Error:            oauth2const.ErrorServerError,
+				ErrorDescription: "Failed to process device authorization request",
+			}
+		}
+		if tooFast {
+			return nil, &oauth2model.ErrorResponse{
+				Error:            oauth2const.ErrorSlowDown,
+				ErrorDescription: "The client is polling too frequently",
+			}
+		}
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorAuthorizationPending,
+			ErrorDescription: "The authorization request is still pending",
+		}
+	}
+}
+
+// getPendingAuthorization retrieves the pending device authorization request for a user code.
+func (s *deviceService) getPendingAuthorization(
+	ctx context.Context, userCode string,
+) (DeviceAuthorization, string, string) {
+	normalizedUserCode, ok := normalizeUserCode(userCode)
+	if !ok {
+		return DeviceAuthorization{}, oauth2const.ErrorInvalidRequest, "Invalid user code"
+	}
+
+	authorization, found, err := s.store.GetByUserCode(ctx, normalizedUserCode)
+	if err != nil {
+		s.logger.Error("Failed to retrieve device authorization request", log.Error(err))
+		return DeviceAuthorization{}, oauth2const.ErrorServerError, "Failed to process verification request"
+	}
+	if !found {
+		s.logger.Debug("Device verification rejected", log.Error(errUserCodeNotFound))
+		return DeviceAuthorization{}, oauth2const.ErrorInvalidRequest, "Invalid or expired user code"
+	}
+	if authorization.Status != AuthorizationStatusPending {
+		s.logger.Debug("Device verification rejected", log.Error(errAuthorizationCompleted))
+		return DeviceAuthorization{}, oauth2const.ErrorInvalidRequest, "Invalid or expired user code"
+	}
+	return authorization, "", ""
+}
+
+// resolveUserAttributesCacheTTL computes the attribute cache TTL for a device authorization.
+// The largest of the access and refresh token (if allowed) validity periods is taken as the base,
+// then the device code lifetime is added to cover the window between approval and the client's
+// next poll, along with the fixed buffer applied to authorization code flows.
+func resolveUserAttributesCacheTTL(app *inboundmodel.OAuthClient) int64 {
+	maxTTL := tokenservice.ResolveTokenConfig(app, tokenservice.TokenTypeAccess).ValidityPeriod
+	if app.IsAllowedGrantType(oauth2const.GrantTypeRefreshToken) {
+		refreshTTL := tokenservice.ResolveTokenConfig(app, tokenservice.TokenTypeRefresh).ValidityPeriod
+		if refreshTTL > maxTTL {
+			maxTTL = refreshTTL
+		}
+	}
+	expiresIn := config.GetServerRuntime().Config.OAuth.DeviceAuthorization.ExpiresIn
+	return maxTTL + expiresIn + oauth2const.AttributeCacheTTLBufferSeconds
+}
+
+// buildVerificationURI builds the gate client page where users enter their user code.
+func buildVerificationURI() string {
+	gateClient := config.GetServerRuntime().Config.GateClient
+	devicePath := gateClient.DevicePath
+	if strings.TrimSpace(devicePath) == "" {
+		devicePath = defaultDevicePath
+	}
+	verificationURI := url.URL{
+		Scheme: gateClient.Scheme,
+		Host:   net.JoinHostPort(gateClient.Hostname, strconv.Itoa(gateClient.Port)),
+		Path:   devicePath,
+	}
+	return verificationURI.String()
+}
+
+// normalizeUserCode canonicalizes a user entered code to the XXXX-XXXX form. Input is
+// case-insensitive and separators or whitespace are ignored (RFC 8628 §6.1).
+func normalizeUserCode(userCode string) (string, bool) {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(userCode) {
+		if r == '-' || r == ' ' || r == '\t' {
+			continue
+		}
+		if !strings.ContainsRune(userCodeCharset, r) {
+			return "", false
+		}
+		b.WriteRune(r)
+	}
+	code := b.String()
+	if len(code) != userCodeLength {
+		return "", false
+	}
+	return formatUserCode(code), true
+}
+
+// formatUserCode inserts a separator in the middle of a user code for readability.
+func formatUserCode(code string) string {
+	return code[:userCodeLength/2] + "-" + code[userCodeLength/2:]
+}
+
+// generateUserCode generates a random user code from userCodeCharset.
+func generateUserCode() (string, error) {
+	charsetLen := big.NewInt(int64(len(userCodeCharset)))
+	code := make([]byte, userCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, charsetLen)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate random index: %w", err)
+		}
+		code[i] = userCodeCharset[n.Int64()]
+	}
+	return formatUserCode(string(code)), nil
+}
+
+// generateRandomKey generates a cryptographically random, URL-safe key.
+func generateRandomKey() (string, error) {
+	b := make([]byte, deviceCodeRandomBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
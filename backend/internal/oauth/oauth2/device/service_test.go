@@ -0,0 +1,546 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	flowcm "github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/flowexec"
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/resource"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/tests/mocks/flow/flowexecmock"
+	"github.com/asgardeo/thunder/tests/mocks/inboundclientmock"
+	"github.com/asgardeo/thunder/tests/mocks/jose/jwtmock"
+	"github.com/asgardeo/thunder/tests/mocks/resourcemock"
+)
+
+const (
+	testClientID   = "test-client"
+	testAppID      = "test-app-id"
+	testDeviceCode = "test-device-code"
+	testUserCode   = "BCDF-GHJK"
+	testAuthID     = "test-auth-id"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	ctx                 context.Context
+	mockStore           *deviceStoreInterfaceMock
+	mockInboundClient   *inboundclientmock.InboundClientServiceInterfaceMock
+	mockJWTService      *jwtmock.JWTServiceInterfaceMock
+	mockFlowExecService *flowexecmock.FlowExecServiceInterfaceMock
+	mockResourceService *resourcemock.ResourceServiceInterfaceMock
+	service             DeviceAuthorizationServiceInterface
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	testConfig := &config.Config{
+		JWT: config.JWTConfig{ValidityPeriod: 3600},
+		GateClient: config.GateClientConfig{
+			Scheme:     "https",
+			Hostname:   "localhost",
+			Port:       5190,
+			DevicePath: "/gate/device",
+		},
+		OAuth: config.OAuthConfig{
+			DeviceAuthorization: config.DeviceAuthorizationConfig{
+				ExpiresIn:       600,
+				PollingInterval: 5,
+			},
+		},
+	}
+	_ = config.InitializeServerRuntime("", testConfig)
+	s.ctx = context.Background()
+
+	s.mockStore = newDeviceStoreInterfaceMock(s.T())
+	s.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(s.T())
+	s.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(s.T())
+	s.mockFlowExecService = flowexecmock.NewFlowExecServiceInterfaceMock(s.T())
+	s.mockResourceService = resourcemock.NewResourceServiceInterfaceMock(s.T())
+	s.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, mock.Anything).
+		Return(func(_ context.Context, identifier string) *resource.ResourceServer {
+			return &resource.ResourceServer{ID: identifier, Identifier: identifier}
+		}, func(_ context.Context, _ string) *serviceerror.ServiceError {
+			return nil
+		}).Maybe()
+	s.mockResourceService.On("ValidatePermissions", mock.Anything, mock.Anything, mock.Anything).
+		Return([]string{}, (*serviceerror.ServiceError)(nil)).Maybe()
+
+	s.service = newDeviceService(s.mockStore, s.mockInboundClient, s.mockJWTService,
+		s.mockFlowExecService, s.mockResourceService)
+}
+
+func (s *ServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *ServiceTestSuite) newTestApp() *inboundmodel.OAuthClient {
+	return &inboundmodel.OAuthClient{
+		ID:                      testAppID,
+		ClientID:                testClientID,
+		GrantTypes:              []oauth2const.GrantType{oauth2const.GrantTypeDeviceCode},
+		TokenEndpointAuthMethod: oauth2const.TokenEndpointAuthMethodNone,
+		PublicClient:            true,
+	}
+}
+
+func (s *ServiceTestSuite) newPendingAuthorization() DeviceAuthorization {
+	return DeviceAuthorization{
+		DeviceCode:     testDeviceCode,
+		UserCode:       testUserCode,
+		ClientID:       testClientID,
+		StandardScopes: []string{"openid"},
+		Status:         AuthorizationStatusPending,
+		VerificationID: testAuthID,
+		Interval:       5,
+		ExpiryTime:     time.Now().Add(10 * time.Minute),
+	}
+}
+
+// buildAssertion builds an unsigned JWT carrying the given claims. Signature verification is mocked.
+func buildAssertion(claims map[string]any) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	payload, _ := json.Marshal(claims)
+	return header + "." + base64.RawURLEncoding.EncodeToString(payload) + "."
+}
+
+// Tests for HandleDeviceAuthorizationRequest
+
+func (s *ServiceTestSuite) TestHandleDeviceAuthorizationRequest_Success() {
+	var stored DeviceAuthorization
+	s.mockStore.EXPECT().Create(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, authorization DeviceAuthorization) {
+			stored = authorization
+		}).Return(nil)
+
+	resp, errCode, _ := s.service.HandleDeviceAuthorizationRequest(s.ctx, "openid read", nil, s.newTestApp())
+
+	s.Empty(errCode)
+	s.NotNil(resp)
+	s.NotEmpty(resp.DeviceCode)
+	s.Regexp(`^[BCDFGHJKLMNPQRSTVWXZ]{4}-[BCDFGHJKLMNPQRSTVWXZ]{4}$`, resp.UserCode)
+	s.Equal("https://localhost:5190/gate/device", resp.VerificationURI)
+	s.Equal(resp.VerificationURI+"?user_code="+resp.UserCode, resp.VerificationURIComplete)
+	s.Equal(int64(600), resp.ExpiresIn)
+	s.Equal(int64(5), resp.Interval)
+
+	s.Equal(resp.DeviceCode, stored.DeviceCode)
+	s.Equal(resp.UserCode, stored.UserCode)
+	s.Equal(testClientID, stored.ClientID)
+	s.Equal([]string{"openid"}, stored.StandardScopes)
+	s.Equal([]string{"read"}, stored.PermissionScopes)
+	s.Equal(AuthorizationStatusPending, stored.Status)
+	s.WithinDuration(time.Now().Add(10*time.Minute), stored.ExpiryTime, 5*time.Second)
+}
+
+func (s *ServiceTestSuite) TestHandleDeviceAuthorizationRequest_GrantNotAllowed() {
+	app := s.newTestApp()
+	app.GrantTypes = []oauth2const.GrantType{oauth2const.GrantTypeAuthorizationCode}
+
+	resp, errCode, _ := s.service.HandleDeviceAuthorizationRequest(s.ctx, "openid", nil, app)
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorUnauthorizedClient, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandleDeviceAuthorizationRequest_InvalidResource() {
+	resp, errCode, _ := s.service.HandleDeviceAuthorizationRequest(
+		s.ctx, "openid", []string{"not-a-uri"}, s.newTestApp())
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorInvalidTarget, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandleDeviceAuthorizationRequest_StoreError() {
+	s.mockStore.EXPECT().Create(mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	resp, errCode, _ := s.service.HandleDeviceAuthorizationRequest(s.ctx, "openid", nil, s.newTestApp())
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorServerError, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandleDeviceAuthorizationRequest_DefaultDevicePath() {
+	config.GetServerRuntime().Config.GateClient.DevicePath = ""
+	s.mockStore.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	resp, errCode, _ := s.service.HandleDeviceAuthorizationRequest(s.ctx, "openid", nil, s.newTestApp())
+
+	s.Empty(errCode)
+	s.Equal("https://localhost:5190/device", resp.VerificationURI)
+}
+
+// Tests for InitiateVerification
+
+func (s *ServiceTestSuite) TestInitiateVerification_Success() {
+	authorization := s.newPendingAuthorization()
+	authorization.VerificationID = ""
+	authorization.PermissionScopes = []string{"read", "write"}
+	s.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(authorization, true, nil)
+	s.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(s.newTestApp(), nil)
+	s.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything,
+		mock.MatchedBy(func(initCtx *flowexec.FlowInitContext) bool {
+			return initCtx.ApplicationID == testAppID &&
+				initCtx.FlowType == string(flowcm.FlowTypeAuthentication) &&
+				initCtx.RuntimeData[flowcm.RuntimeKeyClientID] == testClientID &&
+				initCtx.RuntimeData[flowcm.RuntimeKeyRequestedPermissions] == "read write" &&
+				initCtx.RuntimeData[flowcm.RuntimeKeyUserAttributesCacheTTLSeconds] == "4260"
+		})).Return("test-execution-id", nil)
+	var updated DeviceAuthorization
+	s.mockStore.EXPECT().Update(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, a DeviceAuthorization) { updated = a }).Return(nil)
+
+	// Lower case input with whitespace is normalized.
+	resp, errCode, _ := s.service.InitiateVerification(s.ctx, " bcdf ghjk ")
+
+	s.Empty(errCode)
+	s.Equal(testAppID, resp.ApplicationID)
+	s.Equal("test-execution-id", resp.ExecutionID)
+	s.NotEmpty(resp.AuthID)
+	s.Equal(resp.AuthID, updated.VerificationID)
+}
+
+func (s *ServiceTestSuite) TestInitiateVerification_InvalidUserCodeFormat() {
+	resp, errCode, _ := s.service.InitiateVerification(s.ctx, "AEIO-1234")
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+}
+
+func (s *ServiceTestSuite) TestInitiateVerification_UserCodeNotFound() {
+	s.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(DeviceAuthorization{}, false, nil)
+
+	resp, errCode, errDesc := s.service.InitiateVerification(s.ctx, testUserCode)
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+	s.Equal("Invalid or expired user code", errDesc)
+}
+
+func (s *ServiceTestSuite) TestInitiateVerification_AlreadyCompleted() {
+	authorization := s.newPendingAuthorization()
+	authorization.Status = AuthorizationStatusAuthorized
+	s.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(authorization, true, nil)
+
+	resp, errCode, _ := s.service.InitiateVerification(s.ctx, testUserCode)
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+}
+
+func (s *ServiceTestSuite) TestInitiateVerification_StoreError() {
+	s.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).
+		Return(DeviceAuthorization{}, false, errors.New("db error"))
+
+	resp, errCode, _ := s.service.InitiateVerification(s.ctx, testUserCode)
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorServerError, errCode)
+}
+
+func (s *ServiceTestSuite) TestInitiateVerification_FlowInitiationError() {
+	s.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).
+		Return(s.newPendingAuthorization(), true, nil)
+	s.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(s.newTestApp(), nil)
+	s.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).
+		Return("", &serviceerror.InternalServerError)
+
+	resp, errCode, _ := s.service.InitiateVerification(s.ctx, testUserCode)
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorServerError, errCode)
+}
+
+// Tests for CompleteVerification
+
+func (s *ServiceTestSuite) TestCompleteVerification_Approved() {
+	assertion := buildAssertion(map[string]any{
+		"sub":                    "test-user",
+		"iat":                    1701421200,
+		"authorized_permissions": "read",
+		"aci":                    "test-cache-id",
+	})
+	s.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).
+		Return(s.newPendingAuthorization(), true, nil)
+	s.mockJWTService.EXPECT().VerifyJWT(assertion, "", "").Return(nil)
+	s.mockStore.EXPECT().Update(mock.Anything, mock.MatchedBy(func(a DeviceAuthorization) bool {
+		return a.Status == AuthorizationStatusAuthorized && a.AuthorizedUserID == "test-user" &&
+			a.AttributeCacheID == "test-cache-id" && a.AuthTime.Equal(time.Unix(1701421200, 0)) &&
+			assert.ObjectsAreEqual([]string{"read"}, a.PermissionScopes)
+	})).Return(nil)
+
+	status, errCode, _ := s.service.CompleteVerification(s.ctx, testUserCode, testAuthID, assertion, false)
+
+	s.Empty(errCode)
+	s.Equal(AuthorizationStatusAuthorized, status)
+}
+
+func (s *ServiceTestSuite) TestCompleteVerification_Denied() {
+	s.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).
+		Return(s.newPendingAuthorization(), true, nil)
+	s.mockStore.EXPECT().Update(mock.Anything, mock.MatchedBy(func(a DeviceAuthorization) bool {
+		return a.Status == AuthorizationStatusDenied && a.AuthorizedUserID == ""
+	})).Return(nil)
+
+	status, errCode, _ := s.service.CompleteVerification(s.ctx, testUserCode, testAuthID, "", true)
+
+	s.Empty(errCode)
+	s.Equal(AuthorizationStatusDenied, status)
+}
+
+func (s *ServiceTestSuite) TestCompleteVerification_AuthIDMismatch() {
+	s.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).
+		Return(s.newPendingAuthorization(), true, nil)
+
+	status, errCode, _ := s.service.CompleteVerification(s.ctx, testUserCode, "other-auth-id", "", true)
+
+	s.Empty(status)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+}
+
+func (s *ServiceTestSuite) TestCompleteVerification_VerificationNotInitiated() {
+	authorization := s.newPendingAuthorization()
+	authorization.VerificationID = ""
+	s.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(authorization, true, nil)
+
+	status, errCode, _ := s.service.CompleteVerification(s.ctx, testUserCode, "", "", true)
+
+	s.Empty(status)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+}
+
+func (s *ServiceTestSuite) TestCompleteVerification_MissingAssertion() {
+	s.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).
+		Return(s.newPendingAuthorization(), true, nil)
+
+	status, errCode, errDesc := s.service.CompleteVerification(s.ctx, testUserCode, testAuthID, "", false)
+
+	s.Empty(status)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+	s.Equal("Assertion is required", errDesc)
+}
+
+func (s *ServiceTestSuite) TestCompleteVerification_InvalidAssertionSignature() {
+	s.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).
+		Return(s.newPendingAuthorization(), true, nil)
+	s.mockJWTService.EXPECT().VerifyJWT("invalid-assertion", "", "").Return(&jwt.ErrorInvalidTokenSignature)
+
+	status, errCode, errDesc := s.service.CompleteVerification(
+		s.ctx, testUserCode, testAuthID, "invalid-assertion", false)
+
+	s.Empty(status)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+	s.Equal("Invalid assertion", errDesc)
+}
+
+func (s *ServiceTestSuite) TestCompleteVerification_AssertionWithoutSubject() {
+	assertion := buildAssertion(map[string]any{"iat": 1701421200})
+	s.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).
+		Return(s.newPendingAuthorization(), true, nil)
+	s.mockJWTService.EXPECT().VerifyJWT(assertion, "", "").Return(nil)
+
+	status, errCode, _ := s.service.CompleteVerification(s.ctx, testUserCode, testAuthID, assertion, false)
+
+	s.Empty(status)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+}
+
+func (s *ServiceTestSuite) TestCompleteVerification_UpdateError() {
+	s.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).
+		Return(s.newPendingAuthorization(), true, nil)
+	s.mockStore.EXPECT().Update(mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	status, errCode, _ := s.service.CompleteVerification(s.ctx, testUserCode, testAuthID, "", true)
+
+	s.Empty(status)
+	s.Equal(oauth2const.ErrorServerError, errCode)
+}
+
+// Tests for ConsumeDeviceAuthorization
+
+func (s *ServiceTestSuite) TestConsumeDeviceAuthorization_Authorized() {
+	authorization := s.newPendingAuthorization()
+	authorization.Status = AuthorizationStatusAuthorized
+	authorization.AuthorizedUserID = "test-user"
+	s.mockStore.EXPECT().GetByDeviceCode(mock.Anything, testDeviceCode).Return(authorization, true, nil)
+	s.mockStore.EXPECT().Delete(mock.Anything, testDeviceCode).Return(true, nil)
+
+	result, errResp := s.service.ConsumeDeviceAuthorization(s.ctx, testClientID, testDeviceCode)
+
+	s.Nil(errResp)
+	s.Equal("test-user", result.AuthorizedUserID)
+}
+
+func (s *ServiceTestSuite) TestConsumeDeviceAuthorization_Denied() {
+	authorization := s.newPendingAuthorization()
+	authorization.Status = AuthorizationStatusDenied
+	s.mockStore.EXPECT().GetByDeviceCode(mock.Anything, testDeviceCode).Return(authorization, true, nil)
+	s.mockStore.EXPECT().Delete(mock.Anything, testDeviceCode).Return(true, nil)
+
+	result, errResp := s.service.ConsumeDeviceAuthorization(s.ctx, testClientID, testDeviceCode)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorAccessDenied, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestConsumeDeviceAuthorization_AlreadyConsumed() {
+	authorization := s.newPendingAuthorization()
+	authorization.Status = AuthorizationStatusAuthorized
+	s.mockStore.EXPECT().GetByDeviceCode(mock.Anything, testDeviceCode).Return(authorization, true, nil)
+	s.mockStore.EXPECT().Delete(mock.Anything, testDeviceCode).Return(false, nil)
+
+	result, errResp := s.service.ConsumeDeviceAuthorization(s.ctx, testClientID, testDeviceCode)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidGrant, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestConsumeDeviceAuthorization_Pending() {
+	s.mockStore.EXPECT().GetByDeviceCode(mock.Anything, testDeviceCode).
+		Return(s.newPendingAuthorization(), true, nil)
+	s.mockStore.EXPECT().UpdatePollState(mock.Anything, mock.MatchedBy(func(a DeviceAuthorization) bool {
+		return !a.LastPolledAt.IsZero()
+	})).Return(nil)
+
+	result, errResp := s.service.ConsumeDeviceAuthorization(s.ctx, testClientID, testDeviceCode)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorAuthorizationPending, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestConsumeDeviceAuthorization_SlowDown() {
+	authorization := s.newPendingAuthorization()
+	authorization.LastPolledAt = time.Now().Add(-time.Second)
+	s.mockStore.EXPECT().GetByDeviceCode(mock.Anything, testDeviceCode).Return(authorization, true, nil)
+	interval := authorization.Interval
+	s.mockStore.EXPECT().UpdatePollState(mock.Anything, mock.MatchedBy(func(a DeviceAuthorization) bool {
+		return a.Interval == interval+slowDownIntervalIncrement
+	})).Return(nil)
+
+	result, errResp := s.service.ConsumeDeviceAuthorization(s.ctx, testClientID, testDeviceCode)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorSlowDown, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestConsumeDeviceAuthorization_PollAfterInterval() {
+	authorization := s.newPendingAuthorization()
+	authorization.LastPolledAt = time.Now().Add(-10 * time.Second)
+	s.mockStore.EXPECT().GetByDeviceCode(mock.Anything, testDeviceCode).Return(authorization, true, nil)
+	interval := authorization.Interval
+	s.mockStore.EXPECT().UpdatePollState(mock.Anything, mock.MatchedBy(func(a DeviceAuthorization) bool {
+		return a.Interval == interval
+	})).Return(nil)
+
+	_, errResp := s.service.ConsumeDeviceAuthorization(s.ctx, testClientID, testDeviceCode)
+
+	s.Equal(oauth2const.ErrorAuthorizationPending, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestConsumeDeviceAuthorization_Expired() {
+	authorization := s.newPendingAuthorization()
+	authorization.ExpiryTime = time.Now().Add(-time.Second)
+	s.mockStore.EXPECT().GetByDeviceCode(mock.Anything, testDeviceCode).Return(authorization, true, nil)
+
+	result, errResp := s.service.ConsumeDeviceAuthorization(s.ctx, testClientID, testDeviceCode)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorExpiredToken, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestConsumeDeviceAuthorization_NotFound() {
+	s.mockStore.EXPECT().GetByDeviceCode(mock.Anything, testDeviceCode).Return(DeviceAuthorization{}, false, nil)
+
+	result, errResp := s.service.ConsumeDeviceAuthorization(s.ctx, testClientID, testDeviceCode)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidGrant, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestConsumeDeviceAuthorization_ClientMismatch() {
+	s.mockStore.EXPECT().GetByDeviceCode(mock.Anything, testDeviceCode).
+		Return(s.newPendingAuthorization(), true, nil)
+
+	result, errResp := s.service.ConsumeDeviceAuthorization(s.ctx, "other-client", testDeviceCode)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidGrant, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestConsumeDeviceAuthorization_StoreError() {
+	s.mockStore.EXPECT().GetByDeviceCode(mock.Anything, testDeviceCode).
+		Return(DeviceAuthorization{}, false, errors.New("db error"))
+
+	result, errResp := s.service.ConsumeDeviceAuthorization(s.ctx, testClientID, testDeviceCode)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorServerError, errResp.Error)
+}
+
+// Tests for helpers
+
+func (s *ServiceTestSuite) TestNormalizeUserCode() {
+	cases := map[string]string{
+		"BCDF-GHJK":   "BCDF-GHJK",
+		"bcdfghjk":    "BCDF-GHJK",
+		" bcdf ghjk ": "BCDF-GHJK",
+	}
+	for input, expected := range cases {
+		normalized, ok := normalizeUserCode(input)
+		s.True(ok, input)
+		s.Equal(expected, normalized)
+	}
+
+	for _, input := range []string{"", "BCDF-GHJ", "BCDF-GHJKL", "ABCD-EFGH", "BCDF-GHJ1"} {
+		_, ok := normalizeUserCode(input)
+		s.False(ok, input)
+	}
+}
+
+func (s *ServiceTestSuite) TestGenerateUserCode() {
+	code, err := generateUserCode()
+
+	s.NoError(err)
+	s.Len(code, userCodeLength+1)
+	normalized, ok := normalizeUserCode(code)
+	s.True(ok)
+	s.Equal(code, normalized)
+	s.False(strings.ContainsAny(code, "AEIOUY"))
+}
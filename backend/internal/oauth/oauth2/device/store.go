@@ -0,0 +1,216 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+	dbutils "github.com/asgardeo/thunder/internal/system/database/utils"
+)
+
+// deviceStoreInterface defines the interface for device authorization storage.
+type deviceStoreInterface interface {
+	Create(ctx context.Context, authorization DeviceAuthorization) error
+	GetByDeviceCode(ctx context.Context, deviceCode string) (DeviceAuthorization, bool, error)
+	GetByUserCode(ctx context.Context, userCode string) (DeviceAuthorization, bool, error)
+	Update(ctx context.Context, authorization DeviceAuthorization) error
+	UpdatePollState(ctx context.Context, authorization DeviceAuthorization) error
+	Delete(ctx context.Context, deviceCode string) (bool, error)
+}
+
+// deviceAuthorizationStore is the relational-DB-backed implementation of deviceStoreInterface.
+type deviceAuthorizationStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newDeviceAuthorizationStore creates a new DB-backed device authorization store.
+func newDeviceAuthorizationStore(deploymentID string) deviceStoreInterface {
+	return &deviceAuthorizationStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// Create persists a new device authorization request.
+func (s *deviceAuthorizationStore) Create(ctx context.Context, authorization DeviceAuthorization) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	data, err := json.Marshal(authorization)
+	if err != nil {
+		return fmt.Errorf("failed to marshal device authorization: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryInsertDeviceAuthorization, authorization.DeviceCode,
+		s.deploymentID, authorization.UserCode, data, authorization.ExpiryTime.UTC()); err != nil {
+		return fmt.Errorf("failed to insert device authorization: %w", err)
+	}
+	return nil
+}
+
+// GetByDeviceCode retrieves a device authorization request by its device code.
+// Expired requests are returned as well so that the caller can report expired_token.
+func (s *deviceAuthorizationStore) GetByDeviceCode(
+	ctx context.Context, deviceCode string,
+) (DeviceAuthorization, bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return DeviceAuthorization{}, false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetDeviceAuthorizationByDeviceCode, deviceCode, s.deploymentID)
+	if err != nil {
+		return DeviceAuthorization{}, false, fmt.Errorf("failed to query device authorization: %w", err)
+	}
+	if len(results) == 0 {
+		return DeviceAuthorization{}, false, nil
+	}
+
+	authorization, err := buildDeviceAuthorizationFromRow(results[0])
+	if err != nil {
+		return DeviceAuthorization{}, false, err
+	}
+	return authorization, true, nil
+}
+
+// GetByUserCode retrieves an unexpired device authorization request by its user code.
+func (s *deviceAuthorizationStore) GetByUserCode(
+	ctx context.Context, userCode string,
+) (DeviceAuthorization, bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return DeviceAuthorization{}, false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetDeviceAuthorizationByUserCode, userCode,
+		time.Now().UTC(), s.deploymentID)
+	if err != nil {
+		return DeviceAuthorization{}, false, fmt.Errorf("failed to query device authorization: %w", err)
+	}
+	if len(results) == 0 {
+		return DeviceAuthorization{}, false, nil
+	}
+
+	authorization, err := buildDeviceAuthorizationFromRow(results[0])
+	if err != nil {
+		return DeviceAuthorization{}, false, err
+	}
+	return authorization, true, nil
+}
+
+// Update replaces the stored data of a device authorization request.
+func (s *deviceAuthorizationStore) Update(ctx context.Context, authorization DeviceAuthorization) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	data, err := json.Marshal(authorization)
+	if err != nil {
+		return fmt.Errorf("failed to marshal device authorization: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateDeviceAuthorizationData, data,
+		authorization.DeviceCode, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to update device authorization: %w", err)
+	}
+	return nil
+}
+
+// UpdatePollState records the time at which the client last polled the token endpoint along with
+// the polling interval currently in effect for the request.
+func (s *deviceAuthorizationStore) UpdatePollState(ctx context.Context, authorization DeviceAuthorization) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateDeviceAuthorizationPollState,
+		authorization.LastPolledAt.UTC(), authorization.Interval, authorization.DeviceCode,
+		s.deploymentID); err != nil {
+		return fmt.Errorf("failed to update device authorization poll state: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a device authorization request. Returns false if the request did not exist,
+// which indicates that a concurrent request already consumed it.
+func (s *deviceAuthorizationStore) Delete(ctx context.Context, deviceCode string) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteDeviceAuthorization, deviceCode, s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete device authorization: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// buildDeviceAuthorizationFromRow reconstructs a DeviceAuthorization from a database row.
+func buildDeviceAuthorizationFromRow(row map[string]any) (DeviceAuthorization, error) {
+	var dataJSON []byte
+	if val, ok := row[dbColumnAuthorizationData].(string); ok && val != "" {
+		dataJSON = []byte(val)
+	} else if val, ok := row[dbColumnAuthorizationData].([]byte); ok && len(val) > 0 {
+		dataJSON = val
+	} else {
+		return DeviceAuthorization{}, errors.New("authorization_data is missing or of unexpected type")
+	}
+
+	var authorization DeviceAuthorization
+	if err := json.Unmarshal(dataJSON, &authorization); err != nil {
+		return DeviceAuthorization{}, fmt.Errorf("failed to unmarshal device authorization: %w", err)
+	}
+
+	lastPolledAt, err := dbutils.ParseNullableTimeField(row[dbColumnLastPolledAt], dbColumnLastPolledAt)
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+	authorization.LastPolledAt = lastPolledAt
+	if interval, ok := parseIntervalField(row[dbColumnPollInterval]); ok && interval > 0 {
+		authorization.Interval = interval
+	}
+	return authorization, nil
+}
+
+// parseIntervalField parses the nullable poll interval column from the database result.
+func parseIntervalField(field any) (int64, bool) {
+	switch v := field.(type) {
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case float64:
+		return int64(v), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import dbmodel "github.com/asgardeo/thunder/internal/system/database/model"
+
+// Database column names for device authorization storage.
+const (
+	dbColumnAuthorizationData = "authorization_data"
+	dbColumnLastPolledAt      = "last_polled_at"
+	dbColumnPollInterval      = "poll_interval"
+)
+
+var queryInsertDeviceAuthorization = dbmodel.DBQuery{
+	ID: "DAQ-DAS-01",
+	Query: `INSERT INTO "DEVICE_AUTHORIZATION" (DEVICE_CODE, DEPLOYMENT_ID, USER_CODE, AUTHORIZATION_DATA, ` +
+		`EXPIRY_TIME) VALUES ($1, $2, $3, $4, $5)`,
+}
+
+var queryGetDeviceAuthorizationByDeviceCode = dbmodel.DBQuery{
+	ID: "DAQ-DAS-02",
+	Query: `SELECT AUTHORIZATION_DATA, LAST_POLLED_AT, POLL_INTERVAL FROM "DEVICE_AUTHORIZATION" ` +
+		`WHERE DEVICE_CODE = $1 AND DEPLOYMENT_ID = $2`,
+}
+
+var queryGetDeviceAuthorizationByUserCode = dbmodel.DBQuery{
+	ID: "DAQ-DAS-03",
+	Query: `SELECT AUTHORIZATION_DATA, LAST_POLLED_AT, POLL_INTERVAL FROM "DEVICE_AUTHORIZATION" ` +
+		`WHERE USER_CODE = $1 AND EXPIRY_TIME > $2 AND DEPLOYMENT_ID = $3`,
+}
+
+var queryUpdateDeviceAuthorizationData = dbmodel.DBQuery{
+	ID: "DAQ-DAS-04",
+	Query: `UPDATE "DEVICE_AUTHORIZATION" SET AUTHORIZATION_DATA = $1 ` +
+		`WHERE DEVICE_CODE = $2 AND DEPLOYMENT_ID = $3`,
+}
+
+var queryUpdateDeviceAuthorizationPollState = dbmodel.DBQuery{
+	ID: "DAQ-DAS-05",
+	Query: `UPDATE "DEVICE_AUTHORIZATION" SET LAST_POLLED_AT = $1, POLL_INTERVAL = $2 ` +
+		`WHERE DEVICE_CODE = $3 AND DEPLOYMENT_ID = $4`,
+}
+
+var queryDeleteDeviceAuthorization = dbmodel.DBQuery{
+	ID:    "DAQ-DAS-06",
+	Query: `DELETE FROM "DEVICE_AUTHORIZATION" WHERE DEVICE_CODE = $1 AND DEPLOYMENT_ID = $2`,
+}
@@ -0,0 +1,298 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type StoreTestSuite struct {
+	suite.Suite
+	mockDBProvider    *providermock.DBProviderInterfaceMock
+	mockDBClient      *providermock.DBClientInterfaceMock
+	store             *deviceAuthorizationStore
+	ctx               context.Context
+	testAuthorization DeviceAuthorization
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (s *StoreTestSuite) SetupTest() {
+	s.mockDBProvider = &providermock.DBProviderInterfaceMock{}
+	s.mockDBClient = &providermock.DBClientInterfaceMock{}
+	s.store = &deviceAuthorizationStore{
+		dbProvider:   s.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+	s.testAuthorization = DeviceAuthorization{
+		DeviceCode:       testDeviceCode,
+		UserCode:         testUserCode,
+		ClientID:         testClientID,
+		StandardScopes:   []string{"openid"},
+		PermissionScopes: []string{"read"},
+		Status:           AuthorizationStatusPending,
+		Interval:         5,
+		ExpiryTime:       time.Now().Add(10 * time.Minute).UTC().Truncate(time.Second),
+	}
+}
+
+func (s *StoreTestSuite) authorizationRow(lastPolledAt any) map[string]any {
+	data, _ := json.Marshal(s.testAuthorization)
+	return map[string]any{
+		dbColumnAuthorizationData: string(data),
+		dbColumnLastPolledAt:      lastPolledAt,
+	}
+}
+
+// Tests for Create
+
+func (s *StoreTestSuite) TestCreate_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertDeviceAuthorization,
+		testDeviceCode, testDeploymentID, testUserCode, mock.Anything, s.testAuthorization.ExpiryTime,
+	).Return(int64(1), nil)
+
+	err := s.store.Create(s.ctx, s.testAuthorization)
+
+	assert.NoError(s.T(), err)
+	s.mockDBClient.AssertExpectations(s.T())
+}
+
+func (s *StoreTestSuite) TestCreate_DBClientError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db error"))
+
+	err := s.store.Create(s.ctx, s.testAuthorization)
+
+	assert.Error(s.T(), err)
+}
+
+func (s *StoreTestSuite) TestCreate_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertDeviceAuthorization,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(int64(0), errors.New("insert error"))
+
+	err := s.store.Create(s.ctx, s.testAuthorization)
+
+	assert.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "failed to insert device authorization")
+}
+
+// Tests for GetByDeviceCode
+
+func (s *StoreTestSuite) TestGetByDeviceCode_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetDeviceAuthorizationByDeviceCode,
+		testDeviceCode, testDeploymentID,
+	).Return([]map[string]any{s.authorizationRow("2026-01-02 03:04:05.123456")}, nil)
+
+	result, found, err := s.store.GetByDeviceCode(s.ctx, testDeviceCode)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), found)
+	assert.Equal(s.T(), testClientID, result.ClientID)
+	assert.Equal(s.T(), testUserCode, result.UserCode)
+	assert.Equal(s.T(), []string{"read"}, result.PermissionScopes)
+	assert.True(s.T(), s.testAuthorization.ExpiryTime.Equal(result.ExpiryTime))
+	assert.Equal(s.T(), time.Date(2026, 1, 2, 3, 4, 5, 123456000, time.UTC), result.LastPolledAt)
+}
+
+func (s *StoreTestSuite) TestGetByDeviceCode_NeverPolled() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetDeviceAuthorizationByDeviceCode,
+		testDeviceCode, testDeploymentID,
+	).Return([]map[string]any{s.authorizationRow(nil)}, nil)
+
+	result, found, err := s.store.GetByDeviceCode(s.ctx, testDeviceCode)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), found)
+	assert.True(s.T(), result.LastPolledAt.IsZero())
+}
+
+func (s *StoreTestSuite) TestGetByDeviceCode_PollIntervalOverride() {
+	row := s.authorizationRow(nil)
+	row[dbColumnPollInterval] = int64(10)
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetDeviceAuthorizationByDeviceCode,
+		testDeviceCode, testDeploymentID,
+	).Return([]map[string]any{row}, nil)
+
+	result, found, err := s.store.GetByDeviceCode(s.ctx, testDeviceCode)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), found)
+	assert.Equal(s.T(), int64(10), result.Interval)
+}
+
+func (s *StoreTestSuite) TestGetByDeviceCode_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetDeviceAuthorizationByDeviceCode,
+		testDeviceCode, testDeploymentID,
+	).Return([]map[string]any{}, nil)
+
+	_, found, err := s.store.GetByDeviceCode(s.ctx, testDeviceCode)
+
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), found)
+}
+
+func (s *StoreTestSuite) TestGetByDeviceCode_QueryError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetDeviceAuthorizationByDeviceCode,
+		testDeviceCode, testDeploymentID,
+	).Return(nil, errors.New("query error"))
+
+	_, found, err := s.store.GetByDeviceCode(s.ctx, testDeviceCode)
+
+	assert.Error(s.T(), err)
+	assert.False(s.T(), found)
+}
+
+func (s *StoreTestSuite) TestGetByDeviceCode_InvalidData() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetDeviceAuthorizationByDeviceCode,
+		testDeviceCode, testDeploymentID,
+	).Return([]map[string]any{{dbColumnAuthorizationData: 42}}, nil)
+
+	_, found, err := s.store.GetByDeviceCode(s.ctx, testDeviceCode)
+
+	assert.Error(s.T(), err)
+	assert.False(s.T(), found)
+}
+
+// Tests for GetByUserCode
+
+func (s *StoreTestSuite) TestGetByUserCode_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetDeviceAuthorizationByUserCode,
+		testUserCode, mock.Anything, testDeploymentID,
+	).Return([]map[string]any{s.authorizationRow(nil)}, nil)
+
+	result, found, err := s.store.GetByUserCode(s.ctx, testUserCode)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), found)
+	assert.Equal(s.T(), testDeviceCode, result.DeviceCode)
+}
+
+func (s *StoreTestSuite) TestGetByUserCode_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetDeviceAuthorizationByUserCode,
+		testUserCode, mock.Anything, testDeploymentID,
+	).Return([]map[string]any{}, nil)
+
+	_, found, err := s.store.GetByUserCode(s.ctx, testUserCode)
+
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), found)
+}
+
+// Tests for Update
+
+func (s *StoreTestSuite) TestUpdate_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateDeviceAuthorizationData,
+		mock.Anything, testDeviceCode, testDeploymentID,
+	).Return(int64(1), nil)
+
+	err := s.store.Update(s.ctx, s.testAuthorization)
+
+	assert.NoError(s.T(), err)
+}
+
+func (s *StoreTestSuite) TestUpdate_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateDeviceAuthorizationData,
+		mock.Anything, testDeviceCode, testDeploymentID,
+	).Return(int64(0), errors.New("update error"))
+
+	err := s.store.Update(s.ctx, s.testAuthorization)
+
+	assert.Error(s.T(), err)
+}
+
+// Tests for UpdatePollState
+
+func (s *StoreTestSuite) TestUpdatePollState_Success() {
+	polledAt := time.Now()
+	s.testAuthorization.LastPolledAt = polledAt
+	s.testAuthorization.Interval = 10
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateDeviceAuthorizationPollState,
+		polledAt.UTC(), int64(10), testDeviceCode, testDeploymentID,
+	).Return(int64(1), nil)
+
+	err := s.store.UpdatePollState(s.ctx, s.testAuthorization)
+
+	assert.NoError(s.T(), err)
+}
+
+// Tests for Delete
+
+func (s *StoreTestSuite) TestDelete_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteDeviceAuthorization,
+		testDeviceCode, testDeploymentID,
+	).Return(int64(1), nil)
+
+	deleted, err := s.store.Delete(s.ctx, testDeviceCode)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), deleted)
+}
+
+func (s *StoreTestSuite) TestDelete_AlreadyDeleted() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteDeviceAuthorization,
+		testDeviceCode, testDeploymentID,
+	).Return(int64(0), nil)
+
+	deleted, err := s.store.Delete(s.ctx, testDeviceCode)
+
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), deleted)
+}
+
+func (s *StoreTestSuite) TestDelete_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteDeviceAuthorization,
+		testDeviceCode, testDeploymentID,
+	).Return(int64(0), errors.New("delete error"))
+
+	deleted, err := s.store.Delete(s.ctx, testDeviceCode)
+
+	assert.Error(s.T(), err)
+	assert.False(s.T(), deleted)
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/pkiservice"
 )
 
 // testPKIService is a minimal PKIServiceInterface implementation for discovery tests.
@@ -59,6 +60,10 @@ func (m *testPKIService) GetAllX509Certificates() (map[string]*x509.Certificate,
 	return nil, nil
 }
 
+func (m *testPKIService) GetActiveSigningKeyID() string { return "" }
+
+func (m *testPKIService) SetManagedKeys([]pkiservice.PKI, string) {}
+
 type DiscoveryTestSuite struct {
 	suite.Suite
 	pkiService       *testPKIService
@@ -123,9 +128,11 @@ func (suite *DiscoveryTestSuite) TestOAuth2AuthorizationServerMetadata() {
 	assert.NotEmpty(suite.T(), metadata.RegistrationEndpoint)
 	assert.NotEmpty(suite.T(), metadata.IntrospectionEndpoint)
 	assert.NotEmpty(suite.T(), metadata.UserInfoEndpoint)
-
-	// Verify only implemented endpoints are present
-	assert.Empty(suite.T(), metadata.RevocationEndpoint) // Not implemented
+	assert.Equal(suite.T(), "https://localhost:8080/oauth2/revoke", metadata.RevocationEndpoint)
+	assert.Equal(suite.T(), "https://localhost:8080/oauth2/device_authorization",
+		metadata.DeviceAuthorizationEndpoint)
+	assert.ElementsMatch(suite.T(), metadata.TokenEndpointAuthMethodsSupported,
+		metadata.RevocationEndpointAuthMethodsSupported)
 
 	// Verify only implemented grant types are present
 	assert.Contains(suite.T(), metadata.GrantTypesSupported, "authorization_code")
@@ -135,10 +142,26 @@ func (suite *DiscoveryTestSuite) TestOAuth2AuthorizationServerMetadata() {
 	assert.NotContains(suite.T(), metadata.GrantTypesSupported, "implicit") // Not implemented
 
 	// Verify only implemented response types are present
-	assert.Equal(suite.T(), []string{"code"}, metadata.ResponseTypesSupported)
+	assert.Equal(suite.T(), []string{"code", "id_token", "code id_token", "code id_token token"},
+		metadata.ResponseTypesSupported)
+	assert.Equal(suite.T(), []string{"query", "fragment", "form_post"}, metadata.ResponseModesSupported)
 
 	// Verify RFC 9207 advertisement
 	assert.True(suite.T(), metadata.AuthorizationResponseIssParameterSupported)
+
+	// Certificate-bound access tokens are only advertised when mutual TLS is enabled
+	assert.False(suite.T(), metadata.TLSClientCertificateBoundAccessTokens)
+
+	// Verify RFC 9449 advertisement
+	assert.Contains(suite.T(), metadata.DPoPSigningAlgValuesSupported, "ES256")
+
+	// Verify RFC 9101 advertisement; request objects by reference are only accepted when allowed
+	assert.True(suite.T(), metadata.RequestParameterSupported)
+	assert.False(suite.T(), metadata.RequestURIParameterSupported)
+	assert.False(suite.T(), metadata.RequireSignedRequestObject)
+	assert.Contains(suite.T(), metadata.RequestObjectSigningAlgValuesSupported, "PS256")
+	assert.NotContains(suite.T(), metadata.RequestObjectSigningAlgValuesSupported, "none")
+	assert.Contains(suite.T(), metadata.RequestObjectEncryptionAlgValuesSupported, "RSA-OAEP-256")
 }
 
 func (suite *DiscoveryTestSuite) TestOIDCDiscovery() {
@@ -167,6 +190,14 @@ func (suite *DiscoveryTestSuite) TestOIDCDiscovery() {
 
 	// Verify claims parameter support
 	assert.True(suite.T(), metadata.ClaimsParameterSupported, "claims_parameter_supported should be true")
+	assert.Equal(suite.T(), "https://localhost:8080/oauth2/logout", metadata.EndSessionEndpoint)
+	assert.True(suite.T(), metadata.BackchannelLogoutSupported)
+	assert.True(suite.T(), metadata.BackchannelLogoutSessionSupported)
+	assert.True(suite.T(), metadata.FrontchannelLogoutSupported)
+	assert.True(suite.T(), metadata.FrontchannelLogoutSessionSupported)
+	assert.Equal(suite.T(), "https://localhost:8080/oauth2/bc-authorize", metadata.BackchannelAuthenticationEndpoint)
+	assert.Equal(suite.T(), []string{"poll", "ping"}, metadata.BackchannelTokenDeliveryModesSupported)
+	assert.False(suite.T(), metadata.BackchannelUserCodeParameterSupported)
 
 	// Verify RFC 9207 advertisement (inherited from embedded OAuth2AuthorizationServerMetadata)
 	assert.True(suite.T(), metadata.AuthorizationResponseIssParameterSupported)
@@ -194,11 +225,17 @@ func TestGrantTypeIsValid(t *testing.T) {
 func TestResponseTypeIsValid(t *testing.T) {
 	// Test valid response types
 	assert.True(t, constants.ResponseTypeCode.IsValid())
+	assert.True(t, constants.ResponseTypeIDToken.IsValid())
+	assert.True(t, constants.ResponseTypeCodeIDToken.IsValid())
+	assert.True(t, constants.ResponseTypeCodeIDTokenToken.IsValid())
+	assert.True(t, constants.ResponseType("id_token code").IsValid())
+	assert.True(t, constants.ResponseType("token code id_token").IsValid())
 
 	// Test invalid response types
 	assert.False(t, constants.ResponseType("invalid").IsValid())
 	assert.False(t, constants.ResponseType("token").IsValid())
-	assert.False(t, constants.ResponseType("id_token").IsValid())
+	assert.False(t, constants.ResponseType("id_token token").IsValid())
+	assert.False(t, constants.ResponseType("code token").IsValid())
 	assert.False(t, constants.ResponseType("").IsValid())
 }
 
@@ -223,9 +260,8 @@ func TestGetSupportedResponseTypes(t *testing.T) {
 	supported := constants.GetSupportedResponseTypes()
 
 	assert.NotNil(t, supported)
-	assert.Equal(t, 1, len(supported))
-	assert.Contains(t, supported, "code")
-	assert.Equal(t, []string{"code"}, supported)
+	assert.Equal(t, []string{"code", "id_token", "code id_token", "code id_token token"}, supported)
+	assert.NotContains(t, supported, "token")
 }
 
 // TestGetSupportedGrantTypes tests the GetSupportedGrantTypes function
@@ -234,11 +270,14 @@ func TestGetSupportedGrantTypes(t *testing.T) {
 	supported := constants.GetSupportedGrantTypes()
 
 	assert.NotNil(t, supported)
-	assert.Equal(t, 4, len(supported))
+	assert.Equal(t, 7, len(supported))
 	assert.Contains(t, supported, "authorization_code")
 	assert.Contains(t, supported, "client_credentials")
 	assert.Contains(t, supported, "refresh_token")
 	assert.Contains(t, supported, "urn:ietf:params:oauth:grant-type:token-exchange")
+	assert.Contains(t, supported, "urn:ietf:params:oauth:grant-type:device_code")
+	assert.Contains(t, supported, "urn:openid:params:grant-type:ciba")
+	assert.Contains(t, supported, "urn:ietf:params:oauth:grant-type:jwt-bearer")
 	assert.NotContains(t, supported, "password")
 	assert.NotContains(t, supported, "implicit")
 }
@@ -249,11 +288,13 @@ func TestGetSupportedTokenEndpointAuthMethods(t *testing.T) {
 	supported := constants.GetSupportedTokenEndpointAuthMethods()
 
 	assert.NotNil(t, supported)
-	assert.Equal(t, 4, len(supported))
+	assert.Equal(t, 6, len(supported))
 	assert.Contains(t, supported, "client_secret_basic")
 	assert.Contains(t, supported, "client_secret_post")
 	assert.Contains(t, supported, "none")
 	assert.Contains(t, supported, "private_key_jwt")
+	assert.Contains(t, supported, "tls_client_auth")
+	assert.Contains(t, supported, "self_signed_tls_client_auth")
 	assert.NotContains(t, supported, "client_secret_jwt")
 }
 
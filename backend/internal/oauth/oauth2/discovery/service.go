@@ -24,7 +24,9 @@ import (
 
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/dpop"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/pkce"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/requestobject"
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/pkiservice"
 )
@@ -60,15 +62,27 @@ func (ds *discoveryService) GetOAuth2AuthorizationServerMetadata(
 		UserInfoEndpoint:                           ds.getUserInfoEndpoint(),
 		JWKSUri:                                    ds.getJWKSUri(),
 		RegistrationEndpoint:                       ds.getRegistrationEndpoint(),
+		RevocationEndpoint:                         ds.getRevocationEndpoint(),
+		RevocationEndpointAuthMethodsSupported:     ds.getSupportedTokenEndpointAuthMethods(),
 		IntrospectionEndpoint:                      ds.getIntrospectionEndpoint(),
 		PushedAuthorizationRequestEndpoint:         ds.getPAREndpoint(),
 		RequirePushedAuthorizationRequests:         ds.isGlobalPARRequired(),
+		DeviceAuthorizationEndpoint:                ds.getDeviceAuthorizationEndpoint(),
 		ScopesSupported:                            ds.getSupportedScopes(),
 		ResponseTypesSupported:                     ds.getSupportedResponseTypes(),
+		ResponseModesSupported:                     ds.getSupportedResponseModes(),
 		GrantTypesSupported:                        ds.getSupportedGrantTypes(),
 		TokenEndpointAuthMethodsSupported:          ds.getSupportedTokenEndpointAuthMethods(),
 		CodeChallengeMethodsSupported:              ds.getSupportedCodeChallengeMethods(),
 		AuthorizationResponseIssParameterSupported: true,
+		TLSClientCertificateBoundAccessTokens:      ds.isMutualTLSEnabled(),
+		DPoPSigningAlgValuesSupported:              dpop.GetSupportedSigningAlgorithms(),
+		RequestParameterSupported:                  true,
+		RequestURIParameterSupported:               ds.isRequestURIAllowed(),
+		RequireSignedRequestObject:                 ds.isGlobalSignedRequestObjectRequired(),
+		RequestObjectSigningAlgValuesSupported:     requestobject.GetSupportedSigningAlgorithms(),
+		RequestObjectEncryptionAlgValuesSupported:  requestobject.GetSupportedEncryptionAlgorithms(),
+		RequestObjectEncryptionEncValuesSupported:  requestobject.GetSupportedEncryptionEncodings(),
 	}
 
 	return metadata
@@ -79,17 +93,25 @@ func (ds *discoveryService) GetOIDCMetadata(ctx context.Context) *OIDCProviderMe
 	oauth2Meta := ds.GetOAuth2AuthorizationServerMetadata(ctx)
 
 	return &OIDCProviderMetadata{
-		OAuth2AuthorizationServerMetadata:    *oauth2Meta,
-		SubjectTypesSupported:                ds.getSupportedSubjectTypes(),
-		IDTokenSigningAlgValuesSupported:     ds.pkiService.GetSupportedSigningAlgorithms(),
-		UserInfoSigningAlgValuesSupported:    ds.pkiService.GetSupportedSigningAlgorithms(),
-		UserInfoEncryptionAlgValuesSupported: inboundmodel.SupportedUserInfoEncryptionAlgs,
-		UserInfoEncryptionEncValuesSupported: inboundmodel.SupportedUserInfoEncryptionEncs,
-		IDTokenEncryptionAlgValuesSupported:  inboundmodel.SupportedIDTokenEncryptionAlgs,
-		IDTokenEncryptionEncValuesSupported:  inboundmodel.SupportedIDTokenEncryptionEncs,
-		ClaimsSupported:                      ds.getSupportedClaims(),
-		ClaimsParameterSupported:             true,
-		AcrValuesSupported:                   ds.getSupportedAcrValues(),
+		OAuth2AuthorizationServerMetadata:      *oauth2Meta,
+		SubjectTypesSupported:                  ds.getSupportedSubjectTypes(),
+		IDTokenSigningAlgValuesSupported:       ds.pkiService.GetSupportedSigningAlgorithms(),
+		UserInfoSigningAlgValuesSupported:      ds.pkiService.GetSupportedSigningAlgorithms(),
+		UserInfoEncryptionAlgValuesSupported:   inboundmodel.SupportedUserInfoEncryptionAlgs,
+		UserInfoEncryptionEncValuesSupported:   inboundmodel.SupportedUserInfoEncryptionEncs,
+		IDTokenEncryptionAlgValuesSupported:    inboundmodel.SupportedIDTokenEncryptionAlgs,
+		IDTokenEncryptionEncValuesSupported:    inboundmodel.SupportedIDTokenEncryptionEncs,
+		ClaimsSupported:                        ds.getSupportedClaims(),
+		ClaimsParameterSupported:               true,
+		EndSessionEndpoint:                     ds.getEndSessionEndpoint(),
+		BackchannelLogoutSupported:             true,
+		BackchannelLogoutSessionSupported:      true,
+		FrontchannelLogoutSupported:            true,
+		FrontchannelLogoutSessionSupported:     true,
+		AcrValuesSupported:                     ds.getSupportedAcrValues(),
+		BackchannelAuthenticationEndpoint:      ds.getBackchannelAuthenticationEndpoint(),
+		BackchannelTokenDeliveryModesSupported: ds.getSupportedBackchannelTokenDeliveryModes(),
+		BackchannelUserCodeParameterSupported:  false,
 	}
 }
 
@@ -113,10 +135,18 @@ func (ds *discoveryService) getIntrospectionEndpoint() string {
 	return ds.baseURL + constants.OAuth2IntrospectionEndpoint
 }
 
+func (ds *discoveryService) getRevocationEndpoint() string {
+	return ds.baseURL + constants.OAuth2RevokeEndpoint
+}
+
 func (ds *discoveryService) getUserInfoEndpoint() string {
 	return ds.baseURL + constants.OAuth2UserInfoEndpoint
 }
 
+func (ds *discoveryService) getEndSessionEndpoint() string {
+	return ds.baseURL + constants.OAuth2LogoutEndpoint
+}
+
 func (ds *discoveryService) getRegistrationEndpoint() string {
 	return ds.baseURL + constants.OAuth2DCREndpoint
 }
@@ -133,6 +163,10 @@ func (ds *discoveryService) getSupportedResponseTypes() []string {
 	return constants.GetSupportedResponseTypes()
 }
 
+func (ds *discoveryService) getSupportedResponseModes() []string {
+	return constants.GetSupportedResponseModes()
+}
+
 func (ds *discoveryService) getSupportedGrantTypes() []string {
 	return constants.GetSupportedGrantTypes()
 }
@@ -149,10 +183,34 @@ func (ds *discoveryService) getPAREndpoint() string {
 	return ds.baseURL + constants.OAuth2PAREndpoint
 }
 
+func (ds *discoveryService) getDeviceAuthorizationEndpoint() string {
+	return ds.baseURL + constants.OAuth2DeviceAuthzEndpoint
+}
+
+func (ds *discoveryService) getBackchannelAuthenticationEndpoint() string {
+	return ds.baseURL + constants.OAuth2BackchannelEndpoint
+}
+
+func (ds *discoveryService) getSupportedBackchannelTokenDeliveryModes() []string {
+	return []string{constants.BackchannelTokenDeliveryModePoll, constants.BackchannelTokenDeliveryModePing}
+}
+
 func (ds *discoveryService) isGlobalPARRequired() bool {
 	return config.GetServerRuntime().Config.OAuth.PAR.RequirePAR
 }
 
+func (ds *discoveryService) isRequestURIAllowed() bool {
+	return config.GetServerRuntime().Config.OAuth.RequestObject.AllowRequestURI
+}
+
+func (ds *discoveryService) isGlobalSignedRequestObjectRequired() bool {
+	return config.GetServerRuntime().Config.OAuth.RequestObject.RequireSignedRequestObject
+}
+
+func (ds *discoveryService) isMutualTLSEnabled() bool {
+	return config.GetServerRuntime().Config.OAuth.MTLS.Enabled
+}
+
 func (ds *discoveryService) getSupportedSubjectTypes() []string {
 	return constants.GetSupportedSubjectTypes()
 }
@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package dpop implements OAuth 2.0 Demonstrating Proof of Possession (RFC 9449).
+package dpop
+
+import "github.com/asgardeo/thunder/internal/system/jose/jws"
+
+const (
+	// HeaderDPoP is the request header that carries a DPoP proof JWT.
+	HeaderDPoP = "DPoP"
+	// HeaderDPoPNonce is the response header that carries a server-issued DPoP nonce.
+	HeaderDPoPNonce = "DPoP-Nonce"
+
+	// proofTokenType is the typ header value a DPoP proof must carry.
+	proofTokenType = "dpop+jwt"
+	// nonceTokenType is the typ header value of the signed JWTs used as server nonces.
+	nonceTokenType = "dpop-nonce+jwt"
+	// nonceAudience is the aud claim of the signed JWTs used as server nonces.
+	nonceAudience = "urn:thunder:dpop:nonce"
+)
+
+// supportedSigningAlgorithms lists the asymmetric algorithms accepted for DPoP proofs.
+var supportedSigningAlgorithms = []jws.Algorithm{
+	jws.ES256, jws.ES384, jws.ES512, jws.RS256, jws.RS512, jws.PS256, jws.EdDSA,
+}
+
+// GetSupportedSigningAlgorithms returns the JWS algorithms accepted for DPoP proofs.
+func GetSupportedSigningAlgorithms() []string {
+	algorithms := make([]string, 0, len(supportedSigningAlgorithms))
+	for _, alg := range supportedSigningAlgorithms {
+		algorithms = append(algorithms, string(alg))
+	}
+	return algorithms
+}
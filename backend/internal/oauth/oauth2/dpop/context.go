@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dpop
+
+import "context"
+
+type contextKey string
+
+// proofKeyThumbprintKey is the context key for the JWK thumbprint of a validated DPoP proof key.
+var proofKeyThumbprintKey contextKey = "dpop_proof_key_thumbprint"
+
+// WithProofKeyThumbprint returns a copy of ctx carrying the JWK thumbprint of a validated DPoP proof key.
+func WithProofKeyThumbprint(ctx context.Context, thumbprint string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, proofKeyThumbprintKey, thumbprint)
+}
+
+// GetProofKeyThumbprint returns the JWK thumbprint of the DPoP proof key validated for the current
+// request, or an empty string when the request did not carry a DPoP proof.
+func GetProofKeyThumbprint(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	thumbprint, _ := ctx.Value(proofKeyThumbprintKey).(string)
+	return thumbprint
+}
@@ -0,0 +1,29 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dpop
+
+import "errors"
+
+// ErrInvalidProof is returned when a DPoP proof is malformed, is not correctly signed, does not match
+// the request it was sent with, or has already been used.
+var ErrInvalidProof = errors.New("invalid DPoP proof")
+
+// ErrNonceRequired is returned when a DPoP proof does not carry a valid server-issued nonce.
+// The client is expected to retry with the nonce returned in the DPoP-Nonce response header.
+var ErrNonceRequired = errors.New("DPoP proof must include a valid server-issued nonce")
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dpop
+
+import (
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+)
+
+// Initialize creates the DPoP proof validation service.
+func Initialize(jwtService jwt.JWTServiceInterface) DPoPServiceInterface {
+	return newDPoPService(jwtService, initializeUsedProofStore())
+}
+
+// initializeUsedProofStore selects the used proof store implementation based on the configured
+// runtime DB type.
+func initializeUsedProofStore() usedProofStoreInterface {
+	deploymentID := config.GetServerRuntime().Config.Server.Identifier
+
+	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		return newRedisUsedProofStore(provider.GetRedisProvider(), deploymentID)
+	}
+	return newUsedProofStore(deploymentID)
+}
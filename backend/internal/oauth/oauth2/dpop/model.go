@@ -0,0 +1,30 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dpop
+
+// ProofRequest describes the HTTP request a DPoP proof was presented with.
+type ProofRequest struct {
+	// Method is the HTTP method of the request, matched against the htm claim.
+	Method string
+	// URI is the target URI of the request, matched against the htu claim.
+	URI string
+	// AccessToken is the access token presented with the request, if any. When set, the proof
+	// must carry a matching ath claim.
+	AccessToken string
+}
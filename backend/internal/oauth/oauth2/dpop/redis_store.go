@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dpop
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// dpopRedisClient abstracts the Redis commands used by the used proof store.
+type dpopRedisClient interface {
+	SetNX(ctx context.Context, key string, value any, expiration time.Duration) *redis.BoolCmd
+}
+
+// redisUsedProofStore is the Redis-backed implementation of usedProofStoreInterface.
+// Entries are written with a TTL matching the proof lifetime so that Redis evicts them automatically.
+type redisUsedProofStore struct {
+	client       dpopRedisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// newRedisUsedProofStore creates a new Redis-backed used proof store.
+func newRedisUsedProofStore(p provider.RedisProviderInterface, deploymentID string) usedProofStoreInterface {
+	return &redisUsedProofStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: deploymentID,
+	}
+}
+
+// usedKey builds the Redis key for the usage marker of a proof.
+func (s *redisUsedProofStore) usedKey(proofID string) string {
+	return fmt.Sprintf("%s:runtime:%s:consumed_dpop_proof:%s", s.keyPrefix, s.deploymentID, proofID)
+}
+
+// MarkUsed records the proof as used. Returns false if the proof was already used.
+func (s *redisUsedProofStore) MarkUsed(ctx context.Context, proofID string, expiryTime time.Time) (bool, error) {
+	ttl := time.Until(expiryTime)
+	if ttl <= 0 {
+		// The proof is no longer accepted; there is nothing to record.
+		return false, nil
+	}
+
+	marked, err := s.client.SetNX(ctx, s.usedKey(proofID), "1", ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to record used DPoP proof in Redis: %w", err)
+	}
+	return marked, nil
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dpop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const redisTestKeyPrefix = "thunderid"
+
+type RedisUsedProofStoreTestSuite struct {
+	suite.Suite
+	mockClient *dpopRedisClientMock
+	store      *redisUsedProofStore
+	ctx        context.Context
+}
+
+func TestRedisUsedProofStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisUsedProofStoreTestSuite))
+}
+
+func (s *RedisUsedProofStoreTestSuite) SetupTest() {
+	s.mockClient = newDpopRedisClientMock(s.T())
+	s.store = &redisUsedProofStore{
+		client:       s.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+}
+
+func (s *RedisUsedProofStoreTestSuite) buildRedisKey() string {
+	return fmt.Sprintf("%s:runtime:%s:consumed_dpop_proof:%s", redisTestKeyPrefix, testDeploymentID, testProofID)
+}
+
+func (s *RedisUsedProofStoreTestSuite) TestUsedKey() {
+	s.Equal(s.buildRedisKey(), s.store.usedKey(testProofID))
+}
+
+func (s *RedisUsedProofStoreTestSuite) TestMarkUsed_Success() {
+	boolCmd := redis.NewBoolCmd(s.ctx)
+	boolCmd.SetVal(true)
+	s.mockClient.On("SetNX", s.ctx, s.buildRedisKey(), "1",
+		mock.MatchedBy(func(ttl time.Duration) bool { return ttl > 0 && ttl <= time.Minute })).Return(boolCmd)
+
+	marked, err := s.store.MarkUsed(s.ctx, testProofID, time.Now().Add(time.Minute))
+
+	s.NoError(err)
+	s.True(marked)
+}
+
+func (s *RedisUsedProofStoreTestSuite) TestMarkUsed_AlreadyUsed() {
+	boolCmd := redis.NewBoolCmd(s.ctx)
+	boolCmd.SetVal(false)
+	s.mockClient.On("SetNX", s.ctx, s.buildRedisKey(), "1", mock.Anything).Return(boolCmd)
+
+	marked, err := s.store.MarkUsed(s.ctx, testProofID, time.Now().Add(time.Minute))
+
+	s.NoError(err)
+	s.False(marked)
+}
+
+func (s *RedisUsedProofStoreTestSuite) TestMarkUsed_RedisError() {
+	boolCmd := redis.NewBoolCmd(s.ctx)
+	boolCmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("SetNX", s.ctx, mock.Anything, mock.Anything, mock.Anything).Return(boolCmd)
+
+	marked, err := s.store.MarkUsed(s.ctx, testProofID, time.Now().Add(time.Minute))
+
+	s.ErrorContains(err, "failed to record used DPoP proof in Redis")
+	s.False(marked)
+}
+
+func (s *RedisUsedProofStoreTestSuite) TestMarkUsed_Expired() {
+	marked, err := s.store.MarkUsed(s.ctx, testProofID, time.Now().Add(-time.Minute))
+
+	s.NoError(err)
+	s.False(marked)
+	s.mockClient.AssertNotCalled(s.T(), "SetNX", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,246 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dpop
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/jose/jws"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+// DPoPServiceInterface defines the interface for validating DPoP proofs and issuing server nonces.
+type DPoPServiceInterface interface {
+	ValidateProof(ctx context.Context, proof string, request ProofRequest) (string, error)
+	IssueNonce(ctx context.Context) (string, error)
+}
+
+// dpopService implements DPoPServiceInterface.
+type dpopService struct {
+	jwtService jwt.JWTServiceInterface
+	proofStore usedProofStoreInterface
+	logger     *log.Logger
+}
+
+// newDPoPService creates a new DPoP service instance.
+func newDPoPService(jwtService jwt.JWTServiceInterface, proofStore usedProofStoreInterface) DPoPServiceInterface {
+	return &dpopService{
+		jwtService: jwtService,
+		proofStore: proofStore,
+		logger:     log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DPoPService")),
+	}
+}
+
+// ValidateProof validates a DPoP proof against the request it was presented with (RFC 9449 §4.3)
+// and returns the JWK thumbprint of the proof key. It returns ErrNonceRequired when the proof lacks
+// a valid server nonce that the server requires, and ErrInvalidProof for every other failure.
+func (s *dpopService) ValidateProof(ctx context.Context, proof string, request ProofRequest) (string, error) {
+	header, err := jws.DecodeHeader(proof)
+	if err != nil {
+		return "", s.invalidProof(err.Error())
+	}
+	if typ, _ := header["typ"].(string); typ != proofTokenType {
+		return "", s.invalidProof("unexpected typ header")
+	}
+	if alg, _ := header["alg"].(string); !slices.Contains(supportedSigningAlgorithms, jws.Algorithm(alg)) {
+		return "", s.invalidProof("unsupported alg header")
+	}
+	jwk, ok := header["jwk"].(map[string]interface{})
+	if !ok {
+		return "", s.invalidProof("missing jwk header")
+	}
+	if _, hasPrivate := jwk["d"]; hasPrivate {
+		return "", s.invalidProof("jwk header contains a private key")
+	}
+	if err := jws.VerifyWithJWK(proof, jwk); err != nil {
+		return "", s.invalidProof(err.Error())
+	}
+
+	claims, err := jwt.DecodeJWTPayload(proof)
+	if err != nil {
+		return "", s.invalidProof(err.Error())
+	}
+	jti, _ := claims["jti"].(string)
+	if jti == "" {
+		return "", s.invalidProof("missing jti claim")
+	}
+	if htm, _ := claims["htm"].(string); htm != request.Method {
+		return "", s.invalidProof("htm claim does not match the request method")
+	}
+	if htu, _ := claims["htu"].(string); !matchesTargetURI(htu, request.URI) {
+		return "", s.invalidProof("htu claim does not match the request URI")
+	}
+	acceptedUntil, err := validateIssuedAt(claims)
+	if err != nil {
+		return "", s.invalidProof(err.Error())
+	}
+	if request.AccessToken != "" {
+		ath, _ := claims["ath"].(string)
+		if subtle.ConstantTimeCompare([]byte(ath), []byte(accessTokenHash(request.AccessToken))) != 1 {
+			return "", s.invalidProof("ath claim does not match the access token")
+		}
+	}
+	if err := s.validateNonce(claims); err != nil {
+		return "", err
+	}
+
+	thumbprint, err := jws.ComputeJWKThumbprint(jwk)
+	if err != nil {
+		return "", s.invalidProof(err.Error())
+	}
+
+	// A proof may only be used once. The jti is scoped to the proof key so that clients cannot
+	// invalidate each other's proofs by guessing identifiers. The record is kept only for as long
+	// as the proof would otherwise be accepted.
+	firstUse, err := s.proofStore.MarkUsed(ctx, proofID(thumbprint, jti), acceptedUntil)
+	if err != nil {
+		s.logger.Error("Failed to record DPoP proof for replay detection", log.Error(err))
+		return "", s.invalidProof("proof could not be recorded for replay detection")
+	}
+	if !firstUse {
+		return "", s.invalidProof("proof has already been used")
+	}
+
+	return thumbprint, nil
+}
+
+// IssueNonce issues a server nonce for clients to include in subsequent DPoP proofs.
+// Nonces are signed JWTs so that any server node can validate them without shared state.
+func (s *dpopService) IssueNonce(ctx context.Context) (string, error) {
+	validity := config.GetServerRuntime().Config.OAuth.DPoP.NonceValidityPeriod
+	claims := map[string]interface{}{"aud": nonceAudience}
+	nonce, _, svcErr := s.jwtService.GenerateJWT(ctx, "", "", validity, claims, nonceTokenType, "")
+	if svcErr != nil {
+		return "", fmt.Errorf("failed to generate DPoP nonce: %s", svcErr.Error.DefaultValue)
+	}
+	return nonce, nil
+}
+
+// validateNonce checks the nonce claim of a proof. A nonce is only mandatory when the server is
+// configured to require one, but any nonce that is present must be valid.
+func (s *dpopService) validateNonce(claims map[string]interface{}) error {
+	nonce, _ := claims["nonce"].(string)
+	if nonce == "" {
+		if config.GetServerRuntime().Config.OAuth.DPoP.RequireNonce {
+			return ErrNonceRequired
+		}
+		return nil
+	}
+
+	header, err := jws.DecodeHeader(nonce)
+	if err != nil {
+		s.logger.Debug("Failed to decode DPoP nonce", log.Error(err))
+		return ErrNonceRequired
+	}
+	if typ, _ := header["typ"].(string); typ != nonceTokenType {
+		return ErrNonceRequired
+	}
+	if svcErr := s.jwtService.VerifyJWT(nonce, nonceAudience, ""); svcErr != nil {
+		s.logger.Debug("DPoP nonce is invalid or expired", log.String("error", svcErr.Error.DefaultValue))
+		return ErrNonceRequired
+	}
+	return nil
+}
+
+// invalidProof logs the reason a proof was rejected and returns ErrInvalidProof.
+func (s *dpopService) invalidProof(reason string) error {
+	s.logger.Debug("Rejected DPoP proof", log.String("reason", reason))
+	return ErrInvalidProof
+}
+
+// validateIssuedAt checks that the proof was created within the configured validity period,
+// allowing for the configured clock skew, and returns the time until which the proof is accepted.
+func validateIssuedAt(claims map[string]interface{}) (time.Time, error) {
+	iat, ok := claims["iat"].(float64)
+	if !ok {
+		return time.Time{}, errors.New("missing iat claim")
+	}
+
+	conf := config.GetServerRuntime().Config
+	now := time.Now().Unix()
+	issuedAt := int64(iat)
+	if issuedAt > now+conf.JWT.Leeway {
+		return time.Time{}, errors.New("proof is issued in the future")
+	}
+	acceptedUntil := issuedAt + conf.OAuth.DPoP.ProofValidityPeriod + conf.JWT.Leeway
+	if acceptedUntil < now {
+		return time.Time{}, errors.New("proof has expired")
+	}
+	return time.Unix(acceptedUntil, 0), nil
+}
+
+// proofID derives the fixed-length identifier under which a used proof is recorded from the
+// thumbprint of the proof key and the jti of the proof.
+func proofID(thumbprint, jti string) string {
+	sum := sha256.Sum256([]byte(thumbprint + ":" + jti))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// matchesTargetURI compares the htu claim with the request URI, ignoring query and fragment
+// components and normalizing scheme, host and default ports (RFC 9449 §4.3).
+func matchesTargetURI(htu, requestURI string) bool {
+	if htu == "" {
+		return false
+	}
+	claimed, err := url.Parse(htu)
+	if err != nil {
+		return false
+	}
+	expected, err := url.Parse(requestURI)
+	if err != nil {
+		return false
+	}
+	return normalizeTargetURI(claimed) == normalizeTargetURI(expected)
+}
+
+// normalizeTargetURI renders the scheme, authority and path of a URI in a comparable form.
+func normalizeTargetURI(u *url.URL) string {
+	scheme := strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+	if (scheme == "https" && port == "443") || (scheme == "http" && port == "80") {
+		port = ""
+	}
+	if port != "" {
+		host += ":" + port
+	}
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	return scheme + "://" + host + path
+}
+
+// accessTokenHash returns the base64url-encoded SHA-256 hash of an access token, as carried by
+// the ath claim.
+func accessTokenHash(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
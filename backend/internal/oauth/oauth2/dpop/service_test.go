@@ -0,0 +1,380 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dpop
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/jose/jws"
+	"github.com/asgardeo/thunder/tests/mocks/jose/jwtmock"
+)
+
+const (
+	testTokenEndpoint = "https://localhost:8090/oauth2/token"
+	testNonce         = "eyJhbGciOiJSUzI1NiIsInR5cCI6ImRwb3Atbm9uY2Urand0In0.eyJhdWQiOiJub25jZSJ9.sig"
+)
+
+type DPoPServiceTestSuite struct {
+	suite.Suite
+	mockJWTService *jwtmock.JWTServiceInterfaceMock
+	mockProofStore *usedProofStoreInterfaceMock
+	service        DPoPServiceInterface
+	privateKey     *ecdsa.PrivateKey
+	jwk            map[string]interface{}
+}
+
+func TestDPoPServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DPoPServiceTestSuite))
+}
+
+func (suite *DPoPServiceTestSuite) SetupSuite() {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+	suite.privateKey = privateKey
+	suite.jwk = map[string]interface{}{
+		"kty": "EC",
+		"crv": "P-256",
+		"x":   base64.RawURLEncoding.EncodeToString(privateKey.X.FillBytes(make([]byte, 32))),
+		"y":   base64.RawURLEncoding.EncodeToString(privateKey.Y.FillBytes(make([]byte, 32))),
+	}
+}
+
+func (suite *DPoPServiceTestSuite) SetupTest() {
+	suite.initRuntime(false)
+	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.mockProofStore = newUsedProofStoreInterfaceMock(suite.T())
+	suite.service = newDPoPService(suite.mockJWTService, suite.mockProofStore)
+}
+
+func (suite *DPoPServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *DPoPServiceTestSuite) initRuntime(requireNonce bool) {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		JWT: config.JWTConfig{Leeway: 5},
+		OAuth: config.OAuthConfig{
+			DPoP: config.DPoPConfig{
+				ProofValidityPeriod: 60,
+				RequireNonce:        requireNonce,
+				NonceValidityPeriod: 300,
+			},
+		},
+	}
+	_ = config.InitializeServerRuntime("", testConfig)
+}
+
+// createProof builds an ES256 DPoP proof with the given header and claim overrides.
+func (suite *DPoPServiceTestSuite) createProof(
+	headerOverrides map[string]interface{}, claimOverrides map[string]interface{},
+) string {
+	header := map[string]interface{}{"typ": "dpop+jwt", "alg": "ES256", "jwk": suite.jwk}
+	for key, value := range headerOverrides {
+		if value == nil {
+			delete(header, key)
+		} else {
+			header[key] = value
+		}
+	}
+	claims := map[string]interface{}{
+		"jti": "proof-1",
+		"htm": "POST",
+		"htu": testTokenEndpoint,
+		"iat": time.Now().Unix(),
+	}
+	for key, value := range claimOverrides {
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+	}
+
+	headerJSON, _ := json.Marshal(header)
+	claimsJSON, _ := json.Marshal(claims)
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." +
+		base64.RawURLEncoding.EncodeToString(claimsJSON)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, suite.privateKey, digest[:])
+	suite.Require().NoError(err)
+	signature := append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func (suite *DPoPServiceTestSuite) tokenRequest() ProofRequest {
+	return ProofRequest{Method: "POST", URI: testTokenEndpoint}
+}
+
+func (suite *DPoPServiceTestSuite) expectUnusedProof(jti string) string {
+	thumbprint, err := jws.ComputeJWKThumbprint(suite.jwk)
+	suite.Require().NoError(err)
+	suite.mockProofStore.On("MarkUsed", mock.Anything, proofID(thumbprint, jti), mock.AnythingOfType("time.Time")).
+		Return(true, nil).Once()
+	return thumbprint
+}
+
+func (suite *DPoPServiceTestSuite) TestValidateProof_Success() {
+	expected := suite.expectUnusedProof("proof-1")
+
+	thumbprint, err := suite.service.ValidateProof(context.Background(), suite.createProof(nil, nil),
+		suite.tokenRequest())
+
+	suite.NoError(err)
+	suite.Equal(expected, thumbprint)
+}
+
+func (suite *DPoPServiceTestSuite) TestValidateProof_TargetURINormalization() {
+	testCases := []struct {
+		name string
+		htu  string
+	}{
+		{"QueryIgnored", testTokenEndpoint + "?foo=bar"},
+		{"FragmentIgnored", testTokenEndpoint + "#section"},
+		{"CaseInsensitiveHost", "HTTPS://LocalHost:8090/oauth2/token"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			jti := "proof-" + tc.name
+			suite.expectUnusedProof(jti)
+			proof := suite.createProof(nil, map[string]interface{}{"htu": tc.htu, "jti": jti})
+
+			_, err := suite.service.ValidateProof(context.Background(), proof, suite.tokenRequest())
+			suite.NoError(err)
+		})
+	}
+}
+
+func (suite *DPoPServiceTestSuite) TestValidateProof_DefaultPortNormalization() {
+	suite.expectUnusedProof("proof-1")
+	proof := suite.createProof(nil, map[string]interface{}{"htu": "https://server.example.com:443/oauth2/token"})
+
+	_, err := suite.service.ValidateProof(context.Background(), proof,
+		ProofRequest{Method: "POST", URI: "https://server.example.com/oauth2/token"})
+
+	suite.NoError(err)
+}
+
+func (suite *DPoPServiceTestSuite) TestValidateProof_InvalidProofs() {
+	privateJWK := map[string]interface{}{}
+	for key, value := range suite.jwk {
+		privateJWK[key] = value
+	}
+	privateJWK["d"] = "private"
+
+	testCases := []struct {
+		name   string
+		proof  func() string
+		access string
+	}{
+		{"Malformed", func() string { return "not-a-jwt" }, ""},
+		{"WrongType", func() string {
+			return suite.createProof(map[string]interface{}{"typ": "JWT"}, nil)
+		}, ""},
+		{"SymmetricAlgorithm", func() string {
+			return suite.createProof(map[string]interface{}{"alg": "HS256"}, nil)
+		}, ""},
+		{"MissingJWK", func() string {
+			return suite.createProof(map[string]interface{}{"jwk": nil}, nil)
+		}, ""},
+		{"PrivateJWK", func() string {
+			return suite.createProof(map[string]interface{}{"jwk": privateJWK}, nil)
+		}, ""},
+		{"TamperedClaims", func() string {
+			parts := strings.Split(suite.createProof(nil, nil), ".")
+			other := strings.Split(suite.createProof(nil, map[string]interface{}{"jti": "proof-2"}), ".")
+			return parts[0] + "." + other[1] + "." + parts[2]
+		}, ""},
+		{"MissingJTI", func() string {
+			return suite.createProof(nil, map[string]interface{}{"jti": nil})
+		}, ""},
+		{"MethodMismatch", func() string {
+			return suite.createProof(nil, map[string]interface{}{"htm": "GET"})
+		}, ""},
+		{"URIMismatch", func() string {
+			return suite.createProof(nil, map[string]interface{}{"htu": "https://localhost:8090/oauth2/userinfo"})
+		}, ""},
+		{"MissingIssuedAt", func() string {
+			return suite.createProof(nil, map[string]interface{}{"iat": nil})
+		}, ""},
+		{"Expired", func() string {
+			return suite.createProof(nil, map[string]interface{}{"iat": time.Now().Add(-2 * time.Minute).Unix()})
+		}, ""},
+		{"IssuedInFuture", func() string {
+			return suite.createProof(nil, map[string]interface{}{"iat": time.Now().Add(time.Minute).Unix()})
+		}, ""},
+		{"MissingAccessTokenHash", func() string {
+			return suite.createProof(nil, nil)
+		}, "access-token"},
+		{"AccessTokenHashMismatch", func() string {
+			return suite.createProof(nil, map[string]interface{}{"ath": accessTokenHash("other-token")})
+		}, "access-token"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			request := suite.tokenRequest()
+			request.AccessToken = tc.access
+
+			thumbprint, err := suite.service.ValidateProof(context.Background(), tc.proof(), request)
+
+			suite.ErrorIs(err, ErrInvalidProof)
+			suite.Empty(thumbprint)
+		})
+	}
+}
+
+func (suite *DPoPServiceTestSuite) TestValidateProof_AccessTokenHash() {
+	suite.expectUnusedProof("proof-1")
+	proof := suite.createProof(nil, map[string]interface{}{"ath": accessTokenHash("access-token")})
+
+	_, err := suite.service.ValidateProof(context.Background(), proof,
+		ProofRequest{Method: "POST", URI: testTokenEndpoint, AccessToken: "access-token"})
+
+	suite.NoError(err)
+}
+
+func (suite *DPoPServiceTestSuite) TestValidateProof_Replay() {
+	thumbprint, err := jws.ComputeJWKThumbprint(suite.jwk)
+	suite.Require().NoError(err)
+	suite.mockProofStore.On("MarkUsed", mock.Anything, proofID(thumbprint, "proof-1"), mock.AnythingOfType("time.Time")).
+		Return(false, nil).Once()
+
+	_, err = suite.service.ValidateProof(context.Background(), suite.createProof(nil, nil), suite.tokenRequest())
+
+	suite.ErrorIs(err, ErrInvalidProof)
+}
+
+func (suite *DPoPServiceTestSuite) TestValidateProof_StoreError() {
+	thumbprint, err := jws.ComputeJWKThumbprint(suite.jwk)
+	suite.Require().NoError(err)
+	suite.mockProofStore.On("MarkUsed", mock.Anything, proofID(thumbprint, "proof-1"), mock.AnythingOfType("time.Time")).
+		Return(false, errors.New("db down")).Once()
+
+	_, err = suite.service.ValidateProof(context.Background(), suite.createProof(nil, nil), suite.tokenRequest())
+
+	suite.ErrorIs(err, ErrInvalidProof)
+}
+
+func (suite *DPoPServiceTestSuite) TestValidateProof_RecordsProofUntilItExpires() {
+	thumbprint, err := jws.ComputeJWKThumbprint(suite.jwk)
+	suite.Require().NoError(err)
+	issuedAt := time.Now().Unix()
+	expectedExpiry := time.Unix(issuedAt+60+5, 0)
+	suite.mockProofStore.On("MarkUsed", mock.Anything, proofID(thumbprint, "proof-1"), expectedExpiry).
+		Return(true, nil).Once()
+
+	_, err = suite.service.ValidateProof(context.Background(),
+		suite.createProof(nil, map[string]interface{}{"iat": issuedAt}), suite.tokenRequest())
+
+	suite.NoError(err)
+}
+
+func (suite *DPoPServiceTestSuite) TestValidateProof_NonceRequired() {
+	suite.initRuntime(true)
+
+	_, err := suite.service.ValidateProof(context.Background(), suite.createProof(nil, nil), suite.tokenRequest())
+
+	suite.ErrorIs(err, ErrNonceRequired)
+}
+
+func (suite *DPoPServiceTestSuite) TestValidateProof_ValidNonce() {
+	suite.initRuntime(true)
+	suite.mockJWTService.On("VerifyJWT", testNonce, nonceAudience, "").Return(nil).Once()
+	suite.expectUnusedProof("proof-1")
+
+	_, err := suite.service.ValidateProof(context.Background(),
+		suite.createProof(nil, map[string]interface{}{"nonce": testNonce}), suite.tokenRequest())
+
+	suite.NoError(err)
+}
+
+func (suite *DPoPServiceTestSuite) TestValidateProof_InvalidNonce() {
+	suite.mockJWTService.On("VerifyJWT", testNonce, nonceAudience, "").
+		Return(&serviceerror.ServiceError{Code: "JWT-1001"}).Once()
+
+	_, err := suite.service.ValidateProof(context.Background(),
+		suite.createProof(nil, map[string]interface{}{"nonce": testNonce}), suite.tokenRequest())
+
+	suite.ErrorIs(err, ErrNonceRequired)
+}
+
+func (suite *DPoPServiceTestSuite) TestValidateProof_NonceWithWrongType() {
+	_, err := suite.service.ValidateProof(context.Background(),
+		suite.createProof(nil, map[string]interface{}{"nonce": "opaque-value"}), suite.tokenRequest())
+
+	suite.ErrorIs(err, ErrNonceRequired)
+	suite.mockJWTService.AssertNotCalled(suite.T(), "VerifyJWT", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DPoPServiceTestSuite) TestIssueNonce() {
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "", "", int64(300),
+		map[string]interface{}{"aud": nonceAudience}, nonceTokenType, "").
+		Return("nonce-jwt", int64(0), nil).Once()
+
+	nonce, err := suite.service.IssueNonce(context.Background())
+
+	suite.NoError(err)
+	suite.Equal("nonce-jwt", nonce)
+}
+
+func (suite *DPoPServiceTestSuite) TestIssueNonce_Error() {
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return("", int64(0), &serviceerror.InternalServerError).Once()
+
+	nonce, err := suite.service.IssueNonce(context.Background())
+
+	suite.Error(err)
+	suite.Empty(nonce)
+}
+
+func (suite *DPoPServiceTestSuite) TestProofKeyThumbprintContext() {
+	suite.Empty(GetProofKeyThumbprint(context.Background()))
+	suite.Empty(GetProofKeyThumbprint(nil)) //nolint:staticcheck // nil context is handled explicitly
+
+	ctx := WithProofKeyThumbprint(context.Background(), "thumb")
+	suite.Equal("thumb", GetProofKeyThumbprint(ctx))
+}
+
+func (suite *DPoPServiceTestSuite) TestGetSupportedSigningAlgorithms() {
+	algorithms := GetSupportedSigningAlgorithms()
+
+	suite.Contains(algorithms, "ES256")
+	suite.Contains(algorithms, "EdDSA")
+	suite.NotContains(algorithms, "HS256")
+	suite.NotContains(algorithms, "none")
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dpop
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// usedProofStoreInterface defines the interface for tracking DPoP proofs that have already been
+// accepted. Entries only need to be retained until the proof would no longer be accepted.
+type usedProofStoreInterface interface {
+	MarkUsed(ctx context.Context, proofID string, expiryTime time.Time) (bool, error)
+}
+
+// usedProofStore is the relational-DB-backed implementation of usedProofStoreInterface.
+type usedProofStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newUsedProofStore creates a new DB-backed used proof store.
+func newUsedProofStore(deploymentID string) usedProofStoreInterface {
+	return &usedProofStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// MarkUsed records the proof as used. Returns false if the proof was already used.
+func (s *usedProofStore) MarkUsed(ctx context.Context, proofID string, expiryTime time.Time) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(
+		ctx, queryInsertConsumedDPoPProof, proofID, s.deploymentID, expiryTime.UTC(),
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to record used DPoP proof: %w", err)
+	}
+	return rows > 0, nil
+}
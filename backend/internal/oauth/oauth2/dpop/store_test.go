@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dpop
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/tests/mocks/database/providermock"
+)
+
+const (
+	testDeploymentID = "test-deployment-id"
+	testProofID      = "test-proof-id"
+)
+
+type UsedProofStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *usedProofStore
+	ctx            context.Context
+	expiry         time.Time
+}
+
+func TestUsedProofStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(UsedProofStoreTestSuite))
+}
+
+func (s *UsedProofStoreTestSuite) SetupTest() {
+	s.mockDBProvider = providermock.NewDBProviderInterfaceMock(s.T())
+	s.mockDBClient = providermock.NewDBClientInterfaceMock(s.T())
+	s.store = &usedProofStore{
+		dbProvider:   s.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+	s.expiry = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+}
+
+func (s *UsedProofStoreTestSuite) TestMarkUsed_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertConsumedDPoPProof,
+		testProofID, testDeploymentID, s.expiry).Return(int64(1), nil)
+
+	marked, err := s.store.MarkUsed(s.ctx, testProofID, s.expiry)
+
+	s.NoError(err)
+	s.True(marked)
+}
+
+func (s *UsedProofStoreTestSuite) TestMarkUsed_AlreadyUsed() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertConsumedDPoPProof,
+		mock.Anything, mock.Anything, mock.Anything).Return(int64(0), nil)
+
+	marked, err := s.store.MarkUsed(s.ctx, testProofID, s.expiry)
+
+	s.NoError(err)
+	s.False(marked)
+}
+
+func (s *UsedProofStoreTestSuite) TestMarkUsed_DBClientError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db client error"))
+
+	marked, err := s.store.MarkUsed(s.ctx, testProofID, s.expiry)
+
+	s.Error(err)
+	s.False(marked)
+}
+
+func (s *UsedProofStoreTestSuite) TestMarkUsed_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertConsumedDPoPProof,
+		mock.Anything, mock.Anything, mock.Anything).Return(int64(0), errors.New("exec failed"))
+
+	marked, err := s.store.MarkUsed(s.ctx, testProofID, s.expiry)
+
+	s.ErrorContains(err, "failed to record used DPoP proof")
+	s.False(marked)
+}
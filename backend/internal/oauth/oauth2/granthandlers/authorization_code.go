@@ -26,6 +26,7 @@ import (
 
 	"github.com/asgardeo/thunder/internal/attributecache"
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/authorizationdetails"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/authz"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
@@ -116,6 +117,16 @@ func (h *authorizationCodeGrantHandler) HandleGrant(ctx context.Context, tokenRe
 		return nil, errResponse
 	}
 
+	// Per RFC 9396 §6.1, the token request MAY narrow the authorization details granted by the code.
+	requestedDetails, errResp := authorizationdetails.Parse(tokenRequest.AuthorizationDetails)
+	if errResp != nil {
+		return nil, errResp
+	}
+	authorizationDetails, errResp := authorizationdetails.Narrow(requestedDetails, authCode.AuthorizationDetails)
+	if errResp != nil {
+		return nil, errResp
+	}
+
 	// Parse authorized scopes
 	authorizedScopes := tokenservice.ParseScopes(authCode.Scopes)
 
@@ -185,17 +196,18 @@ func (h *authorizationCodeGrantHandler) HandleGrant(ctx context.Context, tokenRe
 
 	// Generate access token using tokenBuilder (attributes will be filtered in BuildAccessToken)
 	accessToken, err := h.tokenBuilder.BuildAccessToken(&tokenservice.AccessTokenBuildContext{
-		Context:          ctx,
-		Subject:          authCode.AuthorizedUserID,
-		Audiences:        accessTokenAudiences,
-		ClientID:         tokenRequest.ClientID,
-		Scopes:           accessTokenScopes,
-		UserAttributes:   attrs,
-		AttributeCacheID: authCode.AttributeCacheID,
-		GrantType:        string(constants.GrantTypeAuthorizationCode),
-		OAuthApp:         oauthApp,
-		ClaimsRequest:    authCode.ClaimsRequest,
-		ClaimsLocales:    authCode.ClaimsLocales,
+		Context:              ctx,
+		Subject:              authCode.AuthorizedUserID,
+		Audiences:            accessTokenAudiences,
+		ClientID:             tokenRequest.ClientID,
+		Scopes:               accessTokenScopes,
+		UserAttributes:       attrs,
+		AttributeCacheID:     authCode.AttributeCacheID,
+		GrantType:            string(constants.GrantTypeAuthorizationCode),
+		OAuthApp:             oauthApp,
+		ClaimsRequest:        authCode.ClaimsRequest,
+		ClaimsLocales:        authCode.ClaimsLocales,
+		AuthorizationDetails: authorizationDetails,
 	})
 	if err != nil {
 		return nil, &model.ErrorResponse{
@@ -226,6 +238,7 @@ func (h *authorizationCodeGrantHandler) HandleGrant(ctx context.Context, tokenRe
 			ClaimsRequest:  authCode.ClaimsRequest,
 			Nonce:          authCode.Nonce,
 			CompletedACR:   authCode.CompletedACR,
+			SID:            authCode.SessionSID,
 		})
 		if err != nil {
 			logger.Error("Failed to generate ID token", log.Error(err))
@@ -1355,3 +1355,42 @@ func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_TokenReques
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), []string{testResourceURL}, capturedAudiences)
 }
+
+func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_AuthorizationDetailsNarrowed() {
+	paymentDetail := model.AuthorizationDetail{"type": "payment_initiation", "amount": "50.00"}
+	accountDetail := model.AuthorizationDetail{"type": "account_information", "actions": []interface{}{"read"}}
+	authCode := suite.testAuthzCode
+	authCode.AuthorizationDetails = []model.AuthorizationDetail{paymentDetail, accountDetail}
+	suite.mockAuthzService.On("GetAuthorizationCodeDetails", mock.Anything, testClientID, "test-auth-code").
+		Return(&authCode, nil)
+
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+		return len(ctx.AuthorizationDetails) == 1 && ctx.AuthorizationDetails[0]["type"] == "payment_initiation"
+	})).Return(&model.TokenDTO{Token: "test-jwt-token", TokenType: constants.TokenTypeBearer}, nil)
+
+	tokenReq := *suite.testTokenReq
+	tokenReq.AuthorizationDetails = `[{"type":"payment_initiation","amount":"50.00"}]`
+
+	result, err := suite.handler.HandleGrant(context.Background(), &tokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	suite.mockTokenBuilder.AssertExpectations(suite.T())
+}
+
+func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_AuthorizationDetailsExceedGrant() {
+	authCode := suite.testAuthzCode
+	authCode.AuthorizationDetails = []model.AuthorizationDetail{{"type": "payment_initiation", "amount": "50.00"}}
+	suite.mockAuthzService.On("GetAuthorizationCodeDetails", mock.Anything, testClientID, "test-auth-code").
+		Return(&authCode, nil)
+
+	tokenReq := *suite.testTokenReq
+	tokenReq.AuthorizationDetails = `[{"type":"payment_initiation","amount":"5000.00"}]`
+
+	result, err := suite.handler.HandleGrant(context.Background(), &tokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInvalidAuthorizationDetails, err.Error)
+	suite.mockTokenBuilder.AssertNotCalled(suite.T(), "BuildAccessToken", mock.Anything)
+}
@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package granthandlers
+
+import (
+	"context"
+	"slices"
+
+	"github.com/asgardeo/thunder/internal/attributecache"
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/ciba"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/resourceindicators"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
+	"github.com/asgardeo/thunder/internal/resource"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+// cibaGrantHandler handles the OpenID Client-Initiated Backchannel Authentication (CIBA) grant type.
+type cibaGrantHandler struct {
+	cibaService     ciba.BackchannelAuthServiceInterface
+	tokenBuilder    tokenservice.TokenBuilderInterface
+	attributeCache  attributecache.AttributeCacheServiceInterface
+	resourceService resource.ResourceServiceInterface
+}
+
+// newCIBAGrantHandler creates a new instance of CIBAGrantHandler.
+func newCIBAGrantHandler(
+	cibaService ciba.BackchannelAuthServiceInterface,
+	tokenBuilder tokenservice.TokenBuilderInterface,
+	attributeCache attributecache.AttributeCacheServiceInterface,
+	resourceService resource.ResourceServiceInterface,
+) GrantHandlerInterface {
+	return &cibaGrantHandler{
+		cibaService:     cibaService,
+		tokenBuilder:    tokenBuilder,
+		attributeCache:  attributeCache,
+		resourceService: resourceService,
+	}
+}
+
+// ValidateGrant validates the CIBA grant request.
+func (h *cibaGrantHandler) ValidateGrant(ctx context.Context, tokenRequest *model.TokenRequest,
+	oauthApp *inboundmodel.OAuthClient) *model.ErrorResponse {
+	if tokenRequest.GrantType == "" {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidRequest,
+			ErrorDescription: "Missing grant_type parameter",
+		}
+	}
+	if constants.GrantType(tokenRequest.GrantType) != constants.GrantTypeCIBA {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorUnsupportedGrantType,
+			ErrorDescription: "Unsupported grant type",
+		}
+	}
+	if tokenRequest.AuthReqID == "" {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidRequest,
+			ErrorDescription: "auth_req_id is required",
+		}
+	}
+	if tokenRequest.ClientID == "" {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidClient,
+			ErrorDescription: "client_id is required",
+		}
+	}
+
+	return nil
+}
+
+// HandleGrant redeems the backchannel authentication request and generates a token response once the
+// user has approved it on their authentication device.
+func (h *cibaGrantHandler) HandleGrant(ctx context.Context, tokenRequest *model.TokenRequest,
+	oauthApp *inboundmodel.OAuthClient) (
+	*model.TokenResponseDTO, *model.ErrorResponse) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CIBAGrantHandler"))
+
+	authRequest, errResp := h.cibaService.ConsumeBackchannelAuthRequest(
+		ctx, tokenRequest.ClientID, tokenRequest.AuthReqID)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	authorizedScopes := append(append([]string{}, authRequest.StandardScopes...),
+		authRequest.PermissionScopes...)
+
+	// Get user attributes from attribute cache
+	attrs := make(map[string]interface{})
+	if authRequest.AttributeCacheID != "" {
+		userAttributes, err := h.attributeCache.GetAttributeCache(ctx, authRequest.AttributeCacheID)
+		if err != nil {
+			logger.Error("Failed to get user attributes from attribute cache. " + err.ErrorDescription.DefaultValue)
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorServerError,
+				ErrorDescription: "Failed to get user attributes from attribute cache",
+			}
+		}
+		attrs = userAttributes.Attributes
+	}
+
+	resourceServers, errResp := resourceindicators.ResolveResourceServers(
+		ctx, h.resourceService, authRequest.Resources)
+	if errResp != nil {
+		return nil, errResp
+	}
+	audiences, errResp := resourceindicators.ComposeAudiences(ctx, h.resourceService, authRequest.ClientID,
+		resourceServers, authorizedScopes)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	accessToken, err := h.tokenBuilder.BuildAccessToken(&tokenservice.AccessTokenBuildContext{
+		Context:          ctx,
+		Subject:          authRequest.AuthorizedUserID,
+		Audiences:        audiences,
+		ClientID:         tokenRequest.ClientID,
+		Scopes:           authorizedScopes,
+		UserAttributes:   attrs,
+		AttributeCacheID: authRequest.AttributeCacheID,
+		GrantType:        string(constants.GrantTypeCIBA),
+		OAuthApp:         oauthApp,
+	})
+	if err != nil {
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate token",
+		}
+	}
+
+	tokenResponse := &model.TokenResponseDTO{
+		AccessToken: *accessToken,
+	}
+
+	// Generate ID token if 'openid' scope is present
+	if slices.Contains(authorizedScopes, constants.ScopeOpenID) {
+		idToken, err := h.tokenBuilder.BuildIDToken(&tokenservice.IDTokenBuildContext{
+			Context:        ctx,
+			Subject:        authRequest.AuthorizedUserID,
+			Audience:       tokenRequest.ClientID,
+			Scopes:         authorizedScopes,
+			UserAttributes: attrs,
+			AuthTime:       authRequest.AuthTime.Unix(),
+			OAuthApp:       oauthApp,
+			CompletedACR:   authRequest.CompletedACR,
+		})
+		if err != nil {
+			logger.Error("Failed to generate ID token", log.Error(err))
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorServerError,
+				ErrorDescription: "Failed to generate token",
+			}
+		}
+		tokenResponse.IDToken = *idToken
+	}
+
+	return tokenResponse, nil
+}
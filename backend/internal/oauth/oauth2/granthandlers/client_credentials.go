@@ -25,6 +25,7 @@ import (
 	"github.com/asgardeo/thunder/internal/authz"
 	"github.com/asgardeo/thunder/internal/entityprovider"
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/authorizationdetails"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/resourceindicators"
@@ -152,6 +153,16 @@ func (h *clientCredentialsGrantHandler) HandleGrant(ctx context.Context, tokenRe
 		return nil, errResp
 	}
 
+	// Authorization details requested by the client are validated against the type definitions
+	// of the resource servers (RFC 9396 §6).
+	authorizationDetails, errResp := authorizationdetails.Parse(tokenRequest.AuthorizationDetails)
+	if errResp != nil {
+		return nil, errResp
+	}
+	if errResp := authorizationdetails.Validate(ctx, h.resourceService, authorizationDetails); errResp != nil {
+		return nil, errResp
+	}
+
 	clientAttributes, clientAttrErr := tokenservice.BuildClientAttributes(ctx, oauthApp, h.ouService)
 	if clientAttrErr != nil {
 		return nil, &model.ErrorResponse{
@@ -161,15 +172,16 @@ func (h *clientCredentialsGrantHandler) HandleGrant(ctx context.Context, tokenRe
 	}
 
 	accessToken, err := h.tokenBuilder.BuildAccessToken(&tokenservice.AccessTokenBuildContext{
-		Context:          ctx,
-		Subject:          tokenRequest.ClientID,
-		Audiences:        audiences,
-		ClientID:         tokenRequest.ClientID,
-		Scopes:           scopes,
-		UserAttributes:   make(map[string]interface{}),
-		GrantType:        string(constants.GrantTypeClientCredentials),
-		OAuthApp:         oauthApp,
-		ClientAttributes: clientAttributes,
+		Context:              ctx,
+		Subject:              tokenRequest.ClientID,
+		Audiences:            audiences,
+		ClientID:             tokenRequest.ClientID,
+		Scopes:               scopes,
+		UserAttributes:       make(map[string]interface{}),
+		GrantType:            string(constants.GrantTypeClientCredentials),
+		OAuthApp:             oauthApp,
+		ClientAttributes:     clientAttributes,
+		AuthorizationDetails: authorizationDetails,
 	})
 	if err != nil {
 		return nil, &model.ErrorResponse{
@@ -751,3 +751,48 @@ func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_ImplicitRSD
 	assert.Contains(suite.T(), capturedAudiences, rsIdentifier1)
 	assert.Contains(suite.T(), capturedAudiences, rsIdentifier2)
 }
+
+func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_WithAuthorizationDetails() {
+	tokenRequest := &model.TokenRequest{
+		GrantType:            "client_credentials",
+		ClientID:             testClientID,
+		ClientSecret:         "secret123",
+		AuthorizationDetails: `[{"type":"payment_initiation","amount":"50.00"}]`,
+	}
+
+	suite.mockResourceService.On("ValidateAuthorizationDetail", mock.Anything, mock.Anything).
+		Return(&resource.ResourceServer{Identifier: "https://payments.example.com"}, nil).Once()
+	suite.mockTokenBuilder.On("BuildAccessToken",
+		mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return len(ctx.AuthorizationDetails) == 1 && ctx.AuthorizationDetails[0]["amount"] == "50.00"
+		})).Return(&model.TokenDTO{
+		Token:     testJWTToken,
+		TokenType: constants.TokenTypeBearer,
+		ClientID:  testClientID,
+	}, nil)
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), tokenRequest, suite.oauthApp)
+
+	assert.Nil(suite.T(), errResp)
+	assert.NotNil(suite.T(), result)
+	suite.mockTokenBuilder.AssertExpectations(suite.T())
+}
+
+func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_InvalidAuthorizationDetails() {
+	tokenRequest := &model.TokenRequest{
+		GrantType:            "client_credentials",
+		ClientID:             testClientID,
+		ClientSecret:         "secret123",
+		AuthorizationDetails: `[{"type":"payment_initiation"}]`,
+	}
+
+	suite.mockResourceService.On("ValidateAuthorizationDetail", mock.Anything, mock.Anything).
+		Return(nil, &resource.ErrorAuthorizationDetailTypeNotFound).Once()
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), tokenRequest, suite.oauthApp)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorInvalidAuthorizationDetails, errResp.Error)
+	suite.mockTokenBuilder.AssertNotCalled(suite.T(), "BuildAccessToken", mock.Anything)
+}
@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package granthandlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/attributecache"
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/device"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
+	"github.com/asgardeo/thunder/internal/resource"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/tests/mocks/attributecachemock"
+	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/devicemock"
+	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/tokenservicemock"
+	"github.com/asgardeo/thunder/tests/mocks/resourcemock"
+)
+
+const testDeviceCode = "test-device-code"
+
+type DeviceCodeGrantHandlerTestSuite struct {
+	suite.Suite
+	handler              *deviceCodeGrantHandler
+	mockDeviceService    *devicemock.DeviceAuthorizationServiceInterfaceMock
+	mockTokenBuilder     *tokenservicemock.TokenBuilderInterfaceMock
+	mockAttrCacheService *attributecachemock.AttributeCacheServiceInterfaceMock
+	mockResourceService  *resourcemock.ResourceServiceInterfaceMock
+	oauthApp             *inboundmodel.OAuthClient
+	tokenRequest         *model.TokenRequest
+	authorization        *device.DeviceAuthorization
+}
+
+func TestDeviceCodeGrantHandlerSuite(t *testing.T) {
+	suite.Run(t, new(DeviceCodeGrantHandlerTestSuite))
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) SetupTest() {
+	testConfig := &config.Config{
+		JWT: config.JWTConfig{
+			ValidityPeriod: 3600,
+		},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+
+	suite.mockDeviceService = devicemock.NewDeviceAuthorizationServiceInterfaceMock(suite.T())
+	suite.mockTokenBuilder = tokenservicemock.NewTokenBuilderInterfaceMock(suite.T())
+	suite.mockAttrCacheService = attributecachemock.NewAttributeCacheServiceInterfaceMock(suite.T())
+	suite.mockResourceService = resourcemock.NewResourceServiceInterfaceMock(suite.T())
+
+	suite.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, mock.Anything).
+		Return(func(_ context.Context, identifier string) *resource.ResourceServer {
+			return &resource.ResourceServer{ID: identifier, Identifier: identifier}
+		}, func(_ context.Context, _ string) *serviceerror.ServiceError {
+			return nil
+		}).Maybe()
+	suite.mockResourceService.On("ValidatePermissions", mock.Anything, mock.Anything, mock.Anything).
+		Return([]string{}, nil).Maybe()
+	suite.mockResourceService.On("FindResourceServersByPermissions", mock.Anything, mock.Anything).
+		Return([]resource.ResourceServer{}, nil).Maybe()
+
+	suite.handler = &deviceCodeGrantHandler{
+		deviceService:   suite.mockDeviceService,
+		tokenBuilder:    suite.mockTokenBuilder,
+		attributeCache:  suite.mockAttrCacheService,
+		resourceService: suite.mockResourceService,
+	}
+
+	suite.oauthApp = &inboundmodel.OAuthClient{
+		ClientID:                testClientID,
+		GrantTypes:              []constants.GrantType{constants.GrantTypeDeviceCode},
+		TokenEndpointAuthMethod: constants.TokenEndpointAuthMethodNone,
+		PublicClient:            true,
+	}
+
+	suite.tokenRequest = &model.TokenRequest{
+		GrantType:  string(constants.GrantTypeDeviceCode),
+		ClientID:   testClientID,
+		DeviceCode: testDeviceCode,
+	}
+
+	suite.authorization = &device.DeviceAuthorization{
+		DeviceCode:       testDeviceCode,
+		ClientID:         testClientID,
+		StandardScopes:   []string{"openid"},
+		PermissionScopes: []string{"read"},
+		Resources:        []string{testResourceURL},
+		Status:           device.AuthorizationStatusAuthorized,
+		AuthorizedUserID: testUserID,
+		AttributeCacheID: testCacheID,
+		AuthTime:         time.Unix(1700000000, 0),
+		CompletedACR:     "urn:thunder:acr:password",
+	}
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestValidateGrant_Success() {
+	suite.Nil(suite.handler.ValidateGrant(context.Background(), suite.tokenRequest, suite.oauthApp))
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestValidateGrant_InvalidRequests() {
+	testCases := []struct {
+		name          string
+		modify        func(req *model.TokenRequest)
+		expectedError string
+	}{
+		{"MissingGrantType", func(req *model.TokenRequest) { req.GrantType = "" }, constants.ErrorInvalidRequest},
+		{"WrongGrantType", func(req *model.TokenRequest) { req.GrantType = "authorization_code" },
+			constants.ErrorUnsupportedGrantType},
+		{"MissingDeviceCode", func(req *model.TokenRequest) { req.DeviceCode = "" }, constants.ErrorInvalidRequest},
+		{"MissingClientID", func(req *model.TokenRequest) { req.ClientID = "" }, constants.ErrorInvalidClient},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			req := *suite.tokenRequest
+			tc.modify(&req)
+
+			errResp := suite.handler.ValidateGrant(context.Background(), &req, suite.oauthApp)
+
+			suite.NotNil(errResp)
+			suite.Equal(tc.expectedError, errResp.Error)
+		})
+	}
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_Success() {
+	suite.mockDeviceService.EXPECT().ConsumeDeviceAuthorization(mock.Anything, testClientID, testDeviceCode).
+		Return(suite.authorization, nil)
+	suite.mockAttrCacheService.EXPECT().GetAttributeCache(mock.Anything, testCacheID).
+		Return(&attributecache.AttributeCache{Attributes: map[string]interface{}{"email": "user@example.com"}}, nil)
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+		return ctx.Subject == testUserID && ctx.ClientID == testClientID &&
+			ctx.GrantType == string(constants.GrantTypeDeviceCode) &&
+			len(ctx.Audiences) == 1 && ctx.Audiences[0] == testResourceURL &&
+			ctx.UserAttributes["email"] == "user@example.com"
+	})).Return(&model.TokenDTO{Token: "test-access-token", Subject: testUserID}, nil)
+	suite.mockTokenBuilder.On("BuildIDToken", mock.MatchedBy(func(ctx *tokenservice.IDTokenBuildContext) bool {
+		return ctx.Subject == testUserID && ctx.Audience == testClientID &&
+			ctx.AuthTime == int64(1700000000) && ctx.CompletedACR == "urn:thunder:acr:password"
+	})).Return(&model.TokenDTO{Token: "test-id-token"}, nil)
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenRequest, suite.oauthApp)
+
+	suite.Nil(errResp)
+	suite.Equal("test-access-token", result.AccessToken.Token)
+	suite.Equal("test-id-token", result.IDToken.Token)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_WithoutOpenIDScope() {
+	suite.authorization.StandardScopes = nil
+	suite.authorization.AttributeCacheID = ""
+	suite.mockDeviceService.EXPECT().ConsumeDeviceAuthorization(mock.Anything, testClientID, testDeviceCode).
+		Return(suite.authorization, nil)
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).
+		Return(&model.TokenDTO{Token: "test-access-token"}, nil)
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenRequest, suite.oauthApp)
+
+	suite.Nil(errResp)
+	suite.Equal("test-access-token", result.AccessToken.Token)
+	suite.Empty(result.IDToken.Token)
+	suite.mockTokenBuilder.AssertNotCalled(suite.T(), "BuildIDToken", mock.Anything)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_AuthorizationPending() {
+	suite.mockDeviceService.EXPECT().ConsumeDeviceAuthorization(mock.Anything, testClientID, testDeviceCode).
+		Return(nil, &model.ErrorResponse{Error: constants.ErrorAuthorizationPending})
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenRequest, suite.oauthApp)
+
+	suite.Nil(result)
+	suite.Equal(constants.ErrorAuthorizationPending, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_AttributeCacheError() {
+	suite.mockDeviceService.EXPECT().ConsumeDeviceAuthorization(mock.Anything, testClientID, testDeviceCode).
+		Return(suite.authorization, nil)
+	suite.mockAttrCacheService.EXPECT().GetAttributeCache(mock.Anything, testCacheID).
+		Return(nil, &serviceerror.InternalServerError)
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenRequest, suite.oauthApp)
+
+	suite.Nil(result)
+	suite.Equal(constants.ErrorServerError, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_AccessTokenError() {
+	suite.authorization.AttributeCacheID = ""
+	suite.mockDeviceService.EXPECT().ConsumeDeviceAuthorization(mock.Anything, testClientID, testDeviceCode).
+		Return(suite.authorization, nil)
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).Return(nil, errors.New("jwt generation failed"))
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenRequest, suite.oauthApp)
+
+	suite.Nil(result)
+	suite.Equal(constants.ErrorServerError, errResp.Error)
+}
@@ -27,10 +27,17 @@ import (
 	"github.com/asgardeo/thunder/internal/flow/flowexec"
 	"github.com/asgardeo/thunder/internal/inboundclient"
 	oauth2authz "github.com/asgardeo/thunder/internal/oauth/oauth2/authz"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/ciba"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/device"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/jwksresolver"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/par"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/requestobject"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/revocation"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokengrant"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
 	"github.com/asgardeo/thunder/internal/ou"
 	"github.com/asgardeo/thunder/internal/resource"
+	"github.com/asgardeo/thunder/internal/session"
 	"github.com/asgardeo/thunder/internal/system/jose/jwt"
 )
 
@@ -48,9 +55,17 @@ func Initialize(
 	entityProv entityprovider.EntityProviderInterface,
 	resourceService resource.ResourceServiceInterface,
 	parService par.PARServiceInterface,
+	requestObjectService requestobject.RequestObjectServiceInterface,
+	revocationService revocation.TokenRevocationServiceInterface,
+	grantService tokengrant.TokenGrantServiceInterface,
+	sessionService session.SessionServiceInterface,
+	deviceService device.DeviceAuthorizationServiceInterface,
+	cibaService ciba.BackchannelAuthServiceInterface,
+	jwksResolver *jwksresolver.Resolver,
 ) (GrantHandlerProviderInterface, error) {
 	oauthAuthzService, err := oauth2authz.Initialize(
-		mux, inboundClient, resourceService, jwtService, flowExecService, parService,
+		mux, inboundClient, resourceService, jwtService, tokenBuilder, tokenValidator, attrCacheService,
+		flowExecService, parService, requestObjectService, sessionService,
 	)
 	if err != nil {
 		return nil, err
@@ -65,6 +80,11 @@ func Initialize(
 		authzService,
 		entityProv,
 		resourceService,
+		revocationService,
+		grantService,
+		deviceService,
+		cibaService,
+		jwksResolver,
 	)
 	return grantHandlerProvider, nil
 }
@@ -0,0 +1,320 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package granthandlers
+
+import (
+	"context"
+	"slices"
+
+	"github.com/asgardeo/thunder/internal/authz"
+	certmodel "github.com/asgardeo/thunder/internal/cert"
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/jwksresolver"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/resourceindicators"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
+	"github.com/asgardeo/thunder/internal/resource"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+// jwtBearerGrantHandler handles the JWT bearer authorization grant type (RFC 7523).
+type jwtBearerGrantHandler struct {
+	jwtService      jwt.JWTServiceInterface
+	jwksResolver    *jwksresolver.Resolver
+	tokenBuilder    tokenservice.TokenBuilderInterface
+	authzService    authz.AuthorizationServiceInterface
+	entityProv      entityprovider.EntityProviderInterface
+	resourceService resource.ResourceServiceInterface
+}
+
+// newJWTBearerGrantHandler creates a new instance of jwtBearerGrantHandler.
+func newJWTBearerGrantHandler(
+	jwtService jwt.JWTServiceInterface,
+	jwksResolver *jwksresolver.Resolver,
+	tokenBuilder tokenservice.TokenBuilderInterface,
+	authzService authz.AuthorizationServiceInterface,
+	entityProv entityprovider.EntityProviderInterface,
+	resourceService resource.ResourceServiceInterface,
+) GrantHandlerInterface {
+	return &jwtBearerGrantHandler{
+		jwtService:      jwtService,
+		jwksResolver:    jwksResolver,
+		tokenBuilder:    tokenBuilder,
+		authzService:    authzService,
+		entityProv:      entityProv,
+		resourceService: resourceService,
+	}
+}
+
+// ValidateGrant validates the JWT bearer grant request.
+func (h *jwtBearerGrantHandler) ValidateGrant(ctx context.Context, tokenRequest *model.TokenRequest,
+	oauthApp *inboundmodel.OAuthClient) *model.ErrorResponse {
+	if constants.GrantType(tokenRequest.GrantType) != constants.GrantTypeJWTBearer {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorUnsupportedGrantType,
+			ErrorDescription: "Unsupported grant type",
+		}
+	}
+	if tokenRequest.Assertion == "" {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidRequest,
+			ErrorDescription: "Missing required parameter: assertion",
+		}
+	}
+	if tokenRequest.ClientID == "" {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidClient,
+			ErrorDescription: "client_id is required",
+		}
+	}
+
+	return resourceindicators.ValidateResourceURIs(tokenRequest.Resources)
+}
+
+// HandleGrant validates the assertion against the issuer's keys and issues an access token for the
+// local entity the assertion's subject maps to.
+func (h *jwtBearerGrantHandler) HandleGrant(ctx context.Context, tokenRequest *model.TokenRequest,
+	oauthApp *inboundmodel.OAuthClient) (
+	*model.TokenResponseDTO, *model.ErrorResponse) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "JWTBearerGrantHandler"))
+
+	subject, trustedIssuer, errResp := h.validateAssertion(ctx, tokenRequest.Assertion, oauthApp, logger)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	entityID, errResp := h.identifyEntity(trustedIssuer, subject, logger)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	resolvedRSes, errResp := resourceindicators.ResolveResourceServers(ctx, h.resourceService, tokenRequest.Resources)
+	if errResp != nil {
+		return nil, errResp
+	}
+	scopes := tokenservice.ParseScopes(tokenRequest.Scope)
+	if len(resolvedRSes) > 0 {
+		rsValidScopes, rsErr := resourceindicators.ComputeRSValidScopes(ctx, h.resourceService, resolvedRSes, scopes)
+		if rsErr != nil {
+			return nil, rsErr
+		}
+		scopes = resourceindicators.UnionScopes(rsValidScopes)
+	}
+
+	if len(scopes) > 0 {
+		scopes, errResp = h.getAuthorizedPermissions(ctx, entityID, scopes, logger)
+		if errResp != nil {
+			return nil, errResp
+		}
+	}
+
+	audiences, errResp := resourceindicators.ComposeAudiences(ctx, h.resourceService, tokenRequest.ClientID,
+		resolvedRSes, scopes)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	accessToken, err := h.tokenBuilder.BuildAccessToken(&tokenservice.AccessTokenBuildContext{
+		Context:        ctx,
+		Subject:        entityID,
+		Audiences:      audiences,
+		ClientID:       tokenRequest.ClientID,
+		Scopes:         scopes,
+		UserAttributes: make(map[string]interface{}),
+		GrantType:      string(constants.GrantTypeJWTBearer),
+		OAuthApp:       oauthApp,
+	})
+	if err != nil {
+		logger.Error("Failed to generate access token", log.Error(err))
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate token",
+		}
+	}
+
+	return &model.TokenResponseDTO{
+		AccessToken: *accessToken,
+	}, nil
+}
+
+// validateAssertion verifies the assertion per RFC 7523 §3 and returns its subject along with the
+// configuration of its issuer.
+// The issuer must be both a configured trusted issuer and allowed for the client, the signature must
+// verify against the issuer's JWKS, and the audience must identify this authorization server.
+func (h *jwtBearerGrantHandler) validateAssertion(ctx context.Context, assertion string,
+	oauthApp *inboundmodel.OAuthClient, logger *log.Logger) (
+	string, *config.JWTBearerIssuerConfig, *model.ErrorResponse) {
+	invalidAssertion := &model.ErrorResponse{
+		Error:            constants.ErrorInvalidGrant,
+		ErrorDescription: "Invalid assertion",
+	}
+
+	header, claims, err := jwt.DecodeJWT(assertion)
+	if err != nil {
+		logger.Debug("Failed to decode the assertion", log.Error(err))
+		return "", nil, invalidAssertion
+	}
+
+	issuer, _ := claims["iss"].(string)
+	trustedIssuer := config.GetServerRuntime().Config.OAuth.JWTBearer.GetTrustedIssuer(issuer)
+	if trustedIssuer == nil || !oauthApp.IsAllowedJWTBearerIssuer(issuer) {
+		logger.Debug("The assertion issuer is not trusted for the client", log.String("issuer", issuer))
+		return "", nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "The assertion issuer is not trusted",
+		}
+	}
+
+	alg, _ := header["alg"].(string)
+	if alg == "" || alg == "none" {
+		return "", nil, invalidAssertion
+	}
+	kid, _ := header["kid"].(string)
+
+	publicKey, svcErr := h.jwksResolver.ResolveVerificationKey(ctx, trustedIssuerJWKS(trustedIssuer), kid, alg)
+	if svcErr != nil {
+		if svcErr.Code == jwksresolver.ErrorVerificationKeyNotFound.Code {
+			return "", nil, invalidAssertion
+		}
+		logger.Error("Failed to resolve the assertion verification key", log.String("issuer", issuer))
+		return "", nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to validate the assertion",
+		}
+	}
+
+	// Verifies the signature along with the iss, exp and nbf claims.
+	if svcErr := h.jwtService.VerifyJWTWithPublicKey(assertion, publicKey, "", issuer); svcErr != nil {
+		logger.Debug("Failed to verify the assertion", log.String("error", svcErr.ErrorDescription.DefaultValue))
+		return "", nil, invalidAssertion
+	}
+
+	if !hasAcceptedAudience(claims["aud"]) {
+		logger.Debug("The assertion audience does not identify this server")
+		return "", nil, invalidAssertion
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return "", nil, invalidAssertion
+	}
+
+	return subject, trustedIssuer, nil
+}
+
+// identifyEntity maps the assertion subject to a local entity using the issuer's subject attribute.
+func (h *jwtBearerGrantHandler) identifyEntity(trustedIssuer *config.JWTBearerIssuerConfig, subject string,
+	logger *log.Logger) (string, *model.ErrorResponse) {
+	entityID, epErr := h.entityProv.IdentifyEntity(map[string]interface{}{trustedIssuer.SubjectAttribute: subject})
+	if epErr != nil {
+		if epErr.Code == entityprovider.ErrorCodeEntityNotFound ||
+			epErr.Code == entityprovider.ErrorCodeAmbiguousEntity {
+			logger.Debug("The assertion subject does not map to a single entity", log.String("issuer", trustedIssuer.Issuer))
+			return "", &model.ErrorResponse{
+				Error:            constants.ErrorInvalidGrant,
+				ErrorDescription: "The assertion subject is not recognized",
+			}
+		}
+		logger.Error("Failed to identify the assertion subject", log.Error(epErr))
+		return "", &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate token",
+		}
+	}
+	if entityID == nil || *entityID == "" {
+		return "", &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "The assertion subject is not recognized",
+		}
+	}
+
+	return *entityID, nil
+}
+
+// getAuthorizedPermissions narrows the requested scopes to the permissions granted to the entity,
+// directly or through its groups.
+func (h *jwtBearerGrantHandler) getAuthorizedPermissions(ctx context.Context, entityID string,
+	scopes []string, logger *log.Logger) ([]string, *model.ErrorResponse) {
+	var groupIDs []string
+	groups, groupErr := h.entityProv.GetTransitiveEntityGroups(entityID)
+	if groupErr != nil {
+		// Ignore unimplemented providers to preserve existing behavior.
+		if groupErr.Code != entityprovider.ErrorCodeNotImplemented {
+			logger.Error("Failed to resolve entity group memberships", log.String("error", groupErr.Error()))
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorServerError,
+				ErrorDescription: "Failed to generate token",
+			}
+		}
+	} else {
+		for _, group := range groups {
+			if group.ID != "" && !slices.Contains(groupIDs, group.ID) {
+				groupIDs = append(groupIDs, group.ID)
+			}
+		}
+	}
+
+	authzResp, svcErr := h.authzService.GetAuthorizedPermissions(ctx, authz.GetAuthorizedPermissionsRequest{
+		EntityID:             entityID,
+		GroupIDs:             groupIDs,
+		RequestedPermissions: scopes,
+	})
+	if svcErr != nil {
+		logger.Error("Failed to get authorized permissions for entity",
+			log.String("error", svcErr.Error.DefaultValue))
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate token",
+		}
+	}
+
+	return authzResp.AuthorizedPermissions, nil
+}
+
+// trustedIssuerJWKS describes the trusted issuer's key source in the form the JWKS resolver accepts.
+func trustedIssuerJWKS(trustedIssuer *config.JWTBearerIssuerConfig) *inboundmodel.Certificate {
+	if trustedIssuer.JWKS != "" {
+		return &inboundmodel.Certificate{Type: certmodel.CertificateTypeJWKS, Value: trustedIssuer.JWKS}
+	}
+	return &inboundmodel.Certificate{Type: certmodel.CertificateTypeJWKSURI, Value: trustedIssuer.JWKSURI}
+}
+
+// hasAcceptedAudience reports whether the aud claim identifies this authorization server, either by
+// its issuer identifier or by its token endpoint URL (RFC 7523 §3).
+func hasAcceptedAudience(aud interface{}) bool {
+	runtimeConfig := config.GetServerRuntime().Config
+	accepted := []string{
+		runtimeConfig.JWT.Issuer,
+		config.GetServerURL(&runtimeConfig.Server) + constants.OAuth2TokenEndpoint,
+	}
+
+	isAccepted := func(value interface{}) bool {
+		s, ok := value.(string)
+		return ok && s != "" && slices.Contains(accepted, s)
+	}
+
+	if values, ok := aud.([]interface{}); ok {
+		return slices.ContainsFunc(values, isAccepted)
+	}
+	return isAccepted(aud)
+}
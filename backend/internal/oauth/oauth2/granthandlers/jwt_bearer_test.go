@@ -0,0 +1,335 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package granthandlers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/authz"
+	"github.com/asgardeo/thunder/internal/entityprovider"
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/jwksresolver"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
+	"github.com/asgardeo/thunder/internal/resource"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/tests/mocks/authzmock"
+	"github.com/asgardeo/thunder/tests/mocks/entityprovidermock"
+	"github.com/asgardeo/thunder/tests/mocks/jose/jwtmock"
+	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/tokenservicemock"
+	"github.com/asgardeo/thunder/tests/mocks/resourcemock"
+)
+
+const (
+	testAssertionIssuer  = "https://idp.example.com"
+	testAssertionSubject = "workload@example.com"
+	testAssertionKID     = "idp-key-1"
+	testServerIssuer     = "https://auth.example.com"
+)
+
+type JWTBearerGrantHandlerTestSuite struct {
+	suite.Suite
+	handler             *jwtBearerGrantHandler
+	mockJWTService      *jwtmock.JWTServiceInterfaceMock
+	mockTokenBuilder    *tokenservicemock.TokenBuilderInterfaceMock
+	mockAuthzService    *authzmock.AuthorizationServiceInterfaceMock
+	mockEntityProv      *entityprovidermock.EntityProviderInterfaceMock
+	mockResourceService *resourcemock.ResourceServiceInterfaceMock
+	oauthApp            *inboundmodel.OAuthClient
+	tokenRequest        *model.TokenRequest
+}
+
+func TestJWTBearerGrantHandlerSuite(t *testing.T) {
+	suite.Run(t, new(JWTBearerGrantHandlerTestSuite))
+}
+
+func (suite *JWTBearerGrantHandlerTestSuite) SetupTest() {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	jwks, err := json.Marshal(map[string]interface{}{"keys": []interface{}{map[string]interface{}{
+		"kty": "RSA",
+		"kid": testAssertionKID,
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(priv.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(priv.E)).Bytes()),
+	}}})
+	suite.Require().NoError(err)
+
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		JWT: config.JWTConfig{
+			Issuer:         testServerIssuer,
+			ValidityPeriod: 3600,
+		},
+	}
+	testConfig.OAuth.JWTBearer.TrustedIssuers = []config.JWTBearerIssuerConfig{
+		{Issuer: testAssertionIssuer, JWKS: string(jwks), SubjectAttribute: "email"},
+	}
+	suite.Require().NoError(config.InitializeServerRuntime("", testConfig))
+
+	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.mockTokenBuilder = tokenservicemock.NewTokenBuilderInterfaceMock(suite.T())
+	suite.mockAuthzService = authzmock.NewAuthorizationServiceInterfaceMock(suite.T())
+	suite.mockEntityProv = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockResourceService = resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	suite.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, mock.Anything).
+		Return(func(_ context.Context, identifier string) *resource.ResourceServer {
+			return &resource.ResourceServer{ID: identifier, Identifier: identifier}
+		}, func(_ context.Context, _ string) *serviceerror.ServiceError {
+			return nil
+		}).Maybe()
+	suite.mockResourceService.On("ValidatePermissions", mock.Anything, mock.Anything, mock.Anything).
+		Return([]string{}, nil).Maybe()
+	suite.mockResourceService.On("FindResourceServersByPermissions", mock.Anything, mock.Anything).
+		Return([]resource.ResourceServer{}, nil).Maybe()
+
+	suite.handler = &jwtBearerGrantHandler{
+		jwtService:      suite.mockJWTService,
+		jwksResolver:    jwksresolver.Initialize(nil),
+		tokenBuilder:    suite.mockTokenBuilder,
+		authzService:    suite.mockAuthzService,
+		entityProv:      suite.mockEntityProv,
+		resourceService: suite.mockResourceService,
+	}
+
+	suite.oauthApp = &inboundmodel.OAuthClient{
+		ID:                      "app-1",
+		ClientID:                testClientID,
+		GrantTypes:              []constants.GrantType{constants.GrantTypeJWTBearer},
+		TokenEndpointAuthMethod: constants.TokenEndpointAuthMethodClientSecretBasic,
+		JWTBearerAllowedIssuers: []string{testAssertionIssuer},
+	}
+
+	suite.tokenRequest = &model.TokenRequest{
+		GrantType: string(constants.GrantTypeJWTBearer),
+		ClientID:  testClientID,
+		Assertion: buildTestAssertion(map[string]interface{}{"kid": testAssertionKID}, nil),
+	}
+}
+
+func (suite *JWTBearerGrantHandlerTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+// buildTestAssertion builds an assertion with default RS256 header and claims, overridden by the given maps.
+// The signature is a placeholder; signature verification is delegated to the mocked JWT service.
+func buildTestAssertion(header, claims map[string]interface{}) string {
+	h := map[string]interface{}{"alg": "RS256", "typ": "JWT"}
+	for k, v := range header {
+		h[k] = v
+	}
+	c := map[string]interface{}{
+		"iss": testAssertionIssuer,
+		"sub": testAssertionSubject,
+		"aud": testServerIssuer,
+		"exp": 9999999999,
+	}
+	for k, v := range claims {
+		c[k] = v
+	}
+	hb, _ := json.Marshal(h)
+	cb, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(hb) + "." + base64.RawURLEncoding.EncodeToString(cb) + ".sig"
+}
+
+func (suite *JWTBearerGrantHandlerTestSuite) expectVerification(assertion string) {
+	suite.mockJWTService.EXPECT().VerifyJWTWithPublicKey(assertion, mock.Anything, "", testAssertionIssuer).
+		Return(nil)
+}
+
+func (suite *JWTBearerGrantHandlerTestSuite) expectIdentifyEntity() {
+	entityID := testUserID
+	suite.mockEntityProv.EXPECT().IdentifyEntity(map[string]interface{}{"email": testAssertionSubject}).
+		Return(&entityID, nil)
+}
+
+func (suite *JWTBearerGrantHandlerTestSuite) TestValidateGrant_Success() {
+	suite.Nil(suite.handler.ValidateGrant(context.Background(), suite.tokenRequest, suite.oauthApp))
+}
+
+func (suite *JWTBearerGrantHandlerTestSuite) TestValidateGrant_InvalidRequests() {
+	testCases := []struct {
+		name          string
+		modify        func(req *model.TokenRequest)
+		expectedError string
+	}{
+		{"WrongGrantType", func(req *model.TokenRequest) { req.GrantType = "client_credentials" },
+			constants.ErrorUnsupportedGrantType},
+		{"MissingAssertion", func(req *model.TokenRequest) { req.Assertion = "" }, constants.ErrorInvalidRequest},
+		{"MissingClientID", func(req *model.TokenRequest) { req.ClientID = "" }, constants.ErrorInvalidClient},
+		{"InvalidResource", func(req *model.TokenRequest) { req.Resources = []string{"relative"} },
+			constants.ErrorInvalidTarget},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			req := *suite.tokenRequest
+			tc.modify(&req)
+
+			errResp := suite.handler.ValidateGrant(context.Background(), &req, suite.oauthApp)
+
+			suite.Require().NotNil(errResp)
+			suite.Equal(tc.expectedError, errResp.Error)
+		})
+	}
+}
+
+func (suite *JWTBearerGrantHandlerTestSuite) TestHandleGrant_Success() {
+	suite.tokenRequest.Scope = "read write"
+	suite.expectVerification(suite.tokenRequest.Assertion)
+	suite.expectIdentifyEntity()
+	suite.mockEntityProv.EXPECT().GetTransitiveEntityGroups(testUserID).
+		Return([]entityprovider.EntityGroup{{ID: "group-1"}}, nil)
+	suite.mockAuthzService.EXPECT().GetAuthorizedPermissions(mock.Anything, authz.GetAuthorizedPermissionsRequest{
+		EntityID:             testUserID,
+		GroupIDs:             []string{"group-1"},
+		RequestedPermissions: []string{"read", "write"},
+	}).Return(&authz.GetAuthorizedPermissionsResponse{AuthorizedPermissions: []string{"read"}}, nil)
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+		return ctx.Subject == testUserID && ctx.ClientID == testClientID &&
+			ctx.GrantType == string(constants.GrantTypeJWTBearer) &&
+			len(ctx.Scopes) == 1 && ctx.Scopes[0] == "read"
+	})).Return(&model.TokenDTO{Token: "test-access-token", Subject: testUserID}, nil)
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenRequest, suite.oauthApp)
+
+	suite.Nil(errResp)
+	suite.Equal("test-access-token", result.AccessToken.Token)
+	suite.Empty(result.IDToken.Token)
+}
+
+func (suite *JWTBearerGrantHandlerTestSuite) TestHandleGrant_TokenEndpointAudience() {
+	suite.tokenRequest.Assertion = buildTestAssertion(map[string]interface{}{"kid": testAssertionKID},
+		map[string]interface{}{"aud": []interface{}{"https://other", "https://localhost:8090/oauth2/token"}})
+	runtime := config.GetServerRuntime()
+	runtime.Config.Server.Hostname = "localhost"
+	runtime.Config.Server.Port = 8090
+	suite.expectVerification(suite.tokenRequest.Assertion)
+	suite.expectIdentifyEntity()
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).
+		Return(&model.TokenDTO{Token: "test-access-token"}, nil)
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenRequest, suite.oauthApp)
+
+	suite.Nil(errResp)
+	suite.Equal("test-access-token", result.AccessToken.Token)
+}
+
+func (suite *JWTBearerGrantHandlerTestSuite) TestHandleGrant_InvalidAssertions() {
+	testCases := []struct {
+		name          string
+		assertion     string
+		verified      bool
+		expectedError string
+	}{
+		{"Malformed", "not-a-jwt", false, constants.ErrorInvalidGrant},
+		{"UntrustedIssuer", buildTestAssertion(nil, map[string]interface{}{"iss": "https://unknown"}),
+			false, constants.ErrorInvalidGrant},
+		{"AlgNone", buildTestAssertion(map[string]interface{}{"alg": "none"}, nil), false,
+			constants.ErrorInvalidGrant},
+		{"UnknownKID", buildTestAssertion(map[string]interface{}{"kid": "other-key"}, nil), false,
+			constants.ErrorInvalidGrant},
+		{"WrongAudience", buildTestAssertion(map[string]interface{}{"kid": testAssertionKID},
+			map[string]interface{}{"aud": "https://other"}), true, constants.ErrorInvalidGrant},
+		{"MissingSubject", buildTestAssertion(map[string]interface{}{"kid": testAssertionKID},
+			map[string]interface{}{"sub": ""}), true, constants.ErrorInvalidGrant},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			if tc.verified {
+				suite.expectVerification(tc.assertion)
+			}
+			req := *suite.tokenRequest
+			req.Assertion = tc.assertion
+
+			result, errResp := suite.handler.HandleGrant(context.Background(), &req, suite.oauthApp)
+
+			suite.Nil(result)
+			suite.Require().NotNil(errResp)
+			suite.Equal(tc.expectedError, errResp.Error)
+		})
+	}
+}
+
+func (suite *JWTBearerGrantHandlerTestSuite) TestHandleGrant_IssuerNotAllowedForClient() {
+	suite.oauthApp.JWTBearerAllowedIssuers = nil
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenRequest, suite.oauthApp)
+
+	suite.Nil(result)
+	suite.Equal(constants.ErrorInvalidGrant, errResp.Error)
+}
+
+func (suite *JWTBearerGrantHandlerTestSuite) TestHandleGrant_SignatureVerificationFails() {
+	suite.mockJWTService.EXPECT().VerifyJWTWithPublicKey(suite.tokenRequest.Assertion, mock.Anything, "",
+		testAssertionIssuer).Return(&jwt.ErrorInvalidTokenSignature)
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenRequest, suite.oauthApp)
+
+	suite.Nil(result)
+	suite.Equal(constants.ErrorInvalidGrant, errResp.Error)
+}
+
+func (suite *JWTBearerGrantHandlerTestSuite) TestHandleGrant_SubjectNotFound() {
+	suite.expectVerification(suite.tokenRequest.Assertion)
+	suite.mockEntityProv.EXPECT().IdentifyEntity(mock.Anything).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "not found", ""))
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenRequest, suite.oauthApp)
+
+	suite.Nil(result)
+	suite.Equal(constants.ErrorInvalidGrant, errResp.Error)
+}
+
+func (suite *JWTBearerGrantHandlerTestSuite) TestHandleGrant_IdentifyEntityError() {
+	suite.expectVerification(suite.tokenRequest.Assertion)
+	suite.mockEntityProv.EXPECT().IdentifyEntity(mock.Anything).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeSystemError, "error", ""))
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenRequest, suite.oauthApp)
+
+	suite.Nil(result)
+	suite.Equal(constants.ErrorServerError, errResp.Error)
+}
+
+func (suite *JWTBearerGrantHandlerTestSuite) TestHandleGrant_AccessTokenError() {
+	suite.expectVerification(suite.tokenRequest.Assertion)
+	suite.expectIdentifyEntity()
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).Return(nil, errors.New("jwt generation failed"))
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenRequest, suite.oauthApp)
+
+	suite.Nil(result)
+	suite.Equal(constants.ErrorServerError, errResp.Error)
+}
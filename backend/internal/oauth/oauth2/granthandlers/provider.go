@@ -23,7 +23,12 @@ import (
 	rbacauthz "github.com/asgardeo/thunder/internal/authz"
 	"github.com/asgardeo/thunder/internal/entityprovider"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/authz"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/ciba"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/device"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/jwksresolver"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/revocation"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokengrant"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
 	"github.com/asgardeo/thunder/internal/ou"
 	"github.com/asgardeo/thunder/internal/resource"
@@ -41,6 +46,9 @@ type GrantHandlerProvider struct {
 	authorizationCodeGrantHandler GrantHandlerInterface
 	refreshTokenGrantHandler      GrantHandlerInterface
 	tokenExchangeGrantHandler     GrantHandlerInterface
+	deviceCodeGrantHandler        GrantHandlerInterface
+	cibaGrantHandler              GrantHandlerInterface
+	jwtBearerGrantHandler         GrantHandlerInterface
 }
 
 // newGrantHandlerProvider creates a new instance of GrantHandlerProvider.
@@ -54,6 +62,11 @@ func newGrantHandlerProvider(
 	rbacAuthzService rbacauthz.AuthorizationServiceInterface,
 	entityProv entityprovider.EntityProviderInterface,
 	resourceService resource.ResourceServiceInterface,
+	revocationService revocation.TokenRevocationServiceInterface,
+	grantService tokengrant.TokenGrantServiceInterface,
+	deviceService device.DeviceAuthorizationServiceInterface,
+	cibaService ciba.BackchannelAuthServiceInterface,
+	jwksResolver *jwksresolver.Resolver,
 ) GrantHandlerProviderInterface {
 	return &GrantHandlerProvider{
 		clientCredentialsGrantHandler: newClientCredentialsGrantHandler(
@@ -61,9 +74,16 @@ func newGrantHandlerProvider(
 		authorizationCodeGrantHandler: newAuthorizationCodeGrantHandler(
 			authzService, tokenBuilder, attrCacheService, resourceService),
 		refreshTokenGrantHandler: newRefreshTokenGrantHandler(
-			jwtService, tokenBuilder, tokenValidator, attrCacheService, resourceService),
+			jwtService, tokenBuilder, tokenValidator, attrCacheService, resourceService, revocationService,
+			grantService),
 		tokenExchangeGrantHandler: newTokenExchangeGrantHandler(
 			tokenBuilder, tokenValidator, resourceService),
+		deviceCodeGrantHandler: newDeviceCodeGrantHandler(
+			deviceService, tokenBuilder, attrCacheService, resourceService),
+		cibaGrantHandler: newCIBAGrantHandler(
+			cibaService, tokenBuilder, attrCacheService, resourceService),
+		jwtBearerGrantHandler: newJWTBearerGrantHandler(
+			jwtService, jwksResolver, tokenBuilder, rbacAuthzService, entityProv, resourceService),
 	}
 }
 
@@ -78,6 +98,12 @@ func (p *GrantHandlerProvider) GetGrantHandler(grantType constants.GrantType) (G
 		return p.refreshTokenGrantHandler, nil
 	case constants.GrantTypeTokenExchange:
 		return p.tokenExchangeGrantHandler, nil
+	case constants.GrantTypeDeviceCode:
+		return p.deviceCodeGrantHandler, nil
+	case constants.GrantTypeCIBA:
+		return p.cibaGrantHandler, nil
+	case constants.GrantTypeJWTBearer:
+		return p.jwtBearerGrantHandler, nil
 	default:
 		return nil, constants.UnSupportedGrantTypeError
 	}
@@ -30,6 +30,9 @@ import (
 	"github.com/asgardeo/thunder/tests/mocks/entityprovidermock"
 	"github.com/asgardeo/thunder/tests/mocks/jose/jwtmock"
 	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/authzmock"
+	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/cibamock"
+	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/devicemock"
+	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/revocationmock"
 	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/tokenservicemock"
 	"github.com/asgardeo/thunder/tests/mocks/oumock"
 	"github.com/asgardeo/thunder/tests/mocks/resourcemock"
@@ -37,16 +40,19 @@ import (
 
 type GrantHandlerProviderTestSuite struct {
 	suite.Suite
-	provider             GrantHandlerProviderInterface
-	mockJWTService       *jwtmock.JWTServiceInterfaceMock
-	authzService         *authzmock.AuthorizeServiceInterfaceMock
-	mockTokenBuilder     *tokenservicemock.TokenBuilderInterfaceMock
-	mockTokenValidator   *tokenservicemock.TokenValidatorInterfaceMock
-	mockAttrCacheService *attributecachemock.AttributeCacheServiceInterfaceMock
-	mockOUService        *oumock.OrganizationUnitServiceInterfaceMock
-	mockRBACAuthzService *rbacauthzmock.AuthorizationServiceInterfaceMock
-	mockEntityProvider   *entityprovidermock.EntityProviderInterfaceMock
-	mockResourceService  *resourcemock.ResourceServiceInterfaceMock
+	provider              GrantHandlerProviderInterface
+	mockJWTService        *jwtmock.JWTServiceInterfaceMock
+	authzService          *authzmock.AuthorizeServiceInterfaceMock
+	mockTokenBuilder      *tokenservicemock.TokenBuilderInterfaceMock
+	mockTokenValidator    *tokenservicemock.TokenValidatorInterfaceMock
+	mockAttrCacheService  *attributecachemock.AttributeCacheServiceInterfaceMock
+	mockOUService         *oumock.OrganizationUnitServiceInterfaceMock
+	mockRBACAuthzService  *rbacauthzmock.AuthorizationServiceInterfaceMock
+	mockEntityProvider    *entityprovidermock.EntityProviderInterfaceMock
+	mockResourceService   *resourcemock.ResourceServiceInterfaceMock
+	mockRevocationService *revocationmock.TokenRevocationServiceInterfaceMock
+	mockDeviceService     *devicemock.DeviceAuthorizationServiceInterfaceMock
+	mockCIBAService       *cibamock.BackchannelAuthServiceInterfaceMock
 }
 
 func TestGrantHandlerProviderSuite(t *testing.T) {
@@ -63,6 +69,9 @@ func (suite *GrantHandlerProviderTestSuite) SetupTest() {
 	suite.mockRBACAuthzService = rbacauthzmock.NewAuthorizationServiceInterfaceMock(suite.T())
 	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
 	suite.mockResourceService = resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	suite.mockRevocationService = revocationmock.NewTokenRevocationServiceInterfaceMock(suite.T())
+	suite.mockDeviceService = devicemock.NewDeviceAuthorizationServiceInterfaceMock(suite.T())
+	suite.mockCIBAService = cibamock.NewBackchannelAuthServiceInterfaceMock(suite.T())
 	suite.provider = newGrantHandlerProvider(
 		suite.mockJWTService,
 		suite.authzService,
@@ -73,6 +82,11 @@ func (suite *GrantHandlerProviderTestSuite) SetupTest() {
 		suite.mockRBACAuthzService,
 		suite.mockEntityProvider,
 		suite.mockResourceService,
+		suite.mockRevocationService,
+		nil,
+		suite.mockDeviceService,
+		suite.mockCIBAService,
+		nil,
 	)
 }
 
@@ -87,6 +101,11 @@ func (suite *GrantHandlerProviderTestSuite) TestNewGrantHandlerProvider() {
 		suite.mockRBACAuthzService,
 		suite.mockEntityProvider,
 		suite.mockResourceService,
+		suite.mockRevocationService,
+		nil,
+		suite.mockDeviceService,
+		suite.mockCIBAService,
+		nil,
 	)
 	assert.NotNil(suite.T(), provider)
 	assert.Implements(suite.T(), (*GrantHandlerProviderInterface)(nil), provider)
@@ -142,6 +161,9 @@ func (suite *GrantHandlerProviderTestSuite) TestGetGrantHandler_AllSupportedType
 		constants.GrantTypeClientCredentials,
 		constants.GrantTypeAuthorizationCode,
 		constants.GrantTypeRefreshToken,
+		constants.GrantTypeDeviceCode,
+		constants.GrantTypeCIBA,
+		constants.GrantTypeJWTBearer,
 	}
 
 	for _, grantType := range supportedTypes {
@@ -20,15 +20,20 @@ package granthandlers
 
 import (
 	"context"
+	"crypto/subtle"
 	"slices"
 	"strings"
 	"time"
 
 	"github.com/asgardeo/thunder/internal/attributecache"
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/authorizationdetails"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/dpop"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/resourceindicators"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/revocation"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokengrant"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
 	oauth2utils "github.com/asgardeo/thunder/internal/oauth/oauth2/utils"
 	"github.com/asgardeo/thunder/internal/resource"
@@ -36,15 +41,18 @@ import (
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	"github.com/asgardeo/thunder/internal/system/jose/jwt"
 	"github.com/asgardeo/thunder/internal/system/log"
+	sysutils "github.com/asgardeo/thunder/internal/system/utils"
 )
 
 // refreshTokenGrantHandler handles the refresh token grant type.
 type refreshTokenGrantHandler struct {
-	jwtService       jwt.JWTServiceInterface
-	tokenBuilder     tokenservice.TokenBuilderInterface
-	tokenValidator   tokenservice.TokenValidatorInterface
-	attrCacheService attributecache.AttributeCacheServiceInterface
-	resourceService  resource.ResourceServiceInterface
+	jwtService        jwt.JWTServiceInterface
+	tokenBuilder      tokenservice.TokenBuilderInterface
+	tokenValidator    tokenservice.TokenValidatorInterface
+	attrCacheService  attributecache.AttributeCacheServiceInterface
+	resourceService   resource.ResourceServiceInterface
+	revocationService revocation.TokenRevocationServiceInterface
+	grantService      tokengrant.TokenGrantServiceInterface
 }
 
 // newRefreshTokenGrantHandler creates a new instance of RefreshTokenGrantHandler.
@@ -54,13 +62,17 @@ func newRefreshTokenGrantHandler(
 	tokenValidator tokenservice.TokenValidatorInterface,
 	attrCacheService attributecache.AttributeCacheServiceInterface,
 	resourceService resource.ResourceServiceInterface,
+	revocationService revocation.TokenRevocationServiceInterface,
+	grantService tokengrant.TokenGrantServiceInterface,
 ) RefreshTokenGrantHandlerInterface {
 	return &refreshTokenGrantHandler{
-		jwtService:       jwtService,
-		tokenBuilder:     tokenBuilder,
-		tokenValidator:   tokenValidator,
-		attrCacheService: attrCacheService,
-		resourceService:  resourceService,
+		jwtService:        jwtService,
+		tokenBuilder:      tokenBuilder,
+		tokenValidator:    tokenValidator,
+		attrCacheService:  attrCacheService,
+		resourceService:   resourceService,
+		revocationService: revocationService,
+		grantService:      grantService,
 	}
 }
 
@@ -109,11 +121,48 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 		}
 	}
 
+	if errResp := h.checkRevocation(ctx, refreshTokenClaims.JTI, logger); errResp != nil {
+		return nil, errResp
+	}
+
+	// A refresh token bound to a DPoP key may only be redeemed with a proof signed by that key (RFC 9449 §5).
+	if refreshTokenClaims.ProofKeyThumbprint != "" &&
+		subtle.ConstantTimeCompare([]byte(refreshTokenClaims.ProofKeyThumbprint),
+			[]byte(dpop.GetProofKeyThumbprint(ctx))) != 1 {
+		logger.Debug("DPoP proof key does not match the refresh token binding")
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidDPoPProof,
+			ErrorDescription: "DPoP proof key does not match the refresh token binding",
+		}
+	}
+
+	// Refresh tokens carrying a grant ID belong to a persisted family and must be its current token.
+	// Tokens issued before families were introduced carry no grant ID and are accepted as is.
+	var grant *tokengrant.Grant
+	if refreshTokenClaims.GrantID != "" {
+		var errResp *model.ErrorResponse
+		grant, errResp = h.validateGrant(ctx, refreshTokenClaims, tokenRequest.ClientID, logger)
+		if errResp != nil {
+			return nil, errResp
+		}
+	}
+
 	newTokenScopes, scopeErr := h.validateAndApplyScopes(tokenRequest.Scope, refreshTokenClaims.Scopes, logger)
 	if scopeErr != nil {
 		return nil, scopeErr
 	}
 
+	// Per RFC 9396 §6.2, the refresh request MAY narrow the authorization details of the grant.
+	requestedDetails, detailsErr := authorizationdetails.Parse(tokenRequest.AuthorizationDetails)
+	if detailsErr != nil {
+		return nil, detailsErr
+	}
+	authorizationDetails, detailsErr := authorizationdetails.Narrow(
+		requestedDetails, refreshTokenClaims.AuthorizationDetails)
+	if detailsErr != nil {
+		return nil, detailsErr
+	}
+
 	// Compute narrowed audiences per RFC 8707 §2.1. When the client supplies resource parameters,
 	// narrow the audience to the intersection with the original refresh-token audiences.
 	// An empty intersection is a client error (invalid_target).
@@ -181,17 +230,18 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 	}
 
 	accessToken, err := h.tokenBuilder.BuildAccessToken(&tokenservice.AccessTokenBuildContext{
-		Context:          ctx,
-		Subject:          refreshTokenClaims.Sub,
-		Audiences:        audiences,
-		ClientID:         tokenRequest.ClientID,
-		Scopes:           newTokenScopes,
-		UserAttributes:   attrs,
-		AttributeCacheID: refreshTokenClaims.AttributeCacheID,
-		GrantType:        refreshTokenClaims.GrantType,
-		OAuthApp:         oauthApp,
-		ClaimsRequest:    refreshTokenClaims.ClaimsRequest,
-		ClaimsLocales:    refreshTokenClaims.ClaimsLocales,
+		Context:              ctx,
+		Subject:              refreshTokenClaims.Sub,
+		Audiences:            audiences,
+		ClientID:             tokenRequest.ClientID,
+		Scopes:               newTokenScopes,
+		UserAttributes:       attrs,
+		AttributeCacheID:     refreshTokenClaims.AttributeCacheID,
+		GrantType:            refreshTokenClaims.GrantType,
+		OAuthApp:             oauthApp,
+		ClaimsRequest:        refreshTokenClaims.ClaimsRequest,
+		ClaimsLocales:        refreshTokenClaims.ClaimsLocales,
+		AuthorizationDetails: authorizationDetails,
 	})
 	if err != nil {
 		logger.Error("Failed to generate access token", log.Error(err))
@@ -214,6 +264,8 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 			Audience:       tokenRequest.ClientID,
 			Scopes:         newTokenScopes,
 			UserAttributes: attrs,
+			AuthTime:       refreshTokenClaims.AuthTime,
+			SID:            refreshTokenClaims.SID,
 			OAuthApp:       oauthApp,
 			ClaimsRequest:  refreshTokenClaims.ClaimsRequest,
 		})
@@ -232,14 +284,21 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 	renewRefreshToken := conf.OAuth.RefreshToken.RenewOnGrant
 
 	// Issue a new refresh token if renew_on_grant is enabled; otherwise reuse the existing one.
+	// The new token replaces the redeemed one within its family, so the redeemed token cannot be used again.
 	// RFC 8707 §5: the refresh token preserves the full original audience, not the narrowed one.
 	if renewRefreshToken {
 		logger.Debug("Renewing refresh token", log.String("client_id", tokenRequest.ClientID))
-		errResp := h.IssueRefreshToken(ctx, tokenResponse, oauthApp,
-			refreshTokenClaims.Sub, refreshTokenClaims.Audiences,
-			refreshTokenClaims.GrantType, newTokenScopes,
-			refreshTokenClaims.ClaimsRequest, refreshTokenClaims.ClaimsLocales,
-			refreshTokenClaims.AttributeCacheID)
+		var errResp *model.ErrorResponse
+		if grant != nil {
+			errResp = h.rotateRefreshToken(ctx, tokenResponse, oauthApp, grant, refreshTokenClaims,
+				newTokenScopes, logger)
+		} else {
+			errResp = h.IssueRefreshToken(ctx, tokenResponse, oauthApp,
+				refreshTokenClaims.Sub, refreshTokenClaims.Audiences,
+				refreshTokenClaims.GrantType, newTokenScopes,
+				refreshTokenClaims.ClaimsRequest, refreshTokenClaims.ClaimsLocales,
+				refreshTokenClaims.AttributeCacheID)
+		}
 		if errResp != nil && errResp.Error != "" {
 			logger.Error("Failed to issue refresh token", log.String("error", errResp.Error))
 			return nil, errResp
@@ -261,7 +320,138 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 	return tokenResponse, nil
 }
 
-// IssueRefreshToken generates a new refresh token for the given OAuth application and scopes.
+// checkRevocation rejects refresh tokens that have been revoked through the revocation endpoint.
+func (h *refreshTokenGrantHandler) checkRevocation(
+	ctx context.Context, jti string, logger *log.Logger,
+) *model.ErrorResponse {
+	if jti == "" {
+		return nil
+	}
+	revoked, err := h.revocationService.IsTokenRevoked(ctx, jti)
+	if err != nil {
+		logger.Error("Failed to check refresh token revocation status", log.Error(err))
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to validate refresh token",
+		}
+	}
+	if revoked {
+		logger.Debug("Refresh token has been revoked", log.String("jti", jti))
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Invalid refresh token",
+		}
+	}
+	return nil
+}
+
+// validateGrant ensures the redeemed refresh token is the current token of an active grant issued to the
+// requesting client. Redeeming a token that has already been rotated revokes the grant.
+func (h *refreshTokenGrantHandler) validateGrant(
+	ctx context.Context, claims *tokenservice.RefreshTokenClaims, clientID string, logger *log.Logger,
+) (*tokengrant.Grant, *model.ErrorResponse) {
+	grant, svcErr := h.grantService.ValidateGrantToken(ctx, claims.GrantID, claims.JTI)
+	if svcErr != nil {
+		if svcErr.Type == serviceerror.ClientErrorType {
+			logger.Debug("Refresh token grant is not active or the token has been reused",
+				log.String("error", svcErr.Error.DefaultValue))
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorInvalidGrant,
+				ErrorDescription: "Invalid refresh token",
+			}
+		}
+		logger.Error("Failed to validate refresh token grant",
+			log.String("error", svcErr.ErrorDescription.DefaultValue))
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to validate refresh token",
+		}
+	}
+	if grant.ClientID != clientID {
+		logger.Debug("Refresh token grant does not belong to the requesting client")
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Invalid refresh token",
+		}
+	}
+	return grant, nil
+}
+
+// rotateRefreshToken issues the next refresh token of a grant and makes it the grant's current token.
+// The new token never outlives the grant's absolute lifetime.
+func (h *refreshTokenGrantHandler) rotateRefreshToken(
+	ctx context.Context,
+	tokenResponse *model.TokenResponseDTO,
+	oauthApp *inboundmodel.OAuthClient,
+	grant *tokengrant.Grant,
+	claims *tokenservice.RefreshTokenClaims,
+	scopes []string,
+	logger *log.Logger,
+) *model.ErrorResponse {
+	now := time.Now()
+	validity := resolveRefreshTokenValidity(oauthApp, now, grant.AbsoluteExpiryTime)
+	if validity <= 0 {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Invalid refresh token",
+		}
+	}
+
+	tokenID, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		logger.Error("Failed to generate refresh token ID", log.Error(err))
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate refresh token",
+		}
+	}
+
+	refreshToken, err := h.tokenBuilder.BuildRefreshToken(&tokenservice.RefreshTokenBuildContext{
+		Context:              ctx,
+		ClientID:             oauthApp.ClientID,
+		Scopes:               scopes,
+		GrantType:            claims.GrantType,
+		AccessTokenSubject:   claims.Sub,
+		AccessTokenAudiences: claims.Audiences,
+		AttributeCacheID:     claims.AttributeCacheID,
+		OAuthApp:             oauthApp,
+		ClaimsRequest:        claims.ClaimsRequest,
+		ClaimsLocales:        claims.ClaimsLocales,
+		AuthorizationDetails: tokenResponse.AccessToken.AuthorizationDetails,
+		AuthTime:             claims.AuthTime,
+		SID:                  claims.SID,
+		GrantID:              grant.ID,
+		TokenID:              tokenID,
+		ValidityPeriod:       validity,
+	})
+	if err != nil {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate refresh token",
+		}
+	}
+
+	expiryTime := now.Add(time.Duration(validity) * time.Second)
+	if svcErr := h.grantService.RotateGrantToken(ctx, grant, tokenID, expiryTime); svcErr != nil {
+		if svcErr.Type == serviceerror.ClientErrorType {
+			logger.Debug("Refresh token was redeemed concurrently, grant revoked")
+			return &model.ErrorResponse{
+				Error:            constants.ErrorInvalidGrant,
+				ErrorDescription: "Invalid refresh token",
+			}
+		}
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate refresh token",
+		}
+	}
+
+	tokenResponse.RefreshToken = *refreshToken
+	return nil
+}
+
+// IssueRefreshToken generates a new refresh token for the given OAuth application and scopes, starting a
+// new grant that tracks the token.
 func (h *refreshTokenGrantHandler) IssueRefreshToken(
 	ctx context.Context,
 	tokenResponse *model.TokenResponseDTO,
@@ -272,6 +462,34 @@ func (h *refreshTokenGrantHandler) IssueRefreshToken(
 	claimsLocales string,
 	attributeCacheID string,
 ) *model.ErrorResponse {
+	grantID, grantIDErr := sysutils.GenerateUUIDv7()
+	tokenID, tokenIDErr := sysutils.GenerateUUIDv7()
+	if grantIDErr != nil || tokenIDErr != nil {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate refresh token",
+		}
+	}
+
+	now := time.Now()
+	var absoluteExpiryTime time.Time
+	if absolute := tokenservice.ResolveTokenConfig(oauthApp, tokenservice.TokenTypeRefresh).
+		AbsoluteValidityPeriod; absolute > 0 {
+		absoluteExpiryTime = now.Add(time.Duration(absolute) * time.Second)
+	}
+	validity := resolveRefreshTokenValidity(oauthApp, now, absoluteExpiryTime)
+
+	// The refresh token carries the authorization details bound to the access token issued alongside it,
+	// and the authentication time and session of the ID token, if any.
+	var authorizationDetails []model.AuthorizationDetail
+	var authTime int64
+	var sid string
+	if tokenResponse != nil {
+		authorizationDetails = tokenResponse.AccessToken.AuthorizationDetails
+		authTime = tokenResponse.IDToken.AuthTime
+		sid = tokenResponse.IDToken.SID
+	}
+
 	tokenCtx := &tokenservice.RefreshTokenBuildContext{
 		Context:              ctx,
 		ClientID:             oauthApp.ClientID,
@@ -283,6 +501,12 @@ func (h *refreshTokenGrantHandler) IssueRefreshToken(
 		OAuthApp:             oauthApp,
 		ClaimsRequest:        claimsRequest,
 		ClaimsLocales:        claimsLocales,
+		AuthorizationDetails: authorizationDetails,
+		AuthTime:             authTime,
+		SID:                  sid,
+		GrantID:              grantID,
+		TokenID:              tokenID,
+		ValidityPeriod:       validity,
 	}
 
 	// Build refresh token using token builder
@@ -294,6 +518,22 @@ func (h *refreshTokenGrantHandler) IssueRefreshToken(
 		}
 	}
 
+	if _, svcErr := h.grantService.CreateGrant(ctx, tokengrant.Grant{
+		ID:                 grantID,
+		ClientID:           oauthApp.ClientID,
+		UserID:             subject,
+		GrantType:          grantType,
+		Scopes:             scopes,
+		CurrentTokenID:     tokenID,
+		ExpiryTime:         now.Add(time.Duration(validity) * time.Second),
+		AbsoluteExpiryTime: absoluteExpiryTime,
+	}); svcErr != nil {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate refresh token",
+		}
+	}
+
 	if tokenResponse == nil {
 		tokenResponse = &model.TokenResponseDTO{}
 	}
@@ -301,6 +541,20 @@ func (h *refreshTokenGrantHandler) IssueRefreshToken(
 	return nil
 }
 
+// resolveRefreshTokenValidity returns the validity period of a refresh token issued at the given time: the
+// idle validity period, capped by the remaining absolute lifetime of the grant when it has one.
+func resolveRefreshTokenValidity(
+	oauthApp *inboundmodel.OAuthClient, now time.Time, absoluteExpiryTime time.Time,
+) int64 {
+	validity := tokenservice.ResolveTokenConfig(oauthApp, tokenservice.TokenTypeRefresh).ValidityPeriod
+	if !absoluteExpiryTime.IsZero() {
+		if remaining := int64(absoluteExpiryTime.Sub(now) / time.Second); remaining < validity {
+			validity = remaining
+		}
+	}
+	return validity
+}
+
 // extendCacheTTL extends the attribute cache TTL when the desired lifetime exceeds what is already
 // stored. The desired TTL is the larger of:
 //   - the refresh token's actual expiry (iat + validity; for a renewed token, iat = now)
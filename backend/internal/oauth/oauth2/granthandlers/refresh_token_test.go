@@ -31,7 +31,9 @@ import (
 	"github.com/asgardeo/thunder/internal/attributecache"
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/dpop"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokengrant"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
 	"github.com/asgardeo/thunder/internal/resource"
 	"github.com/asgardeo/thunder/internal/system/config"
@@ -40,6 +42,8 @@ import (
 	"github.com/asgardeo/thunder/internal/system/log"
 	"github.com/asgardeo/thunder/tests/mocks/attributecachemock"
 	"github.com/asgardeo/thunder/tests/mocks/jose/jwtmock"
+	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/revocationmock"
+	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/tokengrantmock"
 	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/tokenservicemock"
 	"github.com/asgardeo/thunder/tests/mocks/resourcemock"
 )
@@ -53,16 +57,18 @@ const testRS02URI = "https://rs02.example.com"
 
 type RefreshTokenGrantHandlerTestSuite struct {
 	suite.Suite
-	handler              *refreshTokenGrantHandler
-	mockJWTService       *jwtmock.JWTServiceInterfaceMock
-	mockTokenBuilder     *tokenservicemock.TokenBuilderInterfaceMock
-	mockTokenValidator   *tokenservicemock.TokenValidatorInterfaceMock
-	mockAttrCacheService *attributecachemock.AttributeCacheServiceInterfaceMock
-	mockResourceService  *resourcemock.ResourceServiceInterfaceMock
-	oauthApp             *inboundmodel.OAuthClient
-	validRefreshToken    string
-	validClaims          map[string]interface{}
-	testTokenReq         *model.TokenRequest
+	handler               *refreshTokenGrantHandler
+	mockJWTService        *jwtmock.JWTServiceInterfaceMock
+	mockTokenBuilder      *tokenservicemock.TokenBuilderInterfaceMock
+	mockTokenValidator    *tokenservicemock.TokenValidatorInterfaceMock
+	mockAttrCacheService  *attributecachemock.AttributeCacheServiceInterfaceMock
+	mockResourceService   *resourcemock.ResourceServiceInterfaceMock
+	mockRevocationService *revocationmock.TokenRevocationServiceInterfaceMock
+	mockGrantService      *tokengrantmock.TokenGrantServiceInterfaceMock
+	oauthApp              *inboundmodel.OAuthClient
+	validRefreshToken     string
+	validClaims           map[string]interface{}
+	testTokenReq          *model.TokenRequest
 }
 
 func TestRefreshTokenGrantHandlerSuite(t *testing.T) {
@@ -92,6 +98,10 @@ func (suite *RefreshTokenGrantHandlerTestSuite) SetupTest() {
 	suite.mockTokenValidator = tokenservicemock.NewTokenValidatorInterfaceMock(suite.T())
 	suite.mockAttrCacheService = attributecachemock.NewAttributeCacheServiceInterfaceMock(suite.T())
 	suite.mockResourceService = resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	suite.mockRevocationService = revocationmock.NewTokenRevocationServiceInterfaceMock(suite.T())
+	suite.mockGrantService = tokengrantmock.NewTokenGrantServiceInterfaceMock(suite.T())
+	suite.mockGrantService.On("CreateGrant", mock.Anything, mock.Anything).
+		Return(&tokengrant.Grant{}, nil).Maybe()
 
 	suite.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, mock.Anything).
 		Return(func(_ context.Context, identifier string) *resource.ResourceServer {
@@ -103,11 +113,13 @@ func (suite *RefreshTokenGrantHandlerTestSuite) SetupTest() {
 		Return([]string{}, nil).Maybe()
 
 	suite.handler = &refreshTokenGrantHandler{
-		jwtService:       suite.mockJWTService,
-		tokenBuilder:     suite.mockTokenBuilder,
-		tokenValidator:   suite.mockTokenValidator,
-		attrCacheService: suite.mockAttrCacheService,
-		resourceService:  suite.mockResourceService,
+		jwtService:        suite.mockJWTService,
+		tokenBuilder:      suite.mockTokenBuilder,
+		tokenValidator:    suite.mockTokenValidator,
+		attrCacheService:  suite.mockAttrCacheService,
+		resourceService:   suite.mockResourceService,
+		revocationService: suite.mockRevocationService,
+		grantService:      suite.mockGrantService,
 	}
 
 	suite.oauthApp = &inboundmodel.OAuthClient{
@@ -152,6 +164,8 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestNewRefreshTokenGrantHandler(
 		suite.mockTokenValidator,
 		suite.mockAttrCacheService,
 		suite.mockResourceService,
+		suite.mockRevocationService,
+		suite.mockGrantService,
 	)
 	assert.NotNil(suite.T(), handler)
 	assert.Implements(suite.T(), (*RefreshTokenGrantHandlerInterface)(nil), handler)
@@ -245,6 +259,50 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_Success()
 	assert.Equal(suite.T(), testRefreshTokenClientID, tokenResponse.RefreshToken.ClientID)
 }
 
+func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_CreatesGrant() {
+	config.GetServerRuntime().Config.OAuth.RefreshToken.AbsoluteValidityPeriod = 600
+
+	var tokenID string
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.MatchedBy(
+		func(ctx *tokenservice.RefreshTokenBuildContext) bool {
+			tokenID = ctx.TokenID
+			return ctx.GrantID != "" && ctx.TokenID != "" && ctx.ValidityPeriod == 600
+		})).Return(&model.TokenDTO{Token: "new.refresh.token", ExpiresIn: 600}, nil)
+
+	grantService := tokengrantmock.NewTokenGrantServiceInterfaceMock(suite.T())
+	grantService.On("CreateGrant", mock.Anything, mock.MatchedBy(func(grant tokengrant.Grant) bool {
+		return grant.ClientID == testRefreshTokenClientID && grant.UserID == testRefreshTokenUserID &&
+			grant.CurrentTokenID == tokenID && grant.GrantType == "authorization_code" &&
+			!grant.AbsoluteExpiryTime.IsZero() && !grant.ExpiryTime.After(grant.AbsoluteExpiryTime)
+	})).Return(&tokengrant.Grant{}, nil)
+	suite.handler.grantService = grantService
+
+	tokenResponse := &model.TokenResponseDTO{}
+	err := suite.handler.IssueRefreshToken(context.Background(), tokenResponse, suite.oauthApp,
+		testRefreshTokenUserID, []string{testRefreshTokenAudience},
+		"authorization_code", []string{"read"}, nil, "", "")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "new.refresh.token", tokenResponse.RefreshToken.Token)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_CreateGrantError() {
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.Anything).
+		Return(&model.TokenDTO{Token: "new.refresh.token"}, nil)
+
+	grantService := tokengrantmock.NewTokenGrantServiceInterfaceMock(suite.T())
+	grantService.On("CreateGrant", mock.Anything, mock.Anything).
+		Return(nil, &serviceerror.InternalServerError)
+	suite.handler.grantService = grantService
+
+	err := suite.handler.IssueRefreshToken(context.Background(), &model.TokenResponseDTO{}, suite.oauthApp,
+		testRefreshTokenUserID, nil, "authorization_code", []string{"read"}, nil, "", "")
+
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorServerError, err.Error)
+	assert.Equal(suite.T(), "Failed to generate refresh token", err.ErrorDescription)
+}
+
 func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_JWTGenerationError() {
 	// Mock token builder to return error
 	suite.mockTokenBuilder.On("BuildRefreshToken", mock.Anything).
@@ -473,6 +531,115 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_IssueRefreshToke
 	assert.Equal(suite.T(), "Failed to generate refresh token", err.ErrorDescription)
 }
 
+func (suite *RefreshTokenGrantHandlerTestSuite) mockGrantFamilyClaims() *tokenservice.RefreshTokenClaims {
+	claims := &tokenservice.RefreshTokenClaims{
+		JTI:       "token-1",
+		GrantID:   "grant-1",
+		Sub:       testRefreshTokenUserID,
+		Audiences: []string{testRefreshTokenAudience},
+		Scopes:    []string{"read"},
+		GrantType: "authorization_code",
+		Iat:       time.Now().Unix(),
+	}
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(claims, nil)
+	suite.mockRevocationService.On("IsTokenRevoked", mock.Anything, "token-1").Return(false, nil)
+	return claims
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_GrantTokenReused() {
+	suite.mockGrantFamilyClaims()
+	suite.mockGrantService.On("ValidateGrantToken", mock.Anything, "grant-1", "token-1").
+		Return(nil, &tokengrant.ErrorRefreshTokenReused)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, err.Error)
+	assert.Equal(suite.T(), "Invalid refresh token", err.ErrorDescription)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_GrantValidationServerError() {
+	suite.mockGrantFamilyClaims()
+	suite.mockGrantService.On("ValidateGrantToken", mock.Anything, "grant-1", "token-1").
+		Return(nil, &serviceerror.InternalServerError)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorServerError, err.Error)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_GrantClientMismatch() {
+	suite.mockGrantFamilyClaims()
+	suite.mockGrantService.On("ValidateGrantToken", mock.Anything, "grant-1", "token-1").
+		Return(&tokengrant.Grant{ID: "grant-1", ClientID: "other-client", CurrentTokenID: "token-1"}, nil)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, err.Error)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_RotatesGrantToken() {
+	config.GetServerRuntime().Config.OAuth.RefreshToken.RenewOnGrant = true
+	suite.mockGrantFamilyClaims()
+
+	absoluteExpiry := time.Now().Add(10 * time.Minute)
+	grant := &tokengrant.Grant{
+		ID: "grant-1", ClientID: testRefreshTokenClientID, CurrentTokenID: "token-1",
+		AbsoluteExpiryTime: absoluteExpiry,
+	}
+	suite.mockGrantService.On("ValidateGrantToken", mock.Anything, "grant-1", "token-1").Return(grant, nil)
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).Return(&model.TokenDTO{
+		Token: "new.access.token", IssuedAt: time.Now().Unix(), ExpiresIn: 3600, Scopes: []string{"read"},
+	}, nil)
+
+	var newTokenID string
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.MatchedBy(
+		func(ctx *tokenservice.RefreshTokenBuildContext) bool {
+			newTokenID = ctx.TokenID
+			// The idle validity (86400s) is capped by the remaining absolute lifetime.
+			return ctx.GrantID == "grant-1" && ctx.TokenID != "" && ctx.TokenID != "token-1" &&
+				ctx.ValidityPeriod > 0 && ctx.ValidityPeriod <= 600
+		})).Return(&model.TokenDTO{Token: "rotated.refresh.token"}, nil)
+	suite.mockGrantService.On("RotateGrantToken", mock.Anything, grant,
+		mock.MatchedBy(func(tokenID string) bool { return tokenID == newTokenID }),
+		mock.MatchedBy(func(expiry time.Time) bool { return !expiry.After(absoluteExpiry) }),
+	).Return(nil)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), response)
+	assert.Equal(suite.T(), "rotated.refresh.token", response.RefreshToken.Token)
+	suite.mockGrantService.AssertNotCalled(suite.T(), "CreateGrant", mock.Anything, mock.Anything)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ConcurrentRotationRejected() {
+	config.GetServerRuntime().Config.OAuth.RefreshToken.RenewOnGrant = true
+	suite.mockGrantFamilyClaims()
+
+	grant := &tokengrant.Grant{ID: "grant-1", ClientID: testRefreshTokenClientID, CurrentTokenID: "token-1"}
+	suite.mockGrantService.On("ValidateGrantToken", mock.Anything, "grant-1", "token-1").Return(grant, nil)
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).Return(&model.TokenDTO{
+		Token: "new.access.token", IssuedAt: time.Now().Unix(), ExpiresIn: 3600,
+	}, nil)
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.Anything).
+		Return(&model.TokenDTO{Token: "rotated.refresh.token"}, nil)
+	suite.mockGrantService.On("RotateGrantToken", mock.Anything, grant, mock.Anything, mock.Anything).
+		Return(&tokengrant.ErrorRefreshTokenReused)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, err.Error)
+}
+
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ExtractIatClaimError() {
 	// RenewOnGrant is disabled by default in SetupTest
 
@@ -561,7 +728,7 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_IDTokenGenerated
 			return ctx.Subject == testRefreshTokenUserID &&
 				ctx.Audience == testRefreshTokenClientID &&
 				len(ctx.Scopes) == 2 &&
-				ctx.AuthTime == 0 // auth_time is not set during refresh (per OIDC spec)
+				ctx.AuthTime == 0 // the refresh token does not carry the original auth_time
 		})).Return(&model.TokenDTO{
 		Token:     "new.id.token",
 		IssuedAt:  time.Now().Unix(),
@@ -587,6 +754,49 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_IDTokenGenerated
 	assert.Equal(suite.T(), testRefreshTokenUserID, response.IDToken.Subject)
 }
 
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_IDTokenKeepsOriginalAuthTime() {
+	authTime := time.Now().Add(-time.Hour).Unix()
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{
+			Sub:       testRefreshTokenUserID,
+			Audiences: []string{testRefreshTokenAudience},
+			Scopes:    []string{"openid", "read"},
+			GrantType: "authorization_code",
+			Iat:       int64(suite.validClaims["iat"].(float64)),
+			AuthTime:  authTime,
+		}, nil)
+
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).Return(&model.TokenDTO{
+		Token:     "new.access.token",
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 3600,
+		Scopes:    []string{"openid", "read"},
+	}, nil)
+
+	// The ID token issued on refresh carries the time of the original authentication (OIDC Core §12.2).
+	suite.mockTokenBuilder.On("BuildIDToken", mock.MatchedBy(
+		func(ctx *tokenservice.IDTokenBuildContext) bool {
+			return ctx.AuthTime == authTime
+		})).Return(&model.TokenDTO{
+		Token:    "new.id.token",
+		IssuedAt: time.Now().Unix(),
+		AuthTime: authTime,
+	}, nil)
+
+	tokenReq := &model.TokenRequest{
+		GrantType:    string(constants.GrantTypeRefreshToken),
+		ClientID:     testRefreshTokenClientID,
+		RefreshToken: suite.validRefreshToken,
+		Scope:        "openid read",
+	}
+
+	response, err := suite.handler.HandleGrant(context.Background(), tokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "new.id.token", response.IDToken.Token)
+	suite.mockTokenBuilder.AssertExpectations(suite.T())
+}
+
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_NoIDToken_WhenOpenIDScopeAbsent() {
 	// Mock successful refresh token validation without openid scope
 	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
@@ -1357,3 +1567,94 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ResourceNarrowin
 	assert.NotNil(suite.T(), response)
 	assert.Equal(suite.T(), "new.access.token", response.AccessToken.Token)
 }
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_RevokedRefreshToken() {
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{
+			JTI:       "revoked-jti",
+			Sub:       testRefreshTokenUserID,
+			Audiences: []string{testRefreshTokenAudience},
+			Scopes:    []string{"read"},
+			GrantType: "authorization_code",
+		}, nil)
+	suite.mockRevocationService.On("IsTokenRevoked", mock.Anything, "revoked-jti").Return(true, nil)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, err.Error)
+	assert.Equal(suite.T(), "Invalid refresh token", err.ErrorDescription)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_RevocationCheckFails() {
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{
+			JTI:       "some-jti",
+			Sub:       testRefreshTokenUserID,
+			Audiences: []string{testRefreshTokenAudience},
+			Scopes:    []string{"read"},
+			GrantType: "authorization_code",
+		}, nil)
+	suite.mockRevocationService.On("IsTokenRevoked", mock.Anything, "some-jti").
+		Return(false, errors.New("store unavailable"))
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorServerError, err.Error)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_DPoPBoundRefreshToken_KeyMismatch() {
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{
+			JTI:                "bound-jti",
+			Sub:                testRefreshTokenUserID,
+			Audiences:          []string{testRefreshTokenAudience},
+			Scopes:             []string{"read"},
+			GrantType:          "authorization_code",
+			ProofKeyThumbprint: "bound-thumbprint",
+		}, nil)
+	suite.mockRevocationService.On("IsTokenRevoked", mock.Anything, "bound-jti").Return(false, nil)
+
+	testCases := []struct {
+		name string
+		ctx  context.Context
+	}{
+		{"NoProof", context.Background()},
+		{"DifferentKey", dpop.WithProofKeyThumbprint(context.Background(), "other-thumbprint")},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			response, err := suite.handler.HandleGrant(tc.ctx, suite.testTokenReq, suite.oauthApp)
+
+			assert.Nil(suite.T(), response)
+			assert.NotNil(suite.T(), err)
+			assert.Equal(suite.T(), constants.ErrorInvalidDPoPProof, err.Error)
+		})
+	}
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_AuthorizationDetailsExceedGrant() {
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{
+			Sub:                  testRefreshTokenUserID,
+			Audiences:            []string{testRefreshTokenAudience},
+			Scopes:               []string{"read"},
+			GrantType:            "authorization_code",
+			Iat:                  int64(suite.validClaims["iat"].(float64)),
+			AuthorizationDetails: []model.AuthorizationDetail{{"type": "payment_initiation", "amount": "50.00"}},
+		}, nil)
+
+	tokenReq := *suite.testTokenReq
+	tokenReq.AuthorizationDetails = `[{"type":"account_information"}]`
+
+	response, err := suite.handler.HandleGrant(context.Background(), &tokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInvalidAuthorizationDetails, err.Error)
+	suite.mockTokenBuilder.AssertNotCalled(suite.T(), "BuildAccessToken", mock.Anything)
+}
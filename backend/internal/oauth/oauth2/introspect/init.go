@@ -26,6 +26,7 @@ import (
 	"github.com/asgardeo/thunder/internal/inboundclient"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/clientauth"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/discovery"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/revocation"
 	"github.com/asgardeo/thunder/internal/system/jose/jwt"
 	"github.com/asgardeo/thunder/internal/system/middleware"
 )
@@ -37,8 +38,9 @@ func Initialize(
 	inboundClient inboundclient.InboundClientServiceInterface,
 	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
 	discoveryService discovery.DiscoveryServiceInterface,
+	revocationService revocation.TokenRevocationServiceInterface,
 ) TokenIntrospectionServiceInterface {
-	introspectionService := newTokenIntrospectionService(jwtService)
+	introspectionService := newTokenIntrospectionService(jwtService, revocationService)
 	introspectHandler := newTokenIntrospectionHandler(introspectionService)
 	registerRoutes(mux, introspectHandler, inboundClient, authnProvider, jwtService, discoveryService)
 	return introspectionService
@@ -18,6 +18,8 @@
 
 package introspect
 
+import "github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+
 // IntrospectRequest represents the request to the token introspection endpoint
 type IntrospectRequest struct {
 	Token         string `json:"token" form:"token"`
@@ -38,4 +40,10 @@ type IntrospectResponse struct {
 	Aud       any    `json:"aud,omitempty"`
 	Iss       string `json:"iss,omitempty"`
 	Jti       string `json:"jti,omitempty"`
+	// Cnf carries the confirmation of a sender-constrained token, e.g. the x5t#S256 certificate
+	// thumbprint of a mutual-TLS bound token (RFC 8705 §3.2) or the jkt key thumbprint of a
+	// DPoP-bound token (RFC 9449 §6.2).
+	Cnf map[string]string `json:"cnf,omitempty"`
+	// AuthorizationDetails carries the authorization details granted to the token (RFC 9396 §9.2).
+	AuthorizationDetails []model.AuthorizationDetail `json:"authorization_details,omitempty"`
 }
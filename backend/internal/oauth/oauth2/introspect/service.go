@@ -23,7 +23,9 @@ import (
 	"context"
 	"errors"
 
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/authorizationdetails"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/revocation"
 	"github.com/asgardeo/thunder/internal/system/jose/jwt"
 	"github.com/asgardeo/thunder/internal/system/log"
 )
@@ -35,13 +37,18 @@ type TokenIntrospectionServiceInterface interface {
 
 // tokenIntrospectionService implements the TokenIntrospectionServiceInterface.
 type tokenIntrospectionService struct {
-	jwtService jwt.JWTServiceInterface
+	jwtService        jwt.JWTServiceInterface
+	revocationService revocation.TokenRevocationServiceInterface
 }
 
 // newTokenIntrospectionService creates a new tokenIntrospectionService instance (internal use).
-func newTokenIntrospectionService(jwtService jwt.JWTServiceInterface) TokenIntrospectionServiceInterface {
+func newTokenIntrospectionService(
+	jwtService jwt.JWTServiceInterface,
+	revocationService revocation.TokenRevocationServiceInterface,
+) TokenIntrospectionServiceInterface {
 	return &tokenIntrospectionService{
-		jwtService: jwtService,
+		jwtService:        jwtService,
+		revocationService: revocationService,
 	}
 }
 
@@ -70,8 +77,20 @@ func (s *tokenIntrospectionService) IntrospectToken(
 		}, nil
 	}
 
-	// TODO: Add validations for token revocation and validity to be used by the resource server
-	//  who makes the introspection call when the support is implemented.
+	// Tokens revoked through the revocation endpoint must be reported as inactive.
+	if jti, ok := payload["jti"].(string); ok && jti != "" {
+		revoked, err := s.revocationService.IsTokenRevoked(ctx, jti)
+		if err != nil {
+			logger.Error("Failed to check token revocation status", log.Error(err))
+			return nil, err
+		}
+		if revoked {
+			logger.Debug("Token has been revoked", log.String("jti", jti))
+			return &IntrospectResponse{
+				Active: false,
+			}, nil
+		}
+	}
 
 	return s.prepareValidResponse(payload), nil
 }
@@ -88,8 +107,7 @@ func (s *tokenIntrospectionService) validateToken(logger *log.Logger, token stri
 // prepareValidResponse prepares the response for a valid token introspection.
 func (s *tokenIntrospectionService) prepareValidResponse(payload map[string]interface{}) *IntrospectResponse {
 	response := &IntrospectResponse{
-		Active: true,
-		// TODO: Revisit if/when adding support for other token types.
+		Active:    true,
 		TokenType: constants.TokenTypeBearer,
 	}
 
@@ -136,6 +154,18 @@ func (s *tokenIntrospectionService) prepareValidResponse(payload map[string]inte
 	if jti, ok := payload["jti"].(string); ok {
 		response.Jti = jti
 	}
+	response.AuthorizationDetails = authorizationdetails.FromClaim(payload[constants.ClaimAuthorizationDetails])
+	if thumbprint := jwt.GetCertificateThumbprintConfirmation(payload); thumbprint != "" {
+		response.Cnf = map[string]string{jwt.ConfirmationX5tS256: thumbprint}
+	}
+	if thumbprint := jwt.GetKeyThumbprintConfirmation(payload); thumbprint != "" {
+		if response.Cnf == nil {
+			response.Cnf = map[string]string{}
+		}
+		response.Cnf[jwt.ConfirmationJKT] = thumbprint
+		// DPoP-bound tokens are reported with the DPoP token type (RFC 9449 §6.2).
+		response.TokenType = constants.TokenTypeDPoP
+	}
 
 	return response
 }
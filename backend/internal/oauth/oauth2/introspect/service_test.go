@@ -26,6 +26,7 @@ import (
 	"crypto/rsa"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	"github.com/asgardeo/thunder/internal/system/cryptolab"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	"github.com/asgardeo/thunder/tests/mocks/jose/jwtmock"
+	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/revocationmock"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -42,6 +44,7 @@ import (
 type TokenIntrospectionServiceTestSuite struct {
 	suite.Suite
 	jwtServiceMock     *jwtmock.JWTServiceInterfaceMock
+	revocationMock     *revocationmock.TokenRevocationServiceInterfaceMock
 	introspectService  TokenIntrospectionServiceInterface
 	validToken         string
 	expiredToken       string
@@ -64,7 +67,10 @@ func (s *TokenIntrospectionServiceTestSuite) SetupTest() {
 		s.T().Fatal("Error generating RSA key:", err)
 	}
 
-	s.introspectService = newTokenIntrospectionService(s.jwtServiceMock)
+	s.revocationMock = revocationmock.NewTokenRevocationServiceInterfaceMock(s.T())
+	s.revocationMock.On("IsTokenRevoked", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+
+	s.introspectService = newTokenIntrospectionService(s.jwtServiceMock, s.revocationMock)
 
 	s.validToken = s.createValidToken()
 	s.expiredToken = s.createExpiredToken()
@@ -469,3 +475,79 @@ func (s *TokenIntrospectionServiceTestSuite) createArrayAudToken() string {
 
 	return s.createToken(claims)
 }
+
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_RevokedToken() {
+	revocationMock := revocationmock.NewTokenRevocationServiceInterfaceMock(s.T())
+	revocationMock.On("IsTokenRevoked", mock.Anything, "token-id-123").Return(true, nil)
+	service := newTokenIntrospectionService(s.jwtServiceMock, revocationMock)
+	s.jwtServiceMock.On("VerifyJWT", s.validToken, "", "").Return(nil)
+
+	response, err := service.IntrospectToken(context.Background(), s.validToken, "")
+
+	s.NoError(err)
+	s.NotNil(response)
+	s.False(response.Active)
+}
+
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_RevocationCheckFails() {
+	revocationMock := revocationmock.NewTokenRevocationServiceInterfaceMock(s.T())
+	revocationMock.On("IsTokenRevoked", mock.Anything, "token-id-123").
+		Return(false, errors.New("store unavailable"))
+	service := newTokenIntrospectionService(s.jwtServiceMock, revocationMock)
+	s.jwtServiceMock.On("VerifyJWT", s.validToken, "", "").Return(nil)
+
+	response, err := service.IntrospectToken(context.Background(), s.validToken, "")
+
+	s.Error(err)
+	s.Nil(response)
+}
+
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_CertificateBoundToken() {
+	token := s.createToken(map[string]interface{}{
+		"exp":       float64(time.Now().Add(time.Hour).Unix()),
+		"client_id": "client123",
+		"cnf":       map[string]interface{}{"x5t#S256": "cert-thumbprint"},
+	})
+	s.jwtServiceMock.On("VerifyJWT", token, "", "").Return(nil)
+
+	response, err := s.introspectService.IntrospectToken(context.Background(), token, "")
+
+	s.NoError(err)
+	s.True(response.Active)
+	s.Equal(map[string]string{"x5t#S256": "cert-thumbprint"}, response.Cnf)
+}
+
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_DPoPBoundToken() {
+	token := s.createToken(map[string]interface{}{
+		"exp":       float64(time.Now().Add(time.Hour).Unix()),
+		"client_id": "client123",
+		"cnf":       map[string]interface{}{"jkt": "key-thumbprint"},
+	})
+	s.jwtServiceMock.On("VerifyJWT", token, "", "").Return(nil)
+
+	response, err := s.introspectService.IntrospectToken(context.Background(), token, "")
+
+	s.NoError(err)
+	s.True(response.Active)
+	s.Equal(constants.TokenTypeDPoP, response.TokenType)
+	s.Equal(map[string]string{"jkt": "key-thumbprint"}, response.Cnf)
+}
+
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_AuthorizationDetails() {
+	token := s.createToken(map[string]interface{}{
+		"exp":       float64(time.Now().Add(time.Hour).Unix()),
+		"client_id": "client123",
+		"authorization_details": []interface{}{
+			map[string]interface{}{"type": "payment_initiation", "amount": "50.00"},
+		},
+	})
+	s.jwtServiceMock.On("VerifyJWT", token, "", "").Return(nil)
+
+	response, err := s.introspectService.IntrospectToken(context.Background(), token, "")
+
+	s.NoError(err)
+	s.True(response.Active)
+	s.Require().Len(response.AuthorizationDetails, 1)
+	s.Equal("payment_initiation", response.AuthorizationDetails[0]["type"])
+	s.Equal("50.00", response.AuthorizationDetails[0]["amount"])
+}
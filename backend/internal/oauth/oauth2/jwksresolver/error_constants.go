@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package jwksresolver
+
+import (
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/i18n/core"
+)
+
+// Client errors for JWKS resolver
+var (
+	// ErrorVerificationKeyNotFound is returned when the JWKS holds no key that can verify the signature.
+	ErrorVerificationKeyNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "JWKS-1001",
+		Error: core.I18nMessage{
+			Key:          "error.jwksresolver.verification_key_not_found",
+			DefaultValue: "Verification key not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.jwksresolver.verification_key_not_found_description",
+			DefaultValue: "No key in the JWKS matches the key ID and algorithm of the token",
+		},
+	}
+)
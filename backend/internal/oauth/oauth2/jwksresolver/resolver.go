@@ -16,14 +16,17 @@
  * under the License.
  */
 
-// Package jwksresolver provides utilities for resolving RSA encryption keys from
-// a relying party's JWKS (inline or remote URI). It is intentionally RSA- and
-// encryption-specific and is not a general-purpose JWK resolver.
+// Package jwksresolver provides utilities for resolving public keys from a JWKS
+// (inline or remote URI): RSA encryption keys of relying parties, and signature
+// verification keys of external assertion issuers.
 package jwksresolver
 
 import (
+	"bytes"
 	"context"
 	"crypto"
+	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -79,23 +82,98 @@ func (r *Resolver) ResolveEncryptionKey(
 		return nil, "", &serviceerror.InternalServerError
 	}
 
-	var jwksData []byte
+	jwksData, svcErr := r.loadJWKS(ctx, certificate)
+	if svcErr != nil {
+		return nil, "", svcErr
+	}
+
+	return r.parseEncryptionKeyFromJWKS(jwksData, encryptionAlg, policy)
+}
+
+// ResolveVerificationKey resolves the public key used to verify a JWS signed by the holder of the
+// given JWKS. When kid is non-empty only the JWK with a matching kid is considered; otherwise the
+// first signing-capable key compatible with alg is returned. Keys declared for encryption are skipped.
+func (r *Resolver) ResolveVerificationKey(
+	ctx context.Context,
+	certificate *inboundmodel.Certificate,
+	kid string,
+	alg string,
+) (crypto.PublicKey, *serviceerror.ServiceError) {
+	if certificate == nil || certificate.Type == "" {
+		r.logger.Error("No JWKS configured for verification key resolution")
+		return nil, &serviceerror.InternalServerError
+	}
+
+	jwksData, svcErr := r.loadJWKS(ctx, certificate)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	return r.parseVerificationKeyFromJWKS(jwksData, kid, alg)
+}
+
+// MatchCertificate reports whether the JWKS held in, or referenced by, the certificate contains the
+// given X.509 certificate, either as the first entry of a key's x5c chain or as a bare public key.
+// It backs self-signed certificate mutual-TLS client authentication (RFC 8705 §2.2).
+func (r *Resolver) MatchCertificate(
+	ctx context.Context,
+	certificate *inboundmodel.Certificate,
+	clientCert *x509.Certificate,
+) (bool, *serviceerror.ServiceError) {
+	if certificate == nil || certificate.Type == "" || clientCert == nil {
+		return false, nil
+	}
+
+	jwksData, svcErr := r.loadJWKS(ctx, certificate)
+	if svcErr != nil {
+		return false, svcErr
+	}
+
+	var jwksObj struct {
+		Keys []map[string]interface{} `json:"keys"`
+	}
+	if err := json.Unmarshal(jwksData, &jwksObj); err != nil {
+		r.logger.Error("Failed to parse JWKS for certificate matching", log.Error(err))
+		return false, &serviceerror.InternalServerError
+	}
+
+	for _, key := range jwksObj.Keys {
+		if chain, ok := key["x5c"].([]interface{}); ok && len(chain) > 0 {
+			encoded, _ := chain[0].(string)
+			der, err := base64.StdEncoding.DecodeString(encoded)
+			if err == nil && bytes.Equal(der, clientCert.Raw) {
+				return true, nil
+			}
+			continue
+		}
+		pub, err := jws.JWKToPublicKey(key)
+		if err != nil {
+			continue
+		}
+		if comparable, ok := pub.(interface{ Equal(crypto.PublicKey) bool }); ok &&
+			comparable.Equal(clientCert.PublicKey) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// loadJWKS returns the raw JWKS document held inline in, or referenced by, the certificate.
+func (r *Resolver) loadJWKS(
+	ctx context.Context,
+	certificate *inboundmodel.Certificate,
+) ([]byte, *serviceerror.ServiceError) {
 	switch certificate.Type {
 	case certmodel.CertificateTypeJWKS:
-		jwksData = []byte(certificate.Value)
+		return []byte(certificate.Value), nil
 	case certmodel.CertificateTypeJWKSURI:
-		body, svcErr := r.fetchJWKS(ctx, certificate.Value)
-		if svcErr != nil {
-			return nil, "", svcErr
-		}
-		jwksData = body
+		return r.fetchJWKS(ctx, certificate.Value)
 	default:
-		r.logger.Error("Unsupported certificate type for encryption key resolution",
+		r.logger.Error("Unsupported certificate type for JWKS resolution",
 			log.String("type", string(certificate.Type)))
-		return nil, "", &serviceerror.InternalServerError
+		return nil, &serviceerror.InternalServerError
 	}
-
-	return r.parseEncryptionKeyFromJWKS(jwksData, encryptionAlg, policy)
 }
 
 // fetchJWKS fetches the JWKS document from the given URI with SSRF protection and a 1 MB size cap.
@@ -186,6 +264,41 @@ func (r *Resolver) parseEncryptionKeyFromJWKS(
 	return nil, "", &serviceerror.InternalServerError
 }
 
+// parseVerificationKeyFromJWKS finds the signature verification key in the JWKS matching kid and alg.
+func (r *Resolver) parseVerificationKeyFromJWKS(
+	jwksData []byte,
+	kid string,
+	alg string,
+) (crypto.PublicKey, *serviceerror.ServiceError) {
+	var jwksObj struct {
+		Keys []map[string]interface{} `json:"keys"`
+	}
+	if err := json.Unmarshal(jwksData, &jwksObj); err != nil {
+		r.logger.Error("Failed to parse JWKS for verification key resolution", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	for _, key := range jwksObj.Keys {
+		if use, _ := key["use"].(string); use == "enc" {
+			continue
+		}
+		if keyKid, _ := key["kid"].(string); kid != "" && keyKid != kid {
+			continue
+		}
+		// Only filter by alg when the field is explicitly present.
+		if keyAlg, _ := key["alg"].(string); keyAlg != "" && alg != "" && keyAlg != alg {
+			continue
+		}
+		pub, err := jws.JWKToPublicKey(key)
+		if err == nil && pub != nil {
+			return pub, nil
+		}
+	}
+
+	r.logger.Debug("No suitable verification key found in JWKS", log.String("kid", kid), log.String("alg", alg))
+	return nil, &ErrorVerificationKeyNotFound
+}
+
 // jwksEndpoint returns the scheme and host of the given URI for safe log output,
 // omitting path, query, and fragment to avoid leaking credentials or tokens.
 func jwksEndpoint(uri string) string {
@@ -22,6 +22,8 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/base64"
 	"encoding/json"
 	"io"
@@ -29,6 +31,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -36,6 +39,7 @@ import (
 
 	certmodel "github.com/asgardeo/thunder/internal/cert"
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	"github.com/asgardeo/thunder/tests/mocks/httpmock"
 )
 
@@ -347,3 +351,151 @@ func (suite *ResolverTestSuite) TestParseEncryptionKeyFromJWKS_SigUseKey_Lenient
 	assert.Nil(suite.T(), pub) // use="sig" is explicitly non-enc; skipped in both policies
 	assert.NotNil(suite.T(), svcErr)
 }
+
+// ---------------------------------------------------------------------------
+// ResolveVerificationKey
+// ---------------------------------------------------------------------------
+
+func (suite *ResolverTestSuite) TestResolveVerificationKey_NilCertificate() {
+	r := newJWKSResolver(nil)
+	pub, svcErr := r.ResolveVerificationKey(context.Background(), nil, "kid", "RS256")
+	assert.Nil(suite.T(), pub)
+	assert.NotNil(suite.T(), svcErr)
+}
+
+func (suite *ResolverTestSuite) TestResolveVerificationKey_InlineJWKS_MatchesKid() {
+	priv1, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	priv2, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	k1 := rsaKeyEntry(&priv1.PublicKey, "first", "RS256")
+	k1["use"] = "sig"
+	k2 := rsaKeyEntry(&priv2.PublicKey, "second", "RS256")
+	delete(k2, "use")
+
+	r := newJWKSResolver(nil)
+	cert := &inboundmodel.Certificate{Type: certmodel.CertificateTypeJWKS, Value: multiKeyJWKS(k1, k2)}
+	pub, svcErr := r.ResolveVerificationKey(context.Background(), cert, "second", "RS256")
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), priv2.PublicKey.N, pub.(*rsa.PublicKey).N)
+}
+
+func (suite *ResolverTestSuite) TestResolveVerificationKey_JWKSURI_Success() {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	jwks := rsaJWKS(&priv.PublicKey, "sig", "remote-kid")
+
+	mockHTTP := httpmock.NewHTTPClientInterfaceMock(suite.T())
+	mockHTTP.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == testJWKSURI
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(jwks)),
+	}, nil)
+
+	r := newJWKSResolver(mockHTTP)
+	cert := &inboundmodel.Certificate{Type: certmodel.CertificateTypeJWKSURI, Value: testJWKSURI}
+	pub, svcErr := r.ResolveVerificationKey(context.Background(), cert, "", "RS256")
+	assert.NotNil(suite.T(), pub)
+	assert.Nil(suite.T(), svcErr)
+}
+
+func (suite *ResolverTestSuite) TestParseVerificationKeyFromJWKS_NoMatch() {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+		jwks string
+		kid  string
+		alg  string
+	}{
+		{"EncKeySkipped", rsaJWKS(&priv.PublicKey, "enc", "kid-1"), "kid-1", "RS256"},
+		{"KidMismatch", rsaJWKS(&priv.PublicKey, "sig", "kid-1"), "kid-2", "RS256"},
+		{"AlgMismatch", multiKeyJWKS(rsaKeyEntry(&priv.PublicKey, "", "PS256")), "", "RS256"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			r := newJWKSResolver(nil)
+			pub, svcErr := r.parseVerificationKeyFromJWKS([]byte(tc.jwks), tc.kid, tc.alg)
+			assert.Nil(suite.T(), pub)
+			suite.Require().NotNil(svcErr)
+			assert.Equal(suite.T(), ErrorVerificationKeyNotFound.Code, svcErr.Code)
+		})
+	}
+}
+
+func (suite *ResolverTestSuite) TestParseVerificationKeyFromJWKS_InvalidJSON() {
+	r := newJWKSResolver(nil)
+	pub, svcErr := r.parseVerificationKeyFromJWKS([]byte("{not json"), "", "RS256")
+	assert.Nil(suite.T(), pub)
+	suite.Require().NotNil(svcErr)
+	assert.Equal(suite.T(), serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+// selfSignedCert creates a self-signed client certificate for the given key.
+func (suite *ResolverTestSuite) selfSignedCert(priv *rsa.PrivateKey) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &priv.PublicKey, priv)
+	suite.Require().NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	suite.Require().NoError(err)
+	return cert
+}
+
+func (suite *ResolverTestSuite) TestMatchCertificate() {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	clientCert := suite.selfSignedCert(priv)
+	otherCert := suite.selfSignedCert(other)
+
+	x5cKey := rsaKeyEntry(&other.PublicKey, "x5c", "")
+	x5cKey["x5c"] = []interface{}{base64.StdEncoding.EncodeToString(clientCert.Raw)}
+	mismatchedX5CKey := rsaKeyEntry(&priv.PublicKey, "x5c", "")
+	mismatchedX5CKey["x5c"] = []interface{}{base64.StdEncoding.EncodeToString(otherCert.Raw)}
+
+	testCases := []struct {
+		name     string
+		jwks     string
+		expected bool
+	}{
+		{"PublicKeyMatch", rsaJWKS(&priv.PublicKey, "sig", "kid"), true},
+		{"X5CMatch", multiKeyJWKS(x5cKey), true},
+		{"X5CMismatchIgnoresKey", multiKeyJWKS(mismatchedX5CKey), false},
+		{"NoMatch", rsaJWKS(&other.PublicKey, "sig", "kid"), false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			r := newJWKSResolver(nil)
+			cert := &inboundmodel.Certificate{Type: certmodel.CertificateTypeJWKS, Value: tc.jwks}
+			matched, svcErr := r.MatchCertificate(context.Background(), cert, clientCert)
+			suite.Nil(svcErr)
+			suite.Equal(tc.expected, matched)
+		})
+	}
+}
+
+func (suite *ResolverTestSuite) TestMatchCertificate_NoCertificate() {
+	r := newJWKSResolver(nil)
+	matched, svcErr := r.MatchCertificate(context.Background(), nil, &x509.Certificate{})
+	suite.Nil(svcErr)
+	suite.False(matched)
+}
+
+func (suite *ResolverTestSuite) TestMatchCertificate_InvalidJWKS() {
+	r := newJWKSResolver(nil)
+	cert := &inboundmodel.Certificate{Type: certmodel.CertificateTypeJWKS, Value: "{not json"}
+	matched, svcErr := r.MatchCertificate(context.Background(), cert, &x509.Certificate{})
+	suite.False(matched)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
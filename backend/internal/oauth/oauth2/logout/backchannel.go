@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/inboundclient"
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/session"
+	"github.com/asgardeo/thunder/internal/system/config"
+	sysconst "github.com/asgardeo/thunder/internal/system/constants"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	syshttp "github.com/asgardeo/thunder/internal/system/http"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+const (
+	// logoutTokenValidityPeriod is the validity period of a back-channel logout token in seconds.
+	logoutTokenValidityPeriod = 120
+	// backChannelLogoutTimeout is the timeout of a single back-channel logout request.
+	backChannelLogoutTimeout = 5 * time.Second
+	// backChannelLogoutMaxAttempts is the number of attempts made to deliver a logout token.
+	backChannelLogoutMaxAttempts = 3
+	// backChannelLogoutRetryDelay is the delay between two delivery attempts.
+	backChannelLogoutRetryDelay = time.Second
+	// backChannelLogoutEvent is the event member of a back-channel logout token.
+	backChannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+)
+
+// backChannelLogoutNotifier notifies the clients that participated in a terminated session through
+// the OpenID Connect Back-Channel Logout mechanism.
+type backChannelLogoutNotifier struct {
+	jwtService    jwt.JWTServiceInterface
+	inboundClient inboundclient.InboundClientServiceInterface
+	httpClient    syshttp.HTTPClientInterface
+	maxAttempts   int
+	retryDelay    time.Duration
+	logger        *log.Logger
+}
+
+// newBackChannelLogoutNotifier creates a new instance of backChannelLogoutNotifier.
+func newBackChannelLogoutNotifier(
+	jwtService jwt.JWTServiceInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	httpClient syshttp.HTTPClientInterface,
+) *backChannelLogoutNotifier {
+	return &backChannelLogoutNotifier{
+		jwtService:    jwtService,
+		inboundClient: inboundClient,
+		httpClient:    httpClient,
+		maxAttempts:   backChannelLogoutMaxAttempts,
+		retryDelay:    backChannelLogoutRetryDelay,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "BackChannelLogoutNotifier")),
+	}
+}
+
+// OnSessionTerminated sends logout tokens to the clients of the terminated session. Delivery happens
+// in the background so that terminating a session is not delayed by unresponsive clients.
+func (n *backChannelLogoutNotifier) OnSessionTerminated(ctx context.Context, sess session.Session) {
+	if len(sess.ClientIDs) == 0 {
+		return
+	}
+	go n.notifyClients(context.WithoutCancel(ctx), sess)
+}
+
+// notifyClients sends a logout token to the back-channel logout URI of each client of the session.
+func (n *backChannelLogoutNotifier) notifyClients(ctx context.Context, sess session.Session) {
+	for _, clientID := range sess.ClientIDs {
+		client, err := n.inboundClient.GetOAuthClientByClientID(ctx, clientID)
+		if err != nil {
+			n.logger.Error("Failed to retrieve OAuth client for back-channel logout",
+				log.String("clientId", clientID), log.Error(err))
+			continue
+		}
+		if client == nil || client.BackChannelLogoutURI == "" {
+			continue
+		}
+
+		logoutToken, svcErr := n.buildLogoutToken(ctx, sess, clientID)
+		if svcErr != nil {
+			n.logger.Error("Failed to generate logout token", log.String("clientId", clientID),
+				log.String("error", svcErr.Error.DefaultValue))
+			continue
+		}
+
+		if err := n.deliverWithRetry(ctx, client.BackChannelLogoutURI, logoutToken); err != nil {
+			n.logger.Error("Failed to deliver back-channel logout token",
+				log.String("clientId", clientID), log.Error(err))
+			continue
+		}
+		n.logger.Debug("Delivered back-channel logout token", log.String("clientId", clientID))
+	}
+}
+
+// buildLogoutToken generates a signed logout token for the given client and session.
+func (n *backChannelLogoutNotifier) buildLogoutToken(
+	ctx context.Context, sess session.Session, clientID string,
+) (string, *serviceerror.ServiceError) {
+	claims := map[string]interface{}{
+		"aud": clientID,
+		"sid": sess.SID,
+		"events": map[string]interface{}{
+			backChannelLogoutEvent: map[string]interface{}{},
+		},
+	}
+
+	token, _, svcErr := n.jwtService.GenerateJWT(ctx, sess.UserID, config.GetServerRuntime().Config.JWT.Issuer,
+		logoutTokenValidityPeriod, claims, jwt.TokenTypeLogoutToken, "")
+	if svcErr != nil {
+		return "", svcErr
+	}
+	return token, nil
+}
+
+// deliverWithRetry posts the logout token to the back-channel logout URI, retrying on failures.
+func (n *backChannelLogoutNotifier) deliverWithRetry(ctx context.Context, logoutURI, logoutToken string) error {
+	var lastErr error
+	for attempt := 0; attempt < n.maxAttempts; attempt++ {
+		if attempt > 0 {
+			n.logger.Debug("Retrying back-channel logout request", log.Int("attempt", attempt))
+			time.Sleep(n.retryDelay)
+		}
+
+		lastErr = n.deliver(ctx, logoutURI, logoutToken)
+		if lastErr == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("failed after %d attempts: %w", n.maxAttempts, lastErr)
+}
+
+// deliver makes a single back-channel logout request.
+func (n *backChannelLogoutNotifier) deliver(ctx context.Context, logoutURI, logoutToken string) error {
+	form := url.Values{}
+	form.Set(oauth2const.RequestParamLogoutToken, logoutToken)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, logoutURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create back-channel logout request: %w", err)
+	}
+	req.Header.Set(sysconst.ContentTypeHeaderName, sysconst.ContentTypeFormURLEncoded)
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute back-channel logout request: %w", err)
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			n.logger.Error("Failed to close response body", log.Error(closeErr))
+		}
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("back-channel logout request returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/session"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/tests/mocks/httpmock"
+	"github.com/asgardeo/thunder/tests/mocks/inboundclientmock"
+	"github.com/asgardeo/thunder/tests/mocks/jose/jwtmock"
+)
+
+const (
+	testBackChannelLogoutURI = "https://client.example.com/backchannel-logout"
+	testLogoutToken          = "logout.token.value"
+)
+
+type BackChannelLogoutNotifierTestSuite struct {
+	suite.Suite
+	mockJWTService    *jwtmock.JWTServiceInterfaceMock
+	mockInboundClient *inboundclientmock.InboundClientServiceInterfaceMock
+	mockHTTPClient    *httpmock.HTTPClientInterfaceMock
+	notifier          *backChannelLogoutNotifier
+	ctx               context.Context
+}
+
+func TestBackChannelLogoutNotifierTestSuite(t *testing.T) {
+	suite.Run(t, new(BackChannelLogoutNotifierTestSuite))
+}
+
+func (s *BackChannelLogoutNotifierTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("test", &config.Config{
+		JWT: config.JWTConfig{Issuer: testIssuer},
+	})
+
+	s.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(s.T())
+	s.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(s.T())
+	s.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(s.T())
+	s.notifier = newBackChannelLogoutNotifier(s.mockJWTService, s.mockInboundClient, s.mockHTTPClient)
+	s.notifier.retryDelay = 0
+	s.ctx = context.Background()
+}
+
+func (s *BackChannelLogoutNotifierTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *BackChannelLogoutNotifierTestSuite) testSession(clientIDs ...string) session.Session {
+	return session.Session{ID: testSessionID, SID: testSessionSID, UserID: testUserID, ClientIDs: clientIDs}
+}
+
+func (s *BackChannelLogoutNotifierTestSuite) mockBackChannelClient() {
+	s.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(&inboundmodel.OAuthClient{
+			ClientID:             testClientID,
+			BackChannelLogoutURI: testBackChannelLogoutURI,
+		}, nil)
+}
+
+func (s *BackChannelLogoutNotifierTestSuite) mockLogoutToken() {
+	s.mockJWTService.EXPECT().GenerateJWT(mock.Anything, testUserID, testIssuer, int64(logoutTokenValidityPeriod),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			events, ok := claims["events"].(map[string]interface{})
+			if !ok {
+				return false
+			}
+			_, hasEvent := events[backChannelLogoutEvent]
+			return claims["aud"] == testClientID && claims["sid"] == testSessionSID && hasEvent
+		}), jwt.TokenTypeLogoutToken, "").
+		Return(testLogoutToken, int64(0), nil)
+}
+
+func logoutRequestMatcher(req *http.Request) bool {
+	if req.Method != http.MethodPost || req.URL.String() != testBackChannelLogoutURI {
+		return false
+	}
+	if err := req.ParseForm(); err != nil {
+		return false
+	}
+	return req.PostForm.Get("logout_token") == testLogoutToken
+}
+
+func httpResponse(statusCode int) *http.Response {
+	return &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader(""))}
+}
+
+func (s *BackChannelLogoutNotifierTestSuite) TestNotifyClients_DeliversLogoutToken() {
+	s.mockBackChannelClient()
+	s.mockLogoutToken()
+	s.mockHTTPClient.EXPECT().Do(mock.MatchedBy(logoutRequestMatcher)).
+		Return(httpResponse(http.StatusOK), nil).Once()
+
+	s.notifier.notifyClients(s.ctx, s.testSession(testClientID))
+}
+
+func (s *BackChannelLogoutNotifierTestSuite) TestNotifyClients_RetriesOnFailure() {
+	s.mockBackChannelClient()
+	s.mockLogoutToken()
+	s.mockHTTPClient.EXPECT().Do(mock.Anything).Return(nil, errors.New("connection refused")).Once()
+	s.mockHTTPClient.EXPECT().Do(mock.Anything).Return(httpResponse(http.StatusServiceUnavailable), nil).Once()
+	s.mockHTTPClient.EXPECT().Do(mock.Anything).Return(httpResponse(http.StatusNoContent), nil).Once()
+
+	s.notifier.notifyClients(s.ctx, s.testSession(testClientID))
+}
+
+func (s *BackChannelLogoutNotifierTestSuite) TestNotifyClients_StopsAfterMaxAttempts() {
+	s.mockBackChannelClient()
+	s.mockLogoutToken()
+	s.mockHTTPClient.EXPECT().Do(mock.Anything).Return(httpResponse(http.StatusBadRequest), nil).
+		Times(backChannelLogoutMaxAttempts)
+
+	s.notifier.notifyClients(s.ctx, s.testSession(testClientID))
+}
+
+func (s *BackChannelLogoutNotifierTestSuite) TestNotifyClients_SkipsClientsWithoutLogoutURI() {
+	s.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "no-backchannel-client").
+		Return(&inboundmodel.OAuthClient{ClientID: "no-backchannel-client"}, nil)
+	s.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "unknown-client").Return(nil, nil)
+	s.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "failing-client").
+		Return(nil, errors.New("db error"))
+
+	s.notifier.notifyClients(s.ctx, s.testSession("no-backchannel-client", "unknown-client", "failing-client"))
+
+	s.mockJWTService.AssertNotCalled(s.T(), "GenerateJWT", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	s.mockHTTPClient.AssertNotCalled(s.T(), "Do", mock.Anything)
+}
+
+func (s *BackChannelLogoutNotifierTestSuite) TestNotifyClients_TokenGenerationFailure() {
+	s.mockBackChannelClient()
+	s.mockJWTService.EXPECT().GenerateJWT(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return("", int64(0), &serviceerror.InternalServerError)
+
+	s.notifier.notifyClients(s.ctx, s.testSession(testClientID))
+
+	s.mockHTTPClient.AssertNotCalled(s.T(), "Do", mock.Anything)
+}
+
+func (s *BackChannelLogoutNotifierTestSuite) TestOnSessionTerminated_WithoutClients() {
+	s.notifier.OnSessionTerminated(s.ctx, s.testSession())
+
+	s.mockInboundClient.AssertNotCalled(s.T(), "GetOAuthClientByClientID", mock.Anything, mock.Anything)
+}
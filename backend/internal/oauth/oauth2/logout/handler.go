@@ -0,0 +1,185 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	oauth2utils "github.com/asgardeo/thunder/internal/oauth/oauth2/utils"
+	"github.com/asgardeo/thunder/internal/session"
+	"github.com/asgardeo/thunder/internal/system/config"
+	sysconst "github.com/asgardeo/thunder/internal/system/constants"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+const (
+	// logoutConfirmParam is the form parameter through which the gate logout confirmation page
+	// confirms a logout request.
+	logoutConfirmParam = "confirm"
+	// defaultLogoutPath is the gate client logout confirmation path used when none is configured.
+	defaultLogoutPath = "/logout"
+)
+
+// frontChannelLogoutTemplate renders the front-channel logout URIs of the clients of the terminated
+// session in hidden iframes and then redirects the user agent to the post logout redirect URI.
+var frontChannelLogoutTemplate = template.Must(template.New("frontChannelLogout").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Logged out</title>
+{{- if .RedirectURI}}
+<meta http-equiv="refresh" content="2;url={{.RedirectURI}}">
+{{- end}}
+</head>
+<body>
+<p>You have been logged out.</p>
+{{- range .FrontChannelLogoutURIs}}
+<iframe src="{{.}}" style="display:none"></iframe>
+{{- end}}
+</body>
+</html>
+`))
+
+// logoutHandler handles OpenID Connect RP-initiated logout requests.
+type logoutHandler struct {
+	service LogoutServiceInterface
+	logger  *log.Logger
+}
+
+// newLogoutHandler creates a new logout handler (internal use).
+func newLogoutHandler(logoutService LogoutServiceInterface) *logoutHandler {
+	return &logoutHandler{
+		service: logoutService,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "LogoutHandler")),
+	}
+}
+
+// HandleLogoutRequest handles logout requests sent with either GET or POST as defined in the
+// OpenID Connect RP-Initiated Logout specification.
+func (h *logoutHandler) HandleLogoutRequest(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		h.redirectToErrorPage(w, r, oauth2const.ErrorInvalidRequest, "Failed to parse request parameters")
+		return
+	}
+
+	request := &LogoutRequest{
+		IDTokenHint:           r.Form.Get(oauth2const.RequestParamIDTokenHint),
+		ClientID:              r.Form.Get(oauth2const.RequestParamClientID),
+		PostLogoutRedirectURI: r.Form.Get(oauth2const.RequestParamPostLogoutRedirect),
+		State:                 r.Form.Get(oauth2const.RequestParamState),
+		SessionID:             session.GetSessionIDFromRequest(r),
+		// The confirmation is only accepted in POST requests. The session cookie is SameSite=Lax and hence
+		// not sent with cross-site POST requests, so a third party site cannot confirm a logout on behalf
+		// of the user.
+		Confirmed: r.Method == http.MethodPost && r.PostForm.Get(logoutConfirmParam) == "true",
+	}
+
+	result, logoutErr := h.service.HandleLogout(r.Context(), request)
+	if logoutErr != nil {
+		h.redirectToErrorPage(w, r, logoutErr.Code, logoutErr.Message)
+		return
+	}
+	if result.ConfirmationRequired {
+		h.redirectToConfirmationPage(w, r, request)
+		return
+	}
+
+	session.ClearSessionCookie(w)
+	if len(result.FrontChannelLogoutURIs) > 0 {
+		h.renderFrontChannelLogoutPage(w, result)
+		return
+	}
+	if result.RedirectURI != "" {
+		http.Redirect(w, r, result.RedirectURI, http.StatusFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// renderFrontChannelLogoutPage writes the page that notifies the clients through their front-channel
+// logout URIs before redirecting the user agent.
+func (h *logoutHandler) renderFrontChannelLogoutPage(w http.ResponseWriter, result *LogoutResult) {
+	w.Header().Set(sysconst.ContentTypeHeaderName, "text/html; charset=utf-8")
+	w.Header().Set(sysconst.CacheControlHeaderName, sysconst.CacheControlNoStore)
+	w.WriteHeader(http.StatusOK)
+	if err := frontChannelLogoutTemplate.Execute(w, result); err != nil {
+		h.logger.Error("Failed to render front-channel logout page", log.Error(err))
+	}
+}
+
+// redirectToConfirmationPage redirects the user agent to the gate logout confirmation page, which
+// resubmits the logout request with the user's confirmation.
+func (h *logoutHandler) redirectToConfirmationPage(w http.ResponseWriter, r *http.Request, request *LogoutRequest) {
+	gateClientConfig := config.GetServerRuntime().Config.GateClient
+	logoutPath := gateClientConfig.LogoutPath
+	if strings.TrimSpace(logoutPath) == "" {
+		logoutPath = defaultLogoutPath
+	}
+	confirmationPageURL := (&url.URL{
+		Scheme: gateClientConfig.Scheme,
+		Host:   fmt.Sprintf("%s:%d", gateClientConfig.Hostname, gateClientConfig.Port),
+		Path:   logoutPath,
+	}).String()
+
+	params := map[string]string{}
+	if request.ClientID != "" {
+		params[oauth2const.RequestParamClientID] = request.ClientID
+	}
+	if request.PostLogoutRedirectURI != "" {
+		params[oauth2const.RequestParamPostLogoutRedirect] = request.PostLogoutRedirectURI
+	}
+	if request.State != "" {
+		params[oauth2const.RequestParamState] = request.State
+	}
+	redirectURL, err := oauth2utils.GetURIWithQueryParams(confirmationPageURL, params)
+	if err != nil {
+		h.logger.Error("Failed to construct logout confirmation page URL", log.Error(err))
+		h.redirectToErrorPage(w, r, oauth2const.ErrorServerError, "Failed to process logout request")
+		return
+	}
+
+	http.Redirect(w, r, redirectURL, http.StatusFound)
+}
+
+// redirectToErrorPage redirects the user agent to the gate error page with the given error.
+func (h *logoutHandler) redirectToErrorPage(w http.ResponseWriter, r *http.Request, code, msg string) {
+	gateClientConfig := config.GetServerRuntime().Config.GateClient
+	errorPageURL := (&url.URL{
+		Scheme: gateClientConfig.Scheme,
+		Host:   fmt.Sprintf("%s:%d", gateClientConfig.Hostname, gateClientConfig.Port),
+		Path:   gateClientConfig.ErrorPath,
+	}).String()
+
+	redirectURL, err := oauth2utils.GetURIWithQueryParams(errorPageURL, map[string]string{
+		"errorCode":    code,
+		"errorMessage": msg,
+	})
+	if err != nil {
+		h.logger.Error("Failed to construct error page URL", log.Error(err))
+		http.Error(w, "Failed to redirect to error page", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, redirectURL, http.StatusFound)
+}
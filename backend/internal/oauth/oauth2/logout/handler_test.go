@@ -0,0 +1,192 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/system/config"
+)
+
+type LogoutHandlerTestSuite struct {
+	suite.Suite
+	mockService *LogoutServiceInterfaceMock
+	handler     *logoutHandler
+}
+
+func TestLogoutHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(LogoutHandlerTestSuite))
+}
+
+func (s *LogoutHandlerTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("test", &config.Config{
+		GateClient: config.GateClientConfig{
+			Scheme:     "https",
+			Hostname:   "localhost",
+			Port:       3000,
+			ErrorPath:  "/error",
+			LogoutPath: "/logout",
+		},
+	})
+
+	s.mockService = NewLogoutServiceInterfaceMock(s.T())
+	s.handler = newLogoutHandler(s.mockService)
+}
+
+func (s *LogoutHandlerTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *LogoutHandlerTestSuite) TestHandleLogoutRequest_GetRedirects() {
+	s.mockService.EXPECT().HandleLogout(mock.Anything, &LogoutRequest{
+		IDTokenHint:           "hint",
+		PostLogoutRedirectURI: testRedirectURI,
+		State:                 "xyz",
+		SessionID:             testSessionID,
+	}).Return(&LogoutResult{RedirectURI: testRedirectURI + "?state=xyz"}, nil)
+
+	query := url.Values{
+		"id_token_hint":            {"hint"},
+		"post_logout_redirect_uri": {testRedirectURI},
+		"state":                    {"xyz"},
+	}
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout?"+query.Encode(), nil)
+	req.AddCookie(&http.Cookie{Name: "thunder_session", Value: testSessionID})
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleLogoutRequest(rr, req)
+
+	s.Equal(http.StatusFound, rr.Code)
+	s.Equal(testRedirectURI+"?state=xyz", rr.Header().Get("Location"))
+	cookies := rr.Result().Cookies()
+	s.Len(cookies, 1)
+	s.Equal("thunder_session", cookies[0].Name)
+	s.Negative(cookies[0].MaxAge)
+}
+
+func (s *LogoutHandlerTestSuite) TestHandleLogoutRequest_PostWithoutRedirect() {
+	s.mockService.EXPECT().HandleLogout(mock.Anything, &LogoutRequest{ClientID: testClientID}).
+		Return(&LogoutResult{}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/logout",
+		strings.NewReader(url.Values{"client_id": {testClientID}}.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleLogoutRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Len(rr.Result().Cookies(), 1)
+}
+
+func (s *LogoutHandlerTestSuite) TestHandleLogoutRequest_RedirectsToConfirmationPage() {
+	s.mockService.EXPECT().HandleLogout(mock.Anything, &LogoutRequest{
+		ClientID:              testClientID,
+		PostLogoutRedirectURI: testRedirectURI,
+		SessionID:             testSessionID,
+	}).Return(&LogoutResult{ConfirmationRequired: true}, nil)
+
+	query := url.Values{
+		"client_id":                {testClientID},
+		"post_logout_redirect_uri": {testRedirectURI},
+		"confirm":                  {"true"},
+	}
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout?"+query.Encode(), nil)
+	req.AddCookie(&http.Cookie{Name: "thunder_session", Value: testSessionID})
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleLogoutRequest(rr, req)
+
+	s.Equal(http.StatusFound, rr.Code)
+	location, err := url.Parse(rr.Header().Get("Location"))
+	s.Require().NoError(err)
+	s.Equal("localhost:3000", location.Host)
+	s.Equal("/logout", location.Path)
+	s.Equal(testClientID, location.Query().Get("client_id"))
+	s.Equal(testRedirectURI, location.Query().Get("post_logout_redirect_uri"))
+	s.Empty(rr.Result().Cookies())
+}
+
+func (s *LogoutHandlerTestSuite) TestHandleLogoutRequest_PostWithConfirmation() {
+	s.mockService.EXPECT().HandleLogout(mock.Anything, &LogoutRequest{
+		ClientID:  testClientID,
+		SessionID: testSessionID,
+		Confirmed: true,
+	}).Return(&LogoutResult{}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/logout",
+		strings.NewReader(url.Values{"client_id": {testClientID}, "confirm": {"true"}}.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.AddCookie(&http.Cookie{Name: "thunder_session", Value: testSessionID})
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleLogoutRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Len(rr.Result().Cookies(), 1)
+}
+
+func (s *LogoutHandlerTestSuite) TestHandleLogoutRequest_RendersFrontChannelLogoutPage() {
+	frontChannelURI := "https://rp.example.com/frontchannel-logout?iss=https%3A%2F%2Fthunder&sid=" + testSessionID
+	s.mockService.EXPECT().HandleLogout(mock.Anything, mock.Anything).Return(&LogoutResult{
+		RedirectURI:            testRedirectURI,
+		FrontChannelLogoutURIs: []string{frontChannelURI},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout", nil)
+	req.AddCookie(&http.Cookie{Name: "thunder_session", Value: testSessionID})
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleLogoutRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal("text/html; charset=utf-8", rr.Header().Get("Content-Type"))
+	s.Equal("no-store", rr.Header().Get("Cache-Control"))
+	body := rr.Body.String()
+	s.Contains(body, `<iframe src="https://rp.example.com/frontchannel-logout?iss=https%3A%2F%2Fthunder&amp;sid=`)
+	s.Contains(body, `content="2;url=`+testRedirectURI+`"`)
+	s.Len(rr.Result().Cookies(), 1)
+}
+
+func (s *LogoutHandlerTestSuite) TestHandleLogoutRequest_ErrorRedirectsToErrorPage() {
+	s.mockService.EXPECT().HandleLogout(mock.Anything, mock.Anything).Return(nil, &LogoutError{
+		Code:    oauth2const.ErrorInvalidRequest,
+		Message: "Invalid id_token_hint",
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout?id_token_hint=bad", nil)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleLogoutRequest(rr, req)
+
+	s.Equal(http.StatusFound, rr.Code)
+	location := rr.Header().Get("Location")
+	s.True(strings.HasPrefix(location, "https://localhost:3000/error?"))
+	s.Contains(location, "errorCode=invalid_request")
+	s.Empty(rr.Result().Cookies())
+}
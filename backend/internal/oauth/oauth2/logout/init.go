@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+import (
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/inboundclient"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
+	"github.com/asgardeo/thunder/internal/session"
+	syshttp "github.com/asgardeo/thunder/internal/system/http"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+)
+
+// Initialize initializes the logout handler, registers its routes and subscribes the back-channel
+// logout notifier to session terminations.
+func Initialize(
+	mux *http.ServeMux,
+	jwtService jwt.JWTServiceInterface,
+	tokenValidator tokenservice.TokenValidatorInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	sessionService session.SessionServiceInterface,
+) LogoutServiceInterface {
+	backChannelNotifier := newBackChannelLogoutNotifier(
+		jwtService, inboundClient, syshttp.NewHTTPClientWithTimeout(backChannelLogoutTimeout))
+	sessionService.RegisterTerminationListener(backChannelNotifier)
+
+	logoutService := newLogoutService(tokenValidator, inboundClient, sessionService)
+	logoutHandler := newLogoutHandler(logoutService)
+	registerRoutes(mux, logoutHandler)
+	return logoutService
+}
+
+// registerRoutes registers the routes for the logout endpoint.
+func registerRoutes(mux *http.ServeMux, logoutHandler *logoutHandler) {
+	// CORS is not enabled on the logout endpoint since the user agent is redirected to it.
+	mux.HandleFunc("GET /oauth2/logout", logoutHandler.HandleLogoutRequest)
+	mux.HandleFunc("POST /oauth2/logout", logoutHandler.HandleLogoutRequest)
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+// LogoutRequest represents an OpenID Connect RP-initiated logout request.
+type LogoutRequest struct {
+	IDTokenHint           string
+	ClientID              string
+	PostLogoutRedirectURI string
+	State                 string
+	SessionID             string
+	// Confirmed indicates that the user confirmed the logout on the gate logout confirmation page.
+	Confirmed bool
+}
+
+// LogoutResult represents the outcome of a successful logout request.
+type LogoutResult struct {
+	// RedirectURI is the URI the user agent is redirected to after logout. It is empty when no
+	// post logout redirect was requested.
+	RedirectURI string
+	// FrontChannelLogoutURIs are the front-channel logout URIs of the clients of the terminated session.
+	FrontChannelLogoutURIs []string
+	// ConfirmationRequired indicates that the session was not terminated since the request cannot be
+	// attributed to a client, and the user has to confirm the logout first.
+	ConfirmationRequired bool
+}
+
+// LogoutError represents an error encountered while processing a logout request.
+type LogoutError struct {
+	Code    string
+	Message string
+}
@@ -0,0 +1,249 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package logout implements the OpenID Connect RP-initiated logout (end_session) endpoint along with
+// the front-channel and back-channel logout notifications sent to clients.
+package logout
+
+import (
+	"context"
+	"slices"
+
+	"github.com/asgardeo/thunder/internal/inboundclient"
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
+	oauth2utils "github.com/asgardeo/thunder/internal/oauth/oauth2/utils"
+	"github.com/asgardeo/thunder/internal/session"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+const loggerComponentName = "LogoutService"
+
+// LogoutServiceInterface defines the interface for handling RP-initiated logout requests.
+type LogoutServiceInterface interface {
+	// HandleLogout terminates the browser session of the request and returns the URI the user agent
+	// should be redirected to afterwards along with the front-channel logout URIs to be rendered.
+	// Requests without an id_token_hint terminate the session only once the user has confirmed them.
+	HandleLogout(ctx context.Context, request *LogoutRequest) (*LogoutResult, *LogoutError)
+}
+
+// logoutService is the default implementation of the LogoutServiceInterface.
+type logoutService struct {
+	tokenValidator tokenservice.TokenValidatorInterface
+	inboundClient  inboundclient.InboundClientServiceInterface
+	sessionService session.SessionServiceInterface
+	logger         *log.Logger
+}
+
+// newLogoutService creates a new instance of logoutService with injected dependencies.
+func newLogoutService(
+	tokenValidator tokenservice.TokenValidatorInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	sessionService session.SessionServiceInterface,
+) LogoutServiceInterface {
+	return &logoutService{
+		tokenValidator: tokenValidator,
+		inboundClient:  inboundClient,
+		sessionService: sessionService,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// HandleLogout terminates the browser session of the request and returns the URI the user agent
+// should be redirected to afterwards along with the front-channel logout URIs to be rendered.
+// Requests without an id_token_hint terminate the session only once the user has confirmed them.
+func (s *logoutService) HandleLogout(ctx context.Context, request *LogoutRequest) (*LogoutResult, *LogoutError) {
+	subject := ""
+	clientID := request.ClientID
+	if request.IDTokenHint != "" {
+		hintSubject, hintClientID, logoutErr := s.validateIDTokenHint(ctx, request.IDTokenHint)
+		if logoutErr != nil {
+			return nil, logoutErr
+		}
+		if clientID != "" && hintClientID != "" && clientID != hintClientID {
+			return nil, &LogoutError{
+				Code:    oauth2const.ErrorInvalidRequest,
+				Message: "The client_id does not match the id_token_hint",
+			}
+		}
+		if clientID == "" {
+			clientID = hintClientID
+		}
+		subject = hintSubject
+	}
+
+	redirectURI := ""
+	if request.PostLogoutRedirectURI != "" {
+		if logoutErr := s.validatePostLogoutRedirectURI(
+			ctx, clientID, request.PostLogoutRedirectURI); logoutErr != nil {
+			return nil, logoutErr
+		}
+		redirectURI = request.PostLogoutRedirectURI
+		if request.State != "" {
+			uri, err := oauth2utils.GetURIWithQueryParams(redirectURI, map[string]string{
+				oauth2const.RequestParamState: request.State,
+			})
+			if err != nil {
+				s.logger.Error("Failed to construct post logout redirect URI", log.Error(err))
+				return nil, &LogoutError{
+					Code:    oauth2const.ErrorServerError,
+					Message: "Failed to process logout request",
+				}
+			}
+			redirectURI = uri
+		}
+	}
+
+	// A request without an id_token_hint may have been triggered by any site, hence the session is only
+	// terminated once the user confirms the logout (OpenID Connect RP-Initiated Logout §2).
+	if request.IDTokenHint == "" && !request.Confirmed && request.SessionID != "" {
+		return &LogoutResult{ConfirmationRequired: true}, nil
+	}
+
+	frontChannelLogoutURIs, logoutErr := s.terminateSession(ctx, request.SessionID, subject)
+	if logoutErr != nil {
+		return nil, logoutErr
+	}
+
+	return &LogoutResult{
+		RedirectURI:            redirectURI,
+		FrontChannelLogoutURIs: frontChannelLogoutURIs,
+	}, nil
+}
+
+// validateIDTokenHint verifies that the ID token hint was issued by this server and returns its
+// subject and the client it was issued to. Expired ID tokens are accepted as hints.
+func (s *logoutService) validateIDTokenHint(
+	ctx context.Context, idTokenHint string,
+) (string, string, *LogoutError) {
+	hintClaims, err := s.tokenValidator.ValidateIDTokenHint(ctx, idTokenHint)
+	if err != nil {
+		s.logger.Debug("Invalid id_token_hint", log.Error(err))
+		return "", "", &LogoutError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "Invalid id_token_hint",
+		}
+	}
+	return hintClaims.Sub, hintClaims.ClientID, nil
+}
+
+// validatePostLogoutRedirectURI checks that the redirect URI is registered for the client.
+func (s *logoutService) validatePostLogoutRedirectURI(
+	ctx context.Context, clientID, redirectURI string,
+) *LogoutError {
+	if clientID == "" {
+		return &LogoutError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "Either client_id or id_token_hint is required with post_logout_redirect_uri",
+		}
+	}
+
+	client, err := s.inboundClient.GetOAuthClientByClientID(ctx, clientID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve OAuth client", log.Error(err))
+		return &LogoutError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process logout request",
+		}
+	}
+	if client == nil {
+		return &LogoutError{
+			Code:    oauth2const.ErrorInvalidClient,
+			Message: "Invalid client_id",
+		}
+	}
+	if !slices.Contains(client.PostLogoutRedirectURIs, redirectURI) {
+		return &LogoutError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "The post_logout_redirect_uri is not registered for the client",
+		}
+	}
+
+	return nil
+}
+
+// terminateSession terminates the browser session and returns the front-channel logout URIs of the
+// clients of the session. When a subject is given the session is only terminated if it belongs to
+// that subject.
+func (s *logoutService) terminateSession(
+	ctx context.Context, sessionID, subject string,
+) ([]string, *LogoutError) {
+	if sessionID == "" {
+		return nil, nil
+	}
+
+	sess, svcErr := s.sessionService.GetSession(ctx, sessionID)
+	if svcErr != nil {
+		if svcErr.Type == serviceerror.ClientErrorType {
+			// The session has already expired or been terminated.
+			return nil, nil
+		}
+		return nil, &LogoutError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process logout request",
+		}
+	}
+	if subject != "" && sess.UserID != subject {
+		s.logger.Debug("The id_token_hint subject does not match the session user")
+		return nil, nil
+	}
+
+	if svcErr := s.sessionService.TerminateSession(ctx, sessionID); svcErr != nil {
+		if svcErr.Type == serviceerror.ClientErrorType {
+			return nil, nil
+		}
+		return nil, &LogoutError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process logout request",
+		}
+	}
+
+	return s.getFrontChannelLogoutURIs(ctx, sess), nil
+}
+
+// getFrontChannelLogoutURIs returns the front-channel logout URIs of the clients of the session with
+// the iss and sid query parameters appended. Clients that cannot be resolved are skipped.
+func (s *logoutService) getFrontChannelLogoutURIs(ctx context.Context, sess *session.Session) []string {
+	issuer := config.GetServerRuntime().Config.JWT.Issuer
+	uris := make([]string, 0, len(sess.ClientIDs))
+	for _, clientID := range sess.ClientIDs {
+		client, err := s.inboundClient.GetOAuthClientByClientID(ctx, clientID)
+		if err != nil {
+			s.logger.Error("Failed to retrieve OAuth client for front-channel logout",
+				log.String("clientId", clientID), log.Error(err))
+			continue
+		}
+		if client == nil || client.FrontChannelLogoutURI == "" {
+			continue
+		}
+
+		uri, err := oauth2utils.GetURIWithQueryParams(client.FrontChannelLogoutURI, map[string]string{
+			oauth2const.RequestParamIss: issuer,
+			oauth2const.RequestParamSid: sess.SID,
+		})
+		if err != nil {
+			s.logger.Error("Failed to construct front-channel logout URI",
+				log.String("clientId", clientID), log.Error(err))
+			continue
+		}
+		uris = append(uris, uri)
+	}
+	return uris
+}
@@ -0,0 +1,273 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
+	"github.com/asgardeo/thunder/internal/session"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/tests/mocks/inboundclientmock"
+	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/tokenservicemock"
+	"github.com/asgardeo/thunder/tests/mocks/sessionmock"
+)
+
+const (
+	testIssuer      = "https://localhost:8090"
+	testClientID    = "test-client"
+	testUserID      = "test-user"
+	testSessionID   = "test-session-id"
+	testSessionSID  = "test-session-sid"
+	testRedirectURI = "https://client.example.com/logged-out"
+	testIDToken     = "test-id-token"
+)
+
+type LogoutServiceTestSuite struct {
+	suite.Suite
+	mockTokenValidator *tokenservicemock.TokenValidatorInterfaceMock
+	mockInboundClient  *inboundclientmock.InboundClientServiceInterfaceMock
+	mockSessionService *sessionmock.SessionServiceInterfaceMock
+	service            LogoutServiceInterface
+	ctx                context.Context
+}
+
+func TestLogoutServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LogoutServiceTestSuite))
+}
+
+func (s *LogoutServiceTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("test", &config.Config{
+		JWT: config.JWTConfig{Issuer: testIssuer},
+	})
+
+	s.mockTokenValidator = tokenservicemock.NewTokenValidatorInterfaceMock(s.T())
+	s.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(s.T())
+	s.mockSessionService = sessionmock.NewSessionServiceInterfaceMock(s.T())
+	s.service = newLogoutService(s.mockTokenValidator, s.mockInboundClient, s.mockSessionService)
+	s.ctx = context.Background()
+}
+
+func (s *LogoutServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *LogoutServiceTestSuite) mockValidIDTokenHint() {
+	s.mockTokenValidator.EXPECT().ValidateIDTokenHint(mock.Anything, testIDToken).
+		Return(&tokenservice.IDTokenHintClaims{Sub: testUserID, ClientID: testClientID}, nil)
+}
+
+func (s *LogoutServiceTestSuite) mockClient() {
+	s.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(&inboundmodel.OAuthClient{
+			ClientID:               testClientID,
+			PostLogoutRedirectURIs: []string{testRedirectURI},
+		}, nil)
+}
+
+func (s *LogoutServiceTestSuite) mockActiveSession(userID string) {
+	s.mockSessionService.EXPECT().GetSession(mock.Anything, testSessionID).
+		Return(&session.Session{ID: testSessionID, UserID: userID}, nil)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogout_WithIDTokenHintAndRedirect() {
+	s.mockValidIDTokenHint()
+	s.mockClient()
+	s.mockActiveSession(testUserID)
+	s.mockSessionService.EXPECT().TerminateSession(mock.Anything, testSessionID).Return(nil)
+
+	result, logoutErr := s.service.HandleLogout(s.ctx, &LogoutRequest{
+		IDTokenHint:           testIDToken,
+		PostLogoutRedirectURI: testRedirectURI,
+		State:                 "xyz",
+		SessionID:             testSessionID,
+	})
+
+	s.Nil(logoutErr)
+	s.Equal(testRedirectURI+"?state=xyz", result.RedirectURI)
+	s.Empty(result.FrontChannelLogoutURIs)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogout_WithoutHintOrRedirect() {
+	s.mockActiveSession(testUserID)
+	s.mockSessionService.EXPECT().TerminateSession(mock.Anything, testSessionID).Return(nil)
+
+	result, logoutErr := s.service.HandleLogout(s.ctx, &LogoutRequest{SessionID: testSessionID, Confirmed: true})
+
+	s.Nil(logoutErr)
+	s.False(result.ConfirmationRequired)
+	s.Empty(result.RedirectURI)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogout_WithoutHintRequiresConfirmation() {
+	s.mockClient()
+
+	result, logoutErr := s.service.HandleLogout(s.ctx, &LogoutRequest{
+		ClientID:              testClientID,
+		PostLogoutRedirectURI: testRedirectURI,
+		SessionID:             testSessionID,
+	})
+
+	s.Nil(logoutErr)
+	s.True(result.ConfirmationRequired)
+	s.Empty(result.RedirectURI)
+	s.mockSessionService.AssertNotCalled(s.T(), "TerminateSession", mock.Anything, mock.Anything)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogout_ReturnsFrontChannelLogoutURIs() {
+	s.mockSessionService.EXPECT().GetSession(mock.Anything, testSessionID).
+		Return(&session.Session{
+			ID:        testSessionID,
+			SID:       testSessionSID,
+			UserID:    testUserID,
+			ClientIDs: []string{testClientID, "no-frontchannel-client", "failing-client"},
+		}, nil)
+	s.mockSessionService.EXPECT().TerminateSession(mock.Anything, testSessionID).Return(nil)
+	s.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(&inboundmodel.OAuthClient{
+			ClientID:              testClientID,
+			FrontChannelLogoutURI: "https://client.example.com/frontchannel-logout",
+		}, nil)
+	s.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "no-frontchannel-client").
+		Return(&inboundmodel.OAuthClient{ClientID: "no-frontchannel-client"}, nil)
+	s.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "failing-client").
+		Return(nil, errors.New("db error"))
+
+	result, logoutErr := s.service.HandleLogout(s.ctx, &LogoutRequest{SessionID: testSessionID, Confirmed: true})
+
+	s.Nil(logoutErr)
+	s.Equal([]string{
+		"https://client.example.com/frontchannel-logout?iss=https%3A%2F%2Flocalhost%3A8090&sid=" + testSessionSID,
+	}, result.FrontChannelLogoutURIs)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogout_NoSession() {
+	result, logoutErr := s.service.HandleLogout(s.ctx, &LogoutRequest{})
+
+	s.Nil(logoutErr)
+	s.Empty(result.RedirectURI)
+	s.mockSessionService.AssertNotCalled(s.T(), "GetSession", mock.Anything, mock.Anything)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogout_SessionAlreadyTerminated() {
+	s.mockSessionService.EXPECT().GetSession(mock.Anything, testSessionID).
+		Return(nil, &session.ErrorSessionNotFound)
+
+	_, logoutErr := s.service.HandleLogout(s.ctx, &LogoutRequest{SessionID: testSessionID, Confirmed: true})
+
+	s.Nil(logoutErr)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogout_SessionOfAnotherUser() {
+	s.mockValidIDTokenHint()
+	s.mockActiveSession("another-user")
+
+	_, logoutErr := s.service.HandleLogout(s.ctx, &LogoutRequest{
+		IDTokenHint: testIDToken,
+		SessionID:   testSessionID,
+	})
+
+	s.Nil(logoutErr)
+	s.mockSessionService.AssertNotCalled(s.T(), "TerminateSession", mock.Anything, mock.Anything)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogout_SessionLookupError() {
+	s.mockSessionService.EXPECT().GetSession(mock.Anything, testSessionID).
+		Return(nil, &serviceerror.InternalServerError)
+
+	_, logoutErr := s.service.HandleLogout(s.ctx, &LogoutRequest{SessionID: testSessionID, Confirmed: true})
+
+	s.NotNil(logoutErr)
+	s.Equal(oauth2const.ErrorServerError, logoutErr.Code)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogout_InvalidIDTokenHint() {
+	s.mockTokenValidator.EXPECT().ValidateIDTokenHint(mock.Anything, testIDToken).
+		Return(nil, errors.New("id_token_hint is not an ID token"))
+
+	_, logoutErr := s.service.HandleLogout(s.ctx, &LogoutRequest{IDTokenHint: testIDToken})
+
+	s.NotNil(logoutErr)
+	s.Equal(oauth2const.ErrorInvalidRequest, logoutErr.Code)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogout_ClientIDMismatch() {
+	s.mockValidIDTokenHint()
+
+	_, logoutErr := s.service.HandleLogout(s.ctx, &LogoutRequest{
+		IDTokenHint: testIDToken,
+		ClientID:    "another-client",
+	})
+
+	s.NotNil(logoutErr)
+	s.Equal(oauth2const.ErrorInvalidRequest, logoutErr.Code)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogout_RedirectWithoutClient() {
+	_, logoutErr := s.service.HandleLogout(s.ctx, &LogoutRequest{PostLogoutRedirectURI: testRedirectURI})
+
+	s.NotNil(logoutErr)
+	s.Equal(oauth2const.ErrorInvalidRequest, logoutErr.Code)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogout_UnregisteredRedirect() {
+	s.mockClient()
+
+	_, logoutErr := s.service.HandleLogout(s.ctx, &LogoutRequest{
+		ClientID:              testClientID,
+		PostLogoutRedirectURI: "https://attacker.example.com",
+	})
+
+	s.NotNil(logoutErr)
+	s.Equal(oauth2const.ErrorInvalidRequest, logoutErr.Code)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogout_UnknownClient() {
+	s.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "unknown").Return(nil, nil)
+
+	_, logoutErr := s.service.HandleLogout(s.ctx, &LogoutRequest{
+		ClientID:              "unknown",
+		PostLogoutRedirectURI: testRedirectURI,
+	})
+
+	s.NotNil(logoutErr)
+	s.Equal(oauth2const.ErrorInvalidClient, logoutErr.Code)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogout_ClientLookupError() {
+	s.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(nil, errors.New("db error"))
+
+	_, logoutErr := s.service.HandleLogout(s.ctx, &LogoutRequest{
+		ClientID:              testClientID,
+		PostLogoutRedirectURI: testRedirectURI,
+	})
+
+	s.NotNil(logoutErr)
+	s.Equal(oauth2const.ErrorServerError, logoutErr.Code)
+}
@@ -26,6 +26,7 @@ type OAuthParameters struct {
 	ClientID            string
 	RedirectURI         string
 	ResponseType        string
+	ResponseMode        string
 	StandardScopes      []string
 	PermissionScopes    []string
 	CodeChallenge       string
@@ -35,8 +36,23 @@ type OAuthParameters struct {
 	ClaimsLocales       string
 	Nonce               string
 	AcrValues           string
+	Prompt              string
+	// MaxAge is the allowable elapsed time in seconds since the user last authenticated, or nil when the
+	// max_age parameter was not sent.
+	MaxAge *int64
+	// LoginHint is a hint about the identifier the user might use to log in.
+	LoginHint string
+	// IDTokenHint is an ID token previously issued to the client, identifying the user expected to be
+	// authenticated.
+	IDTokenHint string
+	// AuthorizationDetails holds the rich authorization request details (RFC 9396), if any.
+	AuthorizationDetails []AuthorizationDetail
 }
 
+// AuthorizationDetail represents a single authorization details object of a rich authorization
+// request (RFC 9396). The "type" member identifies the schema that the remaining members follow.
+type AuthorizationDetail map[string]interface{}
+
 // ClaimsRequest represents the OIDC claims request parameter structure.
 type ClaimsRequest struct {
 	UserInfo map[string]*IndividualClaimRequest `json:"userinfo,omitempty"`
@@ -31,6 +31,9 @@ type TokenRequest struct {
 	CodeVerifier       string   `json:"code_verifier,omitempty"`
 	Code               string   `json:"code,omitempty"`
 	RedirectURI        string   `json:"redirect_uri,omitempty"`
+	DeviceCode         string   `json:"device_code,omitempty"`
+	AuthReqID          string   `json:"auth_req_id,omitempty"`
+	Assertion          string   `json:"assertion,omitempty"`
 	Resources          []string `json:"resources,omitempty"`
 	SubjectToken       string   `json:"subject_token,omitempty"`
 	SubjectTokenType   string   `json:"subject_token_type,omitempty"`
@@ -38,6 +41,8 @@ type TokenRequest struct {
 	ActorTokenType     string   `json:"actor_token_type,omitempty"`
 	RequestedTokenType string   `json:"requested_token_type,omitempty"`
 	Audiences          []string `json:"audiences,omitempty"`
+	// AuthorizationDetails holds the JSON-encoded authorization_details parameter (RFC 9396 §6).
+	AuthorizationDetails string `json:"authorization_details,omitempty"`
 }
 
 // TokenResponse represents the OAuth2 token response.
@@ -49,6 +54,8 @@ type TokenResponse struct {
 	Scope           string `json:"scope,omitempty"`
 	IDToken         string `json:"id_token,omitempty"`
 	IssuedTokenType string `json:"issued_token_type,omitempty"`
+	// AuthorizationDetails holds the authorization details granted to the access token (RFC 9396 §7).
+	AuthorizationDetails []AuthorizationDetail `json:"authorization_details,omitempty"`
 }
 
 // TokenDTO represents the data transfer object for tokens.
@@ -66,6 +73,12 @@ type TokenDTO struct {
 	OriginalAudiences []string
 	ClaimsRequest     *ClaimsRequest
 	ClaimsLocales     string
+	// AuthorizationDetails holds the rich authorization request details bound to the token, if any.
+	AuthorizationDetails []AuthorizationDetail
+	// AuthTime is the time at which the user authenticated, carried by ID tokens.
+	AuthTime int64
+	// SID is the identifier of the SSO session the ID token was issued within, if any.
+	SID string
 }
 
 // TokenResponseDTO represents the data transfer object for token responses.
@@ -26,6 +26,7 @@ import (
 	"github.com/asgardeo/thunder/internal/inboundclient"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/clientauth"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/discovery"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/requestobject"
 	"github.com/asgardeo/thunder/internal/resource"
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/database/provider"
@@ -42,9 +43,10 @@ func Initialize(
 	jwtService jwt.JWTServiceInterface,
 	discoveryService discovery.DiscoveryServiceInterface,
 	resourceService resource.ResourceServiceInterface,
+	requestObjectService requestobject.RequestObjectServiceInterface,
 ) PARServiceInterface {
 	store := initializePARStore()
-	parSvc := newPARService(store, resourceService)
+	parSvc := newPARService(store, resourceService, requestObjectService)
 	handler := newPARHandler(parSvc)
 	registerRoutes(mux, handler, inboundClient, authnProvider, jwtService, discoveryService)
 	return parSvc
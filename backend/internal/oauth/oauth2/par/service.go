@@ -23,9 +23,11 @@ import (
 	"strings"
 
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/authorizationdetails"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/authz/requestvalidator"
 	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
 	oauth2model "github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/requestobject"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/resourceindicators"
 	oauth2utils "github.com/asgardeo/thunder/internal/oauth/oauth2/utils"
 	"github.com/asgardeo/thunder/internal/resource"
@@ -36,6 +38,11 @@ import (
 // requestURIPrefix is the URN prefix used for PAR request URIs per RFC 9126.
 const requestURIPrefix = "urn:ietf:params:oauth:request_uri:"
 
+// IsPushedAuthorizationRequestURI reports whether the request_uri was issued by the PAR endpoint.
+func IsPushedAuthorizationRequestURI(requestURI string) bool {
+	return strings.HasPrefix(requestURI, requestURIPrefix)
+}
+
 // PARServiceInterface defines the interface for the PAR service.
 type PARServiceInterface interface {
 	HandlePushedAuthorizationRequest(
@@ -49,19 +56,22 @@ type PARServiceInterface interface {
 
 // parService implements PARServiceInterface.
 type parService struct {
-	store           parStoreInterface
-	resourceService resource.ResourceServiceInterface
-	logger          *log.Logger
+	store                parStoreInterface
+	resourceService      resource.ResourceServiceInterface
+	requestObjectService requestobject.RequestObjectServiceInterface
+	logger               *log.Logger
 }
 
 // newPARService creates a new PAR service instance.
 func newPARService(
 	store parStoreInterface, resourceService resource.ResourceServiceInterface,
+	requestObjectService requestobject.RequestObjectServiceInterface,
 ) PARServiceInterface {
 	return &parService{
-		store:           store,
-		resourceService: resourceService,
-		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "PARService")),
+		store:                store,
+		resourceService:      resourceService,
+		requestObjectService: requestObjectService,
+		logger:               log.GetLogger().With(log.String(log.LoggerKeyComponentName, "PARService")),
 	}
 }
 
@@ -77,6 +87,21 @@ func (s *parService) HandlePushedAuthorizationRequest(
 			"request_uri parameter must not be included in a pushed authorization request"
 	}
 
+	// A request object replaces the parameters sent alongside it (RFC 9126 §3, RFC 9101 §5).
+	if request := params[oauth2const.RequestParamRequest]; request != "" {
+		requestObject, errCode, errMsg := s.requestObjectService.ResolveRequestObject(ctx, request, "", oauthApp)
+		if errCode != "" {
+			return nil, errCode, errMsg
+		}
+		params, errCode, errMsg = requestvalidator.ApplyRequestObjectParams(params, requestObject.Params)
+		if errCode != "" {
+			return nil, errCode, errMsg
+		}
+		resources = requestObject.Resources
+	} else if oauthApp.RequiresSignedRequestObject() {
+		return nil, oauth2const.ErrorInvalidRequest, "A signed request object is required for this client"
+	}
+
 	// Validate the redirect URI.
 	redirectURI := params[oauth2const.RequestParamRedirectURI]
 	if err := oauthApp.ValidateRedirectURI(redirectURI); err != nil {
@@ -117,24 +142,38 @@ func (s *parService) HandlePushedAuthorizationRequest(
 		return nil, errResp.Error, errResp.ErrorDescription
 	}
 
+	authorizationDetails, errResp := authorizationdetails.Parse(params[oauth2const.RequestParamAuthorizationDetails])
+	if errResp == nil {
+		errResp = authorizationdetails.Validate(ctx, s.resourceService, authorizationDetails)
+	}
+	if errResp != nil {
+		return nil, errResp.Error, errResp.ErrorDescription
+	}
+
 	if redirectURI == "" && len(oauthApp.RedirectURIs) == 1 {
 		redirectURI = oauthApp.RedirectURIs[0]
 	}
 
 	oauthParams := oauth2model.OAuthParameters{
-		State:               params[oauth2const.RequestParamState],
-		ClientID:            oauthApp.ClientID,
-		RedirectURI:         redirectURI,
-		ResponseType:        params[oauth2const.RequestParamResponseType],
-		StandardScopes:      oidcScopes,
-		PermissionScopes:    nonOidcScopes,
-		CodeChallenge:       params[oauth2const.RequestParamCodeChallenge],
-		CodeChallengeMethod: params[oauth2const.RequestParamCodeChallengeMethod],
-		Resources:           resources,
-		ClaimsRequest:       claimsRequest,
-		ClaimsLocales:       params[oauth2const.RequestParamClaimsLocales],
-		Nonce:               params[oauth2const.RequestParamNonce],
-		AcrValues:           params[oauth2const.RequestParamAcrValues],
+		State:                params[oauth2const.RequestParamState],
+		ClientID:             oauthApp.ClientID,
+		RedirectURI:          redirectURI,
+		ResponseType:         params[oauth2const.RequestParamResponseType],
+		ResponseMode:         params[oauth2const.RequestParamResponseMode],
+		StandardScopes:       oidcScopes,
+		PermissionScopes:     nonOidcScopes,
+		CodeChallenge:        params[oauth2const.RequestParamCodeChallenge],
+		CodeChallengeMethod:  params[oauth2const.RequestParamCodeChallengeMethod],
+		Resources:            resources,
+		ClaimsRequest:        claimsRequest,
+		ClaimsLocales:        params[oauth2const.RequestParamClaimsLocales],
+		Nonce:                params[oauth2const.RequestParamNonce],
+		AcrValues:            params[oauth2const.RequestParamAcrValues],
+		Prompt:               params[oauth2const.RequestParamPrompt],
+		MaxAge:               requestvalidator.ParseMaxAge(params[oauth2const.RequestParamMaxAge]),
+		LoginHint:            params[oauth2const.RequestParamLoginHint],
+		IDTokenHint:          params[oauth2const.RequestParamIDTokenHint],
+		AuthorizationDetails: authorizationDetails,
 	}
 
 	parRequest := pushedAuthorizationRequest{
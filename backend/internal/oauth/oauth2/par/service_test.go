@@ -31,9 +31,11 @@ import (
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
 	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/requestobject"
 	"github.com/asgardeo/thunder/internal/resource"
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/requestobjectmock"
 	"github.com/asgardeo/thunder/tests/mocks/resourcemock"
 )
 
@@ -99,7 +101,7 @@ func (s *ServiceTestSuite) newValidParams() map[string]string {
 func (s *ServiceTestSuite) TestHandlePAR_Success() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).Return("test-uri", nil)
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 	app := s.newTestApp()
 	params := s.newValidParams()
 
@@ -113,7 +115,7 @@ func (s *ServiceTestSuite) TestHandlePAR_Success() {
 
 func (s *ServiceTestSuite) TestHandlePAR_RejectsRequestURIInBody() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamRequestURI] = "urn:ietf:params:oauth:request_uri:test"
@@ -125,9 +127,63 @@ func (s *ServiceTestSuite) TestHandlePAR_RejectsRequestURIInBody() {
 	assert.Contains(s.T(), errDesc, "request_uri parameter must not be included")
 }
 
+func (s *ServiceTestSuite) TestHandlePAR_RequestObject() {
+	store := newParStoreInterfaceMock(s.T())
+	store.EXPECT().Store(mock.Anything, mock.MatchedBy(func(req pushedAuthorizationRequest) bool {
+		return req.OAuthParameters.State == "signed-state" &&
+			req.OAuthParameters.Resources[0] == "https://api.example.com"
+	}), mock.Anything).Return("test-uri", nil)
+	requestObjectService := requestobjectmock.NewRequestObjectServiceInterfaceMock(s.T())
+	app := s.newTestApp()
+	signedParams := s.newValidParams()
+	signedParams[oauth2const.RequestParamState] = "signed-state"
+	requestObjectService.EXPECT().ResolveRequestObject(mock.Anything, "signed-request", "", app).
+		Return(&requestobject.RequestObject{
+			Params:    signedParams,
+			Resources: []string{"https://api.example.com"},
+		}, "", "")
+	svc := newPARService(store, s.newPermissiveResourceMock(), requestObjectService)
+	params := map[string]string{
+		oauth2const.RequestParamRequest: "signed-request",
+		oauth2const.RequestParamState:   "unsigned-state",
+	}
+
+	resp, errCode, _ := svc.HandlePushedAuthorizationRequest(s.ctx, params, nil, app)
+
+	assert.Empty(s.T(), errCode)
+	assert.NotNil(s.T(), resp)
+}
+
+func (s *ServiceTestSuite) TestHandlePAR_InvalidRequestObject() {
+	store := newParStoreInterfaceMock(s.T())
+	requestObjectService := requestobjectmock.NewRequestObjectServiceInterfaceMock(s.T())
+	requestObjectService.EXPECT().ResolveRequestObject(mock.Anything, "signed-request", "", mock.Anything).
+		Return(nil, oauth2const.ErrorInvalidRequestObject, "The request object signature is invalid")
+	svc := newPARService(store, s.newPermissiveResourceMock(), requestObjectService)
+	params := map[string]string{oauth2const.RequestParamRequest: "signed-request"}
+
+	resp, errCode, _ := svc.HandlePushedAuthorizationRequest(s.ctx, params, nil, s.newTestApp())
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), oauth2const.ErrorInvalidRequestObject, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandlePAR_SignedRequestObjectRequired() {
+	store := newParStoreInterfaceMock(s.T())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
+	app := s.newTestApp()
+	app.RequireSignedRequestObject = true
+
+	resp, errCode, errDesc := svc.HandlePushedAuthorizationRequest(s.ctx, s.newValidParams(), nil, app)
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), oauth2const.ErrorInvalidRequest, errCode)
+	assert.Contains(s.T(), errDesc, "signed request object is required")
+}
+
 func (s *ServiceTestSuite) TestHandlePAR_MissingResponseType() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	delete(params, oauth2const.RequestParamResponseType)
@@ -140,7 +196,7 @@ func (s *ServiceTestSuite) TestHandlePAR_MissingResponseType() {
 
 func (s *ServiceTestSuite) TestHandlePAR_InvalidRedirectURI() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamRedirectURI] = "https://evil.com/callback"
@@ -153,7 +209,7 @@ func (s *ServiceTestSuite) TestHandlePAR_InvalidRedirectURI() {
 
 func (s *ServiceTestSuite) TestHandlePAR_UnauthorizedGrantType() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 	app := s.newTestApp()
 	app.GrantTypes = []oauth2const.GrantType{oauth2const.GrantTypeClientCredentials}
 	params := s.newValidParams()
@@ -166,7 +222,7 @@ func (s *ServiceTestSuite) TestHandlePAR_UnauthorizedGrantType() {
 
 func (s *ServiceTestSuite) TestHandlePAR_UnsupportedResponseType() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamResponseType] = "token"
@@ -179,7 +235,7 @@ func (s *ServiceTestSuite) TestHandlePAR_UnsupportedResponseType() {
 
 func (s *ServiceTestSuite) TestHandlePAR_PKCERequired() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 	app := s.newTestApp()
 	app.PKCERequired = true
 	params := s.newValidParams()
@@ -194,7 +250,7 @@ func (s *ServiceTestSuite) TestHandlePAR_PKCERequired() {
 func (s *ServiceTestSuite) TestHandlePAR_StoreError() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).Return("", errors.New("store error"))
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 	app := s.newTestApp()
 	params := s.newValidParams()
 
@@ -204,23 +260,25 @@ func (s *ServiceTestSuite) TestHandlePAR_StoreError() {
 	assert.Equal(s.T(), oauth2const.ErrorServerError, errCode)
 }
 
-func (s *ServiceTestSuite) TestHandlePAR_PromptNone_LoginRequired() {
+func (s *ServiceTestSuite) TestHandlePAR_PromptNone_StoresPrompt() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	store.EXPECT().Store(mock.Anything, mock.MatchedBy(func(req pushedAuthorizationRequest) bool {
+		return req.OAuthParameters.Prompt == "none"
+	}), mock.Anything).Return("test-uri", nil)
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamPrompt] = "none"
 
-	resp, errCode, errDesc := svc.HandlePushedAuthorizationRequest(s.ctx, params, nil, app)
+	resp, errCode, _ := svc.HandlePushedAuthorizationRequest(s.ctx, params, nil, app)
 
-	assert.Nil(s.T(), resp)
-	assert.Equal(s.T(), oauth2const.ErrorLoginRequired, errCode)
-	assert.Equal(s.T(), "User authentication is required", errDesc)
+	assert.NotNil(s.T(), resp)
+	assert.Empty(s.T(), errCode)
 }
 
 func (s *ServiceTestSuite) TestHandlePAR_PromptInvalid() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamPrompt] = "invalid_value"
@@ -234,7 +292,7 @@ func (s *ServiceTestSuite) TestHandlePAR_PromptInvalid() {
 func (s *ServiceTestSuite) TestHandlePAR_PromptLogin_Success() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).Return("test-uri", nil)
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamPrompt] = "login"
@@ -247,7 +305,7 @@ func (s *ServiceTestSuite) TestHandlePAR_PromptLogin_Success() {
 
 func (s *ServiceTestSuite) TestHandlePAR_ResourceWithFragment() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	resources := []string{"https://api.example.com/resource#fragment"}
@@ -260,7 +318,7 @@ func (s *ServiceTestSuite) TestHandlePAR_ResourceWithFragment() {
 
 func (s *ServiceTestSuite) TestHandlePAR_ResourceMissingScheme() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	resources := []string{"api.example.com/resource"}
@@ -274,7 +332,7 @@ func (s *ServiceTestSuite) TestHandlePAR_ResourceMissingScheme() {
 func (s *ServiceTestSuite) TestHandlePAR_ValidResource_Success() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).Return("test-uri", nil)
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	resources := []string{"https://api.example.com/resource"}
@@ -293,7 +351,7 @@ func (s *ServiceTestSuite) TestHandlePAR_UnregisteredResource_InvalidTarget() {
 			Type: serviceerror.ClientErrorType,
 			Code: "RES-1001",
 		})
-	svc := newPARService(store, rsMock)
+	svc := newPARService(store, rsMock, nil)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	resources := []string{"https://unknown.example.com"}
@@ -312,7 +370,7 @@ func (s *ServiceTestSuite) TestHandlePAR_ResourceResolutionServerError() {
 			Type: serviceerror.ServerErrorType,
 			Code: "RES-5000",
 		})
-	svc := newPARService(store, rsMock)
+	svc := newPARService(store, rsMock, nil)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	resources := []string{"https://api.example.com/resource"}
@@ -342,7 +400,7 @@ func (s *ServiceTestSuite) TestHandlePAR_ScopesDownscopedAgainstResourceServers(
 		})).
 		Return([]string{"write"}, (*serviceerror.ServiceError)(nil))
 
-	svc := newPARService(store, rsMock)
+	svc := newPARService(store, rsMock, nil)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamScope] = "read write"
@@ -363,7 +421,7 @@ func (s *ServiceTestSuite) TestHandlePAR_AcrValuesPropagated() {
 			captured = req
 		}).Return("test-uri", nil)
 
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamAcrValues] = "urn:thunder:acr:password urn:thunder:acr:generated-code"
@@ -377,9 +435,85 @@ func (s *ServiceTestSuite) TestHandlePAR_AcrValuesPropagated() {
 		captured.OAuthParameters.AcrValues)
 }
 
+func (s *ServiceTestSuite) TestHandlePAR_AuthorizationDetailsPropagated() {
+	store := newParStoreInterfaceMock(s.T())
+	var captured pushedAuthorizationRequest
+	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, req pushedAuthorizationRequest, _ int64) {
+			captured = req
+		}).Return("test-uri", nil)
+
+	rsMock := s.newPermissiveResourceMock()
+	rsMock.On("ValidateAuthorizationDetail", mock.Anything, mock.Anything).
+		Return(&resource.ResourceServer{Identifier: "https://payments.example.com"},
+			(*serviceerror.ServiceError)(nil))
+
+	svc := newPARService(store, rsMock, nil)
+	app := s.newTestApp()
+	params := s.newValidParams()
+	params[oauth2const.RequestParamAuthorizationDetails] = `[{"type":"payment_initiation","amount":"50.00"}]`
+
+	resp, errCode, _ := svc.HandlePushedAuthorizationRequest(s.ctx, params, nil, app)
+
+	assert.Empty(s.T(), errCode)
+	assert.NotNil(s.T(), resp)
+	assert.Len(s.T(), captured.OAuthParameters.AuthorizationDetails, 1)
+	assert.Equal(s.T(), "payment_initiation", captured.OAuthParameters.AuthorizationDetails[0]["type"])
+}
+
+func (s *ServiceTestSuite) TestHandlePAR_OIDCHintsPropagated() {
+	store := newParStoreInterfaceMock(s.T())
+	var captured pushedAuthorizationRequest
+	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, req pushedAuthorizationRequest, _ int64) {
+			captured = req
+		}).Return("test-uri", nil)
+
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
+	app := s.newTestApp()
+	params := s.newValidParams()
+	params[oauth2const.RequestParamMaxAge] = "300"
+	params[oauth2const.RequestParamLoginHint] = "alice@example.com"
+	params[oauth2const.RequestParamIDTokenHint] = "header.payload.signature"
+
+	_, errCode, _ := svc.HandlePushedAuthorizationRequest(s.ctx, params, nil, app)
+
+	assert.Empty(s.T(), errCode)
+	assert.NotNil(s.T(), captured.OAuthParameters.MaxAge)
+	assert.Equal(s.T(), int64(300), *captured.OAuthParameters.MaxAge)
+	assert.Equal(s.T(), "alice@example.com", captured.OAuthParameters.LoginHint)
+	assert.Equal(s.T(), "header.payload.signature", captured.OAuthParameters.IDTokenHint)
+}
+
+func (s *ServiceTestSuite) TestHandlePAR_InvalidMaxAge() {
+	store := newParStoreInterfaceMock(s.T())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
+	app := s.newTestApp()
+	params := s.newValidParams()
+	params[oauth2const.RequestParamMaxAge] = "-1"
+
+	resp, errCode, _ := svc.HandlePushedAuthorizationRequest(s.ctx, params, nil, app)
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), oauth2const.ErrorInvalidRequest, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandlePAR_MalformedAuthorizationDetails() {
+	store := newParStoreInterfaceMock(s.T())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
+	app := s.newTestApp()
+	params := s.newValidParams()
+	params[oauth2const.RequestParamAuthorizationDetails] = `{"type":"payment_initiation"}`
+
+	resp, errCode, _ := svc.HandlePushedAuthorizationRequest(s.ctx, params, nil, app)
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), oauth2const.ErrorInvalidAuthorizationDetails, errCode)
+}
+
 func (s *ServiceTestSuite) TestHandlePAR_NonceTooLong() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamNonce] = strings.Repeat("a", oauth2const.MaxNonceLength+1)
@@ -401,7 +535,7 @@ func (s *ServiceTestSuite) TestResolvePAR_Success() {
 	}
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Consume(mock.Anything, mock.Anything).Return(storedRequest, true, nil)
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 
 	result, err := svc.ResolvePushedAuthorizationRequest(
 		s.ctx, requestURIPrefix+"test-uri", "test-client")
@@ -414,7 +548,7 @@ func (s *ServiceTestSuite) TestResolvePAR_Success() {
 
 func (s *ServiceTestSuite) TestResolvePAR_InvalidURIFormat() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 
 	result, err := svc.ResolvePushedAuthorizationRequest(s.ctx, "invalid-uri", "test-client")
 
@@ -425,7 +559,7 @@ func (s *ServiceTestSuite) TestResolvePAR_InvalidURIFormat() {
 func (s *ServiceTestSuite) TestResolvePAR_NotFound() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Consume(mock.Anything, mock.Anything).Return(pushedAuthorizationRequest{}, false, nil)
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 
 	result, err := svc.ResolvePushedAuthorizationRequest(
 		s.ctx, requestURIPrefix+"nonexistent", "test-client")
@@ -443,7 +577,7 @@ func (s *ServiceTestSuite) TestResolvePAR_ClientIDMismatch() {
 	}
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Consume(mock.Anything, mock.Anything).Return(storedRequest, true, nil)
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 
 	result, err := svc.ResolvePushedAuthorizationRequest(
 		s.ctx, requestURIPrefix+"test-uri", "client-b")
@@ -456,7 +590,7 @@ func (s *ServiceTestSuite) TestResolvePAR_ConsumeError() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Consume(mock.Anything, mock.Anything).
 		Return(pushedAuthorizationRequest{}, false, errors.New("cache error"))
-	svc := newPARService(store, s.newPermissiveResourceMock())
+	svc := newPARService(store, s.newPermissiveResourceMock(), nil)
 
 	result, err := svc.ResolvePushedAuthorizationRequest(
 		s.ctx, requestURIPrefix+"test-uri", "test-client")
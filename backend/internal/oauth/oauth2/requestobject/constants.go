@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package requestobject implements JWT-secured authorization requests (RFC 9101). It resolves a
+// request object passed by value or by reference, decrypts it when it is encrypted to the server,
+// verifies the client's signature and returns the authorization request parameters it carries.
+package requestobject
+
+import (
+	"github.com/asgardeo/thunder/internal/system/jose/jwe"
+	"github.com/asgardeo/thunder/internal/system/jose/jws"
+)
+
+const (
+	// requestObjectContentType is the media type of request objects fetched from a request_uri.
+	requestObjectContentType = "application/oauth-authz-req+jwt"
+	// maxRequestObjectBytes caps the size of request objects fetched from a request_uri.
+	maxRequestObjectBytes = 64 << 10
+)
+
+// supportedSigningAlgorithms lists the asymmetric algorithms accepted for request object signatures.
+var supportedSigningAlgorithms = []jws.Algorithm{
+	jws.RS256, jws.RS512, jws.PS256, jws.ES256, jws.ES384, jws.ES512, jws.EdDSA,
+}
+
+// supportedEncryptionAlgorithms lists the key-management algorithms accepted for encrypted request objects.
+var supportedEncryptionAlgorithms = []jwe.KeyEncAlgorithm{jwe.RSAOAEP, jwe.RSAOAEP256}
+
+// supportedEncryptionEncodings lists the content-encryption algorithms accepted for encrypted request objects.
+var supportedEncryptionEncodings = []jwe.ContentEncAlgorithm{jwe.A128CBCHS256, jwe.A256GCM}
+
+// registeredClaims lists the JWT claims of a request object that are not authorization request parameters.
+var registeredClaims = []string{"iss", "aud", "exp", "iat", "nbf", "jti"}
+
+// GetSupportedSigningAlgorithms returns the JWS algorithms accepted for request objects.
+func GetSupportedSigningAlgorithms() []string {
+	algorithms := make([]string, 0, len(supportedSigningAlgorithms))
+	for _, alg := range supportedSigningAlgorithms {
+		algorithms = append(algorithms, string(alg))
+	}
+	return algorithms
+}
+
+// GetSupportedEncryptionAlgorithms returns the JWE key-management algorithms accepted for request objects.
+func GetSupportedEncryptionAlgorithms() []string {
+	algorithms := make([]string, 0, len(supportedEncryptionAlgorithms))
+	for _, alg := range supportedEncryptionAlgorithms {
+		algorithms = append(algorithms, string(alg))
+	}
+	return algorithms
+}
+
+// GetSupportedEncryptionEncodings returns the JWE content-encryption algorithms accepted for request objects.
+func GetSupportedEncryptionEncodings() []string {
+	encodings := make([]string, 0, len(supportedEncryptionEncodings))
+	for _, enc := range supportedEncryptionEncodings {
+		encodings = append(encodings, string(enc))
+	}
+	return encodings
+}
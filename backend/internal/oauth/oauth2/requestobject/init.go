@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package requestobject
+
+import (
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/jwksresolver"
+	syshttp "github.com/asgardeo/thunder/internal/system/http"
+	"github.com/asgardeo/thunder/internal/system/jose/jwe"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+)
+
+// Initialize creates the request object service. httpClient is used to fetch request objects passed
+// by reference and must be pre-configured with timeouts and SSRF protection.
+func Initialize(
+	jwtService jwt.JWTServiceInterface,
+	jweService jwe.JWEServiceInterface,
+	jwksResolver *jwksresolver.Resolver,
+	httpClient syshttp.HTTPClientInterface,
+) RequestObjectServiceInterface {
+	return newRequestObjectService(jwtService, jweService, jwksResolver, httpClient)
+}
@@ -0,0 +1,28 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package requestobject
+
+// RequestObject holds the authorization request parameters carried by a verified request object.
+type RequestObject struct {
+	// Params maps each single-valued authorization request parameter to its value. Structured values,
+	// such as the claims parameter, are held in their JSON serialization.
+	Params map[string]string
+	// Resources lists the resource indicators (RFC 8707) requested by the request object.
+	Resources []string
+}
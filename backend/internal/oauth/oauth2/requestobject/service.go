@@ -0,0 +1,322 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package requestobject
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/jwksresolver"
+	"github.com/asgardeo/thunder/internal/system/config"
+	syshttp "github.com/asgardeo/thunder/internal/system/http"
+	"github.com/asgardeo/thunder/internal/system/jose/jwe"
+	"github.com/asgardeo/thunder/internal/system/jose/jws"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+// RequestObjectServiceInterface defines the interface for resolving JWT-secured authorization requests.
+type RequestObjectServiceInterface interface {
+	ResolveRequestObject(
+		ctx context.Context, request string, requestURI string, oauthApp *inboundmodel.OAuthClient,
+	) (*RequestObject, string, string)
+}
+
+// requestObjectService implements RequestObjectServiceInterface.
+type requestObjectService struct {
+	jwtService   jwt.JWTServiceInterface
+	jweService   jwe.JWEServiceInterface
+	jwksResolver *jwksresolver.Resolver
+	httpClient   syshttp.HTTPClientInterface
+	logger       *log.Logger
+}
+
+// newRequestObjectService creates a new request object service instance.
+func newRequestObjectService(
+	jwtService jwt.JWTServiceInterface,
+	jweService jwe.JWEServiceInterface,
+	jwksResolver *jwksresolver.Resolver,
+	httpClient syshttp.HTTPClientInterface,
+) RequestObjectServiceInterface {
+	return &requestObjectService{
+		jwtService:   jwtService,
+		jweService:   jweService,
+		jwksResolver: jwksResolver,
+		httpClient:   httpClient,
+		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "RequestObjectService")),
+	}
+}
+
+// ResolveRequestObject resolves the request object passed by value in request, or by reference in
+// requestURI, and returns the authorization request parameters it carries. The request object must be
+// signed by the client with a key from its registered JWKS, issued by the client and addressed to this
+// server. It may additionally be encrypted to the server's key (nested JWT).
+//
+// Returns (errorCode, errorDescription) on failure. Empty errorCode means the request object is valid.
+func (s *requestObjectService) ResolveRequestObject(
+	ctx context.Context, request string, requestURI string, oauthApp *inboundmodel.OAuthClient,
+) (*RequestObject, string, string) {
+	if request != "" && requestURI != "" {
+		return nil, oauth2const.ErrorInvalidRequest,
+			"The request and request_uri parameters must not be used together"
+	}
+
+	if requestURI != "" {
+		var errCode, errMsg string
+		request, errCode, errMsg = s.fetchRequestObject(ctx, requestURI)
+		if errCode != "" {
+			return nil, errCode, errMsg
+		}
+	}
+
+	token, errCode, errMsg := s.decryptRequestObject(request)
+	if errCode != "" {
+		return nil, errCode, errMsg
+	}
+
+	claims, errCode, errMsg := s.verifyRequestObject(ctx, token, oauthApp)
+	if errCode != "" {
+		return nil, errCode, errMsg
+	}
+
+	return buildRequestObject(claims, oauthApp.ClientID)
+}
+
+// fetchRequestObject retrieves a request object passed by reference from a client-hosted https URI.
+func (s *requestObjectService) fetchRequestObject(ctx context.Context, requestURI string) (string, string, string) {
+	if !config.GetServerRuntime().Config.OAuth.RequestObject.AllowRequestURI {
+		return "", oauth2const.ErrorRequestURINotSupported,
+			"Only request_uri values issued by the pushed authorization request endpoint are supported"
+	}
+
+	parsed, err := url.Parse(requestURI)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+		return "", oauth2const.ErrorInvalidRequestURI, "The request_uri must be an absolute https URI"
+	}
+	if err := syshttp.IsSSRFSafeURL(requestURI); err != nil {
+		s.logger.Debug("Request URI is not SSRF-safe", log.String("host", parsed.Host), log.Error(err))
+		return "", oauth2const.ErrorInvalidRequestURI, "The request_uri is not reachable"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURI, nil)
+	if err != nil {
+		return "", oauth2const.ErrorInvalidRequestURI, "The request_uri is not reachable"
+	}
+	req.Header.Set("Accept", requestObjectContentType)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		s.logger.Debug("Failed to fetch the request object", log.String("host", parsed.Host), log.Error(err))
+		return "", oauth2const.ErrorInvalidRequestURI, "The request_uri is not reachable"
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		s.logger.Debug("Request URI returned non-200 status",
+			log.String("host", parsed.Host), log.Int("statusCode", resp.StatusCode))
+		return "", oauth2const.ErrorInvalidRequestURI, "The request_uri is not reachable"
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxRequestObjectBytes+1))
+	if err != nil || len(body) > maxRequestObjectBytes {
+		return "", oauth2const.ErrorInvalidRequestURI, "The request_uri did not return a valid request object"
+	}
+
+	return strings.TrimSpace(string(body)), "", ""
+}
+
+// decryptRequestObject decrypts a request object encrypted to the server's key and returns the signed
+// request object it wraps. Request objects in JWS compact serialization are returned unchanged.
+func (s *requestObjectService) decryptRequestObject(request string) (string, string, string) {
+	if strings.Count(request, ".") != 4 {
+		return request, "", ""
+	}
+
+	header, _, _, _, _, _, err := jwe.DecodeJWE(request)
+	if err != nil {
+		return "", oauth2const.ErrorInvalidRequestObject, "The request object is malformed"
+	}
+	alg, _ := header["alg"].(string)
+	enc, _ := header["enc"].(string)
+	if !slices.Contains(supportedEncryptionAlgorithms, jwe.KeyEncAlgorithm(alg)) ||
+		!slices.Contains(supportedEncryptionEncodings, jwe.ContentEncAlgorithm(enc)) {
+		return "", oauth2const.ErrorInvalidRequestObject,
+			"The request object is encrypted with an unsupported algorithm"
+	}
+
+	payload, svcErr := s.jweService.Decrypt(request)
+	if svcErr != nil {
+		s.logger.Debug("Failed to decrypt the request object", log.String("error", svcErr.Code))
+		return "", oauth2const.ErrorInvalidRequestObject, "The request object could not be decrypted"
+	}
+
+	return string(payload), "", ""
+}
+
+// verifyRequestObject verifies the client's signature and the iss, aud, exp and nbf claims of the
+// request object, and returns its claims.
+func (s *requestObjectService) verifyRequestObject(
+	ctx context.Context, token string, oauthApp *inboundmodel.OAuthClient,
+) (map[string]interface{}, string, string) {
+	header, err := jws.DecodeHeader(token)
+	if err != nil {
+		return nil, oauth2const.ErrorInvalidRequestObject, "The request object is malformed"
+	}
+
+	// Unsigned and symmetrically signed request objects are rejected.
+	alg, _ := header["alg"].(string)
+	if !slices.Contains(supportedSigningAlgorithms, jws.Algorithm(alg)) {
+		return nil, oauth2const.ErrorInvalidRequestObject,
+			"The request object must be signed with a supported algorithm"
+	}
+	if oauthApp.Certificate == nil || oauthApp.Certificate.Type == "" {
+		return nil, oauth2const.ErrorInvalidRequestObject,
+			"The client has no registered keys to verify the request object"
+	}
+	kid, _ := header["kid"].(string)
+
+	publicKey, svcErr := s.jwksResolver.ResolveVerificationKey(ctx, oauthApp.Certificate, kid, alg)
+	if svcErr != nil {
+		if svcErr.Code == jwksresolver.ErrorVerificationKeyNotFound.Code {
+			return nil, oauth2const.ErrorInvalidRequestObject, "The request object signature is invalid"
+		}
+		s.logger.Error("Failed to resolve the request object verification key",
+			log.MaskedString("clientID", oauthApp.ClientID))
+		return nil, oauth2const.ErrorServerError, "Failed to process the request object"
+	}
+
+	issuer := config.GetServerRuntime().Config.JWT.Issuer
+	if svcErr := s.jwtService.VerifyJWTWithPublicKey(token, publicKey, issuer, oauthApp.ClientID); svcErr != nil {
+		s.logger.Debug("Failed to verify the request object",
+			log.String("error", svcErr.ErrorDescription.DefaultValue))
+		return nil, oauth2const.ErrorInvalidRequestObject,
+			"The request object signature or claims are invalid"
+	}
+
+	claims, err := jwt.DecodeJWTPayload(token)
+	if err != nil {
+		return nil, oauth2const.ErrorInvalidRequestObject, "The request object is malformed"
+	}
+	return claims, "", ""
+}
+
+// buildRequestObject converts the claims of a verified request object into authorization request parameters.
+func buildRequestObject(claims map[string]interface{}, clientID string) (*RequestObject, string, string) {
+	requestObject := &RequestObject{Params: make(map[string]string, len(claims))}
+
+	for name, value := range claims {
+		if slices.Contains(registeredClaims, name) {
+			continue
+		}
+
+		switch name {
+		case oauth2const.RequestParamRequest, oauth2const.RequestParamRequestURI:
+			return nil, oauth2const.ErrorInvalidRequestObject,
+				"The request object must not contain the request or request_uri parameters"
+		case oauth2const.RequestParamResource:
+			resources, ok := parseResources(value)
+			if !ok {
+				return nil, oauth2const.ErrorInvalidRequestObject,
+					"The resource parameter of the request object is invalid"
+			}
+			requestObject.Resources = resources
+			if len(resources) > 0 {
+				requestObject.Params[name] = resources[0]
+			}
+			continue
+		case oauth2const.RequestParamAuthorizationDetails:
+			// Authorization details are conveyed as a JSON array in the request object (RFC 9396 §3).
+			details, ok := value.([]interface{})
+			if !ok {
+				return nil, oauth2const.ErrorInvalidRequestObject,
+					"The authorization_details parameter of the request object is invalid"
+			}
+			encoded, err := json.Marshal(details)
+			if err != nil {
+				return nil, oauth2const.ErrorInvalidRequestObject,
+					"The authorization_details parameter of the request object is invalid"
+			}
+			requestObject.Params[name] = string(encoded)
+			continue
+		}
+
+		param, ok := claimToParam(value)
+		if !ok {
+			return nil, oauth2const.ErrorInvalidRequestObject,
+				"The " + name + " parameter of the request object is invalid"
+		}
+		requestObject.Params[name] = param
+	}
+
+	requestedClientID, ok := requestObject.Params[oauth2const.RequestParamClientID]
+	if ok && requestedClientID != clientID {
+		return nil, oauth2const.ErrorInvalidRequestObject,
+			"The client_id of the request object does not match the client"
+	}
+
+	return requestObject, "", ""
+}
+
+// parseResources returns the resource indicators carried as a single string or an array of strings.
+func parseResources(value interface{}) ([]string, bool) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, true
+	case []interface{}:
+		resources := make([]string, 0, len(v))
+		for _, item := range v {
+			resource, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			resources = append(resources, resource)
+		}
+		return resources, true
+	default:
+		return nil, false
+	}
+}
+
+// claimToParam converts a request object claim into the string form of the equivalent query parameter.
+// Objects such as the claims parameter are kept in their JSON serialization.
+func claimToParam(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case map[string]interface{}:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(encoded), true
+	default:
+		return "", false
+	}
+}
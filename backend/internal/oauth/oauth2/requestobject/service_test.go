@@ -0,0 +1,391 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package requestobject
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	certmodel "github.com/asgardeo/thunder/internal/cert"
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	oauth2const "github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/jwksresolver"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/tests/mocks/httpmock"
+	"github.com/asgardeo/thunder/tests/mocks/jose/jwemock"
+	"github.com/asgardeo/thunder/tests/mocks/jose/jwtmock"
+)
+
+const (
+	testClientID   = "test-client"
+	testIssuer     = "https://localhost:8090"
+	testKeyID      = "request-signing-key"
+	testRequestURI = "https://client.example.com/requests/abc"
+)
+
+type RequestObjectServiceTestSuite struct {
+	suite.Suite
+	mockJWTService  *jwtmock.JWTServiceInterfaceMock
+	mockJWEService  *jwemock.JWEServiceInterfaceMock
+	mockHTTPClient  *httpmock.HTTPClientInterfaceMock
+	service         RequestObjectServiceInterface
+	oauthApp        *inboundmodel.OAuthClient
+	jwksCertificate string
+}
+
+func TestRequestObjectServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RequestObjectServiceTestSuite))
+}
+
+func (suite *RequestObjectServiceTestSuite) SetupSuite() {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+	jwks, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]interface{}{{
+			"kty": "EC",
+			"crv": "P-256",
+			"kid": testKeyID,
+			"x":   base64.RawURLEncoding.EncodeToString(privateKey.X.FillBytes(make([]byte, 32))),
+			"y":   base64.RawURLEncoding.EncodeToString(privateKey.Y.FillBytes(make([]byte, 32))),
+		}},
+	})
+	suite.Require().NoError(err)
+	suite.jwksCertificate = string(jwks)
+}
+
+func (suite *RequestObjectServiceTestSuite) SetupTest() {
+	suite.initRuntime(false)
+	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.mockJWEService = jwemock.NewJWEServiceInterfaceMock(suite.T())
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.service = newRequestObjectService(suite.mockJWTService, suite.mockJWEService,
+		jwksresolver.Initialize(nil), suite.mockHTTPClient)
+	suite.oauthApp = &inboundmodel.OAuthClient{
+		ClientID: testClientID,
+		Certificate: &inboundmodel.Certificate{
+			Type:  certmodel.CertificateTypeJWKS,
+			Value: suite.jwksCertificate,
+		},
+	}
+}
+
+func (suite *RequestObjectServiceTestSuite) initRuntime(allowRequestURI bool) {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		JWT: config.JWTConfig{Issuer: testIssuer},
+		OAuth: config.OAuthConfig{
+			RequestObject: config.RequestObjectConfig{AllowRequestURI: allowRequestURI},
+		},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+}
+
+// buildToken builds a compact JWS with the given header and claims. The signature is not computed
+// since signature verification is delegated to the mocked JWT service.
+func buildToken(header, claims map[string]interface{}) string {
+	headerJSON, _ := json.Marshal(header)
+	claimsJSON, _ := json.Marshal(claims)
+	return base64.RawURLEncoding.EncodeToString(headerJSON) + "." +
+		base64.RawURLEncoding.EncodeToString(claimsJSON) + ".signature"
+}
+
+// buildEncryptedToken builds a compact JWE with the given header and placeholder segments.
+func buildEncryptedToken(header map[string]interface{}) string {
+	headerJSON, _ := json.Marshal(header)
+	segment := base64.RawURLEncoding.EncodeToString([]byte("segment"))
+	return base64.RawURLEncoding.EncodeToString(headerJSON) + "." +
+		strings.Join([]string{segment, segment, segment, segment}, ".")
+}
+
+func signedHeader() map[string]interface{} {
+	return map[string]interface{}{"alg": "ES256", "kid": testKeyID, "typ": "oauth-authz-req+jwt"}
+}
+
+func requestClaims(extra map[string]interface{}) map[string]interface{} {
+	claims := map[string]interface{}{
+		"iss":           testClientID,
+		"aud":           testIssuer,
+		"exp":           time.Now().Add(5 * time.Minute).Unix(),
+		"client_id":     testClientID,
+		"response_type": "code",
+		"redirect_uri":  "https://client.example.com/callback",
+		"scope":         "openid profile",
+	}
+	for key, value := range extra {
+		claims[key] = value
+	}
+	return claims
+}
+
+func (suite *RequestObjectServiceTestSuite) expectVerification(token string, svcErr *serviceerror.ServiceError) {
+	suite.mockJWTService.On("VerifyJWTWithPublicKey", token, mock.Anything, testIssuer, testClientID).
+		Return(svcErr).Once()
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_Success() {
+	token := buildToken(signedHeader(), requestClaims(map[string]interface{}{
+		"max_age":  float64(300),
+		"claims":   map[string]interface{}{"id_token": map[string]interface{}{"email": nil}},
+		"resource": []interface{}{"https://api.example.com", "https://other.example.com"},
+	}))
+	suite.expectVerification(token, nil)
+
+	requestObject, errCode, _ := suite.service.ResolveRequestObject(context.Background(), token, "", suite.oauthApp)
+
+	suite.Empty(errCode)
+	suite.Require().NotNil(requestObject)
+	suite.Equal("code", requestObject.Params[oauth2const.RequestParamResponseType])
+	suite.Equal("openid profile", requestObject.Params[oauth2const.RequestParamScope])
+	suite.Equal("300", requestObject.Params["max_age"])
+	suite.JSONEq(`{"id_token":{"email":null}}`, requestObject.Params[oauth2const.RequestParamClaims])
+	suite.Equal([]string{"https://api.example.com", "https://other.example.com"}, requestObject.Resources)
+	suite.Equal("https://api.example.com", requestObject.Params[oauth2const.RequestParamResource])
+	// JWT claims are not authorization request parameters.
+	suite.NotContains(requestObject.Params, "iss")
+	suite.NotContains(requestObject.Params, "exp")
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_RequestAndRequestURI() {
+	requestObject, errCode, _ := suite.service.ResolveRequestObject(
+		context.Background(), "token", testRequestURI, suite.oauthApp)
+
+	suite.Nil(requestObject)
+	suite.Equal(oauth2const.ErrorInvalidRequest, errCode)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_UnsignedRejected() {
+	token := buildToken(map[string]interface{}{"alg": "none"}, requestClaims(nil))
+
+	_, errCode, _ := suite.service.ResolveRequestObject(context.Background(), token, "", suite.oauthApp)
+
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errCode)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_SymmetricAlgorithmRejected() {
+	token := buildToken(map[string]interface{}{"alg": "HS256"}, requestClaims(nil))
+
+	_, errCode, _ := suite.service.ResolveRequestObject(context.Background(), token, "", suite.oauthApp)
+
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errCode)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_Malformed() {
+	_, errCode, _ := suite.service.ResolveRequestObject(context.Background(), "not-a-jwt", "", suite.oauthApp)
+
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errCode)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_NoRegisteredKeys() {
+	suite.oauthApp.Certificate = nil
+	token := buildToken(signedHeader(), requestClaims(nil))
+
+	_, errCode, _ := suite.service.ResolveRequestObject(context.Background(), token, "", suite.oauthApp)
+
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errCode)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_UnknownKeyID() {
+	header := signedHeader()
+	header["kid"] = "unknown-key"
+	token := buildToken(header, requestClaims(nil))
+
+	_, errCode, _ := suite.service.ResolveRequestObject(context.Background(), token, "", suite.oauthApp)
+
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errCode)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_VerificationFailed() {
+	token := buildToken(signedHeader(), requestClaims(nil))
+	suite.expectVerification(token, &serviceerror.ServiceError{Code: "JWT-1001"})
+
+	_, errCode, _ := suite.service.ResolveRequestObject(context.Background(), token, "", suite.oauthApp)
+
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errCode)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_ClientIDMismatch() {
+	token := buildToken(signedHeader(), requestClaims(map[string]interface{}{"client_id": "other-client"}))
+	suite.expectVerification(token, nil)
+
+	requestObject, errCode, _ := suite.service.ResolveRequestObject(
+		context.Background(), token, "", suite.oauthApp)
+
+	suite.Nil(requestObject)
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errCode)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_NestedRequestURIRejected() {
+	token := buildToken(signedHeader(), requestClaims(map[string]interface{}{"request_uri": testRequestURI}))
+	suite.expectVerification(token, nil)
+
+	_, errCode, _ := suite.service.ResolveRequestObject(context.Background(), token, "", suite.oauthApp)
+
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errCode)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_InvalidParameterType() {
+	token := buildToken(signedHeader(), requestClaims(map[string]interface{}{"prompt": []interface{}{"login"}}))
+	suite.expectVerification(token, nil)
+
+	_, errCode, _ := suite.service.ResolveRequestObject(context.Background(), token, "", suite.oauthApp)
+
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errCode)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_AuthorizationDetails() {
+	details := []interface{}{map[string]interface{}{"type": "payment_initiation", "amount": "50.00"}}
+	token := buildToken(signedHeader(), requestClaims(map[string]interface{}{"authorization_details": details}))
+	suite.expectVerification(token, nil)
+
+	requestObject, errCode, _ := suite.service.ResolveRequestObject(
+		context.Background(), token, "", suite.oauthApp)
+
+	suite.Empty(errCode)
+	suite.Require().NotNil(requestObject)
+	suite.JSONEq(`[{"type":"payment_initiation","amount":"50.00"}]`,
+		requestObject.Params[oauth2const.RequestParamAuthorizationDetails])
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_InvalidAuthorizationDetails() {
+	token := buildToken(signedHeader(), requestClaims(map[string]interface{}{"authorization_details": "payment"}))
+	suite.expectVerification(token, nil)
+
+	_, errCode, _ := suite.service.ResolveRequestObject(context.Background(), token, "", suite.oauthApp)
+
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errCode)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_Encrypted() {
+	token := buildToken(signedHeader(), requestClaims(nil))
+	encrypted := buildEncryptedToken(map[string]interface{}{"alg": "RSA-OAEP-256", "enc": "A256GCM", "cty": "JWT"})
+	suite.mockJWEService.On("Decrypt", encrypted).Return([]byte(token), nil).Once()
+	suite.expectVerification(token, nil)
+
+	requestObject, errCode, _ := suite.service.ResolveRequestObject(
+		context.Background(), encrypted, "", suite.oauthApp)
+
+	suite.Empty(errCode)
+	suite.Require().NotNil(requestObject)
+	suite.Equal("code", requestObject.Params[oauth2const.RequestParamResponseType])
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_UnsupportedEncryptionAlgorithm() {
+	encrypted := buildEncryptedToken(map[string]interface{}{"alg": "A128KW", "enc": "A256GCM"})
+
+	_, errCode, _ := suite.service.ResolveRequestObject(context.Background(), encrypted, "", suite.oauthApp)
+
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errCode)
+	suite.mockJWEService.AssertNotCalled(suite.T(), "Decrypt", mock.Anything)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_DecryptionFailed() {
+	encrypted := buildEncryptedToken(map[string]interface{}{"alg": "RSA-OAEP", "enc": "A128CBC-HS256"})
+	suite.mockJWEService.On("Decrypt", encrypted).Return(nil, &serviceerror.ServiceError{Code: "JWE-1003"}).Once()
+
+	_, errCode, _ := suite.service.ResolveRequestObject(context.Background(), encrypted, "", suite.oauthApp)
+
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errCode)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_RequestURINotAllowed() {
+	_, errCode, _ := suite.service.ResolveRequestObject(context.Background(), "", testRequestURI, suite.oauthApp)
+
+	suite.Equal(oauth2const.ErrorRequestURINotSupported, errCode)
+	suite.mockHTTPClient.AssertNotCalled(suite.T(), "Do", mock.Anything)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_RequestURI() {
+	suite.initRuntime(true)
+	token := buildToken(signedHeader(), requestClaims(nil))
+	suite.mockHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == testRequestURI && req.Header.Get("Accept") == requestObjectContentType
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(token + "\n")),
+	}, nil).Once()
+	suite.expectVerification(token, nil)
+
+	requestObject, errCode, _ := suite.service.ResolveRequestObject(
+		context.Background(), "", testRequestURI, suite.oauthApp)
+
+	suite.Empty(errCode)
+	suite.Require().NotNil(requestObject)
+	suite.Equal("openid profile", requestObject.Params[oauth2const.RequestParamScope])
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_RequestURIMustUseHTTPS() {
+	suite.initRuntime(true)
+
+	_, errCode, _ := suite.service.ResolveRequestObject(
+		context.Background(), "", "http://client.example.com/requests/abc", suite.oauthApp)
+
+	suite.Equal(oauth2const.ErrorInvalidRequestURI, errCode)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_RequestURIPrivateAddress() {
+	suite.initRuntime(true)
+
+	_, errCode, _ := suite.service.ResolveRequestObject(
+		context.Background(), "", "https://127.0.0.1/requests/abc", suite.oauthApp)
+
+	suite.Equal(oauth2const.ErrorInvalidRequestURI, errCode)
+	suite.mockHTTPClient.AssertNotCalled(suite.T(), "Do", mock.Anything)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_RequestURIFetchFailed() {
+	suite.initRuntime(true)
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("connection refused")).Once()
+
+	_, errCode, _ := suite.service.ResolveRequestObject(context.Background(), "", testRequestURI, suite.oauthApp)
+
+	suite.Equal(oauth2const.ErrorInvalidRequestURI, errCode)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolveRequestObject_RequestURINotFound() {
+	suite.initRuntime(true)
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(&http.Response{
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader("")),
+	}, nil).Once()
+
+	_, errCode, _ := suite.service.ResolveRequestObject(context.Background(), "", testRequestURI, suite.oauthApp)
+
+	suite.Equal(oauth2const.ErrorInvalidRequestURI, errCode)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestGetSupportedAlgorithms() {
+	suite.Contains(GetSupportedSigningAlgorithms(), "PS256")
+	suite.NotContains(GetSupportedSigningAlgorithms(), "none")
+	suite.Equal([]string{"RSA-OAEP", "RSA-OAEP-256"}, GetSupportedEncryptionAlgorithms())
+	suite.Equal([]string{"A128CBC-HS256", "A256GCM"}, GetSupportedEncryptionEncodings())
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package revocation
+
+import (
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/clientauth"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/system/log"
+	sysutils "github.com/asgardeo/thunder/internal/system/utils"
+)
+
+// tokenRevocationHandler handles OAuth 2.0 token revocation requests.
+type tokenRevocationHandler struct {
+	service TokenRevocationServiceInterface
+	logger  *log.Logger
+}
+
+// newTokenRevocationHandler creates a new token revocation handler (internal use).
+func newTokenRevocationHandler(revocationService TokenRevocationServiceInterface) *tokenRevocationHandler {
+	return &tokenRevocationHandler{
+		service: revocationService,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "TokenRevocationHandler")),
+	}
+}
+
+// HandleRevoke handles token revocation requests as defined in RFC 7009.
+func (h *tokenRevocationHandler) HandleRevoke(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Client authentication is handled by the ClientAuthMiddleware.
+	clientInfo := clientauth.GetOAuthClient(ctx)
+	if clientInfo == nil {
+		h.logger.Error("OAuth client not found in context - ClientAuthMiddleware must be applied")
+		sysutils.WriteJSONError(w, constants.ErrorServerError,
+			"Something went wrong", http.StatusInternalServerError, nil)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		sysutils.WriteJSONError(w, constants.ErrorInvalidRequest, "Failed to decode request body",
+			http.StatusBadRequest, nil)
+		return
+	}
+
+	token := r.FormValue(constants.RequestParamToken)
+	if token == "" {
+		sysutils.WriteJSONError(w, constants.ErrorInvalidRequest, "Token parameter is required",
+			http.StatusBadRequest, nil)
+		return
+	}
+	tokenTypeHint := r.FormValue(constants.RequestParamTokenTypeHint)
+
+	if errResp := h.service.RevokeToken(ctx, token, tokenTypeHint, clientInfo.ClientID); errResp != nil {
+		statusCode := http.StatusBadRequest
+		if errResp.Error == constants.ErrorServerError {
+			statusCode = http.StatusInternalServerError
+		}
+		sysutils.WriteJSONError(w, errResp.Error, errResp.ErrorDescription, statusCode, nil)
+		return
+	}
+
+	// RFC 7009 §2.2: the content of the response body is ignored by the client.
+	w.WriteHeader(http.StatusOK)
+}
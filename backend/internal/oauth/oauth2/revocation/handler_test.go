@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package revocation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/clientauth"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *TokenRevocationServiceInterfaceMock
+	handler     *tokenRevocationHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewTokenRevocationServiceInterfaceMock(s.T())
+	s.handler = newTokenRevocationHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) newRequest(form url.Values, withClient bool) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/revoke", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if withClient {
+		ctx := context.WithValue(req.Context(), clientauth.OAuthClientKey,
+			&clientauth.OAuthClientInfo{ClientID: testClientID})
+		req = req.WithContext(ctx)
+	}
+	return req
+}
+
+func (s *HandlerTestSuite) TestHandleRevoke_Success() {
+	form := url.Values{"token": {"some-token"}, "token_type_hint": {"refresh_token"}}
+	s.mockService.On("RevokeToken", mock.Anything, "some-token", "refresh_token", testClientID).Return(nil)
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleRevoke(rr, s.newRequest(form, true))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Empty(rr.Body.String())
+}
+
+func (s *HandlerTestSuite) TestHandleRevoke_MissingClient() {
+	rr := httptest.NewRecorder()
+	s.handler.HandleRevoke(rr, s.newRequest(url.Values{"token": {"some-token"}}, false))
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleRevoke_MissingToken() {
+	rr := httptest.NewRecorder()
+	s.handler.HandleRevoke(rr, s.newRequest(url.Values{}, true))
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	s.Contains(rr.Body.String(), constants.ErrorInvalidRequest)
+}
+
+func (s *HandlerTestSuite) TestHandleRevoke_ServiceError() {
+	s.mockService.On("RevokeToken", mock.Anything, "some-token", "", testClientID).Return(
+		&model.ErrorResponse{Error: constants.ErrorUnsupportedTokenType, ErrorDescription: "unsupported"})
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleRevoke(rr, s.newRequest(url.Values{"token": {"some-token"}}, true))
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	s.Contains(rr.Body.String(), constants.ErrorUnsupportedTokenType)
+}
+
+func (s *HandlerTestSuite) TestHandleRevoke_ServerError() {
+	s.mockService.On("RevokeToken", mock.Anything, "some-token", "", testClientID).Return(
+		&model.ErrorResponse{Error: constants.ErrorServerError, ErrorDescription: "failed"})
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleRevoke(rr, s.newRequest(url.Values{"token": {"some-token"}}, true))
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package revocation
+
+import (
+	"context"
+	"net/http"
+
+	authnprovidermgr "github.com/asgardeo/thunder/internal/authnprovider/manager"
+	"github.com/asgardeo/thunder/internal/inboundclient"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/clientauth"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/discovery"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/internal/system/middleware"
+)
+
+// Initialize initializes the token revocation handler and registers its routes.
+// Returns the TokenRevocationServiceInterface so that token consumers can reject revoked tokens.
+func Initialize(
+	mux *http.ServeMux,
+	jwtService jwt.JWTServiceInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+	discoveryService discovery.DiscoveryServiceInterface,
+) TokenRevocationServiceInterface {
+	store := initializeRevokedTokenStore()
+	revocationService := newTokenRevocationService(jwtService, store)
+	revocationHandler := newTokenRevocationHandler(revocationService)
+	registerRoutes(mux, revocationHandler, inboundClient, authnProvider, jwtService, discoveryService)
+	return revocationService
+}
+
+// initializeRevokedTokenStore selects the revoked token store implementation based on the
+// configured runtime DB type.
+func initializeRevokedTokenStore() revokedTokenStoreInterface {
+	deploymentID := config.GetServerRuntime().Config.Server.Identifier
+
+	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		return newRedisRevokedTokenStore(provider.GetRedisProvider(), deploymentID)
+	}
+	return newRevokedTokenStore(deploymentID)
+}
+
+// registerRoutes registers the token revocation endpoint route with client authentication middleware.
+func registerRoutes(
+	mux *http.ServeMux,
+	revocationHandler *tokenRevocationHandler,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+	jwtService jwt.JWTServiceInterface,
+	discoveryService discovery.DiscoveryServiceInterface,
+) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST", "OPTIONS"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	endpointURL := discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background()).RevocationEndpoint
+	clientAuthMiddleware := clientauth.ClientAuthMiddleware(inboundClient, authnProvider, jwtService, endpointURL)
+	handler := clientAuthMiddleware(http.HandlerFunc(revocationHandler.HandleRevoke))
+
+	pattern, wrappedHandler := middleware.WithCORS(
+		"POST /oauth2/revoke",
+		handler.ServeHTTP,
+		opts,
+	)
+	mux.HandleFunc(pattern, wrappedHandler)
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /oauth2/revoke",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package revocation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// revocationRedisClient abstracts the Redis commands used by the revoked token store.
+type revocationRedisClient interface {
+	Set(ctx context.Context, key string, value any, expiration time.Duration) *redis.StatusCmd
+	Exists(ctx context.Context, keys ...string) *redis.IntCmd
+}
+
+// redisRevokedTokenStore is the Redis-backed implementation of revokedTokenStoreInterface.
+// Entries are written with a TTL matching the remaining token lifetime so that Redis evicts
+// them automatically once the token would have expired.
+type redisRevokedTokenStore struct {
+	client       revocationRedisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// newRedisRevokedTokenStore creates a new Redis-backed revoked token store.
+func newRedisRevokedTokenStore(
+	p provider.RedisProviderInterface, deploymentID string,
+) revokedTokenStoreInterface {
+	return &redisRevokedTokenStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: deploymentID,
+	}
+}
+
+// revokedTokenKey builds the Redis key for a revoked token identifier.
+func (s *redisRevokedTokenStore) revokedTokenKey(tokenID string) string {
+	return fmt.Sprintf("%s:runtime:%s:revoked_token:%s", s.keyPrefix, s.deploymentID, tokenID)
+}
+
+// Revoke records the given token identifier as revoked until the given expiry time.
+func (s *redisRevokedTokenStore) Revoke(ctx context.Context, tokenID string, expiryTime time.Time) error {
+	ttl := time.Until(expiryTime)
+	if ttl <= 0 {
+		// The token has already expired; there is nothing left to revoke.
+		return nil
+	}
+
+	if err := s.client.Set(ctx, s.revokedTokenKey(tokenID), 1, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store revoked token in Redis: %w", err)
+	}
+	return nil
+}
+
+// IsRevoked checks whether the given token identifier has been revoked.
+func (s *redisRevokedTokenStore) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	count, err := s.client.Exists(ctx, s.revokedTokenKey(tokenID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check revoked token in Redis: %w", err)
+	}
+	return count > 0, nil
+}
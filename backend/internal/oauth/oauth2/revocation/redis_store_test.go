@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package revocation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	redisTestKeyPrefix    = "thunderid"
+	redisTestDeploymentID = "test-deployment-id"
+)
+
+type RedisStoreTestSuite struct {
+	suite.Suite
+	mockClient *revocationRedisClientMock
+	store      *redisRevokedTokenStore
+	ctx        context.Context
+}
+
+func TestRedisStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisStoreTestSuite))
+}
+
+func (s *RedisStoreTestSuite) SetupTest() {
+	s.mockClient = newRevocationRedisClientMock(s.T())
+	s.store = &redisRevokedTokenStore{
+		client:       s.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: redisTestDeploymentID,
+	}
+	s.ctx = context.Background()
+}
+
+func (s *RedisStoreTestSuite) buildRedisKey(tokenID string) string {
+	return fmt.Sprintf("%s:runtime:%s:revoked_token:%s", redisTestKeyPrefix, redisTestDeploymentID, tokenID)
+}
+
+func (s *RedisStoreTestSuite) TestRevokedTokenKey() {
+	s.Equal(s.buildRedisKey(testTokenID), s.store.revokedTokenKey(testTokenID))
+}
+
+// Tests for Revoke
+
+func (s *RedisStoreTestSuite) TestRevoke_Success() {
+	statusCmd := redis.NewStatusCmd(s.ctx)
+	s.mockClient.On("Set", s.ctx, s.buildRedisKey(testTokenID), 1,
+		mock.MatchedBy(func(ttl time.Duration) bool {
+			return ttl > 59*time.Minute && ttl <= time.Hour
+		}),
+	).Return(statusCmd)
+
+	err := s.store.Revoke(s.ctx, testTokenID, time.Now().Add(time.Hour))
+
+	s.NoError(err)
+}
+
+func (s *RedisStoreTestSuite) TestRevoke_AlreadyExpired() {
+	err := s.store.Revoke(s.ctx, testTokenID, time.Now().Add(-time.Minute))
+
+	s.NoError(err)
+	s.mockClient.AssertNotCalled(s.T(), "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *RedisStoreTestSuite) TestRevoke_SetError() {
+	statusCmd := redis.NewStatusCmd(s.ctx)
+	statusCmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("Set", s.ctx, mock.Anything, mock.Anything, mock.Anything).Return(statusCmd)
+
+	err := s.store.Revoke(s.ctx, testTokenID, time.Now().Add(time.Hour))
+
+	s.Error(err)
+	s.Contains(err.Error(), "failed to store revoked token in Redis")
+}
+
+// Tests for IsRevoked
+
+func (s *RedisStoreTestSuite) TestIsRevoked_Found() {
+	intCmd := redis.NewIntCmd(s.ctx)
+	intCmd.SetVal(1)
+	s.mockClient.On("Exists", s.ctx, s.buildRedisKey(testTokenID)).Return(intCmd)
+
+	revoked, err := s.store.IsRevoked(s.ctx, testTokenID)
+
+	s.NoError(err)
+	s.True(revoked)
+}
+
+func (s *RedisStoreTestSuite) TestIsRevoked_NotFound() {
+	intCmd := redis.NewIntCmd(s.ctx)
+	intCmd.SetVal(0)
+	s.mockClient.On("Exists", s.ctx, s.buildRedisKey(testTokenID)).Return(intCmd)
+
+	revoked, err := s.store.IsRevoked(s.ctx, testTokenID)
+
+	s.NoError(err)
+	s.False(revoked)
+}
+
+func (s *RedisStoreTestSuite) TestIsRevoked_Error() {
+	intCmd := redis.NewIntCmd(s.ctx)
+	intCmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("Exists", s.ctx, s.buildRedisKey(testTokenID)).Return(intCmd)
+
+	revoked, err := s.store.IsRevoked(s.ctx, testTokenID)
+
+	s.Error(err)
+	s.False(revoked)
+	s.Contains(err.Error(), "failed to check revoked token in Redis")
+}
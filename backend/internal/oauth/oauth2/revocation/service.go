@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package revocation implements the OAuth 2.0 token revocation endpoint (RFC 7009).
+package revocation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+// claimAccessTokenSub is the refresh token claim carrying the subject of the associated access token.
+// Its presence distinguishes refresh tokens from other self-contained tokens issued by the server.
+const claimAccessTokenSub = "access_token_sub"
+
+// TokenRevocationServiceInterface defines the interface for OAuth 2.0 token revocation.
+type TokenRevocationServiceInterface interface {
+	RevokeToken(ctx context.Context, token, tokenTypeHint, clientID string) *model.ErrorResponse
+	IsTokenRevoked(ctx context.Context, tokenID string) (bool, error)
+}
+
+// tokenRevocationService implements the TokenRevocationServiceInterface.
+type tokenRevocationService struct {
+	jwtService jwt.JWTServiceInterface
+	store      revokedTokenStoreInterface
+	logger     *log.Logger
+}
+
+// newTokenRevocationService creates a new tokenRevocationService instance (internal use).
+func newTokenRevocationService(
+	jwtService jwt.JWTServiceInterface, store revokedTokenStoreInterface,
+) TokenRevocationServiceInterface {
+	return &tokenRevocationService{
+		jwtService: jwtService,
+		store:      store,
+		logger:     log.GetLogger().With(log.String(log.LoggerKeyComponentName, "TokenRevocationService")),
+	}
+}
+
+// RevokeToken revokes the given access or refresh token on behalf of the given client.
+// Per RFC 7009 §2.2, invalid, expired or unknown tokens do not result in an error. The
+// token_type_hint is only advisory since issued tokens are self-describing.
+func (s *tokenRevocationService) RevokeToken(
+	ctx context.Context, token, tokenTypeHint, clientID string,
+) *model.ErrorResponse {
+	if err := s.jwtService.VerifyJWT(token, "", ""); err != nil {
+		s.logger.Debug("Ignoring revocation of an invalid token",
+			log.String("error", err.Error.DefaultValue))
+		return nil
+	}
+
+	header, payload, err := jwt.DecodeJWT(token)
+	if err != nil {
+		s.logger.Debug("Ignoring revocation of an undecodable token", log.Error(err))
+		return nil
+	}
+
+	tokenType, owner := resolveTokenTypeAndOwner(header, payload)
+	if tokenType == "" {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorUnsupportedTokenType,
+			ErrorDescription: "The presented token type is not supported for revocation",
+		}
+	}
+	if tokenTypeHint != "" && tokenTypeHint != tokenType {
+		s.logger.Debug("Token type hint does not match the presented token",
+			log.String("hint", tokenTypeHint), log.String("tokenType", tokenType))
+	}
+
+	if owner != clientID {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorUnauthorizedClient,
+			ErrorDescription: "The token was not issued to the requesting client",
+		}
+	}
+
+	tokenID, _ := payload["jti"].(string)
+	exp, _ := payload[constants.ClaimExp].(float64)
+	if tokenID == "" || exp == 0 {
+		s.logger.Debug("Ignoring revocation of a token without jti or exp claims")
+		return nil
+	}
+
+	if err := s.store.Revoke(ctx, tokenID, time.Unix(int64(exp), 0)); err != nil {
+		s.logger.Error("Failed to record revoked token", log.Error(err))
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to revoke the token",
+		}
+	}
+
+	s.logger.Debug("Token revoked", log.String("client_id", clientID), log.String("tokenType", tokenType))
+	return nil
+}
+
+// IsTokenRevoked checks whether the token with the given jti has been revoked.
+func (s *tokenRevocationService) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	if tokenID == "" {
+		return false, errors.New("token identifier is required")
+	}
+	return s.store.IsRevoked(ctx, tokenID)
+}
+
+// resolveTokenTypeAndOwner determines whether the token is an access or refresh token and returns
+// the client the token was issued to. An empty token type is returned for unsupported tokens.
+func resolveTokenTypeAndOwner(header, payload map[string]interface{}) (string, string) {
+	if typ, _ := header["typ"].(string); typ == jwt.TokenTypeAccessToken {
+		clientID, _ := payload["client_id"].(string)
+		return constants.TokenTypeHintAccessToken, clientID
+	}
+	if _, ok := payload[claimAccessTokenSub].(string); ok {
+		// Refresh tokens are issued with the client as the subject.
+		clientID, _ := payload[constants.ClaimSub].(string)
+		return constants.TokenTypeHintRefreshToken, clientID
+	}
+	return "", ""
+}
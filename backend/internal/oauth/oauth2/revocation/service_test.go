@@ -0,0 +1,161 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package revocation
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/tests/mocks/jose/jwtmock"
+)
+
+const testClientID = "test-client"
+
+type ServiceTestSuite struct {
+	suite.Suite
+	mockJWTService *jwtmock.JWTServiceInterfaceMock
+	mockStore      *revokedTokenStoreInterfaceMock
+	service        TokenRevocationServiceInterface
+	ctx            context.Context
+	exp            int64
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	s.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(s.T())
+	s.mockStore = newRevokedTokenStoreInterfaceMock(s.T())
+	s.service = newTokenRevocationService(s.mockJWTService, s.mockStore)
+	s.ctx = context.Background()
+	s.exp = time.Now().Add(time.Hour).Unix()
+}
+
+func buildTestToken(header, payload map[string]interface{}) string {
+	headerJSON, _ := json.Marshal(header)
+	payloadJSON, _ := json.Marshal(payload)
+	return base64.RawURLEncoding.EncodeToString(headerJSON) + "." +
+		base64.RawURLEncoding.EncodeToString(payloadJSON) + ".signature"
+}
+
+func (s *ServiceTestSuite) accessToken(clientID string) string {
+	return buildTestToken(
+		map[string]interface{}{"alg": "RS256", "typ": jwt.TokenTypeAccessToken},
+		map[string]interface{}{"sub": "user-1", "client_id": clientID, "jti": testTokenID, "exp": s.exp},
+	)
+}
+
+func (s *ServiceTestSuite) refreshToken(clientID string) string {
+	return buildTestToken(
+		map[string]interface{}{"alg": "RS256", "typ": jwt.TokenTypeJWT},
+		map[string]interface{}{"sub": clientID, "access_token_sub": "user-1", "jti": testTokenID, "exp": s.exp},
+	)
+}
+
+func (s *ServiceTestSuite) TestRevokeToken_AccessToken() {
+	token := s.accessToken(testClientID)
+	s.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
+	s.mockStore.On("Revoke", s.ctx, testTokenID, time.Unix(s.exp, 0)).Return(nil)
+
+	errResp := s.service.RevokeToken(s.ctx, token, constants.TokenTypeHintAccessToken, testClientID)
+
+	s.Nil(errResp)
+}
+
+func (s *ServiceTestSuite) TestRevokeToken_RefreshTokenWithMismatchedHint() {
+	token := s.refreshToken(testClientID)
+	s.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
+	s.mockStore.On("Revoke", s.ctx, testTokenID, time.Unix(s.exp, 0)).Return(nil)
+
+	errResp := s.service.RevokeToken(s.ctx, token, constants.TokenTypeHintAccessToken, testClientID)
+
+	s.Nil(errResp)
+}
+
+func (s *ServiceTestSuite) TestRevokeToken_InvalidTokenIsIgnored() {
+	token := s.accessToken(testClientID)
+	s.mockJWTService.On("VerifyJWT", token, "", "").Return(&serviceerror.InternalServerError)
+
+	errResp := s.service.RevokeToken(s.ctx, token, "", testClientID)
+
+	s.Nil(errResp)
+	s.mockStore.AssertNotCalled(s.T(), "Revoke", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestRevokeToken_UnsupportedTokenType() {
+	token := buildTestToken(
+		map[string]interface{}{"alg": "RS256", "typ": jwt.TokenTypeJWT},
+		map[string]interface{}{"sub": "user-1", "aud": testClientID, "jti": testTokenID, "exp": s.exp},
+	)
+	s.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
+
+	errResp := s.service.RevokeToken(s.ctx, token, "", testClientID)
+
+	s.NotNil(errResp)
+	s.Equal(constants.ErrorUnsupportedTokenType, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestRevokeToken_TokenOfAnotherClient() {
+	token := s.accessToken("other-client")
+	s.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
+
+	errResp := s.service.RevokeToken(s.ctx, token, "", testClientID)
+
+	s.NotNil(errResp)
+	s.Equal(constants.ErrorUnauthorizedClient, errResp.Error)
+	s.mockStore.AssertNotCalled(s.T(), "Revoke", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestRevokeToken_StoreError() {
+	token := s.refreshToken(testClientID)
+	s.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
+	s.mockStore.On("Revoke", s.ctx, testTokenID, mock.Anything).Return(errors.New("db down"))
+
+	errResp := s.service.RevokeToken(s.ctx, token, "", testClientID)
+
+	s.NotNil(errResp)
+	s.Equal(constants.ErrorServerError, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestIsTokenRevoked() {
+	s.mockStore.On("IsRevoked", s.ctx, testTokenID).Return(true, nil)
+
+	revoked, err := s.service.IsTokenRevoked(s.ctx, testTokenID)
+
+	s.NoError(err)
+	s.True(revoked)
+}
+
+func (s *ServiceTestSuite) TestIsTokenRevoked_EmptyTokenID() {
+	revoked, err := s.service.IsTokenRevoked(s.ctx, "")
+
+	s.Error(err)
+	s.False(revoked)
+}
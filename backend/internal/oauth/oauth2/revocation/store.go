@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package revocation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// revokedTokenStoreInterface defines the interface for tracking revoked token identifiers.
+// Entries only need to be retained until the revoked token would have expired on its own.
+type revokedTokenStoreInterface interface {
+	Revoke(ctx context.Context, tokenID string, expiryTime time.Time) error
+	IsRevoked(ctx context.Context, tokenID string) (bool, error)
+}
+
+// revokedTokenStore is the relational-DB-backed implementation of revokedTokenStoreInterface.
+type revokedTokenStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newRevokedTokenStore creates a new DB-backed revoked token store.
+func newRevokedTokenStore(deploymentID string) revokedTokenStoreInterface {
+	return &revokedTokenStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// Revoke records the given token identifier as revoked until the given expiry time.
+// Revoking an already revoked token is a no-op.
+func (s *revokedTokenStore) Revoke(ctx context.Context, tokenID string, expiryTime time.Time) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(
+		ctx, queryInsertRevokedToken, tokenID, s.deploymentID, expiryTime.UTC(),
+	); err != nil {
+		return fmt.Errorf("failed to insert revoked token: %w", err)
+	}
+	return nil
+}
+
+// IsRevoked checks whether the given token identifier has been revoked.
+// Entries past their expiry time are ignored as the token itself is no longer valid.
+func (s *revokedTokenStore) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(
+		ctx, queryCheckRevokedToken, tokenID, s.deploymentID, time.Now().UTC(),
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to query revoked token: %w", err)
+	}
+	return len(results) > 0, nil
+}
@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package revocation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/tests/mocks/database/providermock"
+)
+
+const (
+	testDeploymentID = "test-deployment-id"
+	testTokenID      = "0190d3f6-6b7a-7c3e-8a1b-1234567890ab"
+)
+
+type StoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *revokedTokenStore
+	ctx            context.Context
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (s *StoreTestSuite) SetupTest() {
+	s.mockDBProvider = &providermock.DBProviderInterfaceMock{}
+	s.mockDBClient = &providermock.DBClientInterfaceMock{}
+	s.store = &revokedTokenStore{
+		dbProvider:   s.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+}
+
+// Tests for Revoke
+
+func (s *StoreTestSuite) TestRevoke_Success() {
+	expiry := time.Now().Add(time.Hour)
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertRevokedToken,
+		testTokenID, testDeploymentID, expiry.UTC(),
+	).Return(int64(1), nil)
+
+	err := s.store.Revoke(s.ctx, testTokenID, expiry)
+
+	assert.NoError(s.T(), err)
+	s.mockDBClient.AssertExpectations(s.T())
+}
+
+func (s *StoreTestSuite) TestRevoke_DBClientError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db client error"))
+
+	err := s.store.Revoke(s.ctx, testTokenID, time.Now().Add(time.Hour))
+
+	assert.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "failed to get database client")
+}
+
+func (s *StoreTestSuite) TestRevoke_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertRevokedToken,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(int64(0), errors.New("insert failed"))
+
+	err := s.store.Revoke(s.ctx, testTokenID, time.Now().Add(time.Hour))
+
+	assert.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "failed to insert revoked token")
+}
+
+// Tests for IsRevoked
+
+func (s *StoreTestSuite) TestIsRevoked_Found() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryCheckRevokedToken,
+		testTokenID, testDeploymentID, mock.AnythingOfType("time.Time"),
+	).Return([]map[string]interface{}{{"token_id": testTokenID}}, nil)
+
+	revoked, err := s.store.IsRevoked(s.ctx, testTokenID)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), revoked)
+}
+
+func (s *StoreTestSuite) TestIsRevoked_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryCheckRevokedToken,
+		testTokenID, testDeploymentID, mock.AnythingOfType("time.Time"),
+	).Return([]map[string]interface{}{}, nil)
+
+	revoked, err := s.store.IsRevoked(s.ctx, testTokenID)
+
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), revoked)
+}
+
+func (s *StoreTestSuite) TestIsRevoked_QueryError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryCheckRevokedToken,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil, errors.New("query failed"))
+
+	revoked, err := s.store.IsRevoked(s.ctx, testTokenID)
+
+	assert.Error(s.T(), err)
+	assert.False(s.T(), revoked)
+}
@@ -19,11 +19,15 @@
 package token
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"time"
 
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/clientauth"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/dpop"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
 	sysconst "github.com/asgardeo/thunder/internal/system/constants"
 	"github.com/asgardeo/thunder/internal/system/log"
@@ -40,16 +44,22 @@ type TokenHandlerInterface interface {
 type tokenHandler struct {
 	tokenService     TokenServiceInterface
 	observabilitySvc observability.ObservabilityServiceInterface
+	dpopService      dpop.DPoPServiceInterface
+	tokenEndpoint    string
 }
 
 // newTokenHandler creates a new instance of tokenHandler.
 func newTokenHandler(
 	tokenService TokenServiceInterface,
 	observabilitySvc observability.ObservabilityServiceInterface,
+	dpopService dpop.DPoPServiceInterface,
+	tokenEndpoint string,
 ) TokenHandlerInterface {
 	return &tokenHandler{
 		tokenService:     tokenService,
 		observabilitySvc: observabilitySvc,
+		dpopService:      dpopService,
+		tokenEndpoint:    tokenEndpoint,
 	}
 }
 
@@ -78,29 +88,46 @@ func (th *tokenHandler) HandleTokenRequest(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	// Certificate-bound access tokens can only be issued over a mutual-TLS connection (RFC 8705 §3).
+	if clientInfo.OAuthApp != nil && clientInfo.OAuthApp.CertificateBoundAccessTokens &&
+		clientInfo.CertificateThumbprint == "" {
+		utils.WriteJSONError(w, constants.ErrorInvalidRequest,
+			"A client certificate is required for certificate-bound access tokens", http.StatusBadRequest, nil)
+		return
+	}
+
+	ctx, ok := th.validateDPoPProof(w, r, clientInfo.OAuthApp)
+	if !ok {
+		return
+	}
+
 	// Build the token request domain model from the HTTP form values.
 	tokenRequest := &model.TokenRequest{
-		GrantType:          r.FormValue(constants.RequestParamGrantType),
-		ClientID:           clientInfo.ClientID,
-		ClientSecret:       clientInfo.ClientSecret,
-		Scope:              r.FormValue("scope"),
-		Username:           r.FormValue("username"),
-		Password:           r.FormValue("password"),
-		RefreshToken:       r.FormValue("refresh_token"),
-		CodeVerifier:       r.FormValue("code_verifier"),
-		Code:               r.FormValue("code"),
-		RedirectURI:        r.FormValue("redirect_uri"),
-		Resources:          r.Form[constants.RequestParamResource],
-		SubjectToken:       r.FormValue(constants.RequestParamSubjectToken),
-		SubjectTokenType:   r.FormValue(constants.RequestParamSubjectTokenType),
-		ActorToken:         r.FormValue(constants.RequestParamActorToken),
-		ActorTokenType:     r.FormValue(constants.RequestParamActorTokenType),
-		RequestedTokenType: r.FormValue(constants.RequestParamRequestedTokenType),
-		Audiences:          r.Form[constants.RequestParamAudience],
+		GrantType:            r.FormValue(constants.RequestParamGrantType),
+		ClientID:             clientInfo.ClientID,
+		ClientSecret:         clientInfo.ClientSecret,
+		Scope:                r.FormValue("scope"),
+		Username:             r.FormValue("username"),
+		Password:             r.FormValue("password"),
+		RefreshToken:         r.FormValue("refresh_token"),
+		CodeVerifier:         r.FormValue("code_verifier"),
+		Code:                 r.FormValue("code"),
+		RedirectURI:          r.FormValue("redirect_uri"),
+		DeviceCode:           r.FormValue(constants.RequestParamDeviceCode),
+		AuthReqID:            r.FormValue(constants.RequestParamAuthReqID),
+		Assertion:            r.FormValue(constants.RequestParamAssertion),
+		Resources:            r.Form[constants.RequestParamResource],
+		SubjectToken:         r.FormValue(constants.RequestParamSubjectToken),
+		SubjectTokenType:     r.FormValue(constants.RequestParamSubjectTokenType),
+		ActorToken:           r.FormValue(constants.RequestParamActorToken),
+		ActorTokenType:       r.FormValue(constants.RequestParamActorTokenType),
+		RequestedTokenType:   r.FormValue(constants.RequestParamRequestedTokenType),
+		Audiences:            r.Form[constants.RequestParamAudience],
+		AuthorizationDetails: r.FormValue(constants.RequestParamAuthorizationDetails),
 	}
 
 	// Delegate all business logic to the token service.
-	tokenResponse, tokenError := th.tokenService.ProcessTokenRequest(r.Context(), tokenRequest, clientInfo.OAuthApp)
+	tokenResponse, tokenError := th.tokenService.ProcessTokenRequest(ctx, tokenRequest, clientInfo.OAuthApp)
 	if tokenError != nil {
 		if tokenError.Error != "" {
 			var statusCode int
@@ -126,3 +153,48 @@ func (th *tokenHandler) HandleTokenRequest(w http.ResponseWriter, r *http.Reques
 
 	utils.WriteSuccessResponse(w, http.StatusOK, tokenResponse)
 }
+
+// validateDPoPProof validates the DPoP proof sent with the token request, if any, and returns a context
+// carrying the proof key thumbprint so that issued tokens are bound to it (RFC 9449 §5). Returns false
+// after writing an error response when the proof is missing for a client that requires one, or invalid.
+func (th *tokenHandler) validateDPoPProof(
+	w http.ResponseWriter, r *http.Request, oauthApp *inboundmodel.OAuthClient,
+) (context.Context, bool) {
+	proofs := r.Header.Values(dpop.HeaderDPoP)
+	switch {
+	case len(proofs) == 0:
+		if oauthApp != nil && oauthApp.DPoPBoundAccessTokens {
+			utils.WriteJSONError(w, constants.ErrorInvalidDPoPProof,
+				"A DPoP proof is required for this client", http.StatusBadRequest, nil)
+			return nil, false
+		}
+		return r.Context(), true
+	case len(proofs) > 1:
+		utils.WriteJSONError(w, constants.ErrorInvalidDPoPProof,
+			"Only one DPoP proof may be presented", http.StatusBadRequest, nil)
+		return nil, false
+	}
+
+	thumbprint, err := th.dpopService.ValidateProof(r.Context(), proofs[0],
+		dpop.ProofRequest{Method: r.Method, URI: th.tokenEndpoint})
+	if err == nil {
+		return dpop.WithProofKeyThumbprint(r.Context(), thumbprint), true
+	}
+
+	if !errors.Is(err, dpop.ErrNonceRequired) {
+		utils.WriteJSONError(w, constants.ErrorInvalidDPoPProof, "Invalid DPoP proof", http.StatusBadRequest, nil)
+		return nil, false
+	}
+
+	nonce, nonceErr := th.dpopService.IssueNonce(r.Context())
+	if nonceErr != nil {
+		log.GetLogger().Error("Failed to issue DPoP nonce", log.Error(nonceErr))
+		utils.WriteJSONError(w, constants.ErrorServerError,
+			"Something went wrong", http.StatusInternalServerError, nil)
+		return nil, false
+	}
+	utils.WriteJSONError(w, constants.ErrorUseDPoPNonce,
+		"Authorization server requires nonce in DPoP proof", http.StatusBadRequest,
+		[]map[string]string{{dpop.HeaderDPoPNonce: nonce}})
+	return nil, false
+}
@@ -21,6 +21,7 @@ package token
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -34,12 +35,17 @@ import (
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/clientauth"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/dpop"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
+	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/dpopmock"
 )
 
+const testTokenEndpoint = "https://localhost:8090/oauth2/token"
+
 type TokenHandlerTestSuite struct {
 	suite.Suite
 	mockTokenService *TokenServiceInterfaceMock
+	mockDPoPService  *dpopmock.DPoPServiceInterfaceMock
 }
 
 func TestTokenHandlerSuite(t *testing.T) {
@@ -48,11 +54,12 @@ func TestTokenHandlerSuite(t *testing.T) {
 
 func (suite *TokenHandlerTestSuite) SetupTest() {
 	suite.mockTokenService = NewTokenServiceInterfaceMock(suite.T())
+	suite.mockDPoPService = dpopmock.NewDPoPServiceInterfaceMock(suite.T())
 }
 
 // newHandler creates a tokenHandler backed by the suite's service mock.
 func (suite *TokenHandlerTestSuite) newHandler() *tokenHandler {
-	return newTokenHandler(suite.mockTokenService, nil).(*tokenHandler)
+	return newTokenHandler(suite.mockTokenService, nil, suite.mockDPoPService, testTokenEndpoint).(*tokenHandler)
 }
 
 // buildRequest constructs a POST /token request with URL-encoded form data.
@@ -76,7 +83,7 @@ func (suite *TokenHandlerTestSuite) withClientContext(
 }
 
 func (suite *TokenHandlerTestSuite) TestnewTokenHandler() {
-	handler := newTokenHandler(suite.mockTokenService, nil)
+	handler := newTokenHandler(suite.mockTokenService, nil, nil, "")
 	assert.NotNil(suite.T(), handler)
 	assert.Implements(suite.T(), (*TokenHandlerInterface)(nil), handler)
 }
@@ -116,6 +123,126 @@ func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_MissingClientID() {
 	assert.Equal(suite.T(), "server_error", response["error"])
 }
 
+func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_CertificateBoundWithoutCertificate() {
+	handler := suite.newHandler()
+	mockApp := &inboundmodel.OAuthClient{ClientID: "test-client-id", CertificateBoundAccessTokens: true}
+	formData := url.Values{}
+	formData.Set("grant_type", "client_credentials")
+	req := suite.withClientContext(suite.buildRequest(formData), mockApp)
+	rr := httptest.NewRecorder()
+
+	handler.HandleTokenRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, rr.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(rr.Body.Bytes(), &response)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "invalid_request", response["error"])
+}
+
+func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_DPoPProofBindsContext() {
+	handler := suite.newHandler()
+	mockApp := &inboundmodel.OAuthClient{ClientID: "test-client-id", DPoPBoundAccessTokens: true}
+	formData := url.Values{}
+	formData.Set("grant_type", "authorization_code")
+	req := suite.withClientContext(suite.buildRequest(formData), mockApp)
+	req.Header.Set(dpop.HeaderDPoP, "proof")
+
+	suite.mockDPoPService.EXPECT().
+		ValidateProof(mock.Anything, "proof", dpop.ProofRequest{Method: http.MethodPost, URI: testTokenEndpoint}).
+		Return("thumbprint", nil)
+	suite.mockTokenService.EXPECT().
+		ProcessTokenRequest(mock.MatchedBy(func(ctx context.Context) bool {
+			return dpop.GetProofKeyThumbprint(ctx) == "thumbprint"
+		}), mock.Anything, mock.Anything).
+		Return(&model.TokenResponse{AccessToken: "access-token-123", TokenType: constants.TokenTypeDPoP}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.HandleTokenRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusOK, rr.Code)
+}
+
+func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_DPoPProofErrors() {
+	tests := []struct {
+		name          string
+		dpopBound     bool
+		proofs        []string
+		validateErr   error
+		expectedError string
+	}{
+		{"ProofRequiredForClient", true, nil, nil, constants.ErrorInvalidDPoPProof},
+		{"MultipleProofs", false, []string{"proof", "proof"}, nil, constants.ErrorInvalidDPoPProof},
+		{"InvalidProof", false, []string{"proof"}, dpop.ErrInvalidProof, constants.ErrorInvalidDPoPProof},
+	}
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			handler := suite.newHandler()
+			mockApp := &inboundmodel.OAuthClient{ClientID: "test-client-id", DPoPBoundAccessTokens: tc.dpopBound}
+			formData := url.Values{}
+			formData.Set("grant_type", "authorization_code")
+			req := suite.withClientContext(suite.buildRequest(formData), mockApp)
+			for _, proof := range tc.proofs {
+				req.Header.Add(dpop.HeaderDPoP, proof)
+			}
+			if tc.validateErr != nil {
+				suite.mockDPoPService.EXPECT().ValidateProof(mock.Anything, "proof", mock.Anything).
+					Return("", tc.validateErr).Once()
+			}
+
+			rr := httptest.NewRecorder()
+			handler.HandleTokenRequest(rr, req)
+
+			assert.Equal(suite.T(), http.StatusBadRequest, rr.Code)
+			var response map[string]interface{}
+			err := json.Unmarshal(rr.Body.Bytes(), &response)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tc.expectedError, response["error"])
+		})
+	}
+}
+
+func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_DPoPNonceRequired() {
+	handler := suite.newHandler()
+	mockApp := &inboundmodel.OAuthClient{ClientID: "test-client-id"}
+	formData := url.Values{}
+	formData.Set("grant_type", "authorization_code")
+	req := suite.withClientContext(suite.buildRequest(formData), mockApp)
+	req.Header.Set(dpop.HeaderDPoP, "proof")
+
+	suite.mockDPoPService.EXPECT().ValidateProof(mock.Anything, "proof", mock.Anything).
+		Return("", dpop.ErrNonceRequired)
+	suite.mockDPoPService.EXPECT().IssueNonce(mock.Anything).Return("server-nonce", nil)
+
+	rr := httptest.NewRecorder()
+	handler.HandleTokenRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, rr.Code)
+	assert.Equal(suite.T(), "server-nonce", rr.Header().Get(dpop.HeaderDPoPNonce))
+	var response map[string]interface{}
+	err := json.Unmarshal(rr.Body.Bytes(), &response)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorUseDPoPNonce, response["error"])
+}
+
+func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_DPoPNonceIssueFailure() {
+	handler := suite.newHandler()
+	mockApp := &inboundmodel.OAuthClient{ClientID: "test-client-id"}
+	formData := url.Values{}
+	formData.Set("grant_type", "authorization_code")
+	req := suite.withClientContext(suite.buildRequest(formData), mockApp)
+	req.Header.Set(dpop.HeaderDPoP, "proof")
+
+	suite.mockDPoPService.EXPECT().ValidateProof(mock.Anything, "proof", mock.Anything).
+		Return("", dpop.ErrNonceRequired)
+	suite.mockDPoPService.EXPECT().IssueNonce(mock.Anything).Return("", errors.New("signing failed"))
+
+	rr := httptest.NewRecorder()
+	handler.HandleTokenRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, rr.Code)
+}
+
 func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_ServiceErrors() {
 	tests := []struct {
 		name          string
@@ -145,7 +272,7 @@ func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_ServiceErrors() {
 	for _, tc := range tests {
 		suite.Run(tc.name, func() {
 			mockSvc := NewTokenServiceInterfaceMock(suite.T())
-			handler := newTokenHandler(mockSvc, nil).(*tokenHandler)
+			handler := newTokenHandler(mockSvc, nil, nil, "").(*tokenHandler)
 			mockApp := &inboundmodel.OAuthClient{ClientID: "test-client-id"}
 			formData := url.Values{}
 			formData.Set("grant_type", tc.grantType)
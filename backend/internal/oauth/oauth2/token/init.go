@@ -26,6 +26,7 @@ import (
 	"github.com/asgardeo/thunder/internal/inboundclient"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/clientauth"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/discovery"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/dpop"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/granthandlers"
 	"github.com/asgardeo/thunder/internal/oauth/scope"
 	"github.com/asgardeo/thunder/internal/system/jose/jwt"
@@ -44,11 +45,13 @@ func Initialize(
 	scopeValidator scope.ScopeValidatorInterface,
 	observabilitySvc observability.ObservabilityServiceInterface,
 	discoveryService discovery.DiscoveryServiceInterface,
+	dpopService dpop.DPoPServiceInterface,
 	transactioner transaction.Transactioner,
 ) TokenHandlerInterface {
+	endpointURL := discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background()).TokenEndpoint
 	tokenSvc := newTokenService(grantHandlerProvider, scopeValidator, observabilitySvc, transactioner)
-	tokenHandler := newTokenHandler(tokenSvc, observabilitySvc)
-	registerRoutes(mux, tokenHandler, inboundClient, authnProvider, jwtService, discoveryService)
+	tokenHandler := newTokenHandler(tokenSvc, observabilitySvc, dpopService, endpointURL)
+	registerRoutes(mux, tokenHandler, inboundClient, authnProvider, jwtService, endpointURL)
 	return tokenHandler
 }
 
@@ -59,16 +62,16 @@ func registerRoutes(
 	inboundClient inboundclient.InboundClientServiceInterface,
 	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
 	jwtService jwt.JWTServiceInterface,
-	discoveryService discovery.DiscoveryServiceInterface,
+	endpointURL string,
 ) {
 	corsOpts := middleware.CORSOptions{
-		AllowedMethods:   []string{"POST"},
-		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowedMethods:   []string{"POST", "OPTIONS"},
+		AllowedHeaders:   []string{"Content-Type", "Authorization", dpop.HeaderDPoP},
+		ExposedHeaders:   []string{dpop.HeaderDPoPNonce},
 		AllowCredentials: true,
 		MaxAge:           600,
 	}
 
-	endpointURL := discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background()).TokenEndpoint
 	clientAuthMiddleware := clientauth.ClientAuthMiddleware(inboundClient, authnProvider, jwtService, endpointURL)
 	handler := clientAuthMiddleware(http.HandlerFunc(tokenHandler.HandleTokenRequest))
 
@@ -79,4 +82,10 @@ func registerRoutes(
 	)
 
 	mux.HandleFunc(pattern, wrappedHandler)
+
+	// Browser-based clients send a CORS preflight before POSTing a request that carries a DPoP proof.
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /oauth2/token",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, corsOpts))
 }
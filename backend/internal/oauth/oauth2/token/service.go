@@ -182,8 +182,8 @@ func (ts *tokenService) ProcessTokenRequest(
 	}
 
 	// Issue refresh token if applicable.
-	if grantType == constants.GrantTypeAuthorizationCode &&
-		oauthApp.IsAllowedGrantType(constants.GrantTypeRefreshToken) {
+	if (grantType == constants.GrantTypeAuthorizationCode || grantType == constants.GrantTypeDeviceCode ||
+		grantType == constants.GrantTypeCIBA) && oauthApp.IsAllowedGrantType(constants.GrantTypeRefreshToken) {
 		logger.Debug("Issuing refresh token for the token request",
 			log.String("client_id", clientID), log.String("grant_type", grantTypeStr))
 
@@ -233,12 +233,13 @@ func (ts *tokenService) ProcessTokenRequest(
 	// Build token response.
 	scopes := strings.Join(tokenRespDTO.AccessToken.Scopes, " ")
 	tokenResponse := &model.TokenResponse{
-		AccessToken:  tokenRespDTO.AccessToken.Token,
-		TokenType:    tokenRespDTO.AccessToken.TokenType,
-		ExpiresIn:    tokenRespDTO.AccessToken.ExpiresIn,
-		RefreshToken: tokenRespDTO.RefreshToken.Token,
-		Scope:        scopes,
-		IDToken:      tokenRespDTO.IDToken.Token,
+		AccessToken:          tokenRespDTO.AccessToken.Token,
+		TokenType:            tokenRespDTO.AccessToken.TokenType,
+		ExpiresIn:            tokenRespDTO.AccessToken.ExpiresIn,
+		RefreshToken:         tokenRespDTO.RefreshToken.Token,
+		Scope:                scopes,
+		IDToken:              tokenRespDTO.IDToken.Token,
+		AuthorizationDetails: tokenRespDTO.AccessToken.AuthorizationDetails,
 	}
 
 	// For token exchange, determine the issued_token_type from the request.
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokengrant
+
+import (
+	"errors"
+
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/i18n/core"
+)
+
+// Store-level errors.
+var (
+	// errGrantNotFound is returned when a grant is not found, has expired or no longer holds the expected token.
+	errGrantNotFound = errors.New("grant not found")
+)
+
+// Client-facing service errors.
+var (
+	// ErrorMissingGrantID is returned when the grant ID is missing.
+	ErrorMissingGrantID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "RTG-1001",
+		Error: core.I18nMessage{
+			Key:          "error.tokengrant.missing_grant_id",
+			DefaultValue: "Missing grant ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tokengrant.missing_grant_id_description",
+			DefaultValue: "Grant ID is required",
+		},
+	}
+
+	// ErrorMissingUserID is returned when the user ID is missing.
+	ErrorMissingUserID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "RTG-1002",
+		Error: core.I18nMessage{
+			Key:          "error.tokengrant.missing_user_id",
+			DefaultValue: "Missing user ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tokengrant.missing_user_id_description",
+			DefaultValue: "User ID is required",
+		},
+	}
+
+	// ErrorGrantNotFound is returned when a grant is not found or has expired.
+	ErrorGrantNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "RTG-1003",
+		Error: core.I18nMessage{
+			Key:          "error.tokengrant.grant_not_found",
+			DefaultValue: "Grant not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tokengrant.grant_not_found_description",
+			DefaultValue: "The grant with the specified ID does not exist or has expired",
+		},
+	}
+
+	// ErrorRefreshTokenReused is returned when a refresh token that has already been rotated is redeemed
+	// again. The grant is revoked when this happens.
+	ErrorRefreshTokenReused = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "RTG-1004",
+		Error: core.I18nMessage{
+			Key:          "error.tokengrant.refresh_token_reused",
+			DefaultValue: "Refresh token reused",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tokengrant.refresh_token_reused_description",
+			DefaultValue: "The refresh token has already been used and the grant has been revoked",
+		},
+	}
+)
@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokengrant
+
+import (
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/system/error/apierror"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/log"
+	sysutils "github.com/asgardeo/thunder/internal/system/utils"
+)
+
+const handlerLoggerComponentName = "TokenGrantHandler"
+
+// grantHandler is the handler for refresh token grant management operations.
+type grantHandler struct {
+	grantService TokenGrantServiceInterface
+}
+
+// newGrantHandler creates a new instance of grantHandler.
+func newGrantHandler(grantService TokenGrantServiceInterface) *grantHandler {
+	return &grantHandler{
+		grantService: grantService,
+	}
+}
+
+// HandleGrantListRequest handles the list grants of a user request.
+func (gh *grantHandler) HandleGrantListRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	userID := sysutils.SanitizeString(r.URL.Query().Get("userId"))
+	grants, svcErr := gh.grantService.GetUserGrants(ctx, userID)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	grantList := make([]GrantResponse, 0, len(grants))
+	for i := range grants {
+		grantList = append(grantList, toGrantResponse(&grants[i]))
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, GrantListResponse{
+		TotalResults: len(grantList),
+		Grants:       grantList,
+	})
+
+	logger.Debug("Successfully listed user grants", log.Int("count", len(grantList)))
+}
+
+// HandleGrantGetRequest handles the get grant by id request.
+func (gh *grantHandler) HandleGrantGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := r.PathValue("id")
+	grant, svcErr := gh.grantService.GetGrant(ctx, id)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, toGrantResponse(grant))
+}
+
+// HandleGrantDeleteRequest handles the revoke grant by id request.
+func (gh *grantHandler) HandleGrantDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	if svcErr := gh.grantService.RevokeGrant(ctx, id); svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.Debug("Successfully revoked grant")
+}
+
+// HandleUserGrantsDeleteRequest handles the revoke all grants of a user request.
+func (gh *grantHandler) HandleUserGrantsDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	userID := sysutils.SanitizeString(r.URL.Query().Get("userId"))
+	if svcErr := gh.grantService.RevokeUserGrants(ctx, userID); svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.Debug("Successfully revoked user grants")
+}
+
+// toGrantResponse converts a grant to its API representation.
+func toGrantResponse(grant *Grant) GrantResponse {
+	resp := GrantResponse{
+		ID:            grant.ID,
+		ClientID:      grant.ClientID,
+		UserID:        grant.UserID,
+		GrantType:     grant.GrantType,
+		Scopes:        grant.Scopes,
+		CreatedAt:     grant.CreatedAt.Unix(),
+		LastRotatedAt: grant.UpdatedAt.Unix(),
+		ExpiresAt:     grant.ExpiryTime.Unix(),
+	}
+	if resp.Scopes == nil {
+		resp.Scopes = []string{}
+	}
+	if !grant.AbsoluteExpiryTime.IsZero() {
+		resp.AbsoluteExpiresAt = grant.AbsoluteExpiryTime.Unix()
+	}
+	return resp
+}
+
+// handleError writes the error response corresponding to the given service error.
+func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorGrantNotFound.Code:
+			statusCode = http.StatusNotFound
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
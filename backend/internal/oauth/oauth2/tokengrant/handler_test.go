@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokengrant
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/system/error/apierror"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *TokenGrantServiceInterfaceMock
+	handler     *grantHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewTokenGrantServiceInterfaceMock(s.T())
+	s.handler = newGrantHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) testGrant() Grant {
+	return Grant{
+		ID:                 testGrantID,
+		ClientID:           testClientID,
+		UserID:             testUserID,
+		GrantType:          "authorization_code",
+		Scopes:             []string{"openid"},
+		CurrentTokenID:     testTokenID,
+		CreatedAt:          time.Unix(1700000000, 0),
+		UpdatedAt:          time.Unix(1700001800, 0),
+		ExpiryTime:         time.Unix(1700003600, 0),
+		AbsoluteExpiryTime: time.Unix(1702592000, 0),
+	}
+}
+
+func (s *HandlerTestSuite) TestHandleGrantListRequest_Success() {
+	s.mockService.EXPECT().GetUserGrants(mock.Anything, testUserID).Return([]Grant{s.testGrant()}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/grants?userId="+testUserID, nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleGrantListRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp GrantListResponse
+	s.NoError(json.NewDecoder(rr.Body).Decode(&resp))
+	s.Equal(1, resp.TotalResults)
+	s.Equal(testGrantID, resp.Grants[0].ID)
+	s.Equal(testClientID, resp.Grants[0].ClientID)
+	s.Equal(int64(1700001800), resp.Grants[0].LastRotatedAt)
+	s.Equal(int64(1700003600), resp.Grants[0].ExpiresAt)
+	s.Equal(int64(1702592000), resp.Grants[0].AbsoluteExpiresAt)
+	s.NotContains(rr.Body.String(), testTokenID)
+}
+
+func (s *HandlerTestSuite) TestHandleGrantListRequest_MissingUserID() {
+	s.mockService.EXPECT().GetUserGrants(mock.Anything, "").Return(nil, &ErrorMissingUserID)
+
+	req := httptest.NewRequest(http.MethodGet, "/grants", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleGrantListRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var resp apierror.ErrorResponse
+	s.NoError(json.NewDecoder(rr.Body).Decode(&resp))
+	s.Equal(ErrorMissingUserID.Code, resp.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleGrantGetRequest_Success() {
+	grant := s.testGrant()
+	grant.AbsoluteExpiryTime = time.Time{}
+	s.mockService.EXPECT().GetGrant(mock.Anything, testGrantID).Return(&grant, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/grants/"+testGrantID, nil)
+	req.SetPathValue("id", testGrantID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleGrantGetRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.NotContains(rr.Body.String(), "absoluteExpiresAt")
+}
+
+func (s *HandlerTestSuite) TestHandleGrantGetRequest_NotFound() {
+	s.mockService.EXPECT().GetGrant(mock.Anything, testGrantID).Return(nil, &ErrorGrantNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/grants/"+testGrantID, nil)
+	req.SetPathValue("id", testGrantID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleGrantGetRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleGrantDeleteRequest_Success() {
+	s.mockService.EXPECT().RevokeGrant(mock.Anything, testGrantID).Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/grants/"+testGrantID, nil)
+	req.SetPathValue("id", testGrantID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleGrantDeleteRequest(rr, req)
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleUserGrantsDeleteRequest_Success() {
+	s.mockService.EXPECT().RevokeUserGrants(mock.Anything, testUserID).Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/grants?userId="+testUserID, nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleUserGrantsDeleteRequest(rr, req)
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleUserGrantsDeleteRequest_ServerError() {
+	s.mockService.EXPECT().RevokeUserGrants(mock.Anything, testUserID).Return(&serviceerror.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodDelete, "/grants?userId="+testUserID, nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleUserGrantsDeleteRequest(rr, req)
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokengrant
+
+import "time"
+
+// Grant represents a refresh token family. A family is started when a refresh token is first issued to a
+// client on behalf of a user and tracks the only refresh token of the family that may still be redeemed.
+type Grant struct {
+	// ID is the unique identifier of the grant. It is carried by every refresh token of the family.
+	ID string `json:"id"`
+
+	// ClientID is the identifier of the OAuth client the grant was issued to.
+	ClientID string `json:"clientId"`
+
+	// UserID is the subject of the access tokens issued within the grant.
+	UserID string `json:"userId"`
+
+	// GrantType is the grant type that started the family.
+	GrantType string `json:"grantType"`
+
+	// Scopes contains the scopes authorized within the grant.
+	Scopes []string `json:"scopes"`
+
+	// CurrentTokenID is the identifier of the refresh token that may be redeemed next.
+	CurrentTokenID string `json:"currentTokenId"`
+
+	// CreatedAt is the time at which the grant was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt is the time at which the refresh token of the grant was last rotated.
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// ExpiryTime is the time at which the current refresh token, and therefore the grant, expires.
+	ExpiryTime time.Time `json:"expiryTime"`
+
+	// AbsoluteExpiryTime is the time beyond which the grant can no longer be renewed.
+	// It is zero when the grant has no absolute lifetime.
+	AbsoluteExpiryTime time.Time `json:"absoluteExpiryTime"`
+}
+
+// GrantResponse represents a grant in the grant management API responses.
+type GrantResponse struct {
+	ID                string   `json:"id"`
+	ClientID          string   `json:"clientId"`
+	UserID            string   `json:"userId"`
+	GrantType         string   `json:"grantType"`
+	Scopes            []string `json:"scopes"`
+	CreatedAt         int64    `json:"createdAt"`
+	LastRotatedAt     int64    `json:"lastRotatedAt"`
+	ExpiresAt         int64    `json:"expiresAt"`
+	AbsoluteExpiresAt int64    `json:"absoluteExpiresAt,omitempty"`
+}
+
+// GrantListResponse represents the response for listing the grants of a user.
+type GrantListResponse struct {
+	TotalResults int             `json:"totalResults"`
+	Grants       []GrantResponse `json:"grants"`
+}
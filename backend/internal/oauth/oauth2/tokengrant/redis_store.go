@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokengrant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// grantRedisClient abstracts the Redis commands used by the grant store.
+type grantRedisClient interface {
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd
+	SetArgs(ctx context.Context, key string, value any, a redis.SetArgs) *redis.StatusCmd
+	Get(ctx context.Context, key string) *redis.StringCmd
+	Del(ctx context.Context, keys ...string) *redis.IntCmd
+	ExpireNX(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd
+	ExpireGT(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd
+	SAdd(ctx context.Context, key string, members ...interface{}) *redis.IntCmd
+	SMembers(ctx context.Context, key string) *redis.StringSliceCmd
+	SRem(ctx context.Context, key string, members ...interface{}) *redis.IntCmd
+}
+
+// redisGrantStore is the Redis-backed implementation of grantStoreInterface.
+// Each grant is stored under its own key with a TTL matching the grant expiry, and a per-user
+// set indexes the grant IDs of a user. Stale index members are pruned lazily on lookup.
+type redisGrantStore struct {
+	client       grantRedisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// newRedisGrantStore creates a new Redis-backed grant store.
+func newRedisGrantStore(p provider.RedisProviderInterface) grantStoreInterface {
+	return &redisGrantStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// grantKey builds the Redis key for a grant.
+func (s *redisGrantStore) grantKey(id string) string {
+	return fmt.Sprintf("%s:runtime:%s:refreshgrant:%s", s.keyPrefix, s.deploymentID, id)
+}
+
+// userGrantsKey builds the Redis key for the set of grant IDs of a user.
+func (s *redisGrantStore) userGrantsKey(userID string) string {
+	return fmt.Sprintf("%s:runtime:%s:userrefreshgrants:%s", s.keyPrefix, s.deploymentID, userID)
+}
+
+// CreateGrant stores the grant in Redis and adds it to the user's grant index.
+func (s *redisGrantStore) CreateGrant(ctx context.Context, grant Grant) error {
+	data, err := json.Marshal(grant)
+	if err != nil {
+		return fmt.Errorf("failed to marshal grant: %w", err)
+	}
+
+	ttl := time.Until(grant.ExpiryTime)
+	if ttl <= 0 {
+		return errors.New("grant has already expired")
+	}
+	if err := s.client.Set(ctx, s.grantKey(grant.ID), data, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store grant in Redis: %w", err)
+	}
+
+	indexKey := s.userGrantsKey(grant.UserID)
+	if err := s.client.SAdd(ctx, indexKey, grant.ID).Err(); err != nil {
+		return fmt.Errorf("failed to index grant in Redis: %w", err)
+	}
+	return s.extendIndexExpiry(ctx, indexKey, ttl)
+}
+
+// GetGrant retrieves a grant from Redis.
+func (s *redisGrantStore) GetGrant(ctx context.Context, id string) (Grant, error) {
+	data, err := s.client.Get(ctx, s.grantKey(id)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return Grant{}, errGrantNotFound
+		}
+		return Grant{}, fmt.Errorf("failed to get grant from Redis: %w", err)
+	}
+
+	var grant Grant
+	if err := json.Unmarshal(data, &grant); err != nil {
+		return Grant{}, fmt.Errorf("failed to unmarshal grant: %w", err)
+	}
+	if !grant.ExpiryTime.After(time.Now()) {
+		return Grant{}, errGrantNotFound
+	}
+
+	return grant, nil
+}
+
+// GetGrantsByUserID retrieves the grants of a user from Redis.
+func (s *redisGrantStore) GetGrantsByUserID(ctx context.Context, userID string) ([]Grant, error) {
+	indexKey := s.userGrantsKey(userID)
+	ids, err := s.client.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user grants from Redis: %w", err)
+	}
+
+	grants := make([]Grant, 0, len(ids))
+	for _, id := range ids {
+		grant, err := s.GetGrant(ctx, id)
+		if err != nil {
+			if errors.Is(err, errGrantNotFound) {
+				// Best-effort pruning of index members whose grant has expired.
+				_ = s.client.SRem(ctx, indexKey, id).Err()
+				continue
+			}
+			return nil, err
+		}
+		grants = append(grants, grant)
+	}
+
+	return grants, nil
+}
+
+// RotateToken replaces the stored grant in Redis. The previous value is returned atomically by the
+// write, so a concurrent rotation of the same token is detected and reported as errGrantNotFound.
+func (s *redisGrantStore) RotateToken(ctx context.Context, grant Grant, previousTokenID string) error {
+	if !grant.ExpiryTime.After(time.Now()) {
+		return errGrantNotFound
+	}
+	data, err := json.Marshal(grant)
+	if err != nil {
+		return fmt.Errorf("failed to marshal grant: %w", err)
+	}
+
+	previous, err := s.client.SetArgs(ctx, s.grantKey(grant.ID), data,
+		redis.SetArgs{Mode: "XX", Get: true, ExpireAt: grant.ExpiryTime}).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return errGrantNotFound
+		}
+		return fmt.Errorf("failed to rotate grant token in Redis: %w", err)
+	}
+
+	var previousGrant Grant
+	if err := json.Unmarshal([]byte(previous), &previousGrant); err != nil {
+		return fmt.Errorf("failed to unmarshal grant: %w", err)
+	}
+	if previousGrant.CurrentTokenID != previousTokenID {
+		return errGrantNotFound
+	}
+
+	return s.extendIndexExpiry(ctx, s.userGrantsKey(grant.UserID), time.Until(grant.ExpiryTime))
+}
+
+// DeleteGrant removes a grant from Redis and from the user's grant index.
+func (s *redisGrantStore) DeleteGrant(ctx context.Context, id string) error {
+	grant, err := s.GetGrant(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.Del(ctx, s.grantKey(id)).Err(); err != nil {
+		return fmt.Errorf("failed to delete grant from Redis: %w", err)
+	}
+	if err := s.client.SRem(ctx, s.userGrantsKey(grant.UserID), id).Err(); err != nil {
+		return fmt.Errorf("failed to remove grant from index in Redis: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteGrantsByUserID removes all grants of a user from Redis.
+func (s *redisGrantStore) DeleteGrantsByUserID(ctx context.Context, userID string) error {
+	indexKey := s.userGrantsKey(userID)
+	ids, err := s.client.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get user grants from Redis: %w", err)
+	}
+
+	keys := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		keys = append(keys, s.grantKey(id))
+	}
+	keys = append(keys, indexKey)
+
+	if err := s.client.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("failed to delete user grants from Redis: %w", err)
+	}
+
+	return nil
+}
+
+// extendIndexExpiry ensures the user's grant index lives at least as long as the given TTL. Grants of
+// different clients can have different lifetimes, so the index expiry is only ever extended.
+func (s *redisGrantStore) extendIndexExpiry(ctx context.Context, indexKey string, ttl time.Duration) error {
+	if err := s.client.ExpireNX(ctx, indexKey, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set grant index expiry in Redis: %w", err)
+	}
+	if err := s.client.ExpireGT(ctx, indexKey, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set grant index expiry in Redis: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokengrant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	redisTestKeyPrefix    = "thunderid"
+	redisTestDeploymentID = "test-deployment-id"
+)
+
+type RedisStoreTestSuite struct {
+	suite.Suite
+	mockClient *grantRedisClientMock
+	store      *redisGrantStore
+	ctx        context.Context
+}
+
+func TestRedisStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisStoreTestSuite))
+}
+
+func (s *RedisStoreTestSuite) SetupTest() {
+	s.mockClient = newGrantRedisClientMock(s.T())
+	s.store = &redisGrantStore{
+		client:       s.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: redisTestDeploymentID,
+	}
+	s.ctx = context.Background()
+}
+
+func (s *RedisStoreTestSuite) buildGrantKey(id string) string {
+	return fmt.Sprintf("%s:runtime:%s:refreshgrant:%s", redisTestKeyPrefix, redisTestDeploymentID, id)
+}
+
+func (s *RedisStoreTestSuite) buildUserGrantsKey(userID string) string {
+	return fmt.Sprintf("%s:runtime:%s:userrefreshgrants:%s", redisTestKeyPrefix, redisTestDeploymentID, userID)
+}
+
+func (s *RedisStoreTestSuite) testGrant() Grant {
+	return Grant{
+		ID:             testGrantID,
+		ClientID:       testClientID,
+		UserID:         testUserID,
+		GrantType:      "authorization_code",
+		Scopes:         []string{"openid"},
+		CurrentTokenID: testTokenID,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+		ExpiryTime:     time.Now().Add(time.Hour),
+	}
+}
+
+// mockGet configures the Get call for a grant key to return the given grant.
+func (s *RedisStoreTestSuite) mockGet(grant Grant) {
+	data, err := json.Marshal(grant)
+	s.Require().NoError(err)
+	stringCmd := redis.NewStringCmd(s.ctx)
+	stringCmd.SetVal(string(data))
+	s.mockClient.On("Get", s.ctx, s.buildGrantKey(grant.ID)).Return(stringCmd)
+}
+
+// mockGetNotFound configures the Get call for a grant key to return redis.Nil.
+func (s *RedisStoreTestSuite) mockGetNotFound(id string) {
+	stringCmd := redis.NewStringCmd(s.ctx)
+	stringCmd.SetErr(redis.Nil)
+	s.mockClient.On("Get", s.ctx, s.buildGrantKey(id)).Return(stringCmd)
+}
+
+// mockIndexExpiry configures the calls that extend the expiry of the user's grant index.
+func (s *RedisStoreTestSuite) mockIndexExpiry() {
+	s.mockClient.On("ExpireNX", s.ctx, s.buildUserGrantsKey(testUserID), mock.Anything).
+		Return(redis.NewBoolCmd(s.ctx))
+	s.mockClient.On("ExpireGT", s.ctx, s.buildUserGrantsKey(testUserID), mock.Anything).
+		Return(redis.NewBoolCmd(s.ctx))
+}
+
+func (s *RedisStoreTestSuite) TestKeys() {
+	s.Equal(s.buildGrantKey(testGrantID), s.store.grantKey(testGrantID))
+	s.Equal(s.buildUserGrantsKey(testUserID), s.store.userGrantsKey(testUserID))
+}
+
+func (s *RedisStoreTestSuite) TestCreateGrant_Success() {
+	ttlMatcher := mock.MatchedBy(func(ttl time.Duration) bool {
+		return ttl > 59*time.Minute && ttl <= time.Hour
+	})
+	s.mockClient.On("Set", s.ctx, s.buildGrantKey(testGrantID), mock.Anything, ttlMatcher).
+		Return(redis.NewStatusCmd(s.ctx))
+	s.mockClient.On("SAdd", s.ctx, s.buildUserGrantsKey(testUserID), testGrantID).
+		Return(redis.NewIntCmd(s.ctx))
+	s.mockIndexExpiry()
+
+	s.NoError(s.store.CreateGrant(s.ctx, s.testGrant()))
+}
+
+func (s *RedisStoreTestSuite) TestCreateGrant_Expired() {
+	grant := s.testGrant()
+	grant.ExpiryTime = time.Now().Add(-time.Minute)
+
+	s.Error(s.store.CreateGrant(s.ctx, grant))
+}
+
+func (s *RedisStoreTestSuite) TestGetGrant_Success() {
+	grant := s.testGrant()
+	s.mockGet(grant)
+
+	result, err := s.store.GetGrant(s.ctx, testGrantID)
+
+	s.NoError(err)
+	s.Equal(testTokenID, result.CurrentTokenID)
+}
+
+func (s *RedisStoreTestSuite) TestGetGrant_NotFound() {
+	s.mockGetNotFound(testGrantID)
+
+	_, err := s.store.GetGrant(s.ctx, testGrantID)
+
+	s.ErrorIs(err, errGrantNotFound)
+}
+
+func (s *RedisStoreTestSuite) TestGetGrantsByUserID_PrunesExpired() {
+	s.mockClient.On("SMembers", s.ctx, s.buildUserGrantsKey(testUserID)).
+		Return(redis.NewStringSliceResult([]string{testGrantID, "expired-grant"}, nil))
+	s.mockGet(s.testGrant())
+	s.mockGetNotFound("expired-grant")
+	s.mockClient.On("SRem", s.ctx, s.buildUserGrantsKey(testUserID), "expired-grant").
+		Return(redis.NewIntCmd(s.ctx))
+
+	grants, err := s.store.GetGrantsByUserID(s.ctx, testUserID)
+
+	s.NoError(err)
+	s.Len(grants, 1)
+}
+
+func (s *RedisStoreTestSuite) TestRotateToken_Success() {
+	previous := s.testGrant()
+	previousData, err := json.Marshal(previous)
+	s.Require().NoError(err)
+
+	rotated := previous
+	rotated.CurrentTokenID = "next-token"
+	s.mockClient.On("SetArgs", s.ctx, s.buildGrantKey(testGrantID), mock.Anything,
+		mock.MatchedBy(func(args redis.SetArgs) bool {
+			return args.Mode == "XX" && args.Get && args.ExpireAt.Equal(rotated.ExpiryTime)
+		})).Return(redis.NewStatusResult(string(previousData), nil))
+	s.mockIndexExpiry()
+
+	s.NoError(s.store.RotateToken(s.ctx, rotated, testTokenID))
+}
+
+func (s *RedisStoreTestSuite) TestRotateToken_ConcurrentRotation() {
+	previous := s.testGrant()
+	previous.CurrentTokenID = "other-token"
+	previousData, err := json.Marshal(previous)
+	s.Require().NoError(err)
+	s.mockClient.On("SetArgs", s.ctx, s.buildGrantKey(testGrantID), mock.Anything, mock.Anything).
+		Return(redis.NewStatusResult(string(previousData), nil))
+
+	err = s.store.RotateToken(s.ctx, s.testGrant(), testTokenID)
+
+	s.ErrorIs(err, errGrantNotFound)
+}
+
+func (s *RedisStoreTestSuite) TestRotateToken_NotFound() {
+	s.mockClient.On("SetArgs", s.ctx, s.buildGrantKey(testGrantID), mock.Anything, mock.Anything).
+		Return(redis.NewStatusResult("", redis.Nil))
+
+	err := s.store.RotateToken(s.ctx, s.testGrant(), testTokenID)
+
+	s.ErrorIs(err, errGrantNotFound)
+}
+
+func (s *RedisStoreTestSuite) TestRotateToken_RedisError() {
+	s.mockClient.On("SetArgs", s.ctx, s.buildGrantKey(testGrantID), mock.Anything, mock.Anything).
+		Return(redis.NewStatusResult("", errors.New("connection refused")))
+
+	err := s.store.RotateToken(s.ctx, s.testGrant(), testTokenID)
+
+	s.Error(err)
+	s.NotErrorIs(err, errGrantNotFound)
+}
+
+func (s *RedisStoreTestSuite) TestDeleteGrant_Success() {
+	s.mockGet(s.testGrant())
+	s.mockClient.On("Del", s.ctx, s.buildGrantKey(testGrantID)).Return(redis.NewIntCmd(s.ctx))
+	s.mockClient.On("SRem", s.ctx, s.buildUserGrantsKey(testUserID), testGrantID).Return(redis.NewIntCmd(s.ctx))
+
+	s.NoError(s.store.DeleteGrant(s.ctx, testGrantID))
+}
+
+func (s *RedisStoreTestSuite) TestDeleteGrantsByUserID_Success() {
+	s.mockClient.On("SMembers", s.ctx, s.buildUserGrantsKey(testUserID)).
+		Return(redis.NewStringSliceResult([]string{testGrantID}, nil))
+	s.mockClient.On("Del", s.ctx, s.buildGrantKey(testGrantID), s.buildUserGrantsKey(testUserID)).
+		Return(redis.NewIntCmd(s.ctx))
+
+	s.NoError(s.store.DeleteGrantsByUserID(s.ctx, testUserID))
+}
@@ -0,0 +1,231 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package tokengrant persists refresh token families so that refresh tokens can be rotated on use
+// and a family can be revoked as a whole when one of its tokens is replayed.
+package tokengrant
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+const loggerComponentName = "TokenGrantService"
+
+// TokenGrantServiceInterface defines the interface for the refresh token grant service.
+type TokenGrantServiceInterface interface {
+	// CreateGrant persists a new grant whose current token is grant.CurrentTokenID.
+	CreateGrant(ctx context.Context, grant Grant) (*Grant, *serviceerror.ServiceError)
+
+	// ValidateGrantToken ensures the given token is the current token of an active grant. Redeeming a token
+	// that is no longer current revokes the grant.
+	ValidateGrantToken(ctx context.Context, grantID, tokenID string) (*Grant, *serviceerror.ServiceError)
+
+	// RotateGrantToken replaces the current token of a grant. The rotation fails, and the grant is revoked,
+	// if the grant's current token has changed since it was validated.
+	RotateGrantToken(
+		ctx context.Context, grant *Grant, newTokenID string, expiryTime time.Time,
+	) *serviceerror.ServiceError
+
+	// GetGrant retrieves an active grant by ID.
+	GetGrant(ctx context.Context, grantID string) (*Grant, *serviceerror.ServiceError)
+
+	// GetUserGrants retrieves the active grants of a user.
+	GetUserGrants(ctx context.Context, userID string) ([]Grant, *serviceerror.ServiceError)
+
+	// RevokeGrant revokes a grant by ID.
+	RevokeGrant(ctx context.Context, grantID string) *serviceerror.ServiceError
+
+	// RevokeUserGrants revokes all grants of a user.
+	RevokeUserGrants(ctx context.Context, userID string) *serviceerror.ServiceError
+}
+
+// tokenGrantService is the default implementation of the TokenGrantServiceInterface.
+type tokenGrantService struct {
+	store grantStoreInterface
+}
+
+// newTokenGrantService creates a new instance of tokenGrantService with injected dependencies.
+func newTokenGrantService(store grantStoreInterface) TokenGrantServiceInterface {
+	return &tokenGrantService{
+		store: store,
+	}
+}
+
+// CreateGrant persists a new grant whose current token is grant.CurrentTokenID.
+func (s *tokenGrantService) CreateGrant(ctx context.Context, grant Grant) (*Grant, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if strings.TrimSpace(grant.ID) == "" {
+		return nil, &ErrorMissingGrantID
+	}
+
+	now := time.Now()
+	grant.CreatedAt = now
+	grant.UpdatedAt = now
+	if grant.Scopes == nil {
+		grant.Scopes = []string{}
+	}
+
+	if err := s.store.CreateGrant(ctx, grant); err != nil {
+		logger.Error("Failed to create grant", log.Error(err), log.String("clientId", grant.ClientID))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	logger.Debug("Successfully created grant", log.MaskedString("grantId", grant.ID))
+	return &grant, nil
+}
+
+// ValidateGrantToken ensures the given token is the current token of an active grant.
+func (s *tokenGrantService) ValidateGrantToken(
+	ctx context.Context, grantID, tokenID string,
+) (*Grant, *serviceerror.ServiceError) {
+	grant, svcErr := s.GetGrant(ctx, grantID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if grant.CurrentTokenID != tokenID {
+		return nil, s.revokeReusedGrant(ctx, grant.ID)
+	}
+
+	return grant, nil
+}
+
+// RotateGrantToken replaces the current token of a grant.
+func (s *tokenGrantService) RotateGrantToken(
+	ctx context.Context, grant *Grant, newTokenID string, expiryTime time.Time,
+) *serviceerror.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if grant == nil || strings.TrimSpace(grant.ID) == "" {
+		return &ErrorMissingGrantID
+	}
+
+	rotated := *grant
+	rotated.CurrentTokenID = newTokenID
+	rotated.UpdatedAt = time.Now()
+	rotated.ExpiryTime = expiryTime
+
+	if err := s.store.RotateToken(ctx, rotated, grant.CurrentTokenID); err != nil {
+		if errors.Is(err, errGrantNotFound) {
+			// The token was redeemed concurrently, or the grant was revoked in the meantime.
+			return s.revokeReusedGrant(ctx, grant.ID)
+		}
+		logger.Error("Failed to rotate grant token", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	*grant = rotated
+	return nil
+}
+
+// GetGrant retrieves an active grant by ID.
+func (s *tokenGrantService) GetGrant(ctx context.Context, grantID string) (*Grant, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if strings.TrimSpace(grantID) == "" {
+		return nil, &ErrorMissingGrantID
+	}
+
+	grant, err := s.store.GetGrant(ctx, grantID)
+	if err != nil {
+		if errors.Is(err, errGrantNotFound) {
+			logger.Debug("Grant not found", log.MaskedString("grantId", grantID))
+			return nil, &ErrorGrantNotFound
+		}
+		logger.Error("Failed to retrieve grant", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &grant, nil
+}
+
+// GetUserGrants retrieves the active grants of a user.
+func (s *tokenGrantService) GetUserGrants(
+	ctx context.Context, userID string,
+) ([]Grant, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if strings.TrimSpace(userID) == "" {
+		return nil, &ErrorMissingUserID
+	}
+
+	grants, err := s.store.GetGrantsByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("Failed to retrieve user grants", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return grants, nil
+}
+
+// RevokeGrant revokes a grant by ID.
+func (s *tokenGrantService) RevokeGrant(ctx context.Context, grantID string) *serviceerror.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if strings.TrimSpace(grantID) == "" {
+		return &ErrorMissingGrantID
+	}
+
+	if err := s.store.DeleteGrant(ctx, grantID); err != nil {
+		if errors.Is(err, errGrantNotFound) {
+			return &ErrorGrantNotFound
+		}
+		logger.Error("Failed to revoke grant", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	logger.Debug("Successfully revoked grant", log.MaskedString("grantId", grantID))
+	return nil
+}
+
+// RevokeUserGrants revokes all grants of a user.
+func (s *tokenGrantService) RevokeUserGrants(ctx context.Context, userID string) *serviceerror.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if strings.TrimSpace(userID) == "" {
+		return &ErrorMissingUserID
+	}
+
+	if err := s.store.DeleteGrantsByUserID(ctx, userID); err != nil {
+		logger.Error("Failed to revoke user grants", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	logger.Debug("Successfully revoked user grants")
+	return nil
+}
+
+// revokeReusedGrant revokes a grant after one of its rotated refresh tokens was redeemed again.
+func (s *tokenGrantService) revokeReusedGrant(ctx context.Context, grantID string) *serviceerror.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	logger.Warn("Refresh token reuse detected, revoking grant", log.MaskedString("grantId", grantID))
+	if err := s.store.DeleteGrant(ctx, grantID); err != nil && !errors.Is(err, errGrantNotFound) {
+		logger.Error("Failed to revoke grant after refresh token reuse", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	return &ErrorRefreshTokenReused
+}
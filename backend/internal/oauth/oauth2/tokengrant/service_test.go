@@ -0,0 +1,211 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokengrant
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	mockStore *grantStoreInterfaceMock
+	service   TokenGrantServiceInterface
+	ctx       context.Context
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	s.mockStore = newGrantStoreInterfaceMock(s.T())
+	s.service = newTokenGrantService(s.mockStore)
+	s.ctx = context.Background()
+}
+
+func (s *ServiceTestSuite) testGrant() Grant {
+	return Grant{
+		ID:             testGrantID,
+		ClientID:       testClientID,
+		UserID:         testUserID,
+		GrantType:      "authorization_code",
+		Scopes:         []string{"openid"},
+		CurrentTokenID: testTokenID,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+		ExpiryTime:     time.Now().Add(time.Hour),
+	}
+}
+
+// Tests for CreateGrant
+
+func (s *ServiceTestSuite) TestCreateGrant_Success() {
+	grant := s.testGrant()
+	grant.CreatedAt = time.Time{}
+	grant.Scopes = nil
+	s.mockStore.EXPECT().CreateGrant(mock.Anything, mock.MatchedBy(func(g Grant) bool {
+		return g.ID == testGrantID && !g.CreatedAt.IsZero() && g.UpdatedAt.Equal(g.CreatedAt) &&
+			g.Scopes != nil
+	})).Return(nil)
+
+	result, svcErr := s.service.CreateGrant(s.ctx, grant)
+
+	s.Nil(svcErr)
+	s.Equal(testTokenID, result.CurrentTokenID)
+}
+
+func (s *ServiceTestSuite) TestCreateGrant_MissingID() {
+	_, svcErr := s.service.CreateGrant(s.ctx, Grant{})
+
+	s.Equal(&ErrorMissingGrantID, svcErr)
+}
+
+func (s *ServiceTestSuite) TestCreateGrant_StoreError() {
+	s.mockStore.EXPECT().CreateGrant(mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	_, svcErr := s.service.CreateGrant(s.ctx, s.testGrant())
+
+	s.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+// Tests for ValidateGrantToken
+
+func (s *ServiceTestSuite) TestValidateGrantToken_Success() {
+	s.mockStore.EXPECT().GetGrant(mock.Anything, testGrantID).Return(s.testGrant(), nil)
+
+	grant, svcErr := s.service.ValidateGrantToken(s.ctx, testGrantID, testTokenID)
+
+	s.Nil(svcErr)
+	s.Equal(testGrantID, grant.ID)
+}
+
+func (s *ServiceTestSuite) TestValidateGrantToken_NotFound() {
+	s.mockStore.EXPECT().GetGrant(mock.Anything, testGrantID).Return(Grant{}, errGrantNotFound)
+
+	_, svcErr := s.service.ValidateGrantToken(s.ctx, testGrantID, testTokenID)
+
+	s.Equal(&ErrorGrantNotFound, svcErr)
+}
+
+func (s *ServiceTestSuite) TestValidateGrantToken_ReuseRevokesGrant() {
+	s.mockStore.EXPECT().GetGrant(mock.Anything, testGrantID).Return(s.testGrant(), nil)
+	s.mockStore.EXPECT().DeleteGrant(mock.Anything, testGrantID).Return(nil)
+
+	_, svcErr := s.service.ValidateGrantToken(s.ctx, testGrantID, "rotated-token")
+
+	s.Equal(&ErrorRefreshTokenReused, svcErr)
+}
+
+func (s *ServiceTestSuite) TestValidateGrantToken_ReuseRevocationFailure() {
+	s.mockStore.EXPECT().GetGrant(mock.Anything, testGrantID).Return(s.testGrant(), nil)
+	s.mockStore.EXPECT().DeleteGrant(mock.Anything, testGrantID).Return(errors.New("db error"))
+
+	_, svcErr := s.service.ValidateGrantToken(s.ctx, testGrantID, "rotated-token")
+
+	s.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+// Tests for RotateGrantToken
+
+func (s *ServiceTestSuite) TestRotateGrantToken_Success() {
+	grant := s.testGrant()
+	expiry := time.Now().Add(2 * time.Hour)
+	s.mockStore.EXPECT().RotateToken(mock.Anything, mock.MatchedBy(func(g Grant) bool {
+		return g.CurrentTokenID == "next-token" && g.ExpiryTime.Equal(expiry)
+	}), testTokenID).Return(nil)
+
+	svcErr := s.service.RotateGrantToken(s.ctx, &grant, "next-token", expiry)
+
+	s.Nil(svcErr)
+	s.Equal("next-token", grant.CurrentTokenID)
+	s.True(grant.ExpiryTime.Equal(expiry))
+}
+
+func (s *ServiceTestSuite) TestRotateGrantToken_ConcurrentRotationRevokesGrant() {
+	grant := s.testGrant()
+	s.mockStore.EXPECT().RotateToken(mock.Anything, mock.Anything, testTokenID).Return(errGrantNotFound)
+	s.mockStore.EXPECT().DeleteGrant(mock.Anything, testGrantID).Return(errGrantNotFound)
+
+	svcErr := s.service.RotateGrantToken(s.ctx, &grant, "next-token", time.Now().Add(time.Hour))
+
+	s.Equal(&ErrorRefreshTokenReused, svcErr)
+	s.Equal(testTokenID, grant.CurrentTokenID)
+}
+
+func (s *ServiceTestSuite) TestRotateGrantToken_StoreError() {
+	grant := s.testGrant()
+	s.mockStore.EXPECT().RotateToken(mock.Anything, mock.Anything, testTokenID).Return(errors.New("db error"))
+
+	svcErr := s.service.RotateGrantToken(s.ctx, &grant, "next-token", time.Now().Add(time.Hour))
+
+	s.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (s *ServiceTestSuite) TestRotateGrantToken_NilGrant() {
+	s.Equal(&ErrorMissingGrantID, s.service.RotateGrantToken(s.ctx, nil, "next-token", time.Now()))
+}
+
+// Tests for grant management
+
+func (s *ServiceTestSuite) TestGetGrant_MissingID() {
+	_, svcErr := s.service.GetGrant(s.ctx, " ")
+
+	s.Equal(&ErrorMissingGrantID, svcErr)
+}
+
+func (s *ServiceTestSuite) TestGetUserGrants_Success() {
+	s.mockStore.EXPECT().GetGrantsByUserID(mock.Anything, testUserID).Return([]Grant{s.testGrant()}, nil)
+
+	grants, svcErr := s.service.GetUserGrants(s.ctx, testUserID)
+
+	s.Nil(svcErr)
+	s.Len(grants, 1)
+}
+
+func (s *ServiceTestSuite) TestGetUserGrants_MissingUserID() {
+	_, svcErr := s.service.GetUserGrants(s.ctx, "")
+
+	s.Equal(&ErrorMissingUserID, svcErr)
+}
+
+func (s *ServiceTestSuite) TestRevokeGrant_NotFound() {
+	s.mockStore.EXPECT().DeleteGrant(mock.Anything, testGrantID).Return(errGrantNotFound)
+
+	s.Equal(&ErrorGrantNotFound, s.service.RevokeGrant(s.ctx, testGrantID))
+}
+
+func (s *ServiceTestSuite) TestRevokeUserGrants_Success() {
+	s.mockStore.EXPECT().DeleteGrantsByUserID(mock.Anything, testUserID).Return(nil)
+
+	s.Nil(s.service.RevokeUserGrants(s.ctx, testUserID))
+}
+
+func (s *ServiceTestSuite) TestRevokeUserGrants_StoreError() {
+	s.mockStore.EXPECT().DeleteGrantsByUserID(mock.Anything, testUserID).Return(errors.New("db error"))
+
+	s.Equal(&serviceerror.InternalServerError, s.service.RevokeUserGrants(s.ctx, testUserID))
+}
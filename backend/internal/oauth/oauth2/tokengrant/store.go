@@ -0,0 +1,300 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokengrant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	dbprovider "github.com/asgardeo/thunder/internal/system/database/provider"
+	dbutils "github.com/asgardeo/thunder/internal/system/database/utils"
+)
+
+const (
+	jsonDataKeyGrantType          = "grant_type"
+	jsonDataKeyScopes             = "scopes"
+	jsonDataKeyAbsoluteExpiryTime = "absolute_expiry_time"
+)
+
+// grantStoreInterface defines the interface for the refresh token grant store.
+type grantStoreInterface interface {
+	// CreateGrant persists a new grant.
+	CreateGrant(ctx context.Context, grant Grant) error
+
+	// GetGrant retrieves an unexpired grant by ID.
+	GetGrant(ctx context.Context, id string) (Grant, error)
+
+	// GetGrantsByUserID retrieves the unexpired grants of a user.
+	GetGrantsByUserID(ctx context.Context, userID string) ([]Grant, error)
+
+	// RotateToken replaces the current token of an unexpired grant with grant.CurrentTokenID and updates
+	// its expiry, provided previousTokenID is still the current token. Returns errGrantNotFound otherwise.
+	RotateToken(ctx context.Context, grant Grant, previousTokenID string) error
+
+	// DeleteGrant deletes a grant by ID.
+	DeleteGrant(ctx context.Context, id string) error
+
+	// DeleteGrantsByUserID deletes all grants of a user.
+	DeleteGrantsByUserID(ctx context.Context, userID string) error
+}
+
+// grantStore is the SQL implementation of grantStoreInterface.
+type grantStore struct {
+	dbProvider   dbprovider.DBProviderInterface
+	deploymentID string
+}
+
+// newGrantStore creates a new instance of grantStore.
+func newGrantStore() grantStoreInterface {
+	return &grantStore{
+		dbProvider:   dbprovider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateGrant persists a new grant in the database.
+func (s *grantStore) CreateGrant(ctx context.Context, grant Grant) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	grantData, err := getGrantDataJSON(grant)
+	if err != nil {
+		return err
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryInsertGrant, grant.ID, grant.ClientID, grant.UserID,
+		grant.CurrentTokenID, grantData, grant.ExpiryTime, grant.CreatedAt, grant.UpdatedAt, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to insert grant: %w", err)
+	}
+	if rows == 0 {
+		return errors.New("no rows affected, grant creation failed")
+	}
+
+	return nil
+}
+
+// GetGrant retrieves an unexpired grant by ID from the database.
+func (s *grantStore) GetGrant(ctx context.Context, id string) (Grant, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return Grant{}, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetGrant, id, time.Now(), s.deploymentID)
+	if err != nil {
+		return Grant{}, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return Grant{}, errGrantNotFound
+	}
+	if len(results) > 1 {
+		return Grant{}, errors.New("multiple grants found")
+	}
+
+	grant, err := buildGrantFromResultRow(results[0])
+	if err != nil {
+		return Grant{}, fmt.Errorf("failed to build grant from result row: %w", err)
+	}
+
+	return grant, nil
+}
+
+// GetGrantsByUserID retrieves the unexpired grants of a user from the database.
+func (s *grantStore) GetGrantsByUserID(ctx context.Context, userID string) ([]Grant, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetGrantsByUserID, userID, time.Now(), s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	grants := make([]Grant, 0, len(results))
+	for _, row := range results {
+		grant, err := buildGrantFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build grant from result row: %w", err)
+		}
+		grants = append(grants, grant)
+	}
+
+	return grants, nil
+}
+
+// RotateToken replaces the current token of an unexpired grant in the database. The update is
+// conditional on the previous token still being current, so concurrent redemptions of the same
+// token cannot both succeed.
+func (s *grantStore) RotateToken(ctx context.Context, grant Grant, previousTokenID string) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryRotateGrantToken, grant.ID, grant.CurrentTokenID,
+		grant.ExpiryTime, grant.UpdatedAt, previousTokenID, time.Now(), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to rotate grant token: %w", err)
+	}
+	if rows == 0 {
+		return errGrantNotFound
+	}
+
+	return nil
+}
+
+// DeleteGrant deletes a grant by ID from the database.
+func (s *grantStore) DeleteGrant(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryDeleteGrant, id, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete grant: %w", err)
+	}
+	if rows == 0 {
+		return errGrantNotFound
+	}
+
+	return nil
+}
+
+// DeleteGrantsByUserID deletes all grants of a user from the database.
+func (s *grantStore) DeleteGrantsByUserID(ctx context.Context, userID string) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteGrantsByUserID, userID, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to delete grants: %w", err)
+	}
+
+	return nil
+}
+
+// getGrantDataJSON serializes the grant attributes stored in the GRANT_DATA column.
+func getGrantDataJSON(grant Grant) (string, error) {
+	scopes := grant.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	var absoluteExpiryTime int64
+	if !grant.AbsoluteExpiryTime.IsZero() {
+		absoluteExpiryTime = grant.AbsoluteExpiryTime.Unix()
+	}
+	jsonData := map[string]interface{}{
+		jsonDataKeyGrantType:          grant.GrantType,
+		jsonDataKeyScopes:             scopes,
+		jsonDataKeyAbsoluteExpiryTime: absoluteExpiryTime,
+	}
+
+	data, err := json.Marshal(jsonData)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal grant data: %w", err)
+	}
+	return string(data), nil
+}
+
+// buildGrantFromResultRow builds a Grant object from a database result row.
+func buildGrantFromResultRow(row map[string]interface{}) (Grant, error) {
+	id, ok := row["grant_id"].(string)
+	if !ok {
+		return Grant{}, errors.New("failed to parse grant_id as string")
+	}
+
+	clientID, ok := row["client_id"].(string)
+	if !ok {
+		return Grant{}, errors.New("failed to parse client_id as string")
+	}
+
+	userID, ok := row["user_id"].(string)
+	if !ok {
+		return Grant{}, errors.New("failed to parse user_id as string")
+	}
+
+	currentTokenID, ok := row["current_token_id"].(string)
+	if !ok {
+		return Grant{}, errors.New("failed to parse current_token_id as string")
+	}
+
+	var dataStr string
+	switch v := row["grant_data"].(type) {
+	case string:
+		dataStr = v
+	case []byte:
+		dataStr = string(v)
+	default:
+		return Grant{}, errors.New("failed to parse grant_data: expected string or []byte")
+	}
+
+	var data struct {
+		GrantType          string   `json:"grant_type"`
+		Scopes             []string `json:"scopes"`
+		AbsoluteExpiryTime int64    `json:"absolute_expiry_time"`
+	}
+	if err := json.Unmarshal([]byte(dataStr), &data); err != nil {
+		return Grant{}, fmt.Errorf("failed to unmarshal grant data: %w", err)
+	}
+	if data.Scopes == nil {
+		data.Scopes = []string{}
+	}
+
+	expiryTime, err := dbutils.ParseTimeField(row["expiry_time"], "expiry_time")
+	if err != nil {
+		return Grant{}, err
+	}
+
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return Grant{}, err
+	}
+
+	updatedAt, err := dbutils.ParseTimeField(row["updated_at"], "updated_at")
+	if err != nil {
+		return Grant{}, err
+	}
+
+	grant := Grant{
+		ID:             id,
+		ClientID:       clientID,
+		UserID:         userID,
+		GrantType:      data.GrantType,
+		Scopes:         data.Scopes,
+		CurrentTokenID: currentTokenID,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+		ExpiryTime:     expiryTime,
+	}
+	if data.AbsoluteExpiryTime > 0 {
+		grant.AbsoluteExpiryTime = time.Unix(data.AbsoluteExpiryTime, 0)
+	}
+
+	return grant, nil
+}
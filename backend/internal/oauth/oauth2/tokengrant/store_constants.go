@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokengrant
+
+import dbmodel "github.com/asgardeo/thunder/internal/system/database/model"
+
+var (
+	// queryInsertGrant inserts a new refresh token grant.
+	queryInsertGrant = dbmodel.DBQuery{
+		ID: "RTG-01",
+		Query: `INSERT INTO "REFRESH_TOKEN_GRANT" (GRANT_ID, CLIENT_ID, USER_ID, CURRENT_TOKEN_ID, GRANT_DATA, ` +
+			`EXPIRY_TIME, CREATED_AT, UPDATED_AT, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+	}
+
+	// queryGetGrant retrieves an unexpired refresh token grant by ID.
+	queryGetGrant = dbmodel.DBQuery{
+		ID: "RTG-02",
+		Query: `SELECT GRANT_ID, CLIENT_ID, USER_ID, CURRENT_TOKEN_ID, GRANT_DATA, EXPIRY_TIME, CREATED_AT, ` +
+			`UPDATED_AT FROM "REFRESH_TOKEN_GRANT" WHERE GRANT_ID = $1 AND EXPIRY_TIME > $2 AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryGetGrantsByUserID retrieves the unexpired refresh token grants of a user.
+	queryGetGrantsByUserID = dbmodel.DBQuery{
+		ID: "RTG-03",
+		Query: `SELECT GRANT_ID, CLIENT_ID, USER_ID, CURRENT_TOKEN_ID, GRANT_DATA, EXPIRY_TIME, CREATED_AT, ` +
+			`UPDATED_AT FROM "REFRESH_TOKEN_GRANT" WHERE USER_ID = $1 AND EXPIRY_TIME > $2 AND DEPLOYMENT_ID = $3 ` +
+			`ORDER BY CREATED_AT`,
+	}
+
+	// queryRotateGrantToken replaces the current token of an unexpired grant, provided the token being
+	// replaced is still the current one.
+	queryRotateGrantToken = dbmodel.DBQuery{
+		ID: "RTG-04",
+		Query: `UPDATE "REFRESH_TOKEN_GRANT" SET CURRENT_TOKEN_ID = $2, EXPIRY_TIME = $3, UPDATED_AT = $4 ` +
+			`WHERE GRANT_ID = $1 AND CURRENT_TOKEN_ID = $5 AND EXPIRY_TIME > $6 AND DEPLOYMENT_ID = $7`,
+	}
+
+	// queryDeleteGrant deletes a refresh token grant by ID.
+	queryDeleteGrant = dbmodel.DBQuery{
+		ID:    "RTG-05",
+		Query: `DELETE FROM "REFRESH_TOKEN_GRANT" WHERE GRANT_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryDeleteGrantsByUserID deletes all refresh token grants of a user.
+	queryDeleteGrantsByUserID = dbmodel.DBQuery{
+		ID:    "RTG-06",
+		Query: `DELETE FROM "REFRESH_TOKEN_GRANT" WHERE USER_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
@@ -0,0 +1,227 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokengrant
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/tests/mocks/database/providermock"
+)
+
+const (
+	testDeploymentID = "test-deployment-id"
+	testGrantID      = "0190d3f6-6b7a-7c3e-8a1b-1234567890ab"
+	testTokenID      = "0190d3f6-6b7a-7c3e-8a1b-1234567890ac"
+	testUserID       = "test-user-id"
+	testClientID     = "test-client-id"
+)
+
+type StoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *grantStore
+	ctx            context.Context
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (s *StoreTestSuite) SetupTest() {
+	s.mockDBProvider = &providermock.DBProviderInterfaceMock{}
+	s.mockDBClient = &providermock.DBClientInterfaceMock{}
+	s.store = &grantStore{
+		dbProvider:   s.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+}
+
+func (s *StoreTestSuite) testGrant() Grant {
+	now := time.Now()
+	return Grant{
+		ID:                 testGrantID,
+		ClientID:           testClientID,
+		UserID:             testUserID,
+		GrantType:          "authorization_code",
+		Scopes:             []string{"openid", "profile"},
+		CurrentTokenID:     testTokenID,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+		ExpiryTime:         now.Add(time.Hour),
+		AbsoluteExpiryTime: time.Unix(now.Add(24*time.Hour).Unix(), 0),
+	}
+}
+
+func (s *StoreTestSuite) resultRow(grant Grant, data interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"grant_id":         grant.ID,
+		"client_id":        grant.ClientID,
+		"user_id":          grant.UserID,
+		"current_token_id": grant.CurrentTokenID,
+		"grant_data":       data,
+		"expiry_time":      grant.ExpiryTime,
+		"created_at":       grant.CreatedAt,
+		"updated_at":       grant.UpdatedAt,
+	}
+}
+
+func (s *StoreTestSuite) TestCreateGrant_Success() {
+	grant := s.testGrant()
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertGrant,
+		testGrantID, testClientID, testUserID, testTokenID, mock.MatchedBy(func(data string) bool {
+			return assert.JSONEq(s.T(), `{"grant_type":"authorization_code","scopes":["openid","profile"],`+
+				`"absolute_expiry_time":`+formatUnix(grant.AbsoluteExpiryTime)+`}`, data)
+		}), grant.ExpiryTime, grant.CreatedAt, grant.UpdatedAt, testDeploymentID,
+	).Return(int64(1), nil)
+
+	err := s.store.CreateGrant(s.ctx, grant)
+
+	assert.NoError(s.T(), err)
+	s.mockDBClient.AssertExpectations(s.T())
+}
+
+func (s *StoreTestSuite) TestCreateGrant_DBClientError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db client error"))
+
+	err := s.store.CreateGrant(s.ctx, s.testGrant())
+
+	assert.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "failed to get database client")
+}
+
+func (s *StoreTestSuite) TestGetGrant_Success() {
+	expected := s.testGrant()
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetGrant, testGrantID, mock.Anything, testDeploymentID).
+		Return([]map[string]interface{}{s.resultRow(expected,
+			`{"grant_type":"authorization_code","scopes":["openid","profile"],"absolute_expiry_time":`+
+				formatUnix(expected.AbsoluteExpiryTime)+`}`)}, nil)
+
+	grant, err := s.store.GetGrant(s.ctx, testGrantID)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, grant)
+}
+
+func (s *StoreTestSuite) TestGetGrant_WithoutAbsoluteExpiry() {
+	expected := s.testGrant()
+	expected.AbsoluteExpiryTime = time.Time{}
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetGrant, testGrantID, mock.Anything, testDeploymentID).
+		Return([]map[string]interface{}{s.resultRow(expected,
+			`{"grant_type":"authorization_code","scopes":["openid","profile"],"absolute_expiry_time":0}`)}, nil)
+
+	grant, err := s.store.GetGrant(s.ctx, testGrantID)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), grant.AbsoluteExpiryTime.IsZero())
+}
+
+func (s *StoreTestSuite) TestGetGrant_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetGrant, testGrantID, mock.Anything, testDeploymentID).
+		Return([]map[string]interface{}{}, nil)
+
+	_, err := s.store.GetGrant(s.ctx, testGrantID)
+
+	assert.ErrorIs(s.T(), err, errGrantNotFound)
+}
+
+func (s *StoreTestSuite) TestGetGrant_InvalidData() {
+	row := s.resultRow(s.testGrant(), "not-json")
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetGrant, testGrantID, mock.Anything, testDeploymentID).
+		Return([]map[string]interface{}{row}, nil)
+
+	_, err := s.store.GetGrant(s.ctx, testGrantID)
+
+	assert.Error(s.T(), err)
+}
+
+func (s *StoreTestSuite) TestGetGrantsByUserID_Success() {
+	grant := s.testGrant()
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetGrantsByUserID, testUserID, mock.Anything,
+		testDeploymentID).Return([]map[string]interface{}{
+		s.resultRow(grant, []byte(`{"grant_type":"authorization_code","scopes":null}`)),
+	}, nil)
+
+	grants, err := s.store.GetGrantsByUserID(s.ctx, testUserID)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), grants, 1)
+	assert.Equal(s.T(), []string{}, grants[0].Scopes)
+}
+
+func (s *StoreTestSuite) TestRotateToken_Success() {
+	grant := s.testGrant()
+	grant.CurrentTokenID = "next-token"
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryRotateGrantToken, testGrantID, "next-token",
+		grant.ExpiryTime, grant.UpdatedAt, testTokenID, mock.Anything, testDeploymentID).Return(int64(1), nil)
+
+	err := s.store.RotateToken(s.ctx, grant, testTokenID)
+
+	assert.NoError(s.T(), err)
+}
+
+func (s *StoreTestSuite) TestRotateToken_TokenNotCurrent() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryRotateGrantToken, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(0), nil)
+
+	err := s.store.RotateToken(s.ctx, s.testGrant(), "stale-token")
+
+	assert.ErrorIs(s.T(), err, errGrantNotFound)
+}
+
+func (s *StoreTestSuite) TestDeleteGrant_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteGrant, testGrantID, testDeploymentID).
+		Return(int64(0), nil)
+
+	err := s.store.DeleteGrant(s.ctx, testGrantID)
+
+	assert.ErrorIs(s.T(), err, errGrantNotFound)
+}
+
+func (s *StoreTestSuite) TestDeleteGrantsByUserID_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteGrantsByUserID, testUserID, testDeploymentID).
+		Return(int64(2), nil)
+
+	err := s.store.DeleteGrantsByUserID(s.ctx, testUserID)
+
+	assert.NoError(s.T(), err)
+}
+
+func formatUnix(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
@@ -23,7 +23,9 @@ import (
 	"fmt"
 
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/clientauth"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/dpop"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/jwksresolver"
 	oauth2model "github.com/asgardeo/thunder/internal/oauth/oauth2/model"
 	oauth2utils "github.com/asgardeo/thunder/internal/oauth/oauth2/utils"
@@ -79,17 +81,23 @@ func (tb *tokenBuilder) BuildAccessToken(ctx *AccessTokenBuildContext) (*oauth2m
 		return nil, fmt.Errorf("failed to build access token claims: %w", claimsErr)
 	}
 
+	tokenType := constants.TokenTypeBearer
+	if dpop.GetProofKeyThumbprint(ctx.Context) != "" {
+		tokenType = constants.TokenTypeDPoP
+	}
+
 	tokenDTO := &oauth2model.TokenDTO{
-		TokenType:        constants.TokenTypeBearer,
-		ExpiresIn:        tokenConfig.ValidityPeriod,
-		Scopes:           ctx.Scopes,
-		ClientID:         ctx.ClientID,
-		UserAttributes:   userAttributes,
-		AttributeCacheID: ctx.AttributeCacheID,
-		Subject:          ctx.Subject,
-		Audiences:        ctx.Audiences,
-		ClaimsRequest:    ctx.ClaimsRequest,
-		ClaimsLocales:    ctx.ClaimsLocales,
+		TokenType:            tokenType,
+		ExpiresIn:            tokenConfig.ValidityPeriod,
+		Scopes:               ctx.Scopes,
+		ClientID:             ctx.ClientID,
+		UserAttributes:       userAttributes,
+		AttributeCacheID:     ctx.AttributeCacheID,
+		Subject:              ctx.Subject,
+		Audiences:            ctx.Audiences,
+		ClaimsRequest:        ctx.ClaimsRequest,
+		ClaimsLocales:        ctx.ClaimsLocales,
+		AuthorizationDetails: ctx.AuthorizationDetails,
 	}
 
 	token, iat, err := tb.jwtService.GenerateJWT(
@@ -170,6 +178,23 @@ func (tb *tokenBuilder) buildAccessTokenClaims(
 		claims[constants.ClaimClaimsLocales] = ctx.ClaimsLocales
 	}
 
+	// Include the granted rich authorization request details (RFC 9396 §9)
+	if len(ctx.AuthorizationDetails) > 0 {
+		claims[constants.ClaimAuthorizationDetails] = ctx.AuthorizationDetails
+	}
+
+	// Bind the token to the client's mutual-TLS certificate (RFC 8705 §3) and DPoP key (RFC 9449 §6).
+	confirmation := make(map[string]interface{})
+	if thumbprint := certificateBinding(ctx); thumbprint != "" {
+		confirmation[jwt.ConfirmationX5tS256] = thumbprint
+	}
+	if thumbprint := dpop.GetProofKeyThumbprint(ctx.Context); thumbprint != "" {
+		confirmation[jwt.ConfirmationJKT] = thumbprint
+	}
+	if len(confirmation) > 0 {
+		claims[jwt.ClaimConfirmation] = confirmation
+	}
+
 	if len(ctx.Audiences) > 1 {
 		claims["aud"] = ctx.Audiences
 	} else if len(ctx.Audiences) == 1 {
@@ -179,6 +204,18 @@ func (tb *tokenBuilder) buildAccessTokenClaims(
 	return claims, nil
 }
 
+// certificateBinding returns the thumbprint of the client certificate presented on the token request
+// when the client requires certificate-bound access tokens, or an empty string otherwise.
+func certificateBinding(ctx *AccessTokenBuildContext) string {
+	if ctx.OAuthApp == nil || !ctx.OAuthApp.CertificateBoundAccessTokens || ctx.Context == nil {
+		return ""
+	}
+	if clientInfo := clientauth.GetOAuthClient(ctx.Context); clientInfo != nil {
+		return clientInfo.CertificateThumbprint
+	}
+	return ""
+}
+
 // buildAccessTokenUserAttributes builds user attributes for the access token based on app configuration.
 func (tb *tokenBuilder) buildAccessTokenUserAttributes(
 	attrs map[string]interface{},
@@ -238,6 +275,10 @@ func (tb *tokenBuilder) BuildRefreshToken(ctx *RefreshTokenBuildContext) (*oauth
 
 	tokenConfig := ResolveTokenConfig(ctx.OAuthApp, TokenTypeRefresh)
 
+	if ctx.ValidityPeriod > 0 {
+		tokenConfig.ValidityPeriod = ctx.ValidityPeriod
+	}
+
 	claims, claimsErr := tb.buildRefreshTokenClaims(ctx)
 	if claimsErr != nil {
 		return nil, fmt.Errorf("failed to build refresh token claims: %w", claimsErr)
@@ -290,6 +331,13 @@ func (tb *tokenBuilder) buildRefreshTokenClaims(ctx *RefreshTokenBuildContext) (
 		claims["aci"] = ctx.AttributeCacheID
 	}
 
+	if ctx.GrantID != "" {
+		claims["grant_id"] = ctx.GrantID
+	}
+	if ctx.TokenID != "" {
+		claims["jti"] = ctx.TokenID
+	}
+
 	// Include claims request if present
 	if ctx.ClaimsRequest != nil && !ctx.ClaimsRequest.IsEmpty() {
 		serialized, err := oauth2utils.SerializeClaimsRequest(ctx.ClaimsRequest)
@@ -306,6 +354,29 @@ func (tb *tokenBuilder) buildRefreshTokenClaims(ctx *RefreshTokenBuildContext) (
 		claims["access_token_claims_locales"] = ctx.ClaimsLocales
 	}
 
+	// Include authorization details if present
+	if len(ctx.AuthorizationDetails) > 0 {
+		claims["access_token_authorization_details"] = ctx.AuthorizationDetails
+	}
+
+	// Include the user's authentication time if known
+	if ctx.AuthTime > 0 {
+		claims["id_token_auth_time"] = ctx.AuthTime
+	}
+
+	// Include the SSO session identifier if the ID token was issued within a session
+	if ctx.SID != "" {
+		claims["id_token_sid"] = ctx.SID
+	}
+
+	// Refresh tokens of public clients are bound to the DPoP key, since the client cannot otherwise
+	// prove it is the legitimate holder (RFC 9449 §5).
+	if ctx.OAuthApp != nil && ctx.OAuthApp.PublicClient {
+		if thumbprint := dpop.GetProofKeyThumbprint(ctx.Context); thumbprint != "" {
+			claims[jwt.ClaimConfirmation] = map[string]interface{}{jwt.ConfirmationJKT: thumbprint}
+		}
+	}
+
 	return claims, nil
 }
 
@@ -319,12 +390,24 @@ func (tb *tokenBuilder) BuildIDToken(ctx *IDTokenBuildContext) (*oauth2model.Tok
 
 	jwtClaims := tb.buildIDTokenClaims(ctx)
 
+	// The c_hash and at_hash claims depend on the signing algorithm, hence the ID token is signed with the
+	// algorithm the hashes were computed for.
+	signingAlg := ""
+	if ctx.AuthorizationCode != "" || ctx.AccessToken != "" {
+		signingAlg = tb.jwtService.GetSigningAlgorithm()
+		if err := addTokenHashClaims(jwtClaims, ctx, signingAlg); err != nil {
+			return nil, fmt.Errorf("failed to compute token hash claims: %w", err)
+		}
+	}
+
 	tokenDTO := &oauth2model.TokenDTO{
 		ExpiresIn: tokenConfig.ValidityPeriod,
 		Scopes:    ctx.Scopes,
 		ClientID:  ctx.Audience,
 		Subject:   ctx.Subject,
 		Audiences: []string{ctx.Audience},
+		AuthTime:  ctx.AuthTime,
+		SID:       ctx.SID,
 	}
 
 	jwtClaims["aud"] = ctx.Audience
@@ -336,7 +419,7 @@ func (tb *tokenBuilder) BuildIDToken(ctx *IDTokenBuildContext) (*oauth2model.Tok
 		tokenConfig.ValidityPeriod,
 		jwtClaims,
 		jwt.TokenTypeJWT,
-		"",
+		signingAlg,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate ID token: %v", err.Error)
@@ -385,7 +468,7 @@ func (tb *tokenBuilder) buildIDTokenClaims(ctx *IDTokenBuildContext) map[string]
 	claims := make(map[string]interface{})
 
 	if ctx.AuthTime > 0 {
-		claims["auth_time"] = ctx.AuthTime
+		claims[constants.ClaimAuthTime] = ctx.AuthTime
 	}
 
 	if ctx.Nonce != "" {
@@ -393,7 +476,11 @@ func (tb *tokenBuilder) buildIDTokenClaims(ctx *IDTokenBuildContext) map[string]
 	}
 
 	if ctx.CompletedACR != "" {
-		claims["acr"] = ctx.CompletedACR
+		claims[constants.ClaimACR] = ctx.CompletedACR
+	}
+
+	if ctx.SID != "" {
+		claims[constants.ClaimSID] = ctx.SID
 	}
 
 	userAttributes := ctx.UserAttributes
@@ -428,5 +515,8 @@ func (tb *tokenBuilder) buildIDTokenClaims(ctx *IDTokenBuildContext) map[string]
 		claims[key] = value
 	}
 
+	// Set after the user claims so that a user attribute cannot override the marker.
+	claims[constants.ClaimTokenUse] = constants.TokenUseID
+
 	return claims
 }
@@ -40,8 +40,11 @@ import (
 
 	certmodel "github.com/asgardeo/thunder/internal/cert"
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/clientauth"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/dpop"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/jwksresolver"
+	oauth2model "github.com/asgardeo/thunder/internal/oauth/oauth2/model"
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	"github.com/asgardeo/thunder/internal/system/jose/jwe"
@@ -145,6 +148,89 @@ func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_Basic() {
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_CertificateBinding() {
+	clientCtx := context.WithValue(context.Background(), clientauth.OAuthClientKey,
+		&clientauth.OAuthClientInfo{ClientID: "test-client", CertificateThumbprint: "cert-thumbprint"})
+
+	testCases := []struct {
+		name          string
+		ctx           context.Context
+		boundTokens   bool
+		expectedClaim interface{}
+	}{
+		{"Bound", clientCtx, true, map[string]interface{}{"x5t#S256": "cert-thumbprint"}},
+		{"BindingNotRequired", clientCtx, false, nil},
+		{"NoClientCertificate", context.Background(), true, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			oauthApp := *suite.oauthApp
+			oauthApp.CertificateBoundAccessTokens = tc.boundTokens
+			var captured map[string]interface{}
+			suite.mockJWTService.On("GenerateJWT", mock.Anything, "user123", mock.Anything, mock.Anything,
+				mock.Anything, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					captured = args.Get(4).(map[string]interface{})
+				}).Return(testAccessToken, time.Now().Unix(), nil)
+
+			_, err := suite.builder.BuildAccessToken(&AccessTokenBuildContext{
+				Context:  tc.ctx,
+				Subject:  "user123",
+				ClientID: "test-client",
+				OAuthApp: &oauthApp,
+			})
+
+			suite.Require().NoError(err)
+			suite.Equal(tc.expectedClaim, captured["cnf"])
+		})
+	}
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_DPoPBinding() {
+	clientCtx := context.WithValue(context.Background(), clientauth.OAuthClientKey,
+		&clientauth.OAuthClientInfo{ClientID: "test-client", CertificateThumbprint: "cert-thumbprint"})
+
+	testCases := []struct {
+		name              string
+		ctx               context.Context
+		expectedTokenType string
+		expectedClaim     interface{}
+	}{
+		{"Bound", dpop.WithProofKeyThumbprint(context.Background(), "key-thumbprint"),
+			constants.TokenTypeDPoP, map[string]interface{}{"jkt": "key-thumbprint"}},
+		{"BoundWithCertificate", dpop.WithProofKeyThumbprint(clientCtx, "key-thumbprint"), constants.TokenTypeDPoP,
+			map[string]interface{}{"x5t#S256": "cert-thumbprint", "jkt": "key-thumbprint"}},
+		{"NoProof", context.Background(), constants.TokenTypeBearer, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			oauthApp := *suite.oauthApp
+			oauthApp.CertificateBoundAccessTokens = true
+			var captured map[string]interface{}
+			suite.mockJWTService.On("GenerateJWT", mock.Anything, "user123", mock.Anything, mock.Anything,
+				mock.Anything, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					captured = args.Get(4).(map[string]interface{})
+				}).Return(testAccessToken, time.Now().Unix(), nil)
+
+			result, err := suite.builder.BuildAccessToken(&AccessTokenBuildContext{
+				Context:  tc.ctx,
+				Subject:  "user123",
+				ClientID: "test-client",
+				OAuthApp: &oauthApp,
+			})
+
+			suite.Require().NoError(err)
+			suite.Equal(tc.expectedTokenType, result.TokenType)
+			suite.Equal(tc.expectedClaim, captured["cnf"])
+		})
+	}
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithActorClaim() {
 	actorClaims := &SubjectTokenClaims{
 		Sub:            "actor123",
@@ -446,6 +532,35 @@ func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithClaimsLocal
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithAuthorizationDetails() {
+	details := []oauth2model.AuthorizationDetail{{"type": "payment_initiation", "amount": "50.00"}}
+	ctx := &AccessTokenBuildContext{
+		Subject:              "user123",
+		Audiences:            []string{"app123"},
+		ClientID:             "test-client",
+		GrantType:            string(constants.GrantTypeAuthorizationCode),
+		OAuthApp:             suite.oauthApp,
+		AuthorizationDetails: details,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return reflect.DeepEqual(claims["authorization_details"], details)
+		}), mock.Anything, mock.Anything,
+	).Return(testAccessToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildAccessToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), details, result.AuthorizationDetails)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Success_Basic() {
 	// Create OAuth app with user attributes configured
 	oauthAppWithUserAttrs := &inboundmodel.OAuthClient{
@@ -498,6 +613,47 @@ func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Success_Basic() {
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_DPoPBinding() {
+	proofCtx := dpop.WithProofKeyThumbprint(context.Background(), "key-thumbprint")
+
+	testCases := []struct {
+		name          string
+		ctx           context.Context
+		publicClient  bool
+		expectedClaim interface{}
+	}{
+		{"PublicClientBound", proofCtx, true, map[string]interface{}{"jkt": "key-thumbprint"}},
+		{"ConfidentialClientNotBound", proofCtx, false, nil},
+		{"NoProof", context.Background(), true, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			oauthApp := *suite.oauthApp
+			oauthApp.PublicClient = tc.publicClient
+			var captured map[string]interface{}
+			suite.mockJWTService.On("GenerateJWT", mock.Anything, "test-client", mock.Anything, mock.Anything,
+				mock.Anything, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					captured = args.Get(4).(map[string]interface{})
+				}).Return(testRefreshToken, time.Now().Unix(), nil)
+
+			_, err := suite.builder.BuildRefreshToken(&RefreshTokenBuildContext{
+				Context:            tc.ctx,
+				ClientID:           "test-client",
+				Scopes:             []string{"read"},
+				GrantType:          string(constants.GrantTypeAuthorizationCode),
+				AccessTokenSubject: "user123",
+				OAuthApp:           &oauthApp,
+			})
+
+			suite.Require().NoError(err)
+			suite.Equal(tc.expectedClaim, captured["cnf"])
+		})
+	}
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Success_WithoutUserAttributes() {
 	ctx := &RefreshTokenBuildContext{
 		ClientID:             "test-client",
@@ -750,6 +906,93 @@ func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Success_WithClaimsLoca
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Success_WithAuthorizationDetails() {
+	details := []oauth2model.AuthorizationDetail{{"type": "payment_initiation", "amount": "50.00"}}
+	ctx := &RefreshTokenBuildContext{
+		ClientID:             "test-client",
+		GrantType:            string(constants.GrantTypeAuthorizationCode),
+		AccessTokenSubject:   "user123",
+		AccessTokenAudiences: []string{"app123"},
+		OAuthApp:             suite.oauthApp,
+		AuthorizationDetails: details,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"test-client",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return reflect.DeepEqual(claims["access_token_authorization_details"], details)
+		}), mock.Anything, mock.Anything,
+	).Return(testRefreshToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildRefreshToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Success_WithGrantFamily() {
+	ctx := &RefreshTokenBuildContext{
+		ClientID:             "test-client",
+		Scopes:               []string{"openid"},
+		GrantType:            string(constants.GrantTypeAuthorizationCode),
+		AccessTokenSubject:   "user123",
+		AccessTokenAudiences: []string{"app123"},
+		OAuthApp:             suite.oauthApp,
+		GrantID:              "grant-1",
+		TokenID:              "token-1",
+		ValidityPeriod:       120,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"test-client",
+		"https://thunder.io",
+		int64(120),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["grant_id"] == "grant-1" && claims["jti"] == "token-1"
+		}), mock.Anything, mock.Anything,
+	).Return(testRefreshToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildRefreshToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(120), result.ExpiresIn)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Success_WithAuthTime() {
+	ctx := &RefreshTokenBuildContext{
+		ClientID:             "test-client",
+		Scopes:               []string{"openid"},
+		GrantType:            string(constants.GrantTypeAuthorizationCode),
+		AccessTokenSubject:   "user123",
+		AccessTokenAudiences: []string{"app123"},
+		OAuthApp:             suite.oauthApp,
+		AuthTime:             1700000000,
+		SID:                  "test-sid",
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"test-client",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["id_token_auth_time"] == int64(1700000000) && claims["id_token_sid"] == "test-sid"
+		}), mock.Anything, mock.Anything,
+	).Return(testRefreshToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildRefreshToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 // ============================================================================
 // BuildIDToken Tests - Success Cases
 // ============================================================================
@@ -774,7 +1017,7 @@ func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_Basic() {
 		int64(3600),
 		mock.MatchedBy(func(claims map[string]interface{}) bool {
 			// sub is passed as first arg to GenerateJWT, not in claims map
-			return claims["auth_time"] == ctx.AuthTime
+			return claims["auth_time"] == ctx.AuthTime && claims["token_use"] == "id"
 		}), mock.Anything, mock.Anything,
 	).Return(expectedToken, expectedIat, nil)
 
@@ -788,6 +1031,33 @@ func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_Basic() {
 	assert.Equal(suite.T(), int64(3600), result.ExpiresIn)
 	assert.Equal(suite.T(), []string{"openid", "profile"}, result.Scopes)
 	assert.Equal(suite.T(), "app123", result.ClientID)
+	assert.Equal(suite.T(), ctx.AuthTime, result.AuthTime)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildIDToken_TokenUseNotOverriddenByUserAttributes() {
+	ctx := &IDTokenBuildContext{
+		Subject:        "user123",
+		Audience:       "app123",
+		Scopes:         []string{"openid"},
+		UserAttributes: map[string]interface{}{"token_use": "access"},
+		OAuthApp: &inboundmodel.OAuthClient{
+			Token: &inboundmodel.OAuthTokenConfig{
+				IDToken: &inboundmodel.IDTokenConfig{UserAttributes: []string{"token_use"}},
+			},
+		},
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything, "user123", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["token_use"] == "id"
+		}), mock.Anything, mock.Anything,
+	).Return(testIDToken, time.Now().Unix(), nil)
+
+	_, err := suite.builder.BuildIDToken(ctx)
+
+	assert.NoError(suite.T(), err)
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
@@ -822,6 +1092,84 @@ func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithNonce() {
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithSID() {
+	ctx := &IDTokenBuildContext{
+		Subject:        "user123",
+		Audience:       "app123",
+		Scopes:         []string{"openid"},
+		UserAttributes: map[string]interface{}{"sub": "user123"},
+		AuthTime:       time.Now().Unix(),
+		OAuthApp:       suite.oauthApp,
+		SID:            "test-sid",
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["sid"] == "test-sid"
+		}), mock.Anything, mock.Anything,
+	).Return(testIDToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildIDToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "test-sid", result.SID)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithTokenHashes() {
+	ctx := &IDTokenBuildContext{
+		Subject:           "user123",
+		Audience:          "app123",
+		Scopes:            []string{"openid"},
+		AuthTime:          time.Now().Unix(),
+		OAuthApp:          suite.oauthApp,
+		Nonce:             "test-nonce-123",
+		AuthorizationCode: "Qcb0Orv1zh30vL1MPRsbm-diHiMwcLyZvn1arpZv-Jxf_11jnpEX3Tgfvk",
+		AccessToken:       "jHkWEdUXMU1BwAsC4vtUsZwnN_fPwm8",
+	}
+
+	suite.mockJWTService.On("GetSigningAlgorithm").Return("RS256")
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["c_hash"] == "LDktKdoQak3Pk0cnXxCltA" &&
+				claims["at_hash"] == "5VB5MqBN5cAjtlBshr3jOQ"
+		}), mock.Anything, "RS256",
+	).Return(testIDToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildIDToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), testIDToken, result.Token)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildIDToken_Error_UnsupportedTokenHashAlgorithm() {
+	ctx := &IDTokenBuildContext{
+		Subject:           "user123",
+		Audience:          "app123",
+		Scopes:            []string{"openid"},
+		OAuthApp:          suite.oauthApp,
+		AuthorizationCode: "test-code",
+	}
+
+	suite.mockJWTService.On("GetSigningAlgorithm").Return("HS256")
+
+	result, err := suite.builder.BuildIDToken(ctx)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	suite.mockJWTService.AssertNotCalled(suite.T(), "GenerateJWT",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithoutNonce() {
 	ctx := &IDTokenBuildContext{
 		Subject:        "user123",
@@ -42,6 +42,9 @@ const (
 type TokenConfig struct {
 	Issuer         string
 	ValidityPeriod int64
+	// AbsoluteValidityPeriod caps the lifetime of a refresh token grant. It is only resolved for refresh
+	// tokens, and zero means no absolute limit.
+	AbsoluteValidityPeriod int64
 }
 
 // AccessTokenBuildContext contains all the information needed to build an access token.
@@ -61,6 +64,8 @@ type AccessTokenBuildContext struct {
 	ClaimsRequest    *oauth2model.ClaimsRequest
 	ClaimsLocales    string
 	ClientAttributes map[string]interface{}
+	// AuthorizationDetails holds the granted rich authorization request details (RFC 9396), if any.
+	AuthorizationDetails []oauth2model.AuthorizationDetail
 }
 
 // RefreshTokenBuildContext contains all the information needed to build a refresh token.
@@ -75,6 +80,17 @@ type RefreshTokenBuildContext struct {
 	OAuthApp             *inboundmodel.OAuthClient
 	ClaimsRequest        *oauth2model.ClaimsRequest
 	ClaimsLocales        string
+	AuthorizationDetails []oauth2model.AuthorizationDetail
+	// AuthTime is the time at which the user authenticated, carried over to the ID tokens issued on refresh.
+	AuthTime int64
+	// SID is the SSO session identifier, carried over to the ID tokens issued on refresh.
+	SID string
+	// GrantID identifies the refresh token family the token belongs to, if any.
+	GrantID string
+	// TokenID is used as the token's jti so the grant can track the current token of the family.
+	TokenID string
+	// ValidityPeriod overrides the resolved refresh token validity when greater than zero.
+	ValidityPeriod int64
 }
 
 // IDTokenBuildContext contains all the information needed to build an ID token (OIDC).
@@ -89,18 +105,30 @@ type IDTokenBuildContext struct {
 	ClaimsRequest  *oauth2model.ClaimsRequest
 	Nonce          string
 	CompletedACR   string
+	// SID is the identifier of the SSO session the ID token is issued within, sent as the sid claim.
+	SID string
+	// AuthorizationCode and AccessToken are the code and access token issued alongside the ID token by the
+	// authorization endpoint, which the ID token is bound to through the c_hash and at_hash claims.
+	AuthorizationCode string
+	AccessToken       string
 }
 
 // RefreshTokenClaims represents the validated claims from a refresh token.
 type RefreshTokenClaims struct {
-	Sub              string
-	Audiences        []string
-	GrantType        string
-	Scopes           []string
-	AttributeCacheID string
-	Iat              int64
-	ClaimsRequest    *oauth2model.ClaimsRequest
-	ClaimsLocales    string
+	JTI                  string
+	Sub                  string
+	Audiences            []string
+	GrantType            string
+	Scopes               []string
+	AttributeCacheID     string
+	Iat                  int64
+	ClaimsRequest        *oauth2model.ClaimsRequest
+	ClaimsLocales        string
+	ProofKeyThumbprint   string
+	GrantID              string
+	AuthorizationDetails []oauth2model.AuthorizationDetail
+	AuthTime             int64
+	SID                  string
 }
 
 // SubjectTokenClaims represents the validated claims from a subject token (for token exchange).
@@ -123,3 +151,11 @@ type AccessTokenClaims struct {
 	ClientID  string
 	Claims    map[string]interface{}
 }
+
+// IDTokenHintClaims represents the validated claims from an ID token presented as an id_token_hint.
+type IDTokenHintClaims struct {
+	Sub string
+	// ClientID is the client the ID token was issued to, resolved from the azp claim or a single aud value.
+	ClientID string
+	Claims   map[string]interface{}
+}
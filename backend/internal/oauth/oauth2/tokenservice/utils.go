@@ -20,7 +20,11 @@ package tokenservice
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
 	"fmt"
+	"hash"
 	"slices"
 	"strings"
 
@@ -30,6 +34,7 @@ import (
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
 	"github.com/asgardeo/thunder/internal/ou"
 	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/jose/jws"
 )
 
 // ParseScopes parses a space-separated scope string into a slice of scope strings.
@@ -82,6 +87,15 @@ func ResolveTokenConfig(oauthApp *inboundmodel.OAuthClient, tokenType TokenType)
 		if conf.OAuth.RefreshToken.ValidityPeriod > 0 {
 			tokenConfig.ValidityPeriod = conf.OAuth.RefreshToken.ValidityPeriod
 		}
+		tokenConfig.AbsoluteValidityPeriod = conf.OAuth.RefreshToken.AbsoluteValidityPeriod
+		if oauthApp != nil && oauthApp.Token != nil && oauthApp.Token.RefreshToken != nil {
+			if oauthApp.Token.RefreshToken.ValidityPeriod > 0 {
+				tokenConfig.ValidityPeriod = oauthApp.Token.RefreshToken.ValidityPeriod
+			}
+			if oauthApp.Token.RefreshToken.AbsoluteValidityPeriod > 0 {
+				tokenConfig.AbsoluteValidityPeriod = oauthApp.Token.RefreshToken.AbsoluteValidityPeriod
+			}
+		}
 	}
 
 	return tokenConfig
@@ -428,7 +442,8 @@ func buildClaimsFromRequest(
 			continue
 		}
 
-		// TODO: Revisit "essential" claim handling if needed.
+		// Essential and voluntary claims are released alike. Essential claims are enforced by the
+		// authentication flow, which requires the user to provide the corresponding attributes.
 
 		result[claimName] = value
 	}
@@ -485,3 +500,42 @@ func resolveClientOUAttributes(
 		constants.ClaimOUHandle: orgUnit.Handle,
 	}, nil
 }
+
+// addTokenHashClaims adds the c_hash and at_hash claims binding the ID token to the authorization code and
+// access token issued alongside it (OIDC Core §3.3.2.11).
+func addTokenHashClaims(claims map[string]interface{}, ctx *IDTokenBuildContext, signingAlg string) error {
+	if ctx.AuthorizationCode != "" {
+		cHash, err := computeTokenHash(ctx.AuthorizationCode, signingAlg)
+		if err != nil {
+			return err
+		}
+		claims[constants.ClaimCHash] = cHash
+	}
+	if ctx.AccessToken != "" {
+		atHash, err := computeTokenHash(ctx.AccessToken, signingAlg)
+		if err != nil {
+			return err
+		}
+		claims[constants.ClaimATHash] = atHash
+	}
+	return nil
+}
+
+// computeTokenHash computes the base64url encoding of the left-most half of the hash of the token, using the
+// hash algorithm of the JWS algorithm the ID token is signed with. Ed25519 uses SHA-512.
+func computeTokenHash(token string, signingAlg string) (string, error) {
+	var h hash.Hash
+	switch jws.Algorithm(signingAlg) {
+	case jws.RS256, jws.PS256, jws.ES256:
+		h = sha256.New()
+	case jws.ES384:
+		h = sha512.New384()
+	case jws.RS512, jws.ES512, jws.EdDSA:
+		h = sha512.New()
+	default:
+		return "", fmt.Errorf("unsupported signing algorithm for token hash: %s", signingAlg)
+	}
+	h.Write([]byte(token))
+	digest := h.Sum(nil)
+	return base64.RawURLEncoding.EncodeToString(digest[:len(digest)/2]), nil
+}
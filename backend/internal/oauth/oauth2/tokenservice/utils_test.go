@@ -832,6 +832,41 @@ func (suite *UtilsTestSuite) TestResolveTokenConfig_RefreshToken_WithTokenConfig
 	assert.Equal(suite.T(), "https://thunder.io", result.Issuer)
 }
 
+func (suite *UtilsTestSuite) TestResolveTokenConfig_RefreshToken_WithAppOverrides() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		JWT: config.JWTConfig{
+			Issuer:         "https://thunder.io",
+			ValidityPeriod: 3600,
+		},
+		OAuth: config.OAuthConfig{
+			RefreshToken: config.RefreshTokenConfig{
+				ValidityPeriod:         86400,
+				AbsoluteValidityPeriod: 2592000,
+			},
+		},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+
+	oauthApp := &inboundmodel.OAuthClient{
+		ClientID: "test-client",
+		Token: &inboundmodel.OAuthTokenConfig{
+			RefreshToken: &inboundmodel.RefreshTokenConfig{
+				ValidityPeriod:         1800,
+				AbsoluteValidityPeriod: 7200,
+			},
+		},
+	}
+
+	result := ResolveTokenConfig(oauthApp, TokenTypeRefresh)
+	assert.Equal(suite.T(), int64(1800), result.ValidityPeriod)
+	assert.Equal(suite.T(), int64(7200), result.AbsoluteValidityPeriod)
+
+	result = ResolveTokenConfig(&inboundmodel.OAuthClient{ClientID: "test-client"}, TokenTypeRefresh)
+	assert.Equal(suite.T(), int64(86400), result.ValidityPeriod)
+	assert.Equal(suite.T(), int64(2592000), result.AbsoluteValidityPeriod)
+}
+
 func (suite *UtilsTestSuite) TestResolveTokenConfig_AccessToken_WithNilOAuthApp() {
 	config.ResetServerRuntime()
 	testConfig := &config.Config{
@@ -1151,3 +1186,26 @@ func (suite *UtilsTestSuite) TestExtractAudiences_SliceWithEmptyString_ReturnsEr
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), auds)
 }
+
+func (suite *UtilsTestSuite) TestComputeTokenHash() {
+	testCases := []struct {
+		alg      string
+		expected string
+	}{
+		{"RS256", "5VB5MqBN5cAjtlBshr3jOQ"},
+		{"PS256", "5VB5MqBN5cAjtlBshr3jOQ"},
+		{"ES256", "5VB5MqBN5cAjtlBshr3jOQ"},
+		{"ES384", "HDouoC9vulCnRDw-nB2rDGeFTatYYtG2"},
+		{"RS512", "zwEBIjsYDFruwBKMiI0Q-zlto33-y3JeowbFwaLrboc"},
+		{"EdDSA", "zwEBIjsYDFruwBKMiI0Q-zlto33-y3JeowbFwaLrboc"},
+	}
+
+	for _, tc := range testCases {
+		tokenHash, err := computeTokenHash("jHkWEdUXMU1BwAsC4vtUsZwnN_fPwm8", tc.alg)
+		assert.NoError(suite.T(), err, tc.alg)
+		assert.Equal(suite.T(), tc.expected, tokenHash, tc.alg)
+	}
+
+	_, err := computeTokenHash("token", "none")
+	assert.Error(suite.T(), err)
+}
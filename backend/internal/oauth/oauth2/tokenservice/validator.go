@@ -19,11 +19,15 @@
 package tokenservice
 
 import (
+	"context"
 	"fmt"
 	"slices"
 	"time"
 
+	"github.com/asgardeo/thunder/internal/inboundclient"
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/authorizationdetails"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
 	oauth2model "github.com/asgardeo/thunder/internal/oauth/oauth2/model"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/utils"
 	"github.com/asgardeo/thunder/internal/system/config"
@@ -35,17 +39,23 @@ type TokenValidatorInterface interface {
 	ValidateAccessToken(token string) (*AccessTokenClaims, error)
 	ValidateRefreshToken(token string, clientID string) (*RefreshTokenClaims, error)
 	ValidateSubjectToken(token string, oauthApp *inboundmodel.OAuthClient) (*SubjectTokenClaims, error)
+	ValidateIDTokenHint(ctx context.Context, token string) (*IDTokenHintClaims, error)
 }
 
 // TokenValidator implements TokenValidatorInterface.
 type tokenValidator struct {
-	jwtService jwt.JWTServiceInterface
+	jwtService    jwt.JWTServiceInterface
+	inboundClient inboundclient.InboundClientServiceInterface
 }
 
 // NewTokenValidator creates a new TokenValidator instance.
-func newTokenValidator(jwtService jwt.JWTServiceInterface) TokenValidatorInterface {
+func newTokenValidator(
+	jwtService jwt.JWTServiceInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+) TokenValidatorInterface {
 	return &tokenValidator{
-		jwtService: jwtService,
+		jwtService:    jwtService,
+		inboundClient: inboundClient,
 	}
 }
 
@@ -123,12 +133,16 @@ func (tv *tokenValidator) ValidateRefreshToken(token string, clientID string) (*
 	}
 
 	// Extract claims
+	jti, _ := extractStringClaim(claims, "jti")
 	sub, _ := extractStringClaim(claims, "access_token_sub")
 	audiences := extractStringSliceClaim(claims, "access_token_aud")
 	grantType, _ := extractStringClaim(claims, "grant_type")
 	iat, _ := extractInt64Claim(claims, "iat")
 	scopes := extractScopesFromClaims(claims, false)
 	attributeCacheID, _ := extractStringClaim(claims, "aci")
+	grantID, _ := extractStringClaim(claims, "grant_id")
+	authTime, _ := extractInt64Claim(claims, "id_token_auth_time")
+	sid, _ := claims["id_token_sid"].(string)
 
 	// Extract claims request if present
 	var claimsRequest *oauth2model.ClaimsRequest
@@ -143,17 +157,24 @@ func (tv *tokenValidator) ValidateRefreshToken(token string, clientID string) (*
 
 	// Extract claims_locales if present
 	claimsLocales, _ := extractStringClaim(claims, "access_token_claims_locales")
+	authorizationDetails := authorizationdetails.FromClaim(claims["access_token_authorization_details"])
 
 	// Extract user type and organizational unit details if present
 	return &RefreshTokenClaims{
-		Sub:              sub,
-		Audiences:        audiences,
-		GrantType:        grantType,
-		Scopes:           scopes,
-		AttributeCacheID: attributeCacheID,
-		Iat:              iat,
-		ClaimsRequest:    claimsRequest,
-		ClaimsLocales:    claimsLocales,
+		JTI:                  jti,
+		Sub:                  sub,
+		Audiences:            audiences,
+		GrantType:            grantType,
+		Scopes:               scopes,
+		AttributeCacheID:     attributeCacheID,
+		Iat:                  iat,
+		ClaimsRequest:        claimsRequest,
+		ClaimsLocales:        claimsLocales,
+		ProofKeyThumbprint:   jwt.GetKeyThumbprintConfirmation(claims),
+		GrantID:              grantID,
+		AuthorizationDetails: authorizationDetails,
+		AuthTime:             authTime,
+		SID:                  sid,
 	}, nil
 }
 
@@ -241,6 +262,61 @@ func (tv *tokenValidator) ValidateSubjectToken(
 	}, nil
 }
 
+// ValidateIDTokenHint validates an ID token previously issued by this server that is presented as an
+// id_token_hint and extracts its claims. Expired ID tokens are accepted as hints. The token must carry the
+// ID token use marker and be issued to a registered client.
+func (tv *tokenValidator) ValidateIDTokenHint(ctx context.Context, token string) (*IDTokenHintClaims, error) {
+	if err := tv.jwtService.VerifyJWTSignature(token); err != nil {
+		return nil, fmt.Errorf("id_token_hint signature verification failed: %v", err.Error)
+	}
+
+	header, err := jwt.DecodeJWTHeader(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode id_token_hint header: %w", err)
+	}
+	if typ, _ := header["typ"].(string); typ != jwt.TokenTypeJWT {
+		return nil, fmt.Errorf("invalid token type: expected %q, got %q", jwt.TokenTypeJWT, typ)
+	}
+
+	claims, err := jwt.DecodeJWTPayload(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode id_token_hint payload: %w", err)
+	}
+
+	if iss, _ := extractStringClaim(claims, "iss"); iss != config.GetServerRuntime().Config.JWT.Issuer {
+		return nil, fmt.Errorf("id_token_hint was not issued by this server")
+	}
+	if tokenUse, _ := extractStringClaim(claims, constants.ClaimTokenUse); tokenUse != constants.TokenUseID {
+		return nil, fmt.Errorf("id_token_hint is not an ID token")
+	}
+	sub, subErr := extractStringClaim(claims, "sub")
+	if subErr != nil || sub == "" {
+		return nil, fmt.Errorf("missing required 'sub' claim in id_token_hint")
+	}
+	audiences, audErr := extractAudiences(claims)
+	if audErr != nil {
+		return nil, fmt.Errorf("missing required 'aud' claim in id_token_hint")
+	}
+
+	clientID := getClientIDFromIDTokenClaims(claims)
+	if clientID == "" || !slices.Contains(audiences, clientID) {
+		return nil, fmt.Errorf("id_token_hint audience does not identify a client")
+	}
+	client, err := tv.inboundClient.GetOAuthClientByClientID(ctx, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the id_token_hint client: %w", err)
+	}
+	if client == nil {
+		return nil, fmt.Errorf("id_token_hint was not issued to a registered client")
+	}
+
+	return &IDTokenHintClaims{
+		Sub:      sub,
+		ClientID: clientID,
+		Claims:   claims,
+	}, nil
+}
+
 // verifyTokenSignatureByIssuer verifies JWT signature using issuer-specific verification method.
 func (tv *tokenValidator) verifyTokenSignatureByIssuer(
 	token string,
@@ -322,3 +398,14 @@ func (tv *tokenValidator) isAuthAssertion(
 
 	return false
 }
+
+// getClientIDFromIDTokenClaims resolves the client an ID token was issued to from its azp or aud claims.
+func getClientIDFromIDTokenClaims(claims map[string]interface{}) string {
+	if azp, ok := claims["azp"].(string); ok && azp != "" {
+		return azp
+	}
+	if auds, err := extractAudiences(claims); err == nil && len(auds) == 1 {
+		return auds[0]
+	}
+	return ""
+}
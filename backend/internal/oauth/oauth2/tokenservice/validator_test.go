@@ -21,18 +21,22 @@ package tokenservice
 import (
 	"github.com/asgardeo/thunder/internal/system/i18n/core"
 
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/tests/mocks/inboundclientmock"
 	"github.com/asgardeo/thunder/tests/mocks/jose/jwtmock"
 )
 
@@ -44,9 +48,10 @@ const (
 
 type TokenValidatorTestSuite struct {
 	suite.Suite
-	mockJWTService *jwtmock.JWTServiceInterfaceMock
-	validator      *tokenValidator
-	oauthApp       *inboundmodel.OAuthClient
+	mockJWTService    *jwtmock.JWTServiceInterfaceMock
+	mockInboundClient *inboundclientmock.InboundClientServiceInterfaceMock
+	validator         *tokenValidator
+	oauthApp          *inboundmodel.OAuthClient
 }
 
 func TestTokenValidatorTestSuite(t *testing.T) {
@@ -67,8 +72,10 @@ func (suite *TokenValidatorTestSuite) SetupTest() {
 	_ = config.InitializeServerRuntime("test", testConfig)
 
 	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(suite.T())
 	suite.validator = &tokenValidator{
-		jwtService: suite.mockJWTService,
+		jwtService:    suite.mockJWTService,
+		inboundClient: suite.mockInboundClient,
 	}
 
 	suite.oauthApp = &inboundmodel.OAuthClient{
@@ -719,6 +726,31 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_Basic() {
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_WithGrantID() {
+	now := time.Now().Unix()
+	claims := map[string]interface{}{
+		"sub":              "test-client",
+		"iss":              "https://thunder.io",
+		"aud":              "test-client",
+		"exp":              float64(now + 3600),
+		"iat":              float64(now),
+		"jti":              "token-1",
+		"access_token_sub": "user123",
+		"access_token_aud": testAppID,
+		"grant_type":       "authorization_code",
+		"grant_id":         "grant-1",
+	}
+	token := suite.createTestJWT(claims)
+
+	suite.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
+
+	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "grant-1", result.GrantID)
+	assert.Equal(suite.T(), "token-1", result.JTI)
+}
+
 func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_WithoutUserAttributes() {
 	now := time.Now().Unix()
 	claims := map[string]interface{}{
@@ -1092,6 +1124,60 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_WithClaim
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_WithAuthorizationDetails() {
+	now := time.Now().Unix()
+	claims := map[string]interface{}{
+		"sub":              "test-client",
+		"iss":              "https://thunder.io",
+		"aud":              "test-client",
+		"exp":              float64(now + 3600),
+		"iat":              float64(now),
+		"access_token_sub": "user123",
+		"access_token_aud": testAppID,
+		"grant_type":       "authorization_code",
+		"access_token_authorization_details": []interface{}{
+			map[string]interface{}{"type": "payment_initiation", "amount": "50.00"},
+		},
+	}
+	token := suite.createTestJWT(claims)
+
+	suite.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
+
+	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	assert.Len(suite.T(), result.AuthorizationDetails, 1)
+	assert.Equal(suite.T(), "payment_initiation", result.AuthorizationDetails[0]["type"])
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_WithAuthTime() {
+	now := time.Now().Unix()
+	claims := map[string]interface{}{
+		"sub":                "test-client",
+		"iss":                "https://thunder.io",
+		"aud":                "test-client",
+		"exp":                float64(now + 3600),
+		"iat":                float64(now),
+		"access_token_sub":   "user123",
+		"access_token_aud":   testAppID,
+		"grant_type":         "authorization_code",
+		"id_token_auth_time": float64(now - 600),
+		"id_token_sid":       "test-sid",
+	}
+	token := suite.createTestJWT(claims)
+
+	suite.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
+
+	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), now-600, result.AuthTime)
+	assert.Equal(suite.T(), "test-sid", result.SID)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 // ============================================================================
 // ValidateAuthAssertion Tests - Success Cases
 // ============================================================================
@@ -1940,3 +2026,233 @@ func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Error_EmptyClientI
 	assert.Contains(suite.T(), err.Error(), "missing required 'client_id' claim")
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
+
+func (suite *TokenValidatorTestSuite) mockRegisteredClient(clientID string) {
+	suite.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, clientID).
+		Return(&inboundmodel.OAuthClient{ClientID: clientID}, nil)
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateIDTokenHint_Success() {
+	claims := map[string]interface{}{
+		"sub":       "user123",
+		"iss":       "https://thunder.io",
+		"aud":       "test-client",
+		"exp":       float64(time.Now().Add(-time.Hour).Unix()),
+		"sid":       "session-sid",
+		"token_use": "id",
+	}
+	token := suite.createTestJWT(claims)
+
+	suite.mockJWTService.On("VerifyJWTSignature", token).Return(nil)
+	suite.mockRegisteredClient("test-client")
+
+	result, err := suite.validator.ValidateIDTokenHint(context.Background(), token)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), "user123", result.Sub)
+	assert.Equal(suite.T(), "test-client", result.ClientID)
+	assert.Equal(suite.T(), "session-sid", result.Claims["sid"])
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateIDTokenHint_ClientIDFromAzp() {
+	claims := map[string]interface{}{
+		"sub":       "user123",
+		"iss":       "https://thunder.io",
+		"aud":       []interface{}{"test-client", "other-client"},
+		"azp":       "test-client",
+		"token_use": "id",
+	}
+	token := suite.createTestJWT(claims)
+
+	suite.mockJWTService.On("VerifyJWTSignature", token).Return(nil)
+	suite.mockRegisteredClient("test-client")
+
+	result, err := suite.validator.ValidateIDTokenHint(context.Background(), token)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "test-client", result.ClientID)
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateIDTokenHint_Error_NoClientAudience() {
+	testCases := []struct {
+		name   string
+		claims map[string]interface{}
+	}{
+		{
+			name:   "MultipleAudiencesWithoutAzp",
+			claims: map[string]interface{}{"aud": []interface{}{"test-client", "other-client"}},
+		},
+		{
+			name:   "AzpNotInAudience",
+			claims: map[string]interface{}{"aud": "test-client", "azp": "other-client"},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.claims["sub"] = "user123"
+			tc.claims["iss"] = "https://thunder.io"
+			tc.claims["token_use"] = "id"
+			token := suite.createTestJWT(tc.claims)
+			suite.mockJWTService.On("VerifyJWTSignature", token).Return(nil)
+
+			result, err := suite.validator.ValidateIDTokenHint(context.Background(), token)
+
+			assert.Error(suite.T(), err)
+			assert.Nil(suite.T(), result)
+			assert.Contains(suite.T(), err.Error(), "audience does not identify a client")
+		})
+	}
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateIDTokenHint_Error_UnregisteredClient() {
+	claims := map[string]interface{}{
+		"sub":       "user123",
+		"iss":       "https://thunder.io",
+		"aud":       "unknown-client",
+		"token_use": "id",
+	}
+	token := suite.createTestJWT(claims)
+
+	suite.mockJWTService.On("VerifyJWTSignature", token).Return(nil)
+	suite.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, "unknown-client").Return(nil, nil)
+
+	result, err := suite.validator.ValidateIDTokenHint(context.Background(), token)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Contains(suite.T(), err.Error(), "not issued to a registered client")
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateIDTokenHint_Error_ClientLookupFailure() {
+	claims := map[string]interface{}{
+		"sub":       "user123",
+		"iss":       "https://thunder.io",
+		"aud":       "test-client",
+		"token_use": "id",
+	}
+	token := suite.createTestJWT(claims)
+
+	suite.mockJWTService.On("VerifyJWTSignature", token).Return(nil)
+	suite.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, "test-client").
+		Return(nil, errors.New("store unavailable"))
+
+	result, err := suite.validator.ValidateIDTokenHint(context.Background(), token)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Contains(suite.T(), err.Error(), "failed to resolve the id_token_hint client")
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateIDTokenHint_Error_InvalidSignature() {
+	token := suite.createTestJWT(map[string]interface{}{"sub": "user123", "iss": "https://thunder.io"})
+
+	suite.mockJWTService.On("VerifyJWTSignature", token).Return(&serviceerror.ServiceError{
+		Code:  "JWT-00001",
+		Error: core.I18nMessage{Key: "error.test.invalid_signature", DefaultValue: "invalid signature"},
+	})
+
+	result, err := suite.validator.ValidateIDTokenHint(context.Background(), token)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Contains(suite.T(), err.Error(), "signature verification failed")
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateIDTokenHint_Error_AccessToken() {
+	claims := map[string]interface{}{
+		"sub":       "user123",
+		"iss":       "https://thunder.io",
+		"aud":       "test-app",
+		"client_id": "test-client",
+	}
+	token := suite.createTestAccessToken(claims)
+
+	suite.mockJWTService.On("VerifyJWTSignature", token).Return(nil)
+
+	result, err := suite.validator.ValidateIDTokenHint(context.Background(), token)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Contains(suite.T(), err.Error(), "invalid token type")
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateIDTokenHint_Error_OtherIssuer() {
+	claims := map[string]interface{}{
+		"sub":       "user123",
+		"iss":       "https://other.example.com",
+		"aud":       "test-client",
+		"token_use": "id",
+	}
+	token := suite.createTestJWT(claims)
+
+	suite.mockJWTService.On("VerifyJWTSignature", token).Return(nil)
+
+	result, err := suite.validator.ValidateIDTokenHint(context.Background(), token)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Contains(suite.T(), err.Error(), "not issued by this server")
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateIDTokenHint_Error_MissingClaims() {
+	testCases := []struct {
+		name        string
+		claims      map[string]interface{}
+		errContains string
+	}{
+		{
+			name:        "MissingSub",
+			claims:      map[string]interface{}{"iss": "https://thunder.io", "aud": "test-client", "token_use": "id"},
+			errContains: "missing required 'sub' claim",
+		},
+		{
+			name:        "MissingAud",
+			claims:      map[string]interface{}{"iss": "https://thunder.io", "sub": "user123", "token_use": "id"},
+			errContains: "missing required 'aud' claim",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			token := suite.createTestJWT(tc.claims)
+			suite.mockJWTService.On("VerifyJWTSignature", token).Return(nil)
+
+			result, err := suite.validator.ValidateIDTokenHint(context.Background(), token)
+
+			assert.Error(suite.T(), err)
+			assert.Nil(suite.T(), result)
+			assert.Contains(suite.T(), err.Error(), tc.errContains)
+		})
+	}
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateIDTokenHint_Error_NotAnIDToken() {
+	testCases := []struct {
+		name   string
+		claims map[string]interface{}
+	}{
+		// Other JWTs signed by this server, such as flow assertions and magic link tokens, do not carry
+		// the ID token use marker.
+		{name: "MissingTokenUse", claims: map[string]interface{}{}},
+		{name: "OtherTokenUse", claims: map[string]interface{}{"token_use": "access"}},
+		{name: "NonStringTokenUse", claims: map[string]interface{}{"token_use": true}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.claims["sub"] = "user123"
+			tc.claims["iss"] = "https://thunder.io"
+			tc.claims["aud"] = "test-client"
+			token := suite.createTestJWT(tc.claims)
+			suite.mockJWTService.On("VerifyJWTSignature", token).Return(nil)
+
+			result, err := suite.validator.ValidateIDTokenHint(context.Background(), token)
+
+			assert.Error(suite.T(), err)
+			assert.Nil(suite.T(), result)
+			assert.Contains(suite.T(), err.Error(), "is not an ID token")
+		})
+	}
+}
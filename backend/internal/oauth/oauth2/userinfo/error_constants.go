@@ -80,4 +80,19 @@ var (
 			DefaultValue: "The 'openid' scope is required for this request",
 		},
 	}
+
+	// errorProofKeyMismatch is returned when a DPoP-bound access token is presented without a proof
+	// signed by the bound key
+	errorProofKeyMismatch = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "invalid_token",
+		Error: core.I18nMessage{
+			Key:          "error.userinfoservice.proof_key_mismatch",
+			DefaultValue: "Invalid access token",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userinfoservice.proof_key_mismatch_description",
+			DefaultValue: "The access token is DPoP-bound and must be presented with a proof from the bound key",
+		},
+	}
 )
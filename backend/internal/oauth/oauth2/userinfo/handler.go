@@ -19,11 +19,15 @@
 package userinfo
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/dpop"
 	serverconst "github.com/asgardeo/thunder/internal/system/constants"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	"github.com/asgardeo/thunder/internal/system/log"
@@ -34,15 +38,23 @@ const handlerLoggerComponentName = "UserInfoHandler"
 
 // userInfoHandler handles OIDC UserInfo requests.
 type userInfoHandler struct {
-	service userInfoServiceInterface
-	logger  *log.Logger
+	service     userInfoServiceInterface
+	dpopService dpop.DPoPServiceInterface
+	endpointURL string
+	logger      *log.Logger
 }
 
 // newUserInfoHandler creates a new userInfo handler.
-func newUserInfoHandler(userInfoService userInfoServiceInterface) *userInfoHandler {
+func newUserInfoHandler(
+	userInfoService userInfoServiceInterface,
+	dpopService dpop.DPoPServiceInterface,
+	endpointURL string,
+) *userInfoHandler {
 	return &userInfoHandler{
-		service: userInfoService,
-		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName)),
+		service:     userInfoService,
+		dpopService: dpopService,
+		endpointURL: endpointURL,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName)),
 	}
 }
 
@@ -50,6 +62,15 @@ func newUserInfoHandler(userInfoService userInfoServiceInterface) *userInfoHandl
 func (h *userInfoHandler) HandleUserInfo(w http.ResponseWriter, r *http.Request) {
 	// Extract access token from Authorization header
 	authHeader := r.Header.Get(serverconst.AuthorizationHeaderName)
+	if isDPoPAuth(authHeader) {
+		accessToken, ctx, ok := h.extractDPoPAccessToken(w, r, authHeader)
+		if !ok {
+			return
+		}
+		h.writeUserInfoResponse(ctx, w, accessToken, constants.TokenTypeDPoP)
+		return
+	}
+
 	accessToken, err := utils.ExtractBearerToken(authHeader)
 	if err != nil {
 		if authHeader == "" || !utils.IsBearerAuth(authHeader) {
@@ -62,9 +83,69 @@ func (h *userInfoHandler) HandleUserInfo(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	result, svcErr := h.service.GetUserInfo(r.Context(), accessToken)
+	h.writeUserInfoResponse(r.Context(), w, accessToken, serverconst.TokenTypeBearer)
+}
+
+// extractDPoPAccessToken extracts the access token from a DPoP Authorization header and validates the
+// accompanying DPoP proof (RFC 9449 §7). Returns a context carrying the proof key thumbprint, or false
+// after writing an error response with a DPoP challenge.
+func (h *userInfoHandler) extractDPoPAccessToken(
+	w http.ResponseWriter, r *http.Request, authHeader string,
+) (string, context.Context, bool) {
+	accessToken := ""
+	if parts := strings.SplitN(authHeader, " ", 2); len(parts) == 2 {
+		accessToken = strings.TrimSpace(parts[1])
+	}
+	if accessToken == "" {
+		writeChallengeError(w, constants.TokenTypeDPoP, constants.ErrorInvalidRequest,
+			"Invalid or malformed DPoP token", http.StatusBadRequest, nil)
+		return "", nil, false
+	}
+
+	proofs := r.Header.Values(dpop.HeaderDPoP)
+	if len(proofs) != 1 {
+		writeChallengeError(w, constants.TokenTypeDPoP, constants.ErrorInvalidDPoPProof,
+			"Exactly one DPoP proof is required", http.StatusUnauthorized, nil)
+		return "", nil, false
+	}
+
+	thumbprint, err := h.dpopService.ValidateProof(r.Context(), proofs[0], dpop.ProofRequest{
+		Method:      r.Method,
+		URI:         h.endpointURL,
+		AccessToken: accessToken,
+	})
+	if err == nil {
+		return accessToken, dpop.WithProofKeyThumbprint(r.Context(), thumbprint), true
+	}
+
+	if !errors.Is(err, dpop.ErrNonceRequired) {
+		h.logger.Debug("Invalid DPoP proof presented at the UserInfo endpoint", log.Error(err))
+		writeChallengeError(w, constants.TokenTypeDPoP, constants.ErrorInvalidDPoPProof,
+			"Invalid DPoP proof", http.StatusUnauthorized, nil)
+		return "", nil, false
+	}
+
+	nonce, nonceErr := h.dpopService.IssueNonce(r.Context())
+	if nonceErr != nil {
+		h.logger.Error("Failed to issue DPoP nonce", log.Error(nonceErr))
+		utils.WriteJSONError(w, constants.ErrorServerError,
+			serviceerror.InternalServerError.Error.DefaultValue, http.StatusInternalServerError, nil)
+		return "", nil, false
+	}
+	writeChallengeError(w, constants.TokenTypeDPoP, constants.ErrorUseDPoPNonce,
+		"Resource server requires nonce in DPoP proof", http.StatusUnauthorized,
+		map[string]string{dpop.HeaderDPoPNonce: nonce})
+	return "", nil, false
+}
+
+// writeUserInfoResponse resolves the user information for the access token and writes the response.
+// Errors are reported with a challenge for the given authentication scheme.
+func (h *userInfoHandler) writeUserInfoResponse(
+	ctx context.Context, w http.ResponseWriter, accessToken, scheme string,
+) {
+	result, svcErr := h.service.GetUserInfo(ctx, accessToken)
 	if svcErr != nil {
-		h.writeServiceErrorResponse(w, svcErr)
+		h.writeServiceErrorResponse(w, svcErr, scheme)
 		return
 	}
 
@@ -88,7 +169,9 @@ func (h *userInfoHandler) HandleUserInfo(w http.ResponseWriter, r *http.Request)
 }
 
 // writeServiceErrorResponse writes a service error response.
-func (h *userInfoHandler) writeServiceErrorResponse(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+func (h *userInfoHandler) writeServiceErrorResponse(
+	w http.ResponseWriter, svcErr *serviceerror.ServiceError, scheme string,
+) {
 	var statusCode int
 
 	switch svcErr.Type {
@@ -108,13 +191,33 @@ func (h *userInfoHandler) writeServiceErrorResponse(w http.ResponseWriter, svcEr
 		utils.WriteJSONError(w, constants.ErrorServerError,
 			serviceerror.InternalServerError.Error.DefaultValue, statusCode, nil)
 	} else {
-		writeBearerError(w, svcErr.Code, svcErr.ErrorDescription.DefaultValue, statusCode)
+		writeChallengeError(w, scheme, svcErr.Code, svcErr.ErrorDescription.DefaultValue, statusCode, nil)
 	}
 }
 
 // writeBearerError writes a JSON error response with a WWW-Authenticate: Bearer header.
 func writeBearerError(w http.ResponseWriter, errorCode, errorDescription string, statusCode int) {
-	wwwAuth := fmt.Sprintf("Bearer error=%q, error_description=%q", errorCode, errorDescription)
-	utils.WriteJSONError(w, errorCode, errorDescription, statusCode,
-		[]map[string]string{{serverconst.WWWAuthenticateHeaderName: wwwAuth}})
+	writeChallengeError(w, serverconst.TokenTypeBearer, errorCode, errorDescription, statusCode, nil)
+}
+
+// writeChallengeError writes a JSON error response with a WWW-Authenticate challenge for the given
+// authentication scheme, along with any additional response headers.
+func writeChallengeError(
+	w http.ResponseWriter, scheme, errorCode, errorDescription string, statusCode int,
+	extraHeaders map[string]string,
+) {
+	headers := map[string]string{
+		serverconst.WWWAuthenticateHeaderName: fmt.Sprintf("%s error=%q, error_description=%q",
+			scheme, errorCode, errorDescription),
+	}
+	for name, value := range extraHeaders {
+		headers[name] = value
+	}
+	utils.WriteJSONError(w, errorCode, errorDescription, statusCode, []map[string]string{headers})
+}
+
+// isDPoPAuth reports whether the Authorization header uses the DPoP authentication scheme.
+func isDPoPAuth(authHeader string) bool {
+	scheme, _, _ := strings.Cut(authHeader, " ")
+	return strings.EqualFold(scheme, constants.TokenTypeDPoP)
 }
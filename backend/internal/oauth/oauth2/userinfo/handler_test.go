@@ -19,6 +19,8 @@
 package userinfo
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -28,14 +30,19 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/dpop"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	"github.com/asgardeo/thunder/internal/system/i18n/core"
+	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/dpopmock"
 )
 
+const testUserInfoEndpoint = "https://localhost:8090/oauth2/userinfo"
+
 type UserInfoHandlerTestSuite struct {
 	suite.Suite
-	mockService *userInfoServiceInterfaceMock
-	handler     *userInfoHandler
+	mockService     *userInfoServiceInterfaceMock
+	mockDPoPService *dpopmock.DPoPServiceInterfaceMock
+	handler         *userInfoHandler
 }
 
 func TestUserInfoHandlerTestSuite(t *testing.T) {
@@ -44,7 +51,8 @@ func TestUserInfoHandlerTestSuite(t *testing.T) {
 
 func (s *UserInfoHandlerTestSuite) SetupTest() {
 	s.mockService = new(userInfoServiceInterfaceMock)
-	s.handler = newUserInfoHandler(s.mockService)
+	s.mockDPoPService = dpopmock.NewDPoPServiceInterfaceMock(s.T())
+	s.handler = newUserInfoHandler(s.mockService, s.mockDPoPService, testUserInfoEndpoint)
 }
 
 // TestHandleUserInfo_MissingAuthorizationHeader tests missing Authorization header.
@@ -321,6 +329,119 @@ func (s *UserInfoHandlerTestSuite) TestWriteServiceErrorResponse_DefaultCase() {
 	s.mockService.AssertExpectations(s.T())
 }
 
+// TestHandleUserInfo_DPoP_Success tests that a valid DPoP proof binds its key thumbprint into the request context.
+func (s *UserInfoHandlerTestSuite) TestHandleUserInfo_DPoP_Success() {
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/userinfo", nil)
+	req.Header.Set("Authorization", "DPoP token123")
+	req.Header.Set(dpop.HeaderDPoP, "proof")
+	rr := httptest.NewRecorder()
+
+	s.mockDPoPService.On("ValidateProof", mock.Anything, "proof", dpop.ProofRequest{
+		Method:      http.MethodGet,
+		URI:         testUserInfoEndpoint,
+		AccessToken: "token123",
+	}).Return("thumbprint", nil)
+	s.mockService.On("GetUserInfo", mock.MatchedBy(func(ctx context.Context) bool {
+		return dpop.GetProofKeyThumbprint(ctx) == "thumbprint"
+	}), "token123").Return(jsonResponse(map[string]interface{}{"sub": "user123"}), nil)
+
+	s.handler.HandleUserInfo(rr, req)
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.Contains(s.T(), rr.Body.String(), "user123")
+	s.mockService.AssertExpectations(s.T())
+}
+
+// TestHandleUserInfo_DPoP_ServiceErrorUsesDPoPChallenge tests that service errors use the DPoP scheme.
+func (s *UserInfoHandlerTestSuite) TestHandleUserInfo_DPoP_ServiceErrorUsesDPoPChallenge() {
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/userinfo", nil)
+	req.Header.Set("Authorization", "DPoP token123")
+	req.Header.Set(dpop.HeaderDPoP, "proof")
+	rr := httptest.NewRecorder()
+
+	s.mockDPoPService.On("ValidateProof", mock.Anything, "proof", mock.Anything).Return("thumbprint", nil)
+	s.mockService.On("GetUserInfo", mock.Anything, "token123").Return(nil, &errorProofKeyMismatch)
+
+	s.handler.HandleUserInfo(rr, req)
+
+	assert.Equal(s.T(), http.StatusUnauthorized, rr.Code)
+	assert.Contains(s.T(), rr.Header().Get("WWW-Authenticate"), "DPoP error=\"invalid_token\"")
+}
+
+// TestHandleUserInfo_DPoP_InvalidRequests tests DPoP requests rejected before reaching the service.
+func (s *UserInfoHandlerTestSuite) TestHandleUserInfo_DPoP_InvalidRequests() {
+	testCases := []struct {
+		name           string
+		authHeader     string
+		proofs         []string
+		validateErr    error
+		expectedStatus int
+		expectedError  string
+	}{
+		{"MissingToken", "DPoP ", []string{"proof"}, nil, http.StatusBadRequest, constants.ErrorInvalidRequest},
+		{"MissingProof", "DPoP token123", nil, nil, http.StatusUnauthorized, constants.ErrorInvalidDPoPProof},
+		{"MultipleProofs", "DPoP token123", []string{"proof1", "proof2"}, nil,
+			http.StatusUnauthorized, constants.ErrorInvalidDPoPProof},
+		{"InvalidProof", "DPoP token123", []string{"proof"}, dpop.ErrInvalidProof,
+			http.StatusUnauthorized, constants.ErrorInvalidDPoPProof},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			req := httptest.NewRequest(http.MethodGet, "/oauth2/userinfo", nil)
+			req.Header.Set("Authorization", tc.authHeader)
+			for _, proof := range tc.proofs {
+				req.Header.Add(dpop.HeaderDPoP, proof)
+			}
+			rr := httptest.NewRecorder()
+			if tc.validateErr != nil {
+				s.mockDPoPService.On("ValidateProof", mock.Anything, "proof", mock.Anything).
+					Return("", tc.validateErr).Once()
+			}
+
+			s.handler.HandleUserInfo(rr, req)
+
+			assert.Equal(s.T(), tc.expectedStatus, rr.Code)
+			assert.Contains(s.T(), rr.Body.String(), tc.expectedError)
+			assert.Contains(s.T(), rr.Header().Get("WWW-Authenticate"), "DPoP")
+		})
+	}
+	s.mockService.AssertNotCalled(s.T(), "GetUserInfo", mock.Anything, mock.Anything)
+}
+
+// TestHandleUserInfo_DPoP_NonceRequired tests that a fresh server nonce is returned when the proof lacks one.
+func (s *UserInfoHandlerTestSuite) TestHandleUserInfo_DPoP_NonceRequired() {
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/userinfo", nil)
+	req.Header.Set("Authorization", "DPoP token123")
+	req.Header.Set(dpop.HeaderDPoP, "proof")
+	rr := httptest.NewRecorder()
+
+	s.mockDPoPService.On("ValidateProof", mock.Anything, "proof", mock.Anything).Return("", dpop.ErrNonceRequired)
+	s.mockDPoPService.On("IssueNonce", mock.Anything).Return("server-nonce", nil)
+
+	s.handler.HandleUserInfo(rr, req)
+
+	assert.Equal(s.T(), http.StatusUnauthorized, rr.Code)
+	assert.Equal(s.T(), "server-nonce", rr.Header().Get(dpop.HeaderDPoPNonce))
+	assert.Contains(s.T(), rr.Header().Get("WWW-Authenticate"), constants.ErrorUseDPoPNonce)
+}
+
+// TestHandleUserInfo_DPoP_NonceIssueFailure tests the server error when a nonce cannot be issued.
+func (s *UserInfoHandlerTestSuite) TestHandleUserInfo_DPoP_NonceIssueFailure() {
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/userinfo", nil)
+	req.Header.Set("Authorization", "DPoP token123")
+	req.Header.Set(dpop.HeaderDPoP, "proof")
+	rr := httptest.NewRecorder()
+
+	s.mockDPoPService.On("ValidateProof", mock.Anything, "proof", mock.Anything).Return("", dpop.ErrNonceRequired)
+	s.mockDPoPService.On("IssueNonce", mock.Anything).Return("", errors.New("signing failed"))
+
+	s.handler.HandleUserInfo(rr, req)
+
+	assert.Equal(s.T(), http.StatusInternalServerError, rr.Code)
+	assert.Empty(s.T(), rr.Header().Get(dpop.HeaderDPoPNonce))
+}
+
 // assertServiceErrorResponse is a helper to test service error responses with WWW-Authenticate headers.
 func (s *UserInfoHandlerTestSuite) assertServiceErrorResponse(
 	token string, svcErr *serviceerror.ServiceError, expectedStatus int, expectedWWWAuthError string,
@@ -19,14 +19,19 @@
 package userinfo
 
 import (
+	"context"
 	"net/http"
+	"slices"
 
 	"github.com/asgardeo/thunder/internal/attributecache"
 	"github.com/asgardeo/thunder/internal/inboundclient"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/discovery"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/dpop"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/jwksresolver"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
 	"github.com/asgardeo/thunder/internal/ou"
+	serverconst "github.com/asgardeo/thunder/internal/system/constants"
 	"github.com/asgardeo/thunder/internal/system/jose/jwe"
 	"github.com/asgardeo/thunder/internal/system/jose/jwt"
 	"github.com/asgardeo/thunder/internal/system/middleware"
@@ -43,11 +48,14 @@ func Initialize(
 	inboundClient inboundclient.InboundClientServiceInterface,
 	ouService ou.OrganizationUnitServiceInterface,
 	attributeCacheSvc attributecache.AttributeCacheServiceInterface,
+	discoveryService discovery.DiscoveryServiceInterface,
+	dpopService dpop.DPoPServiceInterface,
 	transactioner transaction.Transactioner,
 ) userInfoServiceInterface {
 	userInfoService := newUserInfoService(jwtService, jweService, resolver, tokenValidator,
 		inboundClient, ouService, attributeCacheSvc, transactioner)
-	userInfoHandler := newUserInfoHandler(userInfoService)
+	endpointURL := discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background()).UserInfoEndpoint
+	userInfoHandler := newUserInfoHandler(userInfoService, dpopService, endpointURL)
 	registerRoutes(mux, userInfoHandler)
 	return userInfoService
 }
@@ -56,7 +64,8 @@ func Initialize(
 func registerRoutes(mux *http.ServeMux, userInfoHandler *userInfoHandler) {
 	opts := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET", "POST", "OPTIONS"},
-		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowedHeaders:   append(slices.Clone(middleware.DefaultAllowedHeaders), dpop.HeaderDPoP),
+		ExposedHeaders:   []string{serverconst.WWWAuthenticateHeaderName, dpop.HeaderDPoPNonce},
 		AllowCredentials: true,
 		MaxAge:           600,
 	}
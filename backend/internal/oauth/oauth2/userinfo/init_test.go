@@ -24,11 +24,14 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/discovery"
 	"github.com/asgardeo/thunder/tests/mocks/attributecachemock"
 	"github.com/asgardeo/thunder/tests/mocks/inboundclientmock"
 	"github.com/asgardeo/thunder/tests/mocks/jose/jwtmock"
+	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/discoverymock"
 	"github.com/asgardeo/thunder/tests/mocks/oauth/oauth2/tokenservicemock"
 	"github.com/asgardeo/thunder/tests/mocks/oumock"
 )
@@ -40,6 +43,7 @@ type InitTestSuite struct {
 	mockInboundClient         *inboundclientmock.InboundClientServiceInterfaceMock
 	mockOUService             *oumock.OrganizationUnitServiceInterfaceMock
 	mockAttributeCacheService *attributecachemock.AttributeCacheServiceInterfaceMock
+	mockDiscoveryService      *discoverymock.DiscoveryServiceInterfaceMock
 	mockTransactioner         *MockTransactioner
 }
 
@@ -53,6 +57,11 @@ func (suite *InitTestSuite) SetupTest() {
 	suite.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(suite.T())
 	suite.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
 	suite.mockAttributeCacheService = attributecachemock.NewAttributeCacheServiceInterfaceMock(suite.T())
+	suite.mockDiscoveryService = discoverymock.NewDiscoveryServiceInterfaceMock(suite.T())
+	suite.mockDiscoveryService.On("GetOAuth2AuthorizationServerMetadata", mock.Anything).
+		Return(&discovery.OAuth2AuthorizationServerMetadata{
+			UserInfoEndpoint: "https://localhost:8090/oauth2/userinfo",
+		})
 	suite.mockTransactioner = &MockTransactioner{}
 }
 
@@ -61,7 +70,8 @@ func (suite *InitTestSuite) TestInitialize() {
 
 	service := Initialize(mux, suite.mockJWTService, nil, nil,
 		suite.mockTokenValidator, suite.mockInboundClient,
-		suite.mockOUService, suite.mockAttributeCacheService, suite.mockTransactioner)
+		suite.mockOUService, suite.mockAttributeCacheService, suite.mockDiscoveryService, nil,
+		suite.mockTransactioner)
 
 	assert.NotNil(suite.T(), service)
 }
@@ -71,7 +81,8 @@ func (suite *InitTestSuite) TestInitialize_RegistersRoutes() {
 
 	Initialize(mux, suite.mockJWTService, nil, nil,
 		suite.mockTokenValidator, suite.mockInboundClient,
-		suite.mockOUService, suite.mockAttributeCacheService, suite.mockTransactioner)
+		suite.mockOUService, suite.mockAttributeCacheService, suite.mockDiscoveryService, nil,
+		suite.mockTransactioner)
 
 	// Verify that the routes are registered by attempting to get a handler for them.
 	// The pattern includes the method because of CORS middleware wrapping.
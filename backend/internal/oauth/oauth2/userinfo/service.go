@@ -21,6 +21,7 @@ package userinfo
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"slices"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/asgardeo/thunder/internal/inboundclient"
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/dpop"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/jwksresolver"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/model"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
@@ -102,6 +104,10 @@ func (s *userInfoService) GetUserInfo(
 	tokenClaims := accessTokenClaims.Claims
 	sub := accessTokenClaims.Sub
 
+	if svcErr := s.validateProofKeyBinding(ctx, tokenClaims); svcErr != nil {
+		return nil, svcErr
+	}
+
 	if svcErr := s.validateGrantType(tokenClaims); svcErr != nil {
 		return nil, svcErr
 	}
@@ -162,6 +168,22 @@ func (s *userInfoService) GetUserInfo(
 	}
 }
 
+// validateProofKeyBinding rejects a DPoP-bound access token unless the request carried a proof signed by
+// the bound key (RFC 9449 §7.1).
+func (s *userInfoService) validateProofKeyBinding(
+	ctx context.Context, tokenClaims map[string]interface{},
+) *serviceerror.ServiceError {
+	boundThumbprint := jwt.GetKeyThumbprintConfirmation(tokenClaims)
+	if boundThumbprint == "" {
+		return nil
+	}
+	if subtle.ConstantTimeCompare([]byte(boundThumbprint), []byte(dpop.GetProofKeyThumbprint(ctx))) != 1 {
+		s.logger.Debug("DPoP-bound access token presented without a matching proof")
+		return &errorProofKeyMismatch
+	}
+	return nil
+}
+
 // generateJWEUserInfo creates an encrypted JWE UserInfo response.
 func (s *userInfoService) generateJWEUserInfo(
 	ctx context.Context,
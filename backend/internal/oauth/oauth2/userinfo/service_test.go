@@ -37,6 +37,7 @@ import (
 	"github.com/asgardeo/thunder/internal/attributecache"
 	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
+	"github.com/asgardeo/thunder/internal/oauth/oauth2/dpop"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
@@ -181,6 +182,40 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_NoScopes() {
 	s.mockTokenValidator.AssertExpectations(s.T())
 }
 
+// TestGetUserInfo_DPoPBoundToken tests that a DPoP-bound token requires a proof from the bound key
+func (s *UserInfoServiceTestSuite) TestGetUserInfo_DPoPBoundToken() {
+	claims := map[string]interface{}{
+		"exp": float64(time.Now().Add(time.Hour).Unix()),
+		"nbf": float64(time.Now().Add(-time.Minute).Unix()),
+		"sub": "user123",
+		"cnf": map[string]interface{}{"jkt": "bound-thumbprint"},
+	}
+	token := s.createToken(claims)
+
+	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
+
+	testCases := []struct {
+		name         string
+		ctx          context.Context
+		expectedCode string
+	}{
+		{"NoProof", context.Background(), errorProofKeyMismatch.Code},
+		{"DifferentKey", dpop.WithProofKeyThumbprint(context.Background(), "other"), errorProofKeyMismatch.Code},
+		// A matching proof passes the binding check and fails later on the missing openid scope.
+		{"MatchingKey", dpop.WithProofKeyThumbprint(context.Background(), "bound-thumbprint"), "insufficient_scope"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			response, svcErr := s.userInfoService.GetUserInfo(tc.ctx, token)
+			assert.Nil(s.T(), response)
+			assert.NotNil(s.T(), svcErr)
+			assert.Equal(s.T(), tc.expectedCode, svcErr.Code)
+		})
+	}
+}
+
 // TestGetUserInfo_NoScopesEmptyScopeString tests that empty scope string returns insufficient_scope error
 func (s *UserInfoServiceTestSuite) TestGetUserInfo_NoScopesEmptyScopeString() {
 	claims := map[string]interface{}{
@@ -23,7 +23,9 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
 	"regexp"
 
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/constants"
@@ -43,6 +45,42 @@ func GetURIWithQueryParams(uri string, queryParams map[string]string) (string, e
 	return utils.GetURIWithQueryParams(uri, queryParams)
 }
 
+// GetURIWithFragmentParams constructs a URI carrying the given parameters in its fragment.
+// It validates the error code and error description according to the spec.
+func GetURIWithFragmentParams(uri string, fragmentParams map[string]string) (string, error) {
+	if err := validateErrorParams(fragmentParams[constants.RequestParamError],
+		fragmentParams[constants.RequestParamErrorDescription]); err != nil {
+		return "", err
+	}
+
+	parsedURL, err := utils.ParseURL(uri)
+	if err != nil {
+		return "", errors.New("failed to parse the return URI: " + err.Error())
+	}
+	parsedURL.Fragment = ""
+	if len(fragmentParams) == 0 {
+		return parsedURL.String(), nil
+	}
+
+	fragment := url.Values{}
+	for key, value := range fragmentParams {
+		fragment.Add(key, value)
+	}
+	return parsedURL.String() + "#" + fragment.Encode(), nil
+}
+
+// GetAuthorizationResponseURI constructs the client redirect URI carrying the authorization response
+// parameters in the given response mode. Parameters are carried in the query unless the fragment
+// response mode is used.
+func GetAuthorizationResponseURI(
+	uri string, responseMode constants.ResponseMode, params map[string]string,
+) (string, error) {
+	if responseMode == constants.ResponseModeFragment {
+		return GetURIWithFragmentParams(uri, params)
+	}
+	return GetURIWithQueryParams(uri, params)
+}
+
 // validateErrorParams validates the error code and error description parameters.
 func validateErrorParams(err, desc string) error {
 	// Define a regex pattern for the allowed character set: %x20-21 / %x23-5B / %x5D-7E
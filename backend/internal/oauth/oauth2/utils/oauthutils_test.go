@@ -114,6 +114,40 @@ func (suite *OAuth2UtilsTestSuite) TestGetURIWithQueryParams_Success() {
 	}
 }
 
+func (suite *OAuth2UtilsTestSuite) TestGetURIWithFragmentParams() {
+	uri, err := GetURIWithFragmentParams("https://example.com/callback", map[string]string{
+		"id_token": "header.payload.signature",
+		"state":    "a b",
+	})
+	suite.NoError(err)
+	suite.Equal("https://example.com/callback#id_token=header.payload.signature&state=a+b", uri)
+
+	uri, err = GetURIWithFragmentParams("https://example.com/callback?app=1", nil)
+	suite.NoError(err)
+	suite.Equal("https://example.com/callback?app=1", uri)
+
+	_, err = GetURIWithFragmentParams("https://example.com/callback", map[string]string{
+		constants.RequestParamError: "invalid\x22request",
+	})
+	suite.Error(err)
+}
+
+func (suite *OAuth2UtilsTestSuite) TestGetAuthorizationResponseURI() {
+	params := map[string]string{"code": "test-code"}
+
+	uri, err := GetAuthorizationResponseURI("https://example.com/callback", constants.ResponseModeFragment, params)
+	suite.NoError(err)
+	suite.Equal("https://example.com/callback#code=test-code", uri)
+
+	uri, err = GetAuthorizationResponseURI("https://example.com/callback", constants.ResponseModeQuery, params)
+	suite.NoError(err)
+	suite.Equal("https://example.com/callback?code=test-code", uri)
+
+	uri, err = GetAuthorizationResponseURI("https://example.com/callback", "", params)
+	suite.NoError(err)
+	suite.Equal("https://example.com/callback?code=test-code", uri)
+}
+
 func (suite *OAuth2UtilsTestSuite) TestGetURIWithQueryParams_InvalidErrorCode() {
 	testCases := []struct {
 		name        string
@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import (
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/i18n/core"
+)
+
+// Client errors for the password policy service.
+var (
+	// ErrorPasswordTooShort is the error returned when the password is shorter than the policy allows.
+	ErrorPasswordTooShort = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PWP-1001",
+		Error: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_too_short",
+			DefaultValue: "Password too short",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_too_short_description",
+			DefaultValue: "The password is shorter than the minimum length required by the password policy",
+		},
+	}
+	// ErrorPasswordTooLong is the error returned when the password is longer than the policy allows.
+	ErrorPasswordTooLong = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PWP-1002",
+		Error: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_too_long",
+			DefaultValue: "Password too long",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_too_long_description",
+			DefaultValue: "The password is longer than the maximum length allowed by the password policy",
+		},
+	}
+	// ErrorMissingUppercase is the error returned when the password has no uppercase letter.
+	ErrorMissingUppercase = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PWP-1003",
+		Error: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.missing_uppercase",
+			DefaultValue: "Missing uppercase letter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.missing_uppercase_description",
+			DefaultValue: "The password must contain at least one uppercase letter",
+		},
+	}
+	// ErrorMissingLowercase is the error returned when the password has no lowercase letter.
+	ErrorMissingLowercase = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PWP-1004",
+		Error: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.missing_lowercase",
+			DefaultValue: "Missing lowercase letter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.missing_lowercase_description",
+			DefaultValue: "The password must contain at least one lowercase letter",
+		},
+	}
+	// ErrorMissingDigit is the error returned when the password has no digit.
+	ErrorMissingDigit = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PWP-1005",
+		Error: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.missing_digit",
+			DefaultValue: "Missing digit",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.missing_digit_description",
+			DefaultValue: "The password must contain at least one digit",
+		},
+	}
+	// ErrorMissingSpecial is the error returned when the password has no special character.
+	ErrorMissingSpecial = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PWP-1006",
+		Error: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.missing_special",
+			DefaultValue: "Missing special character",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.missing_special_description",
+			DefaultValue: "The password must contain at least one special character",
+		},
+	}
+	// ErrorPasswordReused is the error returned when the password matches one of the recent passwords
+	// of the entity.
+	ErrorPasswordReused = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PWP-1007",
+		Error: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_reused",
+			DefaultValue: "Password reused",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_reused_description",
+			DefaultValue: "The password matches a recently used password",
+		},
+	}
+	// ErrorPasswordBreached is the error returned when the password is found in the breached
+	// password list.
+	ErrorPasswordBreached = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PWP-1008",
+		Error: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_breached",
+			DefaultValue: "Password breached",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_breached_description",
+			DefaultValue: "The password has appeared in a data breach and cannot be used",
+		},
+	}
+	// ErrorPasswordExpired is the error returned when the password of the entity has exceeded the
+	// maximum age of the policy and must be changed.
+	ErrorPasswordExpired = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PWP-1009",
+		Error: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_expired",
+			DefaultValue: "Password expired",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_expired_description",
+			DefaultValue: "The password has expired and must be changed",
+		},
+	}
+)
@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import (
+	"github.com/asgardeo/thunder/internal/ou"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
+)
+
+// Initialize initializes the password policy service.
+func Initialize(
+	hashService hash.HashServiceInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+) (PasswordPolicyServiceInterface, error) {
+	runtime := config.GetServerRuntime()
+	policyConfig := runtime.Config.PasswordPolicy
+
+	breachedPasswords := make(map[string]struct{})
+	if policyConfig.Enabled {
+		var err error
+		breachedPasswords, err = loadBreachedPasswords(policyConfig.BreachedPasswordFile, runtime.ServerHome)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	store := newPasswordHistoryStore(runtime.Config.Server.Identifier)
+	return newPasswordPolicyService(policyConfig, breachedPasswords, store, hashService, ouService), nil
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import (
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
+)
+
+// Subject identifies the entity whose password is validated along with the attributes that select
+// its password policy.
+type Subject struct {
+	EntityID   string
+	EntityType string
+	OUID       string
+}
+
+// passwordRecord holds the password history of an entity. History is ordered from the most recent
+// password to the oldest.
+type passwordRecord struct {
+	ChangedAt time.Time         `json:"changedAt"`
+	History   []hash.Credential `json:"history,omitempty"`
+}
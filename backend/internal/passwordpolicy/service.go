@@ -0,0 +1,248 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package passwordpolicy provides the password policies enforced on the credentials of users.
+package passwordpolicy
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/ou"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+// PasswordPolicyServiceInterface defines the interface of the password policy service.
+type PasswordPolicyServiceInterface interface {
+	// IsEnabled reports whether password policies are enforced.
+	IsEnabled() bool
+	// GetCredentialAttribute returns the credential attribute that holds the password.
+	GetCredentialAttribute() string
+	// ValidatePassword validates a new password of the subject against its password policy.
+	ValidatePassword(ctx context.Context, subject Subject, password string) *serviceerror.ServiceError
+	// RecordPasswordChange records the hashed new password of the subject in its password history.
+	RecordPasswordChange(ctx context.Context, subject Subject, credential hash.Credential) error
+	// IsPasswordExpired reports whether the password of the subject has exceeded the maximum age of
+	// its password policy.
+	IsPasswordExpired(ctx context.Context, subject Subject) (bool, *serviceerror.ServiceError)
+}
+
+// passwordPolicyService is the default implementation of PasswordPolicyServiceInterface.
+type passwordPolicyService struct {
+	config            config.PasswordPolicyConfig
+	breachedPasswords map[string]struct{}
+	store             passwordHistoryStoreInterface
+	hashService       hash.HashServiceInterface
+	ouService         ou.OrganizationUnitServiceInterface
+	logger            *log.Logger
+}
+
+// newPasswordPolicyService creates a new instance of passwordPolicyService with injected dependencies.
+func newPasswordPolicyService(
+	policyConfig config.PasswordPolicyConfig,
+	breachedPasswords map[string]struct{},
+	store passwordHistoryStoreInterface,
+	hashService hash.HashServiceInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+) PasswordPolicyServiceInterface {
+	return &passwordPolicyService{
+		config:            policyConfig,
+		breachedPasswords: breachedPasswords,
+		store:             store,
+		hashService:       hashService,
+		ouService:         ouService,
+		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, "PasswordPolicyService")),
+	}
+}
+
+// IsEnabled reports whether password policies are enforced.
+func (s *passwordPolicyService) IsEnabled() bool {
+	return s.config.Enabled
+}
+
+// GetCredentialAttribute returns the credential attribute that holds the password.
+func (s *passwordPolicyService) GetCredentialAttribute() string {
+	return s.config.CredentialAttribute
+}
+
+// ValidatePassword validates a new password of the subject against the length, character class and
+// breached password rules of its policy, and rejects passwords found in the subject's password history.
+func (s *passwordPolicyService) ValidatePassword(
+	ctx context.Context, subject Subject, password string,
+) *serviceerror.ServiceError {
+	if !s.config.Enabled {
+		return nil
+	}
+	policy, svcErr := s.resolvePolicy(ctx, subject)
+	if svcErr != nil || policy == nil {
+		return svcErr
+	}
+
+	if svcErr := checkComposition(policy, password); svcErr != nil {
+		return svcErr
+	}
+	if policy.CheckBreached {
+		if _, ok := s.breachedPasswords[strings.ToLower(password)]; ok {
+			return &ErrorPasswordBreached
+		}
+	}
+
+	if policy.HistoryCount <= 0 || subject.EntityID == "" {
+		return nil
+	}
+	record, err := s.store.GetPasswordRecord(ctx, subject.EntityID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve password history", log.MaskedString("entityId", subject.EntityID),
+			log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if record == nil {
+		return nil
+	}
+	for i, previous := range record.History {
+		if i >= policy.HistoryCount {
+			break
+		}
+		matched, err := s.hashService.Verify([]byte(password), previous)
+		if err != nil {
+			s.logger.Error("Failed to verify password against history", log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+		if matched {
+			return &ErrorPasswordReused
+		}
+	}
+	return nil
+}
+
+// RecordPasswordChange records the hashed new password of the subject in its password history along
+// with the time of the change. The history is trimmed to the history count of the subject's policy.
+// The change time is recorded even when no history is kept so that the password age can be enforced.
+func (s *passwordPolicyService) RecordPasswordChange(
+	ctx context.Context, subject Subject, credential hash.Credential,
+) error {
+	if !s.config.Enabled {
+		return nil
+	}
+	policy, svcErr := s.resolvePolicy(ctx, subject)
+	if svcErr != nil {
+		return fmt.Errorf("failed to resolve password policy: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+	if policy == nil {
+		return nil
+	}
+
+	record, err := s.store.GetPasswordRecord(ctx, subject.EntityID)
+	if err != nil {
+		return err
+	}
+	if record == nil {
+		record = &passwordRecord{}
+	}
+
+	record.ChangedAt = time.Now().UTC()
+	if policy.HistoryCount > 0 {
+		record.History = append([]hash.Credential{credential}, record.History...)
+		if len(record.History) > policy.HistoryCount {
+			record.History = record.History[:policy.HistoryCount]
+		}
+	} else {
+		record.History = nil
+	}
+	return s.store.SavePasswordRecord(ctx, subject.EntityID, *record)
+}
+
+// IsPasswordExpired reports whether the password of the subject has exceeded the maximum age of its
+// policy. Passwords without a recorded change time, such as those set before the policy was enabled,
+// do not expire.
+func (s *passwordPolicyService) IsPasswordExpired(
+	ctx context.Context, subject Subject,
+) (bool, *serviceerror.ServiceError) {
+	if !s.config.Enabled {
+		return false, nil
+	}
+	policy, svcErr := s.resolvePolicy(ctx, subject)
+	if svcErr != nil {
+		return false, svcErr
+	}
+	if policy == nil || policy.MaxAge <= 0 {
+		return false, nil
+	}
+
+	record, err := s.store.GetPasswordRecord(ctx, subject.EntityID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve password history", log.MaskedString("entityId", subject.EntityID),
+			log.Error(err))
+		return false, &serviceerror.InternalServerError
+	}
+	if record == nil || record.ChangedAt.IsZero() {
+		return false, nil
+	}
+	expiry := record.ChangedAt.Add(time.Duration(policy.MaxAge) * time.Second)
+	return !time.Now().Before(expiry), nil
+}
+
+// resolvePolicy returns the policy of the subject: the first policy that lists the subject's type,
+// then the first policy that lists the subject's organization unit or one of its ancestors, and
+// otherwise the first policy without selectors. Returns nil if no policy applies.
+func (s *passwordPolicyService) resolvePolicy(
+	ctx context.Context, subject Subject,
+) (*config.PasswordPolicyRule, *serviceerror.ServiceError) {
+	policies := s.config.Policies
+
+	if subject.EntityType != "" {
+		for i := range policies {
+			if slices.Contains(policies[i].UserTypes, subject.EntityType) {
+				return &policies[i], nil
+			}
+		}
+	}
+
+	if subject.OUID != "" && s.ouService != nil {
+		for i := range policies {
+			for _, ouID := range policies[i].OrganizationUnits {
+				isParent, svcErr := s.ouService.IsParent(ctx, ouID, subject.OUID)
+				if svcErr != nil {
+					if svcErr.Type == serviceerror.ClientErrorType {
+						// The configured organization unit does not exist.
+						continue
+					}
+					s.logger.Error("Failed to resolve organization unit of password policy",
+						log.String("policy", policies[i].Name), log.Any("error", svcErr))
+					return nil, &serviceerror.InternalServerError
+				}
+				if isParent {
+					return &policies[i], nil
+				}
+			}
+		}
+	}
+
+	for i := range policies {
+		if !hasSelectors(&policies[i]) {
+			return &policies[i], nil
+		}
+	}
+	return nil, nil
+}
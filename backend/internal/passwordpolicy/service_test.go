@@ -0,0 +1,313 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/tests/mocks/crypto/hashmock"
+	"github.com/asgardeo/thunder/tests/mocks/oumock"
+)
+
+const (
+	testEntityID = "entity-1"
+	testOUID     = "ou-child"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	mockStore       *passwordHistoryStoreInterfaceMock
+	mockHashService *hashmock.HashServiceInterfaceMock
+	mockOUService   *oumock.OrganizationUnitServiceInterfaceMock
+	policyConfig    config.PasswordPolicyConfig
+	breached        map[string]struct{}
+	subject         Subject
+	ctx             context.Context
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	s.mockStore = newPasswordHistoryStoreInterfaceMock(s.T())
+	s.mockHashService = hashmock.NewHashServiceInterfaceMock(s.T())
+	s.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(s.T())
+	s.policyConfig = config.PasswordPolicyConfig{
+		Enabled:             true,
+		CredentialAttribute: "password",
+		Policies: []config.PasswordPolicyRule{
+			{
+				Name:             "default",
+				MinLength:        8,
+				MaxLength:        64,
+				RequireUppercase: true,
+				RequireLowercase: true,
+				RequireDigit:     true,
+				HistoryCount:     2,
+				MaxAge:           3600,
+				CheckBreached:    true,
+			},
+		},
+	}
+	s.breached = map[string]struct{}{"password1": {}}
+	s.subject = Subject{EntityID: testEntityID, EntityType: "person", OUID: testOUID}
+	s.ctx = context.Background()
+}
+
+func (s *ServiceTestSuite) newService() PasswordPolicyServiceInterface {
+	return newPasswordPolicyService(s.policyConfig, s.breached, s.mockStore, s.mockHashService, s.mockOUService)
+}
+
+func (s *ServiceTestSuite) TestIsEnabled() {
+	s.True(s.newService().IsEnabled())
+	s.Equal("password", s.newService().GetCredentialAttribute())
+
+	s.policyConfig.Enabled = false
+	s.False(s.newService().IsEnabled())
+}
+
+func (s *ServiceTestSuite) TestValidatePassword_Disabled() {
+	s.policyConfig.Enabled = false
+
+	s.Nil(s.newService().ValidatePassword(s.ctx, s.subject, "weak"))
+}
+
+func (s *ServiceTestSuite) TestValidatePassword_CompositionViolations() {
+	testCases := []struct {
+		name     string
+		password string
+		expected *serviceerror.ServiceError
+	}{
+		{"TooShort", "Ab1", &ErrorPasswordTooShort},
+		{"TooLong", "Ab1" + strings.Repeat("a", 64), &ErrorPasswordTooLong},
+		{"MissingUppercase", "password123", &ErrorMissingUppercase},
+		{"MissingLowercase", "PASSWORD123", &ErrorMissingLowercase},
+		{"MissingDigit", "PasswordOnly", &ErrorMissingDigit},
+		{"Breached", "Password1", &ErrorPasswordBreached},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			svcErr := s.newService().ValidatePassword(s.ctx, s.subject, tc.password)
+			s.Require().NotNil(svcErr)
+			s.Equal(tc.expected.Code, svcErr.Code)
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestValidatePassword_MissingSpecial() {
+	s.policyConfig.Policies[0].RequireSpecial = true
+
+	svcErr := s.newService().ValidatePassword(s.ctx, s.subject, "Password123")
+
+	s.Require().NotNil(svcErr)
+	s.Equal(ErrorMissingSpecial.Code, svcErr.Code)
+}
+
+func (s *ServiceTestSuite) TestValidatePassword_Success() {
+	previous := hash.Credential{Algorithm: hash.PBKDF2, Hash: "old-hash"}
+	s.mockStore.On("GetPasswordRecord", s.ctx, testEntityID).
+		Return(&passwordRecord{History: []hash.Credential{previous}}, nil)
+	s.mockHashService.On("Verify", []byte("Str0ngPassword"), previous).Return(false, nil)
+
+	s.Nil(s.newService().ValidatePassword(s.ctx, s.subject, "Str0ngPassword"))
+}
+
+func (s *ServiceTestSuite) TestValidatePassword_Reused() {
+	older := hash.Credential{Algorithm: hash.PBKDF2, Hash: "older-hash"}
+	previous := hash.Credential{Algorithm: hash.PBKDF2, Hash: "old-hash"}
+	s.mockStore.On("GetPasswordRecord", s.ctx, testEntityID).
+		Return(&passwordRecord{History: []hash.Credential{previous, older}}, nil)
+	s.mockHashService.On("Verify", []byte("Str0ngPassword"), previous).Return(false, nil)
+	s.mockHashService.On("Verify", []byte("Str0ngPassword"), older).Return(true, nil)
+
+	svcErr := s.newService().ValidatePassword(s.ctx, s.subject, "Str0ngPassword")
+
+	s.Require().NotNil(svcErr)
+	s.Equal(ErrorPasswordReused.Code, svcErr.Code)
+}
+
+func (s *ServiceTestSuite) TestValidatePassword_HistoryBeyondCountIgnored() {
+	s.policyConfig.Policies[0].HistoryCount = 1
+	previous := hash.Credential{Algorithm: hash.PBKDF2, Hash: "old-hash"}
+	older := hash.Credential{Algorithm: hash.PBKDF2, Hash: "older-hash"}
+	s.mockStore.On("GetPasswordRecord", s.ctx, testEntityID).
+		Return(&passwordRecord{History: []hash.Credential{previous, older}}, nil)
+	s.mockHashService.On("Verify", []byte("Str0ngPassword"), previous).Return(false, nil)
+
+	s.Nil(s.newService().ValidatePassword(s.ctx, s.subject, "Str0ngPassword"))
+	s.mockHashService.AssertNumberOfCalls(s.T(), "Verify", 1)
+}
+
+func (s *ServiceTestSuite) TestValidatePassword_NewEntitySkipsHistory() {
+	s.Nil(s.newService().ValidatePassword(s.ctx, Subject{EntityType: "person"}, "Str0ngPassword"))
+}
+
+func (s *ServiceTestSuite) TestValidatePassword_StoreError() {
+	s.mockStore.On("GetPasswordRecord", s.ctx, testEntityID).Return(nil, errors.New("db error"))
+
+	svcErr := s.newService().ValidatePassword(s.ctx, s.subject, "Str0ngPassword")
+
+	s.Require().NotNil(svcErr)
+	s.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (s *ServiceTestSuite) TestValidatePassword_PolicyForUserType() {
+	s.policyConfig.Policies = append([]config.PasswordPolicyRule{
+		{Name: "employees", UserTypes: []string{"person"}, MinLength: 20},
+	}, s.policyConfig.Policies...)
+
+	svcErr := s.newService().ValidatePassword(s.ctx, s.subject, "Str0ngPassword")
+
+	s.Require().NotNil(svcErr)
+	s.Equal(ErrorPasswordTooShort.Code, svcErr.Code)
+}
+
+func (s *ServiceTestSuite) TestValidatePassword_PolicyForAncestorOU() {
+	s.policyConfig.Policies = append(s.policyConfig.Policies,
+		config.PasswordPolicyRule{Name: "unknown", OrganizationUnits: []string{"ou-missing"}, MinLength: 30},
+		config.PasswordPolicyRule{Name: "partners", OrganizationUnits: []string{"ou-root"}, MinLength: 20},
+	)
+	s.mockOUService.On("IsParent", s.ctx, "ou-missing", testOUID).
+		Return(false, &serviceerror.ServiceError{Type: serviceerror.ClientErrorType, Code: "OU-1003"})
+	s.mockOUService.On("IsParent", s.ctx, "ou-root", testOUID).Return(true, nil)
+
+	svcErr := s.newService().ValidatePassword(s.ctx, s.subject, "Str0ngPassword")
+
+	s.Require().NotNil(svcErr)
+	s.Equal(ErrorPasswordTooShort.Code, svcErr.Code)
+}
+
+func (s *ServiceTestSuite) TestValidatePassword_OUResolutionError() {
+	s.policyConfig.Policies = append(s.policyConfig.Policies,
+		config.PasswordPolicyRule{Name: "partners", OrganizationUnits: []string{"ou-root"}, MinLength: 20})
+	s.mockOUService.On("IsParent", s.ctx, "ou-root", testOUID).
+		Return(false, &serviceerror.InternalServerError)
+
+	svcErr := s.newService().ValidatePassword(s.ctx, s.subject, "Str0ngPassword")
+
+	s.Require().NotNil(svcErr)
+	s.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (s *ServiceTestSuite) TestValidatePassword_NoApplicablePolicy() {
+	s.policyConfig.Policies = []config.PasswordPolicyRule{
+		{Name: "agents", UserTypes: []string{"agent"}, MinLength: 20},
+	}
+
+	s.Nil(s.newService().ValidatePassword(s.ctx, Subject{EntityType: "person"}, "weak"))
+}
+
+func (s *ServiceTestSuite) TestRecordPasswordChange_TrimsHistory() {
+	newCred := hash.Credential{Algorithm: hash.PBKDF2, Hash: "new-hash"}
+	previous := hash.Credential{Algorithm: hash.PBKDF2, Hash: "old-hash"}
+	older := hash.Credential{Algorithm: hash.PBKDF2, Hash: "older-hash"}
+	s.mockStore.On("GetPasswordRecord", s.ctx, testEntityID).
+		Return(&passwordRecord{History: []hash.Credential{previous, older}}, nil)
+	s.mockStore.On("SavePasswordRecord", s.ctx, testEntityID, mock.MatchedBy(func(r passwordRecord) bool {
+		return len(r.History) == 2 && r.History[0] == newCred && r.History[1] == previous &&
+			time.Since(r.ChangedAt) < time.Minute
+	})).Return(nil)
+
+	s.NoError(s.newService().RecordPasswordChange(s.ctx, s.subject, newCred))
+}
+
+func (s *ServiceTestSuite) TestRecordPasswordChange_WithoutHistory() {
+	s.policyConfig.Policies[0].HistoryCount = 0
+	s.mockStore.On("GetPasswordRecord", s.ctx, testEntityID).Return(nil, nil)
+	s.mockStore.On("SavePasswordRecord", s.ctx, testEntityID, mock.MatchedBy(func(r passwordRecord) bool {
+		return len(r.History) == 0 && !r.ChangedAt.IsZero()
+	})).Return(nil)
+
+	s.NoError(s.newService().RecordPasswordChange(s.ctx, s.subject, hash.Credential{Hash: "new-hash"}))
+}
+
+func (s *ServiceTestSuite) TestRecordPasswordChange_Disabled() {
+	s.policyConfig.Enabled = false
+
+	s.NoError(s.newService().RecordPasswordChange(s.ctx, s.subject, hash.Credential{Hash: "new-hash"}))
+}
+
+func (s *ServiceTestSuite) TestRecordPasswordChange_StoreError() {
+	s.mockStore.On("GetPasswordRecord", s.ctx, testEntityID).Return(nil, errors.New("db error"))
+
+	s.Error(s.newService().RecordPasswordChange(s.ctx, s.subject, hash.Credential{Hash: "new-hash"}))
+}
+
+func (s *ServiceTestSuite) TestIsPasswordExpired() {
+	testCases := []struct {
+		name      string
+		changedAt time.Time
+		expected  bool
+	}{
+		{"Expired", time.Now().Add(-2 * time.Hour), true},
+		{"NotExpired", time.Now().Add(-time.Minute), false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.mockStore = newPasswordHistoryStoreInterfaceMock(s.T())
+			s.mockStore.On("GetPasswordRecord", s.ctx, testEntityID).
+				Return(&passwordRecord{ChangedAt: tc.changedAt}, nil)
+
+			expired, svcErr := s.newService().IsPasswordExpired(s.ctx, s.subject)
+
+			s.Nil(svcErr)
+			s.Equal(tc.expected, expired)
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestIsPasswordExpired_NoRecord() {
+	s.mockStore.On("GetPasswordRecord", s.ctx, testEntityID).Return(nil, nil)
+
+	expired, svcErr := s.newService().IsPasswordExpired(s.ctx, s.subject)
+
+	s.Nil(svcErr)
+	s.False(expired)
+}
+
+func (s *ServiceTestSuite) TestIsPasswordExpired_NoMaxAge() {
+	s.policyConfig.Policies[0].MaxAge = 0
+
+	expired, svcErr := s.newService().IsPasswordExpired(s.ctx, s.subject)
+
+	s.Nil(svcErr)
+	s.False(expired)
+}
+
+func (s *ServiceTestSuite) TestIsPasswordExpired_StoreError() {
+	s.mockStore.On("GetPasswordRecord", s.ctx, testEntityID).Return(nil, errors.New("db error"))
+
+	_, svcErr := s.newService().IsPasswordExpired(s.ctx, s.subject)
+
+	s.Require().NotNil(svcErr)
+	s.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// passwordHistoryStoreInterface defines the interface for storing the password history of entities.
+type passwordHistoryStoreInterface interface {
+	GetPasswordRecord(ctx context.Context, entityID string) (*passwordRecord, error)
+	SavePasswordRecord(ctx context.Context, entityID string, record passwordRecord) error
+}
+
+// passwordHistoryStore is the user-DB-backed implementation of passwordHistoryStoreInterface. The
+// history lives next to the entity so that it is written in the same transaction as the credential.
+type passwordHistoryStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newPasswordHistoryStore creates a new DB-backed password history store.
+func newPasswordHistoryStore(deploymentID string) passwordHistoryStoreInterface {
+	return &passwordHistoryStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// GetPasswordRecord retrieves the password record of the entity. Returns nil if there is none.
+func (s *passwordHistoryStore) GetPasswordRecord(ctx context.Context, entityID string) (*passwordRecord, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetPasswordHistory, entityID, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query password history: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	return buildPasswordRecordFromRow(results[0])
+}
+
+// SavePasswordRecord creates or replaces the password record of the entity.
+func (s *passwordHistoryStore) SavePasswordRecord(
+	ctx context.Context, entityID string, record passwordRecord,
+) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal password history: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(
+		ctx, queryUpsertPasswordHistory, entityID, s.deploymentID, string(data), time.Now().UTC(),
+	); err != nil {
+		return fmt.Errorf("failed to save password history: %w", err)
+	}
+	return nil
+}
+
+// buildPasswordRecordFromRow reconstructs a passwordRecord from a database row.
+func buildPasswordRecordFromRow(row map[string]any) (*passwordRecord, error) {
+	var dataJSON []byte
+	if val, ok := row[dbColumnPasswordData].(string); ok && val != "" {
+		dataJSON = []byte(val)
+	} else if val, ok := row[dbColumnPasswordData].([]byte); ok && len(val) > 0 {
+		dataJSON = val
+	} else {
+		return nil, errors.New("password_data is missing or of unexpected type")
+	}
+
+	var record passwordRecord
+	if err := json.Unmarshal(dataJSON, &record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal password history: %w", err)
+	}
+	return &record, nil
+}
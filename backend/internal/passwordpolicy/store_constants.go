@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import dbmodel "github.com/asgardeo/thunder/internal/system/database/model"
+
+// Database column names for password history storage.
+const (
+	dbColumnPasswordData = "password_data"
+)
+
+var queryUpsertPasswordHistory = dbmodel.DBQuery{
+	ID: "PPQ-PHS-01",
+	Query: `INSERT INTO "PASSWORD_HISTORY" (ENTITY_ID, DEPLOYMENT_ID, PASSWORD_DATA, UPDATED_AT) ` +
+		`VALUES ($1, $2, $3, $4) ON CONFLICT (ENTITY_ID, DEPLOYMENT_ID) ` +
+		`DO UPDATE SET PASSWORD_DATA = excluded.PASSWORD_DATA, UPDATED_AT = excluded.UPDATED_AT`,
+}
+
+var queryGetPasswordHistory = dbmodel.DBQuery{
+	ID:    "PPQ-PHS-02",
+	Query: `SELECT PASSWORD_DATA FROM "PASSWORD_HISTORY" WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2`,
+}
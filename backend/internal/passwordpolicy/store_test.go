@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
+	"github.com/asgardeo/thunder/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type StoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *passwordHistoryStore
+	ctx            context.Context
+	testRecord     passwordRecord
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (s *StoreTestSuite) SetupTest() {
+	s.mockDBProvider = providermock.NewDBProviderInterfaceMock(s.T())
+	s.mockDBClient = providermock.NewDBClientInterfaceMock(s.T())
+	s.store = &passwordHistoryStore{
+		dbProvider:   s.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+	s.testRecord = passwordRecord{
+		ChangedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		History: []hash.Credential{
+			{
+				Algorithm:  hash.PBKDF2,
+				Hash:       "hash",
+				Parameters: hash.CredParameters{Salt: "salt", Iterations: 600000, KeySize: 32},
+			},
+		},
+	}
+}
+
+func (s *StoreTestSuite) TestGetPasswordRecord_Success() {
+	data, _ := json.Marshal(s.testRecord)
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", s.ctx, queryGetPasswordHistory, testEntityID, testDeploymentID).
+		Return([]map[string]any{{dbColumnPasswordData: string(data)}}, nil)
+
+	record, err := s.store.GetPasswordRecord(s.ctx, testEntityID)
+
+	s.NoError(err)
+	s.Equal(&s.testRecord, record)
+}
+
+func (s *StoreTestSuite) TestGetPasswordRecord_ByteData() {
+	data, _ := json.Marshal(s.testRecord)
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", s.ctx, queryGetPasswordHistory, testEntityID, testDeploymentID).
+		Return([]map[string]any{{dbColumnPasswordData: data}}, nil)
+
+	record, err := s.store.GetPasswordRecord(s.ctx, testEntityID)
+
+	s.NoError(err)
+	s.Equal(&s.testRecord, record)
+}
+
+func (s *StoreTestSuite) TestGetPasswordRecord_NotFound() {
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", s.ctx, queryGetPasswordHistory, testEntityID, testDeploymentID).
+		Return([]map[string]any{}, nil)
+
+	record, err := s.store.GetPasswordRecord(s.ctx, testEntityID)
+
+	s.NoError(err)
+	s.Nil(record)
+}
+
+func (s *StoreTestSuite) TestGetPasswordRecord_InvalidData() {
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", s.ctx, queryGetPasswordHistory, testEntityID, testDeploymentID).
+		Return([]map[string]any{{dbColumnPasswordData: 42}}, nil)
+
+	_, err := s.store.GetPasswordRecord(s.ctx, testEntityID)
+
+	s.Error(err)
+}
+
+func (s *StoreTestSuite) TestGetPasswordRecord_DBClientError() {
+	s.mockDBProvider.On("GetUserDBClient").Return(nil, errors.New("db error"))
+
+	_, err := s.store.GetPasswordRecord(s.ctx, testEntityID)
+
+	s.Error(err)
+}
+
+func (s *StoreTestSuite) TestSavePasswordRecord_Success() {
+	data, _ := json.Marshal(s.testRecord)
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", s.ctx, queryUpsertPasswordHistory, testEntityID, testDeploymentID,
+		string(data), mock.AnythingOfType("time.Time")).Return(int64(1), nil)
+
+	s.NoError(s.store.SavePasswordRecord(s.ctx, testEntityID, s.testRecord))
+}
+
+func (s *StoreTestSuite) TestSavePasswordRecord_ExecuteError() {
+	s.mockDBProvider.On("GetUserDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", s.ctx, queryUpsertPasswordHistory, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).Return(int64(0), errors.New("db error"))
+
+	s.Error(s.store.SavePasswordRecord(s.ctx, testEntityID, s.testRecord))
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+)
+
+// checkComposition checks the length and character classes of the password against the policy.
+func checkComposition(policy *config.PasswordPolicyRule, password string) *serviceerror.ServiceError {
+	length := utf8.RuneCountInString(password)
+	if length < policy.MinLength {
+		return &ErrorPasswordTooShort
+	}
+	if policy.MaxLength > 0 && length > policy.MaxLength {
+		return &ErrorPasswordTooLong
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSpecial bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSpecial = true
+		}
+	}
+
+	switch {
+	case policy.RequireUppercase && !hasUpper:
+		return &ErrorMissingUppercase
+	case policy.RequireLowercase && !hasLower:
+		return &ErrorMissingLowercase
+	case policy.RequireDigit && !hasDigit:
+		return &ErrorMissingDigit
+	case policy.RequireSpecial && !hasSpecial:
+		return &ErrorMissingSpecial
+	}
+	return nil
+}
+
+// hasSelectors reports whether the policy is attached to user types or organization units.
+func hasSelectors(policy *config.PasswordPolicyRule) bool {
+	return len(policy.UserTypes) > 0 || len(policy.OrganizationUnits) > 0
+}
+
+// loadBreachedPasswords loads the breached password list from the given file. The file holds one
+// password per line; blank lines and lines starting with '#' are ignored. Passwords are matched
+// case-insensitively. Relative paths are resolved against the server home.
+func loadBreachedPasswords(filePath, serverHome string) (map[string]struct{}, error) {
+	passwords := make(map[string]struct{})
+	if filePath == "" {
+		return passwords, nil
+	}
+	if !filepath.IsAbs(filePath) {
+		filePath = filepath.Join(serverHome, filePath)
+	}
+
+	file, err := os.Open(filepath.Clean(filePath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open breached password file: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		passwords[strings.ToLower(line)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read breached password file: %w", err)
+	}
+	return passwords, nil
+}
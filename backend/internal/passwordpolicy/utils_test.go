@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type UtilsTestSuite struct {
+	suite.Suite
+}
+
+func TestUtilsTestSuite(t *testing.T) {
+	suite.Run(t, new(UtilsTestSuite))
+}
+
+func (s *UtilsTestSuite) TestLoadBreachedPasswords() {
+	dir := s.T().TempDir()
+	content := "# Common passwords\nPassword1\n\n  qwerty  \n"
+	s.Require().NoError(os.WriteFile(filepath.Join(dir, "breached.txt"), []byte(content), 0o600))
+
+	passwords, err := loadBreachedPasswords("breached.txt", dir)
+
+	s.NoError(err)
+	s.Len(passwords, 2)
+	s.Contains(passwords, "password1")
+	s.Contains(passwords, "qwerty")
+}
+
+func (s *UtilsTestSuite) TestLoadBreachedPasswords_NoFile() {
+	passwords, err := loadBreachedPasswords("", s.T().TempDir())
+
+	s.NoError(err)
+	s.Empty(passwords)
+}
+
+func (s *UtilsTestSuite) TestLoadBreachedPasswords_MissingFile() {
+	_, err := loadBreachedPasswords(filepath.Join(s.T().TempDir(), "missing.txt"), "")
+
+	s.Error(err)
+}
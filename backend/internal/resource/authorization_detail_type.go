@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resource
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+)
+
+// validateAuthorizationDetailTypes checks that the authorization details types of a resource server have
+// unique, non-empty type names and JSON schemas that can be resolved.
+func validateAuthorizationDetailTypes(detailTypes []AuthorizationDetailType) *serviceerror.ServiceError {
+	seen := make(map[string]struct{}, len(detailTypes))
+	for _, detailType := range detailTypes {
+		if detailType.Type == "" {
+			return &ErrorInvalidAuthorizationDetailType
+		}
+		if _, ok := seen[detailType.Type]; ok {
+			return &ErrorInvalidAuthorizationDetailType
+		}
+		seen[detailType.Type] = struct{}{}
+
+		if _, err := resolveAuthorizationDetailSchema(detailType.Schema); err != nil {
+			return &ErrorInvalidAuthorizationDetailType
+		}
+	}
+	return nil
+}
+
+// resolveAuthorizationDetailSchema parses and resolves the JSON schema of an authorization details type.
+// Remote references are not resolved, so a schema can only refer to its own definitions.
+func resolveAuthorizationDetailSchema(schema map[string]interface{}) (*jsonschema.Resolved, error) {
+	if len(schema) == 0 {
+		return nil, errors.New("authorization details type schema is empty")
+	}
+
+	encoded, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode authorization details type schema: %w", err)
+	}
+	var parsed jsonschema.Schema
+	if err := json.Unmarshal(encoded, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse authorization details type schema: %w", err)
+	}
+
+	return parsed.Resolve(nil)
+}
+
+// validateAuthorizationDetail validates an authorization details object against the schema of its type.
+func validateAuthorizationDetail(detailType *AuthorizationDetailType, detail map[string]interface{}) error {
+	resolved, err := resolveAuthorizationDetailSchema(detailType.Schema)
+	if err != nil {
+		return err
+	}
+
+	// Round-trip the object through JSON so that it only holds the value types the validator expects.
+	encoded, err := json.Marshal(detail)
+	if err != nil {
+		return fmt.Errorf("failed to encode authorization details: %w", err)
+	}
+	var instance map[string]interface{}
+	if err := json.Unmarshal(encoded, &instance); err != nil {
+		return fmt.Errorf("failed to decode authorization details: %w", err)
+	}
+
+	return resolved.Validate(instance)
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAuthorizationDetailTypes(t *testing.T) {
+	schema := map[string]interface{}{"type": "object"}
+	testCases := []struct {
+		name        string
+		detailTypes []AuthorizationDetailType
+		expectError bool
+	}{
+		{name: "NoTypes", detailTypes: nil},
+		{name: "ValidTypes", detailTypes: []AuthorizationDetailType{
+			{Type: "payment_initiation", Schema: schema},
+			{Type: "account_information", Schema: schema},
+		}},
+		{name: "MissingTypeName", detailTypes: []AuthorizationDetailType{{Schema: schema}}, expectError: true},
+		{name: "DuplicateTypeName", detailTypes: []AuthorizationDetailType{
+			{Type: "payment_initiation", Schema: schema},
+			{Type: "payment_initiation", Schema: schema},
+		}, expectError: true},
+		{name: "MissingSchema", detailTypes: []AuthorizationDetailType{{Type: "payment_initiation"}},
+			expectError: true},
+		{name: "InvalidSchema", detailTypes: []AuthorizationDetailType{
+			{Type: "payment_initiation", Schema: map[string]interface{}{"required": "amount"}},
+		}, expectError: true},
+		{name: "RemoteReference", detailTypes: []AuthorizationDetailType{
+			{Type: "payment_initiation", Schema: map[string]interface{}{"$ref": "https://example.com/schema.json"}},
+		}, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateAuthorizationDetailTypes(tc.detailTypes)
+			if tc.expectError {
+				assert.NotNil(t, err)
+				assert.Equal(t, ErrorInvalidAuthorizationDetailType.Code, err.Code)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateAuthorizationDetail(t *testing.T) {
+	detailType := &AuthorizationDetailType{
+		Type: "payment_initiation",
+		Schema: map[string]interface{}{
+			"type":     "object",
+			"required": []interface{}{"creditorName"},
+			"properties": map[string]interface{}{
+				"creditorName": map[string]interface{}{"type": "string"},
+			},
+		},
+	}
+
+	assert.NoError(t, validateAuthorizationDetail(detailType,
+		map[string]interface{}{"type": "payment_initiation", "creditorName": "Merchant A"}))
+	assert.Error(t, validateAuthorizationDetail(detailType,
+		map[string]interface{}{"type": "payment_initiation", "creditorName": 12}))
+	assert.Error(t, validateAuthorizationDetail(detailType,
+		map[string]interface{}{"type": "payment_initiation"}))
+}
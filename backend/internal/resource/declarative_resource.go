@@ -111,6 +111,8 @@ func (e *resourceServerExporter) GetResourceByID(ctx context.Context, id string)
 		OUID:        server.OUID,
 		Delimiter:   server.Delimiter,
 		Resources:   []Resource{},
+
+		AuthorizationDetailTypes: server.AuthorizationDetailTypes,
 	}
 
 	// Get all resources for this server
@@ -303,6 +305,10 @@ func parseToResourceServer(data []byte) (*ResourceServer, error) {
 	if rs.OUID == "" {
 		return nil, fmt.Errorf("resource server organization unit ID cannot be empty")
 	}
+	if svcErr := validateAuthorizationDetailTypes(rs.AuthorizationDetailTypes); svcErr != nil {
+		return nil, fmt.Errorf("resource server authorization details types are invalid: %s",
+			svcErr.ErrorDescription.DefaultValue)
+	}
 
 	return &rs, nil
 }
@@ -327,6 +327,61 @@ var (
 			DefaultValue: "Resource server handle cannot contain the delimiter character",
 		},
 	}
+	// ErrorInvalidAuthorizationDetailType is returned when an authorization details type definition is invalid.
+	ErrorInvalidAuthorizationDetailType = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "RES-1024",
+		Error: core.I18nMessage{
+			Key:          "error.resourceservice.invalid_authorization_detail_type",
+			DefaultValue: "Invalid authorization details type",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.resourceservice.invalid_authorization_detail_type_description",
+			DefaultValue: "Authorization details types must have a unique type name and a valid JSON schema",
+		},
+	}
+	// ErrorAuthorizationDetailTypeConflict is returned when another resource server defines the same
+	// authorization details type.
+	ErrorAuthorizationDetailTypeConflict = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "RES-1025",
+		Error: core.I18nMessage{
+			Key:          "error.resourceservice.authorization_detail_type_conflict",
+			DefaultValue: "Authorization details type conflict",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.resourceservice.authorization_detail_type_conflict_description",
+			DefaultValue: "An authorization details type with the same name is defined by another resource server",
+		},
+	}
+	// ErrorAuthorizationDetailTypeNotFound is returned when no resource server defines the authorization
+	// details type of an authorization details object.
+	ErrorAuthorizationDetailTypeNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "RES-1026",
+		Error: core.I18nMessage{
+			Key:          "error.resourceservice.authorization_detail_type_not_found",
+			DefaultValue: "Authorization details type not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.resourceservice.authorization_detail_type_not_found_description",
+			DefaultValue: "No resource server defines the authorization details type",
+		},
+	}
+	// ErrorInvalidAuthorizationDetail is returned when an authorization details object does not satisfy
+	// the schema of its type.
+	ErrorInvalidAuthorizationDetail = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "RES-1027",
+		Error: core.I18nMessage{
+			Key:          "error.resourceservice.invalid_authorization_detail",
+			DefaultValue: "Invalid authorization details",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.resourceservice.invalid_authorization_detail_description",
+			DefaultValue: "The authorization details object does not satisfy the schema of its type",
+		},
+	}
 )
 
 // Internal error constants.
@@ -79,6 +79,8 @@ func (h *resourceHandler) HandleResourceServerPostRequest(w http.ResponseWriter,
 		Identifier:  sanitized.Identifier,
 		OUID:        sanitized.OUID,
 		Delimiter:   sanitized.Delimiter,
+
+		AuthorizationDetailTypes: sanitized.AuthorizationDetailTypes,
 	}
 
 	result, svcErr := h.resourceService.CreateResourceServer(ctx, serviceReq)
@@ -122,6 +124,8 @@ func (h *resourceHandler) HandleResourceServerPutRequest(w http.ResponseWriter,
 		Handle:      sanitized.Handle,
 		Identifier:  sanitized.Identifier,
 		OUID:        sanitized.OUID,
+
+		AuthorizationDetailTypes: sanitized.AuthorizationDetailTypes,
 	}
 
 	result, svcErr := h.resourceService.UpdateResourceServer(ctx, id, serviceReq)
@@ -522,7 +526,8 @@ func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
 		switch svcErr.Code {
 		case ErrorResourceServerNotFound.Code, ErrorResourceNotFound.Code, ErrorActionNotFound.Code:
 			statusCode = http.StatusNotFound
-		case ErrorNameConflict.Code, ErrorHandleConflict.Code, ErrorIdentifierConflict.Code:
+		case ErrorNameConflict.Code, ErrorHandleConflict.Code, ErrorIdentifierConflict.Code,
+			ErrorAuthorizationDetailTypeConflict.Code:
 			statusCode = http.StatusConflict
 		default:
 			statusCode = http.StatusBadRequest
@@ -549,6 +554,8 @@ func sanitizeCreateResourceServerRequest(req *CreateResourceServerRequest) Creat
 		Identifier:  sysutils.SanitizeString(req.Identifier),
 		OUID:        sysutils.SanitizeString(req.OUID),
 		Delimiter:   sysutils.SanitizeString(req.Delimiter),
+
+		AuthorizationDetailTypes: sanitizeAuthorizationDetailTypes(req.AuthorizationDetailTypes),
 	}
 }
 
@@ -560,7 +567,26 @@ func sanitizeUpdateResourceServerRequest(req *UpdateResourceServerRequest) Updat
 		Handle:      sysutils.SanitizeString(req.Handle),
 		Identifier:  sysutils.SanitizeString(req.Identifier),
 		OUID:        sysutils.SanitizeString(req.OUID),
+
+		AuthorizationDetailTypes: sanitizeAuthorizationDetailTypes(req.AuthorizationDetailTypes),
+	}
+}
+
+// sanitizeAuthorizationDetailTypes sanitizes the names and descriptions of authorization details types.
+// Schemas are kept as provided since they are validated as JSON schema documents.
+func sanitizeAuthorizationDetailTypes(detailTypes []AuthorizationDetailType) []AuthorizationDetailType {
+	if detailTypes == nil {
+		return nil
+	}
+	sanitized := make([]AuthorizationDetailType, 0, len(detailTypes))
+	for _, detailType := range detailTypes {
+		sanitized = append(sanitized, AuthorizationDetailType{
+			Type:        sysutils.SanitizeString(detailType.Type),
+			Description: sysutils.SanitizeString(detailType.Description),
+			Schema:      detailType.Schema,
+		})
 	}
+	return sanitized
 }
 
 // sanitizeCreateResourceRequest sanitizes input for creating a resource.
@@ -618,6 +644,8 @@ func toResourceServerResponse(rs *ResourceServer) *ResourceServerResponse {
 		OUID:        rs.OUID,
 		Delimiter:   rs.Delimiter,
 		IsReadOnly:  rs.IsReadOnly,
+
+		AuthorizationDetailTypes: rs.AuthorizationDetailTypes,
 	}
 }
 
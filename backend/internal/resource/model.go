@@ -30,6 +30,8 @@ type ResourceServerResponse struct {
 	OUID        string `json:"ouId"`
 	Delimiter   string `json:"delimiter"`
 	IsReadOnly  bool   `json:"isReadOnly"`
+
+	AuthorizationDetailTypes []AuthorizationDetailType `json:"authorizationDetailTypes,omitempty"`
 }
 
 // ResourceResponse represents a resource.
@@ -92,6 +94,8 @@ type CreateResourceServerRequest struct {
 	Identifier  string `json:"identifier,omitempty"`
 	OUID        string `json:"ouId"`
 	Delimiter   string `json:"delimiter,omitempty"`
+
+	AuthorizationDetailTypes []AuthorizationDetailType `json:"authorizationDetailTypes,omitempty"`
 }
 
 // UpdateResourceServerRequest represents the request to update a resource server.
@@ -101,6 +105,8 @@ type UpdateResourceServerRequest struct {
 	Handle      string `json:"handle,omitempty"`
 	Identifier  string `json:"identifier,omitempty"`
 	OUID        string `json:"ouId"`
+
+	AuthorizationDetailTypes []AuthorizationDetailType `json:"authorizationDetailTypes,omitempty"`
 }
 
 // CreateResourceRequest represents the request to create a resource.
@@ -201,4 +207,26 @@ type ResourceServer struct {
 	Delimiter   string     `yaml:"delimiter,omitempty" json:"delimiter,omitempty" yamlfmt:"quoted"`
 	IsReadOnly  bool       `yaml:"-" json:"-"`
 	Resources   []Resource `yaml:"resources,omitempty" json:"resources,omitempty"`
+
+	AuthorizationDetailTypes []AuthorizationDetailType `yaml:"authorization_detail_types,omitempty" json:"authorizationDetailTypes,omitempty"` //nolint:lll
+}
+
+// AuthorizationDetailType represents a type of authorization details that a resource server accepts in
+// rich authorization requests (RFC 9396). Authorization details objects of the type must satisfy Schema,
+// a JSON schema document.
+type AuthorizationDetailType struct {
+	Type        string                 `yaml:"type" json:"type"`
+	Description string                 `yaml:"description,omitempty" json:"description,omitempty"`
+	Schema      map[string]interface{} `yaml:"schema" json:"schema"`
+}
+
+// GetAuthorizationDetailType returns the authorization details type definition with the given name, or nil
+// if the resource server does not define it.
+func (rs *ResourceServer) GetAuthorizationDetailType(detailType string) *AuthorizationDetailType {
+	for i := range rs.AuthorizationDetailTypes {
+		if rs.AuthorizationDetailTypes[i].Type == detailType {
+			return &rs.AuthorizationDetailTypes[i]
+		}
+	}
+	return nil
 }
@@ -100,6 +100,12 @@ type ResourceServiceInterface interface {
 	FindResourceServersByPermissions(
 		ctx context.Context, permissions []string,
 	) ([]ResourceServer, *serviceerror.ServiceError)
+
+	// ValidateAuthorizationDetail validates an authorization details object of a rich authorization
+	// request against the schema of its type, and returns the resource server that defines the type.
+	ValidateAuthorizationDetail(
+		ctx context.Context, detail map[string]interface{},
+	) (*ResourceServer, *serviceerror.ServiceError)
 }
 
 // resourceService is the default implementation of ResourceServiceInterface.
@@ -195,6 +201,11 @@ func (rs *resourceService) CreateResourceServer(
 		}
 	}
 
+	if svcErr := rs.checkAuthorizationDetailTypeConflicts(
+		ctx, "", resourceServer.AuthorizationDetailTypes); svcErr != nil {
+		return nil, svcErr
+	}
+
 	// Set default delimiter if not provided
 	if resourceServer.Delimiter == "" {
 		resourceServer.Delimiter = rs.defaultDelimiter
@@ -238,13 +249,14 @@ func (rs *resourceService) CreateResourceServer(
 		}
 
 		createdRS = &ResourceServer{
-			ID:          id,
-			Name:        resourceServer.Name,
-			Description: resourceServer.Description,
-			Handle:      resourceServer.Handle,
-			Identifier:  resourceServer.Identifier,
-			OUID:        resourceServer.OUID,
-			Delimiter:   resourceServer.Delimiter,
+			ID:                       id,
+			Name:                     resourceServer.Name,
+			Description:              resourceServer.Description,
+			Handle:                   resourceServer.Handle,
+			Identifier:               resourceServer.Identifier,
+			OUID:                     resourceServer.OUID,
+			Delimiter:                resourceServer.Delimiter,
+			AuthorizationDetailTypes: resourceServer.AuthorizationDetailTypes,
 		}
 		return nil
 	}); err != nil {
@@ -429,6 +441,11 @@ func (rs *resourceService) UpdateResourceServer(
 		}
 	}
 
+	if svcErr := rs.checkAuthorizationDetailTypeConflicts(
+		ctx, id, resourceServer.AuthorizationDetailTypes); svcErr != nil {
+		return nil, svcErr
+	}
+
 	// When handle changes, collect all resources and actions for permission recomputation
 	handleChanged := existingResServer.Handle != resourceServer.Handle
 	permData, svcErr2 := rs.collectPermissionData(ctx, id, handleChanged)
@@ -450,13 +467,14 @@ func (rs *resourceService) UpdateResourceServer(
 		}
 
 		updatedRS = &ResourceServer{
-			ID:          id,
-			Name:        resourceServer.Name,
-			Description: resourceServer.Description,
-			Handle:      resourceServer.Handle,
-			Identifier:  resourceServer.Identifier,
-			OUID:        resourceServer.OUID,
-			Delimiter:   resourceServer.Delimiter,
+			ID:                       id,
+			Name:                     resourceServer.Name,
+			Description:              resourceServer.Description,
+			Handle:                   resourceServer.Handle,
+			Identifier:               resourceServer.Identifier,
+			OUID:                     resourceServer.OUID,
+			Delimiter:                resourceServer.Delimiter,
+			AuthorizationDetailTypes: resourceServer.AuthorizationDetailTypes,
 		}
 		return nil
 	}); err != nil {
@@ -1314,6 +1332,77 @@ func (rs *resourceService) FindResourceServersByPermissions(
 	return resourceServers, nil
 }
 
+// ValidateAuthorizationDetail validates an authorization details object of a rich authorization request
+// against the schema of its type, and returns the resource server that defines the type.
+func (rs *resourceService) ValidateAuthorizationDetail(
+	ctx context.Context,
+	detail map[string]interface{},
+) (*ResourceServer, *serviceerror.ServiceError) {
+	detailType, ok := detail["type"].(string)
+	if !ok || detailType == "" {
+		return nil, &ErrorInvalidAuthorizationDetail
+	}
+
+	resourceServer, err := rs.findResourceServerByAuthorizationDetailType(ctx, detailType)
+	if err != nil {
+		rs.logger.Error("Failed to find resource server by authorization details type", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if resourceServer == nil {
+		rs.logger.Debug("Authorization details type not found", log.String("type", detailType))
+		return nil, &ErrorAuthorizationDetailTypeNotFound
+	}
+
+	if err := validateAuthorizationDetail(resourceServer.GetAuthorizationDetailType(detailType), detail); err != nil {
+		rs.logger.Debug("Authorization details do not satisfy the type schema",
+			log.String("type", detailType), log.Error(err))
+		return nil, &ErrorInvalidAuthorizationDetail
+	}
+
+	return resourceServer, nil
+}
+
+// findResourceServerByAuthorizationDetailType returns the resource server that defines the given
+// authorization details type, or nil if no resource server defines it.
+func (rs *resourceService) findResourceServerByAuthorizationDetailType(
+	ctx context.Context, detailType string,
+) (*ResourceServer, error) {
+	for offset := 0; ; offset += serverconst.MaxPageSize {
+		resourceServers, err := rs.resourceStore.GetResourceServerList(ctx, serverconst.MaxPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		for i := range resourceServers {
+			if resourceServers[i].GetAuthorizationDetailType(detailType) != nil {
+				return &resourceServers[i], nil
+			}
+		}
+		if len(resourceServers) < serverconst.MaxPageSize {
+			return nil, nil
+		}
+	}
+}
+
+// checkAuthorizationDetailTypeConflicts ensures that none of the authorization details types is already
+// defined by a resource server other than the one identified by resourceServerID, so that each type
+// resolves to a single resource server.
+func (rs *resourceService) checkAuthorizationDetailTypeConflicts(
+	ctx context.Context, resourceServerID string, detailTypes []AuthorizationDetailType,
+) *serviceerror.ServiceError {
+	for _, detailType := range detailTypes {
+		existing, err := rs.findResourceServerByAuthorizationDetailType(ctx, detailType.Type)
+		if err != nil {
+			rs.logger.Error("Failed to check authorization details type", log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+		if existing != nil && existing.ID != resourceServerID {
+			rs.logger.Debug("Authorization details type already exists", log.String("type", detailType.Type))
+			return &ErrorAuthorizationDetailTypeConflict
+		}
+	}
+	return nil
+}
+
 // Validation helper methods
 
 // validateAndGetResourceServer validates resource server exists and returns it.
@@ -1362,7 +1451,7 @@ func (rs *resourceService) validateResourceServerCreate(resourceServer ResourceS
 			return err
 		}
 	}
-	return nil
+	return validateAuthorizationDetailTypes(resourceServer.AuthorizationDetailTypes)
 }
 
 // validateResourceServerUpdate validates the input for updating a resource server.
@@ -1373,7 +1462,7 @@ func (rs *resourceService) validateResourceServerUpdate(resourceServer ResourceS
 	if resourceServer.OUID == "" {
 		return &ErrorInvalidRequestFormat
 	}
-	return nil
+	return validateAuthorizationDetailTypes(resourceServer.AuthorizationDetailTypes)
 }
 
 // validateResourceCreate validates the input for creating a resource.
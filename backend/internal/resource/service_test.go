@@ -4844,3 +4844,162 @@ func (suite *ResourceServiceTestSuite) TestUpdateResourceServer_IdentifierCheckS
 	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
 	suite.mockStore.AssertExpectations(suite.T())
 }
+
+// Authorization details type tests
+
+func paymentDetailType() AuthorizationDetailType {
+	return AuthorizationDetailType{
+		Type: "payment_initiation",
+		Schema: map[string]interface{}{
+			"type":     "object",
+			"required": []interface{}{"type", "instructedAmount"},
+			"properties": map[string]interface{}{
+				"type": map[string]interface{}{"const": "payment_initiation"},
+				"instructedAmount": map[string]interface{}{
+					"type":     "object",
+					"required": []interface{}{"currency", "amount"},
+					"properties": map[string]interface{}{
+						"currency": map[string]interface{}{"type": "string"},
+						"amount":   map[string]interface{}{"type": "string"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (suite *ResourceServiceTestSuite) TestCreateResourceServer_WithAuthorizationDetailTypes() {
+	rs := ResourceServer{
+		Name:                     "payments",
+		OUID:                     "ou-123",
+		AuthorizationDetailTypes: []AuthorizationDetailType{paymentDetailType()},
+	}
+
+	suite.mockOU.On("GetOrganizationUnit", mock.Anything, "ou-123").
+		Return(oupkg.OrganizationUnit{ID: "ou-123"}, nil)
+	suite.mockStore.On("CheckResourceServerNameExists", mock.Anything, "payments").Return(false, nil)
+	suite.mockStore.On("GetResourceServerList", mock.Anything, mock.Anything, 0).
+		Return([]ResourceServer{{ID: "rs-other", Name: "other"}}, nil)
+	suite.mockStore.On("CreateResourceServer", mock.Anything, mock.AnythingOfType("string"),
+		mock.MatchedBy(func(actual ResourceServer) bool {
+			return len(actual.AuthorizationDetailTypes) == 1
+		})).Return(nil)
+
+	result, err := suite.service.CreateResourceServer(context.Background(), rs)
+
+	suite.Nil(err)
+	suite.Require().NotNil(result)
+	suite.Equal(rs.AuthorizationDetailTypes, result.AuthorizationDetailTypes)
+}
+
+func (suite *ResourceServiceTestSuite) TestCreateResourceServer_AuthorizationDetailTypeConflict() {
+	rs := ResourceServer{
+		Name:                     "payments",
+		OUID:                     "ou-123",
+		AuthorizationDetailTypes: []AuthorizationDetailType{paymentDetailType()},
+	}
+
+	suite.mockOU.On("GetOrganizationUnit", mock.Anything, "ou-123").
+		Return(oupkg.OrganizationUnit{ID: "ou-123"}, nil)
+	suite.mockStore.On("CheckResourceServerNameExists", mock.Anything, "payments").Return(false, nil)
+	suite.mockStore.On("GetResourceServerList", mock.Anything, mock.Anything, 0).
+		Return([]ResourceServer{{
+			ID:                       "rs-other",
+			AuthorizationDetailTypes: []AuthorizationDetailType{paymentDetailType()},
+		}}, nil)
+
+	result, err := suite.service.CreateResourceServer(context.Background(), rs)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorAuthorizationDetailTypeConflict.Code, err.Code)
+	suite.mockStore.AssertNotCalled(suite.T(), "CreateResourceServer", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ResourceServiceTestSuite) TestCreateResourceServer_InvalidAuthorizationDetailType() {
+	rs := ResourceServer{
+		Name: "payments",
+		OUID: "ou-123",
+		AuthorizationDetailTypes: []AuthorizationDetailType{{
+			Type:   "payment_initiation",
+			Schema: map[string]interface{}{"type": 42},
+		}},
+	}
+
+	result, err := suite.service.CreateResourceServer(context.Background(), rs)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorInvalidAuthorizationDetailType.Code, err.Code)
+}
+
+func (suite *ResourceServiceTestSuite) TestValidateAuthorizationDetail_Success() {
+	paymentsRS := ResourceServer{
+		ID:                       "rs-payments",
+		Identifier:               "https://payments.example.com",
+		AuthorizationDetailTypes: []AuthorizationDetailType{paymentDetailType()},
+	}
+	suite.mockStore.On("GetResourceServerList", mock.Anything, mock.Anything, 0).
+		Return([]ResourceServer{{ID: "rs-other"}, paymentsRS}, nil)
+
+	detail := map[string]interface{}{
+		"type":             "payment_initiation",
+		"instructedAmount": map[string]interface{}{"currency": "EUR", "amount": "50.00"},
+	}
+	result, err := suite.service.ValidateAuthorizationDetail(context.Background(), detail)
+
+	suite.Nil(err)
+	suite.Require().NotNil(result)
+	suite.Equal("rs-payments", result.ID)
+}
+
+func (suite *ResourceServiceTestSuite) TestValidateAuthorizationDetail_SchemaViolation() {
+	suite.mockStore.On("GetResourceServerList", mock.Anything, mock.Anything, 0).
+		Return([]ResourceServer{{
+			ID:                       "rs-payments",
+			AuthorizationDetailTypes: []AuthorizationDetailType{paymentDetailType()},
+		}}, nil)
+
+	detail := map[string]interface{}{
+		"type":             "payment_initiation",
+		"instructedAmount": map[string]interface{}{"currency": "EUR"},
+	}
+	result, err := suite.service.ValidateAuthorizationDetail(context.Background(), detail)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorInvalidAuthorizationDetail.Code, err.Code)
+}
+
+func (suite *ResourceServiceTestSuite) TestValidateAuthorizationDetail_UnknownType() {
+	suite.mockStore.On("GetResourceServerList", mock.Anything, mock.Anything, 0).
+		Return([]ResourceServer{{ID: "rs-other"}}, nil)
+
+	result, err := suite.service.ValidateAuthorizationDetail(context.Background(),
+		map[string]interface{}{"type": "account_information"})
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorAuthorizationDetailTypeNotFound.Code, err.Code)
+}
+
+func (suite *ResourceServiceTestSuite) TestValidateAuthorizationDetail_MissingType() {
+	result, err := suite.service.ValidateAuthorizationDetail(context.Background(),
+		map[string]interface{}{"actions": []interface{}{"read"}})
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorInvalidAuthorizationDetail.Code, err.Code)
+}
+
+func (suite *ResourceServiceTestSuite) TestValidateAuthorizationDetail_StoreError() {
+	suite.mockStore.On("GetResourceServerList", mock.Anything, mock.Anything, 0).
+		Return(nil, errors.New("db error"))
+
+	result, err := suite.service.ValidateAuthorizationDetail(context.Background(),
+		map[string]interface{}{"type": "payment_initiation"})
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
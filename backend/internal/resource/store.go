@@ -86,7 +86,8 @@ type resourceStore struct {
 
 // resourceServerProperties represents the JSON structure of PROPERTIES column.
 type resourceServerProperties struct {
-	Delimiter string `json:"delimiter"`
+	Delimiter                string                    `json:"delimiter"`
+	AuthorizationDetailTypes []AuthorizationDetailType `json:"authorizationDetailTypes,omitempty"`
 }
 
 // newResourceStore creates a new instance of resourceStore.
@@ -979,6 +980,7 @@ func resolveProperties(row map[string]interface{}, rs *ResourceServer) {
 		if len(propsBytes) > 0 {
 			if err := json.Unmarshal(propsBytes, &props); err == nil {
 				rs.Delimiter = props.Delimiter
+				rs.AuthorizationDetailTypes = props.AuthorizationDetailTypes
 			}
 		}
 	}
@@ -986,7 +988,10 @@ func resolveProperties(row map[string]interface{}, rs *ResourceServer) {
 
 // buildPropertiesJSON builds the PROPERTIES JSON for a ResourceServer.
 func buildPropertiesJSON(rs ResourceServer) interface{} {
-	properties := resourceServerProperties{Delimiter: rs.Delimiter}
+	properties := resourceServerProperties{
+		Delimiter:                rs.Delimiter,
+		AuthorizationDetailTypes: rs.AuthorizationDetailTypes,
+	}
 	if propsJSON, err := json.Marshal(properties); err == nil {
 		return propsJSON
 	}
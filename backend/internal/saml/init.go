@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package saml provides centralized initialization for the SAML 2.0 identity provider services.
+package saml
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/attributecache"
+	"github.com/asgardeo/thunder/internal/flow/flowexec"
+	"github.com/asgardeo/thunder/internal/inboundclient"
+	"github.com/asgardeo/thunder/internal/saml/saml2/metadata"
+	"github.com/asgardeo/thunder/internal/saml/saml2/signer"
+	"github.com/asgardeo/thunder/internal/saml/saml2/slo"
+	"github.com/asgardeo/thunder/internal/saml/saml2/sso"
+	"github.com/asgardeo/thunder/internal/session"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/internal/system/kmprovider"
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/pkiservice"
+)
+
+// Initialize initializes the SAML 2.0 single sign-on, single logout and metadata services and
+// registers their routes.
+func Initialize(
+	mux *http.ServeMux,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	flowExecService flowexec.FlowExecServiceInterface,
+	jwtService jwt.JWTServiceInterface,
+	attributeCacheSvc attributecache.AttributeCacheServiceInterface,
+	sessionService session.SessionServiceInterface,
+	pkiService pkiservice.PKIServiceInterface,
+	cryptoProvider kmprovider.RuntimeCryptoProvider,
+) error {
+	samlSigner, err := signer.Initialize(pkiService, cryptoProvider)
+	if err != nil {
+		return fmt.Errorf("failed to initialize SAML signer: %w", err)
+	}
+
+	sso.Initialize(mux, inboundClient, flowExecService, jwtService, attributeCacheSvc, sessionService, samlSigner)
+	slo.Initialize(mux, inboundClient, sessionService, samlSigner)
+	metadata.Initialize(mux, samlSigner)
+	return nil
+}
@@ -0,0 +1,313 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package binding implements the SAML 2.0 HTTP-Redirect and HTTP-POST bindings.
+package binding
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/saml/saml2/signer"
+	sysconst "github.com/asgardeo/thunder/internal/system/constants"
+	"github.com/asgardeo/thunder/internal/system/xmldsig"
+)
+
+// maxMessageSize is the maximum size of a decoded SAML message in bytes.
+const maxMessageSize = 256 * 1024
+
+var (
+	// ErrMessageNotFound is returned when the request does not carry the expected SAML message.
+	ErrMessageNotFound = errors.New("SAML message not found")
+	// ErrInvalidEncoding is returned when a SAML message cannot be decoded.
+	ErrInvalidEncoding = errors.New("invalid SAML message encoding")
+	// ErrSignatureRequired is returned when a signed message is required but the request is unsigned.
+	ErrSignatureRequired = errors.New("SAML message signature required")
+)
+
+// Message is a SAML message received through either binding.
+type Message struct {
+	// Binding is the binding URI the message was received with.
+	Binding string
+	// Data is the decoded XML document.
+	Data       []byte
+	RelayState string
+	// RawQuery is the raw query of an HTTP-Redirect binding request, used to verify its signature.
+	RawQuery string
+}
+
+// IsRedirect reports whether the message was received with the HTTP-Redirect binding.
+func (m *Message) IsRedirect() bool {
+	return m.Binding == constants.BindingHTTPRedirect
+}
+
+// PostMessage is a SAML message delivered to a service provider with the HTTP-POST binding.
+type PostMessage struct {
+	Destination string `json:"destination"`
+	Param       string `json:"param"`
+	Data        []byte `json:"data"`
+	RelayState  string `json:"relayState,omitempty"`
+}
+
+// postFormTemplate renders an HTML form that posts a SAML message to the destination.
+var postFormTemplate = template.Must(template.New("samlPostForm").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Redirecting</title>
+</head>
+<body onload="document.forms[0].submit()">
+<form method="post" action="{{.Action}}">
+<input type="hidden" name="{{.Param}}" value="{{.Value}}">
+{{- if .RelayState}}
+<input type="hidden" name="RelayState" value="{{.RelayState}}">
+{{- end}}
+<noscript><button type="submit">Continue</button></noscript>
+</form>
+</body>
+</html>
+`))
+
+// postForm holds the values rendered in the HTTP-POST binding form.
+type postForm struct {
+	Action     string
+	Param      string
+	Value      string
+	RelayState string
+}
+
+// ReadMessage reads the SAML message carried in the given parameter of the request. GET requests are
+// decoded with the HTTP-Redirect binding and POST requests with the HTTP-POST binding.
+func ReadMessage(r *http.Request, param string) (*Message, error) {
+	if r.Method == http.MethodGet {
+		query := r.URL.Query()
+		encoded := query.Get(param)
+		if encoded == "" {
+			return nil, ErrMessageNotFound
+		}
+		data, err := DecodeRedirectMessage(encoded)
+		if err != nil {
+			return nil, err
+		}
+		return &Message{
+			Binding:    constants.BindingHTTPRedirect,
+			Data:       data,
+			RelayState: query.Get(constants.ParamRelayState),
+			RawQuery:   r.URL.RawQuery,
+		}, nil
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidEncoding, err)
+	}
+	encoded := r.PostForm.Get(param)
+	if encoded == "" {
+		return nil, ErrMessageNotFound
+	}
+	data, err := DecodePostMessage(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return &Message{
+		Binding:    constants.BindingHTTPPost,
+		Data:       data,
+		RelayState: r.PostForm.Get(constants.ParamRelayState),
+	}, nil
+}
+
+// DecodeRedirectMessage decodes a deflated and base64 encoded HTTP-Redirect binding message.
+func DecodeRedirectMessage(encoded string) ([]byte, error) {
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidEncoding, err)
+	}
+	reader := flate.NewReader(bytes.NewReader(compressed))
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	data, err := io.ReadAll(io.LimitReader(reader, maxMessageSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidEncoding, err)
+	}
+	if len(data) > maxMessageSize {
+		return nil, fmt.Errorf("%w: message too large", ErrInvalidEncoding)
+	}
+	return data, nil
+}
+
+// EncodeRedirectMessage deflates and base64 encodes a message for the HTTP-Redirect binding.
+func EncodeRedirectMessage(data []byte) (string, error) {
+	var buf bytes.Buffer
+	writer, err := flate.NewWriter(&buf, flate.DefaultCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := writer.Write(data); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// DecodePostMessage decodes a base64 encoded HTTP-POST binding message.
+func DecodePostMessage(encoded string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encoded), ""))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidEncoding, err)
+	}
+	if len(data) > maxMessageSize {
+		return nil, fmt.Errorf("%w: message too large", ErrInvalidEncoding)
+	}
+	return data, nil
+}
+
+// VerifyRedirectSignature verifies the signature of an HTTP-Redirect binding message against the
+// trusted certificates. The signature covers the message, RelayState and SigAlg parameters exactly as
+// they appear in the raw query.
+func VerifyRedirectSignature(rawQuery, param string, certs []*x509.Certificate) error {
+	rawValues := make(map[string]string)
+	for _, pair := range strings.Split(rawQuery, "&") {
+		key, value, _ := strings.Cut(pair, "=")
+		if _, exists := rawValues[key]; exists {
+			return fmt.Errorf("%w: duplicate %s parameter", xmldsig.ErrInvalidSignature, key)
+		}
+		rawValues[key] = value
+	}
+
+	rawSigAlg, hasSigAlg := rawValues[constants.ParamSigAlg]
+	rawSignature, hasSignature := rawValues[constants.ParamSignature]
+	if !hasSigAlg || !hasSignature {
+		return ErrSignatureRequired
+	}
+	sigAlg, err := url.QueryUnescape(rawSigAlg)
+	if err != nil {
+		return fmt.Errorf("%w: malformed SigAlg", xmldsig.ErrInvalidSignature)
+	}
+	encodedSignature, err := url.QueryUnescape(rawSignature)
+	if err != nil {
+		return fmt.Errorf("%w: malformed Signature", xmldsig.ErrInvalidSignature)
+	}
+	signature, err := base64.StdEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return fmt.Errorf("%w: malformed Signature", xmldsig.ErrInvalidSignature)
+	}
+
+	signed := param + "=" + rawValues[param]
+	if rawRelayState, ok := rawValues[constants.ParamRelayState]; ok {
+		signed += "&" + constants.ParamRelayState + "=" + rawRelayState
+	}
+	signed += "&" + constants.ParamSigAlg + "=" + rawSigAlg
+
+	if _, err := xmldsig.SignatureAlgorithm(sigAlg); err != nil {
+		return err
+	}
+	for _, cert := range certs {
+		if xmldsig.VerifyValue([]byte(signed), signature, sigAlg, cert) == nil {
+			return nil
+		}
+	}
+	return xmldsig.ErrInvalidSignature
+}
+
+// VerifySignature verifies the signature of a received message against the certificate of the
+// service provider. Redirect binding messages are verified over the query and POST binding messages
+// through the enveloped signature of the element. Unsigned messages are only accepted when a
+// signature is not required.
+func VerifySignature(msg *Message, param string, e *xmldsig.Element, cert *x509.Certificate, required bool) error {
+	var signed bool
+	if msg.IsRedirect() {
+		signed = strings.Contains("&"+msg.RawQuery, "&"+constants.ParamSignature+"=")
+	} else {
+		signed = xmldsig.IsSigned(e)
+	}
+	if !signed {
+		if required {
+			return ErrSignatureRequired
+		}
+		return nil
+	}
+	if cert == nil {
+		return fmt.Errorf("%w: no certificate to verify the signature", xmldsig.ErrInvalidSignature)
+	}
+
+	certs := []*x509.Certificate{cert}
+	if msg.IsRedirect() {
+		return VerifyRedirectSignature(msg.RawQuery, param, certs)
+	}
+	return xmldsig.VerifyEnveloped(e, certs)
+}
+
+// BuildRedirectURL builds the URL that delivers the message to the destination with the HTTP-Redirect
+// binding. The query is signed when a signer is given.
+func BuildRedirectURL(
+	ctx context.Context, destination, param string, data []byte, relayState string,
+	samlSigner signer.SAMLSignerInterface,
+) (string, error) {
+	encoded, err := EncodeRedirectMessage(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode the SAML message: %w", err)
+	}
+
+	query := param + "=" + url.QueryEscape(encoded)
+	if relayState != "" {
+		query += "&" + constants.ParamRelayState + "=" + url.QueryEscape(relayState)
+	}
+	if samlSigner != nil {
+		query += "&" + constants.ParamSigAlg + "=" + url.QueryEscape(samlSigner.GetSignatureAlgorithm())
+		signature, err := samlSigner.Sign(ctx, []byte(query))
+		if err != nil {
+			return "", fmt.Errorf("failed to sign the SAML message: %w", err)
+		}
+		query += "&" + constants.ParamSignature + "=" + url.QueryEscape(base64.StdEncoding.EncodeToString(signature))
+	}
+
+	separator := "?"
+	if strings.Contains(destination, "?") {
+		separator = "&"
+	}
+	return destination + separator + query, nil
+}
+
+// WritePostForm writes the page that delivers the message to its destination with the HTTP-POST
+// binding.
+func WritePostForm(w http.ResponseWriter, msg *PostMessage) error {
+	w.Header().Set(sysconst.ContentTypeHeaderName, "text/html; charset=utf-8")
+	w.Header().Set(sysconst.CacheControlHeaderName, sysconst.CacheControlNoCacheComposite)
+	w.Header().Set(sysconst.PragmaHeaderName, sysconst.PragmaNoCache)
+	w.WriteHeader(http.StatusOK)
+	return postFormTemplate.Execute(w, postForm{
+		Action:     msg.Destination,
+		Param:      msg.Param,
+		Value:      base64.StdEncoding.EncodeToString(msg.Data),
+		RelayState: msg.RelayState,
+	})
+}
@@ -0,0 +1,283 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package binding
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"errors"
+	"html"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/system/cryptolab"
+	"github.com/asgardeo/thunder/internal/system/xmldsig"
+	"github.com/asgardeo/thunder/tests/mocks/saml/saml2/signermock"
+)
+
+const testMessage = `<samlp:LogoutRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ` +
+	`xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_1" Version="2.0">` +
+	`<saml:Issuer>https://sp.example.com</saml:Issuer></samlp:LogoutRequest>`
+
+type BindingTestSuite struct {
+	suite.Suite
+	key  *rsa.PrivateKey
+	cert *x509.Certificate
+}
+
+func TestBindingTestSuite(t *testing.T) {
+	suite.Run(t, new(BindingTestSuite))
+}
+
+func (suite *BindingTestSuite) SetupSuite() {
+	var err error
+	suite.key, err = rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sp"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &suite.key.PublicKey, suite.key)
+	suite.Require().NoError(err)
+	suite.cert, err = x509.ParseCertificate(der)
+	suite.Require().NoError(err)
+}
+
+func (suite *BindingTestSuite) signFunc() xmldsig.SignFunc {
+	return func(content []byte) ([]byte, error) {
+		return cryptolab.Generate(content, cryptolab.RSASHA256, suite.key)
+	}
+}
+
+func (suite *BindingTestSuite) newSignerMock() *signermock.SAMLSignerInterfaceMock {
+	signerMock := signermock.NewSAMLSignerInterfaceMock(suite.T())
+	signerMock.On("GetSignatureAlgorithm").Return(xmldsig.AlgorithmRSASHA256)
+	signerMock.On("Sign", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, content []byte) ([]byte, error) {
+			return xmldsig.SignValue(content, xmldsig.AlgorithmRSASHA256, suite.signFunc(), suite.cert)
+		})
+	return signerMock
+}
+
+func (suite *BindingTestSuite) TestRedirectMessage_RoundTrip() {
+	encoded, err := EncodeRedirectMessage([]byte(testMessage))
+	suite.Require().NoError(err)
+
+	decoded, err := DecodeRedirectMessage(encoded)
+
+	suite.Require().NoError(err)
+	suite.Equal(testMessage, string(decoded))
+}
+
+func (suite *BindingTestSuite) TestDecodeRedirectMessage_Invalid() {
+	_, err := DecodeRedirectMessage("not base64!")
+	suite.ErrorIs(err, ErrInvalidEncoding)
+
+	_, err = DecodeRedirectMessage(base64.StdEncoding.EncodeToString([]byte("not deflated")))
+	suite.ErrorIs(err, ErrInvalidEncoding)
+}
+
+func (suite *BindingTestSuite) TestDecodeRedirectMessage_TooLarge() {
+	encoded, err := EncodeRedirectMessage([]byte(strings.Repeat("a", maxMessageSize+1)))
+	suite.Require().NoError(err)
+
+	_, err = DecodeRedirectMessage(encoded)
+
+	suite.ErrorIs(err, ErrInvalidEncoding)
+}
+
+func (suite *BindingTestSuite) TestDecodePostMessage_IgnoresLineBreaks() {
+	encoded := base64.StdEncoding.EncodeToString([]byte(testMessage))
+	wrapped := encoded[:20] + "\r\n" + encoded[20:]
+
+	decoded, err := DecodePostMessage(wrapped)
+
+	suite.Require().NoError(err)
+	suite.Equal(testMessage, string(decoded))
+}
+
+func (suite *BindingTestSuite) TestReadMessage_Redirect() {
+	encoded, err := EncodeRedirectMessage([]byte(testMessage))
+	suite.Require().NoError(err)
+	query := url.Values{constants.ParamSAMLRequest: {encoded}, constants.ParamRelayState: {"state"}}
+	req := httptest.NewRequest(http.MethodGet, constants.EndpointSSO+"?"+query.Encode(), nil)
+
+	msg, err := ReadMessage(req, constants.ParamSAMLRequest)
+
+	suite.Require().NoError(err)
+	suite.True(msg.IsRedirect())
+	suite.Equal(testMessage, string(msg.Data))
+	suite.Equal("state", msg.RelayState)
+	suite.Equal(req.URL.RawQuery, msg.RawQuery)
+}
+
+func (suite *BindingTestSuite) TestReadMessage_Post() {
+	form := url.Values{
+		constants.ParamSAMLRequest: {base64.StdEncoding.EncodeToString([]byte(testMessage))},
+		constants.ParamRelayState:  {"state"},
+	}
+	req := httptest.NewRequest(http.MethodPost, constants.EndpointSSO, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	msg, err := ReadMessage(req, constants.ParamSAMLRequest)
+
+	suite.Require().NoError(err)
+	suite.False(msg.IsRedirect())
+	suite.Equal(constants.BindingHTTPPost, msg.Binding)
+	suite.Equal(testMessage, string(msg.Data))
+	suite.Equal("state", msg.RelayState)
+}
+
+func (suite *BindingTestSuite) TestReadMessage_NotFound() {
+	req := httptest.NewRequest(http.MethodGet, constants.EndpointSSO, nil)
+	_, err := ReadMessage(req, constants.ParamSAMLRequest)
+	suite.ErrorIs(err, ErrMessageNotFound)
+
+	req = httptest.NewRequest(http.MethodPost, constants.EndpointSSO, strings.NewReader(""))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_, err = ReadMessage(req, constants.ParamSAMLRequest)
+	suite.ErrorIs(err, ErrMessageNotFound)
+}
+
+func (suite *BindingTestSuite) TestBuildRedirectURL_SignedRoundTrip() {
+	redirectURL, err := BuildRedirectURL(context.Background(), "https://sp.example.com/slo?tenant=a",
+		constants.ParamSAMLRequest, []byte(testMessage), "state", suite.newSignerMock())
+	suite.Require().NoError(err)
+
+	parsed, err := url.Parse(redirectURL)
+	suite.Require().NoError(err)
+	suite.Equal("a", parsed.Query().Get("tenant"))
+	decoded, err := DecodeRedirectMessage(parsed.Query().Get(constants.ParamSAMLRequest))
+	suite.Require().NoError(err)
+	suite.Equal(testMessage, string(decoded))
+	suite.Equal("state", parsed.Query().Get(constants.ParamRelayState))
+
+	rawQuery := redirectURL[strings.Index(redirectURL, "?")+1:]
+	rawQuery = strings.TrimPrefix(rawQuery, "tenant=a&")
+	suite.NoError(VerifyRedirectSignature(rawQuery, constants.ParamSAMLRequest, []*x509.Certificate{suite.cert}))
+}
+
+func (suite *BindingTestSuite) TestBuildRedirectURL_Unsigned() {
+	redirectURL, err := BuildRedirectURL(context.Background(), "https://sp.example.com/slo",
+		constants.ParamSAMLResponse, []byte(testMessage), "", nil)
+
+	suite.Require().NoError(err)
+	suite.True(strings.HasPrefix(redirectURL, "https://sp.example.com/slo?SAMLResponse="))
+	suite.NotContains(redirectURL, constants.ParamSigAlg)
+	suite.NotContains(redirectURL, constants.ParamRelayState)
+}
+
+func (suite *BindingTestSuite) TestBuildRedirectURL_SignFailure() {
+	signerMock := signermock.NewSAMLSignerInterfaceMock(suite.T())
+	signerMock.On("GetSignatureAlgorithm").Return(xmldsig.AlgorithmRSASHA256)
+	signerMock.On("Sign", mock.Anything, mock.Anything).Return(nil, errors.New("sign failed"))
+
+	_, err := BuildRedirectURL(context.Background(), "https://sp.example.com/slo",
+		constants.ParamSAMLRequest, []byte(testMessage), "", signerMock)
+
+	suite.Error(err)
+}
+
+func (suite *BindingTestSuite) signedRedirectQuery() string {
+	redirectURL, err := BuildRedirectURL(context.Background(), "https://idp.example.com/saml2/slo",
+		constants.ParamSAMLRequest, []byte(testMessage), "state", suite.newSignerMock())
+	suite.Require().NoError(err)
+	return redirectURL[strings.Index(redirectURL, "?")+1:]
+}
+
+func (suite *BindingTestSuite) TestVerifyRedirectSignature_Invalid() {
+	rawQuery := suite.signedRedirectQuery()
+	certs := []*x509.Certificate{suite.cert}
+
+	tampered := strings.Replace(rawQuery, "RelayState=state", "RelayState=other", 1)
+	suite.ErrorIs(VerifyRedirectSignature(tampered, constants.ParamSAMLRequest, certs), xmldsig.ErrInvalidSignature)
+
+	duplicated := rawQuery + "&RelayState=other"
+	suite.ErrorIs(VerifyRedirectSignature(duplicated, constants.ParamSAMLRequest, certs), xmldsig.ErrInvalidSignature)
+
+	unsigned := rawQuery[:strings.Index(rawQuery, "&SigAlg=")]
+	suite.ErrorIs(VerifyRedirectSignature(unsigned, constants.ParamSAMLRequest, certs), ErrSignatureRequired)
+}
+
+func (suite *BindingTestSuite) TestVerifySignature_Redirect() {
+	rawQuery := suite.signedRedirectQuery()
+	msg := &Message{Binding: constants.BindingHTTPRedirect, RawQuery: rawQuery}
+
+	suite.NoError(VerifySignature(msg, constants.ParamSAMLRequest, nil, suite.cert, true))
+	suite.ErrorIs(VerifySignature(msg, constants.ParamSAMLRequest, nil, nil, false), xmldsig.ErrInvalidSignature)
+}
+
+func (suite *BindingTestSuite) TestVerifySignature_Post() {
+	root, err := xmldsig.Parse([]byte(testMessage))
+	suite.Require().NoError(err)
+	suite.Require().NoError(xmldsig.SignEnveloped(root, 1, xmldsig.AlgorithmRSASHA256, suite.signFunc(), suite.cert))
+	signed, err := xmldsig.Parse(root.Bytes())
+	suite.Require().NoError(err)
+	msg := &Message{Binding: constants.BindingHTTPPost}
+
+	suite.NoError(VerifySignature(msg, constants.ParamSAMLRequest, signed, suite.cert, true))
+}
+
+func (suite *BindingTestSuite) TestVerifySignature_Unsigned() {
+	root, err := xmldsig.Parse([]byte(testMessage))
+	suite.Require().NoError(err)
+	postMsg := &Message{Binding: constants.BindingHTTPPost}
+	redirectMsg := &Message{Binding: constants.BindingHTTPRedirect, RawQuery: "SAMLRequest=abc"}
+
+	suite.NoError(VerifySignature(postMsg, constants.ParamSAMLRequest, root, nil, false))
+	suite.NoError(VerifySignature(redirectMsg, constants.ParamSAMLRequest, root, nil, false))
+	suite.ErrorIs(VerifySignature(postMsg, constants.ParamSAMLRequest, root, suite.cert, true), ErrSignatureRequired)
+	suite.ErrorIs(VerifySignature(redirectMsg, constants.ParamSAMLRequest, root, suite.cert, true),
+		ErrSignatureRequired)
+}
+
+func (suite *BindingTestSuite) TestWritePostForm() {
+	rr := httptest.NewRecorder()
+
+	err := WritePostForm(rr, &PostMessage{
+		Destination: "https://sp.example.com/acs",
+		Param:       constants.ParamSAMLResponse,
+		Data:        []byte(testMessage),
+		RelayState:  "<state>",
+	})
+
+	suite.Require().NoError(err)
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Equal("text/html; charset=utf-8", rr.Header().Get("Content-Type"))
+	suite.Contains(rr.Header().Get("Cache-Control"), "no-store")
+	body := html.UnescapeString(rr.Body.String())
+	suite.Contains(body, `action="https://sp.example.com/acs"`)
+	suite.Contains(body, `name="SAMLResponse" value="`+base64.StdEncoding.EncodeToString([]byte(testMessage))+`"`)
+	suite.Contains(rr.Body.String(), `name="RelayState" value="&lt;state&gt;"`)
+}
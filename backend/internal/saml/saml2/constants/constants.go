@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package constants defines constants used across the SAML 2.0 module.
+package constants
+
+// SAML 2.0 XML namespaces.
+const (
+	NamespaceProtocol  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	NamespaceAssertion = "urn:oasis:names:tc:SAML:2.0:assertion"
+	NamespaceMetadata  = "urn:oasis:names:tc:SAML:2.0:metadata"
+	NamespaceXMLDSig   = "http://www.w3.org/2000/09/xmldsig#"
+)
+
+// SAML 2.0 protocol bindings.
+const (
+	BindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	BindingHTTPPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+)
+
+// SAML 2.0 NameID formats.
+const (
+	NameIDFormatUnspecified  = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+	NameIDFormatEmailAddress = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	NameIDFormatPersistent   = "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"
+	NameIDFormatTransient    = "urn:oasis:names:tc:SAML:2.0:nameid-format:transient"
+)
+
+// SupportedNameIDFormats lists the NameID formats the identity provider can issue.
+var SupportedNameIDFormats = []string{
+	NameIDFormatUnspecified,
+	NameIDFormatEmailAddress,
+	NameIDFormatPersistent,
+	NameIDFormatTransient,
+}
+
+// SAML 2.0 status codes.
+const (
+	StatusSuccess             = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	StatusRequester           = "urn:oasis:names:tc:SAML:2.0:status:Requester"
+	StatusResponder           = "urn:oasis:names:tc:SAML:2.0:status:Responder"
+	StatusVersionMismatch     = "urn:oasis:names:tc:SAML:2.0:status:VersionMismatch"
+	StatusAuthnFailed         = "urn:oasis:names:tc:SAML:2.0:status:AuthnFailed"
+	StatusNoPassive           = "urn:oasis:names:tc:SAML:2.0:status:NoPassive"
+	StatusRequestDenied       = "urn:oasis:names:tc:SAML:2.0:status:RequestDenied"
+	StatusPartialLogout       = "urn:oasis:names:tc:SAML:2.0:status:PartialLogout"
+	StatusInvalidNameIDPolicy = "urn:oasis:names:tc:SAML:2.0:status:InvalidNameIDPolicy"
+)
+
+// Other SAML 2.0 identifiers.
+const (
+	Version                      = "2.0"
+	SubjectConfirmationBearer    = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	AttributeNameFormatBasic     = "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"
+	AuthnContextUnspecified      = "urn:oasis:names:tc:SAML:2.0:ac:classes:unspecified"
+	LogoutReasonUser             = "urn:oasis:names:tc:SAML:2.0:logout:user"
+	NameIDFormatEntity           = "urn:oasis:names:tc:SAML:2.0:nameid-format:entity"
+	DefaultAssertionValiditySecs = 300
+)
+
+// Identity provider endpoint paths.
+const (
+	EndpointSSO         = "/saml2/sso"
+	EndpointSSOCallback = "/saml2/sso/callback"
+	EndpointSSOResponse = "/saml2/sso/response"
+	EndpointSLO         = "/saml2/slo"
+	EndpointMetadata    = "/saml2/metadata"
+)
+
+// SAML 2.0 binding parameters.
+const (
+	ParamSAMLRequest  = "SAMLRequest"
+	ParamSAMLResponse = "SAMLResponse"
+	ParamRelayState   = "RelayState"
+	ParamSigAlg       = "SigAlg"
+	ParamSignature    = "Signature"
+)
+
+// Query parameters used when redirecting the user agent to the login page.
+const (
+	AuthID      = "authId"
+	AppID       = "appId"
+	ExecutionID = "executionId"
+)
@@ -0,0 +1,233 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package message
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/system/xmldsig"
+)
+
+// Namespace prefixes used in the generated messages.
+const (
+	prefixProtocol  = "samlp"
+	prefixAssertion = "saml"
+)
+
+// SignaturePosition is the child position of the enveloped signature in the generated messages, which
+// is directly after the Issuer element.
+const SignaturePosition = 1
+
+// idRandomBytes is the number of random bytes in a generated message ID.
+const idRandomBytes = 20
+
+// NewID generates a random message ID. The ID starts with an underscore so that it is a valid NCName.
+func NewID() (string, error) {
+	b := make([]byte, idRandomBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return "_" + hex.EncodeToString(b), nil
+}
+
+// SessionIndex derives the session index issued to service providers from the session ID, so that
+// the session cookie value is never disclosed in assertions.
+func SessionIndex(sessionID string) string {
+	sum := sha256.Sum256([]byte(sessionID))
+	return "_" + hex.EncodeToString(sum[:])
+}
+
+// FormatInstant formats the time as a SAML dateTime in UTC.
+func FormatInstant(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05Z")
+}
+
+// BuildAssertion builds an unsigned assertion for the authenticated subject.
+func BuildAssertion(params *AssertionParams) (*xmldsig.Element, error) {
+	id, err := NewID()
+	if err != nil {
+		return nil, err
+	}
+	notOnOrAfter := FormatInstant(params.IssueInstant.Add(time.Duration(params.ValiditySeconds) * time.Second))
+
+	assertion := xmldsig.NewElement(prefixAssertion, "Assertion")
+	assertion.DeclareNamespace(prefixAssertion, constants.NamespaceAssertion)
+	assertion.SetAttr("ID", id)
+	assertion.SetAttr("Version", constants.Version)
+	assertion.SetAttr("IssueInstant", FormatInstant(params.IssueInstant))
+	assertion.AddElement(prefixAssertion, "Issuer").AddText(params.Issuer)
+
+	subject := assertion.AddElement(prefixAssertion, "Subject")
+	nameID := subject.AddElement(prefixAssertion, "NameID")
+	nameID.SetAttr("Format", params.NameIDFormat)
+	nameID.AddText(params.NameID)
+	confirmation := subject.AddElement(prefixAssertion, "SubjectConfirmation")
+	confirmation.SetAttr("Method", constants.SubjectConfirmationBearer)
+	confirmationData := confirmation.AddElement(prefixAssertion, "SubjectConfirmationData")
+	if params.InResponseTo != "" {
+		confirmationData.SetAttr("InResponseTo", params.InResponseTo)
+	}
+	confirmationData.SetAttr("NotOnOrAfter", notOnOrAfter)
+	confirmationData.SetAttr("Recipient", params.Recipient)
+
+	conditions := assertion.AddElement(prefixAssertion, "Conditions")
+	conditions.SetAttr("NotBefore", FormatInstant(params.IssueInstant))
+	conditions.SetAttr("NotOnOrAfter", notOnOrAfter)
+	restriction := conditions.AddElement(prefixAssertion, "AudienceRestriction")
+	for _, audience := range params.Audiences {
+		restriction.AddElement(prefixAssertion, "Audience").AddText(audience)
+	}
+
+	authnStatement := assertion.AddElement(prefixAssertion, "AuthnStatement")
+	authnStatement.SetAttr("AuthnInstant", FormatInstant(params.AuthnInstant))
+	if params.SessionIndex != "" {
+		authnStatement.SetAttr("SessionIndex", params.SessionIndex)
+	}
+	classRef := params.AuthnContextClassRef
+	if classRef == "" {
+		classRef = constants.AuthnContextUnspecified
+	}
+	authnStatement.AddElement(prefixAssertion, "AuthnContext").
+		AddElement(prefixAssertion, "AuthnContextClassRef").AddText(classRef)
+
+	if len(params.Attributes) > 0 {
+		attributeStatement := assertion.AddElement(prefixAssertion, "AttributeStatement")
+		names := make([]string, 0, len(params.Attributes))
+		for name := range params.Attributes {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		for _, name := range names {
+			attribute := attributeStatement.AddElement(prefixAssertion, "Attribute")
+			attribute.SetAttr("Name", name)
+			attribute.SetAttr("NameFormat", constants.AttributeNameFormatBasic)
+			for _, value := range params.Attributes[name] {
+				attribute.AddElement(prefixAssertion, "AttributeValue").AddText(value)
+			}
+		}
+	}
+
+	return assertion, nil
+}
+
+// BuildResponse builds an unsigned Response carrying the assertion, if any.
+func BuildResponse(params *ResponseParams, assertion *xmldsig.Element) (*xmldsig.Element, error) {
+	response, err := newStatusResponse("Response", params)
+	if err != nil {
+		return nil, err
+	}
+	if assertion != nil {
+		response.AddChild(assertion)
+	}
+	return response, nil
+}
+
+// BuildLogoutResponse builds an unsigned LogoutResponse.
+func BuildLogoutResponse(params *ResponseParams) (*xmldsig.Element, error) {
+	return newStatusResponse("LogoutResponse", params)
+}
+
+// BuildLogoutRequest builds an unsigned LogoutRequest for the subject of a session.
+func BuildLogoutRequest(params *LogoutRequestParams) (*xmldsig.Element, error) {
+	request, err := newProtocolMessage("LogoutRequest", params.Issuer, params.Destination, params.IssueInstant)
+	if err != nil {
+		return nil, err
+	}
+	request.SetAttr("Reason", constants.LogoutReasonUser)
+
+	nameID := request.AddElement(prefixAssertion, "NameID")
+	if params.NameIDFormat != "" {
+		nameID.SetAttr("Format", params.NameIDFormat)
+	}
+	nameID.AddText(params.NameID)
+	if params.SessionIndex != "" {
+		request.AddElement(prefixProtocol, "SessionIndex").AddText(params.SessionIndex)
+	}
+	return request, nil
+}
+
+// BuildAuthnRequest builds an unsigned AuthnRequest asking for the response to be delivered with the
+// HTTP-POST binding.
+func BuildAuthnRequest(params *AuthnRequestParams) (*xmldsig.Element, error) {
+	request, err := newProtocolMessage("AuthnRequest", params.Issuer, params.Destination, params.IssueInstant)
+	if err != nil {
+		return nil, err
+	}
+	request.SetAttr("AssertionConsumerServiceURL", params.AssertionConsumerServiceURL)
+	request.SetAttr("ProtocolBinding", constants.BindingHTTPPost)
+
+	policy := request.AddElement(prefixProtocol, "NameIDPolicy")
+	if params.NameIDFormat != "" {
+		policy.SetAttr("Format", params.NameIDFormat)
+	}
+	policy.SetAttr("AllowCreate", "true")
+	return request, nil
+}
+
+// newStatusResponse builds a status response of the given type.
+func newStatusResponse(local string, params *ResponseParams) (*xmldsig.Element, error) {
+	response, err := newProtocolMessage(local, params.Issuer, params.Destination, params.IssueInstant)
+	if err != nil {
+		return nil, err
+	}
+	if params.InResponseTo != "" {
+		response.SetAttr("InResponseTo", params.InResponseTo)
+	}
+
+	statusCode := params.StatusCode
+	if statusCode == "" {
+		statusCode = constants.StatusSuccess
+	}
+	status := response.AddElement(prefixProtocol, "Status")
+	code := status.AddElement(prefixProtocol, "StatusCode")
+	code.SetAttr("Value", statusCode)
+	if params.SubStatusCode != "" {
+		code.AddElement(prefixProtocol, "StatusCode").SetAttr("Value", params.SubStatusCode)
+	}
+	if params.StatusMessage != "" {
+		status.AddElement(prefixProtocol, "StatusMessage").AddText(params.StatusMessage)
+	}
+	return response, nil
+}
+
+// newProtocolMessage builds the root element of a protocol message along with its Issuer.
+func newProtocolMessage(local, issuer, destination string, issueInstant time.Time) (*xmldsig.Element, error) {
+	id, err := NewID()
+	if err != nil {
+		return nil, err
+	}
+
+	root := xmldsig.NewElement(prefixProtocol, local)
+	root.DeclareNamespace(prefixProtocol, constants.NamespaceProtocol)
+	root.DeclareNamespace(prefixAssertion, constants.NamespaceAssertion)
+	root.SetAttr("ID", id)
+	root.SetAttr("Version", constants.Version)
+	root.SetAttr("IssueInstant", FormatInstant(issueInstant))
+	if destination != "" {
+		root.SetAttr("Destination", destination)
+	}
+	root.AddElement(prefixAssertion, "Issuer").AddText(issuer)
+	return root, nil
+}
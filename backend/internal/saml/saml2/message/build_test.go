@@ -0,0 +1,225 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package message
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/system/xmldsig"
+)
+
+type BuildTestSuite struct {
+	suite.Suite
+	issueInstant time.Time
+}
+
+func TestBuildTestSuite(t *testing.T) {
+	suite.Run(t, new(BuildTestSuite))
+}
+
+func (suite *BuildTestSuite) SetupTest() {
+	suite.issueInstant = time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+}
+
+func (suite *BuildTestSuite) TestNewID() {
+	id1, err := NewID()
+	suite.Require().NoError(err)
+	id2, err := NewID()
+	suite.Require().NoError(err)
+
+	suite.True(strings.HasPrefix(id1, "_"))
+	suite.Len(id1, 41)
+	suite.NotEqual(id1, id2)
+}
+
+func (suite *BuildTestSuite) TestSessionIndex() {
+	index := SessionIndex("session-1")
+
+	suite.Equal(index, SessionIndex("session-1"))
+	suite.NotEqual(index, SessionIndex("session-2"))
+	suite.NotContains(index, "session-1")
+	suite.True(strings.HasPrefix(index, "_"))
+}
+
+func (suite *BuildTestSuite) TestBuildAssertion() {
+	assertion, err := BuildAssertion(&AssertionParams{
+		Issuer:       "https://idp.example.com",
+		Recipient:    "https://sp.example.com/acs",
+		InResponseTo: "_req1",
+		Audiences:    []string{"https://sp.example.com"},
+		NameID:       "alice@example.com",
+		NameIDFormat: constants.NameIDFormatEmailAddress,
+		SessionIndex: "_index",
+		AuthnInstant: suite.issueInstant.Add(-time.Minute),
+		Attributes: map[string][]string{
+			"groups": {"admin", "dev"},
+			"email":  {"alice@example.com"},
+		},
+		IssueInstant:    suite.issueInstant,
+		ValiditySeconds: 300,
+	})
+	suite.Require().NoError(err)
+
+	root, err := xmldsig.Parse(assertion.Bytes())
+	suite.Require().NoError(err)
+	suite.True(root.Is(constants.NamespaceAssertion, "Assertion"))
+	suite.Equal("2026-01-01T10:00:00Z", root.AttrValue("IssueInstant"))
+
+	subject := root.FindChild(constants.NamespaceAssertion, "Subject")
+	suite.Require().NotNil(subject)
+	nameID := subject.FindChild(constants.NamespaceAssertion, "NameID")
+	suite.Equal("alice@example.com", nameID.Text())
+	suite.Equal(constants.NameIDFormatEmailAddress, nameID.AttrValue("Format"))
+	confirmationData := subject.FindChild(constants.NamespaceAssertion, "SubjectConfirmation").
+		FindChild(constants.NamespaceAssertion, "SubjectConfirmationData")
+	suite.Equal("_req1", confirmationData.AttrValue("InResponseTo"))
+	suite.Equal("https://sp.example.com/acs", confirmationData.AttrValue("Recipient"))
+	suite.Equal("2026-01-01T10:05:00Z", confirmationData.AttrValue("NotOnOrAfter"))
+
+	conditions := root.FindChild(constants.NamespaceAssertion, "Conditions")
+	suite.Equal("2026-01-01T10:00:00Z", conditions.AttrValue("NotBefore"))
+	audience := conditions.FindChild(constants.NamespaceAssertion, "AudienceRestriction").
+		FindChild(constants.NamespaceAssertion, "Audience")
+	suite.Equal("https://sp.example.com", audience.Text())
+
+	authnStatement := root.FindChild(constants.NamespaceAssertion, "AuthnStatement")
+	suite.Equal("_index", authnStatement.AttrValue("SessionIndex"))
+	suite.Equal("2026-01-01T09:59:00Z", authnStatement.AttrValue("AuthnInstant"))
+	classRef := authnStatement.FindChild(constants.NamespaceAssertion, "AuthnContext").
+		FindChild(constants.NamespaceAssertion, "AuthnContextClassRef")
+	suite.Equal(constants.AuthnContextUnspecified, classRef.Text())
+
+	attributes := root.FindChild(constants.NamespaceAssertion, "AttributeStatement").
+		FindChildren(constants.NamespaceAssertion, "Attribute")
+	suite.Require().Len(attributes, 2)
+	suite.Equal("email", attributes[0].AttrValue("Name"))
+	suite.Equal("groups", attributes[1].AttrValue("Name"))
+	values := attributes[1].FindChildren(constants.NamespaceAssertion, "AttributeValue")
+	suite.Require().Len(values, 2)
+	suite.Equal("admin", values[0].Text())
+	suite.Equal("dev", values[1].Text())
+}
+
+func (suite *BuildTestSuite) TestBuildAssertion_WithoutAttributes() {
+	assertion, err := BuildAssertion(&AssertionParams{
+		Issuer:               "https://idp.example.com",
+		NameID:               "user-1",
+		AuthnContextClassRef: "urn:example:acr",
+		IssueInstant:         suite.issueInstant,
+	})
+	suite.Require().NoError(err)
+
+	suite.Nil(assertion.FindChild(constants.NamespaceAssertion, "AttributeStatement"))
+	classRef := assertion.FindChild(constants.NamespaceAssertion, "AuthnStatement").
+		FindChild(constants.NamespaceAssertion, "AuthnContext").
+		FindChild(constants.NamespaceAssertion, "AuthnContextClassRef")
+	suite.Equal("urn:example:acr", classRef.Text())
+}
+
+func (suite *BuildTestSuite) TestBuildResponse() {
+	assertion, err := BuildAssertion(&AssertionParams{Issuer: "https://idp.example.com", NameID: "user-1"})
+	suite.Require().NoError(err)
+
+	response, err := BuildResponse(&ResponseParams{
+		Issuer:       "https://idp.example.com",
+		Destination:  "https://sp.example.com/acs",
+		InResponseTo: "_req1",
+		IssueInstant: suite.issueInstant,
+	}, assertion)
+	suite.Require().NoError(err)
+
+	suite.True(response.Is(constants.NamespaceProtocol, "Response"))
+	suite.Equal("https://sp.example.com/acs", response.AttrValue("Destination"))
+	suite.Equal("_req1", response.AttrValue("InResponseTo"))
+	suite.Equal(constants.Version, response.AttrValue("Version"))
+	issuer := response.ChildElements()[SignaturePosition-1]
+	suite.True(issuer.Is(constants.NamespaceAssertion, "Issuer"))
+	code := response.FindChild(constants.NamespaceProtocol, "Status").
+		FindChild(constants.NamespaceProtocol, "StatusCode")
+	suite.Equal(constants.StatusSuccess, code.AttrValue("Value"))
+	suite.NotNil(response.FindChild(constants.NamespaceAssertion, "Assertion"))
+}
+
+func (suite *BuildTestSuite) TestBuildResponse_ErrorStatus() {
+	response, err := BuildResponse(&ResponseParams{
+		Issuer:        "https://idp.example.com",
+		StatusCode:    constants.StatusResponder,
+		SubStatusCode: constants.StatusNoPassive,
+		StatusMessage: "Passive authentication is not possible",
+	}, nil)
+	suite.Require().NoError(err)
+
+	status := response.FindChild(constants.NamespaceProtocol, "Status")
+	code := status.FindChild(constants.NamespaceProtocol, "StatusCode")
+	suite.Equal(constants.StatusResponder, code.AttrValue("Value"))
+	suite.Equal(constants.StatusNoPassive,
+		code.FindChild(constants.NamespaceProtocol, "StatusCode").AttrValue("Value"))
+	suite.Equal("Passive authentication is not possible",
+		status.FindChild(constants.NamespaceProtocol, "StatusMessage").Text())
+	suite.Nil(response.FindChild(constants.NamespaceAssertion, "Assertion"))
+	suite.Empty(response.AttrValue("InResponseTo"))
+}
+
+func (suite *BuildTestSuite) TestBuildLogoutRequest() {
+	request, err := BuildLogoutRequest(&LogoutRequestParams{
+		Issuer:      "https://idp.example.com",
+		Destination: "https://sp.example.com/slo",
+		NameID:      "user-1",
+	})
+	suite.Require().NoError(err)
+
+	suite.Equal(constants.LogoutReasonUser, request.AttrValue("Reason"))
+	suite.Empty(request.FindChild(constants.NamespaceAssertion, "NameID").AttrValue("Format"))
+	suite.Nil(request.FindChild(constants.NamespaceProtocol, "SessionIndex"))
+}
+
+func (suite *BuildTestSuite) TestBuildAuthnRequest() {
+	request, err := BuildAuthnRequest(&AuthnRequestParams{
+		Issuer:                      "https://sp.example.com",
+		Destination:                 "https://idp.example.com/sso",
+		AssertionConsumerServiceURL: "https://sp.example.com/acs",
+		NameIDFormat:                constants.NameIDFormatEmailAddress,
+		IssueInstant:                suite.issueInstant,
+	})
+	suite.Require().NoError(err)
+
+	parsed, err := ParseAuthnRequest(request.Bytes())
+	suite.Require().NoError(err)
+	suite.Equal("https://sp.example.com", parsed.Issuer)
+	suite.Equal("https://idp.example.com/sso", parsed.Destination)
+	suite.Equal("https://sp.example.com/acs", parsed.AssertionConsumerServiceURL)
+	suite.Equal(constants.BindingHTTPPost, parsed.ProtocolBinding)
+	suite.Equal(constants.NameIDFormatEmailAddress, parsed.NameIDPolicyFormat)
+	suite.Equal("2026-01-01T10:00:00Z", parsed.IssueInstant)
+}
+
+func (suite *BuildTestSuite) TestBuildAuthnRequest_WithoutNameIDFormat() {
+	request, err := BuildAuthnRequest(&AuthnRequestParams{Issuer: "https://sp.example.com"})
+	suite.Require().NoError(err)
+
+	policy := request.FindChild(constants.NamespaceProtocol, "NameIDPolicy")
+	suite.Require().NotNil(policy)
+	suite.Empty(policy.AttrValue("Format"))
+	suite.Equal("true", policy.AttrValue("AllowCreate"))
+}
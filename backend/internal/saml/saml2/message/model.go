@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package message
+
+import (
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/xmldsig"
+)
+
+// AuthnRequest is a parsed SAML 2.0 AuthnRequest.
+type AuthnRequest struct {
+	ID                          string
+	IssueInstant                string
+	Destination                 string
+	Issuer                      string
+	AssertionConsumerServiceURL string
+	ProtocolBinding             string
+	NameIDPolicyFormat          string
+	ForceAuthn                  bool
+	IsPassive                   bool
+	// Element is the parsed request element, retained to verify its enveloped signature.
+	Element *xmldsig.Element
+}
+
+// LogoutRequest is a parsed SAML 2.0 LogoutRequest.
+type LogoutRequest struct {
+	ID             string
+	IssueInstant   string
+	Destination    string
+	Issuer         string
+	NameID         string
+	SessionIndexes []string
+	// Element is the parsed request element, retained to verify its enveloped signature.
+	Element *xmldsig.Element
+}
+
+// LogoutResponse is a parsed SAML 2.0 LogoutResponse.
+type LogoutResponse struct {
+	ID           string
+	InResponseTo string
+	Issuer       string
+	StatusCode   string
+	// Element is the parsed response element, retained to verify its enveloped signature.
+	Element *xmldsig.Element
+}
+
+// Response is a parsed SAML 2.0 Response received from an identity provider.
+type Response struct {
+	ID           string
+	InResponseTo string
+	Destination  string
+	Issuer       string
+	StatusCode   string
+	// SubStatusCode is the optional second-level status code.
+	SubStatusCode string
+	StatusMessage string
+	// Assertions are the plain assertions carried in the response. Encrypted assertions are not
+	// supported.
+	Assertions []*Assertion
+	// Element is the parsed response element, retained to verify its enveloped signature.
+	Element *xmldsig.Element
+}
+
+// Assertion is a parsed SAML 2.0 Assertion.
+type Assertion struct {
+	ID           string
+	Issuer       string
+	NameID       string
+	NameIDFormat string
+	// SubjectConfirmations holds the data of the bearer subject confirmations of the subject.
+	SubjectConfirmations []SubjectConfirmationData
+	NotBefore            string
+	NotOnOrAfter         string
+	// Audiences holds the audiences of each audience restriction of the conditions.
+	Audiences    [][]string
+	SessionIndex string
+	Attributes   map[string][]string
+	// Element is the parsed assertion element, retained to verify its enveloped signature.
+	Element *xmldsig.Element
+}
+
+// SubjectConfirmationData holds the values of a bearer subject confirmation.
+type SubjectConfirmationData struct {
+	Recipient    string
+	InResponseTo string
+	NotOnOrAfter string
+}
+
+// AssertionParams holds the values of an assertion issued to a service provider.
+type AssertionParams struct {
+	Issuer       string
+	Recipient    string
+	InResponseTo string
+	Audiences    []string
+	NameID       string
+	NameIDFormat string
+	SessionIndex string
+	AuthnInstant time.Time
+	// AuthnContextClassRef defaults to the unspecified authentication context class.
+	AuthnContextClassRef string
+	Attributes           map[string][]string
+	IssueInstant         time.Time
+	ValiditySeconds      int64
+}
+
+// AuthnRequestParams holds the values of an AuthnRequest sent to an identity provider.
+type AuthnRequestParams struct {
+	Issuer                      string
+	Destination                 string
+	AssertionConsumerServiceURL string
+	// NameIDFormat is the optional format requested in the NameIDPolicy.
+	NameIDFormat string
+	IssueInstant time.Time
+}
+
+// ResponseParams holds the values of a Response sent to a service provider.
+type ResponseParams struct {
+	Issuer       string
+	Destination  string
+	InResponseTo string
+	StatusCode   string
+	// SubStatusCode is the optional second-level status code.
+	SubStatusCode string
+	StatusMessage string
+	IssueInstant  time.Time
+}
+
+// LogoutRequestParams holds the values of a LogoutRequest sent to a service provider.
+type LogoutRequestParams struct {
+	Issuer       string
+	Destination  string
+	NameID       string
+	NameIDFormat string
+	SessionIndex string
+	IssueInstant time.Time
+}
@@ -0,0 +1,244 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package message parses and builds SAML 2.0 protocol messages.
+package message
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/system/xmldsig"
+)
+
+// ErrInvalidMessage is returned when a SAML message is malformed or not of the expected type.
+var ErrInvalidMessage = errors.New("invalid SAML message")
+
+// ParseAuthnRequest parses a SAML 2.0 AuthnRequest document.
+func ParseAuthnRequest(data []byte) (*AuthnRequest, error) {
+	root, err := parseProtocolMessage(data, "AuthnRequest")
+	if err != nil {
+		return nil, err
+	}
+
+	request := &AuthnRequest{
+		ID:                          root.AttrValue("ID"),
+		IssueInstant:                root.AttrValue("IssueInstant"),
+		Destination:                 root.AttrValue("Destination"),
+		Issuer:                      getIssuer(root),
+		AssertionConsumerServiceURL: root.AttrValue("AssertionConsumerServiceURL"),
+		ProtocolBinding:             root.AttrValue("ProtocolBinding"),
+		ForceAuthn:                  isTrue(root.AttrValue("ForceAuthn")),
+		IsPassive:                   isTrue(root.AttrValue("IsPassive")),
+		Element:                     root,
+	}
+	if policy := root.FindChild(constants.NamespaceProtocol, "NameIDPolicy"); policy != nil {
+		request.NameIDPolicyFormat = policy.AttrValue("Format")
+	}
+	if request.Issuer == "" {
+		return nil, fmt.Errorf("%w: missing Issuer", ErrInvalidMessage)
+	}
+	return request, nil
+}
+
+// ParseLogoutRequest parses a SAML 2.0 LogoutRequest document.
+func ParseLogoutRequest(data []byte) (*LogoutRequest, error) {
+	root, err := parseProtocolMessage(data, "LogoutRequest")
+	if err != nil {
+		return nil, err
+	}
+
+	request := &LogoutRequest{
+		ID:           root.AttrValue("ID"),
+		IssueInstant: root.AttrValue("IssueInstant"),
+		Destination:  root.AttrValue("Destination"),
+		Issuer:       getIssuer(root),
+		Element:      root,
+	}
+	if nameID := root.FindChild(constants.NamespaceAssertion, "NameID"); nameID != nil {
+		request.NameID = strings.TrimSpace(nameID.Text())
+	}
+	for _, index := range root.FindChildren(constants.NamespaceProtocol, "SessionIndex") {
+		request.SessionIndexes = append(request.SessionIndexes, strings.TrimSpace(index.Text()))
+	}
+	if request.Issuer == "" {
+		return nil, fmt.Errorf("%w: missing Issuer", ErrInvalidMessage)
+	}
+	return request, nil
+}
+
+// ParseLogoutResponse parses a SAML 2.0 LogoutResponse document.
+func ParseLogoutResponse(data []byte) (*LogoutResponse, error) {
+	root, err := parseProtocolMessage(data, "LogoutResponse")
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogoutResponse{
+		ID:           root.AttrValue("ID"),
+		InResponseTo: root.AttrValue("InResponseTo"),
+		Issuer:       getIssuer(root),
+		Element:      root,
+	}
+	if status := root.FindChild(constants.NamespaceProtocol, "Status"); status != nil {
+		if code := status.FindChild(constants.NamespaceProtocol, "StatusCode"); code != nil {
+			response.StatusCode = code.AttrValue("Value")
+		}
+	}
+	return response, nil
+}
+
+// ParseResponse parses a SAML 2.0 Response document along with its plain assertions.
+func ParseResponse(data []byte) (*Response, error) {
+	root, err := parseProtocolMessage(data, "Response")
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Response{
+		ID:           root.AttrValue("ID"),
+		InResponseTo: root.AttrValue("InResponseTo"),
+		Destination:  root.AttrValue("Destination"),
+		Issuer:       getIssuer(root),
+		Element:      root,
+	}
+	if status := root.FindChild(constants.NamespaceProtocol, "Status"); status != nil {
+		if code := status.FindChild(constants.NamespaceProtocol, "StatusCode"); code != nil {
+			response.StatusCode = code.AttrValue("Value")
+			if subCode := code.FindChild(constants.NamespaceProtocol, "StatusCode"); subCode != nil {
+				response.SubStatusCode = subCode.AttrValue("Value")
+			}
+		}
+		if statusMessage := status.FindChild(constants.NamespaceProtocol, "StatusMessage"); statusMessage != nil {
+			response.StatusMessage = strings.TrimSpace(statusMessage.Text())
+		}
+	}
+	for _, e := range root.FindChildren(constants.NamespaceAssertion, "Assertion") {
+		assertion, err := parseAssertion(e)
+		if err != nil {
+			return nil, err
+		}
+		response.Assertions = append(response.Assertions, assertion)
+	}
+	return response, nil
+}
+
+// parseAssertion parses an assertion element.
+func parseAssertion(e *xmldsig.Element) (*Assertion, error) {
+	if e.AttrValue("Version") != constants.Version {
+		return nil, fmt.Errorf("%w: unsupported assertion version", ErrInvalidMessage)
+	}
+	if e.AttrValue("ID") == "" {
+		return nil, fmt.Errorf("%w: missing assertion ID", ErrInvalidMessage)
+	}
+
+	assertion := &Assertion{
+		ID:         e.AttrValue("ID"),
+		Issuer:     getIssuer(e),
+		Attributes: make(map[string][]string),
+		Element:    e,
+	}
+	if subject := e.FindChild(constants.NamespaceAssertion, "Subject"); subject != nil {
+		if nameID := subject.FindChild(constants.NamespaceAssertion, "NameID"); nameID != nil {
+			assertion.NameID = strings.TrimSpace(nameID.Text())
+			assertion.NameIDFormat = nameID.AttrValue("Format")
+		}
+		for _, confirmation := range subject.FindChildren(constants.NamespaceAssertion, "SubjectConfirmation") {
+			if confirmation.AttrValue("Method") != constants.SubjectConfirmationBearer {
+				continue
+			}
+			var data SubjectConfirmationData
+			dataElement := confirmation.FindChild(constants.NamespaceAssertion, "SubjectConfirmationData")
+			if dataElement != nil {
+				data = SubjectConfirmationData{
+					Recipient:    dataElement.AttrValue("Recipient"),
+					InResponseTo: dataElement.AttrValue("InResponseTo"),
+					NotOnOrAfter: dataElement.AttrValue("NotOnOrAfter"),
+				}
+			}
+			assertion.SubjectConfirmations = append(assertion.SubjectConfirmations, data)
+		}
+	}
+	if conditions := e.FindChild(constants.NamespaceAssertion, "Conditions"); conditions != nil {
+		assertion.NotBefore = conditions.AttrValue("NotBefore")
+		assertion.NotOnOrAfter = conditions.AttrValue("NotOnOrAfter")
+		for _, restriction := range conditions.FindChildren(constants.NamespaceAssertion, "AudienceRestriction") {
+			var audiences []string
+			for _, audience := range restriction.FindChildren(constants.NamespaceAssertion, "Audience") {
+				audiences = append(audiences, strings.TrimSpace(audience.Text()))
+			}
+			assertion.Audiences = append(assertion.Audiences, audiences)
+		}
+	}
+	if authnStatement := e.FindChild(constants.NamespaceAssertion, "AuthnStatement"); authnStatement != nil {
+		assertion.SessionIndex = authnStatement.AttrValue("SessionIndex")
+	}
+	for _, statement := range e.FindChildren(constants.NamespaceAssertion, "AttributeStatement") {
+		for _, attribute := range statement.FindChildren(constants.NamespaceAssertion, "Attribute") {
+			name := attribute.AttrValue("Name")
+			for _, value := range attribute.FindChildren(constants.NamespaceAssertion, "AttributeValue") {
+				assertion.Attributes[name] = append(assertion.Attributes[name], strings.TrimSpace(value.Text()))
+			}
+		}
+	}
+	return assertion, nil
+}
+
+// ParseTime parses a SAML dateTime value.
+func ParseTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid dateTime %q", ErrInvalidMessage, value)
+	}
+	return t, nil
+}
+
+// parseProtocolMessage parses the document and checks that its root is a SAML 2.0 protocol message of
+// the expected type with an ID.
+func parseProtocolMessage(data []byte, local string) (*xmldsig.Element, error) {
+	root, err := xmldsig.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMessage, err)
+	}
+	if !root.Is(constants.NamespaceProtocol, local) {
+		return nil, fmt.Errorf("%w: expected %s", ErrInvalidMessage, local)
+	}
+	if root.AttrValue("Version") != constants.Version {
+		return nil, fmt.Errorf("%w: unsupported version", ErrInvalidMessage)
+	}
+	if root.AttrValue("ID") == "" {
+		return nil, fmt.Errorf("%w: missing ID", ErrInvalidMessage)
+	}
+	return root, nil
+}
+
+// getIssuer returns the issuer of the protocol message.
+func getIssuer(root *xmldsig.Element) string {
+	if issuer := root.FindChild(constants.NamespaceAssertion, "Issuer"); issuer != nil {
+		return strings.TrimSpace(issuer.Text())
+	}
+	return ""
+}
+
+// isTrue reports whether the xs:boolean value is true.
+func isTrue(value string) bool {
+	return value == "true" || value == "1"
+}
@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package message
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+)
+
+const testAuthnRequest = `<samlp:AuthnRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ` +
+	`xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_req1" Version="2.0" ` +
+	`IssueInstant="2026-01-01T00:00:00Z" Destination="https://idp.example.com/saml2/sso" ` +
+	`AssertionConsumerServiceURL="https://sp.example.com/acs" ` +
+	`ProtocolBinding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" ForceAuthn="true" IsPassive="0">` +
+	`<saml:Issuer> https://sp.example.com </saml:Issuer>` +
+	`<samlp:NameIDPolicy Format="urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress" AllowCreate="true"/>` +
+	`</samlp:AuthnRequest>`
+
+type ParseTestSuite struct {
+	suite.Suite
+}
+
+func TestParseTestSuite(t *testing.T) {
+	suite.Run(t, new(ParseTestSuite))
+}
+
+func (suite *ParseTestSuite) TestParseAuthnRequest() {
+	request, err := ParseAuthnRequest([]byte(testAuthnRequest))
+
+	suite.Require().NoError(err)
+	suite.Equal("_req1", request.ID)
+	suite.Equal("https://sp.example.com", request.Issuer)
+	suite.Equal("https://idp.example.com/saml2/sso", request.Destination)
+	suite.Equal("https://sp.example.com/acs", request.AssertionConsumerServiceURL)
+	suite.Equal(constants.BindingHTTPPost, request.ProtocolBinding)
+	suite.Equal(constants.NameIDFormatEmailAddress, request.NameIDPolicyFormat)
+	suite.True(request.ForceAuthn)
+	suite.False(request.IsPassive)
+	suite.NotNil(request.Element)
+}
+
+func (suite *ParseTestSuite) TestParseAuthnRequest_Invalid() {
+	testCases := []struct {
+		name string
+		data string
+	}{
+		{"MalformedXML", "<samlp:AuthnRequest"},
+		{"WrongType", `<samlp:LogoutRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ` +
+			`ID="_1" Version="2.0"/>`},
+		{"WrongNamespace", `<AuthnRequest xmlns="urn:example" ID="_1" Version="2.0"/>`},
+		{"UnsupportedVersion", `<samlp:AuthnRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ` +
+			`ID="_1" Version="1.1"/>`},
+		{"MissingID", `<samlp:AuthnRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" Version="2.0"/>`},
+		{"MissingIssuer", `<samlp:AuthnRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ` +
+			`ID="_1" Version="2.0"/>`},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			request, err := ParseAuthnRequest([]byte(tc.data))
+			suite.ErrorIs(err, ErrInvalidMessage)
+			suite.Nil(request)
+		})
+	}
+}
+
+func (suite *ParseTestSuite) TestParseLogoutRequest_RoundTrip() {
+	built, err := BuildLogoutRequest(&LogoutRequestParams{
+		Issuer:       "https://idp.example.com",
+		Destination:  "https://sp.example.com/slo",
+		NameID:       "user-1",
+		NameIDFormat: constants.NameIDFormatPersistent,
+		SessionIndex: SessionIndex("session-1"),
+	})
+	suite.Require().NoError(err)
+
+	request, err := ParseLogoutRequest(built.Bytes())
+
+	suite.Require().NoError(err)
+	suite.Equal(built.AttrValue("ID"), request.ID)
+	suite.Equal("https://idp.example.com", request.Issuer)
+	suite.Equal("https://sp.example.com/slo", request.Destination)
+	suite.Equal("user-1", request.NameID)
+	suite.Equal([]string{SessionIndex("session-1")}, request.SessionIndexes)
+}
+
+func (suite *ParseTestSuite) TestParseLogoutRequest_MissingIssuer() {
+	_, err := ParseLogoutRequest([]byte(`<samlp:LogoutRequest ` +
+		`xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ID="_1" Version="2.0"/>`))
+
+	suite.ErrorIs(err, ErrInvalidMessage)
+}
+
+func (suite *ParseTestSuite) TestParseLogoutResponse_RoundTrip() {
+	built, err := BuildLogoutResponse(&ResponseParams{
+		Issuer:       "https://sp.example.com",
+		InResponseTo: "_req1",
+		StatusCode:   constants.StatusRequester,
+	})
+	suite.Require().NoError(err)
+
+	response, err := ParseLogoutResponse(built.Bytes())
+
+	suite.Require().NoError(err)
+	suite.Equal("https://sp.example.com", response.Issuer)
+	suite.Equal("_req1", response.InResponseTo)
+	suite.Equal(constants.StatusRequester, response.StatusCode)
+}
+
+func (suite *ParseTestSuite) TestParseLogoutResponse_WrongType() {
+	_, err := ParseLogoutResponse([]byte(testAuthnRequest))
+
+	suite.ErrorIs(err, ErrInvalidMessage)
+}
+
+func (suite *ParseTestSuite) TestParseResponse_RoundTrip() {
+	issueInstant := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	assertion, err := BuildAssertion(&AssertionParams{
+		Issuer:          "https://idp.example.com",
+		Recipient:       "https://sp.example.com/acs",
+		InResponseTo:    "_req1",
+		Audiences:       []string{"https://sp.example.com"},
+		NameID:          "user@example.com",
+		NameIDFormat:    constants.NameIDFormatEmailAddress,
+		SessionIndex:    "_session1",
+		AuthnInstant:    issueInstant,
+		Attributes:      map[string][]string{"groups": {"admin", "dev"}, "email": {"user@example.com"}},
+		IssueInstant:    issueInstant,
+		ValiditySeconds: 300,
+	})
+	suite.Require().NoError(err)
+	built, err := BuildResponse(&ResponseParams{
+		Issuer:        "https://idp.example.com",
+		Destination:   "https://sp.example.com/acs",
+		InResponseTo:  "_req1",
+		SubStatusCode: constants.StatusPartialLogout,
+		StatusMessage: "message",
+		IssueInstant:  issueInstant,
+	}, assertion)
+	suite.Require().NoError(err)
+
+	response, err := ParseResponse(built.Bytes())
+
+	suite.Require().NoError(err)
+	suite.Equal(built.AttrValue("ID"), response.ID)
+	suite.Equal("_req1", response.InResponseTo)
+	suite.Equal("https://sp.example.com/acs", response.Destination)
+	suite.Equal("https://idp.example.com", response.Issuer)
+	suite.Equal(constants.StatusSuccess, response.StatusCode)
+	suite.Equal(constants.StatusPartialLogout, response.SubStatusCode)
+	suite.Equal("message", response.StatusMessage)
+	suite.Require().Len(response.Assertions, 1)
+
+	parsed := response.Assertions[0]
+	suite.Equal(assertion.AttrValue("ID"), parsed.ID)
+	suite.Equal("https://idp.example.com", parsed.Issuer)
+	suite.Equal("user@example.com", parsed.NameID)
+	suite.Equal(constants.NameIDFormatEmailAddress, parsed.NameIDFormat)
+	suite.Equal([]SubjectConfirmationData{{
+		Recipient:    "https://sp.example.com/acs",
+		InResponseTo: "_req1",
+		NotOnOrAfter: "2026-01-01T10:05:00Z",
+	}}, parsed.SubjectConfirmations)
+	suite.Equal("2026-01-01T10:00:00Z", parsed.NotBefore)
+	suite.Equal("2026-01-01T10:05:00Z", parsed.NotOnOrAfter)
+	suite.Equal([][]string{{"https://sp.example.com"}}, parsed.Audiences)
+	suite.Equal("_session1", parsed.SessionIndex)
+	suite.Equal(map[string][]string{"groups": {"admin", "dev"}, "email": {"user@example.com"}}, parsed.Attributes)
+	suite.NotNil(parsed.Element)
+}
+
+func (suite *ParseTestSuite) TestParseResponse_Invalid() {
+	testCases := []struct {
+		name string
+		data string
+	}{
+		{"WrongType", testAuthnRequest},
+		{"InvalidAssertionVersion", `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ` +
+			`xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_1" Version="2.0">` +
+			`<saml:Assertion ID="_a1" Version="1.1"/></samlp:Response>`},
+		{"MissingAssertionID", `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ` +
+			`xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_1" Version="2.0">` +
+			`<saml:Assertion Version="2.0"/></samlp:Response>`},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			response, err := ParseResponse([]byte(tc.data))
+			suite.ErrorIs(err, ErrInvalidMessage)
+			suite.Nil(response)
+		})
+	}
+}
+
+func (suite *ParseTestSuite) TestParseTime() {
+	t, err := ParseTime("2026-01-01T10:00:00.123Z")
+	suite.Require().NoError(err)
+	suite.Equal(time.Date(2026, 1, 1, 10, 0, 0, 123000000, time.UTC), t)
+
+	_, err = ParseTime("not-a-time")
+	suite.ErrorIs(err, ErrInvalidMessage)
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package metadata
+
+import (
+	"net/http"
+
+	sysconst "github.com/asgardeo/thunder/internal/system/constants"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+// metadataContentType is the media type of SAML metadata documents.
+const metadataContentType = "application/samlmetadata+xml"
+
+// metadataHandler handles the SAML metadata endpoint.
+type metadataHandler struct {
+	service MetadataServiceInterface
+	logger  *log.Logger
+}
+
+// newMetadataHandler creates a new SAML metadata handler (internal use).
+func newMetadataHandler(service MetadataServiceInterface) *metadataHandler {
+	return &metadataHandler{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SAMLMetadataHandler")),
+	}
+}
+
+// HandleMetadataRequest handles requests for the metadata of the identity provider.
+func (h *metadataHandler) HandleMetadataRequest(w http.ResponseWriter, r *http.Request) {
+	metadata, err := h.service.GetIdentityProviderMetadata(r.Context())
+	if err != nil {
+		h.logger.Error("Failed to build SAML identity provider metadata", log.Error(err))
+		http.Error(w, "Failed to build SAML metadata", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(sysconst.ContentTypeHeaderName, metadataContentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(metadata); err != nil {
+		h.logger.Error("Failed to write SAML metadata response", log.Error(err))
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package metadata
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+)
+
+type MetadataHandlerTestSuite struct {
+	suite.Suite
+	mockService *MetadataServiceInterfaceMock
+	handler     *metadataHandler
+}
+
+func TestMetadataHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(MetadataHandlerTestSuite))
+}
+
+func (s *MetadataHandlerTestSuite) SetupTest() {
+	s.mockService = NewMetadataServiceInterfaceMock(s.T())
+	s.handler = newMetadataHandler(s.mockService)
+}
+
+func (s *MetadataHandlerTestSuite) TestHandleMetadataRequest() {
+	s.mockService.EXPECT().GetIdentityProviderMetadata(mock.Anything).Return([]byte("<md:EntityDescriptor/>"), nil)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleMetadataRequest(rr, httptest.NewRequest(http.MethodGet, constants.EndpointMetadata, nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal(metadataContentType, rr.Header().Get("Content-Type"))
+	s.Equal("<md:EntityDescriptor/>", rr.Body.String())
+}
+
+func (s *MetadataHandlerTestSuite) TestHandleMetadataRequest_Error() {
+	s.mockService.EXPECT().GetIdentityProviderMetadata(mock.Anything).Return(nil, errors.New("sign failed"))
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleMetadataRequest(rr, httptest.NewRequest(http.MethodGet, constants.EndpointMetadata, nil))
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+}
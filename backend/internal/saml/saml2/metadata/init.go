@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package metadata
+
+import (
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/saml/saml2/signer"
+	"github.com/asgardeo/thunder/internal/system/middleware"
+)
+
+// Initialize initializes the SAML metadata service and registers its routes.
+func Initialize(mux *http.ServeMux, samlSigner signer.SAMLSignerInterface) MetadataServiceInterface {
+	metadataService := newMetadataService(samlSigner)
+	registerRoutes(mux, newMetadataHandler(metadataService))
+	return metadataService
+}
+
+// registerRoutes registers the routes for the SAML metadata endpoint.
+func registerRoutes(mux *http.ServeMux, handler *metadataHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "OPTIONS"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: false,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET "+constants.EndpointMetadata,
+		handler.HandleMetadataRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+constants.EndpointMetadata,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package metadata publishes the SAML 2.0 metadata of the identity provider.
+package metadata
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/saml/saml2/message"
+	"github.com/asgardeo/thunder/internal/saml/saml2/signer"
+	saml2utils "github.com/asgardeo/thunder/internal/saml/saml2/utils"
+	"github.com/asgardeo/thunder/internal/system/xmldsig"
+)
+
+// Namespace prefixes used in the metadata document.
+const (
+	prefixMetadata = "md"
+	prefixXMLDSig  = "ds"
+)
+
+// MetadataServiceInterface defines the interface for the SAML metadata service.
+type MetadataServiceInterface interface {
+	// GetIdentityProviderMetadata returns the signed metadata document of the identity provider.
+	GetIdentityProviderMetadata(ctx context.Context) ([]byte, error)
+}
+
+// metadataService is the default implementation of the MetadataServiceInterface.
+type metadataService struct {
+	signer signer.SAMLSignerInterface
+}
+
+// newMetadataService creates a new instance of metadataService with injected dependencies.
+func newMetadataService(samlSigner signer.SAMLSignerInterface) MetadataServiceInterface {
+	return &metadataService{
+		signer: samlSigner,
+	}
+}
+
+// GetIdentityProviderMetadata returns the signed metadata document of the identity provider. The
+// document describes the single sign-on and single logout endpoints for both supported bindings, the
+// NameID formats and the signing certificate.
+func (s *metadataService) GetIdentityProviderMetadata(ctx context.Context) ([]byte, error) {
+	id, err := message.NewID()
+	if err != nil {
+		return nil, err
+	}
+
+	descriptor := xmldsig.NewElement(prefixMetadata, "EntityDescriptor")
+	descriptor.DeclareNamespace(prefixMetadata, constants.NamespaceMetadata)
+	descriptor.DeclareNamespace(prefixXMLDSig, constants.NamespaceXMLDSig)
+	descriptor.SetAttr("ID", id)
+	descriptor.SetAttr("entityID", s.signer.GetEntityID())
+
+	idp := descriptor.AddElement(prefixMetadata, "IDPSSODescriptor")
+	idp.SetAttr("WantAuthnRequestsSigned", "false")
+	idp.SetAttr("protocolSupportEnumeration", constants.NamespaceProtocol)
+
+	keyDescriptor := idp.AddElement(prefixMetadata, "KeyDescriptor")
+	keyDescriptor.SetAttr("use", "signing")
+	keyDescriptor.AddElement(prefixXMLDSig, "KeyInfo").
+		AddElement(prefixXMLDSig, "X509Data").
+		AddElement(prefixXMLDSig, "X509Certificate").
+		AddText(base64.StdEncoding.EncodeToString(s.signer.GetCertificate().Raw))
+
+	sloURL := saml2utils.GetEndpointURL(constants.EndpointSLO)
+	addEndpoints(idp, "SingleLogoutService", sloURL)
+	for _, format := range constants.SupportedNameIDFormats {
+		idp.AddElement(prefixMetadata, "NameIDFormat").AddText(format)
+	}
+	ssoURL := saml2utils.GetEndpointURL(constants.EndpointSSO)
+	addEndpoints(idp, "SingleSignOnService", ssoURL)
+
+	// The signature of an EntityDescriptor precedes all of its other children.
+	if err := s.signer.SignElement(ctx, descriptor, 0); err != nil {
+		return nil, err
+	}
+	return descriptor.Bytes(), nil
+}
+
+// addEndpoints adds an endpoint element of the given type for each supported binding.
+func addEndpoints(descriptor *xmldsig.Element, local, location string) {
+	for _, binding := range []string{constants.BindingHTTPRedirect, constants.BindingHTTPPost} {
+		endpoint := descriptor.AddElement(prefixMetadata, local)
+		endpoint.SetAttr("Binding", binding)
+		endpoint.SetAttr("Location", location)
+	}
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package metadata
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/cryptolab"
+	"github.com/asgardeo/thunder/internal/system/xmldsig"
+	"github.com/asgardeo/thunder/tests/mocks/saml/saml2/signermock"
+)
+
+const testIdPEntityID = "https://idp.example.com"
+
+type MetadataServiceTestSuite struct {
+	suite.Suite
+	key        *rsa.PrivateKey
+	cert       *x509.Certificate
+	mockSigner *signermock.SAMLSignerInterfaceMock
+	service    MetadataServiceInterface
+}
+
+func TestMetadataServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MetadataServiceTestSuite))
+}
+
+func (s *MetadataServiceTestSuite) SetupSuite() {
+	var err error
+	s.key, err = rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &s.key.PublicKey, s.key)
+	s.Require().NoError(err)
+	s.cert, err = x509.ParseCertificate(der)
+	s.Require().NoError(err)
+}
+
+func (s *MetadataServiceTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("test", &config.Config{
+		Server: config.ServerConfig{Hostname: "localhost", Port: 8090},
+	})
+	s.mockSigner = signermock.NewSAMLSignerInterfaceMock(s.T())
+	s.mockSigner.EXPECT().GetEntityID().Return(testIdPEntityID).Maybe()
+	s.mockSigner.EXPECT().GetCertificate().Return(s.cert).Maybe()
+	s.service = newMetadataService(s.mockSigner)
+}
+
+func (s *MetadataServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *MetadataServiceTestSuite) TestGetIdentityProviderMetadata() {
+	s.mockSigner.EXPECT().SignElement(mock.Anything, mock.Anything, 0).
+		RunAndReturn(func(_ context.Context, e *xmldsig.Element, position int) error {
+			return xmldsig.SignEnveloped(e, position, xmldsig.AlgorithmRSASHA256,
+				func(content []byte) ([]byte, error) {
+					return cryptolab.Generate(content, cryptolab.RSASHA256, s.key)
+				}, s.cert)
+		})
+
+	data, err := s.service.GetIdentityProviderMetadata(context.Background())
+
+	s.Require().NoError(err)
+	root, err := xmldsig.Parse(data)
+	s.Require().NoError(err)
+	s.True(root.Is(constants.NamespaceMetadata, "EntityDescriptor"))
+	s.Equal(testIdPEntityID, root.AttrValue("entityID"))
+	s.True(root.ChildElements()[0].Is(constants.NamespaceXMLDSig, "Signature"))
+	s.NoError(xmldsig.VerifyEnveloped(root, []*x509.Certificate{s.cert}))
+
+	idp := root.FindChild(constants.NamespaceMetadata, "IDPSSODescriptor")
+	s.Require().NotNil(idp)
+	s.Equal(constants.NamespaceProtocol, idp.AttrValue("protocolSupportEnumeration"))
+
+	certificate := idp.FindChild(constants.NamespaceMetadata, "KeyDescriptor").
+		FindChild(constants.NamespaceXMLDSig, "KeyInfo").
+		FindChild(constants.NamespaceXMLDSig, "X509Data").
+		FindChild(constants.NamespaceXMLDSig, "X509Certificate")
+	s.Equal(base64.StdEncoding.EncodeToString(s.cert.Raw), certificate.Text())
+
+	ssoServices := idp.FindChildren(constants.NamespaceMetadata, "SingleSignOnService")
+	s.Require().Len(ssoServices, 2)
+	s.Equal(constants.BindingHTTPRedirect, ssoServices[0].AttrValue("Binding"))
+	s.Equal(constants.BindingHTTPPost, ssoServices[1].AttrValue("Binding"))
+	s.Equal("https://localhost:8090/saml2/sso", ssoServices[0].AttrValue("Location"))
+
+	sloServices := idp.FindChildren(constants.NamespaceMetadata, "SingleLogoutService")
+	s.Require().Len(sloServices, 2)
+	s.Equal("https://localhost:8090/saml2/slo", sloServices[1].AttrValue("Location"))
+
+	s.Len(idp.FindChildren(constants.NamespaceMetadata, "NameIDFormat"), len(constants.SupportedNameIDFormats))
+}
+
+func (s *MetadataServiceTestSuite) TestGetIdentityProviderMetadata_SignError() {
+	s.mockSigner.EXPECT().SignElement(mock.Anything, mock.Anything, 0).Return(errors.New("sign failed"))
+
+	data, err := s.service.GetIdentityProviderMetadata(context.Background())
+
+	s.Error(err)
+	s.Nil(data)
+}
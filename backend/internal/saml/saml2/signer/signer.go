@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package signer provides the signing identity of the SAML 2.0 identity provider.
+package signer
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/kmprovider"
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/pkiservice"
+	"github.com/asgardeo/thunder/internal/system/xmldsig"
+)
+
+// SAMLSignerInterface defines the signing identity of the SAML 2.0 identity provider.
+type SAMLSignerInterface interface {
+	// GetEntityID returns the entity ID of the identity provider.
+	GetEntityID() string
+	// GetCertificate returns the certificate of the signing key.
+	GetCertificate() *x509.Certificate
+	// GetSignatureAlgorithm returns the XML signature algorithm URI used for signing.
+	GetSignatureAlgorithm() string
+	// Sign signs the content and returns the signature value encoded as XML signatures expect.
+	Sign(ctx context.Context, content []byte) ([]byte, error)
+	// SignElement signs the element with an enveloped signature inserted at the given child position.
+	SignElement(ctx context.Context, e *xmldsig.Element, position int) error
+}
+
+// samlSigner signs SAML messages with the preferred key of the server through the key manager.
+type samlSigner struct {
+	cryptoProvider kmprovider.RuntimeCryptoProvider
+	keyRef         kmprovider.KeyRef
+	certificate    *x509.Certificate
+	algorithm      string
+	entityID       string
+}
+
+// Initialize creates the SAML signer backed by the preferred signing key of the server.
+func Initialize(
+	pkiService pkiservice.PKIServiceInterface, cryptoProvider kmprovider.RuntimeCryptoProvider,
+) (SAMLSignerInterface, error) {
+	if pkiService == nil || cryptoProvider == nil {
+		return nil, errors.New("PKI service and crypto provider are required")
+	}
+	keyID := config.GetServerRuntime().Config.JWT.PreferredKeyID
+	cert, svcErr := pkiService.GetX509Certificate(keyID)
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to retrieve the certificate for the key id %s: %s",
+			keyID, svcErr.Error.DefaultValue)
+	}
+	algorithm, err := xmldsig.SignatureAlgorithmURI(cert.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the signature algorithm for the key id %s: %w", keyID, err)
+	}
+
+	return &samlSigner{
+		cryptoProvider: cryptoProvider,
+		keyRef:         kmprovider.KeyRef{KeyID: keyID},
+		certificate:    cert,
+		algorithm:      algorithm,
+		entityID:       getIdentityProviderEntityID(),
+	}, nil
+}
+
+// GetEntityID returns the entity ID of the identity provider.
+func (s *samlSigner) GetEntityID() string {
+	return s.entityID
+}
+
+// GetCertificate returns the certificate of the signing key.
+func (s *samlSigner) GetCertificate() *x509.Certificate {
+	return s.certificate
+}
+
+// GetSignatureAlgorithm returns the XML signature algorithm URI used for signing.
+func (s *samlSigner) GetSignatureAlgorithm() string {
+	return s.algorithm
+}
+
+// Sign signs the content and returns the signature value encoded as XML signatures expect.
+func (s *samlSigner) Sign(ctx context.Context, content []byte) ([]byte, error) {
+	return xmldsig.SignValue(content, s.algorithm, s.signFunc(ctx), s.certificate)
+}
+
+// SignElement signs the element with an enveloped signature inserted at the given child position.
+func (s *samlSigner) SignElement(ctx context.Context, e *xmldsig.Element, position int) error {
+	return xmldsig.SignEnveloped(e, position, s.algorithm, s.signFunc(ctx), s.certificate)
+}
+
+// signFunc returns a sign function that delegates to the key manager.
+func (s *samlSigner) signFunc(ctx context.Context) xmldsig.SignFunc {
+	return func(content []byte) ([]byte, error) {
+		alg, err := xmldsig.SignatureAlgorithm(s.algorithm)
+		if err != nil {
+			return nil, err
+		}
+		return s.cryptoProvider.Sign(ctx, s.keyRef, alg, content)
+	}
+}
+
+// getIdentityProviderEntityID returns the entity ID of the identity provider, which is the token
+// issuer of the server, or the server URL when no issuer is configured.
+func getIdentityProviderEntityID() string {
+	runtime := config.GetServerRuntime()
+	if runtime.Config.JWT.Issuer != "" {
+		return runtime.Config.JWT.Issuer
+	}
+	return config.GetServerURL(&runtime.Config.Server)
+}
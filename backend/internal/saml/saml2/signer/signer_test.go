@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signer
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/cryptolab"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/i18n/core"
+	"github.com/asgardeo/thunder/internal/system/kmprovider"
+	"github.com/asgardeo/thunder/internal/system/xmldsig"
+	"github.com/asgardeo/thunder/tests/mocks/crypto/cryptomock"
+	"github.com/asgardeo/thunder/tests/mocks/crypto/pki/pkimock"
+)
+
+const (
+	testKeyID  = "test-key"
+	testIssuer = "https://idp.example.com"
+)
+
+type SignerTestSuite struct {
+	suite.Suite
+	key            *rsa.PrivateKey
+	cert           *x509.Certificate
+	mockPKI        *pkimock.PKIServiceInterfaceMock
+	mockCryptoProv *cryptomock.RuntimeCryptoProviderMock
+}
+
+func TestSignerTestSuite(t *testing.T) {
+	suite.Run(t, new(SignerTestSuite))
+}
+
+func (suite *SignerTestSuite) SetupSuite() {
+	var err error
+	suite.key, err = rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &suite.key.PublicKey, suite.key)
+	suite.Require().NoError(err)
+	suite.cert, err = x509.ParseCertificate(der)
+	suite.Require().NoError(err)
+}
+
+func (suite *SignerTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("test", &config.Config{
+		Server: config.ServerConfig{Hostname: "localhost", Port: 8090},
+		JWT:    config.JWTConfig{Issuer: testIssuer, PreferredKeyID: testKeyID},
+	})
+	suite.mockPKI = pkimock.NewPKIServiceInterfaceMock(suite.T())
+	suite.mockCryptoProv = cryptomock.NewRuntimeCryptoProviderMock(suite.T())
+}
+
+func (suite *SignerTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *SignerTestSuite) newSigner() SAMLSignerInterface {
+	suite.mockPKI.EXPECT().GetX509Certificate(testKeyID).Return(suite.cert, nil)
+	samlSigner, err := Initialize(suite.mockPKI, suite.mockCryptoProv)
+	suite.Require().NoError(err)
+	return samlSigner
+}
+
+func (suite *SignerTestSuite) mockSign() {
+	suite.mockCryptoProv.EXPECT().Sign(mock.Anything, kmprovider.KeyRef{KeyID: testKeyID},
+		cryptolab.RSASHA256, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ kmprovider.KeyRef, alg cryptolab.SignAlgorithm,
+			content []byte) ([]byte, error) {
+			return cryptolab.Generate(content, alg, suite.key)
+		})
+}
+
+func (suite *SignerTestSuite) TestInitialize() {
+	samlSigner := suite.newSigner()
+
+	suite.Equal(testIssuer, samlSigner.GetEntityID())
+	suite.Equal(suite.cert, samlSigner.GetCertificate())
+	suite.Equal(xmldsig.AlgorithmRSASHA256, samlSigner.GetSignatureAlgorithm())
+}
+
+func (suite *SignerTestSuite) TestInitialize_MissingDependencies() {
+	_, err := Initialize(nil, suite.mockCryptoProv)
+	suite.Error(err)
+
+	_, err = Initialize(suite.mockPKI, nil)
+	suite.Error(err)
+}
+
+func (suite *SignerTestSuite) TestInitialize_CertificateNotFound() {
+	suite.mockPKI.EXPECT().GetX509Certificate(testKeyID).Return(nil, &serviceerror.ServiceError{
+		Type:  serviceerror.ServerErrorType,
+		Error: core.I18nMessage{DefaultValue: "certificate not found"},
+	})
+
+	samlSigner, err := Initialize(suite.mockPKI, suite.mockCryptoProv)
+
+	suite.Error(err)
+	suite.Nil(samlSigner)
+}
+
+func (suite *SignerTestSuite) TestGetEntityID_FallsBackToServerURL() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("test", &config.Config{
+		Server: config.ServerConfig{Hostname: "localhost", Port: 8090, HTTPOnly: true},
+		JWT:    config.JWTConfig{PreferredKeyID: testKeyID},
+	})
+
+	samlSigner := suite.newSigner()
+
+	suite.Equal("http://localhost:8090", samlSigner.GetEntityID())
+}
+
+func (suite *SignerTestSuite) TestSign() {
+	suite.mockSign()
+	samlSigner := suite.newSigner()
+
+	signature, err := samlSigner.Sign(context.Background(), []byte("content"))
+
+	suite.Require().NoError(err)
+	suite.NoError(xmldsig.VerifyValue([]byte("content"), signature, xmldsig.AlgorithmRSASHA256, suite.cert))
+}
+
+func (suite *SignerTestSuite) TestSignElement() {
+	suite.mockSign()
+	samlSigner := suite.newSigner()
+	root := xmldsig.NewElement("samlp", "LogoutResponse")
+	root.DeclareNamespace("samlp", "urn:oasis:names:tc:SAML:2.0:protocol")
+	root.SetAttr("ID", "_1")
+	root.AddElement("samlp", "Status")
+
+	suite.Require().NoError(samlSigner.SignElement(context.Background(), root, 0))
+
+	parsed, err := xmldsig.Parse(root.Bytes())
+	suite.Require().NoError(err)
+	suite.NoError(xmldsig.VerifyEnveloped(parsed, []*x509.Certificate{suite.cert}))
+}
+
+func (suite *SignerTestSuite) TestSign_CryptoProviderError() {
+	suite.mockCryptoProv.EXPECT().Sign(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("key unavailable"))
+	samlSigner := suite.newSigner()
+
+	_, err := samlSigner.Sign(context.Background(), []byte("content"))
+
+	suite.Error(err)
+}
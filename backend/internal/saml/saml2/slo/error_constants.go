@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package slo
+
+// Error codes reported on the error page.
+const (
+	errorCodeInvalidRequest = "invalid_request"
+	errorCodeServerError    = "server_error"
+)
+
+var (
+	// errInvalidLogoutMessage is returned when the logout message cannot be read or parsed.
+	errInvalidLogoutMessage = SLOError{
+		Code:    errorCodeInvalidRequest,
+		Message: "Invalid SAML logout message",
+	}
+	// errUnknownServiceProvider is returned when the issuer is not a registered service provider.
+	errUnknownServiceProvider = SLOError{
+		Code:    errorCodeInvalidRequest,
+		Message: "Unknown SAML service provider",
+	}
+	// errInvalidSignature is returned when the message signature is missing or invalid.
+	errInvalidSignature = SLOError{
+		Code:    errorCodeInvalidRequest,
+		Message: "Invalid SAML logout message signature",
+	}
+	// errServerError is returned for unexpected server-side failures.
+	errServerError = SLOError{
+		Code:    errorCodeServerError,
+		Message: "Failed to process SAML logout request",
+	}
+)
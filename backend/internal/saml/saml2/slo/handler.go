@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package slo
+
+import (
+	"encoding/base64"
+	"errors"
+	"html/template"
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/saml/saml2/binding"
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	saml2utils "github.com/asgardeo/thunder/internal/saml/saml2/utils"
+	"github.com/asgardeo/thunder/internal/session"
+	sysconst "github.com/asgardeo/thunder/internal/system/constants"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+// logoutPageTemplate renders the page that notifies the other service providers of the session
+// through hidden iframes and then posts the logout response to the initiating service provider.
+// The response is posted after a delay to let the iframes load.
+var logoutPageTemplate = template.Must(template.New("samlLogout").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Logged out</title>
+</head>
+<body>
+<p>You have been logged out.</p>
+{{- range .FrontChannelLogoutURIs}}
+<iframe src="{{.}}" style="display:none" width="0" height="0"></iframe>
+{{- end}}
+{{- if .Action}}
+<form method="post" action="{{.Action}}">
+<input type="hidden" name="{{.Param}}" value="{{.Value}}">
+{{- if .RelayState}}
+<input type="hidden" name="RelayState" value="{{.RelayState}}">
+{{- end}}
+<noscript><button type="submit">Continue</button></noscript>
+</form>
+<script>setTimeout(function () { document.forms[0].submit(); }, 2000);</script>
+{{- end}}
+</body>
+</html>
+`))
+
+// logoutPage holds the values rendered in the logout page.
+type logoutPage struct {
+	FrontChannelLogoutURIs []string
+	Action                 string
+	Param                  string
+	Value                  string
+	RelayState             string
+}
+
+// sloHandler handles the SAML 2.0 single logout endpoint.
+type sloHandler struct {
+	service SLOServiceInterface
+	logger  *log.Logger
+}
+
+// newSLOHandler creates a new SAML single logout handler (internal use).
+func newSLOHandler(service SLOServiceInterface) *sloHandler {
+	return &sloHandler{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SAMLSLOHandler")),
+	}
+}
+
+// HandleSLORequest handles logout requests and logout responses sent with the HTTP-Redirect (GET) or
+// HTTP-POST (POST) binding.
+func (h *sloHandler) HandleSLORequest(w http.ResponseWriter, r *http.Request) {
+	msg, err := binding.ReadMessage(r, constants.ParamSAMLRequest)
+	if errors.Is(err, binding.ErrMessageNotFound) {
+		h.handleLogoutResponse(w, r)
+		return
+	}
+	if err != nil {
+		h.logger.Debug("Failed to read SAML logout request", log.Error(err))
+		h.redirectToErrorPage(w, r, errInvalidLogoutMessage.Code, errInvalidLogoutMessage.Message)
+		return
+	}
+
+	result, sloErr := h.service.HandleLogoutRequest(r.Context(), msg, session.GetSessionIDFromRequest(r))
+	if sloErr != nil {
+		h.redirectToErrorPage(w, r, sloErr.Code, sloErr.Message)
+		return
+	}
+
+	session.ClearSessionCookie(w)
+	if len(result.FrontChannelLogoutURIs) == 0 && result.Response != nil {
+		if err := binding.WritePostForm(w, result.Response); err != nil {
+			h.logger.Error("Failed to render SAML logout response form", log.Error(err))
+		}
+		return
+	}
+
+	page := logoutPage{FrontChannelLogoutURIs: result.FrontChannelLogoutURIs}
+	if result.Response != nil {
+		page.Action = result.Response.Destination
+		page.Param = result.Response.Param
+		page.Value = base64.StdEncoding.EncodeToString(result.Response.Data)
+		page.RelayState = result.Response.RelayState
+	}
+	w.Header().Set(sysconst.ContentTypeHeaderName, "text/html; charset=utf-8")
+	w.Header().Set(sysconst.CacheControlHeaderName, sysconst.CacheControlNoCacheComposite)
+	w.Header().Set(sysconst.PragmaHeaderName, sysconst.PragmaNoCache)
+	w.WriteHeader(http.StatusOK)
+	if err := logoutPageTemplate.Execute(w, page); err != nil {
+		h.logger.Error("Failed to render SAML logout page", log.Error(err))
+	}
+}
+
+// handleLogoutResponse handles the logout response of a service provider notified of a logout.
+func (h *sloHandler) handleLogoutResponse(w http.ResponseWriter, r *http.Request) {
+	msg, err := binding.ReadMessage(r, constants.ParamSAMLResponse)
+	if err != nil {
+		h.logger.Debug("Failed to read SAML logout response", log.Error(err))
+		h.redirectToErrorPage(w, r, errInvalidLogoutMessage.Code, errInvalidLogoutMessage.Message)
+		return
+	}
+	if sloErr := h.service.HandleLogoutResponse(r.Context(), msg); sloErr != nil {
+		h.redirectToErrorPage(w, r, sloErr.Code, sloErr.Message)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// redirectToErrorPage redirects the user agent to the gate error page with the given error.
+func (h *sloHandler) redirectToErrorPage(w http.ResponseWriter, r *http.Request, code, msg string) {
+	redirectURL, err := saml2utils.GetErrorPageURL(code, msg)
+	if err != nil {
+		h.logger.Error("Failed to construct error page URL", log.Error(err))
+		http.Error(w, "Failed to redirect to error page", http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, r, redirectURL, http.StatusFound)
+}
@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package slo
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/saml/saml2/binding"
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/system/config"
+)
+
+type SLOHandlerTestSuite struct {
+	suite.Suite
+	mockService *SLOServiceInterfaceMock
+	handler     *sloHandler
+}
+
+func TestSLOHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SLOHandlerTestSuite))
+}
+
+func (s *SLOHandlerTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("test", &config.Config{
+		GateClient: config.GateClientConfig{
+			Scheme:    "https",
+			Hostname:  "localhost",
+			Port:      3000,
+			ErrorPath: "/error",
+		},
+	})
+
+	s.mockService = NewSLOServiceInterfaceMock(s.T())
+	s.handler = newSLOHandler(s.mockService)
+}
+
+func (s *SLOHandlerTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *SLOHandlerTestSuite) postRequest(param string) *http.Request {
+	form := url.Values{param: {base64.StdEncoding.EncodeToString([]byte("<samlp:LogoutRequest/>"))}}
+	req := httptest.NewRequest(http.MethodPost, constants.EndpointSLO, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.AddCookie(&http.Cookie{Name: "thunder_session", Value: testSessionID})
+	return req
+}
+
+func (s *SLOHandlerTestSuite) logoutResponse() *binding.PostMessage {
+	return &binding.PostMessage{
+		Destination: testSPSLOURL,
+		Param:       constants.ParamSAMLResponse,
+		Data:        []byte("<samlp:LogoutResponse/>"),
+		RelayState:  testRelayState,
+	}
+}
+
+// assertSessionCookieCleared asserts that the response expires the session cookie.
+func (s *SLOHandlerTestSuite) assertSessionCookieCleared(rr *httptest.ResponseRecorder) {
+	cookies := rr.Result().Cookies()
+	s.Require().Len(cookies, 1)
+	s.Equal("thunder_session", cookies[0].Name)
+	s.Empty(cookies[0].Value)
+	s.Less(cookies[0].MaxAge, 0)
+}
+
+func (s *SLOHandlerTestSuite) TestHandleSLORequest_PostsLogoutResponse() {
+	s.mockService.EXPECT().HandleLogoutRequest(mock.Anything, mock.Anything, testSessionID).
+		Return(&LogoutResult{Response: s.logoutResponse()}, nil)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleSLORequest(rr, s.postRequest(constants.ParamSAMLRequest))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.assertSessionCookieCleared(rr)
+	s.Contains(rr.Body.String(), `action="`+testSPSLOURL+`"`)
+	s.Contains(rr.Body.String(), "document.forms[0].submit()")
+	s.NotContains(rr.Body.String(), "<iframe")
+}
+
+func (s *SLOHandlerTestSuite) TestHandleSLORequest_NotifiesParticipants() {
+	s.mockService.EXPECT().HandleLogoutRequest(mock.Anything, mock.Anything, testSessionID).
+		Return(&LogoutResult{
+			FrontChannelLogoutURIs: []string{testOtherSLOURL + "?SAMLRequest=abc&Signature=def"},
+			Response:               s.logoutResponse(),
+		}, nil)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleSLORequest(rr, s.postRequest(constants.ParamSAMLRequest))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.assertSessionCookieCleared(rr)
+	body := rr.Body.String()
+	s.Contains(body, `<iframe src="`+testOtherSLOURL+`?SAMLRequest=abc&amp;Signature=def"`)
+	s.Contains(body, `action="`+testSPSLOURL+`"`)
+	s.Contains(body, `name="RelayState" value="`+testRelayState+`"`)
+	s.Contains(body, "setTimeout")
+}
+
+func (s *SLOHandlerTestSuite) TestHandleSLORequest_WithoutLogoutURL() {
+	s.mockService.EXPECT().HandleLogoutRequest(mock.Anything, mock.Anything, testSessionID).
+		Return(&LogoutResult{}, nil)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleSLORequest(rr, s.postRequest(constants.ParamSAMLRequest))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.assertSessionCookieCleared(rr)
+	s.Contains(rr.Body.String(), "You have been logged out.")
+	s.NotContains(rr.Body.String(), "<form")
+}
+
+func (s *SLOHandlerTestSuite) TestHandleSLORequest_ServiceError() {
+	s.mockService.EXPECT().HandleLogoutRequest(mock.Anything, mock.Anything, testSessionID).
+		Return(nil, &errUnknownServiceProvider)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleSLORequest(rr, s.postRequest(constants.ParamSAMLRequest))
+
+	s.Equal(http.StatusFound, rr.Code)
+	s.True(strings.HasPrefix(rr.Header().Get("Location"), "https://localhost:3000/error?"))
+	s.Empty(rr.Result().Cookies())
+}
+
+func (s *SLOHandlerTestSuite) TestHandleSLORequest_LogoutResponse() {
+	s.mockService.EXPECT().HandleLogoutResponse(mock.Anything,
+		mock.MatchedBy(func(msg *binding.Message) bool { return msg.Binding == constants.BindingHTTPPost })).
+		Return(nil)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleSLORequest(rr, s.postRequest(constants.ParamSAMLResponse))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Empty(rr.Result().Cookies())
+}
+
+func (s *SLOHandlerTestSuite) TestHandleSLORequest_MissingMessage() {
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleSLORequest(rr, httptest.NewRequest(http.MethodGet, constants.EndpointSLO, nil))
+
+	s.Equal(http.StatusFound, rr.Code)
+	s.Contains(rr.Header().Get("Location"), "errorCode=invalid_request")
+}
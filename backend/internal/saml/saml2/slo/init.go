@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package slo
+
+import (
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/inboundclient"
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/saml/saml2/signer"
+	"github.com/asgardeo/thunder/internal/session"
+)
+
+// Initialize initializes the SAML single logout service and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	sessionService session.SessionServiceInterface,
+	samlSigner signer.SAMLSignerInterface,
+) SLOServiceInterface {
+	sloService := newSLOService(inboundClient, sessionService, samlSigner)
+	registerRoutes(mux, newSLOHandler(sloService))
+	return sloService
+}
+
+// registerRoutes registers the routes for the SAML single logout endpoint.
+func registerRoutes(mux *http.ServeMux, handler *sloHandler) {
+	mux.HandleFunc("GET "+constants.EndpointSLO, handler.HandleSLORequest)
+	mux.HandleFunc("POST "+constants.EndpointSLO, handler.HandleSLORequest)
+}
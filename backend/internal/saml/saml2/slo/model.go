@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package slo
+
+import "github.com/asgardeo/thunder/internal/saml/saml2/binding"
+
+// LogoutResult holds the result of processing a logout request.
+type LogoutResult struct {
+	// Response is the logout response to deliver to the initiating service provider, if it has a
+	// single logout URL.
+	Response *binding.PostMessage
+	// FrontChannelLogoutURIs are the URIs that deliver logout requests to the other service providers
+	// of the terminated session with the HTTP-Redirect binding.
+	FrontChannelLogoutURIs []string
+}
+
+// SLOError represents an error that is reported on the error page.
+type SLOError struct {
+	Code    string
+	Message string
+}
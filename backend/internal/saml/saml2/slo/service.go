@@ -0,0 +1,269 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package slo implements the SAML 2.0 single logout service of the identity provider.
+package slo
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/inboundclient"
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/saml/saml2/binding"
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/saml/saml2/message"
+	"github.com/asgardeo/thunder/internal/saml/saml2/signer"
+	"github.com/asgardeo/thunder/internal/session"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/log"
+	"github.com/asgardeo/thunder/internal/system/xmldsig"
+)
+
+// SLOServiceInterface defines the interface of the SAML 2.0 single logout service.
+type SLOServiceInterface interface {
+	// HandleLogoutRequest terminates the SSO session on request of a service provider and returns the
+	// logout requests for the other service providers of the session along with the logout response.
+	HandleLogoutRequest(ctx context.Context, msg *binding.Message, sessionID string) (*LogoutResult, *SLOError)
+	// HandleLogoutResponse processes the logout response of a service provider notified of a logout.
+	HandleLogoutResponse(ctx context.Context, msg *binding.Message) *SLOError
+}
+
+// sloService is the default implementation of the SLOServiceInterface.
+type sloService struct {
+	inboundClient  inboundclient.InboundClientServiceInterface
+	sessionService session.SessionServiceInterface
+	signer         signer.SAMLSignerInterface
+	logger         *log.Logger
+}
+
+// newSLOService creates a new instance of sloService with injected dependencies.
+func newSLOService(
+	inboundClient inboundclient.InboundClientServiceInterface,
+	sessionService session.SessionServiceInterface,
+	samlSigner signer.SAMLSignerInterface,
+) SLOServiceInterface {
+	return &sloService{
+		inboundClient:  inboundClient,
+		sessionService: sessionService,
+		signer:         samlSigner,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SAMLSLOService")),
+	}
+}
+
+// HandleLogoutRequest terminates the SSO session on request of a service provider and returns the
+// logout requests for the other service providers of the session along with the logout response.
+// The session is only terminated when the service provider takes part in it and, if session indexes
+// are given, one of them identifies the session.
+func (s *sloService) HandleLogoutRequest(ctx context.Context, msg *binding.Message, sessionID string) (
+	*LogoutResult, *SLOError) {
+	request, err := message.ParseLogoutRequest(msg.Data)
+	if err != nil {
+		s.logger.Debug("Failed to parse SAML logout request", log.Error(err))
+		return nil, &errInvalidLogoutMessage
+	}
+	sp, sloErr := s.resolveServiceProvider(ctx, request.Issuer)
+	if sloErr != nil {
+		return nil, sloErr
+	}
+	if sloErr := s.verifySignature(msg, constants.ParamSAMLRequest, request.Element, sp); sloErr != nil {
+		return nil, sloErr
+	}
+
+	statusCode := constants.StatusSuccess
+	var participants []string
+	ssoSession, svcErr := s.getSession(ctx, sessionID)
+	if svcErr != nil {
+		statusCode = constants.StatusResponder
+	} else if ssoSession != nil && isSessionOfRequest(ssoSession, request, sp.EntityID) {
+		if svcErr := s.sessionService.TerminateSession(ctx, ssoSession.ID); svcErr != nil &&
+			svcErr.Type != serviceerror.ClientErrorType {
+			s.logger.Error("Failed to terminate SSO session",
+				log.String("error", svcErr.ErrorDescription.DefaultValue))
+			statusCode = constants.StatusResponder
+		} else {
+			participants = s.getFrontChannelLogoutURIs(ctx, ssoSession, sp.EntityID)
+		}
+	}
+
+	result := &LogoutResult{FrontChannelLogoutURIs: participants}
+	if sp.SingleLogoutURL == "" {
+		return result, nil
+	}
+	response, err := message.BuildLogoutResponse(&message.ResponseParams{
+		Issuer:       s.signer.GetEntityID(),
+		Destination:  sp.SingleLogoutURL,
+		InResponseTo: request.ID,
+		StatusCode:   statusCode,
+		IssueInstant: time.Now(),
+	})
+	if err == nil {
+		err = s.signer.SignElement(ctx, response, message.SignaturePosition)
+	}
+	if err != nil {
+		s.logger.Error("Failed to build SAML logout response", log.Error(err))
+		return nil, &errServerError
+	}
+	result.Response = &binding.PostMessage{
+		Destination: sp.SingleLogoutURL,
+		Param:       constants.ParamSAMLResponse,
+		Data:        response.Bytes(),
+		RelayState:  msg.RelayState,
+	}
+	return result, nil
+}
+
+// HandleLogoutResponse processes the logout response of a service provider notified of a logout.
+// The response is only verified and logged since the session has already been terminated.
+func (s *sloService) HandleLogoutResponse(ctx context.Context, msg *binding.Message) *SLOError {
+	response, err := message.ParseLogoutResponse(msg.Data)
+	if err != nil {
+		s.logger.Debug("Failed to parse SAML logout response", log.Error(err))
+		return &errInvalidLogoutMessage
+	}
+	sp, sloErr := s.resolveServiceProvider(ctx, response.Issuer)
+	if sloErr != nil {
+		return sloErr
+	}
+	if sloErr := s.verifySignature(msg, constants.ParamSAMLResponse, response.Element, sp); sloErr != nil {
+		return sloErr
+	}
+
+	if response.StatusCode != constants.StatusSuccess {
+		s.logger.Debug("SAML service provider did not complete the logout", log.String("entityId", sp.EntityID),
+			log.String("status", response.StatusCode))
+	}
+	return nil
+}
+
+// resolveServiceProvider resolves the service provider that issued a logout message.
+func (s *sloService) resolveServiceProvider(
+	ctx context.Context, issuer string,
+) (*inboundmodel.SAMLServiceProvider, *SLOError) {
+	sp, err := s.inboundClient.GetSAMLServiceProvider(ctx, issuer)
+	if err != nil {
+		s.logger.Error("Failed to retrieve SAML service provider", log.Error(err))
+		return nil, &errServerError
+	}
+	if sp == nil {
+		s.logger.Debug("SAML logout message from an unknown service provider", log.String("issuer", issuer))
+		return nil, &errUnknownServiceProvider
+	}
+	return sp, nil
+}
+
+// verifySignature verifies the signature of a logout message against the certificate of the service
+// provider.
+func (s *sloService) verifySignature(
+	msg *binding.Message, param string, e *xmldsig.Element, sp *inboundmodel.SAMLServiceProvider,
+) *SLOError {
+	cert, err := sp.GetCertificate()
+	if err != nil {
+		s.logger.Error("Invalid SAML service provider certificate", log.String("entityId", sp.EntityID),
+			log.Error(err))
+		return &errServerError
+	}
+	if err := binding.VerifySignature(msg, param, e, cert, sp.RequireSignedRequests); err != nil {
+		s.logger.Debug("SAML logout message signature verification failed", log.Error(err))
+		return &errInvalidSignature
+	}
+	return nil
+}
+
+// getSession returns the SSO session identified by the session ID, or nil if there is none.
+func (s *sloService) getSession(ctx context.Context, sessionID string) (
+	*session.Session, *serviceerror.ServiceError) {
+	if sessionID == "" {
+		return nil, nil
+	}
+	ssoSession, svcErr := s.sessionService.GetSession(ctx, sessionID)
+	if svcErr != nil {
+		if svcErr.Type == serviceerror.ClientErrorType {
+			// The session has already expired or been terminated.
+			return nil, nil
+		}
+		s.logger.Error("Failed to retrieve SSO session", log.String("error", svcErr.ErrorDescription.DefaultValue))
+		return nil, svcErr
+	}
+	return ssoSession, nil
+}
+
+// getFrontChannelLogoutURIs builds the signed HTTP-Redirect binding logout requests for the SAML
+// service providers of the session other than the initiator. Clients of the session that are not SAML
+// service providers, or have no single logout URL, are skipped.
+func (s *sloService) getFrontChannelLogoutURIs(
+	ctx context.Context, ssoSession *session.Session, initiator string,
+) []string {
+	uris := make([]string, 0, len(ssoSession.ClientIDs))
+	for _, clientID := range ssoSession.ClientIDs {
+		if clientID == initiator {
+			continue
+		}
+		participant, err := s.inboundClient.GetSAMLServiceProvider(ctx, clientID)
+		if err != nil {
+			s.logger.Error("Failed to retrieve SAML service provider for single logout",
+				log.String("entityId", clientID), log.Error(err))
+			continue
+		}
+		if participant == nil || participant.SingleLogoutURL == "" {
+			continue
+		}
+
+		uri, err := s.buildLogoutRequestURI(ctx, ssoSession, participant)
+		if err != nil {
+			s.logger.Error("Failed to construct SAML logout request", log.String("entityId", clientID),
+				log.Error(err))
+			continue
+		}
+		uris = append(uris, uri)
+	}
+	return uris
+}
+
+// buildLogoutRequestURI builds the signed HTTP-Redirect binding logout request for a service provider
+// of the session. Service providers are expected to match the session by its session index.
+func (s *sloService) buildLogoutRequestURI(
+	ctx context.Context, ssoSession *session.Session, sp *inboundmodel.SAMLServiceProvider,
+) (string, error) {
+	request, err := message.BuildLogoutRequest(&message.LogoutRequestParams{
+		Issuer:       s.signer.GetEntityID(),
+		Destination:  sp.SingleLogoutURL,
+		NameID:       ssoSession.UserID,
+		NameIDFormat: sp.NameIDFormat,
+		SessionIndex: message.SessionIndex(ssoSession.ID),
+		IssueInstant: time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return binding.BuildRedirectURL(ctx, sp.SingleLogoutURL, constants.ParamSAMLRequest, request.Bytes(), "",
+		s.signer)
+}
+
+// isSessionOfRequest checks whether the logout request refers to the session. The service provider
+// must take part in the session and, if session indexes are given, one of them must identify it.
+func isSessionOfRequest(ssoSession *session.Session, request *message.LogoutRequest, spEntityID string) bool {
+	if !slices.Contains(ssoSession.ClientIDs, spEntityID) {
+		return false
+	}
+	if len(request.SessionIndexes) == 0 {
+		return true
+	}
+	return slices.Contains(request.SessionIndexes, message.SessionIndex(ssoSession.ID))
+}
@@ -0,0 +1,293 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package slo
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/saml/saml2/binding"
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/saml/saml2/message"
+	"github.com/asgardeo/thunder/internal/session"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/xmldsig"
+	"github.com/asgardeo/thunder/tests/mocks/inboundclientmock"
+	"github.com/asgardeo/thunder/tests/mocks/saml/saml2/signermock"
+	"github.com/asgardeo/thunder/tests/mocks/sessionmock"
+)
+
+const (
+	testIdPEntityID = "https://localhost:8090"
+	testSPEntityID  = "https://sp.example.com"
+	testSPSLOURL    = "https://sp.example.com/slo"
+	testOtherSP     = "https://other.example.com"
+	testOtherSLOURL = "https://other.example.com/slo"
+	testOAuthClient = "oauth-client"
+	testUserID      = "user-1"
+	testSessionID   = "session-1"
+	testRelayState  = "relay"
+)
+
+type SLOServiceTestSuite struct {
+	suite.Suite
+	mockInboundClient  *inboundclientmock.InboundClientServiceInterfaceMock
+	mockSessionService *sessionmock.SessionServiceInterfaceMock
+	mockSigner         *signermock.SAMLSignerInterfaceMock
+	service            SLOServiceInterface
+	ctx                context.Context
+}
+
+func TestSLOServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SLOServiceTestSuite))
+}
+
+func (s *SLOServiceTestSuite) SetupTest() {
+	s.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(s.T())
+	s.mockSessionService = sessionmock.NewSessionServiceInterfaceMock(s.T())
+	s.mockSigner = signermock.NewSAMLSignerInterfaceMock(s.T())
+	s.mockSigner.EXPECT().GetEntityID().Return(testIdPEntityID).Maybe()
+	s.mockSigner.EXPECT().SignElement(mock.Anything, mock.Anything, message.SignaturePosition).
+		Return(nil).Maybe()
+	s.mockSigner.EXPECT().GetSignatureAlgorithm().Return(xmldsig.AlgorithmRSASHA256).Maybe()
+	s.mockSigner.EXPECT().Sign(mock.Anything, mock.Anything).Return([]byte("signature"), nil).Maybe()
+	s.service = newSLOService(s.mockInboundClient, s.mockSessionService, s.mockSigner)
+	s.ctx = context.Background()
+}
+
+// logoutRequest builds a LogoutRequest message from the service provider with the given session index.
+func logoutRequest(sessionIndex string) *binding.Message {
+	index := ""
+	if sessionIndex != "" {
+		index = `<samlp:SessionIndex>` + sessionIndex + `</samlp:SessionIndex>`
+	}
+	data := `<samlp:LogoutRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ` +
+		`xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_lr1" Version="2.0" ` +
+		`IssueInstant="2026-01-01T00:00:00Z"><saml:Issuer>` + testSPEntityID + `</saml:Issuer>` +
+		`<saml:NameID>` + testUserID + `</saml:NameID>` + index + `</samlp:LogoutRequest>`
+	return &binding.Message{Binding: constants.BindingHTTPPost, Data: []byte(data), RelayState: testRelayState}
+}
+
+func serviceProvider(entityID, sloURL string) *inboundmodel.SAMLServiceProvider {
+	return &inboundmodel.SAMLServiceProvider{
+		SAMLProfile: inboundmodel.SAMLProfile{
+			EntityID:        entityID,
+			SingleLogoutURL: sloURL,
+			NameIDFormat:    constants.NameIDFormatPersistent,
+		},
+	}
+}
+
+func (s *SLOServiceTestSuite) activeSession() *session.Session {
+	return &session.Session{
+		ID:        testSessionID,
+		UserID:    testUserID,
+		ClientIDs: []string{testSPEntityID, testOtherSP, testOAuthClient},
+	}
+}
+
+// parseStatus returns the status code of a logout response.
+func (s *SLOServiceTestSuite) parseStatus(response *binding.PostMessage) string {
+	parsed, err := message.ParseLogoutResponse(response.Data)
+	s.Require().NoError(err)
+	return parsed.StatusCode
+}
+
+func (s *SLOServiceTestSuite) TestHandleLogoutRequest_TerminatesSessionAndNotifiesParticipants() {
+	s.mockInboundClient.EXPECT().GetSAMLServiceProvider(mock.Anything, testSPEntityID).
+		Return(serviceProvider(testSPEntityID, testSPSLOURL), nil)
+	s.mockInboundClient.EXPECT().GetSAMLServiceProvider(mock.Anything, testOtherSP).
+		Return(serviceProvider(testOtherSP, testOtherSLOURL), nil)
+	s.mockInboundClient.EXPECT().GetSAMLServiceProvider(mock.Anything, testOAuthClient).Return(nil, nil)
+	s.mockSessionService.EXPECT().GetSession(mock.Anything, testSessionID).Return(s.activeSession(), nil)
+	s.mockSessionService.EXPECT().TerminateSession(mock.Anything, testSessionID).Return(nil)
+
+	result, sloErr := s.service.HandleLogoutRequest(s.ctx, logoutRequest(message.SessionIndex(testSessionID)),
+		testSessionID)
+
+	s.Nil(sloErr)
+	s.Require().Len(result.FrontChannelLogoutURIs, 1)
+	logoutURI, err := url.Parse(result.FrontChannelLogoutURIs[0])
+	s.Require().NoError(err)
+	s.Equal(testOtherSLOURL, logoutURI.Scheme+"://"+logoutURI.Host+logoutURI.Path)
+	s.Equal(xmldsig.AlgorithmRSASHA256, logoutURI.Query().Get(constants.ParamSigAlg))
+	s.NotEmpty(logoutURI.Query().Get(constants.ParamSignature))
+	data, err := binding.DecodeRedirectMessage(logoutURI.Query().Get(constants.ParamSAMLRequest))
+	s.Require().NoError(err)
+	request, err := message.ParseLogoutRequest(data)
+	s.Require().NoError(err)
+	s.Equal(testIdPEntityID, request.Issuer)
+	s.Equal(testUserID, request.NameID)
+	s.Equal([]string{message.SessionIndex(testSessionID)}, request.SessionIndexes)
+
+	s.Require().NotNil(result.Response)
+	s.Equal(testSPSLOURL, result.Response.Destination)
+	s.Equal(constants.ParamSAMLResponse, result.Response.Param)
+	s.Equal(testRelayState, result.Response.RelayState)
+	s.Equal(constants.StatusSuccess, s.parseStatus(result.Response))
+}
+
+func (s *SLOServiceTestSuite) TestHandleLogoutRequest_WithoutSessionIndex() {
+	s.mockInboundClient.EXPECT().GetSAMLServiceProvider(mock.Anything, testSPEntityID).
+		Return(serviceProvider(testSPEntityID, ""), nil)
+	s.mockInboundClient.EXPECT().GetSAMLServiceProvider(mock.Anything, mock.Anything).Return(nil, nil)
+	s.mockSessionService.EXPECT().GetSession(mock.Anything, testSessionID).Return(s.activeSession(), nil)
+	s.mockSessionService.EXPECT().TerminateSession(mock.Anything, testSessionID).Return(nil)
+
+	result, sloErr := s.service.HandleLogoutRequest(s.ctx, logoutRequest(""), testSessionID)
+
+	s.Nil(sloErr)
+	s.Empty(result.FrontChannelLogoutURIs)
+	s.Nil(result.Response)
+}
+
+func (s *SLOServiceTestSuite) TestHandleLogoutRequest_SessionIndexMismatch() {
+	s.mockInboundClient.EXPECT().GetSAMLServiceProvider(mock.Anything, testSPEntityID).
+		Return(serviceProvider(testSPEntityID, testSPSLOURL), nil)
+	s.mockSessionService.EXPECT().GetSession(mock.Anything, testSessionID).Return(s.activeSession(), nil)
+
+	result, sloErr := s.service.HandleLogoutRequest(s.ctx, logoutRequest(message.SessionIndex("other")),
+		testSessionID)
+
+	s.Nil(sloErr)
+	s.Empty(result.FrontChannelLogoutURIs)
+	s.Equal(constants.StatusSuccess, s.parseStatus(result.Response))
+	s.mockSessionService.AssertNotCalled(s.T(), "TerminateSession", mock.Anything, mock.Anything)
+}
+
+func (s *SLOServiceTestSuite) TestHandleLogoutRequest_NotASessionParticipant() {
+	s.mockInboundClient.EXPECT().GetSAMLServiceProvider(mock.Anything, testSPEntityID).
+		Return(serviceProvider(testSPEntityID, testSPSLOURL), nil)
+	s.mockSessionService.EXPECT().GetSession(mock.Anything, testSessionID).
+		Return(&session.Session{ID: testSessionID, UserID: testUserID, ClientIDs: []string{testOtherSP}}, nil)
+
+	result, sloErr := s.service.HandleLogoutRequest(s.ctx, logoutRequest(""), testSessionID)
+
+	s.Nil(sloErr)
+	s.Equal(constants.StatusSuccess, s.parseStatus(result.Response))
+	s.mockSessionService.AssertNotCalled(s.T(), "TerminateSession", mock.Anything, mock.Anything)
+}
+
+func (s *SLOServiceTestSuite) TestHandleLogoutRequest_NoSession() {
+	s.mockInboundClient.EXPECT().GetSAMLServiceProvider(mock.Anything, testSPEntityID).
+		Return(serviceProvider(testSPEntityID, testSPSLOURL), nil)
+	s.mockSessionService.EXPECT().GetSession(mock.Anything, testSessionID).
+		Return(nil, &session.ErrorSessionNotFound)
+
+	result, sloErr := s.service.HandleLogoutRequest(s.ctx, logoutRequest(""), testSessionID)
+
+	s.Nil(sloErr)
+	s.Equal(constants.StatusSuccess, s.parseStatus(result.Response))
+}
+
+func (s *SLOServiceTestSuite) TestHandleLogoutRequest_TerminationFailure() {
+	s.mockInboundClient.EXPECT().GetSAMLServiceProvider(mock.Anything, testSPEntityID).
+		Return(serviceProvider(testSPEntityID, testSPSLOURL), nil)
+	s.mockSessionService.EXPECT().GetSession(mock.Anything, testSessionID).Return(s.activeSession(), nil)
+	s.mockSessionService.EXPECT().TerminateSession(mock.Anything, testSessionID).
+		Return(&serviceerror.ServiceError{Type: serviceerror.ServerErrorType})
+
+	result, sloErr := s.service.HandleLogoutRequest(s.ctx, logoutRequest(""), testSessionID)
+
+	s.Nil(sloErr)
+	s.Empty(result.FrontChannelLogoutURIs)
+	s.Equal(constants.StatusResponder, s.parseStatus(result.Response))
+}
+
+func (s *SLOServiceTestSuite) TestHandleLogoutRequest_InvalidMessage() {
+	result, sloErr := s.service.HandleLogoutRequest(s.ctx, &binding.Message{Data: []byte("<invalid")}, "")
+
+	s.Nil(result)
+	s.Equal(&errInvalidLogoutMessage, sloErr)
+}
+
+func (s *SLOServiceTestSuite) TestHandleLogoutRequest_UnknownServiceProvider() {
+	s.mockInboundClient.EXPECT().GetSAMLServiceProvider(mock.Anything, testSPEntityID).Return(nil, nil)
+
+	_, sloErr := s.service.HandleLogoutRequest(s.ctx, logoutRequest(""), testSessionID)
+
+	s.Equal(&errUnknownServiceProvider, sloErr)
+}
+
+func (s *SLOServiceTestSuite) TestHandleLogoutRequest_ServiceProviderLookupError() {
+	s.mockInboundClient.EXPECT().GetSAMLServiceProvider(mock.Anything, testSPEntityID).
+		Return(nil, errors.New("db error"))
+
+	_, sloErr := s.service.HandleLogoutRequest(s.ctx, logoutRequest(""), testSessionID)
+
+	s.Equal(&errServerError, sloErr)
+}
+
+func (s *SLOServiceTestSuite) TestHandleLogoutRequest_SignatureRequired() {
+	sp := serviceProvider(testSPEntityID, testSPSLOURL)
+	sp.RequireSignedRequests = true
+	s.mockInboundClient.EXPECT().GetSAMLServiceProvider(mock.Anything, testSPEntityID).Return(sp, nil)
+
+	_, sloErr := s.service.HandleLogoutRequest(s.ctx, logoutRequest(""), testSessionID)
+
+	s.Equal(&errInvalidSignature, sloErr)
+	s.mockSessionService.AssertNotCalled(s.T(), "GetSession", mock.Anything, mock.Anything)
+}
+
+func (s *SLOServiceTestSuite) TestHandleLogoutResponse() {
+	response, err := message.BuildLogoutResponse(&message.ResponseParams{
+		Issuer:       testOtherSP,
+		InResponseTo: "_lr1",
+		StatusCode:   constants.StatusPartialLogout,
+	})
+	s.Require().NoError(err)
+	s.mockInboundClient.EXPECT().GetSAMLServiceProvider(mock.Anything, testOtherSP).
+		Return(serviceProvider(testOtherSP, testOtherSLOURL), nil)
+
+	sloErr := s.service.HandleLogoutResponse(s.ctx,
+		&binding.Message{Binding: constants.BindingHTTPPost, Data: response.Bytes()})
+
+	s.Nil(sloErr)
+}
+
+func (s *SLOServiceTestSuite) TestHandleLogoutResponse_Invalid() {
+	sloErr := s.service.HandleLogoutResponse(s.ctx, logoutRequest(""))
+	s.Equal(&errInvalidLogoutMessage, sloErr)
+
+	response, err := message.BuildLogoutResponse(&message.ResponseParams{Issuer: "https://unknown.example.com"})
+	s.Require().NoError(err)
+	s.mockInboundClient.EXPECT().GetSAMLServiceProvider(mock.Anything, "https://unknown.example.com").
+		Return(nil, nil)
+	sloErr = s.service.HandleLogoutResponse(s.ctx, &binding.Message{Data: response.Bytes()})
+	s.Equal(&errUnknownServiceProvider, sloErr)
+}
+
+func (s *SLOServiceTestSuite) TestIsSessionOfRequest() {
+	ssoSession := s.activeSession()
+
+	s.True(isSessionOfRequest(ssoSession, &message.LogoutRequest{}, testSPEntityID))
+	s.True(isSessionOfRequest(ssoSession, &message.LogoutRequest{
+		SessionIndexes: []string{"_other", message.SessionIndex(testSessionID)},
+	}, testSPEntityID))
+	s.False(isSessionOfRequest(ssoSession, &message.LogoutRequest{
+		SessionIndexes: []string{testSessionID},
+	}, testSPEntityID))
+	s.False(isSessionOfRequest(ssoSession, &message.LogoutRequest{}, "https://unknown.example.com"))
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sso
+
+// Error codes reported on the error page.
+const (
+	errorCodeInvalidRequest = "invalid_request"
+	errorCodeServerError    = "server_error"
+)
+
+var (
+	// errInvalidAuthnRequest is returned when the authentication request cannot be parsed.
+	errInvalidAuthnRequest = SSOError{
+		Code:    errorCodeInvalidRequest,
+		Message: "Invalid SAML authentication request",
+	}
+	// errUnknownServiceProvider is returned when the issuer is not a registered service provider.
+	errUnknownServiceProvider = SSOError{
+		Code:    errorCodeInvalidRequest,
+		Message: "Unknown SAML service provider",
+	}
+	// errInvalidRequestSignature is returned when the request signature is missing or invalid.
+	errInvalidRequestSignature = SSOError{
+		Code:    errorCodeInvalidRequest,
+		Message: "Invalid SAML authentication request signature",
+	}
+	// errUnregisteredACSURL is returned when the requested assertion consumer service URL is not registered.
+	errUnregisteredACSURL = SSOError{
+		Code:    errorCodeInvalidRequest,
+		Message: "Unregistered assertion consumer service URL",
+	}
+	// errInvalidAuthID is returned when the callback does not match a pending authentication request.
+	errInvalidAuthID = SSOError{
+		Code:    errorCodeInvalidRequest,
+		Message: "Invalid or expired SAML authentication request",
+	}
+	// errInvalidResponseKey is returned when the response key does not match a pending response.
+	errInvalidResponseKey = SSOError{
+		Code:    errorCodeInvalidRequest,
+		Message: "Invalid or expired SAML response",
+	}
+	// errServerError is returned for unexpected server-side failures.
+	errServerError = SSOError{
+		Code:    errorCodeServerError,
+		Message: "Failed to process SAML authentication request",
+	}
+)
@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sso
+
+import (
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/saml/saml2/binding"
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	saml2utils "github.com/asgardeo/thunder/internal/saml/saml2/utils"
+	"github.com/asgardeo/thunder/internal/session"
+	"github.com/asgardeo/thunder/internal/system/log"
+	"github.com/asgardeo/thunder/internal/system/utils"
+)
+
+// ssoHandler handles the SAML 2.0 single sign-on endpoints.
+type ssoHandler struct {
+	service SSOServiceInterface
+	logger  *log.Logger
+}
+
+// newSSOHandler creates a new SAML single sign-on handler (internal use).
+func newSSOHandler(service SSOServiceInterface) *ssoHandler {
+	return &ssoHandler{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SAMLSSOHandler")),
+	}
+}
+
+// HandleSSORequest handles authentication requests sent with the HTTP-Redirect (GET) or HTTP-POST
+// (POST) binding.
+func (h *ssoHandler) HandleSSORequest(w http.ResponseWriter, r *http.Request) {
+	msg, err := binding.ReadMessage(r, constants.ParamSAMLRequest)
+	if err != nil {
+		h.logger.Debug("Failed to read SAML authentication request", log.Error(err))
+		h.redirectToErrorPage(w, r, errInvalidAuthnRequest.Code, errInvalidAuthnRequest.Message)
+		return
+	}
+
+	result, ssoErr := h.service.HandleAuthnRequest(r.Context(), msg, session.GetSessionIDFromRequest(r))
+	if ssoErr != nil {
+		h.redirectToErrorPage(w, r, ssoErr.Code, ssoErr.Message)
+		return
+	}
+	if result.Response != nil {
+		h.writePostForm(w, result.Response)
+		return
+	}
+
+	loginPageURL, err := saml2utils.GetLoginPageURL(result.QueryParams)
+	if err != nil {
+		h.logger.Error("Failed to construct login page URL", log.Error(err))
+		h.redirectToErrorPage(w, r, errServerError.Code, errServerError.Message)
+		return
+	}
+	http.Redirect(w, r, loginPageURL, http.StatusFound)
+}
+
+// HandleAuthCallbackRequest handles the callback from the gate client with the assertion issued by
+// the flow after a successful authentication.
+func (h *ssoHandler) HandleAuthCallbackRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := utils.DecodeJSONBody[authCallbackRequest](r)
+	if err != nil || request.AuthID == "" {
+		utils.WriteJSONError(w, errorCodeInvalidRequest, "Invalid SAML authentication callback request",
+			http.StatusBadRequest, nil)
+		return
+	}
+
+	result, ssoErr := h.service.HandleAuthCallback(r.Context(), request.AuthID, request.Assertion)
+	if ssoErr != nil {
+		errorPageURL, err := saml2utils.GetErrorPageURL(ssoErr.Code, ssoErr.Message)
+		if err != nil {
+			h.logger.Error("Failed to construct error page URL", log.Error(err))
+			http.Error(w, "Failed to redirect to error page", http.StatusInternalServerError)
+			return
+		}
+		utils.WriteSuccessResponse(w, http.StatusOK, authCallbackResponse{RedirectURI: errorPageURL})
+		return
+	}
+
+	if result.Session != nil {
+		session.SetSessionCookie(w, result.Session)
+	}
+	utils.WriteSuccessResponse(w, http.StatusOK, authCallbackResponse{RedirectURI: result.RedirectURI})
+}
+
+// HandleSSOResponseRequest delivers a pending response to the service provider with the HTTP-POST
+// binding.
+func (h *ssoHandler) HandleSSOResponseRequest(w http.ResponseWriter, r *http.Request) {
+	response, ssoErr := h.service.GetResponse(r.Context(), r.URL.Query().Get(responseKeyParam))
+	if ssoErr != nil {
+		h.redirectToErrorPage(w, r, ssoErr.Code, ssoErr.Message)
+		return
+	}
+	h.writePostForm(w, response)
+}
+
+// writePostForm writes the page that posts the response to the assertion consumer service.
+func (h *ssoHandler) writePostForm(w http.ResponseWriter, response *binding.PostMessage) {
+	if err := binding.WritePostForm(w, response); err != nil {
+		h.logger.Error("Failed to render SAML response form", log.Error(err))
+	}
+}
+
+// redirectToErrorPage redirects the user agent to the gate error page with the given error.
+func (h *ssoHandler) redirectToErrorPage(w http.ResponseWriter, r *http.Request, code, msg string) {
+	redirectURL, err := saml2utils.GetErrorPageURL(code, msg)
+	if err != nil {
+		h.logger.Error("Failed to construct error page URL", log.Error(err))
+		http.Error(w, "Failed to redirect to error page", http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, r, redirectURL, http.StatusFound)
+}
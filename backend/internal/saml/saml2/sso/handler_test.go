@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sso
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/saml/saml2/binding"
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/session"
+	"github.com/asgardeo/thunder/internal/system/config"
+)
+
+const testSessionCookieName = "thunder_session"
+
+type SSOHandlerTestSuite struct {
+	suite.Suite
+	mockService *SSOServiceInterfaceMock
+	handler     *ssoHandler
+}
+
+func TestSSOHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SSOHandlerTestSuite))
+}
+
+func (s *SSOHandlerTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("test", &config.Config{
+		GateClient: config.GateClientConfig{
+			Scheme:    "https",
+			Hostname:  "localhost",
+			Port:      3000,
+			LoginPath: "/signin",
+			ErrorPath: "/error",
+		},
+	})
+
+	s.mockService = NewSSOServiceInterfaceMock(s.T())
+	s.handler = newSSOHandler(s.mockService)
+}
+
+func (s *SSOHandlerTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *SSOHandlerTestSuite) redirectRequest(sessionID string) *http.Request {
+	encoded, err := binding.EncodeRedirectMessage([]byte("<samlp:AuthnRequest/>"))
+	s.Require().NoError(err)
+	req := httptest.NewRequest(http.MethodGet,
+		constants.EndpointSSO+"?"+url.Values{constants.ParamSAMLRequest: {encoded}}.Encode(), nil)
+	if sessionID != "" {
+		req.AddCookie(&http.Cookie{Name: testSessionCookieName, Value: sessionID})
+	}
+	return req
+}
+
+func (s *SSOHandlerTestSuite) TestHandleSSORequest_RedirectsToLogin() {
+	s.mockService.EXPECT().HandleAuthnRequest(mock.Anything,
+		mock.MatchedBy(func(msg *binding.Message) bool { return msg.IsRedirect() }), testSessionID).
+		Return(&AuthnRequestResult{QueryParams: map[string]string{constants.AuthID: "auth-key"}}, nil)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleSSORequest(rr, s.redirectRequest(testSessionID))
+
+	s.Equal(http.StatusFound, rr.Code)
+	s.Equal("https://localhost:3000/signin?authId=auth-key", rr.Header().Get("Location"))
+}
+
+func (s *SSOHandlerTestSuite) TestHandleSSORequest_PostsResponse() {
+	s.mockService.EXPECT().HandleAuthnRequest(mock.Anything, mock.Anything, "").
+		Return(&AuthnRequestResult{Response: &binding.PostMessage{
+			Destination: testACSURL,
+			Param:       constants.ParamSAMLResponse,
+			Data:        []byte("<samlp:Response/>"),
+		}}, nil)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleSSORequest(rr, s.redirectRequest(""))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Contains(rr.Body.String(), `action="`+testACSURL+`"`)
+	s.Contains(rr.Body.String(), base64.StdEncoding.EncodeToString([]byte("<samlp:Response/>")))
+}
+
+func (s *SSOHandlerTestSuite) TestHandleSSORequest_MissingMessage() {
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleSSORequest(rr, httptest.NewRequest(http.MethodGet, constants.EndpointSSO, nil))
+
+	s.Equal(http.StatusFound, rr.Code)
+	s.True(strings.HasPrefix(rr.Header().Get("Location"), "https://localhost:3000/error?"))
+	s.Contains(rr.Header().Get("Location"), "errorCode=invalid_request")
+}
+
+func (s *SSOHandlerTestSuite) TestHandleSSORequest_ServiceError() {
+	s.mockService.EXPECT().HandleAuthnRequest(mock.Anything, mock.Anything, "").
+		Return(nil, &errUnregisteredACSURL)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleSSORequest(rr, s.redirectRequest(""))
+
+	s.Equal(http.StatusFound, rr.Code)
+	location, err := url.Parse(rr.Header().Get("Location"))
+	s.Require().NoError(err)
+	s.Equal("/error", location.Path)
+	s.Equal(errUnregisteredACSURL.Message, location.Query().Get("errorMessage"))
+}
+
+func (s *SSOHandlerTestSuite) TestHandleAuthCallbackRequest_Success() {
+	ssoSession := &session.Session{ID: testSessionID, UserID: testUserID, AuthTime: time.Now()}
+	s.mockService.EXPECT().HandleAuthCallback(mock.Anything, "auth-key", "assertion").
+		Return(&AuthCallbackResult{RedirectURI: "https://localhost:8090/saml2/sso/response?key=k",
+			Session: ssoSession}, nil)
+	req := httptest.NewRequest(http.MethodPost, constants.EndpointSSOCallback,
+		strings.NewReader(`{"authId":"auth-key","assertion":"assertion"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleAuthCallbackRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body authCallbackResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal("https://localhost:8090/saml2/sso/response?key=k", body.RedirectURI)
+	cookies := rr.Result().Cookies()
+	s.Require().Len(cookies, 1)
+	s.Equal(testSessionCookieName, cookies[0].Name)
+	s.Equal(testSessionID, cookies[0].Value)
+}
+
+func (s *SSOHandlerTestSuite) TestHandleAuthCallbackRequest_ServiceError() {
+	s.mockService.EXPECT().HandleAuthCallback(mock.Anything, "auth-key", "").Return(nil, &errInvalidAuthID)
+	req := httptest.NewRequest(http.MethodPost, constants.EndpointSSOCallback,
+		strings.NewReader(`{"authId":"auth-key"}`))
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleAuthCallbackRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body authCallbackResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.True(strings.HasPrefix(body.RedirectURI, "https://localhost:3000/error?"))
+	s.Empty(rr.Result().Cookies())
+}
+
+func (s *SSOHandlerTestSuite) TestHandleAuthCallbackRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, constants.EndpointSSOCallback, strings.NewReader(`{}`))
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleAuthCallbackRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *SSOHandlerTestSuite) TestHandleSSOResponseRequest() {
+	s.mockService.EXPECT().GetResponse(mock.Anything, "k").Return(&binding.PostMessage{
+		Destination: testACSURL,
+		Param:       constants.ParamSAMLResponse,
+		Data:        []byte("<samlp:Response/>"),
+		RelayState:  testRelayState,
+	}, nil)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleSSOResponseRequest(rr,
+		httptest.NewRequest(http.MethodGet, constants.EndpointSSOResponse+"?key=k", nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Contains(rr.Body.String(), `name="RelayState" value="`+testRelayState+`"`)
+}
+
+func (s *SSOHandlerTestSuite) TestHandleSSOResponseRequest_InvalidKey() {
+	s.mockService.EXPECT().GetResponse(mock.Anything, "").Return(nil, &errInvalidResponseKey)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleSSOResponseRequest(rr, httptest.NewRequest(http.MethodGet, constants.EndpointSSOResponse, nil))
+
+	s.Equal(http.StatusFound, rr.Code)
+	s.Contains(rr.Header().Get("Location"), "errorCode=invalid_request")
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sso
+
+import (
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/attributecache"
+	"github.com/asgardeo/thunder/internal/flow/flowexec"
+	"github.com/asgardeo/thunder/internal/inboundclient"
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/saml/saml2/signer"
+	"github.com/asgardeo/thunder/internal/session"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/internal/system/middleware"
+)
+
+// Initialize initializes the SAML single sign-on service and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	flowExecService flowexec.FlowExecServiceInterface,
+	jwtService jwt.JWTServiceInterface,
+	attributeCacheSvc attributecache.AttributeCacheServiceInterface,
+	sessionService session.SessionServiceInterface,
+	samlSigner signer.SAMLSignerInterface,
+) SSOServiceInterface {
+	ssoService := newSSOService(inboundClient, flowExecService, jwtService, attributeCacheSvc,
+		sessionService, samlSigner, initializeSAMLRequestStore())
+	registerRoutes(mux, newSSOHandler(ssoService))
+	return ssoService
+}
+
+// initializeSAMLRequestStore selects the SAML request store implementation based on the configured
+// runtime DB type.
+func initializeSAMLRequestStore() samlRequestStoreInterface {
+	deploymentID := config.GetServerRuntime().Config.Server.Identifier
+
+	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		return newRedisSAMLRequestStore(provider.GetRedisProvider(), deploymentID)
+	}
+	return newSAMLRequestStore(deploymentID)
+}
+
+// registerRoutes registers the routes for the SAML single sign-on endpoints.
+func registerRoutes(mux *http.ServeMux, handler *ssoHandler) {
+	// CORS is not enabled on the single sign-on endpoints since the user agent is sent to them.
+	mux.HandleFunc("GET "+constants.EndpointSSO, handler.HandleSSORequest)
+	mux.HandleFunc("POST "+constants.EndpointSSO, handler.HandleSSORequest)
+	mux.HandleFunc("GET "+constants.EndpointSSOResponse, handler.HandleSSOResponseRequest)
+
+	callbackOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST "+constants.EndpointSSOCallback,
+		handler.HandleAuthCallbackRequest, callbackOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+constants.EndpointSSOCallback,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, callbackOpts))
+}
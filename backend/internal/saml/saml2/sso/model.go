@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sso
+
+import (
+	"github.com/asgardeo/thunder/internal/saml/saml2/binding"
+	"github.com/asgardeo/thunder/internal/session"
+)
+
+// samlRequestRecord is an entry of the SAML request store. It holds either the context of an
+// authentication request awaiting the flow or a response awaiting delivery to the service provider.
+type samlRequestRecord struct {
+	AuthnRequest *authnRequestContext `json:"authnRequest,omitempty"`
+	Response     *binding.PostMessage `json:"response,omitempty"`
+}
+
+// authnRequestContext holds the authentication request details needed to respond once the flow completes.
+type authnRequestContext struct {
+	RequestID  string `json:"requestId"`
+	SPEntityID string `json:"spEntityId"`
+	ACSURL     string `json:"acsUrl"`
+	RelayState string `json:"relayState,omitempty"`
+}
+
+// AuthnRequestResult holds the result of processing an authentication request. Response is set when
+// the request was answered directly, either from an existing SSO session or with an error status;
+// otherwise QueryParams carries the parameters for the login page redirect.
+type AuthnRequestResult struct {
+	Response    *binding.PostMessage
+	QueryParams map[string]string
+}
+
+// AuthCallbackResult holds the result of processing the flow callback.
+type AuthCallbackResult struct {
+	// RedirectURI is the URI the user agent navigates to in order to deliver the response.
+	RedirectURI string
+	// Session is the SSO session established for the user, if any.
+	Session *session.Session
+}
+
+// SSOError represents an error that is reported on the error page instead of to the service provider.
+type SSOError struct {
+	Code    string
+	Message string
+}
+
+// authCallbackRequest represents the request body of the flow callback.
+type authCallbackRequest struct {
+	AuthID    string `json:"authId"`
+	Assertion string `json:"assertion"`
+}
+
+// authCallbackResponse represents the response body of the flow callback.
+type authCallbackResponse struct {
+	RedirectURI string `json:"redirect_uri"`
+}
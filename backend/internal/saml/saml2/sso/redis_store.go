@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sso
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// samlRedisClient abstracts the Redis commands used by the SAML request store.
+type samlRedisClient interface {
+	Set(ctx context.Context, key string, value any, expiration time.Duration) *redis.StatusCmd
+	GetDel(ctx context.Context, key string) *redis.StringCmd
+}
+
+// redisSAMLRequestStore is the Redis-backed implementation of samlRequestStoreInterface.
+type redisSAMLRequestStore struct {
+	client       samlRedisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// newRedisSAMLRequestStore creates a new Redis-backed SAML request store.
+func newRedisSAMLRequestStore(
+	p provider.RedisProviderInterface, deploymentID string,
+) samlRequestStoreInterface {
+	return &redisSAMLRequestStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: deploymentID,
+	}
+}
+
+// samlKey builds the Redis key for a request key.
+func (s *redisSAMLRequestStore) samlKey(key string) string {
+	return fmt.Sprintf("%s:runtime:%s:saml:%s", s.keyPrefix, s.deploymentID, key)
+}
+
+// Store persists a record in Redis with a TTL.
+func (s *redisSAMLRequestStore) Store(
+	ctx context.Context, record samlRequestRecord, expirySeconds int64,
+) (string, error) {
+	key, err := generateRandomKey()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate request key: %w", err)
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal SAML request: %w", err)
+	}
+
+	ttl := time.Duration(expirySeconds) * time.Second
+	if err := s.client.Set(ctx, s.samlKey(key), data, ttl).Err(); err != nil {
+		return "", fmt.Errorf("failed to store SAML request in Redis: %w", err)
+	}
+
+	return key, nil
+}
+
+// Consume atomically retrieves and deletes a record via Redis GETDEL.
+func (s *redisSAMLRequestStore) Consume(ctx context.Context, key string) (samlRequestRecord, bool, error) {
+	data, err := s.client.GetDel(ctx, s.samlKey(key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return samlRequestRecord{}, false, nil
+		}
+		return samlRequestRecord{}, false, fmt.Errorf("failed to get SAML request from Redis: %w", err)
+	}
+
+	var record samlRequestRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return samlRequestRecord{}, false, fmt.Errorf("failed to unmarshal SAML request: %w", err)
+	}
+	return record, true, nil
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sso
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/saml/saml2/binding"
+)
+
+const redisTestKeyPrefix = "thunderid"
+
+type RedisStoreTestSuite struct {
+	suite.Suite
+	mockClient *samlRedisClientMock
+	store      *redisSAMLRequestStore
+	ctx        context.Context
+	testRecord samlRequestRecord
+}
+
+func TestRedisStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisStoreTestSuite))
+}
+
+func (s *RedisStoreTestSuite) SetupTest() {
+	s.mockClient = newSamlRedisClientMock(s.T())
+	s.store = &redisSAMLRequestStore{
+		client:       s.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+	s.testRecord = samlRequestRecord{
+		Response: &binding.PostMessage{
+			Destination: "https://sp.example.com/acs",
+			Param:       "SAMLResponse",
+			Data:        []byte("<samlp:Response/>"),
+		},
+	}
+}
+
+func (s *RedisStoreTestSuite) buildRedisKey(key string) string {
+	return fmt.Sprintf("%s:runtime:%s:saml:%s", redisTestKeyPrefix, testDeploymentID, key)
+}
+
+func (s *RedisStoreTestSuite) TestSAMLKey() {
+	s.Equal(s.buildRedisKey(testRequestKey), s.store.samlKey(testRequestKey))
+}
+
+func (s *RedisStoreTestSuite) TestStore_Success() {
+	const expirySeconds int64 = 60
+	s.mockClient.On("Set", s.ctx,
+		mock.MatchedBy(func(k string) bool {
+			return strings.HasPrefix(k, redisTestKeyPrefix+":runtime:"+testDeploymentID+":saml:")
+		}),
+		mock.Anything,
+		time.Duration(expirySeconds)*time.Second,
+	).Return(redis.NewStatusCmd(s.ctx))
+
+	key, err := s.store.Store(s.ctx, s.testRecord, expirySeconds)
+
+	s.NoError(err)
+	s.NotEmpty(key)
+}
+
+func (s *RedisStoreTestSuite) TestStore_SetError() {
+	statusCmd := redis.NewStatusCmd(s.ctx)
+	statusCmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("Set", s.ctx, mock.Anything, mock.Anything, mock.Anything).Return(statusCmd)
+
+	key, err := s.store.Store(s.ctx, s.testRecord, int64(60))
+
+	s.ErrorContains(err, "failed to store SAML request in Redis")
+	s.Empty(key)
+}
+
+func (s *RedisStoreTestSuite) TestConsume_Success() {
+	data, _ := json.Marshal(s.testRecord)
+	stringCmd := redis.NewStringCmd(s.ctx)
+	stringCmd.SetVal(string(data))
+	s.mockClient.On("GetDel", s.ctx, s.buildRedisKey(testRequestKey)).Return(stringCmd)
+
+	record, found, err := s.store.Consume(s.ctx, testRequestKey)
+
+	s.NoError(err)
+	s.True(found)
+	s.Equal(s.testRecord, record)
+}
+
+func (s *RedisStoreTestSuite) TestConsume_NotFound() {
+	stringCmd := redis.NewStringCmd(s.ctx)
+	stringCmd.SetErr(redis.Nil)
+	s.mockClient.On("GetDel", s.ctx, s.buildRedisKey(testRequestKey)).Return(stringCmd)
+
+	_, found, err := s.store.Consume(s.ctx, testRequestKey)
+
+	s.NoError(err)
+	s.False(found)
+}
+
+func (s *RedisStoreTestSuite) TestConsume_RedisError() {
+	stringCmd := redis.NewStringCmd(s.ctx)
+	stringCmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("GetDel", s.ctx, s.buildRedisKey(testRequestKey)).Return(stringCmd)
+
+	_, found, err := s.store.Consume(s.ctx, testRequestKey)
+
+	s.ErrorContains(err, "failed to get SAML request from Redis")
+	s.False(found)
+}
+
+func (s *RedisStoreTestSuite) TestConsume_InvalidJSON() {
+	stringCmd := redis.NewStringCmd(s.ctx)
+	stringCmd.SetVal("{not valid json")
+	s.mockClient.On("GetDel", s.ctx, s.buildRedisKey(testRequestKey)).Return(stringCmd)
+
+	_, found, err := s.store.Consume(s.ctx, testRequestKey)
+
+	s.Error(err)
+	s.False(found)
+}
@@ -0,0 +1,573 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package sso implements the SAML 2.0 single sign-on service of the identity provider.
+package sso
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/attributecache"
+	flowcm "github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/flowassertion"
+	"github.com/asgardeo/thunder/internal/flow/flowexec"
+	"github.com/asgardeo/thunder/internal/inboundclient"
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/saml/saml2/binding"
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/saml/saml2/message"
+	"github.com/asgardeo/thunder/internal/saml/saml2/signer"
+	saml2utils "github.com/asgardeo/thunder/internal/saml/saml2/utils"
+	"github.com/asgardeo/thunder/internal/session"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/internal/system/log"
+	"github.com/asgardeo/thunder/internal/system/xmldsig"
+)
+
+const (
+	// authnRequestExpirySeconds is the time the user has to complete the authentication flow.
+	authnRequestExpirySeconds = 1800
+	// responseExpirySeconds is the time within which a pending response must be delivered.
+	responseExpirySeconds = 60
+	// attributeCacheTTLSeconds is the lifetime of the user attributes cached by the flow. The
+	// attributes are consumed as soon as the flow calls back.
+	attributeCacheTTLSeconds = 60
+	// responseKeyParam is the query parameter carrying the key of a pending response.
+	responseKeyParam = "key"
+)
+
+// SSOServiceInterface defines the interface of the SAML 2.0 single sign-on service.
+type SSOServiceInterface interface {
+	// HandleAuthnRequest processes an authentication request received from a service provider.
+	HandleAuthnRequest(ctx context.Context, msg *binding.Message, sessionID string) (
+		*AuthnRequestResult, *SSOError)
+	// HandleAuthCallback issues the response for an authentication request once the flow completes.
+	HandleAuthCallback(ctx context.Context, authID string, assertion string) (*AuthCallbackResult, *SSOError)
+	// GetResponse consumes a pending response to deliver it to the service provider.
+	GetResponse(ctx context.Context, key string) (*binding.PostMessage, *SSOError)
+}
+
+// ssoService is the default implementation of the SSOServiceInterface.
+type ssoService struct {
+	inboundClient     inboundclient.InboundClientServiceInterface
+	flowExecService   flowexec.FlowExecServiceInterface
+	jwtService        jwt.JWTServiceInterface
+	attributeCacheSvc attributecache.AttributeCacheServiceInterface
+	sessionService    session.SessionServiceInterface
+	signer            signer.SAMLSignerInterface
+	store             samlRequestStoreInterface
+	logger            *log.Logger
+}
+
+// newSSOService creates a new instance of ssoService with injected dependencies.
+func newSSOService(
+	inboundClient inboundclient.InboundClientServiceInterface,
+	flowExecService flowexec.FlowExecServiceInterface,
+	jwtService jwt.JWTServiceInterface,
+	attributeCacheSvc attributecache.AttributeCacheServiceInterface,
+	sessionService session.SessionServiceInterface,
+	samlSigner signer.SAMLSignerInterface,
+	store samlRequestStoreInterface,
+) SSOServiceInterface {
+	return &ssoService{
+		inboundClient:     inboundClient,
+		flowExecService:   flowExecService,
+		jwtService:        jwtService,
+		attributeCacheSvc: attributeCacheSvc,
+		sessionService:    sessionService,
+		signer:            samlSigner,
+		store:             store,
+		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SAMLSSOService")),
+	}
+}
+
+// HandleAuthnRequest processes an authentication request received from a service provider. Requests
+// that cannot be attributed to a registered assertion consumer service URL are reported on the error
+// page; other failures are answered to the service provider with an error status.
+func (s *ssoService) HandleAuthnRequest(ctx context.Context, msg *binding.Message, sessionID string) (
+	*AuthnRequestResult, *SSOError) {
+	request, err := message.ParseAuthnRequest(msg.Data)
+	if err != nil {
+		s.logger.Debug("Failed to parse SAML authentication request", log.Error(err))
+		return nil, &errInvalidAuthnRequest
+	}
+
+	sp, err := s.inboundClient.GetSAMLServiceProvider(ctx, request.Issuer)
+	if err != nil {
+		s.logger.Error("Failed to retrieve SAML service provider", log.Error(err))
+		return nil, &errServerError
+	}
+	if sp == nil {
+		s.logger.Debug("SAML authentication request from an unknown service provider",
+			log.String("issuer", request.Issuer))
+		return nil, &errUnknownServiceProvider
+	}
+
+	if ssoErr := s.verifyRequestSignature(msg, request, sp); ssoErr != nil {
+		return nil, ssoErr
+	}
+
+	acsURL := request.AssertionConsumerServiceURL
+	if acsURL == "" {
+		acsURL = sp.GetDefaultACSURL()
+	} else if !sp.IsRegisteredACSURL(acsURL) {
+		return nil, &errUnregisteredACSURL
+	}
+	requestCtx := &authnRequestContext{
+		RequestID:  request.ID,
+		SPEntityID: sp.EntityID,
+		ACSURL:     acsURL,
+		RelayState: msg.RelayState,
+	}
+
+	if request.Destination != "" && request.Destination != saml2utils.GetEndpointURL(constants.EndpointSSO) {
+		return s.buildErrorResult(ctx, requestCtx, constants.StatusRequester, "",
+			"The request destination does not match the single sign-on service")
+	}
+	if request.ProtocolBinding != "" && request.ProtocolBinding != constants.BindingHTTPPost {
+		return s.buildErrorResult(ctx, requestCtx, constants.StatusRequester, "",
+			"Only the HTTP-POST binding is supported for responses")
+	}
+	if !isNameIDFormatAllowed(request.NameIDPolicyFormat, sp) {
+		return s.buildErrorResult(ctx, requestCtx, constants.StatusRequester,
+			constants.StatusInvalidNameIDPolicy, "The requested NameID format is not supported")
+	}
+
+	if !request.ForceAuthn {
+		if response := s.respondFromSession(ctx, sessionID, requestCtx, sp); response != nil {
+			return &AuthnRequestResult{Response: response}, nil
+		}
+	}
+	if request.IsPassive {
+		return s.buildErrorResult(ctx, requestCtx, constants.StatusResponder, constants.StatusNoPassive,
+			"User authentication is required")
+	}
+
+	return s.initiateFlowAndStoreRequest(ctx, requestCtx, sp)
+}
+
+// HandleAuthCallback issues the response for an authentication request once the flow completes. The
+// response is held until the user agent fetches it through the returned redirect URI.
+func (s *ssoService) HandleAuthCallback(ctx context.Context, authID string, assertion string) (
+	*AuthCallbackResult, *SSOError) {
+	record, found, err := s.store.Consume(ctx, authID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve SAML authentication request", log.Error(err))
+		return nil, &errServerError
+	}
+	if !found || record.AuthnRequest == nil {
+		return nil, &errInvalidAuthID
+	}
+	requestCtx := record.AuthnRequest
+
+	sp, err := s.inboundClient.GetSAMLServiceProvider(ctx, requestCtx.SPEntityID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve SAML service provider", log.Error(err))
+		return nil, &errServerError
+	}
+	if sp == nil {
+		return nil, &errUnknownServiceProvider
+	}
+
+	if assertion == "" {
+		return s.deliverErrorResponse(ctx, requestCtx, constants.StatusRequester, constants.StatusAuthnFailed,
+			"User authentication failed")
+	}
+	if err := s.jwtService.VerifyJWT(assertion, "", ""); err != nil {
+		s.logger.Debug("Invalid assertion signature", log.String("error", err.Error.DefaultValue))
+		return s.deliverErrorResponse(ctx, requestCtx, constants.StatusResponder, constants.StatusAuthnFailed,
+			"User authentication failed")
+	}
+	claims, err := flowassertion.Decode(assertion)
+	if err != nil || claims.UserID == "" {
+		s.logger.Debug("Failed to decode the flow assertion", log.Error(err))
+		return s.deliverErrorResponse(ctx, requestCtx, constants.StatusResponder, constants.StatusAuthnFailed,
+			"User authentication failed")
+	}
+	authTime := claims.AuthTime
+	if authTime.IsZero() {
+		authTime = time.Now()
+	}
+
+	userAttributes, err := s.getUserAttributes(ctx, claims.AttributeCacheID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve user attributes", log.Error(err))
+		return s.deliverErrorResponse(ctx, requestCtx, constants.StatusResponder, "",
+			"Failed to process SAML authentication request")
+	}
+	nameID := claims.UserID
+	if sp.NameIDAttribute != "" {
+		values := getAttributeValues(userAttributes[sp.NameIDAttribute])
+		if len(values) == 0 {
+			return s.deliverErrorResponse(ctx, requestCtx, constants.StatusResponder,
+				constants.StatusInvalidNameIDPolicy, "The NameID attribute is not available for the user")
+		}
+		nameID = values[0]
+	}
+
+	ssoSession := s.establishSession(ctx, claims, authTime, sp.EntityID)
+	sessionIndex := ""
+	if ssoSession != nil {
+		sessionIndex = message.SessionIndex(ssoSession.ID)
+	}
+
+	response, err := s.buildSuccessResponse(ctx, requestCtx, sp, &message.AssertionParams{
+		NameID:       nameID,
+		SessionIndex: sessionIndex,
+		AuthnInstant: authTime,
+		Attributes:   mapAttributes(sp.AttributeMapping, userAttributes),
+	})
+	if err != nil {
+		s.logger.Error("Failed to build SAML response", log.Error(err))
+		return nil, &errServerError
+	}
+	redirectURI, ssoErr := s.storeResponse(ctx, response)
+	if ssoErr != nil {
+		return nil, ssoErr
+	}
+
+	return &AuthCallbackResult{RedirectURI: redirectURI, Session: ssoSession}, nil
+}
+
+// GetResponse consumes a pending response to deliver it to the service provider.
+func (s *ssoService) GetResponse(ctx context.Context, key string) (*binding.PostMessage, *SSOError) {
+	if key == "" {
+		return nil, &errInvalidResponseKey
+	}
+	record, found, err := s.store.Consume(ctx, key)
+	if err != nil {
+		s.logger.Error("Failed to retrieve pending SAML response", log.Error(err))
+		return nil, &errServerError
+	}
+	if !found || record.Response == nil {
+		return nil, &errInvalidResponseKey
+	}
+	return record.Response, nil
+}
+
+// verifyRequestSignature verifies the signature of the authentication request against the
+// certificate of the service provider.
+func (s *ssoService) verifyRequestSignature(
+	msg *binding.Message, request *message.AuthnRequest, sp *inboundmodel.SAMLServiceProvider,
+) *SSOError {
+	cert, err := sp.GetCertificate()
+	if err != nil {
+		s.logger.Error("Invalid SAML service provider certificate", log.String("entityId", sp.EntityID),
+			log.Error(err))
+		return &errServerError
+	}
+	if err := binding.VerifySignature(msg, constants.ParamSAMLRequest, request.Element, cert,
+		sp.RequireSignedRequests); err != nil {
+		s.logger.Debug("SAML authentication request signature verification failed", log.Error(err))
+		return &errInvalidRequestSignature
+	}
+	return nil
+}
+
+// respondFromSession answers the request from an active SSO session, skipping re-authentication.
+// Returns nil when the request must go through the authentication flow. Service providers that
+// release user attributes always go through the flow since the attributes are resolved within it.
+func (s *ssoService) respondFromSession(
+	ctx context.Context, sessionID string, requestCtx *authnRequestContext, sp *inboundmodel.SAMLServiceProvider,
+) *binding.PostMessage {
+	if sessionID == "" || len(sp.AttributeMapping) > 0 || sp.NameIDAttribute != "" {
+		return nil
+	}
+	ssoSession, svcErr := s.sessionService.GetSession(ctx, sessionID)
+	if svcErr != nil {
+		if svcErr.Code != session.ErrorSessionNotFound.Code {
+			s.logger.Error("Failed to retrieve SSO session",
+				log.String("error", svcErr.ErrorDescription.DefaultValue))
+		}
+		return nil
+	}
+
+	response, err := s.buildSuccessResponse(ctx, requestCtx, sp, &message.AssertionParams{
+		NameID:       ssoSession.UserID,
+		SessionIndex: message.SessionIndex(ssoSession.ID),
+		AuthnInstant: ssoSession.AuthTime,
+	})
+	if err != nil {
+		s.logger.Error("Failed to build SAML response from session", log.Error(err))
+		return nil
+	}
+
+	s.addServiceProviderToSession(ctx, ssoSession.ID, sp.EntityID)
+	s.logger.Debug("SAML authentication request satisfied by an existing session",
+		log.String("entityId", sp.EntityID))
+	return response
+}
+
+// initiateFlowAndStoreRequest initiates the authentication flow of the application and stores the
+// request context until the flow calls back.
+func (s *ssoService) initiateFlowAndStoreRequest(
+	ctx context.Context, requestCtx *authnRequestContext, sp *inboundmodel.SAMLServiceProvider,
+) (*AuthnRequestResult, *SSOError) {
+	// The essential attributes key is always set so that only the attributes released to the
+	// service provider are resolved by the flow.
+	runtimeData := map[string]string{
+		flowcm.RuntimeKeyRequiredEssentialAttributes:   getRequiredAttributes(sp),
+		flowcm.RuntimeKeyUserAttributesCacheTTLSeconds: strconv.Itoa(attributeCacheTTLSeconds),
+	}
+	executionID, flowErr := s.flowExecService.InitiateFlow(ctx, &flowexec.FlowInitContext{
+		ApplicationID: sp.AppID,
+		FlowType:      string(flowcm.FlowTypeAuthentication),
+		RuntimeData:   runtimeData,
+	})
+	if flowErr != nil {
+		s.logger.Error("Failed to initiate authentication flow", log.String("error_code", flowErr.Code))
+		return s.buildErrorResult(ctx, requestCtx, constants.StatusResponder, "",
+			"Failed to process SAML authentication request")
+	}
+
+	authID, err := s.store.Store(ctx, samlRequestRecord{AuthnRequest: requestCtx}, authnRequestExpirySeconds)
+	if err != nil {
+		s.logger.Error("Failed to store SAML authentication request", log.Error(err))
+		return s.buildErrorResult(ctx, requestCtx, constants.StatusResponder, "",
+			"Failed to process SAML authentication request")
+	}
+
+	return &AuthnRequestResult{
+		QueryParams: map[string]string{
+			constants.AuthID:      authID,
+			constants.AppID:       sp.AppID,
+			constants.ExecutionID: executionID,
+		},
+	}, nil
+}
+
+// buildSuccessResponse builds the signed response carrying a signed assertion for the subject.
+func (s *ssoService) buildSuccessResponse(
+	ctx context.Context, requestCtx *authnRequestContext, sp *inboundmodel.SAMLServiceProvider,
+	params *message.AssertionParams,
+) (*binding.PostMessage, error) {
+	now := time.Now()
+	validity := sp.AssertionValidityPeriod
+	if validity <= 0 {
+		validity = constants.DefaultAssertionValiditySecs
+	}
+	params.Issuer = s.signer.GetEntityID()
+	params.Recipient = requestCtx.ACSURL
+	params.InResponseTo = requestCtx.RequestID
+	params.Audiences = sp.GetAudiences()
+	params.NameIDFormat = getNameIDFormat(sp)
+	params.IssueInstant = now
+	params.ValiditySeconds = validity
+
+	assertion, err := message.BuildAssertion(params)
+	if err != nil {
+		return nil, err
+	}
+	response, err := message.BuildResponse(&message.ResponseParams{
+		Issuer:       s.signer.GetEntityID(),
+		Destination:  requestCtx.ACSURL,
+		InResponseTo: requestCtx.RequestID,
+		IssueInstant: now,
+	}, assertion)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.signer.SignElement(ctx, assertion, message.SignaturePosition); err != nil {
+		return nil, fmt.Errorf("failed to sign the assertion: %w", err)
+	}
+
+	return s.signResponse(ctx, requestCtx, response)
+}
+
+// buildErrorResult builds a signed response with the given error status.
+func (s *ssoService) buildErrorResult(
+	ctx context.Context, requestCtx *authnRequestContext, statusCode, subStatusCode, statusMessage string,
+) (*AuthnRequestResult, *SSOError) {
+	response, err := s.buildErrorResponse(ctx, requestCtx, statusCode, subStatusCode, statusMessage)
+	if err != nil {
+		s.logger.Error("Failed to build SAML error response", log.Error(err))
+		return nil, &errServerError
+	}
+	return &AuthnRequestResult{Response: response}, nil
+}
+
+// deliverErrorResponse builds a signed response with the given error status and holds it for delivery.
+func (s *ssoService) deliverErrorResponse(
+	ctx context.Context, requestCtx *authnRequestContext, statusCode, subStatusCode, statusMessage string,
+) (*AuthCallbackResult, *SSOError) {
+	response, err := s.buildErrorResponse(ctx, requestCtx, statusCode, subStatusCode, statusMessage)
+	if err != nil {
+		s.logger.Error("Failed to build SAML error response", log.Error(err))
+		return nil, &errServerError
+	}
+	redirectURI, ssoErr := s.storeResponse(ctx, response)
+	if ssoErr != nil {
+		return nil, ssoErr
+	}
+	return &AuthCallbackResult{RedirectURI: redirectURI}, nil
+}
+
+// buildErrorResponse builds a signed response with the given error status.
+func (s *ssoService) buildErrorResponse(
+	ctx context.Context, requestCtx *authnRequestContext, statusCode, subStatusCode, statusMessage string,
+) (*binding.PostMessage, error) {
+	response, err := message.BuildResponse(&message.ResponseParams{
+		Issuer:        s.signer.GetEntityID(),
+		Destination:   requestCtx.ACSURL,
+		InResponseTo:  requestCtx.RequestID,
+		StatusCode:    statusCode,
+		SubStatusCode: subStatusCode,
+		StatusMessage: statusMessage,
+		IssueInstant:  time.Now(),
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.signResponse(ctx, requestCtx, response)
+}
+
+// signResponse signs the response and wraps it for delivery to the assertion consumer service.
+func (s *ssoService) signResponse(
+	ctx context.Context, requestCtx *authnRequestContext, response *xmldsig.Element,
+) (*binding.PostMessage, error) {
+	if err := s.signer.SignElement(ctx, response, message.SignaturePosition); err != nil {
+		return nil, fmt.Errorf("failed to sign the response: %w", err)
+	}
+	return &binding.PostMessage{
+		Destination: requestCtx.ACSURL,
+		Param:       constants.ParamSAMLResponse,
+		Data:        response.Bytes(),
+		RelayState:  requestCtx.RelayState,
+	}, nil
+}
+
+// storeResponse holds the response for delivery and returns the URI the user agent fetches it from.
+func (s *ssoService) storeResponse(ctx context.Context, response *binding.PostMessage) (string, *SSOError) {
+	key, err := s.store.Store(ctx, samlRequestRecord{Response: response}, responseExpirySeconds)
+	if err != nil {
+		s.logger.Error("Failed to store SAML response", log.Error(err))
+		return "", &errServerError
+	}
+	return saml2utils.GetEndpointURL(constants.EndpointSSOResponse) + "?" + responseKeyParam + "=" + key, nil
+}
+
+// establishSession creates an SSO session for the user authenticated by the flow and records the
+// service provider against it. Failures are logged and the response is issued without a session.
+func (s *ssoService) establishSession(
+	ctx context.Context, claims flowassertion.Claims, authTime time.Time, spEntityID string,
+) *session.Session {
+	ssoSession, svcErr := s.sessionService.CreateSession(ctx, claims.UserID, authTime, claims.CompletedACR)
+	if svcErr != nil {
+		s.logger.Error("Failed to create SSO session",
+			log.String("error", svcErr.ErrorDescription.DefaultValue))
+		return nil
+	}
+
+	s.addServiceProviderToSession(ctx, ssoSession.ID, spEntityID)
+	return ssoSession
+}
+
+// addServiceProviderToSession records the service provider against the SSO session so that it takes
+// part in single logout. Failures are logged and otherwise ignored.
+func (s *ssoService) addServiceProviderToSession(ctx context.Context, sessionID, spEntityID string) {
+	if svcErr := s.sessionService.AddClientToSession(ctx, sessionID, spEntityID); svcErr != nil {
+		s.logger.Error("Failed to add service provider to SSO session", log.String("entityId", spEntityID),
+			log.String("error", svcErr.ErrorDescription.DefaultValue))
+	}
+}
+
+// getUserAttributes returns the user attributes cached by the flow and removes them from the cache.
+func (s *ssoService) getUserAttributes(ctx context.Context, attributeCacheID string) (map[string]any, error) {
+	if attributeCacheID == "" {
+		return map[string]any{}, nil
+	}
+	cache, svcErr := s.attributeCacheSvc.GetAttributeCache(ctx, attributeCacheID)
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to retrieve the attribute cache: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+	if svcErr := s.attributeCacheSvc.DeleteAttributeCache(ctx, attributeCacheID); svcErr != nil {
+		s.logger.Debug("Failed to delete the attribute cache",
+			log.String("error", svcErr.ErrorDescription.DefaultValue))
+	}
+	return cache.Attributes, nil
+}
+
+// isNameIDFormatAllowed checks whether the NameID format requested by the service provider can be
+// satisfied with the configured format.
+func isNameIDFormatAllowed(requested string, sp *inboundmodel.SAMLServiceProvider) bool {
+	return requested == "" || requested == constants.NameIDFormatUnspecified || requested == getNameIDFormat(sp)
+}
+
+// getNameIDFormat returns the NameID format of the assertions issued to the service provider.
+func getNameIDFormat(sp *inboundmodel.SAMLServiceProvider) string {
+	if sp.NameIDFormat == "" {
+		return constants.NameIDFormatUnspecified
+	}
+	return sp.NameIDFormat
+}
+
+// getRequiredAttributes returns the space-separated user attributes released to the service provider.
+func getRequiredAttributes(sp *inboundmodel.SAMLServiceProvider) string {
+	attributes := make([]string, 0, len(sp.AttributeMapping)+1)
+	for _, userAttribute := range sp.AttributeMapping {
+		attributes = append(attributes, userAttribute)
+	}
+	if sp.NameIDAttribute != "" {
+		attributes = append(attributes, sp.NameIDAttribute)
+	}
+	slices.Sort(attributes)
+	return strings.Join(slices.Compact(attributes), " ")
+}
+
+// mapAttributes maps the user attributes to the SAML attributes released to the service provider.
+func mapAttributes(attributeMapping map[string]string, userAttributes map[string]any) map[string][]string {
+	attributes := make(map[string][]string, len(attributeMapping))
+	for samlAttribute, userAttribute := range attributeMapping {
+		if values := getAttributeValues(userAttributes[userAttribute]); len(values) > 0 {
+			attributes[samlAttribute] = values
+		}
+	}
+	return attributes
+}
+
+// getAttributeValues converts a user attribute value to SAML attribute values.
+func getAttributeValues(value any) []string {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, getAttributeValues(item)...)
+		}
+		return values
+	case map[string]any:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return nil
+		}
+		return []string{string(encoded)}
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
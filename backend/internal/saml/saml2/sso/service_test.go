@@ -0,0 +1,489 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sso
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/attributecache"
+	flowcm "github.com/asgardeo/thunder/internal/flow/common"
+	"github.com/asgardeo/thunder/internal/flow/flowexec"
+	inboundmodel "github.com/asgardeo/thunder/internal/inboundclient/model"
+	"github.com/asgardeo/thunder/internal/saml/saml2/binding"
+	"github.com/asgardeo/thunder/internal/saml/saml2/constants"
+	"github.com/asgardeo/thunder/internal/saml/saml2/message"
+	"github.com/asgardeo/thunder/internal/session"
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/xmldsig"
+	"github.com/asgardeo/thunder/tests/mocks/attributecachemock"
+	"github.com/asgardeo/thunder/tests/mocks/flow/flowexecmock"
+	"github.com/asgardeo/thunder/tests/mocks/inboundclientmock"
+	"github.com/asgardeo/thunder/tests/mocks/jose/jwtmock"
+	"github.com/asgardeo/thunder/tests/mocks/saml/saml2/signermock"
+	"github.com/asgardeo/thunder/tests/mocks/sessionmock"
+)
+
+const (
+	testIdPEntityID = "https://localhost:8090"
+	testSPEntityID  = "https://sp.example.com"
+	testACSURL      = "https://sp.example.com/acs"
+	testAppID       = "app-1"
+	testUserID      = "user-1"
+	testSessionID   = "session-1"
+	testExecutionID = "execution-1"
+	testRelayState  = "relay"
+)
+
+type SSOServiceTestSuite struct {
+	suite.Suite
+	mockInboundClient  *inboundclientmock.InboundClientServiceInterfaceMock
+	mockFlowExec       *flowexecmock.FlowExecServiceInterfaceMock
+	mockJWTService     *jwtmock.JWTServiceInterfaceMock
+	mockAttributeCache *attributecachemock.AttributeCacheServiceInterfaceMock
+	mockSessionService *sessionmock.SessionServiceInterfaceMock
+	mockSigner         *signermock.SAMLSignerInterfaceMock
+	mockStore          *samlRequestStoreInterfaceMock
+	service            SSOServiceInterface
+	ctx                context.Context
+}
+
+func TestSSOServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SSOServiceTestSuite))
+}
+
+func (s *SSOServiceTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("test", &config.Config{
+		Server: config.ServerConfig{Hostname: "localhost", Port: 8090},
+	})
+
+	s.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(s.T())
+	s.mockFlowExec = flowexecmock.NewFlowExecServiceInterfaceMock(s.T())
+	s.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(s.T())
+	s.mockAttributeCache = attributecachemock.NewAttributeCacheServiceInterfaceMock(s.T())
+	s.mockSessionService = sessionmock.NewSessionServiceInterfaceMock(s.T())
+	s.mockSigner = signermock.NewSAMLSignerInterfaceMock(s.T())
+	s.mockSigner.EXPECT().GetEntityID().Return(testIdPEntityID).Maybe()
+	s.mockSigner.EXPECT().SignElement(mock.Anything, mock.Anything, message.SignaturePosition).
+		Return(nil).Maybe()
+	s.mockStore = newSamlRequestStoreInterfaceMock(s.T())
+	s.service = newSSOService(s.mockInboundClient, s.mockFlowExec, s.mockJWTService, s.mockAttributeCache,
+		s.mockSessionService, s.mockSigner, s.mockStore)
+	s.ctx = context.Background()
+}
+
+func (s *SSOServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+// authnRequest builds an AuthnRequest message with the given additional root attributes.
+func authnRequest(attributes string) *binding.Message {
+	data := `<samlp:AuthnRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ` +
+		`xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_req1" Version="2.0" ` +
+		`IssueInstant="2026-01-01T00:00:00Z" ` + attributes + `>` +
+		`<saml:Issuer>` + testSPEntityID + `</saml:Issuer></samlp:AuthnRequest>`
+	return &binding.Message{Binding: constants.BindingHTTPPost, Data: []byte(data), RelayState: testRelayState}
+}
+
+// buildAssertion builds an unsigned JWT with the given claims. Signature verification is mocked.
+func buildAssertion(claims map[string]any) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	payload, _ := json.Marshal(claims)
+	return header + "." + base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
+
+func (s *SSOServiceTestSuite) serviceProvider() *inboundmodel.SAMLServiceProvider {
+	return &inboundmodel.SAMLServiceProvider{
+		SAMLProfile: inboundmodel.SAMLProfile{
+			EntityID:                     testSPEntityID,
+			AssertionConsumerServiceURLs: []string{testACSURL, "https://sp.example.com/acs2"},
+		},
+		AppID: testAppID,
+	}
+}
+
+func (s *SSOServiceTestSuite) mockServiceProvider(sp *inboundmodel.SAMLServiceProvider) {
+	s.mockInboundClient.EXPECT().GetSAMLServiceProvider(mock.Anything, testSPEntityID).Return(sp, nil)
+}
+
+func (s *SSOServiceTestSuite) authnRequestRecord() samlRequestRecord {
+	return samlRequestRecord{AuthnRequest: &authnRequestContext{
+		RequestID:  "_req1",
+		SPEntityID: testSPEntityID,
+		ACSURL:     testACSURL,
+		RelayState: testRelayState,
+	}}
+}
+
+// parseStatus returns the top-level and second-level status codes of a response.
+func (s *SSOServiceTestSuite) parseStatus(response *binding.PostMessage) (string, string) {
+	root, err := xmldsig.Parse(response.Data)
+	s.Require().NoError(err)
+	code := root.FindChild(constants.NamespaceProtocol, "Status").FindChild(constants.NamespaceProtocol, "StatusCode")
+	subStatus := ""
+	if sub := code.FindChild(constants.NamespaceProtocol, "StatusCode"); sub != nil {
+		subStatus = sub.AttrValue("Value")
+	}
+	return code.AttrValue("Value"), subStatus
+}
+
+// parseAssertion returns the assertion of a successful response.
+func (s *SSOServiceTestSuite) parseAssertion(response *binding.PostMessage) *xmldsig.Element {
+	root, err := xmldsig.Parse(response.Data)
+	s.Require().NoError(err)
+	assertion := root.FindChild(constants.NamespaceAssertion, "Assertion")
+	s.Require().NotNil(assertion)
+	return assertion
+}
+
+func (s *SSOServiceTestSuite) TestHandleAuthnRequest_InvalidMessage() {
+	result, ssoErr := s.service.HandleAuthnRequest(s.ctx, &binding.Message{Data: []byte("<invalid")}, "")
+
+	s.Nil(result)
+	s.Equal(&errInvalidAuthnRequest, ssoErr)
+}
+
+func (s *SSOServiceTestSuite) TestHandleAuthnRequest_UnknownServiceProvider() {
+	s.mockServiceProvider(nil)
+
+	result, ssoErr := s.service.HandleAuthnRequest(s.ctx, authnRequest(""), "")
+
+	s.Nil(result)
+	s.Equal(&errUnknownServiceProvider, ssoErr)
+}
+
+func (s *SSOServiceTestSuite) TestHandleAuthnRequest_ServiceProviderLookupError() {
+	s.mockInboundClient.EXPECT().GetSAMLServiceProvider(mock.Anything, testSPEntityID).
+		Return(nil, errors.New("db error"))
+
+	_, ssoErr := s.service.HandleAuthnRequest(s.ctx, authnRequest(""), "")
+
+	s.Equal(&errServerError, ssoErr)
+}
+
+func (s *SSOServiceTestSuite) TestHandleAuthnRequest_SignatureRequired() {
+	sp := s.serviceProvider()
+	sp.RequireSignedRequests = true
+	s.mockServiceProvider(sp)
+
+	_, ssoErr := s.service.HandleAuthnRequest(s.ctx, authnRequest(""), "")
+
+	s.Equal(&errInvalidRequestSignature, ssoErr)
+}
+
+func (s *SSOServiceTestSuite) TestHandleAuthnRequest_UnregisteredACSURL() {
+	s.mockServiceProvider(s.serviceProvider())
+
+	_, ssoErr := s.service.HandleAuthnRequest(s.ctx,
+		authnRequest(`AssertionConsumerServiceURL="https://attacker.example.com/acs"`), "")
+
+	s.Equal(&errUnregisteredACSURL, ssoErr)
+}
+
+func (s *SSOServiceTestSuite) TestHandleAuthnRequest_RequesterErrors() {
+	testCases := []struct {
+		name       string
+		attributes string
+	}{
+		{"DestinationMismatch", `Destination="https://other.example.com/sso"`},
+		{"UnsupportedProtocolBinding", `ProtocolBinding="` + constants.BindingHTTPRedirect + `"`},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.mockServiceProvider(s.serviceProvider())
+
+			result, ssoErr := s.service.HandleAuthnRequest(s.ctx, authnRequest(tc.attributes), "")
+
+			s.Nil(ssoErr)
+			s.Require().NotNil(result.Response)
+			s.Equal(testACSURL, result.Response.Destination)
+			s.Equal(testRelayState, result.Response.RelayState)
+			status, subStatus := s.parseStatus(result.Response)
+			s.Equal(constants.StatusRequester, status)
+			s.Empty(subStatus)
+		})
+	}
+}
+
+func (s *SSOServiceTestSuite) TestHandleAuthnRequest_InvalidNameIDPolicy() {
+	s.mockServiceProvider(s.serviceProvider())
+	msg := authnRequest(`AssertionConsumerServiceURL="https://sp.example.com/acs2"`)
+	msg.Data = []byte(strings.Replace(string(msg.Data), "</samlp:AuthnRequest>",
+		`<samlp:NameIDPolicy Format="`+constants.NameIDFormatEmailAddress+`"/></samlp:AuthnRequest>`, 1))
+
+	result, ssoErr := s.service.HandleAuthnRequest(s.ctx, msg, "")
+
+	s.Nil(ssoErr)
+	s.Equal("https://sp.example.com/acs2", result.Response.Destination)
+	status, subStatus := s.parseStatus(result.Response)
+	s.Equal(constants.StatusRequester, status)
+	s.Equal(constants.StatusInvalidNameIDPolicy, subStatus)
+}
+
+func (s *SSOServiceTestSuite) TestHandleAuthnRequest_InitiatesFlow() {
+	sp := s.serviceProvider()
+	sp.AttributeMapping = map[string]string{"mail": "email", "groups": "groups"}
+	sp.NameIDAttribute = "email"
+	s.mockServiceProvider(sp)
+	s.mockFlowExec.EXPECT().InitiateFlow(mock.Anything, &flowexec.FlowInitContext{
+		ApplicationID: testAppID,
+		FlowType:      string(flowcm.FlowTypeAuthentication),
+		RuntimeData: map[string]string{
+			flowcm.RuntimeKeyRequiredEssentialAttributes:   "email groups",
+			flowcm.RuntimeKeyUserAttributesCacheTTLSeconds: "60",
+		},
+	}).Return(testExecutionID, nil)
+	s.mockStore.EXPECT().Store(mock.Anything, s.authnRequestRecord(), int64(authnRequestExpirySeconds)).
+		Return("auth-key", nil)
+
+	result, ssoErr := s.service.HandleAuthnRequest(s.ctx,
+		authnRequest(`Destination="https://localhost:8090/saml2/sso"`), testSessionID)
+
+	s.Nil(ssoErr)
+	s.Nil(result.Response)
+	s.Equal(map[string]string{
+		constants.AuthID:      "auth-key",
+		constants.AppID:       testAppID,
+		constants.ExecutionID: testExecutionID,
+	}, result.QueryParams)
+}
+
+func (s *SSOServiceTestSuite) TestHandleAuthnRequest_FlowInitiationError() {
+	s.mockServiceProvider(s.serviceProvider())
+	s.mockFlowExec.EXPECT().InitiateFlow(mock.Anything, mock.Anything).
+		Return("", &serviceerror.ServiceError{Code: "FES-5000", Type: serviceerror.ServerErrorType})
+
+	result, ssoErr := s.service.HandleAuthnRequest(s.ctx, authnRequest(""), "")
+
+	s.Nil(ssoErr)
+	status, _ := s.parseStatus(result.Response)
+	s.Equal(constants.StatusResponder, status)
+}
+
+func (s *SSOServiceTestSuite) TestHandleAuthnRequest_RespondsFromSession() {
+	authTime := time.Now().Add(-time.Minute)
+	s.mockServiceProvider(s.serviceProvider())
+	s.mockSessionService.EXPECT().GetSession(mock.Anything, testSessionID).
+		Return(&session.Session{ID: testSessionID, UserID: testUserID, AuthTime: authTime}, nil)
+	s.mockSessionService.EXPECT().AddClientToSession(mock.Anything, testSessionID, testSPEntityID).Return(nil)
+
+	result, ssoErr := s.service.HandleAuthnRequest(s.ctx, authnRequest(""), testSessionID)
+
+	s.Nil(ssoErr)
+	s.Require().NotNil(result.Response)
+	status, _ := s.parseStatus(result.Response)
+	s.Equal(constants.StatusSuccess, status)
+	assertion := s.parseAssertion(result.Response)
+	nameID := assertion.FindChild(constants.NamespaceAssertion, "Subject").
+		FindChild(constants.NamespaceAssertion, "NameID")
+	s.Equal(testUserID, nameID.Text())
+	authnStatement := assertion.FindChild(constants.NamespaceAssertion, "AuthnStatement")
+	s.Equal(message.SessionIndex(testSessionID), authnStatement.AttrValue("SessionIndex"))
+}
+
+func (s *SSOServiceTestSuite) TestHandleAuthnRequest_IsPassiveWithoutSession() {
+	s.mockServiceProvider(s.serviceProvider())
+	s.mockSessionService.EXPECT().GetSession(mock.Anything, testSessionID).
+		Return(nil, &session.ErrorSessionNotFound)
+
+	result, ssoErr := s.service.HandleAuthnRequest(s.ctx, authnRequest(`IsPassive="true"`), testSessionID)
+
+	s.Nil(ssoErr)
+	status, subStatus := s.parseStatus(result.Response)
+	s.Equal(constants.StatusResponder, status)
+	s.Equal(constants.StatusNoPassive, subStatus)
+}
+
+func (s *SSOServiceTestSuite) TestHandleAuthnRequest_ForceAuthnSkipsSession() {
+	s.mockServiceProvider(s.serviceProvider())
+	s.mockFlowExec.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return(testExecutionID, nil)
+	s.mockStore.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).Return("auth-key", nil)
+
+	result, ssoErr := s.service.HandleAuthnRequest(s.ctx, authnRequest(`ForceAuthn="true"`), testSessionID)
+
+	s.Nil(ssoErr)
+	s.Equal("auth-key", result.QueryParams[constants.AuthID])
+	s.mockSessionService.AssertNotCalled(s.T(), "GetSession", mock.Anything, mock.Anything)
+}
+
+func (s *SSOServiceTestSuite) TestHandleAuthCallback_InvalidAuthID() {
+	s.mockStore.EXPECT().Consume(mock.Anything, "auth-key").Return(samlRequestRecord{}, false, nil)
+
+	result, ssoErr := s.service.HandleAuthCallback(s.ctx, "auth-key", "assertion")
+
+	s.Nil(result)
+	s.Equal(&errInvalidAuthID, ssoErr)
+}
+
+func (s *SSOServiceTestSuite) TestHandleAuthCallback_Success() {
+	sp := s.serviceProvider()
+	sp.AttributeMapping = map[string]string{"mail": "email", "groups": "groups"}
+	sp.NameIDAttribute = "email"
+	sp.NameIDFormat = constants.NameIDFormatEmailAddress
+	authTime := time.Now().Add(-time.Minute).Truncate(time.Second)
+	assertion := buildAssertion(map[string]any{
+		"sub":                  testUserID,
+		"aci":                  "cache-1",
+		"completed_auth_class": "mfa",
+		"iat":                  authTime.Unix(),
+	})
+	ssoSession := &session.Session{ID: testSessionID, UserID: testUserID, AuthTime: authTime}
+
+	s.mockStore.EXPECT().Consume(mock.Anything, "auth-key").Return(s.authnRequestRecord(), true, nil)
+	s.mockServiceProvider(sp)
+	s.mockJWTService.EXPECT().VerifyJWT(assertion, "", "").Return(nil)
+	s.mockAttributeCache.EXPECT().GetAttributeCache(mock.Anything, "cache-1").
+		Return(&attributecache.AttributeCache{ID: "cache-1", Attributes: map[string]any{
+			"email":  "alice@example.com",
+			"groups": []any{"admin", "dev"},
+		}}, nil)
+	s.mockAttributeCache.EXPECT().DeleteAttributeCache(mock.Anything, "cache-1").Return(nil)
+	s.mockSessionService.EXPECT().CreateSession(mock.Anything, testUserID, authTime, "mfa").
+		Return(ssoSession, nil)
+	s.mockSessionService.EXPECT().AddClientToSession(mock.Anything, testSessionID, testSPEntityID).Return(nil)
+
+	var stored samlRequestRecord
+	s.mockStore.EXPECT().Store(mock.Anything, mock.Anything, int64(responseExpirySeconds)).
+		RunAndReturn(func(_ context.Context, record samlRequestRecord, _ int64) (string, error) {
+			stored = record
+			return "response-key", nil
+		})
+
+	result, ssoErr := s.service.HandleAuthCallback(s.ctx, "auth-key", assertion)
+
+	s.Nil(ssoErr)
+	s.Equal("https://localhost:8090/saml2/sso/response?key=response-key", result.RedirectURI)
+	s.Equal(ssoSession, result.Session)
+	s.Require().NotNil(stored.Response)
+	s.Equal(testACSURL, stored.Response.Destination)
+	s.Equal(testRelayState, stored.Response.RelayState)
+
+	assertionElement := s.parseAssertion(stored.Response)
+	nameID := assertionElement.FindChild(constants.NamespaceAssertion, "Subject").
+		FindChild(constants.NamespaceAssertion, "NameID")
+	s.Equal("alice@example.com", nameID.Text())
+	s.Equal(constants.NameIDFormatEmailAddress, nameID.AttrValue("Format"))
+	attributes := assertionElement.FindChild(constants.NamespaceAssertion, "AttributeStatement").
+		FindChildren(constants.NamespaceAssertion, "Attribute")
+	s.Require().Len(attributes, 2)
+	s.Equal("groups", attributes[0].AttrValue("Name"))
+	s.Len(attributes[0].FindChildren(constants.NamespaceAssertion, "AttributeValue"), 2)
+	s.Equal("mail", attributes[1].AttrValue("Name"))
+}
+
+func (s *SSOServiceTestSuite) TestHandleAuthCallback_AuthenticationFailed() {
+	s.mockStore.EXPECT().Consume(mock.Anything, "auth-key").Return(s.authnRequestRecord(), true, nil)
+	s.mockServiceProvider(s.serviceProvider())
+	var stored samlRequestRecord
+	s.mockStore.EXPECT().Store(mock.Anything, mock.Anything, int64(responseExpirySeconds)).
+		RunAndReturn(func(_ context.Context, record samlRequestRecord, _ int64) (string, error) {
+			stored = record
+			return "response-key", nil
+		})
+
+	result, ssoErr := s.service.HandleAuthCallback(s.ctx, "auth-key", "")
+
+	s.Nil(ssoErr)
+	s.Nil(result.Session)
+	status, subStatus := s.parseStatus(stored.Response)
+	s.Equal(constants.StatusRequester, status)
+	s.Equal(constants.StatusAuthnFailed, subStatus)
+}
+
+func (s *SSOServiceTestSuite) TestHandleAuthCallback_InvalidAssertionSignature() {
+	assertion := buildAssertion(map[string]any{"sub": testUserID})
+	s.mockStore.EXPECT().Consume(mock.Anything, "auth-key").Return(s.authnRequestRecord(), true, nil)
+	s.mockServiceProvider(s.serviceProvider())
+	s.mockJWTService.EXPECT().VerifyJWT(assertion, "", "").Return(&serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+	})
+	s.mockStore.EXPECT().Store(mock.Anything, mock.Anything, int64(responseExpirySeconds)).
+		Return("response-key", nil)
+
+	result, ssoErr := s.service.HandleAuthCallback(s.ctx, "auth-key", assertion)
+
+	s.Nil(ssoErr)
+	s.Nil(result.Session)
+	s.Contains(result.RedirectURI, "key=response-key")
+	s.mockSessionService.AssertNotCalled(s.T(), "CreateSession", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything)
+}
+
+func (s *SSOServiceTestSuite) TestGetResponse() {
+	response := &binding.PostMessage{Destination: testACSURL, Param: constants.ParamSAMLResponse}
+	s.mockStore.EXPECT().Consume(mock.Anything, "response-key").
+		Return(samlRequestRecord{Response: response}, true, nil)
+
+	result, ssoErr := s.service.GetResponse(s.ctx, "response-key")
+
+	s.Nil(ssoErr)
+	s.Equal(response, result)
+}
+
+func (s *SSOServiceTestSuite) TestGetResponse_InvalidKey() {
+	_, ssoErr := s.service.GetResponse(s.ctx, "")
+	s.Equal(&errInvalidResponseKey, ssoErr)
+
+	// An authentication request key must not be accepted as a response key.
+	s.mockStore.EXPECT().Consume(mock.Anything, "auth-key").Return(s.authnRequestRecord(), true, nil)
+	_, ssoErr = s.service.GetResponse(s.ctx, "auth-key")
+	s.Equal(&errInvalidResponseKey, ssoErr)
+}
+
+func (s *SSOServiceTestSuite) TestGetResponse_StoreError() {
+	s.mockStore.EXPECT().Consume(mock.Anything, "response-key").
+		Return(samlRequestRecord{}, false, errors.New("db error"))
+
+	_, ssoErr := s.service.GetResponse(s.ctx, "response-key")
+
+	s.Equal(&errServerError, ssoErr)
+}
+
+func (s *SSOServiceTestSuite) TestGetAttributeValues() {
+	testCases := []struct {
+		value    any
+		expected []string
+	}{
+		{nil, nil},
+		{"a", []string{"a"}},
+		{[]string{"a", "b"}, []string{"a", "b"}},
+		{[]any{"a", 1.5, true}, []string{"a", "1.5", "true"}},
+		{map[string]any{"k": "v"}, []string{`{"k":"v"}`}},
+		{42, []string{"42"}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("%v", tc.value), func() {
+			s.Equal(tc.expected, getAttributeValues(tc.value))
+		})
+	}
+}
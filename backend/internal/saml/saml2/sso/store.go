@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sso
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// requestKeyRandomBytes is the number of random bytes for a request key (32 bytes = 256 bits).
+const requestKeyRandomBytes = 32
+
+// samlRequestStoreInterface defines the interface for storing SAML requests and pending responses
+// under single-use random keys.
+type samlRequestStoreInterface interface {
+	Store(ctx context.Context, record samlRequestRecord, expirySeconds int64) (string, error)
+	Consume(ctx context.Context, key string) (samlRequestRecord, bool, error)
+}
+
+// samlRequestStore is the relational-DB-backed implementation of samlRequestStoreInterface.
+type samlRequestStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newSAMLRequestStore creates a new DB-backed SAML request store.
+func newSAMLRequestStore(deploymentID string) samlRequestStoreInterface {
+	return &samlRequestStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// Store persists a record and returns the generated random key.
+func (s *samlRequestStore) Store(
+	ctx context.Context, record samlRequestRecord, expirySeconds int64,
+) (string, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	key, err := generateRandomKey()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate request key: %w", err)
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal SAML request: %w", err)
+	}
+
+	expiryTime := time.Now().UTC().Add(time.Duration(expirySeconds) * time.Second)
+	if _, err := dbClient.ExecuteContext(
+		ctx, queryInsertSAMLRequest, key, s.deploymentID, data, expiryTime,
+	); err != nil {
+		return "", fmt.Errorf("failed to insert SAML request: %w", err)
+	}
+
+	return key, nil
+}
+
+// Consume atomically retrieves and deletes a record from the store.
+// Returns the record, a boolean indicating if found, and any error.
+func (s *samlRequestStore) Consume(ctx context.Context, key string) (samlRequestRecord, bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return samlRequestRecord{}, false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetSAMLRequest, key, time.Now().UTC(), s.deploymentID)
+	if err != nil {
+		return samlRequestRecord{}, false, fmt.Errorf("failed to query SAML request: %w", err)
+	}
+	if len(results) == 0 {
+		return samlRequestRecord{}, false, nil
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteSAMLRequest, key, s.deploymentID)
+	if err != nil {
+		return samlRequestRecord{}, false, fmt.Errorf("failed to delete SAML request: %w", err)
+	}
+	// Another consumer raced us to the delete; treat as already consumed.
+	if rowsAffected == 0 {
+		return samlRequestRecord{}, false, nil
+	}
+
+	record, err := buildSAMLRequestFromRow(results[0])
+	if err != nil {
+		return samlRequestRecord{}, false, err
+	}
+	return record, true, nil
+}
+
+// buildSAMLRequestFromRow reconstructs a samlRequestRecord from a database row.
+func buildSAMLRequestFromRow(row map[string]any) (samlRequestRecord, error) {
+	var dataJSON []byte
+	if val, ok := row[dbColumnRequestData].(string); ok && val != "" {
+		dataJSON = []byte(val)
+	} else if val, ok := row[dbColumnRequestData].([]byte); ok && len(val) > 0 {
+		dataJSON = val
+	} else {
+		return samlRequestRecord{}, errors.New("request_data is missing or of unexpected type")
+	}
+
+	var record samlRequestRecord
+	if err := json.Unmarshal(dataJSON, &record); err != nil {
+		return samlRequestRecord{}, fmt.Errorf("failed to unmarshal SAML request: %w", err)
+	}
+	return record, nil
+}
+
+// generateRandomKey generates a cryptographically random request key.
+func generateRandomKey() (string, error) {
+	b := make([]byte, requestKeyRandomBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
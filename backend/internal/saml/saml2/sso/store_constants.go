@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sso
+
+import dbmodel "github.com/asgardeo/thunder/internal/system/database/model"
+
+// Database column names for SAML request storage.
+const (
+	dbColumnRequestKey  = "request_key"
+	dbColumnRequestData = "request_data"
+)
+
+var queryInsertSAMLRequest = dbmodel.DBQuery{
+	ID: "SAMLQ-SRS-01",
+	Query: `INSERT INTO "SAML_AUTH_REQUEST" (REQUEST_KEY, DEPLOYMENT_ID, REQUEST_DATA, EXPIRY_TIME) ` +
+		`VALUES ($1, $2, $3, $4)`,
+}
+
+var queryGetSAMLRequest = dbmodel.DBQuery{
+	ID: "SAMLQ-SRS-02",
+	Query: `SELECT REQUEST_KEY, REQUEST_DATA FROM "SAML_AUTH_REQUEST" ` +
+		`WHERE REQUEST_KEY = $1 AND EXPIRY_TIME > $2 AND DEPLOYMENT_ID = $3`,
+}
+
+var queryDeleteSAMLRequest = dbmodel.DBQuery{
+	ID:    "SAMLQ-SRS-03",
+	Query: `DELETE FROM "SAML_AUTH_REQUEST" WHERE REQUEST_KEY = $1 AND DEPLOYMENT_ID = $2`,
+}
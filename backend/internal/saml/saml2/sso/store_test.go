@@ -0,0 +1,207 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sso
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/tests/mocks/database/providermock"
+)
+
+const (
+	testDeploymentID = "test-deployment-id"
+	testRequestKey   = "abc"
+)
+
+type StoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *samlRequestStore
+	ctx            context.Context
+	testRecord     samlRequestRecord
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (s *StoreTestSuite) SetupTest() {
+	s.mockDBProvider = providermock.NewDBProviderInterfaceMock(s.T())
+	s.mockDBClient = providermock.NewDBClientInterfaceMock(s.T())
+	s.store = &samlRequestStore{
+		dbProvider:   s.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+	s.testRecord = samlRequestRecord{
+		AuthnRequest: &authnRequestContext{
+			RequestID:  "_req1",
+			SPEntityID: "https://sp.example.com",
+			ACSURL:     "https://sp.example.com/acs",
+			RelayState: "state",
+		},
+	}
+}
+
+func (s *StoreTestSuite) mockQuery(row map[string]any) {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetSAMLRequest,
+		testRequestKey, mock.Anything, testDeploymentID,
+	).Return([]map[string]any{row}, nil)
+}
+
+func (s *StoreTestSuite) TestStore_Success() {
+	const expirySeconds int64 = 60
+	before := time.Now().UTC()
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertSAMLRequest,
+		mock.MatchedBy(func(key string) bool { return len(key) == 43 }),
+		testDeploymentID,
+		mock.MatchedBy(func(data []byte) bool { return len(data) > 0 }),
+		mock.MatchedBy(func(t time.Time) bool {
+			diff := t.Sub(before.Add(time.Duration(expirySeconds) * time.Second))
+			return diff >= -time.Second && diff <= time.Second
+		}),
+	).Return(int64(1), nil)
+
+	key, err := s.store.Store(s.ctx, s.testRecord, expirySeconds)
+
+	s.NoError(err)
+	s.NotEmpty(key)
+}
+
+func (s *StoreTestSuite) TestStore_DBClientError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db client error"))
+
+	key, err := s.store.Store(s.ctx, s.testRecord, int64(60))
+
+	s.Error(err)
+	s.Empty(key)
+}
+
+func (s *StoreTestSuite) TestStore_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertSAMLRequest,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(int64(0), errors.New("insert failed"))
+
+	key, err := s.store.Store(s.ctx, s.testRecord, int64(60))
+
+	s.ErrorContains(err, "failed to insert SAML request")
+	s.Empty(key)
+}
+
+func (s *StoreTestSuite) TestConsume_Success() {
+	data, _ := json.Marshal(s.testRecord)
+	s.mockQuery(map[string]any{dbColumnRequestKey: testRequestKey, dbColumnRequestData: string(data)})
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteSAMLRequest,
+		testRequestKey, testDeploymentID,
+	).Return(int64(1), nil)
+
+	record, found, err := s.store.Consume(s.ctx, testRequestKey)
+
+	s.NoError(err)
+	s.True(found)
+	s.Equal(s.testRecord, record)
+}
+
+func (s *StoreTestSuite) TestConsume_DataAsBytes() {
+	data, _ := json.Marshal(s.testRecord)
+	s.mockQuery(map[string]any{dbColumnRequestKey: testRequestKey, dbColumnRequestData: data})
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteSAMLRequest,
+		testRequestKey, testDeploymentID,
+	).Return(int64(1), nil)
+
+	result, found, err := s.store.Consume(s.ctx, testRequestKey)
+
+	s.NoError(err)
+	s.True(found)
+	s.Equal("_req1", result.AuthnRequest.RequestID)
+}
+
+func (s *StoreTestSuite) TestConsume_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetSAMLRequest,
+		testRequestKey, mock.Anything, testDeploymentID,
+	).Return([]map[string]any{}, nil)
+
+	record, found, err := s.store.Consume(s.ctx, testRequestKey)
+
+	s.NoError(err)
+	s.False(found)
+	s.Equal(samlRequestRecord{}, record)
+}
+
+func (s *StoreTestSuite) TestConsume_QueryError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetSAMLRequest,
+		testRequestKey, mock.Anything, testDeploymentID,
+	).Return(nil, errors.New("query error"))
+
+	_, found, err := s.store.Consume(s.ctx, testRequestKey)
+
+	s.ErrorContains(err, "failed to query SAML request")
+	s.False(found)
+}
+
+func (s *StoreTestSuite) TestConsume_DeleteError() {
+	data, _ := json.Marshal(s.testRecord)
+	s.mockQuery(map[string]any{dbColumnRequestKey: testRequestKey, dbColumnRequestData: string(data)})
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteSAMLRequest,
+		testRequestKey, testDeploymentID,
+	).Return(int64(0), errors.New("delete error"))
+
+	_, found, err := s.store.Consume(s.ctx, testRequestKey)
+
+	s.ErrorContains(err, "failed to delete SAML request")
+	s.False(found)
+}
+
+func (s *StoreTestSuite) TestConsume_RaceLost_DeleteReturnsZero() {
+	data, _ := json.Marshal(s.testRecord)
+	s.mockQuery(map[string]any{dbColumnRequestKey: testRequestKey, dbColumnRequestData: string(data)})
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteSAMLRequest,
+		testRequestKey, testDeploymentID,
+	).Return(int64(0), nil)
+
+	_, found, err := s.store.Consume(s.ctx, testRequestKey)
+
+	s.NoError(err)
+	s.False(found)
+}
+
+func (s *StoreTestSuite) TestConsume_MissingData() {
+	s.mockQuery(map[string]any{dbColumnRequestKey: testRequestKey})
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteSAMLRequest,
+		testRequestKey, testDeploymentID,
+	).Return(int64(1), nil)
+
+	_, found, err := s.store.Consume(s.ctx, testRequestKey)
+
+	s.ErrorContains(err, "request_data is missing")
+	s.False(found)
+}
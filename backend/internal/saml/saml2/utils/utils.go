@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package utils provides helpers shared by the SAML 2.0 endpoints.
+package utils
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	sysutils "github.com/asgardeo/thunder/internal/system/utils"
+)
+
+// GetEndpointURL returns the absolute URL of an identity provider endpoint.
+func GetEndpointURL(path string) string {
+	return config.GetServerURL(&config.GetServerRuntime().Config.Server) + path
+}
+
+// GetLoginPageURL returns the gate login page URL with the given query parameters.
+func GetLoginPageURL(queryParams map[string]string) (string, error) {
+	gateClientConfig := config.GetServerRuntime().Config.GateClient
+	return sysutils.GetURIWithQueryParams(getGateURL(gateClientConfig.LoginPath), queryParams)
+}
+
+// GetErrorPageURL returns the gate error page URL for the given error.
+func GetErrorPageURL(code, msg string) (string, error) {
+	gateClientConfig := config.GetServerRuntime().Config.GateClient
+	return sysutils.GetURIWithQueryParams(getGateURL(gateClientConfig.ErrorPath), map[string]string{
+		"errorCode":    code,
+		"errorMessage": msg,
+	})
+}
+
+// getGateURL returns the absolute URL of a gate client path.
+func getGateURL(path string) string {
+	gateClientConfig := config.GetServerRuntime().Config.GateClient
+	return (&url.URL{
+		Scheme: gateClientConfig.Scheme,
+		Host:   fmt.Sprintf("%s:%d", gateClientConfig.Hostname, gateClientConfig.Port),
+		Path:   path,
+	}).String()
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+)
+
+type UtilsTestSuite struct {
+	suite.Suite
+}
+
+func TestUtilsTestSuite(t *testing.T) {
+	suite.Run(t, new(UtilsTestSuite))
+}
+
+func (s *UtilsTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("test", &config.Config{
+		Server: config.ServerConfig{Hostname: "localhost", Port: 8090},
+		GateClient: config.GateClientConfig{
+			Scheme:    "https",
+			Hostname:  "localhost",
+			Port:      3000,
+			LoginPath: "/signin",
+			ErrorPath: "/error",
+		},
+	})
+}
+
+func (s *UtilsTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *UtilsTestSuite) TestGetEndpointURL() {
+	s.Equal("https://localhost:8090/saml2/sso", GetEndpointURL("/saml2/sso"))
+}
+
+func (s *UtilsTestSuite) TestGetLoginPageURL() {
+	loginURL, err := GetLoginPageURL(map[string]string{"authId": "a b", "appId": "app-1"})
+
+	s.Require().NoError(err)
+	parsed, err := url.Parse(loginURL)
+	s.Require().NoError(err)
+	s.Equal("localhost:3000", parsed.Host)
+	s.Equal("/signin", parsed.Path)
+	s.Equal("a b", parsed.Query().Get("authId"))
+	s.Equal("app-1", parsed.Query().Get("appId"))
+}
+
+func (s *UtilsTestSuite) TestGetErrorPageURL() {
+	errorURL, err := GetErrorPageURL("invalid_request", "Unknown SAML service provider")
+
+	s.Require().NoError(err)
+	parsed, err := url.Parse(errorURL)
+	s.Require().NoError(err)
+	s.Equal("/error", parsed.Path)
+	s.Equal("invalid_request", parsed.Query().Get("errorCode"))
+	s.Equal("Unknown SAML service provider", parsed.Query().Get("errorMessage"))
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package session
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+)
+
+// defaultCookieName is used when no session cookie name is configured.
+const defaultCookieName = "thunder_session"
+
+// getCookieName returns the configured session cookie name.
+func getCookieName() string {
+	if name := config.GetServerRuntime().Config.Session.CookieName; name != "" {
+		return name
+	}
+	return defaultCookieName
+}
+
+// GetSessionIDFromRequest returns the session ID carried by the session cookie of the request,
+// or an empty string if the cookie is not present.
+func GetSessionIDFromRequest(r *http.Request) string {
+	cookie, err := r.Cookie(getCookieName())
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
+
+// SetSessionCookie writes the session cookie for the given session to the response.
+func SetSessionCookie(w http.ResponseWriter, session *Session) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     getCookieName(),
+		Value:    session.ID,
+		Path:     "/",
+		Expires:  session.ExpiryTime,
+		MaxAge:   int(time.Until(session.ExpiryTime).Seconds()),
+		HttpOnly: true,
+		Secure:   !config.GetServerRuntime().Config.Server.HTTPOnly,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// ClearSessionCookie instructs the user agent to remove the session cookie.
+func ClearSessionCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     getCookieName(),
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   !config.GetServerRuntime().Config.Server.HTTPOnly,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package session
+
+import (
+	"errors"
+
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/i18n/core"
+)
+
+// Store-level errors.
+var (
+	// errSessionNotFound is returned when a session is not found or has expired.
+	errSessionNotFound = errors.New("session not found")
+)
+
+// Client-facing service errors.
+var (
+	// ErrorMissingSessionID is returned when the session ID is missing.
+	ErrorMissingSessionID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SSN-1001",
+		Error: core.I18nMessage{
+			Key:          "error.session.missing_session_id",
+			DefaultValue: "Missing session ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.session.missing_session_id_description",
+			DefaultValue: "Session ID is required",
+		},
+	}
+
+	// ErrorMissingUserID is returned when the user ID is missing.
+	ErrorMissingUserID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SSN-1002",
+		Error: core.I18nMessage{
+			Key:          "error.session.missing_user_id",
+			DefaultValue: "Missing user ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.session.missing_user_id_description",
+			DefaultValue: "User ID is required",
+		},
+	}
+
+	// ErrorSessionNotFound is returned when a session is not found or has expired.
+	ErrorSessionNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SSN-1003",
+		Error: core.I18nMessage{
+			Key:          "error.session.session_not_found",
+			DefaultValue: "Session not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.session.session_not_found_description",
+			DefaultValue: "The session with the specified ID does not exist or has expired",
+		},
+	}
+
+	// ErrorMissingClientID is returned when the client ID is missing.
+	ErrorMissingClientID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SSN-1004",
+		Error: core.I18nMessage{
+			Key:          "error.session.missing_client_id",
+			DefaultValue: "Missing client ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.session.missing_client_id_description",
+			DefaultValue: "Client ID is required",
+		},
+	}
+)
@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package session
+
+import (
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/system/error/apierror"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/log"
+	sysutils "github.com/asgardeo/thunder/internal/system/utils"
+)
+
+const handlerLoggerComponentName = "SessionHandler"
+
+// sessionHandler is the handler for session management operations.
+type sessionHandler struct {
+	sessionService SessionServiceInterface
+}
+
+// newSessionHandler creates a new instance of sessionHandler.
+func newSessionHandler(sessionService SessionServiceInterface) *sessionHandler {
+	return &sessionHandler{
+		sessionService: sessionService,
+	}
+}
+
+// HandleSessionListRequest handles the list sessions of a user request.
+func (sh *sessionHandler) HandleSessionListRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	userID := sysutils.SanitizeString(r.URL.Query().Get("userId"))
+	sessions, svcErr := sh.sessionService.GetUserSessions(ctx, userID)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sessionList := make([]SessionResponse, 0, len(sessions))
+	for i := range sessions {
+		sessionList = append(sessionList, toSessionResponse(&sessions[i]))
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, SessionListResponse{
+		TotalResults: len(sessionList),
+		Sessions:     sessionList,
+	})
+
+	logger.Debug("Successfully listed user sessions", log.Int("count", len(sessionList)))
+}
+
+// HandleSessionGetRequest handles the get session by id request.
+func (sh *sessionHandler) HandleSessionGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := r.PathValue("id")
+	session, svcErr := sh.sessionService.GetSession(ctx, id)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, toSessionResponse(session))
+}
+
+// HandleSessionDeleteRequest handles the terminate session by id request.
+func (sh *sessionHandler) HandleSessionDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	if svcErr := sh.sessionService.TerminateSession(ctx, id); svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.Debug("Successfully terminated session")
+}
+
+// HandleUserSessionsDeleteRequest handles the terminate all sessions of a user request.
+func (sh *sessionHandler) HandleUserSessionsDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	userID := sysutils.SanitizeString(r.URL.Query().Get("userId"))
+	if svcErr := sh.sessionService.TerminateUserSessions(ctx, userID); svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.Debug("Successfully terminated user sessions")
+}
+
+// toSessionResponse converts a session to its API representation.
+func toSessionResponse(session *Session) SessionResponse {
+	return SessionResponse{
+		ID:        session.ID,
+		UserID:    session.UserID,
+		AuthTime:  session.AuthTime.Unix(),
+		ACR:       session.ACR,
+		ClientIDs: session.ClientIDs,
+		CreatedAt: session.CreatedAt.Unix(),
+		ExpiresAt: session.ExpiryTime.Unix(),
+	}
+}
+
+// handleError writes the error response corresponding to the given service error.
+func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorSessionNotFound.Code:
+			statusCode = http.StatusNotFound
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
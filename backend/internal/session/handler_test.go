@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package session
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/apierror"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *SessionServiceInterfaceMock
+	handler     *sessionHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewSessionServiceInterfaceMock(s.T())
+	s.handler = newSessionHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) testSession() Session {
+	return Session{
+		ID:         testSessionID,
+		UserID:     testUserID,
+		AuthTime:   time.Unix(1700000000, 0),
+		ACR:        "acr1",
+		ClientIDs:  []string{testClientID},
+		CreatedAt:  time.Unix(1700000000, 0),
+		ExpiryTime: time.Unix(1700003600, 0),
+	}
+}
+
+func (s *HandlerTestSuite) TestHandleSessionListRequest_Success() {
+	s.mockService.EXPECT().GetUserSessions(mock.Anything, testUserID).
+		Return([]Session{s.testSession()}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/sessions?userId="+testUserID, nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleSessionListRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp SessionListResponse
+	s.NoError(json.NewDecoder(rr.Body).Decode(&resp))
+	s.Equal(1, resp.TotalResults)
+	s.Equal(testSessionID, resp.Sessions[0].ID)
+	s.Equal(int64(1700000000), resp.Sessions[0].AuthTime)
+	s.Equal(int64(1700003600), resp.Sessions[0].ExpiresAt)
+	s.Equal([]string{testClientID}, resp.Sessions[0].ClientIDs)
+}
+
+func (s *HandlerTestSuite) TestHandleSessionListRequest_MissingUserID() {
+	s.mockService.EXPECT().GetUserSessions(mock.Anything, "").Return(nil, &ErrorMissingUserID)
+
+	req := httptest.NewRequest(http.MethodGet, "/sessions", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleSessionListRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var resp apierror.ErrorResponse
+	s.NoError(json.NewDecoder(rr.Body).Decode(&resp))
+	s.Equal(ErrorMissingUserID.Code, resp.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleSessionGetRequest_Success() {
+	session := s.testSession()
+	s.mockService.EXPECT().GetSession(mock.Anything, testSessionID).Return(&session, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/sessions/"+testSessionID, nil)
+	req.SetPathValue("id", testSessionID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleSessionGetRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp SessionResponse
+	s.NoError(json.NewDecoder(rr.Body).Decode(&resp))
+	s.Equal(testUserID, resp.UserID)
+	s.Equal("acr1", resp.ACR)
+}
+
+func (s *HandlerTestSuite) TestHandleSessionGetRequest_NotFound() {
+	s.mockService.EXPECT().GetSession(mock.Anything, testSessionID).Return(nil, &ErrorSessionNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/sessions/"+testSessionID, nil)
+	req.SetPathValue("id", testSessionID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleSessionGetRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleSessionDeleteRequest_Success() {
+	s.mockService.EXPECT().TerminateSession(mock.Anything, testSessionID).Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/sessions/"+testSessionID, nil)
+	req.SetPathValue("id", testSessionID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleSessionDeleteRequest(rr, req)
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleSessionDeleteRequest_ServerError() {
+	s.mockService.EXPECT().TerminateSession(mock.Anything, testSessionID).
+		Return(&serviceerror.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodDelete, "/sessions/"+testSessionID, nil)
+	req.SetPathValue("id", testSessionID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleSessionDeleteRequest(rr, req)
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleUserSessionsDeleteRequest_Success() {
+	s.mockService.EXPECT().TerminateUserSessions(mock.Anything, testUserID).Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/sessions?userId="+testUserID, nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleUserSessionsDeleteRequest(rr, req)
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+// Tests for the session cookie helpers
+
+func (s *HandlerTestSuite) TestSessionCookie_RoundTrip() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("test", &config.Config{
+		Session: config.SessionConfig{CookieName: "custom_session"},
+	})
+	defer config.ResetServerRuntime()
+
+	session := s.testSession()
+	session.ExpiryTime = time.Now().Add(time.Hour)
+	rr := httptest.NewRecorder()
+	SetSessionCookie(rr, &session)
+
+	cookies := rr.Result().Cookies()
+	s.Len(cookies, 1)
+	s.Equal("custom_session", cookies[0].Name)
+	s.True(cookies[0].HttpOnly)
+	s.True(cookies[0].Secure)
+	s.Equal(http.SameSiteLaxMode, cookies[0].SameSite)
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/authorize", nil)
+	req.AddCookie(cookies[0])
+	s.Equal(testSessionID, GetSessionIDFromRequest(req))
+
+	rr = httptest.NewRecorder()
+	ClearSessionCookie(rr)
+	cleared := rr.Result().Cookies()
+	s.Len(cleared, 1)
+	s.Equal("custom_session", cleared[0].Name)
+	s.Negative(cleared[0].MaxAge)
+}
+
+func (s *HandlerTestSuite) TestGetSessionIDFromRequest_NoCookie() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("test", &config.Config{})
+	defer config.ResetServerRuntime()
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/authorize", nil)
+	s.Empty(GetSessionIDFromRequest(req))
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package session
+
+import (
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+	"github.com/asgardeo/thunder/internal/system/middleware"
+)
+
+// Initialize initializes the session service and registers the session management routes.
+func Initialize(mux *http.ServeMux) SessionServiceInterface {
+	var store sessionStoreInterface
+	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		store = newRedisSessionStore(provider.GetRedisProvider())
+	} else {
+		store = newSessionStore()
+	}
+	sessionService := newSessionService(store)
+	registerRoutes(mux, newSessionHandler(sessionService))
+	return sessionService
+}
+
+// registerRoutes registers the routes for session management operations.
+func registerRoutes(mux *http.ServeMux, sessionHandler *sessionHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /sessions", sessionHandler.HandleSessionListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("DELETE /sessions", sessionHandler.HandleUserSessionsDeleteRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /sessions", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /sessions/{id}", sessionHandler.HandleSessionGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE /sessions/{id}", sessionHandler.HandleSessionDeleteRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /sessions/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, opts2))
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package session
+
+import "time"
+
+// Session represents a browser SSO session established after a successful authentication.
+type Session struct {
+	// ID is the unique identifier of the session. It is also the value of the session cookie.
+	ID string `json:"id"`
+
+	// SID is the session identifier shared with relying parties through the sid claim. It is
+	// generated independently of ID so that the session cookie value is never exposed.
+	SID string `json:"sid"`
+
+	// UserID is the identifier of the authenticated user.
+	UserID string `json:"userId"`
+
+	// AuthTime is the time at which the user authenticated.
+	AuthTime time.Time `json:"authTime"`
+
+	// ACR is the authentication context class satisfied when the session was established.
+	ACR string `json:"acr,omitempty"`
+
+	// ClientIDs contains the OAuth clients that have been issued tokens within the session.
+	ClientIDs []string `json:"clientIds"`
+
+	// CreatedAt is the time at which the session was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiryTime is the time at which the session expires.
+	ExpiryTime time.Time `json:"expiryTime"`
+}
+
+// SessionResponse represents a session in the session management API responses.
+type SessionResponse struct {
+	ID        string   `json:"id"`
+	UserID    string   `json:"userId"`
+	AuthTime  int64    `json:"authTime"`
+	ACR       string   `json:"acr,omitempty"`
+	ClientIDs []string `json:"clientIds"`
+	CreatedAt int64    `json:"createdAt"`
+	ExpiresAt int64    `json:"expiresAt"`
+}
+
+// SessionListResponse represents the response for listing the sessions of a user.
+type SessionListResponse struct {
+	TotalResults int               `json:"totalResults"`
+	Sessions     []SessionResponse `json:"sessions"`
+}
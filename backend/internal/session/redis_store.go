@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+)
+
+// sessionRedisClient abstracts the Redis commands used by the session store.
+type sessionRedisClient interface {
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd
+	Get(ctx context.Context, key string) *redis.StringCmd
+	Del(ctx context.Context, keys ...string) *redis.IntCmd
+	Expire(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd
+	SAdd(ctx context.Context, key string, members ...interface{}) *redis.IntCmd
+	SMembers(ctx context.Context, key string) *redis.StringSliceCmd
+	SRem(ctx context.Context, key string, members ...interface{}) *redis.IntCmd
+}
+
+// redisSessionStore is the Redis-backed implementation of sessionStoreInterface.
+// Each session is stored under its own key with a TTL matching the session expiry, and a per-user
+// set indexes the session IDs of a user. Stale index members are pruned lazily on lookup.
+type redisSessionStore struct {
+	client       sessionRedisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// newRedisSessionStore creates a new Redis-backed session store.
+func newRedisSessionStore(p provider.RedisProviderInterface) sessionStoreInterface {
+	return &redisSessionStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// sessionKey builds the Redis key for a session.
+func (s *redisSessionStore) sessionKey(id string) string {
+	return fmt.Sprintf("%s:runtime:%s:session:%s", s.keyPrefix, s.deploymentID, id)
+}
+
+// userSessionsKey builds the Redis key for the set of session IDs of a user.
+func (s *redisSessionStore) userSessionsKey(userID string) string {
+	return fmt.Sprintf("%s:runtime:%s:usersessions:%s", s.keyPrefix, s.deploymentID, userID)
+}
+
+// CreateSession stores the session in Redis and adds it to the user's session index.
+func (s *redisSessionStore) CreateSession(ctx context.Context, session Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("failed to marshal session: %w", err)
+	}
+
+	ttl := time.Until(session.ExpiryTime)
+	if ttl <= 0 {
+		return errors.New("session has already expired")
+	}
+	if err := s.client.Set(ctx, s.sessionKey(session.ID), data, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store session in Redis: %w", err)
+	}
+
+	indexKey := s.userSessionsKey(session.UserID)
+	if err := s.client.SAdd(ctx, indexKey, session.ID).Err(); err != nil {
+		return fmt.Errorf("failed to index session in Redis: %w", err)
+	}
+	// The index lives at least as long as the newest session of the user.
+	if err := s.client.Expire(ctx, indexKey, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set session index expiry in Redis: %w", err)
+	}
+
+	return nil
+}
+
+// GetSession retrieves a session from Redis.
+func (s *redisSessionStore) GetSession(ctx context.Context, id string) (Session, error) {
+	data, err := s.client.Get(ctx, s.sessionKey(id)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return Session{}, errSessionNotFound
+		}
+		return Session{}, fmt.Errorf("failed to get session from Redis: %w", err)
+	}
+
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return Session{}, fmt.Errorf("failed to unmarshal session: %w", err)
+	}
+	if !session.ExpiryTime.After(time.Now()) {
+		return Session{}, errSessionNotFound
+	}
+
+	return session, nil
+}
+
+// GetSessionsByUserID retrieves the sessions of a user from Redis.
+func (s *redisSessionStore) GetSessionsByUserID(ctx context.Context, userID string) ([]Session, error) {
+	indexKey := s.userSessionsKey(userID)
+	ids, err := s.client.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user sessions from Redis: %w", err)
+	}
+
+	sessions := make([]Session, 0, len(ids))
+	for _, id := range ids {
+		session, err := s.GetSession(ctx, id)
+		if err != nil {
+			if errors.Is(err, errSessionNotFound) {
+				// Best-effort pruning of index members whose session has expired.
+				_ = s.client.SRem(ctx, indexKey, id).Err()
+				continue
+			}
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// UpdateSessionData replaces the authentication time, ACR and client IDs of a session in Redis, preserving
+// the session TTL.
+func (s *redisSessionStore) UpdateSessionData(ctx context.Context, session Session) error {
+	existing, err := s.GetSession(ctx, session.ID)
+	if err != nil {
+		return err
+	}
+	existing.AuthTime = session.AuthTime
+	existing.ACR = session.ACR
+	existing.ClientIDs = session.ClientIDs
+
+	data, err := json.Marshal(existing)
+	if err != nil {
+		return fmt.Errorf("failed to marshal session: %w", err)
+	}
+	if err := s.client.Set(ctx, s.sessionKey(session.ID), data, redis.KeepTTL).Err(); err != nil {
+		return fmt.Errorf("failed to update session in Redis: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSession removes a session from Redis and from the user's session index.
+func (s *redisSessionStore) DeleteSession(ctx context.Context, id string) error {
+	session, err := s.GetSession(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.Del(ctx, s.sessionKey(id)).Err(); err != nil {
+		return fmt.Errorf("failed to delete session from Redis: %w", err)
+	}
+	if err := s.client.SRem(ctx, s.userSessionsKey(session.UserID), id).Err(); err != nil {
+		return fmt.Errorf("failed to remove session from index in Redis: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSessionsByUserID removes all sessions of a user from Redis.
+func (s *redisSessionStore) DeleteSessionsByUserID(ctx context.Context, userID string) error {
+	indexKey := s.userSessionsKey(userID)
+	ids, err := s.client.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get user sessions from Redis: %w", err)
+	}
+
+	keys := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		keys = append(keys, s.sessionKey(id))
+	}
+	keys = append(keys, indexKey)
+
+	if err := s.client.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("failed to delete user sessions from Redis: %w", err)
+	}
+
+	return nil
+}
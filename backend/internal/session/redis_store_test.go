@@ -0,0 +1,270 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	redisTestKeyPrefix    = "thunderid"
+	redisTestDeploymentID = "test-deployment-id"
+)
+
+type RedisStoreTestSuite struct {
+	suite.Suite
+	mockClient *sessionRedisClientMock
+	store      *redisSessionStore
+	ctx        context.Context
+}
+
+func TestRedisStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisStoreTestSuite))
+}
+
+func (s *RedisStoreTestSuite) SetupTest() {
+	s.mockClient = newSessionRedisClientMock(s.T())
+	s.store = &redisSessionStore{
+		client:       s.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: redisTestDeploymentID,
+	}
+	s.ctx = context.Background()
+}
+
+func (s *RedisStoreTestSuite) buildSessionKey(id string) string {
+	return fmt.Sprintf("%s:runtime:%s:session:%s", redisTestKeyPrefix, redisTestDeploymentID, id)
+}
+
+func (s *RedisStoreTestSuite) buildUserSessionsKey(userID string) string {
+	return fmt.Sprintf("%s:runtime:%s:usersessions:%s", redisTestKeyPrefix, redisTestDeploymentID, userID)
+}
+
+func (s *RedisStoreTestSuite) testSession() Session {
+	return Session{
+		ID:         testSessionID,
+		UserID:     testUserID,
+		AuthTime:   time.Now().Truncate(time.Second),
+		ClientIDs:  []string{testClientID},
+		CreatedAt:  time.Now(),
+		ExpiryTime: time.Now().Add(time.Hour),
+	}
+}
+
+// mockGet configures the Get call for a session key to return the given session.
+func (s *RedisStoreTestSuite) mockGet(session Session) {
+	data, err := json.Marshal(session)
+	s.Require().NoError(err)
+	stringCmd := redis.NewStringCmd(s.ctx)
+	stringCmd.SetVal(string(data))
+	s.mockClient.On("Get", s.ctx, s.buildSessionKey(session.ID)).Return(stringCmd)
+}
+
+// mockGetNotFound configures the Get call for a session key to return redis.Nil.
+func (s *RedisStoreTestSuite) mockGetNotFound(id string) {
+	stringCmd := redis.NewStringCmd(s.ctx)
+	stringCmd.SetErr(redis.Nil)
+	s.mockClient.On("Get", s.ctx, s.buildSessionKey(id)).Return(stringCmd)
+}
+
+func (s *RedisStoreTestSuite) TestKeys() {
+	s.Equal(s.buildSessionKey(testSessionID), s.store.sessionKey(testSessionID))
+	s.Equal(s.buildUserSessionsKey(testUserID), s.store.userSessionsKey(testUserID))
+}
+
+// Tests for CreateSession
+
+func (s *RedisStoreTestSuite) TestCreateSession_Success() {
+	ttlMatcher := mock.MatchedBy(func(ttl time.Duration) bool {
+		return ttl > 59*time.Minute && ttl <= time.Hour
+	})
+	s.mockClient.On("Set", s.ctx, s.buildSessionKey(testSessionID), mock.Anything, ttlMatcher).
+		Return(redis.NewStatusCmd(s.ctx))
+	s.mockClient.On("SAdd", s.ctx, s.buildUserSessionsKey(testUserID), testSessionID).
+		Return(redis.NewIntCmd(s.ctx))
+	s.mockClient.On("Expire", s.ctx, s.buildUserSessionsKey(testUserID), ttlMatcher).
+		Return(redis.NewBoolCmd(s.ctx))
+
+	err := s.store.CreateSession(s.ctx, s.testSession())
+
+	s.NoError(err)
+}
+
+func (s *RedisStoreTestSuite) TestCreateSession_AlreadyExpired() {
+	session := s.testSession()
+	session.ExpiryTime = time.Now().Add(-time.Minute)
+
+	err := s.store.CreateSession(s.ctx, session)
+
+	s.Error(err)
+	s.mockClient.AssertNotCalled(s.T(), "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *RedisStoreTestSuite) TestCreateSession_SetError() {
+	statusCmd := redis.NewStatusCmd(s.ctx)
+	statusCmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("Set", s.ctx, mock.Anything, mock.Anything, mock.Anything).Return(statusCmd)
+
+	err := s.store.CreateSession(s.ctx, s.testSession())
+
+	s.Error(err)
+	s.Contains(err.Error(), "failed to store session in Redis")
+}
+
+// Tests for GetSession
+
+func (s *RedisStoreTestSuite) TestGetSession_Success() {
+	expected := s.testSession()
+	s.mockGet(expected)
+
+	session, err := s.store.GetSession(s.ctx, testSessionID)
+
+	s.NoError(err)
+	s.Equal(expected.ID, session.ID)
+	s.Equal(expected.UserID, session.UserID)
+	s.Equal(expected.ClientIDs, session.ClientIDs)
+}
+
+func (s *RedisStoreTestSuite) TestGetSession_NotFound() {
+	s.mockGetNotFound(testSessionID)
+
+	_, err := s.store.GetSession(s.ctx, testSessionID)
+
+	s.ErrorIs(err, errSessionNotFound)
+}
+
+func (s *RedisStoreTestSuite) TestGetSession_Expired() {
+	session := s.testSession()
+	session.ExpiryTime = time.Now().Add(-time.Second)
+	s.mockGet(session)
+
+	_, err := s.store.GetSession(s.ctx, testSessionID)
+
+	s.ErrorIs(err, errSessionNotFound)
+}
+
+func (s *RedisStoreTestSuite) TestGetSession_Error() {
+	stringCmd := redis.NewStringCmd(s.ctx)
+	stringCmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("Get", s.ctx, s.buildSessionKey(testSessionID)).Return(stringCmd)
+
+	_, err := s.store.GetSession(s.ctx, testSessionID)
+
+	s.Error(err)
+	s.NotErrorIs(err, errSessionNotFound)
+}
+
+// Tests for GetSessionsByUserID
+
+func (s *RedisStoreTestSuite) TestGetSessionsByUserID_PrunesExpiredMembers() {
+	sliceCmd := redis.NewStringSliceCmd(s.ctx)
+	sliceCmd.SetVal([]string{testSessionID, "expired-session"})
+	s.mockClient.On("SMembers", s.ctx, s.buildUserSessionsKey(testUserID)).Return(sliceCmd)
+	s.mockGet(s.testSession())
+	s.mockGetNotFound("expired-session")
+	s.mockClient.On("SRem", s.ctx, s.buildUserSessionsKey(testUserID), "expired-session").
+		Return(redis.NewIntCmd(s.ctx))
+
+	sessions, err := s.store.GetSessionsByUserID(s.ctx, testUserID)
+
+	s.NoError(err)
+	s.Len(sessions, 1)
+	s.Equal(testSessionID, sessions[0].ID)
+}
+
+// Tests for UpdateSessionData
+
+func (s *RedisStoreTestSuite) TestUpdateSessionData_KeepsTTL() {
+	existing := s.testSession()
+	s.mockGet(existing)
+	s.mockClient.On("Set", s.ctx, s.buildSessionKey(testSessionID),
+		mock.MatchedBy(func(data []byte) bool {
+			var session Session
+			return json.Unmarshal(data, &session) == nil && len(session.ClientIDs) == 2 && session.ACR == "acr2"
+		}), time.Duration(redis.KeepTTL)).Return(redis.NewStatusCmd(s.ctx))
+
+	updated := existing
+	updated.ACR = "acr2"
+	updated.ClientIDs = []string{testClientID, "other-client"}
+	err := s.store.UpdateSessionData(s.ctx, updated)
+
+	s.NoError(err)
+}
+
+func (s *RedisStoreTestSuite) TestUpdateSessionData_NotFound() {
+	s.mockGetNotFound(testSessionID)
+
+	err := s.store.UpdateSessionData(s.ctx, s.testSession())
+
+	s.ErrorIs(err, errSessionNotFound)
+}
+
+// Tests for DeleteSession
+
+func (s *RedisStoreTestSuite) TestDeleteSession_Success() {
+	s.mockGet(s.testSession())
+	s.mockClient.On("Del", s.ctx, s.buildSessionKey(testSessionID)).Return(redis.NewIntCmd(s.ctx))
+	s.mockClient.On("SRem", s.ctx, s.buildUserSessionsKey(testUserID), testSessionID).
+		Return(redis.NewIntCmd(s.ctx))
+
+	err := s.store.DeleteSession(s.ctx, testSessionID)
+
+	s.NoError(err)
+}
+
+func (s *RedisStoreTestSuite) TestDeleteSession_NotFound() {
+	s.mockGetNotFound(testSessionID)
+
+	err := s.store.DeleteSession(s.ctx, testSessionID)
+
+	s.ErrorIs(err, errSessionNotFound)
+}
+
+// Tests for DeleteSessionsByUserID
+
+func (s *RedisStoreTestSuite) TestDeleteSessionsByUserID_Success() {
+	sliceCmd := redis.NewStringSliceCmd(s.ctx)
+	sliceCmd.SetVal([]string{testSessionID})
+	s.mockClient.On("SMembers", s.ctx, s.buildUserSessionsKey(testUserID)).Return(sliceCmd)
+	s.mockClient.On("Del", s.ctx, s.buildSessionKey(testSessionID), s.buildUserSessionsKey(testUserID)).
+		Return(redis.NewIntCmd(s.ctx))
+
+	err := s.store.DeleteSessionsByUserID(s.ctx, testUserID)
+
+	s.NoError(err)
+}
+
+func (s *RedisStoreTestSuite) TestDeleteSessionsByUserID_SMembersError() {
+	sliceCmd := redis.NewStringSliceCmd(s.ctx)
+	sliceCmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("SMembers", s.ctx, s.buildUserSessionsKey(testUserID)).Return(sliceCmd)
+
+	err := s.store.DeleteSessionsByUserID(s.ctx, testUserID)
+
+	s.Error(err)
+}
@@ -0,0 +1,310 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package session provides server-side browser SSO sessions.
+package session
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/cryptolab"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/log"
+	"github.com/asgardeo/thunder/internal/system/utils"
+)
+
+const loggerComponentName = "SessionService"
+
+// SessionServiceInterface defines the interface for the SSO session service.
+type SessionServiceInterface interface {
+	// CreateSession creates a new session for an authenticated user.
+	CreateSession(
+		ctx context.Context, userID string, authTime time.Time, acr string,
+	) (*Session, *serviceerror.ServiceError)
+
+	// GetSession retrieves an active session by ID.
+	GetSession(ctx context.Context, sessionID string) (*Session, *serviceerror.ServiceError)
+
+	// AddClientToSession records that tokens were issued to a client within a session.
+	AddClientToSession(ctx context.Context, sessionID, clientID string) *serviceerror.ServiceError
+
+	// ReauthenticateSession records a new authentication of the session user against a session.
+	ReauthenticateSession(
+		ctx context.Context, sessionID string, authTime time.Time, acr string,
+	) (*Session, *serviceerror.ServiceError)
+
+	// GetUserSessions retrieves the active sessions of a user.
+	GetUserSessions(ctx context.Context, userID string) ([]Session, *serviceerror.ServiceError)
+
+	// TerminateSession terminates a session by ID.
+	TerminateSession(ctx context.Context, sessionID string) *serviceerror.ServiceError
+
+	// TerminateUserSessions terminates all sessions of a user.
+	TerminateUserSessions(ctx context.Context, userID string) *serviceerror.ServiceError
+
+	// RegisterTerminationListener registers a listener that is notified whenever a session is terminated.
+	RegisterTerminationListener(listener TerminationListener)
+}
+
+// TerminationListener is notified after a session has been terminated.
+type TerminationListener interface {
+	// OnSessionTerminated is invoked with the session that was terminated.
+	OnSessionTerminated(ctx context.Context, session Session)
+}
+
+// sessionService is the default implementation of the SessionServiceInterface.
+type sessionService struct {
+	store     sessionStoreInterface
+	mu        sync.RWMutex
+	listeners []TerminationListener
+}
+
+// newSessionService creates a new instance of sessionService with injected dependencies.
+func newSessionService(store sessionStoreInterface) SessionServiceInterface {
+	return &sessionService{
+		store: store,
+	}
+}
+
+// CreateSession creates a new session for an authenticated user.
+func (s *sessionService) CreateSession(
+	ctx context.Context, userID string, authTime time.Time, acr string,
+) (*Session, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if strings.TrimSpace(userID) == "" {
+		return nil, &ErrorMissingUserID
+	}
+
+	sessionID, err := utils.GenerateUUIDv7()
+	if err != nil {
+		logger.Error("Failed to generate session ID", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	sid, err := cryptolab.GenerateSecureToken()
+	if err != nil {
+		logger.Error("Failed to generate session sid", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	now := time.Now()
+	if authTime.IsZero() {
+		authTime = now
+	}
+	validityPeriod := config.GetServerRuntime().Config.Session.ValidityPeriod
+
+	session := Session{
+		ID:         sessionID,
+		SID:        sid,
+		UserID:     userID,
+		AuthTime:   authTime,
+		ACR:        acr,
+		ClientIDs:  []string{},
+		CreatedAt:  now,
+		ExpiryTime: now.Add(time.Duration(validityPeriod) * time.Second),
+	}
+
+	if err := s.store.CreateSession(ctx, session); err != nil {
+		logger.Error("Failed to create session", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	logger.Debug("Successfully created session", log.MaskedString("sessionId", sessionID))
+	return &session, nil
+}
+
+// GetSession retrieves an active session by ID.
+func (s *sessionService) GetSession(
+	ctx context.Context, sessionID string,
+) (*Session, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if strings.TrimSpace(sessionID) == "" {
+		return nil, &ErrorMissingSessionID
+	}
+
+	session, err := s.store.GetSession(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, errSessionNotFound) {
+			logger.Debug("Session not found", log.MaskedString("sessionId", sessionID))
+			return nil, &ErrorSessionNotFound
+		}
+		logger.Error("Failed to retrieve session", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &session, nil
+}
+
+// AddClientToSession records that tokens were issued to a client within a session.
+func (s *sessionService) AddClientToSession(
+	ctx context.Context, sessionID, clientID string,
+) *serviceerror.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if strings.TrimSpace(clientID) == "" {
+		return &ErrorMissingClientID
+	}
+
+	session, svcErr := s.GetSession(ctx, sessionID)
+	if svcErr != nil {
+		return svcErr
+	}
+	if slices.Contains(session.ClientIDs, clientID) {
+		return nil
+	}
+
+	session.ClientIDs = append(session.ClientIDs, clientID)
+	if err := s.store.UpdateSessionData(ctx, *session); err != nil {
+		if errors.Is(err, errSessionNotFound) {
+			return &ErrorSessionNotFound
+		}
+		logger.Error("Failed to add client to session", log.Error(err), log.String("clientId", clientID))
+		return &serviceerror.InternalServerError
+	}
+
+	return nil
+}
+
+// ReauthenticateSession records a new authentication of the session user against a session, replacing
+// the authentication time and ACR of the session.
+func (s *sessionService) ReauthenticateSession(
+	ctx context.Context, sessionID string, authTime time.Time, acr string,
+) (*Session, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	session, svcErr := s.GetSession(ctx, sessionID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if authTime.IsZero() {
+		authTime = time.Now()
+	}
+	session.AuthTime = authTime
+	session.ACR = acr
+	if err := s.store.UpdateSessionData(ctx, *session); err != nil {
+		if errors.Is(err, errSessionNotFound) {
+			return nil, &ErrorSessionNotFound
+		}
+		logger.Error("Failed to update session authentication", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	logger.Debug("Successfully reauthenticated session", log.MaskedString("sessionId", sessionID))
+	return session, nil
+}
+
+// GetUserSessions retrieves the active sessions of a user.
+func (s *sessionService) GetUserSessions(
+	ctx context.Context, userID string,
+) ([]Session, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if strings.TrimSpace(userID) == "" {
+		return nil, &ErrorMissingUserID
+	}
+
+	sessions, err := s.store.GetSessionsByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("Failed to retrieve user sessions", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return sessions, nil
+}
+
+// TerminateSession terminates a session by ID.
+func (s *sessionService) TerminateSession(ctx context.Context, sessionID string) *serviceerror.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if strings.TrimSpace(sessionID) == "" {
+		return &ErrorMissingSessionID
+	}
+
+	session, err := s.store.GetSession(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, errSessionNotFound) {
+			return &ErrorSessionNotFound
+		}
+		logger.Error("Failed to retrieve session", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	if err := s.store.DeleteSession(ctx, sessionID); err != nil {
+		if errors.Is(err, errSessionNotFound) {
+			return &ErrorSessionNotFound
+		}
+		logger.Error("Failed to terminate session", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	logger.Debug("Successfully terminated session", log.MaskedString("sessionId", sessionID))
+	s.notifyTermination(ctx, session)
+	return nil
+}
+
+// TerminateUserSessions terminates all sessions of a user.
+func (s *sessionService) TerminateUserSessions(ctx context.Context, userID string) *serviceerror.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if strings.TrimSpace(userID) == "" {
+		return &ErrorMissingUserID
+	}
+
+	sessions, err := s.store.GetSessionsByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("Failed to retrieve user sessions", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	if err := s.store.DeleteSessionsByUserID(ctx, userID); err != nil {
+		logger.Error("Failed to terminate user sessions", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	logger.Debug("Successfully terminated user sessions", log.Int("count", len(sessions)))
+	for i := range sessions {
+		s.notifyTermination(ctx, sessions[i])
+	}
+	return nil
+}
+
+// RegisterTerminationListener registers a listener that is notified whenever a session is terminated.
+func (s *sessionService) RegisterTerminationListener(listener TerminationListener) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listeners = append(s.listeners, listener)
+}
+
+// notifyTermination notifies the registered listeners that a session has been terminated.
+func (s *sessionService) notifyTermination(ctx context.Context, session Session) {
+	s.mu.RLock()
+	listeners := slices.Clone(s.listeners)
+	s.mu.RUnlock()
+
+	for _, listener := range listeners {
+		listener.OnSessionTerminated(ctx, session)
+	}
+}
@@ -0,0 +1,330 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	mockStore *sessionStoreInterfaceMock
+	service   SessionServiceInterface
+	ctx       context.Context
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		Session: config.SessionConfig{
+			ValidityPeriod: 3600,
+		},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+
+	s.mockStore = newSessionStoreInterfaceMock(s.T())
+	s.service = newSessionService(s.mockStore)
+	s.ctx = context.Background()
+}
+
+func (s *ServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *ServiceTestSuite) testSession() Session {
+	return Session{
+		ID:         testSessionID,
+		UserID:     testUserID,
+		AuthTime:   time.Now(),
+		ClientIDs:  []string{},
+		CreatedAt:  time.Now(),
+		ExpiryTime: time.Now().Add(time.Hour),
+	}
+}
+
+// Tests for CreateSession
+
+func (s *ServiceTestSuite) TestCreateSession_Success() {
+	authTime := time.Now().Add(-time.Minute)
+	s.mockStore.EXPECT().CreateSession(mock.Anything, mock.MatchedBy(func(session Session) bool {
+		return session.ID != "" && session.SID != "" && session.SID != session.ID &&
+			session.UserID == testUserID && session.ACR == "acr1" &&
+			session.AuthTime.Equal(authTime) &&
+			session.ExpiryTime.Sub(session.CreatedAt) == time.Hour
+	})).Return(nil)
+
+	session, svcErr := s.service.CreateSession(s.ctx, testUserID, authTime, "acr1")
+
+	s.Nil(svcErr)
+	s.NotNil(session)
+	s.NotEmpty(session.ID)
+	s.Empty(session.ClientIDs)
+}
+
+func (s *ServiceTestSuite) TestCreateSession_DefaultsAuthTime() {
+	s.mockStore.EXPECT().CreateSession(mock.Anything, mock.Anything).Return(nil)
+
+	session, svcErr := s.service.CreateSession(s.ctx, testUserID, time.Time{}, "")
+
+	s.Nil(svcErr)
+	s.False(session.AuthTime.IsZero())
+}
+
+func (s *ServiceTestSuite) TestCreateSession_MissingUserID() {
+	session, svcErr := s.service.CreateSession(s.ctx, " ", time.Now(), "")
+
+	s.Nil(session)
+	s.Equal(&ErrorMissingUserID, svcErr)
+}
+
+func (s *ServiceTestSuite) TestCreateSession_StoreError() {
+	s.mockStore.EXPECT().CreateSession(mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	session, svcErr := s.service.CreateSession(s.ctx, testUserID, time.Now(), "")
+
+	s.Nil(session)
+	s.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+// Tests for GetSession
+
+func (s *ServiceTestSuite) TestGetSession_Success() {
+	s.mockStore.EXPECT().GetSession(mock.Anything, testSessionID).Return(s.testSession(), nil)
+
+	session, svcErr := s.service.GetSession(s.ctx, testSessionID)
+
+	s.Nil(svcErr)
+	s.Equal(testUserID, session.UserID)
+}
+
+func (s *ServiceTestSuite) TestGetSession_MissingID() {
+	session, svcErr := s.service.GetSession(s.ctx, "")
+
+	s.Nil(session)
+	s.Equal(&ErrorMissingSessionID, svcErr)
+}
+
+func (s *ServiceTestSuite) TestGetSession_NotFound() {
+	s.mockStore.EXPECT().GetSession(mock.Anything, testSessionID).Return(Session{}, errSessionNotFound)
+
+	session, svcErr := s.service.GetSession(s.ctx, testSessionID)
+
+	s.Nil(session)
+	s.Equal(&ErrorSessionNotFound, svcErr)
+}
+
+func (s *ServiceTestSuite) TestGetSession_StoreError() {
+	s.mockStore.EXPECT().GetSession(mock.Anything, testSessionID).Return(Session{}, errors.New("db error"))
+
+	session, svcErr := s.service.GetSession(s.ctx, testSessionID)
+
+	s.Nil(session)
+	s.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+// Tests for AddClientToSession
+
+func (s *ServiceTestSuite) TestAddClientToSession_Success() {
+	s.mockStore.EXPECT().GetSession(mock.Anything, testSessionID).Return(s.testSession(), nil)
+	s.mockStore.EXPECT().UpdateSessionData(mock.Anything, mock.MatchedBy(func(session Session) bool {
+		return assert.ObjectsAreEqual([]string{testClientID}, session.ClientIDs)
+	})).Return(nil)
+
+	svcErr := s.service.AddClientToSession(s.ctx, testSessionID, testClientID)
+
+	s.Nil(svcErr)
+}
+
+func (s *ServiceTestSuite) TestAddClientToSession_AlreadyRecorded() {
+	session := s.testSession()
+	session.ClientIDs = []string{testClientID}
+	s.mockStore.EXPECT().GetSession(mock.Anything, testSessionID).Return(session, nil)
+
+	svcErr := s.service.AddClientToSession(s.ctx, testSessionID, testClientID)
+
+	s.Nil(svcErr)
+	s.mockStore.AssertNotCalled(s.T(), "UpdateSessionData", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestAddClientToSession_MissingClientID() {
+	svcErr := s.service.AddClientToSession(s.ctx, testSessionID, "")
+
+	s.Equal(&ErrorMissingClientID, svcErr)
+}
+
+func (s *ServiceTestSuite) TestAddClientToSession_UpdateError() {
+	s.mockStore.EXPECT().GetSession(mock.Anything, testSessionID).Return(s.testSession(), nil)
+	s.mockStore.EXPECT().UpdateSessionData(mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	svcErr := s.service.AddClientToSession(s.ctx, testSessionID, testClientID)
+
+	s.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+// Tests for ReauthenticateSession
+
+func (s *ServiceTestSuite) TestReauthenticateSession_Success() {
+	authTime := time.Now().Add(-time.Second)
+	s.mockStore.EXPECT().GetSession(mock.Anything, testSessionID).Return(s.testSession(), nil)
+	s.mockStore.EXPECT().UpdateSessionData(mock.Anything, mock.MatchedBy(func(session Session) bool {
+		return session.ID == testSessionID && session.AuthTime.Equal(authTime) && session.ACR == "acr2"
+	})).Return(nil)
+
+	session, svcErr := s.service.ReauthenticateSession(s.ctx, testSessionID, authTime, "acr2")
+
+	s.Nil(svcErr)
+	s.Equal("acr2", session.ACR)
+	s.True(session.AuthTime.Equal(authTime))
+}
+
+func (s *ServiceTestSuite) TestReauthenticateSession_NotFound() {
+	s.mockStore.EXPECT().GetSession(mock.Anything, testSessionID).Return(Session{}, errSessionNotFound)
+
+	session, svcErr := s.service.ReauthenticateSession(s.ctx, testSessionID, time.Now(), "")
+
+	s.Nil(session)
+	s.Equal(&ErrorSessionNotFound, svcErr)
+}
+
+func (s *ServiceTestSuite) TestReauthenticateSession_UpdateError() {
+	s.mockStore.EXPECT().GetSession(mock.Anything, testSessionID).Return(s.testSession(), nil)
+	s.mockStore.EXPECT().UpdateSessionData(mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	session, svcErr := s.service.ReauthenticateSession(s.ctx, testSessionID, time.Now(), "")
+
+	s.Nil(session)
+	s.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+// Tests for GetUserSessions
+
+func (s *ServiceTestSuite) TestGetUserSessions_Success() {
+	s.mockStore.EXPECT().GetSessionsByUserID(mock.Anything, testUserID).
+		Return([]Session{s.testSession()}, nil)
+
+	sessions, svcErr := s.service.GetUserSessions(s.ctx, testUserID)
+
+	s.Nil(svcErr)
+	s.Len(sessions, 1)
+}
+
+func (s *ServiceTestSuite) TestGetUserSessions_MissingUserID() {
+	sessions, svcErr := s.service.GetUserSessions(s.ctx, "")
+
+	s.Nil(sessions)
+	s.Equal(&ErrorMissingUserID, svcErr)
+}
+
+// Tests for TerminateSession
+
+func (s *ServiceTestSuite) TestTerminateSession_Success() {
+	session := s.testSession()
+	s.mockStore.EXPECT().GetSession(mock.Anything, testSessionID).Return(session, nil)
+	s.mockStore.EXPECT().DeleteSession(mock.Anything, testSessionID).Return(nil)
+
+	s.Nil(s.service.TerminateSession(s.ctx, testSessionID))
+}
+
+func (s *ServiceTestSuite) TestTerminateSession_NotifiesListeners() {
+	session := s.testSession()
+	listener := NewTerminationListenerMock(s.T())
+	listener.EXPECT().OnSessionTerminated(mock.Anything, session).Return().Once()
+	s.service.RegisterTerminationListener(listener)
+
+	s.mockStore.EXPECT().GetSession(mock.Anything, testSessionID).Return(session, nil)
+	s.mockStore.EXPECT().DeleteSession(mock.Anything, testSessionID).Return(nil)
+
+	s.Nil(s.service.TerminateSession(s.ctx, testSessionID))
+}
+
+func (s *ServiceTestSuite) TestTerminateSession_MissingSessionID() {
+	s.Equal(&ErrorMissingSessionID, s.service.TerminateSession(s.ctx, " "))
+}
+
+func (s *ServiceTestSuite) TestTerminateSession_NotFound() {
+	s.mockStore.EXPECT().GetSession(mock.Anything, testSessionID).Return(Session{}, errSessionNotFound)
+
+	s.Equal(&ErrorSessionNotFound, s.service.TerminateSession(s.ctx, testSessionID))
+}
+
+func (s *ServiceTestSuite) TestTerminateSession_DeleteNotFound() {
+	listener := NewTerminationListenerMock(s.T())
+	s.service.RegisterTerminationListener(listener)
+
+	s.mockStore.EXPECT().GetSession(mock.Anything, testSessionID).Return(s.testSession(), nil)
+	s.mockStore.EXPECT().DeleteSession(mock.Anything, testSessionID).Return(errSessionNotFound)
+
+	s.Equal(&ErrorSessionNotFound, s.service.TerminateSession(s.ctx, testSessionID))
+	listener.AssertNotCalled(s.T(), "OnSessionTerminated", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestTerminateSession_StoreError() {
+	s.mockStore.EXPECT().GetSession(mock.Anything, testSessionID).Return(s.testSession(), nil)
+	s.mockStore.EXPECT().DeleteSession(mock.Anything, testSessionID).Return(errors.New("db error"))
+
+	s.Equal(&serviceerror.InternalServerError, s.service.TerminateSession(s.ctx, testSessionID))
+}
+
+// Tests for TerminateUserSessions
+
+func (s *ServiceTestSuite) TestTerminateUserSessions_Success() {
+	s.mockStore.EXPECT().GetSessionsByUserID(mock.Anything, testUserID).Return([]Session{}, nil)
+	s.mockStore.EXPECT().DeleteSessionsByUserID(mock.Anything, testUserID).Return(nil)
+
+	s.Nil(s.service.TerminateUserSessions(s.ctx, testUserID))
+}
+
+func (s *ServiceTestSuite) TestTerminateUserSessions_NotifiesListeners() {
+	first := s.testSession()
+	second := s.testSession()
+	second.ID = "another-session-id"
+	listener := NewTerminationListenerMock(s.T())
+	listener.EXPECT().OnSessionTerminated(mock.Anything, first).Return().Once()
+	listener.EXPECT().OnSessionTerminated(mock.Anything, second).Return().Once()
+	s.service.RegisterTerminationListener(listener)
+
+	s.mockStore.EXPECT().GetSessionsByUserID(mock.Anything, testUserID).Return([]Session{first, second}, nil)
+	s.mockStore.EXPECT().DeleteSessionsByUserID(mock.Anything, testUserID).Return(nil)
+
+	s.Nil(s.service.TerminateUserSessions(s.ctx, testUserID))
+}
+
+func (s *ServiceTestSuite) TestTerminateUserSessions_RetrieveError() {
+	s.mockStore.EXPECT().GetSessionsByUserID(mock.Anything, testUserID).Return(nil, errors.New("db error"))
+
+	s.Equal(&serviceerror.InternalServerError, s.service.TerminateUserSessions(s.ctx, testUserID))
+}
+
+func (s *ServiceTestSuite) TestTerminateUserSessions_MissingUserID() {
+	s.Equal(&ErrorMissingUserID, s.service.TerminateUserSessions(s.ctx, ""))
+}
@@ -0,0 +1,282 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	dbprovider "github.com/asgardeo/thunder/internal/system/database/provider"
+	dbutils "github.com/asgardeo/thunder/internal/system/database/utils"
+)
+
+const (
+	jsonDataKeySID       = "sid"
+	jsonDataKeyAuthTime  = "auth_time"
+	jsonDataKeyACR       = "acr"
+	jsonDataKeyClientIDs = "client_ids"
+)
+
+// sessionStoreInterface defines the interface for the SSO session store.
+type sessionStoreInterface interface {
+	// CreateSession persists a new session.
+	CreateSession(ctx context.Context, session Session) error
+
+	// GetSession retrieves an unexpired session by ID.
+	GetSession(ctx context.Context, id string) (Session, error)
+
+	// GetSessionsByUserID retrieves the unexpired sessions of a user.
+	GetSessionsByUserID(ctx context.Context, userID string) ([]Session, error)
+
+	// UpdateSessionData replaces the authentication time, ACR and client IDs recorded against an
+	// unexpired session.
+	UpdateSessionData(ctx context.Context, session Session) error
+
+	// DeleteSession deletes a session by ID.
+	DeleteSession(ctx context.Context, id string) error
+
+	// DeleteSessionsByUserID deletes all sessions of a user.
+	DeleteSessionsByUserID(ctx context.Context, userID string) error
+}
+
+// sessionStore is the SQL implementation of sessionStoreInterface.
+type sessionStore struct {
+	dbProvider   dbprovider.DBProviderInterface
+	deploymentID string
+}
+
+// newSessionStore creates a new instance of sessionStore.
+func newSessionStore() sessionStoreInterface {
+	return &sessionStore{
+		dbProvider:   dbprovider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateSession persists a new session in the database.
+func (s *sessionStore) CreateSession(ctx context.Context, session Session) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	sessionData, err := getSessionDataJSON(session)
+	if err != nil {
+		return err
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryInsertSession, session.ID, session.UserID,
+		sessionData, session.ExpiryTime, session.CreatedAt, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to insert session: %w", err)
+	}
+	if rows == 0 {
+		return errors.New("no rows affected, session creation failed")
+	}
+
+	return nil
+}
+
+// GetSession retrieves an unexpired session by ID from the database.
+func (s *sessionStore) GetSession(ctx context.Context, id string) (Session, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return Session{}, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetSession, id, time.Now(), s.deploymentID)
+	if err != nil {
+		return Session{}, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return Session{}, errSessionNotFound
+	}
+	if len(results) > 1 {
+		return Session{}, errors.New("multiple sessions found")
+	}
+
+	session, err := buildSessionFromResultRow(results[0])
+	if err != nil {
+		return Session{}, fmt.Errorf("failed to build session from result row: %w", err)
+	}
+
+	return session, nil
+}
+
+// GetSessionsByUserID retrieves the unexpired sessions of a user from the database.
+func (s *sessionStore) GetSessionsByUserID(ctx context.Context, userID string) ([]Session, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetSessionsByUserID, userID, time.Now(), s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	sessions := make([]Session, 0, len(results))
+	for _, row := range results {
+		session, err := buildSessionFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build session from result row: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// UpdateSessionData replaces the authentication time, ACR and client IDs recorded against an unexpired
+// session in the database.
+func (s *sessionStore) UpdateSessionData(ctx context.Context, session Session) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	sessionData, err := getSessionDataJSON(session)
+	if err != nil {
+		return err
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryUpdateSessionData, session.ID, sessionData,
+		time.Now(), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+	if rows == 0 {
+		return errSessionNotFound
+	}
+
+	return nil
+}
+
+// DeleteSession deletes a session by ID from the database.
+func (s *sessionStore) DeleteSession(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryDeleteSession, id, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	if rows == 0 {
+		return errSessionNotFound
+	}
+
+	return nil
+}
+
+// DeleteSessionsByUserID deletes all sessions of a user from the database.
+func (s *sessionStore) DeleteSessionsByUserID(ctx context.Context, userID string) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteSessionsByUserID, userID, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to delete sessions: %w", err)
+	}
+
+	return nil
+}
+
+// getSessionDataJSON serializes the mutable session attributes stored in the SESSION_DATA column.
+func getSessionDataJSON(session Session) (string, error) {
+	clientIDs := session.ClientIDs
+	if clientIDs == nil {
+		clientIDs = []string{}
+	}
+	jsonData := map[string]interface{}{
+		jsonDataKeySID:       session.SID,
+		jsonDataKeyAuthTime:  session.AuthTime.Unix(),
+		jsonDataKeyACR:       session.ACR,
+		jsonDataKeyClientIDs: clientIDs,
+	}
+
+	data, err := json.Marshal(jsonData)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal session data: %w", err)
+	}
+	return string(data), nil
+}
+
+// buildSessionFromResultRow builds a Session object from a database result row.
+func buildSessionFromResultRow(row map[string]interface{}) (Session, error) {
+	id, ok := row["session_id"].(string)
+	if !ok {
+		return Session{}, errors.New("failed to parse session_id as string")
+	}
+
+	userID, ok := row["user_id"].(string)
+	if !ok {
+		return Session{}, errors.New("failed to parse user_id as string")
+	}
+
+	var dataStr string
+	switch v := row["session_data"].(type) {
+	case string:
+		dataStr = v
+	case []byte:
+		dataStr = string(v)
+	default:
+		return Session{}, errors.New("failed to parse session_data: expected string or []byte")
+	}
+
+	var data struct {
+		SID       string   `json:"sid"`
+		AuthTime  int64    `json:"auth_time"`
+		ACR       string   `json:"acr"`
+		ClientIDs []string `json:"client_ids"`
+	}
+	if err := json.Unmarshal([]byte(dataStr), &data); err != nil {
+		return Session{}, fmt.Errorf("failed to unmarshal session data: %w", err)
+	}
+	if data.ClientIDs == nil {
+		data.ClientIDs = []string{}
+	}
+
+	expiryTime, err := dbutils.ParseTimeField(row["expiry_time"], "expiry_time")
+	if err != nil {
+		return Session{}, err
+	}
+
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return Session{}, err
+	}
+
+	return Session{
+		ID:         id,
+		SID:        data.SID,
+		UserID:     userID,
+		AuthTime:   time.Unix(data.AuthTime, 0),
+		ACR:        data.ACR,
+		ClientIDs:  data.ClientIDs,
+		CreatedAt:  createdAt,
+		ExpiryTime: expiryTime,
+	}, nil
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package session
+
+import dbmodel "github.com/asgardeo/thunder/internal/system/database/model"
+
+var (
+	// queryInsertSession inserts a new SSO session.
+	queryInsertSession = dbmodel.DBQuery{
+		ID: "SSN-01",
+		Query: `INSERT INTO "SSO_SESSION" (SESSION_ID, USER_ID, SESSION_DATA, EXPIRY_TIME, CREATED_AT, ` +
+			`DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6)`,
+	}
+
+	// queryGetSession retrieves an unexpired SSO session by ID.
+	queryGetSession = dbmodel.DBQuery{
+		ID: "SSN-02",
+		Query: `SELECT SESSION_ID, USER_ID, SESSION_DATA, EXPIRY_TIME, CREATED_AT FROM "SSO_SESSION" ` +
+			`WHERE SESSION_ID = $1 AND EXPIRY_TIME > $2 AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryGetSessionsByUserID retrieves the unexpired SSO sessions of a user.
+	queryGetSessionsByUserID = dbmodel.DBQuery{
+		ID: "SSN-03",
+		Query: `SELECT SESSION_ID, USER_ID, SESSION_DATA, EXPIRY_TIME, CREATED_AT FROM "SSO_SESSION" ` +
+			`WHERE USER_ID = $1 AND EXPIRY_TIME > $2 AND DEPLOYMENT_ID = $3 ORDER BY CREATED_AT`,
+	}
+
+	// queryUpdateSessionData updates the data of an unexpired SSO session.
+	queryUpdateSessionData = dbmodel.DBQuery{
+		ID: "SSN-04",
+		Query: `UPDATE "SSO_SESSION" SET SESSION_DATA = $2 ` +
+			`WHERE SESSION_ID = $1 AND EXPIRY_TIME > $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryDeleteSession deletes an SSO session by ID.
+	queryDeleteSession = dbmodel.DBQuery{
+		ID:    "SSN-05",
+		Query: `DELETE FROM "SSO_SESSION" WHERE SESSION_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryDeleteSessionsByUserID deletes all SSO sessions of a user.
+	queryDeleteSessionsByUserID = dbmodel.DBQuery{
+		ID:    "SSN-06",
+		Query: `DELETE FROM "SSO_SESSION" WHERE USER_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
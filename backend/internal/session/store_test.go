@@ -0,0 +1,302 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/tests/mocks/database/providermock"
+)
+
+const (
+	testDeploymentID = "test-deployment-id"
+	testSessionID    = "0190d3f6-6b7a-7c3e-8a1b-1234567890ab"
+	testUserID       = "test-user-id"
+	testClientID     = "test-client-id"
+)
+
+type StoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *sessionStore
+	ctx            context.Context
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (s *StoreTestSuite) SetupTest() {
+	s.mockDBProvider = &providermock.DBProviderInterfaceMock{}
+	s.mockDBClient = &providermock.DBClientInterfaceMock{}
+	s.store = &sessionStore{
+		dbProvider:   s.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+}
+
+func (s *StoreTestSuite) testSession() Session {
+	now := time.Now()
+	return Session{
+		ID:         testSessionID,
+		SID:        "test-sid",
+		UserID:     testUserID,
+		AuthTime:   time.Unix(now.Unix(), 0),
+		ACR:        "urn:thunder:acr:password",
+		ClientIDs:  []string{testClientID},
+		CreatedAt:  now,
+		ExpiryTime: now.Add(time.Hour),
+	}
+}
+
+// Tests for CreateSession
+
+func (s *StoreTestSuite) TestCreateSession_Success() {
+	session := s.testSession()
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertSession,
+		testSessionID, testUserID, mock.MatchedBy(func(data string) bool {
+			return assert.JSONEq(s.T(),
+				`{"sid":"test-sid","auth_time":`+formatUnix(session.AuthTime)+`,"acr":"urn:thunder:acr:password",`+
+					`"client_ids":["test-client-id"]}`, data)
+		}), session.ExpiryTime, session.CreatedAt, testDeploymentID,
+	).Return(int64(1), nil)
+
+	err := s.store.CreateSession(s.ctx, session)
+
+	assert.NoError(s.T(), err)
+	s.mockDBClient.AssertExpectations(s.T())
+}
+
+func (s *StoreTestSuite) TestCreateSession_DBClientError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db client error"))
+
+	err := s.store.CreateSession(s.ctx, s.testSession())
+
+	assert.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "failed to get database client")
+}
+
+func (s *StoreTestSuite) TestCreateSession_NoRowsAffected() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertSession,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(int64(0), nil)
+
+	err := s.store.CreateSession(s.ctx, s.testSession())
+
+	assert.Error(s.T(), err)
+}
+
+// Tests for GetSession
+
+func (s *StoreTestSuite) TestGetSession_Success() {
+	expected := s.testSession()
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetSession,
+		testSessionID, mock.AnythingOfType("time.Time"), testDeploymentID,
+	).Return([]map[string]interface{}{s.resultRow(expected)}, nil)
+
+	session, err := s.store.GetSession(s.ctx, testSessionID)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), testSessionID, session.ID)
+	assert.Equal(s.T(), testUserID, session.UserID)
+	assert.Equal(s.T(), expected.AuthTime.Unix(), session.AuthTime.Unix())
+	assert.Equal(s.T(), expected.ACR, session.ACR)
+	assert.Equal(s.T(), []string{testClientID}, session.ClientIDs)
+}
+
+func (s *StoreTestSuite) TestGetSession_SQLiteTimeStrings() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetSession,
+		testSessionID, mock.Anything, testDeploymentID,
+	).Return([]map[string]interface{}{{
+		"session_id":   testSessionID,
+		"user_id":      testUserID,
+		"session_data": []byte(`{"auth_time":1700000000,"acr":"","client_ids":null}`),
+		"expiry_time":  "2026-10-16 12:00:00.123456 +0000 UTC",
+		"created_at":   "2026-10-16 11:00:00",
+	}}, nil)
+
+	session, err := s.store.GetSession(s.ctx, testSessionID)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1700000000), session.AuthTime.Unix())
+	assert.Empty(s.T(), session.ClientIDs)
+	assert.Equal(s.T(), 12, session.ExpiryTime.Hour())
+}
+
+func (s *StoreTestSuite) TestGetSession_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetSession,
+		testSessionID, mock.Anything, testDeploymentID,
+	).Return([]map[string]interface{}{}, nil)
+
+	_, err := s.store.GetSession(s.ctx, testSessionID)
+
+	assert.ErrorIs(s.T(), err, errSessionNotFound)
+}
+
+func (s *StoreTestSuite) TestGetSession_QueryError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetSession,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil, errors.New("query failed"))
+
+	_, err := s.store.GetSession(s.ctx, testSessionID)
+
+	assert.Error(s.T(), err)
+	assert.NotErrorIs(s.T(), err, errSessionNotFound)
+}
+
+func (s *StoreTestSuite) TestGetSession_InvalidSessionData() {
+	row := s.resultRow(s.testSession())
+	row["session_data"] = "{invalid"
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetSession,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return([]map[string]interface{}{row}, nil)
+
+	_, err := s.store.GetSession(s.ctx, testSessionID)
+
+	assert.Error(s.T(), err)
+}
+
+// Tests for GetSessionsByUserID
+
+func (s *StoreTestSuite) TestGetSessionsByUserID_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetSessionsByUserID,
+		testUserID, mock.AnythingOfType("time.Time"), testDeploymentID,
+	).Return([]map[string]interface{}{s.resultRow(s.testSession())}, nil)
+
+	sessions, err := s.store.GetSessionsByUserID(s.ctx, testUserID)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), sessions, 1)
+	assert.Equal(s.T(), testSessionID, sessions[0].ID)
+}
+
+func (s *StoreTestSuite) TestGetSessionsByUserID_QueryError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetSessionsByUserID,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil, errors.New("query failed"))
+
+	sessions, err := s.store.GetSessionsByUserID(s.ctx, testUserID)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), sessions)
+}
+
+// Tests for UpdateSessionData
+
+func (s *StoreTestSuite) TestUpdateSessionData_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateSessionData,
+		testSessionID, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), testDeploymentID,
+	).Return(int64(1), nil)
+
+	err := s.store.UpdateSessionData(s.ctx, s.testSession())
+
+	assert.NoError(s.T(), err)
+}
+
+func (s *StoreTestSuite) TestUpdateSessionData_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateSessionData,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(int64(0), nil)
+
+	err := s.store.UpdateSessionData(s.ctx, s.testSession())
+
+	assert.ErrorIs(s.T(), err, errSessionNotFound)
+}
+
+// Tests for DeleteSession
+
+func (s *StoreTestSuite) TestDeleteSession_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteSession, testSessionID, testDeploymentID).
+		Return(int64(1), nil)
+
+	err := s.store.DeleteSession(s.ctx, testSessionID)
+
+	assert.NoError(s.T(), err)
+}
+
+func (s *StoreTestSuite) TestDeleteSession_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteSession, testSessionID, testDeploymentID).
+		Return(int64(0), nil)
+
+	err := s.store.DeleteSession(s.ctx, testSessionID)
+
+	assert.ErrorIs(s.T(), err, errSessionNotFound)
+}
+
+// Tests for DeleteSessionsByUserID
+
+func (s *StoreTestSuite) TestDeleteSessionsByUserID_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteSessionsByUserID, testUserID, testDeploymentID).
+		Return(int64(2), nil)
+
+	err := s.store.DeleteSessionsByUserID(s.ctx, testUserID)
+
+	assert.NoError(s.T(), err)
+}
+
+func (s *StoreTestSuite) TestDeleteSessionsByUserID_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteSessionsByUserID, testUserID, testDeploymentID).
+		Return(int64(0), errors.New("delete failed"))
+
+	err := s.store.DeleteSessionsByUserID(s.ctx, testUserID)
+
+	assert.Error(s.T(), err)
+}
+
+// resultRow builds a database result row for the given session.
+func (s *StoreTestSuite) resultRow(session Session) map[string]interface{} {
+	data, err := getSessionDataJSON(session)
+	s.Require().NoError(err)
+	return map[string]interface{}{
+		"session_id":   session.ID,
+		"user_id":      session.UserID,
+		"session_data": data,
+		"expiry_time":  session.ExpiryTime,
+		"created_at":   session.CreatedAt,
+	}
+}
+
+func formatUnix(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
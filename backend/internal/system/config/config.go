@@ -72,12 +72,14 @@ type ServerConfig struct {
 
 // GateClientConfig holds the client configuration details.
 type GateClientConfig struct {
-	Hostname  string `yaml:"hostname" json:"hostname"`
-	Port      int    `yaml:"port" json:"port"`
-	Scheme    string `yaml:"scheme" json:"scheme"`
-	Path      string `yaml:"path" json:"path"`
-	LoginPath string `yaml:"login_path" json:"login_path"`
-	ErrorPath string `yaml:"error_path" json:"error_path"`
+	Hostname   string `yaml:"hostname" json:"hostname"`
+	Port       int    `yaml:"port" json:"port"`
+	Scheme     string `yaml:"scheme" json:"scheme"`
+	Path       string `yaml:"path" json:"path"`
+	LoginPath  string `yaml:"login_path" json:"login_path"`
+	ErrorPath  string `yaml:"error_path" json:"error_path"`
+	DevicePath string `yaml:"device_path" json:"device_path"`
+	LogoutPath string `yaml:"logout_path" json:"logout_path"`
 }
 
 // TLSConfig holds the TLS configuration details.
@@ -185,17 +187,32 @@ type RedisConfig struct {
 
 // JWTConfig holds the JWT configuration details.
 type JWTConfig struct {
-	Issuer         string `yaml:"issuer" json:"issuer"`
-	ValidityPeriod int64  `yaml:"validity_period" json:"validity_period"`
-	Audience       string `yaml:"audience" json:"audience"`
-	PreferredKeyID string `yaml:"preferred_key_id" json:"preferred_key_id"`
-	Leeway         int64  `yaml:"leeway" json:"leeway"`
-}
-
-// RefreshTokenConfig holds the refresh token configuration details.
+	Issuer         string            `yaml:"issuer" json:"issuer"`
+	ValidityPeriod int64             `yaml:"validity_period" json:"validity_period"`
+	Audience       string            `yaml:"audience" json:"audience"`
+	PreferredKeyID string            `yaml:"preferred_key_id" json:"preferred_key_id"`
+	Leeway         int64             `yaml:"leeway" json:"leeway"`
+	KeyRotation    KeyRotationConfig `yaml:"key_rotation" json:"key_rotation"`
+}
+
+// KeyRotationConfig holds the token signing key rotation configuration. Periods are in seconds.
+type KeyRotationConfig struct {
+	Enabled          bool   `yaml:"enabled" json:"enabled"`
+	Algorithm        string `yaml:"algorithm" json:"algorithm"`
+	RotationPeriod   int64  `yaml:"rotation_period" json:"rotation_period"`
+	PrePublishPeriod int64  `yaml:"pre_publish_period" json:"pre_publish_period"`
+	RetirementPeriod int64  `yaml:"retirement_period" json:"retirement_period"`
+	CheckInterval    int64  `yaml:"check_interval" json:"check_interval"`
+}
+
+// RefreshTokenConfig holds the refresh token configuration details. When RenewOnGrant is enabled,
+// refresh tokens are rotated on every use and the reuse of a rotated token revokes its grant.
+// ValidityPeriod is the idle lifetime of a refresh token and AbsoluteValidityPeriod caps the lifetime
+// of the grant, with zero meaning no absolute limit. Periods are in seconds.
 type RefreshTokenConfig struct {
-	RenewOnGrant   bool  `yaml:"renew_on_grant" json:"renew_on_grant"`
-	ValidityPeriod int64 `yaml:"validity_period" json:"validity_period"`
+	RenewOnGrant           bool  `yaml:"renew_on_grant" json:"renew_on_grant"`
+	ValidityPeriod         int64 `yaml:"validity_period" json:"validity_period"`
+	AbsoluteValidityPeriod int64 `yaml:"absolute_validity_period" json:"absolute_validity_period"`
 }
 
 // AuthorizationCodeConfig holds the authorization code configuration details.
@@ -214,13 +231,124 @@ type PARConfig struct {
 	ExpiresIn  int64 `yaml:"expires_in" json:"expires_in"`
 }
 
+// DeviceAuthorizationConfig holds the Device Authorization Grant (RFC 8628) configuration.
+type DeviceAuthorizationConfig struct {
+	ExpiresIn       int64 `yaml:"expires_in" json:"expires_in"`
+	PollingInterval int64 `yaml:"polling_interval" json:"polling_interval"`
+}
+
+// CIBAConfig holds the OpenID Client Initiated Backchannel Authentication (CIBA) configuration.
+type CIBAConfig struct {
+	ExpiresIn       int64 `yaml:"expires_in" json:"expires_in"`
+	PollingInterval int64 `yaml:"polling_interval" json:"polling_interval"`
+	// LoginHintAttributes lists the user attributes a login_hint is matched against, in order.
+	LoginHintAttributes []string `yaml:"login_hint_attributes" json:"login_hint_attributes"`
+}
+
+// MTLSConfig holds the mutual-TLS client authentication (RFC 8705) configuration.
+type MTLSConfig struct {
+	// Enabled makes the server request, but not require, a client certificate during the TLS handshake.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// TrustedCAFile is the PEM bundle, relative to the server home, of the CAs that issue certificates
+	// for tls_client_auth clients.
+	TrustedCAFile string `yaml:"trusted_ca_file" json:"trusted_ca_file"`
+}
+
+// DPoPConfig holds the demonstrating proof of possession (RFC 9449) configuration.
+type DPoPConfig struct {
+	// ProofValidityPeriod is the number of seconds a proof is accepted for after its iat.
+	ProofValidityPeriod int64 `yaml:"proof_validity_period" json:"proof_validity_period"`
+	// RequireNonce makes the server reject proofs that do not carry a server-issued nonce.
+	RequireNonce bool `yaml:"require_nonce" json:"require_nonce"`
+	// NonceValidityPeriod is the number of seconds a server-issued nonce is accepted for.
+	NonceValidityPeriod int64 `yaml:"nonce_validity_period" json:"nonce_validity_period"`
+}
+
+// RequestObjectConfig holds the JWT-secured authorization request (RFC 9101) configuration.
+type RequestObjectConfig struct {
+	// RequireSignedRequestObject makes every client send its authorization request in a signed request object.
+	RequireSignedRequestObject bool `yaml:"require_signed_request_object" json:"require_signed_request_object"`
+	// AllowRequestURI enables fetching request objects by reference from https URIs hosted by clients.
+	// Request URIs issued by the pushed authorization request endpoint are always accepted.
+	AllowRequestURI bool `yaml:"allow_request_uri" json:"allow_request_uri"`
+}
+
+// JWTBearerConfig holds the JWT bearer authorization grant (RFC 7523) configuration.
+type JWTBearerConfig struct {
+	TrustedIssuers []JWTBearerIssuerConfig `yaml:"trusted_issuers" json:"trusted_issuers"`
+}
+
+// JWTBearerIssuerConfig describes an external issuer whose assertions can be exchanged for tokens.
+// Assertion signatures are verified against either the inline JWKS or the keys served at JWKSURI.
+// SubjectAttribute names the indexed entity attribute that the assertion's sub claim is matched
+// against when identifying the local entity.
+type JWTBearerIssuerConfig struct {
+	Issuer           string `yaml:"issuer" json:"issuer"`
+	JWKSURI          string `yaml:"jwks_uri" json:"jwks_uri"`
+	JWKS             string `yaml:"jwks" json:"jwks"`
+	SubjectAttribute string `yaml:"subject_attribute" json:"subject_attribute"`
+}
+
+// GetTrustedIssuer returns the trusted issuer configuration for the given issuer, or nil if the issuer
+// is not trusted.
+func (c *JWTBearerConfig) GetTrustedIssuer(issuer string) *JWTBearerIssuerConfig {
+	for i := range c.TrustedIssuers {
+		if c.TrustedIssuers[i].Issuer == issuer {
+			return &c.TrustedIssuers[i]
+		}
+	}
+	return nil
+}
+
+// Validate checks the JWT bearer trusted issuers for configuration errors.
+// Each issuer must be unique, name a subject attribute, and configure exactly one of jwks or jwks_uri. As with the
+// trusted_issuer JWKS URL, jwks_uri must use HTTPS except for localhost.
+func (c *JWTBearerConfig) Validate() error {
+	seen := make(map[string]struct{}, len(c.TrustedIssuers))
+	for _, ti := range c.TrustedIssuers {
+		if strings.TrimSpace(ti.Issuer) == "" {
+			return fmt.Errorf("jwt_bearer: trusted issuer must not be empty")
+		}
+		if _, ok := seen[ti.Issuer]; ok {
+			return fmt.Errorf("jwt_bearer: trusted issuer %q is configured more than once", ti.Issuer)
+		}
+		seen[ti.Issuer] = struct{}{}
+
+		if strings.TrimSpace(ti.SubjectAttribute) == "" {
+			return fmt.Errorf("jwt_bearer: trusted issuer %q must set subject_attribute", ti.Issuer)
+		}
+		if (ti.JWKS == "") == (ti.JWKSURI == "") {
+			return fmt.Errorf("jwt_bearer: trusted issuer %q must set exactly one of jwks or jwks_uri", ti.Issuer)
+		}
+		if ti.JWKSURI == "" {
+			continue
+		}
+		parsed, err := url.Parse(ti.JWKSURI)
+		if err != nil {
+			return fmt.Errorf("jwt_bearer: jwks_uri of trusted issuer %q is not a valid URL: %w", ti.Issuer, err)
+		}
+		host := parsed.Hostname()
+		if parsed.Scheme != schemeHTTPS &&
+			(parsed.Scheme != "http" || (host != "localhost" && host != "127.0.0.1" && host != "::1")) {
+			return fmt.Errorf("jwt_bearer: jwks_uri of trusted issuer %q must use https", ti.Issuer)
+		}
+	}
+	return nil
+}
+
 // OAuthConfig holds the OAuth configuration details.
 type OAuthConfig struct {
-	RefreshToken      RefreshTokenConfig      `yaml:"refresh_token" json:"refresh_token"`
-	AuthorizationCode AuthorizationCodeConfig `yaml:"authorization_code" json:"authorization_code"`
-	DCR               DCRConfig               `yaml:"dcr" json:"dcr"`
-	PAR               PARConfig               `yaml:"par" json:"par"`
-	AuthClass         AuthClassConfig         `yaml:"auth_class" json:"auth_class"`
+	RefreshToken        RefreshTokenConfig        `yaml:"refresh_token" json:"refresh_token"`
+	AuthorizationCode   AuthorizationCodeConfig   `yaml:"authorization_code" json:"authorization_code"`
+	DCR                 DCRConfig                 `yaml:"dcr" json:"dcr"`
+	PAR                 PARConfig                 `yaml:"par" json:"par"`
+	DeviceAuthorization DeviceAuthorizationConfig `yaml:"device_authorization" json:"device_authorization"`
+	CIBA                CIBAConfig                `yaml:"ciba" json:"ciba"`
+	JWTBearer           JWTBearerConfig           `yaml:"jwt_bearer" json:"jwt_bearer"`
+	MTLS                MTLSConfig                `yaml:"mtls" json:"mtls"`
+	DPoP                DPoPConfig                `yaml:"dpop" json:"dpop"`
+	RequestObject       RequestObjectConfig       `yaml:"request_object" json:"request_object"`
+	AuthClass           AuthClassConfig           `yaml:"auth_class" json:"auth_class"`
 	// AllowWildcardRedirectURI enables wildcard pattern matching for redirect URIs.
 	// When false (default), only exact redirect URI matching is performed.
 	AllowWildcardRedirectURI bool `yaml:"allow_wildcard_redirect_uri" json:"allow_wildcard_redirect_uri"`
@@ -228,11 +356,12 @@ type OAuthConfig struct {
 
 // FlowConfig holds the configuration details for the flow service.
 type FlowConfig struct {
-	DefaultAuthFlowHandle    string `yaml:"default_auth_flow_handle" json:"default_auth_flow_handle"`
-	UserOnboardingFlowHandle string `yaml:"user_onboarding_flow_handle" json:"user_onboarding_flow_handle"`
-	MaxVersionHistory        int    `yaml:"max_version_history" json:"max_version_history"`
-	AutoInferRegistration    bool   `yaml:"auto_infer_registration" json:"auto_infer_registration"`
-	Store                    string `yaml:"store" json:"store"`
+	DefaultAuthFlowHandle     string `yaml:"default_auth_flow_handle" json:"default_auth_flow_handle"`
+	UserOnboardingFlowHandle  string `yaml:"user_onboarding_flow_handle" json:"user_onboarding_flow_handle"`
+	BackchannelAuthFlowHandle string `yaml:"backchannel_auth_flow_handle" json:"backchannel_auth_flow_handle"`
+	MaxVersionHistory         int    `yaml:"max_version_history" json:"max_version_history"`
+	AutoInferRegistration     bool   `yaml:"auto_infer_registration" json:"auto_infer_registration"`
+	Store                     string `yaml:"store" json:"store"`
 }
 
 // CryptoConfig holds the cryptographic configuration details.
@@ -240,6 +369,29 @@ type CryptoConfig struct {
 	Encryption      EncryptionConfig      `yaml:"encryption" json:"encryption"`
 	PasswordHashing PasswordHashingConfig `yaml:"password_hashing" json:"password_hashing"`
 	Keys            []KeyConfig           `yaml:"keys" json:"keys"`
+	KeyManager      KeyManagerConfig      `yaml:"key_manager" json:"key_manager"`
+}
+
+// KeyManagerConfig holds the key manager provider configuration details.
+type KeyManagerConfig struct {
+	Provider string       `yaml:"provider" json:"provider"`
+	PKCS11   PKCS11Config `yaml:"pkcs11" json:"pkcs11"`
+}
+
+// PKCS11Config holds the configuration details of the PKCS#11 key manager provider.
+// Each key maps a key ID to the label of a key pair and its certificate in the token.
+type PKCS11Config struct {
+	Library            string            `yaml:"library" json:"library"`
+	TokenLabel         string            `yaml:"token_label" json:"token_label"`
+	Pin                string            `yaml:"pin" json:"pin"`
+	Keys               []PKCS11KeyConfig `yaml:"keys" json:"keys"`
+	EncryptionKeyLabel string            `yaml:"encryption_key_label" json:"encryption_key_label"`
+}
+
+// PKCS11KeyConfig holds the details of a key pair held in a PKCS#11 token.
+type PKCS11KeyConfig struct {
+	ID    string `yaml:"id" json:"id"`
+	Label string `yaml:"label" json:"label"`
 }
 
 // KeyConfig holds the key configuration details.
@@ -444,6 +596,39 @@ type ThemeConfig struct {
 	Store string `yaml:"store" json:"store"`
 }
 
+// AuthorizationConfig holds the authorization service configuration.
+type AuthorizationConfig struct {
+	ABAC             ABACConfig             `yaml:"abac" json:"abac"`
+	ReBAC            ReBACConfig            `yaml:"rebac" json:"rebac"`
+	AccessEvaluation AccessEvaluationConfig `yaml:"access_evaluation" json:"access_evaluation"`
+}
+
+// ABACConfig holds the attribute-based access control configuration.
+type ABACConfig struct {
+	// Enabled combines attribute-based policies with role-based access control when set.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// CombiningAlgorithm defines how policy decisions are combined with role-based decisions.
+	// Valid values: "deny_overrides" (default), "permit_overrides"
+	CombiningAlgorithm string `yaml:"combining_algorithm" json:"combining_algorithm"`
+	// Store defines the storage mode for authorization policies.
+	// Valid values: "mutable", "declarative", "composite" (hybrid mode)
+	// If not specified, falls back to global DeclarativeResources.Enabled setting.
+	Store string `yaml:"store" json:"store"`
+}
+
+// ReBACConfig holds the relationship-based access control configuration.
+type ReBACConfig struct {
+	// Enabled grants relationship permissions of the form "<namespace>:<object id>#<relation>"
+	// in addition to the permissions granted through role assignments when set.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+}
+
+// AccessEvaluationConfig holds the configuration of the AuthZEN access evaluation API.
+type AccessEvaluationConfig struct {
+	// MaxBatchSize is the maximum number of evaluations accepted in a single batch evaluation request.
+	MaxBatchSize int `yaml:"max_batch_size" json:"max_batch_size"`
+}
+
 // LayoutConfig holds the layout service configuration.
 type LayoutConfig struct {
 	// Store defines the storage mode for layouts.
@@ -511,6 +696,87 @@ type ConsentConfig struct {
 	MaxRetries int    `yaml:"max_retries" json:"max_retries"` // Max retry attempts for transient errors. Default: 3
 }
 
+// SessionConfig holds the configuration for browser SSO sessions.
+type SessionConfig struct {
+	ValidityPeriod int64  `yaml:"validity_period" json:"validity_period"` // Session lifetime in seconds.
+	CookieName     string `yaml:"cookie_name" json:"cookie_name"`
+}
+
+// AccountLockoutConfig holds the configuration for brute-force protection of login attempts.
+// Failed attempts are counted per entity, per login identifier and per client IP. Once a
+// subject reaches the threshold of its policy it is locked for the policy's lock duration,
+// which grows by LockDurationMultiplier with every repeated lock up to MaxLockDuration.
+type AccountLockoutConfig struct {
+	Enabled                bool                   `yaml:"enabled" json:"enabled"`
+	FailureWindow          int64                  `yaml:"failure_window" json:"failure_window"` // Seconds.
+	LockDurationMultiplier float64                `yaml:"lock_duration_multiplier" json:"lock_duration_multiplier"`
+	MaxLockDuration        int64                  `yaml:"max_lock_duration" json:"max_lock_duration"` // Seconds.
+	ProgressiveDelay       ProgressiveDelayConfig `yaml:"progressive_delay" json:"progressive_delay"`
+	Entity                 LockoutPolicyConfig    `yaml:"entity" json:"entity"`
+	Identifier             LockoutPolicyConfig    `yaml:"identifier" json:"identifier"`
+	IP                     LockoutPolicyConfig    `yaml:"ip" json:"ip"`
+}
+
+// ProgressiveDelayConfig holds the configuration for the delay enforced between consecutive failed
+// login attempts. The delay doubles with every failed attempt after FreeAttempts.
+type ProgressiveDelayConfig struct {
+	FreeAttempts int   `yaml:"free_attempts" json:"free_attempts"`
+	BaseDelay    int64 `yaml:"base_delay" json:"base_delay"` // Milliseconds. Zero disables the delay.
+	MaxDelay     int64 `yaml:"max_delay" json:"max_delay"`   // Milliseconds.
+}
+
+// LockoutPolicyConfig holds the lockout thresholds of a subject type.
+type LockoutPolicyConfig struct {
+	MaxFailedAttempts int   `yaml:"max_failed_attempts" json:"max_failed_attempts"` // Zero disables the policy.
+	LockDuration      int64 `yaml:"lock_duration" json:"lock_duration"`             // Seconds.
+}
+
+// PasswordPolicyConfig holds the configuration for the password policies enforced on user credentials.
+// The policy of a user is the first policy that lists the user type, then the first policy that lists
+// the user's organization unit or one of its ancestors, and otherwise the first policy without any
+// selectors.
+type PasswordPolicyConfig struct {
+	Enabled              bool                 `yaml:"enabled" json:"enabled"`
+	CredentialAttribute  string               `yaml:"credential_attribute" json:"credential_attribute"`
+	BreachedPasswordFile string               `yaml:"breached_password_file" json:"breached_password_file"`
+	Policies             []PasswordPolicyRule `yaml:"policies" json:"policies"`
+}
+
+// PasswordPolicyRule holds the password requirements of a set of user types or organization units.
+type PasswordPolicyRule struct {
+	Name              string   `yaml:"name" json:"name"`
+	UserTypes         []string `yaml:"user_types" json:"user_types"`
+	OrganizationUnits []string `yaml:"organization_units" json:"organization_units"`
+	MinLength         int      `yaml:"min_length" json:"min_length"`
+	MaxLength         int      `yaml:"max_length" json:"max_length"` // Zero means no limit.
+	RequireUppercase  bool     `yaml:"require_uppercase" json:"require_uppercase"`
+	RequireLowercase  bool     `yaml:"require_lowercase" json:"require_lowercase"`
+	RequireDigit      bool     `yaml:"require_digit" json:"require_digit"`
+	RequireSpecial    bool     `yaml:"require_special" json:"require_special"`
+	HistoryCount      int      `yaml:"history_count" json:"history_count"` // Zero allows reuse.
+	MaxAge            int64    `yaml:"max_age" json:"max_age"`             // Seconds. Zero never expires.
+	CheckBreached     bool     `yaml:"check_breached" json:"check_breached"`
+}
+
+// TOTPConfig holds the configuration of time-based one-time passwords (RFC 6238) used as an
+// authenticator app second factor.
+type TOTPConfig struct {
+	Issuer            string `yaml:"issuer" json:"issuer"`
+	Digits            int    `yaml:"digits" json:"digits"`
+	Period            int64  `yaml:"period" json:"period"`             // Seconds.
+	DriftWindow       int    `yaml:"drift_window" json:"drift_window"` // Time steps accepted either side.
+	RecoveryCodeCount int    `yaml:"recovery_code_count" json:"recovery_code_count"`
+}
+
+// OTPConfig holds the limits enforced on one-time password sessions delivered over SMS or email.
+// A zero value disables the respective limit.
+type OTPConfig struct {
+	MaxVerifyAttempts int   `yaml:"max_verify_attempts" json:"max_verify_attempts"`
+	ResendInterval    int64 `yaml:"resend_interval" json:"resend_interval"` // Seconds between sends to a recipient.
+	MaxSends          int   `yaml:"max_sends" json:"max_sends"`             // Sends to a recipient per send window.
+	SendWindow        int64 `yaml:"send_window" json:"send_window"`         // Seconds.
+}
+
 // RequiredClaim defines a claim name and expected value that must be present in the token.
 type RequiredClaim struct {
 	Claim string `yaml:"claim" json:"claim"`
@@ -644,10 +910,16 @@ type Config struct {
 	UserProvider         UserProviderConfig     `yaml:"user_provider" json:"user_provider"`
 	EntityProvider       EntityProviderConfig   `yaml:"entity_provider" json:"entity_provider"`
 	Role                 RoleConfig             `yaml:"role" json:"role"`
+	Authorization        AuthorizationConfig    `yaml:"authorization" json:"authorization"`
 	Theme                ThemeConfig            `yaml:"theme" json:"theme"`
 	Layout               LayoutConfig           `yaml:"layout" json:"layout"`
 	Email                EmailConfig            `yaml:"email" json:"email"`
 	Consent              ConsentConfig          `yaml:"consent" json:"consent"`
+	Session              SessionConfig          `yaml:"session" json:"session"`
+	AccountLockout       AccountLockoutConfig   `yaml:"account_lockout" json:"account_lockout"`
+	PasswordPolicy       PasswordPolicyConfig   `yaml:"password_policy" json:"password_policy"`
+	TOTP                 TOTPConfig             `yaml:"totp" json:"totp"`
+	OTP                  OTPConfig              `yaml:"otp" json:"otp"`
 }
 
 // LoadConfig loads the configurations from the specified YAML file and applies defaults.
@@ -672,7 +944,7 @@ func LoadConfig(configPath string, defaultPath string, serverHome string) (*Conf
 
 	// Merge user configuration with defaults
 	mergeConfigs(&cfg, &userCfg)
-	// Derive login_path and error_path from path if not explicitly set
+	// Derive login_path, error_path, device_path and logout_path from path if not explicitly set
 	if cfg.GateClient.Path != "" {
 		if cfg.GateClient.LoginPath == "" {
 			cfg.GateClient.LoginPath = urlpath.Join(cfg.GateClient.Path, "signin")
@@ -680,6 +952,12 @@ func LoadConfig(configPath string, defaultPath string, serverHome string) (*Conf
 		if cfg.GateClient.ErrorPath == "" {
 			cfg.GateClient.ErrorPath = urlpath.Join(cfg.GateClient.Path, "error")
 		}
+		if cfg.GateClient.DevicePath == "" {
+			cfg.GateClient.DevicePath = urlpath.Join(cfg.GateClient.Path, "device")
+		}
+		if cfg.GateClient.LogoutPath == "" {
+			cfg.GateClient.LogoutPath = urlpath.Join(cfg.GateClient.Path, "logout")
+		}
 	}
 
 	// Derive JWT issuer from server config if not set
@@ -703,6 +981,9 @@ func LoadConfig(configPath string, defaultPath string, serverHome string) (*Conf
 	if err := cfg.OAuth.AuthClass.Validate(); err != nil {
 		return nil, err
 	}
+	if err := cfg.OAuth.JWTBearer.Validate(); err != nil {
+		return nil, err
+	}
 
 	return &cfg, nil
 }
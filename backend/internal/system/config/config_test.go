@@ -785,6 +785,8 @@ gate_client:
 	assert.Equal(suite.T(), "/app", config1.GateClient.Path)
 	assert.Equal(suite.T(), "/app/signin", config1.GateClient.LoginPath)
 	assert.Equal(suite.T(), "/app/error", config1.GateClient.ErrorPath)
+	assert.Equal(suite.T(), "/app/device", config1.GateClient.DevicePath)
+	assert.Equal(suite.T(), "/app/logout", config1.GateClient.LogoutPath)
 
 	// Case 2: Path and LoginPath are set
 	userContent2 := `
@@ -1089,3 +1091,54 @@ func (suite *ConfigTestSuite) TestAuthClassValidate_EmptyAMRReference() {
 	assert.Error(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "references an empty AMR key")
 }
+
+func (suite *ConfigTestSuite) TestJWTBearerConfig_Validate() {
+	issuer := func(jwksURI, jwks string) JWTBearerIssuerConfig {
+		return JWTBearerIssuerConfig{
+			Issuer: "https://idp", SubjectAttribute: "email", JWKSURI: jwksURI, JWKS: jwks,
+		}
+	}
+	testCases := []struct {
+		name        string
+		issuers     []JWTBearerIssuerConfig
+		expectedErr string
+	}{
+		{"Empty", nil, ""},
+		{"JWKSURI", []JWTBearerIssuerConfig{issuer("https://idp/jwks", "")}, ""},
+		{"InlineJWKS", []JWTBearerIssuerConfig{issuer("", `{"keys":[]}`)}, ""},
+		{"HTTPLocalhost", []JWTBearerIssuerConfig{issuer("http://localhost:8080/jwks", "")}, ""},
+		{"EmptyIssuer", []JWTBearerIssuerConfig{{JWKSURI: "https://idp/jwks"}}, "must not be empty"},
+		{"NoSubjectAttribute", []JWTBearerIssuerConfig{{Issuer: "https://idp", JWKSURI: "https://idp/jwks"}},
+			"subject_attribute"},
+		{"DuplicateIssuer", []JWTBearerIssuerConfig{
+			issuer("https://idp/jwks", ""), issuer("https://idp/jwks2", ""),
+		}, "more than once"},
+		{"NoKeySource", []JWTBearerIssuerConfig{issuer("", "")}, "exactly one of"},
+		{"BothKeySources", []JWTBearerIssuerConfig{issuer("https://idp/jwks", `{"keys":[]}`)}, "exactly one of"},
+		{"HTTPRejected", []JWTBearerIssuerConfig{issuer("http://idp/jwks", "")}, "must use https"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := (&JWTBearerConfig{TrustedIssuers: tc.issuers}).Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(suite.T(), err)
+			} else {
+				suite.Require().Error(err)
+				assert.Contains(suite.T(), err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (suite *ConfigTestSuite) TestJWTBearerConfig_GetTrustedIssuer() {
+	cfg := &JWTBearerConfig{TrustedIssuers: []JWTBearerIssuerConfig{
+		{Issuer: "https://idp-a", JWKSURI: "https://idp-a/jwks"},
+		{Issuer: "https://idp-b", JWKSURI: "https://idp-b/jwks"},
+	}}
+
+	ti := cfg.GetTrustedIssuer("https://idp-b")
+	suite.Require().NotNil(ti)
+	assert.Equal(suite.T(), "https://idp-b/jwks", ti.JWKSURI)
+	assert.Nil(suite.T(), cfg.GetTrustedIssuer("https://unknown"))
+}
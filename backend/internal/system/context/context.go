@@ -30,6 +30,9 @@ type contextKey string
 const (
 	// TraceIDKey is the context key for storing the trace ID (correlation ID).
 	TraceIDKey contextKey = "trace_id"
+
+	// ClientIPKey is the context key for storing the IP address of the client.
+	ClientIPKey contextKey = "client_ip"
 )
 
 // ============================================================================
@@ -95,3 +98,29 @@ func EnsureTraceID(ctx context.Context) context.Context {
 
 	return ctx
 }
+
+// ============================================================================
+// Client IP Functions
+// ============================================================================
+
+// GetClientIP retrieves the IP address of the client from the context.
+// Returns an empty string if the client IP is not known.
+func GetClientIP(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	if clientIP, ok := ctx.Value(ClientIPKey).(string); ok {
+		return clientIP
+	}
+
+	return ""
+}
+
+// WithClientIP adds the IP address of the client to the context.
+func WithClientIP(ctx context.Context, clientIP string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, ClientIPKey, clientIP)
+}
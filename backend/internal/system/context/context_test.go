@@ -256,3 +256,17 @@ func (s *ContextTestSuite) TestGenerateUUID_MultipleGenerations() {
 		seen[uuid] = true
 	}
 }
+
+func (s *ContextTestSuite) TestWithClientIP_AndGetClientIP() {
+	ctx := WithClientIP(context.Background(), "192.0.2.10")
+
+	if clientIP := GetClientIP(ctx); clientIP != "192.0.2.10" {
+		s.T().Errorf("Expected client IP 192.0.2.10, got %s", clientIP)
+	}
+}
+
+func (s *ContextTestSuite) TestGetClientIP_NotSet() {
+	if clientIP := GetClientIP(context.Background()); clientIP != "" {
+		s.T().Errorf("Expected empty client IP, got %s", clientIP)
+	}
+}
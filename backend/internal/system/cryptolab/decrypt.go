@@ -19,6 +19,7 @@
 package cryptolab
 
 import (
+	"crypto"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/ecdh"
@@ -34,7 +35,8 @@ import (
 // The key type must match the algorithm:
 //
 //   - AlgorithmAESGCM: key must be []byte (AES key). ciphertext is nonce+ciphertext. Returns plaintext.
-//   - AlgorithmRSAOAEP256: key must be *rsa.PrivateKey. ciphertext is the wrapped CEK. Returns unwrapped CEK.
+//   - AlgorithmRSAOAEP256: key must be *rsa.PrivateKey, or a crypto.Decrypter with an RSA public key for
+//     keys held outside the process. ciphertext is the wrapped CEK. Returns unwrapped CEK.
 //   - AlgorithmECDHES: key must be *ecdsa.PrivateKey. ciphertext is ignored.
 //     params.ECDHES.EPK and params.ECDHES.ContentEncryptionAlgorithm must be set. Returns derived CEK.
 //   - AlgorithmECDHESA128KW / AlgorithmECDHESA256KW: key must be *ecdsa.PrivateKey. ciphertext is wrapped CEK.
@@ -50,7 +52,7 @@ func Decrypt(key any, params AlgorithmParams, ciphertext []byte) ([]byte, error)
 	case AlgorithmRSAOAEP256:
 		rsaPriv, ok := key.(*rsa.PrivateKey)
 		if !ok {
-			return nil, errors.New("RSA-OAEP-256 requires a *rsa.PrivateKey")
+			return decryptRSAOAEP256WithDecrypter(key, ciphertext)
 		}
 		return decryptRSAOAEP256(rsaPriv, ciphertext)
 	case AlgorithmECDHES:
@@ -95,6 +97,19 @@ func decryptRSAOAEP256(rsaPriv *rsa.PrivateKey, content []byte) ([]byte, error)
 	return rsa.DecryptOAEP(sha256.New(), rand.Reader, rsaPriv, content, nil)
 }
 
+// decryptRSAOAEP256WithDecrypter unwraps the CEK through the crypto.Decrypter implementation of an RSA
+// private key whose material is not accessible.
+func decryptRSAOAEP256WithDecrypter(key any, content []byte) ([]byte, error) {
+	decrypter, ok := key.(crypto.Decrypter)
+	if !ok {
+		return nil, errors.New("RSA-OAEP-256 requires a *rsa.PrivateKey")
+	}
+	if _, ok := decrypter.Public().(*rsa.PublicKey); !ok {
+		return nil, errors.New("RSA-OAEP-256 requires a *rsa.PrivateKey")
+	}
+	return decrypter.Decrypt(rand.Reader, content, &rsa.OAEPOptions{Hash: crypto.SHA256})
+}
+
 func decryptECDHES(ecdsaPriv *ecdsa.PrivateKey, params AlgorithmParams) ([]byte, error) {
 	epk, err := requireECDHEPK(params, "ECDH-ES")
 	if err != nil {
@@ -19,6 +19,7 @@
 package cryptolab
 
 import (
+	"crypto"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
@@ -175,6 +176,29 @@ func TestRSAOAEP256EncryptDecryptRoundTrip(t *testing.T) {
 	assert.Equal(t, details.CEK, unwrappedCEK, "Unwrapped CEK should match the original CEK")
 }
 
+// opaqueDecrypter hides the private key material behind crypto.Decrypter, as keys held in a hardware
+// security module do.
+type opaqueDecrypter struct {
+	crypto.Decrypter
+}
+
+func TestRSAOAEP256DecryptWithOpaqueKey(t *testing.T) {
+	privKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	params := AlgorithmParams{
+		Algorithm:  AlgorithmRSAOAEP256,
+		RSAOAEP256: RSAOAEP256Params{ContentEncryptionAlgorithm: "A128GCM"},
+	}
+	wrappedCEK, details, err := Encrypt(&privKey.PublicKey, params, nil)
+	require.NoError(t, err)
+
+	unwrappedCEK, err := Decrypt(opaqueDecrypter{privKey}, AlgorithmParams{Algorithm: AlgorithmRSAOAEP256},
+		wrappedCEK)
+	require.NoError(t, err)
+	assert.Equal(t, details.CEK, unwrappedCEK)
+}
+
 func TestRSAOAEP256MissingContentEncryptionAlgorithmFails(t *testing.T) {
 	privKey, err := rsa.GenerateKey(rand.Reader, 2048)
 	require.NoError(t, err)
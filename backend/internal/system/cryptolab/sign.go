@@ -39,6 +39,8 @@ var (
 )
 
 // Generate hashes data according to alg and returns the digital signature using privateKey.
+// Keys whose private material is not accessible, such as keys held in a hardware security module,
+// are used through their crypto.Signer implementation.
 func Generate(data []byte, alg SignAlgorithm, privateKey crypto.PrivateKey) ([]byte, error) {
 	hashed, hashFunc := hashData(data, alg)
 
@@ -48,7 +50,7 @@ func Generate(data []byte, alg SignAlgorithm, privateKey crypto.PrivateKey) ([]b
 	case RSAPSSSHA256:
 		return newRSAPSSSign(hashed, hashFunc, privateKey)
 	case ECDSASHA256, ECDSASHA384, ECDSASHA512:
-		return newECDSASign(hashed, privateKey)
+		return newECDSASign(hashed, hashFunc, privateKey)
 	case ED25519:
 		return newED25519Sign(data, privateKey)
 	default:
@@ -103,7 +105,7 @@ func hashData(data []byte, alg SignAlgorithm) ([]byte, crypto.Hash) {
 func newRSASign(hashed []byte, hashFunc crypto.Hash, privateKey crypto.PrivateKey) ([]byte, error) {
 	rsaKey, ok := privateKey.(*rsa.PrivateKey)
 	if !ok {
-		return nil, ErrInvalidPrivateKey
+		return signWithOpaqueKey(privateKey, hashed, hashFunc, isRSAPublicKey)
 	}
 	return rsa.SignPKCS1v15(rand.Reader, rsaKey, hashFunc, hashed)
 }
@@ -122,11 +124,11 @@ func verifyRSA(hashed, signature []byte, hashFunc crypto.Hash, publicKey crypto.
 // newRSAPSSSign creates an RSA-PSS signature.
 // Salt length equals the hash output size as required by RFC 7518 Section 3.5.
 func newRSAPSSSign(hashed []byte, hashFunc crypto.Hash, privateKey crypto.PrivateKey) ([]byte, error) {
+	opts := &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: hashFunc}
 	rsaKey, ok := privateKey.(*rsa.PrivateKey)
 	if !ok {
-		return nil, ErrInvalidPrivateKey
+		return signWithOpaqueKey(privateKey, hashed, opts, isRSAPublicKey)
 	}
-	opts := &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: hashFunc}
 	return rsa.SignPSS(rand.Reader, rsaKey, hashFunc, hashed, opts)
 }
 
@@ -144,10 +146,10 @@ func verifyRSAPSS(hashed, signature []byte, hashFunc crypto.Hash, publicKey cryp
 	return nil
 }
 
-func newECDSASign(hashed []byte, privateKey crypto.PrivateKey) ([]byte, error) {
+func newECDSASign(hashed []byte, hashFunc crypto.Hash, privateKey crypto.PrivateKey) ([]byte, error) {
 	ecdsaKey, ok := privateKey.(*ecdsa.PrivateKey)
 	if !ok {
-		return nil, ErrInvalidPrivateKey
+		return signWithOpaqueKey(privateKey, hashed, hashFunc, isECDSAPublicKey)
 	}
 	return ecdsa.SignASN1(rand.Reader, ecdsaKey, hashed)
 }
@@ -166,7 +168,7 @@ func verifyECDSA(hashed, signature []byte, publicKey crypto.PublicKey) error {
 func newED25519Sign(data []byte, privateKey crypto.PrivateKey) ([]byte, error) {
 	ed25519Key, ok := privateKey.(ed25519.PrivateKey)
 	if !ok {
-		return nil, ErrInvalidPrivateKey
+		return signWithOpaqueKey(privateKey, data, crypto.Hash(0), isED25519PublicKey)
 	}
 	return ed25519.Sign(ed25519Key, data), nil
 }
@@ -181,3 +183,29 @@ func verifyED25519(data, signature []byte, publicKey crypto.PublicKey) error {
 	}
 	return nil
 }
+
+// signWithOpaqueKey signs the digest through the crypto.Signer implementation of a private key whose
+// material is not accessible. The public key of the signer must match the key type of the algorithm.
+func signWithOpaqueKey(privateKey crypto.PrivateKey, digest []byte, opts crypto.SignerOpts,
+	matchesAlgorithm func(crypto.PublicKey) bool) ([]byte, error) {
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok || !matchesAlgorithm(signer.Public()) {
+		return nil, ErrInvalidPrivateKey
+	}
+	return signer.Sign(rand.Reader, digest, opts)
+}
+
+func isRSAPublicKey(publicKey crypto.PublicKey) bool {
+	_, ok := publicKey.(*rsa.PublicKey)
+	return ok
+}
+
+func isECDSAPublicKey(publicKey crypto.PublicKey) bool {
+	_, ok := publicKey.(*ecdsa.PublicKey)
+	return ok
+}
+
+func isED25519PublicKey(publicKey crypto.PublicKey) bool {
+	_, ok := publicKey.(ed25519.PublicKey)
+	return ok
+}
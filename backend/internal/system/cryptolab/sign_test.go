@@ -128,6 +128,43 @@ func (suite *SignUtilsTestSuite) TestSignUnsupportedAlgorithm() {
 	assert.Equal(suite.T(), ErrUnsupportedAlgorithm, err)
 }
 
+// opaqueSigner hides the private key material behind crypto.Signer, as keys held in a hardware
+// security module do.
+type opaqueSigner struct {
+	gocrypto.Signer
+}
+
+func (suite *SignUtilsTestSuite) TestSignWithOpaqueKey() {
+	ecdsaP384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name       string
+		algorithm  SignAlgorithm
+		privateKey gocrypto.Signer
+	}{
+		{"RSASHA256", RSASHA256, suite.rsaPrivateKey},
+		{"RSAPSSSHA256", RSAPSSSHA256, suite.rsaPrivateKey},
+		{"ECDSASHA256", ECDSASHA256, suite.ecdsaPrivateKey},
+		{"ECDSASHA384", ECDSASHA384, ecdsaP384Key},
+		{"ED25519", ED25519, suite.ed25519PrivateKey},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			signature, err := Generate(suite.testData, tc.algorithm, opaqueSigner{tc.privateKey})
+			assert.NoError(t, err)
+			assert.NoError(t, Verify(suite.testData, signature, tc.algorithm, tc.privateKey.Public()))
+		})
+	}
+}
+
+func (suite *SignUtilsTestSuite) TestSignWithOpaqueKeyOfWrongType() {
+	signature, err := Generate(suite.testData, RSASHA256, opaqueSigner{suite.ecdsaPrivateKey})
+	assert.Equal(suite.T(), ErrInvalidPrivateKey, err)
+	assert.Nil(suite.T(), signature)
+}
+
 func (suite *SignUtilsTestSuite) TestSignInvalidPrivateKey() {
 	testCases := []struct {
 		name       string
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// dbTimeFormat is the layout of the timestamps returned by the SQLite driver and of time values
+// formatted with their default string representation.
+const dbTimeFormat = "2006-01-02 15:04:05.999999999"
+
+// ParseTimeField parses a timestamp column from a database result row. Timestamps are returned as
+// time.Time by the PostgreSQL driver and as strings by the SQLite driver.
+func ParseTimeField(field interface{}, fieldName string) (time.Time, error) {
+	switch v := field.(type) {
+	case string:
+		parsedTime, err := time.Parse(dbTimeFormat, TrimTimeString(v))
+		if err != nil {
+			// Try alternative ISO 8601 format as fallback
+			parsedTime, err = time.Parse(time.RFC3339, v)
+			if err != nil {
+				return time.Time{}, fmt.Errorf("error parsing %s: %w", fieldName, err)
+			}
+		}
+		return parsedTime, nil
+	case time.Time:
+		return v, nil
+	default:
+		return time.Time{}, fmt.Errorf("unexpected type for %s", fieldName)
+	}
+}
+
+// ParseNullableTimeField parses a nullable timestamp column from a database result row. A null value
+// is returned as the zero time.
+func ParseNullableTimeField(field interface{}, fieldName string) (time.Time, error) {
+	if field == nil {
+		return time.Time{}, nil
+	}
+	return ParseTimeField(field, fieldName)
+}
+
+// TrimTimeString trims the time zone information that follows the date and time of a time string, so
+// that it matches the layout expected by ParseTimeField.
+func TrimTimeString(timeStr string) string {
+	parts := strings.SplitN(timeStr, " ", 3)
+	if len(parts) >= 2 {
+		return parts[0] + " " + parts[1]
+	}
+	return timeStr
+}
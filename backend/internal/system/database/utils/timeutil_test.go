@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const testTimeString = "2023-12-01 10:30:45.123456789"
+
+type TimeUtilTestSuite struct {
+	suite.Suite
+}
+
+func TestTimeUtilSuite(t *testing.T) {
+	suite.Run(t, new(TimeUtilTestSuite))
+}
+
+func (suite *TimeUtilTestSuite) TestParseTimeField_StringInput() {
+	expectedTime, _ := time.Parse("2006-01-02 15:04:05.999999999", testTimeString)
+
+	result, err := ParseTimeField(testTimeString, "test_field")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedTime, result)
+}
+
+func (suite *TimeUtilTestSuite) TestParseTimeField_StringWithExtraContent() {
+	expectedTime, _ := time.Parse("2006-01-02 15:04:05.999999999", testTimeString)
+
+	result, err := ParseTimeField(testTimeString+" +0000 UTC", "test_field")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedTime, result)
+}
+
+func (suite *TimeUtilTestSuite) TestParseTimeField_StringWithMicroseconds() {
+	result, err := ParseTimeField("2026-01-02 03:04:05.123456 +0000 UTC", "expiry_time")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2026, result.Year())
+	assert.Equal(suite.T(), 123456000, result.Nanosecond())
+}
+
+func (suite *TimeUtilTestSuite) TestParseTimeField_AlternativeFormat() {
+	// Test ISO 8601 format when custom format fails
+	testTime := "2023-12-01T10:30:45Z"
+	expectedTime, _ := time.Parse(time.RFC3339, testTime)
+
+	result, err := ParseTimeField(testTime, "test_field")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedTime, result)
+}
+
+func (suite *TimeUtilTestSuite) TestParseTimeField_AlternativeFormatWithTimezone() {
+	testTime := "2023-12-01T10:30:45+05:30"
+	expectedTime, _ := time.Parse(time.RFC3339, testTime)
+
+	result, err := ParseTimeField(testTime, "test_field")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedTime, result)
+}
+
+func (suite *TimeUtilTestSuite) TestParseTimeField_TimeInput() {
+	testTime := time.Now()
+
+	result, err := ParseTimeField(testTime, "test_field")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), testTime, result)
+}
+
+func (suite *TimeUtilTestSuite) TestParseTimeField_InvalidStringFormat() {
+	result, err := ParseTimeField("invalid-time-format", "test_field")
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "error parsing test_field")
+	assert.True(suite.T(), result.IsZero())
+}
+
+func (suite *TimeUtilTestSuite) TestParseTimeField_InvalidType() {
+	for _, field := range []interface{}{12345, nil} {
+		result, err := ParseTimeField(field, "test_field")
+		assert.Error(suite.T(), err)
+		assert.Contains(suite.T(), err.Error(), "unexpected type for test_field")
+		assert.True(suite.T(), result.IsZero())
+	}
+}
+
+func (suite *TimeUtilTestSuite) TestParseNullableTimeField() {
+	result, err := ParseNullableTimeField(nil, "test_field")
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.IsZero())
+
+	testTime := time.Now()
+	result, err = ParseNullableTimeField(testTime, "test_field")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), testTime, result)
+
+	_, err = ParseNullableTimeField(12345, "test_field")
+	assert.Error(suite.T(), err)
+}
+
+func (suite *TimeUtilTestSuite) TestTrimTimeString() {
+	input := testTimeString + " extra content here"
+
+	result := TrimTimeString(input)
+	assert.Equal(suite.T(), testTimeString, result)
+}
+
+func (suite *TimeUtilTestSuite) TestTrimTimeString_ShortInput() {
+	input := "2023-12-01"
+
+	result := TrimTimeString(input)
+	assert.Equal(suite.T(), input, result)
+}
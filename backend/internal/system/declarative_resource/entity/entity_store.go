@@ -44,6 +44,8 @@ const (
 	KeyTypeResource           KeyType = "resource"
 	KeyTypeAction             KeyType = "action"
 	KeyTypeRole               KeyType = "role"
+	KeyTypeAuthzPolicy        KeyType = "authz-policy"
+	KeyTypeAuthzNamespace     KeyType = "authz-namespace"
 	KeyTypeUser               KeyType = "user"
 	KeyTypeTemplate           KeyType = "template"
 	KeyTypeEntity             KeyType = "entity"
@@ -61,7 +63,7 @@ func (kt KeyType) IsValid() bool {
 	case KeyTypeApplication, KeyTypeNotification, KeyTypeIDP, KeyTypeNotificationSender,
 		KeyTypeEntityType, KeyTypeOU, KeyTypeFlow, KeyTypeTranslation, KeyTypeTheme, KeyTypeLayout,
 		KeyTypeResourceServer, KeyTypeResource, KeyTypeAction, KeyTypeRole, KeyTypeUser, KeyTypeTemplate,
-		KeyTypeInboundAuth,
+		KeyTypeInboundAuth, KeyTypeAuthzPolicy, KeyTypeAuthzNamespace,
 		KeyTypeEntity:
 		return true
 	default:
@@ -144,6 +144,7 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.invalid_application_url_description": "The provided application URL is not a valid URI",
 	"error.applicationservice.invalid_auth_flow_id": "Invalid auth flow ID",
 	"error.applicationservice.invalid_auth_flow_id_description": "The provided authentication flow ID is invalid",
+	"error.applicationservice.invalid_backchannel_config_description": "Backchannel delivery mode must be 'poll' or 'ping'; 'ping' requires a notification URI",
 	"error.applicationservice.invalid_certificate_type": "Invalid certificate type",
 	"error.applicationservice.invalid_certificate_type_description": "The provided certificate type is not supported",
 	"error.applicationservice.invalid_certificate_value": "Invalid certificate value",
@@ -158,20 +159,26 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.invalid_jwks_uri_description": "The provided JWKS URI is not a valid URI",
 	"error.applicationservice.invalid_jwks_uri_scheme": "Invalid JWKS URI scheme",
 	"error.applicationservice.invalid_jwks_uri_scheme_description": "'jwks_uri' must use HTTPS scheme",
+	"error.applicationservice.invalid_jwt_bearer_issuer_description": "JWT bearer allowed issuers must be configured trusted issuers",
 	"error.applicationservice.invalid_logo_url": "Invalid logo URL",
 	"error.applicationservice.invalid_logo_url_description": "The provided logo URL is not a valid URI",
+	"error.applicationservice.invalid_logout_uri_description": "Logout URIs must be absolute URIs without wildcards or fragments",
 	"error.applicationservice.invalid_oauth_configuration": "Invalid OAuth configuration",
 	"error.applicationservice.invalid_oauth_configuration_description": "The OAuth configuration is invalid",
+	"error.applicationservice.invalid_post_logout_redirect_uri_description": "Post logout redirect URIs must be absolute URIs without wildcards or fragments",
 	"error.applicationservice.invalid_public_client_configuration": "Invalid public client configuration",
 	"error.applicationservice.invalid_public_client_configuration_description": "The public client configuration is invalid",
 	"error.applicationservice.invalid_redirect_uri": "Invalid redirect URI",
 	"error.applicationservice.invalid_redirect_uri_description": "One or more provided redirect URIs are not valid URIs",
+	"error.applicationservice.invalid_refresh_token_config_description": "Refresh token validity periods must be non-negative, with the idle period within the absolute one",
 	"error.applicationservice.invalid_registration_flow_id": "Invalid registration flow ID",
 	"error.applicationservice.invalid_registration_flow_id_description": "The provided registration flow ID is invalid",
 	"error.applicationservice.invalid_request_format": "Invalid request format",
 	"error.applicationservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
 	"error.applicationservice.invalid_response_type": "Invalid response type",
 	"error.applicationservice.invalid_response_type_description": "One or more provided response types are invalid",
+	"error.applicationservice.invalid_saml_configuration": "Invalid SAML configuration",
+	"error.applicationservice.invalid_saml_configuration_description": "The provided SAML inbound authentication configuration is invalid",
 	"error.applicationservice.invalid_token_endpoint_auth_method": "Invalid token endpoint authentication method",
 	"error.applicationservice.invalid_token_endpoint_auth_method_description": "The provided token endpoint authentication method is invalid",
 	"error.applicationservice.invalid_user_type": "Invalid user type",
@@ -189,10 +196,21 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.public_client_must_use_none_auth_description": "Public clients must use 'none' as token endpoint authentication method",
 	"error.applicationservice.redirect_uri_fragment_not_allowed_description": "Redirect URIs must not contain a fragment component",
 	"error.applicationservice.refresh_token_cannot_be_sole_grant_description": "refresh_token grant type cannot be used without another grant type",
-	"error.applicationservice.response_types_require_authorization_code_description": "Response types can only be configured with the authorization_code grant type",
+	"error.applicationservice.response_types_require_authorization_code_description": "Response types issuing an authorization code can only be configured with the authorization_code grant type",
 	"error.applicationservice.result_limit_exceeded": "Result limit exceeded",
+	"error.applicationservice.saml_entity_id_already_exists": "SAML entity ID already exists",
+	"error.applicationservice.saml_entity_id_already_exists_description": "Another application is already registered with the provided SAML entity ID",
+	"error.applicationservice.saml_invalid_acs_url_description": "At least one assertion consumer service URL is required and each must be an absolute URI",
+	"error.applicationservice.saml_invalid_certificate_description": "The service provider certificate must be a PEM encoded X.509 certificate",
+	"error.applicationservice.saml_invalid_single_logout_url_description": "Single logout URL must be an absolute URI without wildcards",
+	"error.applicationservice.saml_missing_entity_id_description": "SAML service provider entity ID is required",
+	"error.applicationservice.saml_signed_requests_require_certificate_description": "A service provider certificate is required when signed requests are enforced",
+	"error.applicationservice.saml_unsupported_nameid_format_description": "The provided NameID format is not supported",
+	"error.applicationservice.self_signed_tls_client_auth_requires_certificate_description": "self_signed_tls_client_auth authentication method requires a certificate",
 	"error.applicationservice.theme_not_found": "Theme not found",
 	"error.applicationservice.theme_not_found_description": "The specified theme configuration does not exist",
+	"error.applicationservice.tls_client_auth_cannot_have_client_secret_description": "Mutual-TLS authentication methods cannot have a client secret",
+	"error.applicationservice.tls_client_auth_requires_subject_dn_description": "tls_client_auth authentication method requires a certificate subject DN",
 	"error.applicationservice.userinfo_encryption_alg_requires_enc_description": "encryptionEnc is required when encryptionAlg is set",
 	"error.applicationservice.userinfo_encryption_enc_requires_alg_description": "encryptionAlg is required when encryptionEnc is set",
 	"error.applicationservice.userinfo_encryption_requires_certificate_description": "a certificate (JWKS or JWKS_URI) is required when userinfo encryption is configured",
@@ -224,6 +242,12 @@ var defaultMessages = map[string]string{
 	"error.attributecache.missing_cache_id_description": "Cache ID is required",
 	"error.authncredservice.invalid_request_format": "Invalid request format",
 	"error.authncredservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.authnlockoutservice.account_locked": "Account locked",
+	"error.authnlockoutservice.account_locked_description": "The account is temporarily locked due to too many failed login attempts",
+	"error.authnlockoutservice.too_many_attempts": "Too many attempts",
+	"error.authnlockoutservice.too_many_attempts_description": "Too many failed login attempts. Try again later",
+	"error.authnmgrservice.account_locked": "Account locked",
+	"error.authnmgrservice.account_locked_description": "The account is temporarily locked due to too many failed login attempts",
 	"error.authnmgrservice.authentication_failed": "Authentication failed",
 	"error.authnmgrservice.authentication_failed_description": "The authentication attempt failed",
 	"error.authnmgrservice.failed_to_get_attributes": "Failed to get attributes",
@@ -246,6 +270,10 @@ var defaultMessages = map[string]string{
 	"error.authnotpservice.invalid_sender_id_description": "The provided sender ID is invalid or empty",
 	"error.authnotpservice.invalid_session_token": "Invalid session token",
 	"error.authnotpservice.invalid_session_token_description": "The provided session token is invalid or empty",
+	"error.authnotpservice.otp_attempts_exceeded": "OTP attempts exceeded",
+	"error.authnotpservice.otp_attempts_exceeded_description": "The maximum number of verification attempts for the OTP has been reached",
+	"error.authnotpservice.otp_send_throttled": "OTP send throttled",
+	"error.authnotpservice.otp_send_throttled_description": "Too many OTPs have been requested for the recipient. Try again later",
 	"error.authnotpservice.unsupported_channel": "Unsupported channel",
 	"error.authnotpservice.unsupported_channel_description": "The provided channel is not supported for OTP authentication",
 	"error.authnservice.ambiguous_user": "Ambiguous user",
@@ -310,6 +338,61 @@ var defaultMessages = map[string]string{
 	"error.authoidcservice.invalid_id_token_description": "The ID token is invalid or malformed",
 	"error.authoidcservice.invalid_id_token_signature": "Invalid ID token signature",
 	"error.authoidcservice.invalid_id_token_signature_description": "The ID token signature verification failed",
+	"error.authsamlservice.authentication_failed": "SAML authentication failed",
+	"error.authsamlservice.authentication_failed_description": "The identity provider did not authenticate the user",
+	"error.authsamlservice.empty_idp_id": "IDP id is empty",
+	"error.authsamlservice.empty_idp_id_description": "The identity provider id cannot be empty",
+	"error.authsamlservice.empty_saml_response": "Empty SAML response",
+	"error.authsamlservice.empty_saml_response_description": "The SAML response cannot be empty",
+	"error.authsamlservice.error_retrieving_idp_description": "Error while retrieving identity provider: ",
+	"error.authsamlservice.failed_to_retrieve_idp": "Failed to retrieve identity provider",
+	"error.authsamlservice.failed_to_retrieve_idp_description": "A client error occurred while retrieving the identity provider configuration",
+	"error.authsamlservice.invalid_idp": "Invalid identity provider",
+	"error.authsamlservice.invalid_idp_description": "The retrieved identity provider is empty or not a SAML identity provider",
+	"error.authsamlservice.invalid_saml_response": "Invalid SAML response",
+	"error.authsamlservice.invalid_saml_response_description": "The SAML response is malformed or not valid for this service provider",
+	"error.authsamlservice.invalid_saml_signature": "Invalid SAML signature",
+	"error.authsamlservice.invalid_saml_signature_description": "Neither the SAML response nor its assertion carries a valid signature",
+	"error.authzpolicyservice.cannot_modify_declarative": "Cannot modify declarative resource",
+	"error.authzpolicyservice.cannot_modify_declarative_description": "The policy is declarative and cannot be modified or deleted",
+	"error.authzpolicyservice.invalid_condition": "Invalid policy condition",
+	"error.authzpolicyservice.invalid_condition_attribute_description": "The condition attribute must start with subject., resource. or context.",
+	"error.authzpolicyservice.invalid_condition_description": "The policy condition is invalid",
+	"error.authzpolicyservice.invalid_condition_operator_description": "The condition operator is not supported",
+	"error.authzpolicyservice.invalid_condition_value_description": "The condition value is not valid for the operator",
+	"error.authzpolicyservice.invalid_effect": "Invalid policy effect",
+	"error.authzpolicyservice.invalid_effect_description": "Policy effect must be either permit or deny",
+	"error.authzpolicyservice.invalid_limit": "Invalid limit",
+	"error.authzpolicyservice.invalid_limit_description": "Limit must be a valid positive integer",
+	"error.authzpolicyservice.invalid_limit_value_description": "Limit must be between 1 and the maximum page size",
+	"error.authzpolicyservice.invalid_offset": "Invalid offset",
+	"error.authzpolicyservice.invalid_offset_description": "Offset must be a valid non-negative integer",
+	"error.authzpolicyservice.invalid_policy_id": "Invalid policy ID",
+	"error.authzpolicyservice.invalid_policy_id_description": "The provided policy ID is invalid",
+	"error.authzpolicyservice.invalid_request_format": "Invalid request format",
+	"error.authzpolicyservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.authzpolicyservice.missing_name": "Missing policy name",
+	"error.authzpolicyservice.missing_name_description": "Policy name is required",
+	"error.authzpolicyservice.missing_permissions": "Missing permissions",
+	"error.authzpolicyservice.missing_permissions_description": "At least one non-empty permission is required",
+	"error.authzpolicyservice.name_conflict": "Policy name conflict",
+	"error.authzpolicyservice.name_conflict_description": "A policy with the same name already exists",
+	"error.authzpolicyservice.policy_not_found": "Policy not found",
+	"error.authzpolicyservice.policy_not_found_description": "The requested authorization policy was not found",
+	"error.authzpolicyservice.result_limit_exceeded": "Result limit exceeded",
+	"error.authzpolicyservice.result_limit_exceeded_description": "Total count of policies exceeds maximum allowed limit in composite mode",
+	"error.authzservice.batch_size_exceeded": "Batch size exceeded",
+	"error.authzservice.batch_size_exceeded_description": "The number of evaluations exceeds the maximum batch size",
+	"error.authzservice.invalid_action": "Invalid action",
+	"error.authzservice.invalid_action_description": "The action name is required",
+	"error.authzservice.invalid_evaluations_semantic": "Invalid evaluations semantic",
+	"error.authzservice.invalid_evaluations_semantic_description": "The evaluations semantic must be one of execute_all, deny_on_first_deny, or permit_on_first_permit",
+	"error.authzservice.invalid_request_format": "Invalid request format",
+	"error.authzservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.authzservice.invalid_resource": "Invalid resource",
+	"error.authzservice.invalid_resource_description": "The resource type and id are required",
+	"error.authzservice.invalid_subject": "Invalid subject",
+	"error.authzservice.invalid_subject_description": "The subject type and id are required",
 	"error.certservice.certificate_already_exists": "Certificate already exists",
 	"error.certservice.certificate_already_exists_description": "A certificate with the same reference type and ID already exists",
 	"error.certservice.certificate_not_found": "Certificate not found",
@@ -609,6 +692,8 @@ var defaultMessages = map[string]string{
 	"error.jweservice.unsupported_algorithm_description": "The specified JWE algorithm is not supported",
 	"error.jweservice.unsupported_encryption_algorithm": "Unsupported encryption algorithm",
 	"error.jweservice.unsupported_encryption_algorithm_description": "The specified encryption algorithm is not supported",
+	"error.jwksresolver.verification_key_not_found": "Verification key not found",
+	"error.jwksresolver.verification_key_not_found_description": "No key in the JWKS matches the key ID and algorithm of the token",
 	"error.jwsservice.decoding_header_error": "JWS decode error",
 	"error.jwsservice.decoding_header_error_description": "Error occurred while decoding JWS header",
 	"error.jwsservice.invalid_format": "Invalid JWS format",
@@ -635,6 +720,12 @@ var defaultMessages = map[string]string{
 	"error.jwtservice.token_expired_description": "The JWT token has expired",
 	"error.jwtservice.unsupported_jws_algorithm": "Unsupported JWS algorithm",
 	"error.jwtservice.unsupported_jws_algorithm_description": "The specified JWS algorithm is not supported",
+	"error.keyrotationservice.cannot_revoke_active_key": "Cannot revoke active key",
+	"error.keyrotationservice.cannot_revoke_active_key_description": "The active signing key must be rotated before it can be revoked",
+	"error.keyrotationservice.key_rotation_disabled": "Key rotation disabled",
+	"error.keyrotationservice.key_rotation_disabled_description": "Signing key rotation is not enabled in the server configuration",
+	"error.keyrotationservice.signing_key_not_found": "Signing key not found",
+	"error.keyrotationservice.signing_key_not_found_description": "The requested signing key was not found",
 	"error.magiclinkservice.expired_token": "Expired token",
 	"error.magiclinkservice.expired_token_description": "The magic link token has expired",
 	"error.magiclinkservice.invalid_token": "Invalid token",
@@ -643,10 +734,14 @@ var defaultMessages = map[string]string{
 	"error.magiclinkservice.malformed_token_claims_description": "The magic link token contains invalid or missing claims",
 	"error.magiclinkservice.resolving_user": "Error resolving user",
 	"error.magiclinkservice.resolving_user_description": "An error occurred while resolving the user for the recipient",
+	"error.magiclinkservice.token_already_used": "Token already used",
+	"error.magiclinkservice.token_already_used_description": "The magic link has already been used",
 	"error.magiclinkservice.token_generation_failed": "Token generation failed",
 	"error.magiclinkservice.token_generation_failed_description": "Failed to generate magic link token",
 	"error.notificationservice.duplicate_sender_name": "Duplicate sender name",
 	"error.notificationservice.duplicate_sender_name_description": "A sender with the same name already exists",
+	"error.notificationservice.email_channel_not_configured": "Email channel not configured",
+	"error.notificationservice.email_channel_not_configured_description": "The email channel cannot be used as an email client is not configured",
 	"error.notificationservice.error_while_retrieving_message_client": "Error while retrieving message client",
 	"error.notificationservice.error_while_retrieving_message_client_description": "An error occurred while retrieving the message client",
 	"error.notificationservice.invalid_channel": "Invalid channel",
@@ -667,6 +762,10 @@ var defaultMessages = map[string]string{
 	"error.notificationservice.invalid_sender_type_description": "The provided sender type is invalid or unsupported",
 	"error.notificationservice.invalid_session_token": "Invalid session token",
 	"error.notificationservice.invalid_session_token_description": "The provided session token is invalid, malformed, or expired",
+	"error.notificationservice.otp_attempts_exceeded": "OTP attempts exceeded",
+	"error.notificationservice.otp_attempts_exceeded_description": "The maximum number of verification attempts for the OTP has been reached",
+	"error.notificationservice.otp_send_throttled": "OTP send throttled",
+	"error.notificationservice.otp_send_throttled_description": "Too many OTPs have been requested for the recipient. Try again later",
 	"error.notificationservice.sender_not_found": "Sender not found",
 	"error.notificationservice.sender_not_found_description": "The requested notification sender could not be found",
 	"error.notificationservice.sender_type_mismatch": "Sender type mismatch",
@@ -730,8 +829,49 @@ var defaultMessages = map[string]string{
 	"error.passkeyservice.session_expired_description": "The session has expired. Please start a new session",
 	"error.passkeyservice.user_not_found": "User not found",
 	"error.passkeyservice.user_not_found_description": "The specified user was not found",
+	"error.passwordpolicyservice.missing_digit": "Missing digit",
+	"error.passwordpolicyservice.missing_digit_description": "The password must contain at least one digit",
+	"error.passwordpolicyservice.missing_lowercase": "Missing lowercase letter",
+	"error.passwordpolicyservice.missing_lowercase_description": "The password must contain at least one lowercase letter",
+	"error.passwordpolicyservice.missing_special": "Missing special character",
+	"error.passwordpolicyservice.missing_special_description": "The password must contain at least one special character",
+	"error.passwordpolicyservice.missing_uppercase": "Missing uppercase letter",
+	"error.passwordpolicyservice.missing_uppercase_description": "The password must contain at least one uppercase letter",
+	"error.passwordpolicyservice.password_breached": "Password breached",
+	"error.passwordpolicyservice.password_breached_description": "The password has appeared in a data breach and cannot be used",
+	"error.passwordpolicyservice.password_expired": "Password expired",
+	"error.passwordpolicyservice.password_expired_description": "The password has expired and must be changed",
+	"error.passwordpolicyservice.password_reused": "Password reused",
+	"error.passwordpolicyservice.password_reused_description": "The password matches a recently used password",
+	"error.passwordpolicyservice.password_too_long": "Password too long",
+	"error.passwordpolicyservice.password_too_long_description": "The password is longer than the maximum length allowed by the password policy",
+	"error.passwordpolicyservice.password_too_short": "Password too short",
+	"error.passwordpolicyservice.password_too_short_description": "The password is shorter than the minimum length required by the password policy",
+	"error.rebacservice.built_in_namespace": "Built-in namespace",
+	"error.rebacservice.built_in_namespace_description": "Relationships of the built-in group and ou namespaces cannot be written",
+	"error.rebacservice.empty_write_request": "Empty write request",
+	"error.rebacservice.empty_write_request_description": "At least one relationship tuple to write or delete is required",
+	"error.rebacservice.invalid_limit": "Invalid limit",
+	"error.rebacservice.invalid_limit_description": "Limit must be a valid positive integer",
+	"error.rebacservice.invalid_limit_value_description": "Limit must be between 1 and the maximum page size",
+	"error.rebacservice.invalid_object": "Invalid object",
+	"error.rebacservice.invalid_object_description": "The object type and id are required",
+	"error.rebacservice.invalid_offset": "Invalid offset",
+	"error.rebacservice.invalid_offset_description": "Offset must be a valid non-negative integer",
+	"error.rebacservice.invalid_request_format": "Invalid request format",
+	"error.rebacservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.rebacservice.invalid_subject": "Invalid subject",
+	"error.rebacservice.invalid_subject_description": "The subject type and id are required",
+	"error.rebacservice.unknown_namespace": "Unknown namespace",
+	"error.rebacservice.unknown_namespace_description": "The namespace is not defined in the authorization schema",
+	"error.rebacservice.unknown_relation": "Unknown relation",
+	"error.rebacservice.unknown_relation_description": "The relation is not defined on the namespace",
 	"error.resourceservice.action_not_found": "Action not found",
 	"error.resourceservice.action_not_found_description": "The action with the specified id does not exist",
+	"error.resourceservice.authorization_detail_type_conflict": "Authorization details type conflict",
+	"error.resourceservice.authorization_detail_type_conflict_description": "An authorization details type with the same name is defined by another resource server",
+	"error.resourceservice.authorization_detail_type_not_found": "Authorization details type not found",
+	"error.resourceservice.authorization_detail_type_not_found_description": "No resource server defines the authorization details type",
 	"error.resourceservice.cannot_delete": "Cannot delete",
 	"error.resourceservice.cannot_delete_description": "Cannot delete resource server/resource that has dependencies",
 	"error.resourceservice.cannot_modify_declarative_action": "Cannot modify declarative action",
@@ -750,6 +890,10 @@ var defaultMessages = map[string]string{
 	"error.resourceservice.handle_conflict_description": "The same handle already exists within the specified resource",
 	"error.resourceservice.identifier_conflict": "Identifier conflict",
 	"error.resourceservice.identifier_conflict_description": "A resource server with the same identifier already exists",
+	"error.resourceservice.invalid_authorization_detail": "Invalid authorization details",
+	"error.resourceservice.invalid_authorization_detail_description": "The authorization details object does not satisfy the schema of its type",
+	"error.resourceservice.invalid_authorization_detail_type": "Invalid authorization details type",
+	"error.resourceservice.invalid_authorization_detail_type_description": "Authorization details types must have a unique type name and a valid JSON schema",
 	"error.resourceservice.invalid_delimiter": "Invalid delimiter",
 	"error.resourceservice.invalid_delimiter_description": "Delimiter must be a single valid character (a-z A-Z 0-9 . _ : - /)",
 	"error.resourceservice.invalid_handle": "Invalid handle",
@@ -814,8 +958,42 @@ var defaultMessages = map[string]string{
 	"error.roleservice.role_name_conflict_description": "A role with the same name exists under the same organization unit",
 	"error.roleservice.role_not_found": "Role not found",
 	"error.roleservice.role_not_found_description": "The role with the specified id does not exist",
+	"error.session.missing_client_id": "Missing client ID",
+	"error.session.missing_client_id_description": "Client ID is required",
+	"error.session.missing_session_id": "Missing session ID",
+	"error.session.missing_session_id_description": "Session ID is required",
+	"error.session.missing_user_id": "Missing user ID",
+	"error.session.missing_user_id_description": "User ID is required",
+	"error.session.session_not_found": "Session not found",
+	"error.session.session_not_found_description": "The session with the specified ID does not exist or has expired",
 	"error.templateservice.template_not_found": "Template not found",
 	"error.templateservice.template_not_found_description": "The requested template does not exist for the given scenario",
+	"error.tokengrant.grant_not_found": "Grant not found",
+	"error.tokengrant.grant_not_found_description": "The grant with the specified ID does not exist or has expired",
+	"error.tokengrant.missing_grant_id": "Missing grant ID",
+	"error.tokengrant.missing_grant_id_description": "Grant ID is required",
+	"error.tokengrant.missing_user_id": "Missing user ID",
+	"error.tokengrant.missing_user_id_description": "User ID is required",
+	"error.tokengrant.refresh_token_reused": "Refresh token reused",
+	"error.tokengrant.refresh_token_reused_description": "The refresh token has already been used and the grant has been revoked",
+	"error.totpservice.empty_code": "Empty code",
+	"error.totpservice.empty_code_description": "The TOTP code is required",
+	"error.totpservice.empty_entity_id": "Empty entity ID",
+	"error.totpservice.empty_entity_id_description": "The entity ID is required",
+	"error.totpservice.enrollment_not_started": "Enrollment not started",
+	"error.totpservice.enrollment_not_started_description": "No pending authenticator app enrollment was found for the user",
+	"error.totpservice.entity_not_found": "Entity not found",
+	"error.totpservice.entity_not_found_description": "The specified entity does not exist",
+	"error.totpservice.invalid_code": "Invalid code",
+	"error.totpservice.invalid_code_description": "The provided code is incorrect, expired or has already been used",
+	"error.totpservice.invalid_request_format": "Invalid request format",
+	"error.totpservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.totpservice.totp_already_enrolled": "TOTP already enrolled",
+	"error.totpservice.totp_already_enrolled_description": "An authenticator app is already enrolled for the user",
+	"error.totpservice.totp_not_enrolled": "TOTP not enrolled",
+	"error.totpservice.totp_not_enrolled_description": "No authenticator app is enrolled for the user",
+	"error.totpservice.unauthenticated": "Unauthenticated",
+	"error.totpservice.unauthenticated_description": "The request is not authenticated",
 	"error.unauthorized": "Unauthorized",
 	"error.unauthorized_description": "The caller is not authorized to perform this operation",
 	"error.userinfoservice.client_credentials_not_supported": "Invalid access token",
@@ -826,6 +1004,10 @@ var defaultMessages = map[string]string{
 	"error.userinfoservice.invalid_access_token_description": "The access token is invalid, expired, or malformed",
 	"error.userinfoservice.missing_sub_claim": "Invalid access token",
 	"error.userinfoservice.missing_sub_claim_description": "The access token is missing or has an invalid 'sub' claim",
+	"error.userinfoservice.proof_key_mismatch": "Invalid access token",
+	"error.userinfoservice.proof_key_mismatch_description": "The access token is DPoP-bound and must be presented with a proof from the bound key",
+	"error.userservice.account_lockout_disabled": "Account lockout disabled",
+	"error.userservice.account_lockout_disabled_description": "Account lockout is not enabled on the server",
 	"error.userservice.ambiguous_user": "Ambiguous user",
 	"error.userservice.ambiguous_user_description": "Multiple users match the provided filters",
 	"error.userservice.attribute_conflict": "Attribute conflict",
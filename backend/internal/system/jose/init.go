@@ -23,12 +23,15 @@ package jose
 import (
 	"github.com/asgardeo/thunder/internal/system/jose/jwe"
 	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/internal/system/kmprovider"
 	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/pkiservice"
 )
 
-// Initialize initializes the JOSE services (JWT and JWE).
-func Initialize(pkiService pkiservice.PKIServiceInterface) (jwt.JWTServiceInterface, jwe.JWEServiceInterface, error) {
-	jwtService, err := jwt.Initialize(pkiService)
+// Initialize initializes the JOSE services (JWT and JWE) with the key material of the configured key manager.
+func Initialize(
+	pkiService pkiservice.PKIServiceInterface, cryptoProvider kmprovider.RuntimeCryptoProvider,
+) (jwt.JWTServiceInterface, jwe.JWEServiceInterface, error) {
+	jwtService, err := jwt.Initialize(pkiService, cryptoProvider)
 	if err != nil {
 		return nil, nil, err
 	}
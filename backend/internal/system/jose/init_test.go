@@ -31,6 +31,7 @@ import (
 	i18ncore "github.com/asgardeo/thunder/internal/system/i18n/core"
 	"github.com/asgardeo/thunder/internal/system/jose/jwe"
 	"github.com/asgardeo/thunder/internal/system/jose/jwt"
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm"
 	"github.com/asgardeo/thunder/tests/mocks/crypto/pki/pkimock"
 )
 
@@ -81,7 +82,8 @@ func (suite *JOSEInitTestSuite) TestInitialize_Success() {
 	suite.mockPKIService.On("GetPrivateKey", "test-key-id").Return(suite.testPrivateKey, nil).Twice()
 	suite.mockPKIService.On("GetCertThumbprint", "test-key-id").Return("test-thumbprint").Twice()
 
-	jwtService, jweService, err := Initialize(suite.mockPKIService)
+	jwtService, jweService, err := Initialize(suite.mockPKIService,
+		defaultkm.NewRuntimeCryptoService(suite.mockPKIService, nil))
 
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), jwtService)
@@ -102,7 +104,8 @@ func (suite *JOSEInitTestSuite) TestInitialize_JWTInitializationFailure() {
 	}
 	suite.mockPKIService.On("GetPrivateKey", "test-key-id").Return(nil, expectedErr).Once()
 
-	jwtService, jweService, err := Initialize(suite.mockPKIService)
+	jwtService, jweService, err := Initialize(suite.mockPKIService,
+		defaultkm.NewRuntimeCryptoService(suite.mockPKIService, nil))
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), jwtService)
@@ -125,7 +128,8 @@ func (suite *JOSEInitTestSuite) TestInitialize_JWEInitializationFailure() {
 	}
 	suite.mockPKIService.On("GetPrivateKey", "test-key-id").Return(nil, expectedErr).Once()
 
-	jwtService, jweService, err := Initialize(suite.mockPKIService)
+	jwtService, jweService, err := Initialize(suite.mockPKIService,
+		defaultkm.NewRuntimeCryptoService(suite.mockPKIService, nil))
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), jwtService)
@@ -142,7 +146,7 @@ func (suite *JOSEInitTestSuite) TestInitialize_NilPKIService() {
 		}
 	}()
 
-	jwtService, jweService, err := Initialize(nil)
+	jwtService, jweService, err := Initialize(nil, nil)
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), jwtService)
@@ -193,7 +197,7 @@ func (suite *JOSEInitTestSuite) TestInitialize_PKIServiceGetPrivateKeyError() {
 			mockPKI := &pkimock.PKIServiceInterfaceMock{}
 			mockPKI.On("GetPrivateKey", "test-key-id").Return(nil, tc.pkiError).Once()
 
-			jwtService, jweService, err := Initialize(mockPKI)
+			jwtService, jweService, err := Initialize(mockPKI, defaultkm.NewRuntimeCryptoService(mockPKI, nil))
 
 			assert.Error(t, err)
 			assert.Nil(t, jwtService)
@@ -210,7 +214,8 @@ func (suite *JOSEInitTestSuite) TestInitialize_ValidatesServiceInterfaces() {
 	suite.mockPKIService.On("GetPrivateKey", "test-key-id").Return(suite.testPrivateKey, nil).Twice()
 	suite.mockPKIService.On("GetCertThumbprint", "test-key-id").Return("test-thumbprint").Twice()
 
-	jwtService, jweService, err := Initialize(suite.mockPKIService)
+	jwtService, jweService, err := Initialize(suite.mockPKIService,
+		defaultkm.NewRuntimeCryptoService(suite.mockPKIService, nil))
 
 	assert.NoError(suite.T(), err)
 
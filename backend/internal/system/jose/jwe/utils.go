@@ -585,7 +585,7 @@ func encryptWithRSAOAEP256(cek []byte, recipientPubKey crypto.PublicKey) ([]byte
 func decryptWithRSAOAEP(encryptedKey []byte, privateKey crypto.PrivateKey) ([]byte, error) {
 	rsaPriv, ok := privateKey.(*rsa.PrivateKey)
 	if !ok {
-		return nil, errors.New("unsupported private key type for JWE key decryption")
+		return decryptWithRSADecrypter(encryptedKey, privateKey, crypto.SHA1)
 	}
 	return rsa.DecryptOAEP(sha1.New(), rand.Reader, rsaPriv, encryptedKey, nil) //nolint:gosec
 }
@@ -667,7 +667,7 @@ func encryptWithECDHESKW(cek []byte, recipientPubKey crypto.PublicKey,
 func decryptWithRSAOAEP256(encryptedKey []byte, privateKey crypto.PrivateKey) ([]byte, error) {
 	rsaPriv, ok := privateKey.(*rsa.PrivateKey)
 	if !ok {
-		return nil, errors.New("unsupported private key type for JWE key decryption")
+		return decryptWithRSADecrypter(encryptedKey, privateKey, crypto.SHA256)
 	}
 	h, err := cryptohash.GetHash(cryptohash.GenericSHA256)
 	if err != nil {
@@ -676,6 +676,19 @@ func decryptWithRSAOAEP256(encryptedKey []byte, privateKey crypto.PrivateKey) ([
 	return rsa.DecryptOAEP(h, rand.Reader, rsaPriv, encryptedKey, nil)
 }
 
+// decryptWithRSADecrypter decrypts the CEK with RSA-OAEP through the crypto.Decrypter implementation of
+// an RSA private key whose material is not accessible, such as a key held in a hardware security module.
+func decryptWithRSADecrypter(encryptedKey []byte, privateKey crypto.PrivateKey, hash crypto.Hash) ([]byte, error) {
+	decrypter, ok := privateKey.(crypto.Decrypter)
+	if !ok {
+		return nil, errors.New("unsupported private key type for JWE key decryption")
+	}
+	if _, ok := decrypter.Public().(*rsa.PublicKey); !ok {
+		return nil, errors.New("unsupported private key type for JWE key decryption")
+	}
+	return decrypter.Decrypt(rand.Reader, encryptedKey, &rsa.OAEPOptions{Hash: hash})
+}
+
 // decryptWithECDHES derives the CEK using ECDH-ES algorithm.
 func decryptWithECDHES(privateKey crypto.PrivateKey, header map[string]interface{},
 	enc ContentEncAlgorithm) ([]byte, error) {
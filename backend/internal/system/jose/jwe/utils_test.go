@@ -19,6 +19,7 @@
 package jwe
 
 import (
+	"crypto"
 	"crypto/ecdh"
 	"crypto/ecdsa"
 	"crypto/elliptic"
@@ -68,6 +69,26 @@ func (s *JEWUtilsTestSuite) TestEncryptDecryptKey_RSA() {
 	}
 }
 
+func (s *JEWUtilsTestSuite) TestDecryptKey_RSAWithOpaqueKey() {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.NoError(err)
+
+	// Keys held in a hardware security module are only available through crypto.Decrypter.
+	opaqueKey := struct{ crypto.Decrypter }{privateKey}
+	cek := []byte("this-is-a-32-byte-long-cek-key!!")
+
+	encryptedKey, _, err := encryptKey(cek, RSAOAEP256, &privateKey.PublicKey, A128GCM)
+	s.NoError(err)
+	decryptedKey, err := decryptKey(encryptedKey, RSAOAEP256, opaqueKey, nil, A128GCM)
+	s.NoError(err)
+	s.Equal(cek, decryptedKey)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	_, err = decryptKey(encryptedKey, RSAOAEP256, struct{ crypto.Signer }{ecKey}, nil, A128GCM)
+	s.Error(err)
+}
+
 func (s *JEWUtilsTestSuite) TestEncryptDecryptKey_ECDH() {
 	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	s.NoError(err)
@@ -22,8 +22,12 @@ package jws
 import (
 	"crypto"
 	"crypto/ecdh"
+	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/asn1"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -194,3 +198,120 @@ func jwkToOKPPublicKey(jwk map[string]interface{}) (ed25519.PublicKey, error) {
 		return nil, fmt.Errorf("unsupported OKP curve: %s", crv)
 	}
 }
+
+// ComputeJWKThumbprint computes the base64url-encoded SHA-256 thumbprint (RFC 7638) of a public JWK.
+func ComputeJWKThumbprint(jwk map[string]interface{}) (string, error) {
+	kty, ok := jwk["kty"].(string)
+	if !ok {
+		return "", errors.New("JWK missing kty")
+	}
+
+	var members []string
+	switch kty {
+	case "RSA":
+		members = []string{"e", "kty", "n"}
+	case "EC":
+		members = []string{"crv", "kty", "x", "y"}
+	case "OKP":
+		members = []string{"crv", "kty", "x"}
+	default:
+		return "", fmt.Errorf("unsupported JWK kty: %s", kty)
+	}
+
+	// Only the required members take part in the thumbprint. encoding/json writes map keys in
+	// lexicographic order without whitespace, which is the canonical form the RFC requires.
+	required := make(map[string]string, len(members))
+	for _, member := range members {
+		value, ok := jwk[member].(string)
+		if !ok || value == "" {
+			return "", fmt.Errorf("JWK missing required member: %s", member)
+		}
+		required[member] = value
+	}
+
+	canonical, err := json.Marshal(required)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JWK members: %w", err)
+	}
+	sum := sha256.Sum256(canonical)
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// VerifyWithJWK verifies the signature of a compact JWS using the public key described by a JWK.
+// The algorithm is taken from the JWS header, and ECDSA signatures are expected in the fixed-width
+// R || S form defined in RFC 7518 Section 3.4.
+func VerifyWithJWK(token string, jwk map[string]interface{}) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return errors.New("invalid JWS token format")
+	}
+
+	header, err := DecodeHeader(token)
+	if err != nil {
+		return err
+	}
+	algStr, _ := header["alg"].(string)
+	signAlg, err := MapAlgorithmToSignAlg(Algorithm(algStr))
+	if err != nil {
+		return err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return fmt.Errorf("failed to decode JWS signature: %w", err)
+	}
+
+	publicKey, err := jwkToVerificationKey(jwk)
+	if err != nil {
+		return err
+	}
+	if ecKey, ok := publicKey.(*ecdsa.PublicKey); ok {
+		if signature, err = ecdsaSignatureToASN1(signature, ecKey); err != nil {
+			return err
+		}
+	}
+
+	if err := cryptolab.Verify([]byte(parts[0]+"."+parts[1]), signature, signAlg, publicKey); err != nil {
+		return fmt.Errorf("JWS signature verification failed: %w", err)
+	}
+	return nil
+}
+
+// jwkToVerificationKey converts a JWK to a public key usable for signature verification.
+// Unlike JWKToPublicKey, EC keys are returned as ECDSA keys rather than ECDH keys.
+func jwkToVerificationKey(jwk map[string]interface{}) (crypto.PublicKey, error) {
+	if kty, _ := jwk["kty"].(string); kty != "EC" {
+		return JWKToPublicKey(jwk)
+	}
+
+	ecdhKey, err := JWKToECPublicKey(jwk)
+	if err != nil {
+		return nil, err
+	}
+
+	var curve elliptic.Curve
+	switch jwk["crv"] {
+	case P256:
+		curve = elliptic.P256()
+	case P384:
+		curve = elliptic.P384()
+	default:
+		curve = elliptic.P521()
+	}
+	return ecdsa.ParseUncompressedPublicKey(curve, ecdhKey.Bytes())
+}
+
+// ecdsaSignatureToASN1 converts a fixed-width R || S ECDSA signature to the ASN.1 DER form.
+func ecdsaSignatureToASN1(signature []byte, publicKey *ecdsa.PublicKey) ([]byte, error) {
+	size := (publicKey.Curve.Params().BitSize + 7) / 8
+	if len(signature) != 2*size {
+		return nil, fmt.Errorf("invalid ECDSA signature length: %d", len(signature))
+	}
+
+	return asn1.Marshal(struct {
+		R, S *big.Int
+	}{
+		R: new(big.Int).SetBytes(signature[:size]),
+		S: new(big.Int).SetBytes(signature[size:]),
+	})
+}
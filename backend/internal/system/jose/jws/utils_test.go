@@ -25,9 +25,11 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -412,3 +414,136 @@ func (suite *JWSUtilsTestSuite) TestJWKToPublicKeyInvalidEC() {
 	assert.Contains(suite.T(), err.Error(), "point not on curve")
 	assert.Nil(suite.T(), publicKey)
 }
+
+func (suite *JWSUtilsTestSuite) TestComputeJWKThumbprintRFC7638Example() {
+	// Example key and thumbprint from RFC 7638 Section 3.1.
+	jwk := map[string]interface{}{
+		"kty": "RSA",
+		"n": "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3" +
+			"oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0z" +
+			"gdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csF" +
+			"Cur-kEgU8awapJzKnqDKgw",
+		"e":   "AQAB",
+		"alg": "RS256",
+		"kid": "2011-04-29",
+	}
+
+	thumbprint, err := ComputeJWKThumbprint(jwk)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs", thumbprint)
+}
+
+func (suite *JWSUtilsTestSuite) TestComputeJWKThumbprintIgnoresOptionalMembers() {
+	jwk := suite.ecJWK()
+	withOptional := suite.ecJWK()
+	withOptional["kid"] = "key-1"
+	withOptional["use"] = "sig"
+
+	thumbprint, err := ComputeJWKThumbprint(jwk)
+	assert.NoError(suite.T(), err)
+	other, err := ComputeJWKThumbprint(withOptional)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), thumbprint, other)
+}
+
+func (suite *JWSUtilsTestSuite) TestComputeJWKThumbprintErrors() {
+	testCases := []struct {
+		name string
+		jwk  map[string]interface{}
+	}{
+		{"MissingKty", map[string]interface{}{"crv": "P-256"}},
+		{"UnsupportedKty", map[string]interface{}{"kty": "oct", "k": "c2VjcmV0"}},
+		{"MissingMember", map[string]interface{}{"kty": "EC", "crv": "P-256", "x": "abc"}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			thumbprint, err := ComputeJWKThumbprint(tc.jwk)
+			assert.Error(suite.T(), err)
+			assert.Empty(suite.T(), thumbprint)
+		})
+	}
+}
+
+func (suite *JWSUtilsTestSuite) TestVerifyWithJWKECDSA() {
+	token := suite.signES256("eyJhbGciOiJFUzI1NiJ9.eyJzdWIiOiJ0ZXN0In0")
+
+	assert.NoError(suite.T(), VerifyWithJWK(token, suite.ecJWK()))
+}
+
+func (suite *JWSUtilsTestSuite) TestVerifyWithJWKRSA() {
+	signingInput := "eyJhbGciOiJSUzI1NiJ9.eyJzdWIiOiJ0ZXN0In0"
+	signature, err := cryptolab.Generate([]byte(signingInput), cryptolab.RSASHA256, suite.rsaPrivateKey)
+	assert.NoError(suite.T(), err)
+	token := signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+
+	jwk := map[string]interface{}{
+		"kty": "RSA",
+		"n":   base64.RawURLEncoding.EncodeToString(suite.rsaPublicKey.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(suite.rsaPublicKey.E)).Bytes()),
+	}
+
+	assert.NoError(suite.T(), VerifyWithJWK(token, jwk))
+}
+
+func (suite *JWSUtilsTestSuite) TestVerifyWithJWKEd25519() {
+	signingInput := "eyJhbGciOiJFZERTQSJ9.eyJzdWIiOiJ0ZXN0In0"
+	signature := ed25519.Sign(suite.edPrivateKey, []byte(signingInput))
+	token := signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+
+	jwk := map[string]interface{}{
+		"kty": "OKP",
+		"crv": "Ed25519",
+		"x":   base64.RawURLEncoding.EncodeToString(suite.edPublicKey),
+	}
+
+	assert.NoError(suite.T(), VerifyWithJWK(token, jwk))
+}
+
+func (suite *JWSUtilsTestSuite) TestVerifyWithJWKFailures() {
+	valid := suite.signES256("eyJhbGciOiJFUzI1NiJ9.eyJzdWIiOiJ0ZXN0In0")
+	parts := strings.Split(valid, ".")
+
+	testCases := []struct {
+		name  string
+		token string
+	}{
+		{"InvalidFormat", "abc.def"},
+		{"TamperedPayload", parts[0] + ".eyJzdWIiOiJvdGhlciJ9." + parts[2]},
+		{"UnsupportedAlgorithm", suite.signES256("eyJhbGciOiJIUzI1NiJ9.eyJzdWIiOiJ0ZXN0In0")},
+		{"ASN1Signature", parts[0] + "." + parts[1] + "." + suite.asn1Signature(parts[0]+"."+parts[1])},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			assert.Error(suite.T(), VerifyWithJWK(tc.token, suite.ecJWK()))
+		})
+	}
+}
+
+func (suite *JWSUtilsTestSuite) ecJWK() map[string]interface{} {
+	return map[string]interface{}{
+		"kty": "EC",
+		"crv": P256,
+		"x":   base64.RawURLEncoding.EncodeToString(suite.ecPublicKey.X.FillBytes(make([]byte, 32))),
+		"y":   base64.RawURLEncoding.EncodeToString(suite.ecPublicKey.Y.FillBytes(make([]byte, 32))),
+	}
+}
+
+// signES256 signs the input with the suite's EC key, producing an R || S JWS signature.
+func (suite *JWSUtilsTestSuite) signES256(signingInput string) string {
+	digest := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, suite.ecPrivateKey, digest[:])
+	assert.NoError(suite.T(), err)
+
+	signature := append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func (suite *JWSUtilsTestSuite) asn1Signature(signingInput string) string {
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := ecdsa.SignASN1(rand.Reader, suite.ecPrivateKey, digest[:])
+	assert.NoError(suite.T(), err)
+	return base64.RawURLEncoding.EncodeToString(signature)
+}
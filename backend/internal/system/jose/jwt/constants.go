@@ -24,4 +24,18 @@ const (
 
 	// TokenTypeAccessToken is the JWT type header value for access tokens as defined in RFC 9068.
 	TokenTypeAccessToken = "at+jwt"
+
+	// TokenTypeLogoutToken is the JWT type header value for OpenID Connect back-channel logout tokens.
+	TokenTypeLogoutToken = "logout+jwt"
+
+	// ClaimConfirmation is the confirmation claim (RFC 7800) binding a token to a proof-of-possession key.
+	ClaimConfirmation = "cnf"
+
+	// ConfirmationX5tS256 is the confirmation member carrying the SHA-256 thumbprint of the X.509
+	// certificate a token is bound to (RFC 8705).
+	ConfirmationX5tS256 = "x5t#S256"
+
+	// ConfirmationJKT is the confirmation member carrying the JWK SHA-256 thumbprint of the DPoP key
+	// a token is bound to (RFC 9449).
+	ConfirmationJKT = "jkt"
 )
@@ -23,13 +23,15 @@ import (
 	"time"
 
 	httpservice "github.com/asgardeo/thunder/internal/system/http"
-	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm"
+	"github.com/asgardeo/thunder/internal/system/kmprovider"
 	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/pkiservice"
 )
 
-// Initialize initializes the JWT service.
-func Initialize(pkiSvc pkiservice.PKIServiceInterface) (JWTServiceInterface, error) {
+// Initialize initializes the JWT service. Tokens are signed through the runtime crypto provider of the
+// configured key manager.
+func Initialize(
+	pkiSvc pkiservice.PKIServiceInterface, cryptoProvider kmprovider.RuntimeCryptoProvider,
+) (JWTServiceInterface, error) {
 	httpClient := httpservice.NewHTTPClientWithTimeout(10 * time.Second)
-	runtimeSvc := defaultkm.NewRuntimeCryptoService(pkiSvc, nil)
-	return newJWTService(pkiSvc, httpClient, runtimeSvc)
+	return newJWTService(pkiSvc, httpClient, cryptoProvider)
 }
@@ -33,6 +33,7 @@ import (
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	"github.com/asgardeo/thunder/internal/system/i18n/core"
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm"
 	"github.com/asgardeo/thunder/tests/mocks/crypto/pki/pkimock"
 )
 
@@ -120,7 +121,7 @@ func (suite *InitTestSuite) TestInitialize_Success() {
 	pkiMock.EXPECT().GetCertThumbprint(mock.Anything).Return("test-kid")
 
 	// Initialize JWT service
-	jwtService, err := Initialize(pkiMock)
+	jwtService, err := Initialize(pkiMock, defaultkm.NewRuntimeCryptoService(pkiMock, nil))
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), jwtService)
 	assert.Implements(suite.T(), (*JWTServiceInterface)(nil), jwtService)
@@ -155,7 +156,7 @@ func (suite *InitTestSuite) TestInitialize_PrivateKeyRetrievalError() {
 	pkiMock.EXPECT().GetPrivateKey(mock.Anything).Return(nil, testErr)
 
 	// Initialize JWT service should fail
-	jwtService, err := Initialize(pkiMock)
+	jwtService, err := Initialize(pkiMock, defaultkm.NewRuntimeCryptoService(pkiMock, nil))
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), jwtService)
 }
@@ -187,7 +188,7 @@ func (suite *InitTestSuite) TestInitialize_WithoutPreferredKeyID() {
 	pkiMock.EXPECT().GetCertThumbprint("").Return("test-kid")
 
 	// Initialize JWT service
-	jwtService, err := Initialize(pkiMock)
+	jwtService, err := Initialize(pkiMock, defaultkm.NewRuntimeCryptoService(pkiMock, nil))
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), jwtService)
 }
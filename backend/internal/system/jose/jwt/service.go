@@ -49,6 +49,7 @@ import (
 type JWTServiceInterface interface {
 	GenerateJWT(ctx context.Context, sub, iss string, validityPeriod int64,
 		claims map[string]interface{}, typ, alg string) (string, int64, *serviceerror.ServiceError)
+	GetSigningAlgorithm() string
 	VerifyJWT(jwtToken string, expectedAud, expectedIss string) *serviceerror.ServiceError
 	VerifyJWTWithPublicKey(jwtToken string, jwtPublicKey crypto.PublicKey, expectedAud,
 		expectedIss string) *serviceerror.ServiceError
@@ -67,16 +68,27 @@ type jwksCacheEntry struct {
 // jwtService implements the JWTServiceInterface for generating and managing JWT tokens.
 type jwtService struct {
 	cryptoProvider kmprovider.RuntimeCryptoProvider
+	pkiService     pkiservice.PKIServiceInterface
 	keyRef         kmprovider.KeyRef
 	publicKey      crypto.PublicKey
 	signAlg        cryptolab.SignAlgorithm
 	jwsAlg         jws.Algorithm
 	kid            string
+	mu             sync.RWMutex
 	logger         *log.Logger
 	jwksCache      sync.Map
 	httpClient     httpservice.HTTPClientInterface
 }
 
+// signingKey holds the key material used to sign tokens and verify their signatures.
+type signingKey struct {
+	keyRef    kmprovider.KeyRef
+	publicKey crypto.PublicKey
+	signAlg   cryptolab.SignAlgorithm
+	jwsAlg    jws.Algorithm
+	kid       string
+}
+
 // newJWTService creates a new JWT service instance.
 func newJWTService(
 	pkiService pkiservice.PKIServiceInterface,
@@ -84,82 +96,111 @@ func newJWTService(
 ) (JWTServiceInterface, error) {
 	preferredKid := config.GetServerRuntime().Config.JWT.PreferredKeyID
 
-	privateKey, err := pkiService.GetPrivateKey(preferredKid)
+	key, err := resolveSigningKey(pkiService, preferredKid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jwtService{
+		cryptoProvider: cryptoProvider,
+		pkiService:     pkiService,
+		keyRef:         key.keyRef,
+		publicKey:      key.publicKey,
+		signAlg:        key.signAlg,
+		jwsAlg:         key.jwsAlg,
+		kid:            key.kid,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, "JWTService")),
+		httpClient:     httpClient,
+	}, nil
+}
+
+// resolveSigningKey resolves the signing key material of the given key ID from the PKI service.
+func resolveSigningKey(pkiService pkiservice.PKIServiceInterface, keyID string) (signingKey, error) {
+	privateKey, err := pkiService.GetPrivateKey(keyID)
 	if err != nil {
-		return nil, errors.New("failed to retrieve private key for the key id: " + preferredKid)
-	}
-
-	kid := pkiService.GetCertThumbprint(preferredKid)
-	keyRef := kmprovider.KeyRef{KeyID: preferredKid}
-	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "JWTService"))
-
-	// Get algorithm based on the type of private key
-	switch k := privateKey.(type) {
-	case *rsa.PrivateKey:
-		return &jwtService{
-			cryptoProvider: cryptoProvider,
-			keyRef:         keyRef,
-			publicKey:      &k.PublicKey,
-			signAlg:        cryptolab.RSASHA256,
-			jwsAlg:         jws.RS256,
-			kid:            kid,
-			logger:         logger,
-			httpClient:     httpClient,
-		}, nil
-	case *ecdsa.PrivateKey:
+		return signingKey{}, errors.New("failed to retrieve private key for the key id: " + keyID)
+	}
+
+	key := signingKey{
+		keyRef: kmprovider.KeyRef{KeyID: keyID},
+		kid:    pkiService.GetCertThumbprint(keyID),
+	}
+
+	// Get algorithm based on the type of the public key. Keys held in a hardware security module are
+	// only available through crypto.Signer.
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return signingKey{}, errors.New("unsupported private key type")
+	}
+	key.publicKey = signer.Public()
+	switch k := key.publicKey.(type) {
+	case *rsa.PublicKey:
+		key.signAlg = cryptolab.RSASHA256
+		key.jwsAlg = jws.RS256
+	case *ecdsa.PublicKey:
 		// Determine ECDSA algorithm based on curve
 		crvName := k.Curve.Params().Name
 		switch crvName {
 		case jws.P256:
-			return &jwtService{
-				cryptoProvider: cryptoProvider,
-				keyRef:         keyRef,
-				publicKey:      &k.PublicKey,
-				signAlg:        cryptolab.ECDSASHA256,
-				jwsAlg:         jws.ES256,
-				kid:            kid,
-				logger:         logger,
-				httpClient:     httpClient,
-			}, nil
+			key.signAlg = cryptolab.ECDSASHA256
+			key.jwsAlg = jws.ES256
 		case jws.P384:
-			return &jwtService{
-				cryptoProvider: cryptoProvider,
-				keyRef:         keyRef,
-				publicKey:      &k.PublicKey,
-				signAlg:        cryptolab.ECDSASHA384,
-				jwsAlg:         jws.ES384,
-				kid:            kid,
-				logger:         logger,
-				httpClient:     httpClient,
-			}, nil
+			key.signAlg = cryptolab.ECDSASHA384
+			key.jwsAlg = jws.ES384
 		case jws.P521:
-			return &jwtService{
-				cryptoProvider: cryptoProvider,
-				keyRef:         keyRef,
-				publicKey:      &k.PublicKey,
-				signAlg:        cryptolab.ECDSASHA512,
-				jwsAlg:         jws.ES512,
-				kid:            kid,
-				logger:         logger,
-				httpClient:     httpClient,
-			}, nil
+			key.signAlg = cryptolab.ECDSASHA512
+			key.jwsAlg = jws.ES512
 		default:
-			return nil, errors.New("unsupported EC curve: " + crvName + " only P-256, P-384 and P-521 are supported")
+			return signingKey{}, errors.New("unsupported EC curve: " + crvName +
+				" only P-256, P-384 and P-521 are supported")
 		}
-	case ed25519.PrivateKey:
-		return &jwtService{
-			cryptoProvider: cryptoProvider,
-			keyRef:         keyRef,
-			publicKey:      k.Public(),
-			signAlg:        cryptolab.ED25519,
-			jwsAlg:         jws.EdDSA,
-			kid:            kid,
-			logger:         logger,
-			httpClient:     httpClient,
-		}, nil
+	case ed25519.PublicKey:
+		key.signAlg = cryptolab.ED25519
+		key.jwsAlg = jws.EdDSA
 	default:
-		return nil, errors.New("unsupported private key type")
+		return signingKey{}, errors.New("unsupported private key type")
 	}
+	return key, nil
+}
+
+// getSigningKey returns the key used to sign tokens. When signing keys are rotated, the active managed
+// key takes precedence over the preferred key and is resolved again whenever it changes.
+func (js *jwtService) getSigningKey() signingKey {
+	js.mu.RLock()
+	current := signingKey{
+		keyRef:    js.keyRef,
+		publicKey: js.publicKey,
+		signAlg:   js.signAlg,
+		jwsAlg:    js.jwsAlg,
+		kid:       js.kid,
+	}
+	js.mu.RUnlock()
+
+	if js.pkiService == nil {
+		return current
+	}
+	activeKeyID := js.pkiService.GetActiveSigningKeyID()
+	if activeKeyID == "" || activeKeyID == current.keyRef.KeyID {
+		return current
+	}
+
+	key, err := resolveSigningKey(js.pkiService, activeKeyID)
+	if err != nil {
+		js.logger.Error("Failed to resolve the active signing key, continuing with the current key",
+			log.String("keyID", activeKeyID), log.Error(err))
+		return current
+	}
+
+	js.mu.Lock()
+	js.keyRef = key.keyRef
+	js.publicKey = key.publicKey
+	js.signAlg = key.signAlg
+	js.jwsAlg = key.jwsAlg
+	js.kid = key.kid
+	js.mu.Unlock()
+
+	js.logger.Debug("Switched to the active signing key", log.String("keyID", activeKeyID))
+	return key
 }
 
 // GenerateJWT generates a JWT signed with the server's private key.
@@ -176,10 +217,11 @@ func (js *jwtService) GenerateJWT(
 		ctx = context.Background()
 	}
 
-	jwsAlg := js.jwsAlg
+	key := js.getSigningKey()
+	jwsAlg := key.jwsAlg
 	if alg != "" {
 		mapped, err := jws.MapAlgorithmToSignAlg(jws.Algorithm(alg))
-		if err != nil || mapped != js.signAlg {
+		if err != nil || mapped != key.signAlg {
 			return "", 0, &ErrorUnsupportedJWSAlgorithm
 		}
 		jwsAlg = jws.Algorithm(alg)
@@ -212,7 +254,7 @@ func (js *jwtService) GenerateJWT(
 	header := map[string]string{
 		"alg": string(jwsAlg),
 		"typ": typ,
-		"kid": js.kid,
+		"kid": key.kid,
 	}
 
 	headerJSON, err := json.Marshal(header)
@@ -268,7 +310,7 @@ func (js *jwtService) GenerateJWT(
 
 	// Create the signing input and sign it with the crypto provider.
 	signingInput := headerBase64 + "." + payloadBase64
-	signature, err := js.cryptoProvider.Sign(ctx, js.keyRef, js.signAlg, []byte(signingInput))
+	signature, err := js.cryptoProvider.Sign(ctx, key.keyRef, key.signAlg, []byte(signingInput))
 	if err != nil {
 		js.logger.Error("Failed to sign JWT: " + err.Error())
 		return "", 0, &serviceerror.InternalServerError
@@ -280,9 +322,14 @@ func (js *jwtService) GenerateJWT(
 	return signingInput + "." + signatureBase64, iat.Unix(), nil
 }
 
+// GetSigningAlgorithm returns the JWS algorithm of the key currently used to sign tokens.
+func (js *jwtService) GetSigningAlgorithm() string {
+	return string(js.getSigningKey().jwsAlg)
+}
+
 // VerifyJWT verifies the JWT token using the server's public key.
 func (js *jwtService) VerifyJWT(jwtToken string, expectedAud, expectedIss string) *serviceerror.ServiceError {
-	if js.publicKey == nil {
+	if js.getSigningKey().publicKey == nil {
 		js.logger.Error("Public key not found for JWT verification")
 		return &serviceerror.InternalServerError
 	}
@@ -343,9 +390,42 @@ func (js *jwtService) VerifyJWTSignature(jwtToken string) *serviceerror.ServiceE
 	signingInput := parts[0] + "." + parts[1]
 
 	// Verify the signature using the configured algorithm
-	err = cryptolab.Verify([]byte(signingInput), signature, js.signAlg, js.publicKey)
+	key := js.getSigningKey()
+	err = cryptolab.Verify([]byte(signingInput), signature, key.signAlg, key.publicKey)
+	if err == nil {
+		return nil
+	}
+
+	// Tokens signed with a retiring or newly activated key carry the kid of that key.
+	if publicKey := js.getPublishedPublicKey(jwtToken, key.kid); publicKey != nil {
+		return js.VerifyJWTSignatureWithPublicKey(jwtToken, publicKey)
+	}
+	return &ErrorInvalidTokenSignature
+}
+
+// getPublishedPublicKey returns the public key of the server key identified by the kid of the token
+// header, or nil when the kid is missing, belongs to the current signing key, or is not known.
+func (js *jwtService) getPublishedPublicKey(jwtToken, currentKid string) crypto.PublicKey {
+	if js.pkiService == nil {
+		return nil
+	}
+	header, err := DecodeJWTHeader(jwtToken)
 	if err != nil {
-		return &ErrorInvalidTokenSignature
+		return nil
+	}
+	kid, _ := header["kid"].(string)
+	if kid == "" || kid == currentKid {
+		return nil
+	}
+
+	certs, svcErr := js.pkiService.GetAllX509Certificates()
+	if svcErr != nil {
+		return nil
+	}
+	for certID, cert := range certs {
+		if js.pkiService.GetCertThumbprint(certID) == kid {
+			return cert.PublicKey
+		}
 	}
 	return nil
 }
@@ -53,6 +53,7 @@ import (
 	"github.com/asgardeo/thunder/internal/system/i18n/core"
 	"github.com/asgardeo/thunder/internal/system/jose/jws"
 	"github.com/asgardeo/thunder/internal/system/kmprovider"
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm"
 	"github.com/asgardeo/thunder/internal/system/log"
 	"github.com/asgardeo/thunder/tests/mocks/crypto/cryptomock"
 	"github.com/asgardeo/thunder/tests/mocks/crypto/pki/pkimock"
@@ -189,7 +190,7 @@ func (suite *JWTServiceTestSuite) TestNewJWTService() {
 	suite.pkiMock.EXPECT().GetPrivateKey(mock.Anything).Return(suite.testPrivateKey, nil)
 	suite.pkiMock.EXPECT().GetCertThumbprint(mock.Anything).Return("test-kid")
 
-	service, err := Initialize(suite.pkiMock)
+	service, err := Initialize(suite.pkiMock, defaultkm.NewRuntimeCryptoService(suite.pkiMock, nil))
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), service)
 	assert.Implements(suite.T(), (*JWTServiceInterface)(nil), service)
@@ -266,7 +267,7 @@ func (suite *JWTServiceTestSuite) TestInitScenarios() {
 				pkiMock.EXPECT().GetCertThumbprint(mock.Anything).Return("test-kid")
 			}
 
-			service, err := Initialize(pkiMock)
+			service, err := Initialize(pkiMock, defaultkm.NewRuntimeCryptoService(pkiMock, nil))
 
 			if tc.expectSuccess {
 				assert.NoError(t, err)
@@ -281,6 +282,10 @@ func (suite *JWTServiceTestSuite) TestInitScenarios() {
 	}
 }
 
+func (suite *JWTServiceTestSuite) TestGetSigningAlgorithm() {
+	assert.Equal(suite.T(), string(jws.RS256), suite.jwtService.GetSigningAlgorithm())
+}
+
 func (suite *JWTServiceTestSuite) TestGenerateJWTScenarios() {
 	testCases := []struct {
 		name               string
@@ -1958,8 +1963,9 @@ func (suite *JWTServiceTestSuite) TestInitWithECDSAKeys() {
 			pkiMock := pkimock.NewPKIServiceInterfaceMock(t)
 			pkiMock.EXPECT().GetPrivateKey(mock.Anything).Return(ecKey, nil)
 			pkiMock.EXPECT().GetCertThumbprint(mock.Anything).Return("test-kid")
+			pkiMock.EXPECT().GetActiveSigningKeyID().Return("").Maybe()
 
-			service, err := Initialize(pkiMock)
+			service, err := Initialize(pkiMock, defaultkm.NewRuntimeCryptoService(pkiMock, nil))
 
 			assert.NoError(t, err)
 			assert.NotNil(t, service)
@@ -2021,8 +2027,9 @@ func (suite *JWTServiceTestSuite) TestInitWithEd25519Key() {
 	pkiMock := pkimock.NewPKIServiceInterfaceMock(suite.T())
 	pkiMock.EXPECT().GetPrivateKey(mock.Anything).Return(priv, nil)
 	pkiMock.EXPECT().GetCertThumbprint(mock.Anything).Return("test-kid")
+	pkiMock.EXPECT().GetActiveSigningKeyID().Return("").Maybe()
 
-	service, err := Initialize(pkiMock)
+	service, err := Initialize(pkiMock, defaultkm.NewRuntimeCryptoService(pkiMock, nil))
 
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), service)
@@ -2080,8 +2087,9 @@ func (suite *JWTServiceTestSuite) TestInitWithECPrivateKeyFormat() {
 	pkiMock := pkimock.NewPKIServiceInterfaceMock(suite.T())
 	pkiMock.EXPECT().GetPrivateKey(mock.Anything).Return(ecKey, nil)
 	pkiMock.EXPECT().GetCertThumbprint(mock.Anything).Return("test-kid")
+	pkiMock.EXPECT().GetActiveSigningKeyID().Return("").Maybe()
 
-	service, err := Initialize(pkiMock)
+	service, err := Initialize(pkiMock, defaultkm.NewRuntimeCryptoService(pkiMock, nil))
 
 	assert.NoError(suite.T(), err)
 
@@ -2121,7 +2129,7 @@ func (suite *JWTServiceTestSuite) TestInitWithUnsupportedECCurve() {
 		DefaultValue: "unsupported EC curve",
 	})
 	pkiMock.EXPECT().GetPrivateKey(mock.Anything).Return(nil, testErr)
-	_, err = Initialize(pkiMock)
+	_, err = Initialize(pkiMock, defaultkm.NewRuntimeCryptoService(pkiMock, nil))
 
 	assert.Error(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "failed to retrieve private key")
@@ -2471,3 +2479,45 @@ func (suite *JWTServiceTestSuite) TestVerifyJWTWithZeroLeeway() {
 	assert.NotNil(suite.T(), svcErr)
 	assert.Equal(suite.T(), ErrorTokenExpired, *svcErr)
 }
+
+func (suite *JWTServiceTestSuite) TestSigningKeyRotation() {
+	// Token signed with the initial signing key.
+	previousToken, _, svcErr := suite.jwtService.GenerateJWT(context.Background(),
+		"test-subject", "test-iss", 3600, map[string]interface{}{"aud": testAud}, TokenTypeJWT, "")
+	suite.Require().Nil(svcErr)
+
+	managedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+
+	cryptoMock := cryptomock.NewRuntimeCryptoProviderMock(suite.T())
+	cryptoMock.EXPECT().
+		Sign(mock.Anything, kmprovider.KeyRef{KeyID: "managed-key"}, cryptolab.ECDSASHA256, mock.Anything).
+		RunAndReturn(func(
+			_ context.Context, _ kmprovider.KeyRef, _ cryptolab.SignAlgorithm, content []byte,
+		) ([]byte, error) {
+			return cryptolab.Generate(content, cryptolab.ECDSASHA256, managedKey)
+		}).Once()
+	suite.jwtService.cryptoProvider = cryptoMock
+	suite.jwtService.pkiService = suite.pkiMock
+
+	suite.pkiMock.EXPECT().GetActiveSigningKeyID().Return("managed-key")
+	suite.pkiMock.EXPECT().GetPrivateKey("managed-key").Return(managedKey, nil).Once()
+	suite.pkiMock.EXPECT().GetCertThumbprint("managed-key").Return("managed-kid").Once()
+
+	token, _, svcErr := suite.jwtService.GenerateJWT(context.Background(),
+		"test-subject", "test-iss", 3600, map[string]interface{}{"aud": testAud}, TokenTypeJWT, "")
+	suite.Require().Nil(svcErr)
+
+	header, err := DecodeJWTHeader(token)
+	suite.Require().NoError(err)
+	suite.Equal("ES256", header["alg"])
+	suite.Equal("managed-kid", header["kid"])
+	suite.Nil(suite.jwtService.VerifyJWTSignature(token))
+
+	// Tokens signed with the previous key remain valid while its certificate is published.
+	suite.pkiMock.EXPECT().GetAllX509Certificates().Return(map[string]*x509.Certificate{
+		"test-kid": {PublicKey: &suite.testPrivateKey.PublicKey},
+	}, nil).Once()
+	suite.pkiMock.EXPECT().GetCertThumbprint("test-kid").Return("test-kid").Once()
+	suite.Nil(suite.jwtService.VerifyJWTSignature(previousToken))
+}
@@ -19,6 +19,8 @@
 package jwt
 
 import (
+	"crypto/sha256"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -80,3 +82,35 @@ func DecodeJWTHeader(jwtToken string) (map[string]interface{}, error) {
 
 	return header, nil
 }
+
+// CertificateThumbprint returns the base64url-encoded SHA-256 thumbprint of the DER-encoded certificate,
+// as carried by the x5t#S256 confirmation member.
+func CertificateThumbprint(cert *x509.Certificate) string {
+	if cert == nil {
+		return ""
+	}
+	sum := sha256.Sum256(cert.Raw)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// GetCertificateThumbprintConfirmation returns the x5t#S256 member of the cnf claim in the given claims,
+// or an empty string when the token is not certificate-bound.
+func GetCertificateThumbprintConfirmation(claims map[string]interface{}) string {
+	return getConfirmationMember(claims, ConfirmationX5tS256)
+}
+
+// GetKeyThumbprintConfirmation returns the jkt member of the cnf claim in the given claims,
+// or an empty string when the token is not bound to a DPoP key.
+func GetKeyThumbprintConfirmation(claims map[string]interface{}) string {
+	return getConfirmationMember(claims, ConfirmationJKT)
+}
+
+// getConfirmationMember returns the named string member of the cnf claim, if present.
+func getConfirmationMember(claims map[string]interface{}, member string) string {
+	cnf, ok := claims[ClaimConfirmation].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	value, _ := cnf[member].(string)
+	return value
+}
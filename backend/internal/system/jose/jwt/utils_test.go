@@ -21,6 +21,8 @@ package jwt
 import (
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
 	"net/http/httptest"
@@ -221,3 +223,48 @@ func (suite *JWTUtilsTestSuite) TestParseJWTHeaderInvalid() {
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), header)
 }
+
+func (suite *JWTUtilsTestSuite) TestCertificateThumbprint() {
+	cert := &x509.Certificate{Raw: []byte("test-certificate-der")}
+	sum := sha256.Sum256(cert.Raw)
+
+	assert.Equal(suite.T(), base64.RawURLEncoding.EncodeToString(sum[:]), CertificateThumbprint(cert))
+	assert.Empty(suite.T(), CertificateThumbprint(nil))
+}
+
+func (suite *JWTUtilsTestSuite) TestGetCertificateThumbprintConfirmation() {
+	testCases := []struct {
+		name     string
+		claims   map[string]interface{}
+		expected string
+	}{
+		{"Bound", map[string]interface{}{"cnf": map[string]interface{}{"x5t#S256": "thumb"}}, "thumb"},
+		{"NoConfirmation", map[string]interface{}{"sub": "user"}, ""},
+		{"OtherConfirmationMethod", map[string]interface{}{"cnf": map[string]interface{}{"jkt": "thumb"}}, ""},
+		{"InvalidConfirmation", map[string]interface{}{"cnf": "thumb"}, ""},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, GetCertificateThumbprintConfirmation(tc.claims))
+		})
+	}
+}
+
+func (suite *JWTUtilsTestSuite) TestGetKeyThumbprintConfirmation() {
+	testCases := []struct {
+		name     string
+		claims   map[string]interface{}
+		expected string
+	}{
+		{"Bound", map[string]interface{}{"cnf": map[string]interface{}{"jkt": "thumb"}}, "thumb"},
+		{"NoConfirmation", map[string]interface{}{"sub": "user"}, ""},
+		{"OtherConfirmationMethod", map[string]interface{}{"cnf": map[string]interface{}{"x5t#S256": "thumb"}}, ""},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, GetKeyThumbprintConfirmation(tc.claims))
+		})
+	}
+}
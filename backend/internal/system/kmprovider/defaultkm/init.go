@@ -37,6 +37,11 @@ var (
 	initErr          error
 )
 
+// init registers the default key manager factory with the key manager registry.
+func init() {
+	kmprovider.RegisterKeyManagerFactory(kmprovider.DefaultProviderName, newKeyManager)
+}
+
 // GetRuntimeCryptoService returns the singleton RuntimeCryptoProvider for the default key manager.
 func GetRuntimeCryptoService() (kmprovider.RuntimeCryptoProvider, error) {
 	globalOnce.Do(func() {
@@ -77,6 +82,24 @@ func Initialize() (kmprovider.RuntimeCryptoProvider, kmprovider.ConfigCryptoProv
 	return runtimeSvc, cfgSvc, nil
 }
 
+// newKeyManager creates the default key manager from the keys and encryption key in the server config.
+func newKeyManager() (*kmprovider.KeyManager, error) {
+	pkiSvc, err := pkiservice.Initialize()
+	if err != nil {
+		return nil, err
+	}
+	cfgSvc, err := initConfigProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	return &kmprovider.KeyManager{
+		PKIService:    pkiSvc,
+		RuntimeCrypto: NewRuntimeCryptoService(pkiSvc, cfgSvc),
+		ConfigCrypto:  cfgSvc,
+	}, nil
+}
+
 func initConfigProvider() (kmprovider.ConfigCryptoProvider, error) {
 	encryptionKey := config.GetServerRuntime().Config.Crypto.Encryption.Key
 	if encryptionKey == "" {
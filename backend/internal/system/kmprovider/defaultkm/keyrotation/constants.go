@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package keyrotation
+
+import "time"
+
+const (
+	// loggerComponentName is the component name used by the signing key rotation service logger.
+	loggerComponentName = "KeyRotationService"
+
+	// signingKeysPath is the base path of the signing key management API.
+	signingKeysPath = "/signing-keys"
+
+	// rsaKeySize is the size in bits of generated RSA signing keys.
+	rsaKeySize = 2048
+
+	// certificateValidityMargin extends the validity of the self-signed certificate of a generated key beyond
+	// its expected lifetime so that the certificate does not expire before the key is revoked.
+	certificateValidityMargin = 30 * 24 * time.Hour
+
+	// certificateCommonName is the subject common name of the self-signed certificates of generated keys.
+	certificateCommonName = "Thunder Token Signing Key"
+
+	// pemTypePrivateKey is the PEM block type of PKCS #8 encoded private keys.
+	pemTypePrivateKey = "PRIVATE KEY"
+
+	// pemTypeCertificate is the PEM block type of X.509 certificates.
+	pemTypeCertificate = "CERTIFICATE"
+)
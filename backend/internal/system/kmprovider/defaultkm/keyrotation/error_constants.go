@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package keyrotation
+
+import (
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/i18n/core"
+)
+
+var (
+	// ErrorKeyRotationDisabled is returned when signing keys are managed while rotation is disabled.
+	ErrorKeyRotationDisabled = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SKM-1001",
+		Error: core.I18nMessage{
+			Key:          "error.keyrotationservice.key_rotation_disabled",
+			DefaultValue: "Key rotation disabled",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.keyrotationservice.key_rotation_disabled_description",
+			DefaultValue: "Signing key rotation is not enabled in the server configuration",
+		},
+	}
+
+	// ErrorSigningKeyNotFound is returned when the requested signing key does not exist.
+	ErrorSigningKeyNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SKM-1002",
+		Error: core.I18nMessage{
+			Key:          "error.keyrotationservice.signing_key_not_found",
+			DefaultValue: "Signing key not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.keyrotationservice.signing_key_not_found_description",
+			DefaultValue: "The requested signing key was not found",
+		},
+	}
+
+	// ErrorCannotRevokeActiveKey is returned when revoking the key that signs new tokens.
+	ErrorCannotRevokeActiveKey = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SKM-1003",
+		Error: core.I18nMessage{
+			Key:          "error.keyrotationservice.cannot_revoke_active_key",
+			DefaultValue: "Cannot revoke active key",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.keyrotationservice.cannot_revoke_active_key_description",
+			DefaultValue: "The active signing key must be rotated before it can be revoked",
+		},
+	}
+)
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package keyrotation
+
+import (
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/system/error/apierror"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/log"
+	sysutils "github.com/asgardeo/thunder/internal/system/utils"
+)
+
+const handlerLoggerComponentName = "KeyRotationHandler"
+
+// keyRotationHandler is the handler for signing key management operations.
+type keyRotationHandler struct {
+	keyRotationService KeyRotationServiceInterface
+	logger             *log.Logger
+}
+
+// newKeyRotationHandler creates a new instance of keyRotationHandler.
+func newKeyRotationHandler(keyRotationService KeyRotationServiceInterface) *keyRotationHandler {
+	return &keyRotationHandler{
+		keyRotationService: keyRotationService,
+		logger:             log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName)),
+	}
+}
+
+// HandleSigningKeyListRequest handles the list signing keys request.
+func (kh *keyRotationHandler) HandleSigningKeyListRequest(w http.ResponseWriter, r *http.Request) {
+	keyList, svcErr := kh.keyRotationService.GetSigningKeys(r.Context())
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, keyList)
+}
+
+// HandleRotateRequest handles the manual signing key rotation request. The rotation is accepted rather than
+// completed while the new key is still pending publication.
+func (kh *keyRotationHandler) HandleRotateRequest(w http.ResponseWriter, r *http.Request) {
+	key, svcErr := kh.keyRotationService.RotateSigningKey(r.Context())
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+	if key.State == KeyStatePending {
+		sysutils.WriteSuccessResponse(w, http.StatusAccepted, key)
+		kh.logger.Debug("Signing key rotation is pending publication of the new key", log.String("id", key.ID))
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, key)
+	kh.logger.Debug("Successfully rotated the signing key", log.String("id", key.ID))
+}
+
+// HandleRevokeRequest handles the signing key revocation request.
+func (kh *keyRotationHandler) HandleRevokeRequest(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if svcErr := kh.keyRotationService.RevokeSigningKey(r.Context(), id); svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+	kh.logger.Debug("Successfully revoked the signing key", log.String("id", id))
+}
+
+// handleError handles service errors and returns appropriate HTTP responses.
+func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	switch {
+	case svcErr.Code == ErrorSigningKeyNotFound.Code:
+		statusCode = http.StatusNotFound
+	case svcErr.Type == serviceerror.ClientErrorType:
+		statusCode = http.StatusBadRequest
+	}
+
+	sysutils.WriteErrorResponse(w, statusCode, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package keyrotation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/asgardeo/thunder/internal/system/error/apierror"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+)
+
+func TestHandleSigningKeyListRequest(t *testing.T) {
+	mockSvc := NewKeyRotationServiceInterfaceMock(t)
+	mockSvc.On("GetSigningKeys", mock.Anything).Return(&SigningKeyList{Keys: []SigningKey{
+		{ID: "key-1", Kid: "kid-1", Algorithm: "RSA", State: KeyStateActive},
+	}}, nil)
+
+	rr := httptest.NewRecorder()
+	newKeyRotationHandler(mockSvc).HandleSigningKeyListRequest(rr,
+		httptest.NewRequest(http.MethodGet, signingKeysPath, nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var response SigningKeyList
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+	require.Len(t, response.Keys, 1)
+	require.Equal(t, KeyStateActive, response.Keys[0].State)
+}
+
+func TestHandleSigningKeyListRequest_Disabled(t *testing.T) {
+	mockSvc := NewKeyRotationServiceInterfaceMock(t)
+	mockSvc.On("GetSigningKeys", mock.Anything).Return(nil, &ErrorKeyRotationDisabled)
+
+	rr := httptest.NewRecorder()
+	newKeyRotationHandler(mockSvc).HandleSigningKeyListRequest(rr,
+		httptest.NewRequest(http.MethodGet, signingKeysPath, nil))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&errResp))
+	require.Equal(t, ErrorKeyRotationDisabled.Code, errResp.Code)
+}
+
+func TestHandleRotateRequest(t *testing.T) {
+	mockSvc := NewKeyRotationServiceInterfaceMock(t)
+	mockSvc.On("RotateSigningKey", mock.Anything).Return(&SigningKey{ID: "key-2", State: KeyStateActive}, nil)
+
+	rr := httptest.NewRecorder()
+	newKeyRotationHandler(mockSvc).HandleRotateRequest(rr,
+		httptest.NewRequest(http.MethodPost, signingKeysPath+"/rotate", nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var response SigningKey
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+	require.Equal(t, "key-2", response.ID)
+}
+
+func TestHandleRotateRequest_Pending(t *testing.T) {
+	mockSvc := NewKeyRotationServiceInterfaceMock(t)
+	mockSvc.On("RotateSigningKey", mock.Anything).Return(&SigningKey{ID: "key-2", State: KeyStatePending}, nil)
+
+	rr := httptest.NewRecorder()
+	newKeyRotationHandler(mockSvc).HandleRotateRequest(rr,
+		httptest.NewRequest(http.MethodPost, signingKeysPath+"/rotate", nil))
+
+	require.Equal(t, http.StatusAccepted, rr.Code)
+	var response SigningKey
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+	require.Equal(t, KeyStatePending, response.State)
+}
+
+func TestHandleRotateRequest_ServerError(t *testing.T) {
+	mockSvc := NewKeyRotationServiceInterfaceMock(t)
+	mockSvc.On("RotateSigningKey", mock.Anything).Return(nil, &serviceerror.InternalServerError)
+
+	rr := httptest.NewRecorder()
+	newKeyRotationHandler(mockSvc).HandleRotateRequest(rr,
+		httptest.NewRequest(http.MethodPost, signingKeysPath+"/rotate", nil))
+
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+}
+
+func TestHandleRevokeRequest(t *testing.T) {
+	testCases := []struct {
+		name           string
+		svcErr         *serviceerror.ServiceError
+		expectedStatus int
+	}{
+		{"Success", nil, http.StatusNoContent},
+		{"NotFound", &ErrorSigningKeyNotFound, http.StatusNotFound},
+		{"ActiveKey", &ErrorCannotRevokeActiveKey, http.StatusBadRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockSvc := NewKeyRotationServiceInterfaceMock(t)
+			mockSvc.On("RevokeSigningKey", mock.Anything, "key-1").Return(tc.svcErr)
+
+			req := httptest.NewRequest(http.MethodPost, signingKeysPath+"/key-1/revoke", nil)
+			req.SetPathValue("id", "key-1")
+			rr := httptest.NewRecorder()
+			newKeyRotationHandler(mockSvc).HandleRevokeRequest(rr, req)
+
+			require.Equal(t, tc.expectedStatus, rr.Code)
+		})
+	}
+}
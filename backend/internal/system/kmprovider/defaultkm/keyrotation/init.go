@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package keyrotation
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/kmprovider"
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/pkiservice"
+	"github.com/asgardeo/thunder/internal/system/middleware"
+)
+
+// Initialize initializes the signing key rotation service and registers its routes. When rotation is
+// enabled, an active signing key is ensured before the scheduled rotation is started.
+func Initialize(
+	mux *http.ServeMux,
+	pkiService pkiservice.PKIServiceInterface,
+	cryptoProvider kmprovider.ConfigCryptoProvider,
+) (KeyRotationServiceInterface, error) {
+	store, transactioner, err := newSigningKeyStore()
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := newKeyRotationService(config.GetServerRuntime().Config.JWT.KeyRotation, store, transactioner,
+		pkiService, cryptoProvider)
+	if err != nil {
+		return nil, err
+	}
+	if service.enabled {
+		if err := service.reconcile(context.Background()); err != nil {
+			return nil, err
+		}
+		service.start()
+	}
+	registerRoutes(mux, newKeyRotationHandler(service))
+
+	return service, nil
+}
+
+// registerRoutes registers the routes for signing key management operations.
+func registerRoutes(mux *http.ServeMux, handler *keyRotationHandler) {
+	getOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	postOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	routes := []struct {
+		path    string
+		method  string
+		handler http.HandlerFunc
+		opts    middleware.CORSOptions
+	}{
+		{signingKeysPath, http.MethodGet, handler.HandleSigningKeyListRequest, getOpts},
+		{signingKeysPath + "/rotate", http.MethodPost, handler.HandleRotateRequest, postOpts},
+		{signingKeysPath + "/{id}/revoke", http.MethodPost, handler.HandleRevokeRequest, postOpts},
+	}
+	for _, route := range routes {
+		mux.HandleFunc(middleware.WithCORS(route.method+" "+route.path, route.handler, route.opts))
+		mux.HandleFunc(middleware.WithCORS("OPTIONS "+route.path, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, route.opts))
+	}
+}
@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package keyrotation
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/pkiservice"
+)
+
+// isSupportedAlgorithm checks whether signing keys can be generated for the given algorithm.
+func isSupportedAlgorithm(algorithm pkiservice.PKIAlgorithm) bool {
+	switch algorithm {
+	case pkiservice.RSA, pkiservice.P256, pkiservice.P384, pkiservice.P521, pkiservice.Ed25519:
+		return true
+	default:
+		return false
+	}
+}
+
+// generateKeyPair generates a private key of the given algorithm along with a self-signed certificate
+// that is valid for the given period. The key and certificate are returned PEM encoded.
+func generateKeyPair(algorithm pkiservice.PKIAlgorithm, keyID string, validity time.Duration) (
+	privateKeyPEM []byte, certificatePEM []byte, err error) {
+	var privateKey crypto.Signer
+	switch algorithm {
+	case pkiservice.RSA:
+		privateKey, err = rsa.GenerateKey(rand.Reader, rsaKeySize)
+	case pkiservice.P256:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case pkiservice.P384:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case pkiservice.P521:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	case pkiservice.Ed25519:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, nil, errors.New("unsupported signing key algorithm: " + string(algorithm))
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			CommonName:         certificateCommonName,
+			OrganizationalUnit: []string{keyID},
+		},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+	certificateDER, err := x509.CreateCertificate(rand.Reader, template, template, privateKey.Public(), privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	privateKeyDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypePrivateKey, Bytes: privateKeyDER}),
+		pem.EncodeToMemory(&pem.Block{Type: pemTypeCertificate, Bytes: certificateDER}), nil
+}
+
+// parseKeyPair parses a PEM encoded private key and certificate into a PKI entity.
+func parseKeyPair(
+	keyID string, algorithm pkiservice.PKIAlgorithm, privateKeyPEM, certificatePEM []byte,
+) (pkiservice.PKI, error) {
+	tlsCert, err := tls.X509KeyPair(certificatePEM, privateKeyPEM)
+	if err != nil {
+		return pkiservice.PKI{}, err
+	}
+	parsedCert, err := x509.ParseCertificate(tlsCert.Certificate[0])
+	if err != nil {
+		return pkiservice.PKI{}, err
+	}
+
+	return pkiservice.PKI{
+		ID:          keyID,
+		Algorithm:   algorithm,
+		PrivateKey:  tlsCert.PrivateKey,
+		Certificate: tlsCert,
+		ThumbPrint:  hash.GenerateThumbprint(parsedCert.Raw),
+	}, nil
+}
+
+// getCertificateThumbprint computes the thumbprint of a PEM encoded certificate, which is used as the
+// kid of the key.
+func getCertificateThumbprint(certificatePEM string) string {
+	block, _ := pem.Decode([]byte(certificatePEM))
+	if block == nil || block.Type != pemTypeCertificate {
+		return ""
+	}
+	return hash.GenerateThumbprint(block.Bytes)
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package keyrotation
+
+import (
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/pkiservice"
+)
+
+// KeyState represents the lifecycle state of a managed signing key.
+type KeyState string
+
+const (
+	// KeyStatePending is the state of a key that is published but not yet used for signing.
+	KeyStatePending KeyState = "pending"
+	// KeyStateActive is the state of the key that signs new tokens.
+	KeyStateActive KeyState = "active"
+	// KeyStateRetiring is the state of a replaced key that is published until the tokens it signed expire.
+	KeyStateRetiring KeyState = "retiring"
+	// KeyStateRevoked is the state of a key that is no longer published or usable.
+	KeyStateRevoked KeyState = "revoked"
+)
+
+// SigningKey represents the metadata of a managed signing key.
+type SigningKey struct {
+	ID          string   `json:"id"`
+	Kid         string   `json:"kid,omitempty"`
+	Algorithm   string   `json:"algorithm"`
+	State       KeyState `json:"state"`
+	CreatedAt   string   `json:"createdAt"`
+	ActivatedAt string   `json:"activatedAt,omitempty"`
+	RetiredAt   string   `json:"retiredAt,omitempty"`
+	RevokedAt   string   `json:"revokedAt,omitempty"`
+}
+
+// SigningKeyList represents the managed signing keys.
+type SigningKeyList struct {
+	Keys []SigningKey `json:"keys"`
+}
+
+// signingKeyRecord represents a managed signing key as persisted in the store. The private key is
+// encrypted with the server encryption key and both key and certificate are PEM encoded.
+type signingKeyRecord struct {
+	ID          string
+	Algorithm   pkiservice.PKIAlgorithm
+	State       KeyState
+	PrivateKey  string
+	Certificate string
+	CreatedAt   time.Time
+	ActivatedAt time.Time
+	RetiredAt   time.Time
+	RevokedAt   time.Time
+}
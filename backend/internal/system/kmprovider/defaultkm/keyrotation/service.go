@@ -0,0 +1,487 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package keyrotation manages the lifecycle of the token signing keys generated by the server. Keys move
+// from pending to active to retiring to revoked on a schedule, and the keys that are not revoked are
+// registered with the PKI service so that they are published in the JWKS and resolvable for signing.
+package keyrotation
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/kmprovider"
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/pkiservice"
+	"github.com/asgardeo/thunder/internal/system/log"
+	"github.com/asgardeo/thunder/internal/system/transaction"
+	"github.com/asgardeo/thunder/internal/system/utils"
+)
+
+// KeyRotationServiceInterface defines the interface for managing the lifecycle of token signing keys.
+type KeyRotationServiceInterface interface {
+	GetSigningKeys(ctx context.Context) (*SigningKeyList, *serviceerror.ServiceError)
+	RotateSigningKey(ctx context.Context) (*SigningKey, *serviceerror.ServiceError)
+	RevokeSigningKey(ctx context.Context, keyID string) *serviceerror.ServiceError
+	Shutdown()
+}
+
+// keyRotationService is the default implementation of KeyRotationServiceInterface.
+type keyRotationService struct {
+	enabled          bool
+	algorithm        pkiservice.PKIAlgorithm
+	rotationPeriod   time.Duration
+	prePublishPeriod time.Duration
+	retirementPeriod time.Duration
+	checkInterval    time.Duration
+	store            signingKeyStoreInterface
+	transactioner    transaction.Transactioner
+	pkiService       pkiservice.PKIServiceInterface
+	cryptoProvider   kmprovider.ConfigCryptoProvider
+	mu               sync.Mutex
+	stopCh           chan struct{}
+	stopOnce         sync.Once
+	logger           *log.Logger
+}
+
+// newKeyRotationService creates a new instance of keyRotationService from the key rotation configuration.
+func newKeyRotationService(
+	cfg config.KeyRotationConfig,
+	store signingKeyStoreInterface,
+	transactioner transaction.Transactioner,
+	pkiService pkiservice.PKIServiceInterface,
+	cryptoProvider kmprovider.ConfigCryptoProvider,
+) (*keyRotationService, error) {
+	service := &keyRotationService{
+		enabled:          cfg.Enabled,
+		algorithm:        pkiservice.PKIAlgorithm(cfg.Algorithm),
+		rotationPeriod:   time.Duration(cfg.RotationPeriod) * time.Second,
+		prePublishPeriod: time.Duration(cfg.PrePublishPeriod) * time.Second,
+		retirementPeriod: time.Duration(cfg.RetirementPeriod) * time.Second,
+		checkInterval:    time.Duration(cfg.CheckInterval) * time.Second,
+		store:            store,
+		transactioner:    transactioner,
+		pkiService:       pkiService,
+		cryptoProvider:   cryptoProvider,
+		stopCh:           make(chan struct{}),
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+	if !service.enabled {
+		return service, nil
+	}
+
+	if !isSupportedAlgorithm(service.algorithm) {
+		return nil, errors.New("unsupported signing key algorithm: " + cfg.Algorithm)
+	}
+	if service.rotationPeriod <= 0 {
+		return nil, errors.New("signing key rotation period must be positive")
+	}
+	if service.prePublishPeriod < 0 || service.prePublishPeriod >= service.rotationPeriod {
+		return nil, errors.New("signing key pre-publish period must be shorter than the rotation period")
+	}
+	if service.retirementPeriod < 0 {
+		return nil, errors.New("signing key retirement period must not be negative")
+	}
+	if service.checkInterval <= 0 {
+		return nil, errors.New("signing key rotation check interval must be positive")
+	}
+	if pkiService == nil || cryptoProvider == nil {
+		return nil, errors.New("signing key rotation requires the PKI service and the encryption service")
+	}
+	return service, nil
+}
+
+// GetSigningKeys returns the managed signing keys in all states.
+func (s *keyRotationService) GetSigningKeys(ctx context.Context) (*SigningKeyList, *serviceerror.ServiceError) {
+	if !s.enabled {
+		return nil, &ErrorKeyRotationDisabled
+	}
+
+	records, err := s.store.GetSigningKeys(ctx)
+	if err != nil {
+		s.logger.Error("Failed to retrieve signing keys", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	keys := make([]SigningKey, 0, len(records))
+	for _, record := range records {
+		keys = append(keys, toSigningKey(record))
+	}
+	return &SigningKeyList{Keys: keys}, nil
+}
+
+// RotateSigningKey replaces the active signing key with the pending key, which is only activated once all
+// server nodes have published it. When there is no such key yet, a pending key is generated if there is
+// none and returned in the pending state, to be activated by a later rotation or on schedule. The replaced
+// key is retired.
+func (s *keyRotationService) RotateSigningKey(ctx context.Context) (*SigningKey, *serviceerror.ServiceError) {
+	if !s.enabled {
+		return nil, &ErrorKeyRotationDisabled
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	var rotated *signingKeyRecord
+	err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		records, err := s.store.GetSigningKeys(txCtx)
+		if err != nil {
+			return err
+		}
+
+		pending := findKeyByState(records, KeyStatePending)
+		if pending == nil {
+			if rotated, err = s.createKey(txCtx, KeyStatePending, now); err != nil || rotated != nil {
+				return err
+			}
+			// Another server node generated the pending key concurrently.
+			if records, err = s.store.GetSigningKeys(txCtx); err != nil {
+				return err
+			}
+			if rotated = findKeyByState(records, KeyStatePending); rotated == nil {
+				return errors.New("pending signing key not found after it was generated by another server node")
+			}
+			return nil
+		}
+
+		rotated = pending
+		if !s.isPublished(pending, 0, now) {
+			return nil
+		}
+		if active := findKeyByState(records, KeyStateActive); active != nil {
+			if err := s.transitionKey(txCtx, active, KeyStateRetiring, now); err != nil {
+				return err
+			}
+		}
+		return s.transitionKey(txCtx, pending, KeyStateActive, now)
+	})
+	if err != nil {
+		s.logger.Error("Failed to rotate the signing key", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	if err := s.publishKeys(ctx); err != nil {
+		s.logger.Error("Failed to publish signing keys", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	if rotated.State == KeyStateActive {
+		s.logger.Info("Rotated the signing key", log.String("keyID", rotated.ID))
+	} else {
+		s.logger.Info("Signing key rotation is waiting for the pending key to be published",
+			log.String("keyID", rotated.ID))
+	}
+	key := toSigningKey(*rotated)
+	return &key, nil
+}
+
+// RevokeSigningKey revokes a pending or retiring signing key immediately. The key is no longer published
+// and tokens signed with it fail verification. The active key must be rotated before it can be revoked.
+func (s *keyRotationService) RevokeSigningKey(ctx context.Context, keyID string) *serviceerror.ServiceError {
+	if !s.enabled {
+		return &ErrorKeyRotationDisabled
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var svcErr *serviceerror.ServiceError
+	err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		records, err := s.store.GetSigningKeys(txCtx)
+		if err != nil {
+			return err
+		}
+
+		key := findKeyByID(records, keyID)
+		switch {
+		case key == nil:
+			svcErr = &ErrorSigningKeyNotFound
+			return nil
+		case key.State == KeyStateActive:
+			svcErr = &ErrorCannotRevokeActiveKey
+			return nil
+		case key.State == KeyStateRevoked:
+			return nil
+		}
+		return s.transitionKey(txCtx, key, KeyStateRevoked, time.Now().UTC())
+	})
+	if err != nil {
+		s.logger.Error("Failed to revoke the signing key", log.String("keyID", keyID), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if svcErr != nil {
+		return svcErr
+	}
+
+	if err := s.publishKeys(ctx); err != nil {
+		s.logger.Error("Failed to publish signing keys", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	s.logger.Info("Revoked the signing key", log.String("keyID", keyID))
+	return nil
+}
+
+// Shutdown stops the scheduled rotation of signing keys.
+func (s *keyRotationService) Shutdown() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+}
+
+// start runs the scheduled rotation of signing keys in the background until the service is shut down.
+func (s *keyRotationService) start() {
+	go func() {
+		ticker := time.NewTicker(s.checkInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := s.reconcile(context.Background()); err != nil {
+					s.logger.Error("Failed to run the scheduled signing key rotation", log.Error(err))
+				}
+			case <-s.stopCh:
+				return
+			}
+		}
+	}()
+
+	s.logger.Debug("Signing key rotation scheduler started", log.Any("interval", s.checkInterval))
+}
+
+// reconcile applies the scheduled transitions that are due and publishes the resulting keys. A key is
+// generated and activated when there is no active key, a pending key is generated ahead of the end of
+// the rotation period of the active key, the pending key replaces the active key once it has been
+// published for the pre-publish period, and retiring keys are revoked after the retirement period.
+// Transitions are conditional on the stored state, and at most one key can be stored in the active or
+// pending state, so that server nodes sharing the database do not apply the same change twice.
+func (s *keyRotationService) reconcile(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		records, err := s.store.GetSigningKeys(txCtx)
+		if err != nil {
+			return err
+		}
+
+		active := findKeyByState(records, KeyStateActive)
+		pending := findKeyByState(records, KeyStatePending)
+		switch {
+		case active == nil && pending != nil:
+			if err := s.transitionKey(txCtx, pending, KeyStateActive, now); err != nil {
+				return err
+			}
+		case active == nil:
+			if _, err := s.createKey(txCtx, KeyStateActive, now); err != nil {
+				return err
+			}
+		case pending == nil:
+			if !now.Before(active.ActivatedAt.Add(s.rotationPeriod - s.prePublishPeriod)) {
+				if _, err := s.createKey(txCtx, KeyStatePending, now); err != nil {
+					return err
+				}
+			}
+		case s.isPublished(pending, s.prePublishPeriod, now):
+			if err := s.transitionKey(txCtx, active, KeyStateRetiring, now); err != nil {
+				return err
+			}
+			if err := s.transitionKey(txCtx, pending, KeyStateActive, now); err != nil {
+				return err
+			}
+		}
+
+		for i := range records {
+			if records[i].State == KeyStateRetiring && !now.Before(records[i].RetiredAt.Add(s.retirementPeriod)) {
+				if err := s.transitionKey(txCtx, &records[i], KeyStateRevoked, now); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.publishKeys(ctx)
+}
+
+// createKey generates a signing key and stores it in the given state with its private key encrypted.
+// Returns nil if another server node has already stored a key in the state.
+func (s *keyRotationService) createKey(
+	ctx context.Context, state KeyState, now time.Time,
+) (*signingKeyRecord, error) {
+	keyID, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return nil, err
+	}
+
+	validity := s.prePublishPeriod + s.rotationPeriod + s.retirementPeriod + certificateValidityMargin
+	privateKeyPEM, certificatePEM, err := generateKeyPair(s.algorithm, keyID, validity)
+	if err != nil {
+		return nil, err
+	}
+	encryptedKey, err := s.cryptoProvider.Encrypt(ctx, privateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &signingKeyRecord{
+		ID:          keyID,
+		Algorithm:   s.algorithm,
+		State:       state,
+		PrivateKey:  string(encryptedKey),
+		Certificate: string(certificatePEM),
+		CreatedAt:   now,
+	}
+	if state == KeyStateActive {
+		record.ActivatedAt = now
+	}
+	created, err := s.store.CreateSigningKey(ctx, *record)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		s.logger.Debug("Signing key already generated by another server node", log.String("state", string(state)))
+		return nil, nil
+	}
+
+	s.logger.Info("Generated a signing key", log.String("keyID", keyID), log.String("state", string(state)))
+	return record, nil
+}
+
+// transitionKey moves a signing key to a new state. A key already moved by another server node is left
+// as it is.
+func (s *keyRotationService) transitionKey(
+	ctx context.Context, key *signingKeyRecord, to KeyState, now time.Time,
+) error {
+	updated, err := s.store.UpdateSigningKeyState(ctx, key.ID, key.State, to, now)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		s.logger.Debug("Signing key state already changed", log.String("keyID", key.ID))
+		return nil
+	}
+
+	key.State = to
+	switch to {
+	case KeyStateActive:
+		key.ActivatedAt = now
+	case KeyStateRetiring:
+		key.RetiredAt = now
+	case KeyStateRevoked:
+		key.RevokedAt = now
+	}
+	s.logger.Info("Signing key state changed", log.String("keyID", key.ID), log.String("state", string(to)))
+	return nil
+}
+
+// isPublished reports whether a pending key has been published for the given period. Server nodes load
+// new keys on their next scheduled check, so a key only counts as published by all of them once the check
+// interval has passed since it was generated.
+func (s *keyRotationService) isPublished(key *signingKeyRecord, period time.Duration, now time.Time) bool {
+	return !now.Before(key.CreatedAt.Add(max(period, s.checkInterval)))
+}
+
+// publishKeys registers the signing keys that are not revoked with the PKI service along with the active key.
+// Keys whose material cannot be loaded are skipped.
+func (s *keyRotationService) publishKeys(ctx context.Context) error {
+	records, err := s.store.GetSigningKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]pkiservice.PKI, 0, len(records))
+	activeKeyID := ""
+	for _, record := range records {
+		if record.State == KeyStateRevoked {
+			continue
+		}
+		key, err := s.loadKey(ctx, record)
+		if err != nil {
+			s.logger.Error("Failed to load the signing key", log.String("keyID", record.ID), log.Error(err))
+			continue
+		}
+		keys = append(keys, key)
+		if record.State == KeyStateActive && activeKeyID == "" {
+			activeKeyID = record.ID
+		}
+	}
+
+	s.pkiService.SetManagedKeys(keys, activeKeyID)
+	return nil
+}
+
+// loadKey decrypts the private key of a stored signing key and parses its key material.
+func (s *keyRotationService) loadKey(ctx context.Context, record signingKeyRecord) (pkiservice.PKI, error) {
+	privateKeyPEM, err := s.cryptoProvider.Decrypt(ctx, []byte(record.PrivateKey))
+	if err != nil {
+		return pkiservice.PKI{}, err
+	}
+	return parseKeyPair(record.ID, record.Algorithm, privateKeyPEM, []byte(record.Certificate))
+}
+
+// findKeyByState returns the earliest created signing key in the given state.
+func findKeyByState(records []signingKeyRecord, state KeyState) *signingKeyRecord {
+	for i := range records {
+		if records[i].State == state {
+			return &records[i]
+		}
+	}
+	return nil
+}
+
+// findKeyByID returns the signing key with the given ID.
+func findKeyByID(records []signingKeyRecord, keyID string) *signingKeyRecord {
+	for i := range records {
+		if records[i].ID == keyID {
+			return &records[i]
+		}
+	}
+	return nil
+}
+
+// toSigningKey converts a stored signing key to its metadata.
+func toSigningKey(record signingKeyRecord) SigningKey {
+	return SigningKey{
+		ID:          record.ID,
+		Kid:         getCertificateThumbprint(record.Certificate),
+		Algorithm:   string(record.Algorithm),
+		State:       record.State,
+		CreatedAt:   formatTime(record.CreatedAt),
+		ActivatedAt: formatTime(record.ActivatedAt),
+		RetiredAt:   formatTime(record.RetiredAt),
+		RevokedAt:   formatTime(record.RevokedAt),
+	}
+}
+
+// formatTime formats a timestamp in RFC 3339 format, returning an empty string for the zero time.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
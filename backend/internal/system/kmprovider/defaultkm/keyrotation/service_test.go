@@ -0,0 +1,493 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package keyrotation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/pkiservice"
+	"github.com/asgardeo/thunder/tests/mocks/crypto/cryptomock"
+	"github.com/asgardeo/thunder/tests/mocks/crypto/pki/pkimock"
+)
+
+var testKeyRotationConfig = config.KeyRotationConfig{
+	Enabled:          true,
+	Algorithm:        string(pkiservice.P256),
+	RotationPeriod:   int64((30 * 24 * time.Hour).Seconds()),
+	PrePublishPeriod: int64((24 * time.Hour).Seconds()),
+	RetirementPeriod: int64((7 * 24 * time.Hour).Seconds()),
+	CheckInterval:    60,
+}
+
+// fakeTransactioner is a light-weight test double to capture transaction usage.
+type fakeTransactioner struct {
+	transactCalls int
+	err           error
+}
+
+func (f *fakeTransactioner) Transact(ctx context.Context, txFunc func(context.Context) error) error {
+	f.transactCalls++
+	if f.err != nil {
+		return f.err
+	}
+	return txFunc(ctx)
+}
+
+type KeyRotationServiceTestSuite struct {
+	suite.Suite
+	mockStore      *signingKeyStoreInterfaceMock
+	mockPKI        *pkimock.PKIServiceInterfaceMock
+	mockCrypto     *cryptomock.ConfigCryptoProviderMock
+	transactioner  *fakeTransactioner
+	service        *keyRotationService
+	createdRecords []signingKeyRecord
+}
+
+func TestKeyRotationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(KeyRotationServiceTestSuite))
+}
+
+func (suite *KeyRotationServiceTestSuite) SetupTest() {
+	suite.mockStore = newSigningKeyStoreInterfaceMock(suite.T())
+	suite.mockPKI = pkimock.NewPKIServiceInterfaceMock(suite.T())
+	suite.mockCrypto = cryptomock.NewConfigCryptoProviderMock(suite.T())
+	suite.transactioner = &fakeTransactioner{}
+	suite.createdRecords = nil
+
+	// The test encryption service passes the key material through unchanged.
+	passThrough := func(_ context.Context, content []byte) ([]byte, error) {
+		return content, nil
+	}
+	suite.mockCrypto.EXPECT().Encrypt(mock.Anything, mock.Anything).RunAndReturn(passThrough).Maybe()
+	suite.mockCrypto.EXPECT().Decrypt(mock.Anything, mock.Anything).RunAndReturn(passThrough).Maybe()
+
+	service, err := newKeyRotationService(testKeyRotationConfig, suite.mockStore, suite.transactioner,
+		suite.mockPKI, suite.mockCrypto)
+	suite.Require().NoError(err)
+	suite.service = service
+}
+
+// newTestRecord creates a stored signing key with real key material.
+func (suite *KeyRotationServiceTestSuite) newTestRecord(
+	id string, state KeyState, createdAt time.Time,
+) signingKeyRecord {
+	privateKeyPEM, certificatePEM, err := generateKeyPair(pkiservice.P256, id, time.Hour)
+	suite.Require().NoError(err)
+	return signingKeyRecord{
+		ID:          id,
+		Algorithm:   pkiservice.P256,
+		State:       state,
+		PrivateKey:  string(privateKeyPEM),
+		Certificate: string(certificatePEM),
+		CreatedAt:   createdAt,
+	}
+}
+
+// expectCreate captures the signing keys created through the store.
+func (suite *KeyRotationServiceTestSuite) expectCreate(state KeyState) {
+	suite.mockStore.EXPECT().CreateSigningKey(mock.Anything, mock.MatchedBy(func(key signingKeyRecord) bool {
+		return key.State == state
+	})).RunAndReturn(func(_ context.Context, key signingKeyRecord) (bool, error) {
+		suite.createdRecords = append(suite.createdRecords, key)
+		return true, nil
+	}).Once()
+}
+
+// expectPublish expects the given number of keys to be published with the given active key.
+func (suite *KeyRotationServiceTestSuite) expectPublish(keyCount int, activeKeyID string) {
+	suite.mockPKI.EXPECT().SetManagedKeys(mock.MatchedBy(func(keys []pkiservice.PKI) bool {
+		return len(keys) == keyCount
+	}), activeKeyID).Return().Once()
+}
+
+func (suite *KeyRotationServiceTestSuite) TestNewKeyRotationService_InvalidConfig() {
+	testCases := []struct {
+		name   string
+		modify func(cfg *config.KeyRotationConfig)
+	}{
+		{"UnsupportedAlgorithm", func(cfg *config.KeyRotationConfig) { cfg.Algorithm = "HS256" }},
+		{"ZeroRotationPeriod", func(cfg *config.KeyRotationConfig) { cfg.RotationPeriod = 0 }},
+		{"PrePublishNotShorterThanRotation", func(cfg *config.KeyRotationConfig) {
+			cfg.PrePublishPeriod = cfg.RotationPeriod
+		}},
+		{"NegativeRetirementPeriod", func(cfg *config.KeyRotationConfig) { cfg.RetirementPeriod = -1 }},
+		{"ZeroCheckInterval", func(cfg *config.KeyRotationConfig) { cfg.CheckInterval = 0 }},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			cfg := testKeyRotationConfig
+			tc.modify(&cfg)
+			service, err := newKeyRotationService(cfg, suite.mockStore, suite.transactioner, suite.mockPKI,
+				suite.mockCrypto)
+			suite.Error(err)
+			suite.Nil(service)
+		})
+	}
+}
+
+func (suite *KeyRotationServiceTestSuite) TestDisabled() {
+	service, err := newKeyRotationService(config.KeyRotationConfig{}, suite.mockStore, suite.transactioner,
+		nil, nil)
+	suite.Require().NoError(err)
+
+	keys, svcErr := service.GetSigningKeys(context.Background())
+	suite.Nil(keys)
+	suite.Equal(ErrorKeyRotationDisabled.Code, svcErr.Code)
+
+	key, svcErr := service.RotateSigningKey(context.Background())
+	suite.Nil(key)
+	suite.Equal(ErrorKeyRotationDisabled.Code, svcErr.Code)
+
+	svcErr = service.RevokeSigningKey(context.Background(), "key-1")
+	suite.Equal(ErrorKeyRotationDisabled.Code, svcErr.Code)
+}
+
+func (suite *KeyRotationServiceTestSuite) TestReconcile_GeneratesActiveKeyWhenNoneExists() {
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).Return([]signingKeyRecord{}, nil).Once()
+	suite.expectCreate(KeyStateActive)
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).RunAndReturn(
+		func(_ context.Context) ([]signingKeyRecord, error) {
+			return suite.createdRecords, nil
+		}).Once()
+	suite.mockPKI.EXPECT().SetManagedKeys(mock.Anything, mock.Anything).
+		Run(func(keys []pkiservice.PKI, activeSigningKeyID string) {
+			suite.Len(keys, 1)
+			suite.Equal(suite.createdRecords[0].ID, activeSigningKeyID)
+			suite.Equal(pkiservice.P256, keys[0].Algorithm)
+			suite.Equal(getCertificateThumbprint(suite.createdRecords[0].Certificate), keys[0].ThumbPrint)
+		}).Return().Once()
+
+	err := suite.service.reconcile(context.Background())
+
+	suite.NoError(err)
+	suite.Require().Len(suite.createdRecords, 1)
+	suite.False(suite.createdRecords[0].ActivatedAt.IsZero())
+	suite.Equal(1, suite.transactioner.transactCalls)
+}
+
+func (suite *KeyRotationServiceTestSuite) TestReconcile_KeyGeneratedByAnotherNode() {
+	active := suite.newTestRecord("key-1", KeyStateActive, time.Now())
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).Return([]signingKeyRecord{}, nil).Once()
+	suite.mockStore.EXPECT().CreateSigningKey(mock.Anything, mock.Anything).Return(false, nil).Once()
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).Return([]signingKeyRecord{active}, nil).Once()
+	suite.expectPublish(1, "key-1")
+
+	suite.NoError(suite.service.reconcile(context.Background()))
+}
+
+func (suite *KeyRotationServiceTestSuite) TestReconcile_ActivatesPendingKeyWhenNoActiveKey() {
+	pending := suite.newTestRecord("key-2", KeyStatePending, time.Now())
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).Return([]signingKeyRecord{pending}, nil).Once()
+	suite.mockStore.EXPECT().UpdateSigningKeyState(mock.Anything, "key-2", KeyStatePending, KeyStateActive,
+		mock.Anything).Return(true, nil).Once()
+	pending.State = KeyStateActive
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).Return([]signingKeyRecord{pending}, nil).Once()
+	suite.expectPublish(1, "key-2")
+
+	suite.NoError(suite.service.reconcile(context.Background()))
+}
+
+func (suite *KeyRotationServiceTestSuite) TestReconcile_PrePublishesKeyBeforeRotationIsDue() {
+	active := suite.newTestRecord("key-1", KeyStateActive, time.Now().Add(-30*24*time.Hour))
+	active.ActivatedAt = time.Now().Add(-29*24*time.Hour - time.Minute)
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).Return([]signingKeyRecord{active}, nil).Once()
+	suite.expectCreate(KeyStatePending)
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).RunAndReturn(
+		func(_ context.Context) ([]signingKeyRecord, error) {
+			return append([]signingKeyRecord{active}, suite.createdRecords...), nil
+		}).Once()
+	suite.expectPublish(2, "key-1")
+
+	suite.NoError(suite.service.reconcile(context.Background()))
+	suite.Require().Len(suite.createdRecords, 1)
+	suite.True(suite.createdRecords[0].ActivatedAt.IsZero())
+}
+
+func (suite *KeyRotationServiceTestSuite) TestReconcile_ActivatesPendingKeyAfterPrePublishPeriod() {
+	active := suite.newTestRecord("key-1", KeyStateActive, time.Now().Add(-31*24*time.Hour))
+	active.ActivatedAt = time.Now().Add(-30 * 24 * time.Hour)
+	pending := suite.newTestRecord("key-2", KeyStatePending, time.Now().Add(-25*time.Hour))
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).
+		Return([]signingKeyRecord{active, pending}, nil).Once()
+	suite.mockStore.EXPECT().UpdateSigningKeyState(mock.Anything, "key-1", KeyStateActive, KeyStateRetiring,
+		mock.Anything).Return(true, nil).Once()
+	suite.mockStore.EXPECT().UpdateSigningKeyState(mock.Anything, "key-2", KeyStatePending, KeyStateActive,
+		mock.Anything).Return(true, nil).Once()
+	active.State = KeyStateRetiring
+	pending.State = KeyStateActive
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).
+		Return([]signingKeyRecord{active, pending}, nil).Once()
+	suite.expectPublish(2, "key-2")
+
+	suite.NoError(suite.service.reconcile(context.Background()))
+}
+
+func (suite *KeyRotationServiceTestSuite) TestReconcile_KeepsPendingKeyDuringPrePublishPeriod() {
+	active := suite.newTestRecord("key-1", KeyStateActive, time.Now().Add(-31*24*time.Hour))
+	active.ActivatedAt = time.Now().Add(-30 * 24 * time.Hour)
+	pending := suite.newTestRecord("key-2", KeyStatePending, time.Now().Add(-time.Hour))
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).
+		Return([]signingKeyRecord{active, pending}, nil).Twice()
+	suite.expectPublish(2, "key-1")
+
+	suite.NoError(suite.service.reconcile(context.Background()))
+	suite.mockStore.AssertNotCalled(suite.T(), "UpdateSigningKeyState", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *KeyRotationServiceTestSuite) TestReconcile_RevokesRetiringKeyAfterRetirementPeriod() {
+	active := suite.newTestRecord("key-2", KeyStateActive, time.Now().Add(-9*24*time.Hour))
+	active.ActivatedAt = time.Now().Add(-8 * 24 * time.Hour)
+	retiring := suite.newTestRecord("key-1", KeyStateRetiring, time.Now().Add(-40*24*time.Hour))
+	retiring.RetiredAt = time.Now().Add(-8 * 24 * time.Hour)
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).
+		Return([]signingKeyRecord{retiring, active}, nil).Once()
+	suite.mockStore.EXPECT().UpdateSigningKeyState(mock.Anything, "key-1", KeyStateRetiring, KeyStateRevoked,
+		mock.Anything).Return(true, nil).Once()
+	retiring.State = KeyStateRevoked
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).
+		Return([]signingKeyRecord{retiring, active}, nil).Once()
+	suite.expectPublish(1, "key-2")
+
+	suite.NoError(suite.service.reconcile(context.Background()))
+}
+
+func (suite *KeyRotationServiceTestSuite) TestReconcile_StoreError() {
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).Return(nil, errors.New("db error")).Once()
+
+	suite.Error(suite.service.reconcile(context.Background()))
+	suite.mockPKI.AssertNotCalled(suite.T(), "SetManagedKeys", mock.Anything, mock.Anything)
+}
+
+func (suite *KeyRotationServiceTestSuite) TestPublishKeys_SkipsKeysThatCannotBeLoaded() {
+	active := suite.newTestRecord("key-1", KeyStateActive, time.Now())
+	corrupt := suite.newTestRecord("key-2", KeyStateRetiring, time.Now())
+	corrupt.PrivateKey = "corrupt"
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).Return([]signingKeyRecord{active, corrupt}, nil).Once()
+	suite.expectPublish(1, "key-1")
+
+	suite.NoError(suite.service.publishKeys(context.Background()))
+}
+
+func (suite *KeyRotationServiceTestSuite) TestRotateSigningKey_ActivatesPendingKey() {
+	active := suite.newTestRecord("key-1", KeyStateActive, time.Now().Add(-time.Hour))
+	active.ActivatedAt = time.Now().Add(-time.Hour)
+	pending := suite.newTestRecord("key-2", KeyStatePending, time.Now().Add(-2*time.Minute))
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).
+		Return([]signingKeyRecord{active, pending}, nil).Once()
+	suite.mockStore.EXPECT().UpdateSigningKeyState(mock.Anything, "key-1", KeyStateActive, KeyStateRetiring,
+		mock.Anything).Return(true, nil).Once()
+	suite.mockStore.EXPECT().UpdateSigningKeyState(mock.Anything, "key-2", KeyStatePending, KeyStateActive,
+		mock.Anything).Return(true, nil).Once()
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).
+		Return([]signingKeyRecord{active, pending}, nil).Once()
+	suite.mockPKI.EXPECT().SetManagedKeys(mock.Anything, mock.Anything).Return().Once()
+
+	key, svcErr := suite.service.RotateSigningKey(context.Background())
+
+	suite.Nil(svcErr)
+	suite.Require().NotNil(key)
+	suite.Equal("key-2", key.ID)
+	suite.Equal(KeyStateActive, key.State)
+	suite.NotEmpty(key.ActivatedAt)
+	suite.Equal(getCertificateThumbprint(pending.Certificate), key.Kid)
+}
+
+func (suite *KeyRotationServiceTestSuite) TestRotateSigningKey_KeepsUnpublishedPendingKey() {
+	active := suite.newTestRecord("key-1", KeyStateActive, time.Now().Add(-time.Hour))
+	pending := suite.newTestRecord("key-2", KeyStatePending, time.Now())
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).
+		Return([]signingKeyRecord{active, pending}, nil).Twice()
+	suite.expectPublish(2, "key-1")
+
+	key, svcErr := suite.service.RotateSigningKey(context.Background())
+
+	suite.Nil(svcErr)
+	suite.Equal("key-2", key.ID)
+	suite.Equal(KeyStatePending, key.State)
+	suite.mockStore.AssertNotCalled(suite.T(), "UpdateSigningKeyState", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *KeyRotationServiceTestSuite) TestRotateSigningKey_GeneratesPendingKeyWhenNoPendingKey() {
+	active := suite.newTestRecord("key-1", KeyStateActive, time.Now().Add(-time.Hour))
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).Return([]signingKeyRecord{active}, nil).Once()
+	suite.expectCreate(KeyStatePending)
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).RunAndReturn(
+		func(_ context.Context) ([]signingKeyRecord, error) {
+			return append([]signingKeyRecord{active}, suite.createdRecords...), nil
+		}).Once()
+	suite.expectPublish(2, "key-1")
+
+	key, svcErr := suite.service.RotateSigningKey(context.Background())
+
+	suite.Nil(svcErr)
+	suite.Require().Len(suite.createdRecords, 1)
+	suite.Equal(suite.createdRecords[0].ID, key.ID)
+	suite.Equal(KeyStatePending, key.State)
+	suite.Equal(string(pkiservice.P256), key.Algorithm)
+	suite.mockStore.AssertNotCalled(suite.T(), "UpdateSigningKeyState", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *KeyRotationServiceTestSuite) TestRotateSigningKey_PendingKeyGeneratedByAnotherNode() {
+	active := suite.newTestRecord("key-1", KeyStateActive, time.Now().Add(-time.Hour))
+	pending := suite.newTestRecord("key-2", KeyStatePending, time.Now())
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).Return([]signingKeyRecord{active}, nil).Once()
+	suite.mockStore.EXPECT().CreateSigningKey(mock.Anything, mock.Anything).Return(false, nil).Once()
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).
+		Return([]signingKeyRecord{active, pending}, nil).Twice()
+	suite.expectPublish(2, "key-1")
+
+	key, svcErr := suite.service.RotateSigningKey(context.Background())
+
+	suite.Nil(svcErr)
+	suite.Equal("key-2", key.ID)
+	suite.Equal(KeyStatePending, key.State)
+}
+
+func (suite *KeyRotationServiceTestSuite) TestRotateSigningKey_StoreError() {
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).Return(nil, errors.New("db error")).Once()
+
+	key, svcErr := suite.service.RotateSigningKey(context.Background())
+
+	suite.Nil(key)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *KeyRotationServiceTestSuite) TestRevokeSigningKey() {
+	active := suite.newTestRecord("key-1", KeyStateActive, time.Now())
+	retiring := suite.newTestRecord("key-2", KeyStateRetiring, time.Now())
+	revoked := suite.newTestRecord("key-3", KeyStateRevoked, time.Now())
+	records := []signingKeyRecord{active, retiring, revoked}
+
+	testCases := []struct {
+		name        string
+		keyID       string
+		setup       func()
+		expectedErr *serviceerror.ServiceError
+	}{
+		{
+			name:        "NotFound",
+			keyID:       "unknown",
+			expectedErr: &ErrorSigningKeyNotFound,
+		},
+		{
+			name:        "ActiveKey",
+			keyID:       "key-1",
+			expectedErr: &ErrorCannotRevokeActiveKey,
+		},
+		{
+			name:  "AlreadyRevoked",
+			keyID: "key-3",
+			setup: func() {
+				suite.mockPKI.EXPECT().SetManagedKeys(mock.Anything, "key-1").Return().Once()
+			},
+		},
+		{
+			name:  "RetiringKey",
+			keyID: "key-2",
+			setup: func() {
+				suite.mockStore.EXPECT().UpdateSigningKeyState(mock.Anything, "key-2", KeyStateRetiring,
+					KeyStateRevoked, mock.Anything).Return(true, nil).Once()
+				suite.mockPKI.EXPECT().SetManagedKeys(mock.Anything, "key-1").Return().Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).
+				RunAndReturn(func(_ context.Context) ([]signingKeyRecord, error) {
+					return append([]signingKeyRecord(nil), records...), nil
+				}).Maybe()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			svcErr := suite.service.RevokeSigningKey(context.Background(), tc.keyID)
+
+			if tc.expectedErr != nil {
+				suite.Require().NotNil(svcErr)
+				suite.Equal(tc.expectedErr.Code, svcErr.Code)
+			} else {
+				suite.Nil(svcErr)
+			}
+		})
+	}
+}
+
+func (suite *KeyRotationServiceTestSuite) TestGetSigningKeys() {
+	retiring := suite.newTestRecord("key-1", KeyStateRetiring, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	retiring.ActivatedAt = time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	retiring.RetiredAt = time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC)
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).Return([]signingKeyRecord{retiring}, nil).Once()
+
+	keys, svcErr := suite.service.GetSigningKeys(context.Background())
+
+	suite.Nil(svcErr)
+	suite.Require().Len(keys.Keys, 1)
+	suite.Equal(SigningKey{
+		ID:          "key-1",
+		Kid:         getCertificateThumbprint(retiring.Certificate),
+		Algorithm:   string(pkiservice.P256),
+		State:       KeyStateRetiring,
+		CreatedAt:   "2026-01-01T00:00:00Z",
+		ActivatedAt: "2026-01-02T00:00:00Z",
+		RetiredAt:   "2026-04-02T00:00:00Z",
+	}, keys.Keys[0])
+}
+
+func (suite *KeyRotationServiceTestSuite) TestGetSigningKeys_StoreError() {
+	suite.mockStore.EXPECT().GetSigningKeys(mock.Anything).Return(nil, errors.New("db error")).Once()
+
+	keys, svcErr := suite.service.GetSigningKeys(context.Background())
+
+	suite.Nil(keys)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *KeyRotationServiceTestSuite) TestGenerateKeyPair_AllAlgorithms() {
+	for _, algorithm := range []pkiservice.PKIAlgorithm{
+		pkiservice.RSA, pkiservice.P256, pkiservice.P384, pkiservice.P521, pkiservice.Ed25519,
+	} {
+		suite.Run(string(algorithm), func() {
+			privateKeyPEM, certificatePEM, err := generateKeyPair(algorithm, "key-1", time.Hour)
+			suite.Require().NoError(err)
+
+			key, err := parseKeyPair("key-1", algorithm, privateKeyPEM, certificatePEM)
+			suite.Require().NoError(err)
+			suite.Equal("key-1", key.ID)
+			suite.NotNil(key.PrivateKey)
+			suite.Equal(getCertificateThumbprint(string(certificatePEM)), key.ThumbPrint)
+		})
+	}
+
+	_, _, err := generateKeyPair("HS256", "key-1", time.Hour)
+	suite.Error(err)
+}
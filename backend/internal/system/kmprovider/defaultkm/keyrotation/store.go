@@ -0,0 +1,192 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package keyrotation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/database/model"
+	"github.com/asgardeo/thunder/internal/system/database/provider"
+	dbutils "github.com/asgardeo/thunder/internal/system/database/utils"
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/pkiservice"
+	"github.com/asgardeo/thunder/internal/system/transaction"
+)
+
+// signingKeyStoreInterface defines the interface for managed signing key store operations.
+type signingKeyStoreInterface interface {
+	CreateSigningKey(ctx context.Context, key signingKeyRecord) (bool, error)
+	GetSigningKeys(ctx context.Context) ([]signingKeyRecord, error)
+	UpdateSigningKeyState(ctx context.Context, keyID string, from, to KeyState, at time.Time) (bool, error)
+}
+
+// signingKeyStore is the database backed implementation of signingKeyStoreInterface.
+type signingKeyStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newSigningKeyStore creates a new instance of signingKeyStore along with the transactioner of the config
+// database.
+func newSigningKeyStore() (signingKeyStoreInterface, transaction.Transactioner, error) {
+	dbProvider := provider.GetDBProvider()
+	client, err := dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	transactioner, err := client.GetTransactioner()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &signingKeyStore{
+		dbProvider:   dbProvider,
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}, transactioner, nil
+}
+
+// CreateSigningKey creates a managed signing key. It returns false when a key is already stored in the
+// active or pending state of the new key, for example because another server node created it first.
+func (s *signingKeyStore) CreateSigningKey(ctx context.Context, key signingKeyRecord) (bool, error) {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return false, err
+	}
+
+	var activatedAt interface{}
+	if !key.ActivatedAt.IsZero() {
+		activatedAt = key.ActivatedAt.UTC()
+	}
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryCreateSigningKey, key.ID, string(key.Algorithm),
+		string(key.State), key.PrivateKey, key.Certificate, key.CreatedAt.UTC(), activatedAt, s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// GetSigningKeys retrieves all managed signing keys ordered by their creation time.
+func (s *signingKeyStore) GetSigningKeys(ctx context.Context) ([]signingKeyRecord, error) {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetSigningKeys, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	keys := make([]signingKeyRecord, 0, len(results))
+	for _, row := range results {
+		key, err := buildSigningKeyFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// UpdateSigningKeyState moves a signing key to a new state if it is still in the expected state. It
+// returns false when the key is not in the expected state, for example because another server node
+// already moved it.
+func (s *signingKeyStore) UpdateSigningKeyState(
+	ctx context.Context, keyID string, from, to KeyState, at time.Time,
+) (bool, error) {
+	var query model.DBQuery
+	switch to {
+	case KeyStateActive:
+		query = queryActivateSigningKey
+	case KeyStateRetiring:
+		query = queryRetireSigningKey
+	case KeyStateRevoked:
+		query = queryRevokeSigningKey
+	default:
+		return false, fmt.Errorf("unsupported signing key state transition to %s", to)
+	}
+
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, query, at.UTC(), keyID, string(from), s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// getConfigDBClient retrieves the config database client.
+func (s *signingKeyStore) getConfigDBClient() (provider.DBClientInterface, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config database client: %w", err)
+	}
+	return dbClient, nil
+}
+
+// buildSigningKeyFromResultRow builds a signingKeyRecord from a database result row.
+func buildSigningKeyFromResultRow(row map[string]interface{}) (signingKeyRecord, error) {
+	keyID, ok := row["key_id"].(string)
+	if !ok {
+		return signingKeyRecord{}, fmt.Errorf("key_id not found or invalid type")
+	}
+	algorithm, ok := row["algorithm"].(string)
+	if !ok {
+		return signingKeyRecord{}, fmt.Errorf("algorithm not found or invalid type")
+	}
+	state, ok := row["state"].(string)
+	if !ok {
+		return signingKeyRecord{}, fmt.Errorf("state not found or invalid type")
+	}
+	privateKey, ok := row["private_key"].(string)
+	if !ok {
+		return signingKeyRecord{}, fmt.Errorf("private_key not found or invalid type")
+	}
+	certificate, ok := row["certificate"].(string)
+	if !ok {
+		return signingKeyRecord{}, fmt.Errorf("certificate not found or invalid type")
+	}
+
+	key := signingKeyRecord{
+		ID:          keyID,
+		Algorithm:   pkiservice.PKIAlgorithm(algorithm),
+		State:       KeyState(state),
+		PrivateKey:  privateKey,
+		Certificate: certificate,
+	}
+
+	var err error
+	if key.CreatedAt, err = dbutils.ParseNullableTimeField(row["created_at"], "created_at"); err != nil {
+		return signingKeyRecord{}, err
+	}
+	if key.ActivatedAt, err = dbutils.ParseNullableTimeField(row["activated_at"], "activated_at"); err != nil {
+		return signingKeyRecord{}, err
+	}
+	if key.RetiredAt, err = dbutils.ParseNullableTimeField(row["retired_at"], "retired_at"); err != nil {
+		return signingKeyRecord{}, err
+	}
+	if key.RevokedAt, err = dbutils.ParseNullableTimeField(row["revoked_at"], "revoked_at"); err != nil {
+		return signingKeyRecord{}, err
+	}
+	return key, nil
+}
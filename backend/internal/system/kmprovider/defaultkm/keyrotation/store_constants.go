@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package keyrotation
+
+import dbmodel "github.com/asgardeo/thunder/internal/system/database/model"
+
+var (
+	// queryCreateSigningKey creates a managed signing key. Nothing is inserted when the unique index on the
+	// active and pending states already holds a key in the state of the new key.
+	queryCreateSigningKey = dbmodel.DBQuery{
+		ID: "SKQ-KR-01",
+		Query: `INSERT INTO "SIGNING_KEY" (KEY_ID, ALGORITHM, STATE, PRIVATE_KEY, CERTIFICATE, CREATED_AT, ` +
+			`ACTIVATED_AT, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT DO NOTHING`,
+	}
+
+	// queryGetSigningKeys retrieves all managed signing keys.
+	queryGetSigningKeys = dbmodel.DBQuery{
+		ID: "SKQ-KR-02",
+		Query: `SELECT KEY_ID, ALGORITHM, STATE, PRIVATE_KEY, CERTIFICATE, CREATED_AT, ACTIVATED_AT, RETIRED_AT, ` +
+			`REVOKED_AT FROM "SIGNING_KEY" WHERE DEPLOYMENT_ID = $1 ORDER BY CREATED_AT`,
+	}
+
+	// queryActivateSigningKey moves a signing key from the given state to the active state.
+	queryActivateSigningKey = dbmodel.DBQuery{
+		ID: "SKQ-KR-03",
+		Query: `UPDATE "SIGNING_KEY" SET STATE = 'active', ACTIVATED_AT = $1 ` +
+			`WHERE KEY_ID = $2 AND STATE = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryRetireSigningKey moves a signing key from the given state to the retiring state.
+	queryRetireSigningKey = dbmodel.DBQuery{
+		ID: "SKQ-KR-04",
+		Query: `UPDATE "SIGNING_KEY" SET STATE = 'retiring', RETIRED_AT = $1 ` +
+			`WHERE KEY_ID = $2 AND STATE = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryRevokeSigningKey moves a signing key from the given state to the revoked state and discards
+	// its private key.
+	queryRevokeSigningKey = dbmodel.DBQuery{
+		ID: "SKQ-KR-05",
+		Query: `UPDATE "SIGNING_KEY" SET STATE = 'revoked', REVOKED_AT = $1, PRIVATE_KEY = '' ` +
+			`WHERE KEY_ID = $2 AND STATE = $3 AND DEPLOYMENT_ID = $4`,
+	}
+)
@@ -22,3 +22,10 @@ package pkiservice
 func Initialize() (PKIServiceInterface, error) {
 	return newPKIService()
 }
+
+// InitializeWithKeys initializes the PKI service with key/certificate pairs loaded by a key manager provider,
+// such as keys held in a hardware security module. The private key of each pair may be an opaque
+// crypto.Signer. The algorithm and thumbprint of each pair are derived from its key and certificate.
+func InitializeWithKeys(keys []PKI) (PKIServiceInterface, error) {
+	return newPKIServiceWithKeys(keys)
+}
@@ -17,7 +17,8 @@
  */
 
 // Package pkiservice loads PEM key/certificate pairs from configuration and provides
-// key material lookup by ID for the default key manager.
+// key material lookup by ID for the default key manager. Keys generated at runtime by the signing key
+// rotation lifecycle are registered alongside the configured keys as managed keys.
 package pkiservice
 
 import (
@@ -31,6 +32,7 @@ import (
 	"os"
 	"path"
 	"slices"
+	"sync"
 
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
@@ -46,12 +48,17 @@ type PKIServiceInterface interface {
 	GetX509Certificate(id string) (*x509.Certificate, *serviceerror.ServiceError)
 	GetAllX509Certificates() (map[string]*x509.Certificate, *serviceerror.ServiceError)
 	GetSupportedSigningAlgorithms() []string
+	GetActiveSigningKeyID() string
+	SetManagedKeys(keys []PKI, activeSigningKeyID string)
 }
 
 // pkiService stores loaded certificates indexed by their ID.
 type pkiService struct {
-	certificates map[string]PKI
-	logger       *log.Logger
+	certificates       map[string]PKI
+	managedKeys        map[string]PKI
+	activeSigningKeyID string
+	mu                 sync.RWMutex
+	logger             *log.Logger
 }
 
 // newPKIService initializes and returns the PKI service, loading all key/cert pairs from config.
@@ -99,19 +106,77 @@ func newPKIService() (PKIServiceInterface, error) {
 		}
 	}
 
+	return buildPKIService(certificates)
+}
+
+// newPKIServiceWithKeys initializes and returns the PKI service with the given key/cert pairs.
+func newPKIServiceWithKeys(keys []PKI) (PKIServiceInterface, error) {
+	certificates := make(map[string]PKI)
+	for _, key := range keys {
+		if key.ID == "" {
+			return nil, errors.New("key has empty ID")
+		}
+		if len(key.Certificate.Certificate) == 0 {
+			return nil, errors.New("certificate not found for key " + key.ID)
+		}
+
+		algorithm, err := getAlgorithmFromKey(key.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		thumbprint, err := getThumbprint(key.Certificate)
+		if err != nil {
+			return nil, err
+		}
+		key.Algorithm = algorithm
+		key.ThumbPrint = thumbprint
+		certificates[key.ID] = key
+	}
+
+	return buildPKIService(certificates)
+}
+
+// buildPKIService creates the PKI service for the loaded key/cert pairs.
+func buildPKIService(certificates map[string]PKI) (PKIServiceInterface, error) {
 	if len(certificates) == 0 {
 		return nil, errors.New("no certificates loaded in PKI service")
 	}
 
 	return &pkiService{
 		certificates: certificates,
+		managedKeys:  make(map[string]PKI),
 		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "PKIService")),
 	}, nil
 }
 
+// getKey retrieves the configured or managed key associated with the given ID.
+func (s *pkiService) getKey(id string) (PKI, bool) {
+	if cert, exists := s.certificates[id]; exists {
+		return cert, true
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	cert, exists := s.managedKeys[id]
+	return cert, exists
+}
+
+// getAllKeys returns the configured and managed keys indexed by their ID.
+func (s *pkiService) getAllKeys() map[string]PKI {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := make(map[string]PKI, len(s.certificates)+len(s.managedKeys))
+	for id, cert := range s.managedKeys {
+		keys[id] = cert
+	}
+	for id, cert := range s.certificates {
+		keys[id] = cert
+	}
+	return keys
+}
+
 // GetPrivateKey retrieves the private key associated with the given ID.
 func (s *pkiService) GetPrivateKey(id string) (crypto.PrivateKey, *serviceerror.ServiceError) {
-	cert, exists := s.certificates[id]
+	cert, exists := s.getKey(id)
 	if !exists || cert.PrivateKey == nil {
 		s.logger.Error("Private key not found for certificate ID: " + id)
 		return nil, &serviceerror.InternalServerError
@@ -121,7 +186,7 @@ func (s *pkiService) GetPrivateKey(id string) (crypto.PrivateKey, *serviceerror.
 
 // GetCertThumbprint retrieves the thumbprint of the certificate associated with the given ID.
 func (s *pkiService) GetCertThumbprint(id string) string {
-	cert, exists := s.certificates[id]
+	cert, exists := s.getKey(id)
 	if !exists {
 		return ""
 	}
@@ -130,7 +195,7 @@ func (s *pkiService) GetCertThumbprint(id string) string {
 
 // GetX509Certificate retrieves the x509 certificate associated with the given ID.
 func (s *pkiService) GetX509Certificate(id string) (*x509.Certificate, *serviceerror.ServiceError) {
-	cert, exists := s.certificates[id]
+	cert, exists := s.getKey(id)
 	if !exists {
 		s.logger.Error("Certificate not found for certificate ID: " + id)
 		return nil, &serviceerror.InternalServerError
@@ -150,7 +215,7 @@ func (s *pkiService) GetX509Certificate(id string) (*x509.Certificate, *servicee
 // GetAllX509Certificates retrieves all x509 certificates as a map indexed by their ID.
 func (s *pkiService) GetAllX509Certificates() (map[string]*x509.Certificate, *serviceerror.ServiceError) {
 	result := make(map[string]*x509.Certificate)
-	for id, cert := range s.certificates {
+	for id, cert := range s.getAllKeys() {
 		if len(cert.Certificate.Certificate) == 0 {
 			s.logger.Error("Certificate data is empty for certificate ID: " + id)
 			return nil, &serviceerror.InternalServerError
@@ -169,7 +234,7 @@ func (s *pkiService) GetAllX509Certificates() (map[string]*x509.Certificate, *se
 // supported across all configured keys.
 func (s *pkiService) GetSupportedSigningAlgorithms() []string {
 	var result []string
-	for _, cert := range s.certificates {
+	for _, cert := range s.getAllKeys() {
 		for _, alg := range pkiAlgorithmToJWSAlgorithms(cert.Algorithm) {
 			if !slices.Contains(result, alg) {
 				result = append(result, alg)
@@ -179,6 +244,28 @@ func (s *pkiService) GetSupportedSigningAlgorithms() []string {
 	return result
 }
 
+// GetActiveSigningKeyID returns the ID of the managed key that is active for signing tokens, or an
+// empty string when signing keys are not managed.
+func (s *pkiService) GetActiveSigningKeyID() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.activeSigningKeyID
+}
+
+// SetManagedKeys replaces the managed keys and the active signing key. Managed keys are published and
+// resolvable by ID in the same way as the configured keys.
+func (s *pkiService) SetManagedKeys(keys []PKI, activeSigningKeyID string) {
+	managedKeys := make(map[string]PKI, len(keys))
+	for _, key := range keys {
+		managedKeys[key.ID] = key
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.managedKeys = managedKeys
+	s.activeSigningKeyID = activeSigningKeyID
+}
+
 // pkiAlgorithmToJWSAlgorithms returns the JWS algorithm strings supported for the given PKI algorithm.
 func pkiAlgorithmToJWSAlgorithms(alg PKIAlgorithm) []string {
 	switch alg {
@@ -197,12 +284,18 @@ func pkiAlgorithmToJWSAlgorithms(alg PKIAlgorithm) []string {
 	}
 }
 
-// getAlgorithmFromKey determines the PKIAlgorithm based on the type of the private key.
+// getAlgorithmFromKey determines the PKIAlgorithm based on the type of the public key of the private key.
+// Keys held outside the process are supported through their crypto.Signer implementation.
 func getAlgorithmFromKey(key crypto.PrivateKey) (PKIAlgorithm, error) {
-	switch k := key.(type) {
-	case *rsa.PrivateKey:
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return "", errors.New("unsupported key type")
+	}
+
+	switch k := signer.Public().(type) {
+	case *rsa.PublicKey:
 		return RSA, nil
-	case *ecdsa.PrivateKey:
+	case *ecdsa.PublicKey:
 		crvName := k.Curve.Params().Name
 		switch crvName {
 		case "P-256":
@@ -214,7 +307,7 @@ func getAlgorithmFromKey(key crypto.PrivateKey) (PKIAlgorithm, error) {
 		default:
 			return "", errors.New("unsupported ECDSA curve: " + crvName)
 		}
-	case ed25519.PrivateKey:
+	case ed25519.PublicKey:
 		return Ed25519, nil
 	default:
 		return "", errors.New("unsupported key type")
@@ -61,8 +61,16 @@ func LoadTLSConfig(cfg *config.Config, certFilePath string, keyFilePath string)
 		log.String("keyFile", keyFilePath))
 
 	// #nosec G402 -- Min TLS version is TLS 1.2 or higher based on config
-	return &tls.Config{
+	tlsConfig := &tls.Config{
 		Certificates: []tls.Certificate{cert},
 		MinVersion:   http.GetTLSVersion(*cfg),
-	}, nil
+	}
+
+	// Mutual-TLS clients may present self-signed certificates, so the certificate is only requested
+	// here and validated during OAuth client authentication.
+	if cfg.OAuth.MTLS.Enabled {
+		tlsConfig.ClientAuth = tls.RequestClientCert
+	}
+
+	return tlsConfig, nil
 }
@@ -18,4 +18,91 @@
 
 package kmprovider
 
-// TODO: Define the key manager registry and provider selection interface.
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/pkiservice"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+// DefaultProviderName is the name of the key manager provider used when no provider is configured.
+const DefaultProviderName = "default"
+
+// KeyManager holds the services of a key manager provider.
+type KeyManager struct {
+	PKIService    pkiservice.PKIServiceInterface
+	RuntimeCrypto RuntimeCryptoProvider
+	ConfigCrypto  ConfigCryptoProvider
+}
+
+// KeyManagerFactory is a function that creates the services of a key manager provider.
+// Factories are registered during package initialization (init()) and called
+// later during service initialization when configuration is available.
+type KeyManagerFactory func() (*KeyManager, error)
+
+// factoryRegistry holds all registered key manager factories.
+var (
+	factoryRegistry = make(map[string]KeyManagerFactory)
+	registryMu      sync.RWMutex
+)
+
+// RegisterKeyManagerFactory registers a key manager factory in the global registry.
+// This should be called from each provider's init() function.
+func RegisterKeyManagerFactory(name string, factory KeyManagerFactory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, exists := factoryRegistry[name]; exists {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "KeyManagerRegistry"))
+		logger.Warn("Key manager factory already registered, replacing", log.String("provider", name))
+	}
+
+	factoryRegistry[name] = factory
+}
+
+// GetKeyManagerFactory returns the factory for a specific key manager provider.
+// Returns nil if the provider is not registered.
+func GetKeyManagerFactory(name string) KeyManagerFactory {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	return factoryRegistry[name]
+}
+
+// GetRegisteredNames returns the sorted names of all registered key manager providers.
+func GetRegisteredNames() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	names := make([]string, 0, len(factoryRegistry))
+	for name := range factoryRegistry {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// InitializeKeyManager creates the key manager of the provider selected in crypto.key_manager.provider.
+// The default provider is used when no provider is configured.
+func InitializeKeyManager() (*KeyManager, error) {
+	name := config.GetServerRuntime().Config.Crypto.KeyManager.Provider
+	if name == "" {
+		name = DefaultProviderName
+	}
+
+	factory := GetKeyManagerFactory(name)
+	if factory == nil {
+		return nil, fmt.Errorf("key manager provider %q is not registered, available providers: %v",
+			name, GetRegisteredNames())
+	}
+
+	keyManager, err := factory()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize key manager provider %q: %w", name, err)
+	}
+	return keyManager, nil
+}
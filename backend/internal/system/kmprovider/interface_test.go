@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kmprovider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+)
+
+func TestInitializeKeyManager(t *testing.T) {
+	t.Cleanup(config.ResetServerRuntime)
+
+	expected := &KeyManager{}
+	RegisterKeyManagerFactory("test-success", func() (*KeyManager, error) { return expected, nil })
+	RegisterKeyManagerFactory("test-failure", func() (*KeyManager, error) { return nil, errors.New("token offline") })
+	assert.Subset(t, GetRegisteredNames(), []string{"test-failure", "test-success"})
+
+	testCases := []struct {
+		name     string
+		provider string
+		wantErr  string
+	}{
+		{"RegisteredProvider", "test-success", ""},
+		{"FactoryFailure", "test-failure", "failed to initialize key manager provider \"test-failure\": token offline"},
+		{"UnknownProvider", "unknown", "key manager provider \"unknown\" is not registered"},
+		{"DefaultProviderNotRegistered", "", "key manager provider \"default\" is not registered"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config.ResetServerRuntime()
+			err := config.InitializeServerRuntime("", &config.Config{
+				Crypto: config.CryptoConfig{KeyManager: config.KeyManagerConfig{Provider: tc.provider}},
+			})
+			require.NoError(t, err)
+
+			keyManager, err := InitializeKeyManager()
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Same(t, expected, keyManager)
+		})
+	}
+}
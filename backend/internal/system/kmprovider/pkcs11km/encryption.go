@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package pkcs11km
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/asgardeo/thunder/internal/system/kmprovider"
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm"
+)
+
+// encryptionService encrypts configuration data with an AES-GCM key held in the token. The encrypted
+// data uses the same format as the default key manager, with the key label as the key ID.
+type encryptionService struct {
+	aead  cipher.AEAD
+	keyID string
+}
+
+func newEncryptionService(aead cipher.AEAD, keyID string) kmprovider.ConfigCryptoProvider {
+	return &encryptionService{
+		aead:  aead,
+		keyID: keyID,
+	}
+}
+
+func (es *encryptionService) Encrypt(_ context.Context, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, es.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	ciphertext, err := seal(es.aead, nonce, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	encData := defaultkm.EncryptedData{
+		Algorithm:  defaultkm.AESGCM,
+		Ciphertext: base64.StdEncoding.EncodeToString(ciphertext),
+		KeyID:      es.keyID,
+	}
+	jsonData, err := json.Marshal(encData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize encrypted data: %w", err)
+	}
+	return jsonData, nil
+}
+
+func (es *encryptionService) Decrypt(_ context.Context, encodedData []byte) ([]byte, error) {
+	var encData defaultkm.EncryptedData
+	if err := json.Unmarshal(encodedData, &encData); err != nil {
+		return nil, fmt.Errorf("invalid data format: %w", err)
+	}
+	if encData.Algorithm != defaultkm.AESGCM {
+		return nil, fmt.Errorf("unsupported algorithm: %s", encData.Algorithm)
+	}
+	if encData.KeyID != es.keyID {
+		return nil, errors.New("decryption key not found for kid")
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(encData.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payload encoding: %w", err)
+	}
+	nonceSize := es.aead.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, errors.New("ciphertext too short")
+	}
+	return es.aead.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], nil)
+}
+
+// seal encrypts the plaintext and prepends the nonce. The AEAD of a PKCS#11 key panics when the token
+// fails to encrypt, so the panic is recovered and returned as an error.
+func seal(aead cipher.AEAD, nonce, plaintext []byte) (ciphertext []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to encrypt with the token key: %v", r)
+		}
+	}()
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package pkcs11km
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm"
+)
+
+// panickingAEAD mimics the AEAD of a PKCS#11 key, which panics when the token fails to encrypt.
+type panickingAEAD struct {
+	cipher.AEAD
+}
+
+func (a panickingAEAD) Seal(_, _, _, _ []byte) []byte {
+	panic("token failure")
+}
+
+func newTestAEAD(t *testing.T) cipher.AEAD {
+	block, err := aes.NewCipher(make([]byte, 32))
+	require.NoError(t, err)
+	aead, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	return aead
+}
+
+func TestEncryptionService_EncryptDecrypt(t *testing.T) {
+	svc := newEncryptionService(newTestAEAD(t), "enc-key")
+
+	encrypted, err := svc.Encrypt(context.Background(), []byte("secret"))
+	require.NoError(t, err)
+
+	var encData defaultkm.EncryptedData
+	require.NoError(t, json.Unmarshal(encrypted, &encData))
+	assert.Equal(t, defaultkm.AESGCM, encData.Algorithm)
+	assert.Equal(t, "enc-key", encData.KeyID)
+
+	decrypted, err := svc.Decrypt(context.Background(), encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), decrypted)
+}
+
+func TestEncryptionService_DecryptErrors(t *testing.T) {
+	svc := newEncryptionService(newTestAEAD(t), "enc-key")
+
+	testCases := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{"InvalidFormat", "not json", "invalid data format"},
+		{"UnsupportedAlgorithm", `{"alg":"DES","ct":"","kid":"enc-key"}`, "unsupported algorithm"},
+		{"UnknownKeyID", `{"alg":"AES-GCM","ct":"","kid":"other"}`, "decryption key not found"},
+		{"InvalidEncoding", `{"alg":"AES-GCM","ct":"%%%","kid":"enc-key"}`, "invalid payload encoding"},
+		{"ShortCiphertext", `{"alg":"AES-GCM","ct":"AAAA","kid":"enc-key"}`, "ciphertext too short"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svc.Decrypt(context.Background(), []byte(tc.data))
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestEncryptionService_EncryptRecoversTokenPanic(t *testing.T) {
+	svc := newEncryptionService(panickingAEAD{newTestAEAD(t)}, "enc-key")
+
+	_, err := svc.Encrypt(context.Background(), []byte("secret"))
+	assert.ErrorContains(t, err, "failed to encrypt with the token key: token failure")
+}
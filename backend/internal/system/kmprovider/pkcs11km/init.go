@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package pkcs11km provides a key manager implementation backed by keys held in a PKCS#11 token,
+// such as a hardware security module.
+package pkcs11km
+
+import (
+	"crypto"
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/kmprovider"
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm"
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/pkiservice"
+)
+
+// ProviderName is the name of the PKCS#11 key manager provider.
+const ProviderName = "pkcs11"
+
+// openToken opens the configured PKCS#11 token. It is a variable to allow tests to substitute the token.
+var openToken = openPKCS11Token
+
+// init registers the PKCS#11 key manager factory with the key manager registry.
+func init() {
+	kmprovider.RegisterKeyManagerFactory(ProviderName, newKeyManager)
+}
+
+// newKeyManager creates the PKCS#11 key manager from the token configured in crypto.key_manager.pkcs11.
+func newKeyManager() (*kmprovider.KeyManager, error) {
+	cfg := config.GetServerRuntime().Config
+	pkcs11Cfg := cfg.Crypto.KeyManager.PKCS11
+	if err := validateConfig(pkcs11Cfg); err != nil {
+		return nil, err
+	}
+	if cfg.JWT.KeyRotation.Enabled {
+		return nil, errors.New("signing key rotation is not supported with keys held in a PKCS#11 token")
+	}
+
+	tkn, err := openToken(pkcs11Cfg)
+	if err != nil {
+		return nil, err
+	}
+	return newKeyManagerWithToken(tkn, pkcs11Cfg)
+}
+
+// newKeyManagerWithToken creates the key manager services from the key pairs and the encryption key
+// held in the token. The config crypto service falls back to crypto.encryption.key when no encryption
+// key label is configured.
+func newKeyManagerWithToken(tkn token, cfg config.PKCS11Config) (*kmprovider.KeyManager, error) {
+	keys := make([]pkiservice.PKI, 0, len(cfg.Keys))
+	for _, keyCfg := range cfg.Keys {
+		key, err := loadKey(tkn, keyCfg)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	pkiSvc, err := pkiservice.InitializeWithKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfgSvc kmprovider.ConfigCryptoProvider
+	if cfg.EncryptionKeyLabel != "" {
+		aead, err := tkn.FindSecretKey(cfg.EncryptionKeyLabel)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load encryption key %q: %w", cfg.EncryptionKeyLabel, err)
+		}
+		cfgSvc = newEncryptionService(aead, cfg.EncryptionKeyLabel)
+	} else {
+		cfgSvc, err = defaultkm.InitConfigProvider()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &kmprovider.KeyManager{
+		PKIService:    pkiSvc,
+		RuntimeCrypto: newRuntimeCryptoService(pkiSvc, cfgSvc),
+		ConfigCrypto:  cfgSvc,
+	}, nil
+}
+
+// loadKey loads a key pair and its certificate from the token.
+func loadKey(tkn token, keyCfg config.PKCS11KeyConfig) (pkiservice.PKI, error) {
+	signer, err := tkn.FindKeyPair(keyCfg.Label)
+	if err != nil {
+		return pkiservice.PKI{}, fmt.Errorf("failed to load key pair %q: %w", keyCfg.Label, err)
+	}
+	cert, err := tkn.FindCertificate(keyCfg.Label)
+	if err != nil {
+		return pkiservice.PKI{}, fmt.Errorf("failed to load certificate %q: %w", keyCfg.Label, err)
+	}
+
+	pub, ok := signer.Public().(interface{ Equal(x crypto.PublicKey) bool })
+	if !ok || !pub.Equal(cert.PublicKey) {
+		return pkiservice.PKI{}, fmt.Errorf("certificate %q does not match its key pair", keyCfg.Label)
+	}
+
+	return pkiservice.PKI{
+		ID:         keyCfg.ID,
+		PrivateKey: signer,
+		Certificate: tls.Certificate{
+			Certificate: [][]byte{cert.Raw},
+			PrivateKey:  signer,
+			Leaf:        cert,
+		},
+	}, nil
+}
+
+// validateConfig validates the PKCS#11 key manager configuration.
+func validateConfig(cfg config.PKCS11Config) error {
+	if cfg.Library == "" {
+		return errors.New("PKCS#11 library not configured in crypto.key_manager.pkcs11.library")
+	}
+	if cfg.TokenLabel == "" {
+		return errors.New("PKCS#11 token label not configured in crypto.key_manager.pkcs11.token_label")
+	}
+	if cfg.Pin == "" {
+		return errors.New("PKCS#11 pin not configured in crypto.key_manager.pkcs11.pin")
+	}
+	if len(cfg.Keys) == 0 {
+		return errors.New("no keys configured in crypto.key_manager.pkcs11.keys")
+	}
+	for _, key := range cfg.Keys {
+		if key.ID == "" || key.Label == "" {
+			return errors.New("each key in crypto.key_manager.pkcs11.keys requires an id and a label")
+		}
+	}
+	return nil
+}
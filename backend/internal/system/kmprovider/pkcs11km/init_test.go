@@ -0,0 +1,295 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package pkcs11km
+
+import (
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"io"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+)
+
+// opaqueSigner hides the concrete type of a private key, as with keys held in a token.
+type opaqueSigner struct {
+	crypto.Signer
+}
+
+// opaqueDecrypter hides the concrete type of an RSA private key, as with keys held in a token.
+type opaqueDecrypter struct {
+	crypto.Decrypter
+}
+
+func (d opaqueDecrypter) Sign(r io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return d.Decrypter.(crypto.Signer).Sign(r, digest, opts)
+}
+
+// fakeToken is an in-memory token used in place of a PKCS#11 token.
+type fakeToken struct {
+	signers    map[string]crypto.Signer
+	certs      map[string]*x509.Certificate
+	secretKeys map[string]cipher.AEAD
+}
+
+func (t *fakeToken) FindKeyPair(label string) (crypto.Signer, error) {
+	signer, ok := t.signers[label]
+	if !ok {
+		return nil, errors.New("key pair not found in the token")
+	}
+	return signer, nil
+}
+
+func (t *fakeToken) FindCertificate(label string) (*x509.Certificate, error) {
+	cert, ok := t.certs[label]
+	if !ok {
+		return nil, errors.New("certificate not found in the token")
+	}
+	return cert, nil
+}
+
+func (t *fakeToken) FindSecretKey(label string) (cipher.AEAD, error) {
+	aead, ok := t.secretKeys[label]
+	if !ok {
+		return nil, errors.New("secret key not found in the token")
+	}
+	return aead, nil
+}
+
+type InitTestSuite struct {
+	suite.Suite
+	rsaKey *rsa.PrivateKey
+	ecKey  *ecdsa.PrivateKey
+	token  *fakeToken
+}
+
+func TestInitTestSuite(t *testing.T) {
+	suite.Run(t, new(InitTestSuite))
+}
+
+func (suite *InitTestSuite) SetupSuite() {
+	var err error
+	suite.rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	suite.ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+}
+
+func (suite *InitTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+
+	block, err := aes.NewCipher(make([]byte, 32))
+	suite.Require().NoError(err)
+	aead, err := cipher.NewGCM(block)
+	suite.Require().NoError(err)
+
+	suite.token = &fakeToken{
+		signers: map[string]crypto.Signer{
+			"rsa-key": opaqueDecrypter{suite.rsaKey},
+			"ec-key":  opaqueSigner{suite.ecKey},
+		},
+		certs: map[string]*x509.Certificate{
+			"rsa-key": suite.createCertificate(suite.rsaKey),
+			"ec-key":  suite.createCertificate(suite.ecKey),
+		},
+		secretKeys: map[string]cipher.AEAD{"enc-key": aead},
+	}
+}
+
+func (suite *InitTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *InitTestSuite) createCertificate(key crypto.Signer) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	suite.Require().NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	suite.Require().NoError(err)
+	return cert
+}
+
+func (suite *InitTestSuite) testConfig() config.PKCS11Config {
+	return config.PKCS11Config{
+		Library:    "/usr/lib/softhsm/libsofthsm2.so",
+		TokenLabel: "thunder",
+		Pin:        "1234",
+		Keys: []config.PKCS11KeyConfig{
+			{ID: "rsa-kid", Label: "rsa-key"},
+			{ID: "ec-kid", Label: "ec-key"},
+		},
+		EncryptionKeyLabel: "enc-key",
+	}
+}
+
+func (suite *InitTestSuite) TestNewKeyManagerWithToken() {
+	keyManager, err := newKeyManagerWithToken(suite.token, suite.testConfig())
+	suite.Require().NoError(err)
+	suite.NotNil(keyManager.RuntimeCrypto)
+	suite.NotNil(keyManager.ConfigCrypto)
+
+	privKey, svcErr := keyManager.PKIService.GetPrivateKey("rsa-kid")
+	suite.Nil(svcErr)
+	suite.Equal(suite.token.signers["rsa-key"], privKey)
+
+	cert, svcErr := keyManager.PKIService.GetX509Certificate("ec-kid")
+	suite.Nil(svcErr)
+	suite.Equal(suite.token.certs["ec-key"], cert)
+
+	suite.ElementsMatch([]string{"RS256", "ES256"},
+		keyManager.PKIService.GetSupportedSigningAlgorithms())
+}
+
+func (suite *InitTestSuite) TestNewKeyManagerWithToken_DefaultEncryptionKey() {
+	err := config.InitializeServerRuntime("", &config.Config{
+		Crypto: config.CryptoConfig{
+			Encryption: config.EncryptionConfig{Key: "0123456789abcdef0123456789abcdef"},
+		},
+	})
+	suite.Require().NoError(err)
+
+	cfg := suite.testConfig()
+	cfg.EncryptionKeyLabel = ""
+	keyManager, err := newKeyManagerWithToken(suite.token, cfg)
+	suite.Require().NoError(err)
+	suite.NotNil(keyManager.ConfigCrypto)
+	_, ok := keyManager.ConfigCrypto.(*encryptionService)
+	suite.False(ok)
+}
+
+func (suite *InitTestSuite) TestNewKeyManagerWithToken_Errors() {
+	testCases := []struct {
+		name    string
+		modify  func(cfg *config.PKCS11Config)
+		wantErr string
+	}{
+		{
+			name: "MissingKeyPair",
+			modify: func(cfg *config.PKCS11Config) {
+				cfg.Keys = []config.PKCS11KeyConfig{{ID: "kid", Label: "missing"}}
+			},
+			wantErr: "failed to load key pair \"missing\"",
+		},
+		{
+			name: "MissingEncryptionKey",
+			modify: func(cfg *config.PKCS11Config) {
+				cfg.EncryptionKeyLabel = "missing"
+			},
+			wantErr: "failed to load encryption key \"missing\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			cfg := suite.testConfig()
+			tc.modify(&cfg)
+			_, err := newKeyManagerWithToken(suite.token, cfg)
+			suite.ErrorContains(err, tc.wantErr)
+		})
+	}
+}
+
+func (suite *InitTestSuite) TestNewKeyManagerWithToken_CertificateMismatch() {
+	suite.token.certs["rsa-key"] = suite.token.certs["ec-key"]
+
+	_, err := newKeyManagerWithToken(suite.token, suite.testConfig())
+	suite.ErrorContains(err, "certificate \"rsa-key\" does not match its key pair")
+}
+
+func (suite *InitTestSuite) TestNewKeyManager() {
+	err := config.InitializeServerRuntime("", &config.Config{
+		Crypto: config.CryptoConfig{
+			KeyManager: config.KeyManagerConfig{Provider: ProviderName, PKCS11: suite.testConfig()},
+		},
+	})
+	suite.Require().NoError(err)
+
+	original := openToken
+	defer func() { openToken = original }()
+	openToken = func(cfg config.PKCS11Config) (token, error) {
+		suite.Equal("thunder", cfg.TokenLabel)
+		return suite.token, nil
+	}
+
+	keyManager, err := newKeyManager()
+	suite.Require().NoError(err)
+	suite.NotNil(keyManager.PKIService)
+}
+
+func (suite *InitTestSuite) TestNewKeyManager_KeyRotationEnabled() {
+	err := config.InitializeServerRuntime("", &config.Config{
+		JWT: config.JWTConfig{KeyRotation: config.KeyRotationConfig{Enabled: true}},
+		Crypto: config.CryptoConfig{
+			KeyManager: config.KeyManagerConfig{Provider: ProviderName, PKCS11: suite.testConfig()},
+		},
+	})
+	suite.Require().NoError(err)
+
+	_, err = newKeyManager()
+	suite.ErrorContains(err, "signing key rotation is not supported")
+}
+
+func (suite *InitTestSuite) TestValidateConfig() {
+	testCases := []struct {
+		name    string
+		modify  func(cfg *config.PKCS11Config)
+		wantErr string
+	}{
+		{"Valid", func(cfg *config.PKCS11Config) {}, ""},
+		{"MissingLibrary", func(cfg *config.PKCS11Config) { cfg.Library = "" }, "library not configured"},
+		{"MissingTokenLabel", func(cfg *config.PKCS11Config) { cfg.TokenLabel = "" }, "token label not configured"},
+		{"MissingPin", func(cfg *config.PKCS11Config) { cfg.Pin = "" }, "pin not configured"},
+		{"NoKeys", func(cfg *config.PKCS11Config) { cfg.Keys = nil }, "no keys configured"},
+		{
+			"KeyWithoutLabel",
+			func(cfg *config.PKCS11Config) { cfg.Keys = []config.PKCS11KeyConfig{{ID: "kid"}} },
+			"requires an id and a label",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			cfg := suite.testConfig()
+			tc.modify(&cfg)
+			err := validateConfig(cfg)
+			if tc.wantErr == "" {
+				suite.NoError(err)
+			} else {
+				suite.ErrorContains(err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package pkcs11km
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/asgardeo/thunder/internal/system/cryptolab"
+	"github.com/asgardeo/thunder/internal/system/kmprovider"
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/pkiservice"
+	"github.com/asgardeo/thunder/internal/system/log"
+)
+
+// runtimeCryptoService performs runtime cryptographic operations with the keys held in the token.
+// Private key operations are delegated to the token through the crypto.Signer and crypto.Decrypter
+// of each key pair, while public key operations use the certificates loaded from the token.
+type runtimeCryptoService struct {
+	pkiService pkiservice.PKIServiceInterface
+	cfgService kmprovider.ConfigCryptoProvider
+	logger     *log.Logger
+}
+
+func newRuntimeCryptoService(
+	pkiSvc pkiservice.PKIServiceInterface,
+	cfgSvc kmprovider.ConfigCryptoProvider,
+) kmprovider.RuntimeCryptoProvider {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "PKCS11RuntimeCryptoService"))
+	return &runtimeCryptoService{
+		pkiService: pkiSvc,
+		cfgService: cfgSvc,
+		logger:     logger,
+	}
+}
+
+func (s *runtimeCryptoService) Encrypt(
+	ctx context.Context, keyRef kmprovider.KeyRef, params cryptolab.AlgorithmParams, content []byte,
+) ([]byte, *cryptolab.CryptoDetails, error) {
+	switch params.Algorithm {
+	case cryptolab.AlgorithmAESGCM:
+		encrypted, err := s.cfgService.Encrypt(ctx, content)
+		return encrypted, nil, err
+	case cryptolab.AlgorithmRSAOAEP256, cryptolab.AlgorithmECDHES, cryptolab.AlgorithmECDHESA128KW,
+		cryptolab.AlgorithmECDHESA256KW:
+		cert, svcErr := s.pkiService.GetX509Certificate(keyRef.KeyID)
+		if svcErr != nil {
+			return nil, nil, fmt.Errorf("key not found for id %s: [%s] %s",
+				keyRef.KeyID, svcErr.Code, svcErr.Error.DefaultValue)
+		}
+		return cryptolab.Encrypt(cert.PublicKey, params, content)
+	default:
+		return nil, nil, fmt.Errorf("unsupported algorithm: %s", params.Algorithm)
+	}
+}
+
+func (s *runtimeCryptoService) Decrypt(
+	ctx context.Context, keyRef kmprovider.KeyRef, params cryptolab.AlgorithmParams, content []byte,
+) ([]byte, error) {
+	switch params.Algorithm {
+	case cryptolab.AlgorithmAESGCM:
+		return s.cfgService.Decrypt(ctx, content)
+	case cryptolab.AlgorithmRSAOAEP256:
+		privKey, err := s.getPrivateKey(keyRef)
+		if err != nil {
+			return nil, err
+		}
+		return cryptolab.Decrypt(privKey, params, content)
+	case cryptolab.AlgorithmECDHES, cryptolab.AlgorithmECDHESA128KW, cryptolab.AlgorithmECDHESA256KW:
+		return nil, errors.New("ECDH key agreement is not supported with keys held in a PKCS#11 token")
+	default:
+		return nil, fmt.Errorf("unsupported algorithm: %s", params.Algorithm)
+	}
+}
+
+func (s *runtimeCryptoService) Sign(
+	_ context.Context, keyRef kmprovider.KeyRef, algorithm cryptolab.SignAlgorithm, content []byte,
+) ([]byte, error) {
+	privKey, err := s.getPrivateKey(keyRef)
+	if err != nil {
+		return nil, err
+	}
+	return cryptolab.Generate(content, algorithm, privKey)
+}
+
+// GetPublicKeys returns the public keys of the certificates loaded from the token, sorted by key ID.
+func (s *runtimeCryptoService) GetPublicKeys(
+	_ context.Context, filter kmprovider.PublicKeyFilter,
+) ([]kmprovider.PublicKeyInfo, error) {
+	certs, svcErr := s.pkiService.GetAllX509Certificates()
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to retrieve certificates: [%s] %s", svcErr.Code, svcErr.Error.DefaultValue)
+	}
+
+	keys := make([]kmprovider.PublicKeyInfo, 0, len(certs))
+	for id, cert := range certs {
+		if filter.KeyID != "" && id != filter.KeyID {
+			continue
+		}
+		alg := getSigningAlgorithm(cert.PublicKey)
+		if filter.Algorithm != "" && alg != filter.Algorithm {
+			continue
+		}
+		keys = append(keys, kmprovider.PublicKeyInfo{
+			KeyID:      id,
+			Algorithm:  alg,
+			PublicKey:  cert.PublicKey,
+			Thumbprint: s.pkiService.GetCertThumbprint(id),
+		})
+	}
+	slices.SortFunc(keys, func(a, b kmprovider.PublicKeyInfo) int {
+		return strings.Compare(a.KeyID, b.KeyID)
+	})
+	return keys, nil
+}
+
+func (s *runtimeCryptoService) GetTLSMaterial(
+	_ context.Context, _ kmprovider.KeyRef,
+) (*kmprovider.TLSMaterial, error) {
+	return nil, errors.New("not implemented")
+}
+
+// getPrivateKey returns the private key of a key pair, which is an opaque handle to the key in the token.
+func (s *runtimeCryptoService) getPrivateKey(keyRef kmprovider.KeyRef) (crypto.PrivateKey, error) {
+	privKey, svcErr := s.pkiService.GetPrivateKey(keyRef.KeyID)
+	if svcErr != nil {
+		return nil, fmt.Errorf("key not found for id %s: [%s] %s",
+			keyRef.KeyID, svcErr.Code, svcErr.Error.DefaultValue)
+	}
+	return privKey, nil
+}
+
+// getSigningAlgorithm returns the JWS signing algorithm of a public key.
+func getSigningAlgorithm(publicKey crypto.PublicKey) cryptolab.Algorithm {
+	switch pub := publicKey.(type) {
+	case *rsa.PublicKey:
+		return cryptolab.AlgorithmRS256
+	case *ecdsa.PublicKey:
+		switch pub.Curve {
+		case elliptic.P256():
+			return cryptolab.AlgorithmES256
+		case elliptic.P384():
+			return cryptolab.AlgorithmES384
+		case elliptic.P521():
+			return cryptolab.AlgorithmES512
+		}
+	case ed25519.PublicKey:
+		return cryptolab.AlgorithmEdDSA
+	}
+	return ""
+}
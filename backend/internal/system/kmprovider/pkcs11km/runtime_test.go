@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package pkcs11km
+
+import (
+	"context"
+
+	"github.com/asgardeo/thunder/internal/system/cryptolab"
+	"github.com/asgardeo/thunder/internal/system/kmprovider"
+)
+
+func (suite *InitTestSuite) newRuntimeCryptoService() kmprovider.RuntimeCryptoProvider {
+	keyManager, err := newKeyManagerWithToken(suite.token, suite.testConfig())
+	suite.Require().NoError(err)
+	return keyManager.RuntimeCrypto
+}
+
+func (suite *InitTestSuite) TestRuntimeSign() {
+	svc := suite.newRuntimeCryptoService()
+	content := []byte("content to sign")
+
+	testCases := []struct {
+		keyID     string
+		algorithm cryptolab.SignAlgorithm
+	}{
+		{"rsa-kid", cryptolab.RSASHA256},
+		{"rsa-kid", cryptolab.RSAPSSSHA256},
+		{"ec-kid", cryptolab.ECDSASHA256},
+	}
+	for _, tc := range testCases {
+		suite.Run(string(tc.algorithm), func() {
+			signature, err := svc.Sign(context.Background(), kmprovider.KeyRef{KeyID: tc.keyID}, tc.algorithm, content)
+			suite.Require().NoError(err)
+
+			cert := suite.token.certs[map[string]string{"rsa-kid": "rsa-key", "ec-kid": "ec-key"}[tc.keyID]]
+			suite.NoError(cryptolab.Verify(content, signature, tc.algorithm, cert.PublicKey))
+		})
+	}
+}
+
+func (suite *InitTestSuite) TestRuntimeSign_Errors() {
+	svc := suite.newRuntimeCryptoService()
+
+	_, err := svc.Sign(context.Background(), kmprovider.KeyRef{KeyID: "unknown"}, cryptolab.RSASHA256, []byte("x"))
+	suite.ErrorContains(err, "key not found for id unknown")
+
+	_, err = svc.Sign(context.Background(), kmprovider.KeyRef{KeyID: "ec-kid"}, cryptolab.RSASHA256, []byte("x"))
+	suite.Error(err)
+}
+
+func (suite *InitTestSuite) TestRuntimeEncryptDecrypt_RSAOAEP256() {
+	svc := suite.newRuntimeCryptoService()
+	keyRef := kmprovider.KeyRef{KeyID: "rsa-kid"}
+	params := cryptolab.AlgorithmParams{
+		Algorithm:  cryptolab.AlgorithmRSAOAEP256,
+		RSAOAEP256: cryptolab.RSAOAEP256Params{ContentEncryptionAlgorithm: "A256GCM"},
+	}
+
+	encryptedCEK, details, err := svc.Encrypt(context.Background(), keyRef, params, nil)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(details)
+
+	cek, err := svc.Decrypt(context.Background(), keyRef, params, encryptedCEK)
+	suite.Require().NoError(err)
+	suite.Equal(details.CEK, cek)
+}
+
+func (suite *InitTestSuite) TestRuntimeEncryptDecrypt_AESGCM() {
+	svc := suite.newRuntimeCryptoService()
+	params := cryptolab.AlgorithmParams{Algorithm: cryptolab.AlgorithmAESGCM}
+
+	encrypted, _, err := svc.Encrypt(context.Background(), kmprovider.KeyRef{}, params, []byte("secret"))
+	suite.Require().NoError(err)
+
+	decrypted, err := svc.Decrypt(context.Background(), kmprovider.KeyRef{}, params, encrypted)
+	suite.Require().NoError(err)
+	suite.Equal([]byte("secret"), decrypted)
+}
+
+func (suite *InitTestSuite) TestRuntimeDecrypt_ECDHNotSupported() {
+	svc := suite.newRuntimeCryptoService()
+	params := cryptolab.AlgorithmParams{Algorithm: cryptolab.AlgorithmECDHES}
+
+	_, err := svc.Decrypt(context.Background(), kmprovider.KeyRef{KeyID: "ec-kid"}, params, nil)
+	suite.ErrorContains(err, "ECDH key agreement is not supported")
+}
+
+func (suite *InitTestSuite) TestRuntimeGetPublicKeys() {
+	svc := suite.newRuntimeCryptoService()
+
+	keys, err := svc.GetPublicKeys(context.Background(), kmprovider.PublicKeyFilter{})
+	suite.Require().NoError(err)
+	suite.Require().Len(keys, 2)
+	suite.Equal("ec-kid", keys[0].KeyID)
+	suite.Equal(cryptolab.AlgorithmES256, keys[0].Algorithm)
+	suite.Equal(suite.token.certs["ec-key"].PublicKey, keys[0].PublicKey)
+	suite.NotEmpty(keys[0].Thumbprint)
+	suite.Equal("rsa-kid", keys[1].KeyID)
+	suite.Equal(cryptolab.AlgorithmRS256, keys[1].Algorithm)
+
+	keys, err = svc.GetPublicKeys(context.Background(), kmprovider.PublicKeyFilter{KeyID: "rsa-kid"})
+	suite.Require().NoError(err)
+	suite.Require().Len(keys, 1)
+	suite.Equal("rsa-kid", keys[0].KeyID)
+
+	keys, err = svc.GetPublicKeys(context.Background(),
+		kmprovider.PublicKeyFilter{Algorithm: cryptolab.AlgorithmES256})
+	suite.Require().NoError(err)
+	suite.Require().Len(keys, 1)
+	suite.Equal("ec-kid", keys[0].KeyID)
+}
@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package pkcs11km
+
+import (
+	"crypto"
+	"crypto/cipher"
+	"crypto/x509"
+)
+
+// token provides access to the objects held in a PKCS#11 token. Objects are looked up by label.
+type token interface {
+	FindKeyPair(label string) (crypto.Signer, error)
+	FindCertificate(label string) (*x509.Certificate, error)
+	FindSecretKey(label string) (cipher.AEAD, error)
+}
@@ -0,0 +1,32 @@
+//go:build !cgo
+
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package pkcs11km
+
+import (
+	"errors"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+)
+
+// openPKCS11Token returns an error as the PKCS#11 library is loaded through cgo.
+func openPKCS11Token(_ config.PKCS11Config) (token, error) {
+	return nil, errors.New("PKCS#11 key manager requires a build with cgo enabled (build.sh --with-pkcs11)")
+}
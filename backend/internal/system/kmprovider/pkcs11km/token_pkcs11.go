@@ -0,0 +1,87 @@
+//go:build cgo
+
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package pkcs11km
+
+import (
+	"crypto"
+	"crypto/cipher"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"github.com/ThalesIgnite/crypto11"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+)
+
+// pkcs11Token is the token implementation backed by a PKCS#11 library.
+type pkcs11Token struct {
+	ctx *crypto11.Context
+}
+
+// openPKCS11Token loads the PKCS#11 library and logs in to the configured token.
+func openPKCS11Token(cfg config.PKCS11Config) (token, error) {
+	ctx, err := crypto11.Configure(&crypto11.Config{
+		Path:       cfg.Library,
+		TokenLabel: cfg.TokenLabel,
+		Pin:        cfg.Pin,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open PKCS#11 token %q: %w", cfg.TokenLabel, err)
+	}
+	return &pkcs11Token{ctx: ctx}, nil
+}
+
+// FindKeyPair finds the key pair with the given label.
+func (t *pkcs11Token) FindKeyPair(label string) (crypto.Signer, error) {
+	signer, err := t.ctx.FindKeyPair(nil, []byte(label))
+	if err != nil {
+		return nil, err
+	}
+	if signer == nil {
+		return nil, errors.New("key pair not found in the token")
+	}
+	return signer, nil
+}
+
+// FindCertificate finds the certificate with the given label.
+func (t *pkcs11Token) FindCertificate(label string) (*x509.Certificate, error) {
+	cert, err := t.ctx.FindCertificate(nil, []byte(label), nil)
+	if err != nil {
+		return nil, err
+	}
+	if cert == nil {
+		return nil, errors.New("certificate not found in the token")
+	}
+	return cert, nil
+}
+
+// FindSecretKey finds the AES key with the given label and returns an AES-GCM cipher that uses it.
+func (t *pkcs11Token) FindSecretKey(label string) (cipher.AEAD, error) {
+	key, err := t.ctx.FindKey(nil, []byte(label))
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, errors.New("secret key not found in the token")
+	}
+	return key.NewGCM()
+}
@@ -0,0 +1,120 @@
+//go:build cgo
+
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package pkcs11km
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ThalesIgnite/crypto11"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/asgardeo/thunder/internal/system/config"
+	"github.com/asgardeo/thunder/internal/system/cryptolab"
+	"github.com/asgardeo/thunder/internal/system/kmprovider"
+)
+
+// TestPKCS11Token runs against a real token, such as a SoftHSM token initialized with
+//
+//	softhsm2-util --init-token --free --label thunder --pin 1234 --so-pin 1234
+//
+// and is skipped unless PKCS11_TEST_LIBRARY, PKCS11_TEST_TOKEN_LABEL and PKCS11_TEST_PIN are set.
+func TestPKCS11Token(t *testing.T) {
+	cfg := config.PKCS11Config{
+		Library:            os.Getenv("PKCS11_TEST_LIBRARY"),
+		TokenLabel:         os.Getenv("PKCS11_TEST_TOKEN_LABEL"),
+		Pin:                os.Getenv("PKCS11_TEST_PIN"),
+		Keys:               []config.PKCS11KeyConfig{{ID: "test-kid", Label: "thunder-test-key"}},
+		EncryptionKeyLabel: "thunder-test-enc-key",
+	}
+	if cfg.Library == "" || cfg.TokenLabel == "" || cfg.Pin == "" {
+		t.Skip("PKCS#11 test token not configured")
+	}
+
+	tkn, err := openPKCS11Token(cfg)
+	require.NoError(t, err)
+	ctx := tkn.(*pkcs11Token).ctx
+	defer func() { _ = ctx.Close() }()
+
+	keyLabel := []byte(cfg.Keys[0].Label)
+	signer, err := ctx.GenerateRSAKeyPairWithLabel(randomID(t), keyLabel, 2048)
+	require.NoError(t, err)
+	defer func() { _ = signer.Delete() }()
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "thunder-test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, signer.Public(), signer)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	require.NoError(t, ctx.ImportCertificateWithLabel(randomID(t), keyLabel, cert))
+	defer func() { _ = ctx.DeleteCertificate(nil, keyLabel, nil) }()
+
+	secretKey, err := ctx.GenerateSecretKeyWithLabel(randomID(t), []byte(cfg.EncryptionKeyLabel), 256,
+		crypto11.CipherAES)
+	require.NoError(t, err)
+	defer func() { _ = secretKey.Delete() }()
+
+	keyManager, err := newKeyManagerWithToken(tkn, cfg)
+	require.NoError(t, err)
+
+	content := []byte("content to sign")
+	signature, err := keyManager.RuntimeCrypto.Sign(context.Background(), kmprovider.KeyRef{KeyID: "test-kid"},
+		cryptolab.RSASHA256, content)
+	require.NoError(t, err)
+	assert.NoError(t, cryptolab.Verify(content, signature, cryptolab.RSASHA256, cert.PublicKey))
+
+	params := cryptolab.AlgorithmParams{
+		Algorithm:  cryptolab.AlgorithmRSAOAEP256,
+		RSAOAEP256: cryptolab.RSAOAEP256Params{ContentEncryptionAlgorithm: "A256GCM"},
+	}
+	encryptedCEK, details, err := keyManager.RuntimeCrypto.Encrypt(context.Background(),
+		kmprovider.KeyRef{KeyID: "test-kid"}, params, nil)
+	require.NoError(t, err)
+	cek, err := keyManager.RuntimeCrypto.Decrypt(context.Background(), kmprovider.KeyRef{KeyID: "test-kid"},
+		params, encryptedCEK)
+	require.NoError(t, err)
+	assert.Equal(t, details.CEK, cek)
+
+	encrypted, err := keyManager.ConfigCrypto.Encrypt(context.Background(), []byte("secret"))
+	require.NoError(t, err)
+	decrypted, err := keyManager.ConfigCrypto.Decrypt(context.Background(), encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), decrypted)
+}
+
+func randomID(t *testing.T) []byte {
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	require.NoError(t, err)
+	return id
+}
@@ -66,6 +66,11 @@ func (m *MockJWTService) GenerateJWT(
 	return args.String(0), args.Get(1).(int64), args.Get(2).(*serviceerror.ServiceError)
 }
 
+func (m *MockJWTService) GetSigningAlgorithm() string {
+	args := m.Called()
+	return args.String(0)
+}
+
 func (m *MockJWTService) VerifyJWT(
 	jwtToken string,
 	expectedAud string,
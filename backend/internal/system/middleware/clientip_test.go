@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sysContext "github.com/asgardeo/thunder/internal/system/context"
+)
+
+func TestClientIPMiddleware_StoresRemoteAddress(t *testing.T) {
+	var actualIP string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actualIP = sysContext.GetClientIP(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.RemoteAddr = "192.0.2.10:54321"
+	req.Header.Set("X-Forwarded-For", "198.51.100.1")
+
+	ClientIPMiddleware(handler).ServeHTTP(httptest.NewRecorder(), req)
+
+	if actualIP != "192.0.2.10" {
+		t.Errorf("Expected client IP 192.0.2.10, got %s", actualIP)
+	}
+}
+
+func TestClientIPMiddleware_RemoteAddressWithoutPort(t *testing.T) {
+	var actualIP string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actualIP = sysContext.GetClientIP(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.RemoteAddr = "2001:db8::1"
+
+	ClientIPMiddleware(handler).ServeHTTP(httptest.NewRecorder(), req)
+
+	if actualIP != "2001:db8::1" {
+		t.Errorf("Expected client IP 2001:db8::1, got %s", actualIP)
+	}
+}
@@ -42,12 +42,15 @@ var DefaultAllowedHeaders = []string{"Content-Type", "Authorization"}
 //
 // AllowedMethods and AllowedHeaders are slices so the response payload is
 // data-driven rather than a parsed string. MaxAge is the preflight cache TTL
-// in seconds; zero suppresses the Access-Control-Max-Age header. The
+// in seconds; zero suppresses the Access-Control-Max-Age header.
+// ExposedHeaders lists response headers that scripts on the allowed origin
+// may read, and is sent on actual (non-preflight) responses only. The
 // per-request Origin echo is decided by the global matcher and never
 // influenced by these options.
 type CORSOptions struct {
 	AllowedMethods   []string
 	AllowedHeaders   []string
+	ExposedHeaders   []string
 	AllowCredentials bool
 	MaxAge           int
 }
@@ -121,6 +124,9 @@ func applyCORSHeaders(w http.ResponseWriter, r *http.Request, opts CORSOptions)
 	}
 
 	if !isPreflight(r) {
+		if exposed := joinHeaderList(opts.ExposedHeaders); exposed != "" {
+			w.Header().Set("Access-Control-Expose-Headers", exposed)
+		}
 		return
 	}
 	if methods := joinHeaderList(opts.AllowedMethods); methods != "" {
@@ -218,6 +218,25 @@ func (suite *CORSMiddlewareTestSuite) TestWithCORS_NoOriginHeader() {
 	assert.Equal(suite.T(), "OK", w.Body.String())
 }
 
+func (suite *CORSMiddlewareTestSuite) TestWithCORS_ExposedHeadersOnActualResponse() {
+	opts := CORSOptions{
+		AllowedMethods: []string{"POST"},
+		ExposedHeaders: []string{"DPoP-Nonce", "WWW-Authenticate"},
+	}
+	_, wrapped := WithCORS("POST /test", noopHandler, opts)
+
+	req, w := newGetRequest("https://example.com")
+	wrapped(w, req)
+	assert.Equal(suite.T(), "DPoP-Nonce, WWW-Authenticate", w.Header().Get("Access-Control-Expose-Headers"))
+
+	preflight := httptest.NewRequest(http.MethodOptions, "/test", nil)
+	preflight.Header.Set("Origin", "https://example.com")
+	preflight.Header.Set("Access-Control-Request-Method", "POST")
+	pw := httptest.NewRecorder()
+	wrapped(pw, preflight)
+	assert.Empty(suite.T(), pw.Header().Get("Access-Control-Expose-Headers"))
+}
+
 func (suite *CORSMiddlewareTestSuite) TestWithCORS_WithoutCredentials() {
 	opts := CORSOptions{
 		AllowedMethods:   []string{"GET"},
@@ -71,6 +71,8 @@ var eventTypeToCategory = map[EventType]EventCategory{
 	EventTypeTokenIssuanceStarted: CategoryAuthentication,
 	EventTypeTokenIssued:          CategoryAuthentication,
 	EventTypeTokenIssuanceFailed:  CategoryAuthentication,
+	EventTypeAccountLocked:        CategoryAuthentication,
+	EventTypeAccountUnlocked:      CategoryAuthentication,
 
 	// Flow events
 	EventTypeFlowStarted:                CategoryFlows,
@@ -47,6 +47,16 @@ func TestGetCategory(t *testing.T) {
 			eventType:    EventTypeTokenIssuanceFailed,
 			wantCategory: CategoryAuthentication,
 		},
+		{
+			name:         "account locked",
+			eventType:    EventTypeAccountLocked,
+			wantCategory: CategoryAuthentication,
+		},
+		{
+			name:         "account unlocked",
+			eventType:    EventTypeAccountUnlocked,
+			wantCategory: CategoryAuthentication,
+		},
 
 		// Flow events
 		{
@@ -284,6 +294,8 @@ func TestEventTypeToCategoryMapping_Comprehensive(t *testing.T) {
 		EventTypeTokenIssuanceStarted,
 		EventTypeTokenIssued,
 		EventTypeTokenIssuanceFailed,
+		EventTypeAccountLocked,
+		EventTypeAccountUnlocked,
 
 		// Flows
 		EventTypeFlowStarted,
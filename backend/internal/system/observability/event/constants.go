@@ -26,6 +26,9 @@ const (
 
 	// ComponentAuthHandler identifies events from authentication handlers.
 	ComponentAuthHandler = "AuthHandler"
+
+	// ComponentAccountLockout identifies events from the account lockout service.
+	ComponentAccountLockout = "AccountLockout"
 )
 
 // Authentication and Authorization Event Types
@@ -41,6 +44,14 @@ const (
 	// EventTypeTokenIssuanceFailed is triggered when token issuance fails.
 	EventTypeTokenIssuanceFailed EventType = "TOKEN_ISSUANCE_FAILED" //nolint:gosec
 
+	// Account Lockout Events
+
+	// EventTypeAccountLocked is triggered when a subject is locked after too many failed login attempts.
+	EventTypeAccountLocked EventType = "ACCOUNT_LOCKED"
+
+	// EventTypeAccountUnlocked is triggered when an administrator unlocks an account.
+	EventTypeAccountUnlocked EventType = "ACCOUNT_UNLOCKED"
+
 	// Flow Execution Events
 
 	// EventTypeFlowStarted is triggered when a flow execution begins.
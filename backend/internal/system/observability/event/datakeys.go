@@ -51,6 +51,11 @@ var DataKey = struct {
 	Scope     string
 	GrantType string
 
+	// Account Lockout Keys
+	LockoutSubject string
+	FailedAttempts string
+	LockedUntil    string
+
 	// Event Metadata Keys
 	Message     string
 	Error       string
@@ -89,6 +94,11 @@ var DataKey = struct {
 	Scope:     "scope",
 	GrantType: "grant_type",
 
+	// Account Lockout Keys
+	LockoutSubject: "lockout_subject",
+	FailedAttempts: "failed_attempts",
+	LockedUntil:    "locked_until",
+
 	// Event Metadata Keys
 	Message:     "message",
 	Error:       "error",
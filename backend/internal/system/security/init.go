@@ -25,8 +25,10 @@ import (
 )
 
 // Initialize creates and returns the security middleware with necessary authenticators.
-func Initialize(jwtService jwt.JWTServiceInterface) (func(http.Handler) http.Handler, error) {
-	jwtAuthenticator := newJWTAuthenticator(jwtService)
+func Initialize(
+	jwtService jwt.JWTServiceInterface, revocationChecker TokenRevocationCheckerInterface,
+) (func(http.Handler) http.Handler, error) {
+	jwtAuthenticator := newJWTAuthenticator(jwtService, revocationChecker)
 	securityService, err := newSecurityService(
 		[]AuthenticatorInterface{jwtAuthenticator}, publicPaths, apiPermissionEntries)
 	if err != nil {
@@ -19,6 +19,8 @@
 package security
 
 import (
+	"context"
+	"crypto/subtle"
 	"net/http"
 	"strings"
 
@@ -28,15 +30,26 @@ import (
 	"github.com/asgardeo/thunder/internal/system/utils"
 )
 
+// TokenRevocationCheckerInterface reports whether a token issued by the server has been revoked.
+// It is implemented by the OAuth token revocation service and injected here to avoid an import cycle.
+type TokenRevocationCheckerInterface interface {
+	IsTokenRevoked(ctx context.Context, tokenID string) (bool, error)
+}
+
 // jwtAuthenticator handles authentication and authorization using JWT Bearer tokens.
 type jwtAuthenticator struct {
-	jwtService jwt.JWTServiceInterface
+	jwtService        jwt.JWTServiceInterface
+	revocationChecker TokenRevocationCheckerInterface
 }
 
 // newJWTAuthenticator creates a new JWT authenticator.
-func newJWTAuthenticator(jwtService jwt.JWTServiceInterface) *jwtAuthenticator {
+// The revocation checker is optional; when nil, revocation status is not checked.
+func newJWTAuthenticator(
+	jwtService jwt.JWTServiceInterface, revocationChecker TokenRevocationCheckerInterface,
+) *jwtAuthenticator {
 	return &jwtAuthenticator{
-		jwtService: jwtService,
+		jwtService:        jwtService,
+		revocationChecker: revocationChecker,
 	}
 }
 
@@ -80,6 +93,17 @@ func (h *jwtAuthenticator) Authenticate(r *http.Request) (*SecurityContext, erro
 		return nil, errInvalidToken
 	}
 
+	// Step 3.1: Reject locally issued tokens that have been revoked.
+	if !config.GetServerRuntime().Config.Server.SecurityConfig.TrustedIssuer.IsConfigured() &&
+		h.isRevoked(r.Context(), attributes) {
+		return nil, errInvalidToken
+	}
+
+	// Step 3.2: Reject certificate-bound tokens replayed without the bound client certificate (RFC 8705 §3).
+	if !isCertificateBindingSatisfied(r, attributes) {
+		return nil, errInvalidToken
+	}
+
 	// Step 4: Extract subject information and build SecurityContext
 	subject := ""
 	if sub, ok := attributes["sub"].(string); ok && sub != "" {
@@ -135,6 +159,37 @@ func (h *jwtAuthenticator) verifyFederatedToken(token string) (verified bool) {
 	return true
 }
 
+// isRevoked checks the revocation status of the token identified by its jti claim.
+// Failures to determine the status are treated as revoked so that the check fails closed.
+func (h *jwtAuthenticator) isRevoked(ctx context.Context, attributes map[string]interface{}) bool {
+	if h.revocationChecker == nil {
+		return false
+	}
+	jti := extractAttribute(attributes, "jti")
+	if jti == "" {
+		return false
+	}
+	revoked, err := h.revocationChecker.IsTokenRevoked(ctx, jti)
+	if err != nil {
+		return true
+	}
+	return revoked
+}
+
+// isCertificateBindingSatisfied reports whether a certificate-bound token was presented over a mutual-TLS
+// connection with the certificate it is bound to. Tokens without a certificate binding always satisfy it.
+func isCertificateBindingSatisfied(r *http.Request, attributes map[string]interface{}) bool {
+	thumbprint := jwt.GetCertificateThumbprintConfirmation(attributes)
+	if thumbprint == "" {
+		return true
+	}
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return false
+	}
+	presented := jwt.CertificateThumbprint(r.TLS.PeerCertificates[0])
+	return subtle.ConstantTimeCompare([]byte(presented), []byte(thumbprint)) == 1
+}
+
 // extractToken extracts the Bearer token from the Authorization header.
 func extractToken(authHeader string) (string, error) {
 	if !utils.HasPrefixFold(authHeader, constants.AuthSchemeBearer) {
@@ -20,18 +20,23 @@ package security
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/asgardeo/thunder/internal/system/config"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	i18ncore "github.com/asgardeo/thunder/internal/system/i18n/core"
+	"github.com/asgardeo/thunder/internal/system/jose/jwt"
 	"github.com/asgardeo/thunder/tests/mocks/jose/jwtmock"
 )
 
@@ -44,7 +49,7 @@ type JWTAuthenticatorTestSuite struct {
 
 func (suite *JWTAuthenticatorTestSuite) SetupTest() {
 	suite.mockJWT = jwtmock.NewJWTServiceInterfaceMock(suite.T())
-	suite.authenticator = newJWTAuthenticator(suite.mockJWT)
+	suite.authenticator = newJWTAuthenticator(suite.mockJWT, nil)
 	// Initialize an empty runtime so verifyFederatedToken sees an unconfigured trusted issuer
 	// and returns false cleanly. Tests that need a specific trusted issuer config override this.
 	config.ResetServerRuntime()
@@ -210,7 +215,7 @@ func (suite *JWTAuthenticatorTestSuite) TestAuthenticate() {
 			if tt.setupMock != nil {
 				tt.setupMock(suite.mockJWT)
 			}
-			suite.authenticator = newJWTAuthenticator(suite.mockJWT)
+			suite.authenticator = newJWTAuthenticator(suite.mockJWT, nil)
 
 			req := httptest.NewRequest(http.MethodGet, "/users", nil)
 			if tt.authHeader != "" {
@@ -394,7 +399,7 @@ func (suite *JWTAuthenticatorTestSuite) TestExtractPermissionsFromJWTClaims_Edge
 func (suite *JWTAuthenticatorTestSuite) TestNewJWTAuthenticator() {
 	mockJWTService := jwtmock.NewJWTServiceInterfaceMock(suite.T())
 
-	authenticator := newJWTAuthenticator(mockJWTService)
+	authenticator := newJWTAuthenticator(mockJWTService, nil)
 
 	assert.NotNil(suite.T(), authenticator)
 	assert.Equal(suite.T(), mockJWTService, authenticator.jwtService)
@@ -540,7 +545,7 @@ func (suite *JWTAuthenticatorTestSuite) TestVerifyFederatedToken_JWKSVerificatio
 
 	mockJWT := jwtmock.NewJWTServiceInterfaceMock(suite.T())
 	mockJWT.On("VerifyJWTWithJWKS", token, jwksURL, audience, issuer).Return(nil)
-	auth := newJWTAuthenticator(mockJWT)
+	auth := newJWTAuthenticator(mockJWT, nil)
 
 	result := auth.verifyFederatedToken(token)
 	assert.True(suite.T(), result)
@@ -579,7 +584,7 @@ func (suite *JWTAuthenticatorTestSuite) TestVerifyFederatedToken_JWKSVerificatio
 		Code:  "JWKS_ERROR",
 		Error: i18ncore.I18nMessage{DefaultValue: "JWKS verification failed"},
 	})
-	auth := newJWTAuthenticator(mockJWT)
+	auth := newJWTAuthenticator(mockJWT, nil)
 
 	result := auth.verifyFederatedToken(token)
 	assert.False(suite.T(), result)
@@ -671,7 +676,7 @@ func (suite *JWTAuthenticatorTestSuite) TestVerifyFederatedToken_RequiredClaims(
 
 			mockJWT := jwtmock.NewJWTServiceInterfaceMock(suite.T())
 			mockJWT.On("VerifyJWTWithJWKS", token, jwksURL, audience, issuer).Return(nil)
-			auth := newJWTAuthenticator(mockJWT)
+			auth := newJWTAuthenticator(mockJWT, nil)
 
 			result := auth.verifyFederatedToken(token)
 			assert.Equal(suite.T(), tc.expectedResult, result)
@@ -736,7 +741,7 @@ func (suite *JWTAuthenticatorTestSuite) TestVerifyFederatedToken_InvalidPayload(
 			_ = config.InitializeServerRuntime("", cfg)
 
 			mockJWT := jwtmock.NewJWTServiceInterfaceMock(suite.T())
-			auth := newJWTAuthenticator(mockJWT)
+			auth := newJWTAuthenticator(mockJWT, nil)
 
 			result := auth.verifyFederatedToken(tc.token)
 			assert.False(suite.T(), result, "malformed token must not verify")
@@ -784,7 +789,7 @@ func (suite *JWTAuthenticatorTestSuite) TestAuthenticate_FederatedTokenFailure()
 		Code:  "JWKS_ERROR",
 		Error: i18ncore.I18nMessage{DefaultValue: "JWKS verification failed"},
 	})
-	auth := newJWTAuthenticator(mockJWT)
+	auth := newJWTAuthenticator(mockJWT, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/users", nil)
 	req.Header.Set("Authorization", "Bearer "+token)
@@ -829,7 +834,7 @@ func (suite *JWTAuthenticatorTestSuite) TestAuthenticate_FederatedTokenSuccess()
 	mockJWT := jwtmock.NewJWTServiceInterfaceMock(suite.T())
 	// When trusted issuer is configured, the local-key path is skipped entirely.
 	mockJWT.On("VerifyJWTWithJWKS", token, jwksURL, audience, issuer).Return(nil)
-	auth := newJWTAuthenticator(mockJWT)
+	auth := newJWTAuthenticator(mockJWT, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/users", nil)
 	req.Header.Set("Authorization", "Bearer "+token)
@@ -843,3 +848,90 @@ func (suite *JWTAuthenticatorTestSuite) TestAuthenticate_FederatedTokenSuccess()
 	mockJWT.AssertExpectations(suite.T())
 	mockJWT.AssertNotCalled(suite.T(), "VerifyJWTSignature")
 }
+
+func (suite *JWTAuthenticatorTestSuite) TestAuthenticate_RevocationCheck() {
+	token := buildFakeJWT(
+		map[string]interface{}{"alg": "RS256", "typ": "at+jwt"},
+		map[string]interface{}{"sub": "user123", "jti": "token-jti"},
+	)
+
+	tests := []struct {
+		name        string
+		revoked     bool
+		checkErr    error
+		expectedErr error
+	}{
+		{name: "Token not revoked", revoked: false},
+		{name: "Token revoked", revoked: true, expectedErr: errInvalidToken},
+		{name: "Revocation check fails", checkErr: errors.New("store unavailable"), expectedErr: errInvalidToken},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			mockJWT := jwtmock.NewJWTServiceInterfaceMock(suite.T())
+			mockJWT.On("VerifyJWT", token, "", "").Return(nil)
+			mockChecker := NewTokenRevocationCheckerInterfaceMock(suite.T())
+			mockChecker.On("IsTokenRevoked", mock.Anything, "token-jti").Return(tt.revoked, tt.checkErr)
+			auth := newJWTAuthenticator(mockJWT, mockChecker)
+
+			req := httptest.NewRequest(http.MethodGet, "/users", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			authCtx, err := auth.Authenticate(req)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(suite.T(), err, tt.expectedErr)
+				assert.Nil(suite.T(), authCtx)
+			} else {
+				assert.NoError(suite.T(), err)
+				assert.NotNil(suite.T(), authCtx)
+			}
+		})
+	}
+}
+
+func (suite *JWTAuthenticatorTestSuite) TestAuthenticate_CertificateBoundToken() {
+	clientCert := &x509.Certificate{Raw: []byte("client-certificate")}
+	otherCert := &x509.Certificate{Raw: []byte("other-certificate")}
+	token := buildFakeJWT(
+		map[string]interface{}{"alg": "RS256", "typ": "at+jwt"},
+		map[string]interface{}{
+			"sub": "user123",
+			"cnf": map[string]interface{}{"x5t#S256": jwt.CertificateThumbprint(clientCert)},
+		},
+	)
+
+	tests := []struct {
+		name        string
+		peerCert    *x509.Certificate
+		expectedErr error
+	}{
+		{name: "Bound certificate presented", peerCert: clientCert},
+		{name: "Different certificate presented", peerCert: otherCert, expectedErr: errInvalidToken},
+		{name: "No certificate presented", expectedErr: errInvalidToken},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			mockJWT := jwtmock.NewJWTServiceInterfaceMock(suite.T())
+			mockJWT.On("VerifyJWT", token, "", "").Return(nil)
+			auth := newJWTAuthenticator(mockJWT, nil)
+
+			req := httptest.NewRequest(http.MethodGet, "/users", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			if tt.peerCert != nil {
+				req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{tt.peerCert}}
+			}
+
+			authCtx, err := auth.Authenticate(req)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(suite.T(), err, tt.expectedErr)
+				assert.Nil(suite.T(), authCtx)
+			} else {
+				assert.NoError(suite.T(), err)
+				assert.NotNil(suite.T(), authCtx)
+			}
+		})
+	}
+}
@@ -42,9 +42,11 @@ var publicPaths = []string{
 	"/flow/execute/**",
 	"/flow/meta",
 	"/oauth2/**",
+	"/saml2/**",
 	"/.well-known/openid-configuration/**",
 	"/.well-known/oauth-authorization-server/**",
 	"/.well-known/oauth-protected-resource",
+	"/.well-known/authzen-configuration",
 	"/gate/**",
 	"/console/**",
 	"/error/**",
@@ -154,6 +156,9 @@ type SystemPermissions struct {
 	UserTypeView  string
 	AgentType     string
 	AgentTypeView string
+	AuthZ         string
+	AuthZView     string
+	AuthZEvaluate string
 }
 
 // sysPerms holds the active system permissions, initialized by InitSystemPermissions.
@@ -187,6 +192,9 @@ func InitSystemPermissions(handle string) {
 		UserTypeView:  buildPermission(handle, "system", "usertype", "view"),
 		AgentType:     buildPermission(handle, "system", "agenttype"),
 		AgentTypeView: buildPermission(handle, "system", "agenttype", "view"),
+		AuthZ:         buildPermission(handle, "system", "authz"),
+		AuthZView:     buildPermission(handle, "system", "authz", "view"),
+		AuthZEvaluate: buildPermission(handle, "system", "authz", "evaluate"),
 	}
 	sysPerms = p
 
@@ -236,6 +244,8 @@ func InitSystemPermissions(handle string) {
 		{"GET /users/me/**", ""},
 		{"PUT /users/me/**", ""},
 		{"POST /users/me/update-credentials", ""},
+		{"POST /users/me/totp/**", ""},
+		{"DELETE /users/me/totp", ""},
 		{"GET /register/passkey/**", ""},
 		{"POST /register/passkey/**", ""},
 
@@ -278,6 +288,25 @@ func InitSystemPermissions(handle string) {
 		{"PUT /agent-types/**", p.AgentType},
 		{"DELETE /agent-types/**", p.AgentType},
 
+		// Access evaluation APIs — called by resource servers acting as policy enforcement points.
+		{"POST /access/v1/evaluation", p.AuthZEvaluate},
+		{"POST /access/v1/evaluations", p.AuthZEvaluate},
+
+		// Authorization policy APIs.
+		{"GET /authorization-policies", p.AuthZView},
+		{"POST /authorization-policies", p.AuthZ},
+		{"GET /authorization-policies/**", p.AuthZView},
+		{"PUT /authorization-policies/**", p.AuthZ},
+		{"DELETE /authorization-policies/**", p.AuthZ},
+
+		// Relationship APIs. Check, expand, and list-objects are called by policy enforcement points.
+		{"GET /relationships", p.AuthZView},
+		{"GET /relationships/namespaces", p.AuthZView},
+		{"POST /relationships/write", p.AuthZ},
+		{"POST /relationships/check", p.AuthZEvaluate},
+		{"POST /relationships/expand", p.AuthZEvaluate},
+		{"POST /relationships/list-objects", p.AuthZEvaluate},
+
 		// Import APIs.
 		{"POST /import", p.Root},
 		{"POST /import/delete", p.Root},
@@ -271,6 +271,9 @@ func TestInitSystemPermissions_EmptyHandle(t *testing.T) {
 	assert.Equal(t, "system:usertype:view", p.UserTypeView)
 	assert.Equal(t, "system:agenttype", p.AgentType)
 	assert.Equal(t, "system:agenttype:view", p.AgentTypeView)
+	assert.Equal(t, "system:authz", p.AuthZ)
+	assert.Equal(t, "system:authz:view", p.AuthZView)
+	assert.Equal(t, "system:authz:evaluate", p.AuthZEvaluate)
 }
 
 func TestInitSystemPermissions_NonEmptyHandle(t *testing.T) {
@@ -289,6 +292,9 @@ func TestInitSystemPermissions_NonEmptyHandle(t *testing.T) {
 	assert.Equal(t, "mgmt:system:usertype:view", p.UserTypeView)
 	assert.Equal(t, "mgmt:system:agenttype", p.AgentType)
 	assert.Equal(t, "mgmt:system:agenttype:view", p.AgentTypeView)
+	assert.Equal(t, "mgmt:system:authz", p.AuthZ)
+	assert.Equal(t, "mgmt:system:authz:view", p.AuthZView)
+	assert.Equal(t, "mgmt:system:authz:evaluate", p.AuthZEvaluate)
 
 	// Restore default for other tests.
 	InitSystemPermissions("")
@@ -340,6 +346,30 @@ func TestGetRequiredPermissionForAPI(t *testing.T) {
 		{name: "POST /users exact", method: http.MethodPost, path: "/users", wantPerm: p.User},
 		{name: "GET /groups exact", method: http.MethodGet, path: "/groups", wantPerm: p.GroupView},
 		{name: "POST /groups exact", method: http.MethodPost, path: "/groups", wantPerm: p.Group},
+		{
+			name:   "POST /access/v1/evaluation exact",
+			method: http.MethodPost, path: "/access/v1/evaluation", wantPerm: p.AuthZEvaluate,
+		},
+		{
+			name:   "POST /access/v1/evaluations exact",
+			method: http.MethodPost, path: "/access/v1/evaluations", wantPerm: p.AuthZEvaluate,
+		},
+		{
+			name:   "GET /authorization-policies exact",
+			method: http.MethodGet, path: "/authorization-policies", wantPerm: p.AuthZView,
+		},
+		{
+			name:   "DELETE /authorization-policies/{id} prefix",
+			method: http.MethodDelete, path: "/authorization-policies/policy-1", wantPerm: p.AuthZ,
+		},
+		{
+			name:   "POST /relationships/write exact",
+			method: http.MethodPost, path: "/relationships/write", wantPerm: p.AuthZ,
+		},
+		{
+			name:   "POST /relationships/check exact",
+			method: http.MethodPost, path: "/relationships/check", wantPerm: p.AuthZEvaluate,
+		},
 
 		// ---- Self-service paths (empty permission = any authenticated user) ----
 		{name: "GET /users/me self-service", method: http.MethodGet, path: "/users/me", wantPerm: ""},
@@ -350,6 +380,14 @@ func TestGetRequiredPermissionForAPI(t *testing.T) {
 			path:     "/users/me/update-credentials",
 			wantPerm: "",
 		},
+		{
+			name:   "POST /users/me/totp/enroll self-service",
+			method: http.MethodPost, path: "/users/me/totp/enroll", wantPerm: "",
+		},
+		{
+			name:   "DELETE /users/me/totp self-service",
+			method: http.MethodDelete, path: "/users/me/totp", wantPerm: "",
+		},
 		{
 			name:   "GET /register/passkey/start self-service",
 			method: http.MethodGet, path: "/register/passkey/start", wantPerm: "",
@@ -40,6 +40,8 @@ const (
 	ScenarioSelfRegistration ScenarioType = "SELF_REGISTRATION"
 	// ScenarioOTP represents the OTP verification scenario.
 	ScenarioOTP ScenarioType = "OTP"
+	// ScenarioBackchannelAuth represents the backchannel (CIBA) authentication approval scenario.
+	ScenarioBackchannelAuth ScenarioType = "BACKCHANNEL_AUTHENTICATION"
 )
 
 // supportedScenarios contains all valid scenario types.
@@ -48,6 +50,7 @@ var supportedScenarios = map[ScenarioType]bool{
 	ScenarioMagicLink:        true,
 	ScenarioSelfRegistration: true,
 	ScenarioOTP:              true,
+	ScenarioBackchannelAuth:  true,
 }
 
 // IsValidScenario checks if the given scenario type is supported.
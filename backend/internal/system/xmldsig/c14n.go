@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xmldsig
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+)
+
+// inclusiveDefaultPrefix is the token used in an InclusiveNamespaces PrefixList for the default namespace.
+const inclusiveDefaultPrefix = "#default"
+
+// Canonicalize returns the exclusive canonical form (without comments) of the element subtree.
+// Prefixes listed in inclusivePrefixes follow the inclusive rules, as with the InclusiveNamespaces
+// PrefixList parameter; "#default" stands for the default namespace.
+func Canonicalize(e *Element, inclusivePrefixes []string) []byte {
+	c := &canonicalizer{inclusive: make(map[string]bool, len(inclusivePrefixes))}
+	for _, prefix := range inclusivePrefixes {
+		if prefix == inclusiveDefaultPrefix {
+			prefix = ""
+		}
+		c.inclusive[prefix] = true
+	}
+	var buf bytes.Buffer
+	c.write(&buf, e, map[string]string{})
+	return buf.Bytes()
+}
+
+// CanonicalizeInclusive returns the canonical XML 1.0 form (without comments) of the element subtree.
+func CanonicalizeInclusive(e *Element) []byte {
+	c := &canonicalizer{all: true}
+	var buf bytes.Buffer
+	c.write(&buf, e, map[string]string{})
+	return buf.Bytes()
+}
+
+// canonicalize applies the canonicalization identified by the algorithm URI.
+func canonicalize(e *Element, algorithm string, inclusivePrefixes []string) ([]byte, error) {
+	switch algorithm {
+	case AlgorithmExclusiveC14N:
+		return Canonicalize(e, inclusivePrefixes), nil
+	case AlgorithmInclusiveC14N:
+		return CanonicalizeInclusive(e), nil
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+}
+
+type canonicalizer struct {
+	// all renders every namespace in scope, as canonical XML 1.0 does.
+	all bool
+	// inclusive holds the prefixes rendered with the inclusive rules under exclusive canonicalization.
+	inclusive map[string]bool
+}
+
+type namespaceDecl struct {
+	prefix string
+	uri    string
+}
+
+type canonicalAttr struct {
+	namespace string
+	qname     string
+	local     string
+	value     string
+}
+
+func (c *canonicalizer) write(buf *bytes.Buffer, e *Element, rendered map[string]string) {
+	decls, rendered := c.namespaceDecls(e, rendered)
+
+	qname := qualifiedName(e.Prefix, e.Local)
+	buf.WriteByte('<')
+	buf.WriteString(qname)
+	for _, decl := range decls {
+		if decl.prefix == "" {
+			buf.WriteString(` xmlns="`)
+		} else {
+			buf.WriteString(` xmlns:`)
+			buf.WriteString(decl.prefix)
+			buf.WriteString(`="`)
+		}
+		buf.WriteString(escapeAttr(decl.uri))
+		buf.WriteByte('"')
+	}
+
+	attrs := make([]canonicalAttr, 0, len(e.Attrs))
+	for _, attr := range e.Attrs {
+		if attr.isNamespaceDeclaration() {
+			continue
+		}
+		namespace := ""
+		if attr.Prefix != "" {
+			namespace = e.LookupNamespace(attr.Prefix)
+		}
+		attrs = append(attrs, canonicalAttr{
+			namespace: namespace,
+			qname:     qualifiedName(attr.Prefix, attr.Local),
+			local:     attr.Local,
+			value:     attr.Value,
+		})
+	}
+	sort.SliceStable(attrs, func(i, j int) bool {
+		if attrs[i].namespace != attrs[j].namespace {
+			return attrs[i].namespace < attrs[j].namespace
+		}
+		return attrs[i].local < attrs[j].local
+	})
+	for _, attr := range attrs {
+		buf.WriteByte(' ')
+		buf.WriteString(attr.qname)
+		buf.WriteString(`="`)
+		buf.WriteString(escapeAttr(attr.value))
+		buf.WriteByte('"')
+	}
+	buf.WriteByte('>')
+
+	for _, child := range e.Children {
+		switch node := child.(type) {
+		case *Element:
+			c.write(buf, node, rendered)
+		case CharData:
+			buf.WriteString(escapeText(string(node)))
+		}
+	}
+
+	buf.WriteString("</")
+	buf.WriteString(qname)
+	buf.WriteByte('>')
+}
+
+// namespaceDecls returns the namespace declarations to render on the element, sorted by prefix, and
+// the namespaces rendered in scope for its children.
+func (c *canonicalizer) namespaceDecls(e *Element, rendered map[string]string) (
+	[]namespaceDecl, map[string]string) {
+	candidates := make(map[string]bool)
+	if c.all {
+		for el := e; el != nil; el = el.parent {
+			for _, attr := range el.Attrs {
+				if attr.Prefix == prefixXMLNS {
+					candidates[attr.Local] = true
+				} else if attr.Prefix == "" && attr.Local == prefixXMLNS {
+					candidates[""] = true
+				}
+			}
+		}
+	} else {
+		candidates[e.Prefix] = true
+		for _, attr := range e.Attrs {
+			if attr.Prefix != "" && !attr.isNamespaceDeclaration() {
+				candidates[attr.Prefix] = true
+			}
+		}
+		for prefix := range c.inclusive {
+			if prefix == "" || e.LookupNamespace(prefix) != "" {
+				candidates[prefix] = true
+			}
+		}
+	}
+	delete(candidates, prefixXML)
+
+	var decls []namespaceDecl
+	for prefix := range candidates {
+		uri := e.LookupNamespace(prefix)
+		if prefix != "" && uri == "" {
+			continue
+		}
+		if rendered[prefix] == uri {
+			continue
+		}
+		decls = append(decls, namespaceDecl{prefix: prefix, uri: uri})
+	}
+	if len(decls) == 0 {
+		return nil, rendered
+	}
+
+	sort.Slice(decls, func(i, j int) bool { return decls[i].prefix < decls[j].prefix })
+	next := make(map[string]string, len(rendered)+len(decls))
+	for prefix, uri := range rendered {
+		next[prefix] = uri
+	}
+	for _, decl := range decls {
+		next[decl.prefix] = decl.uri
+	}
+	return decls, next
+}
+
+func qualifiedName(prefix, local string) string {
+	if prefix == "" {
+		return local
+	}
+	return prefix + ":" + local
+}
+
+var textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+
+var attrEscaper = strings.NewReplacer(
+	"&", "&amp;", "<", "&lt;", `"`, "&quot;", "\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;")
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func escapeAttr(s string) string {
+	return attrEscaper.Replace(s)
+}
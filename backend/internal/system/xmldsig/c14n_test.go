@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xmldsig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const specExampleDocument = `<n0:local xmlns:n0="foo:bar" xmlns:n3="ftp://example.org">` +
+	`<n1:elem2 xmlns:n1="http://example.net" xml:lang="en">` +
+	`<n3:stuff xmlns:n3="ftp://example.org"/></n1:elem2></n0:local>`
+
+type C14NTestSuite struct {
+	suite.Suite
+}
+
+func TestC14NTestSuite(t *testing.T) {
+	suite.Run(t, new(C14NTestSuite))
+}
+
+func (suite *C14NTestSuite) elem2() *Element {
+	root, err := Parse([]byte(specExampleDocument))
+	require.NoError(suite.T(), err)
+	return root.ChildElements()[0]
+}
+
+func (suite *C14NTestSuite) TestCanonicalize_ExclusiveSpecExample() {
+	assert.Equal(suite.T(),
+		`<n1:elem2 xmlns:n1="http://example.net" xml:lang="en">`+
+			`<n3:stuff xmlns:n3="ftp://example.org"></n3:stuff></n1:elem2>`,
+		string(Canonicalize(suite.elem2(), nil)))
+}
+
+func (suite *C14NTestSuite) TestCanonicalize_InclusiveSpecExample() {
+	assert.Equal(suite.T(),
+		`<n1:elem2 xmlns:n0="foo:bar" xmlns:n1="http://example.net" xmlns:n3="ftp://example.org" xml:lang="en">`+
+			`<n3:stuff></n3:stuff></n1:elem2>`,
+		string(CanonicalizeInclusive(suite.elem2())))
+}
+
+func (suite *C14NTestSuite) TestCanonicalize_InclusivePrefixList() {
+	assert.Equal(suite.T(),
+		`<n1:elem2 xmlns:n1="http://example.net" xmlns:n3="ftp://example.org" xml:lang="en">`+
+			`<n3:stuff></n3:stuff></n1:elem2>`,
+		string(Canonicalize(suite.elem2(), []string{"n3"})))
+}
+
+func (suite *C14NTestSuite) TestCanonicalize_SortsAttributesAndEscapes() {
+	root, err := Parse([]byte(`<?xml version="1.0"?><!-- comment -->` +
+		`<a xmlns="urn:a" xmlns:b="urn:b" z="1" b:y="&lt;2&quot;" x="a&#9;b">t &amp; &gt;<c/></a>`))
+	require.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(),
+		`<a xmlns="urn:a" xmlns:b="urn:b" x="a&#x9;b" z="1" b:y="&lt;2&quot;">t &amp; &gt;<c></c></a>`,
+		string(root.Bytes()))
+}
+
+func (suite *C14NTestSuite) TestCanonicalize_UndeclaresDefaultNamespace() {
+	root, err := Parse([]byte(`<a xmlns="urn:a"><b xmlns=""/></a>`))
+	require.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), `<a xmlns="urn:a"><b xmlns=""></b></a>`, string(root.Bytes()))
+	assert.Equal(suite.T(), `<b></b>`, string(Canonicalize(root.ChildElements()[0], nil)))
+}
+
+func (suite *C14NTestSuite) TestParse_Invalid() {
+	testCases := map[string]string{
+		"Doctype":       `<!DOCTYPE a [<!ENTITY x "y">]><a>&x;</a>`,
+		"UnboundPrefix": `<p:a/>`,
+		"Mismatched":    `<a><b></a></b>`,
+		"Incomplete":    `<a><b></b>`,
+		"TwoRoots":      `<a/><b/>`,
+		"Empty":         ``,
+	}
+	for name, document := range testCases {
+		suite.Run(name, func() {
+			_, err := Parse([]byte(document))
+			assert.ErrorIs(suite.T(), err, ErrMalformedXML)
+		})
+	}
+}
+
+func (suite *C14NTestSuite) TestElement_Navigation() {
+	root, err := Parse([]byte(`<p:a xmlns:p="urn:p" ID="x"><p:b>one</p:b><c/><p:b>two</p:b></p:a>`))
+	require.NoError(suite.T(), err)
+
+	assert.True(suite.T(), root.Is("urn:p", "a"))
+	assert.Equal(suite.T(), "x", root.AttrValue("ID"))
+	assert.Len(suite.T(), root.FindChildren("urn:p", "b"), 2)
+	assert.Equal(suite.T(), "one", root.FindChild("urn:p", "b").Text())
+	assert.Nil(suite.T(), root.FindChild("urn:p", "c"))
+
+	child := root.FindChild("", "c")
+	require.NotNil(suite.T(), child)
+	assert.Equal(suite.T(), root, child.Parent())
+	root.RemoveChild(child)
+	assert.Len(suite.T(), root.ChildElements(), 2)
+	assert.Nil(suite.T(), child.Parent())
+}
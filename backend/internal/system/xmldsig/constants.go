@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package xmldsig provides a minimal XML element tree together with exclusive XML canonicalization
+// and enveloped XML signatures as used by SAML 2.0.
+package xmldsig
+
+// Namespace of the XML signature syntax.
+const NamespaceXMLDSig = "http://www.w3.org/2000/09/xmldsig#"
+
+// Canonicalization algorithm URIs.
+const (
+	// AlgorithmExclusiveC14N is exclusive XML canonicalization without comments.
+	AlgorithmExclusiveC14N = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	// AlgorithmInclusiveC14N is canonical XML 1.0 without comments.
+	AlgorithmInclusiveC14N = "http://www.w3.org/TR/2001/REC-xml-c14n-20010315"
+	// AlgorithmEnvelopedSignature is the enveloped signature transform.
+	AlgorithmEnvelopedSignature = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+)
+
+// Digest algorithm URIs.
+const (
+	AlgorithmDigestSHA256 = "http://www.w3.org/2001/04/xmlenc#sha256"
+	AlgorithmDigestSHA384 = "http://www.w3.org/2001/04/xmldsig-more#sha384"
+	AlgorithmDigestSHA512 = "http://www.w3.org/2001/04/xmlenc#sha512"
+)
+
+// Signature algorithm URIs.
+const (
+	AlgorithmRSASHA256   = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	AlgorithmRSASHA512   = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha512"
+	AlgorithmECDSASHA256 = "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha256"
+	AlgorithmECDSASHA384 = "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha384"
+	AlgorithmECDSASHA512 = "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha512"
+)
+
+const (
+	prefixXMLNS    = "xmlns"
+	prefixXML      = "xml"
+	namespaceXML   = "http://www.w3.org/XML/1998/namespace"
+	prefixDS       = "ds"
+	maxParseDepth  = 64
+	maxParseTokens = 100000
+)
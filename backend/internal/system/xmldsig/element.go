@@ -0,0 +1,342 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xmldsig
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrMalformedXML is returned when a document cannot be parsed into an element tree.
+var ErrMalformedXML = errors.New("malformed XML document")
+
+// Node is a child of an Element. It is either an *Element or a CharData.
+type Node interface {
+	isNode()
+}
+
+// CharData is a text node.
+type CharData string
+
+func (CharData) isNode() {}
+
+// Attr is an attribute of an element. Namespace declarations are kept as attributes with the
+// "xmlns" prefix, or with the "xmlns" local name for the default namespace.
+type Attr struct {
+	Prefix string
+	Local  string
+	Value  string
+}
+
+// isNamespaceDeclaration reports whether the attribute declares a namespace.
+func (a Attr) isNamespaceDeclaration() bool {
+	return a.Prefix == prefixXMLNS || (a.Prefix == "" && a.Local == prefixXMLNS)
+}
+
+// Element is an XML element that keeps the prefixes and namespace declarations of the source document
+// so that it can be canonicalized.
+type Element struct {
+	Prefix   string
+	Local    string
+	Attrs    []Attr
+	Children []Node
+	parent   *Element
+}
+
+func (*Element) isNode() {}
+
+// NewElement creates a detached element with the given prefix and local name.
+func NewElement(prefix, local string) *Element {
+	return &Element{Prefix: prefix, Local: local}
+}
+
+// Parse parses an XML document and returns its root element. Comments and processing instructions
+// are dropped, and documents carrying a DOCTYPE are rejected.
+func Parse(data []byte) (*Element, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = true
+
+	var root *Element
+	var current *Element
+	depth := 0
+	for tokens := 0; ; tokens++ {
+		if tokens > maxParseTokens {
+			return nil, fmt.Errorf("%w: too many tokens", ErrMalformedXML)
+		}
+		token, err := decoder.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrMalformedXML, err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if current == nil && root != nil {
+				return nil, fmt.Errorf("%w: multiple root elements", ErrMalformedXML)
+			}
+			depth++
+			if depth > maxParseDepth {
+				return nil, fmt.Errorf("%w: document is nested too deeply", ErrMalformedXML)
+			}
+			el := &Element{Prefix: t.Name.Space, Local: t.Name.Local}
+			for _, attr := range t.Attr {
+				el.Attrs = append(el.Attrs, Attr{Prefix: attr.Name.Space, Local: attr.Name.Local, Value: attr.Value})
+			}
+			if current == nil {
+				root = el
+			} else {
+				current.AddChild(el)
+			}
+			current = el
+		case xml.EndElement:
+			if current == nil || current.Prefix != t.Name.Space || current.Local != t.Name.Local {
+				return nil, fmt.Errorf("%w: unexpected end element %s", ErrMalformedXML, t.Name.Local)
+			}
+			depth--
+			current = current.parent
+		case xml.CharData:
+			if current != nil {
+				current.Children = append(current.Children, CharData(t))
+			} else if len(bytes.TrimSpace(t)) > 0 {
+				return nil, fmt.Errorf("%w: text outside the root element", ErrMalformedXML)
+			}
+		case xml.Directive:
+			return nil, fmt.Errorf("%w: DOCTYPE is not allowed", ErrMalformedXML)
+		}
+	}
+
+	if root == nil || current != nil {
+		return nil, fmt.Errorf("%w: incomplete document", ErrMalformedXML)
+	}
+	if err := root.validateNamespaces(); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// validateNamespaces ensures every prefix used in the tree is bound to a namespace.
+func (e *Element) validateNamespaces() error {
+	if e.Prefix != "" && e.LookupNamespace(e.Prefix) == "" {
+		return fmt.Errorf("%w: unbound prefix %s", ErrMalformedXML, e.Prefix)
+	}
+	for _, attr := range e.Attrs {
+		if attr.Prefix != "" && !attr.isNamespaceDeclaration() && e.LookupNamespace(attr.Prefix) == "" {
+			return fmt.Errorf("%w: unbound prefix %s", ErrMalformedXML, attr.Prefix)
+		}
+	}
+	for _, child := range e.ChildElements() {
+		if err := child.validateNamespaces(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Parent returns the parent element, or nil for a root element.
+func (e *Element) Parent() *Element {
+	return e.parent
+}
+
+// NamespaceURI returns the namespace the element belongs to.
+func (e *Element) NamespaceURI() string {
+	return e.LookupNamespace(e.Prefix)
+}
+
+// Is reports whether the element has the given namespace and local name.
+func (e *Element) Is(namespace, local string) bool {
+	return e.Local == local && e.NamespaceURI() == namespace
+}
+
+// LookupNamespace resolves a prefix against the declarations in scope. An empty prefix resolves the
+// default namespace.
+func (e *Element) LookupNamespace(prefix string) string {
+	if prefix == prefixXML {
+		return namespaceXML
+	}
+	for el := e; el != nil; el = el.parent {
+		for _, attr := range el.Attrs {
+			if prefix == "" && attr.Prefix == "" && attr.Local == prefixXMLNS {
+				return attr.Value
+			}
+			if prefix != "" && attr.Prefix == prefixXMLNS && attr.Local == prefix {
+				return attr.Value
+			}
+		}
+	}
+	return ""
+}
+
+// DeclareNamespace adds a namespace declaration to the element. An empty prefix declares the default
+// namespace.
+func (e *Element) DeclareNamespace(prefix, namespace string) {
+	if prefix == "" {
+		e.setAttr(Attr{Local: prefixXMLNS, Value: namespace})
+		return
+	}
+	e.setAttr(Attr{Prefix: prefixXMLNS, Local: prefix, Value: namespace})
+}
+
+// AttrValue returns the value of the unprefixed attribute with the given name, or an empty string.
+func (e *Element) AttrValue(local string) string {
+	for _, attr := range e.Attrs {
+		if attr.Prefix == "" && attr.Local == local {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// SetAttr sets the value of an unprefixed attribute.
+func (e *Element) SetAttr(local, value string) {
+	e.setAttr(Attr{Local: local, Value: value})
+}
+
+func (e *Element) setAttr(attr Attr) {
+	for i := range e.Attrs {
+		if e.Attrs[i].Prefix == attr.Prefix && e.Attrs[i].Local == attr.Local {
+			e.Attrs[i].Value = attr.Value
+			return
+		}
+	}
+	e.Attrs = append(e.Attrs, attr)
+}
+
+// ChildElements returns the element children.
+func (e *Element) ChildElements() []*Element {
+	children := make([]*Element, 0, len(e.Children))
+	for _, child := range e.Children {
+		if el, ok := child.(*Element); ok {
+			children = append(children, el)
+		}
+	}
+	return children
+}
+
+// FindChild returns the first child element with the given namespace and local name.
+func (e *Element) FindChild(namespace, local string) *Element {
+	for _, child := range e.ChildElements() {
+		if child.Is(namespace, local) {
+			return child
+		}
+	}
+	return nil
+}
+
+// FindChildren returns all child elements with the given namespace and local name.
+func (e *Element) FindChildren(namespace, local string) []*Element {
+	var matches []*Element
+	for _, child := range e.ChildElements() {
+		if child.Is(namespace, local) {
+			matches = append(matches, child)
+		}
+	}
+	return matches
+}
+
+// Text returns the concatenated text content of the element's direct text children.
+func (e *Element) Text() string {
+	var sb strings.Builder
+	for _, child := range e.Children {
+		if text, ok := child.(CharData); ok {
+			sb.WriteString(string(text))
+		}
+	}
+	return sb.String()
+}
+
+// AddChild appends a node to the element.
+func (e *Element) AddChild(node Node) {
+	e.InsertChild(len(e.Children), node)
+}
+
+// AddElement creates a child element, appends it, and returns it.
+func (e *Element) AddElement(prefix, local string) *Element {
+	child := NewElement(prefix, local)
+	e.AddChild(child)
+	return child
+}
+
+// AddText appends a text node to the element.
+func (e *Element) AddText(text string) {
+	e.AddChild(CharData(text))
+}
+
+// InsertChild inserts a node at the given position among the element's children.
+func (e *Element) InsertChild(index int, node Node) {
+	if child, ok := node.(*Element); ok {
+		child.parent = e
+	}
+	if index < 0 || index > len(e.Children) {
+		index = len(e.Children)
+	}
+	e.Children = append(e.Children, nil)
+	copy(e.Children[index+1:], e.Children[index:])
+	e.Children[index] = node
+}
+
+// ChildIndex returns the position of the child among the element's children, or -1.
+func (e *Element) ChildIndex(child *Element) int {
+	for i, node := range e.Children {
+		if node == child {
+			return i
+		}
+	}
+	return -1
+}
+
+// RemoveChild detaches the child element from the element.
+func (e *Element) RemoveChild(child *Element) {
+	if i := e.ChildIndex(child); i >= 0 {
+		e.Children = append(e.Children[:i], e.Children[i+1:]...)
+		child.parent = nil
+	}
+}
+
+// Copy returns a deep copy of the element. The copy keeps a reference to the original parent so that
+// inherited namespace declarations still resolve.
+func (e *Element) Copy() *Element {
+	dup := e.copyTree()
+	dup.parent = e.parent
+	return dup
+}
+
+func (e *Element) copyTree() *Element {
+	dup := &Element{Prefix: e.Prefix, Local: e.Local, Attrs: append([]Attr(nil), e.Attrs...)}
+	for _, child := range e.Children {
+		if el, ok := child.(*Element); ok {
+			childCopy := el.copyTree()
+			childCopy.parent = dup
+			dup.Children = append(dup.Children, childCopy)
+			continue
+		}
+		dup.Children = append(dup.Children, child)
+	}
+	return dup
+}
+
+// Bytes serializes the element in its exclusive canonical form.
+func (e *Element) Bytes() []byte {
+	return Canonicalize(e, nil)
+}
@@ -0,0 +1,349 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xmldsig
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/asgardeo/thunder/internal/system/cryptolab"
+)
+
+var (
+	// ErrSignatureNotFound is returned when the element does not carry exactly one enveloped signature.
+	ErrSignatureNotFound = errors.New("XML signature not found")
+	// ErrInvalidSignature is returned when a signature is malformed or does not verify.
+	ErrInvalidSignature = errors.New("invalid XML signature")
+	// ErrUnsupportedAlgorithm is returned for algorithms outside the supported set.
+	ErrUnsupportedAlgorithm = errors.New("unsupported XML signature algorithm")
+	// ErrMissingID is returned when the element to sign or verify has no ID attribute.
+	ErrMissingID = errors.New("element has no ID attribute")
+)
+
+// idAttribute is the attribute that identifies signed elements in SAML.
+const idAttribute = "ID"
+
+// SignFunc produces a signature over the content using the configured key. ECDSA signatures are
+// returned ASN.1 encoded, as the key manager does.
+type SignFunc func(content []byte) ([]byte, error)
+
+// SignatureAlgorithm maps an XML signature algorithm URI to the matching sign algorithm.
+func SignatureAlgorithm(algorithmURI string) (cryptolab.SignAlgorithm, error) {
+	switch algorithmURI {
+	case AlgorithmRSASHA256:
+		return cryptolab.RSASHA256, nil
+	case AlgorithmRSASHA512:
+		return cryptolab.RSASHA512, nil
+	case AlgorithmECDSASHA256:
+		return cryptolab.ECDSASHA256, nil
+	case AlgorithmECDSASHA384:
+		return cryptolab.ECDSASHA384, nil
+	case AlgorithmECDSASHA512:
+		return cryptolab.ECDSASHA512, nil
+	default:
+		return "", ErrUnsupportedAlgorithm
+	}
+}
+
+// SignatureAlgorithmURI returns the XML signature algorithm URI for a signing key.
+func SignatureAlgorithmURI(publicKey crypto.PublicKey) (string, error) {
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		switch key.Curve.Params().BitSize {
+		case 256:
+			return AlgorithmECDSASHA256, nil
+		case 384:
+			return AlgorithmECDSASHA384, nil
+		case 521:
+			return AlgorithmECDSASHA512, nil
+		}
+		return "", ErrUnsupportedAlgorithm
+	case *rsa.PublicKey:
+		return AlgorithmRSASHA256, nil
+	default:
+		return "", ErrUnsupportedAlgorithm
+	}
+}
+
+// SignValue signs the content and encodes the signature as required by the algorithm. ECDSA values
+// are converted to the fixed-size r||s form used by XML signatures and the SAML HTTP-Redirect binding.
+func SignValue(content []byte, algorithmURI string, sign SignFunc, cert *x509.Certificate) ([]byte, error) {
+	if _, err := SignatureAlgorithm(algorithmURI); err != nil {
+		return nil, err
+	}
+	signature, err := sign(content)
+	if err != nil {
+		return nil, err
+	}
+	if !isECDSA(algorithmURI) {
+		return signature, nil
+	}
+	if cert == nil {
+		return nil, fmt.Errorf("%w: certificate required for ECDSA", ErrInvalidSignature)
+	}
+	publicKey, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: certificate key does not match algorithm", ErrInvalidSignature)
+	}
+	return ecdsaASN1ToRaw(signature, publicKey)
+}
+
+// VerifyValue verifies a signature value produced with the algorithm against the certificate key.
+func VerifyValue(content, signature []byte, algorithmURI string, cert *x509.Certificate) error {
+	alg, err := SignatureAlgorithm(algorithmURI)
+	if err != nil {
+		return err
+	}
+	if cert == nil {
+		return ErrInvalidSignature
+	}
+	if isECDSA(algorithmURI) {
+		publicKey, ok := cert.PublicKey.(*ecdsa.PublicKey)
+		if !ok {
+			return ErrInvalidSignature
+		}
+		if signature, err = ecdsaRawToASN1(signature, publicKey); err != nil {
+			return ErrInvalidSignature
+		}
+	}
+	if err := cryptolab.Verify(content, signature, alg, cert.PublicKey); err != nil {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// SignEnveloped signs the element with an enveloped signature that is inserted as a child at the
+// given position. The element must carry an ID attribute, which the signature references.
+func SignEnveloped(e *Element, position int, algorithmURI string, sign SignFunc, cert *x509.Certificate) error {
+	id := e.AttrValue(idAttribute)
+	if id == "" {
+		return ErrMissingID
+	}
+	digest, err := digest(AlgorithmDigestSHA256, Canonicalize(e, nil))
+	if err != nil {
+		return err
+	}
+
+	signature := NewElement(prefixDS, "Signature")
+	signature.DeclareNamespace(prefixDS, NamespaceXMLDSig)
+	signedInfo := signature.AddElement(prefixDS, "SignedInfo")
+	signedInfo.AddElement(prefixDS, "CanonicalizationMethod").SetAttr("Algorithm", AlgorithmExclusiveC14N)
+	signedInfo.AddElement(prefixDS, "SignatureMethod").SetAttr("Algorithm", algorithmURI)
+	reference := signedInfo.AddElement(prefixDS, "Reference")
+	reference.SetAttr("URI", "#"+id)
+	transforms := reference.AddElement(prefixDS, "Transforms")
+	transforms.AddElement(prefixDS, "Transform").SetAttr("Algorithm", AlgorithmEnvelopedSignature)
+	transforms.AddElement(prefixDS, "Transform").SetAttr("Algorithm", AlgorithmExclusiveC14N)
+	reference.AddElement(prefixDS, "DigestMethod").SetAttr("Algorithm", AlgorithmDigestSHA256)
+	reference.AddElement(prefixDS, "DigestValue").AddText(base64.StdEncoding.EncodeToString(digest))
+
+	e.InsertChild(position, signature)
+	value, err := SignValue(Canonicalize(signedInfo, nil), algorithmURI, sign, cert)
+	if err != nil {
+		e.RemoveChild(signature)
+		return err
+	}
+	signature.AddElement(prefixDS, "SignatureValue").AddText(base64.StdEncoding.EncodeToString(value))
+	if cert != nil {
+		keyInfo := signature.AddElement(prefixDS, "KeyInfo")
+		keyInfo.AddElement(prefixDS, "X509Data").AddElement(prefixDS, "X509Certificate").
+			AddText(base64.StdEncoding.EncodeToString(cert.Raw))
+	}
+	return nil
+}
+
+// IsSigned reports whether the element carries an enveloped signature.
+func IsSigned(e *Element) bool {
+	return e.FindChild(NamespaceXMLDSig, "Signature") != nil
+}
+
+// VerifyEnveloped verifies the enveloped signature of the element against the trusted certificates.
+// The signature must reference the element itself, so a successful result covers the whole element.
+func VerifyEnveloped(e *Element, certs []*x509.Certificate) error {
+	signatures := e.FindChildren(NamespaceXMLDSig, "Signature")
+	if len(signatures) != 1 {
+		return ErrSignatureNotFound
+	}
+	signature := signatures[0]
+	id := e.AttrValue(idAttribute)
+	if id == "" {
+		return ErrMissingID
+	}
+
+	signedInfo := signature.FindChild(NamespaceXMLDSig, "SignedInfo")
+	if signedInfo == nil {
+		return fmt.Errorf("%w: missing SignedInfo", ErrInvalidSignature)
+	}
+	c14nMethod := signedInfo.FindChild(NamespaceXMLDSig, "CanonicalizationMethod")
+	signatureMethod := signedInfo.FindChild(NamespaceXMLDSig, "SignatureMethod")
+	references := signedInfo.FindChildren(NamespaceXMLDSig, "Reference")
+	if c14nMethod == nil || signatureMethod == nil || len(references) != 1 {
+		return fmt.Errorf("%w: malformed SignedInfo", ErrInvalidSignature)
+	}
+	if references[0].AttrValue("URI") != "#"+id {
+		return fmt.Errorf("%w: reference does not match the signed element", ErrInvalidSignature)
+	}
+	if err := verifyReference(e, signature, references[0]); err != nil {
+		return err
+	}
+
+	canonicalSignedInfo, err := canonicalize(signedInfo, c14nMethod.AttrValue("Algorithm"),
+		inclusivePrefixes(c14nMethod))
+	if err != nil {
+		return err
+	}
+	signatureValue := signature.FindChild(NamespaceXMLDSig, "SignatureValue")
+	if signatureValue == nil {
+		return fmt.Errorf("%w: missing SignatureValue", ErrInvalidSignature)
+	}
+	value, err := base64.StdEncoding.DecodeString(stripWhitespace(signatureValue.Text()))
+	if err != nil {
+		return fmt.Errorf("%w: malformed SignatureValue", ErrInvalidSignature)
+	}
+
+	algorithmURI := signatureMethod.AttrValue("Algorithm")
+	if _, err := SignatureAlgorithm(algorithmURI); err != nil {
+		return err
+	}
+	for _, cert := range certs {
+		if VerifyValue(canonicalSignedInfo, value, algorithmURI, cert) == nil {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// verifyReference recomputes the digest of the element without its signature and compares it with
+// the digest recorded in the reference.
+func verifyReference(e, signature, reference *Element) error {
+	c14nAlgorithm := AlgorithmInclusiveC14N
+	var prefixes []string
+	enveloped := false
+	if transforms := reference.FindChild(NamespaceXMLDSig, "Transforms"); transforms != nil {
+		for _, transform := range transforms.FindChildren(NamespaceXMLDSig, "Transform") {
+			switch algorithm := transform.AttrValue("Algorithm"); algorithm {
+			case AlgorithmEnvelopedSignature:
+				enveloped = true
+			case AlgorithmExclusiveC14N, AlgorithmInclusiveC14N:
+				c14nAlgorithm = algorithm
+				prefixes = inclusivePrefixes(transform)
+			default:
+				return ErrUnsupportedAlgorithm
+			}
+		}
+	}
+	if !enveloped {
+		return fmt.Errorf("%w: enveloped signature transform required", ErrInvalidSignature)
+	}
+
+	index := e.ChildIndex(signature)
+	unsigned := e.Copy()
+	unsigned.Children = append(unsigned.Children[:index], unsigned.Children[index+1:]...)
+	canonical, err := canonicalize(unsigned, c14nAlgorithm, prefixes)
+	if err != nil {
+		return err
+	}
+
+	digestMethod := reference.FindChild(NamespaceXMLDSig, "DigestMethod")
+	digestValue := reference.FindChild(NamespaceXMLDSig, "DigestValue")
+	if digestMethod == nil || digestValue == nil {
+		return fmt.Errorf("%w: malformed Reference", ErrInvalidSignature)
+	}
+	computed, err := digest(digestMethod.AttrValue("Algorithm"), canonical)
+	if err != nil {
+		return err
+	}
+	expected, err := base64.StdEncoding.DecodeString(stripWhitespace(digestValue.Text()))
+	if err != nil || subtle.ConstantTimeCompare(computed, expected) != 1 {
+		return fmt.Errorf("%w: digest mismatch", ErrInvalidSignature)
+	}
+	return nil
+}
+
+// inclusivePrefixes reads the InclusiveNamespaces PrefixList of an exclusive canonicalization element.
+func inclusivePrefixes(e *Element) []string {
+	for _, child := range e.ChildElements() {
+		if child.Local == "InclusiveNamespaces" && child.NamespaceURI() == AlgorithmExclusiveC14N {
+			return strings.Fields(child.AttrValue("PrefixList"))
+		}
+	}
+	return nil
+}
+
+func digest(algorithmURI string, content []byte) ([]byte, error) {
+	var hash crypto.Hash
+	switch algorithmURI {
+	case AlgorithmDigestSHA256:
+		hash = crypto.SHA256
+	case AlgorithmDigestSHA384:
+		hash = crypto.SHA384
+	case AlgorithmDigestSHA512:
+		hash = crypto.SHA512
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+	h := hash.New()
+	h.Write(content)
+	return h.Sum(nil), nil
+}
+
+func isECDSA(algorithmURI string) bool {
+	return algorithmURI == AlgorithmECDSASHA256 || algorithmURI == AlgorithmECDSASHA384 ||
+		algorithmURI == AlgorithmECDSASHA512
+}
+
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+func ecdsaASN1ToRaw(signature []byte, publicKey *ecdsa.PublicKey) ([]byte, error) {
+	var sig ecdsaSignature
+	if _, err := asn1.Unmarshal(signature, &sig); err != nil {
+		return nil, err
+	}
+	size := (publicKey.Curve.Params().BitSize + 7) / 8
+	raw := make([]byte, 2*size)
+	sig.R.FillBytes(raw[:size])
+	sig.S.FillBytes(raw[size:])
+	return raw, nil
+}
+
+func ecdsaRawToASN1(signature []byte, publicKey *ecdsa.PublicKey) ([]byte, error) {
+	size := (publicKey.Curve.Params().BitSize + 7) / 8
+	if len(signature) != 2*size {
+		return nil, ErrInvalidSignature
+	}
+	return asn1.Marshal(ecdsaSignature{
+		R: new(big.Int).SetBytes(signature[:size]),
+		S: new(big.Int).SetBytes(signature[size:]),
+	})
+}
+
+func stripWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), "")
+}
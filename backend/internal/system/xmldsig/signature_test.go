@@ -0,0 +1,191 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xmldsig
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/asgardeo/thunder/internal/system/cryptolab"
+)
+
+const testSignedDocument = `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ID="_r1">` +
+	`<saml:Issuer xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion">https://idp.example.com</saml:Issuer>` +
+	`<samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>` +
+	`</samlp:Response>`
+
+type SignatureTestSuite struct {
+	suite.Suite
+	rsaKey  *rsa.PrivateKey
+	rsaCert *x509.Certificate
+	ecKey   *ecdsa.PrivateKey
+	ecCert  *x509.Certificate
+}
+
+func TestSignatureTestSuite(t *testing.T) {
+	suite.Run(t, new(SignatureTestSuite))
+}
+
+func (suite *SignatureTestSuite) SetupSuite() {
+	var err error
+	suite.rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(suite.T(), err)
+	suite.rsaCert = suite.selfSignedCert(suite.rsaKey, &suite.rsaKey.PublicKey)
+	suite.ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(suite.T(), err)
+	suite.ecCert = suite.selfSignedCert(suite.ecKey, &suite.ecKey.PublicKey)
+}
+
+func (suite *SignatureTestSuite) selfSignedCert(key crypto.Signer, publicKey crypto.PublicKey) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, publicKey, key)
+	require.NoError(suite.T(), err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(suite.T(), err)
+	return cert
+}
+
+func (suite *SignatureTestSuite) signer(alg cryptolab.SignAlgorithm, key crypto.PrivateKey) SignFunc {
+	return func(content []byte) ([]byte, error) {
+		return cryptolab.Generate(content, alg, key)
+	}
+}
+
+func (suite *SignatureTestSuite) signedDocument(algorithmURI string, alg cryptolab.SignAlgorithm,
+	key crypto.PrivateKey, cert *x509.Certificate) []byte {
+	root, err := Parse([]byte(testSignedDocument))
+	require.NoError(suite.T(), err)
+	require.NoError(suite.T(), SignEnveloped(root, 1, algorithmURI, suite.signer(alg, key), cert))
+	return root.Bytes()
+}
+
+func (suite *SignatureTestSuite) TestSignAndVerify_RSA() {
+	signed := suite.signedDocument(AlgorithmRSASHA256, cryptolab.RSASHA256, suite.rsaKey, suite.rsaCert)
+
+	root, err := Parse(signed)
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), IsSigned(root))
+	assert.Equal(suite.T(), "Signature", root.ChildElements()[1].Local)
+	assert.NoError(suite.T(), VerifyEnveloped(root, []*x509.Certificate{suite.rsaCert}))
+}
+
+func (suite *SignatureTestSuite) TestSignAndVerify_ECDSA() {
+	signed := suite.signedDocument(AlgorithmECDSASHA256, cryptolab.ECDSASHA256, suite.ecKey, suite.ecCert)
+
+	root, err := Parse(signed)
+	require.NoError(suite.T(), err)
+	assert.NoError(suite.T(), VerifyEnveloped(root, []*x509.Certificate{suite.rsaCert, suite.ecCert}))
+}
+
+func (suite *SignatureTestSuite) TestVerify_SurvivesReindentation() {
+	signed := suite.signedDocument(AlgorithmRSASHA256, cryptolab.RSASHA256, suite.rsaKey, suite.rsaCert)
+	// Whitespace inside the signature element does not affect the signed content.
+	reformatted := strings.Replace(string(signed), "<ds:SignedInfo>", "\n  <ds:SignedInfo>", 1)
+	reformatted = `<?xml version="1.0" encoding="UTF-8"?>` + "\n" + reformatted
+
+	root, err := Parse([]byte(reformatted))
+	require.NoError(suite.T(), err)
+	assert.NoError(suite.T(), VerifyEnveloped(root, []*x509.Certificate{suite.rsaCert}))
+}
+
+func (suite *SignatureTestSuite) TestVerify_TamperedContent() {
+	signed := suite.signedDocument(AlgorithmRSASHA256, cryptolab.RSASHA256, suite.rsaKey, suite.rsaCert)
+	tampered := strings.Replace(string(signed), "https://idp.example.com", "https://evil.example.com", 1)
+
+	root, err := Parse([]byte(tampered))
+	require.NoError(suite.T(), err)
+	assert.ErrorIs(suite.T(), VerifyEnveloped(root, []*x509.Certificate{suite.rsaCert}), ErrInvalidSignature)
+}
+
+func (suite *SignatureTestSuite) TestVerify_UntrustedCertificate() {
+	signed := suite.signedDocument(AlgorithmRSASHA256, cryptolab.RSASHA256, suite.rsaKey, suite.rsaCert)
+
+	root, err := Parse(signed)
+	require.NoError(suite.T(), err)
+	assert.ErrorIs(suite.T(), VerifyEnveloped(root, []*x509.Certificate{suite.ecCert}), ErrInvalidSignature)
+	assert.ErrorIs(suite.T(), VerifyEnveloped(root, nil), ErrInvalidSignature)
+}
+
+func (suite *SignatureTestSuite) TestVerify_ReferenceToAnotherElement() {
+	signed := suite.signedDocument(AlgorithmRSASHA256, cryptolab.RSASHA256, suite.rsaKey, suite.rsaCert)
+	wrapped := strings.Replace(string(signed), `ID="_r1"`, `ID="_r2"`, 1)
+
+	root, err := Parse([]byte(wrapped))
+	require.NoError(suite.T(), err)
+	assert.ErrorIs(suite.T(), VerifyEnveloped(root, []*x509.Certificate{suite.rsaCert}), ErrInvalidSignature)
+}
+
+func (suite *SignatureTestSuite) TestVerify_Unsigned() {
+	root, err := Parse([]byte(testSignedDocument))
+	require.NoError(suite.T(), err)
+
+	assert.False(suite.T(), IsSigned(root))
+	assert.ErrorIs(suite.T(), VerifyEnveloped(root, []*x509.Certificate{suite.rsaCert}), ErrSignatureNotFound)
+}
+
+func (suite *SignatureTestSuite) TestSignEnveloped_MissingID() {
+	root, err := Parse([]byte(`<a/>`))
+	require.NoError(suite.T(), err)
+
+	err = SignEnveloped(root, 0, AlgorithmRSASHA256, suite.signer(cryptolab.RSASHA256, suite.rsaKey), nil)
+	assert.ErrorIs(suite.T(), err, ErrMissingID)
+}
+
+func (suite *SignatureTestSuite) TestSignAndVerifyValue() {
+	content := []byte("SAMLRequest=abc&SigAlg=x")
+
+	value, err := SignValue(content, AlgorithmECDSASHA256, suite.signer(cryptolab.ECDSASHA256, suite.ecKey),
+		suite.ecCert)
+	require.NoError(suite.T(), err)
+	assert.Len(suite.T(), value, 64)
+	assert.NoError(suite.T(), VerifyValue(content, value, AlgorithmECDSASHA256, suite.ecCert))
+	assert.ErrorIs(suite.T(), VerifyValue([]byte("other"), value, AlgorithmECDSASHA256, suite.ecCert),
+		ErrInvalidSignature)
+
+	_, err = SignValue(content, "urn:unknown", suite.signer(cryptolab.RSASHA256, suite.rsaKey), nil)
+	assert.ErrorIs(suite.T(), err, ErrUnsupportedAlgorithm)
+}
+
+func (suite *SignatureTestSuite) TestSignatureAlgorithmURI() {
+	uri, err := SignatureAlgorithmURI(&suite.rsaKey.PublicKey)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), AlgorithmRSASHA256, uri)
+
+	uri, err = SignatureAlgorithmURI(&suite.ecKey.PublicKey)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), AlgorithmECDSASHA256, uri)
+}
@@ -99,8 +99,8 @@ func (_c *UserServiceInterfaceMock_CreateUser_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *UserServiceInterfaceMock_CreateUser_Call) Return(user1 *User, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_CreateUser_Call {
-	_c.Call.Return(user1, serviceError)
+func (_c *UserServiceInterfaceMock_CreateUser_Call) Return(user *User, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_CreateUser_Call {
+	_c.Call.Return(user, serviceError)
 	return _c
 }
 
@@ -584,6 +584,65 @@ func (_c *UserServiceInterfaceMock_GetUsersByPath_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// UnlockUser provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) UnlockUser(ctx context.Context, userID string) *serviceerror.ServiceError {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockUser")
+	}
+
+	var r0 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *serviceerror.ServiceError); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*serviceerror.ServiceError)
+		}
+	}
+	return r0
+}
+
+// UserServiceInterfaceMock_UnlockUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockUser'
+type UserServiceInterfaceMock_UnlockUser_Call struct {
+	*mock.Call
+}
+
+// UnlockUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UserServiceInterfaceMock_Expecter) UnlockUser(ctx interface{}, userID interface{}) *UserServiceInterfaceMock_UnlockUser_Call {
+	return &UserServiceInterfaceMock_UnlockUser_Call{Call: _e.mock.On("UnlockUser", ctx, userID)}
+}
+
+func (_c *UserServiceInterfaceMock_UnlockUser_Call) Run(run func(ctx context.Context, userID string)) *UserServiceInterfaceMock_UnlockUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_UnlockUser_Call) Return(serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_UnlockUser_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_UnlockUser_Call) RunAndReturn(run func(ctx context.Context, userID string) *serviceerror.ServiceError) *UserServiceInterfaceMock_UnlockUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUser provides a mock function for the type UserServiceInterfaceMock
 func (_mock *UserServiceInterfaceMock) UpdateUser(ctx context.Context, userID string, user *User) (*User, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, userID, user)
@@ -650,8 +709,8 @@ func (_c *UserServiceInterfaceMock_UpdateUser_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *UserServiceInterfaceMock_UpdateUser_Call) Return(user1 *User, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_UpdateUser_Call {
-	_c.Call.Return(user1, serviceError)
+func (_c *UserServiceInterfaceMock_UpdateUser_Call) Return(user *User, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_UpdateUser_Call {
+	_c.Call.Return(user, serviceError)
 	return _c
 }
 
@@ -313,6 +313,19 @@ var (
 			DefaultValue: "Multiple users match the provided filters",
 		},
 	}
+	// ErrorAccountLockoutDisabled is the error returned when unlocking a user while account lockout is disabled.
+	ErrorAccountLockoutDisabled = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USR-1027",
+		Error: core.I18nMessage{
+			Key:          "error.userservice.account_lockout_disabled",
+			DefaultValue: "Account lockout disabled",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userservice.account_lockout_disabled_description",
+			DefaultValue: "Account lockout is not enabled on the server",
+		},
+	}
 )
 
 // Error variables
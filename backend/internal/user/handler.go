@@ -255,6 +255,28 @@ func (uh *userHandler) HandleUserDeleteRequest(w http.ResponseWriter, r *http.Re
 	logger.Debug("User DELETE response sent", log.MaskedString(log.LoggerKeyUserID, id))
 }
 
+// HandleUserUnlockRequest handles the unlock user request.
+func (uh *userHandler) HandleUserUnlockRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	if id == "" {
+		handleError(w, &ErrorMissingUserID)
+		return
+	}
+
+	svcErr := uh.userService.UnlockUser(ctx, id)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+
+	logger.Debug("User unlock response sent", log.MaskedString(log.LoggerKeyUserID, id))
+}
+
 // HandleUserListByPathRequest handles the list users by OU path request.
 func (uh *userHandler) HandleUserListByPathRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
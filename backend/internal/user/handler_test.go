@@ -669,6 +669,46 @@ func TestHandleUserDeleteRequest_ErrorCases(t *testing.T) {
 	})
 }
 
+func TestHandleUserUnlockRequest(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	handler := newUserHandler(mockSvc)
+	userID := "u1"
+
+	t.Run("Success", func(t *testing.T) {
+		mockSvc.On("UnlockUser", mock.Anything, userID).Return(nil).Once()
+		req := httptest.NewRequest(http.MethodPost, "/users/"+userID+"/unlock", nil)
+		req.SetPathValue("id", userID)
+		rr := httptest.NewRecorder()
+		handler.HandleUserUnlockRequest(rr, req)
+		require.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("MissingID", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/users//unlock", nil)
+		rr := httptest.NewRecorder()
+		handler.HandleUserUnlockRequest(rr, req)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("LockoutDisabled", func(t *testing.T) {
+		mockSvc.On("UnlockUser", mock.Anything, userID).Return(&ErrorAccountLockoutDisabled).Once()
+		req := httptest.NewRequest(http.MethodPost, "/users/"+userID+"/unlock", nil)
+		req.SetPathValue("id", userID)
+		rr := httptest.NewRecorder()
+		handler.HandleUserUnlockRequest(rr, req)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("ServiceError", func(t *testing.T) {
+		mockSvc.On("UnlockUser", mock.Anything, userID).Return(&serviceerror.InternalServerError).Once()
+		req := httptest.NewRequest(http.MethodPost, "/users/"+userID+"/unlock", nil)
+		req.SetPathValue("id", userID)
+		rr := httptest.NewRecorder()
+		handler.HandleUserUnlockRequest(rr, req)
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
 func TestHandleError_ErrorUnauthorized_Returns403(t *testing.T) {
 	tests := []struct {
 		name     string
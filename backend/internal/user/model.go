@@ -21,6 +21,7 @@ package user
 import (
 	"encoding/json"
 
+	"github.com/asgardeo/thunder/internal/authn/lockout"
 	"github.com/asgardeo/thunder/internal/entity"
 	"github.com/asgardeo/thunder/internal/system/cryptolab/hash"
 	"github.com/asgardeo/thunder/internal/system/utils"
@@ -35,6 +36,8 @@ type User struct {
 	Attributes json.RawMessage `json:"attributes,omitempty"`
 	Display    string          `json:"display,omitempty"`
 	IsReadOnly bool            `json:"isReadOnly"`
+	// LockStatus is the account lockout state of the user, populated when retrieving a single user.
+	LockStatus *lockout.LockStatus `json:"lockStatus,omitempty"`
 }
 
 // Credential represents the credentials of a user.
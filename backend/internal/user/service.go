@@ -27,6 +27,7 @@ import (
 	"path"
 	"strings"
 
+	"github.com/asgardeo/thunder/internal/authn/lockout"
 	"github.com/asgardeo/thunder/internal/entity"
 	"github.com/asgardeo/thunder/internal/entitytype"
 	oupkg "github.com/asgardeo/thunder/internal/ou"
@@ -58,6 +59,7 @@ type UserServiceInterface interface {
 	UpdateUserCredentials(ctx context.Context, userID string,
 		credentials json.RawMessage) *serviceerror.ServiceError
 	DeleteUser(ctx context.Context, userID string) *serviceerror.ServiceError
+	UnlockUser(ctx context.Context, userID string) *serviceerror.ServiceError
 }
 
 // userService is the default implementation of the UserServiceInterface.
@@ -66,6 +68,7 @@ type userService struct {
 	entityService     entity.EntityServiceInterface
 	ouService         oupkg.OrganizationUnitServiceInterface
 	entityTypeService entitytype.EntityTypeServiceInterface
+	lockoutService    lockout.LockoutServiceInterface
 }
 
 // newUserService creates a new instance of userService with injected dependencies.
@@ -74,12 +77,14 @@ func newUserService(
 	entityService entity.EntityServiceInterface,
 	ouService oupkg.OrganizationUnitServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
+	lockoutService lockout.LockoutServiceInterface,
 ) UserServiceInterface {
 	return &userService{
 		authzService:      authzService,
 		entityService:     entityService,
 		ouService:         ouService,
 		entityTypeService: entityTypeService,
+		lockoutService:    lockoutService,
 	}
 }
 
@@ -416,6 +421,16 @@ func (us *userService) GetUser(
 		}
 	}
 
+	if us.lockoutService != nil && us.lockoutService.IsEnabled() {
+		lockStatus, svcErr := us.lockoutService.GetLockStatus(ctx, userID)
+		if svcErr != nil {
+			logger.Warn("Failed to resolve lock status for user, skipping",
+				log.Any("error", svcErr))
+		} else {
+			user.LockStatus = lockStatus
+		}
+	}
+
 	logger.Debug("Successfully retrieved user", log.MaskedString(log.LoggerKeyUserID, userID))
 	return &user, nil
 }
@@ -772,6 +787,47 @@ func (us *userService) DeleteUser(ctx context.Context, userID string) *serviceer
 	return nil
 }
 
+// UnlockUser clears the account lock and failed login attempts of the user.
+func (us *userService) UnlockUser(ctx context.Context, userID string) *serviceerror.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	logger.Debug("Unlocking user", log.MaskedString(log.LoggerKeyUserID, userID))
+
+	if userID == "" {
+		return &ErrorMissingUserID
+	}
+
+	// Fetch the user to resolve the OU ID for the authorization check.
+	existingEntity, err := us.entityService.GetEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			logger.Debug("User not found", log.MaskedString(log.LoggerKeyUserID, userID))
+			return &ErrorUserNotFound
+		}
+		return logErrorAndReturnServerError(logger, "Failed to retrieve user", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	if existingEntity.Category != entity.EntityCategoryUser {
+		return &ErrorUserNotFound
+	}
+	existingUser := entityToUser(existingEntity)
+
+	// Check authz using the user's OU ID.
+	if svcErr := us.checkUserAccess(
+		ctx, security.ActionUpdateUser, existingUser.OUID, userID); svcErr != nil {
+		return svcErr
+	}
+
+	if us.lockoutService == nil || !us.lockoutService.IsEnabled() {
+		return &ErrorAccountLockoutDisabled
+	}
+	if svcErr := us.lockoutService.Unlock(ctx, userID); svcErr != nil {
+		return svcErr
+	}
+
+	logger.Debug("Successfully unlocked user", log.MaskedString(log.LoggerKeyUserID, userID))
+	return nil
+}
+
 // populateUserDisplayNames resolves display names for a slice of users in-place.
 // It batch-fetches display attribute paths from the entity type service and extracts the
 // display value from each user's attributes. Falls back to user ID if extraction fails.
@@ -950,6 +1006,11 @@ func logErrorAndReturnServerError(
 // mapEntityError maps entity service errors to user service errors.
 // Returns nil if the error is not a recognized entity error.
 func mapEntityError(err error) *serviceerror.ServiceError {
+	var policyErr *entity.PasswordPolicyError
+	if errors.As(err, &policyErr) {
+		return policyErr.Violation
+	}
+
 	switch {
 	case errors.Is(err, entity.ErrEntityNotFound):
 		return &ErrorUserNotFound
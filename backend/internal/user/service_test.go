@@ -22,20 +22,24 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/asgardeo/thunder/internal/authn/lockout"
 	entitypkg "github.com/asgardeo/thunder/internal/entity"
 	"github.com/asgardeo/thunder/internal/entitytype"
 	oupkg "github.com/asgardeo/thunder/internal/ou"
+	"github.com/asgardeo/thunder/internal/passwordpolicy"
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
 	i18ncore "github.com/asgardeo/thunder/internal/system/i18n/core"
 	"github.com/asgardeo/thunder/internal/system/log"
 	"github.com/asgardeo/thunder/internal/system/security"
 	"github.com/asgardeo/thunder/internal/system/sysauthz"
 	"github.com/asgardeo/thunder/internal/system/utils"
+	"github.com/asgardeo/thunder/tests/mocks/authn/lockoutmock"
 	"github.com/asgardeo/thunder/tests/mocks/entitymock"
 	"github.com/asgardeo/thunder/tests/mocks/entitytypemock"
 	"github.com/asgardeo/thunder/tests/mocks/oumock"
@@ -614,6 +618,14 @@ func TestUserService_UpdateUserCredentials_Rejections(t *testing.T) {
 			mockEntityErr: entitypkg.ErrInvalidCredential,
 			wantErrCode:   ErrorInvalidCredential.Code,
 		},
+		{
+			// Password policy violations surface the localized violation of the policy.
+			name:    "RejectsPasswordPolicyViolations",
+			payload: `{"password":"weak"}`,
+			mockEntityErr: fmt.Errorf("failed to extract schema credentials: %w",
+				&entitypkg.PasswordPolicyError{Violation: &passwordpolicy.ErrorPasswordTooShort}),
+			wantErrCode: passwordpolicy.ErrorPasswordTooShort.Code,
+		},
 		{
 			// Schema credentials must be plain strings; arrays/objects fail JSON unmarshal
 			// to string in the user service before reaching entity service.
@@ -1728,7 +1740,7 @@ func TestUserService_GetUsersByPath_WithIncludeDisplay_BatchFetchError(t *testin
 }
 
 func TestNewFunctions(t *testing.T) {
-	svc := newUserService(nil, nil, nil, nil)
+	svc := newUserService(nil, nil, nil, nil, nil)
 	require.NotNil(t, svc)
 
 	handler := newUserHandler(svc)
@@ -3179,3 +3191,164 @@ func TestUserService_GetUserList_WithIncludeDisplay(t *testing.T) {
 	require.Equal(t, "Bob", resp.Users[1].Display)
 	require.Equal(t, "sales", resp.Users[1].OUHandle)
 }
+
+func TestUserService_UnlockUser(t *testing.T) {
+	userID := svcTestUserID1
+	userEntity := &entitypkg.Entity{Category: entitypkg.EntityCategoryUser, ID: userID, OUID: testOrgID}
+
+	tests := []struct {
+		name        string
+		userID      string
+		setup       func(t *testing.T) *userService
+		wantErrCode string
+	}{
+		{
+			name:        "MissingUserID_ReturnsError",
+			setup:       func(t *testing.T) *userService { return &userService{} },
+			wantErrCode: ErrorMissingUserID.Code,
+		},
+		{
+			name:   "UserNotFound_ReturnsError",
+			userID: userID,
+			setup: func(t *testing.T) *userService {
+				storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+				storeMock.On("GetEntity", mock.Anything, userID).
+					Return((*entitypkg.Entity)(nil), entitypkg.ErrEntityNotFound).Once()
+				return &userService{entityService: storeMock}
+			},
+			wantErrCode: ErrorUserNotFound.Code,
+		},
+		{
+			name:   "NonUserEntity_ReturnsNotFound",
+			userID: userID,
+			setup: func(t *testing.T) *userService {
+				storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+				storeMock.On("GetEntity", mock.Anything, userID).
+					Return(&entitypkg.Entity{Category: entitypkg.EntityCategoryApp, ID: userID}, nil).Once()
+				return &userService{entityService: storeMock}
+			},
+			wantErrCode: ErrorUserNotFound.Code,
+		},
+		{
+			name:   "AuthzDenied_ReturnsUnauthorized",
+			userID: userID,
+			setup: func(t *testing.T) *userService {
+				storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+				storeMock.On("GetEntity", mock.Anything, userID).Return(userEntity, nil).Once()
+				authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+				authzMock.On("IsActionAllowed", mock.Anything, security.ActionUpdateUser, mock.Anything).
+					Return(false, (*serviceerror.ServiceError)(nil)).Once()
+				return &userService{entityService: storeMock, authzService: authzMock}
+			},
+			wantErrCode: serviceerror.ErrorUnauthorized.Code,
+		},
+		{
+			name:   "LockoutDisabled_ReturnsError",
+			userID: userID,
+			setup: func(t *testing.T) *userService {
+				storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+				storeMock.On("GetEntity", mock.Anything, userID).Return(userEntity, nil).Once()
+				lockoutMock := lockoutmock.NewLockoutServiceInterfaceMock(t)
+				lockoutMock.On("IsEnabled").Return(false).Once()
+				return &userService{
+					entityService:  storeMock,
+					authzService:   newAllowAllAuthz(t),
+					lockoutService: lockoutMock,
+				}
+			},
+			wantErrCode: ErrorAccountLockoutDisabled.Code,
+		},
+		{
+			name:   "UnlockError_ReturnsError",
+			userID: userID,
+			setup: func(t *testing.T) *userService {
+				storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+				storeMock.On("GetEntity", mock.Anything, userID).Return(userEntity, nil).Once()
+				lockoutMock := lockoutmock.NewLockoutServiceInterfaceMock(t)
+				lockoutMock.On("IsEnabled").Return(true).Once()
+				lockoutMock.On("Unlock", mock.Anything, userID).Return(&serviceerror.InternalServerError).Once()
+				return &userService{
+					entityService:  storeMock,
+					authzService:   newAllowAllAuthz(t),
+					lockoutService: lockoutMock,
+				}
+			},
+			wantErrCode: serviceerror.InternalServerError.Code,
+		},
+		{
+			name:   "Success",
+			userID: userID,
+			setup: func(t *testing.T) *userService {
+				storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+				storeMock.On("GetEntity", mock.Anything, userID).Return(userEntity, nil).Once()
+				lockoutMock := lockoutmock.NewLockoutServiceInterfaceMock(t)
+				lockoutMock.On("IsEnabled").Return(true).Once()
+				lockoutMock.On("Unlock", mock.Anything, userID).Return((*serviceerror.ServiceError)(nil)).Once()
+				return &userService{
+					entityService:  storeMock,
+					authzService:   newAllowAllAuthz(t),
+					lockoutService: lockoutMock,
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := tc.setup(t)
+			err := svc.UnlockUser(context.Background(), tc.userID)
+			if tc.wantErrCode == "" {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			require.Equal(t, tc.wantErrCode, err.Code)
+		})
+	}
+}
+
+func TestUserService_GetUser_LockStatus(t *testing.T) {
+	userID := svcTestUserID1
+	userEntity := &entitypkg.Entity{Category: entitypkg.EntityCategoryUser, ID: userID, OUID: testOrgID}
+
+	t.Run("PopulatesLockStatus", func(t *testing.T) {
+		storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+		storeMock.On("GetEntity", mock.Anything, userID).Return(userEntity, nil).Once()
+		lockStatus := &lockout.LockStatus{Locked: true, FailedAttempts: 5}
+		lockoutMock := lockoutmock.NewLockoutServiceInterfaceMock(t)
+		lockoutMock.On("IsEnabled").Return(true).Once()
+		lockoutMock.On("GetLockStatus", mock.Anything, userID).
+			Return(lockStatus, (*serviceerror.ServiceError)(nil)).Once()
+
+		svc := &userService{entityService: storeMock, authzService: newAllowAllAuthz(t), lockoutService: lockoutMock}
+		user, err := svc.GetUser(context.Background(), userID, false)
+		require.Nil(t, err)
+		require.Equal(t, lockStatus, user.LockStatus)
+	})
+
+	t.Run("LockStatusError_OmitsLockStatus", func(t *testing.T) {
+		storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+		storeMock.On("GetEntity", mock.Anything, userID).Return(userEntity, nil).Once()
+		lockoutMock := lockoutmock.NewLockoutServiceInterfaceMock(t)
+		lockoutMock.On("IsEnabled").Return(true).Once()
+		lockoutMock.On("GetLockStatus", mock.Anything, userID).
+			Return((*lockout.LockStatus)(nil), &serviceerror.InternalServerError).Once()
+
+		svc := &userService{entityService: storeMock, authzService: newAllowAllAuthz(t), lockoutService: lockoutMock}
+		user, err := svc.GetUser(context.Background(), userID, false)
+		require.Nil(t, err)
+		require.Nil(t, user.LockStatus)
+	})
+
+	t.Run("LockoutDisabled_OmitsLockStatus", func(t *testing.T) {
+		storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+		storeMock.On("GetEntity", mock.Anything, userID).Return(userEntity, nil).Once()
+		lockoutMock := lockoutmock.NewLockoutServiceInterfaceMock(t)
+		lockoutMock.On("IsEnabled").Return(false).Once()
+
+		svc := &userService{entityService: storeMock, authzService: newAllowAllAuthz(t), lockoutService: lockoutMock}
+		user, err := svc.GetUser(context.Background(), userID, false)
+		require.Nil(t, err)
+		require.Nil(t, user.LockStatus)
+	})
+}
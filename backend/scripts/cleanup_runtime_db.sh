@@ -28,9 +28,21 @@ set -euo pipefail
 #   1. FLOW_CONTEXT       - cascades to FLOW_USER_DATA via ON DELETE CASCADE
 #   2. AUTHORIZATION_CODE
 #   3. AUTHORIZATION_REQUEST
-#   4. WEBAUTHN_SESSION
-#   5. ATTRIBUTE_CACHE
-#   6. PAR_REQUEST
+#   4. AUTHORIZATION_RESPONSE
+#   5. WEBAUTHN_SESSION
+#   6. ATTRIBUTE_CACHE
+#   7. PAR_REQUEST
+#   8. REVOKED_TOKEN
+#   9. SSO_SESSION
+#  10. DEVICE_AUTHORIZATION
+#  11. BACKCHANNEL_AUTH_REQUEST
+#  12. SAML_AUTH_REQUEST
+#  13. LOGIN_ATTEMPT
+#  14. OTP_SESSION
+#  15. OTP_SEND_RECORD
+#  16. CONSUMED_MAGIC_LINK
+#  17. CONSUMED_DPOP_PROOF
+#  18. REFRESH_TOKEN_GRANT
 #
 # Usage examples:
 #   # SQLite (local development)
@@ -70,7 +82,7 @@ USERNAME=""
 PASSWORD=""
 
 # Tables to clean (order matters: FLOW_CONTEXT first for cascade).
-TABLES=("FLOW_CONTEXT" "AUTHORIZATION_CODE" "AUTHORIZATION_REQUEST" "WEBAUTHN_SESSION" "ATTRIBUTE_CACHE" "PAR_REQUEST")
+TABLES=("FLOW_CONTEXT" "AUTHORIZATION_CODE" "AUTHORIZATION_REQUEST" "AUTHORIZATION_RESPONSE" "WEBAUTHN_SESSION" "ATTRIBUTE_CACHE" "PAR_REQUEST" "REVOKED_TOKEN" "SSO_SESSION" "DEVICE_AUTHORIZATION" "BACKCHANNEL_AUTH_REQUEST" "SAML_AUTH_REQUEST" "LOGIN_ATTEMPT" "OTP_SESSION" "OTP_SEND_RECORD" "CONSUMED_MAGIC_LINK" "CONSUMED_DPOP_PROOF" "REFRESH_TOKEN_GRANT")
 
 # Totals for summary.
 TOTAL_DELETED=0
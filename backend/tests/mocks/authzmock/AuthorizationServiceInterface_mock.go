@@ -39,6 +39,146 @@ func (_m *AuthorizationServiceInterfaceMock) EXPECT() *AuthorizationServiceInter
 	return &AuthorizationServiceInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// Evaluate provides a mock function for the type AuthorizationServiceInterfaceMock
+func (_mock *AuthorizationServiceInterfaceMock) Evaluate(ctx context.Context, request authz.EvaluationRequest) (*authz.EvaluationResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Evaluate")
+	}
+
+	var r0 *authz.EvaluationResponse
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authz.EvaluationRequest) (*authz.EvaluationResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authz.EvaluationRequest) *authz.EvaluationResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*authz.EvaluationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authz.EvaluationRequest) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// AuthorizationServiceInterfaceMock_Evaluate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Evaluate'
+type AuthorizationServiceInterfaceMock_Evaluate_Call struct {
+	*mock.Call
+}
+
+// Evaluate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request authz.EvaluationRequest
+func (_e *AuthorizationServiceInterfaceMock_Expecter) Evaluate(ctx interface{}, request interface{}) *AuthorizationServiceInterfaceMock_Evaluate_Call {
+	return &AuthorizationServiceInterfaceMock_Evaluate_Call{Call: _e.mock.On("Evaluate", ctx, request)}
+}
+
+func (_c *AuthorizationServiceInterfaceMock_Evaluate_Call) Run(run func(ctx context.Context, request authz.EvaluationRequest)) *AuthorizationServiceInterfaceMock_Evaluate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authz.EvaluationRequest
+		if args[1] != nil {
+			arg1 = args[1].(authz.EvaluationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthorizationServiceInterfaceMock_Evaluate_Call) Return(evaluationResponse *authz.EvaluationResponse, serviceError *serviceerror.ServiceError) *AuthorizationServiceInterfaceMock_Evaluate_Call {
+	_c.Call.Return(evaluationResponse, serviceError)
+	return _c
+}
+
+func (_c *AuthorizationServiceInterfaceMock_Evaluate_Call) RunAndReturn(run func(ctx context.Context, request authz.EvaluationRequest) (*authz.EvaluationResponse, *serviceerror.ServiceError)) *AuthorizationServiceInterfaceMock_Evaluate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EvaluateBatch provides a mock function for the type AuthorizationServiceInterfaceMock
+func (_mock *AuthorizationServiceInterfaceMock) EvaluateBatch(ctx context.Context, request authz.EvaluationsRequest) (*authz.EvaluationsResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EvaluateBatch")
+	}
+
+	var r0 *authz.EvaluationsResponse
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authz.EvaluationsRequest) (*authz.EvaluationsResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authz.EvaluationsRequest) *authz.EvaluationsResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*authz.EvaluationsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authz.EvaluationsRequest) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// AuthorizationServiceInterfaceMock_EvaluateBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EvaluateBatch'
+type AuthorizationServiceInterfaceMock_EvaluateBatch_Call struct {
+	*mock.Call
+}
+
+// EvaluateBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request authz.EvaluationsRequest
+func (_e *AuthorizationServiceInterfaceMock_Expecter) EvaluateBatch(ctx interface{}, request interface{}) *AuthorizationServiceInterfaceMock_EvaluateBatch_Call {
+	return &AuthorizationServiceInterfaceMock_EvaluateBatch_Call{Call: _e.mock.On("EvaluateBatch", ctx, request)}
+}
+
+func (_c *AuthorizationServiceInterfaceMock_EvaluateBatch_Call) Run(run func(ctx context.Context, request authz.EvaluationsRequest)) *AuthorizationServiceInterfaceMock_EvaluateBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authz.EvaluationsRequest
+		if args[1] != nil {
+			arg1 = args[1].(authz.EvaluationsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthorizationServiceInterfaceMock_EvaluateBatch_Call) Return(evaluationsResponse *authz.EvaluationsResponse, serviceError *serviceerror.ServiceError) *AuthorizationServiceInterfaceMock_EvaluateBatch_Call {
+	_c.Call.Return(evaluationsResponse, serviceError)
+	return _c
+}
+
+func (_c *AuthorizationServiceInterfaceMock_EvaluateBatch_Call) RunAndReturn(run func(ctx context.Context, request authz.EvaluationsRequest) (*authz.EvaluationsResponse, *serviceerror.ServiceError)) *AuthorizationServiceInterfaceMock_EvaluateBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAuthorizedPermissions provides a mock function for the type AuthorizationServiceInterfaceMock
 func (_mock *AuthorizationServiceInterfaceMock) GetAuthorizedPermissions(ctx context.Context, request authz.GetAuthorizedPermissionsRequest) (*authz.GetAuthorizedPermissionsResponse, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, request)
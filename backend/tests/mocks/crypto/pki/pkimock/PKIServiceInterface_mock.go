@@ -9,6 +9,7 @@ import (
 	"crypto/x509"
 
 	"github.com/asgardeo/thunder/internal/system/error/serviceerror"
+	"github.com/asgardeo/thunder/internal/system/kmprovider/defaultkm/pkiservice"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -39,6 +40,50 @@ func (_m *PKIServiceInterfaceMock) EXPECT() *PKIServiceInterfaceMock_Expecter {
 	return &PKIServiceInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// GetActiveSigningKeyID provides a mock function for the type PKIServiceInterfaceMock
+func (_mock *PKIServiceInterfaceMock) GetActiveSigningKeyID() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveSigningKeyID")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// PKIServiceInterfaceMock_GetActiveSigningKeyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveSigningKeyID'
+type PKIServiceInterfaceMock_GetActiveSigningKeyID_Call struct {
+	*mock.Call
+}
+
+// GetActiveSigningKeyID is a helper method to define mock.On call
+func (_e *PKIServiceInterfaceMock_Expecter) GetActiveSigningKeyID() *PKIServiceInterfaceMock_GetActiveSigningKeyID_Call {
+	return &PKIServiceInterfaceMock_GetActiveSigningKeyID_Call{Call: _e.mock.On("GetActiveSigningKeyID")}
+}
+
+func (_c *PKIServiceInterfaceMock_GetActiveSigningKeyID_Call) Run(run func()) *PKIServiceInterfaceMock_GetActiveSigningKeyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_GetActiveSigningKeyID_Call) Return(s string) *PKIServiceInterfaceMock_GetActiveSigningKeyID_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_GetActiveSigningKeyID_Call) RunAndReturn(run func() string) *PKIServiceInterfaceMock_GetActiveSigningKeyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllX509Certificates provides a mock function for the type PKIServiceInterfaceMock
 func (_mock *PKIServiceInterfaceMock) GetAllX509Certificates() (map[string]*x509.Certificate, *serviceerror.ServiceError) {
 	ret := _mock.Called()
@@ -320,3 +365,49 @@ func (_c *PKIServiceInterfaceMock_GetX509Certificate_Call) RunAndReturn(run func
 	_c.Call.Return(run)
 	return _c
 }
+
+// SetManagedKeys provides a mock function for the type PKIServiceInterfaceMock
+func (_mock *PKIServiceInterfaceMock) SetManagedKeys(keys []pkiservice.PKI, activeSigningKeyID string) {
+	_mock.Called(keys, activeSigningKeyID)
+	return
+}
+
+// PKIServiceInterfaceMock_SetManagedKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetManagedKeys'
+type PKIServiceInterfaceMock_SetManagedKeys_Call struct {
+	*mock.Call
+}
+
+// SetManagedKeys is a helper method to define mock.On call
+//   - keys []pkiservice.PKI
+//   - activeSigningKeyID string
+func (_e *PKIServiceInterfaceMock_Expecter) SetManagedKeys(keys interface{}, activeSigningKeyID interface{}) *PKIServiceInterfaceMock_SetManagedKeys_Call {
+	return &PKIServiceInterfaceMock_SetManagedKeys_Call{Call: _e.mock.On("SetManagedKeys", keys, activeSigningKeyID)}
+}
+
+func (_c *PKIServiceInterfaceMock_SetManagedKeys_Call) Run(run func(keys []pkiservice.PKI, activeSigningKeyID string)) *PKIServiceInterfaceMock_SetManagedKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []pkiservice.PKI
+		if args[0] != nil {
+			arg0 = args[0].([]pkiservice.PKI)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_SetManagedKeys_Call) Return() *PKIServiceInterfaceMock_SetManagedKeys_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_SetManagedKeys_Call) RunAndReturn(run func(keys []pkiservice.PKI, activeSigningKeyID string)) *PKIServiceInterfaceMock_SetManagedKeys_Call {
+	_c.Run(run)
+	return _c
+}
@@ -520,6 +520,142 @@ func (_c *InboundClientServiceInterfaceMock_GetOAuthProfileByEntityID_Call) RunA
 	return _c
 }
 
+// GetSAMLProfileByEntityID provides a mock function for the type InboundClientServiceInterfaceMock
+func (_mock *InboundClientServiceInterfaceMock) GetSAMLProfileByEntityID(ctx context.Context, entityID string) (*model.SAMLProfile, error) {
+	ret := _mock.Called(ctx, entityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSAMLProfileByEntityID")
+	}
+
+	var r0 *model.SAMLProfile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.SAMLProfile, error)); ok {
+		return returnFunc(ctx, entityID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.SAMLProfile); ok {
+		r0 = returnFunc(ctx, entityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SAMLProfile)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, entityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSAMLProfileByEntityID'
+type InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call struct {
+	*mock.Call
+}
+
+// GetSAMLProfileByEntityID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+func (_e *InboundClientServiceInterfaceMock_Expecter) GetSAMLProfileByEntityID(ctx interface{}, entityID interface{}) *InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call {
+	return &InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call{Call: _e.mock.On("GetSAMLProfileByEntityID", ctx, entityID)}
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call) Run(run func(ctx context.Context, entityID string)) *InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call) Return(sAMLProfile *model.SAMLProfile, err error) *InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call {
+	_c.Call.Return(sAMLProfile, err)
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call) RunAndReturn(run func(ctx context.Context, entityID string) (*model.SAMLProfile, error)) *InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSAMLServiceProvider provides a mock function for the type InboundClientServiceInterfaceMock
+func (_mock *InboundClientServiceInterfaceMock) GetSAMLServiceProvider(ctx context.Context, spEntityID string) (*model.SAMLServiceProvider, error) {
+	ret := _mock.Called(ctx, spEntityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSAMLServiceProvider")
+	}
+
+	var r0 *model.SAMLServiceProvider
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.SAMLServiceProvider, error)); ok {
+		return returnFunc(ctx, spEntityID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.SAMLServiceProvider); ok {
+		r0 = returnFunc(ctx, spEntityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SAMLServiceProvider)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, spEntityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InboundClientServiceInterfaceMock_GetSAMLServiceProvider_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSAMLServiceProvider'
+type InboundClientServiceInterfaceMock_GetSAMLServiceProvider_Call struct {
+	*mock.Call
+}
+
+// GetSAMLServiceProvider is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spEntityID string
+func (_e *InboundClientServiceInterfaceMock_Expecter) GetSAMLServiceProvider(ctx interface{}, spEntityID interface{}) *InboundClientServiceInterfaceMock_GetSAMLServiceProvider_Call {
+	return &InboundClientServiceInterfaceMock_GetSAMLServiceProvider_Call{Call: _e.mock.On("GetSAMLServiceProvider", ctx, spEntityID)}
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetSAMLServiceProvider_Call) Run(run func(ctx context.Context, spEntityID string)) *InboundClientServiceInterfaceMock_GetSAMLServiceProvider_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetSAMLServiceProvider_Call) Return(sAMLServiceProvider *model.SAMLServiceProvider, err error) *InboundClientServiceInterfaceMock_GetSAMLServiceProvider_Call {
+	_c.Call.Return(sAMLServiceProvider, err)
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetSAMLServiceProvider_Call) RunAndReturn(run func(ctx context.Context, spEntityID string) (*model.SAMLServiceProvider, error)) *InboundClientServiceInterfaceMock_GetSAMLServiceProvider_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsDeclarative provides a mock function for the type InboundClientServiceInterfaceMock
 func (_mock *InboundClientServiceInterfaceMock) IsDeclarative(ctx context.Context, entityID string) bool {
 	ret := _mock.Called(ctx, entityID)
@@ -634,6 +770,69 @@ func (_c *InboundClientServiceInterfaceMock_LoadDeclarativeResources_Call) RunAn
 	return _c
 }
 
+// SyncSAMLProfile provides a mock function for the type InboundClientServiceInterfaceMock
+func (_mock *InboundClientServiceInterfaceMock) SyncSAMLProfile(ctx context.Context, entityID string, samlProfile *model.SAMLProfile) error {
+	ret := _mock.Called(ctx, entityID, samlProfile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncSAMLProfile")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *model.SAMLProfile) error); ok {
+		r0 = returnFunc(ctx, entityID, samlProfile)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// InboundClientServiceInterfaceMock_SyncSAMLProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncSAMLProfile'
+type InboundClientServiceInterfaceMock_SyncSAMLProfile_Call struct {
+	*mock.Call
+}
+
+// SyncSAMLProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - samlProfile *model.SAMLProfile
+func (_e *InboundClientServiceInterfaceMock_Expecter) SyncSAMLProfile(ctx interface{}, entityID interface{}, samlProfile interface{}) *InboundClientServiceInterfaceMock_SyncSAMLProfile_Call {
+	return &InboundClientServiceInterfaceMock_SyncSAMLProfile_Call{Call: _e.mock.On("SyncSAMLProfile", ctx, entityID, samlProfile)}
+}
+
+func (_c *InboundClientServiceInterfaceMock_SyncSAMLProfile_Call) Run(run func(ctx context.Context, entityID string, samlProfile *model.SAMLProfile)) *InboundClientServiceInterfaceMock_SyncSAMLProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *model.SAMLProfile
+		if args[2] != nil {
+			arg2 = args[2].(*model.SAMLProfile)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_SyncSAMLProfile_Call) Return(err error) *InboundClientServiceInterfaceMock_SyncSAMLProfile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_SyncSAMLProfile_Call) RunAndReturn(run func(ctx context.Context, entityID string, samlProfile *model.SAMLProfile) error) *InboundClientServiceInterfaceMock_SyncSAMLProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateInboundClient provides a mock function for the type InboundClientServiceInterfaceMock
 func (_mock *InboundClientServiceInterfaceMock) UpdateInboundClient(ctx context.Context, client *model.InboundClient, appCert *model.Certificate, oauthProfile *model.OAuthProfile, hasClientSecret bool, oauthClientID string, entityName string) error {
 	ret := _mock.Called(ctx, client, appCert, oauthProfile, hasClientSecret, oauthClientID, entityName)
@@ -143,6 +143,50 @@ func (_c *JWTServiceInterfaceMock_GenerateJWT_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// GetSigningAlgorithm provides a mock function for the type JWTServiceInterfaceMock
+func (_mock *JWTServiceInterfaceMock) GetSigningAlgorithm() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSigningAlgorithm")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// JWTServiceInterfaceMock_GetSigningAlgorithm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSigningAlgorithm'
+type JWTServiceInterfaceMock_GetSigningAlgorithm_Call struct {
+	*mock.Call
+}
+
+// GetSigningAlgorithm is a helper method to define mock.On call
+func (_e *JWTServiceInterfaceMock_Expecter) GetSigningAlgorithm() *JWTServiceInterfaceMock_GetSigningAlgorithm_Call {
+	return &JWTServiceInterfaceMock_GetSigningAlgorithm_Call{Call: _e.mock.On("GetSigningAlgorithm")}
+}
+
+func (_c *JWTServiceInterfaceMock_GetSigningAlgorithm_Call) Run(run func()) *JWTServiceInterfaceMock_GetSigningAlgorithm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *JWTServiceInterfaceMock_GetSigningAlgorithm_Call) Return(s string) *JWTServiceInterfaceMock_GetSigningAlgorithm_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *JWTServiceInterfaceMock_GetSigningAlgorithm_Call) RunAndReturn(run func() string) *JWTServiceInterfaceMock_GetSigningAlgorithm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerifyJWT provides a mock function for the type JWTServiceInterfaceMock
 func (_mock *JWTServiceInterfaceMock) VerifyJWT(jwtToken string, expectedAud string, expectedIss string) *serviceerror.ServiceError {
 	ret := _mock.Called(jwtToken, expectedAud, expectedIss)
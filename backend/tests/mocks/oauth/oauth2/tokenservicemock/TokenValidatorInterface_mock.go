@@ -5,6 +5,8 @@
 package tokenservicemock
 
 import (
+	"context"
+
 	"github.com/asgardeo/thunder/internal/inboundclient/model"
 	"github.com/asgardeo/thunder/internal/oauth/oauth2/tokenservice"
 	mock "github.com/stretchr/testify/mock"
@@ -99,6 +101,74 @@ func (_c *TokenValidatorInterfaceMock_ValidateAccessToken_Call) RunAndReturn(run
 	return _c
 }
 
+// ValidateIDTokenHint provides a mock function for the type TokenValidatorInterfaceMock
+func (_mock *TokenValidatorInterfaceMock) ValidateIDTokenHint(ctx context.Context, token string) (*tokenservice.IDTokenHintClaims, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateIDTokenHint")
+	}
+
+	var r0 *tokenservice.IDTokenHintClaims
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*tokenservice.IDTokenHintClaims, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *tokenservice.IDTokenHintClaims); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tokenservice.IDTokenHintClaims)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenValidatorInterfaceMock_ValidateIDTokenHint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateIDTokenHint'
+type TokenValidatorInterfaceMock_ValidateIDTokenHint_Call struct {
+	*mock.Call
+}
+
+// ValidateIDTokenHint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *TokenValidatorInterfaceMock_Expecter) ValidateIDTokenHint(ctx interface{}, token interface{}) *TokenValidatorInterfaceMock_ValidateIDTokenHint_Call {
+	return &TokenValidatorInterfaceMock_ValidateIDTokenHint_Call{Call: _e.mock.On("ValidateIDTokenHint", ctx, token)}
+}
+
+func (_c *TokenValidatorInterfaceMock_ValidateIDTokenHint_Call) Run(run func(ctx context.Context, token string)) *TokenValidatorInterfaceMock_ValidateIDTokenHint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenValidatorInterfaceMock_ValidateIDTokenHint_Call) Return(iDTokenHintClaims *tokenservice.IDTokenHintClaims, err error) *TokenValidatorInterfaceMock_ValidateIDTokenHint_Call {
+	_c.Call.Return(iDTokenHintClaims, err)
+	return _c
+}
+
+func (_c *TokenValidatorInterfaceMock_ValidateIDTokenHint_Call) RunAndReturn(run func(ctx context.Context, token string) (*tokenservice.IDTokenHintClaims, error)) *TokenValidatorInterfaceMock_ValidateIDTokenHint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateRefreshToken provides a mock function for the type TokenValidatorInterfaceMock
 func (_mock *TokenValidatorInterfaceMock) ValidateRefreshToken(token string, clientID string) (*tokenservice.RefreshTokenClaims, error) {
 	ret := _mock.Called(token, clientID)
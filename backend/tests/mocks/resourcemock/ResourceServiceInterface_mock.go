@@ -1379,6 +1379,76 @@ func (_c *ResourceServiceInterfaceMock_UpdateResourceServer_Call) RunAndReturn(r
 	return _c
 }
 
+// ValidateAuthorizationDetail provides a mock function for the type ResourceServiceInterfaceMock
+func (_mock *ResourceServiceInterfaceMock) ValidateAuthorizationDetail(ctx context.Context, detail map[string]interface{}) (*resource.ResourceServer, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, detail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateAuthorizationDetail")
+	}
+
+	var r0 *resource.ResourceServer
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]interface{}) (*resource.ResourceServer, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, detail)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]interface{}) *resource.ResourceServer); ok {
+		r0 = returnFunc(ctx, detail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resource.ResourceServer)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, map[string]interface{}) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, detail)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ResourceServiceInterfaceMock_ValidateAuthorizationDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateAuthorizationDetail'
+type ResourceServiceInterfaceMock_ValidateAuthorizationDetail_Call struct {
+	*mock.Call
+}
+
+// ValidateAuthorizationDetail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - detail map[string]interface{}
+func (_e *ResourceServiceInterfaceMock_Expecter) ValidateAuthorizationDetail(ctx interface{}, detail interface{}) *ResourceServiceInterfaceMock_ValidateAuthorizationDetail_Call {
+	return &ResourceServiceInterfaceMock_ValidateAuthorizationDetail_Call{Call: _e.mock.On("ValidateAuthorizationDetail", ctx, detail)}
+}
+
+func (_c *ResourceServiceInterfaceMock_ValidateAuthorizationDetail_Call) Run(run func(ctx context.Context, detail map[string]interface{})) *ResourceServiceInterfaceMock_ValidateAuthorizationDetail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 map[string]interface{}
+		if args[1] != nil {
+			arg1 = args[1].(map[string]interface{})
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ResourceServiceInterfaceMock_ValidateAuthorizationDetail_Call) Return(resourceServer *resource.ResourceServer, serviceError *serviceerror.ServiceError) *ResourceServiceInterfaceMock_ValidateAuthorizationDetail_Call {
+	_c.Call.Return(resourceServer, serviceError)
+	return _c
+}
+
+func (_c *ResourceServiceInterfaceMock_ValidateAuthorizationDetail_Call) RunAndReturn(run func(ctx context.Context, detail map[string]interface{}) (*resource.ResourceServer, *serviceerror.ServiceError)) *ResourceServiceInterfaceMock_ValidateAuthorizationDetail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidatePermissions provides a mock function for the type ResourceServiceInterfaceMock
 func (_mock *ResourceServiceInterfaceMock) ValidatePermissions(ctx context.Context, resourceServerID string, permissions []string) ([]string, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, resourceServerID, permissions)
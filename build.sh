@@ -21,12 +21,15 @@ set -e
 
 SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
 
-# Check if --without-consent is passed and remove it from args
+# Check if --without-consent or --with-pkcs11 is passed and remove it from args
 WITHOUT_CONSENT=${WITHOUT_CONSENT:-false}
+WITH_PKCS11=${WITH_PKCS11:-false}
 NEW_ARGS=()
 for arg in "$@"; do
     if [ "$arg" = "--without-consent" ]; then
         WITHOUT_CONSENT="true"
+    elif [ "$arg" = "--with-pkcs11" ]; then
+        WITH_PKCS11="true"
     else
         NEW_ARGS+=("$arg")
     fi
@@ -313,7 +316,19 @@ function build_backend() {
         build_flags="$build_flags -cover -coverpkg=$coverpkg"
     fi
 
-    GOOS=$GO_OS GOARCH=$GO_ARCH CGO_ENABLED=0 go build -C "$BACKEND_BASE_DIR" \
+    # The PKCS#11 key manager loads the HSM vendor library through cgo, so it is only included in
+    # builds with cgo enabled. Such builds need a C toolchain for the target platform.
+    local cgo_enabled=0
+    if [ "$WITH_PKCS11" = "true" ]; then
+        echo "Building with PKCS#11 key manager support (cgo enabled)..."
+        if { [ "$GO_OS" != "$DEFAULT_OS" ] || [ "$GO_ARCH" != "$DEFAULT_ARCH" ]; } && [ -z "$CC" ]; then
+            echo "Error: Building for $GO_OS/$GO_ARCH with PKCS#11 support requires CC to point to a C cross-compiler."
+            exit 1
+        fi
+        cgo_enabled=1
+    fi
+
+    GOOS=$GO_OS GOARCH=$GO_ARCH CGO_ENABLED=$cgo_enabled go build -C "$BACKEND_BASE_DIR" \
     $build_flags -ldflags "-X \"main.version=$VERSION\" \
     -X \"main.buildDate=$$(date -u '+%Y-%m-%d %H:%M:%S UTC')\"" \
     -o "../$BUILD_DIR/$output_binary" ./cmd/server
@@ -1315,6 +1330,7 @@ case "$1" in
         echo "  run_docs                 - Run the documentation development server with live reload"
         echo ""
         echo "  --without-consent        - Skip packaging/running the consent server"
+        echo "  --with-pkcs11            - Build the backend with cgo to include the PKCS#11 key manager"
         exit 1
         ;;
 esac
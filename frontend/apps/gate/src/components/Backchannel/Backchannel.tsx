@@ -0,0 +1,148 @@
+/**
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+import {useConfig} from '@thunderid/contexts';
+import {useDesign, AuthCardLayout, AuthPageLayout} from '@thunderid/design';
+import {Alert, Box, Button, CircularProgress, Typography} from '@wso2/oxygen-ui';
+import {useState} from 'react';
+import type {JSX} from 'react';
+import {useTranslation} from 'react-i18next';
+import {useSearchParams} from 'react-router';
+import useFlowExecution from '../../hooks/useFlowExecution';
+import postJSON from '../../utils/postJSON';
+import FlowSteps from '../FlowSteps/FlowSteps';
+
+/**
+ * Steps of the backchannel approval page.
+ */
+type BackchannelStep = 'review' | 'flow' | 'authorized' | 'denied';
+
+/**
+ * Lets the user approve or deny a backchannel authentication request (OpenID Connect CIBA) from the approval link
+ * sent to them. The link carries the `executionId` of the flow initiated by the server, the `backchannelToken` that
+ * the flow verifies and the `applicationId` of the requesting client. On approval the user completes the flow and the
+ * resulting assertion is sent back to the server together with the backchannel token.
+ */
+export default function Backchannel(): JSX.Element {
+  const [searchParams] = useSearchParams();
+  const {t} = useTranslation();
+  const {getServerUrl} = useConfig();
+  const {isDesignEnabled, isLoading: isDesignLoading} = useDesign();
+  const {flowResponse, isLoading, error, execute, run} = useFlowExecution(t('backchannel:errors.failed.description'));
+
+  const [step, setStep] = useState<BackchannelStep>('review');
+
+  const executionId = searchParams.get('executionId') ?? '';
+  const backchannelToken = searchParams.get('backchannelToken') ?? '';
+  const applicationId = searchParams.get('applicationId') ?? undefined;
+  const baseUrl = getServerUrl() ?? (import.meta.env.VITE_ASGARDEO_BASE_URL as string);
+
+  const completeApproval = async (assertion: string | undefined, denied: boolean) => {
+    const result = await postJSON<{status: string}>(`${baseUrl}/oauth2/bc-authorize/callback`, {
+      backchannelToken,
+      assertion,
+      denied,
+    });
+    setStep(result.status === 'denied' ? 'denied' : 'authorized');
+  };
+
+  const advance = async (action?: string, inputs?: Record<string, string>) => {
+    const completed = await execute({applicationId, executionId, action, inputs});
+    if (completed) {
+      await completeApproval(completed.assertion, false);
+      return;
+    }
+    setStep('flow');
+  };
+
+  const handleFlowSubmit = (action: string, inputs: Record<string, string>) => run(() => advance(action, inputs));
+
+  const handleApprove = () => run(() => advance(undefined, {backchannelToken}));
+
+  const handleDeny = () => run(() => completeApproval(undefined, true));
+
+  const renderStep = () => {
+    if (!executionId || !backchannelToken) {
+      return <Alert severity="error">{t('backchannel:errors.invalid.description')}</Alert>;
+    }
+
+    switch (step) {
+      case 'authorized':
+        return (
+          <Typography variant="body1" color="text.secondary">
+            {t('backchannel:authorized.description')}
+          </Typography>
+        );
+      case 'denied':
+        return (
+          <Typography variant="body1" color="text.secondary">
+            {t('backchannel:denied.description')}
+          </Typography>
+        );
+      case 'flow':
+        return (
+          <FlowSteps flowResponse={flowResponse} isLoading={isLoading} onSubmit={handleFlowSubmit}>
+            <Button type="button" variant="text" fullWidth disabled={isLoading} onClick={handleDeny}>
+              {t('backchannel:button.deny')}
+            </Button>
+          </FlowSteps>
+        );
+      default:
+        return (
+          <Box sx={{display: 'flex', flexDirection: 'column', gap: 2}}>
+            <Typography variant="body1" color="text.secondary">
+              {t('backchannel:description')}
+            </Typography>
+            <Button type="button" variant="contained" fullWidth disabled={isLoading} onClick={handleApprove}>
+              {isLoading ? <CircularProgress size={20} /> : t('backchannel:button.approve')}
+            </Button>
+            <Button type="button" variant="text" fullWidth disabled={isLoading} onClick={handleDeny}>
+              {t('backchannel:button.deny')}
+            </Button>
+          </Box>
+        );
+    }
+  };
+
+  return (
+    <AuthPageLayout isLoading={isDesignLoading} variant="Backchannel">
+      <AuthCardLayout
+        variant="BackchannelBox"
+        logo={{
+          src: {
+            light: `${import.meta.env.BASE_URL}/assets/images/logo.svg`,
+            dark: `${import.meta.env.BASE_URL}/assets/images/logo-inverted.svg`,
+          },
+          alt: {light: '', dark: ''},
+        }}
+        showLogo={!isDesignEnabled}
+        logoDisplay={{display: 'flex'}}
+      >
+        <Typography component="h1" variant="h4" sx={{mb: 1}}>
+          {t('backchannel:heading')}
+        </Typography>
+        {error && (
+          <Alert severity="error" sx={{mb: 2}}>
+            {error}
+          </Alert>
+        )}
+        {renderStep()}
+      </AuthCardLayout>
+    </AuthPageLayout>
+  );
+}
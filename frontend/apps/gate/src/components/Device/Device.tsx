@@ -0,0 +1,182 @@
+/**
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+import {useConfig} from '@thunderid/contexts';
+import {useDesign, AuthCardLayout, AuthPageLayout} from '@thunderid/design';
+import {Alert, Box, Button, CircularProgress, TextField, Typography} from '@wso2/oxygen-ui';
+import {useState} from 'react';
+import type {FormEvent, JSX} from 'react';
+import {useTranslation} from 'react-i18next';
+import {useSearchParams} from 'react-router';
+import useFlowExecution from '../../hooks/useFlowExecution';
+import postJSON from '../../utils/postJSON';
+import FlowSteps from '../FlowSteps/FlowSteps';
+
+/**
+ * Response of the device verification endpoint once the authentication flow for a user code has been initiated.
+ */
+interface VerificationResponse {
+  authId: string;
+  applicationId: string;
+  executionId: string;
+}
+
+/**
+ * Steps of the device verification page.
+ */
+type DeviceStep = 'code' | 'flow' | 'authorized' | 'denied';
+
+/**
+ * Lets the user authorize a device that started the OAuth 2.0 device authorization grant (RFC 8628). The user enters
+ * the user code shown on the device, which may be pre-filled from the `user_code` query parameter of the complete
+ * verification URI, signs in through the authentication flow of the device's application and the resulting
+ * assertion is sent back to the server to approve the device. The user can also deny the device.
+ */
+export default function Device(): JSX.Element {
+  const [searchParams] = useSearchParams();
+  const {t} = useTranslation();
+  const {getServerUrl} = useConfig();
+  const {isDesignEnabled, isLoading: isDesignLoading} = useDesign();
+  const {flowResponse, isLoading, error, execute, run} = useFlowExecution(t('device:errors.failed.description'));
+
+  const [step, setStep] = useState<DeviceStep>('code');
+  const [userCode, setUserCode] = useState<string>(searchParams.get('user_code') ?? '');
+  const [verification, setVerification] = useState<VerificationResponse | null>(null);
+
+  const baseUrl = getServerUrl() ?? (import.meta.env.VITE_ASGARDEO_BASE_URL as string);
+
+  const completeVerification = async (authId: string, assertion: string | undefined, denied: boolean) => {
+    const result = await postJSON<{status: string}>(`${baseUrl}/oauth2/device/callback`, {
+      userCode,
+      authId,
+      assertion,
+      denied,
+    });
+    setStep(result.status === 'denied' ? 'denied' : 'authorized');
+  };
+
+  const advance = async (current: VerificationResponse, action?: string, inputs?: Record<string, string>) => {
+    const completed = await execute({
+      applicationId: current.applicationId,
+      executionId: current.executionId,
+      action,
+      inputs,
+    });
+    if (completed) {
+      await completeVerification(current.authId, completed.assertion, false);
+      return;
+    }
+    setStep('flow');
+  };
+
+  const handleCodeSubmit = (event: FormEvent<HTMLFormElement>) => {
+    event.preventDefault();
+    run(async () => {
+      const response = await postJSON<VerificationResponse>(`${baseUrl}/oauth2/device/verify`, {
+        userCode: userCode.trim().toUpperCase(),
+      });
+      setVerification(response);
+      await advance(response);
+    });
+  };
+
+  const handleFlowSubmit = (action: string, inputs: Record<string, string>) => {
+    if (verification) {
+      run(() => advance(verification, action, inputs));
+    }
+  };
+
+  const handleDeny = () => {
+    if (verification) {
+      run(() => completeVerification(verification.authId, undefined, true));
+    }
+  };
+
+  const renderStep = () => {
+    switch (step) {
+      case 'authorized':
+        return (
+          <Typography variant="body1" color="text.secondary">
+            {t('device:authorized.description')}
+          </Typography>
+        );
+      case 'denied':
+        return (
+          <Typography variant="body1" color="text.secondary">
+            {t('device:denied.description')}
+          </Typography>
+        );
+      case 'flow':
+        return (
+          <FlowSteps flowResponse={flowResponse} isLoading={isLoading} onSubmit={handleFlowSubmit}>
+            <Button type="button" variant="text" fullWidth disabled={isLoading} onClick={handleDeny}>
+              {t('device:button.deny')}
+            </Button>
+          </FlowSteps>
+        );
+      default:
+        return (
+          <Box component="form" onSubmit={handleCodeSubmit} sx={{display: 'flex', flexDirection: 'column', gap: 2}}>
+            <Typography variant="body1" color="text.secondary">
+              {t('device:description')}
+            </Typography>
+            <TextField
+              label={t('device:fields.userCode.label')}
+              placeholder={t('device:fields.userCode.placeholder')}
+              value={userCode}
+              onChange={(event) => setUserCode(event.target.value)}
+              autoComplete="off"
+              autoFocus
+              required
+              fullWidth
+            />
+            <Button type="submit" variant="contained" fullWidth disabled={isLoading || !userCode.trim()}>
+              {isLoading ? <CircularProgress size={20} /> : t('device:button.continue')}
+            </Button>
+          </Box>
+        );
+    }
+  };
+
+  return (
+    <AuthPageLayout isLoading={isDesignLoading} variant="Device">
+      <AuthCardLayout
+        variant="DeviceBox"
+        logo={{
+          src: {
+            light: `${import.meta.env.BASE_URL}/assets/images/logo.svg`,
+            dark: `${import.meta.env.BASE_URL}/assets/images/logo-inverted.svg`,
+          },
+          alt: {light: '', dark: ''},
+        }}
+        showLogo={!isDesignEnabled}
+        logoDisplay={{display: 'flex'}}
+      >
+        <Typography component="h1" variant="h4" sx={{mb: 1}}>
+          {t('device:heading')}
+        </Typography>
+        {error && (
+          <Alert severity="error" sx={{mb: 2}}>
+            {error}
+          </Alert>
+        )}
+        {renderStep()}
+      </AuthCardLayout>
+    </AuthPageLayout>
+  );
+}
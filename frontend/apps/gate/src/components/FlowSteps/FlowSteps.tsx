@@ -0,0 +1,98 @@
+/**
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+import type {EmbeddedFlowComponent} from '@asgardeo/react';
+import {FlowComponentRenderer} from '@thunderid/design';
+import {useTemplateLiteralResolver} from '@thunderid/hooks';
+import {TemplateLiteralType} from '@thunderid/utils';
+import {Box} from '@wso2/oxygen-ui';
+import {useState} from 'react';
+import type {JSX, ReactNode} from 'react';
+import {useTranslation} from 'react-i18next';
+import type {FlowExecutionResponse} from '../../hooks/useFlowExecution';
+
+/**
+ * Props of the {@link FlowSteps} component.
+ */
+export interface FlowStepsProps {
+  /**
+   * The incomplete flow step to render.
+   */
+  flowResponse: FlowExecutionResponse | null;
+  /**
+   * Whether the next step is being executed.
+   */
+  isLoading: boolean;
+  /**
+   * Called with the triggered action and the collected inputs.
+   */
+  onSubmit: (action: string, inputs: Record<string, string>) => void;
+  /**
+   * Content rendered after the flow components, such as a button to deny the request.
+   */
+  children?: ReactNode;
+}
+
+/**
+ * Renders the components of an incomplete flow step returned by the flow execution endpoint.
+ */
+export default function FlowSteps({flowResponse, isLoading, onSubmit, children = null}: FlowStepsProps): JSX.Element {
+  const {t} = useTranslation();
+  const {resolveAll} = useTemplateLiteralResolver();
+
+  const [formInputs, setFormInputs] = useState<Record<string, string>>({});
+
+  const components: EmbeddedFlowComponent[] = flowResponse?.data?.meta?.components ?? [];
+
+  return (
+    <Box sx={{display: 'flex', flexDirection: 'column', gap: 2}}>
+      {components.map((component: EmbeddedFlowComponent, index: number) => (
+        <FlowComponentRenderer
+          key={component.id ?? index}
+          component={component}
+          index={index}
+          values={formInputs}
+          isLoading={isLoading}
+          additionalData={flowResponse?.data?.additionalData}
+          resolve={(template) =>
+            resolveAll(template, {
+              [TemplateLiteralType.TRANSLATION]: t,
+              [TemplateLiteralType.META]: (path: string) => {
+                const value: unknown = path
+                  .split('.')
+                  .reduce<unknown>(
+                    (acc: unknown, key: string): unknown =>
+                      acc != null && typeof acc === 'object' ? (acc as Record<string, unknown>)[key] : acc,
+                    flowResponse?.data?.meta,
+                  );
+
+                return (value as string | undefined) ?? `{{meta(${path})}}`;
+              },
+            })
+          }
+          onInputChange={(field: string, value: string) => setFormInputs((prev) => ({...prev, [field]: value}))}
+          onSubmit={(action, inputs) => {
+            onSubmit(action.id ?? '', inputs);
+            setFormInputs({});
+          }}
+        />
+      ))}
+      {children}
+    </Box>
+  );
+}
@@ -0,0 +1,91 @@
+/**
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+import {useConfig} from '@thunderid/contexts';
+import {useDesign, AuthCardLayout, AuthPageLayout} from '@thunderid/design';
+import {Box, Button, Typography} from '@wso2/oxygen-ui';
+import {useState} from 'react';
+import type {JSX} from 'react';
+import {useTranslation} from 'react-i18next';
+import {useSearchParams} from 'react-router';
+
+/**
+ * Parameters of the logout request that are resubmitted to the logout endpoint once the user confirms.
+ */
+const LOGOUT_REQUEST_PARAMS: string[] = ['client_id', 'post_logout_redirect_uri', 'state'];
+
+/**
+ * Asks the user to confirm a logout request that the server could not attribute to a client. On confirmation, the
+ * logout request is resubmitted to the logout endpoint with a top-level form post so that the session cookie is sent.
+ */
+export default function Logout(): JSX.Element {
+  const [searchParams] = useSearchParams();
+  const {t} = useTranslation();
+  const {getServerUrl} = useConfig();
+  const {isDesignEnabled, isLoading: isDesignLoading} = useDesign();
+
+  const [isCancelled, setIsCancelled] = useState<boolean>(false);
+
+  const baseUrl = getServerUrl() ?? (import.meta.env.VITE_ASGARDEO_BASE_URL as string);
+
+  return (
+    <AuthPageLayout isLoading={isDesignLoading} variant="Logout">
+      <AuthCardLayout
+        variant="LogoutBox"
+        logo={{
+          src: {
+            light: `${import.meta.env.BASE_URL}/assets/images/logo.svg`,
+            dark: `${import.meta.env.BASE_URL}/assets/images/logo-inverted.svg`,
+          },
+          alt: {light: '', dark: ''},
+        }}
+        showLogo={!isDesignEnabled}
+        logoDisplay={{display: 'flex'}}
+      >
+        <Typography component="h1" variant="h4" sx={{mb: 1}}>
+          {t('logout:heading')}
+        </Typography>
+        {isCancelled ? (
+          <Typography variant="body1" color="text.secondary">
+            {t('logout:cancelled.description')}
+          </Typography>
+        ) : (
+          <Box component="form" method="post" action={`${baseUrl}/oauth2/logout`}>
+            <Typography variant="body1" color="text.secondary" sx={{mb: 3}}>
+              {t('logout:description')}
+            </Typography>
+            {LOGOUT_REQUEST_PARAMS.map((param: string) => {
+              const value = searchParams.get(param);
+
+              return value ? <input key={param} type="hidden" name={param} value={value} /> : null;
+            })}
+            <input type="hidden" name="confirm" value="true" />
+            <Box sx={{display: 'flex', flexDirection: 'column', gap: 1}}>
+              <Button type="submit" variant="contained" fullWidth>
+                {t('logout:button.confirm')}
+              </Button>
+              <Button type="button" variant="text" fullWidth onClick={() => setIsCancelled(true)}>
+                {t('logout:button.cancel')}
+              </Button>
+            </Box>
+          </Box>
+        )}
+      </AuthCardLayout>
+    </AuthPageLayout>
+  );
+}
@@ -25,6 +25,7 @@ import {useState} from 'react';
 import type {JSX} from 'react';
 import {useTranslation} from 'react-i18next';
 import {useSearchParams} from 'react-router';
+import findIdentifierInputRef from '../../utils/findIdentifierInputRef';
 import generateFallbackSignUpUrl from '../../utils/generateFallbackSignUpUrl';
 
 export default function SignInBox(): JSX.Element {
@@ -38,7 +39,22 @@ export default function SignInBox(): JSX.Element {
   const [formInputs, setFormInputs] = useState<Record<string, string>>({});
   const [fieldErrors, setFieldErrors] = useState<Record<string, string>>({});
 
-  const validateForm = (components: EmbeddedFlowComponent[]): boolean => {
+  /**
+   * Pre-fills the identifier input with the login hint sent by the server until the user edits it.
+   */
+  const getFormValues = (
+    components: EmbeddedFlowComponent[],
+    additionalData: Record<string, unknown> | undefined,
+  ): Record<string, string> => {
+    const loginHint = additionalData?.['loginHint'];
+    const identifierRef = findIdentifierInputRef(components);
+    if (typeof loginHint !== 'string' || !identifierRef || identifierRef in formInputs) {
+      return formInputs;
+    }
+    return {...formInputs, [identifierRef]: loginHint};
+  };
+
+  const validateForm = (components: EmbeddedFlowComponent[], values: Record<string, string>): boolean => {
     const errors: Record<string, string> = {};
     let isValid = true;
 
@@ -53,7 +69,7 @@ export default function SignInBox(): JSX.Element {
         typeof component.ref === 'string' &&
         typeof component.label === 'string'
       ) {
-        const value = formInputs[component.ref] ?? '';
+        const value = values[component.ref] ?? '';
         if (!value.trim()) {
           errors[component.ref] = `${t('validations:form.field.required', {field: t(resolve(component.label)!)})}`;
           isValid = false;
@@ -100,6 +116,7 @@ export default function SignInBox(): JSX.Element {
               )}
               {(() => {
                 const renderComponents = components && components.length > 0 ? components : [];
+                const values = getFormValues(renderComponents, additionalData);
 
                 if (renderComponents.length > 0) {
                   return (
@@ -109,7 +126,7 @@ export default function SignInBox(): JSX.Element {
                           key={component.id ?? index}
                           component={component}
                           index={index}
-                          values={formInputs}
+                          values={values}
                           fieldErrors={fieldErrors}
                           isLoading={isLoading}
                           additionalData={additionalData}
@@ -130,7 +147,7 @@ export default function SignInBox(): JSX.Element {
                             })
                           }
                           onInputChange={updateInput}
-                          onValidate={validateForm}
+                          onValidate={(validatedComponents) => validateForm(validatedComponents, values)}
                           onSubmit={(action, inputs) => {
                             void onSubmit({inputs, action: action.id}).finally(() => {
                               setFormInputs({});
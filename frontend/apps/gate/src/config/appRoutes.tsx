@@ -21,7 +21,10 @@ import {Navigate, type RouteProps} from 'react-router';
 import ROUTES from '../constants/routes';
 import DefaultLayout from '../layouts/DefaultLayout';
 import AcceptInvitePage from '../pages/AcceptInvitePage';
+import BackchannelPage from '../pages/BackchannelPage';
+import DevicePage from '../pages/DevicePage';
 import ErrorPage from '../pages/ErrorPage';
+import LogoutPage from '../pages/LogoutPage';
 import SignInPage from '../pages/SignInPage';
 import SignUpPage from '../pages/SignUpPage';
 
@@ -66,6 +69,9 @@ const appRoutes: AppRoute[] = [
       {path: ROUTES.AUTH.INVITE, element: <AcceptInvitePage />},
       {path: ROUTES.AUTH.CALLBACK, element: <CallbackRoute />},
       {path: ROUTES.AUTH.ERROR, element: <ErrorPage />},
+      {path: ROUTES.AUTH.LOGOUT, element: <LogoutPage />},
+      {path: ROUTES.AUTH.DEVICE, element: <DevicePage />},
+      {path: ROUTES.AUTH.BACKCHANNEL, element: <BackchannelPage />},
     ],
   },
 ];
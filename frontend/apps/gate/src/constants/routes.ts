@@ -48,6 +48,18 @@ export interface Routes {
      * OAuth callback page route.
      */
     CALLBACK: string;
+    /**
+     * Logout confirmation page route.
+     */
+    LOGOUT: string;
+    /**
+     * Device authorization user code entry page route.
+     */
+    DEVICE: string;
+    /**
+     * Backchannel authentication approval page route.
+     */
+    BACKCHANNEL: string;
   };
 }
 
@@ -73,6 +85,9 @@ const ROUTES: Routes = {
     SIGN_UP: '/signup',
     INVITE: '/invite',
     CALLBACK: '/callback',
+    LOGOUT: '/logout',
+    DEVICE: '/device',
+    BACKCHANNEL: '/backchannel',
   },
 } as const;
 
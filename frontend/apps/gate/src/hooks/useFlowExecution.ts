@@ -0,0 +1,128 @@
+/**
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+import type {EmbeddedFlowComponent} from '@asgardeo/react';
+import {useConfig} from '@thunderid/contexts';
+import {useRef, useState} from 'react';
+import postJSON from '../utils/postJSON';
+
+/**
+ * Response of the flow execution endpoint.
+ */
+export interface FlowExecutionResponse {
+  executionId: string;
+  flowStatus: 'INCOMPLETE' | 'COMPLETE' | 'ERROR';
+  type?: string;
+  challengeToken?: string;
+  assertion?: string;
+  failureReason?: string;
+  data?: {
+    redirectURL?: string;
+    meta?: {components?: EmbeddedFlowComponent[]} & Record<string, unknown>;
+    additionalData?: Record<string, string>;
+  };
+}
+
+/**
+ * Request sent to the flow execution endpoint to advance a flow that was initiated by the server.
+ */
+export interface FlowExecutionRequest {
+  applicationId?: string;
+  executionId: string;
+  action?: string;
+  inputs?: Record<string, string>;
+}
+
+/**
+ * Result of the {@link useFlowExecution} hook.
+ */
+export interface UseFlowExecutionResult {
+  /**
+   * The last incomplete step of the flow, whose components are awaiting user input.
+   */
+  flowResponse: FlowExecutionResponse | null;
+  /**
+   * Whether a task started with `run` is in progress.
+   */
+  isLoading: boolean;
+  /**
+   * The error raised by the last task started with `run`.
+   */
+  error: string | null;
+  /**
+   * Executes the next step of the flow. Resolves with the completed response once the flow completes, or with
+   * `null` while the flow awaits user input or has redirected the browser.
+   */
+  execute: (request: FlowExecutionRequest) => Promise<FlowExecutionResponse | null>;
+  /**
+   * Runs an asynchronous task, tracking its loading state and surfacing any error it throws.
+   */
+  run: (task: () => Promise<void>) => void;
+}
+
+/**
+ * Drives an authentication flow that the server initiated on behalf of another grant, such as the device
+ * authorization grant or a backchannel authentication request, through the flow execution endpoint. The caller
+ * receives the completed response and hands its assertion back to the grant specific callback endpoint.
+ *
+ * @param fallbackError - Message shown when a task fails without an error message.
+ * @returns The flow state and the functions used to advance it.
+ */
+export default function useFlowExecution(fallbackError: string): UseFlowExecutionResult {
+  const {getServerUrl} = useConfig();
+  const [flowResponse, setFlowResponse] = useState<FlowExecutionResponse | null>(null);
+  const [isLoading, setIsLoading] = useState<boolean>(false);
+  const [error, setError] = useState<string | null>(null);
+  const challengeToken = useRef<string | undefined>(undefined);
+
+  const baseUrl = getServerUrl() ?? (import.meta.env.VITE_ASGARDEO_BASE_URL as string);
+
+  const execute = async (request: FlowExecutionRequest): Promise<FlowExecutionResponse | null> => {
+    const response = await postJSON<FlowExecutionResponse>(`${baseUrl}/flow/execute`, {
+      ...request,
+      inputs: request.inputs ?? {},
+      verbose: true,
+      challengeToken: challengeToken.current,
+    });
+    challengeToken.current = response.challengeToken;
+
+    if (response.flowStatus === 'ERROR') {
+      throw new Error(response.failureReason ?? fallbackError);
+    }
+    if (response.flowStatus === 'COMPLETE') {
+      return response;
+    }
+    if (response.type === 'REDIRECTION' && response.data?.redirectURL) {
+      window.location.assign(response.data.redirectURL);
+      return null;
+    }
+    setFlowResponse(response);
+
+    return null;
+  };
+
+  const run = (task: () => Promise<void>): void => {
+    setIsLoading(true);
+    setError(null);
+    task()
+      .catch((err: unknown) => setError(err instanceof Error && err.message ? err.message : fallbackError))
+      .finally(() => setIsLoading(false));
+  };
+
+  return {flowResponse, isLoading, error, execute, run};
+}
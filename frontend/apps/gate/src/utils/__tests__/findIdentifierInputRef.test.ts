@@ -0,0 +1,56 @@
+/**
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+import type {EmbeddedFlowComponent} from '@asgardeo/react';
+import {describe, it, expect} from 'vitest';
+import findIdentifierInputRef from '../findIdentifierInputRef';
+
+describe('findIdentifierInputRef', () => {
+  it('should return the ref of the first text input', () => {
+    const components = [
+      {id: 'heading', type: 'TEXT', label: 'Sign in'},
+      {id: 'username', type: 'TEXT_INPUT', ref: 'username'},
+      {id: 'email', type: 'TEXT_INPUT', ref: 'email'},
+    ] as unknown as EmbeddedFlowComponent[];
+
+    expect(findIdentifierInputRef(components)).toBe('username');
+  });
+
+  it('should search the components of a block', () => {
+    const components = [
+      {
+        id: 'block',
+        type: 'BLOCK',
+        components: [
+          {id: 'username', type: 'TEXT_INPUT', ref: 'username'},
+          {id: 'password', type: 'PASSWORD_INPUT', ref: 'password'},
+        ],
+      },
+    ] as unknown as EmbeddedFlowComponent[];
+
+    expect(findIdentifierInputRef(components)).toBe('username');
+  });
+
+  it('should return undefined when the step has no text input', () => {
+    const components = [
+      {id: 'password', type: 'PASSWORD_INPUT', ref: 'password'},
+    ] as unknown as EmbeddedFlowComponent[];
+
+    expect(findIdentifierInputRef(components)).toBeUndefined();
+  });
+});
@@ -0,0 +1,55 @@
+/**
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+import {EmbeddedFlowComponentType, type EmbeddedFlowComponent} from '@asgardeo/react';
+
+/**
+ * Find the reference of the first text input of a flow step.
+ *
+ * The server sends the `login_hint` of the authorization request along with the
+ * first step that collects a non-sensitive input, which is the input used to
+ * identify the user.  Nested components (e.g. the inputs of a `BLOCK`) are
+ * searched depth first.
+ *
+ * @param components - The components of the current flow step.
+ * @returns The `ref` of the first text input, or `undefined` when the step has none.
+ *
+ * @example
+ * ```ts
+ * findIdentifierInputRef([{type: 'BLOCK', components: [{type: 'TEXT_INPUT', ref: 'username'}]}]);
+ * // => 'username'
+ * ```
+ */
+export default function findIdentifierInputRef(components: EmbeddedFlowComponent[]): string | undefined {
+  for (const component of components) {
+    if (
+      ((component.type as EmbeddedFlowComponentType) === EmbeddedFlowComponentType.TextInput ||
+        component.type === 'TEXT_INPUT') &&
+      typeof component.ref === 'string'
+    ) {
+      return component.ref;
+    }
+
+    const nestedRef = component.components ? findIdentifierInputRef(component.components) : undefined;
+    if (nestedRef) {
+      return nestedRef;
+    }
+  }
+
+  return undefined;
+}
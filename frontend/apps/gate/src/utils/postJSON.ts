@@ -0,0 +1,45 @@
+/**
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+/**
+ * Posts a JSON body to a server endpoint and returns the parsed response.
+ *
+ * @param url - The endpoint URL.
+ * @param body - The request body.
+ * @returns The parsed response body.
+ * @throws {Error} With the server provided `error_description` when the request fails.
+ *
+ * @example
+ * ```ts
+ * const result = await postJSON<{status: string}>(`${baseUrl}/oauth2/device/callback`, {userCode, authId});
+ * ```
+ */
+export default async function postJSON<T>(url: string, body: Record<string, unknown>): Promise<T> {
+  const response = await fetch(url, {
+    method: 'POST',
+    headers: {'Content-Type': 'application/json', Accept: 'application/json'},
+    credentials: 'include',
+    body: JSON.stringify(body),
+  });
+  const payload = (await response.json().catch(() => ({}))) as Record<string, unknown>;
+  if (!response.ok) {
+    throw new Error(typeof payload.error_description === 'string' ? payload.error_description : response.statusText);
+  }
+
+  return payload as T;
+}
@@ -129,6 +129,11 @@ export default function FlowComponentRenderer({
     const isExpiredOnMount = hasTimer && expiresIn <= 0;
 
     if (hasConsent) {
+      const authorizationDetails = additionalData?.['authorizationDetailsPrompt'] as
+        | string
+        | Record<string, unknown>[]
+        | undefined;
+
       return (
         <>
           <ConsentAdapter
@@ -137,6 +142,7 @@ export default function FlowComponentRenderer({
             }
             formValues={values}
             onInputChange={onInputChange}
+            authorizationDetails={authorizationDetails}
           />
           <BlockAdapter
             component={component}
@@ -147,7 +153,16 @@ export default function FlowComponentRenderer({
             isLoading={isLoading || isExpiredOnMount}
             resolve={resolve}
             onInputChange={onInputChange}
-            onSubmit={onSubmit}
+            onSubmit={(action, inputs) =>
+              // The requested authorization details are approved with the primary (allow) action and denied
+              // with any other action of the consent step.
+              onSubmit(
+                action,
+                authorizationDetails != null
+                  ? {...inputs, authorizationDetailsApproved: String(action.variant === 'PRIMARY')}
+                  : inputs,
+              )
+            }
             onValidate={onValidate}
             signUpFallbackUrl={signUpFallbackUrl}
           />
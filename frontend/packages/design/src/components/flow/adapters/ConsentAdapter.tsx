@@ -33,6 +33,34 @@ interface ConsentAdapterProps {
   formValues: Record<string, string>;
   /** Handler invoked when the user toggles an optional attribute */
   onInputChange: (name: string, value: string) => void;
+  /** Raw authorization details from additionalData.authorizationDetailsPrompt */
+  authorizationDetails?: string | Record<string, unknown>[];
+}
+
+/**
+ * Parses the requested authorization details (RFC 9396) sent by the backend as a JSON array.
+ */
+function parseAuthorizationDetails(
+  authorizationDetails?: string | Record<string, unknown>[],
+): Record<string, unknown>[] {
+  if (!authorizationDetails) return [];
+  if (Array.isArray(authorizationDetails)) return authorizationDetails;
+
+  try {
+    const parsed: unknown = JSON.parse(authorizationDetails);
+    return Array.isArray(parsed) ? (parsed as Record<string, unknown>[]) : [];
+  } catch {
+    return [];
+  }
+}
+
+/**
+ * Formats a field of an authorization detail for display.
+ */
+function formatAuthorizationDetailValue(value: unknown): string {
+  if (Array.isArray(value)) return value.map((item) => formatAuthorizationDetailValue(item)).join(', ');
+  if (value !== null && typeof value === 'object') return JSON.stringify(value);
+  return String(value);
 }
 
 /**
@@ -40,139 +68,168 @@ interface ConsentAdapterProps {
  *
  * Uses the SDK's `Consent` render-prop component to parse the backend data,
  * then renders each purpose section with oxygen-ui `Checkbox` and `Typography`.
+ * Requested authorization details are listed below the purposes; they are
+ * authorized as a whole by the action the user submits the step with.
  */
 export default function ConsentAdapter({
   consentData = undefined,
   formValues,
   onInputChange,
+  authorizationDetails = undefined,
 }: ConsentAdapterProps): JSX.Element | null {
   if (!consentData) return null;
 
+  const details = parseAuthorizationDetails(authorizationDetails);
+
   return (
-    <Consent consentData={consentData} formValues={formValues} onInputChange={onInputChange}>
-      {({purposes}: ConsentRenderProps) => (
-        <Box className={cn('Flow--consent')} sx={{display: 'flex', flexDirection: 'column', gap: 2, mt: 1}}>
-          {purposes.map((purpose, idx) => (
-            <Box key={purpose.purposeId ?? idx}>
-              {purpose.essential && purpose.essential.length > 0 && (
-                <Box sx={{mt: 1}}>
-                  <Typography className={cn('Text--subtitle2')} variant="subtitle2" fontWeight="bold" sx={{mb: 0.5}}>
-                    Essential Attributes
-                  </Typography>
-                  <ConsentCheckboxList
-                    variant="ESSENTIAL"
-                    purpose={purpose}
-                    formValues={formValues}
-                    onInputChange={onInputChange}
-                  >
-                    {({attributes, isChecked}) => (
-                      <Box sx={{display: 'flex', flexDirection: 'column'}}>
-                        {attributes.map((attr) => (
-                          <Box key={attr} sx={{px: 1}}>
-                            <FormControlLabel
-                              className={cn('FormControlLabel--root')}
-                              control={
-                                <Switch
-                                  className={cn('Switch--root')}
-                                  checked={isChecked(attr)}
-                                  disabled
-                                  size="small"
-                                />
-                              }
-                              label={
-                                <Box sx={{display: 'flex', alignItems: 'center', gap: 1.5}}>
-                                  <Box
-                                    sx={{
-                                      width: 6,
-                                      height: 6,
-                                      borderRadius: '50%',
-                                      backgroundColor: 'text.disabled',
-                                      flexShrink: 0,
-                                    }}
+    <>
+      <Consent consentData={consentData} formValues={formValues} onInputChange={onInputChange}>
+        {({purposes}: ConsentRenderProps) => (
+          <Box className={cn('Flow--consent')} sx={{display: 'flex', flexDirection: 'column', gap: 2, mt: 1}}>
+            {purposes.map((purpose, idx) => (
+              <Box key={purpose.purposeId ?? idx}>
+                {purpose.essential && purpose.essential.length > 0 && (
+                  <Box sx={{mt: 1}}>
+                    <Typography className={cn('Text--subtitle2')} variant="subtitle2" fontWeight="bold" sx={{mb: 0.5}}>
+                      Essential Attributes
+                    </Typography>
+                    <ConsentCheckboxList
+                      variant="ESSENTIAL"
+                      purpose={purpose}
+                      formValues={formValues}
+                      onInputChange={onInputChange}
+                    >
+                      {({attributes, isChecked}) => (
+                        <Box sx={{display: 'flex', flexDirection: 'column'}}>
+                          {attributes.map((attr) => (
+                            <Box key={attr} sx={{px: 1}}>
+                              <FormControlLabel
+                                className={cn('FormControlLabel--root')}
+                                control={
+                                  <Switch
+                                    className={cn('Switch--root')}
+                                    checked={isChecked(attr)}
+                                    disabled
+                                    size="small"
                                   />
-                                  <Typography className={cn('Text--body2')} variant="body2" sx={{fontWeight: 500}}>
-                                    {attr}
-                                  </Typography>
-                                </Box>
-                              }
-                              labelPlacement="start"
-                              sx={{
-                                m: 0,
-                                width: '100%',
-                                justifyContent: 'space-between',
-                                py: 0.5,
-                              }}
-                            />
-                            <Divider className={cn('Divider--root')} sx={{opacity: 0.5}} />
-                          </Box>
-                        ))}
-                      </Box>
-                    )}
-                  </ConsentCheckboxList>
-                </Box>
-              )}
-              {purpose.optional && purpose.optional.length > 0 && (
-                <Box sx={{mt: 1}}>
-                  <Typography className={cn('Text--subtitle2')} variant="subtitle2" fontWeight="bold" sx={{mb: 0.5}}>
-                    Optional Attributes
-                  </Typography>
-                  <ConsentCheckboxList
-                    variant="OPTIONAL"
-                    purpose={purpose}
-                    formValues={formValues}
-                    onInputChange={onInputChange}
-                  >
-                    {({attributes, isChecked, handleChange}) => (
-                      <Box sx={{display: 'flex', flexDirection: 'column'}}>
-                        {attributes.map((attr) => (
-                          <Box key={attr} sx={{px: 1}}>
-                            <FormControlLabel
-                              className={cn('FormControlLabel--root')}
-                              control={
-                                <Switch
-                                  className={cn('Switch--root')}
-                                  checked={isChecked(attr)}
-                                  onChange={(e) => handleChange(attr, (e.target as HTMLInputElement).checked)}
-                                  size="small"
-                                />
-                              }
-                              label={
-                                <Box sx={{display: 'flex', alignItems: 'center', gap: 1.5}}>
-                                  <Box
-                                    sx={{
-                                      width: 6,
-                                      height: 6,
-                                      borderRadius: '50%',
-                                      backgroundColor: 'text.disabled',
-                                      flexShrink: 0,
-                                    }}
+                                }
+                                label={
+                                  <Box sx={{display: 'flex', alignItems: 'center', gap: 1.5}}>
+                                    <Box
+                                      sx={{
+                                        width: 6,
+                                        height: 6,
+                                        borderRadius: '50%',
+                                        backgroundColor: 'text.disabled',
+                                        flexShrink: 0,
+                                      }}
+                                    />
+                                    <Typography className={cn('Text--body2')} variant="body2" sx={{fontWeight: 500}}>
+                                      {attr}
+                                    </Typography>
+                                  </Box>
+                                }
+                                labelPlacement="start"
+                                sx={{
+                                  m: 0,
+                                  width: '100%',
+                                  justifyContent: 'space-between',
+                                  py: 0.5,
+                                }}
+                              />
+                              <Divider className={cn('Divider--root')} sx={{opacity: 0.5}} />
+                            </Box>
+                          ))}
+                        </Box>
+                      )}
+                    </ConsentCheckboxList>
+                  </Box>
+                )}
+                {purpose.optional && purpose.optional.length > 0 && (
+                  <Box sx={{mt: 1}}>
+                    <Typography className={cn('Text--subtitle2')} variant="subtitle2" fontWeight="bold" sx={{mb: 0.5}}>
+                      Optional Attributes
+                    </Typography>
+                    <ConsentCheckboxList
+                      variant="OPTIONAL"
+                      purpose={purpose}
+                      formValues={formValues}
+                      onInputChange={onInputChange}
+                    >
+                      {({attributes, isChecked, handleChange}) => (
+                        <Box sx={{display: 'flex', flexDirection: 'column'}}>
+                          {attributes.map((attr) => (
+                            <Box key={attr} sx={{px: 1}}>
+                              <FormControlLabel
+                                className={cn('FormControlLabel--root')}
+                                control={
+                                  <Switch
+                                    className={cn('Switch--root')}
+                                    checked={isChecked(attr)}
+                                    onChange={(e) => handleChange(attr, (e.target as HTMLInputElement).checked)}
+                                    size="small"
                                   />
-                                  <Typography className={cn('Text--body2')} variant="body2" sx={{fontWeight: 500}}>
-                                    {attr}
-                                  </Typography>
-                                </Box>
-                              }
-                              labelPlacement="start"
-                              sx={{
-                                m: 0,
-                                width: '100%',
-                                justifyContent: 'space-between',
-                                py: 0.5,
-                              }}
-                            />
-                            <Divider className={cn('Divider--root')} sx={{opacity: 0.5}} />
-                          </Box>
-                        ))}
-                      </Box>
-                    )}
-                  </ConsentCheckboxList>
-                </Box>
-              )}
-              {idx < purposes.length - 1 && <Divider className={cn('Divider--root')} sx={{mt: 2}} />}
+                                }
+                                label={
+                                  <Box sx={{display: 'flex', alignItems: 'center', gap: 1.5}}>
+                                    <Box
+                                      sx={{
+                                        width: 6,
+                                        height: 6,
+                                        borderRadius: '50%',
+                                        backgroundColor: 'text.disabled',
+                                        flexShrink: 0,
+                                      }}
+                                    />
+                                    <Typography className={cn('Text--body2')} variant="body2" sx={{fontWeight: 500}}>
+                                      {attr}
+                                    </Typography>
+                                  </Box>
+                                }
+                                labelPlacement="start"
+                                sx={{
+                                  m: 0,
+                                  width: '100%',
+                                  justifyContent: 'space-between',
+                                  py: 0.5,
+                                }}
+                              />
+                              <Divider className={cn('Divider--root')} sx={{opacity: 0.5}} />
+                            </Box>
+                          ))}
+                        </Box>
+                      )}
+                    </ConsentCheckboxList>
+                  </Box>
+                )}
+                {idx < purposes.length - 1 && <Divider className={cn('Divider--root')} sx={{mt: 2}} />}
+              </Box>
+            ))}
+          </Box>
+        )}
+      </Consent>
+      {details.length > 0 && (
+        <Box className={cn('Flow--authorization-details')} sx={{mt: 1}}>
+          <Typography className={cn('Text--subtitle2')} variant="subtitle2" fontWeight="bold" sx={{mb: 0.5}}>
+            Requested Authorizations
+          </Typography>
+          {details.map((detail, idx) => (
+            <Box key={`${String(detail['type'])}-${idx}`} sx={{px: 1, py: 0.5}}>
+              <Typography className={cn('Text--body2')} variant="body2" sx={{fontWeight: 500}}>
+                {String(detail['type'])}
+              </Typography>
+              {Object.entries(detail)
+                .filter(([key]) => key !== 'type')
+                .map(([key, value]) => (
+                  <Typography key={key} className={cn('Text--caption')} variant="caption" component="div">
+                    {key}: {formatAuthorizationDetailValue(value)}
+                  </Typography>
+                ))}
+              <Divider className={cn('Divider--root')} sx={{opacity: 0.5, mt: 0.5}} />
             </Box>
           ))}
         </Box>
       )}
-    </Consent>
+    </>
   );
 }
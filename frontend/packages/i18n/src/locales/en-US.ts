@@ -2029,6 +2029,46 @@ const translations = {
     'errors.passkey.failed': 'Failed to create passkey. Please try again.',
   },
 
+  // ============================================================================
+  // Logout - Logout confirmation page translations
+  // ============================================================================
+  logout: {
+    heading: 'Sign Out',
+    description: 'Do you want to sign out? You will be signed out of all the applications you are signed in to.',
+    'button.confirm': 'Sign out',
+    'button.cancel': 'Stay signed in',
+    'cancelled.description': 'You are still signed in. You can close this window.',
+  },
+
+  // ============================================================================
+  // Device - Device authorization user code entry page translations
+  // ============================================================================
+  device: {
+    heading: 'Connect a Device',
+    description: 'Enter the code displayed on your device to continue.',
+    'fields.userCode.label': 'Device code',
+    'fields.userCode.placeholder': 'XXXX-XXXX',
+    'button.continue': 'Continue',
+    'button.deny': 'Deny access',
+    'authorized.description': 'Your device is now connected. You can return to your device.',
+    'denied.description': 'Access was denied for the device. You can close this window.',
+    'errors.failed.description': 'We could not verify the device code. Please try again.',
+  },
+
+  // ============================================================================
+  // Backchannel - Backchannel authentication approval page translations
+  // ============================================================================
+  backchannel: {
+    heading: 'Approve Sign-In Request',
+    description: 'An application is requesting to sign you in. Continue only if you started this request.',
+    'button.approve': 'Continue',
+    'button.deny': 'Deny request',
+    'authorized.description': 'The sign-in request was approved. You can close this window.',
+    'denied.description': 'The sign-in request was denied. You can close this window.',
+    'errors.invalid.description': 'This approval link is invalid or has expired.',
+    'errors.failed.description': 'We could not process the sign-in request. Please try again.',
+  },
+
   // ============================================================================
   // Components namespace - SDK component error translations
   // ============================================================================
@@ -46,6 +46,7 @@ type OAuth2AuthorizationServerMetadata struct {
 	RegistrationEndpoint                       string   `json:"registration_endpoint,omitempty"`
 	ScopesSupported                            []string `json:"scopes_supported"`
 	ResponseTypesSupported                     []string `json:"response_types_supported"`
+	ResponseModesSupported                     []string `json:"response_modes_supported,omitempty"`
 	GrantTypesSupported                        []string `json:"grant_types_supported"`
 	TokenEndpointAuthMethodsSupported          []string `json:"token_endpoint_auth_methods_supported"`
 	CodeChallengeMethodsSupported              []string `json:"code_challenge_methods_supported,omitempty"`
@@ -123,7 +124,10 @@ func (ts *DiscoveryTestSuite) TestOAuth2AuthorizationServerMetadata_GET_Success(
 
 	// Verify supported response types
 	ts.NotEmpty(metadata.ResponseTypesSupported, "ResponseTypesSupported should not be empty")
-	ts.Equal([]string{"code"}, metadata.ResponseTypesSupported, "Should only support 'code' response type")
+	ts.Equal([]string{"code", "id_token", "code id_token", "code id_token token"},
+		metadata.ResponseTypesSupported, "Should support the code, implicit and hybrid response types")
+	ts.Equal([]string{"query", "fragment", "form_post"}, metadata.ResponseModesSupported,
+		"Should support the query, fragment and form_post response modes")
 
 	// Verify supported token endpoint auth methods
 	ts.NotEmpty(metadata.TokenEndpointAuthMethodsSupported, "TokenEndpointAuthMethodsSupported should not be empty")